@@ -533,11 +533,27 @@ const getAllAliases = function (aliases) {
 
   return new Set(
     aliasList.filter(function (itm) {
-      return getDomainType(itm) !== domainTypes.OtherDomainZone;
+      return getDomainType(itm) !== domainTypes.OtherDomainZone && !isCoveredByEnvCertificate(itm);
     })
   );
 };
 
+// isCoveredByEnvCertificate returns true if the alias is the environment domain or a single label under it,
+// such as "pr-123.test.app.example.com" or "*.test.app.example.com". These aliases are already covered by the
+// "${env}.${app}.${domain}" and "*.${env}.${app}.${domain}" names of the certificate, so adding or removing them
+// doesn't require a new certificate.
+const isCoveredByEnvCertificate = function (alias) {
+  const envDomain = domainTypes.EnvDomainZone.domain;
+  if (alias === envDomain || alias === `*.${envDomain}`) {
+    return true;
+  }
+  if (!alias.endsWith(`.${envDomain}`)) {
+    return false;
+  }
+  const label = alias.slice(0, -`.${envDomain}`.length);
+  return label.length > 0 && !label.includes(".") && !label.includes("*");
+};
+
 const getDomainType = function (alias) {
   if (domainTypes.EnvDomainZone.regex.test(alias)) {
     return domainTypes.EnvDomainZone;
@@ -0,0 +1,489 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+"use strict";
+
+const aws = require("aws-sdk");
+
+// managedWebACLDescription marks the web ACLs created by this custom resource, so that
+// they can be deleted once no service of the load balancer rate limits its requests.
+const managedWebACLDescription =
+  "Rate limits requests to the services of a load balancer. Managed by AWS Copilot.";
+// rateLimitedResponseCode is the status code returned to clients over their rate limit.
+const rateLimitedResponseCode = 429;
+
+// These are used for test purposes only
+let defaultResponseURL;
+let defaultLogGroup;
+let defaultLogStream;
+let maxAttempts = 10;
+let retryDelayMs = 1000;
+
+/**
+ * Upload a CloudFormation response object to S3.
+ *
+ * @param {object} event the Lambda event payload received by the handler function
+ * @param {object} context the Lambda context received by the handler function
+ * @param {string} responseStatus the response status, either 'SUCCESS' or 'FAILED'
+ * @param {string} physicalResourceId CloudFormation physical resource ID
+ * @param {object} [responseData] arbitrary response data object
+ * @param {string} [reason] reason for failure, if any, to convey to the user
+ * @returns {Promise} Promise that is resolved on success, or rejected on connection error or HTTP error response
+ */
+let report = function (
+  event,
+  context,
+  responseStatus,
+  physicalResourceId,
+  responseData,
+  reason
+) {
+  return new Promise((resolve, reject) => {
+    const https = require("https");
+    const { URL } = require("url");
+
+    let responseBody = JSON.stringify({
+      Status: responseStatus,
+      Reason: reason,
+      PhysicalResourceId: physicalResourceId || context.logStreamName,
+      StackId: event.StackId,
+      RequestId: event.RequestId,
+      LogicalResourceId: event.LogicalResourceId,
+      Data: responseData,
+    });
+
+    const parsedUrl = new URL(event.ResponseURL || defaultResponseURL);
+    const options = {
+      hostname: parsedUrl.hostname,
+      port: 443,
+      path: parsedUrl.pathname + parsedUrl.search,
+      method: "PUT",
+      headers: {
+        "Content-Type": "",
+        "Content-Length": responseBody.length,
+      },
+    };
+
+    https
+      .request(options)
+      .on("error", reject)
+      .on("response", (res) => {
+        res.resume();
+        if (res.statusCode >= 400) {
+          reject(new Error(`Error ${res.statusCode}: ${res.statusMessage}`));
+        } else {
+          resolve();
+        }
+      })
+      .end(responseBody, "utf8");
+  });
+};
+
+const sleep = function (ms) {
+  return new Promise((resolve) => setTimeout(resolve, ms));
+};
+
+/**
+ * Returns the name of the web ACL created for a load balancer.
+ * For example, "arn:aws:elasticloadbalancing:us-west-2:000000000:loadbalancer/app/demo-test/1234"
+ * returns "copilot-demo-test-1234".
+ *
+ * @param {string} loadBalancerArn the ARN of the application load balancer.
+ * @returns {string} The name of the web ACL.
+ */
+const webACLName = function (loadBalancerArn) {
+  const parts = loadBalancerArn.split("/");
+  return `copilot-${parts[parts.length - 2]}-${parts[parts.length - 1]}`;
+};
+
+/**
+ * Returns true if the web ACL was created by this custom resource for the load balancer.
+ *
+ * @param {object} acl the web ACL.
+ * @param {string} loadBalancerArn the ARN of the application load balancer.
+ * @returns {boolean} Whether Copilot manages the web ACL.
+ */
+const isManagedWebACL = function (acl, loadBalancerArn) {
+  return (
+    acl.Name === webACLName(loadBalancerArn) &&
+    acl.Description === managedWebACLDescription
+  );
+};
+
+/**
+ * Finds the web ACL with the given name.
+ *
+ * @param {string} name the name of the web ACL.
+ * @returns {object} The summary of the web ACL, or undefined if it doesn't exist.
+ */
+const findWebACL = async function (name) {
+  const waf = new aws.WAFV2();
+  let marker;
+  do {
+    const resp = await waf
+      .listWebACLs({ Scope: "REGIONAL", NextMarker: marker })
+      .promise();
+    const acl = (resp.WebACLs || []).find((acl) => acl.Name === name);
+    if (acl) {
+      return acl;
+    }
+    marker = resp.NextMarker;
+  } while (marker);
+  return undefined;
+};
+
+/**
+ * Returns the web ACL that Copilot manages for the load balancer.
+ * If there is none, a web ACL that allows all requests is created and associated with the load balancer.
+ * Web ACLs associated outside of Copilot are never modified.
+ *
+ * @param {string} loadBalancerArn the ARN of the application load balancer.
+ * @returns {object} The name and ID of the web ACL.
+ */
+const getOrCreateWebACL = async function (loadBalancerArn) {
+  const waf = new aws.WAFV2();
+  const { WebACL: associated } = await waf
+    .getWebACLForResource({ ResourceArn: loadBalancerArn })
+    .promise();
+  if (associated) {
+    if (!isManagedWebACL(associated, loadBalancerArn)) {
+      throw new Error(
+        `load balancer ${loadBalancerArn} is associated with web ACL ${associated.Name} that is not managed by Copilot: remove "http.rate_limit" from the manifest or add the rate-based rule to the web ACL yourself`
+      );
+    }
+    return { Name: associated.Name, Id: associated.Id };
+  }
+
+  const name = webACLName(loadBalancerArn);
+  let acl;
+  try {
+    const { Summary } = await waf
+      .createWebACL({
+        Name: name,
+        Scope: "REGIONAL",
+        Description: managedWebACLDescription,
+        DefaultAction: { Allow: {} },
+        Rules: [],
+        VisibilityConfig: {
+          SampledRequestsEnabled: true,
+          CloudWatchMetricsEnabled: true,
+          MetricName: name,
+        },
+      })
+      .promise();
+    acl = Summary;
+  } catch (err) {
+    if (err.code !== "WAFDuplicateItemException") {
+      throw err;
+    }
+    // Another service of the load balancer is creating the web ACL.
+    acl = await findWebACL(name);
+    if (!acl) {
+      throw new Error(`find web ACL ${name}: ${err.message}`);
+    }
+  }
+
+  // A new web ACL can't be associated until it has propagated.
+  for (let attempt = 1; ; attempt++) {
+    try {
+      await waf
+        .associateWebACL({ WebACLArn: acl.ARN, ResourceArn: loadBalancerArn })
+        .promise();
+      break;
+    } catch (err) {
+      if (
+        err.code !== "WAFUnavailableEntityException" ||
+        attempt >= maxAttempts
+      ) {
+        throw err;
+      }
+      await sleep(retryDelayMs);
+    }
+  }
+  return { Name: acl.Name, Id: acl.Id };
+};
+
+/**
+ * Replaces the rules of a web ACL with the output of updateRulesFn.
+ * The update is retried if the web ACL changed since it was read.
+ *
+ * @param {object} acl the name and ID of the web ACL.
+ * @param {function} updateRulesFn returns the new rules given the current ones.
+ * @returns {object} The web ACL after the update.
+ */
+const updateWebACLRules = async function (acl, updateRulesFn) {
+  const waf = new aws.WAFV2();
+  for (let attempt = 1; ; attempt++) {
+    const { WebACL, LockToken } = await waf
+      .getWebACL({ Name: acl.Name, Id: acl.Id, Scope: "REGIONAL" })
+      .promise();
+    const rules = updateRulesFn(WebACL.Rules || []);
+    try {
+      await waf
+        .updateWebACL({
+          Name: WebACL.Name,
+          Id: WebACL.Id,
+          Scope: "REGIONAL",
+          LockToken: LockToken,
+          Description: WebACL.Description,
+          DefaultAction: WebACL.DefaultAction,
+          Rules: rules,
+          VisibilityConfig: WebACL.VisibilityConfig,
+          CustomResponseBodies: WebACL.CustomResponseBodies,
+          CaptchaConfig: WebACL.CaptchaConfig,
+        })
+        .promise();
+      return { ...WebACL, Rules: rules };
+    } catch (err) {
+      if (
+        err.code !== "WAFOptimisticLockException" ||
+        attempt >= maxAttempts
+      ) {
+        throw err;
+      }
+    }
+  }
+};
+
+/**
+ * Returns a statement matching requests whose field is the value.
+ */
+const byteMatch = function (fieldToMatch, value, positionalConstraint) {
+  return {
+    ByteMatchStatement: {
+      FieldToMatch: fieldToMatch,
+      SearchString: value,
+      PositionalConstraint: positionalConstraint,
+      TextTransformations: [{ Priority: 0, Type: "LOWERCASE" }],
+    },
+  };
+};
+
+const anyOf = function (statements) {
+  if (statements.length === 1) {
+    return statements[0];
+  }
+  return { OrStatement: { Statements: statements } };
+};
+
+/**
+ * Returns the statement matching the requests routed to the service, or undefined if the
+ * service receives all the requests of the load balancer.
+ *
+ * @param {string} rulePath the path of the service's listener rule, "/" for the root path.
+ * @param {string[]} aliases the host names of the service's listener rule.
+ * @returns {object} The scope-down statement of the rate-based rule.
+ */
+const scopeDownStatement = function (rulePath, aliases) {
+  const statements = [];
+  if (aliases && aliases.length > 0) {
+    statements.push(
+      anyOf(
+        aliases.map((alias) => {
+          const host = alias.toLowerCase();
+          // A wildcard alias such as "*.example.com" matches any subdomain.
+          if (host.startsWith("*.")) {
+            return byteMatch(
+              { SingleHeader: { Name: "host" } },
+              host.substring(1),
+              "ENDS_WITH"
+            );
+          }
+          return byteMatch(
+            { SingleHeader: { Name: "host" } },
+            host,
+            "EXACTLY"
+          );
+        })
+      )
+    );
+  }
+  if (rulePath && rulePath !== "/") {
+    const path = `/${rulePath}`.toLowerCase();
+    statements.push(
+      anyOf([
+        byteMatch({ UriPath: {} }, path, "EXACTLY"),
+        byteMatch({ UriPath: {} }, `${path}/`, "STARTS_WITH"),
+      ])
+    );
+  }
+  switch (statements.length) {
+    case 0:
+      return undefined;
+    case 1:
+      return statements[0];
+    default:
+      return { AndStatement: { Statements: statements } };
+  }
+};
+
+/**
+ * Returns the rate-based rule blocking the IP addresses that send more requests to the service than the limit.
+ *
+ * @param {object} props the properties of the custom resource.
+ * @param {number} priority the priority of the rule in the web ACL.
+ * @returns {object} The rule.
+ */
+const rateBasedRule = function (props, priority) {
+  const statement = {
+    Limit: parseInt(props.Limit),
+    AggregateKeyType: "IP",
+  };
+  const scopeDown = scopeDownStatement(props.RulePath, props.Aliases);
+  if (scopeDown) {
+    statement.ScopeDownStatement = scopeDown;
+  }
+  return {
+    Name: props.RuleName,
+    Priority: priority,
+    Action: {
+      Block: {
+        CustomResponse: { ResponseCode: rateLimitedResponseCode },
+      },
+    },
+    Statement: { RateBasedStatement: statement },
+    VisibilityConfig: {
+      SampledRequestsEnabled: true,
+      CloudWatchMetricsEnabled: true,
+      MetricName: props.RuleName,
+    },
+  };
+};
+
+/**
+ * Adds the rate-based rule of the service to the web ACL of the load balancer, or replaces it if it exists.
+ *
+ * @param {object} props the properties of the custom resource.
+ */
+const putRateLimitRule = async function (props) {
+  const acl = await getOrCreateWebACL(props.LoadBalancerArn);
+  await updateWebACLRules(acl, (rules) => {
+    const existing = rules.find((rule) => rule.Name === props.RuleName);
+    if (existing) {
+      return rules.map((rule) =>
+        rule.Name === props.RuleName
+          ? rateBasedRule(props, existing.Priority)
+          : rule
+      );
+    }
+    const priority =
+      rules.length === 0
+        ? 0
+        : Math.max(...rules.map((rule) => rule.Priority)) + 1;
+    return rules.concat(rateBasedRule(props, priority));
+  });
+};
+
+/**
+ * Removes the rate-based rule of the service from the web ACL that Copilot manages for the load balancer.
+ * The web ACL is deleted once it has no more rules.
+ *
+ * @param {object} props the properties of the custom resource.
+ */
+const deleteRateLimitRule = async function (props) {
+  const waf = new aws.WAFV2();
+  const { WebACL: associated } = await waf
+    .getWebACLForResource({ ResourceArn: props.LoadBalancerArn })
+    .promise();
+  if (!associated || !isManagedWebACL(associated, props.LoadBalancerArn)) {
+    // The rule was never added to a web ACL that isn't managed by Copilot.
+    return;
+  }
+  const acl = await updateWebACLRules(associated, (rules) =>
+    rules.filter((rule) => rule.Name !== props.RuleName)
+  );
+  if (acl.Rules.length > 0) {
+    return;
+  }
+
+  await waf
+    .disassociateWebACL({ ResourceArn: props.LoadBalancerArn })
+    .promise();
+  const { WebACL, LockToken } = await waf
+    .getWebACL({ Name: acl.Name, Id: acl.Id, Scope: "REGIONAL" })
+    .promise();
+  if (WebACL.Rules && WebACL.Rules.length > 0) {
+    // Another service of the load balancer added its rule in the meantime.
+    await waf
+      .associateWebACL({
+        WebACLArn: WebACL.ARN,
+        ResourceArn: props.LoadBalancerArn,
+      })
+      .promise();
+    return;
+  }
+  await waf
+    .deleteWebACL({
+      Name: acl.Name,
+      Id: acl.Id,
+      Scope: "REGIONAL",
+      LockToken: LockToken,
+    })
+    .promise();
+};
+
+/**
+ * WAF rate limit rule handler, invoked by Lambda.
+ */
+exports.rateLimitRuleHandler = async function (event, context) {
+  const props = event.ResourceProperties;
+  const physicalResourceId = `${props.LoadBalancerArn}/${props.RuleName}`;
+
+  try {
+    switch (event.RequestType) {
+      case "Create":
+      case "Update":
+        // If the load balancer or the rule name changes, the physical ID changes and
+        // CloudFormation deletes the previous rule once the stack update completes.
+        await putRateLimitRule(props);
+        break;
+      case "Delete":
+        await deleteRateLimitRule(props);
+        break;
+      default:
+        throw new Error(`Unsupported request type ${event.RequestType}`);
+    }
+
+    await report(event, context, "SUCCESS", physicalResourceId);
+  } catch (err) {
+    console.log(`Caught error ${err}.`);
+    await report(
+      event,
+      context,
+      "FAILED",
+      physicalResourceId,
+      null,
+      `${err.message} (Log: ${defaultLogGroup || context.logGroupName}/${
+        defaultLogStream || context.logStreamName
+      })`
+    );
+  }
+};
+
+/**
+ * @private
+ */
+exports.withDefaultResponseURL = function (url) {
+  defaultResponseURL = url;
+};
+
+/**
+ * @private
+ */
+exports.withDefaultLogStream = function (logStream) {
+  defaultLogStream = logStream;
+};
+
+/**
+ * @private
+ */
+exports.withDefaultLogGroup = function (logGroup) {
+  defaultLogGroup = logGroup;
+};
+
+/**
+ * @private
+ */
+exports.withRetries = function (attempts, delayMs) {
+  maxAttempts = attempts;
+  retryDelayMs = delayMs;
+};
@@ -456,6 +456,44 @@ describe("DNS Validated Certificate Handler", () => {
       });
   });
 
+  test("Update operation quits early if only aliases covered by the environment certificate change", () => {
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" && body.PhysicalResourceId === "mockCertArn"
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(handler.certificateRequestHandler)
+      .event({
+        RequestType: "Update",
+        RequestId: testRequestId,
+        PhysicalResourceId: "mockCertArn",
+        ResourceProperties: {
+          AppName: testAppName,
+          EnvName: testEnvName,
+          DomainName: testDomainName,
+          Aliases: `{
+            "frontend": ["v1.${testAppName}.${testDomainName}"],
+            "review-123": ["pr-123.${testEnvName}.${testAppName}.${testDomainName}"],
+            "review": ["*.${testEnvName}.${testAppName}.${testDomainName}"]
+          }`,
+          EnvHostedZoneId: testHostedZoneId,
+          Region: "us-east-1",
+          RootDNSRole: testRootDNSRole,
+        },
+        OldResourceProperties: {
+          Aliases: `{
+            "frontend": ["v1.${testAppName}.${testDomainName}"]
+          }`,
+        },
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
   test("Create operation requests a legacy certificate", () => {
     const requestCertificateFake = sinon.fake.resolves({
       CertificateArn: testCertificateArn,
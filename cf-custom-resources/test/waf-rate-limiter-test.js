@@ -0,0 +1,535 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+"use strict";
+
+describe("WAF Rate Limiter", () => {
+  const AWS = require("aws-sdk-mock");
+  const LambdaTester = require("lambda-tester").noVersionCheck();
+  const sinon = require("sinon");
+  const rateLimiter = require("../lib/waf-rate-limiter");
+  const nock = require("nock");
+  const ResponseURL = "https://cloudwatch-response-mock.example.com/";
+  const LogGroup = "/aws/lambda/testLambda";
+  const LogStream = "2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd";
+
+  let origLog = console.log;
+
+  const testRequestId = "f4ef1b10-c39a-44e3-99c0-fbf7e53c3943";
+  const testLoadBalancerArn =
+    "arn:aws:elasticloadbalancing:us-west-2:000000000:loadbalancer/app/demo-test/1234";
+  const testWebACLArn =
+    "arn:aws:wafv2:us-west-2:000000000:regional/webacl/copilot-demo-test-1234/abcd";
+  const testProps = {
+    LoadBalancerArn: testLoadBalancerArn,
+    RuleName: "demo-test-api",
+    RulePath: "api",
+    Aliases: ["API.example.com"],
+    Limit: "300000",
+  };
+  const managedDescription =
+    "Rate limits requests to the services of a load balancer. Managed by AWS Copilot.";
+  const otherRule = {
+    Name: "demo-test-frontend",
+    Priority: 3,
+  };
+  const wantedRule = (priority) => ({
+    Name: "demo-test-api",
+    Priority: priority,
+    Action: {
+      Block: {
+        CustomResponse: { ResponseCode: 429 },
+      },
+    },
+    Statement: {
+      RateBasedStatement: {
+        Limit: 300000,
+        AggregateKeyType: "IP",
+        ScopeDownStatement: {
+          AndStatement: {
+            Statements: [
+              {
+                ByteMatchStatement: {
+                  FieldToMatch: { SingleHeader: { Name: "host" } },
+                  SearchString: "api.example.com",
+                  PositionalConstraint: "EXACTLY",
+                  TextTransformations: [{ Priority: 0, Type: "LOWERCASE" }],
+                },
+              },
+              {
+                OrStatement: {
+                  Statements: [
+                    {
+                      ByteMatchStatement: {
+                        FieldToMatch: { UriPath: {} },
+                        SearchString: "/api",
+                        PositionalConstraint: "EXACTLY",
+                        TextTransformations: [
+                          { Priority: 0, Type: "LOWERCASE" },
+                        ],
+                      },
+                    },
+                    {
+                      ByteMatchStatement: {
+                        FieldToMatch: { UriPath: {} },
+                        SearchString: "/api/",
+                        PositionalConstraint: "STARTS_WITH",
+                        TextTransformations: [
+                          { Priority: 0, Type: "LOWERCASE" },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+    },
+    VisibilityConfig: {
+      SampledRequestsEnabled: true,
+      CloudWatchMetricsEnabled: true,
+      MetricName: "demo-test-api",
+    },
+  });
+  const webACL = (rules, description) => ({
+    Name: "copilot-demo-test-1234",
+    Id: "abcd",
+    ARN: testWebACLArn,
+    Description: description,
+    DefaultAction: { Allow: {} },
+    Rules: rules,
+    VisibilityConfig: {
+      SampledRequestsEnabled: true,
+      CloudWatchMetricsEnabled: true,
+      MetricName: "copilot-demo-test-1234",
+    },
+  });
+
+  beforeEach(() => {
+    rateLimiter.withDefaultResponseURL(ResponseURL);
+    rateLimiter.withDefaultLogGroup(LogGroup);
+    rateLimiter.withDefaultLogStream(LogStream);
+    rateLimiter.withRetries(2, 0);
+    console.log = function () {};
+  });
+  afterEach(() => {
+    AWS.restore();
+    console.log = origLog;
+  });
+
+  test("Bogus operation fails", () => {
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason ===
+            "Unsupported request type bogus (Log: /aws/lambda/testLambda/2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd)"
+        );
+      })
+      .reply(200);
+    return LambdaTester(rateLimiter.rateLimitRuleHandler)
+      .event({
+        RequestType: "bogus",
+        RequestId: testRequestId,
+        ResourceProperties: testProps,
+        LogicalResourceId: "mockID",
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("Create adds the rule after the existing rules of the associated web ACL", () => {
+    const getWebACLForResourceFake = sinon.fake.resolves({
+      WebACL: webACL([otherRule], managedDescription),
+    });
+    const getWebACLFake = sinon.fake.resolves({
+      WebACL: webACL([otherRule], managedDescription),
+      LockToken: "token",
+    });
+    const updateWebACLFake = sinon.fake.resolves({});
+    const createWebACLFake = sinon.fake.resolves({});
+    AWS.mock("WAFV2", "getWebACLForResource", getWebACLForResourceFake);
+    AWS.mock("WAFV2", "getWebACL", getWebACLFake);
+    AWS.mock("WAFV2", "updateWebACL", updateWebACLFake);
+    AWS.mock("WAFV2", "createWebACL", createWebACLFake);
+
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" &&
+          body.PhysicalResourceId === `${testLoadBalancerArn}/demo-test-api`
+        );
+      })
+      .reply(200);
+    return LambdaTester(rateLimiter.rateLimitRuleHandler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        ResourceProperties: testProps,
+        LogicalResourceId: "mockID",
+      })
+      .expectResolve(() => {
+        sinon.assert.notCalled(createWebACLFake);
+        sinon.assert.calledWith(
+          getWebACLFake,
+          sinon.match({
+            Name: "copilot-demo-test-1234",
+            Id: "abcd",
+            Scope: "REGIONAL",
+          })
+        );
+        sinon.assert.calledWith(
+          updateWebACLFake,
+          sinon.match({
+            Id: "abcd",
+            LockToken: "token",
+            Description: managedDescription,
+            Rules: [otherRule, wantedRule(4)],
+          })
+        );
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("Create fails if the load balancer is associated with a web ACL not managed by Copilot", () => {
+    const updateWebACLFake = sinon.fake.resolves({});
+    const createWebACLFake = sinon.fake.resolves({});
+    AWS.mock(
+      "WAFV2",
+      "getWebACLForResource",
+      sinon.fake.resolves({
+        WebACL: { ...webACL([otherRule], "custom"), Name: "firewall" },
+      })
+    );
+    AWS.mock("WAFV2", "updateWebACL", updateWebACLFake);
+    AWS.mock("WAFV2", "createWebACL", createWebACLFake);
+
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason.startsWith(
+            `load balancer ${testLoadBalancerArn} is associated with web ACL firewall that is not managed by Copilot`
+          )
+        );
+      })
+      .reply(200);
+    return LambdaTester(rateLimiter.rateLimitRuleHandler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        ResourceProperties: testProps,
+        LogicalResourceId: "mockID",
+      })
+      .expectResolve(() => {
+        sinon.assert.notCalled(updateWebACLFake);
+        sinon.assert.notCalled(createWebACLFake);
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("Create creates and associates a web ACL if the load balancer doesn't have one", () => {
+    const getWebACLForResourceFake = sinon.fake.resolves({});
+    const createWebACLFake = sinon.fake.resolves({
+      Summary: {
+        Name: "copilot-demo-test-1234",
+        Id: "abcd",
+        ARN: testWebACLArn,
+      },
+    });
+    const associateWebACLFake = sinon.stub();
+    associateWebACLFake.onFirstCall().rejects({
+      code: "WAFUnavailableEntityException",
+      message: "not yet",
+    });
+    associateWebACLFake.resolves({});
+    const getWebACLFake = sinon.fake.resolves({
+      WebACL: webACL([], managedDescription),
+      LockToken: "token",
+    });
+    const updateWebACLFake = sinon.fake.resolves({});
+    AWS.mock("WAFV2", "getWebACLForResource", getWebACLForResourceFake);
+    AWS.mock("WAFV2", "createWebACL", createWebACLFake);
+    AWS.mock("WAFV2", "associateWebACL", associateWebACLFake);
+    AWS.mock("WAFV2", "getWebACL", getWebACLFake);
+    AWS.mock("WAFV2", "updateWebACL", updateWebACLFake);
+
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+    return LambdaTester(rateLimiter.rateLimitRuleHandler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        ResourceProperties: testProps,
+        LogicalResourceId: "mockID",
+      })
+      .expectResolve(() => {
+        sinon.assert.calledWith(
+          createWebACLFake,
+          sinon.match({
+            Name: "copilot-demo-test-1234",
+            Scope: "REGIONAL",
+            Description: managedDescription,
+            DefaultAction: { Allow: {} },
+          })
+        );
+        sinon.assert.calledTwice(associateWebACLFake);
+        sinon.assert.calledWith(
+          associateWebACLFake,
+          sinon.match({
+            WebACLArn: testWebACLArn,
+            ResourceArn: testLoadBalancerArn,
+          })
+        );
+        sinon.assert.calledWith(
+          updateWebACLFake,
+          sinon.match({
+            Rules: [wantedRule(0)],
+          })
+        );
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("Update replaces the rule in place and retries if the web ACL changed", () => {
+    const getWebACLForResourceFake = sinon.fake.resolves({
+      WebACL: webACL([wantedRule(7)], managedDescription),
+    });
+    const getWebACLFake = sinon.fake.resolves({
+      WebACL: webACL(
+        [{ ...wantedRule(7), Statement: {} }, otherRule],
+        managedDescription
+      ),
+      LockToken: "token",
+    });
+    const updateWebACLFake = sinon.stub();
+    updateWebACLFake.onFirstCall().rejects({
+      code: "WAFOptimisticLockException",
+      message: "changed",
+    });
+    updateWebACLFake.resolves({});
+    AWS.mock("WAFV2", "getWebACLForResource", getWebACLForResourceFake);
+    AWS.mock("WAFV2", "getWebACL", getWebACLFake);
+    AWS.mock("WAFV2", "updateWebACL", updateWebACLFake);
+
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+    return LambdaTester(rateLimiter.rateLimitRuleHandler)
+      .event({
+        RequestType: "Update",
+        RequestId: testRequestId,
+        ResourceProperties: testProps,
+        LogicalResourceId: "mockID",
+      })
+      .expectResolve(() => {
+        sinon.assert.calledTwice(getWebACLFake);
+        sinon.assert.calledTwice(updateWebACLFake);
+        sinon.assert.calledWith(
+          updateWebACLFake,
+          sinon.match({
+            Rules: [wantedRule(7), otherRule],
+          })
+        );
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("Create fails if the web ACL can't be updated", () => {
+    AWS.mock(
+      "WAFV2",
+      "getWebACLForResource",
+      sinon.fake.resolves({ WebACL: webACL([], managedDescription) })
+    );
+    AWS.mock(
+      "WAFV2",
+      "getWebACL",
+      sinon.fake.resolves({
+        WebACL: webACL([], managedDescription),
+        LockToken: "token",
+      })
+    );
+    AWS.mock(
+      "WAFV2",
+      "updateWebACL",
+      sinon.fake.rejects(new Error("some error"))
+    );
+
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason ===
+            "some error (Log: /aws/lambda/testLambda/2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd)"
+        );
+      })
+      .reply(200);
+    return LambdaTester(rateLimiter.rateLimitRuleHandler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        ResourceProperties: testProps,
+        LogicalResourceId: "mockID",
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("Delete is a no-op if the load balancer doesn't have a web ACL", () => {
+    const getWebACLFake = sinon.fake.resolves({});
+    AWS.mock("WAFV2", "getWebACLForResource", sinon.fake.resolves({}));
+    AWS.mock("WAFV2", "getWebACL", getWebACLFake);
+
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+    return LambdaTester(rateLimiter.rateLimitRuleHandler)
+      .event({
+        RequestType: "Delete",
+        RequestId: testRequestId,
+        ResourceProperties: testProps,
+        LogicalResourceId: "mockID",
+      })
+      .expectResolve(() => {
+        sinon.assert.notCalled(getWebACLFake);
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("Delete leaves a web ACL not managed by Copilot untouched", () => {
+    const getWebACLFake = sinon.fake.resolves({});
+    const updateWebACLFake = sinon.fake.resolves({});
+    AWS.mock(
+      "WAFV2",
+      "getWebACLForResource",
+      sinon.fake.resolves({ WebACL: webACL([otherRule], "custom") })
+    );
+    AWS.mock("WAFV2", "getWebACL", getWebACLFake);
+    AWS.mock("WAFV2", "updateWebACL", updateWebACLFake);
+
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+    return LambdaTester(rateLimiter.rateLimitRuleHandler)
+      .event({
+        RequestType: "Delete",
+        RequestId: testRequestId,
+        ResourceProperties: testProps,
+        LogicalResourceId: "mockID",
+      })
+      .expectResolve(() => {
+        sinon.assert.notCalled(getWebACLFake);
+        sinon.assert.notCalled(updateWebACLFake);
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("Delete removes the rule and keeps a web ACL with other rules", () => {
+    const getWebACLFake = sinon.fake.resolves({
+      WebACL: webACL([otherRule, wantedRule(4)], managedDescription),
+      LockToken: "token",
+    });
+    const updateWebACLFake = sinon.fake.resolves({});
+    const disassociateWebACLFake = sinon.fake.resolves({});
+    AWS.mock(
+      "WAFV2",
+      "getWebACLForResource",
+      sinon.fake.resolves({
+        WebACL: webACL([otherRule, wantedRule(4)], managedDescription),
+      })
+    );
+    AWS.mock("WAFV2", "getWebACL", getWebACLFake);
+    AWS.mock("WAFV2", "updateWebACL", updateWebACLFake);
+    AWS.mock("WAFV2", "disassociateWebACL", disassociateWebACLFake);
+
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+    return LambdaTester(rateLimiter.rateLimitRuleHandler)
+      .event({
+        RequestType: "Delete",
+        RequestId: testRequestId,
+        ResourceProperties: testProps,
+        LogicalResourceId: "mockID",
+      })
+      .expectResolve(() => {
+        sinon.assert.calledWith(
+          updateWebACLFake,
+          sinon.match({
+            Rules: [otherRule],
+          })
+        );
+        sinon.assert.notCalled(disassociateWebACLFake);
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("Delete removes the web ACL created by Copilot once it has no rules", () => {
+    const getWebACLFake = sinon.stub();
+    getWebACLFake.onFirstCall().resolves({
+      WebACL: webACL([wantedRule(0)], managedDescription),
+      LockToken: "token1",
+    });
+    getWebACLFake.resolves({
+      WebACL: webACL([], managedDescription),
+      LockToken: "token2",
+    });
+    const updateWebACLFake = sinon.fake.resolves({});
+    const disassociateWebACLFake = sinon.fake.resolves({});
+    const deleteWebACLFake = sinon.fake.resolves({});
+    AWS.mock(
+      "WAFV2",
+      "getWebACLForResource",
+      sinon.fake.resolves({
+        WebACL: webACL([wantedRule(0)], managedDescription),
+      })
+    );
+    AWS.mock("WAFV2", "getWebACL", getWebACLFake);
+    AWS.mock("WAFV2", "updateWebACL", updateWebACLFake);
+    AWS.mock("WAFV2", "disassociateWebACL", disassociateWebACLFake);
+    AWS.mock("WAFV2", "deleteWebACL", deleteWebACLFake);
+
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+    return LambdaTester(rateLimiter.rateLimitRuleHandler)
+      .event({
+        RequestType: "Delete",
+        RequestId: testRequestId,
+        ResourceProperties: testProps,
+        LogicalResourceId: "mockID",
+      })
+      .expectResolve(() => {
+        sinon.assert.calledWith(
+          disassociateWebACLFake,
+          sinon.match({ ResourceArn: testLoadBalancerArn })
+        );
+        sinon.assert.calledWith(
+          deleteWebACLFake,
+          sinon.match({
+            Name: "copilot-demo-test-1234",
+            Id: "abcd",
+            Scope: "REGIONAL",
+            LockToken: "token2",
+          })
+        );
+        expect(request.isDone()).toBe(true);
+      });
+  });
+});
@@ -85,6 +85,8 @@ func buildRootCmd() *cobra.Command {
 	// "Extend" command group
 	cmd.AddCommand(cli.BuildStorageCmd())
 	cmd.AddCommand(cli.BuildSecretCmd())
+	cmd.AddCommand(cli.BuildTemplatesCmd())
+	cmd.AddCommand(cli.BuildManifestCmd())
 
 	// "Settings" command group.
 	cmd.AddCommand(cli.BuildVersionCmd())
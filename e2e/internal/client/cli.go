@@ -445,13 +445,15 @@ copilot svc deploy
 	--name $n
 	--env $e
 	--tag $t
+	--yes-security
 */
 func (cli *CLI) SvcDeploy(opts *SvcDeployInput) (string, error) {
 	arguments := []string{
 		"svc", "deploy",
 		"--name", opts.Name,
 		"--env", opts.EnvName,
-		"--tag", opts.ImageTag}
+		"--tag", opts.ImageTag,
+		"--yes-security"}
 	if opts.Force {
 		arguments = append(arguments, "--force")
 	}
@@ -609,11 +611,13 @@ func (cli *CLI) EnvInit(opts *EnvInitRequest) (string, error) {
 copilot env deploy
 	--name $n
 	--app $a
+	--yes-security
 */
 func (cli *CLI) EnvDeploy(opts *EnvDeployRequest) (string, error) {
 	commands := []string{"env", "deploy",
 		"--name", opts.Name,
 		"--app", opts.AppName,
+		"--yes-security",
 	}
 	return cli.exec(exec.Command(cli.path, commands...))
 }
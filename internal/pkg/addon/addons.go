@@ -60,6 +60,7 @@ func New(wlName string) (*Addons, error) {
 
 // Template merges CloudFormation templates under the "addons/" directory of a workload
 // into a single CloudFormation template and returns it.
+// Aurora clusters with reader instances also output their writer endpoint, reader endpoint and port.
 //
 // If the addons directory doesn't exist, it returns the empty string and
 // ErrAddonsDirNotExist.
@@ -93,6 +94,9 @@ func (a *Addons) Template() (string, error) {
 			return "", err
 		}
 	}
+	if err := mergedTemplate.addAuroraEndpointOutputs(); err != nil {
+		return "", err
+	}
 	out, err := yaml.Marshal(mergedTemplate)
 	if err != nil {
 		return "", fmt.Errorf("marshal merged addons template: %w", err)
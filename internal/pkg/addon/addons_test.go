@@ -235,6 +235,23 @@ func TestAddons_Template(t *testing.T) {
 				return string(wanted)
 			}(),
 		},
+		"outputs the writer endpoint, reader endpoint and port of Aurora clusters with readers": {
+			mockAddons: func(ctrl *gomock.Controller) *Addons {
+				ws := mocks.NewMockworkspaceReader(ctrl)
+				ws.EXPECT().ReadAddonsDir(testSvcName).Return([]string{"readers.yml"}, nil)
+
+				readers, _ := ioutil.ReadFile(filepath.Join("testdata", "aurora", "readers.yml"))
+				ws.EXPECT().ReadAddon(testSvcName, "readers.yml").Return(readers, nil)
+				return &Addons{
+					wlName: testSvcName,
+					ws:     ws,
+				}
+			},
+			wantedTemplate: func() string {
+				wanted, _ := ioutil.ReadFile(filepath.Join("testdata", "aurora", "wanted.yml"))
+				return string(wanted)
+			}(),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -0,0 +1,136 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package addon
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// AWS CloudFormation resource types of an Aurora cluster.
+const (
+	rdsDBClusterType  = "AWS::RDS::DBCluster"
+	rdsDBInstanceType = "AWS::RDS::DBInstance"
+)
+
+// Suffixes of the outputs that Copilot adds to the addons template for an Aurora cluster with readers.
+// The outputs are named after the logical ID of the cluster, ex: "MyClusterWriterEndpoint".
+const (
+	AuroraWriterEndpointOutputSuffix = "WriterEndpoint"
+	AuroraReaderEndpointOutputSuffix = "ReaderEndpoint"
+	AuroraPortOutputSuffix           = "Port"
+)
+
+// auroraEndpointOutput is an output that exposes an attribute of an Aurora cluster.
+type auroraEndpointOutput struct {
+	suffix      string
+	attribute   string
+	description string
+}
+
+var auroraEndpointOutputs = []auroraEndpointOutput{
+	{
+		suffix:      AuroraWriterEndpointOutputSuffix,
+		attribute:   "Endpoint.Address",
+		description: "The connection endpoint of the writer instance of the DB cluster.",
+	},
+	{
+		suffix:      AuroraReaderEndpointOutputSuffix,
+		attribute:   "ReadEndpoint.Address",
+		description: "The load-balanced endpoint of the reader instances of the DB cluster.",
+	},
+	{
+		suffix:      AuroraPortOutputSuffix,
+		attribute:   "Endpoint.Port",
+		description: "The port of the writer and reader endpoints of the DB cluster.",
+	},
+}
+
+// addAuroraEndpointOutputs adds the writer endpoint, reader endpoint and port of each Aurora cluster
+// with reader instances to the Outputs of t, so that they're injected as environment variables in the workload.
+// Outputs already defined by the template are left untouched.
+func (t *cfnTemplate) addAuroraEndpointOutputs() error {
+	clusters, err := auroraClustersWithReaders(&t.Resources)
+	if err != nil {
+		return err
+	}
+	if len(clusters) == 0 {
+		return nil
+	}
+	if t.Outputs.IsZero() {
+		t.Outputs = yaml.Node{
+			Kind: yaml.MappingNode,
+			Tag:  "!!map",
+		}
+	}
+	defined := make(map[string]bool)
+	for _, content := range mappingContents(&t.Outputs) {
+		defined[content.keyNode.Value] = true
+	}
+	for _, cluster := range clusters {
+		for _, out := range auroraEndpointOutputs {
+			name := cluster + out.suffix
+			if defined[name] {
+				continue
+			}
+			t.Outputs.Content = append(t.Outputs.Content, &yaml.Node{
+				Kind:  yaml.ScalarNode,
+				Tag:   "!!str",
+				Value: name,
+			}, &yaml.Node{
+				Kind: yaml.MappingNode,
+				Tag:  "!!map",
+				Content: []*yaml.Node{
+					{Kind: yaml.ScalarNode, Tag: "!!str", Value: "Description"},
+					{Kind: yaml.ScalarNode, Tag: "!!str", Value: out.description},
+					{Kind: yaml.ScalarNode, Tag: "!!str", Value: "Value"},
+					{Kind: yaml.ScalarNode, Tag: "!GetAtt", Value: fmt.Sprintf("%s.%s", cluster, out.attribute)},
+				},
+			})
+		}
+	}
+	return nil
+}
+
+// auroraClustersWithReaders returns the logical IDs of the DB clusters that have at least two DB instances,
+// a writer and one or more readers, in the order in which they're defined.
+func auroraClustersWithReaders(resourcesNode *yaml.Node) ([]string, error) {
+	if resourcesNode.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	var clusters []string
+	instancesOf := make(map[string]int)
+	for _, content := range mappingContents(resourcesNode) {
+		fields := struct {
+			Type       string `yaml:"Type"`
+			Properties struct {
+				DBClusterIdentifier yaml.Node `yaml:"DBClusterIdentifier"`
+			} `yaml:"Properties"`
+		}{}
+		if err := content.valueNode.Decode(&fields); err != nil {
+			return nil, fmt.Errorf(`decode the "Type" and "Properties" fields of resource "%s": %w`, content.keyNode.Value, err)
+		}
+		switch fields.Type {
+		case rdsDBClusterType:
+			clusters = append(clusters, content.keyNode.Value)
+		case rdsDBInstanceType:
+			if ref, ok := refNodeValue(&fields.Properties.DBClusterIdentifier); ok {
+				instancesOf[ref]++
+			}
+		}
+	}
+	var withReaders []string
+	for _, cluster := range clusters {
+		if instancesOf[cluster] > 1 {
+			withReaders = append(withReaders, cluster)
+		}
+	}
+	return withReaders, nil
+}
+
+// refNodeValue returns the logical ID referred by a node like "!Ref MyCluster" or "Ref: MyCluster".
+func refNodeValue(node *yaml.Node) (string, bool) {
+	return (&outputNode{valueNode: node}).ref()
+}
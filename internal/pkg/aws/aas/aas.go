@@ -6,6 +6,7 @@ package aas
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	aas "github.com/aws/aws-sdk-go/service/applicationautoscaling"
@@ -17,10 +18,30 @@ const (
 	// ECS service resource ID format: service/${clusterName}/${serviceName}.
 	fmtECSResourceID    = "service/%s/%s"
 	ecsServiceNamespace = "ecs"
+
+	// The maximum number of scaling activities returned by a single call.
+	maxScalingActivitiesPerPage = 50
 )
 
 type api interface {
 	DescribeScalingPolicies(input *aas.DescribeScalingPoliciesInput) (*aas.DescribeScalingPoliciesOutput, error)
+	DescribeScalableTargets(input *aas.DescribeScalableTargetsInput) (*aas.DescribeScalableTargetsOutput, error)
+	DescribeScalingActivities(input *aas.DescribeScalingActivitiesInput) (*aas.DescribeScalingActivitiesOutput, error)
+}
+
+// ScalableTarget holds the capacity bounds that Application Auto Scaling scales a resource within.
+type ScalableTarget struct {
+	MinCapacity int64 `json:"minCapacity"`
+	MaxCapacity int64 `json:"maxCapacity"`
+}
+
+// ScalingActivity holds a scale-out or scale-in event of a scalable target.
+type ScalingActivity struct {
+	Description string    `json:"description"`
+	Cause       string    `json:"cause"`
+	Status      string    `json:"status"`
+	StartTime   time.Time `json:"startTime"`
+	EndTime     time.Time `json:"endTime"`
 }
 
 // ApplicationAutoscaling wraps an Amazon Application Auto Scaling client.
@@ -62,3 +83,63 @@ func (a *ApplicationAutoscaling) ECSServiceAlarmNames(cluster, service string) (
 	}
 	return alarms, nil
 }
+
+// ECSServiceScalableTarget returns the scalable target of the desired count of the ECS service.
+// If the service doesn't auto scale, it returns nil.
+func (a *ApplicationAutoscaling) ECSServiceScalableTarget(cluster, service string) (*ScalableTarget, error) {
+	resp, err := a.client.DescribeScalableTargets(&aas.DescribeScalableTargetsInput{
+		ResourceIds:       aws.StringSlice([]string{fmt.Sprintf(fmtECSResourceID, cluster, service)}),
+		ScalableDimension: aws.String(aas.ScalableDimensionEcsServiceDesiredCount),
+		ServiceNamespace:  aws.String(ecsServiceNamespace),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe scalable targets for ECS service %s/%s: %w", cluster, service, err)
+	}
+	if len(resp.ScalableTargets) == 0 {
+		return nil, nil
+	}
+	target := resp.ScalableTargets[0]
+	return &ScalableTarget{
+		MinCapacity: aws.Int64Value(target.MinCapacity),
+		MaxCapacity: aws.Int64Value(target.MaxCapacity),
+	}, nil
+}
+
+// ECSServiceScalingActivities returns up to limit of the most recent scaling activities of the desired count
+// of the ECS service, starting with the most recent one.
+func (a *ApplicationAutoscaling) ECSServiceScalingActivities(cluster, service string, limit int) ([]ScalingActivity, error) {
+	var activities []ScalingActivity
+	in := &aas.DescribeScalingActivitiesInput{
+		ResourceId:        aws.String(fmt.Sprintf(fmtECSResourceID, cluster, service)),
+		ScalableDimension: aws.String(aas.ScalableDimensionEcsServiceDesiredCount),
+		ServiceNamespace:  aws.String(ecsServiceNamespace),
+	}
+	for len(activities) < limit {
+		in.MaxResults = aws.Int64(int64(min(limit-len(activities), maxScalingActivitiesPerPage)))
+		resp, err := a.client.DescribeScalingActivities(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe scaling activities for ECS service %s/%s: %w", cluster, service, err)
+		}
+		for _, activity := range resp.ScalingActivities {
+			activities = append(activities, ScalingActivity{
+				Description: aws.StringValue(activity.Description),
+				Cause:       aws.StringValue(activity.Cause),
+				Status:      aws.StringValue(activity.StatusCode),
+				StartTime:   aws.TimeValue(activity.StartTime),
+				EndTime:     aws.TimeValue(activity.EndTime),
+			})
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		in.NextToken = resp.NextToken
+	}
+	return activities, nil
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	aas "github.com/aws/aws-sdk-go/service/applicationautoscaling"
@@ -139,3 +140,168 @@ func TestCloudWatch_ECSServiceAutoscalingAlarms(t *testing.T) {
 
 	}
 }
+
+func TestApplicationAutoscaling_ECSServiceScalableTarget(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m aasMocks)
+
+		wantErr    error
+		wantTarget *ScalableTarget
+	}{
+		"errors if failed to describe scalable targets": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("describe scalable targets for ECS service mockCluster/mockService: some error"),
+		},
+		"returns nil if the service doesn't auto scale": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(gomock.Any()).Return(&aas.DescribeScalableTargetsOutput{}, nil)
+			},
+		},
+		"success": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(&aas.DescribeScalableTargetsInput{
+					ResourceIds:       aws.StringSlice([]string{"service/mockCluster/mockService"}),
+					ScalableDimension: aws.String("ecs:service:DesiredCount"),
+					ServiceNamespace:  aws.String("ecs"),
+				}).Return(&aas.DescribeScalableTargetsOutput{
+					ScalableTargets: []*aas.ScalableTarget{
+						{
+							MinCapacity: aws.Int64(1),
+							MaxCapacity: aws.Int64(10),
+						},
+					},
+				}, nil)
+			},
+			wantTarget: &ScalableTarget{
+				MinCapacity: 1,
+				MaxCapacity: 10,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := aasMocks{
+				client: mocks.NewMockapi(ctrl),
+			}
+			tc.setupMocks(m)
+
+			aasSvc := ApplicationAutoscaling{
+				client: m.client,
+			}
+
+			// WHEN
+			got, err := aasSvc.ECSServiceScalableTarget("mockCluster", "mockService")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantTarget, got)
+		})
+	}
+}
+
+func TestApplicationAutoscaling_ECSServiceScalingActivities(t *testing.T) {
+	startTime := time.Date(2022, 8, 1, 10, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(time.Minute)
+	input := func(maxResults int64, nextToken *string) *aas.DescribeScalingActivitiesInput {
+		return &aas.DescribeScalingActivitiesInput{
+			ResourceId:        aws.String("service/mockCluster/mockService"),
+			ScalableDimension: aws.String("ecs:service:DesiredCount"),
+			ServiceNamespace:  aws.String("ecs"),
+			MaxResults:        aws.Int64(maxResults),
+			NextToken:         nextToken,
+		}
+	}
+	activity := &aas.ScalingActivity{
+		Description: aws.String("Setting desired count to 3."),
+		Cause:       aws.String("monitor alarm TargetTracking-service/mockCluster/mockService-AlarmHigh in state ALARM triggered policy CPU"),
+		StatusCode:  aws.String("Successful"),
+		StartTime:   aws.Time(startTime),
+		EndTime:     aws.Time(endTime),
+	}
+	wantActivity := ScalingActivity{
+		Description: "Setting desired count to 3.",
+		Cause:       "monitor alarm TargetTracking-service/mockCluster/mockService-AlarmHigh in state ALARM triggered policy CPU",
+		Status:      "Successful",
+		StartTime:   startTime,
+		EndTime:     endTime,
+	}
+	testCases := map[string]struct {
+		limit      int
+		setupMocks func(m aasMocks)
+
+		wantErr        error
+		wantActivities []ScalingActivity
+	}{
+		"errors if failed to describe scaling activities": {
+			limit: 5,
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalingActivities(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("describe scaling activities for ECS service mockCluster/mockService: some error"),
+		},
+		"stops paginating once the limit is reached": {
+			limit: 2,
+			setupMocks: func(m aasMocks) {
+				gomock.InOrder(
+					m.client.EXPECT().DescribeScalingActivities(input(2, nil)).Return(&aas.DescribeScalingActivitiesOutput{
+						ScalingActivities: []*aas.ScalingActivity{activity},
+						NextToken:         aws.String("mockNextToken"),
+					}, nil),
+					m.client.EXPECT().DescribeScalingActivities(input(1, aws.String("mockNextToken"))).Return(&aas.DescribeScalingActivitiesOutput{
+						ScalingActivities: []*aas.ScalingActivity{activity},
+						NextToken:         aws.String("mockNextToken2"),
+					}, nil),
+				)
+			},
+			wantActivities: []ScalingActivity{wantActivity, wantActivity},
+		},
+		"stops paginating once there are no more activities": {
+			limit: 5,
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalingActivities(input(5, nil)).Return(&aas.DescribeScalingActivitiesOutput{
+					ScalingActivities: []*aas.ScalingActivity{activity},
+				}, nil)
+			},
+			wantActivities: []ScalingActivity{wantActivity},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := aasMocks{
+				client: mocks.NewMockapi(ctrl),
+			}
+			tc.setupMocks(m)
+
+			aasSvc := ApplicationAutoscaling{
+				client: m.client,
+			}
+
+			// WHEN
+			got, err := aasSvc.ECSServiceScalingActivities("mockCluster", "mockService", tc.limit)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantActivities, got)
+		})
+	}
+}
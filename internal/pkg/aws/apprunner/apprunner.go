@@ -42,6 +42,7 @@ type api interface {
 	ResumeService(input *apprunner.ResumeServiceInput) (*apprunner.ResumeServiceOutput, error)
 	StartDeployment(input *apprunner.StartDeploymentInput) (*apprunner.StartDeploymentOutput, error)
 	DescribeObservabilityConfiguration(input *apprunner.DescribeObservabilityConfigurationInput) (*apprunner.DescribeObservabilityConfigurationOutput, error)
+	DescribeCustomDomains(input *apprunner.DescribeCustomDomainsInput) (*apprunner.DescribeCustomDomainsOutput, error)
 }
 
 // AppRunner wraps an AWS AppRunner client.
@@ -125,6 +126,33 @@ func (a *AppRunner) ServiceARN(svc string) (string, error) {
 	return "", fmt.Errorf("no AppRunner service found for %s", svc)
 }
 
+// DescribeCustomDomains returns the custom domains associated with an AppRunner service given its ARN.
+func (a *AppRunner) DescribeCustomDomains(svcARN string) ([]CustomDomain, error) {
+	var domains []CustomDomain
+	var nextToken *string
+	for {
+		resp, err := a.client.DescribeCustomDomains(&apprunner.DescribeCustomDomainsInput{
+			ServiceArn: aws.String(svcARN),
+			NextToken:  nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe custom domains of service %s: %w", svcARN, err)
+		}
+		for _, domain := range resp.CustomDomains {
+			domains = append(domains, CustomDomain{
+				DomainName:         aws.StringValue(domain.DomainName),
+				Status:             aws.StringValue(domain.Status),
+				EnableWWWSubdomain: aws.BoolValue(domain.EnableWWWSubdomain),
+			})
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		nextToken = resp.NextToken
+	}
+	return domains, nil
+}
+
 // PauseService pause the running App Runner service.
 func (a *AppRunner) PauseService(svcARN string) error {
 	resp, err := a.client.PauseService(&apprunner.PauseServiceInput{
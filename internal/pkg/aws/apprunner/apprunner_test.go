@@ -182,6 +182,88 @@ func TestAppRunner_ServiceARN(t *testing.T) {
 	}
 }
 
+func TestAppRunner_DescribeCustomDomains(t *testing.T) {
+	const mockSvcARN = "arn:aws:apprunner:us-west-2:123456789012:service/phonetool-test-frontend/fc1098ac269245959ba78fd58bdd4bf"
+	testError := errors.New("some error")
+	testCases := map[string]struct {
+		mockAppRunnerClient func(m *mocks.Mockapi)
+
+		wantErr     error
+		wantDomains []CustomDomain
+	}{
+		"errors if fail to describe custom domains": {
+			mockAppRunnerClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeCustomDomains(&apprunner.DescribeCustomDomainsInput{
+					ServiceArn: aws.String(mockSvcARN),
+				}).Return(nil, testError)
+			},
+			wantErr: fmt.Errorf("describe custom domains of service %s: some error", mockSvcARN),
+		},
+		"success with multiple pages": {
+			mockAppRunnerClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeCustomDomains(&apprunner.DescribeCustomDomainsInput{
+					ServiceArn: aws.String(mockSvcARN),
+				}).Return(&apprunner.DescribeCustomDomainsOutput{
+					CustomDomains: []*apprunner.CustomDomain{
+						{
+							DomainName:         aws.String("example.com"),
+							Status:             aws.String("ACTIVE"),
+							EnableWWWSubdomain: aws.Bool(true),
+						},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeCustomDomains(&apprunner.DescribeCustomDomainsInput{
+					ServiceArn: aws.String(mockSvcARN),
+					NextToken:  aws.String("next"),
+				}).Return(&apprunner.DescribeCustomDomainsOutput{
+					CustomDomains: []*apprunner.CustomDomain{
+						{
+							DomainName:         aws.String("api.example.com"),
+							Status:             aws.String("PENDING_CERTIFICATE_DNS_VALIDATION"),
+							EnableWWWSubdomain: aws.Bool(false),
+						},
+					},
+				}, nil)
+			},
+			wantDomains: []CustomDomain{
+				{
+					DomainName:         "example.com",
+					Status:             "ACTIVE",
+					EnableWWWSubdomain: true,
+				},
+				{
+					DomainName: "api.example.com",
+					Status:     "PENDING_CERTIFICATE_DNS_VALIDATION",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAppRunnerClient := mocks.NewMockapi(ctrl)
+			tc.mockAppRunnerClient(mockAppRunnerClient)
+
+			service := AppRunner{
+				client: mockAppRunnerClient,
+			}
+
+			domains, err := service.DescribeCustomDomains(mockSvcARN)
+
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantDomains, domains)
+			}
+		})
+	}
+}
+
 func Test_ParseServiceName(t *testing.T) {
 	testCases := map[string]struct {
 		svcARN string
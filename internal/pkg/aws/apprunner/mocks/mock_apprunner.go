@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: ./internal/pkg/aws/apprunner/apprunner.go
+// Source: ./aws/apprunner/apprunner.go
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -34,6 +34,21 @@ func (m *Mockapi) EXPECT() *MockapiMockRecorder {
 	return m.recorder
 }
 
+// DescribeCustomDomains mocks base method.
+func (m *Mockapi) DescribeCustomDomains(input *apprunner.DescribeCustomDomainsInput) (*apprunner.DescribeCustomDomainsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCustomDomains", input)
+	ret0, _ := ret[0].(*apprunner.DescribeCustomDomainsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCustomDomains indicates an expected call of DescribeCustomDomains.
+func (mr *MockapiMockRecorder) DescribeCustomDomains(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCustomDomains", reflect.TypeOf((*Mockapi)(nil).DescribeCustomDomains), input)
+}
+
 // DescribeObservabilityConfiguration mocks base method.
 func (m *Mockapi) DescribeObservabilityConfiguration(input *apprunner.DescribeObservabilityConfigurationInput) (*apprunner.DescribeObservabilityConfigurationOutput, error) {
 	m.ctrl.T.Helper()
@@ -5,6 +5,7 @@
 package apprunner
 
 import (
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/apprunner"
@@ -27,6 +28,19 @@ type Service struct {
 	Observability        ObservabilityConfiguration
 }
 
+// CustomDomain contains the name and the association status of a custom domain of a service.
+type CustomDomain struct {
+	DomainName         string
+	Status             string // For example, "ACTIVE" or "PENDING_CERTIFICATE_DNS_VALIDATION".
+	EnableWWWSubdomain bool
+}
+
+// IsActive returns true if the service can be reached through the custom domain.
+func (d CustomDomain) IsActive() bool {
+	// The API returns statuses in lower case, unlike the enum values of the SDK.
+	return strings.EqualFold(d.Status, apprunner.CustomDomainAssociationStatusActive)
+}
+
 // EnvironmentVariable contains the name and value of an environment variable.
 type EnvironmentVariable struct {
 	Name  string
@@ -225,6 +225,18 @@ func (cs *changeSet) executeWithNoRollback() error {
 // createAndExecute calls create and then execute.
 // If the change set is empty, returns a ErrChangeSetEmpty.
 func (cs *changeSet) createAndExecute(conf *stackConfig) error {
+	if err := cs.createOrDeleteIfEmpty(conf); err != nil {
+		return err
+	}
+	if conf.DisableRollback {
+		return cs.executeWithNoRollback()
+	}
+	return cs.execute()
+}
+
+// createOrDeleteIfEmpty creates a ChangeSet. If the ChangeSet does not contain any changes, then it's deleted and
+// ErrChangeSetEmpty is returned.
+func (cs *changeSet) createOrDeleteIfEmpty(conf *stackConfig) error {
 	if err := cs.create(conf); err != nil {
 		// It's possible that there are no changes between the previous and proposed stack change sets.
 		// We make a call to describe the change set to see if that is indeed the case and handle it gracefully.
@@ -245,10 +257,7 @@ func (cs *changeSet) createAndExecute(conf *stackConfig) error {
 		}
 		return fmt.Errorf("%w: %s", err, descr.StatusReason)
 	}
-	if conf.DisableRollback {
-		return cs.executeWithNoRollback()
-	}
-	return cs.execute()
+	return nil
 }
 
 // delete removes the change set.
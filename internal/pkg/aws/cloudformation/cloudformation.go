@@ -26,6 +26,9 @@ var eventErrorStates = []string{
 	cloudformation.ResourceStatusImportRollbackFailed,
 }
 
+// driftDetectionPollDelay is how long to wait in between polls for the status of a drift detection.
+var driftDetectionPollDelay = 3 * time.Second
+
 var waiters = []request.WaiterOption{
 	request.WithWaiterDelay(request.ConstantWaiterDelay(5 * time.Second)), // How long to wait in between poll cfn for updates.
 	request.WithWaiterMaxAttempts(1080),                                   // Wait for at most 90 mins for any cfn action.
@@ -119,6 +122,29 @@ func (c *CloudFormation) Update(stack *Stack) (changeSetID string, err error) {
 	return c.update(stack)
 }
 
+// CreateChangeSet creates a change set for an existing stack with the new configuration without executing it.
+// If there are no changes for the stack, deletes the empty change set and returns ErrChangeSetEmpty.
+func (c *CloudFormation) CreateChangeSet(stack *Stack) (changeSetID string, err error) {
+	descr, err := c.Describe(stack.Name)
+	if err != nil {
+		return "", err
+	}
+	status := StackStatus(aws.StringValue(descr.StackStatus))
+	if status.InProgress() {
+		return "", &ErrStackUpdateInProgress{
+			Name: stack.Name,
+		}
+	}
+	cs, err := newUpdateChangeSet(c.client, stack.Name)
+	if err != nil {
+		return "", err
+	}
+	if err := cs.createOrDeleteIfEmpty(stack.stackConfig); err != nil {
+		return "", err
+	}
+	return cs.name, nil
+}
+
 // UpdateAndWait calls Update and then blocks until the stack is updated or until the max attempt window expires.
 func (c *CloudFormation) UpdateAndWait(stack *Stack) error {
 	if _, err := c.Update(stack); err != nil {
@@ -294,6 +320,74 @@ func (c *CloudFormation) StackResources(name string) ([]*StackResource, error) {
 	return resources, nil
 }
 
+// DetectStackDrift detects drift on a stack and waits until the detection completes.
+// It returns the resources that were modified or deleted outside of CloudFormation.
+// If the stack does not exist, returns ErrStackNotFound.
+func (c *CloudFormation) DetectStackDrift(ctx context.Context, name string) ([]*StackResourceDrift, error) {
+	out, err := c.client.DetectStackDrift(&cloudformation.DetectStackDriftInput{
+		StackName: aws.String(name),
+	})
+	if err != nil {
+		if stackDoesNotExist(err) {
+			return nil, &ErrStackNotFound{name: name}
+		}
+		return nil, fmt.Errorf("detect drift for stack %s: %w", name, err)
+	}
+	driftStatus, err := c.waitForDriftDetection(ctx, name, aws.StringValue(out.StackDriftDetectionId))
+	if err != nil {
+		return nil, err
+	}
+	if driftStatus != cloudformation.StackDriftStatusDrifted {
+		return nil, nil
+	}
+	var drifts []*StackResourceDrift
+	var nextToken *string
+	for {
+		out, err := c.client.DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+			StackName: aws.String(name),
+			StackResourceDriftStatusFilters: aws.StringSlice([]string{
+				cloudformation.StackResourceDriftStatusModified,
+				cloudformation.StackResourceDriftStatusDeleted,
+			}),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe resource drifts for stack %s: %w", name, err)
+		}
+		for _, drift := range out.StackResourceDrifts {
+			d := StackResourceDrift(*drift)
+			drifts = append(drifts, &d)
+		}
+		nextToken = out.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return drifts, nil
+}
+
+func (c *CloudFormation) waitForDriftDetection(ctx context.Context, name, detectionID string) (driftStatus string, err error) {
+	for {
+		out, err := c.client.DescribeStackDriftDetectionStatus(&cloudformation.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: aws.String(detectionID),
+		})
+		if err != nil {
+			return "", fmt.Errorf("describe drift detection status %s for stack %s: %w", detectionID, name, err)
+		}
+		switch aws.StringValue(out.DetectionStatus) {
+		case cloudformation.StackDriftDetectionStatusDetectionComplete:
+			return aws.StringValue(out.StackDriftStatus), nil
+		case cloudformation.StackDriftDetectionStatusDetectionFailed:
+			return "", fmt.Errorf("drift detection %s for stack %s failed: %s", detectionID, name, aws.StringValue(out.DetectionStatusReason))
+		}
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("wait for drift detection %s for stack %s: %w", detectionID, name, ctx.Err())
+		case <-time.After(driftDetectionPollDelay):
+		}
+	}
+}
+
 func (c *CloudFormation) events(stackName string, match eventMatcher) ([]StackEvent, error) {
 	var nextToken *string
 	var events []StackEvent
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -613,6 +614,103 @@ func TestCloudFormation_Update(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_CreateChangeSet(t *testing.T) {
+	const (
+		mockStackName     = "id"
+		mockChangeSetName = "copilot-31323334-3536-4738-b930-313233333435"
+	)
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) client
+		wantedErr  error
+	}{
+		"fail if the stack is already in progress": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{{StackStatus: aws.String(cloudformation.StackStatusUpdateInProgress)}},
+				}, nil)
+				return m
+			},
+			wantedErr: &ErrStackUpdateInProgress{
+				Name: mockStack.Name,
+			},
+		},
+		"delete change set and throw ErrChangeSetEmpty if the change set is empty": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{{StackStatus: aws.String(cloudformation.StackStatusUpdateComplete)}},
+				}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(nil, errors.New("some error"))
+				m.EXPECT().DescribeChangeSet(gomock.Any()).
+					Return(&cloudformation.DescribeChangeSetOutput{
+						Changes:      []*cloudformation.Change{},
+						StatusReason: aws.String("The submitted information didn't contain changes. Submit different information to create a change set."),
+					}, nil)
+				m.EXPECT().DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetName),
+					StackName:     aws.String(mockStackName),
+				}).Return(nil, nil)
+				return m
+			},
+			wantedErr: fmt.Errorf("change set with name copilot-31323334-3536-4738-b930-313233333435 for stack id has no changes"),
+		},
+		"creates the change set without executing it": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{{StackStatus: aws.String(cloudformation.StackStatusUpdateComplete)}},
+				}, nil)
+				m.EXPECT().CreateChangeSet(&cloudformation.CreateChangeSetInput{
+					ChangeSetName:       aws.String(mockChangeSetName),
+					StackName:           aws.String(mockStackName),
+					ChangeSetType:       aws.String("UPDATE"),
+					IncludeNestedStacks: aws.Bool(true),
+					Capabilities: aws.StringSlice([]string{
+						cloudformation.CapabilityCapabilityIam,
+						cloudformation.CapabilityCapabilityNamedIam,
+						cloudformation.CapabilityCapabilityAutoExpand,
+					}),
+					TemplateBody: aws.String("template"),
+				}).Return(&cloudformation.CreateChangeSetOutput{
+					Id: aws.String(mockChangeSetName),
+				}, nil)
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), &cloudformation.DescribeChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetName),
+				}, gomock.Any()).Return(nil)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Times(0)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			seed := bytes.NewBufferString("12345678901233456789") // always generate the same UUID
+			uuid.SetRand(seed)
+			defer uuid.SetRand(nil)
+
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			id, err := c.CreateChangeSet(mockStack)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, mockChangeSetName, id)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_UpdateAndWait(t *testing.T) {
 	testCases := map[string]struct {
 		createMock func(ctrl *gomock.Controller) client
@@ -1299,6 +1397,156 @@ func TestStackDescriber_StackResources(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_DetectStackDrift(t *testing.T) {
+	const mockDetectionID = "mockDetectionID"
+	mockDriftStatusInput := &cloudformation.DescribeStackDriftDetectionStatusInput{
+		StackDriftDetectionId: aws.String(mockDetectionID),
+	}
+	mockDriftsInput := func(nextToken *string) *cloudformation.DescribeStackResourceDriftsInput {
+		return &cloudformation.DescribeStackResourceDriftsInput{
+			StackName:                       aws.String("phonetool-test"),
+			StackResourceDriftStatusFilters: aws.StringSlice([]string{"MODIFIED", "DELETED"}),
+			NextToken:                       nextToken,
+		}
+	}
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) client
+
+		wantedDrifts []*StackResourceDrift
+		wantedError  error
+	}{
+		"return ErrStackNotFound if the stack does not exist": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(nil, errDoesNotExist)
+				return m
+			},
+			wantedError: &ErrStackNotFound{name: "phonetool-test"},
+		},
+		"return a wrapped error if fail to detect drift": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New("detect drift for stack phonetool-test: some error"),
+		},
+		"return an error if the drift detection fails": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String(mockDetectionID),
+				}, nil)
+				m.EXPECT().DescribeStackDriftDetectionStatus(mockDriftStatusInput).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+					DetectionStatus:       aws.String(cloudformation.StackDriftDetectionStatusDetectionFailed),
+					DetectionStatusReason: aws.String("some reason"),
+				}, nil)
+				return m
+			},
+			wantedError: errors.New("drift detection mockDetectionID for stack phonetool-test failed: some reason"),
+		},
+		"return nil if the stack is in sync": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String(mockDetectionID),
+				}, nil)
+				m.EXPECT().DescribeStackDriftDetectionStatus(mockDriftStatusInput).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+					DetectionStatus:  aws.String(cloudformation.StackDriftDetectionStatusDetectionComplete),
+					StackDriftStatus: aws.String(cloudformation.StackDriftStatusInSync),
+				}, nil)
+				return m
+			},
+		},
+		"return a wrapped error if fail to describe resource drifts": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String(mockDetectionID),
+				}, nil)
+				m.EXPECT().DescribeStackDriftDetectionStatus(mockDriftStatusInput).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+					DetectionStatus:  aws.String(cloudformation.StackDriftDetectionStatusDetectionComplete),
+					StackDriftStatus: aws.String(cloudformation.StackDriftStatusDrifted),
+				}, nil)
+				m.EXPECT().DescribeStackResourceDrifts(mockDriftsInput(nil)).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New("describe resource drifts for stack phonetool-test: some error"),
+		},
+		"wait for the detection to complete and return paginated resource drifts": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DetectStackDrift(&cloudformation.DetectStackDriftInput{
+					StackName: aws.String("phonetool-test"),
+				}).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String(mockDetectionID),
+				}, nil)
+				gomock.InOrder(
+					m.EXPECT().DescribeStackDriftDetectionStatus(mockDriftStatusInput).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+						DetectionStatus: aws.String(cloudformation.StackDriftDetectionStatusDetectionInProgress),
+					}, nil),
+					m.EXPECT().DescribeStackDriftDetectionStatus(mockDriftStatusInput).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+						DetectionStatus:  aws.String(cloudformation.StackDriftDetectionStatusDetectionComplete),
+						StackDriftStatus: aws.String(cloudformation.StackDriftStatusDrifted),
+					}, nil),
+				)
+				m.EXPECT().DescribeStackResourceDrifts(mockDriftsInput(nil)).Return(&cloudformation.DescribeStackResourceDriftsOutput{
+					StackResourceDrifts: []*cloudformation.StackResourceDrift{
+						{
+							LogicalResourceId:        aws.String("PublicLoadBalancer"),
+							StackResourceDriftStatus: aws.String(cloudformation.StackResourceDriftStatusModified),
+						},
+					},
+					NextToken: aws.String("token"),
+				}, nil)
+				m.EXPECT().DescribeStackResourceDrifts(mockDriftsInput(aws.String("token"))).Return(&cloudformation.DescribeStackResourceDriftsOutput{
+					StackResourceDrifts: []*cloudformation.StackResourceDrift{
+						{
+							LogicalResourceId:        aws.String("Cluster"),
+							StackResourceDriftStatus: aws.String(cloudformation.StackResourceDriftStatusDeleted),
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantedDrifts: []*StackResourceDrift{
+				{
+					LogicalResourceId:        aws.String("PublicLoadBalancer"),
+					StackResourceDriftStatus: aws.String(cloudformation.StackResourceDriftStatusModified),
+				},
+				{
+					LogicalResourceId:        aws.String("Cluster"),
+					StackResourceDriftStatus: aws.String(cloudformation.StackResourceDriftStatusDeleted),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+			defer func(delay time.Duration) { driftDetectionPollDelay = delay }(driftDetectionPollDelay)
+			driftDetectionPollDelay = 0
+
+			// WHEN
+			actual, err := c.DetectStackDrift(context.Background(), "phonetool-test")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDrifts, actual)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_ListStacksWithTags(t *testing.T) {
 	mockAppTag := cloudformation.Tag{
 		Key:   aws.String("copilot-application"),
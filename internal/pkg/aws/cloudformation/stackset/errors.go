@@ -21,6 +21,15 @@ func (e *ErrStackSetOutOfDate) Error() string {
 	return fmt.Sprintf("stack set %s update was out of date (feel free to try again): %v", e.stackSetName, e.parentErr)
 }
 
+// ErrStackSetNotFound occurs when the stack set does not exist.
+type ErrStackSetNotFound struct {
+	name string
+}
+
+func (e *ErrStackSetNotFound) Error() string {
+	return fmt.Sprintf("stack set %s not found", e.name)
+}
+
 // isAlreadyExistingStackSet returns true if the underlying error is a stack already exists error.
 func isAlreadyExistingStackSet(err error) bool {
 	if aerr, ok := err.(awserr.Error); ok {
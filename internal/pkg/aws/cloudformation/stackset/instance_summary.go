@@ -3,9 +3,11 @@
 
 package stackset
 
-// InstanceSummary represents the identifiers for a stack instance.
+// InstanceSummary represents the identifiers and status of a stack instance.
 type InstanceSummary struct {
-	StackID string
-	Account string
-	Region  string
+	StackID      string
+	Account      string
+	Region       string
+	Status       string // One of "CURRENT", "OUTDATED", or "INOPERABLE".
+	StatusReason string
 }
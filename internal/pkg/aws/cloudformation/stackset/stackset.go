@@ -23,6 +23,7 @@ type api interface {
 	DescribeStackSetOperation(*cloudformation.DescribeStackSetOperationInput) (*cloudformation.DescribeStackSetOperationOutput, error)
 
 	CreateStackInstances(*cloudformation.CreateStackInstancesInput) (*cloudformation.CreateStackInstancesOutput, error)
+	ImportStacksToStackSet(*cloudformation.ImportStacksToStackSetInput) (*cloudformation.ImportStacksToStackSetOutput, error)
 	DeleteStackInstances(*cloudformation.DeleteStackInstancesInput) (*cloudformation.DeleteStackInstancesOutput, error)
 	ListStackInstances(*cloudformation.ListStackInstancesInput) (*cloudformation.ListStackInstancesOutput, error)
 }
@@ -72,6 +73,11 @@ func (ss *StackSet) Describe(name string) (Description, error) {
 		StackSetName: aws.String(name),
 	})
 	if err != nil {
+		if isNotFoundStackSet(err) {
+			return Description{}, &ErrStackSetNotFound{
+				name: name,
+			}
+		}
 		return Description{}, fmt.Errorf("describe stack set %s: %w", name, err)
 	}
 	return Description{
@@ -172,6 +178,33 @@ func (ss *StackSet) CreateInstancesAndWait(name string, accounts, regions []stri
 	return ss.waitForOperation(name, id)
 }
 
+// ImportStacksAndWait adds existing stacks to a stack set as stack instances, and waits until the operation completes.
+func (ss *StackSet) ImportStacksAndWait(name string, stackIDs []string) error {
+	resp, err := ss.client.ImportStacksToStackSet(&cloudformation.ImportStacksToStackSetInput{
+		StackSetName: aws.String(name),
+		StackIds:     aws.StringSlice(stackIDs),
+	})
+	if err != nil {
+		return fmt.Errorf("import stacks %v into stack set %s: %w", stackIDs, name, err)
+	}
+	return ss.waitForOperation(name, aws.StringValue(resp.OperationId))
+}
+
+// DeleteInstancesAndWait removes the stack instances in the regions of the specified AWS accounts from a stack set,
+// and waits until the operation completes. If retainStacks is true, the stacks of the instances are kept.
+func (ss *StackSet) DeleteInstancesAndWait(name string, accounts, regions []string, retainStacks bool) error {
+	resp, err := ss.client.DeleteStackInstances(&cloudformation.DeleteStackInstancesInput{
+		StackSetName: aws.String(name),
+		Accounts:     aws.StringSlice(accounts),
+		Regions:      aws.StringSlice(regions),
+		RetainStacks: aws.Bool(retainStacks),
+	})
+	if err != nil {
+		return fmt.Errorf("delete stack instances in regions %v for accounts %v for stack set %s: %w", regions, accounts, name, err)
+	}
+	return ss.waitForOperation(name, aws.StringValue(resp.OperationId))
+}
+
 // InstanceSummariesOption allows to filter instance summaries to retrieve for the stack set.
 type InstanceSummariesOption func(input *cloudformation.ListStackInstancesInput)
 
@@ -190,9 +223,11 @@ func (ss *StackSet) InstanceSummaries(name string, opts ...InstanceSummariesOpti
 	var summaries []InstanceSummary
 	for _, summary := range resp.Summaries {
 		summaries = append(summaries, InstanceSummary{
-			StackID: aws.StringValue(summary.StackId),
-			Account: aws.StringValue(summary.Account),
-			Region:  aws.StringValue(summary.Region),
+			StackID:      aws.StringValue(summary.StackId),
+			Account:      aws.StringValue(summary.Account),
+			Region:       aws.StringValue(summary.Region),
+			Status:       aws.StringValue(summary.Status),
+			StatusReason: aws.StringValue(summary.StatusReason),
 		})
 	}
 	return summaries, nil
@@ -353,6 +388,56 @@ func WithTags(tags map[string]string) CreateOrUpdateOption {
 	}
 }
 
+// WithTemplateURL sets the location of the template in Amazon S3, instead of passing the template body.
+func WithTemplateURL(url string) CreateOrUpdateOption {
+	return func(input interface{}) {
+		switch v := input.(type) {
+		case *cloudformation.CreateStackSetInput:
+			{
+				v.TemplateBody = nil
+				v.TemplateURL = aws.String(url)
+			}
+		case *cloudformation.UpdateStackSetInput:
+			{
+				v.TemplateBody = nil
+				v.TemplateURL = aws.String(url)
+			}
+		}
+	}
+}
+
+// WithParameters sets the values of the template parameters for all the stack instances in a stack set.
+func WithParameters(params []*cloudformation.Parameter) CreateOrUpdateOption {
+	return func(input interface{}) {
+		switch v := input.(type) {
+		case *cloudformation.CreateStackSetInput:
+			{
+				v.Parameters = params
+			}
+		case *cloudformation.UpdateStackSetInput:
+			{
+				v.Parameters = params
+			}
+		}
+	}
+}
+
+// WithCapabilities acknowledges the capabilities that the template of a stack set requires.
+func WithCapabilities(capabilities ...string) CreateOrUpdateOption {
+	return func(input interface{}) {
+		switch v := input.(type) {
+		case *cloudformation.CreateStackSetInput:
+			{
+				v.Capabilities = aws.StringSlice(capabilities)
+			}
+		case *cloudformation.UpdateStackSetInput:
+			{
+				v.Capabilities = aws.StringSlice(capabilities)
+			}
+		}
+	}
+}
+
 // WithOperationID sets the operation ID of a stack set operation.
 // This functional option can only be used while updating a stack set, otherwise it's a no-op.
 func WithOperationID(operationID string) CreateOrUpdateOption {
@@ -366,6 +451,20 @@ func WithOperationID(operationID string) CreateOrUpdateOption {
 	}
 }
 
+// WithInstances limits a stack set update to the stack instances in the regions of the specified AWS accounts.
+// This functional option can only be used while updating a stack set, otherwise it's a no-op.
+func WithInstances(accounts, regions []string) CreateOrUpdateOption {
+	return func(input interface{}) {
+		switch v := input.(type) {
+		case *cloudformation.UpdateStackSetInput:
+			{
+				v.Accounts = aws.StringSlice(accounts)
+				v.Regions = aws.StringSlice(regions)
+			}
+		}
+	}
+}
+
 // FilterSummariesByAccountID limits the accountID for the stack instance summaries to retrieve.
 func FilterSummariesByAccountID(accountID string) InstanceSummariesOption {
 	return func(input *cloudformation.ListStackInstancesInput) {
@@ -117,6 +117,16 @@ func TestStackSet_Describe(t *testing.T) {
 				Template: "body",
 			},
 		},
+		"returns ErrStackSetNotFound if the stack set does not exist": {
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStackSet(gomock.Any()).Return(nil, awserr.New(cloudformation.ErrCodeStackSetNotFoundException, "", nil))
+				return m
+			},
+			wantedError: &ErrStackSetNotFound{
+				name: testName,
+			},
+		},
 		"wraps error on unexpected failure": {
 			mockClient: func(ctrl *gomock.Controller) api {
 				m := mocks.NewMockapi(ctrl)
@@ -552,6 +562,130 @@ func TestStackSet_CreateInstances(t *testing.T) {
 	}
 }
 
+func TestStackSet_ImportStacksAndWait(t *testing.T) {
+	testStackIDs := []string{"arn:aws:cloudformation:us-west-2:1234:stack/phonetool-test/1"}
+	testCases := map[string]struct {
+		mockClient  func(ctrl *gomock.Controller) api
+		wantedError error
+	}{
+		"successfully imports the stacks": {
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ImportStacksToStackSet(&cloudformation.ImportStacksToStackSetInput{
+					StackSetName: aws.String(testName),
+					StackIds:     aws.StringSlice(testStackIDs),
+				}).Return(&cloudformation.ImportStacksToStackSetOutput{
+					OperationId: aws.String("1"),
+				}, nil)
+				m.EXPECT().DescribeStackSetOperation(&cloudformation.DescribeStackSetOperationInput{
+					StackSetName: aws.String(testName),
+					OperationId:  aws.String("1"),
+				}).Return(&cloudformation.DescribeStackSetOperationOutput{
+					StackSetOperation: &cloudformation.StackSetOperation{
+						Status: aws.String(opStatusSucceeded),
+					},
+				}, nil)
+				return m
+			},
+		},
+		"wraps error on unexpected failure": {
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ImportStacksToStackSet(gomock.Any()).Return(nil, testError)
+				return m
+			},
+			wantedError: fmt.Errorf("import stacks %v into stack set %s: %w", testStackIDs, testName, testError),
+		},
+		"returns an error if the import fails": {
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ImportStacksToStackSet(gomock.Any()).Return(&cloudformation.ImportStacksToStackSetOutput{
+					OperationId: aws.String("1"),
+				}, nil)
+				m.EXPECT().DescribeStackSetOperation(gomock.Any()).Return(&cloudformation.DescribeStackSetOperationOutput{
+					StackSetOperation: &cloudformation.StackSetOperation{
+						Status: aws.String(opStatusFailed),
+					},
+				}, nil)
+				return m
+			},
+			wantedError: fmt.Errorf("operation 1 for stack set %s failed", testName),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := StackSet{
+				client: tc.mockClient(ctrl),
+			}
+
+			// WHEN
+			err := client.ImportStacksAndWait(testName, testStackIDs)
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+		})
+	}
+}
+
+func TestStackSet_DeleteInstancesAndWait(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient  func(ctrl *gomock.Controller) api
+		wantedError error
+	}{
+		"successfully deletes the stack instances and retains their stacks": {
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DeleteStackInstances(&cloudformation.DeleteStackInstancesInput{
+					StackSetName: aws.String(testName),
+					Accounts:     aws.StringSlice([]string{"1234"}),
+					Regions:      aws.StringSlice([]string{"us-west-2"}),
+					RetainStacks: aws.Bool(true),
+				}).Return(&cloudformation.DeleteStackInstancesOutput{
+					OperationId: aws.String("1"),
+				}, nil)
+				m.EXPECT().DescribeStackSetOperation(&cloudformation.DescribeStackSetOperationInput{
+					StackSetName: aws.String(testName),
+					OperationId:  aws.String("1"),
+				}).Return(&cloudformation.DescribeStackSetOperationOutput{
+					StackSetOperation: &cloudformation.StackSetOperation{
+						Status: aws.String(opStatusSucceeded),
+					},
+				}, nil)
+				return m
+			},
+		},
+		"wraps error on unexpected failure": {
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DeleteStackInstances(gomock.Any()).Return(nil, testError)
+				return m
+			},
+			wantedError: fmt.Errorf("delete stack instances in regions [us-west-2] for accounts [1234] for stack set %s: %w", testName, testError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := StackSet{
+				client: tc.mockClient(ctrl),
+			}
+
+			// WHEN
+			err := client.DeleteInstancesAndWait(testName, []string{"1234"}, []string{"us-west-2"}, true)
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+		})
+	}
+}
+
 func TestStackSet_InstanceSummaries(t *testing.T) {
 	const (
 		testAccountID = "1234"
@@ -572,9 +706,11 @@ func TestStackSet_InstanceSummaries(t *testing.T) {
 				}).Return(&cloudformation.ListStackInstancesOutput{
 					Summaries: []*cloudformation.StackInstanceSummary{
 						{
-							StackId: aws.String(testName),
-							Account: aws.String(testAccountID),
-							Region:  aws.String(testRegion),
+							StackId:      aws.String(testName),
+							Account:      aws.String(testAccountID),
+							Region:       aws.String(testRegion),
+							Status:       aws.String(cloudformation.StackInstanceStatusOutdated),
+							StatusReason: aws.String("some reason"),
 						},
 					},
 				}, nil)
@@ -582,9 +718,11 @@ func TestStackSet_InstanceSummaries(t *testing.T) {
 			},
 			wantedSummaries: []InstanceSummary{
 				{
-					StackID: testName,
-					Account: testAccountID,
-					Region:  testRegion,
+					StackID:      testName,
+					Account:      testAccountID,
+					Region:       testRegion,
+					Status:       cloudformation.StackInstanceStatusOutdated,
+					StatusReason: "some reason",
 				},
 			},
 		},
@@ -619,3 +757,42 @@ func TestStackSet_InstanceSummaries(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateOrUpdateOptions(t *testing.T) {
+	testParams := []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String("AppName"),
+			ParameterValue: aws.String("phonetool"),
+		},
+	}
+	opts := []CreateOrUpdateOption{
+		WithTemplateURL("https://bucket.s3.amazonaws.com/template.yml"),
+		WithParameters(testParams),
+		WithCapabilities(cloudformation.CapabilityCapabilityIam, cloudformation.CapabilityCapabilityNamedIam),
+		WithInstances([]string{"1234"}, []string{"us-west-2"}),
+	}
+
+	createIn := &cloudformation.CreateStackSetInput{
+		TemplateBody: aws.String("body"),
+	}
+	updateIn := &cloudformation.UpdateStackSetInput{
+		TemplateBody: aws.String("body"),
+	}
+	for _, opt := range opts {
+		opt(createIn)
+		opt(updateIn)
+	}
+
+	require.Equal(t, &cloudformation.CreateStackSetInput{
+		TemplateURL:  aws.String("https://bucket.s3.amazonaws.com/template.yml"),
+		Parameters:   testParams,
+		Capabilities: aws.StringSlice([]string{cloudformation.CapabilityCapabilityIam, cloudformation.CapabilityCapabilityNamedIam}),
+	}, createIn)
+	require.Equal(t, &cloudformation.UpdateStackSetInput{
+		TemplateURL:  aws.String("https://bucket.s3.amazonaws.com/template.yml"),
+		Parameters:   testParams,
+		Capabilities: aws.StringSlice([]string{cloudformation.CapabilityCapabilityIam, cloudformation.CapabilityCapabilityNamedIam}),
+		Accounts:     aws.StringSlice([]string{"1234"}),
+		Regions:      aws.StringSlice([]string{"us-west-2"}),
+	}, updateIn)
+}
@@ -0,0 +1,129 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package codebuild provides a client to make API requests to AWS CodeBuild.
+package codebuild
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+)
+
+const defaultPollInterval = 5 * time.Second
+
+type api interface {
+	StartBuild(input *codebuild.StartBuildInput) (*codebuild.StartBuildOutput, error)
+	BatchGetBuilds(input *codebuild.BatchGetBuildsInput) (*codebuild.BatchGetBuildsOutput, error)
+}
+
+// CodeBuild wraps an AWS CodeBuild client.
+type CodeBuild struct {
+	client       api
+	pollInterval time.Duration
+}
+
+// New returns a CodeBuild client configured against the input session.
+func New(s *session.Session) *CodeBuild {
+	return &CodeBuild{
+		client:       codebuild.New(s),
+		pollInterval: defaultPollInterval,
+	}
+}
+
+// StartBuildInput holds the fields to start a build of a project.
+type StartBuildInput struct {
+	Project         string
+	SourceLocation  string            // Location of the zipped source in S3, in the "bucket/key" format.
+	EnvVars         map[string]string // Optional. Plaintext environment variables for the build.
+	EnvironmentType string            // Optional. Overrides the environment type of the project, such as "ARM_CONTAINER".
+	Image           string            // Optional. Overrides the image of the project's build environment.
+}
+
+// Build holds the result of a build.
+type Build struct {
+	ID          string
+	Status      string
+	LogsURL     string            // Deep link to the CloudWatch logs of the build.
+	ExportedEnv map[string]string // Environment variables exported by the build.
+}
+
+// StartBuild starts a build of the project with the source uploaded to S3, and returns the ID of the build.
+func (c *CodeBuild) StartBuild(in *StartBuildInput) (string, error) {
+	input := &codebuild.StartBuildInput{
+		ProjectName:            aws.String(in.Project),
+		SourceTypeOverride:     aws.String(codebuild.SourceTypeS3),
+		SourceLocationOverride: aws.String(in.SourceLocation),
+	}
+	if in.EnvironmentType != "" {
+		input.EnvironmentTypeOverride = aws.String(in.EnvironmentType)
+	}
+	if in.Image != "" {
+		input.ImageOverride = aws.String(in.Image)
+	}
+	// Sort the names of the environment variables for test stability.
+	var names []string
+	for name := range in.EnvVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		input.EnvironmentVariablesOverride = append(input.EnvironmentVariablesOverride, &codebuild.EnvironmentVariable{
+			Name:  aws.String(name),
+			Type:  aws.String(codebuild.EnvironmentVariableTypePlaintext),
+			Value: aws.String(in.EnvVars[name]),
+		})
+	}
+	out, err := c.client.StartBuild(input)
+	if err != nil {
+		return "", fmt.Errorf("start build of project %s: %w", in.Project, err)
+	}
+	return aws.StringValue(out.Build.Id), nil
+}
+
+// WaitForBuild blocks until the build completes or the context is canceled, and returns the completed build.
+// The status of the returned build is one of "SUCCEEDED", "FAILED", "FAULT", "TIMED_OUT" or "STOPPED".
+func (c *CodeBuild) WaitForBuild(ctx context.Context, id string) (*Build, error) {
+	var interval time.Duration // Defaults to 0.
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("wait for build %s: %w", id, ctx.Err())
+		case <-time.After(interval):
+			out, err := c.client.BatchGetBuilds(&codebuild.BatchGetBuildsInput{
+				Ids: aws.StringSlice([]string{id}),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("get build %s: %w", id, err)
+			}
+			if len(out.Builds) == 0 {
+				return nil, fmt.Errorf("build %s not found", id)
+			}
+			build := out.Builds[0]
+			if aws.StringValue(build.BuildStatus) != codebuild.StatusTypeInProgress {
+				return toBuild(build), nil
+			}
+			interval = c.pollInterval
+		}
+	}
+}
+
+func toBuild(in *codebuild.Build) *Build {
+	build := &Build{
+		ID:          aws.StringValue(in.Id),
+		Status:      aws.StringValue(in.BuildStatus),
+		ExportedEnv: make(map[string]string),
+	}
+	if in.Logs != nil {
+		build.LogsURL = aws.StringValue(in.Logs.DeepLink)
+	}
+	for _, v := range in.ExportedEnvironmentVariables {
+		build.ExportedEnv[aws.StringValue(v.Name)] = aws.StringValue(v.Value)
+	}
+	return build
+}
@@ -0,0 +1,159 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codebuild
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeBuild_StartBuild(t *testing.T) {
+	testCases := map[string]struct {
+		in         *StartBuildInput
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedID    string
+		wantedError error
+	}{
+		"error if fail to start build": {
+			in: &StartBuildInput{
+				Project:        "phonetool-remote-build",
+				SourceLocation: "bucket/source.zip",
+			},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartBuild(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("start build of project phonetool-remote-build: some error"),
+		},
+		"start build with overrides": {
+			in: &StartBuildInput{
+				Project:         "phonetool-remote-build",
+				SourceLocation:  "bucket/source.zip",
+				EnvVars:         map[string]string{"B": "2", "A": "1"},
+				EnvironmentType: "ARM_CONTAINER",
+				Image:           "aws/codebuild/amazonlinux2-aarch64-standard:2.0",
+			},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartBuild(&codebuild.StartBuildInput{
+					ProjectName:             aws.String("phonetool-remote-build"),
+					SourceTypeOverride:      aws.String(codebuild.SourceTypeS3),
+					SourceLocationOverride:  aws.String("bucket/source.zip"),
+					EnvironmentTypeOverride: aws.String("ARM_CONTAINER"),
+					ImageOverride:           aws.String("aws/codebuild/amazonlinux2-aarch64-standard:2.0"),
+					EnvironmentVariablesOverride: []*codebuild.EnvironmentVariable{
+						{Name: aws.String("A"), Type: aws.String(codebuild.EnvironmentVariableTypePlaintext), Value: aws.String("1")},
+						{Name: aws.String("B"), Type: aws.String(codebuild.EnvironmentVariableTypePlaintext), Value: aws.String("2")},
+					},
+				}).Return(&codebuild.StartBuildOutput{
+					Build: &codebuild.Build{Id: aws.String("phonetool-remote-build:1234")},
+				}, nil)
+			},
+			wantedID: "phonetool-remote-build:1234",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			cb := CodeBuild{client: m}
+
+			// WHEN
+			id, err := cb.StartBuild(tc.in)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedID, id)
+		})
+	}
+}
+
+func TestCodeBuild_WaitForBuild(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedBuild *Build
+		wantedError error
+	}{
+		"error if fail to get build": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get build phonetool-remote-build:1234: some error"),
+		},
+		"error if build is not found": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(gomock.Any()).Return(&codebuild.BatchGetBuildsOutput{}, nil)
+			},
+			wantedError: errors.New("build phonetool-remote-build:1234 not found"),
+		},
+		"poll until the build completes": {
+			setupMocks: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().BatchGetBuilds(&codebuild.BatchGetBuildsInput{
+						Ids: aws.StringSlice([]string{"phonetool-remote-build:1234"}),
+					}).Return(&codebuild.BatchGetBuildsOutput{
+						Builds: []*codebuild.Build{
+							{Id: aws.String("phonetool-remote-build:1234"), BuildStatus: aws.String(codebuild.StatusTypeInProgress)},
+						},
+					}, nil),
+					m.EXPECT().BatchGetBuilds(gomock.Any()).Return(&codebuild.BatchGetBuildsOutput{
+						Builds: []*codebuild.Build{
+							{
+								Id:          aws.String("phonetool-remote-build:1234"),
+								BuildStatus: aws.String(codebuild.StatusTypeSucceeded),
+								Logs:        &codebuild.LogsLocation{DeepLink: aws.String("https://logs")},
+								ExportedEnvironmentVariables: []*codebuild.ExportedEnvironmentVariable{
+									{Name: aws.String("IMAGE_DIGEST"), Value: aws.String("sha256:1234")},
+								},
+							},
+						},
+					}, nil),
+				)
+			},
+			wantedBuild: &Build{
+				ID:          "phonetool-remote-build:1234",
+				Status:      codebuild.StatusTypeSucceeded,
+				LogsURL:     "https://logs",
+				ExportedEnv: map[string]string{"IMAGE_DIGEST": "sha256:1234"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			cb := CodeBuild{client: m}
+
+			// WHEN
+			build, err := cb.WaitForBuild(context.Background(), "phonetool-remote-build:1234")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedBuild, build)
+		})
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package codedeploy provides a client to make API requests to AWS CodeDeploy.
+package codedeploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+)
+
+const (
+	// Deployments shift traffic for at most ~10 minutes with the predefined ECS configurations,
+	// and the original tasks are kept for 5 minutes afterwards.
+	defaultPollInterval = 15 * time.Second
+	defaultMaxTries     = 160
+)
+
+type api interface {
+	CreateDeployment(input *codedeploy.CreateDeploymentInput) (*codedeploy.CreateDeploymentOutput, error)
+	GetDeployment(input *codedeploy.GetDeploymentInput) (*codedeploy.GetDeploymentOutput, error)
+}
+
+// CodeDeploy wraps an AWS CodeDeploy client.
+type CodeDeploy struct {
+	client api
+
+	pollInterval time.Duration
+	maxTries     int
+}
+
+// New returns a CodeDeploy configured against the input session.
+func New(s *session.Session) *CodeDeploy {
+	return &CodeDeploy{
+		client:       codedeploy.New(s),
+		pollInterval: defaultPollInterval,
+		maxTries:     defaultMaxTries,
+	}
+}
+
+// ECSDeploymentInput holds the fields required to deploy a new task definition to an ECS service.
+type ECSDeploymentInput struct {
+	Application     string // Name of the CodeDeploy application.
+	DeploymentGroup string // Name of the deployment group targeting the ECS service.
+	TaskDefinition  string // ARN of the task definition to deploy.
+	ContainerName   string // Name of the container that receives traffic from the load balancer.
+	ContainerPort   int    // Port of the container that receives traffic from the load balancer.
+
+	// Optional. Total time that the deployment waits between traffic shifting steps,
+	// the wait for the deployment to complete is extended by this duration.
+	BakeTime time.Duration
+}
+
+// ErrDeploymentFailed occurs when a deployment does not succeed.
+type ErrDeploymentFailed struct {
+	ID      string
+	Status  string
+	Message string
+}
+
+func (e *ErrDeploymentFailed) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("deployment %s is %s", e.ID, e.Status)
+	}
+	return fmt.Sprintf("deployment %s is %s: %s", e.ID, e.Status, e.Message)
+}
+
+// ErrWaitDeploymentTimeout occurs when a deployment does not complete after the maximum number of polls.
+type ErrWaitDeploymentTimeout struct {
+	ID string
+}
+
+func (e *ErrWaitDeploymentTimeout) Error() string {
+	return fmt.Sprintf("deployment %s did not complete in time", e.ID)
+}
+
+// Timeout allows ErrWaitDeploymentTimeout to implement a timeout error interface.
+func (e *ErrWaitDeploymentTimeout) Timeout() bool {
+	return true
+}
+
+// DeployECSService creates a blue/green deployment of the task definition and waits until the deployment completes.
+// If the deployment fails or is stopped by an alarm, CodeDeploy rolls back the traffic and an ErrDeploymentFailed is returned.
+func (c *CodeDeploy) DeployECSService(in *ECSDeploymentInput) error {
+	appSpec, err := ecsAppSpec(in)
+	if err != nil {
+		return err
+	}
+	out, err := c.client.CreateDeployment(&codedeploy.CreateDeploymentInput{
+		ApplicationName:     aws.String(in.Application),
+		DeploymentGroupName: aws.String(in.DeploymentGroup),
+		Revision: &codedeploy.RevisionLocation{
+			RevisionType: aws.String(codedeploy.RevisionLocationTypeAppSpecContent),
+			AppSpecContent: &codedeploy.AppSpecContent{
+				Content: aws.String(appSpec),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("create deployment for deployment group %s: %w", in.DeploymentGroup, err)
+	}
+	return c.waitUntilDeploymentComplete(aws.StringValue(out.DeploymentId), in.BakeTime)
+}
+
+func (c *CodeDeploy) waitUntilDeploymentComplete(id string, bakeTime time.Duration) error {
+	maxTries := c.maxTries
+	if c.pollInterval > 0 {
+		maxTries += int(bakeTime / c.pollInterval)
+	}
+	for try := 0; try < maxTries; try++ {
+		out, err := c.client.GetDeployment(&codedeploy.GetDeploymentInput{
+			DeploymentId: aws.String(id),
+		})
+		if err != nil {
+			return fmt.Errorf("get deployment %s: %w", id, err)
+		}
+		info := out.DeploymentInfo
+		switch status := aws.StringValue(info.Status); status {
+		case codedeploy.DeploymentStatusSucceeded:
+			return nil
+		case codedeploy.DeploymentStatusFailed, codedeploy.DeploymentStatusStopped:
+			var msg string
+			if info.ErrorInformation != nil {
+				msg = aws.StringValue(info.ErrorInformation.Message)
+			}
+			return &ErrDeploymentFailed{
+				ID:      id,
+				Status:  status,
+				Message: msg,
+			}
+		}
+		time.Sleep(c.pollInterval)
+	}
+	return &ErrWaitDeploymentTimeout{
+		ID: id,
+	}
+}
+
+// ecsAppSpec returns the AppSpec file that replaces the task definition of the ECS service targeted by the deployment group.
+// See https://docs.aws.amazon.com/codedeploy/latest/userguide/reference-appspec-file-structure-resources.html#reference-appspec-file-structure-resources-ecs
+func ecsAppSpec(in *ECSDeploymentInput) (string, error) {
+	type loadBalancerInfo struct {
+		ContainerName string
+		ContainerPort int
+	}
+	type properties struct {
+		TaskDefinition   string
+		LoadBalancerInfo loadBalancerInfo
+	}
+	type targetService struct {
+		Type       string
+		Properties properties
+	}
+	spec := struct {
+		Version   string `json:"version"`
+		Resources []map[string]targetService
+	}{
+		Version: "0.0",
+		Resources: []map[string]targetService{
+			{
+				"TargetService": {
+					Type: "AWS::ECS::Service",
+					Properties: properties{
+						TaskDefinition: in.TaskDefinition,
+						LoadBalancerInfo: loadBalancerInfo{
+							ContainerName: in.ContainerName,
+							ContainerPort: in.ContainerPort,
+						},
+					},
+				},
+			},
+		},
+	}
+	out, err := json.Marshal(spec)
+	if err != nil {
+		return "", fmt.Errorf("marshal AppSpec: %w", err)
+	}
+	return string(out), nil
+}
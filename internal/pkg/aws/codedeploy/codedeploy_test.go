@@ -0,0 +1,142 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codedeploy
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeDeploy_DeployECSService(t *testing.T) {
+	const wantedAppSpec = `{"version":"0.0","Resources":[{"TargetService":{"Type":"AWS::ECS::Service","Properties":{"TaskDefinition":"arn:aws:ecs:us-west-2:111111111111:task-definition/phonetool-test-frontend:2","LoadBalancerInfo":{"ContainerName":"frontend","ContainerPort":80}}}}]}`
+	mockInput := &ECSDeploymentInput{
+		Application:     "phonetool-test-frontend",
+		DeploymentGroup: "phonetool-test-frontend-dg",
+		TaskDefinition:  "arn:aws:ecs:us-west-2:111111111111:task-definition/phonetool-test-frontend:2",
+		ContainerName:   "frontend",
+		ContainerPort:   80,
+	}
+	deploymentWithStatus := func(status string) *codedeploy.GetDeploymentOutput {
+		return &codedeploy.GetDeploymentOutput{
+			DeploymentInfo: &codedeploy.DeploymentInfo{
+				Status: aws.String(status),
+			},
+		}
+	}
+	testCases := map[string]struct {
+		setUpMocks   func(m *mocks.Mockapi)
+		pollInterval time.Duration
+		bakeTime     time.Duration
+
+		wantedErr error
+	}{
+		"error if fail to create the deployment": {
+			setUpMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateDeployment(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("create deployment for deployment group phonetool-test-frontend-dg: some error"),
+		},
+		"error if fail to get the deployment": {
+			setUpMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateDeployment(gomock.Any()).Return(&codedeploy.CreateDeploymentOutput{
+					DeploymentId: aws.String("d-1"),
+				}, nil)
+				m.EXPECT().GetDeployment(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get deployment d-1: some error"),
+		},
+		"error if the deployment is rolled back": {
+			setUpMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateDeployment(gomock.Any()).Return(&codedeploy.CreateDeploymentOutput{
+					DeploymentId: aws.String("d-1"),
+				}, nil)
+				m.EXPECT().GetDeployment(gomock.Any()).Return(&codedeploy.GetDeploymentOutput{
+					DeploymentInfo: &codedeploy.DeploymentInfo{
+						Status: aws.String(codedeploy.DeploymentStatusStopped),
+						ErrorInformation: &codedeploy.ErrorInformation{
+							Message: aws.String("One or more alarms have been activated"),
+						},
+					},
+				}, nil)
+			},
+			wantedErr: errors.New("deployment d-1 is Stopped: One or more alarms have been activated"),
+		},
+		"error if the deployment does not complete in time": {
+			setUpMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateDeployment(gomock.Any()).Return(&codedeploy.CreateDeploymentOutput{
+					DeploymentId: aws.String("d-1"),
+				}, nil)
+				m.EXPECT().GetDeployment(gomock.Any()).Return(deploymentWithStatus(codedeploy.DeploymentStatusInProgress), nil).Times(3)
+			},
+			wantedErr: errors.New("deployment d-1 did not complete in time"),
+		},
+		"wait longer for deployments that bake between traffic shifting steps": {
+			setUpMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateDeployment(gomock.Any()).Return(&codedeploy.CreateDeploymentOutput{
+					DeploymentId: aws.String("d-1"),
+				}, nil)
+				m.EXPECT().GetDeployment(gomock.Any()).Return(deploymentWithStatus(codedeploy.DeploymentStatusInProgress), nil).Times(5)
+			},
+			pollInterval: time.Millisecond,
+			bakeTime:     2 * time.Millisecond,
+			wantedErr:    errors.New("deployment d-1 did not complete in time"),
+		},
+		"success": {
+			setUpMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateDeployment(&codedeploy.CreateDeploymentInput{
+					ApplicationName:     aws.String("phonetool-test-frontend"),
+					DeploymentGroupName: aws.String("phonetool-test-frontend-dg"),
+					Revision: &codedeploy.RevisionLocation{
+						RevisionType: aws.String("AppSpecContent"),
+						AppSpecContent: &codedeploy.AppSpecContent{
+							Content: aws.String(wantedAppSpec),
+						},
+					},
+				}).Return(&codedeploy.CreateDeploymentOutput{
+					DeploymentId: aws.String("d-1"),
+				}, nil)
+				gomock.InOrder(
+					m.EXPECT().GetDeployment(&codedeploy.GetDeploymentInput{
+						DeploymentId: aws.String("d-1"),
+					}).Return(deploymentWithStatus(codedeploy.DeploymentStatusInProgress), nil),
+					m.EXPECT().GetDeployment(gomock.Any()).Return(deploymentWithStatus(codedeploy.DeploymentStatusSucceeded), nil),
+				)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setUpMocks(m)
+			cd := CodeDeploy{
+				client:       m,
+				pollInterval: tc.pollInterval,
+				maxTries:     3,
+			}
+			in := *mockInput
+			in.BakeTime = tc.bakeTime
+
+			// WHEN
+			err := cd.DeployECSService(&in)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
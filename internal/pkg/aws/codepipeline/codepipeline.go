@@ -7,6 +7,7 @@ package codepipeline
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/xlab/treeprint"
@@ -47,12 +48,34 @@ type Pipeline struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// deployStagePrefix is the prefix of the name of the stages that deploy to an environment.
+const deployStagePrefix = "DeployTo-"
+
 // Stage wraps the codepipeline pipeline stage.
 type Stage struct {
-	Name     string `json:"name"`
-	Category string `json:"category"`
-	Provider string `json:"provider"`
-	Details  string `json:"details"`
+	Name            string   `json:"name"`
+	Category        string   `json:"category"`
+	Provider        string   `json:"provider"`
+	Details         string   `json:"details"`
+	Environment     string   `json:"environment,omitempty"` // Name of the environment that the stage deploys to.
+	InputArtifacts  []string `json:"inputArtifacts,omitempty"`
+	OutputArtifacts []string `json:"outputArtifacts,omitempty"`
+}
+
+// PipelineExecution is a summary of an execution of a pipeline.
+type PipelineExecution struct {
+	ID        string    `json:"id"`
+	Status    string    `json:"status"`
+	Trigger   string    `json:"trigger,omitempty"` // Type of the event that started the execution, such as "Webhook" or "StartPipelineExecution".
+	StartedAt time.Time `json:"startedAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	Commit    string    `json:"commit,omitempty"`        // Revision ID of the source code that the execution ran with.
+	CommitMsg string    `json:"commitMessage,omitempty"` // Summary of the source revision, such as the commit message.
+}
+
+// Duration returns how long the execution ran, or has been running for if it's still in progress.
+func (e PipelineExecution) Duration() time.Duration {
+	return e.UpdatedAt.Sub(e.StartedAt)
 }
 
 // PipelineState represents a Pipeline's status.
@@ -150,9 +173,27 @@ func (c *CodePipeline) GetPipeline(name string) (*Pipeline, error) {
 
 // HumanString returns the stringified Stage struct with human readable format.
 // Example output:
-//   DeployTo-test	Deploy	Cloudformation	stackname: dinder-test-test
+//   DeployTo-test	Deploy	Cloudformation	test	BuildOutput	stackname: dinder-test-test
 func (s *Stage) HumanString() string {
-	return fmt.Sprintf("%s\t%s\t%s\t%s\n", s.Name, s.Category, s.Provider, s.Details)
+	env := s.Environment
+	if env == "" {
+		env = "-"
+	}
+	return fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\n", s.Name, s.Category, s.Provider, env, s.artifactsString(), s.Details)
+}
+
+// artifactsString returns the input and output artifacts of the stage, for example "SCCheckoutArtifact -> BuildOutput".
+func (s *Stage) artifactsString() string {
+	in, out := strings.Join(s.InputArtifacts, ", "), strings.Join(s.OutputArtifacts, ", ")
+	switch {
+	case in == "" && out == "":
+		return "-"
+	case out == "":
+		return in
+	case in == "":
+		return "-> " + out
+	}
+	return in + " -> " + out
 }
 
 // RetryStageExecution tries to re-initiate a failed stage for the given pipeline.
@@ -273,9 +314,64 @@ func (c *CodePipeline) getStage(s *cp.StageDeclaration) (*Stage, error) {
 		Provider: provider,
 		Details:  details,
 	}
+	if strings.HasPrefix(name, deployStagePrefix) {
+		stage.Environment = strings.TrimPrefix(name, deployStagePrefix)
+	}
+	stage.InputArtifacts, stage.OutputArtifacts = stageArtifacts(s.Actions)
 	return stage, nil
 }
 
+// stageArtifacts returns the names of the artifacts consumed and produced by the actions of a stage, without duplicates.
+func stageArtifacts(actions []*cp.ActionDeclaration) (inputs []string, outputs []string) {
+	seenIn, seenOut := make(map[string]bool), make(map[string]bool)
+	for _, action := range actions {
+		for _, artifact := range action.InputArtifacts {
+			name := aws.StringValue(artifact.Name)
+			if !seenIn[name] {
+				seenIn[name] = true
+				inputs = append(inputs, name)
+			}
+		}
+		for _, artifact := range action.OutputArtifacts {
+			name := aws.StringValue(artifact.Name)
+			if !seenOut[name] {
+				seenOut[name] = true
+				outputs = append(outputs, name)
+			}
+		}
+	}
+	return inputs, outputs
+}
+
+// ListExecutions returns the most recent executions of a pipeline, starting with the latest one.
+func (c *CodePipeline) ListExecutions(pipelineName string, maxResults int) ([]PipelineExecution, error) {
+	out, err := c.client.ListPipelineExecutions(&cp.ListPipelineExecutionsInput{
+		MaxResults:   aws.Int64(int64(maxResults)),
+		PipelineName: aws.String(pipelineName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list pipeline executions for %s: %w", pipelineName, err)
+	}
+	executions := make([]PipelineExecution, len(out.PipelineExecutionSummaries))
+	for i, summary := range out.PipelineExecutionSummaries {
+		execution := PipelineExecution{
+			ID:        aws.StringValue(summary.PipelineExecutionId),
+			Status:    aws.StringValue(summary.Status),
+			StartedAt: aws.TimeValue(summary.StartTime),
+			UpdatedAt: aws.TimeValue(summary.LastUpdateTime),
+		}
+		if summary.Trigger != nil {
+			execution.Trigger = aws.StringValue(summary.Trigger.TriggerType)
+		}
+		if len(summary.SourceRevisions) != 0 {
+			execution.Commit = aws.StringValue(summary.SourceRevisions[0].RevisionId)
+			execution.CommitMsg = aws.StringValue(summary.SourceRevisions[0].RevisionSummary)
+		}
+		executions[i] = execution
+	}
+	return executions, nil
+}
+
 // pipelineExecutionID returns the ExecutionID of the most recent execution of a pipeline.
 func (c *CodePipeline) pipelineExecutionID(pipelineName string) (string, error) {
 	input := &cp.ListPipelineExecutionsInput{
@@ -149,22 +149,27 @@ func TestCodePipeline_GetPipeline(t *testing.T) {
 				AccountID: "1234567890",
 				Stages: []*Stage{
 					{
-						Name:     "Source",
-						Category: "Source",
-						Provider: "GitHub",
-						Details:  "Repository: badgoose/repo",
+						Name:            "Source",
+						Category:        "Source",
+						Provider:        "GitHub",
+						Details:         "Repository: badgoose/repo",
+						OutputArtifacts: []string{"SCCheckoutArtifact"},
 					},
 					{
-						Name:     "Build",
-						Category: "Build",
-						Provider: "CodeBuild",
-						Details:  "BuildProject: pipeline-dinder-badgoose-repo-BuildProject",
+						Name:            "Build",
+						Category:        "Build",
+						Provider:        "CodeBuild",
+						Details:         "BuildProject: pipeline-dinder-badgoose-repo-BuildProject",
+						InputArtifacts:  []string{"SCCheckoutArtifact"},
+						OutputArtifacts: []string{"BuildOutput"},
 					},
 					{
-						Name:     "DeployTo-test",
-						Category: "Deploy",
-						Provider: "CloudFormation",
-						Details:  "StackName: dinder-test-test",
+						Name:           "DeployTo-test",
+						Category:       "Deploy",
+						Provider:       "CloudFormation",
+						Details:        "StackName: dinder-test-test",
+						Environment:    "test",
+						InputArtifacts: []string{"BuildOutput"},
 					},
 				},
 				CreatedAt: mockTime,
@@ -197,10 +202,11 @@ func TestCodePipeline_GetPipeline(t *testing.T) {
 				AccountID: "1234567890",
 				Stages: []*Stage{
 					{
-						Name:     "Source",
-						Category: "Source",
-						Provider: "GitHub",
-						Details:  "Repository: badgoose/repo",
+						Name:            "Source",
+						Category:        "Source",
+						Provider:        "GitHub",
+						Details:         "Repository: badgoose/repo",
+						OutputArtifacts: []string{"SCCheckoutArtifact"},
 					},
 					{
 						Name:     "DummyStage",
@@ -256,6 +262,111 @@ func TestCodePipeline_GetPipeline(t *testing.T) {
 	}
 }
 
+func TestCodePipeline_ListExecutions(t *testing.T) {
+	const mockPipelineName = "pipeline-dinder-badgoose-repo"
+	startTime := time.Date(2022, time.March, 1, 18, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		expectedOut   []PipelineExecution
+		expectedError error
+	}{
+		"should wrap error from codepipeline client": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListPipelineExecutions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("list pipeline executions for pipeline-dinder-badgoose-repo: some error"),
+		},
+		"should return the summaries of the executions": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListPipelineExecutions(&codepipeline.ListPipelineExecutionsInput{
+					MaxResults:   aws.Int64(5),
+					PipelineName: aws.String(mockPipelineName),
+				}).Return(&codepipeline.ListPipelineExecutionsOutput{
+					PipelineExecutionSummaries: []*codepipeline.PipelineExecutionSummary{
+						{
+							PipelineExecutionId: aws.String("2"),
+							Status:              aws.String("InProgress"),
+							StartTime:           aws.Time(startTime.Add(time.Hour)),
+							LastUpdateTime:      aws.Time(startTime.Add(time.Hour + 2*time.Minute)),
+							Trigger: &codepipeline.ExecutionTrigger{
+								TriggerType: aws.String("Webhook"),
+							},
+							SourceRevisions: []*codepipeline.SourceRevision{
+								{
+									ActionName:      aws.String("SourceCodeFor-dinder"),
+									RevisionId:      aws.String("a1b2c3d"),
+									RevisionSummary: aws.String("Fix the login page"),
+								},
+							},
+						},
+						{
+							PipelineExecutionId: aws.String("1"),
+							Status:              aws.String("Succeeded"),
+							StartTime:           aws.Time(startTime),
+							LastUpdateTime:      aws.Time(startTime.Add(15 * time.Minute)),
+						},
+					},
+				}, nil)
+			},
+			expectedOut: []PipelineExecution{
+				{
+					ID:        "2",
+					Status:    "InProgress",
+					Trigger:   "Webhook",
+					StartedAt: startTime.Add(time.Hour),
+					UpdatedAt: startTime.Add(time.Hour + 2*time.Minute),
+					Commit:    "a1b2c3d",
+					CommitMsg: "Fix the login page",
+				},
+				{
+					ID:        "1",
+					Status:    "Succeeded",
+					StartedAt: startTime,
+					UpdatedAt: startTime.Add(15 * time.Minute),
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{
+				cp: mockClient,
+			})
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			actualOut, err := cp.ListExecutions(mockPipelineName, 5)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedOut, actualOut)
+			}
+		})
+	}
+}
+
+func TestPipelineExecution_Duration(t *testing.T) {
+	startTime := time.Date(2022, time.March, 1, 18, 0, 0, 0, time.UTC)
+	execution := PipelineExecution{
+		StartedAt: startTime,
+		UpdatedAt: startTime.Add(15 * time.Minute),
+	}
+
+	require.Equal(t, 15*time.Minute, execution.Duration())
+}
+
 func TestCodePipeline_GetPipelineState(t *testing.T) {
 	mockPipelineName := "pipeline-dinder-badgoose-repo"
 	mockTime := time.Now()
@@ -39,6 +39,9 @@ type api interface {
 	DescribeRouteTables(input *ec2.DescribeRouteTablesInput) (*ec2.DescribeRouteTablesOutput, error)
 	DescribeAvailabilityZones(input *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error)
 	DescribeManagedPrefixLists(input *ec2.DescribeManagedPrefixListsInput) (*ec2.DescribeManagedPrefixListsOutput, error)
+	DescribeNatGateways(input *ec2.DescribeNatGatewaysInput) (*ec2.DescribeNatGatewaysOutput, error)
+	DescribeInternetGateways(input *ec2.DescribeInternetGatewaysInput) (*ec2.DescribeInternetGatewaysOutput, error)
+	DescribeVpcEndpoints(input *ec2.DescribeVpcEndpointsInput) (*ec2.DescribeVpcEndpointsOutput, error)
 }
 
 // Filter contains the name and values of a filter.
@@ -76,7 +79,23 @@ type VPC struct {
 // Subnet contains the ID and name of a subnet.
 type Subnet struct {
 	Resource
-	CIDRBlock string
+	CIDRBlock        string
+	AvailabilityZone string
+}
+
+// NATGateway contains the ID and name of a NAT gateway, the subnet it's placed in and its public IP address.
+type NATGateway struct {
+	Resource
+	SubnetID string
+	PublicIP string
+}
+
+// VPCEndpoint contains the ID of a VPC endpoint, the name of the service it connects to and its type.
+// For example: VPCEndpoint{"ID": "vpce-0a1b2c3d", "ServiceName": "com.amazonaws.us-west-2.s3", "Type": "Gateway"}.
+type VPCEndpoint struct {
+	ID          string
+	ServiceName string
+	Type        string
 }
 
 // AZ represents an availability zone.
@@ -189,6 +208,31 @@ func (c *EC2) ListVPCs() ([]VPC, error) {
 	return vpcs, nil
 }
 
+// VPCCIDRBlocks returns the primary and secondary IPv4 CIDR blocks associated with a VPC.
+func (c *EC2) VPCCIDRBlocks(vpcID string) ([]string, error) {
+	resp, err := c.client.DescribeVpcs(&ec2.DescribeVpcsInput{
+		VpcIds: aws.StringSlice([]string{vpcID}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe VPC %s: %w", vpcID, err)
+	}
+	if len(resp.Vpcs) == 0 {
+		return nil, fmt.Errorf("VPC %s not found", vpcID)
+	}
+	vpc := resp.Vpcs[0]
+	var cidrs []string
+	for _, association := range vpc.CidrBlockAssociationSet {
+		if association.CidrBlockState != nil && aws.StringValue(association.CidrBlockState.State) != ec2.VpcCidrBlockStateCodeAssociated {
+			continue
+		}
+		cidrs = append(cidrs, aws.StringValue(association.CidrBlock))
+	}
+	if len(cidrs) == 0 && vpc.CidrBlock != nil {
+		cidrs = append(cidrs, aws.StringValue(vpc.CidrBlock))
+	}
+	return cidrs, nil
+}
+
 // ListAZs returns the list of opted-in and available availability zones.
 func (c *EC2) ListAZs() ([]AZ, error) {
 	resp, err := c.client.DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{
@@ -254,18 +298,13 @@ func (c *EC2) ListVPCSubnets(vpcID string) (*VPCSubnets, error) {
 		return nil, err
 	}
 	for _, subnet := range respSubnets {
-		var name string
-		for _, tag := range subnet.Tags {
-			if aws.StringValue(tag.Key) == "Name" {
-				name = aws.StringValue(tag.Value)
-			}
-		}
 		s := Subnet{
 			Resource: Resource{
 				ID:   aws.StringValue(subnet.SubnetId),
-				Name: name,
+				Name: nameTag(subnet.Tags),
 			},
-			CIDRBlock: aws.StringValue(subnet.CidrBlock),
+			CIDRBlock:        aws.StringValue(subnet.CidrBlock),
+			AvailabilityZone: aws.StringValue(subnet.AvailabilityZone),
 		}
 		if rtIndex.IsPublicSubnet(s.ID) {
 			publicSubnets = append(publicSubnets, s)
@@ -279,6 +318,111 @@ func (c *EC2) ListVPCSubnets(vpcID string) (*VPCSubnets, error) {
 	}, nil
 }
 
+// ListNATGateways lists the NAT gateways of a VPC that are not deleted.
+func (c *EC2) ListNATGateways(vpcID string) ([]NATGateway, error) {
+	var gateways []NATGateway
+	input := &ec2.DescribeNatGatewaysInput{
+		Filter: toEC2Filter([]Filter{
+			{
+				Name:   "vpc-id",
+				Values: []string{vpcID},
+			},
+			{
+				Name:   "state",
+				Values: []string{ec2.NatGatewayStatePending, ec2.NatGatewayStateAvailable},
+			},
+		}),
+	}
+	for {
+		resp, err := c.client.DescribeNatGateways(input)
+		if err != nil {
+			return nil, fmt.Errorf("describe NAT gateways of vpc %s: %w", vpcID, err)
+		}
+		for _, gw := range resp.NatGateways {
+			natGW := NATGateway{
+				Resource: Resource{
+					ID:   aws.StringValue(gw.NatGatewayId),
+					Name: nameTag(gw.Tags),
+				},
+				SubnetID: aws.StringValue(gw.SubnetId),
+			}
+			for _, addr := range gw.NatGatewayAddresses {
+				if ip := aws.StringValue(addr.PublicIp); ip != "" {
+					natGW.PublicIP = ip
+					break
+				}
+			}
+			gateways = append(gateways, natGW)
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+	return gateways, nil
+}
+
+// ListInternetGateways lists the internet gateways attached to a VPC.
+func (c *EC2) ListInternetGateways(vpcID string) ([]Resource, error) {
+	var gateways []Resource
+	input := &ec2.DescribeInternetGatewaysInput{
+		Filters: toEC2Filter([]Filter{
+			{
+				Name:   "attachment.vpc-id",
+				Values: []string{vpcID},
+			},
+		}),
+	}
+	for {
+		resp, err := c.client.DescribeInternetGateways(input)
+		if err != nil {
+			return nil, fmt.Errorf("describe internet gateways of vpc %s: %w", vpcID, err)
+		}
+		for _, gw := range resp.InternetGateways {
+			gateways = append(gateways, Resource{
+				ID:   aws.StringValue(gw.InternetGatewayId),
+				Name: nameTag(gw.Tags),
+			})
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+	return gateways, nil
+}
+
+// ListVPCEndpoints lists the VPC endpoints of a VPC.
+func (c *EC2) ListVPCEndpoints(vpcID string) ([]VPCEndpoint, error) {
+	var endpoints []VPCEndpoint
+	input := &ec2.DescribeVpcEndpointsInput{
+		Filters: toEC2Filter([]Filter{
+			{
+				Name:   "vpc-id",
+				Values: []string{vpcID},
+			},
+		}),
+	}
+	for {
+		resp, err := c.client.DescribeVpcEndpoints(input)
+		if err != nil {
+			return nil, fmt.Errorf("describe VPC endpoints of vpc %s: %w", vpcID, err)
+		}
+		for _, endpoint := range resp.VpcEndpoints {
+			endpoints = append(endpoints, VPCEndpoint{
+				ID:          aws.StringValue(endpoint.VpcEndpointId),
+				ServiceName: aws.StringValue(endpoint.ServiceName),
+				Type:        aws.StringValue(endpoint.VpcEndpointType),
+			})
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+	return endpoints, nil
+}
+
 // SubnetIDs finds the subnet IDs with optional filters.
 func (c *EC2) SubnetIDs(filters ...Filter) ([]string, error) {
 	subnets, err := c.subnets(filters...)
@@ -312,6 +456,19 @@ func (c *EC2) SecurityGroups(filters ...Filter) ([]string, error) {
 	return securityGroups, nil
 }
 
+// RouteTableIDs finds the route table IDs with optional filters.
+func (c *EC2) RouteTableIDs(filters ...Filter) ([]string, error) {
+	routeTables, err := c.routeTables(filters...)
+	if err != nil {
+		return nil, err
+	}
+	routeTableIDs := make([]string, len(routeTables))
+	for idx, rt := range routeTables {
+		routeTableIDs[idx] = aws.StringValue(rt.RouteTableId)
+	}
+	return routeTableIDs, nil
+}
+
 func (c *EC2) subnets(filters ...Filter) ([]*ec2.Subnet, error) {
 	inputFilters := toEC2Filter(filters)
 	var subnets []*ec2.Subnet
@@ -367,6 +524,15 @@ func toEC2Filter(filters []Filter) []*ec2.Filter {
 	return ec2Filter
 }
 
+func nameTag(tags []*ec2.Tag) string {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == "Name" {
+			return aws.StringValue(tag.Value)
+		}
+	}
+	return ""
+}
+
 type routeTable ec2.RouteTable
 
 // IsMain returns true if the route table is the default route table for the VPC.
@@ -922,6 +922,69 @@ func TestEC2_SecurityGroups(t *testing.T) {
 	}
 }
 
+func TestEC2_RouteTableIDs(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedIDs   []string
+	}{
+		"failed to get route tables": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+					Filters: toEC2Filter(inAppEnvFilters),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe route tables: some error"),
+		},
+		"get route tables with pagination": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+					Filters: toEC2Filter(inAppEnvFilters),
+				}).Return(&ec2.DescribeRouteTablesOutput{
+					RouteTables: []*ec2.RouteTable{
+						{
+							RouteTableId: aws.String("rtb-1"),
+						},
+					},
+					NextToken: aws.String("mockNextToken"),
+				}, nil)
+				m.EXPECT().DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+					Filters:   toEC2Filter(inAppEnvFilters),
+					NextToken: aws.String("mockNextToken"),
+				}).Return(&ec2.DescribeRouteTablesOutput{
+					RouteTables: []*ec2.RouteTable{
+						{
+							RouteTableId: aws.String("rtb-2"),
+						},
+					},
+				}, nil)
+			},
+			wantedIDs: []string{"rtb-1", "rtb-2"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			ids, err := ec2Client.RouteTableIDs(inAppEnvFilters...)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedIDs, ids)
+		})
+	}
+}
+
 func TestEC2_HasDNSSupport(t *testing.T) {
 	testCases := map[string]struct {
 		vpcID string
@@ -974,3 +1037,313 @@ func TestEC2_HasDNSSupport(t *testing.T) {
 		})
 	}
 }
+
+func TestEC2_VPCCIDRBlocks(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedCIDRs []string
+	}{
+		"fail to describe VPC": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcs(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe VPC mockVPCID: some error"),
+		},
+		"VPC not found": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcs(gomock.Any()).Return(&ec2.DescribeVpcsOutput{}, nil)
+			},
+			wantedError: errors.New("VPC mockVPCID not found"),
+		},
+		"returns the associated CIDR blocks": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcs(&ec2.DescribeVpcsInput{
+					VpcIds: aws.StringSlice([]string{"mockVPCID"}),
+				}).Return(&ec2.DescribeVpcsOutput{
+					Vpcs: []*ec2.Vpc{
+						{
+							CidrBlock: aws.String("10.0.0.0/16"),
+							CidrBlockAssociationSet: []*ec2.VpcCidrBlockAssociation{
+								{
+									CidrBlock:      aws.String("10.0.0.0/16"),
+									CidrBlockState: &ec2.VpcCidrBlockState{State: aws.String("associated")},
+								},
+								{
+									CidrBlock:      aws.String("10.1.0.0/16"),
+									CidrBlockState: &ec2.VpcCidrBlockState{State: aws.String("associated")},
+								},
+								{
+									CidrBlock:      aws.String("10.2.0.0/16"),
+									CidrBlockState: &ec2.VpcCidrBlockState{State: aws.String("disassociated")},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedCIDRs: []string{"10.0.0.0/16", "10.1.0.0/16"},
+		},
+		"falls back to the primary CIDR block": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcs(gomock.Any()).Return(&ec2.DescribeVpcsOutput{
+					Vpcs: []*ec2.Vpc{
+						{
+							CidrBlock: aws.String("10.0.0.0/16"),
+						},
+					},
+				}, nil)
+			},
+			wantedCIDRs: []string{"10.0.0.0/16"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			cidrs, err := ec2Client.VPCCIDRBlocks("mockVPCID")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedCIDRs, cidrs)
+			}
+		})
+	}
+}
+
+func TestEC2_ListNATGateways(t *testing.T) {
+	mockFilter := toEC2Filter([]Filter{
+		{
+			Name:   "vpc-id",
+			Values: []string{"mockVPCID"},
+		},
+		{
+			Name:   "state",
+			Values: []string{ec2.NatGatewayStatePending, ec2.NatGatewayStateAvailable},
+		},
+	})
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError    error
+		wantedGateways []NATGateway
+	}{
+		"fail to describe NAT gateways": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeNatGateways(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe NAT gateways of vpc mockVPCID: some error"),
+		},
+		"get NAT gateways with pagination": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeNatGateways(&ec2.DescribeNatGatewaysInput{
+					Filter: mockFilter,
+				}).Return(&ec2.DescribeNatGatewaysOutput{
+					NatGateways: []*ec2.NatGateway{
+						{
+							NatGatewayId: aws.String("nat-1"),
+							SubnetId:     aws.String("subnet-1"),
+							Tags: []*ec2.Tag{
+								{
+									Key:   aws.String("Name"),
+									Value: aws.String("copilot-phonetool-test"),
+								},
+							},
+							NatGatewayAddresses: []*ec2.NatGatewayAddress{
+								{
+									PublicIp: aws.String("3.3.3.3"),
+								},
+							},
+						},
+					},
+					NextToken: aws.String("mockNextToken"),
+				}, nil)
+				m.EXPECT().DescribeNatGateways(&ec2.DescribeNatGatewaysInput{
+					Filter:    mockFilter,
+					NextToken: aws.String("mockNextToken"),
+				}).Return(&ec2.DescribeNatGatewaysOutput{
+					NatGateways: []*ec2.NatGateway{
+						{
+							NatGatewayId: aws.String("nat-2"),
+							SubnetId:     aws.String("subnet-2"),
+						},
+					},
+				}, nil)
+			},
+			wantedGateways: []NATGateway{
+				{
+					Resource: Resource{
+						ID:   "nat-1",
+						Name: "copilot-phonetool-test",
+					},
+					SubnetID: "subnet-1",
+					PublicIP: "3.3.3.3",
+				},
+				{
+					Resource: Resource{
+						ID: "nat-2",
+					},
+					SubnetID: "subnet-2",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			gateways, err := ec2Client.ListNATGateways("mockVPCID")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedGateways, gateways)
+		})
+	}
+}
+
+func TestEC2_ListInternetGateways(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError    error
+		wantedGateways []Resource
+	}{
+		"fail to describe internet gateways": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeInternetGateways(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe internet gateways of vpc mockVPCID: some error"),
+		},
+		"success": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{
+					Filters: toEC2Filter([]Filter{
+						{
+							Name:   "attachment.vpc-id",
+							Values: []string{"mockVPCID"},
+						},
+					}),
+				}).Return(&ec2.DescribeInternetGatewaysOutput{
+					InternetGateways: []*ec2.InternetGateway{
+						{
+							InternetGatewayId: aws.String("igw-1"),
+							Tags: []*ec2.Tag{
+								{
+									Key:   aws.String("Name"),
+									Value: aws.String("copilot-phonetool-test"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedGateways: []Resource{
+				{
+					ID:   "igw-1",
+					Name: "copilot-phonetool-test",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			gateways, err := ec2Client.ListInternetGateways("mockVPCID")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedGateways, gateways)
+		})
+	}
+}
+
+func TestEC2_ListVPCEndpoints(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError     error
+		wantedEndpoints []VPCEndpoint
+	}{
+		"fail to describe VPC endpoints": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcEndpoints(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe VPC endpoints of vpc mockVPCID: some error"),
+		},
+		"success": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{
+					Filters: toEC2Filter([]Filter{
+						{
+							Name:   "vpc-id",
+							Values: []string{"mockVPCID"},
+						},
+					}),
+				}).Return(&ec2.DescribeVpcEndpointsOutput{
+					VpcEndpoints: []*ec2.VpcEndpoint{
+						{
+							VpcEndpointId:   aws.String("vpce-1"),
+							ServiceName:     aws.String("com.amazonaws.us-west-2.s3"),
+							VpcEndpointType: aws.String("Gateway"),
+						},
+					},
+				}, nil)
+			},
+			wantedEndpoints: []VPCEndpoint{
+				{
+					ID:          "vpce-1",
+					ServiceName: "com.amazonaws.us-west-2.s3",
+					Type:        "Gateway",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			endpoints, err := ec2Client.ListVPCEndpoints("mockVPCID")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEndpoints, endpoints)
+		})
+	}
+}
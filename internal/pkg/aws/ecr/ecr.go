@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -24,6 +25,8 @@ const (
 	urlFmtStringForCN = "%s.dkr.ecr.%s.amazonaws.com.cn/%s"
 	arnResourcePrefix = "repository/"
 	batchDeleteLimit  = 100
+	latestImageTag    = "latest"
+	digestPrefix      = "sha256:"
 )
 
 type api interface {
@@ -31,6 +34,7 @@ type api interface {
 	GetAuthorizationToken(*ecr.GetAuthorizationTokenInput) (*ecr.GetAuthorizationTokenOutput, error)
 	DescribeRepositories(*ecr.DescribeRepositoriesInput) (*ecr.DescribeRepositoriesOutput, error)
 	BatchDeleteImage(*ecr.BatchDeleteImageInput) (*ecr.BatchDeleteImageOutput, error)
+	DescribeImageScanFindings(*ecr.DescribeImageScanFindingsInput) (*ecr.DescribeImageScanFindingsOutput, error)
 }
 
 // ECR wraps an AWS ECR client.
@@ -84,9 +88,45 @@ func (c ECR) RepositoryURI(name string) (string, error) {
 	return *repo.RepositoryUri, nil
 }
 
+// IsTagImmutable returns true if the ECR repository prevents image tags from being overwritten.
+func (c ECR) IsTagImmutable(name string) (bool, error) {
+	result, err := c.client.DescribeRepositories(&ecr.DescribeRepositoriesInput{
+		RepositoryNames: aws.StringSlice([]string{name}),
+	})
+	if err != nil {
+		return false, fmt.Errorf("ecr describe repository %s: %w", name, err)
+	}
+	if len(result.Repositories) == 0 {
+		return false, errors.New("no repositories found")
+	}
+	return aws.StringValue(result.Repositories[0].ImageTagMutability) == ecr.ImageTagMutabilityImmutable, nil
+}
+
+// ImageTagExists returns true if an image with the tag exists in the ECR repository.
+func (c ECR) ImageTagExists(name, tag string) (bool, error) {
+	_, err := c.client.DescribeImages(&ecr.DescribeImagesInput{
+		RepositoryName: aws.String(name),
+		ImageIds: []*ecr.ImageIdentifier{
+			{
+				ImageTag: aws.String(tag),
+			},
+		},
+	})
+	if err != nil {
+		if isImageNotFoundErr(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("ecr repo %s describe image with tag %s: %w", name, tag, err)
+	}
+	return true, nil
+}
+
 // Image houses metadata for ECR repository images.
 type Image struct {
-	Digest string
+	Digest      string
+	Tags        []string
+	PushedAt    time.Time
+	SizeInBytes int64
 }
 
 func (i Image) imageIdentifier() *ecr.ImageIdentifier {
@@ -106,9 +146,7 @@ func (c ECR) ListImages(repoName string) ([]Image, error) {
 		return nil, fmt.Errorf("ecr repo %s describe images: %w", repoName, err)
 	}
 	for _, imageDetails := range resp.ImageDetails {
-		images = append(images, Image{
-			Digest: *imageDetails.ImageDigest,
-		})
+		images = append(images, toImage(imageDetails))
 	}
 	for resp.NextToken != nil {
 		resp, err = c.client.DescribeImages(&ecr.DescribeImagesInput{
@@ -119,14 +157,64 @@ func (c ECR) ListImages(repoName string) ([]Image, error) {
 			return nil, fmt.Errorf("ecr repo %s describe images: %w", repoName, err)
 		}
 		for _, imageDetails := range resp.ImageDetails {
-			images = append(images, Image{
-				Digest: *imageDetails.ImageDigest,
-			})
+			images = append(images, toImage(imageDetails))
 		}
 	}
 	return images, nil
 }
 
+func toImage(details *ecr.ImageDetail) Image {
+	img := Image{
+		Digest:      aws.StringValue(details.ImageDigest),
+		PushedAt:    aws.TimeValue(details.ImagePushedAt),
+		SizeInBytes: aws.Int64Value(details.ImageSizeInBytes),
+	}
+	if len(details.ImageTags) != 0 {
+		img.Tags = aws.StringValueSlice(details.ImageTags)
+	}
+	return img
+}
+
+// ScanFindings holds the result of the latest vulnerability scan of an image.
+type ScanFindings struct {
+	Digest string           // Digest of the scanned image.
+	Status string           // Status of the scan, the counts are only available if it's "COMPLETE".
+	Counts map[string]int64 // Number of findings by severity, for example "CRITICAL" or "HIGH".
+}
+
+// ImageScanFindings returns the findings of the latest vulnerability scan of an image referred to by a tag or a digest.
+// If the image was never scanned, then returns nil.
+func (c ECR) ImageScanFindings(repoName, tagOrDigest string) (*ScanFindings, error) {
+	id := &ecr.ImageIdentifier{
+		ImageTag: aws.String(tagOrDigest),
+	}
+	if strings.HasPrefix(tagOrDigest, digestPrefix) {
+		id = &ecr.ImageIdentifier{
+			ImageDigest: aws.String(tagOrDigest),
+		}
+	}
+	out, err := c.client.DescribeImageScanFindings(&ecr.DescribeImageScanFindingsInput{
+		RepositoryName: aws.String(repoName),
+		ImageId:        id,
+	})
+	if err != nil {
+		if isScanNotFoundErr(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("ecr repo %s describe scan findings of image %s: %w", repoName, tagOrDigest, err)
+	}
+	findings := &ScanFindings{
+		Digest: aws.StringValue(out.ImageId.ImageDigest),
+	}
+	if out.ImageScanStatus != nil {
+		findings.Status = aws.StringValue(out.ImageScanStatus.Status)
+	}
+	if out.ImageScanFindings != nil {
+		findings.Counts = aws.Int64ValueMap(out.ImageScanFindings.FindingSeverityCounts)
+	}
+	return findings, nil
+}
+
 // DeleteImages calls the ECR BatchDeleteImage API with the input image list and repository name.
 func (c ECR) DeleteImages(images []Image, repoName string) error {
 	if len(images) == 0 {
@@ -175,6 +263,40 @@ func (c ECR) ClearRepository(repoName string) error {
 	return err
 }
 
+// ClearImagesWithTagPrefix deletes the images of a repository that are tagged with prefix.
+// It's used to delete the images of a workload from a repository shared by all the workloads of an application.
+// An image is deleted only if all of its tags start with prefix, except for the "latest" tag which doesn't belong to any workload.
+func (c ECR) ClearImagesWithTagPrefix(repoName, prefix string) error {
+	images, err := c.ListImages(repoName)
+	if err != nil {
+		if isRepoNotFoundErr(errors.Unwrap(err)) {
+			return nil
+		}
+		return err
+	}
+	var toDelete []Image
+	for _, image := range images {
+		if hasOnlyTagsWithPrefix(image, prefix) {
+			toDelete = append(toDelete, image)
+		}
+	}
+	return c.DeleteImages(toDelete, repoName)
+}
+
+func hasOnlyTagsWithPrefix(image Image, prefix string) bool {
+	var found bool
+	for _, tag := range image.Tags {
+		if tag == latestImageTag {
+			continue
+		}
+		if !strings.HasPrefix(tag, prefix) {
+			return false
+		}
+		found = true
+	}
+	return found
+}
+
 // URIFromARN converts an ECR Repo ARN to a Repository URI
 func URIFromARN(repositoryARN string) (string, error) {
 	repoARN, err := arn.Parse(repositoryARN)
@@ -204,3 +326,19 @@ func isRepoNotFoundErr(err error) bool {
 	}
 	return false
 }
+
+func isScanNotFoundErr(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+	return aerr.Code() == ecr.ErrCodeScanNotFoundException
+}
+
+func isImageNotFoundErr(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+	return aerr.Code() == ecr.ErrCodeImageNotFoundException
+}
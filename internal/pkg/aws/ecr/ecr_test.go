@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -141,6 +142,246 @@ func TestRepositoryURI(t *testing.T) {
 	}
 }
 
+func TestIsTagImmutable(t *testing.T) {
+	mockError := errors.New("error")
+	mockRepoName := "mockRepoName"
+
+	testCases := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantImmutable bool
+		wantErr       error
+	}{
+		"should return wrapped error given error returned from DescribeRepositories": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRepositories(gomock.Any()).Return(nil, mockError)
+			},
+			wantErr: fmt.Errorf("ecr describe repository %s: %w", mockRepoName, mockError),
+		},
+		"should return error given no repositories returned in list": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRepositories(gomock.Any()).Return(&ecr.DescribeRepositoriesOutput{}, nil)
+			},
+			wantErr: errors.New("no repositories found"),
+		},
+		"should return false if tags are mutable": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRepositories(&ecr.DescribeRepositoriesInput{
+					RepositoryNames: aws.StringSlice([]string{mockRepoName}),
+				}).Return(&ecr.DescribeRepositoriesOutput{
+					Repositories: []*ecr.Repository{
+						{
+							ImageTagMutability: aws.String(ecr.ImageTagMutabilityMutable),
+						},
+					},
+				}, nil)
+			},
+		},
+		"should return true if tags are immutable": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRepositories(&ecr.DescribeRepositoriesInput{
+					RepositoryNames: aws.StringSlice([]string{mockRepoName}),
+				}).Return(&ecr.DescribeRepositoriesOutput{
+					Repositories: []*ecr.Repository{
+						{
+							ImageTagMutability: aws.String(ecr.ImageTagMutabilityImmutable),
+						},
+					},
+				}, nil)
+			},
+			wantImmutable: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			gotImmutable, gotErr := client.IsTagImmutable(mockRepoName)
+
+			require.Equal(t, tc.wantImmutable, gotImmutable)
+			require.Equal(t, tc.wantErr, gotErr)
+		})
+	}
+}
+
+func TestImageTagExists(t *testing.T) {
+	mockRepoName := "mockRepoName"
+	mockTag := "v1.0.0"
+	mockAwsError := awserr.New("someErrorCode", "some error", nil)
+	mockImageNotFoundError := awserr.New(ecr.ErrCodeImageNotFoundException, "some error", nil)
+	mockInput := &ecr.DescribeImagesInput{
+		RepositoryName: aws.String(mockRepoName),
+		ImageIds: []*ecr.ImageIdentifier{
+			{
+				ImageTag: aws.String(mockTag),
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantExists bool
+		wantErr    error
+	}{
+		"should return wrapped error given error returned from DescribeImages": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(mockInput).Return(nil, mockAwsError)
+			},
+			wantErr: fmt.Errorf("ecr repo mockRepoName describe image with tag v1.0.0: %w", mockAwsError),
+		},
+		"should return false if the image is not found": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(mockInput).Return(nil, mockImageNotFoundError)
+			},
+		},
+		"should return true if the image exists": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(mockInput).Return(&ecr.DescribeImagesOutput{
+					ImageDetails: []*ecr.ImageDetail{
+						{
+							ImageTags: aws.StringSlice([]string{mockTag}),
+						},
+					},
+				}, nil)
+			},
+			wantExists: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			gotExists, gotErr := client.ImageTagExists(mockRepoName, mockTag)
+
+			require.Equal(t, tc.wantExists, gotExists)
+			require.Equal(t, tc.wantErr, gotErr)
+		})
+	}
+}
+
+func TestImageScanFindings(t *testing.T) {
+	mockRepoName := "mockRepoName"
+	mockAwsError := awserr.New("someErrorCode", "some error", nil)
+
+	testCases := map[string]struct {
+		inTagOrDigest string
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantFindings *ScanFindings
+		wantErr      error
+	}{
+		"should return wrapped error given error returned from DescribeImageScanFindings": {
+			inTagOrDigest: "v1.0.0",
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImageScanFindings(gomock.Any()).Return(nil, mockAwsError)
+			},
+			wantErr: fmt.Errorf("ecr repo mockRepoName describe scan findings of image v1.0.0: %w", mockAwsError),
+		},
+		"should return nil if the image was never scanned": {
+			inTagOrDigest: "v1.0.0",
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImageScanFindings(gomock.Any()).Return(nil, awserr.New(ecr.ErrCodeScanNotFoundException, "some error", nil))
+			},
+		},
+		"should look up the image by tag": {
+			inTagOrDigest: "v1.0.0",
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImageScanFindings(&ecr.DescribeImageScanFindingsInput{
+					RepositoryName: aws.String(mockRepoName),
+					ImageId: &ecr.ImageIdentifier{
+						ImageTag: aws.String("v1.0.0"),
+					},
+				}).Return(&ecr.DescribeImageScanFindingsOutput{
+					ImageId: &ecr.ImageIdentifier{
+						ImageDigest: aws.String("sha256:1a2b3c"),
+						ImageTag:    aws.String("v1.0.0"),
+					},
+					ImageScanStatus: &ecr.ImageScanStatus{
+						Status: aws.String(ecr.ScanStatusInProgress),
+					},
+				}, nil)
+			},
+			wantFindings: &ScanFindings{
+				Digest: "sha256:1a2b3c",
+				Status: "IN_PROGRESS",
+			},
+		},
+		"should look up the image by digest and count the findings": {
+			inTagOrDigest: "sha256:1a2b3c",
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImageScanFindings(&ecr.DescribeImageScanFindingsInput{
+					RepositoryName: aws.String(mockRepoName),
+					ImageId: &ecr.ImageIdentifier{
+						ImageDigest: aws.String("sha256:1a2b3c"),
+					},
+				}).Return(&ecr.DescribeImageScanFindingsOutput{
+					ImageId: &ecr.ImageIdentifier{
+						ImageDigest: aws.String("sha256:1a2b3c"),
+					},
+					ImageScanStatus: &ecr.ImageScanStatus{
+						Status: aws.String(ecr.ScanStatusComplete),
+					},
+					ImageScanFindings: &ecr.ImageScanFindings{
+						FindingSeverityCounts: aws.Int64Map(map[string]int64{
+							"CRITICAL": 1,
+							"LOW":      4,
+						}),
+					},
+				}, nil)
+			},
+			wantFindings: &ScanFindings{
+				Digest: "sha256:1a2b3c",
+				Status: "COMPLETE",
+				Counts: map[string]int64{
+					"CRITICAL": 1,
+					"LOW":      4,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			gotFindings, gotErr := client.ImageScanFindings(mockRepoName, tc.inTagOrDigest)
+
+			require.Equal(t, tc.wantFindings, gotFindings)
+			require.Equal(t, tc.wantErr, gotErr)
+		})
+	}
+}
+
 func TestURIFromARN(t *testing.T) {
 
 	testCases := map[string]struct {
@@ -188,6 +429,7 @@ func TestListImages(t *testing.T) {
 	mockError := errors.New("mockError")
 	mockDigest := "mockDigest"
 	mockNextToken := "next"
+	mockPushedAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
 
 	tests := map[string]struct {
 		mockECRClient func(m *mocks.Mockapi)
@@ -207,12 +449,15 @@ func TestListImages(t *testing.T) {
 				m.EXPECT().DescribeImages(gomock.Any()).Return(&ecr.DescribeImagesOutput{
 					ImageDetails: []*ecr.ImageDetail{
 						{
-							ImageDigest: aws.String(mockDigest),
+							ImageDigest:      aws.String(mockDigest),
+							ImageTags:        aws.StringSlice([]string{"frontend-latest", "frontend-v1"}),
+							ImagePushedAt:    aws.Time(mockPushedAt),
+							ImageSizeInBytes: aws.Int64(1024),
 						},
 					},
 				}, nil)
 			},
-			wantImages: []Image{{Digest: mockDigest}},
+			wantImages: []Image{{Digest: mockDigest, Tags: []string{"frontend-latest", "frontend-v1"}, PushedAt: mockPushedAt, SizeInBytes: 1024}},
 			wantError:  nil,
 		},
 		"should return all images when paginated": {
@@ -450,3 +695,90 @@ func TestClearRepository(t *testing.T) {
 		})
 	}
 }
+
+func TestClearImagesWithTagPrefix(t *testing.T) {
+	const mockRepoName = "phonetool"
+	mockError := errors.New("some error")
+
+	tests := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantError error
+	}{
+		"returns nil if repo not exists": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(gomock.Any()).Return(nil, awserr.New("RepositoryNotFoundException", "some error", nil))
+				m.EXPECT().BatchDeleteImage(gomock.Any()).Times(0)
+			},
+		},
+		"returns error if fail to list images": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(gomock.Any()).Return(nil, mockError)
+			},
+			wantError: fmt.Errorf("ecr repo phonetool describe images: %w", mockError),
+		},
+		"deletes only the images whose tags all have the prefix besides latest": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(&ecr.DescribeImagesInput{
+					RepositoryName: aws.String(mockRepoName),
+				}).Return(&ecr.DescribeImagesOutput{
+					ImageDetails: []*ecr.ImageDetail{
+						{
+							ImageDigest: aws.String("frontend"),
+							ImageTags:   aws.StringSlice([]string{"frontend-latest", "frontend-v1"}),
+						},
+						{
+							ImageDigest: aws.String("frontend-latest"),
+							ImageTags:   aws.StringSlice([]string{"latest", "frontend-v2"}),
+						},
+						{
+							ImageDigest: aws.String("latest"),
+							ImageTags:   aws.StringSlice([]string{"latest"}),
+						},
+						{
+							ImageDigest: aws.String("shared"),
+							ImageTags:   aws.StringSlice([]string{"frontend-v2", "backend-v2"}),
+						},
+						{
+							ImageDigest: aws.String("backend"),
+							ImageTags:   aws.StringSlice([]string{"backend-latest"}),
+						},
+						{
+							ImageDigest: aws.String("untagged"),
+						},
+					},
+				}, nil)
+				m.EXPECT().BatchDeleteImage(&ecr.BatchDeleteImageInput{
+					RepositoryName: aws.String(mockRepoName),
+					ImageIds: []*ecr.ImageIdentifier{
+						{
+							ImageDigest: aws.String("frontend"),
+						},
+						{
+							ImageDigest: aws.String("frontend-latest"),
+						},
+					},
+				}).Return(&ecr.BatchDeleteImageOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			gotError := client.ClearImagesWithTagPrefix(mockRepoName, "frontend-")
+
+			require.Equal(t, tc.wantError, gotError)
+		})
+	}
+}
@@ -7,6 +7,7 @@ package ecs
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -15,7 +16,9 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 )
 
 const (
@@ -23,6 +26,7 @@ const (
 	waitServiceStablePollingInterval = 15 * time.Second
 	waitServiceStableMaxTry          = 80
 	stableServiceDeploymentNum       = 1
+	reconnectSessionDelay            = time.Second
 	// ECS EndpointsID
 	EndpointsID = ecs.EndpointsID
 )
@@ -44,13 +48,19 @@ type ssmSessionStarter interface {
 	StartSession(ssmSession *ecs.Session) error
 }
 
+type ssmSessionResumer interface {
+	ResumeSession(input *ssm.ResumeSessionInput) (*ssm.ResumeSessionOutput, error)
+}
+
 // ECS wraps an AWS ECS client.
 type ECS struct {
 	client         api
 	newSessStarter func() ssmSessionStarter
+	sessResumer    ssmSessionResumer
 
 	maxServiceStableTries int
 	pollIntervalDuration  time.Duration
+	reconnectDelay        time.Duration
 }
 
 // RunTaskInput holds the fields needed to run tasks.
@@ -71,6 +81,9 @@ type ExecuteCommandInput struct {
 	Command   string
 	Task      string
 	Container string
+
+	Reconnect int           // Optional. Maximum number of times to resume the session if its connection drops.
+	KeepAlive time.Duration // Optional. Interval at which the container writes to the idle session so that it isn't closed.
 }
 
 // New returns a Service configured against the input session.
@@ -80,8 +93,10 @@ func New(s *session.Session) *ECS {
 		newSessStarter: func() ssmSessionStarter {
 			return exec.NewSSMPluginCommand(s)
 		},
+		sessResumer:           ssm.New(s),
 		maxServiceStableTries: waitServiceStableMaxTry,
 		pollIntervalDuration:  waitServiceStablePollingInterval,
+		reconnectDelay:        reconnectSessionDelay,
 	}
 }
 
@@ -307,6 +322,39 @@ func (e *ECS) DefaultCluster() (string, error) {
 	return aws.StringValue(cluster.ClusterArn), nil
 }
 
+// Cluster holds the fields of an ECS cluster.
+type Cluster struct {
+	ARN               string
+	Name              string
+	Status            string
+	CapacityProviders []string
+}
+
+// IsActive returns true if the cluster can run tasks.
+func (c *Cluster) IsActive() bool {
+	return c.Status == clusterStatusActive
+}
+
+// Cluster returns the cluster with the given name or ARN.
+func (e *ECS) Cluster(name string) (*Cluster, error) {
+	resp, err := e.client.DescribeClusters(&ecs.DescribeClustersInput{
+		Clusters: aws.StringSlice([]string{name}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe cluster %s: %w", name, err)
+	}
+	if len(resp.Clusters) == 0 {
+		return nil, fmt.Errorf("cluster %s not found", name)
+	}
+	cluster := resp.Clusters[0]
+	return &Cluster{
+		ARN:               aws.StringValue(cluster.ClusterArn),
+		Name:              aws.StringValue(cluster.ClusterName),
+		Status:            aws.StringValue(cluster.Status),
+		CapacityProviders: aws.StringValueSlice(cluster.CapacityProviders),
+	}, nil
+}
+
 // HasDefaultCluster tries to find the default cluster and returns true if there is one.
 func (e *ECS) HasDefaultCluster() (bool, error) {
 	if _, err := e.DefaultCluster(); err != nil {
@@ -390,9 +438,13 @@ func (e *ECS) DescribeTasks(cluster string, taskARNs []string) ([]*Task, error)
 
 // ExecuteCommand executes commands in a running container, and then terminate the session.
 func (e *ECS) ExecuteCommand(in ExecuteCommandInput) (err error) {
+	command := in.Command
+	if in.KeepAlive > 0 {
+		command = keepAliveCommand(in.Command, in.KeepAlive)
+	}
 	execCmdresp, err := e.client.ExecuteCommand(&ecs.ExecuteCommandInput{
 		Cluster:     aws.String(in.Cluster),
-		Command:     aws.String(in.Command),
+		Command:     aws.String(command),
 		Container:   aws.String(in.Container),
 		Interactive: aws.Bool(true),
 		Task:        aws.String(in.Task),
@@ -400,11 +452,39 @@ func (e *ECS) ExecuteCommand(in ExecuteCommandInput) (err error) {
 	if err != nil {
 		return &ErrExecuteCommand{err: err}
 	}
-	sessID := aws.StringValue(execCmdresp.Session.SessionId)
-	if err = e.newSessStarter().StartSession(execCmdresp.Session); err != nil {
-		err = fmt.Errorf("start session %s using ssm plugin: %w", sessID, err)
+	sess := execCmdresp.Session
+	sessID := aws.StringValue(sess.SessionId)
+	for attempt := 1; ; attempt++ {
+		err := e.newSessStarter().StartSession(sess)
+		if err == nil {
+			return nil
+		}
+		if attempt > in.Reconnect {
+			return fmt.Errorf("start session %s using ssm plugin: %w", sessID, err)
+		}
+		// The plugin exits with an error when the connection drops, so try to resume the same session with exponential backoff.
+		log.Warningf("Lost the connection to session %s, reconnecting (attempt %d of %d).\n", sessID, attempt, in.Reconnect)
+		time.Sleep(e.reconnectDelay * time.Duration(1<<(attempt-1)))
+		out, resumeErr := e.sessResumer.ResumeSession(&ssm.ResumeSessionInput{
+			SessionId: aws.String(sessID),
+		})
+		if resumeErr != nil {
+			return fmt.Errorf("resume session %s after it was disconnected: %w", sessID, resumeErr)
+		}
+		sess = &ecs.Session{
+			SessionId:  out.SessionId,
+			StreamUrl:  out.StreamUrl,
+			TokenValue: out.TokenValue,
+		}
 	}
-	return err
+}
+
+// keepAliveCommand wraps the command so that a background loop writes a null byte, which terminals don't display,
+// to the session at every interval for as long as the command runs. The container must have a "/bin/sh" shell.
+func keepAliveCommand(command string, interval time.Duration) string {
+	loop := fmt.Sprintf(`(while sleep %d && kill -0 $$ 2>/dev/null; do printf "\0"; done) & exec %s`,
+		int(interval.Seconds()), command)
+	return fmt.Sprintf("/bin/sh -c '%s'", strings.ReplaceAll(loop, "'", `'\''`))
 }
 
 // NetworkConfiguration returns the network configuration of a service.
@@ -7,11 +7,13 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -716,6 +718,78 @@ func TestECS_StopTasks(t *testing.T) {
 	}
 }
 
+func TestECS_Cluster(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantedCluster *Cluster
+		wantedError   error
+	}{
+		"return the cluster": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().
+					DescribeClusters(&ecs.DescribeClustersInput{
+						Clusters: aws.StringSlice([]string{"shared"}),
+					}).
+					Return(&ecs.DescribeClustersOutput{
+						Clusters: []*ecs.Cluster{
+							{
+								ClusterArn:        aws.String("arn:aws:ecs:us-east-1:0123456:cluster/shared"),
+								ClusterName:       aws.String("shared"),
+								Status:            aws.String(clusterStatusActive),
+								CapacityProviders: aws.StringSlice([]string{"FARGATE", "FARGATE_SPOT"}),
+							},
+						},
+					}, nil)
+			},
+			wantedCluster: &Cluster{
+				ARN:               "arn:aws:ecs:us-east-1:0123456:cluster/shared",
+				Name:              "shared",
+				Status:            clusterStatusActive,
+				CapacityProviders: []string{"FARGATE", "FARGATE_SPOT"},
+			},
+		},
+		"error if the cluster doesn't exist": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().
+					DescribeClusters(gomock.Any()).
+					Return(&ecs.DescribeClustersOutput{}, nil)
+			},
+			wantedError: errors.New("cluster shared not found"),
+		},
+		"error if fail to describe the cluster": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().
+					DescribeClusters(gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe cluster shared: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			ecs := ECS{
+				client: mockECSClient,
+			}
+
+			cluster, err := ecs.Cluster("shared")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedCluster, cluster)
+		})
+	}
+}
+
 func TestECS_DefaultCluster(t *testing.T) {
 	testCases := map[string]struct {
 		mockECSClient func(m *mocks.Mockapi)
@@ -1164,8 +1238,10 @@ func TestECS_ExecuteCommand(t *testing.T) {
 	}
 	mockErr := errors.New("some error")
 	testCases := map[string]struct {
+		inReconnect     int
 		mockAPI         func(m *mocks.Mockapi)
 		mockSessStarter func(m *mocks.MockssmSessionStarter)
+		mockSessResumer func(m *mocks.MockssmSessionResumer)
 		wantedError     error
 	}{
 		"return error if fail to call ExecuteCommand": {
@@ -1192,6 +1268,67 @@ func TestECS_ExecuteCommand(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("start session mockSessID using ssm plugin: some error"),
 		},
+		"return error if fail to resume the session": {
+			inReconnect: 3,
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ExecuteCommand(mockExecCmdIn).Return(&ecs.ExecuteCommandOutput{
+					Session: mockSess,
+				}, nil)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartSession(mockSess).Return(mockErr)
+			},
+			mockSessResumer: func(m *mocks.MockssmSessionResumer) {
+				m.EXPECT().ResumeSession(&ssm.ResumeSessionInput{
+					SessionId: aws.String("mockSessID"),
+				}).Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("resume session mockSessID after it was disconnected: some error"),
+		},
+		"return error if the session keeps disconnecting": {
+			inReconnect: 1,
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ExecuteCommand(mockExecCmdIn).Return(&ecs.ExecuteCommandOutput{
+					Session: mockSess,
+				}, nil)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartSession(gomock.Any()).Return(mockErr).Times(2)
+			},
+			mockSessResumer: func(m *mocks.MockssmSessionResumer) {
+				m.EXPECT().ResumeSession(gomock.Any()).Return(&ssm.ResumeSessionOutput{
+					SessionId: aws.String("mockSessID"),
+				}, nil)
+			},
+			wantedError: fmt.Errorf("start session mockSessID using ssm plugin: some error"),
+		},
+		"success after resuming the session": {
+			inReconnect: 3,
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ExecuteCommand(mockExecCmdIn).Return(&ecs.ExecuteCommandOutput{
+					Session: mockSess,
+				}, nil)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {
+				gomock.InOrder(
+					m.EXPECT().StartSession(mockSess).Return(mockErr),
+					m.EXPECT().StartSession(&ecs.Session{
+						SessionId:  aws.String("mockSessID"),
+						StreamUrl:  aws.String("mockStreamURL"),
+						TokenValue: aws.String("mockToken"),
+					}).Return(nil),
+				)
+			},
+			mockSessResumer: func(m *mocks.MockssmSessionResumer) {
+				m.EXPECT().ResumeSession(&ssm.ResumeSessionInput{
+					SessionId: aws.String("mockSessID"),
+				}).Return(&ssm.ResumeSessionOutput{
+					SessionId:  aws.String("mockSessID"),
+					StreamUrl:  aws.String("mockStreamURL"),
+					TokenValue: aws.String("mockToken"),
+				}, nil)
+			},
+		},
 		"success": {
 			mockAPI: func(m *mocks.Mockapi) {
 				m.EXPECT().ExecuteCommand(mockExecCmdIn).Return(&ecs.ExecuteCommandOutput{
@@ -1211,14 +1348,19 @@ func TestECS_ExecuteCommand(t *testing.T) {
 
 			mockAPI := mocks.NewMockapi(ctrl)
 			mockSessStarter := mocks.NewMockssmSessionStarter(ctrl)
+			mockSessResumer := mocks.NewMockssmSessionResumer(ctrl)
 			tc.mockAPI(mockAPI)
 			tc.mockSessStarter(mockSessStarter)
+			if tc.mockSessResumer != nil {
+				tc.mockSessResumer(mockSessResumer)
+			}
 
 			ecs := ECS{
 				client: mockAPI,
 				newSessStarter: func() ssmSessionStarter {
 					return mockSessStarter
 				},
+				sessResumer: mockSessResumer,
 			}
 
 			err := ecs.ExecuteCommand(ExecuteCommandInput{
@@ -1226,6 +1368,7 @@ func TestECS_ExecuteCommand(t *testing.T) {
 				Command:   "mockCommand",
 				Container: "mockContainer",
 				Task:      "mockTask",
+				Reconnect: tc.inReconnect,
 			})
 			if tc.wantedError != nil {
 				require.EqualError(t, err, tc.wantedError.Error())
@@ -1236,6 +1379,15 @@ func TestECS_ExecuteCommand(t *testing.T) {
 	}
 }
 
+func TestKeepAliveCommand(t *testing.T) {
+	require.Equal(t,
+		`/bin/sh -c '(while sleep 60 && kill -0 $$ 2>/dev/null; do printf "\0"; done) & exec /bin/sh'`,
+		keepAliveCommand("/bin/sh", time.Minute))
+	require.Equal(t,
+		`/bin/sh -c '(while sleep 30 && kill -0 $$ 2>/dev/null; do printf "\0"; done) & exec echo '\''hi'\'''`,
+		keepAliveCommand("echo 'hi'", 30*time.Second))
+}
+
 func TestECS_NetworkConfiguration(t *testing.T) {
 	testCases := map[string]struct {
 		mockAPI func(m *mocks.Mockapi)
@@ -8,6 +8,7 @@ import (
 	reflect "reflect"
 
 	ecs "github.com/aws/aws-sdk-go/service/ecs"
+	ssm "github.com/aws/aws-sdk-go/service/ssm"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -219,3 +220,41 @@ func (mr *MockssmSessionStarterMockRecorder) StartSession(ssmSession interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSession", reflect.TypeOf((*MockssmSessionStarter)(nil).StartSession), ssmSession)
 }
+
+// MockssmSessionResumer is a mock of ssmSessionResumer interface.
+type MockssmSessionResumer struct {
+	ctrl     *gomock.Controller
+	recorder *MockssmSessionResumerMockRecorder
+}
+
+// MockssmSessionResumerMockRecorder is the mock recorder for MockssmSessionResumer.
+type MockssmSessionResumerMockRecorder struct {
+	mock *MockssmSessionResumer
+}
+
+// NewMockssmSessionResumer creates a new mock instance.
+func NewMockssmSessionResumer(ctrl *gomock.Controller) *MockssmSessionResumer {
+	mock := &MockssmSessionResumer{ctrl: ctrl}
+	mock.recorder = &MockssmSessionResumerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockssmSessionResumer) EXPECT() *MockssmSessionResumerMockRecorder {
+	return m.recorder
+}
+
+// ResumeSession mocks base method.
+func (m *MockssmSessionResumer) ResumeSession(input *ssm.ResumeSessionInput) (*ssm.ResumeSessionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeSession", input)
+	ret0, _ := ret[0].(*ssm.ResumeSessionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeSession indicates an expected call of ResumeSession.
+func (mr *MockssmSessionResumerMockRecorder) ResumeSession(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeSession", reflect.TypeOf((*MockssmSessionResumer)(nil).ResumeSession), input)
+}
@@ -6,6 +6,7 @@ package ecs
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -70,6 +71,32 @@ func (s *Service) ServiceStatus() ServiceStatus {
 	}
 }
 
+// ServiceEvent is an event of a service, such as a task that couldn't be placed or the service reaching a steady state.
+type ServiceEvent struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	Message   string    `json:"message"`
+}
+
+// RecentEvents returns up to limit of the most recent events of the service, newest first.
+func (s *Service) RecentEvents(limit int) []ServiceEvent {
+	var events []ServiceEvent
+	for _, event := range s.Events {
+		events = append(events, ServiceEvent{
+			ID:        aws.StringValue(event.Id),
+			CreatedAt: aws.TimeValue(event.CreatedAt),
+			Message:   aws.StringValue(event.Message),
+		})
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].CreatedAt.After(events[j].CreatedAt)
+	})
+	if len(events) > limit {
+		events = events[:limit]
+	}
+	return events
+}
+
 // TargetGroups returns the ARNs of target groups attached to the service.
 func (s *Service) TargetGroups() []string {
 	var targetGroupARNs []string
@@ -93,3 +93,40 @@ func TestService_ServiceStatus(t *testing.T) {
 		require.Equal(t, got, wanted)
 	})
 }
+
+func TestService_RecentEvents(t *testing.T) {
+	t.Run("should return the most recent events first up to the limit", func(t *testing.T) {
+		s := Service{
+			Events: []*ecs.ServiceEvent{
+				{
+					Id:        aws.String("1"),
+					CreatedAt: aws.Time(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+					Message:   aws.String("(service api) has started 1 tasks: (task 1234)."),
+				},
+				{
+					Id:        aws.String("3"),
+					CreatedAt: aws.Time(time.Date(2020, 1, 1, 0, 2, 0, 0, time.UTC)),
+					Message:   aws.String("(service api) has reached a steady state."),
+				},
+				{
+					Id:        aws.String("2"),
+					CreatedAt: aws.Time(time.Date(2020, 1, 1, 0, 1, 0, 0, time.UTC)),
+					Message:   aws.String("(service api) registered 1 targets in (target-group tg)"),
+				},
+			},
+		}
+		got := s.RecentEvents(2)
+		require.Equal(t, []ServiceEvent{
+			{
+				ID:        "3",
+				CreatedAt: time.Date(2020, 1, 1, 0, 2, 0, 0, time.UTC),
+				Message:   "(service api) has reached a steady state.",
+			},
+			{
+				ID:        "2",
+				CreatedAt: time.Date(2020, 1, 1, 0, 1, 0, 0, time.UTC),
+				Message:   "(service api) registered 1 targets in (target-group tg)",
+			},
+		}, got)
+	})
+}
@@ -79,22 +79,34 @@ func (t *Task) TaskStatus() (*TaskStatus, error) {
 		stoppedReason = aws.StringValue(t.StoppedReason)
 	}
 	var images []Image
+	var stoppedContainers []StoppedContainer
 	for _, container := range t.Containers {
 		images = append(images, Image{
 			ID:     aws.StringValue(container.Image),
 			Digest: imageDigestValue(aws.StringValue(container.ImageDigest)),
 		})
+		if aws.StringValue(container.LastStatus) != ecs.DesiredStatusStopped {
+			continue
+		}
+		stoppedContainers = append(stoppedContainers, StoppedContainer{
+			Name:     aws.StringValue(container.Name),
+			Image:    aws.StringValue(container.Image),
+			ExitCode: container.ExitCode,
+			Reason:   aws.StringValue(container.Reason),
+		})
 	}
 	return &TaskStatus{
-		Health:           aws.StringValue(t.HealthStatus),
-		ID:               taskID,
-		Images:           images,
-		LastStatus:       aws.StringValue(t.LastStatus),
-		StartedAt:        startedAt,
-		StoppedAt:        stoppedAt,
-		StoppedReason:    stoppedReason,
-		CapacityProvider: aws.StringValue(t.CapacityProviderName),
-		TaskDefinition:   aws.StringValue(t.TaskDefinitionArn),
+		Health:            aws.StringValue(t.HealthStatus),
+		ID:                taskID,
+		Images:            images,
+		LastStatus:        aws.StringValue(t.LastStatus),
+		StartedAt:         startedAt,
+		StoppedAt:         stoppedAt,
+		StoppedReason:     stoppedReason,
+		StopCode:          aws.StringValue(t.StopCode),
+		StoppedContainers: stoppedContainers,
+		CapacityProvider:  aws.StringValue(t.CapacityProviderName),
+		TaskDefinition:    aws.StringValue(t.TaskDefinitionArn),
 	}, nil
 }
 
@@ -164,6 +176,19 @@ type TaskStatus struct {
 	StoppedReason    string    `json:"stoppedReason"`
 	CapacityProvider string    `json:"capacityProvider"`
 	TaskDefinition   string    `json:"taskDefinitionARN"`
+
+	// StopCode and StoppedContainers explain why a stopped task stopped.
+	StopCode          string             `json:"stopCode,omitempty"`
+	StoppedContainers []StoppedContainer `json:"stoppedContainers,omitempty"`
+}
+
+// StoppedContainer contains the exit code and the reason of a container that stopped.
+// The exit code is nil if the container never ran, for example if its image couldn't be pulled.
+type StoppedContainer struct {
+	Name     string `json:"name"`
+	Image    string `json:"image,omitempty"`
+	ExitCode *int64 `json:"exitCode,omitempty"`
+	Reason   string `json:"reason,omitempty"`
 }
 
 // TaskDefinition wraps up ECS TaskDefinition struct.
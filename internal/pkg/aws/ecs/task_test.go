@@ -27,6 +27,7 @@ func TestTask_TaskStatus(t *testing.T) {
 		startedAt     time.Time
 		stoppedAt     time.Time
 		stoppedReason *string
+		stopCode      *string
 
 		wantTaskStatus *TaskStatus
 		wantErr        error
@@ -112,6 +113,55 @@ func TestTask_TaskStatus(t *testing.T) {
 				StoppedReason: "some reason",
 			},
 		},
+		"success with a stopped task whose container ran out of memory": {
+			taskArn: aws.String("arn:aws:ecs:us-west-2:123456789:task/my-project-test-Cluster-9F7Y0RLP60R7/4082490ee6c245e09d2145010aa1ba8d"),
+			containers: []*ecs.Container{
+				{
+					Name:       aws.String("web"),
+					Image:      aws.String("mockImageArn"),
+					LastStatus: aws.String("STOPPED"),
+					ExitCode:   aws.Int64(137),
+					Reason:     aws.String("OutOfMemoryError: Container killed due to memory usage"),
+				},
+				{
+					Name:       aws.String("firelens_log_router"),
+					Image:      aws.String("mockSidecarImageArn"),
+					LastStatus: aws.String("STOPPED"),
+				},
+			},
+			lastStatus:    aws.String("STOPPED"),
+			stoppedAt:     stopTime,
+			stoppedReason: aws.String("Essential container in task exited"),
+			stopCode:      aws.String("EssentialContainerExited"),
+
+			wantTaskStatus: &TaskStatus{
+				ID: "4082490ee6c245e09d2145010aa1ba8d",
+				Images: []Image{
+					{
+						ID: "mockImageArn",
+					},
+					{
+						ID: "mockSidecarImageArn",
+					},
+				},
+				LastStatus:    "STOPPED",
+				StoppedAt:     stopTime,
+				StoppedReason: "Essential container in task exited",
+				StopCode:      "EssentialContainerExited",
+				StoppedContainers: []StoppedContainer{
+					{
+						Name:     "web",
+						Image:    "mockImageArn",
+						ExitCode: aws.Int64(137),
+						Reason:   "OutOfMemoryError: Container killed due to memory usage",
+					},
+					{
+						Name:  "firelens_log_router",
+						Image: "mockSidecarImageArn",
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -128,6 +178,7 @@ func TestTask_TaskStatus(t *testing.T) {
 				StartedAt:     &tc.startedAt,
 				StoppedAt:     &tc.stoppedAt,
 				StoppedReason: tc.stoppedReason,
+				StopCode:      tc.stopCode,
 			}
 
 			gotTaskStatus, gotErr := task.TaskStatus()
@@ -21,6 +21,7 @@ const (
 type api interface {
 	DescribeTargetHealth(input *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error)
 	DescribeRules(input *elbv2.DescribeRulesInput) (*elbv2.DescribeRulesOutput, error)
+	DescribeListeners(input *elbv2.DescribeListenersInput) (*elbv2.DescribeListenersOutput, error)
 }
 
 // ELBV2 wraps an AWS ELBV2 client.
@@ -73,6 +74,132 @@ func (e *ELBV2) ListenerRuleHostHeaders(ruleARN string) ([]string, error) {
 	return hostHeaders, nil
 }
 
+// ListenerRule is the host headers and path patterns of a listener rule, and the target groups it forwards traffic to.
+type ListenerRule struct {
+	ARN             string
+	HostHeaders     []string // Sorted host names, empty if the rule matches any host.
+	PathPatterns    []string // Path patterns in the order of the rule condition, such as "/svc" and "/svc/*".
+	TargetGroupARNs []string // Empty if the rule doesn't forward traffic, for example if it redirects HTTP to HTTPS.
+}
+
+// ListenerRules returns the listener rules with the given ARNs in the same order as the ARNs.
+func (e *ELBV2) ListenerRules(ruleARNs ...string) ([]*ListenerRule, error) {
+	resp, err := e.client.DescribeRules(&elbv2.DescribeRulesInput{
+		RuleArns: aws.StringSlice(ruleARNs),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe listener rules %v: %w", ruleARNs, err)
+	}
+	byARN := make(map[string]*elbv2.Rule, len(resp.Rules))
+	for _, rule := range resp.Rules {
+		byARN[aws.StringValue(rule.RuleArn)] = rule
+	}
+	rules := make([]*ListenerRule, len(ruleARNs))
+	for i, arn := range ruleARNs {
+		rule, ok := byARN[arn]
+		if !ok {
+			return nil, fmt.Errorf("cannot find listener rule %s", arn)
+		}
+		rules[i] = &ListenerRule{
+			ARN:             arn,
+			HostHeaders:     ruleHostHeaders(rule),
+			PathPatterns:    rulePathPatterns(rule),
+			TargetGroupARNs: ruleTargetGroupARNs(rule),
+		}
+	}
+	return rules, nil
+}
+
+func ruleHostHeaders(rule *elbv2.Rule) []string {
+	set := make(map[string]bool)
+	for _, condition := range rule.Conditions {
+		if aws.StringValue(condition.Field) != "host-header" {
+			continue
+		}
+		for _, value := range condition.Values {
+			set[aws.StringValue(value)] = true
+		}
+		if condition.HostHeaderConfig != nil {
+			for _, value := range condition.HostHeaderConfig.Values {
+				set[aws.StringValue(value)] = true
+			}
+		}
+	}
+	var hosts []string
+	for host := range set {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	return hosts
+}
+
+func rulePathPatterns(rule *elbv2.Rule) []string {
+	var patterns []string
+	for _, condition := range rule.Conditions {
+		if aws.StringValue(condition.Field) != "path-pattern" {
+			continue
+		}
+		// Like for host headers, Values is the legacy field that allowed only a single pattern.
+		patterns = append(patterns, aws.StringValueSlice(condition.Values)...)
+		if condition.PathPatternConfig != nil {
+			patterns = append(patterns, aws.StringValueSlice(condition.PathPatternConfig.Values)...)
+		}
+	}
+	return patterns
+}
+
+func ruleTargetGroupARNs(rule *elbv2.Rule) []string {
+	var arns []string
+	for _, action := range rule.Actions {
+		if aws.StringValue(action.Type) != elbv2.ActionTypeEnumForward {
+			continue
+		}
+		if action.TargetGroupArn != nil {
+			arns = append(arns, aws.StringValue(action.TargetGroupArn))
+			continue
+		}
+		if action.ForwardConfig == nil {
+			continue
+		}
+		for _, tg := range action.ForwardConfig.TargetGroups {
+			arns = append(arns, aws.StringValue(tg.TargetGroupArn))
+		}
+	}
+	return arns
+}
+
+// Listener is the port and protocol on which a load balancer listens for connections.
+type Listener struct {
+	ARN      string
+	Port     int64
+	Protocol string // One of "HTTP", "HTTPS", "TCP", "TLS", "UDP", or "TCP_UDP".
+}
+
+// Listeners returns the listeners with the given ARNs sorted by port and protocol.
+func (e *ELBV2) Listeners(listenerARNs ...string) ([]*Listener, error) {
+	resp, err := e.client.DescribeListeners(&elbv2.DescribeListenersInput{
+		ListenerArns: aws.StringSlice(listenerARNs),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe listeners %v: %w", listenerARNs, err)
+	}
+	listeners := make([]*Listener, len(resp.Listeners))
+	for i, listener := range resp.Listeners {
+		listeners[i] = &Listener{
+			ARN:      aws.StringValue(listener.ListenerArn),
+			Port:     aws.Int64Value(listener.Port),
+			Protocol: aws.StringValue(listener.Protocol),
+		}
+	}
+	sort.SliceStable(listeners, func(i, j int) bool {
+		if listeners[i].Port != listeners[j].Port {
+			return listeners[i].Port < listeners[j].Port
+		}
+		return listeners[i].Protocol < listeners[j].Protocol
+	})
+	return listeners, nil
+}
+
 // TargetHealth wraps up elbv2.TargetHealthDescription.
 type TargetHealth elbv2.TargetHealthDescription
 
@@ -219,6 +219,203 @@ func TestELBV2_ListenerRuleHostHeaders(t *testing.T) {
 	}
 }
 
+func TestELBV2_Listeners(t *testing.T) {
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		wanted      []*Listener
+		wantedError error
+	}{
+		"fail to describe listeners": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeListeners(&elbv2.DescribeListenersInput{
+					ListenerArns: aws.StringSlice([]string{"listener1", "listener2"}),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe listeners [listener1 listener2]: some error"),
+		},
+		"success": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeListeners(&elbv2.DescribeListenersInput{
+					ListenerArns: aws.StringSlice([]string{"listener1", "listener2"}),
+				}).Return(&elbv2.DescribeListenersOutput{
+					Listeners: []*elbv2.Listener{
+						{
+							ListenerArn: aws.String("listener1"),
+							Port:        aws.Int64(8080),
+							Protocol:    aws.String("TCP"),
+						},
+						{
+							ListenerArn: aws.String("listener2"),
+							Port:        aws.Int64(53),
+							Protocol:    aws.String("UDP"),
+						},
+					},
+				}, nil)
+			},
+			wanted: []*Listener{
+				{
+					ARN:      "listener2",
+					Port:     53,
+					Protocol: "UDP",
+				},
+				{
+					ARN:      "listener1",
+					Port:     8080,
+					Protocol: "TCP",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			got, err := elbv2Client.Listeners("listener1", "listener2")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestELBV2_ListenerRules(t *testing.T) {
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		wanted      []*ListenerRule
+		wantedError error
+	}{
+		"fail to describe rules": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					RuleArns: aws.StringSlice([]string{"rule1", "rule2"}),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe listener rules [rule1 rule2]: some error"),
+		},
+		"fail if a rule is missing": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(gomock.Any()).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							RuleArn: aws.String("rule1"),
+						},
+					},
+				}, nil)
+			},
+			wantedError: fmt.Errorf("cannot find listener rule rule2"),
+		},
+		"success": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					RuleArns: aws.StringSlice([]string{"rule1", "rule2"}),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							RuleArn: aws.String("rule2"),
+							Conditions: []*elbv2.RuleCondition{
+								{
+									Field:  aws.String("path-pattern"),
+									Values: aws.StringSlice([]string{"/*"}),
+								},
+							},
+							Actions: []*elbv2.Action{
+								{
+									Type: aws.String(elbv2.ActionTypeEnumRedirect),
+								},
+							},
+						},
+						{
+							RuleArn: aws.String("rule1"),
+							Conditions: []*elbv2.RuleCondition{
+								{
+									Field: aws.String("host-header"),
+									HostHeaderConfig: &elbv2.HostHeaderConditionConfig{
+										Values: aws.StringSlice([]string{"svc.internal", "internal-lb.us-west-2.elb.amazonaws.com"}),
+									},
+								},
+								{
+									Field: aws.String("path-pattern"),
+									PathPatternConfig: &elbv2.PathPatternConditionConfig{
+										Values: aws.StringSlice([]string{"/api", "/api/*"}),
+									},
+								},
+							},
+							Actions: []*elbv2.Action{
+								{
+									Type:           aws.String(elbv2.ActionTypeEnumForward),
+									TargetGroupArn: aws.String("tg1"),
+								},
+								{
+									Type: aws.String(elbv2.ActionTypeEnumForward),
+									ForwardConfig: &elbv2.ForwardActionConfig{
+										TargetGroups: []*elbv2.TargetGroupTuple{
+											{
+												TargetGroupArn: aws.String("tg2"),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: []*ListenerRule{
+				{
+					ARN:             "rule1",
+					HostHeaders:     []string{"internal-lb.us-west-2.elb.amazonaws.com", "svc.internal"},
+					PathPatterns:    []string{"/api", "/api/*"},
+					TargetGroupARNs: []string{"tg1", "tg2"},
+				},
+				{
+					ARN:          "rule2",
+					PathPatterns: []string{"/*"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			got, err := elbv2Client.ListenerRules("rule1", "rule2")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
 func TestTargetHealth_HealthStatus(t *testing.T) {
 	testCases := map[string]struct {
 		inTargetHealth *TargetHealth
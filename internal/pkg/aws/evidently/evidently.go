@@ -0,0 +1,144 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package evidently provides a client to make API requests to Amazon CloudWatch Evidently.
+package evidently
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+)
+
+type api interface {
+	ListFeatures(input *cloudwatchevidently.ListFeaturesInput) (*cloudwatchevidently.ListFeaturesOutput, error)
+	ListLaunches(input *cloudwatchevidently.ListLaunchesInput) (*cloudwatchevidently.ListLaunchesOutput, error)
+	StartLaunch(input *cloudwatchevidently.StartLaunchInput) (*cloudwatchevidently.StartLaunchOutput, error)
+	StopLaunch(input *cloudwatchevidently.StopLaunchInput) (*cloudwatchevidently.StopLaunchOutput, error)
+}
+
+// Evidently wraps an Amazon CloudWatch Evidently client.
+type Evidently struct {
+	client api
+}
+
+// New returns an Evidently client configured against the input session.
+func New(s *session.Session) *Evidently {
+	return &Evidently{
+		client: cloudwatchevidently.New(s),
+	}
+}
+
+// Feature is a feature flag in an Evidently project.
+type Feature struct {
+	Name             string
+	Status           string // One of "AVAILABLE" or "UPDATING".
+	DefaultVariation string // Variation served to users that aren't part of a launch.
+}
+
+// Launch gradually rolls out variations of features in an Evidently project to a share of the users.
+type Launch struct {
+	Name     string
+	Status   string   // One of "CREATED", "UPDATING", "RUNNING", "COMPLETED", or "CANCELLED".
+	Features []string // Names of the features rolled out by the launch.
+}
+
+// Features returns the features of an Evidently project.
+// The project can be identified by its name or its ARN.
+func (e *Evidently) Features(project string) ([]Feature, error) {
+	var features []Feature
+	var nextToken *string
+	for {
+		out, err := e.client.ListFeatures(&cloudwatchevidently.ListFeaturesInput{
+			Project:   aws.String(project),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list features of project %s: %w", project, err)
+		}
+		for _, feature := range out.Features {
+			features = append(features, Feature{
+				Name:             aws.StringValue(feature.Name),
+				Status:           aws.StringValue(feature.Status),
+				DefaultVariation: aws.StringValue(feature.DefaultVariation),
+			})
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	return features, nil
+}
+
+// Launches returns the launches of an Evidently project.
+// The project can be identified by its name or its ARN.
+func (e *Evidently) Launches(project string) ([]Launch, error) {
+	var launches []Launch
+	var nextToken *string
+	for {
+		out, err := e.client.ListLaunches(&cloudwatchevidently.ListLaunchesInput{
+			Project:   aws.String(project),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list launches of project %s: %w", project, err)
+		}
+		for _, launch := range out.Launches {
+			launches = append(launches, Launch{
+				Name:     aws.StringValue(launch.Name),
+				Status:   aws.StringValue(launch.Status),
+				Features: launchFeatures(launch.Groups),
+			})
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	return launches, nil
+}
+
+// launchFeatures returns the sorted names of the features that are varied by the groups of a launch.
+func launchFeatures(groups []*cloudwatchevidently.LaunchGroup) []string {
+	seen := make(map[string]bool)
+	var features []string
+	for _, group := range groups {
+		for feature := range group.FeatureVariations {
+			if seen[feature] {
+				continue
+			}
+			seen[feature] = true
+			features = append(features, feature)
+		}
+	}
+	sort.Strings(features)
+	return features
+}
+
+// StartLaunch starts a launch of an Evidently project.
+func (e *Evidently) StartLaunch(project, launch string) error {
+	if _, err := e.client.StartLaunch(&cloudwatchevidently.StartLaunchInput{
+		Project: aws.String(project),
+		Launch:  aws.String(launch),
+	}); err != nil {
+		return fmt.Errorf("start launch %s of project %s: %w", launch, project, err)
+	}
+	return nil
+}
+
+// StopLaunch stops a running launch of an Evidently project, and marks it as completed.
+// Users that were part of the launch are served the default variation of its features again.
+func (e *Evidently) StopLaunch(project, launch string) error {
+	if _, err := e.client.StopLaunch(&cloudwatchevidently.StopLaunchInput{
+		Project:      aws.String(project),
+		Launch:       aws.String(launch),
+		DesiredState: aws.String(cloudwatchevidently.LaunchStopDesiredStateCompleted),
+	}); err != nil {
+		return fmt.Errorf("stop launch %s of project %s: %w", launch, project, err)
+	}
+	return nil
+}
@@ -0,0 +1,278 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package evidently
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	"github.com/aws/copilot-cli/internal/pkg/aws/evidently/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const mockProject = "arn:aws:evidently:us-west-2:123456789012:project/phonetool-test-frontend"
+
+func TestEvidently_Features(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedFeatures []Feature
+		wantedError    error
+	}{
+		"fail to list features": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListFeatures(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list features of project " + mockProject + ": some error"),
+		},
+		"return paginated features": {
+			mockClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().ListFeatures(&cloudwatchevidently.ListFeaturesInput{
+						Project: aws.String(mockProject),
+					}).Return(&cloudwatchevidently.ListFeaturesOutput{
+						Features: []*cloudwatchevidently.FeatureSummary{
+							{
+								Name:             aws.String("dark-mode"),
+								Status:           aws.String("AVAILABLE"),
+								DefaultVariation: aws.String("on"),
+							},
+						},
+						NextToken: aws.String("token"),
+					}, nil),
+					m.EXPECT().ListFeatures(&cloudwatchevidently.ListFeaturesInput{
+						Project:   aws.String(mockProject),
+						NextToken: aws.String("token"),
+					}).Return(&cloudwatchevidently.ListFeaturesOutput{
+						Features: []*cloudwatchevidently.FeatureSummary{
+							{
+								Name:             aws.String("new-checkout"),
+								Status:           aws.String("UPDATING"),
+								DefaultVariation: aws.String("off"),
+							},
+						},
+					}, nil),
+				)
+			},
+			wantedFeatures: []Feature{
+				{
+					Name:             "dark-mode",
+					Status:           "AVAILABLE",
+					DefaultVariation: "on",
+				},
+				{
+					Name:             "new-checkout",
+					Status:           "UPDATING",
+					DefaultVariation: "off",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			e := &Evidently{client: m}
+
+			// WHEN
+			features, err := e.Features(mockProject)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedFeatures, features)
+		})
+	}
+}
+
+func TestEvidently_Launches(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedLaunches []Launch
+		wantedError    error
+	}{
+		"fail to list launches": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListLaunches(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list launches of project " + mockProject + ": some error"),
+		},
+		"return paginated launches with the features they roll out": {
+			mockClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().ListLaunches(&cloudwatchevidently.ListLaunchesInput{
+						Project: aws.String(mockProject),
+					}).Return(&cloudwatchevidently.ListLaunchesOutput{
+						Launches: []*cloudwatchevidently.Launch{
+							{
+								Name:   aws.String("checkout-rollout"),
+								Status: aws.String("RUNNING"),
+								Groups: []*cloudwatchevidently.LaunchGroup{
+									{
+										Name: aws.String("control"),
+										FeatureVariations: aws.StringMap(map[string]string{
+											"new-checkout": "off",
+											"dark-mode":    "off",
+										}),
+									},
+									{
+										Name: aws.String("treatment"),
+										FeatureVariations: aws.StringMap(map[string]string{
+											"new-checkout": "on",
+										}),
+									},
+								},
+							},
+						},
+						NextToken: aws.String("token"),
+					}, nil),
+					m.EXPECT().ListLaunches(&cloudwatchevidently.ListLaunchesInput{
+						Project:   aws.String(mockProject),
+						NextToken: aws.String("token"),
+					}).Return(&cloudwatchevidently.ListLaunchesOutput{
+						Launches: []*cloudwatchevidently.Launch{
+							{
+								Name:   aws.String("dark-mode-beta"),
+								Status: aws.String("CREATED"),
+							},
+						},
+					}, nil),
+				)
+			},
+			wantedLaunches: []Launch{
+				{
+					Name:     "checkout-rollout",
+					Status:   "RUNNING",
+					Features: []string{"dark-mode", "new-checkout"},
+				},
+				{
+					Name:   "dark-mode-beta",
+					Status: "CREATED",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			e := &Evidently{client: m}
+
+			// WHEN
+			launches, err := e.Launches(mockProject)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedLaunches, launches)
+		})
+	}
+}
+
+func TestEvidently_StartLaunch(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedError error
+	}{
+		"fail to start the launch": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartLaunch(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("start launch checkout-rollout of project " + mockProject + ": some error"),
+		},
+		"start the launch": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartLaunch(&cloudwatchevidently.StartLaunchInput{
+					Project: aws.String(mockProject),
+					Launch:  aws.String("checkout-rollout"),
+				}).Return(&cloudwatchevidently.StartLaunchOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			e := &Evidently{client: m}
+
+			// WHEN
+			err := e.StartLaunch(mockProject, "checkout-rollout")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestEvidently_StopLaunch(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedError error
+	}{
+		"fail to stop the launch": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StopLaunch(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("stop launch checkout-rollout of project " + mockProject + ": some error"),
+		},
+		"stop the launch as completed": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StopLaunch(&cloudwatchevidently.StopLaunchInput{
+					Project:      aws.String(mockProject),
+					Launch:       aws.String("checkout-rollout"),
+					DesiredState: aws.String("COMPLETED"),
+				}).Return(&cloudwatchevidently.StopLaunchOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			e := &Evidently{client: m}
+
+			// WHEN
+			err := e.StopLaunch(mockProject, "checkout-rollout")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/aws/evidently/evidently.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	cloudwatchevidently "github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// Mockapi is a mock of api interface.
+type Mockapi struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiMockRecorder
+}
+
+// MockapiMockRecorder is the mock recorder for Mockapi.
+type MockapiMockRecorder struct {
+	mock *Mockapi
+}
+
+// NewMockapi creates a new mock instance.
+func NewMockapi(ctrl *gomock.Controller) *Mockapi {
+	mock := &Mockapi{ctrl: ctrl}
+	mock.recorder = &MockapiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockapi) EXPECT() *MockapiMockRecorder {
+	return m.recorder
+}
+
+// ListFeatures mocks base method.
+func (m *Mockapi) ListFeatures(input *cloudwatchevidently.ListFeaturesInput) (*cloudwatchevidently.ListFeaturesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFeatures", input)
+	ret0, _ := ret[0].(*cloudwatchevidently.ListFeaturesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFeatures indicates an expected call of ListFeatures.
+func (mr *MockapiMockRecorder) ListFeatures(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeatures", reflect.TypeOf((*Mockapi)(nil).ListFeatures), input)
+}
+
+// ListLaunches mocks base method.
+func (m *Mockapi) ListLaunches(input *cloudwatchevidently.ListLaunchesInput) (*cloudwatchevidently.ListLaunchesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLaunches", input)
+	ret0, _ := ret[0].(*cloudwatchevidently.ListLaunchesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLaunches indicates an expected call of ListLaunches.
+func (mr *MockapiMockRecorder) ListLaunches(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLaunches", reflect.TypeOf((*Mockapi)(nil).ListLaunches), input)
+}
+
+// StartLaunch mocks base method.
+func (m *Mockapi) StartLaunch(input *cloudwatchevidently.StartLaunchInput) (*cloudwatchevidently.StartLaunchOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartLaunch", input)
+	ret0, _ := ret[0].(*cloudwatchevidently.StartLaunchOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartLaunch indicates an expected call of StartLaunch.
+func (mr *MockapiMockRecorder) StartLaunch(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartLaunch", reflect.TypeOf((*Mockapi)(nil).StartLaunch), input)
+}
+
+// StopLaunch mocks base method.
+func (m *Mockapi) StopLaunch(input *cloudwatchevidently.StopLaunchInput) (*cloudwatchevidently.StopLaunchOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopLaunch", input)
+	ret0, _ := ret[0].(*cloudwatchevidently.StopLaunchOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopLaunch indicates an expected call of StopLaunch.
+func (mr *MockapiMockRecorder) StopLaunch(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopLaunch", reflect.TypeOf((*Mockapi)(nil).StopLaunch), input)
+}
@@ -0,0 +1,143 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package health provides a client to make API requests to AWS Health.
+package health
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/health"
+)
+
+const (
+	// The AWS Health API is served from a single global endpoint in us-east-1.
+	globalEndpointRegion = "us-east-1"
+
+	ecsServiceName = "ECS"
+
+	// describeAffectedEntitiesMaxEvents is the maximum number of events whose entities can be described at once.
+	describeAffectedEntitiesMaxEvents = 10
+
+	errCodeSubscriptionRequired = "SubscriptionRequiredException"
+)
+
+// ErrSubscriptionRequired occurs when the account does not have a support plan that gives access to the AWS Health API.
+var ErrSubscriptionRequired = errors.New("the AWS Health API requires a Business, Enterprise On-Ramp, or Enterprise Support plan")
+
+type api interface {
+	DescribeEvents(input *health.DescribeEventsInput) (*health.DescribeEventsOutput, error)
+	DescribeAffectedEntities(input *health.DescribeAffectedEntitiesInput) (*health.DescribeAffectedEntitiesOutput, error)
+}
+
+// Health wraps an AWS Health client.
+type Health struct {
+	client api
+}
+
+// New returns a Health client configured against the input session.
+func New(s *session.Session) *Health {
+	return &Health{
+		client: health.New(s, aws.NewConfig().WithRegion(globalEndpointRegion)),
+	}
+}
+
+// Event is an AWS Health event scheduled by AWS.
+type Event struct {
+	ARN              string
+	TypeCode         string    // For example, AWS_ECS_TASK_PATCHING_RETIREMENT.
+	StatusCode       string    // One of "upcoming" or "open".
+	StartTime        time.Time // When the scheduled change starts.
+	EndTime          time.Time // Zero if the scheduled change has no end time.
+	AffectedEntities []string  // Values of the resources affected by the event, such as task or service ARNs.
+}
+
+// ScheduledECSChanges returns the upcoming and open changes that AWS scheduled for Amazon ECS resources in a region,
+// such as Fargate task retirements and platform version migrations.
+func (h *Health) ScheduledECSChanges(region string) ([]Event, error) {
+	var events []Event
+	var nextToken *string
+	for {
+		out, err := h.client.DescribeEvents(&health.DescribeEventsInput{
+			Filter: &health.EventFilter{
+				Services:            aws.StringSlice([]string{ecsServiceName}),
+				Regions:             aws.StringSlice([]string{region}),
+				EventTypeCategories: aws.StringSlice([]string{health.EventTypeCategoryScheduledChange}),
+				EventStatusCodes:    aws.StringSlice([]string{health.EventStatusCodeUpcoming, health.EventStatusCodeOpen}),
+			},
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, wrapErr(fmt.Errorf("describe scheduled ECS events in region %s: %w", region, err))
+		}
+		for _, event := range out.Events {
+			events = append(events, Event{
+				ARN:        aws.StringValue(event.Arn),
+				TypeCode:   aws.StringValue(event.EventTypeCode),
+				StatusCode: aws.StringValue(event.StatusCode),
+				StartTime:  aws.TimeValue(event.StartTime),
+				EndTime:    aws.TimeValue(event.EndTime),
+			})
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	if err := h.addAffectedEntities(events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (h *Health) addAffectedEntities(events []Event) error {
+	idx := make(map[string]int, len(events))
+	var arns []string
+	for i, event := range events {
+		idx[event.ARN] = i
+		arns = append(arns, event.ARN)
+	}
+	for start := 0; start < len(arns); start += describeAffectedEntitiesMaxEvents {
+		end := start + describeAffectedEntitiesMaxEvents
+		if end > len(arns) {
+			end = len(arns)
+		}
+		var nextToken *string
+		for {
+			out, err := h.client.DescribeAffectedEntities(&health.DescribeAffectedEntitiesInput{
+				Filter: &health.EntityFilter{
+					EventArns: aws.StringSlice(arns[start:end]),
+				},
+				NextToken: nextToken,
+			})
+			if err != nil {
+				return wrapErr(fmt.Errorf("describe entities affected by scheduled ECS events: %w", err))
+			}
+			for _, entity := range out.Entities {
+				i, ok := idx[aws.StringValue(entity.EventArn)]
+				if !ok {
+					continue
+				}
+				events[i].AffectedEntities = append(events[i].AffectedEntities, aws.StringValue(entity.EntityValue))
+			}
+			if out.NextToken == nil {
+				break
+			}
+			nextToken = out.NextToken
+		}
+	}
+	return nil
+}
+
+func wrapErr(err error) error {
+	var aerr awserr.Error
+	if errors.As(err, &aerr) && aerr.Code() == errCodeSubscriptionRequired {
+		return ErrSubscriptionRequired
+	}
+	return err
+}
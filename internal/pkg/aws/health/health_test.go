@@ -0,0 +1,188 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/health"
+	"github.com/aws/copilot-cli/internal/pkg/aws/health/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealth_ScheduledECSChanges(t *testing.T) {
+	const mockRegion = "us-west-2"
+	mockStart := time.Date(2022, time.October, 20, 8, 0, 0, 0, time.UTC)
+	mockEnd := time.Date(2022, time.October, 27, 8, 0, 0, 0, time.UTC)
+	wantedFilter := &health.EventFilter{
+		Services:            aws.StringSlice([]string{"ECS"}),
+		Regions:             aws.StringSlice([]string{mockRegion}),
+		EventTypeCategories: aws.StringSlice([]string{"scheduledChange"}),
+		EventStatusCodes:    aws.StringSlice([]string{"upcoming", "open"}),
+	}
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedEvents []Event
+		wantedError  error
+	}{
+		"fail to describe events": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeEvents(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe scheduled ECS events in region us-west-2: some error"),
+		},
+		"return a friendly error if the account does not have a support plan": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeEvents(gomock.Any()).Return(nil, awserr.New("SubscriptionRequiredException", "not subscribed", nil))
+			},
+			wantedError: ErrSubscriptionRequired,
+		},
+		"fail to describe affected entities": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeEvents(gomock.Any()).Return(&health.DescribeEventsOutput{
+					Events: []*health.Event{
+						{
+							Arn: aws.String("arn1"),
+						},
+					},
+				}, nil)
+				m.EXPECT().DescribeAffectedEntities(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe entities affected by scheduled ECS events: some error"),
+		},
+		"return no events": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeEvents(gomock.Any()).Return(&health.DescribeEventsOutput{}, nil)
+				m.EXPECT().DescribeAffectedEntities(gomock.Any()).Times(0)
+			},
+		},
+		"return paginated events with their affected entities": {
+			mockClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().DescribeEvents(&health.DescribeEventsInput{
+						Filter: wantedFilter,
+					}).Return(&health.DescribeEventsOutput{
+						Events: []*health.Event{
+							{
+								Arn:           aws.String("arn1"),
+								EventTypeCode: aws.String("AWS_ECS_TASK_PATCHING_RETIREMENT"),
+								StatusCode:    aws.String("upcoming"),
+								StartTime:     aws.Time(mockStart),
+								EndTime:       aws.Time(mockEnd),
+							},
+						},
+						NextToken: aws.String("token"),
+					}, nil),
+					m.EXPECT().DescribeEvents(&health.DescribeEventsInput{
+						Filter:    wantedFilter,
+						NextToken: aws.String("token"),
+					}).Return(&health.DescribeEventsOutput{
+						Events: []*health.Event{
+							{
+								Arn:           aws.String("arn2"),
+								EventTypeCode: aws.String("AWS_ECS_PLATFORM_VERSION_RETIREMENT"),
+								StatusCode:    aws.String("open"),
+								StartTime:     aws.Time(mockStart),
+							},
+						},
+					}, nil),
+				)
+				m.EXPECT().DescribeAffectedEntities(&health.DescribeAffectedEntitiesInput{
+					Filter: &health.EntityFilter{
+						EventArns: aws.StringSlice([]string{"arn1", "arn2"}),
+					},
+				}).Return(&health.DescribeAffectedEntitiesOutput{
+					Entities: []*health.AffectedEntity{
+						{
+							EventArn:    aws.String("arn1"),
+							EntityValue: aws.String("arn:aws:ecs:us-west-2:123456789012:task/phonetool-test-Cluster/1234"),
+						},
+						{
+							EventArn:    aws.String("arn2"),
+							EntityValue: aws.String("phonetool-test-api-Service-abcd"),
+						},
+					},
+				}, nil)
+			},
+			wantedEvents: []Event{
+				{
+					ARN:              "arn1",
+					TypeCode:         "AWS_ECS_TASK_PATCHING_RETIREMENT",
+					StatusCode:       "upcoming",
+					StartTime:        mockStart,
+					EndTime:          mockEnd,
+					AffectedEntities: []string{"arn:aws:ecs:us-west-2:123456789012:task/phonetool-test-Cluster/1234"},
+				},
+				{
+					ARN:              "arn2",
+					TypeCode:         "AWS_ECS_PLATFORM_VERSION_RETIREMENT",
+					StatusCode:       "open",
+					StartTime:        mockStart,
+					AffectedEntities: []string{"phonetool-test-api-Service-abcd"},
+				},
+			},
+		},
+		"describe affected entities in batches of ten events": {
+			mockClient: func(m *mocks.Mockapi) {
+				var events []*health.Event
+				for i := 0; i < 11; i++ {
+					events = append(events, &health.Event{
+						Arn: aws.String(fmt.Sprintf("arn%d", i)),
+					})
+				}
+				m.EXPECT().DescribeEvents(gomock.Any()).Return(&health.DescribeEventsOutput{
+					Events: events,
+				}, nil)
+				m.EXPECT().DescribeAffectedEntities(gomock.Any()).DoAndReturn(func(in *health.DescribeAffectedEntitiesInput) (*health.DescribeAffectedEntitiesOutput, error) {
+					require.Len(t, in.Filter.EventArns, 10)
+					return &health.DescribeAffectedEntitiesOutput{}, nil
+				})
+				m.EXPECT().DescribeAffectedEntities(gomock.Any()).DoAndReturn(func(in *health.DescribeAffectedEntitiesInput) (*health.DescribeAffectedEntitiesOutput, error) {
+					require.Equal(t, aws.StringSlice([]string{"arn10"}), in.Filter.EventArns)
+					return &health.DescribeAffectedEntitiesOutput{}, nil
+				})
+			},
+			wantedEvents: func() []Event {
+				var events []Event
+				for i := 0; i < 11; i++ {
+					events = append(events, Event{
+						ARN: fmt.Sprintf("arn%d", i),
+					})
+				}
+				return events
+			}(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := Health{
+				client: m,
+			}
+
+			// WHEN
+			events, err := client.ScheduledECSChanges(mockRegion)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedEvents, events)
+			}
+		})
+	}
+}
@@ -31,6 +31,7 @@ func New(s *session.Session) STS {
 
 // Caller holds information about a calling entity.
 type Caller struct {
+	ARN         string // ARN of the calling entity, such as an IAM user or an assumed role session.
 	RootUserARN string
 	Account     string
 	UserID      string
@@ -48,6 +49,7 @@ func (s STS) Get() (Caller, error) {
 	}
 
 	return Caller{
+		ARN:         aws.StringValue(out.Arn),
 		RootUserARN: fmt.Sprintf("arn:%s:iam::%s:root", parsedARN.Partition, aws.StringValue(out.Account)),
 		Account:     aws.StringValue(out.Account),
 		UserID:      aws.StringValue(out.UserId),
@@ -0,0 +1,46 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package lambda provides a client to make API requests to AWS Lambda.
+package lambda
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/lambda"
+)
+
+type api interface {
+	Invoke(input *lambda.InvokeInput) (*lambda.InvokeOutput, error)
+}
+
+// Lambda wraps an AWS Lambda client.
+type Lambda struct {
+	client api
+}
+
+// New returns Lambda configured against the input session.
+func New(s *session.Session) *Lambda {
+	return &Lambda{
+		client: lambda.New(s),
+	}
+}
+
+// Invoke synchronously invokes the function with the JSON payload and waits for it to complete.
+// An error is returned if the function's code raised an error.
+func (l *Lambda) Invoke(functionARN string, payload []byte) error {
+	out, err := l.client.Invoke(&lambda.InvokeInput{
+		FunctionName:   aws.String(functionARN),
+		InvocationType: aws.String(lambda.InvocationTypeRequestResponse),
+		Payload:        payload,
+	})
+	if err != nil {
+		return fmt.Errorf("invoke function %s: %w", functionARN, err)
+	}
+	if out.FunctionError != nil {
+		return fmt.Errorf("function %s returned an error: %s", functionARN, string(out.Payload))
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package lambda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/copilot-cli/internal/pkg/aws/lambda/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLambda_Invoke(t *testing.T) {
+	const mockARN = "arn:aws:lambda:us-west-2:123456789012:function:validate"
+	mockPayload := []byte(`{"environment":"test"}`)
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedError error
+	}{
+		"fail to invoke the function": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().Invoke(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("invoke function arn:aws:lambda:us-west-2:123456789012:function:validate: some error"),
+		},
+		"the function raised an error": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().Invoke(gomock.Any()).Return(&lambda.InvokeOutput{
+					FunctionError: aws.String("Unhandled"),
+					Payload:       []byte(`{"errorMessage":"subnets are not reachable"}`),
+				}, nil)
+			},
+			wantedError: errors.New(`function arn:aws:lambda:us-west-2:123456789012:function:validate returned an error: {"errorMessage":"subnets are not reachable"}`),
+		},
+		"success": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().Invoke(&lambda.InvokeInput{
+					FunctionName:   aws.String(mockARN),
+					InvocationType: aws.String(lambda.InvocationTypeRequestResponse),
+					Payload:        mockPayload,
+				}).Return(&lambda.InvokeOutput{
+					StatusCode: aws.Int64(200),
+				}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := Lambda{
+				client: m,
+			}
+
+			// WHEN
+			err := client.Invoke(mockARN, mockPayload)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package partitions
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// Copilot features that are not available in every region or partition.
+const (
+	FeatureECS                     = "ECS services and jobs"
+	FeatureRequestDrivenWebService = "Request-Driven Web Service"
+	FeatureServiceConnect          = "Service Connect"
+	FeatureEFS                     = "EFS storage"
+	FeatureCDN                     = "CloudFront CDN"
+)
+
+// Endpoint IDs of the AWS services that Copilot features depend on.
+const (
+	ecsEndpointsID              = "ecs"
+	appRunnerEndpointsID        = "apprunner"
+	serviceDiscoveryEndpointsID = "servicediscovery"
+	efsEndpointsID              = "elasticfilesystem"
+)
+
+type feature struct {
+	name string
+	// unavailable returns why the feature can't be used in the region, or an empty string if it can.
+	unavailable func(region string, partition endpoints.Partition) string
+}
+
+var features = []feature{
+	{
+		name:        FeatureECS,
+		unavailable: requiresService(ecsEndpointsID, "Amazon ECS"),
+	},
+	{
+		name:        FeatureRequestDrivenWebService,
+		unavailable: requiresService(appRunnerEndpointsID, "AWS App Runner"),
+	},
+	{
+		name:        FeatureServiceConnect,
+		unavailable: requiresService(serviceDiscoveryEndpointsID, "AWS Cloud Map"),
+	},
+	{
+		name:        FeatureEFS,
+		unavailable: requiresService(efsEndpointsID, "Amazon EFS"),
+	},
+	{
+		name: FeatureCDN,
+		unavailable: func(_ string, partition endpoints.Partition) string {
+			if partition.ID() == endpoints.AwsPartitionID {
+				return ""
+			}
+			return fmt.Sprintf("Amazon CloudFront distributions are only supported in the %s partition", endpoints.AwsPartitionID)
+		},
+	},
+}
+
+func requiresService(sID, displayName string) func(string, endpoints.Partition) string {
+	return func(region string, partition endpoints.Partition) string {
+		regions, ok := endpoints.RegionsForService(endpoints.DefaultPartitions(), partition.ID(), sID)
+		if ok {
+			if _, ok := regions[region]; ok {
+				return ""
+			}
+		}
+		return fmt.Sprintf("%s is not available in region %s", displayName, region)
+	}
+}
+
+// FeatureStatus reports whether a Copilot feature can be used in a region.
+type FeatureStatus struct {
+	Name      string `json:"name"`
+	Available bool   `json:"available"`
+	Reason    string `json:"reason,omitempty"` // Why the feature is unavailable.
+}
+
+// ErrFeatureUnavailable occurs when a Copilot feature can't be used in a region.
+type ErrFeatureUnavailable struct {
+	Feature string
+	Region  string
+	Reason  string
+}
+
+func (e *ErrFeatureUnavailable) Error() string {
+	return fmt.Sprintf("%s cannot be used in region %s: %s", e.Feature, e.Region, e.Reason)
+}
+
+// Features returns whether each Copilot feature that depends on the region or partition can be used in the region.
+func (r Region) Features() ([]FeatureStatus, error) {
+	partition, err := r.Partition()
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]FeatureStatus, len(features))
+	for i, f := range features {
+		reason := f.unavailable(string(r), partition)
+		statuses[i] = FeatureStatus{
+			Name:      f.name,
+			Available: reason == "",
+			Reason:    reason,
+		}
+	}
+	return statuses, nil
+}
+
+// CheckFeature returns an ErrFeatureUnavailable if the Copilot feature can't be used in the region.
+func (r Region) CheckFeature(name string) error {
+	statuses, err := r.Features()
+	if err != nil {
+		return err
+	}
+	for _, status := range statuses {
+		if status.Name != name {
+			continue
+		}
+		if status.Available {
+			return nil
+		}
+		return &ErrFeatureUnavailable{
+			Feature: name,
+			Region:  string(r),
+			Reason:  status.Reason,
+		}
+	}
+	return fmt.Errorf("unknown feature %s", name)
+}
@@ -0,0 +1,92 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package partitions
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegion_Features(t *testing.T) {
+	testCases := map[string]struct {
+		region string
+
+		wanted    []FeatureStatus
+		wantedErr error
+	}{
+		"error finding the partition": {
+			region:    "weird region",
+			wantedErr: errors.New("find the partition for region weird region"),
+		},
+		"every feature is available": {
+			region: "us-west-2",
+			wanted: []FeatureStatus{
+				{Name: FeatureECS, Available: true},
+				{Name: FeatureRequestDrivenWebService, Available: true},
+				{Name: FeatureServiceConnect, Available: true},
+				{Name: FeatureEFS, Available: true},
+				{Name: FeatureCDN, Available: true},
+			},
+		},
+		"features are unavailable in a region of another partition": {
+			region: "cn-north-1",
+			wanted: []FeatureStatus{
+				{Name: FeatureECS, Available: true},
+				{Name: FeatureRequestDrivenWebService, Reason: "AWS App Runner is not available in region cn-north-1"},
+				{Name: FeatureServiceConnect, Available: true},
+				{Name: FeatureEFS, Available: true},
+				{Name: FeatureCDN, Reason: "Amazon CloudFront distributions are only supported in the aws partition"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Region(tc.region).Features()
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestRegion_CheckFeature(t *testing.T) {
+	testCases := map[string]struct {
+		region  string
+		feature string
+
+		wantedErr error
+	}{
+		"feature is available": {
+			region:  "us-west-2",
+			feature: FeatureRequestDrivenWebService,
+		},
+		"feature is unavailable": {
+			region:    "us-gov-west-1",
+			feature:   FeatureCDN,
+			wantedErr: errors.New("CloudFront CDN cannot be used in region us-gov-west-1: Amazon CloudFront distributions are only supported in the aws partition"),
+		},
+		"unknown feature": {
+			region:    "us-west-2",
+			feature:   "Time Travel",
+			wantedErr: errors.New("unknown feature Time Travel"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := Region(tc.region).CheckFeature(tc.feature)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -21,6 +21,16 @@ const (
 
 type api interface {
 	ListHostedZonesByName(in *route53.ListHostedZonesByNameInput) (*route53.ListHostedZonesByNameOutput, error)
+	GetHostedZone(in *route53.GetHostedZoneInput) (*route53.GetHostedZoneOutput, error)
+	ListResourceRecordSets(in *route53.ListResourceRecordSetsInput) (*route53.ListResourceRecordSetsOutput, error)
+	ChangeResourceRecordSets(in *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error)
+	WaitUntilResourceRecordSetsChanged(in *route53.GetChangeInput) error
+}
+
+// NSRecord is a name server record of a hosted zone.
+type NSRecord struct {
+	Name        string   // Fully qualified domain name without the trailing dot, such as "test.app.example.com".
+	NameServers []string // Name servers without the trailing dot.
 }
 
 // Route53 wraps an Route53 client.
@@ -61,6 +71,95 @@ func (r *Route53) DomainHostedZoneID(domainName string) (string, error) {
 	}
 }
 
+// NameServers returns the name servers that Route 53 assigned to a hosted zone.
+func (r *Route53) NameServers(hostedZoneID string) ([]string, error) {
+	out, err := r.client.GetHostedZone(&route53.GetHostedZoneInput{
+		Id: aws.String(hostedZoneID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get hosted zone %s: %w", hostedZoneID, err)
+	}
+	if out.DelegationSet == nil {
+		// Private hosted zones don't have a delegation set.
+		return nil, nil
+	}
+	return trimTrailingDots(aws.StringValueSlice(out.DelegationSet.NameServers)), nil
+}
+
+// ListNSRecords returns the name server records of a hosted zone, including the one of the zone itself.
+func (r *Route53) ListNSRecords(hostedZoneID string) ([]NSRecord, error) {
+	var records []NSRecord
+	in := &route53.ListResourceRecordSetsInput{HostedZoneId: aws.String(hostedZoneID)}
+	for {
+		out, err := r.client.ListResourceRecordSets(in)
+		if err != nil {
+			return nil, fmt.Errorf("list record sets of hosted zone %s: %w", hostedZoneID, err)
+		}
+		for _, set := range out.ResourceRecordSets {
+			if aws.StringValue(set.Type) != route53.RRTypeNs {
+				continue
+			}
+			var nameServers []string
+			for _, record := range set.ResourceRecords {
+				nameServers = append(nameServers, aws.StringValue(record.Value))
+			}
+			records = append(records, NSRecord{
+				Name:        strings.TrimSuffix(aws.StringValue(set.Name), "."),
+				NameServers: trimTrailingDots(nameServers),
+			})
+		}
+		if !aws.BoolValue(out.IsTruncated) {
+			return records, nil
+		}
+		in = &route53.ListResourceRecordSetsInput{
+			HostedZoneId:          aws.String(hostedZoneID),
+			StartRecordName:       out.NextRecordName,
+			StartRecordType:       out.NextRecordType,
+			StartRecordIdentifier: out.NextRecordIdentifier,
+		}
+	}
+}
+
+// UpsertNSRecord creates or updates the name server record of a domain in a hosted zone,
+// and waits until the change is propagated to all Route 53 DNS servers.
+func (r *Route53) UpsertNSRecord(hostedZoneID string, record NSRecord, ttl int64) error {
+	var values []*route53.ResourceRecord
+	for _, ns := range record.NameServers {
+		values = append(values, &route53.ResourceRecord{Value: aws.String(ns)})
+	}
+	out, err := r.client.ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(hostedZoneID),
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: []*route53.Change{
+				{
+					Action: aws.String(route53.ChangeActionUpsert),
+					ResourceRecordSet: &route53.ResourceRecordSet{
+						Name:            aws.String(record.Name),
+						Type:            aws.String(route53.RRTypeNs),
+						TTL:             aws.Int64(ttl),
+						ResourceRecords: values,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("upsert NS record %s in hosted zone %s: %w", record.Name, hostedZoneID, err)
+	}
+	if err := r.client.WaitUntilResourceRecordSetsChanged(&route53.GetChangeInput{Id: out.ChangeInfo.Id}); err != nil {
+		return fmt.Errorf("wait for NS record %s to be updated in hosted zone %s: %w", record.Name, hostedZoneID, err)
+	}
+	return nil
+}
+
+func trimTrailingDots(names []string) []string {
+	var trimmed []string
+	for _, name := range names {
+		trimmed = append(trimmed, strings.TrimSuffix(name, "."))
+	}
+	return trimmed
+}
+
 type filterZoneFunc func(*route53.HostedZone) bool
 
 func filterHostedZones(zones []*route53.HostedZone, fn filterZoneFunc) []*route53.HostedZone {
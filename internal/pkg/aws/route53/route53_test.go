@@ -164,3 +164,239 @@ func TestRoute53_DomainHostedZoneID(t *testing.T) {
 
 	}
 }
+
+func TestRoute53_NameServers(t *testing.T) {
+	testCases := map[string]struct {
+		mockRoute53Client func(m *mocks.Mockapi)
+
+		wantErr         error
+		wantNameServers []string
+	}{
+		"fail to get hosted zone": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().GetHostedZone(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("get hosted zone mockID: some error"),
+		},
+		"private hosted zone": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().GetHostedZone(gomock.Any()).Return(&route53.GetHostedZoneOutput{}, nil)
+			},
+		},
+		"success": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().GetHostedZone(&route53.GetHostedZoneInput{
+					Id: aws.String("mockID"),
+				}).Return(&route53.GetHostedZoneOutput{
+					DelegationSet: &route53.DelegationSet{
+						NameServers: aws.StringSlice([]string{"ns-1.awsdns-01.org", "ns-2.awsdns-02.com."}),
+					},
+				}, nil)
+			},
+			wantNameServers: []string{"ns-1.awsdns-01.org", "ns-2.awsdns-02.com"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRoute53Client := mocks.NewMockapi(ctrl)
+			tc.mockRoute53Client(mockRoute53Client)
+
+			service := Route53{
+				client: mockRoute53Client,
+			}
+
+			// WHEN
+			got, err := service.NameServers("mockID")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantNameServers, got)
+			}
+		})
+	}
+}
+
+func TestRoute53_ListNSRecords(t *testing.T) {
+	testCases := map[string]struct {
+		mockRoute53Client func(m *mocks.Mockapi)
+
+		wantErr     error
+		wantRecords []NSRecord
+	}{
+		"fail to list record sets": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().ListResourceRecordSets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("list record sets of hosted zone mockID: some error"),
+		},
+		"success with pagination": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().ListResourceRecordSets(&route53.ListResourceRecordSetsInput{
+					HostedZoneId: aws.String("mockID"),
+				}).Return(&route53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []*route53.ResourceRecordSet{
+						{
+							Name: aws.String("app.example.com."),
+							Type: aws.String("NS"),
+							ResourceRecords: []*route53.ResourceRecord{
+								{Value: aws.String("ns-1.awsdns-01.org.")},
+							},
+						},
+						{
+							Name: aws.String("app.example.com."),
+							Type: aws.String("SOA"),
+							ResourceRecords: []*route53.ResourceRecord{
+								{Value: aws.String("ns-1.awsdns-01.org. awsdns-hostmaster.amazon.com. 1 7200 900 1209600 86400")},
+							},
+						},
+					},
+					IsTruncated:    aws.Bool(true),
+					NextRecordName: aws.String("test.app.example.com."),
+					NextRecordType: aws.String("NS"),
+				}, nil)
+				m.EXPECT().ListResourceRecordSets(&route53.ListResourceRecordSetsInput{
+					HostedZoneId:    aws.String("mockID"),
+					StartRecordName: aws.String("test.app.example.com."),
+					StartRecordType: aws.String("NS"),
+				}).Return(&route53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []*route53.ResourceRecordSet{
+						{
+							Name: aws.String("test.app.example.com."),
+							Type: aws.String("NS"),
+							ResourceRecords: []*route53.ResourceRecord{
+								{Value: aws.String("ns-2.awsdns-02.com")},
+								{Value: aws.String("ns-3.awsdns-03.net")},
+							},
+						},
+					},
+					IsTruncated: aws.Bool(false),
+				}, nil)
+			},
+			wantRecords: []NSRecord{
+				{
+					Name:        "app.example.com",
+					NameServers: []string{"ns-1.awsdns-01.org"},
+				},
+				{
+					Name:        "test.app.example.com",
+					NameServers: []string{"ns-2.awsdns-02.com", "ns-3.awsdns-03.net"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRoute53Client := mocks.NewMockapi(ctrl)
+			tc.mockRoute53Client(mockRoute53Client)
+
+			service := Route53{
+				client: mockRoute53Client,
+			}
+
+			// WHEN
+			got, err := service.ListNSRecords("mockID")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantRecords, got)
+			}
+		})
+	}
+}
+
+func TestRoute53_UpsertNSRecord(t *testing.T) {
+	record := NSRecord{
+		Name:        "test.app.example.com",
+		NameServers: []string{"ns-2.awsdns-02.com", "ns-3.awsdns-03.net"},
+	}
+	testCases := map[string]struct {
+		mockRoute53Client func(m *mocks.Mockapi)
+
+		wantErr error
+	}{
+		"fail to change record sets": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().ChangeResourceRecordSets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("upsert NS record test.app.example.com in hosted zone mockID: some error"),
+		},
+		"fail to wait for the change": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().ChangeResourceRecordSets(gomock.Any()).Return(&route53.ChangeResourceRecordSetsOutput{
+					ChangeInfo: &route53.ChangeInfo{Id: aws.String("mockChangeID")},
+				}, nil)
+				m.EXPECT().WaitUntilResourceRecordSetsChanged(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantErr: errors.New("wait for NS record test.app.example.com to be updated in hosted zone mockID: some error"),
+		},
+		"success": {
+			mockRoute53Client: func(m *mocks.Mockapi) {
+				m.EXPECT().ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+					HostedZoneId: aws.String("mockID"),
+					ChangeBatch: &route53.ChangeBatch{
+						Changes: []*route53.Change{
+							{
+								Action: aws.String("UPSERT"),
+								ResourceRecordSet: &route53.ResourceRecordSet{
+									Name: aws.String("test.app.example.com"),
+									Type: aws.String("NS"),
+									TTL:  aws.Int64(60),
+									ResourceRecords: []*route53.ResourceRecord{
+										{Value: aws.String("ns-2.awsdns-02.com")},
+										{Value: aws.String("ns-3.awsdns-03.net")},
+									},
+								},
+							},
+						},
+					},
+				}).Return(&route53.ChangeResourceRecordSetsOutput{
+					ChangeInfo: &route53.ChangeInfo{Id: aws.String("mockChangeID")},
+				}, nil)
+				m.EXPECT().WaitUntilResourceRecordSetsChanged(&route53.GetChangeInput{
+					Id: aws.String("mockChangeID"),
+				}).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRoute53Client := mocks.NewMockapi(ctrl)
+			tc.mockRoute53Client(mockRoute53Client)
+
+			service := Route53{
+				client: mockRoute53Client,
+			}
+
+			// WHEN
+			err := service.UpsertNSRecord("mockID", record, 60)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -9,7 +9,9 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 
@@ -21,7 +23,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 )
 
-const notFound = "NotFound"
+const (
+	notFound  = "NotFound"
+	forbidden = "Forbidden"
+
+	// See https://docs.aws.amazon.com/AmazonS3/latest/API/API_DeleteObjects.html
+	batchDeleteLimit = 1000
+)
 
 type s3ManagerAPI interface {
 	Upload(input *s3manager.UploadInput, options ...func(*s3manager.Uploader)) (*s3manager.UploadOutput, error)
@@ -31,6 +39,9 @@ type s3API interface {
 	ListObjectVersions(input *s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error)
 	DeleteObjects(input *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
 	HeadBucket(input *s3.HeadBucketInput) (*s3.HeadBucketOutput, error)
+	HeadObject(input *s3.HeadObjectInput) (*s3.HeadObjectOutput, error)
+	GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error)
+	ListObjectsV2(input *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error)
 }
 
 // NamedBinary is a named binary to be uploaded.
@@ -46,6 +57,7 @@ type CompressAndUploadFunc func(key string, objects ...NamedBinary) (url string,
 type S3 struct {
 	s3Manager s3ManagerAPI
 	s3Client  s3API
+	region    string
 }
 
 // New returns an S3 client configured against the input session.
@@ -53,6 +65,7 @@ func New(s *session.Session) *S3 {
 	return &S3{
 		s3Manager: s3manager.NewUploader(s),
 		s3Client:  s3.New(s),
+		region:    aws.StringValue(s.Config.Region),
 	}
 }
 
@@ -85,6 +98,166 @@ func (s *S3) Upload(bucket, key string, data io.Reader) (string, error) {
 	return s.upload(bucket, key, data)
 }
 
+// UploadIfNotExists uploads a file to an S3 bucket under the specified key only if no object exists under that key yet.
+// It is meant for keys that embed a hash of their content, so that unchanged files are not uploaded again.
+func (s *S3) UploadIfNotExists(bucket, key string, data io.Reader) (string, error) {
+	exists, err := s.isObjectExists(bucket, key)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return URL(s.region, bucket, key), nil
+	}
+	return s.upload(bucket, key, data)
+}
+
+// ErrObjectNotFound is returned when no object is stored under a key in a bucket.
+type ErrObjectNotFound struct {
+	Bucket string
+	Key    string
+}
+
+func (e *ErrObjectNotFound) Error() string {
+	return fmt.Sprintf("object %s not found in bucket %s", e.Key, e.Bucket)
+}
+
+// Download returns the content of the object stored under key in the bucket.
+// If the object does not exist, it returns an ErrObjectNotFound.
+func (s *S3) Download(bucket, key string) ([]byte, error) {
+	out, err := s.s3Client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, &ErrObjectNotFound{
+				Bucket: bucket,
+				Key:    key,
+			}
+		}
+		return nil, fmt.Errorf("get object %s in bucket %s: %w", key, bucket, err)
+	}
+	defer out.Body.Close()
+	dat, err := ioutil.ReadAll(out.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read object %s in bucket %s: %w", key, bucket, err)
+	}
+	return dat, nil
+}
+
+// ListObjectKeys returns the keys of all objects in the bucket whose key starts with the prefix.
+func (s *S3) ListObjectKeys(bucket, prefix string) ([]string, error) {
+	var keys []string
+	in := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	}
+	for {
+		out, err := s.s3Client.ListObjectsV2(in)
+		if err != nil {
+			return nil, fmt.Errorf("list objects with prefix %s in bucket %s: %w", prefix, bucket, err)
+		}
+		for _, object := range out.Contents {
+			keys = append(keys, aws.StringValue(object.Key))
+		}
+		if !aws.BoolValue(out.IsTruncated) {
+			return keys, nil
+		}
+		in.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+// Object houses metadata of all the versions of an object in a bucket.
+type Object struct {
+	Key          string
+	Size         int64     // Total size in bytes of all the versions of the object.
+	LastModified time.Time // Time the most recent version of the object was created.
+	VersionIDs   []string  // IDs of all the versions and delete markers of the object.
+}
+
+// ListObjects returns the metadata of all objects in the bucket whose key starts with the prefix.
+// Objects are aggregated over all of their versions, so that deleting them reclaims their whole size.
+func (s *S3) ListObjects(bucket, prefix string) ([]*Object, error) {
+	var objects []*Object
+	objectForKey := make(map[string]*Object)
+	object := func(key string) *Object {
+		if obj, ok := objectForKey[key]; ok {
+			return obj
+		}
+		obj := &Object{
+			Key: key,
+		}
+		objectForKey[key] = obj
+		objects = append(objects, obj)
+		return obj
+	}
+	in := &s3.ListObjectVersionsInput{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	}
+	for {
+		out, err := s.s3Client.ListObjectVersions(in)
+		if err != nil {
+			return nil, fmt.Errorf("list object versions with prefix %s in bucket %s: %w", prefix, bucket, err)
+		}
+		for _, version := range out.Versions {
+			obj := object(aws.StringValue(version.Key))
+			obj.Size += aws.Int64Value(version.Size)
+			obj.VersionIDs = append(obj.VersionIDs, aws.StringValue(version.VersionId))
+			if lastModified := aws.TimeValue(version.LastModified); lastModified.After(obj.LastModified) {
+				obj.LastModified = lastModified
+			}
+		}
+		for _, marker := range out.DeleteMarkers {
+			obj := object(aws.StringValue(marker.Key))
+			obj.VersionIDs = append(obj.VersionIDs, aws.StringValue(marker.VersionId))
+			if lastModified := aws.TimeValue(marker.LastModified); lastModified.After(obj.LastModified) {
+				obj.LastModified = lastModified
+			}
+		}
+		if !aws.BoolValue(out.IsTruncated) {
+			return objects, nil
+		}
+		in.KeyMarker = out.NextKeyMarker
+		in.VersionIdMarker = out.NextVersionIdMarker
+	}
+}
+
+// DeleteObjects permanently deletes all the versions of the objects from the bucket.
+func (s *S3) DeleteObjects(bucket string, objects []*Object) error {
+	var ids []*s3.ObjectIdentifier
+	for _, obj := range objects {
+		for _, versionID := range obj.VersionIDs {
+			ids = append(ids, &s3.ObjectIdentifier{
+				Key:       aws.String(obj.Key),
+				VersionId: aws.String(versionID),
+			})
+		}
+	}
+	for len(ids) > 0 {
+		batch := ids
+		if len(batch) > batchDeleteLimit {
+			batch = ids[:batchDeleteLimit]
+		}
+		ids = ids[len(batch):]
+		out, err := s.s3Client.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: batch,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("delete objects from bucket %s: %w", bucket, err)
+		}
+		if len(out.Errors) > 0 {
+			failure := out.Errors[0]
+			return fmt.Errorf("delete object %s from bucket %s: %s", aws.StringValue(failure.Key), bucket, aws.StringValue(failure.Message))
+		}
+	}
+	return nil
+}
+
 // EmptyBucket deletes all objects within the bucket.
 func (s *S3) EmptyBucket(bucket string) error {
 	var listResp *s3.ListObjectVersionsOutput
@@ -192,6 +365,22 @@ func (s *S3) isBucketExists(bucket string) (bool, error) {
 	return true, nil
 }
 
+// isObjectExists returns true if an object is stored under key in the bucket.
+// Without s3:ListBucket permissions, S3 returns "Forbidden" instead of "NotFound" for missing objects.
+func (s *S3) isObjectExists(bucket, key string) (bool, error) {
+	_, err := s.s3Client.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && (aerr.Code() == notFound || aerr.Code() == forbidden) {
+			return false, nil
+		}
+		return false, fmt.Errorf("head object %s in bucket %s: %w", key, bucket, err)
+	}
+	return true, nil
+}
+
 func (s *S3) upload(bucket, key string, buf io.Reader) (string, error) {
 	in := &s3manager.UploadInput{
 		Body:   buf,
@@ -10,7 +10,9 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
@@ -151,6 +153,395 @@ func TestS3_Upload(t *testing.T) {
 	}
 }
 
+func TestS3_UploadIfNotExists(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client        func(m *mocks.Mocks3API)
+		mockS3ManagerClient func(m *mocks.Mocks3ManagerAPI)
+
+		wantedURL string
+		wantError error
+	}{
+		"return error if fail to check whether the object exists": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().HeadObject(&s3.HeadObjectInput{
+					Bucket: aws.String("mockBucket"),
+					Key:    aws.String("mockFileName"),
+				}).Return(nil, errors.New("some error"))
+			},
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerAPI) {},
+			wantError:           fmt.Errorf("head object mockFileName in bucket mockBucket: some error"),
+		},
+		"skip upload if the object already exists": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().HeadObject(gomock.Any()).Return(&s3.HeadObjectOutput{}, nil)
+			},
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerAPI) {
+				m.EXPECT().Upload(gomock.Any()).Times(0)
+			},
+			wantedURL: "https://mockBucket.s3.us-west-2.amazonaws.com/mockFileName",
+		},
+		"upload if the object is not found": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().HeadObject(gomock.Any()).Return(nil, awserr.New("NotFound", "message", nil))
+			},
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerAPI) {
+				m.EXPECT().Upload(gomock.Any()).Do(func(in *s3manager.UploadInput, _ ...func(*s3manager.Uploader)) {
+					require.Equal(t, "mockBucket", aws.StringValue(in.Bucket))
+					require.Equal(t, "mockFileName", aws.StringValue(in.Key))
+				}).Return(&s3manager.UploadOutput{
+					Location: "mockURL",
+				}, nil)
+			},
+			wantedURL: "mockURL",
+		},
+		"upload if not permitted to tell whether the object exists": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().HeadObject(gomock.Any()).Return(nil, awserr.New("Forbidden", "message", nil))
+			},
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerAPI) {
+				m.EXPECT().Upload(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantError: fmt.Errorf("upload mockFileName to bucket mockBucket: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			mockS3ManagerClient := mocks.NewMocks3ManagerAPI(ctrl)
+			tc.mockS3Client(mockS3Client)
+			tc.mockS3ManagerClient(mockS3ManagerClient)
+
+			service := S3{
+				s3Client:  mockS3Client,
+				s3Manager: mockS3ManagerClient,
+				region:    "us-west-2",
+			}
+
+			gotURL, gotErr := service.UploadIfNotExists("mockBucket", "mockFileName", bytes.NewBuffer([]byte("bar")))
+
+			if tc.wantError != nil {
+				require.EqualError(t, gotErr, tc.wantError.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantedURL, gotURL)
+			}
+		})
+	}
+}
+
+func TestS3_Download(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3API)
+
+		wantedContent []byte
+		wantError     error
+	}{
+		"return ErrObjectNotFound if there is no object under the key": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetObject(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeNoSuchKey, "message", nil))
+			},
+			wantError: &ErrObjectNotFound{
+				Bucket: "mockBucket",
+				Key:    "mockFileName",
+			},
+		},
+		"return wrapped error if fail to get the object": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetObject(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantError: errors.New("get object mockFileName in bucket mockBucket: some error"),
+		},
+		"return the content of the object": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetObject(&s3.GetObjectInput{
+					Bucket: aws.String("mockBucket"),
+					Key:    aws.String("mockFileName"),
+				}).Return(&s3.GetObjectOutput{
+					Body: ioutil.NopCloser(strings.NewReader("bar")),
+				}, nil)
+			},
+			wantedContent: []byte("bar"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			tc.mockS3Client(mockS3Client)
+
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			got, gotErr := service.Download("mockBucket", "mockFileName")
+
+			if tc.wantError != nil {
+				require.EqualError(t, gotErr, tc.wantError.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantedContent, got)
+			}
+		})
+	}
+}
+
+func TestS3_ListObjectKeys(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3API)
+
+		wantedKeys []string
+		wantError  error
+	}{
+		"return wrapped error if fail to list objects": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().ListObjectsV2(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantError: errors.New("list objects with prefix templates/ in bucket mockBucket: some error"),
+		},
+		"return the keys of all pages": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().ListObjectsV2(&s3.ListObjectsV2Input{
+					Bucket: aws.String("mockBucket"),
+					Prefix: aws.String("templates/"),
+				}).Return(&s3.ListObjectsV2Output{
+					Contents: []*s3.Object{
+						{Key: aws.String("templates/go-api/v1/manifest.yml")},
+					},
+					IsTruncated:           aws.Bool(true),
+					NextContinuationToken: aws.String("token"),
+				}, nil)
+				m.EXPECT().ListObjectsV2(&s3.ListObjectsV2Input{
+					Bucket:            aws.String("mockBucket"),
+					Prefix:            aws.String("templates/"),
+					ContinuationToken: aws.String("token"),
+				}).Return(&s3.ListObjectsV2Output{
+					Contents: []*s3.Object{
+						{Key: aws.String("templates/go-api/v1/template.yml")},
+					},
+				}, nil)
+			},
+			wantedKeys: []string{"templates/go-api/v1/manifest.yml", "templates/go-api/v1/template.yml"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			tc.mockS3Client(mockS3Client)
+
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			got, gotErr := service.ListObjectKeys("mockBucket", "templates/")
+
+			if tc.wantError != nil {
+				require.EqualError(t, gotErr, tc.wantError.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantedKeys, got)
+			}
+		})
+	}
+}
+
+func TestS3_ListObjects(t *testing.T) {
+	older := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3API)
+
+		wantedObjects []*Object
+		wantError     error
+	}{
+		"return wrapped error if fail to list object versions": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().ListObjectVersions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantError: errors.New("list object versions with prefix manual/ in bucket mockBucket: some error"),
+		},
+		"aggregate the versions and delete markers of objects over all pages": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().ListObjectVersions(&s3.ListObjectVersionsInput{
+					Bucket: aws.String("mockBucket"),
+					Prefix: aws.String("manual/"),
+				}).Return(&s3.ListObjectVersionsOutput{
+					Versions: []*s3.ObjectVersion{
+						{Key: aws.String("manual/a.yml"), VersionId: aws.String("a2"), Size: aws.Int64(20), LastModified: aws.Time(newer)},
+						{Key: aws.String("manual/a.yml"), VersionId: aws.String("a1"), Size: aws.Int64(10), LastModified: aws.Time(older)},
+					},
+					IsTruncated:         aws.Bool(true),
+					NextKeyMarker:       aws.String("manual/a.yml"),
+					NextVersionIdMarker: aws.String("a1"),
+				}, nil)
+				m.EXPECT().ListObjectVersions(&s3.ListObjectVersionsInput{
+					Bucket:          aws.String("mockBucket"),
+					Prefix:          aws.String("manual/"),
+					KeyMarker:       aws.String("manual/a.yml"),
+					VersionIdMarker: aws.String("a1"),
+				}).Return(&s3.ListObjectVersionsOutput{
+					Versions: []*s3.ObjectVersion{
+						{Key: aws.String("manual/a.yml"), VersionId: aws.String("a0"), Size: aws.Int64(5), LastModified: aws.Time(older)},
+						{Key: aws.String("manual/b.yml"), VersionId: aws.String("b1"), Size: aws.Int64(7), LastModified: aws.Time(older)},
+					},
+					DeleteMarkers: []*s3.DeleteMarkerEntry{
+						{Key: aws.String("manual/b.yml"), VersionId: aws.String("b2"), LastModified: aws.Time(newer)},
+					},
+				}, nil)
+			},
+			wantedObjects: []*Object{
+				{
+					Key:          "manual/a.yml",
+					Size:         35,
+					LastModified: newer,
+					VersionIDs:   []string{"a2", "a1", "a0"},
+				},
+				{
+					Key:          "manual/b.yml",
+					Size:         7,
+					LastModified: newer,
+					VersionIDs:   []string{"b1", "b2"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			tc.mockS3Client(mockS3Client)
+
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			got, gotErr := service.ListObjects("mockBucket", "manual/")
+
+			if tc.wantError != nil {
+				require.EqualError(t, gotErr, tc.wantError.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantedObjects, got)
+			}
+		})
+	}
+}
+
+func TestS3_DeleteObjects(t *testing.T) {
+	manyVersions := make([]string, batchDeleteLimit+1)
+	for i := range manyVersions {
+		manyVersions[i] = fmt.Sprintf("v%d", i)
+	}
+	testCases := map[string]struct {
+		inObjects    []*Object
+		mockS3Client func(m *mocks.Mocks3API)
+
+		wantError error
+	}{
+		"no-op if there are no objects": {
+			mockS3Client: func(m *mocks.Mocks3API) {},
+		},
+		"delete all the versions of the objects": {
+			inObjects: []*Object{
+				{Key: "manual/a.yml", VersionIDs: []string{"a2", "a1"}},
+				{Key: "manual/b.yml", VersionIDs: []string{"b1"}},
+			},
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().DeleteObjects(&s3.DeleteObjectsInput{
+					Bucket: aws.String("mockBucket"),
+					Delete: &s3.Delete{
+						Objects: []*s3.ObjectIdentifier{
+							{Key: aws.String("manual/a.yml"), VersionId: aws.String("a2")},
+							{Key: aws.String("manual/a.yml"), VersionId: aws.String("a1")},
+							{Key: aws.String("manual/b.yml"), VersionId: aws.String("b1")},
+						},
+						Quiet: aws.Bool(true),
+					},
+				}).Return(&s3.DeleteObjectsOutput{}, nil)
+			},
+		},
+		"delete versions in batches": {
+			inObjects: []*Object{
+				{Key: "manual/a.yml", VersionIDs: manyVersions},
+			},
+			mockS3Client: func(m *mocks.Mocks3API) {
+				gomock.InOrder(
+					m.EXPECT().DeleteObjects(gomock.Any()).DoAndReturn(func(in *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error) {
+						require.Len(t, in.Delete.Objects, batchDeleteLimit)
+						return &s3.DeleteObjectsOutput{}, nil
+					}),
+					m.EXPECT().DeleteObjects(gomock.Any()).DoAndReturn(func(in *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error) {
+						require.Len(t, in.Delete.Objects, 1)
+						return &s3.DeleteObjectsOutput{}, nil
+					}),
+				)
+			},
+		},
+		"return wrapped error if fail to delete objects": {
+			inObjects: []*Object{
+				{Key: "manual/a.yml", VersionIDs: []string{"a1"}},
+			},
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().DeleteObjects(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantError: errors.New("delete objects from bucket mockBucket: some error"),
+		},
+		"return error if an object can't be deleted": {
+			inObjects: []*Object{
+				{Key: "manual/a.yml", VersionIDs: []string{"a1"}},
+			},
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().DeleteObjects(gomock.Any()).Return(&s3.DeleteObjectsOutput{
+					Errors: []*s3.Error{
+						{Key: aws.String("manual/a.yml"), Message: aws.String("Access Denied")},
+					},
+				}, nil)
+			},
+			wantError: errors.New("delete object manual/a.yml from bucket mockBucket: Access Denied"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			tc.mockS3Client(mockS3Client)
+
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			gotErr := service.DeleteObjects("mockBucket", tc.inObjects)
+
+			if tc.wantError != nil {
+				require.EqualError(t, gotErr, tc.wantError.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
 type namedBinary struct{}
 
 func (n namedBinary) Name() string { return "foo" }
@@ -0,0 +1,240 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package catalog provides access to organization-provided workload templates.
+// A catalog is stored in an S3 bucket or a git repository and lays out each version of a template in its own directory:
+//
+//	<name>/<version>/template.yml  # Metadata of the template, such as its description and workload type.
+//	<name>/<version>/manifest.yml  # Manifest of the workload, rendered with text/template.
+//	<name>/<version>/Dockerfile    # Optional. Dockerfile scaffolding for the workload.
+//	<name>/<version>/addons/*.yml  # Optional. Addons templates for the workload.
+package catalog
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"text/template"
+
+	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	metadataFileName   = "template.yml"
+	manifestFileName   = "manifest.yml"
+	dockerfileFileName = "Dockerfile"
+	addonsDirName      = "addons"
+
+	versionSeparator = "@"
+)
+
+type source interface {
+	Files() ([]string, error)
+	Read(path string) ([]byte, error)
+}
+
+// Catalog is a collection of versioned workload templates.
+type Catalog struct {
+	src source
+}
+
+// Template describes a version of a workload template in a catalog.
+type Template struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+}
+
+// Contents holds the files of a version of a workload template.
+type Contents struct {
+	Template
+	Manifest   []byte            // Manifest of the workload, as a text/template.
+	Dockerfile []byte            // Empty if the template doesn't provide a Dockerfile.
+	Addons     map[string][]byte // Addons templates keyed by their file name.
+}
+
+// ManifestData holds the values that a template's manifest can refer to.
+type ManifestData struct {
+	Name       string
+	Dockerfile string
+	Image      string
+	Port       uint16
+}
+
+type metadata struct {
+	Type        string `yaml:"type"`
+	Description string `yaml:"description"`
+}
+
+// ErrTemplateNotFound occurs when a template, or a version of it, doesn't exist in a catalog.
+type ErrTemplateNotFound struct {
+	Name    string
+	Version string
+}
+
+func (e *ErrTemplateNotFound) Error() string {
+	if e.Version == "" {
+		return fmt.Sprintf("template %s not found in the catalog", e.Name)
+	}
+	return fmt.Sprintf("version %s of template %s not found in the catalog", e.Version, e.Name)
+}
+
+// List returns all the versions of the templates in the catalog sorted by name, and from the latest to the oldest version.
+func (c *Catalog) List() ([]Template, error) {
+	files, err := c.src.Files()
+	if err != nil {
+		return nil, err
+	}
+	var templates []Template
+	for _, file := range files {
+		elems := strings.Split(file, "/")
+		if len(elems) != 3 || elems[2] != metadataFileName {
+			continue
+		}
+		tpl, err := c.template(elems[0], elems[1])
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, tpl)
+	}
+	sort.SliceStable(templates, func(i, j int) bool {
+		if templates[i].Name != templates[j].Name {
+			return templates[i].Name < templates[j].Name
+		}
+		return compareVersions(templates[i].Version, templates[j].Version) > 0
+	})
+	return templates, nil
+}
+
+// Get returns the contents of a template referred to as "name" for its latest version, or "name@version".
+func (c *Catalog) Get(ref string) (*Contents, error) {
+	name, version := ParseRef(ref)
+	files, err := c.src.Files()
+	if err != nil {
+		return nil, err
+	}
+	if version == "" {
+		version = latestVersion(name, files)
+		if version == "" {
+			return nil, &ErrTemplateNotFound{Name: name}
+		}
+	}
+	dir := path.Join(name, version)
+	var hasMetadata, hasManifest, hasDockerfile bool
+	var addons []string
+	for _, file := range files {
+		switch {
+		case file == path.Join(dir, metadataFileName):
+			hasMetadata = true
+		case file == path.Join(dir, manifestFileName):
+			hasManifest = true
+		case file == path.Join(dir, dockerfileFileName):
+			hasDockerfile = true
+		case path.Dir(file) == path.Join(dir, addonsDirName) && isYAML(file):
+			addons = append(addons, file)
+		}
+	}
+	if !hasMetadata {
+		return nil, &ErrTemplateNotFound{Name: name, Version: version}
+	}
+	if !hasManifest {
+		return nil, fmt.Errorf("template %s does not contain a %s file", ref, manifestFileName)
+	}
+
+	tpl, err := c.template(name, version)
+	if err != nil {
+		return nil, err
+	}
+	contents := &Contents{
+		Template: tpl,
+	}
+	if contents.Manifest, err = c.src.Read(path.Join(dir, manifestFileName)); err != nil {
+		return nil, err
+	}
+	if hasDockerfile {
+		if contents.Dockerfile, err = c.src.Read(path.Join(dir, dockerfileFileName)); err != nil {
+			return nil, err
+		}
+	}
+	for _, addon := range addons {
+		if contents.Addons == nil {
+			contents.Addons = make(map[string][]byte)
+		}
+		content, err := c.src.Read(addon)
+		if err != nil {
+			return nil, err
+		}
+		contents.Addons[path.Base(addon)] = content
+	}
+	return contents, nil
+}
+
+// RenderManifest returns the manifest of the template filled with data.
+func (c *Contents) RenderManifest(data ManifestData) ([]byte, error) {
+	tpl, err := template.New(manifestFileName).Parse(string(c.Manifest))
+	if err != nil {
+		return nil, fmt.Errorf("parse manifest of template %s: %w", c.Name, err)
+	}
+	buf := new(bytes.Buffer)
+	if err := tpl.Execute(buf, data); err != nil {
+		return nil, fmt.Errorf("execute manifest of template %s: %w", c.Name, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// ParseRef splits a template reference of the form "name[@version]" into its name and version.
+func ParseRef(ref string) (name, version string) {
+	parts := strings.SplitN(ref, versionSeparator, 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+func (c *Catalog) template(name, version string) (Template, error) {
+	raw, err := c.src.Read(path.Join(name, version, metadataFileName))
+	if err != nil {
+		return Template{}, err
+	}
+	var meta metadata
+	if err := yaml.Unmarshal(raw, &meta); err != nil {
+		return Template{}, fmt.Errorf("unmarshal %s of template %s%s%s: %w", metadataFileName, name, versionSeparator, version, err)
+	}
+	return Template{
+		Name:        name,
+		Version:     version,
+		Type:        meta.Type,
+		Description: meta.Description,
+	}, nil
+}
+
+func latestVersion(name string, files []string) string {
+	var latest string
+	for _, file := range files {
+		elems := strings.Split(file, "/")
+		if len(elems) != 3 || elems[0] != name || elems[2] != metadataFileName {
+			continue
+		}
+		if latest == "" || compareVersions(elems[1], latest) > 0 {
+			latest = elems[1]
+		}
+	}
+	return latest
+}
+
+// compareVersions compares versions as semantic versions if they both are, otherwise lexically.
+func compareVersions(a, b string) int {
+	if semver.IsValid(a) && semver.IsValid(b) {
+		return semver.Compare(a, b)
+	}
+	return strings.Compare(a, b)
+}
+
+func isYAML(file string) bool {
+	ext := path.Ext(file)
+	return ext == ".yml" || ext == ".yaml"
+}
@@ -0,0 +1,270 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package catalog
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/catalog/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalog_List(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mocksource)
+
+		wanted      []Template
+		wantedError error
+	}{
+		"returns the error if the files cannot be listed": {
+			setupMocks: func(m *mocks.Mocksource) {
+				m.EXPECT().Files().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns a wrapped error if the metadata cannot be unmarshaled": {
+			setupMocks: func(m *mocks.Mocksource) {
+				m.EXPECT().Files().Return([]string{"go-api/v1/template.yml"}, nil)
+				m.EXPECT().Read("go-api/v1/template.yml").Return([]byte("type: [}"), nil)
+			},
+			wantedError: errors.New("unmarshal template.yml of template go-api@v1: yaml: did not find expected node content"),
+		},
+		"returns the templates sorted by name and from the latest version": {
+			setupMocks: func(m *mocks.Mocksource) {
+				m.EXPECT().Files().Return([]string{
+					"worker/v1/template.yml",
+					"go-api/v1.2.0/template.yml",
+					"go-api/v1.10.0/template.yml",
+					"go-api/v1.10.0/manifest.yml",
+					"README.md",
+				}, nil)
+				m.EXPECT().Read("worker/v1/template.yml").Return([]byte("type: Worker Service"), nil)
+				m.EXPECT().Read("go-api/v1.2.0/template.yml").Return([]byte("type: Load Balanced Web Service"), nil)
+				m.EXPECT().Read("go-api/v1.10.0/template.yml").Return([]byte(`type: Load Balanced Web Service
+description: A Go HTTP API.`), nil)
+			},
+			wanted: []Template{
+				{
+					Name:        "go-api",
+					Version:     "v1.10.0",
+					Type:        "Load Balanced Web Service",
+					Description: "A Go HTTP API.",
+				},
+				{
+					Name:    "go-api",
+					Version: "v1.2.0",
+					Type:    "Load Balanced Web Service",
+				},
+				{
+					Name:    "worker",
+					Version: "v1",
+					Type:    "Worker Service",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMocksource(ctrl)
+			tc.setupMocks(m)
+			c := &Catalog{src: m}
+
+			// WHEN
+			got, err := c.List()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestCatalog_Get(t *testing.T) {
+	files := []string{
+		"go-api/v1/template.yml",
+		"go-api/v1/manifest.yml",
+		"go-api/v2/template.yml",
+		"go-api/v2/manifest.yml",
+		"go-api/v2/Dockerfile",
+		"go-api/v2/addons/table.yml",
+		"go-api/v2/addons/README.md",
+		"broken/v1/template.yml",
+	}
+	testCases := map[string]struct {
+		ref        string
+		setupMocks func(m *mocks.Mocksource)
+
+		wanted      *Contents
+		wantedError error
+	}{
+		"returns ErrTemplateNotFound if the template does not exist": {
+			ref: "python-api",
+			setupMocks: func(m *mocks.Mocksource) {
+				m.EXPECT().Files().Return(files, nil)
+			},
+			wantedError: &ErrTemplateNotFound{Name: "python-api"},
+		},
+		"returns ErrTemplateNotFound if the version does not exist": {
+			ref: "go-api@v3",
+			setupMocks: func(m *mocks.Mocksource) {
+				m.EXPECT().Files().Return(files, nil)
+			},
+			wantedError: &ErrTemplateNotFound{Name: "go-api", Version: "v3"},
+		},
+		"returns an error if the template does not have a manifest": {
+			ref: "broken",
+			setupMocks: func(m *mocks.Mocksource) {
+				m.EXPECT().Files().Return(files, nil)
+			},
+			wantedError: errors.New("template broken does not contain a manifest.yml file"),
+		},
+		"returns the requested version": {
+			ref: "go-api@v1",
+			setupMocks: func(m *mocks.Mocksource) {
+				m.EXPECT().Files().Return(files, nil)
+				m.EXPECT().Read("go-api/v1/template.yml").Return([]byte("type: Backend Service"), nil)
+				m.EXPECT().Read("go-api/v1/manifest.yml").Return([]byte("name: {{.Name}}"), nil)
+			},
+			wanted: &Contents{
+				Template: Template{
+					Name:    "go-api",
+					Version: "v1",
+					Type:    "Backend Service",
+				},
+				Manifest: []byte("name: {{.Name}}"),
+			},
+		},
+		"returns the latest version with its Dockerfile and addons": {
+			ref: "go-api",
+			setupMocks: func(m *mocks.Mocksource) {
+				m.EXPECT().Files().Return(files, nil)
+				m.EXPECT().Read("go-api/v2/template.yml").Return([]byte("type: Load Balanced Web Service"), nil)
+				m.EXPECT().Read("go-api/v2/manifest.yml").Return([]byte("name: {{.Name}}"), nil)
+				m.EXPECT().Read("go-api/v2/Dockerfile").Return([]byte("FROM golang"), nil)
+				m.EXPECT().Read("go-api/v2/addons/table.yml").Return([]byte("Resources: {}"), nil)
+			},
+			wanted: &Contents{
+				Template: Template{
+					Name:    "go-api",
+					Version: "v2",
+					Type:    "Load Balanced Web Service",
+				},
+				Manifest:   []byte("name: {{.Name}}"),
+				Dockerfile: []byte("FROM golang"),
+				Addons: map[string][]byte{
+					"table.yml": []byte("Resources: {}"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMocksource(ctrl)
+			tc.setupMocks(m)
+			c := &Catalog{src: m}
+
+			// WHEN
+			got, err := c.Get(tc.ref)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestContents_RenderManifest(t *testing.T) {
+	testCases := map[string]struct {
+		manifest string
+
+		wanted      string
+		wantedError error
+	}{
+		"returns a wrapped error if the manifest cannot be parsed": {
+			manifest:    "name: {{.Name",
+			wantedError: errors.New(`parse manifest of template go-api: template: manifest.yml:1: unclosed action`),
+		},
+		"renders the manifest with the data": {
+			manifest: `name: {{.Name}}
+image:
+  build: {{.Dockerfile}}
+  port: {{.Port}}`,
+			wanted: `name: api
+image:
+  build: api/Dockerfile
+  port: 8080`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			c := &Contents{
+				Template: Template{Name: "go-api"},
+				Manifest: []byte(tc.manifest),
+			}
+
+			// WHEN
+			got, err := c.RenderManifest(ManifestData{
+				Name:       "api",
+				Dockerfile: "api/Dockerfile",
+				Port:       8080,
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, string(got))
+		})
+	}
+}
+
+func TestParseRef(t *testing.T) {
+	testCases := map[string]struct {
+		ref string
+
+		wantedName    string
+		wantedVersion string
+	}{
+		"name only": {
+			ref:        "go-api",
+			wantedName: "go-api",
+		},
+		"name and version": {
+			ref:           "go-api@v1.2.0",
+			wantedName:    "go-api",
+			wantedVersion: "v1.2.0",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotName, gotVersion := ParseRef(tc.ref)
+
+			require.Equal(t, tc.wantedName, gotName)
+			require.Equal(t, tc.wantedVersion, gotVersion)
+		})
+	}
+}
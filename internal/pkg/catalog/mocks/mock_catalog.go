@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/catalog/catalog.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// Mocksource is a mock of source interface.
+type Mocksource struct {
+	ctrl     *gomock.Controller
+	recorder *MocksourceMockRecorder
+}
+
+// MocksourceMockRecorder is the mock recorder for Mocksource.
+type MocksourceMockRecorder struct {
+	mock *Mocksource
+}
+
+// NewMocksource creates a new mock instance.
+func NewMocksource(ctrl *gomock.Controller) *Mocksource {
+	mock := &Mocksource{ctrl: ctrl}
+	mock.recorder = &MocksourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocksource) EXPECT() *MocksourceMockRecorder {
+	return m.recorder
+}
+
+// Files mocks base method.
+func (m *Mocksource) Files() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Files")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Files indicates an expected call of Files.
+func (mr *MocksourceMockRecorder) Files() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Files", reflect.TypeOf((*Mocksource)(nil).Files))
+}
+
+// Read mocks base method.
+func (m *Mocksource) Read(path string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", path)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MocksourceMockRecorder) Read(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*Mocksource)(nil).Read), path)
+}
@@ -0,0 +1,107 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/catalog/source.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	exec "github.com/aws/copilot-cli/internal/pkg/exec"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// Mocks3Client is a mock of s3Client interface.
+type Mocks3Client struct {
+	ctrl     *gomock.Controller
+	recorder *Mocks3ClientMockRecorder
+}
+
+// Mocks3ClientMockRecorder is the mock recorder for Mocks3Client.
+type Mocks3ClientMockRecorder struct {
+	mock *Mocks3Client
+}
+
+// NewMocks3Client creates a new mock instance.
+func NewMocks3Client(ctrl *gomock.Controller) *Mocks3Client {
+	mock := &Mocks3Client{ctrl: ctrl}
+	mock.recorder = &Mocks3ClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocks3Client) EXPECT() *Mocks3ClientMockRecorder {
+	return m.recorder
+}
+
+// Download mocks base method.
+func (m *Mocks3Client) Download(bucket, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", bucket, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Download indicates an expected call of Download.
+func (mr *Mocks3ClientMockRecorder) Download(bucket, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*Mocks3Client)(nil).Download), bucket, key)
+}
+
+// ListObjectKeys mocks base method.
+func (m *Mocks3Client) ListObjectKeys(bucket, prefix string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjectKeys", bucket, prefix)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjectKeys indicates an expected call of ListObjectKeys.
+func (mr *Mocks3ClientMockRecorder) ListObjectKeys(bucket, prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectKeys", reflect.TypeOf((*Mocks3Client)(nil).ListObjectKeys), bucket, prefix)
+}
+
+// Mockrunner is a mock of runner interface.
+type Mockrunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockrunnerMockRecorder
+}
+
+// MockrunnerMockRecorder is the mock recorder for Mockrunner.
+type MockrunnerMockRecorder struct {
+	mock *Mockrunner
+}
+
+// NewMockrunner creates a new mock instance.
+func NewMockrunner(ctrl *gomock.Controller) *Mockrunner {
+	mock := &Mockrunner{ctrl: ctrl}
+	mock.recorder = &MockrunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockrunner) EXPECT() *MockrunnerMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *Mockrunner) Run(name string, args []string, options ...exec.CmdOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name, args}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Run", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockrunnerMockRecorder) Run(name, args interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name, args}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*Mockrunner)(nil).Run), varargs...)
+}
@@ -0,0 +1,194 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package catalog
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/spf13/afero"
+)
+
+const (
+	s3LocationPrefix  = "s3://"
+	gitLocationPrefix = "git::"
+	gitDirName        = ".git"
+)
+
+type s3Client interface {
+	ListObjectKeys(bucket, prefix string) ([]string, error)
+	Download(bucket, key string) ([]byte, error)
+}
+
+type runner interface {
+	Run(name string, args []string, options ...exec.CmdOption) error
+}
+
+// New returns a catalog stored at location, which is either "s3://bucket[/prefix]" or "git::<repository URL>".
+func New(location string, sess *session.Session) (*Catalog, error) {
+	if err := ValidateLocation(location); err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(location, s3LocationPrefix) {
+		bucket, prefix := parseS3Location(location)
+		return &Catalog{
+			src: &s3Source{
+				bucket: bucket,
+				prefix: prefix,
+				client: s3.New(sess),
+			},
+		}, nil
+	}
+	return &Catalog{
+		src: &gitSource{
+			url:    strings.TrimPrefix(location, gitLocationPrefix),
+			runner: exec.NewCmd(),
+			fs:     afero.NewOsFs(),
+		},
+	}, nil
+}
+
+// ValidateLocation returns an error if location is not a supported catalog location.
+func ValidateLocation(location string) error {
+	switch {
+	case strings.HasPrefix(location, s3LocationPrefix):
+		if bucket, _ := parseS3Location(location); bucket == "" {
+			return fmt.Errorf("template catalog location %q must specify a bucket", location)
+		}
+		return nil
+	case strings.HasPrefix(location, gitLocationPrefix):
+		if strings.TrimPrefix(location, gitLocationPrefix) == "" {
+			return fmt.Errorf("template catalog location %q must specify a repository URL", location)
+		}
+		return nil
+	default:
+		return fmt.Errorf("template catalog location %q must start with %q or %q", location, s3LocationPrefix, gitLocationPrefix)
+	}
+}
+
+// parseS3Location returns the bucket and the key prefix, ending with a slash if not empty, of an "s3://bucket/prefix" location.
+func parseS3Location(location string) (bucket, prefix string) {
+	parts := strings.SplitN(strings.TrimPrefix(location, s3LocationPrefix), "/", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	prefix = strings.Trim(parts[1], "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	return parts[0], prefix
+}
+
+// s3Source reads a catalog stored under a prefix of an S3 bucket.
+type s3Source struct {
+	bucket string
+	prefix string
+	client s3Client
+}
+
+// Files returns the paths of all the files in the catalog relative to the prefix.
+func (s *s3Source) Files() ([]string, error) {
+	keys, err := s.client.ListObjectKeys(s.bucket, s.prefix)
+	if err != nil {
+		return nil, fmt.Errorf("list templates in bucket %s: %w", s.bucket, err)
+	}
+	var files []string
+	for _, key := range keys {
+		if strings.HasSuffix(key, "/") {
+			continue // Skip folder placeholders.
+		}
+		files = append(files, strings.TrimPrefix(key, s.prefix))
+	}
+	return files, nil
+}
+
+// Read returns the content of the file at path relative to the prefix.
+func (s *s3Source) Read(path string) ([]byte, error) {
+	content, err := s.client.Download(s.bucket, s.prefix+path)
+	if err != nil {
+		return nil, fmt.Errorf("download template file %s: %w", path, err)
+	}
+	return content, nil
+}
+
+// gitSource reads a catalog stored in a git repository.
+// The repository is cloned once and its files are kept in memory.
+type gitSource struct {
+	url    string
+	runner runner
+	fs     afero.Fs
+
+	files map[string][]byte
+}
+
+// Files returns the paths of all the files in the repository.
+func (s *gitSource) Files() ([]string, error) {
+	if err := s.clone(); err != nil {
+		return nil, err
+	}
+	var files []string
+	for file := range s.files {
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// Read returns the content of the file at path in the repository.
+func (s *gitSource) Read(path string) ([]byte, error) {
+	if err := s.clone(); err != nil {
+		return nil, err
+	}
+	content, ok := s.files[path]
+	if !ok {
+		return nil, fmt.Errorf("template file %s not found in repository %s", path, s.url)
+	}
+	return content, nil
+}
+
+func (s *gitSource) clone() error {
+	if s.files != nil {
+		return nil
+	}
+	dir, err := afero.TempDir(s.fs, "", "copilot-templates-")
+	if err != nil {
+		return fmt.Errorf("create temporary directory for the template catalog: %w", err)
+	}
+	defer s.fs.RemoveAll(dir)
+
+	if err := s.runner.Run("git", []string{"clone", "--quiet", "--depth", "1", s.url, dir}); err != nil {
+		return fmt.Errorf("clone template catalog %s: %w", s.url, err)
+	}
+	files := make(map[string][]byte)
+	err = afero.Walk(s.fs, dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == gitDirName {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		content, err := afero.ReadFile(s.fs, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = content
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("read template catalog %s: %w", s.url, err)
+	}
+	s.files = files
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package catalog
+
+import (
+	"errors"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/catalog/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateLocation(t *testing.T) {
+	testCases := map[string]struct {
+		location string
+
+		wantedError error
+	}{
+		"valid s3 location": {
+			location: "s3://my-bucket/templates",
+		},
+		"valid git location": {
+			location: "git::https://github.com/acme/copilot-templates.git",
+		},
+		"s3 location without a bucket": {
+			location:    "s3://",
+			wantedError: errors.New(`template catalog location "s3://" must specify a bucket`),
+		},
+		"git location without a URL": {
+			location:    "git::",
+			wantedError: errors.New(`template catalog location "git::" must specify a repository URL`),
+		},
+		"unsupported location": {
+			location:    "https://example.com/templates",
+			wantedError: errors.New(`template catalog location "https://example.com/templates" must start with "s3://" or "git::"`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateLocation(tc.location)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestS3Source(t *testing.T) {
+	t.Run("lists the files relative to the prefix", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMocks3Client(ctrl)
+		m.EXPECT().ListObjectKeys("my-bucket", "templates/").Return([]string{
+			"templates/go-api/",
+			"templates/go-api/v1/template.yml",
+		}, nil)
+		bucket, prefix := parseS3Location("s3://my-bucket/templates/")
+		src := &s3Source{bucket: bucket, prefix: prefix, client: m}
+
+		// WHEN
+		files, err := src.Files()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []string{"go-api/v1/template.yml"}, files)
+	})
+	t.Run("returns a wrapped error if the files cannot be downloaded", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMocks3Client(ctrl)
+		m.EXPECT().Download("my-bucket", "go-api/v1/template.yml").Return(nil, errors.New("some error"))
+		src := &s3Source{bucket: "my-bucket", client: m}
+
+		// WHEN
+		_, err := src.Read("go-api/v1/template.yml")
+
+		// THEN
+		require.EqualError(t, err, "download template file go-api/v1/template.yml: some error")
+	})
+}
+
+func TestGitSource(t *testing.T) {
+	const url = "https://github.com/acme/copilot-templates.git"
+	t.Run("returns a wrapped error if the repository cannot be cloned", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockrunner(ctrl)
+		m.EXPECT().Run("git", gomock.Any()).Return(errors.New("some error"))
+		src := &gitSource{url: url, runner: m, fs: afero.NewMemMapFs()}
+
+		// WHEN
+		_, err := src.Files()
+
+		// THEN
+		require.EqualError(t, err, "clone template catalog https://github.com/acme/copilot-templates.git: some error")
+	})
+	t.Run("clones the repository once and reads its files", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		fs := afero.NewMemMapFs()
+		m := mocks.NewMockrunner(ctrl)
+		m.EXPECT().Run("git", gomock.Any()).DoAndReturn(func(_ string, args []string, _ ...exec.CmdOption) error {
+			require.Equal(t, []string{"clone", "--quiet", "--depth", "1", url}, args[:5])
+			dir := args[5]
+			require.NoError(t, afero.WriteFile(fs, filepath.Join(dir, "go-api", "v1", "template.yml"), []byte("type: Backend Service"), 0644))
+			require.NoError(t, afero.WriteFile(fs, filepath.Join(dir, ".git", "HEAD"), []byte("ref: refs/heads/main"), 0644))
+			return nil
+		}).Times(1)
+		src := &gitSource{url: url, runner: m, fs: fs}
+
+		// WHEN
+		files, err := src.Files()
+		require.NoError(t, err)
+		content, err := src.Read("go-api/v1/template.yml")
+
+		// THEN
+		require.NoError(t, err)
+		sort.Strings(files)
+		require.Equal(t, []string{"go-api/v1/template.yml"}, files)
+		require.Equal(t, "type: Backend Service", string(content))
+	})
+}
@@ -25,6 +25,8 @@ Applications are a collection of services and environments.`,
 	cmd.AddCommand(buildAppShowCmd())
 	cmd.AddCommand(buildAppDeleteCommand())
 	cmd.AddCommand(buildAppUpgradeCmd())
+	cmd.AddCommand(buildAppGCCmd())
+	cmd.AddCommand(buildAppDNSCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
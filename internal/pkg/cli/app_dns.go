@@ -0,0 +1,393 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appDNSVerifyNamePrompt       = "Which application's DNS delegation would you like to verify?"
+	appDNSVerifyNameHelpPrompt   = "An application is a collection of related services."
+	fmtAppDNSVerifyConfirmPrompt = "Are you sure you want to update %s in the hosted zones of application %s?"
+	appDNSVerifyConfirmHelp      = "The NS records are updated to the name servers of the hosted zones of the subdomains."
+
+	// TTLs of the NS records created by the application stack and by the DNS delegation custom resource of environments.
+	appDomainDelegationTTL = 900
+	envDomainDelegationTTL = 60
+
+	dnsDelegationHealthy     = "healthy"
+	dnsDelegationMissing     = "missing"      // The parent zone has no NS record for the subdomain.
+	dnsDelegationStale       = "stale"        // The NS record doesn't point to the name servers of the subdomain's zone.
+	dnsDelegationMissingZone = "missing zone" // The subdomain has no hosted zone to delegate to.
+	dnsDelegationOrphaned    = "orphaned"     // The NS record is for a subdomain that isn't an environment of the application.
+)
+
+var (
+	errAppDNSVerifyCancelled = errors.New("app dns verify cancelled - no changes made")
+)
+
+type verifyAppDNSVars struct {
+	name             string
+	fix              bool
+	skipConfirmation bool
+}
+
+type verifyAppDNSOpts struct {
+	verifyAppDNSVars
+
+	store  store
+	appDNS dnsDelegationManager // Route 53 client of the application account, which owns the root and application zones.
+	sel    appSelector
+	prompt prompter
+	w      io.Writer
+
+	// newEnvDNS returns a Route 53 client of the account that owns the hosted zone of the environment.
+	newEnvDNS func(env *config.Environment) (hostedZoneDescriber, error)
+}
+
+func newVerifyAppDNSOpts(vars verifyAppDNSVars) (*verifyAppDNSOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("app dns verify"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	prompter := prompt.New()
+	return &verifyAppDNSOpts{
+		verifyAppDNSVars: vars,
+
+		store:  store,
+		appDNS: route53.New(defaultSess),
+		sel:    selector.NewAppEnvSelector(prompter, store),
+		prompt: prompter,
+		w:      log.OutputWriter,
+		newEnvDNS: func(env *config.Environment) (hostedZoneDescriber, error) {
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return route53.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *verifyAppDNSOpts) Validate() error {
+	if o.name == "" {
+		return nil
+	}
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	if app.Domain == "" {
+		return fmt.Errorf("application %s is not associated with a domain", o.name)
+	}
+	return nil
+}
+
+// Ask prompts for the application name if it's not provided.
+func (o *verifyAppDNSOpts) Ask() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.sel.Application(appDNSVerifyNamePrompt, appDNSVerifyNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.name = name
+	return o.Validate()
+}
+
+// dnsDelegation is a link of the delegation chain from the domain of an application to the subdomains of its environments.
+type dnsDelegation struct {
+	Subdomain    string
+	Parent       string // Domain of the hosted zone that delegates the subdomain.
+	ParentZoneID string
+	Status       string
+
+	nameServers []string // Name servers of the hosted zone of the subdomain.
+	ttl         int64
+}
+
+// Execute checks that the root domain of the application delegates to the hosted zone of the application,
+// and that the hosted zone of the application delegates to the hosted zone of each environment.
+// If --fix is set, the NS records that are missing or stale are updated like the DNS delegation custom resource does.
+func (o *verifyAppDNSOpts) Execute() error {
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	delegations, err := o.delegations(app)
+	if err != nil {
+		return err
+	}
+	o.writeReport(delegations)
+
+	var broken []*dnsDelegation
+	for _, d := range delegations {
+		switch d.Status {
+		case dnsDelegationMissing, dnsDelegationStale:
+			broken = append(broken, d)
+		case dnsDelegationMissingZone:
+			log.Warningf("Environment subdomain %s doesn't have a hosted zone. Run %s to recreate it.\n",
+				d.Subdomain, color.HighlightCode("copilot env deploy"))
+		case dnsDelegationOrphaned:
+			log.Warningf("NS record %s doesn't belong to any environment of application %s. Delete it if its environment was deleted.\n",
+				d.Subdomain, o.name)
+		}
+	}
+	if len(broken) == 0 {
+		log.Successf("The domain %s of application %s delegates to all of its environments.\n", app.Domain, o.name)
+		return nil
+	}
+	if !o.fix {
+		log.Infof("Run %s to update the NS records.\n", color.HighlightCode(fmt.Sprintf("copilot app dns verify -n %s --fix", o.name)))
+		return fmt.Errorf("DNS delegation of application %s is broken for %s", o.name, strings.Join(subdomains(broken), ", "))
+	}
+	if !o.skipConfirmation {
+		records := fmt.Sprintf("%d NS record", len(broken))
+		if len(broken) > 1 {
+			records += "s"
+		}
+		yes, err := o.prompt.Confirm(fmt.Sprintf(fmtAppDNSVerifyConfirmPrompt, records, color.HighlightUserInput(o.name)), appDNSVerifyConfirmHelp)
+		if err != nil {
+			return fmt.Errorf("confirm DNS delegation repair of application %s: %w", o.name, err)
+		}
+		if !yes {
+			return errAppDNSVerifyCancelled
+		}
+	}
+	for _, d := range broken {
+		record := route53.NSRecord{
+			Name:        d.Subdomain,
+			NameServers: d.nameServers,
+		}
+		if err := o.appDNS.UpsertNSRecord(d.ParentZoneID, record, d.ttl); err != nil {
+			return err
+		}
+		log.Successf("Delegated %s from %s to its hosted zone.\n", d.Subdomain, d.Parent)
+	}
+	return nil
+}
+
+// delegations returns the delegation from the root domain to the application subdomain first,
+// followed by the delegations from the application subdomain to each environment subdomain, sorted by name.
+func (o *verifyAppDNSOpts) delegations(app *config.Application) ([]*dnsDelegation, error) {
+	rootZoneID := app.DomainHostedZoneID
+	if rootZoneID == "" {
+		// Applications created before the hosted zone ID was stored.
+		id, err := o.appDNS.DomainHostedZoneID(app.Domain)
+		if err != nil {
+			return nil, fmt.Errorf("get hosted zone of domain %s: %w", app.Domain, err)
+		}
+		rootZoneID = id
+	}
+	appDomain := fmt.Sprintf("%s.%s", app.Name, app.Domain)
+	appZoneID, err := o.appDNS.DomainHostedZoneID(appDomain)
+	if err != nil {
+		return nil, fmt.Errorf("get hosted zone of application subdomain %s: %w", appDomain, err)
+	}
+	appNameServers, err := o.appDNS.NameServers(appZoneID)
+	if err != nil {
+		return nil, fmt.Errorf("get name servers of application subdomain %s: %w", appDomain, err)
+	}
+	rootRecords, err := o.appDNS.ListNSRecords(rootZoneID)
+	if err != nil {
+		return nil, fmt.Errorf("list NS records of domain %s: %w", app.Domain, err)
+	}
+	delegations := []*dnsDelegation{
+		{
+			Subdomain:    appDomain,
+			Parent:       app.Domain,
+			ParentZoneID: rootZoneID,
+			Status:       delegationStatus(rootRecords, appDomain, appNameServers),
+			nameServers:  appNameServers,
+			ttl:          appDomainDelegationTTL,
+		},
+	}
+
+	envs, err := o.store.ListEnvironments(app.Name)
+	if err != nil {
+		return nil, fmt.Errorf("list environments of application %s: %w", app.Name, err)
+	}
+	appRecords, err := o.appDNS.ListNSRecords(appZoneID)
+	if err != nil {
+		return nil, fmt.Errorf("list NS records of application subdomain %s: %w", appDomain, err)
+	}
+	envDomains := make(map[string]bool)
+	for _, env := range envs {
+		envDomain := fmt.Sprintf("%s.%s", env.Name, appDomain)
+		envDomains[envDomain] = true
+		d := &dnsDelegation{
+			Subdomain:    envDomain,
+			Parent:       appDomain,
+			ParentZoneID: appZoneID,
+			ttl:          envDomainDelegationTTL,
+		}
+		delegations = append(delegations, d)
+
+		envDNS, err := o.newEnvDNS(env)
+		if err != nil {
+			return nil, err
+		}
+		envZoneID, err := envDNS.DomainHostedZoneID(envDomain)
+		if err != nil {
+			var errNotFound *route53.ErrDomainHostedZoneNotFound
+			if !errors.As(err, &errNotFound) {
+				return nil, fmt.Errorf("get hosted zone of environment subdomain %s: %w", envDomain, err)
+			}
+			d.Status = dnsDelegationMissingZone
+			continue
+		}
+		if d.nameServers, err = envDNS.NameServers(envZoneID); err != nil {
+			return nil, fmt.Errorf("get name servers of environment subdomain %s: %w", envDomain, err)
+		}
+		d.Status = delegationStatus(appRecords, envDomain, d.nameServers)
+	}
+	for _, record := range appRecords {
+		if record.Name == appDomain || envDomains[record.Name] {
+			continue
+		}
+		delegations = append(delegations, &dnsDelegation{
+			Subdomain:    record.Name,
+			Parent:       appDomain,
+			ParentZoneID: appZoneID,
+			Status:       dnsDelegationOrphaned,
+		})
+	}
+	envDelegations := delegations[1:]
+	sort.SliceStable(envDelegations, func(i, j int) bool {
+		return envDelegations[i].Subdomain < envDelegations[j].Subdomain
+	})
+	return delegations, nil
+}
+
+func (o *verifyAppDNSOpts) writeReport(delegations []*dnsDelegation) {
+	writer := tabwriter.NewWriter(o.w, 0, 4, 2, ' ', 0)
+	fmt.Fprint(writer, color.Bold.Sprint("DNS Delegation\n\n"))
+	writer.Flush()
+	writeTable(writer, []string{"Subdomain", "Delegated By", "Status"}, func() {
+		for _, d := range delegations {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", d.Subdomain, d.Parent, colorDNSDelegationStatus(d.Status))
+		}
+	})
+}
+
+// delegationStatus returns whether the NS record of the subdomain in the parent zone points to the wanted name servers.
+func delegationStatus(records []route53.NSRecord, subdomain string, nameServers []string) string {
+	for _, record := range records {
+		if record.Name != subdomain {
+			continue
+		}
+		if sameNameServers(record.NameServers, nameServers) {
+			return dnsDelegationHealthy
+		}
+		return dnsDelegationStale
+	}
+	return dnsDelegationMissing
+}
+
+// sameNameServers returns true if both lists hold the same name servers regardless of their order and case.
+func sameNameServers(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	normalize := func(names []string) []string {
+		normalized := make([]string, len(names))
+		for i, name := range names {
+			normalized[i] = strings.ToLower(name)
+		}
+		sort.Strings(normalized)
+		return normalized
+	}
+	a, b = normalize(a), normalize(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func subdomains(delegations []*dnsDelegation) []string {
+	var names []string
+	for _, d := range delegations {
+		names = append(names, d.Subdomain)
+	}
+	return names
+}
+
+func colorDNSDelegationStatus(status string) string {
+	switch status {
+	case dnsDelegationHealthy:
+		return color.Green.Sprint(status)
+	case dnsDelegationOrphaned:
+		return color.Yellow.Sprint(status)
+	default:
+		return color.Red.Sprint(status)
+	}
+}
+
+// buildAppDNSCmd builds the command for managing the DNS delegation of an application.
+func buildAppDNSCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dns",
+		Short: "Commands for the DNS delegation of applications.",
+		Long: `Commands for the DNS delegation of applications.
+The domain of an application delegates its subdomain to the hosted zone of the application,
+which delegates the subdomain of each environment to the hosted zone of the environment.`,
+	}
+	cmd.AddCommand(buildAppDNSVerifyCmd())
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
+
+// buildAppDNSVerifyCmd builds the command for verifying the DNS delegation chain of an application.
+func buildAppDNSVerifyCmd() *cobra.Command {
+	vars := verifyAppDNSVars{}
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verifies the DNS delegation from the domain of an application to its environments.",
+		Long: `Verifies the DNS delegation from the domain of an application to its environments.
+Checks that the NS records of the application and environment subdomains point to the name servers of their hosted zones,
+and reports the NS records that don't belong to any environment.`,
+		Example: `
+  Verify the DNS delegation of application "my-app".
+  /code $ copilot app dns verify -n my-app
+
+  Update the missing or stale NS records of "my-app" without confirmation.
+  /code $ copilot app dns verify -n my-app --fix --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newVerifyAppDNSOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.fix, fixFlag, false, appDNSVerifyFixFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
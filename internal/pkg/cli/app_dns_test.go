@@ -0,0 +1,310 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type verifyAppDNSMocks struct {
+	store  *mocks.Mockstore
+	appDNS *mocks.MockdnsDelegationManager
+	envDNS *mocks.MockhostedZoneDescriber
+	prompt *mocks.Mockprompter
+}
+
+func TestVerifyAppDNSOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"skip validation if the application name isn't provided": {
+			setupMocks: func(m *mocks.Mockstore) {},
+		},
+		"error if fails to get the application": {
+			inName: "phonetool",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get application phonetool: some error"),
+		},
+		"error if the application doesn't have a domain": {
+			inName: "phonetool",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			wantedError: errors.New("application phonetool is not associated with a domain"),
+		},
+		"valid application with a domain": {
+			inName: "phonetool",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool", Domain: "example.com"}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			tc.setupMocks(store)
+			opts := &verifyAppDNSOpts{
+				verifyAppDNSVars: verifyAppDNSVars{
+					name: tc.inName,
+				},
+				store: store,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyAppDNSOpts_Execute(t *testing.T) {
+	const (
+		testApp       = "phonetool"
+		testDomain    = "example.com"
+		testAppDomain = "phonetool.example.com"
+		rootZoneID    = "Z0ROOT"
+		appZoneID     = "Z0APP"
+		testZoneID    = "Z0TEST"
+		prodZoneID    = "Z0PROD"
+	)
+	testApplication := &config.Application{Name: testApp, Domain: testDomain, DomainHostedZoneID: rootZoneID}
+	envs := []*config.Environment{
+		{App: testApp, Name: "test"},
+		{App: testApp, Name: "prod"},
+	}
+	appNameServers := []string{"ns-1.awsdns-01.org", "ns-2.awsdns-02.com"}
+	testNameServers := []string{"ns-3.awsdns-03.net", "ns-4.awsdns-04.co.uk"}
+	prodNameServers := []string{"ns-5.awsdns-05.org", "ns-6.awsdns-06.com"}
+	describeZones := func(m *verifyAppDNSMocks) {
+		m.store.EXPECT().GetApplication(testApp).Return(testApplication, nil)
+		m.appDNS.EXPECT().DomainHostedZoneID(testAppDomain).Return(appZoneID, nil)
+		m.appDNS.EXPECT().NameServers(appZoneID).Return(appNameServers, nil)
+		m.store.EXPECT().ListEnvironments(testApp).Return(envs, nil)
+		m.envDNS.EXPECT().DomainHostedZoneID("test.phonetool.example.com").Return(testZoneID, nil)
+		m.envDNS.EXPECT().NameServers(testZoneID).Return(testNameServers, nil)
+		m.envDNS.EXPECT().DomainHostedZoneID("prod.phonetool.example.com").Return(prodZoneID, nil)
+		m.envDNS.EXPECT().NameServers(prodZoneID).Return(prodNameServers, nil)
+	}
+	healthyRecords := func(m *verifyAppDNSMocks) {
+		m.appDNS.EXPECT().ListNSRecords(rootZoneID).Return([]route53.NSRecord{
+			{Name: testDomain, NameServers: []string{"ns-7.awsdns-07.org"}},
+			{Name: testAppDomain, NameServers: []string{"NS-2.AWSDNS-02.COM", "ns-1.awsdns-01.org"}},
+		}, nil)
+		m.appDNS.EXPECT().ListNSRecords(appZoneID).Return([]route53.NSRecord{
+			{Name: testAppDomain, NameServers: appNameServers},
+			{Name: "test.phonetool.example.com", NameServers: testNameServers},
+			{Name: "prod.phonetool.example.com", NameServers: prodNameServers},
+		}, nil)
+	}
+	brokenRecords := func(m *verifyAppDNSMocks) {
+		m.appDNS.EXPECT().ListNSRecords(rootZoneID).Return([]route53.NSRecord{
+			{Name: testDomain, NameServers: []string{"ns-7.awsdns-07.org"}},
+			{Name: testAppDomain, NameServers: appNameServers},
+		}, nil)
+		m.appDNS.EXPECT().ListNSRecords(appZoneID).Return([]route53.NSRecord{
+			{Name: testAppDomain, NameServers: appNameServers},
+			{Name: "prod.phonetool.example.com", NameServers: []string{"ns-8.awsdns-08.net"}},
+			{Name: "old.phonetool.example.com", NameServers: []string{"ns-9.awsdns-09.net"}},
+		}, nil)
+	}
+	brokenReport := `DNS Delegation
+
+  Subdomain                   Delegated By           Status
+  ---------                   ------------           ------
+  phonetool.example.com       example.com            healthy
+  old.phonetool.example.com   phonetool.example.com  orphaned
+  prod.phonetool.example.com  phonetool.example.com  stale
+  test.phonetool.example.com  phonetool.example.com  missing
+`
+	testCases := map[string]struct {
+		inFix              bool
+		inSkipConfirmation bool
+
+		setupMocks func(m *verifyAppDNSMocks)
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"error if fails to get the hosted zone of the application": {
+			setupMocks: func(m *verifyAppDNSMocks) {
+				m.store.EXPECT().GetApplication(testApp).Return(testApplication, nil)
+				m.appDNS.EXPECT().DomainHostedZoneID(testAppDomain).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("get hosted zone of application subdomain phonetool.example.com: some error"),
+		},
+		"error if fails to get the name servers of an environment": {
+			setupMocks: func(m *verifyAppDNSMocks) {
+				m.store.EXPECT().GetApplication(testApp).Return(testApplication, nil)
+				m.appDNS.EXPECT().DomainHostedZoneID(testAppDomain).Return(appZoneID, nil)
+				m.appDNS.EXPECT().NameServers(appZoneID).Return(appNameServers, nil)
+				m.appDNS.EXPECT().ListNSRecords(rootZoneID).Return(nil, nil)
+				m.store.EXPECT().ListEnvironments(testApp).Return(envs[:1], nil)
+				m.appDNS.EXPECT().ListNSRecords(appZoneID).Return(nil, nil)
+				m.envDNS.EXPECT().DomainHostedZoneID("test.phonetool.example.com").Return(testZoneID, nil)
+				m.envDNS.EXPECT().NameServers(testZoneID).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get name servers of environment subdomain test.phonetool.example.com: some error"),
+		},
+		"healthy delegation": {
+			setupMocks: func(m *verifyAppDNSMocks) {
+				describeZones(m)
+				healthyRecords(m)
+			},
+			wantedOutput: `DNS Delegation
+
+  Subdomain                   Delegated By           Status
+  ---------                   ------------           ------
+  phonetool.example.com       example.com            healthy
+  prod.phonetool.example.com  phonetool.example.com  healthy
+  test.phonetool.example.com  phonetool.example.com  healthy
+`,
+		},
+		"environment without a hosted zone can't be fixed": {
+			setupMocks: func(m *verifyAppDNSMocks) {
+				m.store.EXPECT().GetApplication(testApp).Return(testApplication, nil)
+				m.appDNS.EXPECT().DomainHostedZoneID(testAppDomain).Return(appZoneID, nil)
+				m.appDNS.EXPECT().NameServers(appZoneID).Return(appNameServers, nil)
+				m.appDNS.EXPECT().ListNSRecords(rootZoneID).Return([]route53.NSRecord{
+					{Name: testAppDomain, NameServers: appNameServers},
+				}, nil)
+				m.store.EXPECT().ListEnvironments(testApp).Return(envs[:1], nil)
+				m.appDNS.EXPECT().ListNSRecords(appZoneID).Return(nil, nil)
+				m.envDNS.EXPECT().DomainHostedZoneID("test.phonetool.example.com").Return("", &route53.ErrDomainHostedZoneNotFound{})
+			},
+			wantedOutput: `DNS Delegation
+
+  Subdomain                   Delegated By           Status
+  ---------                   ------------           ------
+  phonetool.example.com       example.com            healthy
+  test.phonetool.example.com  phonetool.example.com  missing zone
+`,
+		},
+		"error if the delegation is broken without --fix": {
+			setupMocks: func(m *verifyAppDNSMocks) {
+				describeZones(m)
+				brokenRecords(m)
+			},
+			wantedOutput: brokenReport,
+			wantedError:  errors.New("DNS delegation of application phonetool is broken for prod.phonetool.example.com, test.phonetool.example.com"),
+		},
+		"do not fix the delegation if the user cancels": {
+			inFix: true,
+			setupMocks: func(m *verifyAppDNSMocks) {
+				describeZones(m)
+				brokenRecords(m)
+				m.prompt.EXPECT().Confirm(gomock.Any(), appDNSVerifyConfirmHelp).Return(false, nil)
+			},
+			wantedOutput: brokenReport,
+			wantedError:  errAppDNSVerifyCancelled,
+		},
+		"error if fails to update a NS record": {
+			inFix:              true,
+			inSkipConfirmation: true,
+			setupMocks: func(m *verifyAppDNSMocks) {
+				describeZones(m)
+				brokenRecords(m)
+				m.appDNS.EXPECT().UpsertNSRecord(appZoneID, gomock.Any(), int64(60)).Return(errors.New("some error"))
+			},
+			wantedOutput: brokenReport,
+			wantedError:  errors.New("some error"),
+		},
+		"fix the missing and stale NS records": {
+			inFix: true,
+			setupMocks: func(m *verifyAppDNSMocks) {
+				describeZones(m)
+				brokenRecords(m)
+				m.prompt.EXPECT().Confirm("Are you sure you want to update 2 NS records in the hosted zones of application phonetool?", appDNSVerifyConfirmHelp).Return(true, nil)
+				m.appDNS.EXPECT().UpsertNSRecord(appZoneID, route53.NSRecord{
+					Name:        "prod.phonetool.example.com",
+					NameServers: prodNameServers,
+				}, int64(60)).Return(nil)
+				m.appDNS.EXPECT().UpsertNSRecord(appZoneID, route53.NSRecord{
+					Name:        "test.phonetool.example.com",
+					NameServers: testNameServers,
+				}, int64(60)).Return(nil)
+			},
+			wantedOutput: brokenReport,
+		},
+		"fix the delegation of the application subdomain": {
+			inFix:              true,
+			inSkipConfirmation: true,
+			setupMocks: func(m *verifyAppDNSMocks) {
+				describeZones(m)
+				m.appDNS.EXPECT().ListNSRecords(rootZoneID).Return(nil, nil)
+				m.appDNS.EXPECT().ListNSRecords(appZoneID).Return([]route53.NSRecord{
+					{Name: "test.phonetool.example.com", NameServers: testNameServers},
+					{Name: "prod.phonetool.example.com", NameServers: prodNameServers},
+				}, nil)
+				m.appDNS.EXPECT().UpsertNSRecord(rootZoneID, route53.NSRecord{
+					Name:        testAppDomain,
+					NameServers: appNameServers,
+				}, int64(900)).Return(nil)
+			},
+			wantedOutput: `DNS Delegation
+
+  Subdomain                   Delegated By           Status
+  ---------                   ------------           ------
+  phonetool.example.com       example.com            missing
+  prod.phonetool.example.com  phonetool.example.com  healthy
+  test.phonetool.example.com  phonetool.example.com  healthy
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &verifyAppDNSMocks{
+				store:  mocks.NewMockstore(ctrl),
+				appDNS: mocks.NewMockdnsDelegationManager(ctrl),
+				envDNS: mocks.NewMockhostedZoneDescriber(ctrl),
+				prompt: mocks.NewMockprompter(ctrl),
+			}
+			tc.setupMocks(m)
+			buf := new(bytes.Buffer)
+			opts := &verifyAppDNSOpts{
+				verifyAppDNSVars: verifyAppDNSVars{
+					name:             testApp,
+					fix:              tc.inFix,
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+				store:  m.store,
+				appDNS: m.appDNS,
+				prompt: m.prompt,
+				w:      buf,
+				newEnvDNS: func(env *config.Environment) (hostedZoneDescriber, error) {
+					return m.envDNS, nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedOutput, buf.String())
+		})
+	}
+}
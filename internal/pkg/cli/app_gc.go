@@ -0,0 +1,526 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	describestack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
+	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appGCNamePrompt       = "Which application would you like to clean up?"
+	appGCNameHelpPrompt   = "An application is a collection of related services."
+	fmtAppGCConfirmPrompt = "Are you sure you want to delete %s of images and artifacts from application %s?"
+	appGCConfirmHelp      = "Deleted images and artifacts can't be recovered."
+
+	defaultGCKeepImages = 10
+	defaultGCKeepDays   = 30
+
+	gcTargetRepository = "repository"
+	gcTargetBucket     = "bucket"
+)
+
+var (
+	errAppGCCancelled = errors.New("app gc cancelled - no changes made")
+)
+
+type gcAppVars struct {
+	name             string
+	keepImages       int
+	keepDays         int
+	dryRun           bool
+	skipConfirmation bool
+}
+
+type gcAppOpts struct {
+	gcAppVars
+
+	store        store
+	deployStore  deployedWorkloadsLister
+	appResources appResourcesGetter
+	sel          appSelector
+	prompt       prompter
+	w            io.Writer
+	now          func() time.Time
+
+	// deployedImage returns the container image that a workload is deployed with in the environment.
+	deployedImage     func(env *config.Environment, wkld string) (string, error)
+	newImagePruner    func(region string) (imagePruner, error)
+	newArtifactPruner func(region string) (artifactPruner, error)
+}
+
+func newGCAppOpts(vars gcAppVars) (*gcAppOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("app gc"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	return &gcAppOpts{
+		gcAppVars: vars,
+
+		store:        store,
+		deployStore:  deployStore,
+		appResources: cloudformation.New(defaultSess),
+		sel:          selector.NewAppEnvSelector(prompter, store),
+		prompt:       prompter,
+		w:            log.OutputWriter,
+		now:          time.Now,
+		deployedImage: func(env *config.Environment, wkld string) (string, error) {
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return "", err
+			}
+			descr, err := describestack.NewStackDescriber(stack.NameForService(vars.name, env.Name, wkld), sess).Describe()
+			if err != nil {
+				return "", err
+			}
+			return descr.Parameters[stack.WorkloadContainerImageParamKey], nil
+		},
+		newImagePruner: func(region string) (imagePruner, error) {
+			sess, err := sessProvider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create default session with region %s: %w", region, err)
+			}
+			return ecr.New(sess), nil
+		},
+		newArtifactPruner: func(region string) (artifactPruner, error) {
+			sess, err := sessProvider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create default session with region %s: %w", region, err)
+			}
+			return s3.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *gcAppOpts) Validate() error {
+	if o.keepImages < 0 {
+		return fmt.Errorf("--%s must be greater than or equal to 0", keepImagesFlag)
+	}
+	if o.keepDays < 0 {
+		return fmt.Errorf("--%s must be greater than or equal to 0", keepDaysFlag)
+	}
+	if o.name != "" {
+		if _, err := o.store.GetApplication(o.name); err != nil {
+			return fmt.Errorf("get application %s: %w", o.name, err)
+		}
+	}
+	return nil
+}
+
+// Ask prompts for the application name if it's not provided.
+func (o *gcAppOpts) Ask() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.sel.Application(appGCNamePrompt, appGCNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// gcTarget is an image repository or an artifact bucket along with the images or artifacts to delete from it.
+type gcTarget struct {
+	Region string
+	Type   string // Either "repository" or "bucket".
+	Name   string
+	Count  int
+	Size   int64 // Size in bytes reclaimed by deleting the images or artifacts.
+
+	delete func() error
+}
+
+// Execute lists the images and artifacts of the application that are past their retention policy, and deletes them
+// unless --dry-run is set.
+// An image is deleted if it's older than --keep-days, isn't among the --keep-images most recent images of its workload,
+// and isn't deployed in any environment.
+// A deployment artifact is deleted if it's older than --keep-days and isn't the latest artifact of its stack or function,
+// nor referenced by a set of custom resources that is kept.
+func (o *gcAppOpts) Execute() error {
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	wklds, err := o.store.ListWorkloads(o.name)
+	if err != nil {
+		return fmt.Errorf("list workloads of application %s: %w", o.name, err)
+	}
+	inUse, err := o.deployedImages()
+	if err != nil {
+		return err
+	}
+	regionalResources, err := o.appResources.GetRegionalAppResources(app)
+	if err != nil {
+		return fmt.Errorf("get regional resources of application %s: %w", o.name, err)
+	}
+	var targets []*gcTarget
+	for _, resources := range regionalResources {
+		repos, err := o.imageTargets(resources, wklds, inUse)
+		if err != nil {
+			return err
+		}
+		targets = append(targets, repos...)
+		bucket, err := o.artifactTarget(resources)
+		if err != nil {
+			return err
+		}
+		if bucket != nil {
+			targets = append(targets, bucket)
+		}
+	}
+	o.writeReport(targets)
+
+	var total int64
+	for _, target := range targets {
+		total += target.Size
+	}
+	if o.dryRun || len(targets) == 0 {
+		return nil
+	}
+	if !o.skipConfirmation {
+		yes, err := o.prompt.Confirm(fmt.Sprintf(fmtAppGCConfirmPrompt, humanize.Bytes(uint64(total)), color.HighlightUserInput(o.name)), appGCConfirmHelp)
+		if err != nil {
+			return fmt.Errorf("confirm garbage collection of application %s: %w", o.name, err)
+		}
+		if !yes {
+			return errAppGCCancelled
+		}
+	}
+	for _, target := range targets {
+		if err := target.delete(); err != nil {
+			return fmt.Errorf("delete from %s %s in region %s: %w", target.Type, target.Name, target.Region, err)
+		}
+		log.Successf("Deleted %d items (%s) from %s %s in region %s.\n", target.Count, humanize.Bytes(uint64(target.Size)), target.Type, target.Name, target.Region)
+	}
+	return nil
+}
+
+// deployedImages returns the tags and digests of the images deployed in any environment, by repository name.
+func (o *gcAppOpts) deployedImages() (map[string]map[string]bool, error) {
+	envs, err := o.store.ListEnvironments(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("list environments of application %s: %w", o.name, err)
+	}
+	inUse := make(map[string]map[string]bool)
+	for _, env := range envs {
+		svcs, err := o.deployStore.ListDeployedServices(o.name, env.Name)
+		if err != nil {
+			return nil, fmt.Errorf("list services deployed in environment %s: %w", env.Name, err)
+		}
+		jobs, err := o.deployStore.ListDeployedJobs(o.name, env.Name)
+		if err != nil {
+			return nil, fmt.Errorf("list jobs deployed in environment %s: %w", env.Name, err)
+		}
+		for _, wkld := range append(svcs, jobs...) {
+			image, err := o.deployedImage(env, wkld)
+			if err != nil {
+				return nil, fmt.Errorf("get the image of %s deployed in environment %s: %w", wkld, env.Name, err)
+			}
+			img := dockerfile.ParseBaseImage(image)
+			if !strings.Contains(img.Name, ecrRegistryHostInfix) {
+				continue
+			}
+			repo := img.Name[strings.Index(img.Name, "/")+1:]
+			if inUse[repo] == nil {
+				inUse[repo] = make(map[string]bool)
+			}
+			inUse[repo][img.Tag] = true
+			inUse[repo][img.Digest] = true
+		}
+	}
+	return inUse, nil
+}
+
+func (o *gcAppOpts) imageTargets(resources *stack.AppRegionalResources, wklds []*config.Workload, inUse map[string]map[string]bool) ([]*gcTarget, error) {
+	var repos []string
+	for name := range resources.RepositoryURLs {
+		repos = append(repos, stack.NameForWorkloadRepository(o.name, name, false))
+	}
+	sort.Strings(repos)
+	if resources.SharedRepositoryURL != "" {
+		repos = append(repos, stack.NameForWorkloadRepository(o.name, "", true))
+	}
+	if len(repos) == 0 {
+		return nil, nil
+	}
+	pruner, err := o.newImagePruner(resources.Region)
+	if err != nil {
+		return nil, err
+	}
+	var wkldNames []string
+	for _, wkld := range wklds {
+		wkldNames = append(wkldNames, wkld.Name)
+	}
+	var targets []*gcTarget
+	for _, repo := range repos {
+		images, err := pruner.ListImages(repo)
+		if err != nil {
+			return nil, fmt.Errorf("list images of repository %s in region %s: %w", repo, resources.Region, err)
+		}
+		var groups [][]ecr.Image
+		if repo == stack.NameForWorkloadRepository(o.name, "", true) {
+			groups = imagesByWorkload(images, wkldNames)
+		} else {
+			groups = [][]ecr.Image{images}
+		}
+		var expired []ecr.Image
+		for _, group := range groups {
+			expired = append(expired, expiredImages(group, inUse[repo], o.keepImages, o.expiry())...)
+		}
+		if len(expired) == 0 {
+			continue
+		}
+		target := &gcTarget{
+			Region: resources.Region,
+			Type:   gcTargetRepository,
+			Name:   repo,
+			Count:  len(expired),
+		}
+		for _, image := range expired {
+			target.Size += image.SizeInBytes
+		}
+		repo := repo
+		target.delete = func() error {
+			return pruner.DeleteImages(expired, repo)
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+func (o *gcAppOpts) artifactTarget(resources *stack.AppRegionalResources) (*gcTarget, error) {
+	if resources.S3Bucket == "" {
+		return nil, nil
+	}
+	pruner, err := o.newArtifactPruner(resources.Region)
+	if err != nil {
+		return nil, err
+	}
+	var objects []*s3.Object
+	for _, prefix := range artifactpath.DeploymentArtifactPrefixes() {
+		objs, err := pruner.ListObjects(resources.S3Bucket, prefix)
+		if err != nil {
+			return nil, fmt.Errorf("list artifacts in bucket %s: %w", resources.S3Bucket, err)
+		}
+		objects = append(objects, objs...)
+	}
+	expired, err := expiredArtifacts(objects, o.expiry(), func(key string) ([]byte, error) {
+		return pruner.Download(resources.S3Bucket, key)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(expired) == 0 {
+		return nil, nil
+	}
+	target := &gcTarget{
+		Region: resources.Region,
+		Type:   gcTargetBucket,
+		Name:   resources.S3Bucket,
+		Count:  len(expired),
+		delete: func() error {
+			return pruner.DeleteObjects(resources.S3Bucket, expired)
+		},
+	}
+	for _, obj := range expired {
+		target.Size += obj.Size
+	}
+	return target, nil
+}
+
+// expiry returns the time before which images and artifacts are past their retention period.
+func (o *gcAppOpts) expiry() time.Time {
+	return o.now().AddDate(0, 0, -o.keepDays)
+}
+
+func (o *gcAppOpts) writeReport(targets []*gcTarget) {
+	writer := tabwriter.NewWriter(o.w, 0, 4, 2, ' ', 0)
+	header := "Garbage\n\n"
+	if o.dryRun {
+		header = "Garbage (dry run)\n\n"
+	}
+	fmt.Fprint(writer, color.Bold.Sprint(header))
+	writer.Flush()
+	if len(targets) == 0 {
+		fmt.Fprintln(writer, "  No images or artifacts past their retention policy.")
+		writer.Flush()
+		return
+	}
+	var total int64
+	writeTable(writer, []string{"Region", "Type", "Name", "Items", "Size"}, func() {
+		for _, target := range targets {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\t%d\t%s\n", target.Region, target.Type, target.Name, target.Count, humanize.Bytes(uint64(target.Size)))
+			total += target.Size
+		}
+	})
+	fmt.Fprintf(writer, "\n  Total: %s\n", humanize.Bytes(uint64(total)))
+	writer.Flush()
+}
+
+// imagesByWorkload groups the images of a repository shared by the workloads of an application by workload,
+// based on the prefix of their tags. Images that don't belong to any workload are grouped together.
+func imagesByWorkload(images []ecr.Image, wklds []string) [][]ecr.Image {
+	// Match the longest workload names first, so that "api-v2-" tags don't belong to "api".
+	names := append([]string(nil), wklds...)
+	sort.Slice(names, func(i, j int) bool {
+		return len(names[i]) > len(names[j])
+	})
+	imagesForWkld := make(map[string][]ecr.Image)
+	for _, image := range images {
+		var owner string
+	tags:
+		for _, tag := range image.Tags {
+			for _, name := range names {
+				if strings.HasPrefix(tag, stack.SharedRepositoryImageTag(name, "")) {
+					owner = name
+					break tags
+				}
+			}
+		}
+		imagesForWkld[owner] = append(imagesForWkld[owner], image)
+	}
+	owners := make([]string, 0, len(imagesForWkld))
+	for owner := range imagesForWkld {
+		owners = append(owners, owner)
+	}
+	sort.Strings(owners)
+	groups := make([][]ecr.Image, len(owners))
+	for i, owner := range owners {
+		groups[i] = imagesForWkld[owner]
+	}
+	return groups
+}
+
+// expiredImages returns the images pushed before expiry that aren't among the keep most recent images,
+// deployed, or tagged "latest".
+func expiredImages(images []ecr.Image, inUse map[string]bool, keep int, expiry time.Time) []ecr.Image {
+	sorted := append([]ecr.Image(nil), images...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].PushedAt.After(sorted[j].PushedAt)
+	})
+	var expired []ecr.Image
+	for i, image := range sorted {
+		if i < keep || !image.PushedAt.Before(expiry) || inUse[image.Digest] {
+			continue
+		}
+		kept := false
+		for _, tag := range image.Tags {
+			if tag == defaultImageTag || inUse[tag] {
+				kept = true
+				break
+			}
+		}
+		if !kept {
+			expired = append(expired, image)
+		}
+	}
+	return expired
+}
+
+// expiredArtifacts returns the artifacts modified before expiry, except for the latest artifact of each directory
+// and the custom resources referenced by the sets that are kept.
+func expiredArtifacts(objects []*s3.Object, expiry time.Time, download func(key string) ([]byte, error)) ([]*s3.Object, error) {
+	latestInDir := make(map[string]*s3.Object)
+	for _, obj := range objects {
+		dir := path.Dir(obj.Key)
+		if latest, ok := latestInDir[dir]; !ok || obj.LastModified.After(latest.LastModified) {
+			latestInDir[dir] = obj
+		}
+	}
+	isKept := func(obj *s3.Object) bool {
+		return !obj.LastModified.Before(expiry) || latestInDir[path.Dir(obj.Key)] == obj
+	}
+	referenced := make(map[string]bool)
+	for _, obj := range objects {
+		if !artifactpath.IsCustomResourceSet(obj.Key) || !isKept(obj) {
+			continue
+		}
+		dat, err := download(obj.Key)
+		if err != nil {
+			return nil, fmt.Errorf("download custom resources set %s: %w", obj.Key, err)
+		}
+		var keyForFn map[string]string
+		if err := json.Unmarshal(dat, &keyForFn); err != nil {
+			return nil, fmt.Errorf("unmarshal custom resources set %s: %w", obj.Key, err)
+		}
+		for _, key := range keyForFn {
+			referenced[key] = true
+		}
+	}
+	var expired []*s3.Object
+	for _, obj := range objects {
+		if isKept(obj) || referenced[obj.Key] {
+			continue
+		}
+		expired = append(expired, obj)
+	}
+	return expired, nil
+}
+
+// buildAppGCCmd builds the command to delete the images and deployment artifacts of an application past their retention.
+func buildAppGCCmd() *cobra.Command {
+	vars := gcAppVars{}
+	cmd := &cobra.Command{
+		Use:   "gc",
+		Short: "Deletes old images and deployment artifacts of an application.",
+		Long: `Deletes old images and deployment artifacts of an application.
+Images are deleted from the ECR repositories of the application unless they are deployed or among the most recent images of their workload.
+Stack templates, addons, custom resources and remote build sources are deleted from the artifact buckets unless they are the latest of their stack.`,
+		Example: `
+  Report the images and artifacts of "my-app" that would be deleted without deleting them.
+  /code $ copilot app gc -n my-app --dry-run
+
+  Keep the 5 most recent images of each workload and any image or artifact from the last 7 days.
+  /code $ copilot app gc -n my-app --keep-images 5 --keep-days 7 --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newGCAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().IntVar(&vars.keepImages, keepImagesFlag, defaultGCKeepImages, keepImagesFlagDescription)
+	cmd.Flags().IntVar(&vars.keepDays, keepDaysFlag, defaultGCKeepDays, keepDaysFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, gcDryRunFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,272 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type gcAppMocks struct {
+	store        *mocks.Mockstore
+	deployStore  *mocks.MockdeployedWorkloadsLister
+	appResources *mocks.MockappResourcesGetter
+	prompt       *mocks.Mockprompter
+	images       *mocks.MockimagePruner
+	artifacts    *mocks.MockartifactPruner
+}
+
+func TestGCAppOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inKeepImages int
+		inKeepDays   int
+
+		wantedError error
+	}{
+		"valid retention": {
+			inKeepImages: 10,
+			inKeepDays:   30,
+		},
+		"error if the number of images to keep is negative": {
+			inKeepImages: -1,
+			wantedError:  errors.New("--keep-images must be greater than or equal to 0"),
+		},
+		"error if the number of days to keep is negative": {
+			inKeepDays:  -1,
+			wantedError: errors.New("--keep-days must be greater than or equal to 0"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &gcAppOpts{
+				gcAppVars: gcAppVars{
+					keepImages: tc.inKeepImages,
+					keepDays:   tc.inKeepDays,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGCAppOpts_Execute(t *testing.T) {
+	const (
+		testApp    = "phonetool"
+		testRegion = "us-west-2"
+		testBucket = "stackset-bucket"
+		testRepo   = "phonetool/api"
+	)
+	now := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	testApplication := &config.Application{Name: testApp}
+	testEnv := &config.Environment{App: testApp, Name: "test", Region: testRegion}
+
+	oldImage := ecr.Image{Digest: "sha256:old", Tags: []string{"v1"}, PushedAt: time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC), SizeInBytes: 1000}
+	images := []ecr.Image{
+		{Digest: "sha256:new", Tags: []string{"v3"}, PushedAt: time.Date(2022, 5, 30, 0, 0, 0, 0, time.UTC), SizeInBytes: 1000},
+		{Digest: "sha256:deployed", Tags: []string{"v0"}, PushedAt: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), SizeInBytes: 1000},
+		oldImage,
+		{Digest: "sha256:latest", Tags: []string{"latest"}, PushedAt: time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC), SizeInBytes: 1000},
+	}
+	oldTemplate := &s3.Object{Key: "manual/templates/phonetool-test-api/a.yml", Size: 500, LastModified: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+	templates := []*s3.Object{
+		oldTemplate,
+		{Key: "manual/templates/phonetool-test-api/b.yml", Size: 500, LastModified: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	olderFunction := &s3.Object{Key: "manual/scripts/custom-resources/fn/older.zip", Size: 500, LastModified: time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)}
+	customResources := []*s3.Object{
+		{Key: "manual/scripts/custom-resources/sets/v1.json", Size: 100, LastModified: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Key: "manual/scripts/custom-resources/fn/old.zip", Size: 500, LastModified: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		olderFunction,
+		{Key: "manual/scripts/custom-resources/fn/new.zip", Size: 500, LastModified: time.Date(2022, 5, 31, 0, 0, 0, 0, time.UTC)},
+	}
+	listGarbage := func(m *gcAppMocks) {
+		m.store.EXPECT().GetApplication(testApp).Return(testApplication, nil)
+		m.store.EXPECT().ListWorkloads(testApp).Return([]*config.Workload{{Name: "api"}}, nil)
+		m.store.EXPECT().ListEnvironments(testApp).Return([]*config.Environment{testEnv}, nil)
+		m.deployStore.EXPECT().ListDeployedServices(testApp, "test").Return([]string{"api"}, nil)
+		m.deployStore.EXPECT().ListDeployedJobs(testApp, "test").Return(nil, nil)
+		m.appResources.EXPECT().GetRegionalAppResources(testApplication).Return([]*stack.AppRegionalResources{
+			{
+				Region:         testRegion,
+				S3Bucket:       testBucket,
+				RepositoryURLs: map[string]string{"api": "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api"},
+			},
+		}, nil)
+		m.images.EXPECT().ListImages(testRepo).Return(images, nil)
+		m.artifacts.EXPECT().ListObjects(testBucket, "manual/templates/").Return(templates, nil)
+		m.artifacts.EXPECT().ListObjects(testBucket, "manual/addons/").Return(nil, nil)
+		m.artifacts.EXPECT().ListObjects(testBucket, "manual/scripts/custom-resources/").Return(customResources, nil)
+		m.artifacts.EXPECT().ListObjects(testBucket, "manual/remote-builds/").Return(nil, nil)
+		m.artifacts.EXPECT().Download(testBucket, "manual/scripts/custom-resources/sets/v1.json").
+			Return([]byte(`{"Fn":"manual/scripts/custom-resources/fn/old.zip"}`), nil)
+	}
+	report := `Garbage
+
+  Region     Type        Name             Items  Size
+  ------     ----        ----             -----  ----
+  us-west-2  repository  phonetool/api    1      1.0 kB
+  us-west-2  bucket      stackset-bucket  2      1.0 kB
+
+  Total: 2.0 kB
+`
+	testCases := map[string]struct {
+		inDryRun           bool
+		inSkipConfirmation bool
+
+		setupMocks func(m *gcAppMocks)
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"error if fails to get the application": {
+			setupMocks: func(m *gcAppMocks) {
+				m.store.EXPECT().GetApplication(testApp).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get application phonetool: some error"),
+		},
+		"error if fails to get a deployed image": {
+			setupMocks: func(m *gcAppMocks) {
+				m.store.EXPECT().GetApplication(testApp).Return(testApplication, nil)
+				m.store.EXPECT().ListWorkloads(testApp).Return(nil, nil)
+				m.store.EXPECT().ListEnvironments(testApp).Return([]*config.Environment{testEnv}, nil)
+				m.deployStore.EXPECT().ListDeployedServices(testApp, "test").Return([]string{"frontend"}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs(testApp, "test").Return(nil, nil)
+			},
+			wantedError: errors.New("get the image of frontend deployed in environment test: some error"),
+		},
+		"error if fails to list images": {
+			setupMocks: func(m *gcAppMocks) {
+				m.store.EXPECT().GetApplication(testApp).Return(testApplication, nil)
+				m.store.EXPECT().ListWorkloads(testApp).Return(nil, nil)
+				m.store.EXPECT().ListEnvironments(testApp).Return(nil, nil)
+				m.appResources.EXPECT().GetRegionalAppResources(testApplication).Return([]*stack.AppRegionalResources{
+					{
+						Region:         testRegion,
+						RepositoryURLs: map[string]string{"api": "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api"},
+					},
+				}, nil)
+				m.images.EXPECT().ListImages(testRepo).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list images of repository phonetool/api in region us-west-2: some error"),
+		},
+		"report the garbage without deleting it on a dry run": {
+			inDryRun:   true,
+			setupMocks: listGarbage,
+			wantedOutput: `Garbage (dry run)
+
+  Region     Type        Name             Items  Size
+  ------     ----        ----             -----  ----
+  us-west-2  repository  phonetool/api    1      1.0 kB
+  us-west-2  bucket      stackset-bucket  2      1.0 kB
+
+  Total: 2.0 kB
+`,
+		},
+		"cancel if the user doesn't confirm": {
+			setupMocks: func(m *gcAppMocks) {
+				listGarbage(m)
+				m.prompt.EXPECT().Confirm("Are you sure you want to delete 2.0 kB of images and artifacts from application phonetool?", appGCConfirmHelp).Return(false, nil)
+			},
+			wantedOutput: report,
+			wantedError:  errAppGCCancelled,
+		},
+		"delete the garbage": {
+			inSkipConfirmation: true,
+			setupMocks: func(m *gcAppMocks) {
+				listGarbage(m)
+				m.images.EXPECT().DeleteImages([]ecr.Image{oldImage}, testRepo).Return(nil)
+				m.artifacts.EXPECT().DeleteObjects(testBucket, []*s3.Object{oldTemplate, olderFunction}).Return(nil)
+			},
+			wantedOutput: report,
+		},
+		"error if fails to delete the garbage": {
+			inSkipConfirmation: true,
+			setupMocks: func(m *gcAppMocks) {
+				listGarbage(m)
+				m.images.EXPECT().DeleteImages([]ecr.Image{oldImage}, testRepo).Return(errors.New("some error"))
+			},
+			wantedOutput: report,
+			wantedError:  errors.New("delete from repository phonetool/api in region us-west-2: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &gcAppMocks{
+				store:        mocks.NewMockstore(ctrl),
+				deployStore:  mocks.NewMockdeployedWorkloadsLister(ctrl),
+				appResources: mocks.NewMockappResourcesGetter(ctrl),
+				prompt:       mocks.NewMockprompter(ctrl),
+				images:       mocks.NewMockimagePruner(ctrl),
+				artifacts:    mocks.NewMockartifactPruner(ctrl),
+			}
+			tc.setupMocks(m)
+			buf := new(bytes.Buffer)
+			opts := &gcAppOpts{
+				gcAppVars: gcAppVars{
+					name:             testApp,
+					keepImages:       1,
+					keepDays:         30,
+					dryRun:           tc.inDryRun,
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+				store:        m.store,
+				deployStore:  m.deployStore,
+				appResources: m.appResources,
+				prompt:       m.prompt,
+				w:            buf,
+				now:          func() time.Time { return now },
+				deployedImage: func(env *config.Environment, wkld string) (string, error) {
+					if wkld != "api" {
+						return "", errors.New("some error")
+					}
+					return "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api@sha256:deployed", nil
+				},
+				newImagePruner: func(region string) (imagePruner, error) {
+					return m.images, nil
+				},
+				newArtifactPruner: func(region string) (artifactPruner, error) {
+					return m.artifacts, nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedOutput, buf.String())
+		})
+	}
+}
+
+func TestImagesByWorkload(t *testing.T) {
+	api := ecr.Image{Digest: "sha256:1", Tags: []string{"api-abc"}}
+	apiV2 := ecr.Image{Digest: "sha256:2", Tags: []string{"api-v2-abc"}}
+	orphan := ecr.Image{Digest: "sha256:3", Tags: []string{"latest"}}
+
+	groups := imagesByWorkload([]ecr.Image{api, apiV2, orphan}, []string{"api", "api-v2"})
+
+	require.Equal(t, [][]ecr.Image{{orphan}, {api}, {apiV2}}, groups)
+}
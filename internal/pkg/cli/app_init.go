@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -15,6 +16,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/catalog"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
@@ -37,9 +39,13 @@ const (
 )
 
 type initAppVars struct {
-	name         string
-	domainName   string
-	resourceTags map[string]string
+	name             string
+	domainName       string
+	resourceTags     map[string]string
+	resourcePrefix   string
+	sharedRepository bool
+	templateCatalog  string
+	peeredCIDRs      []string
 }
 
 type initAppOpts struct {
@@ -105,6 +111,21 @@ func (o *initAppOpts) Validate() error {
 		}
 		o.cachedHostedZoneID = id
 	}
+	if o.resourcePrefix != "" {
+		if err := validateResourcePrefix(o.resourcePrefix); err != nil {
+			return fmt.Errorf("resource prefix %s is invalid: %w", o.resourcePrefix, err)
+		}
+	}
+	if o.templateCatalog != "" {
+		if err := catalog.ValidateLocation(o.templateCatalog); err != nil {
+			return err
+		}
+	}
+	for _, cidr := range o.peeredCIDRs {
+		if err := validateCIDR(cidr); err != nil {
+			return fmt.Errorf("peered CIDR %s is invalid: %w", cidr, err)
+		}
+	}
 	return nil
 }
 
@@ -178,6 +199,11 @@ func (o *initAppOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("get identity: %w", err)
 	}
+	if o.resourcePrefix != "" {
+		if err := o.validateResourceNamesUnique(o.name); err != nil {
+			return err
+		}
+	}
 
 	err = o.ws.Create(o.name)
 	if err != nil {
@@ -198,6 +224,7 @@ func (o *initAppOpts) Execute() error {
 		DomainHostedZoneID: hostedZoneID,
 		AdditionalTags:     o.resourceTags,
 		Version:            deploy.LatestAppTemplateVersion,
+		SharedRepository:   o.sharedRepository,
 	})
 	if err != nil {
 		o.prog.Stop(log.Serrorf(fmtAppInitFailed, color.HighlightUserInput(o.name)))
@@ -211,9 +238,19 @@ func (o *initAppOpts) Execute() error {
 		Domain:             o.domainName,
 		DomainHostedZoneID: hostedZoneID,
 		Tags:               o.resourceTags,
+		ResourcePrefix:     o.resourcePrefix,
+		SharedRepository:   o.sharedRepository,
+		TemplateCatalog:    o.templateCatalog,
+		PeeredCIDRs:        o.peeredCIDRs,
 	}); err != nil {
 		return err
 	}
+	if err := o.updateTemplateCatalog(); err != nil {
+		return err
+	}
+	if err := o.updatePeeredCIDRs(); err != nil {
+		return err
+	}
 	log.Successf("The directory %s will hold service manifests for application %s.\n", color.HighlightResource(workspace.CopilotDirName), color.HighlightUserInput(o.name))
 	log.Infoln()
 	return nil
@@ -234,6 +271,70 @@ func (o *initAppOpts) validateAppName(name string) error {
 	if o.domainName != "" && app.Domain != o.domainName {
 		return fmt.Errorf("application named %s already exists with a different domain name %s", name, app.Domain)
 	}
+	if o.resourcePrefix != "" && app.ResourcePrefix != o.resourcePrefix {
+		return fmt.Errorf("application named %s already exists with a different resource prefix %s", name, app.ResourcePrefix)
+	}
+	if o.sharedRepository && !app.SharedRepository {
+		return fmt.Errorf("application named %s already exists without a shared repository: run %s to migrate it",
+			name, color.HighlightCode(fmt.Sprintf("copilot app upgrade -n %s --%s", name, sharedRepoFlag)))
+	}
+	return nil
+}
+
+// updateTemplateCatalog stores the template catalog of an application that already existed before running the command.
+func (o *initAppOpts) updateTemplateCatalog() error {
+	if o.templateCatalog == "" {
+		return nil
+	}
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	if app.TemplateCatalog == o.templateCatalog {
+		return nil
+	}
+	app.TemplateCatalog = o.templateCatalog
+	if err := o.store.UpdateApplication(app); err != nil {
+		return fmt.Errorf("update template catalog of application %s: %w", o.name, err)
+	}
+	return nil
+}
+
+// updatePeeredCIDRs stores the peered network CIDRs of an application that already existed before running the command.
+func (o *initAppOpts) updatePeeredCIDRs() error {
+	if len(o.peeredCIDRs) == 0 {
+		return nil
+	}
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	if strings.Join(app.PeeredCIDRs, ",") == strings.Join(o.peeredCIDRs, ",") {
+		return nil
+	}
+	app.PeeredCIDRs = o.peeredCIDRs
+	if err := o.store.UpdateApplication(app); err != nil {
+		return fmt.Errorf("update peered CIDRs of application %s: %w", o.name, err)
+	}
+	return nil
+}
+
+// validateResourceNamesUnique returns an error if the resources of the application would share
+// their physical names with the resources of another application.
+func (o *initAppOpts) validateResourceNamesUnique(name string) error {
+	apps, err := o.store.ListApplications()
+	if err != nil {
+		return fmt.Errorf("list applications: %w", err)
+	}
+	names := deploy.ResourceNames{Prefix: o.resourcePrefix}
+	for _, app := range apps {
+		if app.Name == name {
+			continue
+		}
+		if names.Collides(name, deploy.ResourceNames{Prefix: app.ResourcePrefix}, app.Name) {
+			return fmt.Errorf("resource names of application %s with prefix %s collide with the resources of application %s", name, o.resourcePrefix, app.Name)
+		}
+	}
 	return nil
 }
 
@@ -319,7 +420,15 @@ An application is a collection of containerized services that operate together.`
   Create a new application with an existing domain name in Amazon Route53.
   /code $ copilot app init --domain example.com
   Create a new application with resource tags.
-  /code $ copilot app init --resource-tags department=MyDept,team=MyTeam`,
+  /code $ copilot app init --resource-tags department=MyDept,team=MyTeam
+  Create a new application whose clusters, roles and log groups are named with a prefix.
+  /code $ copilot app init --resource-prefix corp-
+  Create a new application whose services and jobs store their images in a single ECR repository.
+  /code $ copilot app init --shared-repository
+  Create a new application whose services can be initialized from templates stored in S3.
+  /code $ copilot app init --template-catalog s3://my-templates/copilot
+  Create a new application whose environment VPCs can't overlap with a peered corporate network.
+  /code $ copilot app init --peered-cidrs 10.100.0.0/16`,
 		Args: reservedArgs,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitAppOpts(vars)
@@ -334,5 +443,9 @@ An application is a collection of containerized services that operate together.`
 	}
 	cmd.Flags().StringVar(&vars.domainName, domainNameFlag, "", domainNameFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().StringVar(&vars.resourcePrefix, resourcePrefixFlag, "", resourcePrefixFlagDescription)
+	cmd.Flags().BoolVar(&vars.sharedRepository, sharedRepoFlag, false, sharedRepoFlagDescription)
+	cmd.Flags().StringVar(&vars.templateCatalog, templateCatalogFlag, "", templateCatalogFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.peeredCIDRs, peeredCIDRsFlag, nil, peeredCIDRsFlagDescription)
 	return cmd
 }
@@ -27,8 +27,12 @@ type initAppMocks struct {
 
 func TestInitAppOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inAppName    string
-		inDomainName string
+		inAppName          string
+		inDomainName       string
+		inResourcePrefix   string
+		inSharedRepository bool
+		inTemplateCatalog  string
+		inPeeredCIDRs      []string
 
 		mock func(m *initAppMocks)
 
@@ -45,6 +49,45 @@ func TestInitAppOpts_Validate(t *testing.T) {
 				})
 			},
 		},
+		"error if the existing application doesn't share a repository": {
+			inAppName:          "metrics",
+			inSharedRepository: true,
+			mock: func(m *initAppMocks) {
+				m.mockStore.EXPECT().GetApplication("metrics").Return(&config.Application{
+					Name: "metrics",
+				}, nil)
+			},
+
+			wantedError: errors.New("application named metrics already exists without a shared repository: run `copilot app upgrade -n metrics --shared-repository` to migrate it"),
+		},
+		"valid existing application with a shared repository": {
+			inAppName:          "metrics",
+			inSharedRepository: true,
+			mock: func(m *initAppMocks) {
+				m.mockStore.EXPECT().GetApplication("metrics").Return(&config.Application{
+					Name:             "metrics",
+					SharedRepository: true,
+				}, nil)
+			},
+		},
+		"invalid resource prefix": {
+			inResourcePrefix: "1corp",
+			mock:             func(m *initAppMocks) {},
+
+			wantedError: errors.New("resource prefix 1corp is invalid: value must start with a letter, contain only letters, numbers, hyphens, and underscores, and not exceed 20 characters"),
+		},
+		"invalid template catalog": {
+			inTemplateCatalog: "https://example.com/templates",
+			mock:              func(m *initAppMocks) {},
+
+			wantedError: errors.New(`template catalog location "https://example.com/templates" must start with "s3://" or "git::"`),
+		},
+		"invalid peered CIDR": {
+			inPeeredCIDRs: []string{"10.100.0.0/16", "10.200.0.0"},
+			mock:          func(m *initAppMocks) {},
+
+			wantedError: errors.New("peered CIDR 10.200.0.0 is invalid: value must be a valid IP address range (example: 10.0.0.0/16)"),
+		},
 		"invalid app name": {
 			inAppName: "123chicken",
 			mock:      func(m *initAppMocks) {},
@@ -141,8 +184,12 @@ func TestInitAppOpts_Validate(t *testing.T) {
 				domainInfoGetter: m.mockDomainInfoGetter,
 				store:            m.mockStore,
 				initAppVars: initAppVars{
-					name:       tc.inAppName,
-					domainName: tc.inDomainName,
+					name:             tc.inAppName,
+					domainName:       tc.inDomainName,
+					resourcePrefix:   tc.inResourcePrefix,
+					sharedRepository: tc.inSharedRepository,
+					templateCatalog:  tc.inTemplateCatalog,
+					peeredCIDRs:      tc.inPeeredCIDRs,
 				},
 			}
 
@@ -293,9 +340,14 @@ func TestInitAppOpts_Execute(t *testing.T) {
 	testCases := map[string]struct {
 		inDomainName         string
 		inDomainHostedZoneID string
+		inResourcePrefix     string
+		inSharedRepository   bool
+		inTemplateCatalog    string
+		inPeeredCIDRs        []string
 
-		expectedError error
-		mocking       func(t *testing.T,
+		expectedError  error
+		expectedErrMsg string
+		mocking        func(t *testing.T,
 			mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
 			mockIdentityService *mocks.MockidentityService, mockDeployer *mocks.MockappDeployer,
 			mockProgress *mocks.Mockprogress)
@@ -379,6 +431,159 @@ func TestInitAppOpts_Execute(t *testing.T) {
 				mockProgress.EXPECT().Stop(log.Serrorf(fmtAppInitFailed, "myapp"))
 			},
 		},
+		"should return error if resource names collide with another application": {
+			inResourcePrefix: "corp-",
+			expectedErrMsg:   "resource names of application myapp with prefix corp- collide with the resources of application corp-myapp",
+			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
+				mockIdentityService *mocks.MockidentityService, mockDeployer *mocks.MockappDeployer,
+				mockProgress *mocks.Mockprogress) {
+				mockIdentityService.EXPECT().Get().Return(identity.Caller{Account: "12345"}, nil)
+				mockstore.EXPECT().ListApplications().Return([]*config.Application{
+					{Name: "corp-myapp"},
+				}, nil)
+			},
+		},
+		"with a successful call to add app with a resource prefix": {
+			inResourcePrefix: "corp-",
+			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
+				mockIdentityService *mocks.MockidentityService, mockDeployer *mocks.MockappDeployer,
+				mockProgress *mocks.Mockprogress) {
+				mockIdentityService.EXPECT().Get().Return(identity.Caller{Account: "12345"}, nil)
+				mockstore.EXPECT().ListApplications().Return([]*config.Application{
+					{Name: "myapp", ResourcePrefix: "corp-"},
+					{Name: "myapp", ResourcePrefix: "other-"},
+				}, nil)
+				mockWorkspace.EXPECT().Create(gomock.Eq("myapp")).Return(nil)
+				mockProgress.EXPECT().Start(fmt.Sprintf(fmtAppInitStart, "myapp"))
+				mockDeployer.EXPECT().DeployApp(gomock.Any()).Return(nil)
+				mockProgress.EXPECT().Stop(log.Ssuccessf(fmtAppInitComplete, "myapp"))
+				mockstore.EXPECT().CreateApplication(&config.Application{
+					AccountID:      "12345",
+					Name:           "myapp",
+					ResourcePrefix: "corp-",
+					Tags: map[string]string{
+						"owner": "boss",
+					},
+				})
+			},
+		},
+		"with a successful call to add app with a shared repository": {
+			inSharedRepository: true,
+			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
+				mockIdentityService *mocks.MockidentityService, mockDeployer *mocks.MockappDeployer,
+				mockProgress *mocks.Mockprogress) {
+				mockIdentityService.EXPECT().Get().Return(identity.Caller{Account: "12345"}, nil)
+				mockWorkspace.EXPECT().Create(gomock.Eq("myapp")).Return(nil)
+				mockProgress.EXPECT().Start(fmt.Sprintf(fmtAppInitStart, "myapp"))
+				mockDeployer.EXPECT().DeployApp(&deploy.CreateAppInput{
+					Name:      "myapp",
+					AccountID: "12345",
+					AdditionalTags: map[string]string{
+						"owner": "boss",
+					},
+					Version:          deploy.LatestAppTemplateVersion,
+					SharedRepository: true,
+				}).Return(nil)
+				mockProgress.EXPECT().Stop(log.Ssuccessf(fmtAppInitComplete, "myapp"))
+				mockstore.EXPECT().CreateApplication(&config.Application{
+					AccountID: "12345",
+					Name:      "myapp",
+					Tags: map[string]string{
+						"owner": "boss",
+					},
+					SharedRepository: true,
+				})
+			},
+		},
+		"with a successful call to add app with a template catalog": {
+			inTemplateCatalog: "s3://my-templates/copilot",
+			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
+				mockIdentityService *mocks.MockidentityService, mockDeployer *mocks.MockappDeployer,
+				mockProgress *mocks.Mockprogress) {
+				mockIdentityService.EXPECT().Get().Return(identity.Caller{Account: "12345"}, nil)
+				mockWorkspace.EXPECT().Create(gomock.Eq("myapp")).Return(nil)
+				mockProgress.EXPECT().Start(fmt.Sprintf(fmtAppInitStart, "myapp"))
+				mockDeployer.EXPECT().DeployApp(gomock.Any()).Return(nil)
+				mockProgress.EXPECT().Stop(log.Ssuccessf(fmtAppInitComplete, "myapp"))
+				mockstore.EXPECT().CreateApplication(&config.Application{
+					AccountID: "12345",
+					Name:      "myapp",
+					Tags: map[string]string{
+						"owner": "boss",
+					},
+					TemplateCatalog: "s3://my-templates/copilot",
+				})
+				mockstore.EXPECT().GetApplication("myapp").Return(&config.Application{
+					Name:            "myapp",
+					TemplateCatalog: "s3://my-templates/copilot",
+				}, nil)
+			},
+		},
+		"should update the template catalog of an existing application": {
+			inTemplateCatalog: "git::https://github.com/acme/copilot-templates.git",
+			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
+				mockIdentityService *mocks.MockidentityService, mockDeployer *mocks.MockappDeployer,
+				mockProgress *mocks.Mockprogress) {
+				mockIdentityService.EXPECT().Get().Return(identity.Caller{Account: "12345"}, nil)
+				mockWorkspace.EXPECT().Create(gomock.Eq("myapp")).Return(nil)
+				mockProgress.EXPECT().Start(fmt.Sprintf(fmtAppInitStart, "myapp"))
+				mockDeployer.EXPECT().DeployApp(gomock.Any()).Return(nil)
+				mockProgress.EXPECT().Stop(log.Ssuccessf(fmtAppInitComplete, "myapp"))
+				mockstore.EXPECT().CreateApplication(gomock.Any()).Return(nil)
+				mockstore.EXPECT().GetApplication("myapp").Return(&config.Application{
+					Name:            "myapp",
+					TemplateCatalog: "s3://my-templates/copilot",
+				}, nil)
+				mockstore.EXPECT().UpdateApplication(&config.Application{
+					Name:            "myapp",
+					TemplateCatalog: "git::https://github.com/acme/copilot-templates.git",
+				}).Return(nil)
+			},
+		},
+		"should update the peered CIDRs of an existing application": {
+			inPeeredCIDRs: []string{"10.100.0.0/16", "172.31.0.0/16"},
+			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
+				mockIdentityService *mocks.MockidentityService, mockDeployer *mocks.MockappDeployer,
+				mockProgress *mocks.Mockprogress) {
+				mockIdentityService.EXPECT().Get().Return(identity.Caller{Account: "12345"}, nil)
+				mockWorkspace.EXPECT().Create(gomock.Eq("myapp")).Return(nil)
+				mockProgress.EXPECT().Start(fmt.Sprintf(fmtAppInitStart, "myapp"))
+				mockDeployer.EXPECT().DeployApp(gomock.Any()).Return(nil)
+				mockProgress.EXPECT().Stop(log.Ssuccessf(fmtAppInitComplete, "myapp"))
+				mockstore.EXPECT().CreateApplication(&config.Application{
+					AccountID: "12345",
+					Name:      "myapp",
+					Tags: map[string]string{
+						"owner": "boss",
+					},
+					PeeredCIDRs: []string{"10.100.0.0/16", "172.31.0.0/16"},
+				}).Return(nil)
+				mockstore.EXPECT().GetApplication("myapp").Return(&config.Application{
+					Name:        "myapp",
+					PeeredCIDRs: []string{"10.100.0.0/16"},
+				}, nil)
+				mockstore.EXPECT().UpdateApplication(&config.Application{
+					Name:        "myapp",
+					PeeredCIDRs: []string{"10.100.0.0/16", "172.31.0.0/16"},
+				}).Return(nil)
+			},
+		},
+		"should return a wrapped error if the template catalog cannot be updated": {
+			inTemplateCatalog: "s3://my-templates/copilot",
+			expectedErrMsg:    "update template catalog of application myapp: error",
+			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
+				mockIdentityService *mocks.MockidentityService, mockDeployer *mocks.MockappDeployer,
+				mockProgress *mocks.Mockprogress) {
+				mockIdentityService.EXPECT().Get().Return(identity.Caller{Account: "12345"}, nil)
+				mockWorkspace.EXPECT().Create(gomock.Eq("myapp")).Return(nil)
+				mockProgress.EXPECT().Start(fmt.Sprintf(fmtAppInitStart, "myapp"))
+				mockDeployer.EXPECT().DeployApp(gomock.Any()).Return(nil)
+				mockProgress.EXPECT().Stop(log.Ssuccessf(fmtAppInitComplete, "myapp"))
+				mockstore.EXPECT().CreateApplication(gomock.Any()).Return(nil)
+				mockstore.EXPECT().GetApplication("myapp").Return(&config.Application{Name: "myapp"}, nil)
+				mockstore.EXPECT().UpdateApplication(gomock.Any()).Return(mockError)
+			},
+		},
 		"should return error from CreateApplication": {
 			expectedError: mockError,
 			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
@@ -417,8 +622,12 @@ func TestInitAppOpts_Execute(t *testing.T) {
 
 			opts := &initAppOpts{
 				initAppVars: initAppVars{
-					name:       "myapp",
-					domainName: tc.inDomainName,
+					name:             "myapp",
+					domainName:       tc.inDomainName,
+					resourcePrefix:   tc.inResourcePrefix,
+					sharedRepository: tc.inSharedRepository,
+					templateCatalog:  tc.inTemplateCatalog,
+					peeredCIDRs:      tc.inPeeredCIDRs,
 					resourceTags: map[string]string{
 						"owner": "boss",
 					},
@@ -436,10 +645,13 @@ func TestInitAppOpts_Execute(t *testing.T) {
 			err := opts.Execute()
 
 			// THEN
-			if tc.expectedError == nil {
-				require.NoError(t, err)
-			} else {
+			switch {
+			case tc.expectedErrMsg != "":
+				require.EqualError(t, err, tc.expectedErrMsg)
+			case tc.expectedError != nil:
 				require.True(t, errors.Is(err, tc.expectedError))
+			default:
+				require.NoError(t, err)
 			}
 		})
 	}
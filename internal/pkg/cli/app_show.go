@@ -44,13 +44,13 @@ type showAppVars struct {
 type showAppOpts struct {
 	showAppVars
 
-	store            store
-	w                io.Writer
-	sel              appSelector
-	deployStore      deployedEnvironmentLister
-	codepipeline     pipelineGetter
-	pipelineLister   deployedPipelineLister
-	newVersionGetter func(string) (versionGetter, error)
+	store           store
+	w               io.Writer
+	sel             appSelector
+	deployStore     deployedEnvironmentLister
+	codepipeline    pipelineGetter
+	pipelineLister  deployedPipelineLister
+	newAppDescriber func(string) (appDescriber, error)
 }
 
 func newShowAppOpts(vars showAppVars) (*showAppOpts, error) {
@@ -72,7 +72,7 @@ func newShowAppOpts(vars showAppVars) (*showAppOpts, error) {
 		deployStore:    deployStore,
 		codepipeline:   codepipeline.New(defaultSession),
 		pipelineLister: deploy.NewPipelineStore(rg.New(defaultSession)),
-		newVersionGetter: func(s string) (versionGetter, error) {
+		newAppDescriber: func(s string) (appDescriber, error) {
 			d, err := describe.NewAppDescriber(s)
 			if err != nil {
 				return d, fmt.Errorf("new app describer for application %s: %v", s, err)
@@ -209,14 +209,19 @@ func (o *showAppOpts) description() (*describe.App, error) {
 			Type: job.Type,
 		})
 	}
-	versionGetter, err := o.newVersionGetter(o.name)
+	appDescriber, err := o.newAppDescriber(o.name)
 	if err != nil {
 		return nil, err
 	}
-	version, err := versionGetter.Version()
+	version, err := appDescriber.Version()
 	if err != nil {
 		return nil, fmt.Errorf("get version for application %s: %w", o.name, err)
 	}
+	regions, err := appDescriber.RegionalResources(app)
+	if err != nil {
+		return nil, err
+	}
+	pipelineInfo = appendRegionalPipelines(pipelineInfo, regions)
 	return &describe.App{
 		Name:               app.Name,
 		Version:            version,
@@ -225,10 +230,34 @@ func (o *showAppOpts) description() (*describe.App, error) {
 		Services:           trimmedSvcs,
 		Jobs:               trimmedJobs,
 		Pipelines:          pipelineInfo,
+		Regions:            regions,
 		WkldDeployedtoEnvs: wkldDeployedtoEnvs,
 	}, nil
 }
 
+// appendRegionalPipelines appends the pipelines deployed to the regions of an application
+// that aren't already part of the pipelines deployed to its default region.
+func appendRegionalPipelines(pipelines []*codepipeline.Pipeline, regions []*describe.AppRegionalResources) []*codepipeline.Pipeline {
+	type key struct {
+		region, name string
+	}
+	seen := make(map[key]bool)
+	for _, pipeline := range pipelines {
+		seen[key{pipeline.Region, pipeline.Name}] = true
+	}
+	for _, region := range regions {
+		for _, pipeline := range region.Pipelines {
+			k := key{pipeline.Region, pipeline.Name}
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+			pipelines = append(pipelines, pipeline)
+		}
+	}
+	return pipelines
+}
+
 func (o *showAppOpts) askName() error {
 	if o.name != "" {
 		return nil
@@ -247,10 +276,12 @@ func buildAppShowCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "show",
 		Short: "Shows info about an application.",
-		Long:  "Shows configuration, environments and services for an application.",
+		Long:  "Shows configuration, environments, services and regional resources for an application.",
 		Example: `
   Shows info about the application "my-app"
-  /code $ copilot app show -n my-app`,
+  /code $ copilot app show -n my-app
+  Shows info about the application "my-app" in JSON format.
+  /code $ copilot app show -n my-app --json`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newShowAppOpts(vars)
 			if err != nil {
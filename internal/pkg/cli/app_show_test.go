@@ -13,6 +13,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -23,7 +24,7 @@ type showAppMocks struct {
 	deployStore    *mocks.MockdeployedEnvironmentLister
 	pipelineGetter *mocks.MockpipelineGetter
 	pipelineLister *mocks.MockdeployedPipelineLister
-	versionGetter  *mocks.MockversionGetter
+	appDescriber   *mocks.MockappDescriber
 }
 
 func TestShowAppOpts_Validate(t *testing.T) {
@@ -230,10 +231,67 @@ func TestShowAppOpts_Execute(t *testing.T) {
 					GetPipeline("bad-goose").Return(&codepipeline.Pipeline{
 					Name: "bad-goose",
 				}, nil)
-				m.versionGetter.EXPECT().Version().Return("v0.0.0", nil)
+				m.appDescriber.EXPECT().Version().Return("v0.0.0", nil)
+				m.appDescriber.EXPECT().RegionalResources(&config.Application{
+					Name:   "my-app",
+					Domain: "example.com",
+				}).Return([]*describe.AppRegionalResources{
+					{
+						Region:              "us-west-1",
+						StackInstanceID:     "arn:aws:cloudformation:us-west-1:123456789:stack/StackSet-my-app-infrastructure/1",
+						S3Bucket:            "my-app-bucket-1",
+						KMSKeyARN:           "arn:aws:kms:us-west-1:123456789:key/1",
+						SharedRepositoryURL: "123456789.dkr.ecr.us-west-1.amazonaws.com/my-app",
+						Repositories: []*describe.AppRepository{
+							{
+								Name:       "my-app",
+								URL:        "123456789.dkr.ecr.us-west-1.amazonaws.com/my-app",
+								ImageCount: 3,
+							},
+						},
+					},
+					{
+						Region:          "us-west-2",
+						StackInstanceID: "arn:aws:cloudformation:us-west-2:123456789:stack/StackSet-my-app-infrastructure/2",
+						S3Bucket:        "my-app-bucket-2",
+						KMSKeyARN:       "arn:aws:kms:us-west-2:123456789:key/2",
+						RepositoryURLs: map[string]string{
+							"my-svc": "123456789.dkr.ecr.us-west-2.amazonaws.com/my-app/my-svc",
+						},
+						Repositories: []*describe.AppRepository{
+							{
+								Name:       "my-app/my-svc",
+								URL:        "123456789.dkr.ecr.us-west-2.amazonaws.com/my-app/my-svc",
+								ImageCount: 1,
+							},
+						},
+						Pipelines: []*codepipeline.Pipeline{
+							{
+								Name:   "west-pipeline",
+								Region: "us-west-2",
+							},
+						},
+					},
+				}, nil)
 			},
 
-			wantedContent: "{\"name\":\"my-app\",\"version\":\"v0.0.0\",\"uri\":\"example.com\",\"environments\":[{\"app\":\"\",\"name\":\"test\",\"region\":\"us-west-2\",\"accountID\":\"123456789\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"},{\"app\":\"\",\"name\":\"prod\",\"region\":\"us-west-1\",\"accountID\":\"123456789\",\"prod\":true,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"}],\"services\":[{\"app\":\"\",\"name\":\"my-svc\",\"type\":\"lb-web-svc\"}],\"jobs\":[{\"app\":\"\",\"name\":\"my-job\",\"type\":\"Scheduled Job\"}],\"pipelines\":[{\"pipelineName\":\"my-pipeline-repo\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"},{\"pipelineName\":\"bad-goose\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"}]}\n",
+			wantedContent: "{\"name\":\"my-app\",\"version\":\"v0.0.0\",\"uri\":\"example.com\",\"environments\":[{\"app\":\"\",\"name\":\"test\",\"region\":\"us-west-2\",\"accountID\":\"123456789\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"},{\"app\":\"\",\"name\":\"prod\",\"region\":\"us-west-1\",\"accountID\":\"123456789\",\"prod\":true,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"}],\"services\":[{\"app\":\"\",\"name\":\"my-svc\",\"type\":\"lb-web-svc\"}],\"jobs\":[{\"app\":\"\",\"name\":\"my-job\",\"type\":\"Scheduled Job\"}],\"pipelines\":[{\"pipelineName\":\"my-pipeline-repo\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"},{\"pipelineName\":\"bad-goose\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"},{\"pipelineName\":\"west-pipeline\",\"region\":\"us-west-2\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"}],\"regions\":[{\"region\":\"us-west-1\",\"stackInstanceId\":\"arn:aws:cloudformation:us-west-1:123456789:stack/StackSet-my-app-infrastructure/1\",\"s3Bucket\":\"my-app-bucket-1\",\"kmsKeyArn\":\"arn:aws:kms:us-west-1:123456789:key/1\",\"sharedRepositoryUrl\":\"123456789.dkr.ecr.us-west-1.amazonaws.com/my-app\",\"repositories\":[{\"name\":\"my-app\",\"url\":\"123456789.dkr.ecr.us-west-1.amazonaws.com/my-app\",\"imageCount\":3}]},{\"region\":\"us-west-2\",\"stackInstanceId\":\"arn:aws:cloudformation:us-west-2:123456789:stack/StackSet-my-app-infrastructure/2\",\"s3Bucket\":\"my-app-bucket-2\",\"kmsKeyArn\":\"arn:aws:kms:us-west-2:123456789:key/2\",\"repositoryUrls\":{\"my-svc\":\"123456789.dkr.ecr.us-west-2.amazonaws.com/my-app/my-svc\"},\"repositories\":[{\"name\":\"my-app/my-svc\",\"url\":\"123456789.dkr.ecr.us-west-2.amazonaws.com/my-app/my-svc\",\"imageCount\":1}]}]}\n",
+		},
+		"returns error if fail to get regional resources": {
+			shouldOutputJSON: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name: "my-app",
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{}, nil)
+				m.storeSvc.EXPECT().ListJobs("my-app").Return([]*config.Workload{}, nil)
+				m.pipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{}, nil)
+				m.appDescriber.EXPECT().Version().Return("v0.0.0", nil)
+				m.appDescriber.EXPECT().RegionalResources(gomock.Any()).Return(nil, testError)
+			},
+			wantedError: testError,
 		},
 		"correctly shows human output": {
 			setupMocks: func(m showAppMocks) {
@@ -272,13 +330,47 @@ func TestShowAppOpts_Execute(t *testing.T) {
 				m.pipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{mockPipeline, mockLegacyPipeline}, nil)
 				m.pipelineGetter.EXPECT().
 					GetPipeline("pipeline-my-app-my-pipeline-repo").Return(&codepipeline.Pipeline{
-					Name: "my-pipeline-repo",
+					Name:   "my-pipeline-repo",
+					Region: "us-west-2",
 				}, nil)
 				m.pipelineGetter.EXPECT().
 					GetPipeline("bad-goose").Return(&codepipeline.Pipeline{
-					Name: "bad-goose",
+					Name:   "bad-goose",
+					Region: "us-west-2",
+				}, nil)
+				m.appDescriber.EXPECT().Version().Return("v0.0.0", nil)
+				m.appDescriber.EXPECT().RegionalResources(gomock.Any()).Return([]*describe.AppRegionalResources{
+					{
+						Region:    "us-west-1",
+						S3Bucket:  "my-app-bucket-1",
+						KMSKeyARN: "arn:aws:kms:us-west-1:123456789:key/1",
+					},
+					{
+						Region:    "us-west-2",
+						S3Bucket:  "my-app-bucket-2",
+						KMSKeyARN: "arn:aws:kms:us-west-2:123456789:key/2",
+						Repositories: []*describe.AppRepository{
+							{
+								Name:       "my-app/my-job",
+								ImageCount: 0,
+							},
+							{
+								Name:       "my-app/my-svc",
+								ImageCount: 12,
+							},
+						},
+						Pipelines: []*codepipeline.Pipeline{
+							{
+								Name:   "my-pipeline-repo",
+								Region: "us-west-2",
+							},
+							{
+								Name:   "west-pipeline",
+								Region: "us-west-2",
+							},
+						},
+					},
 				}, nil)
-				m.versionGetter.EXPECT().Version().Return("v0.0.0", nil)
 			},
 
 			wantedContent: `About
@@ -301,12 +393,27 @@ Workloads
   my-svc  lb-web-svc     test
   my-job  Scheduled Job  prod, test
 
+Regional Resources
+
+  Region     Artifact Bucket  KMS Key
+  ------     ---------------  -------
+  us-west-1  my-app-bucket-1  arn:aws:kms:us-west-1:123456789:key/1
+  us-west-2  my-app-bucket-2  arn:aws:kms:us-west-2:123456789:key/2
+
+Repositories
+
+  Region     Name           Images
+  ------     ----           ------
+  us-west-2  my-app/my-job  0
+    "        my-app/my-svc  12
+
 Pipelines
 
-  Name
-  ----
-  my-pipeline-repo
-  bad-goose
+  Name              Region
+  ----              ------
+  my-pipeline-repo  us-west-2
+  bad-goose         us-west-2
+  west-pipeline     us-west-2
 `,
 		},
 		"correctly shows human output with latest version": {
@@ -344,7 +451,8 @@ Pipelines
 				m.deployStore.EXPECT().ListDeployedServices("my-app", "test").Return([]string{"my-svc"}, nil)
 				m.deployStore.EXPECT().ListDeployedServices("my-app", "prod").Return([]string{"my-svc"}, nil)
 				m.pipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{}, nil)
-				m.versionGetter.EXPECT().Version().Return(deploy.LatestAppTemplateVersion, nil)
+				m.appDescriber.EXPECT().Version().Return(deploy.LatestAppTemplateVersion, nil)
+				m.appDescriber.EXPECT().RegionalResources(gomock.Any()).Return(nil, nil)
 			},
 
 			wantedContent: `About
@@ -369,8 +477,8 @@ Workloads
 
 Pipelines
 
-  Name
-  ----
+  Name    Region
+  ----    ------
 `,
 		},
 		"when service/job is not deployed": {
@@ -410,9 +518,11 @@ Pipelines
 				m.pipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{mockPipeline}, nil)
 				m.pipelineGetter.EXPECT().
 					GetPipeline("pipeline-my-app-my-pipeline-repo").Return(&codepipeline.Pipeline{
-					Name: "my-pipeline-repo",
+					Name:   "my-pipeline-repo",
+					Region: "us-west-2",
 				}, nil)
-				m.versionGetter.EXPECT().Version().Return(deploy.LatestAppTemplateVersion, nil)
+				m.appDescriber.EXPECT().Version().Return(deploy.LatestAppTemplateVersion, nil)
+				m.appDescriber.EXPECT().RegionalResources(gomock.Any()).Return(nil, nil)
 			},
 
 			wantedContent: `About
@@ -437,9 +547,9 @@ Workloads
 
 Pipelines
 
-  Name
-  ----
-  my-pipeline-repo
+  Name              Region
+  ----              ------
+  my-pipeline-repo  us-west-2
 `,
 		}, "when multiple services/jobs are deployed": {
 			setupMocks: func(m showAppMocks) {
@@ -499,9 +609,11 @@ Pipelines
 				m.pipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{mockPipeline}, nil)
 				m.pipelineGetter.EXPECT().
 					GetPipeline("pipeline-my-app-my-pipeline-repo").Return(&codepipeline.Pipeline{
-					Name: "my-pipeline-repo",
+					Name:   "my-pipeline-repo",
+					Region: "us-west-2",
 				}, nil)
-				m.versionGetter.EXPECT().Version().Return(deploy.LatestAppTemplateVersion, nil)
+				m.appDescriber.EXPECT().Version().Return(deploy.LatestAppTemplateVersion, nil)
+				m.appDescriber.EXPECT().RegionalResources(gomock.Any()).Return(nil, nil)
 			},
 
 			wantedContent: `About
@@ -529,9 +641,9 @@ Workloads
 
 Pipelines
 
-  Name
-  ----
-  my-pipeline-repo
+  Name              Region
+  ----              ------
+  my-pipeline-repo  us-west-2
 `,
 		},
 		"returns error if fail to get application": {
@@ -729,7 +841,7 @@ Pipelines
 				m.deployStore.EXPECT().ListDeployedServices("my-app", "test").Return([]string{"my-svc"}, nil).AnyTimes()
 				m.deployStore.EXPECT().ListDeployedServices("my-app", "prod").Return([]string{"my-svc"}, nil).AnyTimes()
 				m.pipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{}, nil)
-				m.versionGetter.EXPECT().Version().Return("", testError)
+				m.appDescriber.EXPECT().Version().Return("", testError)
 			},
 			wantedError: fmt.Errorf("get version for application %s: %w", "my-app", testError),
 		},
@@ -743,14 +855,14 @@ Pipelines
 			b := &bytes.Buffer{}
 			mockStoreReader := mocks.NewMockstore(ctrl)
 			mockPLSvc := mocks.NewMockpipelineGetter(ctrl)
-			mockVersionGetter := mocks.NewMockversionGetter(ctrl)
+			mockAppDescriber := mocks.NewMockappDescriber(ctrl)
 			mockPipelineLister := mocks.NewMockdeployedPipelineLister(ctrl)
 			mockDeployStore := mocks.NewMockdeployedEnvironmentLister(ctrl)
 
 			mocks := showAppMocks{
 				storeSvc:       mockStoreReader,
 				pipelineGetter: mockPLSvc,
-				versionGetter:  mockVersionGetter,
+				appDescriber:   mockAppDescriber,
 				pipelineLister: mockPipelineLister,
 				deployStore:    mockDeployStore,
 			}
@@ -766,8 +878,8 @@ Pipelines
 				codepipeline:   mockPLSvc,
 				pipelineLister: mockPipelineLister,
 				deployStore:    mockDeployStore,
-				newVersionGetter: func(s string) (versionGetter, error) {
-					return mockVersionGetter, nil
+				newAppDescriber: func(s string) (appDescriber, error) {
+					return mockAppDescriber, nil
 				},
 			}
 
@@ -5,17 +5,22 @@ package cli
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -30,13 +35,24 @@ const (
 	fmtAppUpgradeFailed   = "Failed to upgrade application %s's template to version %s.\n"
 	fmtAppUpgradeComplete = "Upgraded application %s's template to version %s.\n"
 
+	fmtAppSharedRepoAddStart       = "Adding a shared repository to application %s."
+	fmtAppSharedRepoAddFailed      = "Failed to add a shared repository to application %s.\n"
+	fmtAppSharedRepoAddComplete    = "Added a shared repository to application %s.\n"
+	fmtAppSharedRepoCopyStart      = "Copying the images of application %s to the shared repository in region %s."
+	fmtAppSharedRepoCopyFailed     = "Failed to copy the images of application %s to the shared repository in region %s.\n"
+	fmtAppSharedRepoCopyComplete   = "Copied the images of application %s to the shared repository in region %s.\n"
+	fmtAppSharedRepoRemoveStart    = "Removing the service repositories from application %s."
+	fmtAppSharedRepoRemoveFailed   = "Failed to remove the service repositories from application %s.\n"
+	fmtAppSharedRepoRemoveComplete = "Removed the service repositories from application %s.\n"
+
 	appUpgradeNamePrompt     = "Which application would you like to upgrade?"
 	appUpgradeNameHelpPrompt = "An application is a collection of related services."
 )
 
 // appUpgradeVars holds flag values.
 type appUpgradeVars struct {
-	name string
+	name             string
+	sharedRepository bool
 }
 
 // appUpgradeOpts represents the app upgrade command and holds the necessary data
@@ -51,10 +67,16 @@ type appUpgradeOpts struct {
 	sel           appSelector
 	identity      identityService
 	upgrader      appUpgrader
+
+	// Clients to migrate the images of an application to a shared repository.
+	repoMigrator    sharedRepositoryMigrator
+	newImageClients func(region string) (repositoryImageLister, error)
+	docker          imageCopier
 }
 
 func newAppUpgradeOpts(vars appUpgradeVars) (*appUpgradeOpts, error) {
-	sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("app upgrade")).Default()
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("app upgrade"))
+	sess, err := sessProvider.Default()
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +94,15 @@ func newAppUpgradeOpts(vars appUpgradeVars) (*appUpgradeOpts, error) {
 		sel:            selector.NewAppEnvSelector(prompt.New(), store),
 		versionGetter:  d,
 		upgrader:       cloudformation.New(sess),
+		repoMigrator:   cloudformation.New(sess),
+		newImageClients: func(region string) (repositoryImageLister, error) {
+			regionalSess, err := sessProvider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create default session with region %s: %w", region, err)
+			}
+			return ecr.New(regionalSess), nil
+		},
+		docker: dockerengine.New(exec.NewCmd()),
 	}, nil
 }
 
@@ -96,14 +127,24 @@ func (o *appUpgradeOpts) Ask() error {
 
 // Execute updates the cloudformation stack as well as the stackset of an application to the latest version.
 // If any stack is busy updating, it spins and waits until the stack can be updated.
+// If --shared-repository is set, Execute then migrates the images of the application to a single ECR repository.
 func (o *appUpgradeOpts) Execute() error {
 	version, err := o.versionGetter.Version()
 	if err != nil {
 		return fmt.Errorf("get template version of application %s: %v", o.name, err)
 	}
-	if !shouldUpgradeApp(o.name, version) {
-		return nil
+	if shouldUpgradeApp(o.name, version) {
+		if err := o.upgrade(version); err != nil {
+			return err
+		}
+	}
+	if o.sharedRepository {
+		return o.migrateToSharedRepository()
 	}
+	return nil
+}
+
+func (o *appUpgradeOpts) upgrade(version string) (err error) {
 	app, err := o.store.GetApplication(o.name)
 	if err != nil {
 		return fmt.Errorf("get application %s: %w", o.name, err)
@@ -123,6 +164,101 @@ func (o *appUpgradeOpts) Execute() error {
 	return nil
 }
 
+// migrateToSharedRepository moves an application from one ECR repository per workload to a single repository.
+// The shared repository is first deployed alongside the workload repositories, then every tagged image of a workload
+// is copied to the shared repository with its tag prefixed by the workload name. Finally, the workload repositories
+// are removed from the application stack set. They are retained so that running tasks can still pull their images.
+func (o *appUpgradeOpts) migrateToSharedRepository() error {
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	if app.SharedRepository {
+		log.Infof("Application %s already stores its images in a shared repository.\n", color.HighlightUserInput(o.name))
+		return nil
+	}
+	if err := o.docker.CheckDockerEngineRunning(); err != nil {
+		return fmt.Errorf("check if docker engine is running: %w", err)
+	}
+
+	o.prog.Start(fmt.Sprintf(fmtAppSharedRepoAddStart, color.HighlightUserInput(o.name)))
+	if err := o.repoMigrator.AddSharedRepositoryToApp(app); err != nil {
+		o.prog.Stop(log.Serrorf(fmtAppSharedRepoAddFailed, color.HighlightUserInput(o.name)))
+		return err
+	}
+	o.prog.Stop(log.Ssuccessf(fmtAppSharedRepoAddComplete, color.HighlightUserInput(o.name)))
+
+	regionalResources, err := o.repoMigrator.GetRegionalAppResources(app)
+	if err != nil {
+		return fmt.Errorf("get regional resources of application %s: %w", o.name, err)
+	}
+	for _, resources := range regionalResources {
+		o.prog.Start(fmt.Sprintf(fmtAppSharedRepoCopyStart, color.HighlightUserInput(o.name), resources.Region))
+		if err := o.copyImagesToSharedRepository(resources); err != nil {
+			o.prog.Stop(log.Serrorf(fmtAppSharedRepoCopyFailed, color.HighlightUserInput(o.name), resources.Region))
+			return err
+		}
+		o.prog.Stop(log.Ssuccessf(fmtAppSharedRepoCopyComplete, color.HighlightUserInput(o.name), resources.Region))
+	}
+
+	app.SharedRepository = true
+	if err := o.store.UpdateApplication(app); err != nil {
+		return fmt.Errorf("update application %s: %w", o.name, err)
+	}
+
+	o.prog.Start(fmt.Sprintf(fmtAppSharedRepoRemoveStart, color.HighlightUserInput(o.name)))
+	if err := o.repoMigrator.RemoveWorkloadRepositoriesFromApp(app); err != nil {
+		o.prog.Stop(log.Serrorf(fmtAppSharedRepoRemoveFailed, color.HighlightUserInput(o.name)))
+		return err
+	}
+	o.prog.Stop(log.Ssuccessf(fmtAppSharedRepoRemoveComplete, color.HighlightUserInput(o.name)))
+	log.Infof(`The service repositories of application %s are retained so that running tasks can still pull their images.
+You can delete them once all your services and jobs are redeployed from the shared repository.
+`, color.HighlightUserInput(o.name))
+	return nil
+}
+
+func (o *appUpgradeOpts) copyImagesToSharedRepository(resources *stack.AppRegionalResources) error {
+	if resources.SharedRepositoryURL == "" {
+		return fmt.Errorf("shared repository of application %s in region %s not found", o.name, resources.Region)
+	}
+	registry, err := o.newImageClients(resources.Region)
+	if err != nil {
+		return err
+	}
+	if !o.docker.IsEcrCredentialHelperEnabled(resources.SharedRepositoryURL) {
+		username, password, err := registry.Auth()
+		if err != nil {
+			return fmt.Errorf("get auth: %w", err)
+		}
+		if err := o.docker.Login(resources.SharedRepositoryURL, username, password); err != nil {
+			return fmt.Errorf("login to repo %s: %w", o.name, err)
+		}
+	}
+	var wlNames []string
+	for name := range resources.RepositoryURLs {
+		wlNames = append(wlNames, name)
+	}
+	sort.Strings(wlNames)
+	for _, name := range wlNames {
+		repoName := stack.NameForWorkloadRepository(o.name, name, false)
+		images, err := registry.ListImages(repoName)
+		if err != nil {
+			return fmt.Errorf("list images of repo %s: %w", repoName, err)
+		}
+		for _, image := range images {
+			for _, tag := range image.Tags {
+				source := fmt.Sprintf("%s:%s", resources.RepositoryURLs[name], tag)
+				target := fmt.Sprintf("%s:%s", resources.SharedRepositoryURL, stack.SharedRepositoryImageTag(name, tag))
+				if err := o.docker.CopyImage(source, target); err != nil {
+					return fmt.Errorf("copy image %s to %s: %w", source, target, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func (o *appUpgradeOpts) askName() error {
 	if o.name != "" {
 		return nil
@@ -198,7 +334,9 @@ func buildAppUpgradeCmd() *cobra.Command {
 		Short: "Upgrades the template of an application to the latest version.",
 		Example: `
     Upgrade the application "my-app" to the latest version
-    /code $ copilot app upgrade -n my-app`,
+    /code $ copilot app upgrade -n my-app
+    Move the images of "my-app" to a single ECR repository shared by all its services and jobs
+    /code $ copilot app upgrade -n my-app --shared-repository`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newAppUpgradeOpts(vars)
 			if err != nil {
@@ -208,5 +346,6 @@ func buildAppUpgradeCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.sharedRepository, sharedRepoFlag, false, appUpgradeSharedRepoFlagDescription)
 	return cmd
 }
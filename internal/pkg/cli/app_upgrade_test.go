@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -343,6 +345,180 @@ func TestAppUpgradeOpts_Execute(t *testing.T) {
 				}
 			},
 		},
+		"skip migration if the application already shares a repository": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockVersionGetter := mocks.NewMockversionGetter(ctrl)
+				mockVersionGetter.EXPECT().Version().Return(deploy.LatestAppTemplateVersion, nil)
+
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Name:             "phonetool",
+					SharedRepository: true,
+				}, nil)
+
+				mockMigrator := mocks.NewMocksharedRepositoryMigrator(ctrl)
+				mockMigrator.EXPECT().AddSharedRepositoryToApp(gomock.Any()).Times(0)
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name:             "phonetool",
+						sharedRepository: true,
+					},
+					versionGetter: mockVersionGetter,
+					store:         mockStore,
+					repoMigrator:  mockMigrator,
+				}
+			},
+		},
+		"should return error if docker engine is not running": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockVersionGetter := mocks.NewMockversionGetter(ctrl)
+				mockVersionGetter.EXPECT().Version().Return(deploy.LatestAppTemplateVersion, nil)
+
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+
+				mockDocker := mocks.NewMockimageCopier(ctrl)
+				mockDocker.EXPECT().CheckDockerEngineRunning().Return(errors.New("some error"))
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name:             "phonetool",
+						sharedRepository: true,
+					},
+					versionGetter: mockVersionGetter,
+					store:         mockStore,
+					docker:        mockDocker,
+				}
+			},
+			wantedErr: errors.New("check if docker engine is running: some error"),
+		},
+		"should return error if fail to copy an image to the shared repository": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockVersionGetter := mocks.NewMockversionGetter(ctrl)
+				mockVersionGetter.EXPECT().Version().Return(deploy.LatestAppTemplateVersion, nil)
+
+				mockProg := mocks.NewMockprogress(ctrl)
+				mockProg.EXPECT().Start(gomock.Any()).AnyTimes()
+				mockProg.EXPECT().Stop(gomock.Any()).AnyTimes()
+
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				mockStore.EXPECT().UpdateApplication(gomock.Any()).Times(0)
+
+				mockMigrator := mocks.NewMocksharedRepositoryMigrator(ctrl)
+				mockMigrator.EXPECT().AddSharedRepositoryToApp(gomock.Any()).Return(nil)
+				mockMigrator.EXPECT().GetRegionalAppResources(gomock.Any()).Return([]*stack.AppRegionalResources{
+					{
+						Region: "us-west-2",
+						RepositoryURLs: map[string]string{
+							"frontend": "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
+						},
+						SharedRepositoryURL: "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool",
+					},
+				}, nil)
+				mockMigrator.EXPECT().RemoveWorkloadRepositoriesFromApp(gomock.Any()).Times(0)
+
+				mockRegistry := mocks.NewMockrepositoryImageLister(ctrl)
+				mockRegistry.EXPECT().ListImages("phonetool/frontend").Return([]ecr.Image{
+					{
+						Digest: "sha256:1234",
+						Tags:   []string{"v1"},
+					},
+				}, nil)
+
+				mockDocker := mocks.NewMockimageCopier(ctrl)
+				mockDocker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				mockDocker.EXPECT().IsEcrCredentialHelperEnabled(gomock.Any()).Return(true)
+				mockDocker.EXPECT().CopyImage(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name:             "phonetool",
+						sharedRepository: true,
+					},
+					versionGetter: mockVersionGetter,
+					store:         mockStore,
+					prog:          mockProg,
+					repoMigrator:  mockMigrator,
+					newImageClients: func(region string) (repositoryImageLister, error) {
+						return mockRegistry, nil
+					},
+					docker: mockDocker,
+				}
+			},
+			wantedErr: errors.New("copy image 123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:v1 to 123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool:frontend-v1: some error"),
+		},
+		"migrate the images of the application to a shared repository": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockVersionGetter := mocks.NewMockversionGetter(ctrl)
+				mockVersionGetter.EXPECT().Version().Return(deploy.LatestAppTemplateVersion, nil)
+
+				mockProg := mocks.NewMockprogress(ctrl)
+				mockProg.EXPECT().Start(gomock.Any()).AnyTimes()
+				mockProg.EXPECT().Stop(gomock.Any()).AnyTimes()
+
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+
+				mockMigrator := mocks.NewMocksharedRepositoryMigrator(ctrl)
+				mockRegistry := mocks.NewMockrepositoryImageLister(ctrl)
+				mockDocker := mocks.NewMockimageCopier(ctrl)
+				gomock.InOrder(
+					mockDocker.EXPECT().CheckDockerEngineRunning().Return(nil),
+					mockMigrator.EXPECT().AddSharedRepositoryToApp(&config.Application{Name: "phonetool"}).Return(nil),
+					mockMigrator.EXPECT().GetRegionalAppResources(gomock.Any()).Return([]*stack.AppRegionalResources{
+						{
+							Region: "us-west-2",
+							RepositoryURLs: map[string]string{
+								"frontend": "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
+								"api":      "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api",
+							},
+							SharedRepositoryURL: "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool",
+						},
+					}, nil),
+					mockDocker.EXPECT().IsEcrCredentialHelperEnabled("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool").Return(false),
+					mockRegistry.EXPECT().Auth().Return("AWS", "password", nil),
+					mockDocker.EXPECT().Login("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool", "AWS", "password").Return(nil),
+					mockRegistry.EXPECT().ListImages("phonetool/api").Return([]ecr.Image{
+						{
+							Digest: "sha256:1234",
+							Tags:   []string{"latest", "v1"},
+						},
+						{
+							Digest: "sha256:5678",
+						},
+					}, nil),
+					mockDocker.EXPECT().CopyImage("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:latest", "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool:api-latest").Return(nil),
+					mockDocker.EXPECT().CopyImage("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v1", "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool:api-v1").Return(nil),
+					mockRegistry.EXPECT().ListImages("phonetool/frontend").Return(nil, nil),
+					mockStore.EXPECT().UpdateApplication(&config.Application{
+						Name:             "phonetool",
+						SharedRepository: true,
+					}).Return(nil),
+					mockMigrator.EXPECT().RemoveWorkloadRepositoriesFromApp(&config.Application{
+						Name:             "phonetool",
+						SharedRepository: true,
+					}).Return(nil),
+				)
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name:             "phonetool",
+						sharedRepository: true,
+					},
+					versionGetter: mockVersionGetter,
+					store:         mockStore,
+					prog:          mockProg,
+					repoMigrator:  mockMigrator,
+					newImageClients: func(region string) (repositoryImageLister, error) {
+						require.Equal(t, "us-west-2", region)
+						return mockRegistry, nil
+					},
+					docker: mockDocker,
+				}
+			},
+		},
 	}
 
 	for name, tc := range testCases {
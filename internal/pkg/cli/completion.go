@@ -60,7 +60,8 @@ func BuildCompletionCmd(rootCmd *cobra.Command) *cobra.Command {
 		Use:   "completion [shell]",
 		Short: "Output shell completion code.",
 		Long: `Output shell completion code for bash, zsh or fish.
-The code must be evaluated to provide interactive completion of commands.`,
+The code must be evaluated to provide interactive completion of commands.
+Run "copilot completion matrix" to list the Copilot features available in a region.`,
 		Example: `
   Install zsh completion
   /code $ source <(copilot completion zsh)
@@ -97,6 +98,7 @@ The code must be evaluated to provide interactive completion of commands.`,
 			return opts.Execute()
 		}),
 	}
+	cmd.AddCommand(buildCompletionMatrixCmd())
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
 		"group": group.Settings,
@@ -0,0 +1,132 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/spf13/cobra"
+)
+
+type completionMatrixVars struct {
+	region           string
+	shouldOutputJSON bool
+}
+
+type completionMatrixOpts struct {
+	completionMatrixVars
+
+	w             io.Writer
+	defaultRegion func() (string, error)
+}
+
+func newCompletionMatrixOpts(vars completionMatrixVars) *completionMatrixOpts {
+	return &completionMatrixOpts{
+		completionMatrixVars: vars,
+		w:                    os.Stdout,
+		defaultRegion: func() (string, error) {
+			sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("completion matrix")).Default()
+			if err != nil {
+				return "", fmt.Errorf("default session: %w", err)
+			}
+			return aws.StringValue(sess.Config.Region), nil
+		},
+	}
+}
+
+// Execute writes which Copilot features are available in the region.
+func (o *completionMatrixOpts) Execute() error {
+	if o.region == "" {
+		region, err := o.defaultRegion()
+		if err != nil {
+			return err
+		}
+		if region == "" {
+			return errors.New("no region is configured: specify one with --" + regionFlag)
+		}
+		o.region = region
+	}
+	partition, err := partitions.Region(o.region).Partition()
+	if err != nil {
+		return err
+	}
+	features, err := partitions.Region(o.region).Features()
+	if err != nil {
+		return err
+	}
+	if o.shouldOutputJSON {
+		return o.jsonOutput(partition.ID(), features)
+	}
+	o.humanOutput(features)
+	return nil
+}
+
+func (o *completionMatrixOpts) jsonOutput(partition string, features []partitions.FeatureStatus) error {
+	type serializedMatrix struct {
+		Region    string                     `json:"region"`
+		Partition string                     `json:"partition"`
+		Features  []partitions.FeatureStatus `json:"features"`
+	}
+	b, err := json.Marshal(serializedMatrix{
+		Region:    o.region,
+		Partition: partition,
+		Features:  features,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal features: %w", err)
+	}
+	fmt.Fprintf(o.w, "%s\n", b)
+	return nil
+}
+
+func (o *completionMatrixOpts) humanOutput(features []partitions.FeatureStatus) {
+	writer := tabwriter.NewWriter(o.w, 0, 4, 2, ' ', 0)
+	headers := []string{"Feature", "Available", "Reason"}
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+	var underlines []string
+	for _, header := range headers {
+		underlines = append(underlines, strings.Repeat("-", len(header)))
+	}
+	fmt.Fprintf(writer, "%s\n", strings.Join(underlines, "\t"))
+	for _, f := range features {
+		available := "Yes"
+		if !f.Available {
+			available = "No"
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", f.Name, available, f.Reason)
+	}
+	writer.Flush()
+}
+
+// buildCompletionMatrixCmd builds the command for reporting the Copilot features available in a region.
+func buildCompletionMatrixCmd() *cobra.Command {
+	vars := completionMatrixVars{}
+	cmd := &cobra.Command{
+		Use:   "matrix",
+		Short: "Lists the Copilot features that are available in a region.",
+		Long: `Lists the Copilot features that are available in a region.
+Features can be unavailable because an AWS service doesn't exist in the region or partition.`,
+		Example: `
+  Lists the features available in the region of your default profile.
+  /code $ copilot completion matrix
+  Lists the features available in the Beijing region as JSON.
+  /code $ copilot completion matrix --region cn-north-1 --json`,
+		Args: cobra.NoArgs,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return newCompletionMatrixOpts(vars).Execute()
+		}),
+	}
+	cmd.Flags().StringVar(&vars.region, regionFlag, "", completionMatrixRegionFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,84 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompletionMatrixOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inRegion        string
+		inJSON          bool
+		inDefaultRegion func() (string, error)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"error if the default region cannot be retrieved": {
+			inDefaultRegion: func() (string, error) {
+				return "", errors.New("some error")
+			},
+			wantedError: errors.New("some error"),
+		},
+		"error if no region is configured": {
+			inDefaultRegion: func() (string, error) {
+				return "", nil
+			},
+			wantedError: errors.New("no region is configured: specify one with --region"),
+		},
+		"error if the partition of the region cannot be found": {
+			inRegion:    "weird region",
+			wantedError: errors.New("find the partition for region weird region"),
+		},
+		"writes the features of the default region": {
+			inDefaultRegion: func() (string, error) {
+				return "cn-north-1", nil
+			},
+			wantedContent: `Feature                     Available  Reason
+-------                     ---------  ------
+ECS services and jobs       Yes        
+Request-Driven Web Service  No         AWS App Runner is not available in region cn-north-1
+Service Connect             Yes        
+EFS storage                 Yes        
+CloudFront CDN              No         Amazon CloudFront distributions are only supported in the aws partition
+`,
+		},
+		"writes the features of the region as JSON": {
+			inRegion:      "us-west-2",
+			inJSON:        true,
+			wantedContent: `{"region":"us-west-2","partition":"aws","features":[{"name":"ECS services and jobs","available":true},{"name":"Request-Driven Web Service","available":true},{"name":"Service Connect","available":true},{"name":"EFS storage","available":true},{"name":"CloudFront CDN","available":true}]}` + "\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			b := &strings.Builder{}
+			opts := completionMatrixOpts{
+				completionMatrixVars: completionMatrixVars{
+					region:           tc.inRegion,
+					shouldOutputJSON: tc.inJSON,
+				},
+				w:             b,
+				defaultRegion: tc.inDefaultRegion,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -5,7 +5,10 @@ package cli
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -17,36 +20,91 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/graph"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
 const (
 	svcWkldType = "svc"
 	jobWkldType = "job"
+	envType     = "environment"
 )
 
-type deployOpts struct {
+const (
+	continueDeployPlanPrompt = "Continue with the deployment?"
+
+	// Directory under "copilot/" that holds the environment manifests.
+	envManifestsDirName = "environments"
+
+	// maxConcurrentWkldDeployments is the maximum number of stacks deployed at the same time with --all.
+	maxConcurrentWkldDeployments = 4
+)
+
+// Reasons for deploying a stack as part of the deployment plan.
+const (
+	reasonManifestChanged = "manifest or addons changed"
+	reasonSourceChanged   = "source code changed"
+	reasonNotDeployed     = "not deployed yet"
+)
+
+// Outcomes of deploying a stack with --all.
+const (
+	deployStepSucceeded = "deployed"
+	deployStepFailed    = "failed"
+	deployStepSkipped   = "skipped"
+)
+
+type deployVars struct {
 	deployWkldVars
+	since            string
+	skipConfirmation bool
+	all              bool
+}
+
+type deployOpts struct {
+	deployVars
 
-	deployWkld     actionCommand
-	setupDeployCmd func(*deployOpts, string)
+	deployWkld      actionCommand
+	setupDeployCmd  func(*deployOpts, string)
+	newEnvDeployCmd func(*deployOpts) (cmd, error)
 
-	sel    wsSelector
-	store  store
-	ws     wsWlDirReader
-	prompt prompter
+	sel         wsSelector
+	store       store
+	deployStore deployedWorkloadsLister
+	ws          wsWlDirReader
+	prompt      prompter
+	runner      execRunner
 
 	// values for logging
 	wlType string
+
+	// Set for the workloads deployed concurrently with --all.
+	progressOut    termprogress.FileWriter
+	nonInteractive bool
 }
 
-func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
+// deployPlanStep is a stack that needs to be redeployed, and why.
+type deployPlanStep struct {
+	kind   string // One of envType, svcWkldType or jobWkldType.
+	name   string
+	reason string
+}
+
+// String returns the kind and name of the stack, for example "svc frontend".
+func (s deployPlanStep) String() string {
+	return fmt.Sprintf("%s %s", s.kind, s.name)
+}
+
+func newDeployOpts(vars deployVars) (*deployOpts, error) {
 	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("deploy"))
 	defaultSess, err := sessProvider.Default()
 	if err != nil {
@@ -57,13 +115,28 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new workspace: %w", err)
 	}
+	deployStore, err := deploy.NewStore(sessProvider, store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
 	prompter := prompt.New()
 	return &deployOpts{
-		deployWkldVars: vars,
-		store:          store,
-		sel:            selector.NewLocalWorkloadSelector(prompter, store, ws),
-		ws:             ws,
-		prompt:         prompter,
+		deployVars:  vars,
+		store:       store,
+		deployStore: deployStore,
+		sel:         selector.NewLocalWorkloadSelector(prompter, store, ws),
+		ws:          ws,
+		prompt:      prompter,
+		runner:      exec.NewCmd(),
+
+		newEnvDeployCmd: func(o *deployOpts) (cmd, error) {
+			return newEnvDeployOpts(deployEnvVars{
+				appName:         o.appName,
+				name:            o.envName,
+				disableRollback: o.disableRollback,
+				yesSecurity:     o.yesSecurity,
+			})
+		},
 
 		setupDeployCmd: func(o *deployOpts, workloadType string) {
 			switch {
@@ -76,8 +149,13 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 					newInterpolator: newManifestInterpolator,
 					unmarshal:       manifest.UnmarshalWorkload,
 					sel:             selector.NewLocalWorkloadSelector(o.prompt, o.store, ws),
+					prompt:          o.prompt,
 					cmd:             exec.NewCmd(),
+					fs:              afero.NewOsFs(),
 					sessProvider:    sessProvider,
+					progressOut:     o.progressOut,
+					nonInteractive:  o.nonInteractive,
+					now:             time.Now,
 				}
 				opts.newJobDeployer = func() (workloadDeployer, error) {
 					return newJobDeployer(opts)
@@ -95,7 +173,10 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 					sel:             selector.NewLocalWorkloadSelector(o.prompt, o.store, ws),
 					prompt:          o.prompt,
 					cmd:             exec.NewCmd(),
+					fs:              afero.NewOsFs(),
 					sessProvider:    sessProvider,
+					progressOut:     o.progressOut,
+					nonInteractive:  o.nonInteractive,
 				}
 				opts.newSvcDeployer = func() (workloadDeployer, error) {
 					return newSvcDeployer(opts)
@@ -107,9 +188,33 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 }
 
 func (o *deployOpts) Run() error {
+	if o.all {
+		for _, flag := range []struct {
+			name  string
+			isSet bool
+		}{
+			{nameFlag, o.name != ""},
+			{sinceFlag, o.since != ""},
+		} {
+			if flag.isSet {
+				return fmt.Errorf("cannot specify both --%s and --%s", allFlag, flag.name)
+			}
+		}
+		return o.deployAll()
+	}
+	if o.since != "" {
+		if o.name != "" {
+			return fmt.Errorf("cannot specify both --%s and --%s", nameFlag, sinceFlag)
+		}
+		return o.deployChanges()
+	}
 	if err := o.askName(); err != nil {
 		return err
 	}
+	return o.deployWorkload()
+}
+
+func (o *deployOpts) deployWorkload() error {
 	if err := o.loadWkld(); err != nil {
 		return err
 	}
@@ -122,6 +227,448 @@ func (o *deployOpts) Run() error {
 	return nil
 }
 
+// deployChanges deploys, in dependency order, the environment and workloads that need to be redeployed.
+func (o *deployOpts) deployChanges() error {
+	if err := o.askEnvName(); err != nil {
+		return err
+	}
+	steps, err := o.plan()
+	if err != nil {
+		return err
+	}
+	if len(steps) == 0 {
+		log.Infof("Nothing changed since %s that needs to be deployed to environment %s.\n", o.since, o.envName)
+		return nil
+	}
+	log.Infof("Copilot will deploy the following to environment %s, in order:\n", o.envName)
+	for i, step := range steps {
+		log.Infof("  %d. %s %s (%s)\n", i+1, step.kind, step.name, step.reason)
+	}
+	if !o.skipConfirmation {
+		ok, err := o.prompt.Confirm(continueDeployPlanPrompt, "")
+		if err != nil {
+			return fmt.Errorf("confirm deployment plan: %w", err)
+		}
+		if !ok {
+			return nil
+		}
+	}
+	for _, step := range steps {
+		if step.kind == envType {
+			if err := o.deployEnv(); err != nil {
+				return err
+			}
+			continue
+		}
+		o.name = step.name
+		if err := o.deployWorkload(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deployAll deploys the environment and every workload in the workspace, ordered by their dependencies.
+// Stacks that don't depend on each other are deployed concurrently, and a failed deployment
+// only skips the stacks that depend on it.
+func (o *deployOpts) deployAll() error {
+	if err := o.askEnvName(); err != nil {
+		return err
+	}
+	steps, edges, err := o.allDeploySteps()
+	if err != nil {
+		return err
+	}
+	if len(steps) == 0 {
+		log.Infoln("No environment, services or jobs to deploy in the workspace.")
+		return nil
+	}
+	ranks, err := rankDeploySteps(steps, edges)
+	if err != nil {
+		return err
+	}
+	log.Infof("Copilot will deploy the following to environment %s, in order:\n", o.envName)
+	for i, rank := range ranks {
+		log.Infof("  %d. %s\n", i+1, english.WordSeries(deployStepLabels(rank), "and"))
+	}
+	if !o.skipConfirmation {
+		ok, err := o.prompt.Confirm(continueDeployPlanPrompt, "")
+		if err != nil {
+			return fmt.Errorf("confirm deployment plan: %w", err)
+		}
+		if !ok {
+			return nil
+		}
+	}
+
+	dependencies := make(map[deployPlanStep][]deployPlanStep)
+	for _, edge := range edges {
+		dependencies[edge.To] = append(dependencies[edge.To], edge.From)
+	}
+	statuses := make(map[deployPlanStep]string)
+	errs := make(map[deployPlanStep]error)
+	for _, rank := range ranks {
+		var ready []deployPlanStep
+		for _, step := range rank {
+			if hasFailedDependency(step, dependencies, statuses) {
+				statuses[step] = deployStepSkipped
+				continue
+			}
+			ready = append(ready, step)
+		}
+		for i, err := range o.deployInParallel(ready) {
+			if err != nil {
+				statuses[ready[i]] = deployStepFailed
+				errs[ready[i]] = err
+				continue
+			}
+			statuses[ready[i]] = deployStepSucceeded
+		}
+	}
+	return logDeploySummary(steps, statuses, errs)
+}
+
+// allDeploySteps returns the environment, if its manifest is in the workspace, followed by every service and job
+// in the workspace. Each returned edge means that its "From" stack must be deployed before its "To" stack:
+// the environment before the workloads, backend services before the services that are reachable from the internet,
+// and the services or jobs publishing to topics before the worker services subscribed to them.
+func (o *deployOpts) allDeploySteps() ([]deployPlanStep, []graph.Edge[deployPlanStep], error) {
+	envs, err := o.ws.ListEnvironments()
+	if err != nil {
+		return nil, nil, fmt.Errorf("list environments in the workspace: %w", err)
+	}
+	svcs, err := o.ws.ListServices()
+	if err != nil {
+		return nil, nil, fmt.Errorf("list services in the workspace: %w", err)
+	}
+	jobs, err := o.ws.ListJobs()
+	if err != nil {
+		return nil, nil, fmt.Errorf("list jobs in the workspace: %w", err)
+	}
+
+	var steps []deployPlanStep
+	var envStep *deployPlanStep
+	if contains(o.envName, envs) {
+		envStep = &deployPlanStep{kind: envType, name: o.envName}
+		steps = append(steps, *envStep)
+	}
+	wklds := make(map[string]deployPlanStep)
+	for _, wkld := range []struct {
+		kind  string
+		names []string
+	}{
+		{kind: svcWkldType, names: svcs},
+		{kind: jobWkldType, names: jobs},
+	} {
+		for _, name := range wkld.names {
+			step := deployPlanStep{kind: wkld.kind, name: name}
+			wklds[name] = step
+			steps = append(steps, step)
+		}
+	}
+
+	var edges []graph.Edge[deployPlanStep]
+	var backends, frontends []deployPlanStep
+	for _, step := range steps {
+		if step.kind == envType {
+			continue
+		}
+		if envStep != nil {
+			edges = append(edges, graph.Edge[deployPlanStep]{From: *envStep, To: step})
+		}
+		mft, err := o.workloadManifest(step.name)
+		if err != nil {
+			return nil, nil, err
+		}
+		switch mft := mft.(type) {
+		case *manifest.BackendService:
+			backends = append(backends, step)
+		case *manifest.LoadBalancedWebService, *manifest.RequestDrivenWebService:
+			frontends = append(frontends, step)
+		case *manifest.WorkerService:
+			for _, topic := range mft.Subscribe.Topics {
+				publisher, ok := wklds[aws.StringValue(topic.Service)]
+				if !ok || publisher == step {
+					continue
+				}
+				edges = append(edges, graph.Edge[deployPlanStep]{From: publisher, To: step})
+			}
+		}
+	}
+	for _, backend := range backends {
+		for _, frontend := range frontends {
+			edges = append(edges, graph.Edge[deployPlanStep]{From: backend, To: frontend})
+		}
+	}
+	return steps, edges, nil
+}
+
+// workloadManifest returns the manifest of the workload with the overrides of the target environment applied.
+func (o *deployOpts) workloadManifest(name string) (manifest.WorkloadManifest, error) {
+	raw, err := o.ws.ReadWorkloadManifest(name)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest file for %s: %w", name, err)
+	}
+	mft, err := manifest.UnmarshalWorkload(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal manifest for %s: %w", name, err)
+	}
+	envMft, err := mft.ApplyEnv(o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("apply environment %s override: %w", o.envName, err)
+	}
+	return envMft, nil
+}
+
+// rankDeploySteps groups the steps so that each group only depends on the groups before it.
+// The steps within a group keep their original order.
+func rankDeploySteps(steps []deployPlanStep, edges []graph.Edge[deployPlanStep]) ([][]deployPlanStep, error) {
+	digraph := graph.New(steps...)
+	for _, edge := range edges {
+		digraph.Add(edge)
+	}
+	sorter, err := graph.TopologicalOrder(digraph)
+	if err != nil {
+		return nil, fmt.Errorf("order deployments by their dependencies: %w", err)
+	}
+	var ranks [][]deployPlanStep
+	for _, step := range steps {
+		rank, _ := sorter.Rank(step)
+		for len(ranks) <= rank {
+			ranks = append(ranks, nil)
+		}
+		ranks[rank] = append(ranks[rank], step)
+	}
+	return ranks, nil
+}
+
+func hasFailedDependency(step deployPlanStep, dependencies map[deployPlanStep][]deployPlanStep, statuses map[deployPlanStep]string) bool {
+	for _, dep := range dependencies[step] {
+		if statuses[dep] != deployStepSucceeded {
+			return true
+		}
+	}
+	return false
+}
+
+// deployInParallel deploys the steps concurrently and returns the error of each step, in the same order.
+// The progress of each workload is buffered and printed at once when its deployment completes,
+// so that the progress of the stacks deployed at the same time doesn't interleave.
+func (o *deployOpts) deployInParallel(steps []deployPlanStep) []error {
+	errs := make([]error, len(steps))
+	sem := make(chan struct{}, maxConcurrentWkldDeployments)
+	var wg sync.WaitGroup
+	var logMu sync.Mutex
+	for i, step := range steps {
+		wg.Add(1)
+		go func(i int, step deployPlanStep) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			logMu.Lock()
+			log.Infof("Deploying %s.\n", step)
+			logMu.Unlock()
+			progress := termprogress.NewFrameWriter()
+			err := o.deployStep(step, progress)
+
+			logMu.Lock()
+			defer logMu.Unlock()
+			if out := progress.String(); out != "" {
+				log.Infof("\nProgress of %s:\n", step)
+				fmt.Fprint(log.DiagnosticWriter, out)
+			}
+			if err != nil {
+				log.Errorf("Failed to deploy %s: %v\n", step, err)
+				errs[i] = err
+				return
+			}
+			log.Successf("Deployed %s.\n", step)
+		}(i, step)
+	}
+	wg.Wait()
+	return errs
+}
+
+// deployStep deploys the environment, or the workload with its progress rendered to progressOut.
+// Workloads are deployed alongside each other, so they can't prompt.
+func (o *deployOpts) deployStep(step deployPlanStep, progressOut termprogress.FileWriter) error {
+	if step.kind == envType {
+		return o.deployEnv()
+	}
+	// Each workload gets its own copy of the options since they are deployed concurrently.
+	wkldOpts := *o
+	wkldOpts.name = step.name
+	wkldOpts.progressOut = progressOut
+	wkldOpts.nonInteractive = true
+	return wkldOpts.deployWorkload()
+}
+
+// logDeploySummary logs the outcome of each step, and returns an error if any of them didn't succeed.
+func logDeploySummary(steps []deployPlanStep, statuses map[deployPlanStep]string, errs map[deployPlanStep]error) error {
+	var failed, skipped []string
+	log.Infoln("Deployment summary:")
+	for _, step := range steps {
+		switch statuses[step] {
+		case deployStepSucceeded:
+			log.Successf("%s %s\n", step, deployStepSucceeded)
+		case deployStepFailed:
+			log.Errorf("%s %s: %v\n", step, deployStepFailed, errs[step])
+			failed = append(failed, step.String())
+		case deployStepSkipped:
+			log.Warningf("%s %s because a dependency failed to deploy\n", step, deployStepSkipped)
+			skipped = append(skipped, step.String())
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return &errDeployStepsFailed{
+		failed:  failed,
+		skipped: skipped,
+		total:   len(steps),
+	}
+}
+
+func deployStepLabels(steps []deployPlanStep) []string {
+	labels := make([]string, len(steps))
+	for i, step := range steps {
+		labels[i] = step.String()
+	}
+	return labels
+}
+
+func (o *deployOpts) askEnvName() error {
+	if o.envName != "" {
+		return nil
+	}
+	name, err := o.sel.Environment("Select an environment to deploy to", "", o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.envName = name
+	return nil
+}
+
+func (o *deployOpts) deployEnv() error {
+	envCmd, err := o.newEnvDeployCmd(o)
+	if err != nil {
+		return err
+	}
+	if err := envCmd.Validate(); err != nil {
+		return fmt.Errorf("validate env deploy: %w", err)
+	}
+	if err := envCmd.Ask(); err != nil {
+		return fmt.Errorf("ask env deploy: %w", err)
+	}
+	if err := envCmd.Execute(); err != nil {
+		return fmt.Errorf("execute env deploy: %w", err)
+	}
+	return nil
+}
+
+// plan returns the environment if its manifest changed since the git revision, followed by the services then
+// the jobs that changed since the revision or that are not deployed to the environment yet.
+func (o *deployOpts) plan() ([]deployPlanStep, error) {
+	changed, err := changedGitFiles(o.runner, o.since)
+	if err != nil {
+		return nil, fmt.Errorf("list files changed since %s: %w", o.since, err)
+	}
+	wsPath, err := o.ws.Path()
+	if err != nil {
+		return nil, fmt.Errorf("get workspace path: %w", err)
+	}
+	copilotDir := filepath.Join(wsPath, workspace.CopilotDirName)
+
+	var steps []deployPlanStep
+	if anyPathUnder(changed, filepath.Join(copilotDir, envManifestsDirName, o.envName)) {
+		steps = append(steps, deployPlanStep{kind: envType, name: o.envName, reason: reasonManifestChanged})
+	}
+	svcs, err := o.ws.ListServices()
+	if err != nil {
+		return nil, fmt.Errorf("list services in the workspace: %w", err)
+	}
+	deployedSvcs, err := o.deployStore.ListDeployedServices(o.appName, o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("list services deployed to environment %s: %w", o.envName, err)
+	}
+	jobs, err := o.ws.ListJobs()
+	if err != nil {
+		return nil, fmt.Errorf("list jobs in the workspace: %w", err)
+	}
+	deployedJobs, err := o.deployStore.ListDeployedJobs(o.appName, o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("list jobs deployed to environment %s: %w", o.envName, err)
+	}
+	for _, wkld := range []struct {
+		kind     string
+		names    []string
+		deployed []string
+	}{
+		{kind: svcWkldType, names: svcs, deployed: deployedSvcs},
+		{kind: jobWkldType, names: jobs, deployed: deployedJobs},
+	} {
+		for _, name := range wkld.names {
+			reason, err := o.workloadChangeReason(name, wkld.deployed, changed, wsPath)
+			if err != nil {
+				return nil, err
+			}
+			if reason != "" {
+				steps = append(steps, deployPlanStep{kind: wkld.kind, name: name, reason: reason})
+			}
+		}
+	}
+	return steps, nil
+}
+
+// workloadChangeReason returns why the workload needs to be redeployed, or an empty string if it doesn't.
+func (o *deployOpts) workloadChangeReason(name string, deployed, changed []string, wsPath string) (string, error) {
+	if !contains(name, deployed) {
+		return reasonNotDeployed, nil
+	}
+	if anyPathUnder(changed, filepath.Join(wsPath, workspace.CopilotDirName, name)) {
+		return reasonManifestChanged, nil
+	}
+	raw, err := o.ws.ReadWorkloadManifest(name)
+	if err != nil {
+		return "", fmt.Errorf("read manifest file for %s: %w", name, err)
+	}
+	mft, err := manifest.UnmarshalWorkload(raw)
+	if err != nil {
+		return "", fmt.Errorf("unmarshal manifest for %s: %w", name, err)
+	}
+	type buildable interface {
+		BuildRequired() (bool, error)
+		BuildArgs(rootDirectory string) *manifest.DockerBuildArgs
+	}
+	b, ok := mft.(buildable)
+	if !ok {
+		return "", nil
+	}
+	if required, err := b.BuildRequired(); err != nil || !required {
+		return "", err
+	}
+	args := b.BuildArgs(wsPath)
+	if anyPathUnder(changed, aws.StringValue(args.Context)) || contains(aws.StringValue(args.Dockerfile), changed) {
+		return reasonSourceChanged, nil
+	}
+	return "", nil
+}
+
+// anyPathUnder returns true if any of the paths is dir or is under dir.
+func anyPathUnder(paths []string, dir string) bool {
+	for _, path := range paths {
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			continue
+		}
+		if rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
 func (o *deployOpts) askName() error {
 	if o.name != "" {
 		return nil
@@ -163,16 +710,22 @@ func (o *deployOpts) loadWkldCmd() error {
 
 // BuildDeployCmd is the deploy command.
 func BuildDeployCmd() *cobra.Command {
-	vars := deployWkldVars{}
+	vars := deployVars{}
 	cmd := &cobra.Command{
 		Use:   "deploy",
 		Short: "Deploy a Copilot job or service.",
-		Long:  "Deploy a Copilot job or service.",
+		Long: `Deploy a Copilot job or service.
+With --since, deploy everything that changed since a git revision: the environment first, then services, then jobs.
+With --all, deploy the environment and every service and job in the workspace in dependency order.`,
 		Example: `
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot deploy --name frontend --env test
   Deploys a job named "mailer" with additional resource tags to a "prod" environment.
-  /code $ copilot deploy -n mailer -e prod --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot deploy -n mailer -e prod --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Deploys the environment, services and jobs that changed since the "main" branch to a "test" environment.
+  /code $ copilot deploy --env test --since main
+  Deploys the environment and every service and job in the workspace to a "test" environment.
+  /code $ copilot deploy --env test --all --yes`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDeployOpts(vars)
 			if err != nil {
@@ -187,10 +740,14 @@ func BuildDeployCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", workloadFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
-	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
+	cmd.Flags().Var(newImageTagsFlag(&vars.imageTag, &vars.extraImageTags), imageTagFlag, imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceFlagDescription)
 	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
+	cmd.Flags().BoolVar(&vars.yesSecurity, yesSecurityFlag, false, yesSecurityFlagDescription)
+	cmd.Flags().StringVar(&vars.since, sinceFlag, "", deploySinceFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.all, allFlag, false, deployAllFlagDescription)
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -198,3 +755,17 @@ func BuildDeployCmd() *cobra.Command {
 	}
 	return cmd
 }
+
+type errDeployStepsFailed struct {
+	failed  []string
+	skipped []string
+	total   int
+}
+
+func (e *errDeployStepsFailed) Error() string {
+	msg := fmt.Sprintf("%d of %d deployments failed: %s", len(e.failed), e.total, english.WordSeries(e.failed, "and"))
+	if len(e.skipped) == 0 {
+		return msg
+	}
+	return fmt.Sprintf("%s; skipped %s", msg, english.WordSeries(e.skipped, "and"))
+}
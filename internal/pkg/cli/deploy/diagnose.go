@@ -0,0 +1,99 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+)
+
+const (
+	// maxDiagnosedTasks is the number of stopped tasks to explain when a deployment fails.
+	maxDiagnosedTasks = 3
+	// diagnosedLogLinesLimit is the number of log lines to print for each stopped task.
+	diagnosedLogLinesLimit = 20
+)
+
+type serviceDescriber interface {
+	DescribeService(app, env, svc string) (*ecs.ServiceDesc, error)
+}
+
+type logEventsWriter interface {
+	WriteLogEvents(opts logging.WriteLogEventsOpts) error
+}
+
+// stoppedTasksDiagnoser explains why the tasks of a failed ECS deployment stopped,
+// so that a deployment rolled back by the ECS deployment circuit breaker doesn't only report that the service didn't stabilize.
+type stoppedTasksDiagnoser struct {
+	app string
+	env string
+	svc string
+
+	describer serviceDescriber
+	logs      logEventsWriter
+	w         io.Writer
+}
+
+// Diagnose writes the stop reason, container exit codes, and latest log lines of the tasks that stopped since the deployment started.
+func (d *stoppedTasksDiagnoser) Diagnose(since time.Time) error {
+	desc, err := d.describer.DescribeService(d.app, d.env, d.svc)
+	if err != nil {
+		return fmt.Errorf("describe service %s: %w", d.svc, err)
+	}
+	var tasks []*awsecs.Task
+	for _, task := range desc.StoppedTasks {
+		if task.CreatedAt == nil || task.CreatedAt.Before(since) {
+			continue
+		}
+		tasks = append(tasks, task)
+		if len(tasks) == maxDiagnosedTasks {
+			break
+		}
+	}
+	if len(tasks) == 0 {
+		return nil
+	}
+	fmt.Fprintf(d.w, "\nTasks of service %s stopped during the deployment:\n", d.svc)
+	for _, task := range tasks {
+		taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(d.w, "\n  Task %s: %s\n", task.String(), aws.StringValue(task.StoppedReason))
+		for _, container := range task.Containers {
+			fmt.Fprintf(d.w, "    - Container %s %s\n", aws.StringValue(container.Name), exitDescription(container))
+		}
+		fmt.Fprintf(d.w, "  Last %d log lines:\n", diagnosedLogLinesLimit)
+		if err := d.logs.WriteLogEvents(logging.WriteLogEventsOpts{
+			Limit:   aws.Int64(diagnosedLogLinesLimit),
+			TaskIDs: []string{taskID},
+			OnEvents: func(_ io.Writer, logs []logging.HumanJSONStringer) error {
+				return logging.WriteHumanLogs(d.w, logs)
+			},
+		}); err != nil {
+			return fmt.Errorf("get logs of task %s: %w", taskID, err)
+		}
+	}
+	return nil
+}
+
+func exitDescription(container *ecsapi.Container) string {
+	var desc string
+	if container.ExitCode != nil {
+		desc = fmt.Sprintf("exited with code %d", aws.Int64Value(container.ExitCode))
+	} else {
+		desc = "stopped without an exit code"
+	}
+	if reason := aws.StringValue(container.Reason); reason != "" {
+		desc = fmt.Sprintf("%s: %s", desc, reason)
+	}
+	return desc
+}
@@ -0,0 +1,141 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockLogLine string
+
+func (l mockLogLine) HumanString() string {
+	return string(l) + "\n"
+}
+
+func (l mockLogLine) JSONString() (string, error) {
+	return string(l), nil
+}
+
+func TestStoppedTasksDiagnoser_Diagnose(t *testing.T) {
+	deployedAt := time.Date(2020, 11, 23, 18, 0, 0, 0, time.UTC)
+	stoppedTask := &awsecs.Task{
+		TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/my-cluster/4082490ee6c245e09d2145010aa1ba8d"),
+		TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/my-app-test-api:2"),
+		CreatedAt:         aws.Time(deployedAt.Add(time.Minute)),
+		StoppedReason:     aws.String("Essential container in task exited"),
+		Containers: []*ecsapi.Container{
+			{
+				Name:     aws.String("api"),
+				ExitCode: aws.Int64(1),
+			},
+			{
+				Name:   aws.String("firelens_log_router"),
+				Reason: aws.String("CannotPullContainerError: pull access denied"),
+			},
+		},
+	}
+	oldTask := &awsecs.Task{
+		TaskArn:   aws.String("arn:aws:ecs:us-west-2:123456789012:task/my-cluster/1234490ee6c245e09d2145010aa1ba8d"),
+		CreatedAt: aws.Time(deployedAt.Add(-time.Hour)),
+	}
+
+	testCases := map[string]struct {
+		setupMocks func(describer *mocks.MockserviceDescriber, logs *mocks.MocklogEventsWriter)
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"error if fail to describe the service": {
+			setupMocks: func(describer *mocks.MockserviceDescriber, logs *mocks.MocklogEventsWriter) {
+				describer.EXPECT().DescribeService("my-app", "test", "api").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe service api: some error"),
+		},
+		"write nothing if no task stopped since the deployment started": {
+			setupMocks: func(describer *mocks.MockserviceDescriber, logs *mocks.MocklogEventsWriter) {
+				describer.EXPECT().DescribeService("my-app", "test", "api").Return(&ecs.ServiceDesc{
+					StoppedTasks: []*awsecs.Task{oldTask},
+				}, nil)
+			},
+		},
+		"error if fail to get the logs of a stopped task": {
+			setupMocks: func(describer *mocks.MockserviceDescriber, logs *mocks.MocklogEventsWriter) {
+				describer.EXPECT().DescribeService("my-app", "test", "api").Return(&ecs.ServiceDesc{
+					StoppedTasks: []*awsecs.Task{stoppedTask},
+				}, nil)
+				logs.EXPECT().WriteLogEvents(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("get logs of task 4082490ee6c245e09d2145010aa1ba8d: some error"),
+		},
+		"write the stop reason, exit codes, and logs of the tasks stopped since the deployment started": {
+			setupMocks: func(describer *mocks.MockserviceDescriber, logs *mocks.MocklogEventsWriter) {
+				describer.EXPECT().DescribeService("my-app", "test", "api").Return(&ecs.ServiceDesc{
+					StoppedTasks: []*awsecs.Task{oldTask, stoppedTask},
+				}, nil)
+				logs.EXPECT().WriteLogEvents(gomock.Any()).DoAndReturn(func(opts logging.WriteLogEventsOpts) error {
+					require.Equal(t, []string{"4082490ee6c245e09d2145010aa1ba8d"}, opts.TaskIDs)
+					require.Equal(t, aws.Int64(20), opts.Limit)
+					return opts.OnEvents(io.Discard, []logging.HumanJSONStringer{
+						mockLogLine("starting server"),
+						mockLogLine("panic: missing DB_NAME"),
+					})
+				})
+			},
+			wantedOutput: `
+Tasks of service api stopped during the deployment:
+
+  Task 4082490e (my-app-test-api:2): Essential container in task exited
+    - Container api exited with code 1
+    - Container firelens_log_router stopped without an exit code: CannotPullContainerError: pull access denied
+  Last 20 log lines:
+starting server
+panic: missing DB_NAME
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			describer := mocks.NewMockserviceDescriber(ctrl)
+			logs := mocks.NewMocklogEventsWriter(ctrl)
+			tc.setupMocks(describer, logs)
+			b := &strings.Builder{}
+			d := &stoppedTasksDiagnoser{
+				app:       "my-app",
+				env:       "test",
+				svc:       "api",
+				describer: describer,
+				logs:      logs,
+				w:         b,
+			}
+
+			// WHEN
+			err := d.Diagnose(deployedAt)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"gopkg.in/yaml.v3"
+)
+
+// TemplateDiff holds the differences between a deployed CloudFormation stack and its newly generated template.
+type TemplateDiff struct {
+	AddedResources   []string // Logical IDs of resources that only exist in the new template.
+	ChangedResources []string // Logical IDs of resources whose properties are different.
+	RemovedResources []string // Logical IDs of resources that only exist in the deployed template.
+	Parameters       []ParameterDiff
+
+	// SecurityResources are the logical IDs of added, changed or removed IAM and security group resources.
+	SecurityResources []string
+	// Addons holds the differences of the nested addons stack, if any.
+	Addons *TemplateDiff
+	// NewStack is true if the stack was never deployed, so every resource is added.
+	NewStack bool
+}
+
+// ParameterDiff represents a parameter whose value is different between the deployed stack and the new configuration.
+// Old is empty if the parameter is added, and New is empty if the parameter is removed.
+type ParameterDiff struct {
+	Key string
+	Old string
+	New string
+}
+
+// IsEmpty returns true if there are no differences.
+func (d *TemplateDiff) IsEmpty() bool {
+	return len(d.AddedResources) == 0 && len(d.ChangedResources) == 0 && len(d.RemovedResources) == 0 && len(d.Parameters) == 0 &&
+		(d.Addons == nil || d.Addons.IsEmpty())
+}
+
+// SecurityChanges returns the logical IDs of the IAM and security group resources that an update to a deployed stack
+// adds, changes or removes. The resources of the nested addons stack are prefixed with the addons stack name.
+func (d *TemplateDiff) SecurityChanges() []string {
+	if d.NewStack {
+		return nil
+	}
+	ids := append([]string{}, d.SecurityResources...)
+	if d.Addons == nil {
+		return ids
+	}
+	for _, id := range d.Addons.SecurityResources {
+		ids = append(ids, fmt.Sprintf("%s/%s", addon.StackName, id))
+	}
+	return ids
+}
+
+// SecurityHumanString returns a concise summary of the security-impacting changes, or an empty string if there are none.
+func (d *TemplateDiff) SecurityHumanString() string {
+	ids := d.SecurityChanges()
+	if len(ids) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	fmt.Fprint(&b, color.Bold.Sprint("Security-impacting changes\n"))
+	for _, id := range ids {
+		fmt.Fprintf(&b, "  ! %s\n", color.HighlightResource(id))
+	}
+	return b.String()
+}
+
+// HumanString returns a human readable summary of the differences.
+func (d *TemplateDiff) HumanString() string {
+	if d.IsEmpty() {
+		return "No changes to the stack.\n"
+	}
+	var b strings.Builder
+	d.writeResources(&b)
+	if len(d.Parameters) != 0 {
+		fmt.Fprint(&b, color.Bold.Sprint("Parameters\n"))
+		for _, p := range d.Parameters {
+			switch {
+			case p.Old == "":
+				fmt.Fprintf(&b, "  + %s: %q\n", p.Key, p.New)
+			case p.New == "":
+				fmt.Fprintf(&b, "  - %s: %q\n", p.Key, p.Old)
+			default:
+				fmt.Fprintf(&b, "  ~ %s: %q -> %q\n", p.Key, p.Old, p.New)
+			}
+		}
+	}
+	if d.Addons != nil && !d.Addons.IsEmpty() {
+		fmt.Fprint(&b, color.Bold.Sprint("Addons\n"))
+		d.Addons.writeResources(&b)
+	}
+	return b.String()
+}
+
+func (d *TemplateDiff) writeResources(b *strings.Builder) {
+	if len(d.AddedResources)+len(d.ChangedResources)+len(d.RemovedResources) != 0 {
+		fmt.Fprint(b, color.Bold.Sprint("Resources\n"))
+		for _, id := range d.AddedResources {
+			fmt.Fprintf(b, "  + %s\n", id)
+		}
+		for _, id := range d.ChangedResources {
+			fmt.Fprintf(b, "  ~ %s\n", id)
+		}
+		for _, id := range d.RemovedResources {
+			fmt.Fprintf(b, "  - %s\n", id)
+		}
+	}
+	if len(d.SecurityResources) != 0 {
+		fmt.Fprint(b, color.Bold.Sprint("IAM and security group changes\n"))
+		for _, id := range d.SecurityResources {
+			fmt.Fprintf(b, "  ! %s\n", color.HighlightResource(id))
+		}
+	}
+}
+
+// computeTemplateDiff compares the deployed template and parameters of a stack against the newly generated ones.
+// The new parameters are expected to be serialized in the same JSON format as "copilot package" outputs.
+func computeTemplateDiff(oldTpl, newTpl string, oldParams []*awscfn.Parameter, newSerializedParams string) (*TemplateDiff, error) {
+	diff, err := computeResourcesDiff(oldTpl, newTpl)
+	if err != nil {
+		return nil, err
+	}
+	var newParams struct {
+		Parameters map[string]string `json:"Parameters"`
+	}
+	if err := json.Unmarshal([]byte(newSerializedParams), &newParams); err != nil {
+		return nil, fmt.Errorf("parse generated parameters: %w", err)
+	}
+	diff.Parameters = parameterDiffs(oldParams, newParams.Parameters)
+	return diff, nil
+}
+
+// computeResourcesDiff compares the resources of the deployed template against the ones of the newly generated template.
+func computeResourcesDiff(oldTpl, newTpl string) (*TemplateDiff, error) {
+	oldResources, err := templateResources(oldTpl)
+	if err != nil {
+		return nil, fmt.Errorf("parse deployed template: %w", err)
+	}
+	newResources, err := templateResources(newTpl)
+	if err != nil {
+		return nil, fmt.Errorf("parse generated template: %w", err)
+	}
+
+	diff := &TemplateDiff{}
+	for id, newNode := range newResources {
+		newNode := newNode
+		oldNode, ok := oldResources[id]
+		if !ok {
+			diff.AddedResources = append(diff.AddedResources, id)
+			if isSecurityResource(&newNode) {
+				diff.SecurityResources = append(diff.SecurityResources, id)
+			}
+			continue
+		}
+		equal, err := nodesEqual(&oldNode, &newNode)
+		if err != nil {
+			return nil, fmt.Errorf("compare resource %s: %w", id, err)
+		}
+		if equal {
+			continue
+		}
+		diff.ChangedResources = append(diff.ChangedResources, id)
+		if isSecurityResource(&oldNode) || isSecurityResource(&newNode) {
+			diff.SecurityResources = append(diff.SecurityResources, id)
+		}
+	}
+	for id, oldNode := range oldResources {
+		oldNode := oldNode
+		if _, ok := newResources[id]; ok {
+			continue
+		}
+		diff.RemovedResources = append(diff.RemovedResources, id)
+		if isSecurityResource(&oldNode) {
+			diff.SecurityResources = append(diff.SecurityResources, id)
+		}
+	}
+
+	sort.Strings(diff.AddedResources)
+	sort.Strings(diff.ChangedResources)
+	sort.Strings(diff.RemovedResources)
+	sort.Strings(diff.SecurityResources)
+	return diff, nil
+}
+
+// isSecurityResource returns true if the resource is an IAM resource or a security group resource.
+func isSecurityResource(resource *yaml.Node) bool {
+	var r struct {
+		Type string `yaml:"Type"`
+	}
+	if err := resource.Decode(&r); err != nil {
+		return false
+	}
+	return strings.HasPrefix(r.Type, "AWS::IAM::") || strings.HasPrefix(r.Type, "AWS::EC2::SecurityGroup")
+}
+
+func templateResources(tpl string) (map[string]yaml.Node, error) {
+	var parsed struct {
+		Resources map[string]yaml.Node `yaml:"Resources"`
+	}
+	if err := yaml.Unmarshal([]byte(tpl), &parsed); err != nil {
+		return nil, err
+	}
+	return parsed.Resources, nil
+}
+
+// nodesEqual returns true if two YAML nodes serialize to the same document, including intrinsic function tags.
+func nodesEqual(a, b *yaml.Node) (bool, error) {
+	aOut, err := yaml.Marshal(a)
+	if err != nil {
+		return false, err
+	}
+	bOut, err := yaml.Marshal(b)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(aOut, bOut), nil
+}
+
+func parameterDiffs(oldParams []*awscfn.Parameter, newParams map[string]string) []ParameterDiff {
+	old := make(map[string]string)
+	for _, p := range oldParams {
+		old[aws.StringValue(p.ParameterKey)] = aws.StringValue(p.ParameterValue)
+	}
+	var diffs []ParameterDiff
+	for key, newVal := range newParams {
+		if oldVal, ok := old[key]; !ok || oldVal != newVal {
+			diffs = append(diffs, ParameterDiff{
+				Key: key,
+				Old: old[key],
+				New: newVal,
+			})
+		}
+	}
+	for key, oldVal := range old {
+		if _, ok := newParams[key]; !ok {
+			diffs = append(diffs, ParameterDiff{
+				Key: key,
+				Old: oldVal,
+			})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Key < diffs[j].Key
+	})
+	return diffs
+}
@@ -0,0 +1,290 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_computeTemplateDiff(t *testing.T) {
+	const deployedTpl = `
+Resources:
+  Cluster:
+    Type: AWS::ECS::Cluster
+  PublicLoadBalancer:
+    Type: AWS::ElasticLoadBalancingV2::LoadBalancer
+    Properties:
+      Scheme: internet-facing
+      SecurityGroups: [ !GetAtt PublicLoadBalancerSecurityGroup.GroupId ]
+  ServiceDiscoveryNamespace:
+    Type: AWS::ServiceDiscovery::PrivateDnsNamespace
+    Properties:
+      Name: !Sub ${EnvironmentName}.local
+`
+	testCases := map[string]struct {
+		inOldTpl    string
+		inNewTpl    string
+		inOldParams []*awscfn.Parameter
+		inNewParams string
+
+		wantedDiff  *TemplateDiff
+		wantedError error
+	}{
+		"error if the deployed template cannot be parsed": {
+			inOldTpl: "Resources: [",
+
+			wantedError: errors.New("parse deployed template: yaml: line 1: did not find expected node content"),
+		},
+		"error if the generated template cannot be parsed": {
+			inOldTpl: deployedTpl,
+			inNewTpl: "Resources: [",
+
+			wantedError: errors.New("parse generated template: yaml: line 1: did not find expected node content"),
+		},
+		"no differences": {
+			inOldTpl: deployedTpl,
+			inNewTpl: deployedTpl,
+			inOldParams: []*awscfn.Parameter{
+				{
+					ParameterKey:   aws.String("AppName"),
+					ParameterValue: aws.String("phonetool"),
+				},
+			},
+			inNewParams: `{"Parameters": {"AppName": "phonetool"}}`,
+
+			wantedDiff: &TemplateDiff{},
+		},
+		"resources and parameters are added, changed and removed": {
+			inOldTpl: deployedTpl,
+			inNewTpl: `
+Resources:
+  Cluster:
+    Type: AWS::ECS::Cluster
+  PublicLoadBalancer:
+    Type: AWS::ElasticLoadBalancingV2::LoadBalancer
+    Properties:
+      Scheme: internet-facing
+      SecurityGroups: [ !Ref PublicLoadBalancerSecurityGroup ]
+  InternalLoadBalancer:
+    Type: AWS::ElasticLoadBalancingV2::LoadBalancer
+`,
+			inOldParams: []*awscfn.Parameter{
+				{
+					ParameterKey:   aws.String("AppName"),
+					ParameterValue: aws.String("phonetool"),
+				},
+				{
+					ParameterKey:   aws.String("ALBWorkloads"),
+					ParameterValue: aws.String("frontend"),
+				},
+				{
+					ParameterKey:   aws.String("Aliases"),
+					ParameterValue: aws.String(""),
+				},
+			},
+			inNewParams: `{"Parameters": {"AppName": "phonetool", "ALBWorkloads": "frontend,api", "InternalALBWorkloads": "backend"}}`,
+
+			wantedDiff: &TemplateDiff{
+				AddedResources:   []string{"InternalLoadBalancer"},
+				ChangedResources: []string{"PublicLoadBalancer"},
+				RemovedResources: []string{"ServiceDiscoveryNamespace"},
+				Parameters: []ParameterDiff{
+					{
+						Key: "ALBWorkloads",
+						Old: "frontend",
+						New: "frontend,api",
+					},
+					{
+						Key: "Aliases",
+					},
+					{
+						Key: "InternalALBWorkloads",
+						New: "backend",
+					},
+				},
+			},
+		},
+		"IAM and security group changes are highlighted": {
+			inOldTpl: `
+Resources:
+  TaskRole:
+    Type: AWS::IAM::Role
+    Properties:
+      Policies: []
+  ServiceSecurityGroup:
+    Type: AWS::EC2::SecurityGroup
+  Service:
+    Type: AWS::ECS::Service
+`,
+			inNewTpl: `
+Resources:
+  TaskRole:
+    Type: AWS::IAM::Role
+    Properties:
+      Policies: [ !Ref DynamoDBPolicy ]
+  ServiceSecurityGroupIngress:
+    Type: AWS::EC2::SecurityGroupIngress
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 2
+`,
+			inNewParams: `{"Parameters": {}}`,
+
+			wantedDiff: &TemplateDiff{
+				AddedResources:    []string{"ServiceSecurityGroupIngress"},
+				ChangedResources:  []string{"Service", "TaskRole"},
+				RemovedResources:  []string{"ServiceSecurityGroup"},
+				SecurityResources: []string{"ServiceSecurityGroup", "ServiceSecurityGroupIngress", "TaskRole"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			diff, err := computeTemplateDiff(tc.inOldTpl, tc.inNewTpl, tc.inOldParams, tc.inNewParams)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDiff, diff)
+			}
+		})
+	}
+}
+
+func TestTemplateDiff_HumanString(t *testing.T) {
+	testCases := map[string]struct {
+		in     *TemplateDiff
+		wanted string
+	}{
+		"no differences": {
+			in:     &TemplateDiff{},
+			wanted: "No changes to the stack.\n",
+		},
+		"resources and parameters are added, changed and removed": {
+			in: &TemplateDiff{
+				AddedResources:   []string{"InternalLoadBalancer"},
+				ChangedResources: []string{"PublicLoadBalancer"},
+				RemovedResources: []string{"ServiceDiscoveryNamespace"},
+				Parameters: []ParameterDiff{
+					{
+						Key: "ALBWorkloads",
+						Old: "frontend",
+						New: "frontend,api",
+					},
+					{
+						Key: "Aliases",
+						Old: "example.com",
+					},
+					{
+						Key: "InternalALBWorkloads",
+						New: "backend",
+					},
+				},
+			},
+			wanted: `Resources
+  + InternalLoadBalancer
+  ~ PublicLoadBalancer
+  - ServiceDiscoveryNamespace
+Parameters
+  ~ ALBWorkloads: "frontend" -> "frontend,api"
+  - Aliases: "example.com"
+  + InternalALBWorkloads: "backend"
+`,
+		},
+		"security and addons changes are shown in separate sections": {
+			in: &TemplateDiff{
+				ChangedResources:  []string{"TaskRole"},
+				SecurityResources: []string{"TaskRole"},
+				Addons: &TemplateDiff{
+					AddedResources: []string{"MyTable"},
+				},
+			},
+			wanted: `Resources
+  ~ TaskRole
+IAM and security group changes
+  ! TaskRole
+Addons
+Resources
+  + MyTable
+`,
+		},
+		"only addons changed": {
+			in: &TemplateDiff{
+				Addons: &TemplateDiff{
+					RemovedResources:  []string{"MyTableAccessPolicy"},
+					SecurityResources: []string{"MyTableAccessPolicy"},
+				},
+			},
+			wanted: `Addons
+Resources
+  - MyTableAccessPolicy
+IAM and security group changes
+  ! MyTableAccessPolicy
+`,
+		},
+		"addons without changes are not shown": {
+			in: &TemplateDiff{
+				Addons: &TemplateDiff{},
+			},
+			wanted: "No changes to the stack.\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.HumanString())
+		})
+	}
+}
+
+func TestTemplateDiff_SecurityHumanString(t *testing.T) {
+	testCases := map[string]struct {
+		in     *TemplateDiff
+		wanted string
+	}{
+		"no security changes": {
+			in: &TemplateDiff{
+				ChangedResources: []string{"Service"},
+			},
+		},
+		"security changes of a new stack are not reported": {
+			in: &TemplateDiff{
+				AddedResources:    []string{"TaskRole"},
+				SecurityResources: []string{"TaskRole"},
+				NewStack:          true,
+			},
+		},
+		"security changes of the stack and its addons": {
+			in: &TemplateDiff{
+				ChangedResources:  []string{"EnvironmentSecurityGroup", "TaskRole"},
+				SecurityResources: []string{"EnvironmentSecurityGroup", "TaskRole"},
+				Addons: &TemplateDiff{
+					AddedResources:    []string{"MyTableAccessPolicy"},
+					SecurityResources: []string{"MyTableAccessPolicy"},
+				},
+			},
+			wanted: `Security-impacting changes
+  ! EnvironmentSecurityGroup
+  ! TaskRole
+  ! AddonsStack/MyTableAccessPolicy
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.SecurityHumanString())
+		})
+	}
+}
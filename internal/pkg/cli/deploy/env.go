@@ -4,20 +4,39 @@
 package deploy
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"runtime"
+	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/lambda"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/guard"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/dustin/go-humanize/english"
+	"github.com/google/uuid"
+	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -30,23 +49,81 @@ type appResourcesGetter interface {
 
 type environmentDeployer interface {
 	UpdateAndRenderEnvironment(out termprogress.FileWriter, env *deploy.CreateEnvironmentInput, opts ...cloudformation.StackOption) error
+	UpdateAndStreamEnvironment(out io.Writer, env *deploy.CreateEnvironmentInput, opts ...cloudformation.StackOption) error
+	UpdateEnvironment(env *deploy.CreateEnvironmentInput, opts ...cloudformation.StackOption) (string, error)
+	CreateEnvironmentChangeSet(env *deploy.CreateEnvironmentInput, opts ...cloudformation.StackOption) (string, error)
+	EnvironmentChangeSet(appName, envName, changeSetID string) (*cloudformation.ChangeSetDescription, error)
+	RenderEnvironmentUpdate(out termprogress.FileWriter, appName, envName string) error
 	EnvironmentParameters(app, env string) ([]*awscfn.Parameter, error)
+	EnvironmentTemplate(app, env string) (string, error)
+	EnvironmentDrift(app, env string) ([]*cloudformation.StackResourceDrift, error)
+	RollbackAndRenderEnvironment(out termprogress.FileWriter, app, env, templateURL string, params []*awscfn.Parameter, cfnExecRoleARN string) error
+	EnvironmentFailedCustomResources(app, env string, since time.Time) ([]deploycfn.FailedCustomResource, error)
 }
 
+type envStackSetInstanceChecker interface {
+	IsEnvironmentInstance(env *config.Environment) (bool, error)
+}
+
+type logEventsGetter interface {
+	LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error)
+}
+
+type envArtifactStore interface {
+	Upload(bucket, key string, data io.Reader) (string, error)
+	UploadIfNotExists(bucket, key string, data io.Reader) (string, error)
+	Download(bucket, key string) ([]byte, error)
+}
+
+type vpcResourcesGetter interface {
+	SecurityGroups(filters ...ec2.Filter) ([]string, error)
+	RouteTableIDs(filters ...ec2.Filter) ([]string, error)
+}
+
+type execRunner interface {
+	Run(name string, args []string, options ...exec.CmdOption) error
+}
+
+type lambdaInvoker interface {
+	Invoke(functionARN string, payload []byte) error
+}
+
+// Stages of an environment deployment during which hooks run.
+const (
+	hookStagePreDeploy  = "pre_deploy"
+	hookStagePostDeploy = "post_deploy"
+)
+
+// maxCustomResourceLogEvents is the maximum number of log events shown for each custom resource that failed to deploy.
+const maxCustomResourceLogEvents = 50
+
 type envDeployer struct {
 	app *config.Application
 	env *config.Environment
 
 	// Dependencies to upload artifacts.
 	templateFS template.Reader
-	s3         uploader
+	s3         envArtifactStore
 	// Dependencies to deploy an environment.
 	appCFN             appResourcesGetter
 	envDeployer        environmentDeployer
 	newStackSerializer func(input *deploy.CreateEnvironmentInput, prevParams []*awscfn.Parameter) stackSerializer
+	useStackSet        bool
+	stackSetInstances  envStackSetInstanceChecker
+	progressOut        termprogress.FileWriter
+	eventsOut          io.Writer
+	vpcGetter          vpcResourcesGetter
+	// Dependencies to run deployment hooks.
+	cmd    execRunner
+	lambda lambdaInvoker
+	// Dependencies to show the logs of failed custom resources.
+	logs logEventsGetter
+	// Nil unless the workspace opts in to validating templates against cfn-guard rules.
+	validator templateValidator
 
 	// Cached variables.
 	appRegionalResources *stack.AppRegionalResources
+	deployedTemplate     string
 }
 
 // NewEnvDeployerInput contains information needd to construct an environment deployer.
@@ -54,6 +131,9 @@ type NewEnvDeployerInput struct {
 	App             *config.Application
 	Env             *config.Environment
 	SessionProvider *sessions.Provider
+	ProgressOut     termprogress.FileWriter // Optional. Where to render the progress of the stack deployment, defaults to os.Stderr.
+	UseStackSet     bool                    // Optional. Deploy the environment stack through a CloudFormation stack set.
+	GuardRulesDir   string                  // Optional. Directory with the cfn-guard rules that the generated template must comply with.
 }
 
 // NewEnvDeployer constructs an environment deployer.
@@ -70,19 +150,40 @@ func NewEnvDeployer(in *NewEnvDeployerInput) (*envDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get env session: %w", err)
 	}
-	return &envDeployer{
+	progressOut := in.ProgressOut
+	if progressOut == nil {
+		progressOut = os.Stderr
+	}
+	stackSet := deploycfn.NewEnvStackSet(in.App, defaultSession, envManagerSession)
+	var deployer environmentDeployer = deploycfn.New(envManagerSession)
+	if in.UseStackSet {
+		deployer = stackSet
+	}
+	d := &envDeployer{
 		app: in.App,
 		env: in.Env,
 
 		templateFS: template.New(),
 		s3:         s3.New(envRegionSession),
 
-		appCFN:      deploycfn.New(defaultSession),
-		envDeployer: deploycfn.New(envManagerSession),
+		appCFN:            deploycfn.New(defaultSession),
+		envDeployer:       deployer,
+		useStackSet:       in.UseStackSet,
+		stackSetInstances: stackSet,
 		newStackSerializer: func(in *deploy.CreateEnvironmentInput, oldParams []*awscfn.Parameter) stackSerializer {
 			return stack.NewEnvConfigFromExistingStack(in, oldParams)
 		},
-	}, nil
+		progressOut: progressOut,
+		eventsOut:   os.Stdout,
+		vpcGetter:   ec2.New(envManagerSession),
+		cmd:         exec.NewCmd(),
+		lambda:      lambda.New(envRegionSession),
+		logs:        cloudwatchlogs.New(envManagerSession),
+	}
+	if in.GuardRulesDir != "" {
+		d.validator = guard.New(in.GuardRulesDir)
+	}
+	return d, nil
 }
 
 // UploadArtifacts uploads the deployment artifacts for the environment.
@@ -99,24 +200,52 @@ func (d *envDeployer) uploadCustomResources(bucket string) (map[string]string, e
 	if err != nil {
 		return nil, fmt.Errorf("read custom resources for environments: %w", err)
 	}
-	urls, err := customresource.Upload(func(key string, dat io.Reader) (url string, err error) {
-		return d.s3.Upload(bucket, key, dat)
-	}, crs)
+	upload := func(key string, dat io.Reader) (url string, err error) {
+		return d.s3.UploadIfNotExists(bucket, key, dat)
+	}
+	urls, err := customresource.Upload(upload, crs)
 	if err != nil {
 		return nil, fmt.Errorf("upload custom resources to bucket %s: %w", bucket, err)
 	}
+	// Record the set of custom resources that the environment stack references, so that "app gc" keeps them
+	// even once newer custom resources are uploaded to the same directories.
+	if _, err := customresource.UploadSet(upload, crs); err != nil {
+		return nil, fmt.Errorf("upload custom resources to bucket %s: %w", bucket, err)
+	}
 	return urls, nil
 }
 
+// PreSeededArtifacts returns the URLs of the custom resources of the environment in a bucket that was seeded ahead of time
+// with the objects uploaded by UploadArtifacts, under the same keys. It doesn't call S3, so that the environment can be
+// deployed from a host without access to the application's bucket.
+func (d *envDeployer) PreSeededArtifacts(bucket string) (map[string]string, error) {
+	crs, err := customresource.Env(d.templateFS)
+	if err != nil {
+		return nil, fmt.Errorf("read custom resources for environments: %w", err)
+	}
+	locate := func(key string, _ io.Reader) (url string, err error) {
+		return s3.URL(d.env.Region, bucket, key), nil
+	}
+	return customresource.Upload(locate, crs)
+}
+
 // DeployEnvironmentInput contains information used to deploy the environment.
 type DeployEnvironmentInput struct {
 	RootUserARN         string
 	CustomResourcesURLs map[string]string
 	Manifest            *manifest.Environment
 	RawManifest         []byte
+	ForceNewUpdate      bool                     // Update the stack and re-run its custom resources even if the template and parameters did not change.
+	JSONProgress        bool                     // Write the stack events to stdout as newline-delimited JSON instead of rendering the progress.
+	ExecutionRoleARN    string                   // Optional. Role assumed by CloudFormation to update the stack, overrides the one in the manifest.
+	AllowDowngrade      bool                     // Deploy the template even if the deployed stack was created by a newer version of Copilot.
+	Timeout             time.Duration            // Optional. How long to wait for the stack update to complete, overrides the one in the manifest.
+	DisableRollback     bool                     // Leave the resources that failed to update in place instead of rolling back the stack.
+	Packaged            *deploy.PackagedTemplate // Optional. A template generated by `env package` to deploy verbatim.
 }
 
-// GenerateCloudFormationTemplate returns the environment stack's template and parameter configuration.
+// GenerateCloudFormationTemplate returns the environment stack's template and parameter configuration,
+// along with their differences from the deployed environment stack.
 func (d *envDeployer) GenerateCloudFormationTemplate(in *DeployEnvironmentInput) (*GenerateCloudFormationTemplateOutput, error) {
 	stackInput, err := d.buildStackInput(in)
 	if err != nil {
@@ -135,19 +264,548 @@ func (d *envDeployer) GenerateCloudFormationTemplate(in *DeployEnvironmentInput)
 	if err != nil {
 		return nil, fmt.Errorf("generate stack template parameters: %w", err)
 	}
+	if err := d.validateTemplate(tpl); err != nil {
+		return nil, err
+	}
+	oldTpl, err := d.envDeployer.EnvironmentTemplate(d.app.Name, d.env.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve deployed environment stack template: %w", err)
+	}
+	diff, err := computeTemplateDiff(oldTpl, tpl, oldParams, params)
+	if err != nil {
+		return nil, fmt.Errorf("compare against deployed environment stack: %w", err)
+	}
 	return &GenerateCloudFormationTemplateOutput{
 		Template:   tpl,
 		Parameters: params,
+		Diff:       diff,
 	}, nil
 }
 
+// DetectDrift returns the resources of the deployed environment stack that were changed outside of CloudFormation.
+func (d *envDeployer) DetectDrift() ([]*cloudformation.StackResourceDrift, error) {
+	drifts, err := d.envDeployer.EnvironmentDrift(d.app.Name, d.env.Name)
+	if err != nil {
+		return nil, fmt.Errorf("detect drift on environment stack: %w", err)
+	}
+	return drifts, nil
+}
+
 // DeployEnvironment deploys an environment using CloudFormation.
+// The pre-deploy and post-deploy hooks from the manifest run before and after the stack is updated.
 func (d *envDeployer) DeployEnvironment(in *DeployEnvironmentInput) error {
+	if err := d.errIfDriftsFromStackSet(); err != nil {
+		return err
+	}
 	stackInput, err := d.buildStackInput(in)
 	if err != nil {
 		return err
 	}
-	return d.envDeployer.UpdateAndRenderEnvironment(os.Stderr, stackInput, cloudformation.WithRoleARN(d.env.ExecutionRoleARN))
+	if err := d.validateTemplateVersion(in); err != nil {
+		return err
+	}
+	if err := d.validateStack(stackInput); err != nil {
+		return err
+	}
+	var preDeploy, postDeploy []manifest.DeploymentHook
+	if in.Manifest != nil {
+		preDeploy, postDeploy = in.Manifest.Hooks.PreDeploy, in.Manifest.Hooks.PostDeploy
+	}
+	if err := d.runHooks(hookStagePreDeploy, preDeploy); err != nil {
+		return err
+	}
+	if err := d.recordDeployment(); err != nil {
+		return err
+	}
+	startedAt := time.Now()
+	if err := d.updateStack(stackInput, in.JSONProgress, d.stackOptions(in)...); err != nil {
+		d.showFailedCustomResourceLogs(startedAt)
+		return err
+	}
+	return d.runHooks(hookStagePostDeploy, postDeploy)
+}
+
+// validateStack checks the template of the environment stack against the cfn-guard rules
+// if the workspace opts in to policy-as-code validation.
+func (d *envDeployer) validateStack(stackInput *deploy.CreateEnvironmentInput) error {
+	if d.validator == nil {
+		return nil
+	}
+	if stackInput.Packaged != nil {
+		return d.validateTemplate(stackInput.Packaged.Template)
+	}
+	oldParams, err := d.envDeployer.EnvironmentParameters(d.app.Name, d.env.Name)
+	if err != nil {
+		return fmt.Errorf("describe environment stack parameters: %w", err)
+	}
+	tpl, err := d.newStackSerializer(stackInput, oldParams).Template()
+	if err != nil {
+		return fmt.Errorf("generate stack template: %w", err)
+	}
+	return d.validateTemplate(tpl)
+}
+
+func (d *envDeployer) validateTemplate(tpl string) error {
+	if d.validator == nil {
+		return nil
+	}
+	if err := d.validator.Validate(d.env.Name, tpl); err != nil {
+		return fmt.Errorf("validate stack template: %w", err)
+	}
+	return nil
+}
+
+// showFailedCustomResourceLogs writes the logs of the Lambda functions backing the custom resources that failed
+// since the deployment started, so that the cause of the failure is shown along with the stack error.
+// Errors are only logged as warnings so that they don't hide the deployment error.
+func (d *envDeployer) showFailedCustomResourceLogs(since time.Time) {
+	failed, err := d.envDeployer.EnvironmentFailedCustomResources(d.app.Name, d.env.Name, since)
+	if err != nil {
+		log.Warningf("Failed to look up the custom resources that failed to deploy: %v\n", err)
+		return
+	}
+	for _, cr := range failed {
+		if cr.FunctionName == "" {
+			continue
+		}
+		out, err := d.logs.LogEvents(cloudwatchlogs.LogEventsOpts{
+			LogGroup:  fmt.Sprintf("/aws/lambda/%s", cr.FunctionName),
+			StartTime: aws.Int64(since.UnixMilli()),
+			EndTime:   aws.Int64(cr.FailedAt.Add(time.Minute).UnixMilli()),
+			Limit:     aws.Int64(maxCustomResourceLogEvents),
+		})
+		if err != nil {
+			log.Warningf("Failed to get the logs of custom resource %s: %v\n", cr.LogicalID, err)
+			continue
+		}
+		fmt.Fprintf(d.progressOut, "\nLogs of function %s for the failed custom resource %s:\n",
+			color.HighlightResource(cr.FunctionName), color.HighlightResource(cr.LogicalID))
+		if len(out.Events) == 0 {
+			fmt.Fprintln(d.progressOut, "No logs were found for the failed invocation.")
+			continue
+		}
+		for _, event := range out.Events {
+			event.Message = strings.TrimRight(event.Message, "\n")
+			fmt.Fprint(d.progressOut, event.HumanString())
+		}
+	}
+}
+
+func (d *envDeployer) updateStack(stackInput *deploy.CreateEnvironmentInput, jsonProgress bool, opts ...cloudformation.StackOption) error {
+	if jsonProgress {
+		return d.envDeployer.UpdateAndStreamEnvironment(d.eventsOut, stackInput, opts...)
+	}
+	return d.envDeployer.UpdateAndRenderEnvironment(d.progressOut, stackInput, opts...)
+}
+
+// stackOptions returns the options to execute the update of the environment stack with.
+func (d *envDeployer) stackOptions(in *DeployEnvironmentInput) []cloudformation.StackOption {
+	opts := []cloudformation.StackOption{cloudformation.WithRoleARN(d.executionRoleARN(in.ExecutionRoleARN, in.Manifest))}
+	if in.DisableRollback {
+		opts = append(opts, cloudformation.WithDisableRollback())
+	}
+	return opts
+}
+
+// executionRoleARN returns the ARN of the role that CloudFormation assumes to update the environment stack.
+// The role from the input takes precedence over the one in the manifest, and both take precedence over the role created by Copilot.
+func (d *envDeployer) executionRoleARN(roleARN string, mft *manifest.Environment) string {
+	if roleARN != "" {
+		return roleARN
+	}
+	if mft != nil && mft.Deployment.ExecutionRole != nil {
+		return aws.StringValue(mft.Deployment.ExecutionRole)
+	}
+	return d.env.ExecutionRoleARN
+}
+
+// timeout returns how long to wait for the environment stack update to complete.
+// The timeout from the input takes precedence over the one in the manifest. If neither is set, the default wait limit applies.
+func (d *envDeployer) timeout(in *DeployEnvironmentInput) time.Duration {
+	if in.Timeout != 0 {
+		return in.Timeout
+	}
+	if in.Manifest != nil && in.Manifest.Deployment.Timeout != nil {
+		return *in.Manifest.Deployment.Timeout
+	}
+	return 0
+}
+
+// EnvironmentDeployment identifies an update of the environment stack that is in progress.
+type EnvironmentDeployment struct {
+	StackName   string
+	ChangeSetID string
+}
+
+// DeployEnvironmentNoWait starts deploying an environment using CloudFormation without waiting for the deployment to complete.
+// The pre-deploy hooks from the manifest run before the stack update starts, however the post-deploy hooks are skipped.
+func (d *envDeployer) DeployEnvironmentNoWait(in *DeployEnvironmentInput) (*EnvironmentDeployment, error) {
+	if err := d.errIfDriftsFromStackSet(); err != nil {
+		return nil, err
+	}
+	stackInput, err := d.buildStackInput(in)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.validateTemplateVersion(in); err != nil {
+		return nil, err
+	}
+	if in.Manifest != nil {
+		if err := d.runHooks(hookStagePreDeploy, in.Manifest.Hooks.PreDeploy); err != nil {
+			return nil, err
+		}
+		if n := len(in.Manifest.Hooks.PostDeploy); n != 0 {
+			log.Warningf("Skipping the %s of environment %s since the deployment is not awaited.\n",
+				english.Plural(n, fmt.Sprintf("%s hook", hookStagePostDeploy), ""), d.env.Name)
+		}
+	}
+	if err := d.recordDeployment(); err != nil {
+		return nil, err
+	}
+	changeSetID, err := d.envDeployer.UpdateEnvironment(stackInput, d.stackOptions(in)...)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvironmentDeployment{
+		StackName:   stack.NameForEnv(d.app.Name, d.env.Name),
+		ChangeSetID: changeSetID,
+	}, nil
+}
+
+// AttachToDeployment renders the progress of the environment deployment in progress until it completes.
+// It returns an error if the deployment failed.
+func (d *envDeployer) AttachToDeployment() error {
+	return d.envDeployer.RenderEnvironmentUpdate(d.progressOut, d.app.Name, d.env.Name)
+}
+
+// EnvironmentChangeSet is a change set for the environment stack that is created but not executed.
+type EnvironmentChangeSet struct {
+	StackName string
+	ID        string
+	Changes   []*awscfn.Change
+}
+
+// CreateChangeSet creates a change set to update the environment stack without executing it.
+// The hooks from the manifest don't run since the environment is not deployed.
+func (d *envDeployer) CreateChangeSet(in *DeployEnvironmentInput) (*EnvironmentChangeSet, error) {
+	if err := d.errIfDriftsFromStackSet(); err != nil {
+		return nil, err
+	}
+	stackInput, err := d.buildStackInput(in)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.validateTemplateVersion(in); err != nil {
+		return nil, err
+	}
+	changeSetID, err := d.envDeployer.CreateEnvironmentChangeSet(stackInput, cloudformation.WithRoleARN(d.executionRoleARN(in.ExecutionRoleARN, in.Manifest)))
+	if err != nil {
+		return nil, err
+	}
+	descr, err := d.envDeployer.EnvironmentChangeSet(d.app.Name, d.env.Name, changeSetID)
+	if err != nil {
+		return nil, err
+	}
+	return &EnvironmentChangeSet{
+		StackName: stack.NameForEnv(d.app.Name, d.env.Name),
+		ID:        changeSetID,
+		Changes:   descr.Changes,
+	}, nil
+}
+
+// ErrEnvTemplateDowngrade is returned when deploying the environment would replace its stack
+// with the template of an older version of Copilot.
+type ErrEnvTemplateDowngrade struct {
+	EnvName         string
+	DeployedVersion string
+	Version         string
+}
+
+func (e *ErrEnvTemplateDowngrade) Error() string {
+	return fmt.Sprintf("environment %s is deployed with template version %s, which is newer than the version %s deployed by this Copilot",
+		e.EnvName, e.DeployedVersion, e.Version)
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+func (e *ErrEnvTemplateDowngrade) RecommendActions() string {
+	return fmt.Sprintf(`The environment was last deployed by a newer version of Copilot, deploying it now would revert its template to %s.
+Upgrade Copilot to deploy the environment with the latest template, or run %s to deploy the older template anyway.`,
+		e.Version, color.HighlightCode(fmt.Sprintf("copilot env deploy --name %s --allow-downgrade", e.EnvName)))
+}
+
+// validateTemplateVersion returns an ErrEnvTemplateDowngrade if the deployed environment stack
+// has a newer template version than the one deployed by this version of Copilot, unless the downgrade is allowed.
+func (d *envDeployer) validateTemplateVersion(in *DeployEnvironmentInput) error {
+	tpl, err := d.getDeployedTemplate()
+	if err != nil {
+		return err
+	}
+	deployed, err := templateVersion(tpl)
+	if err != nil {
+		return err
+	}
+	version := deploy.LatestEnvTemplateVersion
+	if in.Packaged != nil {
+		if version, err = templateVersion(in.Packaged.Template); err != nil {
+			return err
+		}
+	}
+	if semver.Compare(deployed, version) <= 0 {
+		return nil
+	}
+	if !in.AllowDowngrade {
+		return &ErrEnvTemplateDowngrade{
+			EnvName:         d.env.Name,
+			DeployedVersion: deployed,
+			Version:         version,
+		}
+	}
+	log.Warningf("Downgrading the template of environment %s from version %s to %s.\n", d.env.Name, deployed, version)
+	return nil
+}
+
+// templateVersion returns the version recorded in the Metadata of an environment stack template.
+// Templates created before environments were versioned return deploy.LegacyEnvTemplateVersion.
+func templateVersion(tpl string) (string, error) {
+	var parsed struct {
+		Metadata struct {
+			Version string `yaml:"Version"`
+		} `yaml:"Metadata"`
+	}
+	if err := yaml.Unmarshal([]byte(tpl), &parsed); err != nil {
+		return "", fmt.Errorf("unmarshal Metadata property of the environment stack template to read Version: %w", err)
+	}
+	if parsed.Metadata.Version == "" {
+		return deploy.LegacyEnvTemplateVersion, nil
+	}
+	return parsed.Metadata.Version, nil
+}
+
+// ErrNoPreviousDeployment is returned when there is no previous deployment to roll an environment back to.
+type ErrNoPreviousDeployment struct {
+	envName string
+}
+
+func (e *ErrNoPreviousDeployment) Error() string {
+	return fmt.Sprintf("no previous deployment found for environment %s", e.envName)
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+func (e *ErrNoPreviousDeployment) RecommendActions() string {
+	return fmt.Sprintf("Copilot keeps the environment configuration prior to the latest %s that changed the environment,\nso there is nothing to roll back to until the environment is successfully deployed with changes.",
+		color.HighlightCode("copilot env deploy"))
+}
+
+// ErrEnvironmentInStackSet occurs when the stack of an environment deployed through the environment stack set
+// would be updated directly, so that it drifts from its stack instance.
+type ErrEnvironmentInStackSet struct {
+	appName string
+	envName string
+}
+
+func (e *ErrEnvironmentInStackSet) Error() string {
+	return fmt.Sprintf("environment %s is deployed through stack set %s", e.envName, stack.NameForEnvStackSet(e.appName))
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+func (e *ErrEnvironmentInStackSet) RecommendActions() string {
+	return fmt.Sprintf("Run %s so that the environment stack doesn't drift from its stack instance.",
+		color.HighlightCode(fmt.Sprintf("copilot env deploy --name %s --stackset", e.envName)))
+}
+
+// errIfDriftsFromStackSet returns an error if the environment stack is an instance of the environment stack set,
+// but it is about to be updated directly instead of through the stack set.
+func (d *envDeployer) errIfDriftsFromStackSet() error {
+	if d.useStackSet {
+		return nil
+	}
+	isInstance, err := d.stackSetInstances.IsEnvironmentInstance(d.env)
+	if err != nil {
+		return fmt.Errorf("check if environment %s is deployed through a stack set: %w", d.env.Name, err)
+	}
+	if isInstance {
+		return &ErrEnvironmentInStackSet{
+			appName: d.app.Name,
+			envName: d.env.Name,
+		}
+	}
+	return nil
+}
+
+// RollbackEnvironmentInput holds the configuration to roll back an environment with.
+type RollbackEnvironmentInput struct {
+	ExecutionRoleARN string                // Optional. ARN of the role that CloudFormation assumes to roll back the stack.
+	Manifest         *manifest.Environment // Optional. The manifest of the environment, for the execution role it configures.
+}
+
+// Rollback redeploys the environment stack with the template and parameters it had before the latest deployment.
+// The stack is rolled back with the same execution role as a deployment would use.
+func (d *envDeployer) Rollback(in *RollbackEnvironmentInput) error {
+	if err := d.errIfDriftsFromStackSet(); err != nil {
+		return err
+	}
+	resources, err := d.getAppRegionalResources()
+	if err != nil {
+		return err
+	}
+	if err := d.recordDeployment(); err != nil {
+		return err
+	}
+	stackName := stack.NameForEnv(d.app.Name, d.env.Name)
+	raw, err := d.s3.Download(resources.S3Bucket, artifactpath.PreviousDeploymentParams(stackName))
+	if err != nil {
+		var errNotFound *s3.ErrObjectNotFound
+		if errors.As(err, &errNotFound) {
+			return &ErrNoPreviousDeployment{
+				envName: d.env.Name,
+			}
+		}
+		return fmt.Errorf("download the parameters of the previous deployment: %w", err)
+	}
+	var values map[string]string
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return fmt.Errorf("unmarshal the parameters of the previous deployment: %w", err)
+	}
+	params := make([]*awscfn.Parameter, 0, len(values))
+	for key, value := range values {
+		params = append(params, &awscfn.Parameter{
+			ParameterKey:   aws.String(key),
+			ParameterValue: aws.String(value),
+		})
+	}
+	templateURL := s3.URL(d.env.Region, resources.S3Bucket, artifactpath.PreviousDeploymentTemplate(stackName))
+	return d.envDeployer.RollbackAndRenderEnvironment(d.progressOut, d.app.Name, d.env.Name, templateURL, params, d.executionRoleARN(in.ExecutionRoleARN, in.Manifest))
+}
+
+// recordDeployment keeps the configuration of the environment stack to roll back to in the artifact bucket.
+//
+// The outcome of a deployment isn't always awaited, so instead of saving the stack before each update,
+// the stack is compared to the configuration it was last seen deployed with. If the template changed since,
+// an update succeeded and the last seen configuration becomes the previous deployment to roll back to.
+// This way, deployments that fail or don't change the stack never replace the previous deployment.
+func (d *envDeployer) recordDeployment() error {
+	resources, err := d.getAppRegionalResources()
+	if err != nil {
+		return err
+	}
+	deployed, err := d.deployedConfig()
+	if err != nil {
+		return err
+	}
+	stackName := stack.NameForEnv(d.app.Name, d.env.Name)
+	lastTpl, err := d.s3.Download(resources.S3Bucket, artifactpath.LastDeploymentTemplate(stackName))
+	if err != nil {
+		var errNotFound *s3.ErrObjectNotFound
+		if !errors.As(err, &errNotFound) {
+			return fmt.Errorf("download the template of the last deployment: %w", err)
+		}
+		return d.uploadConfig(resources.S3Bucket, artifactpath.LastDeploymentTemplate(stackName), artifactpath.LastDeploymentParams(stackName), deployed)
+	}
+	if string(lastTpl) == deployed.template {
+		return nil
+	}
+	lastParams, err := d.s3.Download(resources.S3Bucket, artifactpath.LastDeploymentParams(stackName))
+	if err != nil {
+		return fmt.Errorf("download the parameters of the last deployment: %w", err)
+	}
+	last := &stackConfig{
+		template: string(lastTpl),
+		params:   lastParams,
+	}
+	if err := d.uploadConfig(resources.S3Bucket, artifactpath.PreviousDeploymentTemplate(stackName), artifactpath.PreviousDeploymentParams(stackName), last); err != nil {
+		return err
+	}
+	return d.uploadConfig(resources.S3Bucket, artifactpath.LastDeploymentTemplate(stackName), artifactpath.LastDeploymentParams(stackName), deployed)
+}
+
+// stackConfig is the template and the JSON-encoded parameter values of a stack.
+type stackConfig struct {
+	template string
+	params   []byte
+}
+
+// deployedConfig returns the template and parameters the environment stack is currently deployed with.
+func (d *envDeployer) deployedConfig() (*stackConfig, error) {
+	tpl, err := d.getDeployedTemplate()
+	if err != nil {
+		return nil, err
+	}
+	params, err := d.envDeployer.EnvironmentParameters(d.app.Name, d.env.Name)
+	if err != nil {
+		return nil, fmt.Errorf("describe environment stack parameters: %w", err)
+	}
+	values := make(map[string]string, len(params))
+	for _, param := range params {
+		values[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+	}
+	rawParams, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("marshal environment stack parameters: %w", err)
+	}
+	return &stackConfig{
+		template: tpl,
+		params:   rawParams,
+	}, nil
+}
+
+func (d *envDeployer) uploadConfig(bucket, templateKey, paramsKey string, config *stackConfig) error {
+	if _, err := d.s3.Upload(bucket, templateKey, strings.NewReader(config.template)); err != nil {
+		return fmt.Errorf("save the environment stack template: %w", err)
+	}
+	if _, err := d.s3.Upload(bucket, paramsKey, bytes.NewReader(config.params)); err != nil {
+		return fmt.Errorf("save the environment stack parameters: %w", err)
+	}
+	return nil
+}
+
+// runHooks runs the hooks in order.
+// A failing hook stops the deployment unless it's configured to only warn on failure.
+func (d *envDeployer) runHooks(stage string, hooks []manifest.DeploymentHook) error {
+	for i, hook := range hooks {
+		fmt.Fprintf(d.progressOut, "Running %s hook %s.\n", stage, hook)
+		err := d.runHook(stage, hook)
+		if err == nil {
+			continue
+		}
+		if !hook.AbortOnFailure() {
+			log.Warningf("%s hook %s for environment %s failed: %v\n", stage, hook, d.env.Name, err)
+			continue
+		}
+		return fmt.Errorf(`run "%s[%d]" hook %s: %w`, stage, i, hook, err)
+	}
+	return nil
+}
+
+type hookPayload struct {
+	Application string `json:"application"`
+	Environment string `json:"environment"`
+	Stage       string `json:"stage"`
+}
+
+func (d *envDeployer) runHook(stage string, hook manifest.DeploymentHook) error {
+	if hook.Lambda != nil {
+		payload, err := json.Marshal(hookPayload{
+			Application: d.app.Name,
+			Environment: d.env.Name,
+			Stage:       stage,
+		})
+		if err != nil {
+			return fmt.Errorf("marshal payload: %w", err)
+		}
+		return d.lambda.Invoke(aws.StringValue(hook.Lambda), payload)
+	}
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == "windows" {
+		shell, flag = "cmd", "/C"
+	}
+	return d.cmd.Run(shell, []string{flag, aws.StringValue(hook.Command)},
+		exec.Stdout(d.progressOut),
+		exec.Stderr(d.progressOut),
+		exec.Env(
+			fmt.Sprintf("COPILOT_APPLICATION_NAME=%s", d.app.Name),
+			fmt.Sprintf("COPILOT_ENVIRONMENT_NAME=%s", d.env.Name),
+			fmt.Sprintf("COPILOT_HOOK_STAGE=%s", stage),
+		))
 }
 
 func (d *envDeployer) getAppRegionalResources() (*stack.AppRegionalResources, error) {
@@ -161,9 +819,22 @@ func (d *envDeployer) getAppRegionalResources() (*stack.AppRegionalResources, er
 	if resources.S3Bucket == "" {
 		return nil, fmt.Errorf("cannot find the S3 artifact bucket in region %s", d.env.Region)
 	}
+	d.appRegionalResources = resources
 	return resources, nil
 }
 
+func (d *envDeployer) getDeployedTemplate() (string, error) {
+	if d.deployedTemplate != "" {
+		return d.deployedTemplate, nil
+	}
+	tpl, err := d.envDeployer.EnvironmentTemplate(d.app.Name, d.env.Name)
+	if err != nil {
+		return "", fmt.Errorf("retrieve deployed environment stack template: %w", err)
+	}
+	d.deployedTemplate = tpl
+	return tpl, nil
+}
+
 func (d *envDeployer) buildStackInput(in *DeployEnvironmentInput) (*deploy.CreateEnvironmentInput, error) {
 	resources, err := d.getAppRegionalResources()
 	if err != nil {
@@ -173,12 +844,24 @@ func (d *envDeployer) buildStackInput(in *DeployEnvironmentInput) (*deploy.Creat
 	if err != nil {
 		return nil, err
 	}
+	if err := d.validateImportedVPCResources(in.Manifest); err != nil {
+		return nil, err
+	}
+	var forceUpdateID string
+	if in.ForceNewUpdate {
+		id, err := uuid.NewRandom()
+		if err != nil {
+			return nil, fmt.Errorf("generate force update ID: %w", err)
+		}
+		forceUpdateID = id.String()
+	}
 	return &deploy.CreateEnvironmentInput{
 		Name: d.env.Name,
 		App: deploy.AppInformation{
 			Name:                d.app.Name,
 			Domain:              d.app.Domain,
 			AccountPrincipalARN: in.RootUserARN,
+			ResourcePrefix:      d.app.ResourcePrefix,
 		},
 		AdditionalTags:       d.app.Tags,
 		CustomResourcesURLs:  in.CustomResourcesURLs,
@@ -187,5 +870,61 @@ func (d *envDeployer) buildStackInput(in *DeployEnvironmentInput) (*deploy.Creat
 		Mft:                  in.Manifest,
 		RawMft:               in.RawManifest,
 		Version:              deploy.LatestEnvTemplateVersion,
+		ForceUpdateID:        forceUpdateID,
+		Timeout:              d.timeout(in),
+		Packaged:             in.Packaged,
 	}, nil
 }
+
+// validateImportedVPCResources returns an error if the existing security group or route tables
+// referenced in the manifest don't belong to the imported VPC.
+func (d *envDeployer) validateImportedVPCResources(mft *manifest.Environment) error {
+	if mft == nil {
+		return nil
+	}
+	vpc := mft.Network.VPC
+	if vpc.ID == nil || (vpc.SecurityGroup == nil && vpc.RouteTables.IsEmpty()) {
+		return nil
+	}
+	vpcFilter := ec2.Filter{
+		Name:   "vpc-id",
+		Values: []string{aws.StringValue(vpc.ID)},
+	}
+	if vpc.SecurityGroup != nil {
+		ids, err := d.vpcGetter.SecurityGroups(vpcFilter, ec2.Filter{
+			Name:   "group-id",
+			Values: []string{aws.StringValue(vpc.SecurityGroup)},
+		})
+		if err != nil {
+			return fmt.Errorf("get security group %s: %w", aws.StringValue(vpc.SecurityGroup), err)
+		}
+		if len(ids) == 0 {
+			return fmt.Errorf("security group %s does not exist in VPC %s", aws.StringValue(vpc.SecurityGroup), aws.StringValue(vpc.ID))
+		}
+	}
+	var routeTables []string
+	if vpc.RouteTables.Public != nil {
+		routeTables = append(routeTables, aws.StringValue(vpc.RouteTables.Public))
+	}
+	routeTables = append(routeTables, vpc.RouteTables.Private...)
+	if len(routeTables) == 0 {
+		return nil
+	}
+	found, err := d.vpcGetter.RouteTableIDs(vpcFilter, ec2.Filter{
+		Name:   "route-table-id",
+		Values: routeTables,
+	})
+	if err != nil {
+		return fmt.Errorf("get route tables %s: %w", english.WordSeries(routeTables, "and"), err)
+	}
+	exists := make(map[string]bool, len(found))
+	for _, id := range found {
+		exists[id] = true
+	}
+	for _, id := range routeTables {
+		if !exists[id] {
+			return fmt.Errorf("route table %s does not exist in VPC %s", id, aws.StringValue(vpc.ID))
+		}
+	}
+	return nil
+}
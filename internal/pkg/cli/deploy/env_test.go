@@ -9,22 +9,49 @@ import (
 	"io"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
+func TestEnvDeployer_PreSeededArtifacts(t *testing.T) {
+	d := envDeployer{
+		env: &config.Environment{
+			Region: "us-west-2",
+		},
+		templateFS: fakeTemplateFS(),
+	}
+	crs, err := customresource.Env(fakeTemplateFS())
+	require.NoError(t, err)
+	wanted := make(map[string]string)
+	for _, cr := range crs {
+		wanted[cr.FunctionName()] = fmt.Sprintf("https://internal-artifacts.s3.us-west-2.amazonaws.com/%s", cr.ArtifactPath())
+	}
+
+	got, err := d.PreSeededArtifacts("internal-artifacts")
+
+	require.NoError(t, err)
+	require.Equal(t, wanted, got)
+}
+
 type uploadArtifactsMock struct {
 	appCFN *mocks.MockappResourcesGetter
-	s3     *mocks.Mockuploader
+	s3     *mocks.MockenvArtifactStore
 }
 
 func TestEnvDeployer_UploadArtifacts(t *testing.T) {
@@ -55,10 +82,24 @@ func TestEnvDeployer_UploadArtifacts(t *testing.T) {
 				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
 					S3Bucket: "mockS3Bucket",
 				}, nil)
-				m.s3.EXPECT().Upload("mockS3Bucket", gomock.Any(), gomock.Any()).AnyTimes().Return("", fmt.Errorf("some error"))
+				m.s3.EXPECT().UploadIfNotExists("mockS3Bucket", gomock.Any(), gomock.Any()).AnyTimes().Return("", fmt.Errorf("some error"))
 			},
 			wantedError: errors.New("upload custom resources to bucket mockS3Bucket"),
 		},
+		"fail to upload the set of custom resources": {
+			setUpMocks: func(m *uploadArtifactsMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.s3.EXPECT().UploadIfNotExists("mockS3Bucket", gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader) (url string, err error) {
+					if strings.Contains(key, "/sets/") {
+						return "", errors.New("some error")
+					}
+					return "", nil
+				}).AnyTimes()
+			},
+			wantedError: errors.New("upload custom resources to bucket mockS3Bucket: upload custom resources set"),
+		},
 		"success with URL returned": {
 			setUpMocks: func(m *uploadArtifactsMock) {
 				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
@@ -67,7 +108,7 @@ func TestEnvDeployer_UploadArtifacts(t *testing.T) {
 				crs, err := customresource.Env(fakeTemplateFS())
 				require.NoError(t, err)
 
-				m.s3.EXPECT().Upload("mockS3Bucket", gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader) (url string, err error) {
+				m.s3.EXPECT().UploadIfNotExists("mockS3Bucket", gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader) (url string, err error) {
 					for _, cr := range crs {
 						if strings.Contains(key, strings.ToLower(cr.FunctionName())) {
 							return "", nil
@@ -75,6 +116,10 @@ func TestEnvDeployer_UploadArtifacts(t *testing.T) {
 					}
 					return "", errors.New("did not match any custom resource")
 				}).Times(len(crs))
+				m.s3.EXPECT().UploadIfNotExists("mockS3Bucket", gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader) (url string, err error) {
+					require.True(t, strings.HasPrefix(key, "manual/scripts/custom-resources/sets/"), "expected the set of custom resources to be uploaded last")
+					return "", nil
+				})
 			},
 			wantedOut: map[string]string{
 				"CertificateValidationFunction": "",
@@ -91,7 +136,7 @@ func TestEnvDeployer_UploadArtifacts(t *testing.T) {
 
 			m := &uploadArtifactsMock{
 				appCFN: mocks.NewMockappResourcesGetter(ctrl),
-				s3:     mocks.NewMockuploader(ctrl),
+				s3:     mocks.NewMockenvArtifactStore(ctrl),
 			}
 			tc.setUpMocks(m)
 
@@ -120,7 +165,23 @@ func TestEnvDeployer_UploadArtifacts(t *testing.T) {
 type deployEnvironmentMock struct {
 	appCFN      *mocks.MockappResourcesGetter
 	envDeployer *mocks.MockenvironmentDeployer
+	s3          *mocks.MockenvArtifactStore
 	stack       *mocks.MockstackSerializer
+	cmd         *mocks.MockexecRunner
+	lambda      *mocks.MocklambdaInvoker
+	validator   *mocks.MocktemplateValidator
+	stackSet    *mocks.MockenvStackSetInstanceChecker
+}
+
+// mockDeployedEnvTemplate is a deployed environment stack template that was created by this version of Copilot.
+const mockDeployedEnvTemplate = `Metadata:
+  Version: ` + deploy.LatestEnvTemplateVersion
+
+// expectRecordDeployment expects the deployed environment stack to be compared with the configuration it was last seen deployed with.
+func expectRecordDeployment(m *deployEnvironmentMock) {
+	m.envDeployer.EXPECT().EnvironmentTemplate(gomock.Any(), gomock.Any()).Return(mockDeployedEnvTemplate, nil)
+	m.envDeployer.EXPECT().EnvironmentParameters(gomock.Any(), gomock.Any()).Return(nil, nil)
+	m.s3.EXPECT().Download("mockS3Bucket", "manual/last-deployment/mockApp-mockEnv/template.yml").Return([]byte(mockDeployedEnvTemplate), nil)
 }
 
 func TestEnvDeployer_GenerateCloudFormationTemplate(t *testing.T) {
@@ -133,10 +194,12 @@ func TestEnvDeployer_GenerateCloudFormationTemplate(t *testing.T) {
 		Name: mockAppName,
 	}
 	testCases := map[string]struct {
-		setUpMocks func(m *deployEnvironmentMock)
+		useGuardRules bool
+		setUpMocks    func(m *deployEnvironmentMock)
 
 		wantedTemplate string
 		wantedParams   string
+		wantedDiff     *TemplateDiff
 		wantedError    error
 	}{
 		"fail to get app resources by region": {
@@ -176,18 +239,64 @@ func TestEnvDeployer_GenerateCloudFormationTemplate(t *testing.T) {
 			},
 			wantedError: errors.New("generate stack template parameters: some error"),
 		},
+		"fail if the template violates the cfn-guard rules": {
+			useGuardRules: true,
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), gomock.Any()).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.envDeployer.EXPECT().EnvironmentParameters(gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.stack.EXPECT().Template().Return("Resources: {}", nil)
+				m.stack.EXPECT().SerializedParameters().Return("", nil)
+				m.validator.EXPECT().Validate(mockEnvName, "Resources: {}").Return(errors.New("some error"))
+			},
+			wantedError: errors.New("validate stack template: some error"),
+		},
+		"fail to get the deployed stack template": {
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), gomock.Any()).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.envDeployer.EXPECT().EnvironmentParameters(gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.stack.EXPECT().Template().Return("", nil)
+				m.stack.EXPECT().SerializedParameters().Return("", nil)
+				m.envDeployer.EXPECT().EnvironmentTemplate(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("retrieve deployed environment stack template: some error"),
+		},
+		"fail to compare against the deployed stack": {
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), gomock.Any()).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.envDeployer.EXPECT().EnvironmentParameters(gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.stack.EXPECT().Template().Return("Resources: {}", nil)
+				m.stack.EXPECT().SerializedParameters().Return("gobi", nil)
+				m.envDeployer.EXPECT().EnvironmentTemplate(gomock.Any(), gomock.Any()).Return("Resources: {}", nil)
+			},
+			wantedError: errors.New("compare against deployed environment stack: parse generated parameters: invalid character 'g' looking for beginning of value"),
+		},
 		"successfully return templates environment deployment": {
 			setUpMocks: func(m *deployEnvironmentMock) {
 				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
 					S3Bucket: "mockS3Bucket",
 				}, nil)
-				m.envDeployer.EXPECT().EnvironmentParameters(mockAppName, mockEnvName).Return(nil, nil)
-				m.stack.EXPECT().Template().Return("aloo", nil)
-				m.stack.EXPECT().SerializedParameters().Return("gobi", nil)
+				m.envDeployer.EXPECT().EnvironmentParameters(mockAppName, mockEnvName).Return([]*awscfn.Parameter{
+					{
+						ParameterKey:   aws.String("AppName"),
+						ParameterValue: aws.String("mockApp"),
+					},
+				}, nil)
+				m.stack.EXPECT().Template().Return("Resources:\n  Cluster:\n    Type: AWS::ECS::Cluster\n", nil)
+				m.stack.EXPECT().SerializedParameters().Return(`{"Parameters": {"AppName": "mockApp"}}`, nil)
+				m.envDeployer.EXPECT().EnvironmentTemplate(mockAppName, mockEnvName).Return("Resources: {}", nil)
 			},
 
-			wantedTemplate: "aloo",
-			wantedParams:   "gobi",
+			wantedTemplate: "Resources:\n  Cluster:\n    Type: AWS::ECS::Cluster\n",
+			wantedParams:   `{"Parameters": {"AppName": "mockApp"}}`,
+			wantedDiff: &TemplateDiff{
+				AddedResources: []string{"Cluster"},
+			},
 		},
 	}
 	for name, tc := range testCases {
@@ -199,6 +308,7 @@ func TestEnvDeployer_GenerateCloudFormationTemplate(t *testing.T) {
 				appCFN:      mocks.NewMockappResourcesGetter(ctrl),
 				envDeployer: mocks.NewMockenvironmentDeployer(ctrl),
 				stack:       mocks.NewMockstackSerializer(ctrl),
+				validator:   mocks.NewMocktemplateValidator(ctrl),
 			}
 			tc.setUpMocks(m)
 			d := envDeployer{
@@ -213,6 +323,9 @@ func TestEnvDeployer_GenerateCloudFormationTemplate(t *testing.T) {
 					return m.stack
 				},
 			}
+			if tc.useGuardRules {
+				d.validator = m.validator
+			}
 			actual, err := d.GenerateCloudFormationTemplate(&DeployEnvironmentInput{})
 			if tc.wantedError != nil {
 				require.EqualError(t, err, tc.wantedError.Error())
@@ -220,6 +333,7 @@ func TestEnvDeployer_GenerateCloudFormationTemplate(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, tc.wantedTemplate, actual.Template)
 				require.Equal(t, tc.wantedParams, actual.Parameters)
+				require.Equal(t, tc.wantedDiff, actual.Diff)
 			}
 		})
 	}
@@ -235,10 +349,37 @@ func TestEnvDeployer_DeployEnvironment(t *testing.T) {
 	mockApp := &config.Application{
 		Name: mockAppName,
 	}
+	const mockFunctionARN = "arn:aws:lambda:us-west-2:123456789012:function:validate"
+	mockHooks := func(pre, post []manifest.DeploymentHook) *manifest.Environment {
+		mft := &manifest.Environment{}
+		mft.Hooks.PreDeploy = pre
+		mft.Hooks.PostDeploy = post
+		return mft
+	}
 	testCases := map[string]struct {
-		setUpMocks  func(m *deployEnvironmentMock)
-		wantedError error
+		inManifest       *manifest.Environment
+		inForceNewUpdate bool
+		inJSONProgress   bool
+		inAllowDowngrade bool
+		inNoRollback     bool
+		inPackaged       *deploy.PackagedTemplate
+		useGuardRules    bool
+		setUpMocks       func(m *deployEnvironmentMock)
+		wantedError      error
 	}{
+		"fail to check if the environment is deployed through the stack set": {
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.stackSet.EXPECT().IsEnvironmentInstance(gomock.Any()).Return(false, errors.New("some error"))
+			},
+			wantedError: errors.New("check if environment mockEnv is deployed through a stack set: some error"),
+		},
+		"error instead of updating the stack of an environment deployed through the stack set": {
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.stackSet.EXPECT().IsEnvironmentInstance(gomock.Any()).Return(true, nil)
+				m.envDeployer.EXPECT().UpdateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("environment mockEnv is deployed through stack set mockApp-environments"),
+		},
 		"fail to get app resources by region": {
 			setUpMocks: func(m *deployEnvironmentMock) {
 				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).
@@ -251,15 +392,213 @@ func TestEnvDeployer_DeployEnvironment(t *testing.T) {
 				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
 					S3Bucket: "mockS3Bucket",
 				}, nil)
+				expectRecordDeployment(m)
 				m.envDeployer.EXPECT().UpdateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+				m.envDeployer.EXPECT().EnvironmentFailedCustomResources(mockAppName, mockEnvName, gomock.Any()).Return(nil, nil)
 			},
 			wantedError: errors.New("some error"),
 		},
+		"do not deploy if the deployed stack has a newer template version": {
+			inManifest: mockHooks([]manifest.DeploymentHook{
+				{Command: aws.String("./validate.sh")},
+			}, nil),
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.envDeployer.EXPECT().EnvironmentTemplate(mockAppName, mockEnvName).Return("Metadata:\n  Version: v99.0.0\n", nil)
+				m.cmd.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.envDeployer.EXPECT().UpdateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: fmt.Errorf("environment mockEnv is deployed with template version v99.0.0, which is newer than the version %s deployed by this Copilot", deploy.LatestEnvTemplateVersion),
+		},
+		"deploy over a newer template version if the downgrade is allowed": {
+			inAllowDowngrade: true,
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.envDeployer.EXPECT().EnvironmentTemplate(mockAppName, mockEnvName).Return("Metadata:\n  Version: v99.0.0\n", nil).Times(1)
+				m.envDeployer.EXPECT().EnvironmentParameters(mockAppName, mockEnvName).Return(nil, nil)
+				m.s3.EXPECT().Download("mockS3Bucket", "manual/last-deployment/mockApp-mockEnv/template.yml").Return(nil, &s3.ErrObjectNotFound{})
+				m.s3.EXPECT().Upload("mockS3Bucket", gomock.Any(), gomock.Any()).Return("", nil).Times(2)
+				m.envDeployer.EXPECT().UpdateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"do not deploy a packaged template that violates the cfn-guard rules": {
+			inPackaged: &deploy.PackagedTemplate{
+				Template: mockDeployedEnvTemplate,
+			},
+			useGuardRules: true,
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.envDeployer.EXPECT().EnvironmentTemplate(mockAppName, mockEnvName).Return(mockDeployedEnvTemplate, nil)
+				m.validator.EXPECT().Validate(mockEnvName, mockDeployedEnvTemplate).Return(errors.New("some error"))
+				m.envDeployer.EXPECT().UpdateAndStreamEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("validate stack template: some error"),
+		},
+		"do not deploy a packaged template older than the deployed stack": {
+			inPackaged: &deploy.PackagedTemplate{
+				Template: "Metadata:\n  Version: v1.0.0\n",
+			},
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.envDeployer.EXPECT().EnvironmentTemplate(mockAppName, mockEnvName).Return(mockDeployedEnvTemplate, nil)
+				m.envDeployer.EXPECT().UpdateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: fmt.Errorf("environment mockEnv is deployed with template version %s, which is newer than the version v1.0.0 deployed by this Copilot", deploy.LatestEnvTemplateVersion),
+		},
+		"deploy a packaged template": {
+			inPackaged: &deploy.PackagedTemplate{
+				Template:      mockDeployedEnvTemplate,
+				Configuration: `{"Parameters": {"AppName": "mockApp"}}`,
+			},
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.envDeployer.EXPECT().EnvironmentTemplate(mockAppName, mockEnvName).Return(mockDeployedEnvTemplate, nil)
+				m.envDeployer.EXPECT().EnvironmentParameters(mockAppName, mockEnvName).Return(nil, nil)
+				m.s3.EXPECT().Download("mockS3Bucket", "manual/last-deployment/mockApp-mockEnv/template.yml").Return(nil, &s3.ErrObjectNotFound{})
+				m.s3.EXPECT().Upload("mockS3Bucket", gomock.Any(), gomock.Any()).Return("", nil).Times(2)
+				m.envDeployer.EXPECT().UpdateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ progress.FileWriter, in *deploy.CreateEnvironmentInput, _ ...cloudformation.StackOption) error {
+						require.Equal(t, &deploy.PackagedTemplate{
+							Template:      mockDeployedEnvTemplate,
+							Configuration: `{"Parameters": {"AppName": "mockApp"}}`,
+						}, in.Packaged)
+						return nil
+					})
+			},
+		},
+		"deploy over a legacy template without a version": {
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.envDeployer.EXPECT().EnvironmentTemplate(mockAppName, mockEnvName).Return("Resources: {}\n", nil)
+				m.envDeployer.EXPECT().EnvironmentParameters(mockAppName, mockEnvName).Return(nil, nil)
+				m.s3.EXPECT().Download("mockS3Bucket", "manual/last-deployment/mockApp-mockEnv/template.yml").Return(nil, &s3.ErrObjectNotFound{})
+				m.s3.EXPECT().Upload("mockS3Bucket", gomock.Any(), gomock.Any()).Return("", nil).Times(2)
+				m.envDeployer.EXPECT().UpdateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"do not deploy if the deployed stack cannot be saved for rollback": {
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.envDeployer.EXPECT().EnvironmentTemplate(mockAppName, mockEnvName).Return(mockDeployedEnvTemplate, nil)
+				m.envDeployer.EXPECT().EnvironmentParameters(mockAppName, mockEnvName).Return([]*awscfn.Parameter{
+					{
+						ParameterKey:   aws.String("AppName"),
+						ParameterValue: aws.String("mockApp"),
+					},
+				}, nil)
+				m.s3.EXPECT().Download("mockS3Bucket", "manual/last-deployment/mockApp-mockEnv/template.yml").Return([]byte("Resources: {}\n"), nil)
+				m.s3.EXPECT().Download("mockS3Bucket", "manual/last-deployment/mockApp-mockEnv/params.json").Return([]byte(`{"AppName":"oldApp"}`), nil)
+				m.s3.EXPECT().Upload("mockS3Bucket", "manual/previous-deployment/mockApp-mockEnv/template.yml", gomock.Any()).Return("", nil)
+				m.s3.EXPECT().Upload("mockS3Bucket", "manual/previous-deployment/mockApp-mockEnv/params.json", gomock.Any()).
+					DoAndReturn(func(_, _ string, data io.Reader) (string, error) {
+						dat, err := io.ReadAll(data)
+						require.NoError(t, err)
+						require.JSONEq(t, `{"AppName":"oldApp"}`, string(dat))
+						return "", errors.New("some error")
+					})
+				m.envDeployer.EXPECT().UpdateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("save the environment stack parameters: some error"),
+		},
+		"do not deploy if a pre-deploy hook fails": {
+			inManifest: mockHooks([]manifest.DeploymentHook{
+				{Command: aws.String("./validate.sh")},
+			}, nil),
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.envDeployer.EXPECT().EnvironmentTemplate("mockApp", "mockEnv").Return(mockDeployedEnvTemplate, nil)
+				m.cmd.EXPECT().Run("sh", []string{"-c", "./validate.sh"}, gomock.Any()).Return(errors.New("exit status 1"))
+				m.envDeployer.EXPECT().UpdateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: errors.New(`run "pre_deploy[0]" hook command "./validate.sh": exit status 1`),
+		},
+		"deploy if a pre-deploy hook that only warns fails": {
+			inManifest: mockHooks([]manifest.DeploymentHook{
+				{
+					Command:   aws.String("./validate.sh"),
+					OnFailure: aws.String(manifest.HookOnFailureWarn),
+				},
+			}, nil),
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.cmd.EXPECT().Run("sh", []string{"-c", "./validate.sh"}, gomock.Any()).Return(errors.New("exit status 1"))
+				expectRecordDeployment(m)
+				m.envDeployer.EXPECT().UpdateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"do not run post-deploy hooks if the deployment fails": {
+			inManifest: mockHooks(nil, []manifest.DeploymentHook{
+				{Lambda: aws.String(mockFunctionARN)},
+			}),
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				expectRecordDeployment(m)
+				m.envDeployer.EXPECT().UpdateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+				m.envDeployer.EXPECT().EnvironmentFailedCustomResources(mockAppName, mockEnvName, gomock.Any()).Return(nil, nil)
+				m.lambda.EXPECT().Invoke(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("some error"),
+		},
+		"fail if a post-deploy hook fails": {
+			inManifest: mockHooks(nil, []manifest.DeploymentHook{
+				{Lambda: aws.String(mockFunctionARN)},
+				{Command: aws.String("./smoke-test.sh")},
+			}),
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				expectRecordDeployment(m)
+				m.envDeployer.EXPECT().UpdateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.lambda.EXPECT().Invoke(mockFunctionARN, gomock.Any()).Return(errors.New("some error"))
+				m.cmd.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: fmt.Errorf(`run "post_deploy[0]" hook function %s: some error`, mockFunctionARN),
+		},
+		"run hooks around a successful deployment": {
+			inManifest: mockHooks([]manifest.DeploymentHook{
+				{Command: aws.String("./validate.sh")},
+			}, []manifest.DeploymentHook{
+				{Lambda: aws.String(mockFunctionARN)},
+			}),
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				expectRecordDeployment(m)
+				gomock.InOrder(
+					m.cmd.EXPECT().Run("sh", []string{"-c", "./validate.sh"}, gomock.Any()).Return(nil),
+					m.envDeployer.EXPECT().UpdateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil),
+					m.lambda.EXPECT().Invoke(mockFunctionARN, []byte(`{"application":"mockApp","environment":"mockEnv","stage":"post_deploy"}`)).Return(nil),
+				)
+			},
+		},
 		"successful environment deployment": {
 			setUpMocks: func(m *deployEnvironmentMock) {
 				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
 					S3Bucket: "mockS3Bucket",
 				}, nil)
+				expectRecordDeployment(m)
 				m.envDeployer.EXPECT().UpdateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
 					func(_ progress.FileWriter, in *deploy.CreateEnvironmentInput, opts ...cloudformation.StackOption) error {
 						require.Equal(t, mockEnvName, in.Name)
@@ -268,6 +607,51 @@ func TestEnvDeployer_DeployEnvironment(t *testing.T) {
 							"mockResource": "mockURL",
 						}, in.CustomResourcesURLs)
 						require.Equal(t, deploy.LatestEnvTemplateVersion, in.Version)
+						require.Empty(t, in.ForceUpdateID)
+						return nil
+					})
+			},
+		},
+		"generate a force update ID when forcing a new update": {
+			inForceNewUpdate: true,
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				expectRecordDeployment(m)
+				m.envDeployer.EXPECT().UpdateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ progress.FileWriter, in *deploy.CreateEnvironmentInput, opts ...cloudformation.StackOption) error {
+						require.NotEmpty(t, in.ForceUpdateID)
+						return nil
+					})
+			},
+		},
+		"disable the rollback of the stack if the deployment fails": {
+			inNoRollback: true,
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				expectRecordDeployment(m)
+				m.envDeployer.EXPECT().UpdateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ progress.FileWriter, _ *deploy.CreateEnvironmentInput, opts ...cloudformation.StackOption) error {
+						require.True(t, cloudformation.NewStack("mockApp-mockEnv", "", opts...).DisableRollback)
+						return nil
+					})
+			},
+		},
+		"stream the stack events as JSON instead of rendering the progress": {
+			inJSONProgress: true,
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				expectRecordDeployment(m)
+				m.envDeployer.EXPECT().UpdateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.envDeployer.EXPECT().UpdateAndStreamEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(out io.Writer, in *deploy.CreateEnvironmentInput, opts ...cloudformation.StackOption) error {
+						require.Equal(t, io.Discard, out)
+						require.Equal(t, mockEnvName, in.Name)
 						return nil
 					})
 			},
@@ -281,8 +665,14 @@ func TestEnvDeployer_DeployEnvironment(t *testing.T) {
 			m := &deployEnvironmentMock{
 				appCFN:      mocks.NewMockappResourcesGetter(ctrl),
 				envDeployer: mocks.NewMockenvironmentDeployer(ctrl),
+				stackSet:    mocks.NewMockenvStackSetInstanceChecker(ctrl),
+				s3:          mocks.NewMockenvArtifactStore(ctrl),
+				cmd:         mocks.NewMockexecRunner(ctrl),
+				lambda:      mocks.NewMocklambdaInvoker(ctrl),
+				validator:   mocks.NewMocktemplateValidator(ctrl),
 			}
 			tc.setUpMocks(m)
+			m.stackSet.EXPECT().IsEnvironmentInstance(gomock.Any()).Return(false, nil).AnyTimes()
 			d := envDeployer{
 				app: mockApp,
 				env: &config.Environment{
@@ -290,14 +680,29 @@ func TestEnvDeployer_DeployEnvironment(t *testing.T) {
 					ManagerRoleARN: mockManagerRoleARN,
 					Region:         mockEnvRegion,
 				},
-				appCFN:      m.appCFN,
-				envDeployer: m.envDeployer,
+				appCFN:            m.appCFN,
+				envDeployer:       m.envDeployer,
+				stackSetInstances: m.stackSet,
+				s3:                m.s3,
+				progressOut:       discardFileWriter{},
+				eventsOut:         io.Discard,
+				cmd:               m.cmd,
+				lambda:            m.lambda,
+			}
+			if tc.useGuardRules {
+				d.validator = m.validator
 			}
 			mockIn := &DeployEnvironmentInput{
 				RootUserARN: "mockRootUserARN",
+				Manifest:    tc.inManifest,
 				CustomResourcesURLs: map[string]string{
 					"mockResource": "mockURL",
 				},
+				ForceNewUpdate:  tc.inForceNewUpdate,
+				JSONProgress:    tc.inJSONProgress,
+				AllowDowngrade:  tc.inAllowDowngrade,
+				DisableRollback: tc.inNoRollback,
+				Packaged:        tc.inPackaged,
 			}
 			gotErr := d.DeployEnvironment(mockIn)
 			if tc.wantedError != nil {
@@ -308,3 +713,751 @@ func TestEnvDeployer_DeployEnvironment(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvDeployer_DetectDrift(t *testing.T) {
+	const (
+		mockAppName = "mockApp"
+		mockEnvName = "mockEnv"
+	)
+	testCases := map[string]struct {
+		setUpMocks func(m *mocks.MockenvironmentDeployer)
+
+		wantedDrifts []*cloudformation.StackResourceDrift
+		wantedError  error
+	}{
+		"fail to detect drift": {
+			setUpMocks: func(m *mocks.MockenvironmentDeployer) {
+				m.EXPECT().EnvironmentDrift(mockAppName, mockEnvName).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("detect drift on environment stack: some error"),
+		},
+		"return drifted resources": {
+			setUpMocks: func(m *mocks.MockenvironmentDeployer) {
+				m.EXPECT().EnvironmentDrift(mockAppName, mockEnvName).Return([]*cloudformation.StackResourceDrift{
+					{
+						LogicalResourceId: aws.String("PublicLoadBalancer"),
+					},
+				}, nil)
+			},
+			wantedDrifts: []*cloudformation.StackResourceDrift{
+				{
+					LogicalResourceId: aws.String("PublicLoadBalancer"),
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockenvironmentDeployer(ctrl)
+			tc.setUpMocks(m)
+			d := envDeployer{
+				app: &config.Application{
+					Name: mockAppName,
+				},
+				env: &config.Environment{
+					Name: mockEnvName,
+				},
+				envDeployer: m,
+			}
+
+			drifts, err := d.DetectDrift()
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDrifts, drifts)
+			}
+		})
+	}
+}
+
+type discardFileWriter struct{}
+
+func (discardFileWriter) Write(p []byte) (int, error) { return len(p), nil }
+
+func (discardFileWriter) Fd() uintptr { return 0 }
+
+type bufferFileWriter struct {
+	strings.Builder
+}
+
+func (*bufferFileWriter) Fd() uintptr { return 0 }
+
+func TestEnvDeployer_showFailedCustomResourceLogs(t *testing.T) {
+	mockSince := time.Date(2022, 10, 18, 10, 0, 0, 0, time.UTC)
+	mockFailedAt := mockSince.Add(3 * time.Minute)
+	testCases := map[string]struct {
+		setUpMocks func(deployer *mocks.MockenvironmentDeployer, logs *mocks.MocklogEventsGetter)
+
+		wanted string
+	}{
+		"nothing is shown if the custom resources cannot be looked up": {
+			setUpMocks: func(deployer *mocks.MockenvironmentDeployer, logs *mocks.MocklogEventsGetter) {
+				deployer.EXPECT().EnvironmentFailedCustomResources("phonetool", "test", mockSince).Return(nil, errors.New("some error"))
+				logs.EXPECT().LogEvents(gomock.Any()).Times(0)
+			},
+		},
+		"skip the custom resources without a known function and the ones whose logs cannot be fetched": {
+			setUpMocks: func(deployer *mocks.MockenvironmentDeployer, logs *mocks.MocklogEventsGetter) {
+				deployer.EXPECT().EnvironmentFailedCustomResources("phonetool", "test", mockSince).Return([]deploycfn.FailedCustomResource{
+					{
+						LogicalID: "HTTPSCert",
+						FailedAt:  mockFailedAt,
+					},
+					{
+						LogicalID:    "CustomDomainAction",
+						FailedAt:     mockFailedAt,
+						FunctionName: "phonetool-test-CustomDomainFunction-abc",
+					},
+				}, nil)
+				logs.EXPECT().LogEvents(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+		},
+		"show the logs of the failed invocation": {
+			setUpMocks: func(deployer *mocks.MockenvironmentDeployer, logs *mocks.MocklogEventsGetter) {
+				deployer.EXPECT().EnvironmentFailedCustomResources("phonetool", "test", mockSince).Return([]deploycfn.FailedCustomResource{
+					{
+						LogicalID:    "CustomDomainAction",
+						FailedAt:     mockFailedAt,
+						FunctionName: "phonetool-test-CustomDomainFunction-abc",
+					},
+					{
+						LogicalID:    "HTTPSCert",
+						FailedAt:     mockFailedAt,
+						FunctionName: "phonetool-test-CertificateValidationFunction-def",
+					},
+				}, nil)
+				logs.EXPECT().LogEvents(cloudwatchlogs.LogEventsOpts{
+					LogGroup:  "/aws/lambda/phonetool-test-CustomDomainFunction-abc",
+					StartTime: aws.Int64(mockSince.UnixMilli()),
+					EndTime:   aws.Int64(mockFailedAt.Add(time.Minute).UnixMilli()),
+					Limit:     aws.Int64(50),
+				}).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: []*cloudwatchlogs.Event{
+						{
+							LogStreamName: "2022/10/18/[$LATEST]abc",
+							Message:       "AccessDenied: not authorized to perform route53:ChangeResourceRecordSets\n",
+						},
+					},
+				}, nil)
+				logs.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{}, nil)
+			},
+			wanted: `
+Logs of function phonetool-test-CustomDomainFunction-abc for the failed custom resource CustomDomainAction:
+2022/10/18/[$LATEST]abc AccessDenied: not authorized to perform route53:ChangeResourceRecordSets
+
+Logs of function phonetool-test-CertificateValidationFunction-def for the failed custom resource HTTPSCert:
+No logs were found for the failed invocation.
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			deployer := mocks.NewMockenvironmentDeployer(ctrl)
+			logs := mocks.NewMocklogEventsGetter(ctrl)
+			tc.setUpMocks(deployer, logs)
+			out := &bufferFileWriter{}
+			d := envDeployer{
+				app: &config.Application{
+					Name: "phonetool",
+				},
+				env: &config.Environment{
+					Name: "test",
+				},
+				envDeployer: deployer,
+				logs:        logs,
+				progressOut: out,
+			}
+
+			// WHEN
+			d.showFailedCustomResourceLogs(mockSince)
+
+			// THEN
+			require.Equal(t, tc.wanted, out.String())
+		})
+	}
+}
+
+func TestEnvDeployer_DeployEnvironmentNoWait(t *testing.T) {
+	mockApp := &config.Application{
+		Name: "mockApp",
+	}
+	testCases := map[string]struct {
+		inManifest  *manifest.Environment
+		setUpMocks  func(m *deployEnvironmentMock)
+		wantedOut   *EnvironmentDeployment
+		wantedError error
+	}{
+		"fail to start the deployment": {
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				expectRecordDeployment(m)
+				m.envDeployer.EXPECT().UpdateEnvironment(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"do not start the deployment if a pre-deploy hook fails": {
+			inManifest: func() *manifest.Environment {
+				mft := &manifest.Environment{}
+				mft.Hooks.PreDeploy = []manifest.DeploymentHook{
+					{Command: aws.String("./validate.sh")},
+				}
+				return mft
+			}(),
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.envDeployer.EXPECT().EnvironmentTemplate("mockApp", "mockEnv").Return(mockDeployedEnvTemplate, nil)
+				m.cmd.EXPECT().Run("sh", []string{"-c", "./validate.sh"}, gomock.Any()).Return(errors.New("exit status 1"))
+				m.envDeployer.EXPECT().UpdateEnvironment(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: errors.New(`run "pre_deploy[0]" hook command "./validate.sh": exit status 1`),
+		},
+		"skip post-deploy hooks and return the started deployment": {
+			inManifest: func() *manifest.Environment {
+				mft := &manifest.Environment{}
+				mft.Hooks.PostDeploy = []manifest.DeploymentHook{
+					{Command: aws.String("./smoke-test.sh")},
+				}
+				return mft
+			}(),
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				expectRecordDeployment(m)
+				m.envDeployer.EXPECT().UpdateEnvironment(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(in *deploy.CreateEnvironmentInput, opts ...cloudformation.StackOption) (string, error) {
+						require.Equal(t, "mockEnv", in.Name)
+						return "mockChangeSetARN", nil
+					})
+				m.cmd.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedOut: &EnvironmentDeployment{
+				StackName:   "mockApp-mockEnv",
+				ChangeSetID: "mockChangeSetARN",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &deployEnvironmentMock{
+				appCFN:      mocks.NewMockappResourcesGetter(ctrl),
+				envDeployer: mocks.NewMockenvironmentDeployer(ctrl),
+				stackSet:    mocks.NewMockenvStackSetInstanceChecker(ctrl),
+				s3:          mocks.NewMockenvArtifactStore(ctrl),
+				cmd:         mocks.NewMockexecRunner(ctrl),
+			}
+			tc.setUpMocks(m)
+			m.stackSet.EXPECT().IsEnvironmentInstance(gomock.Any()).Return(false, nil).AnyTimes()
+			d := envDeployer{
+				app: mockApp,
+				env: &config.Environment{
+					Name:   "mockEnv",
+					Region: "us-west-2",
+				},
+				appCFN:            m.appCFN,
+				envDeployer:       m.envDeployer,
+				stackSetInstances: m.stackSet,
+				s3:                m.s3,
+				progressOut:       discardFileWriter{},
+				cmd:               m.cmd,
+			}
+			out, err := d.DeployEnvironmentNoWait(&DeployEnvironmentInput{
+				RootUserARN: "mockRootUserARN",
+				Manifest:    tc.inManifest,
+			})
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOut, out)
+			}
+		})
+	}
+}
+
+func TestEnvDeployer_Rollback(t *testing.T) {
+	mockApp := &config.Application{
+		Name: "mockApp",
+	}
+	mftWithRole, err := manifest.UnmarshalEnvironment([]byte(`name: mockEnv
+type: Environment
+deployment:
+  execution_role: arn:aws:iam::123456789012:role/manifest-role
+`))
+	require.NoError(t, err)
+	expectRollbackWithRole := func(m *deployEnvironmentMock, roleARN string) {
+		m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{
+			S3Bucket: "mockS3Bucket",
+		}, nil)
+		expectRecordDeployment(m)
+		m.s3.EXPECT().Download("mockS3Bucket", "manual/previous-deployment/mockApp-mockEnv/params.json").Return([]byte(`{}`), nil)
+		m.envDeployer.EXPECT().RollbackAndRenderEnvironment(gomock.Any(), "mockApp", "mockEnv", gomock.Any(), gomock.Any(), roleARN).Return(nil)
+	}
+	testCases := map[string]struct {
+		in          *RollbackEnvironmentInput
+		setUpMocks  func(m *deployEnvironmentMock)
+		wantedError error
+	}{
+		"error instead of rolling back the stack of an environment deployed through the stack set": {
+			in: &RollbackEnvironmentInput{},
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.stackSet.EXPECT().IsEnvironmentInstance(gomock.Any()).Return(true, nil)
+				m.envDeployer.EXPECT().RollbackAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("environment mockEnv is deployed through stack set mockApp-environments"),
+		},
+		"return ErrNoPreviousDeployment if the environment was never deployed": {
+			in: &RollbackEnvironmentInput{},
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				expectRecordDeployment(m)
+				m.s3.EXPECT().Download("mockS3Bucket", "manual/previous-deployment/mockApp-mockEnv/params.json").Return(nil, &s3.ErrObjectNotFound{})
+				m.envDeployer.EXPECT().RollbackAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: &ErrNoPreviousDeployment{
+				envName: "mockEnv",
+			},
+		},
+		"wrap error if fail to download the previous parameters": {
+			in: &RollbackEnvironmentInput{},
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				expectRecordDeployment(m)
+				m.s3.EXPECT().Download("mockS3Bucket", "manual/previous-deployment/mockApp-mockEnv/params.json").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("download the parameters of the previous deployment: some error"),
+		},
+		"roll back to the configuration before the latest deployment that changed the stack": {
+			in: &RollbackEnvironmentInput{},
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.envDeployer.EXPECT().EnvironmentTemplate("mockApp", "mockEnv").Return(mockDeployedEnvTemplate, nil)
+				m.envDeployer.EXPECT().EnvironmentParameters("mockApp", "mockEnv").Return(nil, nil)
+				gomock.InOrder(
+					m.s3.EXPECT().Download("mockS3Bucket", "manual/last-deployment/mockApp-mockEnv/template.yml").Return([]byte("Resources: {}\n"), nil),
+					m.s3.EXPECT().Download("mockS3Bucket", "manual/last-deployment/mockApp-mockEnv/params.json").Return([]byte(`{"AppName":"mockApp"}`), nil),
+					m.s3.EXPECT().Upload("mockS3Bucket", "manual/previous-deployment/mockApp-mockEnv/template.yml", gomock.Any()).Return("", nil),
+					m.s3.EXPECT().Upload("mockS3Bucket", "manual/previous-deployment/mockApp-mockEnv/params.json", gomock.Any()).Return("", nil),
+					m.s3.EXPECT().Upload("mockS3Bucket", "manual/last-deployment/mockApp-mockEnv/template.yml", gomock.Any()).Return("", nil),
+					m.s3.EXPECT().Upload("mockS3Bucket", "manual/last-deployment/mockApp-mockEnv/params.json", gomock.Any()).Return("", nil),
+					m.s3.EXPECT().Download("mockS3Bucket", "manual/previous-deployment/mockApp-mockEnv/params.json").Return([]byte(`{"AppName":"mockApp"}`), nil),
+				)
+				m.envDeployer.EXPECT().RollbackAndRenderEnvironment(gomock.Any(), "mockApp", "mockEnv",
+					"https://mockS3Bucket.s3.us-west-2.amazonaws.com/manual/previous-deployment/mockApp-mockEnv/template.yml",
+					gomock.Any(), "mockExecutionRoleARN").Return(nil)
+			},
+		},
+		"roll back with the execution role in the manifest": {
+			in: &RollbackEnvironmentInput{
+				Manifest: mftWithRole,
+			},
+			setUpMocks: func(m *deployEnvironmentMock) {
+				expectRollbackWithRole(m, "arn:aws:iam::123456789012:role/manifest-role")
+			},
+		},
+		"the execution role from the input takes precedence over the manifest": {
+			in: &RollbackEnvironmentInput{
+				ExecutionRoleARN: "arn:aws:iam::123456789012:role/flag-role",
+				Manifest:         mftWithRole,
+			},
+			setUpMocks: func(m *deployEnvironmentMock) {
+				expectRollbackWithRole(m, "arn:aws:iam::123456789012:role/flag-role")
+			},
+		},
+		"roll back to the previous template and parameters": {
+			in: &RollbackEnvironmentInput{},
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				expectRecordDeployment(m)
+				m.s3.EXPECT().Download("mockS3Bucket", "manual/previous-deployment/mockApp-mockEnv/params.json").Return([]byte(`{"AppName":"mockApp"}`), nil)
+				m.envDeployer.EXPECT().RollbackAndRenderEnvironment(gomock.Any(), "mockApp", "mockEnv",
+					"https://mockS3Bucket.s3.us-west-2.amazonaws.com/manual/previous-deployment/mockApp-mockEnv/template.yml",
+					[]*awscfn.Parameter{
+						{
+							ParameterKey:   aws.String("AppName"),
+							ParameterValue: aws.String("mockApp"),
+						},
+					}, "mockExecutionRoleARN").Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &deployEnvironmentMock{
+				appCFN:      mocks.NewMockappResourcesGetter(ctrl),
+				envDeployer: mocks.NewMockenvironmentDeployer(ctrl),
+				stackSet:    mocks.NewMockenvStackSetInstanceChecker(ctrl),
+				s3:          mocks.NewMockenvArtifactStore(ctrl),
+			}
+			tc.setUpMocks(m)
+			m.stackSet.EXPECT().IsEnvironmentInstance(gomock.Any()).Return(false, nil).AnyTimes()
+			d := envDeployer{
+				app: mockApp,
+				env: &config.Environment{
+					Name:             "mockEnv",
+					Region:           "us-west-2",
+					ExecutionRoleARN: "mockExecutionRoleARN",
+				},
+				appCFN:            m.appCFN,
+				envDeployer:       m.envDeployer,
+				stackSetInstances: m.stackSet,
+				s3:                m.s3,
+				progressOut:       discardFileWriter{},
+			}
+			err := d.Rollback(tc.in)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEnvDeployer_CreateChangeSet(t *testing.T) {
+	mockApp := &config.Application{
+		Name: "mockApp",
+	}
+	testCases := map[string]struct {
+		setUpMocks  func(m *deployEnvironmentMock)
+		wantedOut   *EnvironmentChangeSet
+		wantedError error
+	}{
+		"fail to create the change set": {
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.envDeployer.EXPECT().EnvironmentTemplate("mockApp", "mockEnv").Return(mockDeployedEnvTemplate, nil)
+				m.envDeployer.EXPECT().CreateEnvironmentChangeSet(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"fail to describe the change set": {
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.envDeployer.EXPECT().EnvironmentTemplate("mockApp", "mockEnv").Return(mockDeployedEnvTemplate, nil)
+				m.envDeployer.EXPECT().CreateEnvironmentChangeSet(gomock.Any(), gomock.Any()).Return("mockChangeSetARN", nil)
+				m.envDeployer.EXPECT().EnvironmentChangeSet("mockApp", "mockEnv", "mockChangeSetARN").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"return the change set without running any hook": {
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.envDeployer.EXPECT().EnvironmentTemplate("mockApp", "mockEnv").Return(mockDeployedEnvTemplate, nil)
+				m.envDeployer.EXPECT().CreateEnvironmentChangeSet(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(in *deploy.CreateEnvironmentInput, opts ...cloudformation.StackOption) (string, error) {
+						require.Equal(t, "mockEnv", in.Name)
+						return "mockChangeSetARN", nil
+					})
+				m.envDeployer.EXPECT().EnvironmentChangeSet("mockApp", "mockEnv", "mockChangeSetARN").Return(&cloudformation.ChangeSetDescription{
+					Changes: []*awscfn.Change{
+						{
+							ResourceChange: &awscfn.ResourceChange{
+								Action:            aws.String(awscfn.ChangeActionModify),
+								LogicalResourceId: aws.String("PublicLoadBalancer"),
+							},
+						},
+					},
+				}, nil)
+				m.cmd.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedOut: &EnvironmentChangeSet{
+				StackName: "mockApp-mockEnv",
+				ID:        "mockChangeSetARN",
+				Changes: []*awscfn.Change{
+					{
+						ResourceChange: &awscfn.ResourceChange{
+							Action:            aws.String(awscfn.ChangeActionModify),
+							LogicalResourceId: aws.String("PublicLoadBalancer"),
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &deployEnvironmentMock{
+				appCFN:      mocks.NewMockappResourcesGetter(ctrl),
+				envDeployer: mocks.NewMockenvironmentDeployer(ctrl),
+				stackSet:    mocks.NewMockenvStackSetInstanceChecker(ctrl),
+				cmd:         mocks.NewMockexecRunner(ctrl),
+			}
+			tc.setUpMocks(m)
+			m.stackSet.EXPECT().IsEnvironmentInstance(gomock.Any()).Return(false, nil).AnyTimes()
+			d := envDeployer{
+				app: mockApp,
+				env: &config.Environment{
+					Name:   "mockEnv",
+					Region: "us-west-2",
+				},
+				appCFN:            m.appCFN,
+				envDeployer:       m.envDeployer,
+				stackSetInstances: m.stackSet,
+				progressOut:       discardFileWriter{},
+				cmd:               m.cmd,
+			}
+			mft := &manifest.Environment{}
+			mft.Hooks.PreDeploy = []manifest.DeploymentHook{
+				{Command: aws.String("./validate.sh")},
+			}
+			out, err := d.CreateChangeSet(&DeployEnvironmentInput{
+				RootUserARN: "mockRootUserARN",
+				Manifest:    mft,
+			})
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOut, out)
+			}
+		})
+	}
+}
+
+func TestEnvDeployer_AttachToDeployment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockenvironmentDeployer(ctrl)
+	m.EXPECT().RenderEnvironmentUpdate(gomock.Any(), "mockApp", "mockEnv").Return(errors.New("some error"))
+	d := envDeployer{
+		app: &config.Application{
+			Name: "mockApp",
+		},
+		env: &config.Environment{
+			Name: "mockEnv",
+		},
+		envDeployer: m,
+	}
+
+	require.EqualError(t, d.AttachToDeployment(), "some error")
+}
+
+func TestEnvDeployer_validateImportedVPCResources(t *testing.T) {
+	mockImportedVPCManifest := func(t *testing.T) *manifest.Environment {
+		mft, err := manifest.UnmarshalEnvironment([]byte(`name: test
+type: Environment
+network:
+  vpc:
+    id: vpc-1234
+    subnets:
+      public:
+        - id: subnet-1
+        - id: subnet-2
+    security_group: sg-1234
+    route_tables:
+      public: rtb-1
+      private:
+        - rtb-2
+`))
+		require.NoError(t, err)
+		return mft
+	}
+	vpcFilter := ec2.Filter{
+		Name:   "vpc-id",
+		Values: []string{"vpc-1234"},
+	}
+	testCases := map[string]struct {
+		inManifest func(t *testing.T) *manifest.Environment
+		setUpMocks func(m *mocks.MockvpcResourcesGetter)
+
+		wantedError error
+	}{
+		"no-op without a manifest": {
+			inManifest: func(t *testing.T) *manifest.Environment { return nil },
+			setUpMocks: func(m *mocks.MockvpcResourcesGetter) {},
+		},
+		"no-op if no existing resources are referenced": {
+			inManifest: func(t *testing.T) *manifest.Environment {
+				mft, err := manifest.UnmarshalEnvironment([]byte(`name: test
+type: Environment
+network:
+  vpc:
+    id: vpc-1234
+`))
+				require.NoError(t, err)
+				return mft
+			},
+			setUpMocks: func(m *mocks.MockvpcResourcesGetter) {},
+		},
+		"error if fail to get the security group": {
+			inManifest: mockImportedVPCManifest,
+			setUpMocks: func(m *mocks.MockvpcResourcesGetter) {
+				m.EXPECT().SecurityGroups(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get security group sg-1234: some error"),
+		},
+		"error if the security group is not in the vpc": {
+			inManifest: mockImportedVPCManifest,
+			setUpMocks: func(m *mocks.MockvpcResourcesGetter) {
+				m.EXPECT().SecurityGroups(vpcFilter, ec2.Filter{
+					Name:   "group-id",
+					Values: []string{"sg-1234"},
+				}).Return(nil, nil)
+			},
+			wantedError: errors.New("security group sg-1234 does not exist in VPC vpc-1234"),
+		},
+		"error if fail to get the route tables": {
+			inManifest: mockImportedVPCManifest,
+			setUpMocks: func(m *mocks.MockvpcResourcesGetter) {
+				m.EXPECT().SecurityGroups(gomock.Any()).Return([]string{"sg-1234"}, nil)
+				m.EXPECT().RouteTableIDs(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get route tables rtb-1 and rtb-2: some error"),
+		},
+		"error if a route table is not in the vpc": {
+			inManifest: mockImportedVPCManifest,
+			setUpMocks: func(m *mocks.MockvpcResourcesGetter) {
+				m.EXPECT().SecurityGroups(gomock.Any()).Return([]string{"sg-1234"}, nil)
+				m.EXPECT().RouteTableIDs(vpcFilter, ec2.Filter{
+					Name:   "route-table-id",
+					Values: []string{"rtb-1", "rtb-2"},
+				}).Return([]string{"rtb-1"}, nil)
+			},
+			wantedError: errors.New("route table rtb-2 does not exist in VPC vpc-1234"),
+		},
+		"success": {
+			inManifest: mockImportedVPCManifest,
+			setUpMocks: func(m *mocks.MockvpcResourcesGetter) {
+				m.EXPECT().SecurityGroups(gomock.Any()).Return([]string{"sg-1234"}, nil)
+				m.EXPECT().RouteTableIDs(gomock.Any()).Return([]string{"rtb-2", "rtb-1"}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockvpcResourcesGetter(ctrl)
+			tc.setUpMocks(m)
+			d := envDeployer{
+				vpcGetter: m,
+			}
+
+			err := d.validateImportedVPCResources(tc.inManifest(t))
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestEnvDeployer_executionRoleARN(t *testing.T) {
+	mftWithRole, err := manifest.UnmarshalEnvironment([]byte(`name: test
+type: Environment
+deployment:
+  execution_role: arn:aws:iam::123456789012:role/manifest-role
+`))
+	require.NoError(t, err)
+	testCases := map[string]struct {
+		in     *DeployEnvironmentInput
+		wanted string
+	}{
+		"defaults to the role created by Copilot": {
+			in: &DeployEnvironmentInput{
+				Manifest: &manifest.Environment{},
+			},
+			wanted: "arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole",
+		},
+		"uses the role in the manifest": {
+			in: &DeployEnvironmentInput{
+				Manifest: mftWithRole,
+			},
+			wanted: "arn:aws:iam::123456789012:role/manifest-role",
+		},
+		"the role from the input takes precedence over the manifest": {
+			in: &DeployEnvironmentInput{
+				Manifest:         mftWithRole,
+				ExecutionRoleARN: "arn:aws:iam::123456789012:role/flag-role",
+			},
+			wanted: "arn:aws:iam::123456789012:role/flag-role",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			d := envDeployer{
+				env: &config.Environment{
+					ExecutionRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole",
+				},
+			}
+
+			require.Equal(t, tc.wanted, d.executionRoleARN(tc.in.ExecutionRoleARN, tc.in.Manifest))
+		})
+	}
+}
+
+func TestEnvDeployer_timeout(t *testing.T) {
+	mftWithTimeout, err := manifest.UnmarshalEnvironment([]byte(`name: test
+type: Environment
+deployment:
+  timeout: 3h
+`))
+	require.NoError(t, err)
+	testCases := map[string]struct {
+		in     *DeployEnvironmentInput
+		wanted time.Duration
+	}{
+		"defaults to no timeout so that the default wait limit applies": {
+			in: &DeployEnvironmentInput{
+				Manifest: &manifest.Environment{},
+			},
+		},
+		"uses the timeout in the manifest": {
+			in: &DeployEnvironmentInput{
+				Manifest: mftWithTimeout,
+			},
+			wanted: 3 * time.Hour,
+		},
+		"the timeout from the input takes precedence over the manifest": {
+			in: &DeployEnvironmentInput{
+				Manifest: mftWithTimeout,
+				Timeout:  10 * time.Minute,
+			},
+			wanted: 10 * time.Minute,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			d := envDeployer{}
+
+			require.Equal(t, tc.wanted, d.timeout(tc.in))
+		})
+	}
+}
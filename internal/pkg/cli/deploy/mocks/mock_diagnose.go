@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/cli/deploy/diagnose.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	ecs "github.com/aws/copilot-cli/internal/pkg/ecs"
+	logging "github.com/aws/copilot-cli/internal/pkg/logging"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockserviceDescriber is a mock of serviceDescriber interface.
+type MockserviceDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceDescriberMockRecorder
+}
+
+// MockserviceDescriberMockRecorder is the mock recorder for MockserviceDescriber.
+type MockserviceDescriberMockRecorder struct {
+	mock *MockserviceDescriber
+}
+
+// NewMockserviceDescriber creates a new mock instance.
+func NewMockserviceDescriber(ctrl *gomock.Controller) *MockserviceDescriber {
+	mock := &MockserviceDescriber{ctrl: ctrl}
+	mock.recorder = &MockserviceDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceDescriber) EXPECT() *MockserviceDescriberMockRecorder {
+	return m.recorder
+}
+
+// DescribeService mocks base method.
+func (m *MockserviceDescriber) DescribeService(app, env, svc string) (*ecs.ServiceDesc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeService", app, env, svc)
+	ret0, _ := ret[0].(*ecs.ServiceDesc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeService indicates an expected call of DescribeService.
+func (mr *MockserviceDescriberMockRecorder) DescribeService(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeService", reflect.TypeOf((*MockserviceDescriber)(nil).DescribeService), app, env, svc)
+}
+
+// MocklogEventsWriter is a mock of logEventsWriter interface.
+type MocklogEventsWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MocklogEventsWriterMockRecorder
+}
+
+// MocklogEventsWriterMockRecorder is the mock recorder for MocklogEventsWriter.
+type MocklogEventsWriterMockRecorder struct {
+	mock *MocklogEventsWriter
+}
+
+// NewMocklogEventsWriter creates a new mock instance.
+func NewMocklogEventsWriter(ctrl *gomock.Controller) *MocklogEventsWriter {
+	mock := &MocklogEventsWriter{ctrl: ctrl}
+	mock.recorder = &MocklogEventsWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklogEventsWriter) EXPECT() *MocklogEventsWriterMockRecorder {
+	return m.recorder
+}
+
+// WriteLogEvents mocks base method.
+func (m *MocklogEventsWriter) WriteLogEvents(opts logging.WriteLogEventsOpts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteLogEvents", opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteLogEvents indicates an expected call of WriteLogEvents.
+func (mr *MocklogEventsWriterMockRecorder) WriteLogEvents(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteLogEvents", reflect.TypeOf((*MocklogEventsWriter)(nil).WriteLogEvents), opts)
+}
@@ -1,17 +1,23 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: ./internal/pkg/cli/deploy/env.go
+// Source: ./cli/deploy/env.go
 
 // Package mocks is a generated GoMock package.
 package mocks
 
 import (
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	cloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	cloudwatchlogs "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
 	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
+	cloudformation1 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	stack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	exec "github.com/aws/copilot-cli/internal/pkg/exec"
 	progress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -77,6 +83,71 @@ func (m *MockenvironmentDeployer) EXPECT() *MockenvironmentDeployerMockRecorder
 	return m.recorder
 }
 
+// CreateEnvironmentChangeSet mocks base method.
+func (m *MockenvironmentDeployer) CreateEnvironmentChangeSet(env *deploy.CreateEnvironmentInput, opts ...cloudformation0.StackOption) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{env}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateEnvironmentChangeSet", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEnvironmentChangeSet indicates an expected call of CreateEnvironmentChangeSet.
+func (mr *MockenvironmentDeployerMockRecorder) CreateEnvironmentChangeSet(env interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{env}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEnvironmentChangeSet", reflect.TypeOf((*MockenvironmentDeployer)(nil).CreateEnvironmentChangeSet), varargs...)
+}
+
+// EnvironmentChangeSet mocks base method.
+func (m *MockenvironmentDeployer) EnvironmentChangeSet(appName, envName, changeSetID string) (*cloudformation0.ChangeSetDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvironmentChangeSet", appName, envName, changeSetID)
+	ret0, _ := ret[0].(*cloudformation0.ChangeSetDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnvironmentChangeSet indicates an expected call of EnvironmentChangeSet.
+func (mr *MockenvironmentDeployerMockRecorder) EnvironmentChangeSet(appName, envName, changeSetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentChangeSet", reflect.TypeOf((*MockenvironmentDeployer)(nil).EnvironmentChangeSet), appName, envName, changeSetID)
+}
+
+// EnvironmentDrift mocks base method.
+func (m *MockenvironmentDeployer) EnvironmentDrift(app, env string) ([]*cloudformation0.StackResourceDrift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvironmentDrift", app, env)
+	ret0, _ := ret[0].([]*cloudformation0.StackResourceDrift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnvironmentDrift indicates an expected call of EnvironmentDrift.
+func (mr *MockenvironmentDeployerMockRecorder) EnvironmentDrift(app, env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentDrift", reflect.TypeOf((*MockenvironmentDeployer)(nil).EnvironmentDrift), app, env)
+}
+
+// EnvironmentFailedCustomResources mocks base method.
+func (m *MockenvironmentDeployer) EnvironmentFailedCustomResources(app, env string, since time.Time) ([]cloudformation1.FailedCustomResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvironmentFailedCustomResources", app, env, since)
+	ret0, _ := ret[0].([]cloudformation1.FailedCustomResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnvironmentFailedCustomResources indicates an expected call of EnvironmentFailedCustomResources.
+func (mr *MockenvironmentDeployerMockRecorder) EnvironmentFailedCustomResources(app, env, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentFailedCustomResources", reflect.TypeOf((*MockenvironmentDeployer)(nil).EnvironmentFailedCustomResources), app, env, since)
+}
+
 // EnvironmentParameters mocks base method.
 func (m *MockenvironmentDeployer) EnvironmentParameters(app, env string) ([]*cloudformation.Parameter, error) {
 	m.ctrl.T.Helper()
@@ -92,6 +163,49 @@ func (mr *MockenvironmentDeployerMockRecorder) EnvironmentParameters(app, env in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentParameters", reflect.TypeOf((*MockenvironmentDeployer)(nil).EnvironmentParameters), app, env)
 }
 
+// EnvironmentTemplate mocks base method.
+func (m *MockenvironmentDeployer) EnvironmentTemplate(app, env string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvironmentTemplate", app, env)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnvironmentTemplate indicates an expected call of EnvironmentTemplate.
+func (mr *MockenvironmentDeployerMockRecorder) EnvironmentTemplate(app, env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentTemplate", reflect.TypeOf((*MockenvironmentDeployer)(nil).EnvironmentTemplate), app, env)
+}
+
+// RenderEnvironmentUpdate mocks base method.
+func (m *MockenvironmentDeployer) RenderEnvironmentUpdate(out progress.FileWriter, appName, envName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderEnvironmentUpdate", out, appName, envName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenderEnvironmentUpdate indicates an expected call of RenderEnvironmentUpdate.
+func (mr *MockenvironmentDeployerMockRecorder) RenderEnvironmentUpdate(out, appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderEnvironmentUpdate", reflect.TypeOf((*MockenvironmentDeployer)(nil).RenderEnvironmentUpdate), out, appName, envName)
+}
+
+// RollbackAndRenderEnvironment mocks base method.
+func (m *MockenvironmentDeployer) RollbackAndRenderEnvironment(out progress.FileWriter, app, env, templateURL string, params []*cloudformation.Parameter, cfnExecRoleARN string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackAndRenderEnvironment", out, app, env, templateURL, params, cfnExecRoleARN)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackAndRenderEnvironment indicates an expected call of RollbackAndRenderEnvironment.
+func (mr *MockenvironmentDeployerMockRecorder) RollbackAndRenderEnvironment(out, app, env, templateURL, params, cfnExecRoleARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackAndRenderEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).RollbackAndRenderEnvironment), out, app, env, templateURL, params, cfnExecRoleARN)
+}
+
 // UpdateAndRenderEnvironment mocks base method.
 func (m *MockenvironmentDeployer) UpdateAndRenderEnvironment(out progress.FileWriter, env *deploy.CreateEnvironmentInput, opts ...cloudformation0.StackOption) error {
 	m.ctrl.T.Helper()
@@ -110,3 +224,326 @@ func (mr *MockenvironmentDeployerMockRecorder) UpdateAndRenderEnvironment(out, e
 	varargs := append([]interface{}{out, env}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAndRenderEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).UpdateAndRenderEnvironment), varargs...)
 }
+
+// UpdateAndStreamEnvironment mocks base method.
+func (m *MockenvironmentDeployer) UpdateAndStreamEnvironment(out io.Writer, env *deploy.CreateEnvironmentInput, opts ...cloudformation0.StackOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{out, env}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAndStreamEnvironment", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAndStreamEnvironment indicates an expected call of UpdateAndStreamEnvironment.
+func (mr *MockenvironmentDeployerMockRecorder) UpdateAndStreamEnvironment(out, env interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{out, env}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAndStreamEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).UpdateAndStreamEnvironment), varargs...)
+}
+
+// UpdateEnvironment mocks base method.
+func (m *MockenvironmentDeployer) UpdateEnvironment(env *deploy.CreateEnvironmentInput, opts ...cloudformation0.StackOption) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{env}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateEnvironment", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment.
+func (mr *MockenvironmentDeployerMockRecorder) UpdateEnvironment(env interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{env}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).UpdateEnvironment), varargs...)
+}
+
+// MockenvStackSetInstanceChecker is a mock of envStackSetInstanceChecker interface.
+type MockenvStackSetInstanceChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvStackSetInstanceCheckerMockRecorder
+}
+
+// MockenvStackSetInstanceCheckerMockRecorder is the mock recorder for MockenvStackSetInstanceChecker.
+type MockenvStackSetInstanceCheckerMockRecorder struct {
+	mock *MockenvStackSetInstanceChecker
+}
+
+// NewMockenvStackSetInstanceChecker creates a new mock instance.
+func NewMockenvStackSetInstanceChecker(ctrl *gomock.Controller) *MockenvStackSetInstanceChecker {
+	mock := &MockenvStackSetInstanceChecker{ctrl: ctrl}
+	mock.recorder = &MockenvStackSetInstanceCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvStackSetInstanceChecker) EXPECT() *MockenvStackSetInstanceCheckerMockRecorder {
+	return m.recorder
+}
+
+// IsEnvironmentInstance mocks base method.
+func (m *MockenvStackSetInstanceChecker) IsEnvironmentInstance(env *config.Environment) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsEnvironmentInstance", env)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsEnvironmentInstance indicates an expected call of IsEnvironmentInstance.
+func (mr *MockenvStackSetInstanceCheckerMockRecorder) IsEnvironmentInstance(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsEnvironmentInstance", reflect.TypeOf((*MockenvStackSetInstanceChecker)(nil).IsEnvironmentInstance), env)
+}
+
+// MocklogEventsGetter is a mock of logEventsGetter interface.
+type MocklogEventsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocklogEventsGetterMockRecorder
+}
+
+// MocklogEventsGetterMockRecorder is the mock recorder for MocklogEventsGetter.
+type MocklogEventsGetterMockRecorder struct {
+	mock *MocklogEventsGetter
+}
+
+// NewMocklogEventsGetter creates a new mock instance.
+func NewMocklogEventsGetter(ctrl *gomock.Controller) *MocklogEventsGetter {
+	mock := &MocklogEventsGetter{ctrl: ctrl}
+	mock.recorder = &MocklogEventsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklogEventsGetter) EXPECT() *MocklogEventsGetterMockRecorder {
+	return m.recorder
+}
+
+// LogEvents mocks base method.
+func (m *MocklogEventsGetter) LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogEvents", opts)
+	ret0, _ := ret[0].(*cloudwatchlogs.LogEventsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogEvents indicates an expected call of LogEvents.
+func (mr *MocklogEventsGetterMockRecorder) LogEvents(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogEvents", reflect.TypeOf((*MocklogEventsGetter)(nil).LogEvents), opts)
+}
+
+// MockenvArtifactStore is a mock of envArtifactStore interface.
+type MockenvArtifactStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvArtifactStoreMockRecorder
+}
+
+// MockenvArtifactStoreMockRecorder is the mock recorder for MockenvArtifactStore.
+type MockenvArtifactStoreMockRecorder struct {
+	mock *MockenvArtifactStore
+}
+
+// NewMockenvArtifactStore creates a new mock instance.
+func NewMockenvArtifactStore(ctrl *gomock.Controller) *MockenvArtifactStore {
+	mock := &MockenvArtifactStore{ctrl: ctrl}
+	mock.recorder = &MockenvArtifactStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvArtifactStore) EXPECT() *MockenvArtifactStoreMockRecorder {
+	return m.recorder
+}
+
+// Download mocks base method.
+func (m *MockenvArtifactStore) Download(bucket, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", bucket, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockenvArtifactStoreMockRecorder) Download(bucket, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockenvArtifactStore)(nil).Download), bucket, key)
+}
+
+// Upload mocks base method.
+func (m *MockenvArtifactStore) Upload(bucket, key string, data io.Reader) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", bucket, key, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockenvArtifactStoreMockRecorder) Upload(bucket, key, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockenvArtifactStore)(nil).Upload), bucket, key, data)
+}
+
+// UploadIfNotExists mocks base method.
+func (m *MockenvArtifactStore) UploadIfNotExists(bucket, key string, data io.Reader) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadIfNotExists", bucket, key, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadIfNotExists indicates an expected call of UploadIfNotExists.
+func (mr *MockenvArtifactStoreMockRecorder) UploadIfNotExists(bucket, key, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadIfNotExists", reflect.TypeOf((*MockenvArtifactStore)(nil).UploadIfNotExists), bucket, key, data)
+}
+
+// MockvpcResourcesGetter is a mock of vpcResourcesGetter interface.
+type MockvpcResourcesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockvpcResourcesGetterMockRecorder
+}
+
+// MockvpcResourcesGetterMockRecorder is the mock recorder for MockvpcResourcesGetter.
+type MockvpcResourcesGetterMockRecorder struct {
+	mock *MockvpcResourcesGetter
+}
+
+// NewMockvpcResourcesGetter creates a new mock instance.
+func NewMockvpcResourcesGetter(ctrl *gomock.Controller) *MockvpcResourcesGetter {
+	mock := &MockvpcResourcesGetter{ctrl: ctrl}
+	mock.recorder = &MockvpcResourcesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockvpcResourcesGetter) EXPECT() *MockvpcResourcesGetterMockRecorder {
+	return m.recorder
+}
+
+// RouteTableIDs mocks base method.
+func (m *MockvpcResourcesGetter) RouteTableIDs(filters ...ec2.Filter) ([]string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range filters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RouteTableIDs", varargs...)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RouteTableIDs indicates an expected call of RouteTableIDs.
+func (mr *MockvpcResourcesGetterMockRecorder) RouteTableIDs(filters ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RouteTableIDs", reflect.TypeOf((*MockvpcResourcesGetter)(nil).RouteTableIDs), filters...)
+}
+
+// SecurityGroups mocks base method.
+func (m *MockvpcResourcesGetter) SecurityGroups(filters ...ec2.Filter) ([]string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range filters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SecurityGroups", varargs...)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecurityGroups indicates an expected call of SecurityGroups.
+func (mr *MockvpcResourcesGetterMockRecorder) SecurityGroups(filters ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecurityGroups", reflect.TypeOf((*MockvpcResourcesGetter)(nil).SecurityGroups), filters...)
+}
+
+// MockexecRunner is a mock of execRunner interface.
+type MockexecRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockexecRunnerMockRecorder
+}
+
+// MockexecRunnerMockRecorder is the mock recorder for MockexecRunner.
+type MockexecRunnerMockRecorder struct {
+	mock *MockexecRunner
+}
+
+// NewMockexecRunner creates a new mock instance.
+func NewMockexecRunner(ctrl *gomock.Controller) *MockexecRunner {
+	mock := &MockexecRunner{ctrl: ctrl}
+	mock.recorder = &MockexecRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockexecRunner) EXPECT() *MockexecRunnerMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockexecRunner) Run(name string, args []string, options ...exec.CmdOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name, args}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Run", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockexecRunnerMockRecorder) Run(name, args interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name, args}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockexecRunner)(nil).Run), varargs...)
+}
+
+// MocklambdaInvoker is a mock of lambdaInvoker interface.
+type MocklambdaInvoker struct {
+	ctrl     *gomock.Controller
+	recorder *MocklambdaInvokerMockRecorder
+}
+
+// MocklambdaInvokerMockRecorder is the mock recorder for MocklambdaInvoker.
+type MocklambdaInvokerMockRecorder struct {
+	mock *MocklambdaInvoker
+}
+
+// NewMocklambdaInvoker creates a new mock instance.
+func NewMocklambdaInvoker(ctrl *gomock.Controller) *MocklambdaInvoker {
+	mock := &MocklambdaInvoker{ctrl: ctrl}
+	mock.recorder = &MocklambdaInvokerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklambdaInvoker) EXPECT() *MocklambdaInvokerMockRecorder {
+	return m.recorder
+}
+
+// Invoke mocks base method.
+func (m *MocklambdaInvoker) Invoke(functionARN string, payload []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invoke", functionARN, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Invoke indicates an expected call of Invoke.
+func (mr *MocklambdaInvokerMockRecorder) Invoke(functionARN, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invoke", reflect.TypeOf((*MocklambdaInvoker)(nil).Invoke), functionARN, payload)
+}
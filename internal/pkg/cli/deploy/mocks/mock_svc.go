@@ -9,10 +9,13 @@ import (
 	reflect "reflect"
 	time "time"
 
-	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	cloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	codedeploy "github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
+	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	s3 "github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
-	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	cloudformation1 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	dockerengine "github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	repository "github.com/aws/copilot-cli/internal/pkg/repository"
 	progress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -132,6 +135,21 @@ func (mr *MockuploaderMockRecorder) Upload(bucket, key, data interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*Mockuploader)(nil).Upload), bucket, key, data)
 }
 
+// UploadIfNotExists mocks base method.
+func (m *Mockuploader) UploadIfNotExists(bucket, key string, data io.Reader) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadIfNotExists", bucket, key, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadIfNotExists indicates an expected call of UploadIfNotExists.
+func (mr *MockuploaderMockRecorder) UploadIfNotExists(bucket, key, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadIfNotExists", reflect.TypeOf((*Mockuploader)(nil).UploadIfNotExists), bucket, key, data)
+}
+
 // ZipAndUpload mocks base method.
 func (m *Mockuploader) ZipAndUpload(bucket, key string, files ...s3.NamedBinary) (string, error) {
 	m.ctrl.T.Helper()
@@ -418,8 +436,22 @@ func (m *MockserviceDeployer) EXPECT() *MockserviceDeployerMockRecorder {
 	return m.recorder
 }
 
+// DeleteRolledBackStack mocks base method.
+func (m *MockserviceDeployer) DeleteRolledBackStack(stackName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRolledBackStack", stackName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRolledBackStack indicates an expected call of DeleteRolledBackStack.
+func (mr *MockserviceDeployerMockRecorder) DeleteRolledBackStack(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRolledBackStack", reflect.TypeOf((*MockserviceDeployer)(nil).DeleteRolledBackStack), stackName)
+}
+
 // DeployService mocks base method.
-func (m *MockserviceDeployer) DeployService(out progress.FileWriter, conf cloudformation0.StackConfiguration, bucketName string, opts ...cloudformation.StackOption) error {
+func (m *MockserviceDeployer) DeployService(out progress.FileWriter, conf cloudformation1.StackConfiguration, bucketName string, opts ...cloudformation0.StackOption) error {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{out, conf, bucketName}
 	for _, a := range opts {
@@ -437,6 +469,146 @@ func (mr *MockserviceDeployerMockRecorder) DeployService(out, conf, bucketName i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployService", reflect.TypeOf((*MockserviceDeployer)(nil).DeployService), varargs...)
 }
 
+// DeployServiceNoWait mocks base method.
+func (m *MockserviceDeployer) DeployServiceNoWait(out progress.FileWriter, conf cloudformation1.StackConfiguration, bucketName string, opts ...cloudformation0.StackOption) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{out, conf, bucketName}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployServiceNoWait", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployServiceNoWait indicates an expected call of DeployServiceNoWait.
+func (mr *MockserviceDeployerMockRecorder) DeployServiceNoWait(out, conf, bucketName interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{out, conf, bucketName}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployServiceNoWait", reflect.TypeOf((*MockserviceDeployer)(nil).DeployServiceNoWait), varargs...)
+}
+
+// MockdeployedStackDescriber is a mock of deployedStackDescriber interface.
+type MockdeployedStackDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployedStackDescriberMockRecorder
+}
+
+// MockdeployedStackDescriberMockRecorder is the mock recorder for MockdeployedStackDescriber.
+type MockdeployedStackDescriberMockRecorder struct {
+	mock *MockdeployedStackDescriber
+}
+
+// NewMockdeployedStackDescriber creates a new mock instance.
+func NewMockdeployedStackDescriber(ctrl *gomock.Controller) *MockdeployedStackDescriber {
+	mock := &MockdeployedStackDescriber{ctrl: ctrl}
+	mock.recorder = &MockdeployedStackDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeployedStackDescriber) EXPECT() *MockdeployedStackDescriberMockRecorder {
+	return m.recorder
+}
+
+// WorkloadAddonsTemplate mocks base method.
+func (m *MockdeployedStackDescriber) WorkloadAddonsTemplate(stackName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadAddonsTemplate", stackName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkloadAddonsTemplate indicates an expected call of WorkloadAddonsTemplate.
+func (mr *MockdeployedStackDescriberMockRecorder) WorkloadAddonsTemplate(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadAddonsTemplate", reflect.TypeOf((*MockdeployedStackDescriber)(nil).WorkloadAddonsTemplate), stackName)
+}
+
+// WorkloadOutputs mocks base method.
+func (m *MockdeployedStackDescriber) WorkloadOutputs(stackName string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadOutputs", stackName)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkloadOutputs indicates an expected call of WorkloadOutputs.
+func (mr *MockdeployedStackDescriberMockRecorder) WorkloadOutputs(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadOutputs", reflect.TypeOf((*MockdeployedStackDescriber)(nil).WorkloadOutputs), stackName)
+}
+
+// WorkloadParameters mocks base method.
+func (m *MockdeployedStackDescriber) WorkloadParameters(stackName string) ([]*cloudformation.Parameter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadParameters", stackName)
+	ret0, _ := ret[0].([]*cloudformation.Parameter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkloadParameters indicates an expected call of WorkloadParameters.
+func (mr *MockdeployedStackDescriberMockRecorder) WorkloadParameters(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadParameters", reflect.TypeOf((*MockdeployedStackDescriber)(nil).WorkloadParameters), stackName)
+}
+
+// WorkloadTemplate mocks base method.
+func (m *MockdeployedStackDescriber) WorkloadTemplate(stackName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadTemplate", stackName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkloadTemplate indicates an expected call of WorkloadTemplate.
+func (mr *MockdeployedStackDescriberMockRecorder) WorkloadTemplate(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadTemplate", reflect.TypeOf((*MockdeployedStackDescriber)(nil).WorkloadTemplate), stackName)
+}
+
+// MockblueGreenDeployer is a mock of blueGreenDeployer interface.
+type MockblueGreenDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockblueGreenDeployerMockRecorder
+}
+
+// MockblueGreenDeployerMockRecorder is the mock recorder for MockblueGreenDeployer.
+type MockblueGreenDeployerMockRecorder struct {
+	mock *MockblueGreenDeployer
+}
+
+// NewMockblueGreenDeployer creates a new mock instance.
+func NewMockblueGreenDeployer(ctrl *gomock.Controller) *MockblueGreenDeployer {
+	mock := &MockblueGreenDeployer{ctrl: ctrl}
+	mock.recorder = &MockblueGreenDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockblueGreenDeployer) EXPECT() *MockblueGreenDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployECSService mocks base method.
+func (m *MockblueGreenDeployer) DeployECSService(in *codedeploy.ECSDeploymentInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployECSService", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployECSService indicates an expected call of DeployECSService.
+func (mr *MockblueGreenDeployerMockRecorder) DeployECSService(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployECSService", reflect.TypeOf((*MockblueGreenDeployer)(nil).DeployECSService), in)
+}
+
 // MockserviceForceUpdater is a mock of serviceForceUpdater interface.
 type MockserviceForceUpdater struct {
 	ctrl     *gomock.Controller
@@ -489,6 +661,80 @@ func (mr *MockserviceForceUpdaterMockRecorder) LastUpdatedAt(app, env, svc inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastUpdatedAt", reflect.TypeOf((*MockserviceForceUpdater)(nil).LastUpdatedAt), app, env, svc)
 }
 
+// MockdeploymentDiagnoser is a mock of deploymentDiagnoser interface.
+type MockdeploymentDiagnoser struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeploymentDiagnoserMockRecorder
+}
+
+// MockdeploymentDiagnoserMockRecorder is the mock recorder for MockdeploymentDiagnoser.
+type MockdeploymentDiagnoserMockRecorder struct {
+	mock *MockdeploymentDiagnoser
+}
+
+// NewMockdeploymentDiagnoser creates a new mock instance.
+func NewMockdeploymentDiagnoser(ctrl *gomock.Controller) *MockdeploymentDiagnoser {
+	mock := &MockdeploymentDiagnoser{ctrl: ctrl}
+	mock.recorder = &MockdeploymentDiagnoserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeploymentDiagnoser) EXPECT() *MockdeploymentDiagnoserMockRecorder {
+	return m.recorder
+}
+
+// Diagnose mocks base method.
+func (m *MockdeploymentDiagnoser) Diagnose(since time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Diagnose", since)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Diagnose indicates an expected call of Diagnose.
+func (mr *MockdeploymentDiagnoserMockRecorder) Diagnose(since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Diagnose", reflect.TypeOf((*MockdeploymentDiagnoser)(nil).Diagnose), since)
+}
+
+// MocktemplateValidator is a mock of templateValidator interface.
+type MocktemplateValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MocktemplateValidatorMockRecorder
+}
+
+// MocktemplateValidatorMockRecorder is the mock recorder for MocktemplateValidator.
+type MocktemplateValidatorMockRecorder struct {
+	mock *MocktemplateValidator
+}
+
+// NewMocktemplateValidator creates a new mock instance.
+func NewMocktemplateValidator(ctrl *gomock.Controller) *MocktemplateValidator {
+	mock := &MocktemplateValidator{ctrl: ctrl}
+	mock.recorder = &MocktemplateValidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktemplateValidator) EXPECT() *MocktemplateValidatorMockRecorder {
+	return m.recorder
+}
+
+// Validate mocks base method.
+func (m *MocktemplateValidator) Validate(name, template string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", name, template)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MocktemplateValidatorMockRecorder) Validate(name, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MocktemplateValidator)(nil).Validate), name, template)
+}
+
 // Mockspinner is a mock of spinner interface.
 type Mockspinner struct {
 	ctrl     *gomock.Controller
@@ -611,6 +857,86 @@ func (mr *MockaliasCertValidatorMockRecorder) ValidateCertAliases(aliases, certs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCertAliases", reflect.TypeOf((*MockaliasCertValidator)(nil).ValidateCertAliases), aliases, certs)
 }
 
+// MockenvOutputsGetter is a mock of envOutputsGetter interface.
+type MockenvOutputsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvOutputsGetterMockRecorder
+}
+
+// MockenvOutputsGetterMockRecorder is the mock recorder for MockenvOutputsGetter.
+type MockenvOutputsGetterMockRecorder struct {
+	mock *MockenvOutputsGetter
+}
+
+// NewMockenvOutputsGetter creates a new mock instance.
+func NewMockenvOutputsGetter(ctrl *gomock.Controller) *MockenvOutputsGetter {
+	mock := &MockenvOutputsGetter{ctrl: ctrl}
+	mock.recorder = &MockenvOutputsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvOutputsGetter) EXPECT() *MockenvOutputsGetterMockRecorder {
+	return m.recorder
+}
+
+// Outputs mocks base method.
+func (m *MockenvOutputsGetter) Outputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Outputs indicates an expected call of Outputs.
+func (mr *MockenvOutputsGetterMockRecorder) Outputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MockenvOutputsGetter)(nil).Outputs))
+}
+
+// MocksubnetIDsGetter is a mock of subnetIDsGetter interface.
+type MocksubnetIDsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksubnetIDsGetterMockRecorder
+}
+
+// MocksubnetIDsGetterMockRecorder is the mock recorder for MocksubnetIDsGetter.
+type MocksubnetIDsGetterMockRecorder struct {
+	mock *MocksubnetIDsGetter
+}
+
+// NewMocksubnetIDsGetter creates a new mock instance.
+func NewMocksubnetIDsGetter(ctrl *gomock.Controller) *MocksubnetIDsGetter {
+	mock := &MocksubnetIDsGetter{ctrl: ctrl}
+	mock.recorder = &MocksubnetIDsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksubnetIDsGetter) EXPECT() *MocksubnetIDsGetterMockRecorder {
+	return m.recorder
+}
+
+// SubnetIDs mocks base method.
+func (m *MocksubnetIDsGetter) SubnetIDs(filters ...ec2.Filter) ([]string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range filters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubnetIDs", varargs...)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubnetIDs indicates an expected call of SubnetIDs.
+func (mr *MocksubnetIDsGetterMockRecorder) SubnetIDs(filters ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubnetIDs", reflect.TypeOf((*MocksubnetIDsGetter)(nil).SubnetIDs), filters...)
+}
+
 // MockconfigDescriber is a mock of configDescriber interface.
 type MockconfigDescriber struct {
 	ctrl     *gomock.Controller
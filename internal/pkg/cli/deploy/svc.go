@@ -12,6 +12,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,9 +21,11 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/ssm"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
 
@@ -34,11 +37,14 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/spf13/afero"
 	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
 
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/apprunner"
 	awsapprunner "github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
@@ -51,7 +57,10 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/guard"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/remotebuild"
 	"github.com/aws/copilot-cli/internal/pkg/repository"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -64,6 +73,10 @@ const (
 	fmtForceUpdateSvcStart    = "Forcing an update for service %s from environment %s"
 	fmtForceUpdateSvcFailed   = "Failed to force an update for service %s from environment %s: %v.\n"
 	fmtForceUpdateSvcComplete = "Forced an update for service %s from environment %s.\n"
+
+	fmtBlueGreenDeploySvcStart    = "Shifting traffic to the new tasks of service %s in environment %s with CodeDeploy"
+	fmtBlueGreenDeploySvcFailed   = "Failed to shift traffic to the new tasks of service %s in environment %s: %v.\n"
+	fmtBlueGreenDeploySvcComplete = "Shifted traffic to the new tasks of service %s in environment %s.\n"
 )
 
 var (
@@ -97,6 +110,7 @@ type imageBuilderPusher interface {
 
 type uploader interface {
 	Upload(bucket, key string, data io.Reader) (string, error)
+	UploadIfNotExists(bucket, key string, data io.Reader) (string, error)
 	ZipAndUpload(bucket, key string, files ...s3.NamedBinary) (string, error)
 }
 
@@ -127,6 +141,19 @@ type snsTopicsLister interface {
 
 type serviceDeployer interface {
 	DeployService(out progress.FileWriter, conf cloudformation.StackConfiguration, bucketName string, opts ...awscloudformation.StackOption) error
+	DeployServiceNoWait(out progress.FileWriter, conf cloudformation.StackConfiguration, bucketName string, opts ...awscloudformation.StackOption) (string, error)
+	DeleteRolledBackStack(stackName string) error
+}
+
+type deployedStackDescriber interface {
+	WorkloadTemplate(stackName string) (string, error)
+	WorkloadParameters(stackName string) ([]*awscfn.Parameter, error)
+	WorkloadAddonsTemplate(stackName string) (string, error)
+	WorkloadOutputs(stackName string) (map[string]string, error)
+}
+
+type blueGreenDeployer interface {
+	DeployECSService(in *codedeploy.ECSDeploymentInput) error
 }
 
 type serviceForceUpdater interface {
@@ -134,6 +161,14 @@ type serviceForceUpdater interface {
 	LastUpdatedAt(app, env, svc string) (time.Time, error)
 }
 
+type deploymentDiagnoser interface {
+	Diagnose(since time.Time) error
+}
+
+type templateValidator interface {
+	Validate(name, template string) error
+}
+
 type spinner interface {
 	Start(label string)
 	Stop(label string)
@@ -147,15 +182,26 @@ type aliasCertValidator interface {
 	ValidateCertAliases(aliases []string, certs []string) error
 }
 
+type envOutputsGetter interface {
+	Outputs() (map[string]string, error)
+}
+
+type subnetIDsGetter interface {
+	SubnetIDs(filters ...ec2.Filter) ([]string, error)
+}
+
 type configDescriber interface {
 	Manifest() ([]byte, error)
 }
 
 type workloadDeployer struct {
 	name          string
+	instance      string
 	app           *config.Application
 	env           *config.Environment
 	imageTag      string
+	extraTags     []string
+	imageDigest   string
 	resources     *stack.AppRegionalResources
 	mft           interface{}
 	rawMft        []byte
@@ -167,10 +213,15 @@ type workloadDeployer struct {
 	templater          templater
 	imageBuilderPusher imageBuilderPusher
 	deployer           serviceDeployer
+	stackDescriber     deployedStackDescriber
 	endpointGetter     endpointGetter
 	spinner            spinner
+	progressOut        termprogress.FileWriter
 	templateFS         template.Reader
 	envConfigDescriber configDescriber
+	envOutputs         envOutputsGetter
+	subnetGetter       subnetIDsGetter
+	validator          templateValidator // Nil unless the workspace opts in to validating templates against cfn-guard rules.
 
 	// Cached variables.
 	defaultSess              *session.Session
@@ -200,11 +251,17 @@ func (d *workloadDeployer) cachedEnvironmentConfig() (*manifest.Environment, err
 type WorkloadDeployerInput struct {
 	SessionProvider *sessions.Provider
 	Name            string
+	Instance        string // Optional. Name of the instance to deploy the workload as, so that multiple copies of it can run in the same environment.
 	App             *config.Application
 	Env             *config.Environment
 	ImageTag        string
+	ExtraImageTags  []string    // Optional. Additional tags to push the built image with.
+	ImageDigest     string      // Optional. Digest of an image in the workload's repository to deploy instead of building one.
+	RemoteBuild     bool        // Optional. Build and push the image with CodeBuild instead of the local Docker engine.
 	Mft             interface{} // Interpolated, applied, and unmarshaled manifest.
 	RawMft          []byte      // Content of the manifest file without any transformations.
+
+	ProgressOut termprogress.FileWriter // Optional. Where to render the progress of the image build and stack deployment, defaults to os.Stderr.
 }
 
 // NewWorkloadDeployer is the constructor for workloadDeployer.
@@ -232,17 +289,36 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("create default session with region %s: %w", in.Env.Region, err)
 	}
+	progressOut := in.ProgressOut
+	if progressOut == nil {
+		progressOut = os.Stderr
+	}
 	resources, err := cloudformation.New(defaultSession).GetAppResourcesByRegion(in.App, in.Env.Region)
 	if err != nil {
 		return nil, fmt.Errorf("get application %s resources from region %s: %w", in.App.Name, in.Env.Region, err)
 	}
+	guardRulesDir, err := ws.GuardRulesDir()
+	if err != nil {
+		return nil, fmt.Errorf("get cfn-guard rules directory: %w", err)
+	}
 	addonsSvc, err := addon.New(in.Name)
 	if err != nil {
 		return nil, fmt.Errorf("initiate addons service: %w", err)
 	}
-	repoName := fmt.Sprintf("%s/%s", in.App.Name, in.Name)
-	imageBuilderPusher := repository.NewWithURI(
-		ecr.New(defaultSessEnvRegion), repoName, resources.RepositoryURLs[in.Name])
+	repoName := stack.NameForWorkloadRepository(in.App.Name, in.Name, resources.SharedRepositoryURL != "")
+	var imageBuilderPusher imageBuilderPusher = repository.NewWithURI(
+		ecr.New(defaultSessEnvRegion), repoName, resources.WorkloadRepositoryURL(in.Name))
+	if in.RemoteBuild {
+		imageBuilderPusher = remotebuild.New(remotebuild.BuilderConfig{
+			App:      in.App.Name,
+			Name:     in.Name,
+			Bucket:   resources.S3Bucket,
+			Uploader: s3.New(defaultSessEnvRegion),
+			Deployer: cloudformation.New(defaultSessEnvRegion),
+			Runner:   codebuild.New(defaultSessEnvRegion),
+			Out:      progressOut,
+		})
+	}
 	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
 	envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 		App:         in.App.Name,
@@ -252,11 +328,14 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("initiate env describer: %w", err)
 	}
-	return &workloadDeployer{
+	d := &workloadDeployer{
 		name:               in.Name,
+		instance:           in.Instance,
 		app:                in.App,
 		env:                in.Env,
 		imageTag:           in.ImageTag,
+		extraTags:          in.ExtraImageTags,
+		imageDigest:        in.ImageDigest,
 		resources:          resources,
 		workspacePath:      workspacePath,
 		fs:                 &afero.Afero{Fs: afero.NewOsFs()},
@@ -264,10 +343,14 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 		templater:          addonsSvc,
 		imageBuilderPusher: imageBuilderPusher,
 		deployer:           cloudformation.New(envSession),
+		stackDescriber:     cloudformation.New(envSession),
 		endpointGetter:     envDescriber,
-		spinner:            termprogress.NewSpinner(log.DiagnosticWriter),
+		spinner:            termprogress.NewSpinner(progressOut),
+		progressOut:        progressOut,
 		templateFS:         template.New(),
 		envConfigDescriber: envDescriber,
+		envOutputs:         envDescriber,
+		subnetGetter:       ec2.New(envSession),
 
 		defaultSess:              defaultSession,
 		defaultSessWithEnvRegion: defaultSessEnvRegion,
@@ -276,12 +359,17 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 
 		mft:    in.Mft,
 		rawMft: in.RawMft,
-	}, nil
+	}
+	if guardRulesDir != "" {
+		d.validator = guard.New(guardRulesDir)
+	}
+	return d, nil
 }
 
 type svcDeployer struct {
 	*workloadDeployer
 	newSvcUpdater func(func(*session.Session) serviceForceUpdater) serviceForceUpdater
+	diagnoser     deploymentDiagnoser
 	now           func() time.Time
 }
 
@@ -290,11 +378,28 @@ func newSvcDeployer(in *WorkloadDeployerInput) (*svcDeployer, error) {
 	if err != nil {
 		return nil, err
 	}
+	logs, err := logging.NewServiceClient(&logging.NewServiceLogsConfig{
+		App:  in.App.Name,
+		Env:  in.Env.Name,
+		Svc:  wkldDeployer.workloadName(),
+		Sess: wkldDeployer.envSess,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("new logs client for service %s: %w", wkldDeployer.workloadName(), err)
+	}
 	return &svcDeployer{
 		workloadDeployer: wkldDeployer,
 		newSvcUpdater: func(f func(*session.Session) serviceForceUpdater) serviceForceUpdater {
 			return f(wkldDeployer.envSess)
 		},
+		diagnoser: &stoppedTasksDiagnoser{
+			app:       in.App.Name,
+			env:       in.Env.Name,
+			svc:       wkldDeployer.workloadName(),
+			describer: ecs.New(wkldDeployer.envSess),
+			logs:      logs,
+			w:         wkldDeployer.progressOut,
+		},
 		now: time.Now,
 	}, nil
 }
@@ -313,6 +418,10 @@ type lbWebSvcDeployer struct {
 	publicCIDRBlocksGetter publicCIDRBlocksGetter
 	lbMft                  *manifest.LoadBalancedWebService
 	customResources        customResourcesFunc
+	blueGreenDeployer      blueGreenDeployer
+
+	// Outputs of the stack before the deployment, only retrieved for blue/green deployments.
+	deployedOutputs map[string]string
 }
 
 // NewLBWSDeployer is the constructor for lbWebSvcDeployer.
@@ -348,6 +457,7 @@ func NewLBWSDeployer(in *WorkloadDeployerInput) (*lbWebSvcDeployer, error) {
 		publicCIDRBlocksGetter: envDescriber,
 		lbMft:                  lbMft,
 		aliasCertValidator:     acm.New(svcDeployer.envSess),
+		blueGreenDeployer:      codedeploy.New(svcDeployer.envSess),
 		customResources: func(fs template.Reader) ([]*customresource.CustomResource, error) {
 			crs, err := customresource.LBWS(fs)
 			if err != nil {
@@ -529,6 +639,8 @@ type StackRuntimeConfiguration struct {
 	RootUserARN        string
 	Tags               map[string]string
 	CustomResourceURLs map[string]string
+	Deployer           string // Optional. ARN of the identity deploying the workload, recorded in the template metadata.
+	Commit             string // Optional. Git commit of the workspace that the workload is deployed from.
 }
 
 // DeployWorkloadInput is the input of DeployWorkload.
@@ -539,8 +651,11 @@ type DeployWorkloadInput struct {
 
 // Options specifies options for the deployment.
 type Options struct {
-	ForceNewUpdate  bool
-	DisableRollback bool
+	ForceNewUpdate          bool
+	DisableRollback         bool
+	NoWait                  bool                     // Start the stack update and return without waiting for it to complete.
+	RecreateRolledBackStack bool                     // Delete the stack first if it failed to be created and was rolled back.
+	Packaged                *deploy.PackagedTemplate // Deploy a template generated by `package` verbatim instead of generating the stack.
 }
 
 // UploadArtifacts uploads the deployment artifacts such as the container image, custom resources, addons and env files.
@@ -571,12 +686,14 @@ func (d *jobDeployer) UploadArtifacts() (*UploadArtifactsOutput, error) {
 // GenerateCloudFormationTemplateInput is the input of GenerateCloudFormationTemplate.
 type GenerateCloudFormationTemplateInput struct {
 	StackRuntimeConfiguration
+	WithDiff bool // Whether to compute the differences against the deployed stack.
 }
 
 // GenerateCloudFormationTemplateOutput is the output of GenerateCloudFormationTemplate.
 type GenerateCloudFormationTemplateOutput struct {
 	Template   string
 	Parameters string
+	Diff       *TemplateDiff // Differences from the deployed stack, always computed for environments and on request for workloads.
 }
 
 // GenerateCloudFormationTemplate generates a CloudFormation template and parameters for a workload.
@@ -586,11 +703,14 @@ func (d *lbWebSvcDeployer) GenerateCloudFormationTemplate(in *GenerateCloudForma
 	if err != nil {
 		return nil, err
 	}
-	return d.generateCloudFormationTemplate(output.conf)
+	return d.generateCloudFormationTemplate(output.conf, in.WithDiff)
 }
 
 // DeployWorkload deploys a load balanced web service using CloudFormation.
 func (d *lbWebSvcDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecommender, error) {
+	if in.NoWait && d.lbMft.DeployConfig.IsBlueGreen() {
+		return nil, fmt.Errorf("service %s uses blue/green deployments that must be awaited to shift traffic", d.workloadName())
+	}
 	stackConfigOutput, err := d.stackConfiguration(&in.StackRuntimeConfiguration)
 	if err != nil {
 		return nil, err
@@ -598,9 +718,59 @@ func (d *lbWebSvcDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecomm
 	if err := d.deploy(in.Options, *stackConfigOutput); err != nil {
 		return nil, err
 	}
+	if d.lbMft.DeployConfig.IsBlueGreen() {
+		if err := d.deployBlueGreen(in.ForceNewUpdate); err != nil {
+			return nil, err
+		}
+	}
 	return nil, nil
 }
 
+// deployBlueGreen shifts traffic to the task definition of the updated stack with a CodeDeploy blue/green deployment.
+// The deployment is skipped if CloudFormation created the service with the latest task definition,
+// or if the task definition didn't change and an update is not forced.
+func (d *lbWebSvcDeployer) deployBlueGreen(force bool) error {
+	if d.deployedOutputs[stack.LBWebServiceOutputServiceTaskDefinition] == "" {
+		return nil
+	}
+	stackName := stack.NameForService(d.app.Name, d.env.Name, d.workloadName())
+	outputs, err := d.stackDescriber.WorkloadOutputs(stackName)
+	if err != nil {
+		return fmt.Errorf("get outputs of stack %s: %w", stackName, err)
+	}
+	taskDef := outputs[stack.LBWebServiceOutputTaskDefinition]
+	if taskDef == d.deployedOutputs[stack.LBWebServiceOutputTaskDefinition] && !force {
+		return nil
+	}
+	params, err := d.stackDescriber.WorkloadParameters(stackName)
+	if err != nil {
+		return fmt.Errorf("get parameters of stack %s: %w", stackName, err)
+	}
+	in := &codedeploy.ECSDeploymentInput{
+		Application:     outputs[stack.LBWebServiceOutputCodeDeployApplication],
+		DeploymentGroup: outputs[stack.LBWebServiceOutputCodeDeployDeploymentGroup],
+		TaskDefinition:  taskDef,
+		BakeTime:        d.lbMft.DeployConfig.Canary.BakeTime(),
+	}
+	for _, param := range params {
+		switch aws.StringValue(param.ParameterKey) {
+		case stack.WorkloadTargetContainerParamKey:
+			in.ContainerName = aws.StringValue(param.ParameterValue)
+		case stack.WorkloadTargetPortParamKey:
+			if in.ContainerPort, err = strconv.Atoi(aws.StringValue(param.ParameterValue)); err != nil {
+				return fmt.Errorf("parse target port %q of stack %s: %w", aws.StringValue(param.ParameterValue), stackName, err)
+			}
+		}
+	}
+	d.spinner.Start(fmt.Sprintf(fmtBlueGreenDeploySvcStart, color.HighlightUserInput(d.workloadName()), color.HighlightUserInput(d.env.Name)))
+	if err := d.blueGreenDeployer.DeployECSService(in); err != nil {
+		d.spinner.Stop(log.Serrorf(fmtBlueGreenDeploySvcFailed, color.HighlightUserInput(d.workloadName()), color.HighlightUserInput(d.env.Name), err))
+		return fmt.Errorf("deploy service %s with CodeDeploy: %w", d.workloadName(), err)
+	}
+	d.spinner.Stop(log.Ssuccessf(fmtBlueGreenDeploySvcComplete, color.HighlightUserInput(d.workloadName()), color.HighlightUserInput(d.env.Name)))
+	return nil
+}
+
 // GenerateCloudFormationTemplate generates a CloudFormation template and parameters for a workload.
 func (d *backendSvcDeployer) GenerateCloudFormationTemplate(in *GenerateCloudFormationTemplateInput) (
 	*GenerateCloudFormationTemplateOutput, error) {
@@ -608,7 +778,7 @@ func (d *backendSvcDeployer) GenerateCloudFormationTemplate(in *GenerateCloudFor
 	if err != nil {
 		return nil, err
 	}
-	return d.generateCloudFormationTemplate(output.conf)
+	return d.generateCloudFormationTemplate(output.conf, in.WithDiff)
 }
 
 // DeployWorkload deploys a backend service using CloudFormation.
@@ -643,7 +813,7 @@ func (d *rdwsDeployer) GenerateCloudFormationTemplate(in *GenerateCloudFormation
 	if err != nil {
 		return nil, err
 	}
-	return d.generateCloudFormationTemplate(output.conf)
+	return d.generateCloudFormationTemplate(output.conf, in.WithDiff)
 }
 
 // DeployWorkload deploys a request driven web service using CloudFormation.
@@ -697,7 +867,7 @@ func (d *workerSvcDeployer) GenerateCloudFormationTemplate(in *GenerateCloudForm
 	if err != nil {
 		return nil, err
 	}
-	return d.generateCloudFormationTemplate(output.conf)
+	return d.generateCloudFormationTemplate(output.conf, in.WithDiff)
 }
 
 // DeployWorkload deploys a worker service using CloudFormation.
@@ -721,7 +891,7 @@ func (d *jobDeployer) GenerateCloudFormationTemplate(in *GenerateCloudFormationT
 	if err != nil {
 		return nil, err
 	}
-	return d.generateCloudFormationTemplate(output.conf)
+	return d.generateCloudFormationTemplate(output.conf, in.WithDiff)
 }
 
 // DeployWorkload deploys a job using CloudFormation.
@@ -736,13 +906,41 @@ func (d *jobDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecommender
 	if err != nil {
 		return nil, err
 	}
-	if err := d.deployer.DeployService(os.Stderr, stackConfigOutput.conf, d.resources.S3Bucket, opts...); err != nil {
+	conf, err := stackToDeploy(stackConfigOutput.conf, in.Packaged)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.validateTemplate(conf); err != nil {
+		return nil, err
+	}
+	if err := d.warnPlatformVersion(conf.StackName()); err != nil {
+		return nil, err
+	}
+	if err := d.deleteRolledBackStack(in.Options, conf.StackName()); err != nil {
+		return nil, err
+	}
+	if err := d.deployer.DeployService(d.progressOut, conf, d.resources.S3Bucket, opts...); err != nil {
 		return nil, fmt.Errorf("deploy job: %w", err)
 	}
 	return nil, nil
 }
 
-func (d *workloadDeployer) generateCloudFormationTemplate(conf stackSerializer) (
+// workloadName returns the name of the deployed workload, which is the name of the instance if the workload is deployed as one.
+func (d *workloadDeployer) workloadName() string {
+	return manifest.InstanceName(d.name, d.instance)
+}
+
+func (d *workloadDeployer) deleteRolledBackStack(deployOptions Options, stackName string) error {
+	if !deployOptions.RecreateRolledBackStack {
+		return nil
+	}
+	if err := d.deployer.DeleteRolledBackStack(stackName); err != nil {
+		return fmt.Errorf("delete rolled back stack %s: %w", stackName, err)
+	}
+	return nil
+}
+
+func (d *workloadDeployer) generateCloudFormationTemplate(conf cloudformation.StackConfiguration, withDiff bool) (
 	*GenerateCloudFormationTemplateOutput, error) {
 	tpl, err := conf.Template()
 	if err != nil {
@@ -752,10 +950,140 @@ func (d *workloadDeployer) generateCloudFormationTemplate(conf stackSerializer)
 	if err != nil {
 		return nil, fmt.Errorf("generate stack template parameters: %w", err)
 	}
-	return &GenerateCloudFormationTemplateOutput{
+	if err := d.validateTemplate(conf); err != nil {
+		return nil, err
+	}
+	out := &GenerateCloudFormationTemplateOutput{
 		Template:   tpl,
 		Parameters: params,
-	}, nil
+	}
+	if !withDiff {
+		return out, nil
+	}
+	if out.Diff, err = d.templateDiff(conf.StackName(), tpl, params); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// validateTemplate checks the stack template against the cfn-guard rules if the workspace opts in to policy-as-code validation.
+func (d *workloadDeployer) validateTemplate(conf cloudformation.StackConfiguration) error {
+	if d.validator == nil {
+		return nil
+	}
+	tpl, err := conf.Template()
+	if err != nil {
+		return fmt.Errorf("generate stack template: %w", err)
+	}
+	if err := d.validator.Validate(d.workloadName(), tpl); err != nil {
+		return fmt.Errorf("validate stack template: %w", err)
+	}
+	return nil
+}
+
+// warnPlatformVersion warns if the workload pins a deprecated Fargate platform version, or if the pinned version differs
+// from the one of the deployed service since ECS replaces all the running tasks of the service to change it.
+func (d *workloadDeployer) warnPlatformVersion(stackName string) error {
+	mft, ok := d.mft.(platformVersionPinner)
+	if !ok || mft.PinnedPlatformVersion() == "" {
+		return nil
+	}
+	pinned := mft.PinnedPlatformVersion()
+	if mft.HasDeprecatedPlatformVersion() {
+		log.Warningf("Fargate platform version %s of %s is deprecated, pin version 1.4.0 or %s instead.\n",
+			pinned, d.workloadName(), manifest.FargatePlatformVersionLatest)
+	}
+	deployed, err := d.deployedPlatformVersion(stackName)
+	if err != nil {
+		return err
+	}
+	if deployed == "" || deployed == pinned {
+		return nil
+	}
+	log.Warningf("Changing the Fargate platform version of %s from %s to %s replaces all of its running tasks.\n",
+		d.workloadName(), deployed, pinned)
+	return nil
+}
+
+// deployedPlatformVersion returns the Fargate platform version of the deployed ECS service.
+// Returns an empty string if the workload was never deployed or doesn't have an ECS service.
+func (d *workloadDeployer) deployedPlatformVersion(stackName string) (string, error) {
+	tpl, err := d.stackDescriber.WorkloadTemplate(stackName)
+	var errNotFound *awscloudformation.ErrStackNotFound
+	switch {
+	case errors.As(err, &errNotFound):
+		return "", nil
+	case err != nil:
+		return "", fmt.Errorf("retrieve the deployed template of stack %s: %w", stackName, err)
+	}
+	var parsed struct {
+		Resources struct {
+			Service struct {
+				Properties struct {
+					PlatformVersion string `yaml:"PlatformVersion"`
+				} `yaml:"Properties"`
+			} `yaml:"Service"`
+		} `yaml:"Resources"`
+	}
+	if err := yaml.Unmarshal([]byte(tpl), &parsed); err != nil {
+		return "", fmt.Errorf("parse the deployed template of stack %s: %w", stackName, err)
+	}
+	return parsed.Resources.Service.Properties.PlatformVersion, nil
+}
+
+// templateDiff compares the deployed workload stack, including its nested addons stack, against the generated template and parameters.
+// If the workload was never deployed, every resource and parameter is reported as added.
+func (d *workloadDeployer) templateDiff(stackName, tpl, params string) (*TemplateDiff, error) {
+	var oldAddonsTpl string
+	var oldParams []*awscfn.Parameter
+	var newStack bool
+	oldTpl, err := d.stackDescriber.WorkloadTemplate(stackName)
+	var errNotFound *awscloudformation.ErrStackNotFound
+	switch {
+	case errors.As(err, &errNotFound):
+		// The workload was never deployed, there is nothing to compare against.
+		newStack = true
+	case err != nil:
+		return nil, fmt.Errorf("retrieve the deployed template of stack %s: %w", stackName, err)
+	default:
+		if oldParams, err = d.stackDescriber.WorkloadParameters(stackName); err != nil {
+			return nil, fmt.Errorf("retrieve the deployed parameters of stack %s: %w", stackName, err)
+		}
+		if oldAddonsTpl, err = d.stackDescriber.WorkloadAddonsTemplate(stackName); err != nil {
+			return nil, fmt.Errorf("retrieve the deployed addons template of stack %s: %w", stackName, err)
+		}
+	}
+	diff, err := computeTemplateDiff(oldTpl, tpl, oldParams, params)
+	if err != nil {
+		return nil, err
+	}
+	diff.NewStack = newStack
+	newAddonsTpl, err := d.templater.Template()
+	if err != nil {
+		var notFoundErr *addon.ErrAddonsNotFound
+		if !errors.As(err, &notFoundErr) {
+			return nil, fmt.Errorf("retrieve addons template: %w", err)
+		}
+	}
+	if oldAddonsTpl == "" && newAddonsTpl == "" {
+		return diff, nil
+	}
+	if diff.Addons, err = computeResourcesDiff(oldAddonsTpl, newAddonsTpl); err != nil {
+		return nil, fmt.Errorf("compare addons templates: %w", err)
+	}
+	return diff, nil
+}
+
+// stackToDeploy returns the stack built from the packaged template if there is one, otherwise the generated stack configuration.
+func stackToDeploy(conf cloudformation.StackConfiguration, packaged *deploy.PackagedTemplate) (cloudformation.StackConfiguration, error) {
+	if packaged == nil {
+		return conf, nil
+	}
+	packagedStack, err := stack.NewPackagedStack(conf.StackName(), packaged)
+	if err != nil {
+		return nil, fmt.Errorf("read packaged template: %w", err)
+	}
+	return packagedStack, nil
 }
 
 func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackConfigurationOutput) error {
@@ -765,10 +1093,27 @@ func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackCo
 	if deployOptions.DisableRollback {
 		opts = append(opts, awscloudformation.WithDisableRollback())
 	}
+	conf, err := stackToDeploy(stackConfigOutput.conf, deployOptions.Packaged)
+	if err != nil {
+		return err
+	}
+	if err := d.validateTemplate(conf); err != nil {
+		return err
+	}
+	if err := d.warnPlatformVersion(conf.StackName()); err != nil {
+		return err
+	}
+	if err := d.deleteRolledBackStack(deployOptions, conf.StackName()); err != nil {
+		return err
+	}
+	if deployOptions.NoWait {
+		return d.deployNoWait(conf, opts...)
+	}
 	cmdRunAt := d.now()
-	if err := d.deployer.DeployService(os.Stderr, stackConfigOutput.conf, d.resources.S3Bucket, opts...); err != nil {
+	if err := d.deployer.DeployService(d.progressOut, conf, d.resources.S3Bucket, opts...); err != nil {
 		var errEmptyCS *awscloudformation.ErrChangeSetEmpty
 		if !errors.As(err, &errEmptyCS) {
+			d.diagnoseFailedDeployment(stackConfigOutput.diagnoser, cmdRunAt)
 			return fmt.Errorf("deploy service: %w", err)
 		}
 		if !deployOptions.ForceNewUpdate {
@@ -777,10 +1122,10 @@ func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackCo
 		}
 	}
 	// Force update the service if --force is set and the service is not updated by the CFN.
-	if deployOptions.ForceNewUpdate {
-		lastUpdatedAt, err := stackConfigOutput.svcUpdater.LastUpdatedAt(d.app.Name, d.env.Name, d.name)
+	if deployOptions.ForceNewUpdate && stackConfigOutput.svcUpdater != nil {
+		lastUpdatedAt, err := stackConfigOutput.svcUpdater.LastUpdatedAt(d.app.Name, d.env.Name, d.workloadName())
 		if err != nil {
-			return fmt.Errorf("get the last updated deployment time for %s: %w", d.name, err)
+			return fmt.Errorf("get the last updated deployment time for %s: %w", d.workloadName(), err)
 		}
 		if cmdRunAt.After(lastUpdatedAt) {
 			if err := d.forceDeploy(&forceDeployInput{
@@ -794,25 +1139,48 @@ func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackCo
 	return nil
 }
 
+// diagnoseFailedDeployment writes why the tasks started by a failed deployment stopped.
+// Failing to diagnose the deployment only logs a warning, since the deployment error is more relevant.
+func (d *svcDeployer) diagnoseFailedDeployment(diagnoser deploymentDiagnoser, since time.Time) {
+	if diagnoser == nil {
+		return
+	}
+	if err := diagnoser.Diagnose(since); err != nil {
+		log.Warningf("Failed to explain why the tasks of service %s stopped: %v\n", d.workloadName(), err)
+	}
+}
+
+// deployNoWait starts updating the service stack and logs the change set to follow, without waiting for the update to complete.
+func (d *svcDeployer) deployNoWait(conf cloudformation.StackConfiguration, opts ...awscloudformation.StackOption) error {
+	changeSetID, err := d.deployer.DeployServiceNoWait(d.progressOut, conf, d.resources.S3Bucket, opts...)
+	if err != nil {
+		return fmt.Errorf("deploy service: %w", err)
+	}
+	log.Successf("Started deploying service %s.\n", color.HighlightUserInput(d.workloadName()))
+	log.Infof("  - Stack: %s\n", conf.StackName())
+	log.Infof("  - Change set: %s\n", changeSetID)
+	return nil
+}
+
 type forceDeployInput struct {
 	spinner    spinner
 	svcUpdater serviceForceUpdater
 }
 
 func (d *workloadDeployer) forceDeploy(in *forceDeployInput) error {
-	in.spinner.Start(fmt.Sprintf(fmtForceUpdateSvcStart, color.HighlightUserInput(d.name), color.HighlightUserInput(d.env.Name)))
-	if err := in.svcUpdater.ForceUpdateService(d.app.Name, d.env.Name, d.name); err != nil {
-		errLog := fmt.Sprintf(fmtForceUpdateSvcFailed, color.HighlightUserInput(d.name),
+	in.spinner.Start(fmt.Sprintf(fmtForceUpdateSvcStart, color.HighlightUserInput(d.workloadName()), color.HighlightUserInput(d.env.Name)))
+	if err := in.svcUpdater.ForceUpdateService(d.app.Name, d.env.Name, d.workloadName()); err != nil {
+		errLog := fmt.Sprintf(fmtForceUpdateSvcFailed, color.HighlightUserInput(d.workloadName()),
 			color.HighlightUserInput(d.env.Name), err)
 		var terr timeoutError
 		if errors.As(err, &terr) {
 			errLog = fmt.Sprintf("%s  Run %s to check for the fail reason.\n", errLog,
-				color.HighlightCode(fmt.Sprintf("copilot svc status --name %s --env %s", d.name, d.env.Name)))
+				color.HighlightCode(fmt.Sprintf("copilot svc status --name %s --env %s", d.workloadName(), d.env.Name)))
 		}
 		in.spinner.Stop(log.Serror(errLog))
-		return fmt.Errorf("force an update for service %s: %w", d.name, err)
+		return fmt.Errorf("force an update for service %s: %w", d.workloadName(), err)
 	}
-	in.spinner.Stop(log.Ssuccessf(fmtForceUpdateSvcComplete, color.HighlightUserInput(d.name), color.HighlightUserInput(d.env.Name)))
+	in.spinner.Stop(log.Ssuccessf(fmtForceUpdateSvcComplete, color.HighlightUserInput(d.workloadName()), color.HighlightUserInput(d.env.Name)))
 	return nil
 }
 
@@ -837,12 +1205,23 @@ func (d *workloadDeployer) uploadContainerImage(imgBuilderPusher imageBuilderPus
 	if !required {
 		return nil, nil
 	}
+	if d.imageDigest != "" {
+		// The image was already pushed to the ECR repo, for example by a separate build pipeline.
+		return aws.String(d.imageDigest), nil
+	}
 	// If it is built from local Dockerfile, build and push to the ECR repo.
-	buildArg, err := buildArgs(d.name, d.imageTag, d.workspacePath, d.mft)
+	buildArg, err := buildArgs(d.name, d.resources.WorkloadImageTag(d.name, d.imageTag), d.workspacePath, d.mft)
 	if err != nil {
 		return nil, err
 	}
-	digest, err := imgBuilderPusher.BuildAndPush(dockerengine.New(exec.NewCmd()), buildArg)
+	for _, tag := range d.extraTags {
+		buildArg.Tags = append(buildArg.Tags, d.resources.WorkloadImageTag(d.name, tag))
+	}
+	if d.resources.SharedRepositoryURL != "" {
+		// Images in a shared repository always carry a tag with the workload name so that they can be deleted with the workload.
+		buildArg.Tags = append(buildArg.Tags, stack.SharedRepositoryImageTag(d.name, "latest"))
+	}
+	digest, err := imgBuilderPusher.BuildAndPush(dockerengine.New(exec.NewCmdWithOutput(d.progressOut)), buildArg)
 	if err != nil {
 		return nil, fmt.Errorf("build and push image: %w", err)
 	}
@@ -905,7 +1284,7 @@ func (d *workloadDeployer) uploadArtifacts(customResources customResourcesFunc)
 		return nil, err
 	}
 	urls, err := customresource.Upload(func(key string, contents io.Reader) (string, error) {
-		return d.s3Client.Upload(d.resources.S3Bucket, key, contents)
+		return d.s3Client.UploadIfNotExists(d.resources.S3Bucket, key, contents)
 	}, crs)
 	if err != nil {
 		return nil, fmt.Errorf("upload custom resources for %q: %w", d.name, err)
@@ -962,7 +1341,8 @@ func (d *workloadDeployer) pushAddonsTemplateToS3Bucket(in *pushAddonsTemplateTo
 		return "", fmt.Errorf("retrieve addons template: %w", err)
 	}
 	reader := strings.NewReader(tmpl)
-	url, err := in.uploader.Upload(d.resources.S3Bucket, artifactpath.Addons(d.name, []byte(tmpl)), reader)
+	// The key embeds the hash of the template, so an unchanged template is already stored under it.
+	url, err := in.uploader.UploadIfNotExists(d.resources.S3Bucket, artifactpath.Addons(d.name, []byte(tmpl)), reader)
 	if err != nil {
 		return "", fmt.Errorf("put addons artifact to bucket %s: %w", d.resources.S3Bucket, err)
 	}
@@ -974,6 +1354,17 @@ func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.
 	if err != nil {
 		return nil, fmt.Errorf("get service discovery endpoint: %w", err)
 	}
+	names := deploy.ResourceNames{Prefix: d.app.ResourcePrefix}
+	if err := names.ValidateWorkload(d.app.Name, d.env.Name, d.name); err != nil {
+		return nil, fmt.Errorf("validate resource names: %w", err)
+	}
+	var deployment *stack.DeploymentMetadata
+	if in.Deployer != "" {
+		deployment = &stack.DeploymentMetadata{
+			Deployer: in.Deployer,
+			Commit:   in.Commit,
+		}
+	}
 	if in.ImageDigest == nil {
 		return &stack.RuntimeConfig{
 			AddonsTemplateURL:        in.AddonsURL,
@@ -983,6 +1374,8 @@ func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.
 			AccountID:                d.env.AccountID,
 			Region:                   d.env.Region,
 			CustomResourcesURL:       in.CustomResourceURLs,
+			ResourcePrefix:           d.app.ResourcePrefix,
+			Deployment:               deployment,
 		}, nil
 	}
 	return &stack.RuntimeConfig{
@@ -990,20 +1383,78 @@ func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.
 		EnvFileARN:        in.EnvFileARN,
 		AdditionalTags:    in.Tags,
 		Image: &stack.ECRImage{
-			RepoURL:  d.resources.RepositoryURLs[d.name],
-			ImageTag: d.imageTag,
+			RepoURL:  d.resources.WorkloadRepositoryURL(d.name),
+			ImageTag: d.resources.WorkloadImageTag(d.name, d.imageTag),
 			Digest:   aws.StringValue(in.ImageDigest),
 		},
 		ServiceDiscoveryEndpoint: endpoint,
 		AccountID:                d.env.AccountID,
 		Region:                   d.env.Region,
 		CustomResourcesURL:       in.CustomResourceURLs,
+		ResourcePrefix:           d.app.ResourcePrefix,
+		Deployment:               deployment,
 	}, nil
 }
 
+// placementSubnetIDs validates the subnets that the workload's tasks are placed in against the subnets of the environment.
+// If the tasks are placed by availability zones, it returns the environment subnets of the placement type in those zones.
+func (d *workloadDeployer) placementSubnetIDs(placement manifest.PlacementArgOrString) ([]string, error) {
+	if placement.IsEmpty() || placement.PlacementString != nil {
+		return nil, nil
+	}
+	outputs, err := d.envOutputs.Outputs()
+	if err != nil {
+		return nil, fmt.Errorf("get stack outputs of environment %s: %w", d.env.Name, err)
+	}
+	if len(placement.Subnets) != 0 {
+		envSubnets := append(splitSubnetIDs(outputs[stack.EnvOutputPublicSubnets]), splitSubnetIDs(outputs[stack.EnvOutputPrivateSubnets])...)
+		for _, subnet := range placement.Subnets {
+			if !contains(subnet, envSubnets) {
+				return nil, fmt.Errorf("subnet %s in %q is not a subnet of environment %s", subnet, "network.vpc.placement.subnets", d.env.Name)
+			}
+		}
+		return nil, nil
+	}
+	subnetType := placement.SubnetType()
+	output := stack.EnvOutputPublicSubnets
+	if subnetType == manifest.PrivateSubnetPlacement {
+		output = stack.EnvOutputPrivateSubnets
+	}
+	envSubnets := splitSubnetIDs(outputs[output])
+	if len(envSubnets) == 0 {
+		return nil, fmt.Errorf("environment %s does not have %s subnets", d.env.Name, subnetType)
+	}
+	var ids []string
+	for _, az := range placement.AZs {
+		azSubnets, err := d.subnetGetter.SubnetIDs(ec2.Filter{
+			Name:   "subnet-id",
+			Values: envSubnets,
+		}, ec2.Filter{
+			Name:   "availability-zone",
+			Values: []string{az},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get %s subnets of environment %s in availability zone %s: %w", subnetType, d.env.Name, az, err)
+		}
+		if len(azSubnets) == 0 {
+			return nil, fmt.Errorf("environment %s does not have %s subnets in availability zone %s", d.env.Name, subnetType, az)
+		}
+		ids = append(ids, azSubnets...)
+	}
+	return ids, nil
+}
+
+func splitSubnetIDs(ids string) []string {
+	if ids == "" {
+		return nil
+	}
+	return strings.Split(ids, ",")
+}
+
 type svcStackConfigurationOutput struct {
 	conf       cloudformation.StackConfiguration
 	svcUpdater serviceForceUpdater
+	diagnoser  deploymentDiagnoser // Nil if the service doesn't run ECS tasks.
 }
 
 func (d *lbWebSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*svcStackConfigurationOutput, error) {
@@ -1011,12 +1462,27 @@ func (d *lbWebSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*s
 	if err != nil {
 		return nil, err
 	}
+	if rc.PlacementSubnetIDs, err = d.placementSubnetIDs(d.lbMft.Network.VPC.Placement); err != nil {
+		return nil, err
+	}
 	if err := d.validateALBRuntime(); err != nil {
 		return nil, err
 	}
 	if err := d.validateNLBRuntime(); err != nil {
 		return nil, err
 	}
+	var svcUpdater serviceForceUpdater
+	if d.lbMft.DeployConfig.IsBlueGreen() {
+		// CodeDeploy owns the deployments of the service, so the service can't be force updated through ECS.
+		if err := d.retrieveDeployedOutputs(); err != nil {
+			return nil, err
+		}
+		rc.DeployedTaskDefinitionARN = d.deployedOutputs[stack.LBWebServiceOutputServiceTaskDefinition]
+	} else {
+		svcUpdater = d.newSvcUpdater(func(s *session.Session) serviceForceUpdater {
+			return ecs.New(s)
+		})
+	}
 	var opts []stack.LoadBalancedWebServiceOption
 	if !d.lbMft.NLBConfig.IsEmpty() {
 		cidrBlocks, err := d.publicCIDRBlocksGetter.PublicCIDRBlocks()
@@ -1041,18 +1507,39 @@ func (d *lbWebSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*s
 		return nil, fmt.Errorf("create stack configuration: %w", err)
 	}
 	return &svcStackConfigurationOutput{
-		conf: conf,
-		svcUpdater: d.newSvcUpdater(func(s *session.Session) serviceForceUpdater {
-			return ecs.New(s)
-		}),
+		conf:       conf,
+		svcUpdater: svcUpdater,
+		diagnoser:  d.diagnoser,
 	}, nil
 }
 
+// retrieveDeployedOutputs caches the outputs of the service stack before it gets updated.
+// The outputs are empty if the service was never deployed.
+func (d *lbWebSvcDeployer) retrieveDeployedOutputs() error {
+	if d.deployedOutputs != nil {
+		return nil
+	}
+	stackName := stack.NameForService(d.app.Name, d.env.Name, d.workloadName())
+	outputs, err := d.stackDescriber.WorkloadOutputs(stackName)
+	var errNotFound *awscloudformation.ErrStackNotFound
+	switch {
+	case errors.As(err, &errNotFound):
+		outputs = make(map[string]string)
+	case err != nil:
+		return fmt.Errorf("get outputs of stack %s: %w", stackName, err)
+	}
+	d.deployedOutputs = outputs
+	return nil
+}
+
 func (d *backendSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*svcStackConfigurationOutput, error) {
 	rc, err := d.runtimeConfig(in)
 	if err != nil {
 		return nil, err
 	}
+	if rc.PlacementSubnetIDs, err = d.placementSubnetIDs(d.backendMft.Network.VPC.Placement); err != nil {
+		return nil, err
+	}
 	if err := d.validateALBRuntime(); err != nil {
 		return nil, err
 	}
@@ -1076,6 +1563,7 @@ func (d *backendSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (
 		svcUpdater: d.newSvcUpdater(func(s *session.Session) serviceForceUpdater {
 			return ecs.New(s)
 		}),
+		diagnoser: d.diagnoser,
 	}, nil
 }
 
@@ -1100,6 +1588,7 @@ func (d *rdwsDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*rdwsS
 			Name:                d.app.Name,
 			Domain:              d.app.Domain,
 			AccountPrincipalARN: in.RootUserARN,
+			ResourcePrefix:      d.app.ResourcePrefix,
 		},
 		Env:           d.env.Name,
 		Manifest:      d.rdwsMft,
@@ -1120,7 +1609,7 @@ func (d *rdwsDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*rdwsS
 		}, nil
 	}
 
-	if err = validateRDSvcAliasAndAppVersion(d.name,
+	if err = validateRDSvcAliasAndAppVersion(d.workloadName(),
 		aws.StringValue(d.rdwsMft.Alias), d.env.Name, d.app, d.appVersionGetter); err != nil {
 		return nil, err
 	}
@@ -1145,6 +1634,9 @@ func (d *workerSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*
 	if err != nil {
 		return nil, err
 	}
+	if rc.PlacementSubnetIDs, err = d.placementSubnetIDs(d.wsMft.Network.VPC.Placement); err != nil {
+		return nil, err
+	}
 	var topics []deploy.Topic
 	topics, err = d.topicLister.ListSNSTopics(d.app.Name, d.env.Name)
 	if err != nil {
@@ -1174,6 +1666,7 @@ func (d *workerSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*
 			svcUpdater: d.newSvcUpdater(func(s *session.Session) serviceForceUpdater {
 				return ecs.New(s)
 			}),
+			diagnoser: d.diagnoser,
 		},
 		subscriptions: subs,
 	}, nil
@@ -1188,6 +1681,9 @@ func (d *jobDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*jobSta
 	if err != nil {
 		return nil, err
 	}
+	if rc.PlacementSubnetIDs, err = d.placementSubnetIDs(d.jobMft.Network.VPC.Placement); err != nil {
+		return nil, err
+	}
 	conf, err := stack.NewScheduledJob(stack.ScheduledJobConfig{
 		App:           d.app.Name,
 		Env:           d.env.Name,
@@ -1217,7 +1713,7 @@ func buildArgs(name, imageTag, workspacePath string, unmarshaledManifest interfa
 		tags = append(tags, imageTag)
 	}
 	args := mf.BuildArgs(workspacePath)
-	return &dockerengine.BuildArguments{
+	buildArgs := &dockerengine.BuildArguments{
 		Dockerfile: *args.Dockerfile,
 		Context:    *args.Context,
 		Args:       args.Args,
@@ -1225,7 +1721,18 @@ func buildArgs(name, imageTag, workspacePath string, unmarshaledManifest interfa
 		Target:     aws.StringValue(args.Target),
 		Platform:   mf.ContainerPlatform(),
 		Tags:       tags,
-	}, nil
+	}
+	if multiArch, ok := unmarshaledManifest.(interface{ ImagePlatforms() []string }); ok && len(multiArch.ImagePlatforms()) != 0 {
+		// A multi-platform image is built for every platform instead of the platform of the container.
+		buildArgs.Platform = ""
+		buildArgs.Platforms = multiArch.ImagePlatforms()
+	}
+	return buildArgs, nil
+}
+
+type platformVersionPinner interface {
+	PinnedPlatformVersion() string
+	HasDeprecatedPlatformVersion() bool
 }
 
 func envFile(unmarshaledManifest interface{}) string {
@@ -1322,7 +1829,7 @@ func (d *backendSvcDeployer) validateALBRuntime() error {
 	switch {
 	case d.backendMft.RoutingRule.Alias.IsEmpty() && hasImportedCerts:
 		return &errSvcWithNoALBAliasDeployingToEnvWithImportedCerts{
-			name:    d.name,
+			name:    d.workloadName(),
 			envName: d.env.Name,
 		}
 	case d.backendMft.RoutingRule.Alias.IsEmpty():
@@ -1359,7 +1866,7 @@ func (d *lbWebSvcDeployer) validateALBRuntime() error {
 	if d.lbMft.RoutingRule.Alias.IsEmpty() {
 		if hasImportedCerts {
 			return &errSvcWithNoALBAliasDeployingToEnvWithImportedCerts{
-				name:    d.name,
+				name:    d.workloadName(),
 				envName: d.env.Name,
 			}
 		}
@@ -1426,6 +1933,12 @@ func validateLBWSAlias(aliases manifest.Alias, app *config.Application, envName
 		return fmt.Errorf(`convert 'http.alias' to string slice: %w`, err)
 	}
 	for _, alias := range aliasList {
+		if strings.HasPrefix(alias, "*.") {
+			if err := validateWildcardAliasCoverage(alias, app, envName); err != nil {
+				return err
+			}
+			continue
+		}
 		// Alias should be within either env, app, or root hosted zone.
 		var regEnvHostedZone, regAppHostedZone, regRootHostedZone *regexp.Regexp
 		var err error
@@ -1463,6 +1976,16 @@ func validateLBWSAlias(aliases manifest.Alias, app *config.Application, envName
 	return nil
 }
 
+// validateWildcardAliasCoverage returns an error if a wildcard alias is not covered by the certificate of the environment,
+// which is issued for "<env>.<app>.<domain>" and "*.<env>.<app>.<domain>".
+func validateWildcardAliasCoverage(alias string, app *config.Application, envName string) error {
+	envDomain := fmt.Sprintf("%s.%s.%s", envName, app.Name, app.Domain)
+	if alias == "*."+envDomain {
+		return nil
+	}
+	return fmt.Errorf(`wildcard alias "%s" is not covered by the certificate of environment %s: use "*.%s" instead`, alias, envName, envDomain)
+}
+
 func checkUnsupportedRDSvcAlias(alias, envName string, app *config.Application) error {
 	var regEnvHostedZone, regAppHostedZone *regexp.Regexp
 	var err error
@@ -22,16 +22,21 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/progress"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
 )
@@ -44,16 +49,19 @@ type deployMocks struct {
 	mockSNSTopicsLister        *mocks.MocksnsTopicsLister
 	mockServiceDeployer        *mocks.MockserviceDeployer
 	mockServiceForceUpdater    *mocks.MockserviceForceUpdater
+	mockDiagnoser              *mocks.MockdeploymentDiagnoser
 	mockTemplater              *mocks.Mocktemplater
 	mockUploader               *mocks.Mockuploader
 	mockVersionGetter          *mocks.MockversionGetter
 	mockFileReader             *mocks.MockfileReader
 	mockValidator              *mocks.MockaliasCertValidator
+	mockTemplateValidator      *mocks.MocktemplateValidator
 }
 
 type mockWorkloadMft struct {
 	fileName      string
 	buildRequired bool
+	platforms     []string
 }
 
 func (m *mockWorkloadMft) EnvFile() string {
@@ -75,6 +83,10 @@ func (m *mockWorkloadMft) ContainerPlatform() string {
 	return "mockContainerPlatform"
 }
 
+func (m *mockWorkloadMft) ImagePlatforms() []string {
+	return m.platforms
+}
+
 type mockTemplateFS struct {
 	read func(path string) (*template.Content, error)
 }
@@ -122,6 +134,10 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 		inEnvFile       string
 		inBuildRequired bool
 		inRegion        string
+		inSharedRepoURL string
+		inExtraTags     []string
+		inImageDigest   string
+		inPlatforms     []string
 
 		mock                func(t *testing.T, m *deployMocks)
 		mockServiceDeployer func(deployer *workloadDeployer) artifactsUploader
@@ -159,6 +175,66 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 			},
 			wantImageDigest: aws.String("mockDigest"),
 		},
+		"skip building the image if its digest is provided": {
+			inBuildRequired: true,
+			inImageDigest:   "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+			mock: func(t *testing.T, m *deployMocks) {
+				m.mockImageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0)
+				m.mockTemplater.EXPECT().Template().Return("", &addon.ErrAddonsNotFound{
+					WlName: "mockWkld",
+				})
+			},
+			wantImageDigest: aws.String("sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807"),
+		},
+		"build and push image with additional tags": {
+			inBuildRequired: true,
+			inExtraTags:     []string{"main", "latest"},
+			mock: func(t *testing.T, m *deployMocks) {
+				m.mockImageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+					Dockerfile: "mockDockerfile",
+					Context:    "mockContext",
+					Platform:   "mockContainerPlatform",
+					Tags:       []string{mockImageTag, "main", "latest"},
+				}).Return("mockDigest", nil)
+				m.mockTemplater.EXPECT().Template().Return("", &addon.ErrAddonsNotFound{
+					WlName: "mockWkld",
+				})
+			},
+			wantImageDigest: aws.String("mockDigest"),
+		},
+		"build and push a multi-platform image": {
+			inBuildRequired: true,
+			inPlatforms:     []string{"linux/amd64", "linux/arm64"},
+			mock: func(t *testing.T, m *deployMocks) {
+				m.mockImageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+					Dockerfile: "mockDockerfile",
+					Context:    "mockContext",
+					Platforms:  []string{"linux/amd64", "linux/arm64"},
+					Tags:       []string{mockImageTag},
+				}).Return("mockDigest", nil)
+				m.mockTemplater.EXPECT().Template().Return("", &addon.ErrAddonsNotFound{
+					WlName: "mockWkld",
+				})
+			},
+			wantImageDigest: aws.String("mockDigest"),
+		},
+		"build and push image with namespaced tags to a shared repository": {
+			inBuildRequired: true,
+			inSharedRepoURL: "mockSharedRepoURL",
+			inExtraTags:     []string{"main"},
+			mock: func(t *testing.T, m *deployMocks) {
+				m.mockImageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+					Dockerfile: "mockDockerfile",
+					Context:    "mockContext",
+					Platform:   "mockContainerPlatform",
+					Tags:       []string{"mockWkld-mockImageTag", "mockWkld-main", "mockWkld-latest"},
+				}).Return("mockDigest", nil)
+				m.mockTemplater.EXPECT().Template().Return("", &addon.ErrAddonsNotFound{
+					WlName: "mockWkld",
+				})
+			},
+			wantImageDigest: aws.String("mockDigest"),
+		},
 		"should retrieve Load Balanced Web Service custom resource URLs": {
 			mock: func(t *testing.T, m *deployMocks) {
 				// Ignore addon uploads.
@@ -167,7 +243,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				// Ensure all custom resources were uploaded.
 				crs, err := customresource.LBWS(fakeTemplateFS())
 				require.NoError(t, err)
-				m.mockUploader.EXPECT().Upload(mockS3Bucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader) (url string, err error) {
+				m.mockUploader.EXPECT().UploadIfNotExists(mockS3Bucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader) (url string, err error) {
 					for _, cr := range crs {
 						if strings.Contains(key, strings.ToLower(cr.FunctionName())) {
 							return "", nil
@@ -193,7 +269,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				// Ensure all custom resources were uploaded.
 				crs, err := customresource.Backend(fakeTemplateFS())
 				require.NoError(t, err)
-				m.mockUploader.EXPECT().Upload(mockS3Bucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader) (url string, err error) {
+				m.mockUploader.EXPECT().UploadIfNotExists(mockS3Bucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader) (url string, err error) {
 					for _, cr := range crs {
 						if strings.Contains(key, strings.ToLower(cr.FunctionName())) {
 							return "", nil
@@ -219,7 +295,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				// Ensure all custom resources were uploaded.
 				crs, err := customresource.Worker(fakeTemplateFS())
 				require.NoError(t, err)
-				m.mockUploader.EXPECT().Upload(mockS3Bucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader) (url string, err error) {
+				m.mockUploader.EXPECT().UploadIfNotExists(mockS3Bucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader) (url string, err error) {
 					for _, cr := range crs {
 						if strings.Contains(key, strings.ToLower(cr.FunctionName())) {
 							return "", nil
@@ -245,7 +321,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				// Ensure all custom resources were uploaded.
 				crs, err := customresource.RDWS(fakeTemplateFS())
 				require.NoError(t, err)
-				m.mockUploader.EXPECT().Upload(mockS3Bucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader) (url string, err error) {
+				m.mockUploader.EXPECT().UploadIfNotExists(mockS3Bucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader) (url string, err error) {
 					for _, cr := range crs {
 						if strings.Contains(key, strings.ToLower(cr.FunctionName())) {
 							return "", nil
@@ -271,7 +347,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				// Ensure all custom resources were uploaded.
 				crs, err := customresource.ScheduledJob(fakeTemplateFS())
 				require.NoError(t, err)
-				m.mockUploader.EXPECT().Upload(mockS3Bucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader) (url string, err error) {
+				m.mockUploader.EXPECT().UploadIfNotExists(mockS3Bucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader) (url string, err error) {
 					for _, cr := range crs {
 						if strings.Contains(key, strings.ToLower(cr.FunctionName())) {
 							return "", nil
@@ -332,7 +408,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				m.mockUploader.EXPECT().Upload(mockS3Bucket, mockEnvFilePath, gomock.Any()).
 					Return(mockEnvFileS3URL, nil)
 				m.mockTemplater.EXPECT().Template().Return("some data", nil)
-				m.mockUploader.EXPECT().Upload(mockS3Bucket, mockAddonPath, gomock.Any()).
+				m.mockUploader.EXPECT().UploadIfNotExists(mockS3Bucket, mockAddonPath, gomock.Any()).
 					Return(mockAddonsS3URL, nil)
 			},
 
@@ -343,7 +419,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 			inRegion: "us-west-2",
 			mock: func(t *testing.T, m *deployMocks) {
 				m.mockTemplater.EXPECT().Template().Return("some data", nil)
-				m.mockUploader.EXPECT().Upload(mockS3Bucket, mockAddonPath, gomock.Any()).
+				m.mockUploader.EXPECT().UploadIfNotExists(mockS3Bucket, mockAddonPath, gomock.Any()).
 					Return("", mockError)
 			},
 
@@ -386,12 +462,18 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				app: &config.Application{
 					Name: mockAppName,
 				},
-				resources:     mockResources,
+				resources: &stack.AppRegionalResources{
+					S3Bucket:            mockResources.S3Bucket,
+					SharedRepositoryURL: tc.inSharedRepoURL,
+				},
 				imageTag:      mockImageTag,
+				extraTags:     tc.inExtraTags,
+				imageDigest:   tc.inImageDigest,
 				workspacePath: mockWorkspacePath,
 				mft: &mockWorkloadMft{
 					fileName:      tc.inEnvFile,
 					buildRequired: tc.inBuildRequired,
+					platforms:     tc.inPlatforms,
 				},
 
 				templater:          m.mockTemplater,
@@ -455,12 +537,18 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 	mockBeforeTime := time.Unix(1494505743, 0)
 	mockAfterTime := time.Unix(1494505756, 0)
 	tests := map[string]struct {
-		inAliases         manifest.Alias
-		inNLB             manifest.NetworkLoadBalancerConfiguration
-		inApp             *config.Application
-		inEnvironment     *config.Environment
-		inForceDeploy     bool
-		inDisableRollback bool
+		inAliases                 manifest.Alias
+		inNLB                     manifest.NetworkLoadBalancerConfiguration
+		inApp                     *config.Application
+		inEnvironment             *config.Environment
+		inForceDeploy             bool
+		inDisableRollback         bool
+		inNoWait                  bool
+		inInstance                string
+		inDeployStrategy          *string
+		inRecreateRolledBackStack bool
+		inPackaged                *deploy.PackagedTemplate
+		inGuardRules              bool
 
 		// Cached variables.
 		inEnvironmentConfig func() *manifest.Environment
@@ -475,6 +563,20 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 			},
 			wantErr: fmt.Errorf("get service discovery endpoint: some error"),
 		},
+		"fail if the log group name under the naming convention is too long": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name:           strings.Repeat("a", 500),
+				ResourcePrefix: "acme-",
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+			},
+			wantErr: fmt.Errorf("validate resource names: log group name /copilot/acme-%s-mockEnv-mockWkld exceeds the maximum length of 512 characters", strings.Repeat("a", 500)),
+		},
 		"fail if alias is not specified with env has imported certs": {
 			inEnvironment: &config.Environment{
 				Name:   mockEnvName,
@@ -656,6 +758,43 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 			},
 			wantErr: fmt.Errorf(`alias "v1.v2.mockDomain" is not supported in hosted zones managed by Copilot`),
 		},
+		"fail to enable https alias because the wildcard alias is not covered by the environment certificate": {
+			inAliases: manifest.Alias{AdvancedAliases: []manifest.AdvancedAlias{
+				{Alias: aws.String("*.mockApp.mockDomain")},
+			}},
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+			},
+			wantErr: fmt.Errorf(`wildcard alias "*.mockApp.mockDomain" is not covered by the certificate of environment mockEnv: use "*.mockEnv.mockApp.mockDomain" instead`),
+		},
+		"deploy with a wildcard alias covered by the environment certificate": {
+			inNoWait: true,
+			inAliases: manifest.Alias{AdvancedAliases: []manifest.AdvancedAlias{
+				{Alias: aws.String("*.mockEnv.mockApp.mockDomain")},
+			}},
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().DeployServiceNoWait(gomock.Any(), gomock.Any(), "mockBucket", gomock.Any()).Return("mockChangeSetID", nil)
+			},
+		},
 		"fail to enable nlb alias because of invalid alias": {
 			inNLB: manifest.NetworkLoadBalancerConfiguration{
 				Port: aws.String("80"),
@@ -688,9 +827,84 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 			mock: func(m *deployMocks) {
 				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), "mockBucket", gomock.Any()).Return(errors.New("some error"))
+				m.mockDiagnoser.EXPECT().Diagnose(mockNowTime).Return(nil)
+			},
+			wantErr: fmt.Errorf("deploy service: some error"),
+		},
+		"error if the template violates the cfn-guard rules": {
+			inGuardRules: true,
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockTemplateValidator.EXPECT().Validate(mockName, gomock.Any()).Return(errors.New("some error"))
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantErr: fmt.Errorf("validate stack template: some error"),
+		},
+		"error if fail to deploy service even if the stopped tasks can't be diagnosed": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), "mockBucket", gomock.Any()).Return(errors.New("some error"))
+				m.mockDiagnoser.EXPECT().Diagnose(mockNowTime).Return(errors.New("access denied"))
 			},
 			wantErr: fmt.Errorf("deploy service: some error"),
 		},
+		"error if fail to delete the rolled back stack": {
+			inRecreateRolledBackStack: true,
+			inEnvironmentConfig: func() *manifest.Environment {
+				envConfig := &manifest.Environment{}
+				envConfig.Name = aws.String(mockEnvName)
+				return envConfig
+			},
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().DeleteRolledBackStack("mockApp-mockEnv-mockWkld").Return(errors.New("some error"))
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantErr: fmt.Errorf("delete rolled back stack mockApp-mockEnv-mockWkld: some error"),
+		},
+		"delete the rolled back stack before deploying the service": {
+			inRecreateRolledBackStack: true,
+			inEnvironmentConfig: func() *manifest.Environment {
+				envConfig := &manifest.Environment{}
+				envConfig.Name = aws.String(mockEnvName)
+				return envConfig
+			},
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				gomock.InOrder(
+					m.mockServiceDeployer.EXPECT().DeleteRolledBackStack("mockApp-mockEnv-mockWkld").Return(nil),
+					m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), "mockBucket", gomock.Any()).Return(nil),
+				)
+			},
+		},
 		"error if change set is empty but force flag is not set": {
 			inEnvironment: &config.Environment{
 				Name:   mockEnvName,
@@ -705,6 +919,51 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 			},
 			wantErr: fmt.Errorf("deploy service: change set with name mockChangeSet for stack mockStack has no changes"),
 		},
+		"error if fail to start the deployment without waiting": {
+			inNoWait: true,
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().DeployServiceNoWait(gomock.Any(), gomock.Any(), "mockBucket", gomock.Any()).Return("", mockError)
+			},
+			wantErr: fmt.Errorf("deploy service: some error"),
+		},
+		"start the deployment without waiting for it to complete": {
+			inNoWait: true,
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().DeployServiceNoWait(gomock.Any(), gomock.Any(), "mockBucket", gomock.Any()).Return("mockChangeSetID", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"error if the blue/green deployment is not awaited": {
+			inNoWait:         true,
+			inDeployStrategy: aws.String(manifest.ECSBlueGreenDeploymentStrategy),
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockServiceDeployer.EXPECT().DeployServiceNoWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantErr: fmt.Errorf("service mockWkld uses blue/green deployments that must be awaited to shift traffic"),
+		},
 		"error if fail to get last update time when force an update": {
 			inForceDeploy: true,
 			inEnvironment: &config.Environment{
@@ -740,6 +999,27 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 					Return(mockAfterTime, nil)
 			},
 		},
+		"force an update of the ECS service of the instance": {
+			inForceDeploy: true,
+			inInstance:    "tenant-a",
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), "mockBucket", gomock.Any()).
+					Return(nil)
+				m.mockServiceForceUpdater.EXPECT().LastUpdatedAt(mockAppName, mockEnvName, "mockWkld-tenant-a").
+					Return(mockBeforeTime, nil)
+				m.mockSpinner.EXPECT().Start(gomock.Any())
+				m.mockServiceForceUpdater.EXPECT().ForceUpdateService(mockAppName, mockEnvName, "mockWkld-tenant-a").Return(nil)
+				m.mockSpinner.EXPECT().Stop(gomock.Any())
+			},
+		},
 		"error if fail to force an update": {
 			inForceDeploy: true,
 			inEnvironment: &config.Environment{
@@ -823,6 +1103,48 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
 			},
 		},
+		"fail if the packaged template configuration is invalid": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			inPackaged: &deploy.PackagedTemplate{
+				Template:      "Resources: {}",
+				Configuration: "{",
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantErr: errors.New("read packaged template: unmarshal template configuration of stack mockApp--mockWkld: unexpected end of JSON input"),
+		},
+		"success with a packaged template": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			inPackaged: &deploy.PackagedTemplate{
+				Template:      "Resources: {}",
+				Configuration: `{"Parameters": {"EnvName": "mockEnv"}}`,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), "mockBucket", gomock.Any()).
+					DoAndReturn(func(_ progress.FileWriter, conf deploycfn.StackConfiguration, _ string, _ ...cloudformation.StackOption) error {
+						require.Equal(t, "mockApp--mockWkld", conf.StackName())
+						tpl, err := conf.Template()
+						require.NoError(t, err)
+						require.Equal(t, "Resources: {}", tpl)
+						return nil
+					})
+			},
+		},
 		"success with force update": {
 			inForceDeploy: true,
 			inEnvironment: &config.Environment{
@@ -855,9 +1177,11 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				mockEndpointGetter:         mocks.NewMockendpointGetter(ctrl),
 				mockServiceDeployer:        mocks.NewMockserviceDeployer(ctrl),
 				mockServiceForceUpdater:    mocks.NewMockserviceForceUpdater(ctrl),
+				mockDiagnoser:              mocks.NewMockdeploymentDiagnoser(ctrl),
 				mockSpinner:                mocks.NewMockspinner(ctrl),
 				mockPublicCIDRBlocksGetter: mocks.NewMockpublicCIDRBlocksGetter(ctrl),
 				mockValidator:              mocks.NewMockaliasCertValidator(ctrl),
+				mockTemplateValidator:      mocks.NewMocktemplateValidator(ctrl),
 			}
 			tc.mock(m)
 
@@ -870,6 +1194,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				svcDeployer: &svcDeployer{
 					workloadDeployer: &workloadDeployer{
 						name:              mockName,
+						instance:          tc.inInstance,
 						app:               tc.inApp,
 						env:               tc.inEnvironment,
 						environmentConfig: tc.inEnvironmentConfig(),
@@ -881,6 +1206,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 					newSvcUpdater: func(f func(*session.Session) serviceForceUpdater) serviceForceUpdater {
 						return m.mockServiceForceUpdater
 					},
+					diagnoser: m.mockDiagnoser,
 					now: func() time.Time {
 						return mockNowTime
 					},
@@ -908,14 +1234,24 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 							},
 						},
 						NLBConfig: tc.inNLB,
+						DeployConfig: manifest.DeploymentConfiguration{
+							Strategy: tc.inDeployStrategy,
+						},
 					},
 				},
 			}
 
+			if tc.inGuardRules {
+				deployer.validator = m.mockTemplateValidator
+			}
+
 			_, gotErr := deployer.DeployWorkload(&DeployWorkloadInput{
 				Options: Options{
-					ForceNewUpdate:  tc.inForceDeploy,
-					DisableRollback: tc.inDisableRollback,
+					ForceNewUpdate:          tc.inForceDeploy,
+					DisableRollback:         tc.inDisableRollback,
+					NoWait:                  tc.inNoWait,
+					RecreateRolledBackStack: tc.inRecreateRolledBackStack,
+					Packaged:                tc.inPackaged,
 				},
 			})
 
@@ -1284,6 +1620,536 @@ func Test_validateTopicsExist(t *testing.T) {
 	}
 }
 
+func TestWorkloadDeployer_placementSubnetIDs(t *testing.T) {
+	const mockEnvName = "test"
+	mockOutputs := map[string]string{
+		stack.EnvOutputPublicSubnets:  "subnet-1,subnet-2",
+		stack.EnvOutputPrivateSubnets: "subnet-3,subnet-4",
+	}
+	privatePlacement := manifest.PrivateSubnetPlacement
+	testCases := map[string]struct {
+		inPlacement manifest.PlacementArgOrString
+		setUpMocks  func(outputs *mocks.MockenvOutputsGetter, subnets *mocks.MocksubnetIDsGetter)
+
+		wantedIDs []string
+		wantedErr error
+	}{
+		"do not look up the environment if tasks are placed by subnet type": {
+			inPlacement: manifest.PlacementArgOrString{
+				PlacementString: &privatePlacement,
+			},
+			setUpMocks: func(outputs *mocks.MockenvOutputsGetter, subnets *mocks.MocksubnetIDsGetter) {
+				outputs.EXPECT().Outputs().Times(0)
+			},
+		},
+		"error if fail to get the environment stack outputs": {
+			inPlacement: manifest.PlacementArgOrString{
+				PlacementArgs: manifest.PlacementArgs{
+					Subnets: []string{"subnet-1"},
+				},
+			},
+			setUpMocks: func(outputs *mocks.MockenvOutputsGetter, subnets *mocks.MocksubnetIDsGetter) {
+				outputs.EXPECT().Outputs().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get stack outputs of environment test: some error"),
+		},
+		"error if a subnet does not belong to the environment": {
+			inPlacement: manifest.PlacementArgOrString{
+				PlacementArgs: manifest.PlacementArgs{
+					Subnets: []string{"subnet-1", "subnet-5"},
+				},
+			},
+			setUpMocks: func(outputs *mocks.MockenvOutputsGetter, subnets *mocks.MocksubnetIDsGetter) {
+				outputs.EXPECT().Outputs().Return(mockOutputs, nil)
+			},
+			wantedErr: errors.New(`subnet subnet-5 in "network.vpc.placement.subnets" is not a subnet of environment test`),
+		},
+		"success with subnets of the environment": {
+			inPlacement: manifest.PlacementArgOrString{
+				PlacementArgs: manifest.PlacementArgs{
+					Subnets: []string{"subnet-1", "subnet-3"},
+				},
+			},
+			setUpMocks: func(outputs *mocks.MockenvOutputsGetter, subnets *mocks.MocksubnetIDsGetter) {
+				outputs.EXPECT().Outputs().Return(mockOutputs, nil)
+				subnets.EXPECT().SubnetIDs(gomock.Any()).Times(0)
+			},
+		},
+		"error if the environment does not have subnets of the type": {
+			inPlacement: manifest.PlacementArgOrString{
+				PlacementArgs: manifest.PlacementArgs{
+					AZs:  []string{"us-west-2a"},
+					Type: &privatePlacement,
+				},
+			},
+			setUpMocks: func(outputs *mocks.MockenvOutputsGetter, subnets *mocks.MocksubnetIDsGetter) {
+				outputs.EXPECT().Outputs().Return(map[string]string{
+					stack.EnvOutputPublicSubnets: "subnet-1,subnet-2",
+				}, nil)
+			},
+			wantedErr: errors.New("environment test does not have private subnets"),
+		},
+		"error if fail to get the subnets in an availability zone": {
+			inPlacement: manifest.PlacementArgOrString{
+				PlacementArgs: manifest.PlacementArgs{
+					AZs: []string{"us-west-2a"},
+				},
+			},
+			setUpMocks: func(outputs *mocks.MockenvOutputsGetter, subnets *mocks.MocksubnetIDsGetter) {
+				outputs.EXPECT().Outputs().Return(mockOutputs, nil)
+				subnets.EXPECT().SubnetIDs(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get public subnets of environment test in availability zone us-west-2a: some error"),
+		},
+		"error if the environment does not have subnets in an availability zone": {
+			inPlacement: manifest.PlacementArgOrString{
+				PlacementArgs: manifest.PlacementArgs{
+					AZs:  []string{"us-west-2a", "us-west-2c"},
+					Type: &privatePlacement,
+				},
+			},
+			setUpMocks: func(outputs *mocks.MockenvOutputsGetter, subnets *mocks.MocksubnetIDsGetter) {
+				outputs.EXPECT().Outputs().Return(mockOutputs, nil)
+				subnets.EXPECT().SubnetIDs(gomock.Any(), gomock.Any()).Return([]string{"subnet-3"}, nil)
+				subnets.EXPECT().SubnetIDs(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			wantedErr: errors.New("environment test does not have private subnets in availability zone us-west-2c"),
+		},
+		"return the private subnets of the environment in the availability zones": {
+			inPlacement: manifest.PlacementArgOrString{
+				PlacementArgs: manifest.PlacementArgs{
+					AZs:  []string{"us-west-2a", "us-west-2b"},
+					Type: &privatePlacement,
+				},
+			},
+			setUpMocks: func(outputs *mocks.MockenvOutputsGetter, subnets *mocks.MocksubnetIDsGetter) {
+				outputs.EXPECT().Outputs().Return(mockOutputs, nil)
+				subnets.EXPECT().SubnetIDs(ec2.Filter{
+					Name:   "subnet-id",
+					Values: []string{"subnet-3", "subnet-4"},
+				}, ec2.Filter{
+					Name:   "availability-zone",
+					Values: []string{"us-west-2a"},
+				}).Return([]string{"subnet-3"}, nil)
+				subnets.EXPECT().SubnetIDs(ec2.Filter{
+					Name:   "subnet-id",
+					Values: []string{"subnet-3", "subnet-4"},
+				}, ec2.Filter{
+					Name:   "availability-zone",
+					Values: []string{"us-west-2b"},
+				}).Return([]string{"subnet-4"}, nil)
+			},
+			wantedIDs: []string{"subnet-3", "subnet-4"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			outputs := mocks.NewMockenvOutputsGetter(ctrl)
+			subnets := mocks.NewMocksubnetIDsGetter(ctrl)
+			tc.setUpMocks(outputs, subnets)
+			d := &workloadDeployer{
+				env: &config.Environment{
+					Name: mockEnvName,
+				},
+				envOutputs:   outputs,
+				subnetGetter: subnets,
+			}
+
+			ids, err := d.placementSubnetIDs(tc.inPlacement)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedIDs, ids)
+			}
+		})
+	}
+}
+
+func TestWorkloadDeployer_templateDiff(t *testing.T) {
+	const (
+		mockStackName = "phonetool-test-frontend"
+		deployedTpl   = `
+Resources:
+  TaskRole:
+    Type: AWS::IAM::Role
+  Service:
+    Type: AWS::ECS::Service
+`
+		generatedTpl = `
+Resources:
+  TaskRole:
+    Type: AWS::IAM::Role
+    Properties:
+      Policies: []
+  Service:
+    Type: AWS::ECS::Service
+`
+	)
+	testCases := map[string]struct {
+		setUpMocks func(describer *mocks.MockdeployedStackDescriber, addons *mocks.Mocktemplater)
+
+		wantedDiff *TemplateDiff
+		wantedErr  error
+	}{
+		"error if fail to get the deployed template": {
+			setUpMocks: func(describer *mocks.MockdeployedStackDescriber, addons *mocks.Mocktemplater) {
+				describer.EXPECT().WorkloadTemplate(mockStackName).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("retrieve the deployed template of stack phonetool-test-frontend: some error"),
+		},
+		"error if fail to get the deployed addons template": {
+			setUpMocks: func(describer *mocks.MockdeployedStackDescriber, addons *mocks.Mocktemplater) {
+				describer.EXPECT().WorkloadTemplate(mockStackName).Return(deployedTpl, nil)
+				describer.EXPECT().WorkloadParameters(mockStackName).Return(nil, nil)
+				describer.EXPECT().WorkloadAddonsTemplate(mockStackName).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("retrieve the deployed addons template of stack phonetool-test-frontend: some error"),
+		},
+		"every resource is added if the workload was never deployed": {
+			setUpMocks: func(describer *mocks.MockdeployedStackDescriber, addons *mocks.Mocktemplater) {
+				describer.EXPECT().WorkloadTemplate(mockStackName).Return("", &cloudformation.ErrStackNotFound{})
+				describer.EXPECT().WorkloadParameters(gomock.Any()).Times(0)
+				addons.EXPECT().Template().Return("", &addon.ErrAddonsNotFound{})
+			},
+			wantedDiff: &TemplateDiff{
+				AddedResources:    []string{"Service", "TaskRole"},
+				SecurityResources: []string{"TaskRole"},
+				Parameters: []ParameterDiff{
+					{
+						Key: "EnvName",
+						New: "test",
+					},
+				},
+				NewStack: true,
+			},
+		},
+		"compare the service and addons stacks": {
+			setUpMocks: func(describer *mocks.MockdeployedStackDescriber, addons *mocks.Mocktemplater) {
+				describer.EXPECT().WorkloadTemplate(mockStackName).Return(deployedTpl, nil)
+				describer.EXPECT().WorkloadParameters(mockStackName).Return([]*awscfn.Parameter{
+					{
+						ParameterKey:   aws.String("EnvName"),
+						ParameterValue: aws.String("test"),
+					},
+				}, nil)
+				describer.EXPECT().WorkloadAddonsTemplate(mockStackName).Return(`
+Resources:
+  MyTable:
+    Type: AWS::DynamoDB::Table
+`, nil)
+				addons.EXPECT().Template().Return(`
+Resources:
+  MyTable:
+    Type: AWS::DynamoDB::Table
+  MyTableAccessPolicy:
+    Type: AWS::IAM::ManagedPolicy
+`, nil)
+			},
+			wantedDiff: &TemplateDiff{
+				ChangedResources:  []string{"TaskRole"},
+				SecurityResources: []string{"TaskRole"},
+				Addons: &TemplateDiff{
+					AddedResources:    []string{"MyTableAccessPolicy"},
+					SecurityResources: []string{"MyTableAccessPolicy"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			describer := mocks.NewMockdeployedStackDescriber(ctrl)
+			addons := mocks.NewMocktemplater(ctrl)
+			tc.setUpMocks(describer, addons)
+			deployer := &workloadDeployer{
+				stackDescriber: describer,
+				templater:      addons,
+			}
+
+			// WHEN
+			diff, err := deployer.templateDiff(mockStackName, generatedTpl, `{"Parameters": {"EnvName": "test"}}`)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDiff, diff)
+		})
+	}
+}
+
+func TestWorkloadDeployer_warnPlatformVersion(t *testing.T) {
+	const mockStackName = "phonetool-test-frontend"
+	deployedTpl := func(version string) string {
+		return fmt.Sprintf(`
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      PlatformVersion: %s
+`, version)
+	}
+	testCases := map[string]struct {
+		platformVersion *string
+		setUpMocks      func(describer *mocks.MockdeployedStackDescriber)
+
+		wantedWarning string
+		wantedErr     error
+	}{
+		"no warning if the platform version is not pinned": {
+			setUpMocks: func(describer *mocks.MockdeployedStackDescriber) {
+				describer.EXPECT().WorkloadTemplate(gomock.Any()).Times(0)
+			},
+		},
+		"error if fail to get the deployed template": {
+			platformVersion: aws.String("1.4.0"),
+			setUpMocks: func(describer *mocks.MockdeployedStackDescriber) {
+				describer.EXPECT().WorkloadTemplate(mockStackName).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("retrieve the deployed template of stack phonetool-test-frontend: some error"),
+		},
+		"no warning if the service was never deployed": {
+			platformVersion: aws.String("1.4.0"),
+			setUpMocks: func(describer *mocks.MockdeployedStackDescriber) {
+				describer.EXPECT().WorkloadTemplate(mockStackName).Return("", &cloudformation.ErrStackNotFound{})
+			},
+		},
+		"no warning if the pinned version is already deployed": {
+			platformVersion: aws.String("1.4.0"),
+			setUpMocks: func(describer *mocks.MockdeployedStackDescriber) {
+				describer.EXPECT().WorkloadTemplate(mockStackName).Return(deployedTpl("1.4.0"), nil)
+			},
+		},
+		"warn that changing the platform version replaces the running tasks": {
+			platformVersion: aws.String("1.4.0"),
+			setUpMocks: func(describer *mocks.MockdeployedStackDescriber) {
+				describer.EXPECT().WorkloadTemplate(mockStackName).Return(deployedTpl("LATEST"), nil)
+			},
+			wantedWarning: "Changing the Fargate platform version of frontend from LATEST to 1.4.0 replaces all of its running tasks.",
+		},
+		"warn if the pinned version is deprecated": {
+			platformVersion: aws.String("1.2.0"),
+			setUpMocks: func(describer *mocks.MockdeployedStackDescriber) {
+				describer.EXPECT().WorkloadTemplate(mockStackName).Return(deployedTpl("1.2.0"), nil)
+			},
+			wantedWarning: "Fargate platform version 1.2.0 of frontend is deprecated, pin version 1.4.0 or LATEST instead.",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			describer := mocks.NewMockdeployedStackDescriber(ctrl)
+			tc.setUpMocks(describer)
+			b := &strings.Builder{}
+			defaultWriter := log.DiagnosticWriter
+			log.DiagnosticWriter = b
+			defer func() { log.DiagnosticWriter = defaultWriter }()
+			mft := &manifest.BackendService{
+				BackendServiceConfig: manifest.BackendServiceConfig{
+					TaskConfig: manifest.TaskConfig{
+						PlatformVersion: tc.platformVersion,
+					},
+				},
+			}
+			deployer := &workloadDeployer{
+				name:           "frontend",
+				mft:            mft,
+				stackDescriber: describer,
+			}
+
+			// WHEN
+			err := deployer.warnPlatformVersion(mockStackName)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantedWarning == "" {
+				require.Empty(t, b.String())
+				return
+			}
+			require.Contains(t, b.String(), tc.wantedWarning)
+		})
+	}
+}
+
+func TestLBWebSvcDeployer_deployBlueGreen(t *testing.T) {
+	const (
+		mockStackName = "phonetool-test-frontend"
+		oldTaskDef    = "arn:aws:ecs:us-west-2:111111111111:task-definition/phonetool-test-frontend:1"
+		newTaskDef    = "arn:aws:ecs:us-west-2:111111111111:task-definition/phonetool-test-frontend:2"
+		mockCDApp     = "phonetool-test-frontend-CodeDeployApplication"
+		mockCDGroup   = "phonetool-test-frontend-CodeDeployDeploymentGroup"
+	)
+	deployedOutputs := map[string]string{
+		stack.LBWebServiceOutputTaskDefinition:        oldTaskDef,
+		stack.LBWebServiceOutputServiceTaskDefinition: oldTaskDef,
+	}
+	updatedOutputs := map[string]string{
+		stack.LBWebServiceOutputTaskDefinition:            newTaskDef,
+		stack.LBWebServiceOutputServiceTaskDefinition:     oldTaskDef,
+		stack.LBWebServiceOutputCodeDeployApplication:     mockCDApp,
+		stack.LBWebServiceOutputCodeDeployDeploymentGroup: mockCDGroup,
+	}
+	mockParams := []*awscfn.Parameter{
+		{
+			ParameterKey:   aws.String(stack.WorkloadTargetContainerParamKey),
+			ParameterValue: aws.String("frontend"),
+		},
+		{
+			ParameterKey:   aws.String(stack.WorkloadTargetPortParamKey),
+			ParameterValue: aws.String("80"),
+		},
+	}
+	tenMinutes := 10 * time.Minute
+	testCases := map[string]struct {
+		inDeployedOutputs map[string]string
+		inDeployConfig    manifest.DeploymentConfiguration
+		inForce           bool
+		setUpMocks        func(describer *mocks.MockdeployedStackDescriber, bg *mocks.MockblueGreenDeployer, sp *mocks.Mockspinner)
+
+		wantedErr error
+	}{
+		"skip if the service was created with the latest task definition": {
+			inDeployedOutputs: map[string]string{},
+			setUpMocks: func(describer *mocks.MockdeployedStackDescriber, bg *mocks.MockblueGreenDeployer, sp *mocks.Mockspinner) {
+				describer.EXPECT().WorkloadOutputs(gomock.Any()).Times(0)
+				bg.EXPECT().DeployECSService(gomock.Any()).Times(0)
+			},
+		},
+		"skip if the task definition did not change": {
+			inDeployedOutputs: deployedOutputs,
+			setUpMocks: func(describer *mocks.MockdeployedStackDescriber, bg *mocks.MockblueGreenDeployer, sp *mocks.Mockspinner) {
+				describer.EXPECT().WorkloadOutputs(mockStackName).Return(deployedOutputs, nil)
+				bg.EXPECT().DeployECSService(gomock.Any()).Times(0)
+			},
+		},
+		"error if fail to get the stack outputs": {
+			inDeployedOutputs: deployedOutputs,
+			setUpMocks: func(describer *mocks.MockdeployedStackDescriber, bg *mocks.MockblueGreenDeployer, sp *mocks.Mockspinner) {
+				describer.EXPECT().WorkloadOutputs(mockStackName).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get outputs of stack phonetool-test-frontend: some error"),
+		},
+		"error if the deployment is rolled back": {
+			inDeployedOutputs: deployedOutputs,
+			setUpMocks: func(describer *mocks.MockdeployedStackDescriber, bg *mocks.MockblueGreenDeployer, sp *mocks.Mockspinner) {
+				describer.EXPECT().WorkloadOutputs(mockStackName).Return(updatedOutputs, nil)
+				describer.EXPECT().WorkloadParameters(mockStackName).Return(mockParams, nil)
+				sp.EXPECT().Start(gomock.Any())
+				bg.EXPECT().DeployECSService(gomock.Any()).Return(errors.New("deployment d-1 is Stopped"))
+				sp.EXPECT().Stop(gomock.Any())
+			},
+			wantedErr: errors.New("deploy service frontend with CodeDeploy: deployment d-1 is Stopped"),
+		},
+		"redeploy the same task definition if forced": {
+			inDeployedOutputs: deployedOutputs,
+			inForce:           true,
+			setUpMocks: func(describer *mocks.MockdeployedStackDescriber, bg *mocks.MockblueGreenDeployer, sp *mocks.Mockspinner) {
+				describer.EXPECT().WorkloadOutputs(mockStackName).Return(deployedOutputs, nil)
+				describer.EXPECT().WorkloadParameters(mockStackName).Return(mockParams, nil)
+				sp.EXPECT().Start(gomock.Any())
+				bg.EXPECT().DeployECSService(gomock.Any()).Return(nil)
+				sp.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"shift traffic to the new task definition": {
+			inDeployedOutputs: deployedOutputs,
+			setUpMocks: func(describer *mocks.MockdeployedStackDescriber, bg *mocks.MockblueGreenDeployer, sp *mocks.Mockspinner) {
+				describer.EXPECT().WorkloadOutputs(mockStackName).Return(updatedOutputs, nil)
+				describer.EXPECT().WorkloadParameters(mockStackName).Return(mockParams, nil)
+				sp.EXPECT().Start(gomock.Any())
+				bg.EXPECT().DeployECSService(&codedeploy.ECSDeploymentInput{
+					Application:     mockCDApp,
+					DeploymentGroup: mockCDGroup,
+					TaskDefinition:  newTaskDef,
+					ContainerName:   "frontend",
+					ContainerPort:   80,
+				}).Return(nil)
+				sp.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"wait for the canary steps to bake": {
+			inDeployedOutputs: deployedOutputs,
+			inDeployConfig: manifest.DeploymentConfiguration{
+				Strategy: aws.String(manifest.ECSBlueGreenDeploymentStrategy),
+				Canary: manifest.CanaryDeploymentConfig{
+					Steps: []manifest.CanaryStep{
+						{Weight: aws.Int(50), Bake: &tenMinutes},
+					},
+				},
+			},
+			setUpMocks: func(describer *mocks.MockdeployedStackDescriber, bg *mocks.MockblueGreenDeployer, sp *mocks.Mockspinner) {
+				describer.EXPECT().WorkloadOutputs(mockStackName).Return(updatedOutputs, nil)
+				describer.EXPECT().WorkloadParameters(mockStackName).Return(mockParams, nil)
+				sp.EXPECT().Start(gomock.Any())
+				bg.EXPECT().DeployECSService(&codedeploy.ECSDeploymentInput{
+					Application:     mockCDApp,
+					DeploymentGroup: mockCDGroup,
+					TaskDefinition:  newTaskDef,
+					ContainerName:   "frontend",
+					ContainerPort:   80,
+					BakeTime:        10 * time.Minute,
+				}).Return(nil)
+				sp.EXPECT().Stop(gomock.Any())
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			describer := mocks.NewMockdeployedStackDescriber(ctrl)
+			bg := mocks.NewMockblueGreenDeployer(ctrl)
+			sp := mocks.NewMockspinner(ctrl)
+			tc.setUpMocks(describer, bg, sp)
+			deployer := &lbWebSvcDeployer{
+				svcDeployer: &svcDeployer{
+					workloadDeployer: &workloadDeployer{
+						name:           "frontend",
+						app:            &config.Application{Name: "phonetool"},
+						env:            &config.Environment{Name: "test"},
+						stackDescriber: describer,
+						spinner:        sp,
+					},
+				},
+				lbMft: &manifest.LoadBalancedWebService{
+					LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+						DeployConfig: tc.inDeployConfig,
+					},
+				},
+				blueGreenDeployer: bg,
+				deployedOutputs:   tc.inDeployedOutputs,
+			}
+
+			// WHEN
+			err := deployer.deployBlueGreen(tc.inForce)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestBackendSvcDeployer_stackConfiguration(t *testing.T) {
 	const (
 		mockAppName = "mock-app"
@@ -5,10 +5,13 @@ package cli
 
 import (
 	"errors"
+	"sync"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/graph"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -142,10 +145,12 @@ func TestDeployOpts_Run(t *testing.T) {
 			tc.mockSel(mockSel)
 			tc.mockActionCommand(mockCmd)
 			opts := &deployOpts{
-				deployWkldVars: deployWkldVars{
-					appName: tc.inAppName,
-					name:    tc.inName,
-					envName: "test",
+				deployVars: deployVars{
+					deployWkldVars: deployWkldVars{
+						appName: tc.inAppName,
+						name:    tc.inName,
+						envName: "test",
+					},
 				},
 				deployWkld: mockCmd,
 				sel:        mockSel,
@@ -164,3 +169,422 @@ func TestDeployOpts_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestDeployOpts_RunWithSince(t *testing.T) {
+	const (
+		apiManifest = `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+`
+		webManifest = `name: web
+type: Backend Service
+image:
+  location: nginx
+`
+	)
+	mockChangedFiles := func(m *mocks.MockexecRunner) {
+		m.EXPECT().Run("git", []string{"rev-parse", "--show-toplevel"}, gomock.Any()).DoAndReturn(mockGitOutput("/ws\n"))
+		m.EXPECT().Run("git", []string{"diff", "--name-only", "main", "--"}, gomock.Any()).
+			DoAndReturn(mockGitOutput("copilot/environments/test/manifest.yml\ncopilot/fe/manifest.yml\n"))
+		m.EXPECT().Run("git", []string{"ls-files", "--others", "--exclude-standard", "--full-name"}, gomock.Any()).
+			DoAndReturn(mockGitOutput("api/main.go\n"))
+	}
+	mockWorkspace := func(m *mocks.MockwsWlDirReader) {
+		m.EXPECT().Path().Return("/ws", nil)
+		m.EXPECT().ListServices().Return([]string{"api", "fe", "web"}, nil)
+		m.EXPECT().ListJobs().Return([]string{"mailer"}, nil)
+		m.EXPECT().ReadWorkloadManifest("api").Return(workspace.WorkloadManifest(apiManifest), nil)
+		m.EXPECT().ReadWorkloadManifest("web").Return(workspace.WorkloadManifest(webManifest), nil)
+	}
+	mockDeployStore := func(m *mocks.MockdeployedWorkloadsLister) {
+		m.EXPECT().ListDeployedServices("app", "test").Return([]string{"api", "fe", "web"}, nil)
+		m.EXPECT().ListDeployedJobs("app", "test").Return(nil, nil)
+	}
+	testCases := map[string]struct {
+		inName             string
+		inSkipConfirmation bool
+
+		setupMocks func(m *deployPlanMocks)
+
+		wantedDeployed []string
+		wantedErr      string
+	}{
+		"error if --name is specified with --since": {
+			inName:     "fe",
+			setupMocks: func(m *deployPlanMocks) {},
+			wantedErr:  "cannot specify both --name and --since",
+		},
+		"error if fail to list changed files": {
+			setupMocks: func(m *deployPlanMocks) {
+				m.runner.EXPECT().Run("git", []string{"rev-parse", "--show-toplevel"}, gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: "list files changed since main: some error",
+		},
+		"error if fail to list deployed services": {
+			setupMocks: func(m *deployPlanMocks) {
+				mockChangedFiles(m.runner)
+				m.ws.EXPECT().Path().Return("/ws", nil)
+				m.ws.EXPECT().ListServices().Return([]string{"api"}, nil)
+				m.deployStore.EXPECT().ListDeployedServices("app", "test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "list services deployed to environment test: some error",
+		},
+		"nothing to deploy": {
+			setupMocks: func(m *deployPlanMocks) {
+				m.runner.EXPECT().Run("git", []string{"rev-parse", "--show-toplevel"}, gomock.Any()).DoAndReturn(mockGitOutput("/ws\n"))
+				m.runner.EXPECT().Run("git", gomock.Any(), gomock.Any()).DoAndReturn(mockGitOutput("README.md\n")).Times(2)
+				m.ws.EXPECT().Path().Return("/ws", nil)
+				m.ws.EXPECT().ListServices().Return([]string{"web"}, nil)
+				m.ws.EXPECT().ListJobs().Return(nil, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("web").Return(workspace.WorkloadManifest(webManifest), nil)
+				m.deployStore.EXPECT().ListDeployedServices("app", "test").Return([]string{"web"}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("app", "test").Return(nil, nil)
+			},
+		},
+		"do not deploy if the plan is not confirmed": {
+			setupMocks: func(m *deployPlanMocks) {
+				mockChangedFiles(m.runner)
+				mockWorkspace(m.ws)
+				mockDeployStore(m.deployStore)
+				m.prompt.EXPECT().Confirm(continueDeployPlanPrompt, "").Return(false, nil)
+			},
+		},
+		"deploy the environment, then services, then jobs": {
+			setupMocks: func(m *deployPlanMocks) {
+				mockChangedFiles(m.runner)
+				mockWorkspace(m.ws)
+				mockDeployStore(m.deployStore)
+				m.prompt.EXPECT().Confirm(continueDeployPlanPrompt, "").Return(true, nil)
+				gomock.InOrder(
+					m.envCmd.EXPECT().Validate(),
+					m.envCmd.EXPECT().Ask(),
+					m.envCmd.EXPECT().Execute(),
+				)
+				m.store.EXPECT().GetWorkload("app", "api").Return(&config.Workload{Type: "Backend Service"}, nil)
+				m.store.EXPECT().GetWorkload("app", "fe").Return(&config.Workload{Type: "Backend Service"}, nil)
+				m.store.EXPECT().GetWorkload("app", "mailer").Return(&config.Workload{Type: "Scheduled Job"}, nil)
+				m.wkldCmd.EXPECT().Ask().Times(3)
+				m.wkldCmd.EXPECT().Validate().Times(3)
+				m.wkldCmd.EXPECT().Execute().Times(3)
+				m.wkldCmd.EXPECT().RecommendActions().Times(3)
+			},
+			wantedDeployed: []string{"api", "fe", "mailer"},
+		},
+		"skip confirmation and stop at the first failed deployment": {
+			inSkipConfirmation: true,
+			setupMocks: func(m *deployPlanMocks) {
+				mockChangedFiles(m.runner)
+				mockWorkspace(m.ws)
+				mockDeployStore(m.deployStore)
+				m.envCmd.EXPECT().Validate()
+				m.envCmd.EXPECT().Ask()
+				m.envCmd.EXPECT().Execute().Return(errors.New("some error"))
+			},
+			wantedErr: "execute env deploy: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &deployPlanMocks{
+				runner:      mocks.NewMockexecRunner(ctrl),
+				ws:          mocks.NewMockwsWlDirReader(ctrl),
+				deployStore: mocks.NewMockdeployedWorkloadsLister(ctrl),
+				store:       mocks.NewMockstore(ctrl),
+				prompt:      mocks.NewMockprompter(ctrl),
+				envCmd:      mocks.NewMockcmd(ctrl),
+				wkldCmd:     mocks.NewMockactionCommand(ctrl),
+			}
+			tc.setupMocks(m)
+			var deployed []string
+			opts := &deployOpts{
+				deployVars: deployVars{
+					deployWkldVars: deployWkldVars{
+						appName: "app",
+						name:    tc.inName,
+						envName: "test",
+					},
+					since:            "main",
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+				runner:      m.runner,
+				ws:          m.ws,
+				deployStore: m.deployStore,
+				store:       m.store,
+				prompt:      m.prompt,
+				setupDeployCmd: func(o *deployOpts, _ string) {
+					deployed = append(deployed, o.name)
+					o.deployWkld = m.wkldCmd
+				},
+				newEnvDeployCmd: func(o *deployOpts) (cmd, error) {
+					require.Equal(t, "test", o.envName)
+					return m.envCmd, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Run()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDeployed, deployed)
+		})
+	}
+}
+
+func TestDeployOpts_RunWithAll(t *testing.T) {
+	const (
+		apiManifest = `name: api
+type: Backend Service
+image:
+  location: nginx
+publish:
+  topics:
+    - name: orders
+`
+		feManifest = `name: fe
+type: Load Balanced Web Service
+image:
+  location: nginx
+http:
+  path: /
+`
+		workerManifest = `name: worker
+type: Worker Service
+image:
+  location: nginx
+subscribe:
+  topics:
+    - name: orders
+      service: api
+`
+		mailerManifest = `name: mailer
+type: Scheduled Job
+image:
+  location: nginx
+on:
+  schedule: "@daily"
+`
+	)
+	mockWorkspace := func(m *mocks.MockwsWlDirReader) {
+		m.EXPECT().ListEnvironments().Return([]string{"test", "prod"}, nil)
+		m.EXPECT().ListServices().Return([]string{"api", "fe", "worker"}, nil)
+		m.EXPECT().ListJobs().Return([]string{"mailer"}, nil)
+		m.EXPECT().ReadWorkloadManifest("api").Return(workspace.WorkloadManifest(apiManifest), nil)
+		m.EXPECT().ReadWorkloadManifest("fe").Return(workspace.WorkloadManifest(feManifest), nil)
+		m.EXPECT().ReadWorkloadManifest("worker").Return(workspace.WorkloadManifest(workerManifest), nil)
+		m.EXPECT().ReadWorkloadManifest("mailer").Return(workspace.WorkloadManifest(mailerManifest), nil)
+	}
+	mockStore := func(m *mocks.Mockstore) {
+		m.EXPECT().GetWorkload("app", "api").Return(&config.Workload{Type: "Backend Service"}, nil).AnyTimes()
+		m.EXPECT().GetWorkload("app", "fe").Return(&config.Workload{Type: "Load Balanced Web Service"}, nil).AnyTimes()
+		m.EXPECT().GetWorkload("app", "worker").Return(&config.Workload{Type: "Worker Service"}, nil).AnyTimes()
+		m.EXPECT().GetWorkload("app", "mailer").Return(&config.Workload{Type: "Scheduled Job"}, nil).AnyTimes()
+	}
+	mockDeployed := func(cmd *mocks.MockactionCommand) {
+		cmd.EXPECT().Ask()
+		cmd.EXPECT().Validate()
+		cmd.EXPECT().RecommendActions()
+	}
+	testCases := map[string]struct {
+		inName  string
+		inSince string
+
+		setupMocks func(m *deployAllMocks)
+
+		wantedErr string
+	}{
+		"error if --all is specified with --name": {
+			inName:     "fe",
+			setupMocks: func(m *deployAllMocks) {},
+			wantedErr:  "cannot specify both --all and --name",
+		},
+		"error if --all is specified with --since": {
+			inSince:    "main",
+			setupMocks: func(m *deployAllMocks) {},
+			wantedErr:  "cannot specify both --all and --since",
+		},
+		"error if fail to read a workload manifest": {
+			setupMocks: func(m *deployAllMocks) {
+				m.ws.EXPECT().ListEnvironments().Return(nil, nil)
+				m.ws.EXPECT().ListServices().Return([]string{"api"}, nil)
+				m.ws.EXPECT().ListJobs().Return(nil, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "read manifest file for api: some error",
+		},
+		"do not deploy if the plan is not confirmed": {
+			setupMocks: func(m *deployAllMocks) {
+				mockWorkspace(m.ws)
+				m.prompt.EXPECT().Confirm(continueDeployPlanPrompt, "").Return(false, nil)
+			},
+		},
+		"deploy the environment, then the publishers and backends, then their dependents": {
+			setupMocks: func(m *deployAllMocks) {
+				mockWorkspace(m.ws)
+				mockStore(m.store)
+				m.prompt.EXPECT().Confirm(continueDeployPlanPrompt, "").Return(true, nil)
+				m.envCmd.EXPECT().Validate()
+				m.envCmd.EXPECT().Ask()
+				for _, cmd := range m.wkldCmds {
+					mockDeployed(cmd)
+				}
+				envDeployed := m.envCmd.EXPECT().Execute()
+				apiDeployed := m.wkldCmds["api"].EXPECT().Execute().After(envDeployed)
+				m.wkldCmds["mailer"].EXPECT().Execute().After(envDeployed)
+				m.wkldCmds["fe"].EXPECT().Execute().After(apiDeployed)
+				m.wkldCmds["worker"].EXPECT().Execute().After(apiDeployed)
+			},
+		},
+		"skip the dependents of a failed deployment and deploy the rest": {
+			setupMocks: func(m *deployAllMocks) {
+				mockWorkspace(m.ws)
+				mockStore(m.store)
+				m.prompt.EXPECT().Confirm(continueDeployPlanPrompt, "").Return(true, nil)
+				m.envCmd.EXPECT().Validate()
+				m.envCmd.EXPECT().Ask()
+				m.envCmd.EXPECT().Execute()
+				m.wkldCmds["api"].EXPECT().Ask()
+				m.wkldCmds["api"].EXPECT().Validate()
+				m.wkldCmds["api"].EXPECT().Execute().Return(errors.New("some error"))
+				mockDeployed(m.wkldCmds["mailer"])
+				m.wkldCmds["mailer"].EXPECT().Execute()
+			},
+			wantedErr: "1 of 5 deployments failed: svc api; skipped svc fe and svc worker",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &deployAllMocks{
+				ws:     mocks.NewMockwsWlDirReader(ctrl),
+				store:  mocks.NewMockstore(ctrl),
+				prompt: mocks.NewMockprompter(ctrl),
+				envCmd: mocks.NewMockcmd(ctrl),
+				wkldCmds: map[string]*mocks.MockactionCommand{
+					"api":    mocks.NewMockactionCommand(ctrl),
+					"fe":     mocks.NewMockactionCommand(ctrl),
+					"worker": mocks.NewMockactionCommand(ctrl),
+					"mailer": mocks.NewMockactionCommand(ctrl),
+				},
+			}
+			tc.setupMocks(m)
+			var mu sync.Mutex
+			opts := &deployOpts{
+				deployVars: deployVars{
+					deployWkldVars: deployWkldVars{
+						appName: "app",
+						name:    tc.inName,
+						envName: "test",
+					},
+					since: tc.inSince,
+					all:   true,
+				},
+				ws:     m.ws,
+				store:  m.store,
+				prompt: m.prompt,
+				setupDeployCmd: func(o *deployOpts, _ string) {
+					mu.Lock()
+					defer mu.Unlock()
+					require.True(t, o.nonInteractive, "workloads deployed concurrently must not prompt")
+					require.NotNil(t, o.progressOut)
+					o.deployWkld = m.wkldCmds[o.name]
+				},
+				newEnvDeployCmd: func(o *deployOpts) (cmd, error) {
+					require.Equal(t, "test", o.envName)
+					require.False(t, o.nonInteractive)
+					return m.envCmd, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Run()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_rankDeploySteps(t *testing.T) {
+	var (
+		env    = deployPlanStep{kind: envType, name: "test"}
+		api    = deployPlanStep{kind: svcWkldType, name: "api"}
+		fe     = deployPlanStep{kind: svcWkldType, name: "fe"}
+		worker = deployPlanStep{kind: svcWkldType, name: "worker"}
+	)
+	testCases := map[string]struct {
+		inSteps []deployPlanStep
+		inEdges []graph.Edge[deployPlanStep]
+
+		wantedRanks [][]deployPlanStep
+		wantedErr   string
+	}{
+		"error if the dependencies contain a cycle": {
+			inSteps: []deployPlanStep{api, worker},
+			inEdges: []graph.Edge[deployPlanStep]{
+				{From: api, To: worker},
+				{From: worker, To: api},
+			},
+			wantedErr: "order deployments by their dependencies: graph contains a cycle",
+		},
+		"independent steps share a rank and keep their order": {
+			inSteps: []deployPlanStep{env, api, fe, worker},
+			inEdges: []graph.Edge[deployPlanStep]{
+				{From: env, To: api},
+				{From: env, To: fe},
+				{From: env, To: worker},
+				{From: api, To: fe},
+			},
+			wantedRanks: [][]deployPlanStep{
+				{env},
+				{api, worker},
+				{fe},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ranks, err := rankDeploySteps(tc.inSteps, tc.inEdges)
+			if tc.wantedErr != "" {
+				require.ErrorContains(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedRanks, ranks)
+		})
+	}
+}
+
+type deployAllMocks struct {
+	ws       *mocks.MockwsWlDirReader
+	store    *mocks.Mockstore
+	prompt   *mocks.Mockprompter
+	envCmd   *mocks.Mockcmd
+	wkldCmds map[string]*mocks.MockactionCommand
+}
+
+type deployPlanMocks struct {
+	runner      *mocks.MockexecRunner
+	ws          *mocks.MockwsWlDirReader
+	deployStore *mocks.MockdeployedWorkloadsLister
+	store       *mocks.Mockstore
+	prompt      *mocks.Mockprompter
+	envCmd      *mocks.Mockcmd
+	wkldCmd     *mocks.MockactionCommand
+}
@@ -45,6 +45,9 @@ Environments are deployment stages shared between services.`,
 	cmd.AddCommand(buildEnvUpgradeCmd())
 	cmd.AddCommand(buildEnvDeployCmd())
 	cmd.AddCommand(buildEnvPkgCmd())
+	cmd.AddCommand(buildEnvRollbackCmd())
+	cmd.AddCommand(buildEnvMaintenanceCmd())
+	cmd.AddCommand(buildEnvPreviewCmd())
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
 		"group": group.Develop,
@@ -0,0 +1,109 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"net"
+)
+
+const (
+	defaultSuggestedCIDRSize = 16
+	minSuggestedCIDRSize     = 16
+	maxSuggestedCIDRSize     = 24
+	minSubnetCIDRSize        = 24
+	maxSubnetCIDRSize        = 28
+)
+
+// privateCIDRPools are the RFC 1918 address ranges that VPC CIDRs are suggested from, in order of preference.
+var privateCIDRPools = []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"}
+
+// cidrsOverlap returns true if the two IPv4 networks share at least one address.
+func cidrsOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// firstOverlap returns the first CIDR in taken that overlaps with the network, or an empty string if none do.
+// CIDRs in taken that can't be parsed are ignored.
+func firstOverlap(network *net.IPNet, taken []string) string {
+	for _, cidr := range taken {
+		_, other, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		if cidrsOverlap(network, other) {
+			return cidr
+		}
+	}
+	return ""
+}
+
+// suggestVPCCIDR returns the first IPv4 block with the given prefix size in the private address ranges
+// that doesn't overlap with any of the taken CIDRs.
+func suggestVPCCIDR(taken []string, size int) (*net.IPNet, error) {
+	if size < minSuggestedCIDRSize || size > maxSuggestedCIDRSize {
+		return nil, fmt.Errorf("CIDR size /%d must be between /%d and /%d", size, minSuggestedCIDRSize, maxSuggestedCIDRSize)
+	}
+	mask := net.CIDRMask(size, 32)
+	step := uint32(1) << (32 - size)
+	for _, pool := range privateCIDRPools {
+		_, poolNet, _ := net.ParseCIDR(pool)
+		poolOnes, _ := poolNet.Mask.Size()
+		if poolOnes > size {
+			continue
+		}
+		start := ipv4ToUint32(poolNet.IP)
+		count := uint32(1) << (size - poolOnes)
+		for i := uint32(0); i < count; i++ {
+			candidate := &net.IPNet{
+				IP:   uint32ToIPv4(start + i*step),
+				Mask: mask,
+			}
+			if firstOverlap(candidate, taken) == "" {
+				return candidate, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("no /%d block is available in the private address ranges %s", size, prettify(privateCIDRPools))
+}
+
+// splitSubnets divides the VPC CIDR into a public and a private subnet per availability zone.
+// The subnets are as large as possible without being larger than a /24.
+func splitSubnets(vpc *net.IPNet, numAZs int) (public []string, private []string, err error) {
+	vpcOnes, _ := vpc.Mask.Size()
+	numSubnets := 2 * numAZs
+	size := vpcOnes + bits.Len(uint(numSubnets-1))
+	if size < minSubnetCIDRSize {
+		size = minSubnetCIDRSize
+	}
+	if size > maxSubnetCIDRSize {
+		return nil, nil, fmt.Errorf("VPC CIDR %s is too small for %d subnets", vpc.String(), numSubnets)
+	}
+	start := ipv4ToUint32(vpc.IP)
+	step := uint32(1) << (32 - size)
+	for i := 0; i < numSubnets; i++ {
+		subnet := &net.IPNet{
+			IP:   uint32ToIPv4(start + uint32(i)*step),
+			Mask: net.CIDRMask(size, 32),
+		}
+		if i < numAZs {
+			public = append(public, subnet.String())
+			continue
+		}
+		private = append(private, subnet.String())
+	}
+	return public, private, nil
+}
+
+func ipv4ToUint32(ip net.IP) uint32 {
+	return binary.BigEndian.Uint32(ip.To4())
+}
+
+func uint32ToIPv4(n uint32) net.IP {
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, n)
+	return ip
+}
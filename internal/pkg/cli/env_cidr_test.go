@@ -0,0 +1,114 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuggestVPCCIDR(t *testing.T) {
+	testCases := map[string]struct {
+		inTaken []string
+		inSize  int
+
+		wantedCIDR   string
+		wantedErrMsg string
+	}{
+		"returns the first block if nothing is taken": {
+			inSize:     16,
+			wantedCIDR: "10.0.0.0/16",
+		},
+		"skips blocks overlapping with taken CIDRs": {
+			inTaken:    []string{"10.0.0.0/16", "10.1.128.0/20", "not-a-cidr"},
+			inSize:     16,
+			wantedCIDR: "10.2.0.0/16",
+		},
+		"skips blocks contained in a larger taken CIDR": {
+			inTaken:    []string{"10.0.0.0/15"},
+			inSize:     20,
+			wantedCIDR: "10.2.0.0/20",
+		},
+		"moves to the next private range once one is exhausted": {
+			inTaken:    []string{"10.0.0.0/8"},
+			inSize:     16,
+			wantedCIDR: "172.16.0.0/16",
+		},
+		"errors if no block is available": {
+			inTaken:      []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"},
+			inSize:       24,
+			wantedErrMsg: `no /24 block is available in the private address ranges "10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"`,
+		},
+		"errors if the size is out of range": {
+			inSize:       12,
+			wantedErrMsg: "CIDR size /12 must be between /16 and /24",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := suggestVPCCIDR(tc.inTaken, tc.inSize)
+
+			// THEN
+			if tc.wantedErrMsg != "" {
+				require.EqualError(t, err, tc.wantedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedCIDR, got.String())
+		})
+	}
+}
+
+func TestSplitSubnets(t *testing.T) {
+	testCases := map[string]struct {
+		inVPC string
+		inAZs int
+
+		wantedPublic  []string
+		wantedPrivate []string
+		wantedErrMsg  string
+	}{
+		"uses /24 subnets in a large VPC": {
+			inVPC:         "10.0.0.0/16",
+			inAZs:         2,
+			wantedPublic:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+			wantedPrivate: []string{"10.0.2.0/24", "10.0.3.0/24"},
+		},
+		"shrinks the subnets to fit in a small VPC": {
+			inVPC:         "10.0.4.0/24",
+			inAZs:         3,
+			wantedPublic:  []string{"10.0.4.0/27", "10.0.4.32/27", "10.0.4.64/27"},
+			wantedPrivate: []string{"10.0.4.96/27", "10.0.4.128/27", "10.0.4.160/27"},
+		},
+		"errors if the subnets would be too small": {
+			inVPC:        "10.0.4.0/24",
+			inAZs:        16,
+			wantedErrMsg: "VPC CIDR 10.0.4.0/24 is too small for 32 subnets",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			_, vpc, err := net.ParseCIDR(tc.inVPC)
+			require.NoError(t, err)
+
+			// WHEN
+			public, private, err := splitSubnets(vpc, tc.inAZs)
+
+			// THEN
+			if tc.wantedErrMsg != "" {
+				require.EqualError(t, err, tc.wantedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPublic, public)
+			require.Equal(t, tc.wantedPrivate, private)
+		})
+	}
+}
@@ -65,6 +65,7 @@ type deleteEnvOpts struct {
 	store    environmentStore
 	rg       resourceGetter
 	deployer environmentDeployer
+	stackSet envStackSetDetacher
 	iam      roleDeleter
 	prog     progress
 	prompt   prompter
@@ -106,6 +107,11 @@ func newDeleteEnvOpts(vars deleteEnvVars) (*deleteEnvOpts, error) {
 			o.rg = resourcegroupstaggingapi.New(sess)
 			o.iam = iam.New(sess)
 			o.deployer = cloudformation.New(sess)
+			app, err := store.GetApplication(o.appName)
+			if err != nil {
+				return fmt.Errorf("get application %s: %w", o.appName, err)
+			}
+			o.stackSet = cloudformation.NewEnvStackSet(app, defaultSess, sess)
 			return nil
 		},
 	}, nil
@@ -143,9 +149,10 @@ func (o *deleteEnvOpts) Ask() error {
 }
 
 // Execute deletes the environment from the application by:
-// 1. Deleting the cloudformation stack.
-// 2. Deleting the EnvManagerRole and CFNExecutionRole.
-// 3. Deleting the parameter from the SSM store.
+// 1. Removing the cloudformation stack from the environment stack set, if it was deployed through it.
+// 2. Deleting the cloudformation stack.
+// 3. Deleting the EnvManagerRole and CFNExecutionRole.
+// 4. Deleting the parameter from the SSM store.
 // The environment is removed from the store only if other delete operations succeed.
 // Execute assumes that Validate is invoked first.
 func (o *deleteEnvOpts) Execute() error {
@@ -157,6 +164,10 @@ func (o *deleteEnvOpts) Execute() error {
 	}
 
 	o.prog.Start(fmt.Sprintf(fmtDeleteEnvStart, o.name, o.appName))
+	if err := o.detachFromStackSet(); err != nil {
+		o.prog.Stop(log.Serrorf(fmtDeleteEnvFailed, o.name, o.appName))
+		return err
+	}
 	if err := o.ensureRolesAreRetained(); err != nil {
 		o.prog.Stop(log.Serrorf(fmtDeleteEnvFailed, o.name, o.appName))
 		return err
@@ -251,6 +262,19 @@ func (o *deleteEnvOpts) validateNoRunningServices() error {
 	return nil
 }
 
+// detachFromStackSet removes the environment stack from the environment stack set without deleting the stack,
+// so that the stack can be deleted with the environment roles. The stack set is deleted with its last environment.
+func (o *deleteEnvOpts) detachFromStackSet() error {
+	env, err := o.getEnvConfig()
+	if err != nil {
+		return err
+	}
+	if err := o.stackSet.DetachEnvironment(env); err != nil {
+		return fmt.Errorf("remove environment %s from its stack set: %w", o.name, err)
+	}
+	return nil
+}
+
 // ensureRolesAreRetained guarantees that the CloudformationExecutionRole and the EnvironmentManagerRole
 // are retained when the environment cloudformation stack is deleted.
 //
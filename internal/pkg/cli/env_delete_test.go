@@ -219,6 +219,42 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 
 			wantedError: errors.New("service 'frontend, backend' still exist within the environment test"),
 		},
+		"returns wrapped error when environment stack cannot be removed from the stack set": {
+			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
+				rg := mocks.NewMockresourceGetter(ctrl)
+				rg.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+					ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{}}, nil)
+
+				prog := mocks.NewMockprogress(ctrl)
+				prog.EXPECT().Start(gomock.Any())
+
+				stackSet := mocks.NewMockenvStackSetDetacher(ctrl)
+				stackSet.EXPECT().DetachEnvironment(&config.Environment{
+					Name: "test",
+				}).Return(errors.New("some error"))
+
+				deployer := mocks.NewMockenvironmentDeployer(ctrl)
+				deployer.EXPECT().DeleteEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				prog.EXPECT().Stop(log.Serror("Failed to delete environment test from application phonetool.\n"))
+
+				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						appName: "phonetool",
+						name:    "test",
+					},
+					rg:       rg,
+					deployer: deployer,
+					stackSet: stackSet,
+					prog:     prog,
+					envConfig: &config.Environment{
+						Name: "test",
+					},
+					initRuntimeClients: noopInitRuntimeClients,
+				}
+			},
+			wantedError: errors.New("remove environment test from its stack set: some error"),
+		},
 		"returns wrapped error when environment stack cannot be updated to retain roles": {
 			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
 				rg := mocks.NewMockresourceGetter(ctrl)
@@ -228,6 +264,9 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 				prog := mocks.NewMockprogress(ctrl)
 				prog.EXPECT().Start(gomock.Any())
 
+				stackSet := mocks.NewMockenvStackSetDetacher(ctrl)
+				stackSet.EXPECT().DetachEnvironment(gomock.Any()).Return(nil)
+
 				deployer := mocks.NewMockenvironmentDeployer(ctrl)
 				deployer.EXPECT().EnvironmentTemplate(gomock.Any(), gomock.Any()).Return(`
 Resources:
@@ -270,6 +309,7 @@ Resources:
 					},
 					rg:       rg,
 					deployer: deployer,
+					stackSet: stackSet,
 					prog:     prog,
 					envConfig: &config.Environment{
 						ExecutionRoleARN: "arn",
@@ -288,6 +328,9 @@ Resources:
 				prog := mocks.NewMockprogress(ctrl)
 				prog.EXPECT().Start(gomock.Any())
 
+				stackSet := mocks.NewMockenvStackSetDetacher(ctrl)
+				stackSet.EXPECT().DetachEnvironment(gomock.Any()).Return(nil)
+
 				deployer := mocks.NewMockenvironmentDeployer(ctrl)
 				deployer.EXPECT().EnvironmentTemplate(gomock.Any(), gomock.Any()).Return(`
 Resources:
@@ -307,6 +350,7 @@ Resources:
 					},
 					rg:                 rg,
 					deployer:           deployer,
+					stackSet:           stackSet,
 					prog:               prog,
 					envConfig:          &config.Environment{},
 					initRuntimeClients: noopInitRuntimeClients,
@@ -324,6 +368,9 @@ Resources:
 				prog := mocks.NewMockprogress(ctrl)
 				prog.EXPECT().Start("Deleting environment test from application phonetool.")
 
+				stackSet := mocks.NewMockenvStackSetDetacher(ctrl)
+				stackSet.EXPECT().DetachEnvironment(gomock.Any()).Return(nil)
+
 				deployer := mocks.NewMockenvironmentDeployer(ctrl)
 				deployer.EXPECT().EnvironmentTemplate("phonetool", "test").Return(`
 Resources:
@@ -353,6 +400,7 @@ Resources:
 					},
 					rg:       rg,
 					deployer: deployer,
+					stackSet: stackSet,
 					prog:     prog,
 					iam:      iam,
 					store:    store,
@@ -4,26 +4,78 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
+const (
+	continueEnvDeploymentPrompt  = "Continue with the deployment?"
+	fmtEnvSecurityChangesPrompt  = "Deploying environment %s changes its IAM or security group resources. Continue with the deployment?"
+	envSecurityChangesHelpPrompt = "Review the permission changes before deploying them. Run with --yes-security to skip this confirmation."
+
+	fmtEnvDetectDriftStart    = "Detecting drift on environment %s."
+	fmtEnvDetectDriftFailed   = "Failed to detect drift on environment %s.\n"
+	fmtEnvDetectDriftComplete = "Detected drift on environment %s.\n"
+	fmtEnvDetectDriftNone     = "Environment %s has not drifted from its template.\n"
+
+	// maxConcurrentEnvDeployments is the maximum number of environments deployed at the same time with --all.
+	maxConcurrentEnvDeployments = 4
+
+	// Formats to report the progress of an environment deployment.
+	progressFormatHuman = "human"
+	progressFormatJSON  = "json"
+)
+
+var progressFormats = []string{progressFormatHuman, progressFormatJSON}
+
 type deployEnvVars struct {
-	appName string
-	name    string
+	appName         string
+	name            string
+	showDiff        bool
+	yesSecurity     bool
+	detectDrift     bool
+	failOnDrift     bool
+	allEnvs         bool
+	noWait          bool
+	showStatus      bool
+	createChangeSet bool
+	forceNewUpdate  bool
+	progressFormat  string
+	useStackSet     bool
+	roleARN         string
+	allowDowngrade  bool
+	disableRollback bool
+	timeout         time.Duration
+	templatePath    string
+	paramsPath      string
+
+	skipCustomResourcesUpload bool
 }
 
 type deployEnvOpts struct {
@@ -34,13 +86,18 @@ type deployEnvOpts struct {
 	sessionProvider *sessions.Provider
 
 	// Dependencies to ask.
-	sel wsEnvironmentSelector
+	sel    wsEnvironmentSelector
+	prompt prompter
 
 	// Dependencies to execute.
-	ws              wsEnvironmentReader
+	ws              wsEnvironmentReadLister
 	identity        identityService
 	newInterpolator func(app, env string) interpolator
-	newEnvDeployer  func() (envDeployer, error)
+	newEnvDeployer  func(env *config.Environment, progressOut termprogress.FileWriter) (envDeployer, error)
+	fs              afero.Fs
+	diffWriter      io.Writer
+	changeSetWriter io.Writer
+	spinner         progress
 
 	// Cached variables.
 	targetApp *config.Application
@@ -58,41 +115,111 @@ func newEnvDeployOpts(vars deployEnvVars) (*deployEnvOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new workspace: %w", err)
 	}
+	prompter := prompt.New()
 	opts := &deployEnvOpts{
 		deployEnvVars: vars,
 
 		store:           store,
 		sessionProvider: sessProvider,
-		sel:             selector.NewLocalEnvironmentSelector(prompt.New(), store, ws),
+		sel:             selector.NewLocalEnvironmentSelector(prompter, store, ws),
+		prompt:          prompter,
 
 		ws:              ws,
 		identity:        identity.New(defaultSess),
 		newInterpolator: newManifestInterpolator,
+		fs:              afero.NewOsFs(),
+		diffWriter:      log.OutputWriter,
+		changeSetWriter: log.OutputWriter,
+		spinner:         termprogress.NewSpinner(log.DiagnosticWriter),
 	}
-	opts.newEnvDeployer = func() (envDeployer, error) {
-		return newEnvDeployer(opts)
+	opts.newEnvDeployer = func(env *config.Environment, progressOut termprogress.FileWriter) (envDeployer, error) {
+		return newEnvDeployer(opts, env, progressOut, ws)
 	}
 	return opts, nil
 }
 
-func newEnvDeployer(opts *deployEnvOpts) (envDeployer, error) {
+// newEnvDeployer returns a deployer for the environment that renders its progress to progressOut, or to os.Stderr if nil.
+func newEnvDeployer(opts *deployEnvOpts, env *config.Environment, progressOut termprogress.FileWriter, ws guardRulesDirGetter) (envDeployer, error) {
 	app, err := opts.cachedTargetApp()
 	if err != nil {
 		return nil, err
 	}
-	env, err := opts.cachedTargetEnv()
+	guardRulesDir, err := ws.GuardRulesDir()
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("get cfn-guard rules directory: %w", err)
 	}
-	return deploy.NewEnvDeployer(&deploy.NewEnvDeployerInput{
+	in := &deploy.NewEnvDeployerInput{
 		App:             app,
 		Env:             env,
 		SessionProvider: opts.sessionProvider,
-	})
+		UseStackSet:     opts.useStackSet,
+		GuardRulesDir:   guardRulesDir,
+		ProgressOut:     progressOut,
+	}
+	return deploy.NewEnvDeployer(in)
 }
 
-// Validate is a no-op for this command.
+// Validate returns an error if the flag values are incompatible with each other.
 func (o *deployEnvOpts) Validate() error {
+	if err := o.validateProgressFormat(); err != nil {
+		return err
+	}
+	if err := o.validateStackSet(); err != nil {
+		return err
+	}
+	if err := o.validateRoleARN(); err != nil {
+		return err
+	}
+	if err := o.validateTimeout(); err != nil {
+		return err
+	}
+	if err := o.validatePackagedTemplate(); err != nil {
+		return err
+	}
+	if o.showStatus {
+		if o.noWait {
+			return fmt.Errorf("cannot specify both --%s and --%s", statusFlag, noWaitFlag)
+		}
+		if o.showDiff {
+			return fmt.Errorf("cannot specify both --%s and --%s", statusFlag, diffFlag)
+		}
+		if o.detectDrift || o.failOnDrift {
+			return fmt.Errorf("cannot specify --%s with --%s or --%s", statusFlag, detectDriftFlag, failOnDriftFlag)
+		}
+		if o.forceNewUpdate {
+			return fmt.Errorf("cannot specify both --%s and --%s", statusFlag, forceFlag)
+		}
+	}
+	if o.createChangeSet {
+		for _, flag := range []struct {
+			name  string
+			isSet bool
+		}{
+			{allFlag, o.allEnvs},
+			{noWaitFlag, o.noWait},
+			{statusFlag, o.showStatus},
+			{diffFlag, o.showDiff},
+		} {
+			if flag.isSet {
+				return fmt.Errorf("cannot specify both --%s and --%s", createChangeSetFlag, flag.name)
+			}
+		}
+	}
+	if !o.allEnvs {
+		return nil
+	}
+	if o.showStatus {
+		return fmt.Errorf("cannot specify both --%s and --%s", allFlag, statusFlag)
+	}
+	if o.name != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s", allFlag, nameFlag)
+	}
+	if o.showDiff {
+		return fmt.Errorf("cannot specify both --%s and --%s", allFlag, diffFlag)
+	}
+	if o.detectDrift || o.failOnDrift {
+		return fmt.Errorf("cannot specify --%s with --%s or --%s", allFlag, detectDriftFlag, failOnDriftFlag)
+	}
 	return nil
 }
 
@@ -105,16 +232,21 @@ func (o *deployEnvOpts) Ask() error {
 	if _, err := o.cachedTargetApp(); err != nil {
 		return err
 	}
+	if o.allEnvs {
+		return nil
+	}
 	return o.validateOrAskEnvName()
 }
 
 // Execute deploys an environment given a manifest.
 func (o *deployEnvOpts) Execute() error {
-	rawMft, err := o.ws.ReadEnvironmentManifest(o.name)
-	if err != nil {
-		return fmt.Errorf("read manifest for environment %q: %w", o.name, err)
+	if o.allEnvs {
+		return o.deployAllEnvs()
 	}
-	mft, err := environmentManifest(o.name, rawMft, o.newInterpolator(o.appName, o.name))
+	if o.showStatus {
+		return o.attachToDeployment()
+	}
+	mft, rawMft, err := o.readManifest(o.name)
 	if err != nil {
 		return err
 	}
@@ -122,21 +254,418 @@ func (o *deployEnvOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("get identity: %w", err)
 	}
-	deployer, err := o.newEnvDeployer()
+	env, err := o.cachedTargetEnv()
 	if err != nil {
 		return err
 	}
-	urls, err := deployer.UploadArtifacts()
+	deployer, err := o.newEnvDeployer(env, nil)
 	if err != nil {
+		return err
+	}
+	if o.detectDrift || o.failOnDrift {
+		if err := o.checkDrift(deployer); err != nil {
+			return err
+		}
+	}
+	deployIn := &deploy.DeployEnvironmentInput{
+		RootUserARN:      caller.RootUserARN,
+		Manifest:         mft,
+		RawManifest:      rawMft,
+		ForceNewUpdate:   o.forceNewUpdate,
+		JSONProgress:     o.progressFormat == progressFormatJSON,
+		ExecutionRoleARN: o.roleARN,
+		AllowDowngrade:   o.allowDowngrade,
+		Timeout:          o.timeout,
+		DisableRollback:  o.disableRollback,
+	}
+	if o.templatePath != "" {
+		// The custom resources referenced by a packaged template were uploaded when it was generated.
+		if deployIn.Packaged, err = readPackagedTemplate(o.fs, o.templatePath, o.paramsPath); err != nil {
+			return err
+		}
+	} else if deployIn.CustomResourcesURLs, err = o.customResourcesURLs(deployer, o.name, mft); err != nil {
 		return fmt.Errorf("upload artifacts for environment %s: %w", o.name, err)
 	}
-	if err := deployer.DeployEnvironment(&deploy.DeployEnvironmentInput{
+	if o.showDiff {
+		contd, err := o.showDiffAndConfirm(deployer, deployIn)
+		if err != nil {
+			return err
+		}
+		if !contd {
+			return nil
+		}
+	} else if !o.yesSecurity && !o.createChangeSet && deployIn.Packaged == nil {
+		contd, err := o.confirmSecurityChanges(deployer, deployIn)
+		if err != nil {
+			return err
+		}
+		if !contd {
+			return nil
+		}
+	}
+	if o.createChangeSet {
+		return o.createAndPrintChangeSet(deployer, deployIn)
+	}
+	if o.noWait {
+		deployment, err := deployer.DeployEnvironmentNoWait(deployIn)
+		if err != nil {
+			o.showDowngradeDiff(deployer, deployIn, err)
+			return fmt.Errorf("deploy environment %s: %w", o.name, errIfEnvStackRolledBack(o.name, err))
+		}
+		logDeploymentStarted(o.name, deployment)
+		log.Infof("Run %s to follow the deployment until it completes.\n",
+			color.HighlightCode(fmt.Sprintf("copilot env deploy --name %s --%s", o.name, statusFlag)))
+		return nil
+	}
+	if err := deployer.DeployEnvironment(deployIn); err != nil {
+		o.showDowngradeDiff(deployer, deployIn, err)
+		o.showRollbackInstructions(env)
+		return fmt.Errorf("deploy environment %s: %w", o.name, errIfEnvStackRolledBack(o.name, err))
+	}
+	return nil
+}
+
+// showRollbackInstructions prints how to recover the environment stack if automatic rollback was disabled for the failed deployment.
+func (o *deployEnvOpts) showRollbackInstructions(env *config.Environment) {
+	if !o.disableRollback {
+		return
+	}
+	roleARN := env.ExecutionRoleARN
+	if o.roleARN != "" {
+		roleARN = o.roleARN
+	}
+	rollbackCmd := fmt.Sprintf("aws cloudformation rollback-stack --stack-name %s --role-arn %s", stack.NameForEnv(o.appName, o.name), roleARN)
+	log.Infof(`It seems like you have disabled automatic stack rollback for this deployment. To debug, you can:
+* Visit the AWS console to inspect the errors.
+After fixing the deployment, you can:
+1. Run %s to rollback the deployment.
+2. Run %s to make a new deployment.
+`, color.HighlightCode(rollbackCmd), color.HighlightCode("copilot env deploy"))
+}
+
+// showDowngradeDiff prints the changes that deploying the older template would make to the environment stack,
+// if the deployment was refused because the deployed stack has a newer template version.
+func (o *deployEnvOpts) showDowngradeDiff(deployer envDeployer, in *deploy.DeployEnvironmentInput, deployErr error) {
+	var errDowngrade *deploy.ErrEnvTemplateDowngrade
+	if !errors.As(deployErr, &errDowngrade) {
+		return
+	}
+	out, err := deployer.GenerateCloudFormationTemplate(in)
+	if err != nil {
+		log.Warningf("Failed to compare the template version %s against the deployed version %s: %v\n", errDowngrade.Version, errDowngrade.DeployedVersion, err)
+		return
+	}
+	log.Infof("Deploying template version %s over %s would make the following changes to environment %s:\n",
+		errDowngrade.Version, errDowngrade.DeployedVersion, o.name)
+	fmt.Fprint(o.diffWriter, out.Diff.HumanString())
+}
+
+// createAndPrintChangeSet creates a change set for the environment stack and prints its changes without executing it.
+func (o *deployEnvOpts) createAndPrintChangeSet(deployer envDeployer, in *deploy.DeployEnvironmentInput) error {
+	changeSet, err := deployer.CreateChangeSet(in)
+	if err != nil {
+		var errEmptyChangeSet *awscloudformation.ErrChangeSetEmpty
+		if errors.As(err, &errEmptyChangeSet) {
+			log.Infof("No changes to deploy for environment %s.\n", color.HighlightUserInput(o.name))
+			return nil
+		}
+		return fmt.Errorf("create change set for environment %s: %w", o.name, err)
+	}
+	log.Successf("Created change set %s for stack %s.\n", changeSet.ID, changeSet.StackName)
+	var b strings.Builder
+	fmt.Fprintf(&b, "The change set contains %s:\n", english.Plural(len(changeSet.Changes), "change", ""))
+	for _, change := range changeSet.Changes {
+		rc := change.ResourceChange
+		if rc == nil {
+			continue
+		}
+		fmt.Fprintf(&b, "  - %s (%s): %s", aws.StringValue(rc.LogicalResourceId), aws.StringValue(rc.ResourceType),
+			strings.ToLower(aws.StringValue(rc.Action)))
+		if replacement := aws.StringValue(rc.Replacement); replacement != "" {
+			fmt.Fprintf(&b, ", replacement: %s", strings.ToLower(replacement))
+		}
+		fmt.Fprintln(&b)
+	}
+	fmt.Fprint(o.changeSetWriter, b.String())
+	log.Infoln("Review the change set in the AWS CloudFormation console, then execute it to deploy the environment.")
+	return nil
+}
+
+// attachToDeployment follows the deployment in progress of the environment until it completes.
+func (o *deployEnvOpts) attachToDeployment() error {
+	env, err := o.cachedTargetEnv()
+	if err != nil {
+		return err
+	}
+	deployer, err := o.newEnvDeployer(env, nil)
+	if err != nil {
+		return err
+	}
+	if err := deployer.AttachToDeployment(); err != nil {
+		return fmt.Errorf("follow the deployment of environment %s: %w", o.name, err)
+	}
+	log.Successf("Environment %s is deployed.\n", color.HighlightUserInput(o.name))
+	return nil
+}
+
+func logDeploymentStarted(envName string, deployment *deploy.EnvironmentDeployment) {
+	log.Successf("Started deploying environment %s.\n", color.HighlightUserInput(envName))
+	log.Infof("  - Stack: %s\n", deployment.StackName)
+	log.Infof("  - Change set: %s\n", deployment.ChangeSetID)
+}
+
+// envDeployment holds everything needed to deploy one of the environments with --all.
+type envDeployment struct {
+	name     string
+	deployer envDeployer
+	mft      *manifest.Environment
+	rawMft   []byte
+	progress *termprogress.FrameWriter // Buffered progress of the deployment, nil if it's rendered as it happens.
+}
+
+// deployAllEnvs deploys every environment that has a manifest in the workspace.
+// The environments are deployed concurrently, and a failure to deploy one environment doesn't stop the others.
+func (o *deployEnvOpts) deployAllEnvs() error {
+	deployments, err := o.allEnvDeployments()
+	if err != nil {
+		return err
+	}
+	if len(deployments) == 0 {
+		log.Infoln("No environments to deploy.")
+		return nil
+	}
+	caller, err := o.identity.Get()
+	if err != nil {
+		return fmt.Errorf("get identity: %w", err)
+	}
+	var names []string
+	for _, d := range deployments {
+		names = append(names, d.name)
+	}
+	concurrency := maxConcurrentEnvDeployments
+	if o.useStackSet {
+		// The environments share a stack set, and stack set operations can't run at the same time.
+		concurrency = 1
+		log.Infof("Deploying %s %s one at a time through a stack set.\n", english.PluralWord(len(names), "environment", "environments"),
+			english.WordSeries(names, "and"))
+	} else {
+		log.Infof("Deploying %s %s in parallel.\n", english.PluralWord(len(names), "environment", "environments"),
+			english.WordSeries(names, "and"))
+	}
+
+	errs := make([]error, len(deployments))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	var logMu sync.Mutex
+	for i, d := range deployments {
+		wg.Add(1)
+		go func(i int, d *envDeployment) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			logMu.Lock()
+			log.Infof("Deploying environment %s.\n", color.HighlightUserInput(d.name))
+			logMu.Unlock()
+			err := o.deployEnv(d, caller)
+
+			logMu.Lock()
+			defer logMu.Unlock()
+			if d.progress != nil {
+				if out := d.progress.String(); out != "" {
+					log.Infof("\nProgress of environment %s:\n", color.HighlightUserInput(d.name))
+					fmt.Fprint(log.DiagnosticWriter, out)
+				}
+			}
+			if err != nil {
+				log.Errorf("Failed to deploy environment %s: %v\n", color.HighlightUserInput(d.name), err)
+				var errRolledBack *errEnvStackRolledBack
+				if errors.As(err, &errRolledBack) {
+					log.Infoln(errRolledBack.RecommendActions())
+				}
+				errs[i] = err
+				return
+			}
+			if !o.noWait {
+				log.Successf("Deployed environment %s.\n", color.HighlightUserInput(d.name))
+			}
+		}(i, d)
+	}
+	wg.Wait()
+
+	var failed []string
+	for i, err := range errs {
+		if err != nil {
+			failed = append(failed, deployments[i].name)
+		}
+	}
+	if len(failed) != 0 {
+		return &errEnvDeploymentsFailed{
+			failed: failed,
+			total:  len(deployments),
+		}
+	}
+	return nil
+}
+
+// allEnvDeployments reads and validates the manifest of every environment in the workspace,
+// so that no environment is deployed if any of the manifests is invalid.
+func (o *deployEnvOpts) allEnvDeployments() ([]*envDeployment, error) {
+	names, err := o.ws.ListEnvironments()
+	if err != nil {
+		return nil, fmt.Errorf("list environments in workspace: %w", err)
+	}
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	added := make(map[string]*config.Environment)
+	for _, env := range envs {
+		added[env.Name] = env
+	}
+	var deployments []*envDeployment
+	for _, name := range names {
+		env, ok := added[name]
+		if !ok {
+			log.Warningf("Skipping environment %s since it is not added to application %s yet.\n", name, o.appName)
+			continue
+		}
+		mft, rawMft, err := o.readManifest(name)
+		if err != nil {
+			return nil, err
+		}
+		d := &envDeployment{
+			name:   name,
+			mft:    mft,
+			rawMft: rawMft,
+		}
+		var progressOut termprogress.FileWriter
+		if !o.useStackSet {
+			// Rendering the progress of multiple stacks at the same time would garble the terminal,
+			// so it's buffered and printed once the environment is deployed.
+			d.progress = termprogress.NewFrameWriter()
+			progressOut = d.progress
+		}
+		if d.deployer, err = o.newEnvDeployer(env, progressOut); err != nil {
+			return nil, err
+		}
+		deployments = append(deployments, d)
+	}
+	return deployments, nil
+}
+
+func (o *deployEnvOpts) deployEnv(d *envDeployment, caller identity.Caller) error {
+	urls, err := o.customResourcesURLs(d.deployer, d.name, d.mft)
+	if err != nil {
+		return fmt.Errorf("upload artifacts for environment %s: %w", d.name, err)
+	}
+	in := &deploy.DeployEnvironmentInput{
 		RootUserARN:         caller.RootUserARN,
 		CustomResourcesURLs: urls,
-		Manifest:            mft,
-		RawManifest:         rawMft,
-	}); err != nil {
-		return fmt.Errorf("deploy environment %s: %w", o.name, err)
+		Manifest:            d.mft,
+		RawManifest:         d.rawMft,
+		ForceNewUpdate:      o.forceNewUpdate,
+		AllowDowngrade:      o.allowDowngrade,
+		Timeout:             o.timeout,
+		DisableRollback:     o.disableRollback,
+	}
+	if !o.yesSecurity {
+		// Environments are deployed in parallel, so the changes can't be confirmed interactively.
+		out, err := d.deployer.GenerateCloudFormationTemplate(in)
+		if err != nil {
+			return fmt.Errorf("generate the template for environment %s: %w", d.name, err)
+		}
+		if ids := out.Diff.SecurityChanges(); len(ids) != 0 {
+			return fmt.Errorf("deploying environment %s changes IAM or security group resources %s: review them with --%s or run with --%s",
+				d.name, english.WordSeries(ids, "and"), diffFlag, yesSecurityFlag)
+		}
+	}
+	if o.noWait {
+		deployment, err := d.deployer.DeployEnvironmentNoWait(in)
+		if err != nil {
+			return fmt.Errorf("deploy environment %s: %w", d.name, errIfEnvStackRolledBack(d.name, err))
+		}
+		logDeploymentStarted(d.name, deployment)
+		return nil
+	}
+	if err := d.deployer.DeployEnvironment(in); err != nil {
+		return fmt.Errorf("deploy environment %s: %w", d.name, errIfEnvStackRolledBack(d.name, err))
+	}
+	return nil
+}
+
+func (o *deployEnvOpts) readManifest(envName string) (*manifest.Environment, []byte, error) {
+	rawMft, err := o.ws.ReadEnvironmentManifest(envName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read manifest for environment %q: %w", envName, err)
+	}
+	mft, err := environmentManifest(envName, rawMft, o.newInterpolator(o.appName, envName))
+	if err != nil {
+		return nil, nil, err
+	}
+	return mft, rawMft, nil
+}
+
+// showDiffAndConfirm prints the differences between the deployed environment stack and the one to be deployed,
+// and returns true if the user wants to continue with the deployment.
+func (o *deployEnvOpts) showDiffAndConfirm(deployer envDeployer, in *deploy.DeployEnvironmentInput) (bool, error) {
+	out, err := deployer.GenerateCloudFormationTemplate(in)
+	if err != nil {
+		return false, fmt.Errorf("generate the template for environment %s: %w", o.name, err)
+	}
+	fmt.Fprint(o.diffWriter, out.Diff.HumanString())
+	contd, err := o.prompt.Confirm(continueEnvDeploymentPrompt, "")
+	if err != nil {
+		return false, fmt.Errorf("confirm deployment of environment %s: %w", o.name, err)
+	}
+	return contd, nil
+}
+
+// confirmSecurityChanges prints the IAM and security group resources that the deployment changes in the environment stack,
+// and returns true if there are none or if the user wants to continue with the deployment.
+func (o *deployEnvOpts) confirmSecurityChanges(deployer envDeployer, in *deploy.DeployEnvironmentInput) (bool, error) {
+	out, err := deployer.GenerateCloudFormationTemplate(in)
+	if err != nil {
+		return false, fmt.Errorf("generate the template for environment %s: %w", o.name, err)
+	}
+	summary := out.Diff.SecurityHumanString()
+	if summary == "" {
+		return true, nil
+	}
+	fmt.Fprint(o.diffWriter, summary)
+	contd, err := o.prompt.Confirm(fmt.Sprintf(fmtEnvSecurityChangesPrompt, o.name), envSecurityChangesHelpPrompt)
+	if err != nil {
+		return false, fmt.Errorf("confirm security-impacting changes of environment %s: %w", o.name, err)
+	}
+	return contd, nil
+}
+
+// checkDrift warns if the deployed environment stack has drifted from its template,
+// and returns an error if the deployment should be stopped because of the drift.
+func (o *deployEnvOpts) checkDrift(deployer envDeployer) error {
+	o.spinner.Start(fmt.Sprintf(fmtEnvDetectDriftStart, color.HighlightUserInput(o.name)))
+	drifts, err := deployer.DetectDrift()
+	if err != nil {
+		o.spinner.Stop(log.Serrorf(fmtEnvDetectDriftFailed, color.HighlightUserInput(o.name)))
+		return fmt.Errorf("detect drift on environment %s: %w", o.name, err)
+	}
+	if len(drifts) == 0 {
+		o.spinner.Stop(log.Ssuccessf(fmtEnvDetectDriftNone, color.HighlightUserInput(o.name)))
+		return nil
+	}
+	o.spinner.Stop(fmt.Sprintf(fmtEnvDetectDriftComplete, color.HighlightUserInput(o.name)))
+	var b strings.Builder
+	fmt.Fprintln(&b, "The following resources were changed outside of Copilot, deploying will overwrite these changes:")
+	for _, drift := range drifts {
+		fmt.Fprintf(&b, "  - %s (%s): %s\n", aws.StringValue(drift.LogicalResourceId), aws.StringValue(drift.ResourceType),
+			strings.ToLower(aws.StringValue(drift.StackResourceDriftStatus)))
+	}
+	log.Warning(b.String())
+	if o.failOnDrift {
+		return &errEnvStackDrifted{
+			envName:      o.name,
+			numResources: len(drifts),
+		}
 	}
 	return nil
 }
@@ -153,9 +682,72 @@ func environmentManifest(envName string, rawMft []byte, transformer interpolator
 	if err := mft.Validate(); err != nil {
 		return nil, fmt.Errorf("validate environment manifest for %q: %w", envName, err)
 	}
+	if err := mft.Features.ValidateCLIVersion(version.Version); err != nil {
+		return nil, fmt.Errorf("validate features of environment manifest for %q: %w", envName, err)
+	}
 	return mft, nil
 }
 
+type errEnvStackDrifted struct {
+	envName      string
+	numResources int
+}
+
+func (e *errEnvStackDrifted) Error() string {
+	return fmt.Sprintf("environment %s has %d %s that drifted from its template", e.envName, e.numResources,
+		english.PluralWord(e.numResources, "resource", "resources"))
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+// Implements main.actionRecommender interface.
+func (e *errEnvStackDrifted) RecommendActions() string {
+	return fmt.Sprintf("Reconcile the changes in your environment manifest, or run %s to overwrite them.",
+		color.HighlightCode(fmt.Sprintf("copilot env deploy --name %s", e.envName)))
+}
+
+// errIfEnvStackRolledBack explains how to recover an environment whose stack failed to be created.
+// Unlike workload stacks, the stack isn't recreated on deploy since it holds the roles created by "env init".
+func errIfEnvStackRolledBack(envName string, err error) error {
+	var errRollbackComplete *deploycfn.ErrStackRollbackComplete
+	if !errors.As(err, &errRollbackComplete) {
+		return err
+	}
+	return &errEnvStackRolledBack{
+		envName: envName,
+		err:     err,
+	}
+}
+
+type errEnvStackRolledBack struct {
+	envName string
+	err     error
+}
+
+func (e *errEnvStackRolledBack) Error() string {
+	return e.err.Error()
+}
+
+func (e *errEnvStackRolledBack) Unwrap() error {
+	return e.err
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+// Implements main.actionRecommender interface.
+func (e *errEnvStackRolledBack) RecommendActions() string {
+	return fmt.Sprintf("Run %s and then %s to recreate environment %s.",
+		color.HighlightCode(fmt.Sprintf("copilot env delete --name %s", e.envName)),
+		color.HighlightCode(fmt.Sprintf("copilot env init --name %s", e.envName)), e.envName)
+}
+
+type errEnvDeploymentsFailed struct {
+	failed []string
+	total  int
+}
+
+func (e *errEnvDeploymentsFailed) Error() string {
+	return fmt.Sprintf("%d of %d environments failed to deploy: %s", len(e.failed), e.total, english.WordSeries(e.failed, "and"))
+}
+
 func (o *deployEnvOpts) validateOrAskEnvName() error {
 	if o.name != "" {
 		if _, err := o.cachedTargetEnv(); err != nil {
@@ -195,6 +787,131 @@ func (o *deployEnvOpts) cachedTargetApp() (*config.Application, error) {
 	return o.targetApp, nil
 }
 
+func (o *deployEnvOpts) validateProgressFormat() error {
+	switch o.progressFormat {
+	case "", progressFormatHuman:
+		return nil
+	case progressFormatJSON:
+	default:
+		return fmt.Errorf("invalid progress format %q; must be one of %s", o.progressFormat, english.WordSeries(quoteStringSlice(progressFormats), "or"))
+	}
+	for _, flag := range []struct {
+		name  string
+		isSet bool
+	}{
+		{allFlag, o.allEnvs},
+		{noWaitFlag, o.noWait},
+		{statusFlag, o.showStatus},
+		{diffFlag, o.showDiff},
+		{createChangeSetFlag, o.createChangeSet},
+	} {
+		if flag.isSet {
+			return fmt.Errorf("cannot specify both --%s %s and --%s", progressFlag, progressFormatJSON, flag.name)
+		}
+	}
+	return nil
+}
+
+func (o *deployEnvOpts) validateStackSet() error {
+	if !o.useStackSet {
+		return nil
+	}
+	for _, flag := range []struct {
+		name  string
+		isSet bool
+	}{
+		{noWaitFlag, o.noWait},
+		{statusFlag, o.showStatus},
+		{createChangeSetFlag, o.createChangeSet},
+	} {
+		if flag.isSet {
+			return fmt.Errorf("cannot specify both --%s and --%s", stackSetFlag, flag.name)
+		}
+	}
+	return nil
+}
+
+func (o *deployEnvOpts) validateRoleARN() error {
+	if o.roleARN == "" {
+		return nil
+	}
+	if o.allEnvs {
+		// Environments can be in different accounts, so each of them configures its role in its manifest instead.
+		return fmt.Errorf("cannot specify both --%s and --%s", roleARNFlag, allFlag)
+	}
+	return validateRoleARNFlag(o.roleARN)
+}
+
+// validateRoleARNFlag returns an error if the value of the --role-arn flag is not the ARN of an IAM role.
+func validateRoleARNFlag(roleARN string) error {
+	parsed, err := arn.Parse(roleARN)
+	if err != nil || parsed.Service != "iam" || !strings.HasPrefix(parsed.Resource, "role/") {
+		return fmt.Errorf("--%s %q is not a valid IAM role ARN", roleARNFlag, roleARN)
+	}
+	return nil
+}
+
+func (o *deployEnvOpts) validateTimeout() error {
+	if o.timeout == 0 {
+		return nil
+	}
+	if o.timeout < 0 {
+		return fmt.Errorf("--%s %s must be positive", timeoutFlag, o.timeout)
+	}
+	// The timeout only applies to deployments that wait for the stack update to complete.
+	for _, flag := range []struct {
+		name  string
+		isSet bool
+	}{
+		{noWaitFlag, o.noWait},
+		{statusFlag, o.showStatus},
+		{createChangeSetFlag, o.createChangeSet},
+	} {
+		if flag.isSet {
+			return fmt.Errorf("cannot specify both --%s and --%s", timeoutFlag, flag.name)
+		}
+	}
+	return nil
+}
+
+// validatePackagedTemplate returns an error if the packaged template flags are incomplete,
+// or used with flags that don't deploy a single environment stack from the template.
+func (o *deployEnvOpts) validatePackagedTemplate() error {
+	if (o.templatePath == "") != (o.paramsPath == "") {
+		return fmt.Errorf("--%s and --%s must be specified together", templateFlag, paramsFlag)
+	}
+	if o.templatePath == "" {
+		return nil
+	}
+	for _, flag := range []struct {
+		name  string
+		isSet bool
+	}{
+		{allFlag, o.allEnvs},
+		{statusFlag, o.showStatus},
+		{forceFlag, o.forceNewUpdate},
+		{skipCustomResourcesUploadFlag, o.skipCustomResourcesUpload},
+	} {
+		if flag.isSet {
+			return fmt.Errorf("cannot specify both --%s and --%s", templateFlag, flag.name)
+		}
+	}
+	return nil
+}
+
+// customResourcesURLs uploads the custom resources of the environment and returns their URLs.
+// If their upload is skipped, it returns their URLs in the bucket seeded ahead of time that the manifest refers to instead.
+func (o *deployEnvOpts) customResourcesURLs(deployer envDeployer, envName string, mft *manifest.Environment) (map[string]string, error) {
+	if !o.skipCustomResourcesUpload {
+		return deployer.UploadArtifacts()
+	}
+	bucket := aws.StringValue(mft.Deployment.CustomResourcesBucket)
+	if bucket == "" {
+		return nil, fmt.Errorf(`"deployment.custom_resources_bucket" must be set in the manifest of environment %s to skip uploading custom resources`, envName)
+	}
+	return deployer.PreSeededArtifacts(bucket)
+}
+
 // buildEnvDeployCmd builds the command for deploying an environment given a manifest.
 func buildEnvDeployCmd() *cobra.Command {
 	vars := deployEnvVars{}
@@ -204,7 +921,32 @@ func buildEnvDeployCmd() *cobra.Command {
 		Long:  "Deploys an environment to an application.",
 		Example: `
 Deploy an environment named "test".
-/code $copilot env deploy --name test`,
+/code $copilot env deploy --name test
+Review the changes to the "test" environment stack before deploying.
+/code $copilot env deploy --name test --diff
+Stop the deployment if the "test" environment stack was changed outside of Copilot.
+/code $copilot env deploy --name test --fail-on-drift
+Deploy every environment in your workspace in parallel.
+/code $copilot env deploy --all
+Start deploying the "test" environment without waiting, then follow the deployment later.
+/code $copilot env deploy --name test --no-wait
+/code $copilot env deploy --name test --status
+Create a change set for the "prod" environment to review before executing it.
+/code $copilot env deploy --name prod --create-change-set
+Update the "test" environment stack even if nothing changed, to run its custom resources again.
+/code $copilot env deploy --name test --force
+Write the progress of the deployment as JSON lines for a CI system to parse.
+/code $copilot env deploy --name test --progress json
+Deploy every environment in your workspace through CloudFormation StackSets.
+/code $copilot env deploy --all --stackset
+Deploy the "prod" environment with a restricted role instead of the one created by Copilot.
+/code $copilot env deploy --name prod --role-arn arn:aws:iam::123456789012:role/restricted-deploy
+Deploy the "test" environment with this version of Copilot even if a newer version deployed it last.
+/code $copilot env deploy --name test --allow-downgrade
+Wait up to 3 hours for the "prod" environment stack to update.
+/code $copilot env deploy --name prod --timeout 3h
+Deploy the template and configuration generated by "copilot env package --output-dir infrastructure --upload-assets".
+/code $copilot env deploy --name prod --template infrastructure/prod.env.yml --params infrastructure/prod.env.params.json`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newEnvDeployOpts(vars)
 			if err != nil {
@@ -215,5 +957,23 @@ Deploy an environment named "test".
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().BoolVar(&vars.yesSecurity, yesSecurityFlag, false, yesSecurityFlagDescription)
+	cmd.Flags().BoolVar(&vars.detectDrift, detectDriftFlag, false, detectDriftFlagDescription)
+	cmd.Flags().BoolVar(&vars.failOnDrift, failOnDriftFlag, false, failOnDriftFlagDescription)
+	cmd.Flags().BoolVar(&vars.allEnvs, allFlag, false, deployAllEnvsFlagDescription)
+	cmd.Flags().BoolVar(&vars.noWait, noWaitFlag, false, envNoWaitFlagDescription)
+	cmd.Flags().BoolVar(&vars.showStatus, statusFlag, false, envStatusFlagDescription)
+	cmd.Flags().BoolVar(&vars.createChangeSet, createChangeSetFlag, false, createChangeSetFlagDescription)
+	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, envForceFlagDescription)
+	cmd.Flags().StringVar(&vars.progressFormat, progressFlag, progressFormatHuman, envProgressFlagDescription)
+	cmd.Flags().BoolVar(&vars.useStackSet, stackSetFlag, false, envStackSetFlagDescription)
+	cmd.Flags().StringVar(&vars.roleARN, roleARNFlag, "", envRoleARNFlagDescription)
+	cmd.Flags().BoolVar(&vars.allowDowngrade, allowDowngradeFlag, false, envAllowDowngradeFlagDescription)
+	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
+	cmd.Flags().DurationVar(&vars.timeout, timeoutFlag, 0, envTimeoutFlagDescription)
+	cmd.Flags().StringVar(&vars.templatePath, templateFlag, "", packagedTemplateFlagDescription)
+	cmd.Flags().StringVar(&vars.paramsPath, paramsFlag, "", packagedParamsFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipCustomResourcesUpload, skipCustomResourcesUploadFlag, false, skipCustomResourcesUploadFlagDescription)
 	return cmd
 }
@@ -5,18 +5,288 @@ package cli
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
 
+func TestDeployEnvOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inVars deployEnvVars
+
+		wantedError error
+	}{
+		"error if --all is used with --name": {
+			inVars: deployEnvVars{
+				allEnvs: true,
+				name:    "test",
+			},
+			wantedError: errors.New("cannot specify both --all and --name"),
+		},
+		"error if --all is used with --diff": {
+			inVars: deployEnvVars{
+				allEnvs:  true,
+				showDiff: true,
+			},
+			wantedError: errors.New("cannot specify both --all and --diff"),
+		},
+		"error if --all is used with --fail-on-drift": {
+			inVars: deployEnvVars{
+				allEnvs:     true,
+				failOnDrift: true,
+			},
+			wantedError: errors.New("cannot specify --all with --detect-drift or --fail-on-drift"),
+		},
+		"error if --status is used with --no-wait": {
+			inVars: deployEnvVars{
+				name:       "test",
+				showStatus: true,
+				noWait:     true,
+			},
+			wantedError: errors.New("cannot specify both --status and --no-wait"),
+		},
+		"error if --status is used with --diff": {
+			inVars: deployEnvVars{
+				name:       "test",
+				showStatus: true,
+				showDiff:   true,
+			},
+			wantedError: errors.New("cannot specify both --status and --diff"),
+		},
+		"error if --status is used with --detect-drift": {
+			inVars: deployEnvVars{
+				name:        "test",
+				showStatus:  true,
+				detectDrift: true,
+			},
+			wantedError: errors.New("cannot specify --status with --detect-drift or --fail-on-drift"),
+		},
+		"error if --status is used with --all": {
+			inVars: deployEnvVars{
+				allEnvs:    true,
+				showStatus: true,
+			},
+			wantedError: errors.New("cannot specify both --all and --status"),
+		},
+		"error if --status is used with --force": {
+			inVars: deployEnvVars{
+				name:           "test",
+				showStatus:     true,
+				forceNewUpdate: true,
+			},
+			wantedError: errors.New("cannot specify both --status and --force"),
+		},
+		"error if --create-change-set is used with --all": {
+			inVars: deployEnvVars{
+				allEnvs:         true,
+				createChangeSet: true,
+			},
+			wantedError: errors.New("cannot specify both --create-change-set and --all"),
+		},
+		"error if --create-change-set is used with --no-wait": {
+			inVars: deployEnvVars{
+				name:            "test",
+				createChangeSet: true,
+				noWait:          true,
+			},
+			wantedError: errors.New("cannot specify both --create-change-set and --no-wait"),
+		},
+		"error if --create-change-set is used with --diff": {
+			inVars: deployEnvVars{
+				name:            "test",
+				createChangeSet: true,
+				showDiff:        true,
+			},
+			wantedError: errors.New("cannot specify both --create-change-set and --diff"),
+		},
+		"error if --progress is not a valid format": {
+			inVars: deployEnvVars{
+				name:           "test",
+				progressFormat: "yaml",
+			},
+			wantedError: errors.New(`invalid progress format "yaml"; must be one of "human" or "json"`),
+		},
+		"error if --progress json is used with --all": {
+			inVars: deployEnvVars{
+				allEnvs:        true,
+				progressFormat: "json",
+			},
+			wantedError: errors.New("cannot specify both --progress json and --all"),
+		},
+		"error if --progress json is used with --diff": {
+			inVars: deployEnvVars{
+				name:           "test",
+				showDiff:       true,
+				progressFormat: "json",
+			},
+			wantedError: errors.New("cannot specify both --progress json and --diff"),
+		},
+		"success with --progress json and --force": {
+			inVars: deployEnvVars{
+				name:           "test",
+				forceNewUpdate: true,
+				progressFormat: "json",
+			},
+		},
+		"error if --stackset is used with --no-wait": {
+			inVars: deployEnvVars{
+				name:        "test",
+				noWait:      true,
+				useStackSet: true,
+			},
+			wantedError: errors.New("cannot specify both --stackset and --no-wait"),
+		},
+		"error if --stackset is used with --create-change-set": {
+			inVars: deployEnvVars{
+				name:            "test",
+				createChangeSet: true,
+				useStackSet:     true,
+			},
+			wantedError: errors.New("cannot specify both --stackset and --create-change-set"),
+		},
+		"error if --role-arn is used with --all": {
+			inVars: deployEnvVars{
+				allEnvs: true,
+				roleARN: "arn:aws:iam::123456789012:role/restricted-deploy",
+			},
+			wantedError: errors.New("cannot specify both --role-arn and --all"),
+		},
+		"error if --role-arn is not a role ARN": {
+			inVars: deployEnvVars{
+				name:    "test",
+				roleARN: "arn:aws:iam::123456789012:user/deployer",
+			},
+			wantedError: errors.New(`--role-arn "arn:aws:iam::123456789012:user/deployer" is not a valid IAM role ARN`),
+		},
+		"success with --role-arn": {
+			inVars: deployEnvVars{
+				name:    "test",
+				roleARN: "arn:aws:iam::123456789012:role/restricted-deploy",
+			},
+		},
+		"error if --timeout is not positive": {
+			inVars: deployEnvVars{
+				name:    "test",
+				timeout: -time.Minute,
+			},
+			wantedError: errors.New("--timeout -1m0s must be positive"),
+		},
+		"error if --timeout is used with --no-wait": {
+			inVars: deployEnvVars{
+				name:    "test",
+				timeout: time.Hour,
+				noWait:  true,
+			},
+			wantedError: errors.New("cannot specify both --timeout and --no-wait"),
+		},
+		"success with --timeout": {
+			inVars: deployEnvVars{
+				name:    "test",
+				timeout: 3 * time.Hour,
+			},
+		},
+		"success with --stackset and --all": {
+			inVars: deployEnvVars{
+				allEnvs:     true,
+				useStackSet: true,
+			},
+		},
+		"success with --create-change-set and --detect-drift": {
+			inVars: deployEnvVars{
+				name:            "test",
+				createChangeSet: true,
+				detectDrift:     true,
+			},
+		},
+		"error if --template is used without --params": {
+			inVars: deployEnvVars{
+				name:         "test",
+				templatePath: "infrastructure/test.env.yml",
+			},
+			wantedError: errors.New("--template and --params must be specified together"),
+		},
+		"error if --template is used with --all": {
+			inVars: deployEnvVars{
+				allEnvs:      true,
+				templatePath: "infrastructure/test.env.yml",
+				paramsPath:   "infrastructure/test.env.params.json",
+			},
+			wantedError: errors.New("cannot specify both --template and --all"),
+		},
+		"error if --template is used with --force": {
+			inVars: deployEnvVars{
+				name:           "test",
+				forceNewUpdate: true,
+				templatePath:   "infrastructure/test.env.yml",
+				paramsPath:     "infrastructure/test.env.params.json",
+			},
+			wantedError: errors.New("cannot specify both --template and --force"),
+		},
+		"error if --template is used with --skip-custom-resources-upload": {
+			inVars: deployEnvVars{
+				name:                      "test",
+				skipCustomResourcesUpload: true,
+				templatePath:              "infrastructure/test.env.yml",
+				paramsPath:                "infrastructure/test.env.params.json",
+			},
+			wantedError: errors.New("cannot specify both --template and --skip-custom-resources-upload"),
+		},
+		"success with --template and --params": {
+			inVars: deployEnvVars{
+				name:         "test",
+				templatePath: "infrastructure/test.env.yml",
+				paramsPath:   "infrastructure/test.env.params.json",
+			},
+		},
+		"success with --all": {
+			inVars: deployEnvVars{
+				allEnvs: true,
+			},
+		},
+		"success with --all and --no-wait": {
+			inVars: deployEnvVars{
+				allEnvs: true,
+				noWait:  true,
+			},
+		},
+		"success without --all": {
+			inVars: deployEnvVars{
+				name:     "test",
+				showDiff: true,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := deployEnvOpts{
+				deployEnvVars: tc.inVars,
+			}
+			gotErr := opts.Validate()
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
 type deployEnvAskMocks struct {
 	sel   *mocks.MockwsEnvironmentSelector
 	store *mocks.Mockstore
@@ -26,6 +296,7 @@ func TestDeployEnvOpts_Ask(t *testing.T) {
 	testCases := map[string]struct {
 		inAppName  string
 		inName     string
+		inAllEnvs  bool
 		setUpMocks func(m *deployEnvAskMocks)
 
 		wantedEnvName string
@@ -83,6 +354,14 @@ func TestDeployEnvOpts_Ask(t *testing.T) {
 			},
 			wantedEnvName: "mockEnv",
 		},
+		"do not ask for env if all environments are deployed": {
+			inAppName: "mockApp",
+			inAllEnvs: true,
+			setUpMocks: func(m *deployEnvAskMocks) {
+				m.store.EXPECT().GetApplication("mockApp").Return(&config.Application{}, nil)
+				m.sel.EXPECT().LocalEnvironment(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -98,6 +377,7 @@ func TestDeployEnvOpts_Ask(t *testing.T) {
 				deployEnvVars: deployEnvVars{
 					appName: tc.inAppName,
 					name:    tc.inName,
+					allEnvs: tc.inAllEnvs,
 				},
 				sel:   m.sel,
 				store: m.store,
@@ -114,16 +394,38 @@ func TestDeployEnvOpts_Ask(t *testing.T) {
 }
 
 type deployEnvExecuteMocks struct {
-	ws           *mocks.MockwsEnvironmentReader
+	ws           *mocks.MockwsEnvironmentReadLister
 	deployer     *mocks.MockenvDeployer
 	identity     *mocks.MockidentityService
 	interpolator *mocks.Mockinterpolator
+	prompt       *mocks.Mockprompter
+	spinner      *mocks.Mockprogress
 }
 
 func TestDeployEnvOpts_Execute(t *testing.T) {
+	noSecurityChanges := &deploy.GenerateCloudFormationTemplateOutput{
+		Diff: &deploy.TemplateDiff{},
+	}
 	testCases := map[string]struct {
+		inShowDiff        bool
+		inYesSecurity     bool
+		inDetectDrift     bool
+		inFailOnDrift     bool
+		inNoWait          bool
+		inShowStatus      bool
+		inCreateChangeSet bool
+		inForceNewUpdate  bool
+		inProgressFormat  string
+		inAllowDowngrade  bool
+		inNoRollback      bool
+		inTimeout         time.Duration
+		inTemplatePath    string
+		inParamsPath      string
+		inSkipCRUpload    bool
 		unmarshalManifest func(in []byte) (*manifest.Environment, error)
 		setUpMocks        func(m *deployEnvExecuteMocks)
+		wantedDiff        string
+		wantedChangeSet   string
 		wantedErr         error
 	}{
 		"fail to read manifest": {
@@ -165,6 +467,39 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 			},
 			wantedErr: errors.New("upload artifacts for environment mockEnv: some error"),
 		},
+		"error if the custom resources bucket isn't set when skipping the upload of custom resources": {
+			inSkipCRUpload: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Times(0)
+				m.deployer.EXPECT().PreSeededArtifacts(gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New(`upload artifacts for environment mockEnv: "deployment.custom_resources_bucket" must be set in the manifest of environment mockEnv to skip uploading custom resources`),
+		},
+		"use the custom resources of the pre-seeded bucket when skipping their upload": {
+			inSkipCRUpload: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				mft := "name: mockEnv\ntype: Environment\ndeployment:\n  custom_resources_bucket: internal-artifacts\n"
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte(mft), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return(mft, nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Times(0)
+				m.deployer.EXPECT().PreSeededArtifacts("internal-artifacts").Return(map[string]string{
+					"mockResource": "mockURL",
+				}, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).DoAndReturn(func(in *deploy.DeployEnvironmentInput) error {
+					require.Equal(t, map[string]string{"mockResource": "mockURL"}, in.CustomResourcesURLs)
+					return nil
+				})
+			},
+		},
 		"fail to deploy the environment": {
 			setUpMocks: func(m *deployEnvExecuteMocks) {
 				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
@@ -175,12 +510,375 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				m.deployer.EXPECT().UploadArtifacts().Return(map[string]string{
 					"mockResource": "mockURL",
 				}, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
 				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).DoAndReturn(func(_ *deploy.DeployEnvironmentInput) error {
 					return errors.New("some error")
 				})
 			},
 			wantedErr: errors.New("deploy environment mockEnv: some error"),
 		},
+		"recommend recreating the environment if its stack was rolled back": {
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(map[string]string{
+					"mockResource": "mockURL",
+				}, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Return(&deploycfn.ErrStackRollbackComplete{
+					StackName: "mockApp-mockEnv",
+				})
+			},
+			wantedErr: errors.New("deploy environment mockEnv: stack mockApp-mockEnv failed to be created and is in ROLLBACK_COMPLETE state"),
+		},
+		"fail to read the packaged template": {
+			inTemplatePath: "infrastructure/missing.env.yml",
+			inParamsPath:   "infrastructure/mockEnv.env.params.json",
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("read template file infrastructure/missing.env.yml"),
+		},
+		"deploy the packaged template without uploading artifacts": {
+			inTemplatePath: "infrastructure/mockEnv.env.yml",
+			inParamsPath:   "infrastructure/mockEnv.env.params.json",
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Times(0)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).DoAndReturn(func(in *deploy.DeployEnvironmentInput) error {
+					require.Equal(t, "Resources: {}\n", in.Packaged.Template)
+					require.Equal(t, `{"Parameters": {}}`, in.Packaged.Configuration)
+					require.Nil(t, in.CustomResourcesURLs)
+					return nil
+				})
+			},
+		},
+		"show the changes if the deployment is refused because it downgrades the template": {
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(nil, nil)
+				gomock.InOrder(
+					m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil),
+					m.deployer.EXPECT().DeployEnvironment(gomock.Any()).DoAndReturn(func(in *deploy.DeployEnvironmentInput) error {
+						require.False(t, in.AllowDowngrade)
+						return &deploy.ErrEnvTemplateDowngrade{
+							EnvName:         "mockEnv",
+							DeployedVersion: "v1.13.0",
+							Version:         "v1.12.0",
+						}
+					}),
+					m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+						Diff: &deploy.TemplateDiff{
+							RemovedResources: []string{"EnvironmentHTTPSecurityGroup"},
+						},
+					}, nil),
+				)
+			},
+			wantedDiff: "Resources\n  - EnvironmentHTTPSecurityGroup\n",
+			wantedErr:  errors.New("deploy environment mockEnv: environment mockEnv is deployed with template version v1.13.0, which is newer than the version v1.12.0 deployed by this Copilot"),
+		},
+		"fail to generate the template diff": {
+			inShowDiff: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(nil, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(nil, errors.New("some error"))
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("generate the template for environment mockEnv: some error"),
+		},
+		"do not deploy if the user declines after reviewing the diff": {
+			inShowDiff: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(nil, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Diff: &deploy.TemplateDiff{
+						AddedResources: []string{"InternalLoadBalancer"},
+					},
+				}, nil)
+				m.prompt.EXPECT().Confirm(continueEnvDeploymentPrompt, gomock.Any()).Return(false, nil)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Times(0)
+			},
+			wantedDiff: "Resources\n  + InternalLoadBalancer\n",
+		},
+		"deploy after reviewing the diff": {
+			inShowDiff: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(nil, nil)
+				gomock.InOrder(
+					m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+						Diff: &deploy.TemplateDiff{},
+					}, nil),
+					m.prompt.EXPECT().Confirm(continueEnvDeploymentPrompt, gomock.Any()).Return(true, nil),
+					m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Return(nil),
+				)
+			},
+			wantedDiff: "No changes to the stack.\n",
+		},
+		"fail to detect drift": {
+			inDetectDrift: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().DetectDrift().Return(nil, errors.New("some error"))
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.deployer.EXPECT().UploadArtifacts().Times(0)
+			},
+			wantedErr: errors.New("detect drift on environment mockEnv: some error"),
+		},
+		"stop the deployment if the environment has drifted and fail-on-drift is set": {
+			inFailOnDrift: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().DetectDrift().Return([]*awscloudformation.StackResourceDrift{
+					{
+						LogicalResourceId:        aws.String("PublicLoadBalancerSecurityGroup"),
+						ResourceType:             aws.String("AWS::EC2::SecurityGroup"),
+						StackResourceDriftStatus: aws.String("MODIFIED"),
+					},
+				}, nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.deployer.EXPECT().UploadArtifacts().Times(0)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("environment mockEnv has 1 resource that drifted from its template"),
+		},
+		"warn and deploy if the environment has drifted": {
+			inDetectDrift: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().DetectDrift().Return([]*awscloudformation.StackResourceDrift{
+					{
+						LogicalResourceId:        aws.String("PublicLoadBalancerSecurityGroup"),
+						ResourceType:             aws.String("AWS::EC2::SecurityGroup"),
+						StackResourceDriftStatus: aws.String("MODIFIED"),
+					},
+				}, nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.deployer.EXPECT().UploadArtifacts().Return(nil, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Return(nil)
+			},
+		},
+		"deploy if the environment has not drifted and fail-on-drift is set": {
+			inFailOnDrift: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().DetectDrift().Return(nil, nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.deployer.EXPECT().UploadArtifacts().Return(nil, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Return(nil)
+			},
+		},
+		"fail to start the deployment without waiting": {
+			inNoWait: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(nil, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
+				m.deployer.EXPECT().DeployEnvironmentNoWait(gomock.Any()).Return(nil, errors.New("some error"))
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("deploy environment mockEnv: some error"),
+		},
+		"start the deployment without waiting": {
+			inNoWait: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{RootUserARN: "mockRootUserARN"}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(nil, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
+				m.deployer.EXPECT().DeployEnvironmentNoWait(gomock.Any()).DoAndReturn(func(in *deploy.DeployEnvironmentInput) (*deploy.EnvironmentDeployment, error) {
+					require.Equal(t, "mockRootUserARN", in.RootUserARN)
+					return &deploy.EnvironmentDeployment{
+						StackName:   "mockApp-mockEnv",
+						ChangeSetID: "mockChangeSetID",
+					}, nil
+				})
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Times(0)
+			},
+		},
+		"fail to create the change set": {
+			inCreateChangeSet: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(nil, nil)
+				m.deployer.EXPECT().CreateChangeSet(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("create change set for environment mockEnv: some error"),
+		},
+		"do not error if the change set is empty": {
+			inCreateChangeSet: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(nil, nil)
+				m.deployer.EXPECT().CreateChangeSet(gomock.Any()).Return(nil, fmt.Errorf("wrapped: %w", awscloudformation.NewMockErrChangeSetEmpty()))
+			},
+		},
+		"print the changes of the change set without deploying": {
+			inCreateChangeSet: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{RootUserARN: "mockRootUserARN"}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(nil, nil)
+				m.deployer.EXPECT().CreateChangeSet(gomock.Any()).DoAndReturn(func(in *deploy.DeployEnvironmentInput) (*deploy.EnvironmentChangeSet, error) {
+					require.Equal(t, "mockRootUserARN", in.RootUserARN)
+					return &deploy.EnvironmentChangeSet{
+						StackName: "mockApp-mockEnv",
+						ID:        "mockChangeSetID",
+						Changes: []*awscfn.Change{
+							{
+								ResourceChange: &awscfn.ResourceChange{
+									Action:            aws.String(awscfn.ChangeActionModify),
+									LogicalResourceId: aws.String("PublicLoadBalancer"),
+									ResourceType:      aws.String("AWS::ElasticLoadBalancingV2::LoadBalancer"),
+									Replacement:       aws.String(awscfn.ReplacementFalse),
+								},
+							},
+							{
+								ResourceChange: &awscfn.ResourceChange{
+									Action:            aws.String(awscfn.ChangeActionAdd),
+									LogicalResourceId: aws.String("PublicTrustStore"),
+									ResourceType:      aws.String("AWS::ElasticLoadBalancingV2::TrustStore"),
+								},
+							},
+						},
+					}, nil
+				})
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Times(0)
+			},
+			wantedChangeSet: `The change set contains 2 changes:
+  - PublicLoadBalancer (AWS::ElasticLoadBalancingV2::LoadBalancer): modify, replacement: false
+  - PublicTrustStore (AWS::ElasticLoadBalancingV2::TrustStore): add
+`,
+		},
+		"fail to follow the deployment in progress": {
+			inShowStatus: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Times(0)
+				m.deployer.EXPECT().AttachToDeployment().Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("follow the deployment of environment mockEnv: some error"),
+		},
+		"follow the deployment in progress": {
+			inShowStatus: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Times(0)
+				m.deployer.EXPECT().UploadArtifacts().Times(0)
+				m.deployer.EXPECT().AttachToDeployment().Return(nil)
+			},
+		},
+		"do not deploy if the user declines the security-impacting changes": {
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest("mockEnv").Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(nil, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Diff: &deploy.TemplateDiff{
+						AddedResources:    []string{"EnvironmentHTTPSecurityGroup"},
+						SecurityResources: []string{"EnvironmentHTTPSecurityGroup"},
+					},
+				}, nil)
+				m.prompt.EXPECT().Confirm(fmt.Sprintf(fmtEnvSecurityChangesPrompt, "mockEnv"), envSecurityChangesHelpPrompt).Return(false, nil)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Times(0)
+			},
+			wantedDiff: "Security-impacting changes\n  ! EnvironmentHTTPSecurityGroup\n",
+		},
+		"deploy after the user confirms the security-impacting changes": {
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest("mockEnv").Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(nil, nil)
+				gomock.InOrder(
+					m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+						Diff: &deploy.TemplateDiff{
+							ChangedResources:  []string{"CFNExecutionRole"},
+							SecurityResources: []string{"CFNExecutionRole"},
+						},
+					}, nil),
+					m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil),
+					m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Return(nil),
+				)
+			},
+			wantedDiff: "Security-impacting changes\n  ! CFNExecutionRole\n",
+		},
+		"deploy without checking for security-impacting changes with --yes-security": {
+			inYesSecurity: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest("mockEnv").Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate(gomock.Any()).Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(nil, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Times(0)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Return(nil)
+			},
+		},
 		"success": {
 			setUpMocks: func(m *deployEnvExecuteMocks) {
 				m.ws.EXPECT().ReadEnvironmentManifest("mockEnv").Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
@@ -191,6 +889,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				m.deployer.EXPECT().UploadArtifacts().Return(map[string]string{
 					"mockResource": "mockURL",
 				}, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
 				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).DoAndReturn(func(in *deploy.DeployEnvironmentInput) error {
 					require.Equal(t, in.RootUserARN, "mockRootUserARN")
 					require.Equal(t, in.CustomResourcesURLs, map[string]string{
@@ -206,25 +905,135 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				})
 			},
 		},
+		"success with --timeout": {
+			inTimeout: 3 * time.Hour,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest("mockEnv").Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate("name: mockEnv\ntype: Environment\n").Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(map[string]string{}, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).DoAndReturn(func(in *deploy.DeployEnvironmentInput) error {
+					require.Equal(t, 3*time.Hour, in.Timeout)
+					return nil
+				})
+			},
+		},
+		"success with --force": {
+			inForceNewUpdate: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest("mockEnv").Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate("name: mockEnv\ntype: Environment\n").Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(map[string]string{}, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).DoAndReturn(func(in *deploy.DeployEnvironmentInput) error {
+					require.True(t, in.ForceNewUpdate)
+					return nil
+				})
+			},
+		},
+		"success with --allow-downgrade": {
+			inAllowDowngrade: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest("mockEnv").Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate("name: mockEnv\ntype: Environment\n").Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(map[string]string{}, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).DoAndReturn(func(in *deploy.DeployEnvironmentInput) error {
+					require.True(t, in.AllowDowngrade)
+					return nil
+				})
+			},
+		},
+		"fail with --no-rollback": {
+			inNoRollback: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest("mockEnv").Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate("name: mockEnv\ntype: Environment\n").Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(map[string]string{}, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).DoAndReturn(func(in *deploy.DeployEnvironmentInput) error {
+					require.True(t, in.DisableRollback)
+					return errors.New("some error")
+				})
+			},
+			wantedErr: errors.New("deploy environment mockEnv: some error"),
+		},
+		"success with --progress json": {
+			inProgressFormat: "json",
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest("mockEnv").Return([]byte("name: mockEnv\ntype: Environment\n"), nil)
+				m.interpolator.EXPECT().Interpolate("name: mockEnv\ntype: Environment\n").Return("name: mockEnv\ntype: Environment\n", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(map[string]string{}, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).DoAndReturn(func(in *deploy.DeployEnvironmentInput) error {
+					require.True(t, in.JSONProgress)
+					return nil
+				})
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			m := &deployEnvExecuteMocks{
-				ws:           mocks.NewMockwsEnvironmentReader(ctrl),
+				ws:           mocks.NewMockwsEnvironmentReadLister(ctrl),
 				deployer:     mocks.NewMockenvDeployer(ctrl),
 				identity:     mocks.NewMockidentityService(ctrl),
 				interpolator: mocks.NewMockinterpolator(ctrl),
+				prompt:       mocks.NewMockprompter(ctrl),
+				spinner:      mocks.NewMockprogress(ctrl),
 			}
 			tc.setUpMocks(m)
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "infrastructure/mockEnv.env.yml", []byte("Resources: {}\n"), 0644))
+			require.NoError(t, afero.WriteFile(fs, "infrastructure/mockEnv.env.params.json", []byte(`{"Parameters": {}}`), 0644))
+			diff := new(strings.Builder)
+			changeSet := new(strings.Builder)
 			opts := deployEnvOpts{
 				deployEnvVars: deployEnvVars{
-					name: "mockEnv",
+					name:            "mockEnv",
+					showDiff:        tc.inShowDiff,
+					yesSecurity:     tc.inYesSecurity,
+					detectDrift:     tc.inDetectDrift,
+					failOnDrift:     tc.inFailOnDrift,
+					noWait:          tc.inNoWait,
+					showStatus:      tc.inShowStatus,
+					createChangeSet: tc.inCreateChangeSet,
+					forceNewUpdate:  tc.inForceNewUpdate,
+					progressFormat:  tc.inProgressFormat,
+					allowDowngrade:  tc.inAllowDowngrade,
+					disableRollback: tc.inNoRollback,
+					timeout:         tc.inTimeout,
+					templatePath:    tc.inTemplatePath,
+					paramsPath:      tc.inParamsPath,
+
+					skipCustomResourcesUpload: tc.inSkipCRUpload,
 				},
-				ws:       m.ws,
-				identity: m.identity,
-				newEnvDeployer: func() (envDeployer, error) {
+				ws:              m.ws,
+				fs:              fs,
+				identity:        m.identity,
+				prompt:          m.prompt,
+				spinner:         m.spinner,
+				diffWriter:      diff,
+				changeSetWriter: changeSet,
+				newEnvDeployer: func(_ *config.Environment, progressOut termprogress.FileWriter) (envDeployer, error) {
+					require.Nil(t, progressOut)
 					return m.deployer, nil
 				},
 				newInterpolator: func(s string, s2 string) interpolator {
@@ -235,11 +1044,222 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				},
 			}
 			err := opts.Execute()
+			require.Equal(t, tc.wantedDiff, diff.String())
 			if tc.wantedErr != nil {
 				require.Contains(t, err.Error(), tc.wantedErr.Error())
 			} else {
 				require.NoError(t, err)
+				require.Equal(t, tc.wantedChangeSet, changeSet.String())
 			}
 		})
 	}
 }
+
+type deployAllEnvsMocks struct {
+	ws           *mocks.MockwsEnvironmentReadLister
+	store        *mocks.Mockstore
+	identity     *mocks.MockidentityService
+	interpolator *mocks.Mockinterpolator
+	deployers    map[string]*mocks.MockenvDeployer
+}
+
+func TestDeployEnvOpts_ExecuteAllEnvs(t *testing.T) {
+	const mockMft = "name: mockEnv\ntype: Environment\n"
+	noSecurityChanges := &deploy.GenerateCloudFormationTemplateOutput{
+		Diff: &deploy.TemplateDiff{},
+	}
+	testCases := map[string]struct {
+		inNoWait   bool
+		inStackSet bool
+		setUpMocks func(m *deployAllEnvsMocks)
+
+		wantedBufferedProgress bool
+		wantedErr              error
+	}{
+		"fail to list environments in the workspace": {
+			setUpMocks: func(m *deployAllEnvsMocks) {
+				m.ws.EXPECT().ListEnvironments().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list environments in workspace: some error"),
+		},
+		"fail to list environments in the application": {
+			setUpMocks: func(m *deployAllEnvsMocks) {
+				m.ws.EXPECT().ListEnvironments().Return([]string{"test"}, nil)
+				m.store.EXPECT().ListEnvironments("mockApp").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list environments in application mockApp: some error"),
+		},
+		"do not deploy any environment if a manifest is invalid": {
+			setUpMocks: func(m *deployAllEnvsMocks) {
+				m.ws.EXPECT().ListEnvironments().Return([]string{"test", "prod"}, nil)
+				m.store.EXPECT().ListEnvironments("mockApp").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(mockMft), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("prod").Return(nil, errors.New("some error"))
+				m.interpolator.EXPECT().Interpolate(mockMft).Return(mockMft, nil)
+				m.deployers["test"].EXPECT().UploadArtifacts().Times(0)
+				m.deployers["test"].EXPECT().DeployEnvironment(gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New(`read manifest for environment "prod": some error`),
+		},
+		"keep deploying the other environments if one fails": {
+			setUpMocks: func(m *deployAllEnvsMocks) {
+				m.ws.EXPECT().ListEnvironments().Return([]string{"test", "prod"}, nil)
+				m.store.EXPECT().ListEnvironments("mockApp").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte(mockMft), nil).Times(2)
+				m.interpolator.EXPECT().Interpolate(mockMft).Return(mockMft, nil).Times(2)
+				m.identity.EXPECT().Get().Return(identity.Caller{RootUserARN: "mockRootUserARN"}, nil)
+				m.deployers["test"].EXPECT().UploadArtifacts().Return(nil, errors.New("some error"))
+				m.deployers["test"].EXPECT().DeployEnvironment(gomock.Any()).Times(0)
+				m.deployers["prod"].EXPECT().UploadArtifacts().Return(nil, nil)
+				m.deployers["prod"].EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
+				m.deployers["prod"].EXPECT().DeployEnvironment(gomock.Any()).Return(nil)
+			},
+			wantedErr: errors.New("1 of 2 environments failed to deploy: test"),
+		},
+		"do not deploy an environment with security-impacting changes": {
+			setUpMocks: func(m *deployAllEnvsMocks) {
+				m.ws.EXPECT().ListEnvironments().Return([]string{"test", "prod"}, nil)
+				m.store.EXPECT().ListEnvironments("mockApp").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte(mockMft), nil).Times(2)
+				m.interpolator.EXPECT().Interpolate(mockMft).Return(mockMft, nil).Times(2)
+				m.identity.EXPECT().Get().Return(identity.Caller{RootUserARN: "mockRootUserARN"}, nil)
+				m.deployers["test"].EXPECT().UploadArtifacts().Return(nil, nil)
+				m.deployers["test"].EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
+				m.deployers["test"].EXPECT().DeployEnvironment(gomock.Any()).Return(nil)
+				m.deployers["prod"].EXPECT().UploadArtifacts().Return(nil, nil)
+				m.deployers["prod"].EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Diff: &deploy.TemplateDiff{
+						ChangedResources:  []string{"CFNExecutionRole"},
+						SecurityResources: []string{"CFNExecutionRole"},
+					},
+				}, nil)
+				m.deployers["prod"].EXPECT().DeployEnvironment(gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("1 of 2 environments failed to deploy: prod"),
+		},
+		"deploy every environment added to the application": {
+			wantedBufferedProgress: true,
+			setUpMocks: func(m *deployAllEnvsMocks) {
+				m.ws.EXPECT().ListEnvironments().Return([]string{"test", "prod", "dev"}, nil)
+				m.store.EXPECT().ListEnvironments("mockApp").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(mockMft), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("prod").Return([]byte(mockMft), nil)
+				m.interpolator.EXPECT().Interpolate(mockMft).Return(mockMft, nil).Times(2)
+				m.identity.EXPECT().Get().Return(identity.Caller{RootUserARN: "mockRootUserARN"}, nil)
+				for _, env := range []string{"test", "prod"} {
+					m.deployers[env].EXPECT().UploadArtifacts().Return(map[string]string{
+						"mockResource": "mockURL",
+					}, nil)
+					m.deployers[env].EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
+					m.deployers[env].EXPECT().DeployEnvironment(gomock.Any()).DoAndReturn(func(in *deploy.DeployEnvironmentInput) error {
+						require.Equal(t, "mockRootUserARN", in.RootUserARN)
+						require.Equal(t, []byte(mockMft), in.RawManifest)
+						return nil
+					})
+				}
+			},
+		},
+		"render the progress as it happens when the environments are deployed one at a time through a stack set": {
+			inStackSet: true,
+			setUpMocks: func(m *deployAllEnvsMocks) {
+				m.ws.EXPECT().ListEnvironments().Return([]string{"test", "prod"}, nil)
+				m.store.EXPECT().ListEnvironments("mockApp").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte(mockMft), nil).Times(2)
+				m.interpolator.EXPECT().Interpolate(mockMft).Return(mockMft, nil).Times(2)
+				m.identity.EXPECT().Get().Return(identity.Caller{RootUserARN: "mockRootUserARN"}, nil)
+				for _, env := range []string{"test", "prod"} {
+					m.deployers[env].EXPECT().UploadArtifacts().Return(nil, nil)
+					m.deployers[env].EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
+					m.deployers[env].EXPECT().DeployEnvironment(gomock.Any()).Return(nil)
+				}
+			},
+		},
+		"start the deployment of every environment without waiting": {
+			inNoWait: true,
+			setUpMocks: func(m *deployAllEnvsMocks) {
+				m.ws.EXPECT().ListEnvironments().Return([]string{"test", "prod"}, nil)
+				m.store.EXPECT().ListEnvironments("mockApp").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest(gomock.Any()).Return([]byte(mockMft), nil).Times(2)
+				m.interpolator.EXPECT().Interpolate(mockMft).Return(mockMft, nil).Times(2)
+				m.identity.EXPECT().Get().Return(identity.Caller{RootUserARN: "mockRootUserARN"}, nil)
+				for _, env := range []string{"test", "prod"} {
+					m.deployers[env].EXPECT().UploadArtifacts().Return(nil, nil)
+					m.deployers[env].EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
+					m.deployers[env].EXPECT().DeployEnvironmentNoWait(gomock.Any()).Return(&deploy.EnvironmentDeployment{
+						StackName:   "mockApp-" + env,
+						ChangeSetID: "mockChangeSetID",
+					}, nil)
+					m.deployers[env].EXPECT().DeployEnvironment(gomock.Any()).Times(0)
+				}
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &deployAllEnvsMocks{
+				ws:           mocks.NewMockwsEnvironmentReadLister(ctrl),
+				store:        mocks.NewMockstore(ctrl),
+				identity:     mocks.NewMockidentityService(ctrl),
+				interpolator: mocks.NewMockinterpolator(ctrl),
+				deployers: map[string]*mocks.MockenvDeployer{
+					"test": mocks.NewMockenvDeployer(ctrl),
+					"prod": mocks.NewMockenvDeployer(ctrl),
+				},
+			}
+			tc.setUpMocks(m)
+			progressOuts := make(map[string]termprogress.FileWriter)
+			opts := deployEnvOpts{
+				deployEnvVars: deployEnvVars{
+					appName:     "mockApp",
+					allEnvs:     true,
+					noWait:      tc.inNoWait,
+					useStackSet: tc.inStackSet,
+				},
+				ws:       m.ws,
+				store:    m.store,
+				identity: m.identity,
+				newEnvDeployer: func(env *config.Environment, progressOut termprogress.FileWriter) (envDeployer, error) {
+					progressOuts[env.Name] = progressOut
+					return m.deployers[env.Name], nil
+				},
+				newInterpolator: func(_, _ string) interpolator {
+					return m.interpolator
+				},
+			}
+			err := opts.Execute()
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.wantedBufferedProgress {
+				require.NotNil(t, progressOuts["test"])
+				require.NotNil(t, progressOuts["prod"])
+				require.NotSame(t, progressOuts["test"], progressOuts["prod"], "each environment buffers its own progress")
+			}
+			if tc.inStackSet {
+				require.Nil(t, progressOuts["test"])
+				require.Nil(t, progressOuts["prod"])
+			}
+		})
+	}
+}
+
+func TestErrIfEnvStackRolledBack(t *testing.T) {
+	t.Run("leave other errors as is", func(t *testing.T) {
+		err := errors.New("some error")
+		require.Equal(t, err, errIfEnvStackRolledBack("test", err))
+	})
+	t.Run("recommend recreating the environment if its stack was rolled back", func(t *testing.T) {
+		err := errIfEnvStackRolledBack("test", fmt.Errorf("update stack: %w", &deploycfn.ErrStackRollbackComplete{
+			StackName: "phonetool-test",
+		}))
+
+		var errRolledBack *errEnvStackRolledBack
+		require.True(t, errors.As(err, &errRolledBack))
+		require.EqualError(t, err, "update stack: stack phonetool-test failed to be created and is in ROLLBACK_COMPLETE state")
+		require.Equal(t, "Run `copilot env delete --name test` and then `copilot env init --name test` to recreate environment test.", errRolledBack.RecommendActions())
+	})
+}
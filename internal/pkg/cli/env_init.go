@@ -20,6 +20,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
@@ -30,6 +31,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	describestack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -73,6 +75,8 @@ https://aws.github.io/copilot-cli/docs/credentials/#environment-credentials`
 	fmtAddEnvToAppStart      = "Linking account %s and region %s to application %s."
 	fmtAddEnvToAppFailed     = "Failed to link account %s and region %s to application %s.\n\n"
 	fmtAddEnvToAppComplete   = "Linked account %s and region %s to application %s.\n\n"
+
+	fargateSpotCapacityProvider = "FARGATE_SPOT"
 )
 
 var (
@@ -145,8 +149,11 @@ type initEnvVars struct {
 	adjustVPC          adjustVPCVars // Configure parameters for VPC resources generated while initializing an environment.
 	telemetry          telemetryVars // Configure observability and monitoring settings.
 	importCerts        []string      // Additional existing ACM certificates to use.
+	importCluster      string        // Existing ECS cluster to use instead of creating a new one.
 	internalALBSubnets []string      // Subnets to be used for internal ALB placement.
 	allowVPCIngress    bool          // True means the env stack will create ingress to the internal ALB from ports 80/443.
+	suggestCIDR        bool          // True means the VPC CIDR is chosen to not overlap with the other environments of the app.
+	suggestedCIDRSize  int           // Prefix size of the suggested VPC CIDR.
 
 	tempCreds tempCredsVars // Temporary credentials to initialize the environment. Mutually exclusive with the profile.
 	region    string        // The region to create the environment in.
@@ -163,6 +170,7 @@ type initEnvOpts struct {
 	identity       identityService
 	envIdentity    identityService
 	ec2Client      ec2Client
+	cluster        clusterDescriber
 	iam            roleManager
 	cfn            stackExistChecker
 	prog           progress
@@ -175,9 +183,13 @@ type initEnvOpts struct {
 
 	sess *session.Session // Session pointing to environment's AWS account and region.
 
+	envVPCCIDRs func(env *config.Environment) ([]string, error) // Returns the CIDR blocks of an existing environment's VPC.
+
 	// Cached variables.
-	wsAppName string
-	mftPath   string
+	wsAppName   string
+	mftPath     string
+	peeredCIDRs []string
+	appFetched  bool
 }
 
 func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
@@ -215,6 +227,22 @@ func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
 		selApp:         selector.NewAppEnvSelector(prompt.New(), store),
 		appCFN:         deploycfn.New(defaultSession),
 		manifestWriter: ws,
+		envVPCCIDRs: func(env *config.Environment) ([]string, error) {
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from role %s: %w", env.ManagerRoleARN, err)
+			}
+			descr, err := describestack.NewStackDescriber(stack.NameForEnv(env.App, env.Name), sess).Describe()
+			if err != nil {
+				return nil, fmt.Errorf("describe stack of environment %s: %w", env.Name, err)
+			}
+			vpcID, ok := descr.Outputs[stack.EnvOutputVPCID]
+			if !ok {
+				// The environment imports a VPC or isn't deployed yet.
+				return nil, nil
+			}
+			return ec2.New(sess).VPCCIDRBlocks(vpcID)
+		},
 
 		wsAppName: tryReadingAppName(),
 	}, nil
@@ -239,6 +267,11 @@ func (o *initEnvOpts) Validate() error {
 	if err := o.validateCustomizedResources(); err != nil {
 		return err
 	}
+	if o.adjustVPC.CIDR.String() != emptyIPNet.String() {
+		if err := o.validateVPCCIDR(o.adjustVPC.CIDR.String()); err != nil {
+			return err
+		}
+	}
 	return o.validateCredentials()
 }
 
@@ -253,6 +286,9 @@ func (o *initEnvOpts) Ask() error {
 	if err := o.askEnvRegion(); err != nil {
 		return err
 	}
+	if err := o.validateImportedCluster(); err != nil {
+		return err
+	}
 	return o.askCustomizedResources()
 }
 
@@ -350,6 +386,12 @@ func (o *initEnvOpts) validateCustomizedResources() error {
 	if (o.importVPC.isSet() || o.adjustVPC.isSet()) && o.defaultConfig {
 		return fmt.Errorf("cannot import or configure vpc if --%s is set", defaultConfigFlag)
 	}
+	if o.importCluster != "" && o.defaultConfig {
+		return fmt.Errorf("cannot import a cluster if --%s is set", defaultConfigFlag)
+	}
+	if o.importCluster != "" && o.telemetry.EnableContainerInsights {
+		return fmt.Errorf("cannot enable --%s for an imported cluster", enableContainerInsightsFlag)
+	}
 	if o.internalALBSubnets != nil && (o.adjustVPC.isSet() || o.defaultConfig) {
 		log.Error(`To specify internal ALB subnet placement, you must import existing resources, including subnets.
 For default config without subnet placement specification, Copilot will place the internal ALB in the generated private subnets.`)
@@ -372,6 +414,72 @@ For default config without subnet placement specification, Copilot will place th
 			return errors.New("at least two availability zones must be provided to enable Load Balancing")
 		}
 	}
+	if o.suggestCIDR {
+		if o.defaultConfig {
+			return fmt.Errorf("cannot suggest a vpc CIDR if --%s is set", defaultConfigFlag)
+		}
+		if o.importVPC.isSet() {
+			return errors.New("cannot suggest a vpc CIDR when importing a vpc")
+		}
+		if o.adjustVPC.CIDR.String() != emptyIPNet.String() || o.adjustVPC.PublicSubnetCIDRs != nil || o.adjustVPC.PrivateSubnetCIDRs != nil {
+			return fmt.Errorf("cannot specify both --%s and CIDR override flags", suggestCIDRFlag)
+		}
+		if o.suggestedCIDRSize < minSuggestedCIDRSize || o.suggestedCIDRSize > maxSuggestedCIDRSize {
+			return fmt.Errorf("--%s must be between %d and %d", cidrSizeFlag, minSuggestedCIDRSize, maxSuggestedCIDRSize)
+		}
+	}
+	return nil
+}
+
+// validateVPCCIDR returns an error if the VPC CIDR overlaps with a network peered with the application.
+func (o *initEnvOpts) validateVPCCIDR(cidr string) error {
+	peered, err := o.appPeeredCIDRs()
+	if err != nil {
+		return err
+	}
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return errValueNotAnIPNet
+	}
+	if overlap := firstOverlap(network, peered); overlap != "" {
+		return fmt.Errorf("VPC CIDR %s overlaps with the peered network %s of application %s", cidr, overlap, o.appName)
+	}
+	return nil
+}
+
+// appPeeredCIDRs returns the CIDRs of the networks peered with the application.
+func (o *initEnvOpts) appPeeredCIDRs() ([]string, error) {
+	if o.appFetched {
+		return o.peeredCIDRs, nil
+	}
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	o.peeredCIDRs = app.PeeredCIDRs
+	o.appFetched = true
+	return o.peeredCIDRs, nil
+}
+
+func (o *initEnvOpts) validateImportedCluster() error {
+	if o.importCluster == "" {
+		return nil
+	}
+	if o.cluster == nil {
+		o.cluster = awsecs.New(o.sess)
+	}
+	cluster, err := o.cluster.Cluster(o.importCluster)
+	if err != nil {
+		return fmt.Errorf("get cluster %s: %w", o.importCluster, err)
+	}
+	if !cluster.IsActive() {
+		return fmt.Errorf("cluster %s is %s and cannot run tasks", cluster.Name, strings.ToLower(cluster.Status))
+	}
+	if !contains(fargateSpotCapacityProvider, cluster.CapacityProviders) {
+		log.Warningf("Cluster %s doesn't have the %s capacity provider attached, so services with %s can't be deployed to it.\n",
+			cluster.Name, fargateSpotCapacityProvider, color.HighlightCode("count.spot"))
+	}
+	o.importCluster = cluster.Name
 	return nil
 }
 
@@ -389,6 +497,10 @@ func (o *initEnvOpts) askEnvName() error {
 }
 
 func (o *initEnvOpts) askEnvSession() error {
+	if o.sess != nil {
+		// The session was provided by the command creating the environment, such as "env preview create".
+		return nil
+	}
 	if o.profile != "" {
 		sess, err := o.sessProvider.FromProfile(o.profile)
 		if err != nil {
@@ -425,6 +537,10 @@ func (o *initEnvOpts) askEnvRegion() error {
 		}
 		region = v
 	}
+	// Fail early instead of at stack creation if Copilot can't run services and jobs in the region.
+	if err := partitions.Region(region).CheckFeature(partitions.FeatureECS); err != nil {
+		return fmt.Errorf("validate environment region: %w", err)
+	}
 	o.sess.Config.Region = aws.String(region)
 	return nil
 }
@@ -433,6 +549,9 @@ func (o *initEnvOpts) askCustomizedResources() error {
 	if o.defaultConfig {
 		return nil
 	}
+	if o.suggestCIDR {
+		return o.askSuggestedResources()
+	}
 	if o.importVPC.isSet() {
 		return o.askImportResources()
 	}
@@ -552,8 +671,15 @@ be able to add them after this environment is created.
 
 func (o *initEnvOpts) askAdjustResources() error {
 	if o.adjustVPC.CIDR.String() == emptyIPNet.String() {
-		vpcCIDRString, err := o.prompt.Get(envInitVPCCIDRPrompt, envInitVPCCIDRPromptHelp, validateCIDR,
-			prompt.WithDefaultInput(stack.DefaultVPCCIDR), prompt.WithFinalMessage("VPC CIDR:"))
+		if _, err := o.appPeeredCIDRs(); err != nil {
+			return err
+		}
+		vpcCIDRString, err := o.prompt.Get(envInitVPCCIDRPrompt, envInitVPCCIDRPromptHelp, func(v interface{}) error {
+			if err := validateCIDR(v); err != nil {
+				return err
+			}
+			return o.validateVPCCIDR(v.(string))
+		}, prompt.WithDefaultInput(stack.DefaultVPCCIDR), prompt.WithFinalMessage("VPC CIDR:"))
 		if err != nil {
 			return fmt.Errorf("get VPC CIDR: %w", err)
 		}
@@ -591,6 +717,57 @@ func (o *initEnvOpts) askAdjustResources() error {
 	return nil
 }
 
+// askSuggestedResources picks a VPC CIDR that doesn't overlap with the VPCs of the other environments
+// in the application nor with its peered networks, and splits it into a public and private subnet per AZ.
+func (o *initEnvOpts) askSuggestedResources() error {
+	azs, err := o.askAZs()
+	if err != nil {
+		return err
+	}
+	o.adjustVPC.AZs = azs
+	taken, err := o.takenCIDRs()
+	if err != nil {
+		return err
+	}
+	vpcCIDR, err := suggestVPCCIDR(taken, o.suggestedCIDRSize)
+	if err != nil {
+		return fmt.Errorf("suggest VPC CIDR: %w", err)
+	}
+	public, private, err := splitSubnets(vpcCIDR, len(azs))
+	if err != nil {
+		return fmt.Errorf("split VPC CIDR into subnets: %w", err)
+	}
+	o.adjustVPC.CIDR = *vpcCIDR
+	o.adjustVPC.PublicSubnetCIDRs = public
+	o.adjustVPC.PrivateSubnetCIDRs = private
+	log.Infof("Suggested VPC CIDR %s with public subnets %s and private subnets %s.\n",
+		color.HighlightUserInput(vpcCIDR.String()),
+		color.HighlightUserInput(strings.Join(public, ",")), color.HighlightUserInput(strings.Join(private, ",")))
+	return nil
+}
+
+// takenCIDRs returns the CIDR blocks of the existing environment VPCs and peered networks of the application.
+func (o *initEnvOpts) takenCIDRs() ([]string, error) {
+	peered, err := o.appPeeredCIDRs()
+	if err != nil {
+		return nil, err
+	}
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	taken := append([]string{}, peered...)
+	for _, env := range envs {
+		cidrs, err := o.envVPCCIDRs(env)
+		if err != nil {
+			log.Warningf("Couldn't retrieve the VPC CIDR of environment %s, the suggested CIDR might overlap with it: %v\n", env.Name, err)
+			continue
+		}
+		taken = append(taken, cidrs...)
+	}
+	return taken, nil
+}
+
 func (o *initEnvOpts) askAZs() ([]string, error) {
 	if o.adjustVPC.AZs != nil {
 		return o.adjustVPC.AZs, nil
@@ -694,13 +871,17 @@ func (o *initEnvOpts) deployEnv(app *config.Application) error {
 			Name:                o.appName,
 			Domain:              app.Domain,
 			AccountPrincipalARN: caller.RootUserARN,
+			ResourcePrefix:      app.ResourcePrefix,
 		},
 		AdditionalTags:       app.Tags,
 		ArtifactBucketARN:    artifactBucketARN,
 		ArtifactBucketKeyARN: resources.KMSKeyARN,
 	}
 
-	if err := o.cleanUpDanglingRoles(o.appName, o.name); err != nil {
+	if err := deployEnvInput.App.ResourceNames().ValidateEnv(o.appName, o.name); err != nil {
+		return fmt.Errorf("validate resource names for environment %s: %w", o.name, err)
+	}
+	if err := o.cleanUpDanglingRoles(deployEnvInput.App, o.name); err != nil {
 		return err
 	}
 	if err := o.envDeployer.CreateAndRenderEnvironment(os.Stderr, deployEnvInput); err != nil {
@@ -711,7 +892,7 @@ func (o *initEnvOpts) deployEnv(app *config.Application) error {
 		}
 		// The stack failed to create due to an unexpect reason.
 		// Delete the retained roles created part of the stack.
-		o.tryDeletingEnvRoles(o.appName, o.name)
+		o.tryDeletingEnvRoles(deployEnvInput.App, o.name)
 		return err
 	}
 	return nil
@@ -780,10 +961,10 @@ func (o *initEnvOpts) validateInternalALBSubnets() error {
 
 // cleanUpDanglingRoles deletes any IAM roles created for the same app and env that were left over from a previous
 // environment creation.
-func (o *initEnvOpts) cleanUpDanglingRoles(app, env string) error {
-	exists, err := o.cfn.Exists(stack.NameForEnv(app, env))
+func (o *initEnvOpts) cleanUpDanglingRoles(app deploy.AppInformation, env string) error {
+	exists, err := o.cfn.Exists(stack.NameForEnv(app.Name, env))
 	if err != nil {
-		return fmt.Errorf("check if stack %s exists: %w", stack.NameForEnv(app, env), err)
+		return fmt.Errorf("check if stack %s exists: %w", stack.NameForEnv(app.Name, env), err)
 	}
 	if exists {
 		return nil
@@ -799,10 +980,11 @@ func (o *initEnvOpts) cleanUpDanglingRoles(app, env string) error {
 // tryDeletingEnvRoles attempts a best effort deletion of IAM roles created from an environment.
 // To ensure that the roles being deleted were created by Copilot, we check if the copilot-environment tag
 // is applied to the role.
-func (o *initEnvOpts) tryDeletingEnvRoles(app, env string) {
+func (o *initEnvOpts) tryDeletingEnvRoles(app deploy.AppInformation, env string) {
+	names := app.ResourceNames()
 	roleNames := []string{
-		fmt.Sprintf("%s-CFNExecutionRole", stack.NameForEnv(app, env)),
-		fmt.Sprintf("%s-EnvManagerRole", stack.NameForEnv(app, env)),
+		names.EnvCFNExecutionRole(app.Name, env),
+		names.EnvManagerRole(app.Name, env),
 	}
 	for _, roleName := range roleNames {
 		tags, err := o.iam.ListRoleTags(roleName)
@@ -821,6 +1003,7 @@ func (o *initEnvOpts) writeManifest() (string, error) {
 		ImportVPC:                   o.importVPCConfig(),
 		VPCConfig:                   o.adjustVPCConfig(),
 		ImportCertARNs:              o.importCerts,
+		ImportCluster:               o.importCluster,
 		InternalALBSubnets:          o.internalALBSubnets,
 		EnableInternalALBVPCIngress: o.allowVPCIngress,
 	}
@@ -875,11 +1058,17 @@ func buildEnvInitCmd() *cobra.Command {
   /code --import-private-subnets subnet-055fafef48fb3c547,subnet-00c9e76f288363e7f \
   /code --import-cert-arns arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012
 
+  Creates an environment that runs its services and jobs in an existing ECS cluster.
+  /code $ copilot env init --name test --import-cluster shared-cluster
+
   Creates an environment with overridden CIDRs and AZs.
   /code $ copilot env init --override-vpc-cidr 10.1.0.0/16 \
   /code --override-az-names us-west-2b,us-west-2c \
   /code --override-public-cidrs 10.1.0.0/24,10.1.1.0/24 \
-  /code --override-private-cidrs 10.1.2.0/24,10.1.3.0/24`,
+  /code --override-private-cidrs 10.1.2.0/24,10.1.3.0/24
+
+  Creates an environment whose /20 VPC CIDR doesn't overlap with the other environments of the application.
+  /code $ copilot env init --name test --suggest-cidr --cidr-size 20`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitEnvOpts(vars)
 			if err != nil {
@@ -903,6 +1092,7 @@ func buildEnvInitCmd() *cobra.Command {
 	cmd.Flags().StringSliceVar(&vars.importVPC.PublicSubnetIDs, publicSubnetsFlag, nil, publicSubnetsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.importVPC.PrivateSubnetIDs, privateSubnetsFlag, nil, privateSubnetsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.importCerts, certsFlag, nil, certsFlagDescription)
+	cmd.Flags().StringVar(&vars.importCluster, importClusterFlag, "", importClusterFlagDescription)
 	cmd.Flags().IPNetVar(&vars.adjustVPC.CIDR, overrideVPCCIDRFlag, net.IPNet{}, overrideVPCCIDRFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.adjustVPC.AZs, overrideAZsFlag, nil, overrideAZsFlagDescription)
 	// TODO: use IPNetSliceVar when it is available (https://github.com/spf13/pflag/issues/273).
@@ -911,6 +1101,8 @@ func buildEnvInitCmd() *cobra.Command {
 	cmd.Flags().StringSliceVar(&vars.internalALBSubnets, internalALBSubnetsFlag, nil, internalALBSubnetsFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowVPCIngress, allowVPCIngressFlag, false, allowVPCIngressFlagDescription)
 	cmd.Flags().BoolVar(&vars.defaultConfig, defaultConfigFlag, false, defaultConfigFlagDescription)
+	cmd.Flags().BoolVar(&vars.suggestCIDR, suggestCIDRFlag, false, suggestCIDRFlagDescription)
+	cmd.Flags().IntVar(&vars.suggestedCIDRSize, cidrSizeFlag, defaultSuggestedCIDRSize, cidrSizeFlagDescription)
 
 	flags := pflag.NewFlagSet("Common", pflag.ContinueOnError)
 	flags.AddFlag(cmd.Flags().Lookup(appFlag))
@@ -927,12 +1119,15 @@ func buildEnvInitCmd() *cobra.Command {
 	resourcesImportFlags.AddFlag(cmd.Flags().Lookup(publicSubnetsFlag))
 	resourcesImportFlags.AddFlag(cmd.Flags().Lookup(privateSubnetsFlag))
 	resourcesImportFlags.AddFlag(cmd.Flags().Lookup(certsFlag))
+	resourcesImportFlags.AddFlag(cmd.Flags().Lookup(importClusterFlag))
 
 	resourcesConfigFlags := pflag.NewFlagSet("Configure Default Resources", pflag.ContinueOnError)
 	resourcesConfigFlags.AddFlag(cmd.Flags().Lookup(overrideVPCCIDRFlag))
 	resourcesConfigFlags.AddFlag(cmd.Flags().Lookup(overrideAZsFlag))
 	resourcesConfigFlags.AddFlag(cmd.Flags().Lookup(overridePublicSubnetCIDRsFlag))
 	resourcesConfigFlags.AddFlag(cmd.Flags().Lookup(overridePrivateSubnetCIDRsFlag))
+	resourcesConfigFlags.AddFlag(cmd.Flags().Lookup(suggestCIDRFlag))
+	resourcesConfigFlags.AddFlag(cmd.Flags().Lookup(cidrSizeFlag))
 	resourcesConfigFlags.AddFlag(cmd.Flags().Lookup(internalALBSubnetsFlag))
 	resourcesConfigFlags.AddFlag(cmd.Flags().Lookup(allowVPCIngressFlag))
 
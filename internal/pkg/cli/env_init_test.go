@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
@@ -38,6 +39,7 @@ type initEnvMocks struct {
 	ec2Client    *mocks.Mockec2Client
 	selApp       *mocks.MockappSelector
 	store        *mocks.Mockstore
+	cluster      *mocks.MockclusterDescriber
 	wsAppName    string
 }
 
@@ -51,10 +53,14 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 		inPublicIDs          []string
 		inPrivateIDs         []string
 		inInternalALBSubnets []string
+		inImportCluster      string
+		inContainerInsights  bool
 
 		inVPCCIDR     net.IPNet
 		inAZs         []string
 		inPublicCIDRs []string
+		inSuggestCIDR bool
+		inCIDRSize    int
 
 		inProfileName     string
 		inAccessKeyID     string
@@ -147,6 +153,32 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 			},
 			wantedErrMsg: fmt.Sprintf("cannot import or configure vpc if --%s is set", defaultConfigFlag),
 		},
+		"cannot import a cluster if use default flag is set": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+
+			inDefault:       true,
+			inImportCluster: "shared",
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test-pdx").Return(nil, &config.ErrNoSuchEnvironment{})
+			},
+			wantedErrMsg: fmt.Sprintf("cannot import a cluster if --%s is set", defaultConfigFlag),
+		},
+		"cannot enable container insights for an imported cluster": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+
+			inImportCluster:     "shared",
+			inContainerInsights: true,
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test-pdx").Return(nil, &config.ErrNoSuchEnvironment{})
+			},
+			wantedErrMsg: fmt.Sprintf("cannot enable --%s for an imported cluster", enableContainerInsightsFlag),
+		},
 		"should err if both profile and access key id are set": {
 			inAppName:     "phonetool",
 			inEnvName:     "test",
@@ -183,6 +215,70 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 			},
 			wantedErrMsg: "cannot specify both --profile and --aws-session-token",
 		},
+		"should err if the VPC CIDR overlaps with a peered network": {
+			inEnvName: "test",
+			inAppName: "phonetool",
+			inVPCCIDR: net.IPNet{
+				IP:   net.IP{10, 100, 0, 0},
+				Mask: net.IPMask{255, 255, 0, 0},
+			},
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, &config.ErrNoSuchEnvironment{})
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Name:        "phonetool",
+					PeeredCIDRs: []string{"192.168.0.0/16", "10.100.128.0/20"},
+				}, nil)
+			},
+			wantedErrMsg: "VPC CIDR 10.100.0.0/16 overlaps with the peered network 10.100.128.0/20 of application phonetool",
+		},
+		"valid VPC CIDR that doesn't overlap with peered networks": {
+			inEnvName: "test",
+			inAppName: "phonetool",
+			inVPCCIDR: net.IPNet{
+				IP:   net.IP{10, 1, 0, 0},
+				Mask: net.IPMask{255, 255, 0, 0},
+			},
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, &config.ErrNoSuchEnvironment{})
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Name:        "phonetool",
+					PeeredCIDRs: []string{"10.100.0.0/16"},
+				}, nil)
+			},
+		},
+		"cannot suggest a CIDR if the VPC CIDR is overridden": {
+			inSuggestCIDR: true,
+			inCIDRSize:    16,
+			inPublicCIDRs: []string{"10.1.0.0/24", "10.1.1.0/24"},
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+			},
+			wantedErrMsg: "cannot specify both --suggest-cidr and CIDR override flags",
+		},
+		"cannot suggest a CIDR when importing a VPC": {
+			inSuggestCIDR: true,
+			inCIDRSize:    16,
+			inVPCID:       "mockID",
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+			},
+			wantedErrMsg: "cannot suggest a vpc CIDR when importing a vpc",
+		},
+		"should err if the suggested CIDR size is out of range": {
+			inSuggestCIDR: true,
+			inCIDRSize:    8,
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+			},
+			wantedErrMsg: "--cidr-size must be between 16 and 24",
+		},
 		"should err if fewer than two private subnets are set:": {
 			inVPCID:      "mockID",
 			inPublicIDs:  []string{"mockID", "anotherMockID"},
@@ -282,6 +378,12 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 					name:               tc.inEnvName,
 					defaultConfig:      tc.inDefault,
 					internalALBSubnets: tc.inInternalALBSubnets,
+					importCluster:      tc.inImportCluster,
+					suggestCIDR:        tc.inSuggestCIDR,
+					suggestedCIDRSize:  tc.inCIDRSize,
+					telemetry: telemetryVars{
+						EnableContainerInsights: tc.inContainerInsights,
+					},
 					adjustVPC: adjustVPCVars{
 						AZs:               tc.inAZs,
 						PublicSubnetCIDRs: tc.inPublicCIDRs,
@@ -355,10 +457,16 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 		inImportVPCVars      importVPCVars
 		inAdjustVPCVars      adjustVPCVars
 		inInternalALBSubnets []string
+		inImportCluster      string
+		inSuggestCIDR        bool
+		inCIDRSize           int
+		inEnvVPCCIDRs        func(env *config.Environment) ([]string, error)
 
 		setupMocks func(mocks initEnvMocks)
 
-		wantedError error
+		wantedImportCluster string
+		wantedAdjustVPC     adjustVPCVars
+		wantedError         error
 	}{
 		"fail to get env name": {
 			inAppName: mockApp,
@@ -441,6 +549,19 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				m.prompt.EXPECT().Get("Which region?", gomock.Any(), nil, gomock.Any(), gomock.Any()).Times(0)
 			},
 		},
+		"should fail early if the region does not support ECS": {
+			inAppName: mockApp,
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inRegion:  "us-west-3",
+			inDefault: true,
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(&session.Session{
+					Config: &aws.Config{},
+				}, nil)
+			},
+			wantedError: errors.New("validate environment region: ECS services and jobs cannot be used in region us-west-3: Amazon ECS is not available in region us-west-3"),
+		},
 		"should not prompt for configuring environment if default config flag is true": {
 			inAppName: mockApp,
 			inEnv:     mockEnv,
@@ -451,6 +572,50 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 			},
 		},
+		"should resolve the name of an imported cluster": {
+			inAppName:       mockApp,
+			inEnv:           mockEnv,
+			inProfile:       mockProfile,
+			inDefault:       true,
+			inImportCluster: "arn:aws:ecs:us-west-2:123456789012:cluster/shared",
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.cluster.EXPECT().Cluster("arn:aws:ecs:us-west-2:123456789012:cluster/shared").Return(&awsecs.Cluster{
+					ARN:               "arn:aws:ecs:us-west-2:123456789012:cluster/shared",
+					Name:              "shared",
+					Status:            "ACTIVE",
+					CapacityProviders: []string{"FARGATE", "FARGATE_SPOT"},
+				}, nil)
+			},
+			wantedImportCluster: "shared",
+		},
+		"should fail if the imported cluster can't be described": {
+			inAppName:       mockApp,
+			inEnv:           mockEnv,
+			inProfile:       mockProfile,
+			inDefault:       true,
+			inImportCluster: "shared",
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.cluster.EXPECT().Cluster("shared").Return(nil, mockErr)
+			},
+			wantedError: errors.New("get cluster shared: some error"),
+		},
+		"should fail if the imported cluster is not active": {
+			inAppName:       mockApp,
+			inEnv:           mockEnv,
+			inProfile:       mockProfile,
+			inDefault:       true,
+			inImportCluster: "shared",
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.cluster.EXPECT().Cluster("shared").Return(&awsecs.Cluster{
+					Name:   "shared",
+					Status: "INACTIVE",
+				}, nil)
+			},
+			wantedError: errors.New("cluster shared is inactive and cannot run tasks"),
+		},
 		"fail to select whether to adjust or import resources": {
 			inAppName: mockApp,
 			inEnv:     mockEnv,
@@ -756,6 +921,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
 				m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, "", envInitCustomizedEnvTypes, gomock.Any()).
 					Return(envInitAdjustEnvResourcesSelectOption, nil)
+				m.store.EXPECT().GetApplication(mockApp).Return(&config.Application{Name: mockApp}, nil)
 				m.prompt.EXPECT().Get(envInitVPCCIDRPrompt, envInitVPCCIDRPromptHelp, gomock.Any(), gomock.Any()).
 					Return("", mockErr)
 			},
@@ -769,6 +935,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
 				m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(envInitAdjustEnvResourcesSelectOption, nil)
+				m.store.EXPECT().GetApplication(mockApp).Return(&config.Application{Name: mockApp}, nil)
 				m.prompt.EXPECT().Get(envInitVPCCIDRPrompt, gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(mockVPCCIDR, nil)
 				m.ec2Client.EXPECT().ListAZs().Return(nil, errors.New("some error"))
@@ -783,6 +950,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
 				m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(envInitAdjustEnvResourcesSelectOption, nil)
+				m.store.EXPECT().GetApplication(mockApp).Return(&config.Application{Name: mockApp}, nil)
 				m.prompt.EXPECT().Get(envInitVPCCIDRPrompt, gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(mockVPCCIDR, nil)
 				m.ec2Client.EXPECT().ListAZs().Return([]ec2.AZ{
@@ -801,6 +969,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
 				m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(envInitAdjustEnvResourcesSelectOption, nil)
+				m.store.EXPECT().GetApplication(mockApp).Return(&config.Application{Name: mockApp}, nil)
 				m.prompt.EXPECT().Get(envInitVPCCIDRPrompt, gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(mockVPCCIDR, nil)
 				m.ec2Client.EXPECT().ListAZs().Return([]ec2.AZ{
@@ -826,6 +995,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
 				m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(envInitAdjustEnvResourcesSelectOption, nil)
+				m.store.EXPECT().GetApplication(mockApp).Return(&config.Application{Name: mockApp}, nil)
 				m.prompt.EXPECT().Get(envInitVPCCIDRPrompt, gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(mockVPCCIDR, nil)
 				m.ec2Client.EXPECT().ListAZs().Return([]ec2.AZ{
@@ -853,6 +1023,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
 				m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, "", envInitCustomizedEnvTypes, gomock.Any()).
 					Return(envInitAdjustEnvResourcesSelectOption, nil)
+				m.store.EXPECT().GetApplication(mockApp).Return(&config.Application{Name: mockApp}, nil)
 				m.prompt.EXPECT().Get(envInitVPCCIDRPrompt, envInitVPCCIDRPromptHelp, gomock.Any(), gomock.Any()).
 					Return(mockVPCCIDR, nil)
 				m.ec2Client.EXPECT().ListAZs().Return([]ec2.AZ{
@@ -871,6 +1042,64 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 					Return(mockSubnetCIDRs, nil)
 			},
 		},
+		"should suggest a VPC CIDR that doesn't overlap with other environments or peered networks": {
+			inAppName:     mockApp,
+			inEnv:         mockEnv,
+			inProfile:     mockProfile,
+			inSuggestCIDR: true,
+			inCIDRSize:    16,
+			inAdjustVPCVars: adjustVPCVars{
+				AZs: []string{"us-east-1a", "us-east-1b"},
+			},
+			inEnvVPCCIDRs: func(env *config.Environment) ([]string, error) {
+				switch env.Name {
+				case "prod":
+					return []string{"10.0.0.0/16"}, nil
+				case "staging":
+					return nil, errors.New("some error")
+				}
+				return []string{"10.2.0.0/16"}, nil
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.store.EXPECT().GetApplication(mockApp).Return(&config.Application{
+					Name:        mockApp,
+					PeeredCIDRs: []string{"10.1.0.0/16"},
+				}, nil)
+				m.store.EXPECT().ListEnvironments(mockApp).Return([]*config.Environment{
+					{App: mockApp, Name: "prod"},
+					{App: mockApp, Name: "staging"},
+					{App: mockApp, Name: "dev"},
+				}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.prompt.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedAdjustVPC: adjustVPCVars{
+				CIDR: net.IPNet{
+					IP:   net.IP{10, 3, 0, 0},
+					Mask: net.IPMask{255, 255, 0, 0},
+				},
+				AZs:                []string{"us-east-1a", "us-east-1b"},
+				PublicSubnetCIDRs:  []string{"10.3.0.0/24", "10.3.1.0/24"},
+				PrivateSubnetCIDRs: []string{"10.3.2.0/24", "10.3.3.0/24"},
+			},
+		},
+		"should return err if environments cannot be listed to suggest a VPC CIDR": {
+			inAppName:     mockApp,
+			inEnv:         mockEnv,
+			inProfile:     mockProfile,
+			inSuggestCIDR: true,
+			inCIDRSize:    16,
+			inAdjustVPCVars: adjustVPCVars{
+				AZs: []string{"us-east-1a", "us-east-1b"},
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.store.EXPECT().GetApplication(mockApp).Return(&config.Application{Name: mockApp}, nil)
+				m.store.EXPECT().ListEnvironments(mockApp).Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("list environments in application %s: some error", mockApp),
+		},
 		"success with adjusting default env config with flags": {
 			inAppName: mockApp,
 			inEnv:     mockEnv,
@@ -904,6 +1133,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				ec2Client:    mocks.NewMockec2Client(ctrl),
 				selApp:       mocks.NewMockappSelector(ctrl),
 				store:        mocks.NewMockstore(ctrl),
+				cluster:      mocks.NewMockclusterDescriber(ctrl),
 			}
 
 			tc.setupMocks(mocks)
@@ -919,7 +1149,11 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 					adjustVPC:          tc.inAdjustVPCVars,
 					importVPC:          tc.inImportVPCVars,
 					internalALBSubnets: tc.inInternalALBSubnets,
+					importCluster:      tc.inImportCluster,
+					suggestCIDR:        tc.inSuggestCIDR,
+					suggestedCIDRSize:  tc.inCIDRSize,
 				},
+				envVPCCIDRs:  tc.inEnvVPCCIDRs,
 				sessProvider: mocks.sessProvider,
 				selVPC:       mocks.selVPC,
 				selCreds:     mocks.selCreds,
@@ -927,6 +1161,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				prompt:       mocks.prompt,
 				selApp:       mocks.selApp,
 				store:        mocks.store,
+				cluster:      mocks.cluster,
 			}
 
 			// WHEN
@@ -936,6 +1171,10 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 			if tc.wantedError == nil {
 				require.NoError(t, err)
 				require.Equal(t, mockEnv, addEnv.name, "expected environment names to match")
+				require.Equal(t, tc.wantedImportCluster, addEnv.importCluster)
+				if tc.wantedAdjustVPC.isSet() {
+					require.Equal(t, tc.wantedAdjustVPC, addEnv.adjustVPC)
+				}
 			} else {
 				require.EqualError(t, err, tc.wantedError.Error())
 			}
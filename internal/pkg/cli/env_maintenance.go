@@ -0,0 +1,373 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/health"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envMaintenanceAppNamePrompt     = "Which application is the environment in?"
+	envMaintenanceAppNameHelpPrompt = "An application is a collection of related services."
+	envMaintenanceNamePrompt        = "Which environment of %s would you like to check for scheduled maintenance?"
+	fmtEnvMaintenanceRestartPrompt  = "Are you sure you want to restart %s in environment %s now?"
+
+	// maintenanceWindowLayout is the layout of the start and end times of a maintenance window.
+	maintenanceWindowLayout = "15:04"
+)
+
+var (
+	errEnvMaintenanceRestartCancelled = errors.New("env maintenance restart cancelled - no changes made")
+)
+
+type maintenanceEnvVars struct {
+	appName          string
+	name             string
+	restart          bool
+	window           string
+	skipConfirmation bool
+}
+
+type maintenanceEnvOpts struct {
+	maintenanceEnvVars
+
+	store                 store
+	deployStore           deployedWorkloadsLister
+	sel                   configSelector
+	prompt                prompter
+	newMaintenanceClients func(env *config.Environment) (scheduledChangesLister, ecsServiceRestarter, error)
+	w                     io.Writer
+	now                   func() time.Time
+}
+
+func newMaintenanceEnvOpts(vars maintenanceEnvVars) (*maintenanceEnvOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env maintenance"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	return &maintenanceEnvOpts{
+		maintenanceEnvVars: vars,
+
+		store:       store,
+		deployStore: deployStore,
+		sel:         selector.NewConfigSelector(prompter, store),
+		prompt:      prompter,
+		newMaintenanceClients: func(env *config.Environment) (scheduledChangesLister, ecsServiceRestarter, error) {
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return health.New(sess), ecs.New(sess), nil
+		},
+		w:   log.OutputWriter,
+		now: time.Now,
+	}, nil
+}
+
+// Validate returns an error if the maintenance window is malformed or set without --restart.
+func (o *maintenanceEnvOpts) Validate() error {
+	if o.window == "" {
+		return nil
+	}
+	if !o.restart {
+		return fmt.Errorf("--%s must be specified with --%s", restartFlag, windowFlag)
+	}
+	if _, _, err := parseMaintenanceWindow(o.window); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Ask validates the application and environment names if they're provided, otherwise it prompts for them.
+func (o *maintenanceEnvOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateOrAskEnv()
+}
+
+// Execute lists the changes that AWS scheduled for the tasks of the services in the environment.
+// If --restart is set, it restarts the affected services so that their tasks are replaced ahead of the scheduled changes.
+func (o *maintenanceEnvOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.name, err)
+	}
+	changesLister, restarter, err := o.newMaintenanceClients(env)
+	if err != nil {
+		return err
+	}
+	events, err := changesLister.ScheduledECSChanges(env.Region)
+	if err != nil {
+		return fmt.Errorf("list scheduled changes for environment %s: %w", o.name, err)
+	}
+	var svcs []*maintenanceService
+	if len(events) != 0 {
+		if svcs, err = o.ecsServices(restarter); err != nil {
+			return err
+		}
+	}
+	affected := affectedServicesByEvent(events, svcs)
+	if len(affected) == 0 {
+		log.Successf("No scheduled maintenance affects the services in environment %s.\n", color.HighlightUserInput(o.name))
+		return nil
+	}
+	o.printScheduledChanges(events, affected)
+	if !o.restart {
+		log.Infof("Run %s to replace the tasks of these services ahead of the scheduled changes.\n",
+			color.HighlightCode(fmt.Sprintf("copilot env maintenance --name %s --%s", o.name, restartFlag)))
+		return nil
+	}
+	return o.restartServices(restarter, affected)
+}
+
+type maintenanceService struct {
+	name     string // Name of the Copilot service.
+	ecsName  string // Name of the ECS service.
+	taskARNs []string
+}
+
+func (o *maintenanceEnvOpts) ecsServices(describer ecsServiceRestarter) ([]*maintenanceService, error) {
+	names, err := o.deployStore.ListDeployedServices(o.appName, o.name)
+	if err != nil {
+		return nil, fmt.Errorf("list services deployed to environment %s: %w", o.name, err)
+	}
+	var svcs []*maintenanceService
+	for _, name := range names {
+		wkld, err := o.store.GetWorkload(o.appName, name)
+		if err != nil {
+			return nil, fmt.Errorf("get service %s configuration: %w", name, err)
+		}
+		if wkld.Type == manifest.RequestDrivenWebServiceType {
+			// App Runner services are not run as ECS tasks.
+			continue
+		}
+		desc, err := describer.DescribeService(o.appName, o.name, name)
+		if err != nil {
+			return nil, fmt.Errorf("describe ECS service for %s in environment %s: %w", name, o.name, err)
+		}
+		svc := &maintenanceService{
+			name:    name,
+			ecsName: desc.Name,
+		}
+		for _, task := range desc.Tasks {
+			svc.taskARNs = append(svc.taskARNs, aws.StringValue(task.TaskArn))
+		}
+		svcs = append(svcs, svc)
+	}
+	return svcs, nil
+}
+
+// affectedServicesByEvent returns the names of the services affected by each event, keyed by the event ARN.
+// Events that don't affect any service are omitted.
+func affectedServicesByEvent(events []health.Event, svcs []*maintenanceService) map[string][]string {
+	affected := make(map[string][]string)
+	for _, event := range events {
+		for _, svc := range svcs {
+			if svc.isAffectedBy(event) {
+				affected[event.ARN] = append(affected[event.ARN], svc.name)
+			}
+		}
+	}
+	return affected
+}
+
+func (s *maintenanceService) isAffectedBy(event health.Event) bool {
+	for _, entity := range event.AffectedEntities {
+		if s.ecsName != "" && strings.Contains(entity, s.ecsName) {
+			return true
+		}
+		for _, arn := range s.taskARNs {
+			if entity == arn {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (o *maintenanceEnvOpts) printScheduledChanges(events []health.Event, affected map[string][]string) {
+	writer := tabwriter.NewWriter(o.w, 0, 4, 2, ' ', 0)
+	fmt.Fprint(writer, color.Bold.Sprintf("Scheduled maintenance for environment %s\n\n", o.name))
+	writer.Flush()
+	headers := []string{"Event", "Status", "Start", "End", "Services"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	var underlines []string
+	for _, header := range headers {
+		underlines = append(underlines, strings.Repeat("-", len(header)))
+	}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underlines, "\t"))
+	for _, event := range events {
+		svcs, ok := affected[event.ARN]
+		if !ok {
+			continue
+		}
+		end := "-"
+		if !event.EndTime.IsZero() {
+			end = event.EndTime.UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", event.TypeCode, event.StatusCode,
+			event.StartTime.UTC().Format(time.RFC3339), end, strings.Join(svcs, ", "))
+	}
+	writer.Flush()
+}
+
+func (o *maintenanceEnvOpts) restartServices(restarter ecsServiceRestarter, affected map[string][]string) error {
+	var svcs []string
+	seen := make(map[string]bool)
+	for _, names := range affected {
+		for _, name := range names {
+			if !seen[name] {
+				seen[name] = true
+				svcs = append(svcs, name)
+			}
+		}
+	}
+	sort.Strings(svcs)
+	if o.window != "" {
+		start, end, err := parseMaintenanceWindow(o.window)
+		if err != nil {
+			return err
+		}
+		if !inMaintenanceWindow(o.now().UTC(), start, end) {
+			return fmt.Errorf("current time %s is outside of the maintenance window %s (UTC)", o.now().UTC().Format(maintenanceWindowLayout), o.window)
+		}
+	}
+	if !o.skipConfirmation {
+		label := fmt.Sprintf("%s %s", english.PluralWord(len(svcs), "service", ""), english.WordSeries(svcs, "and"))
+		confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtEnvMaintenanceRestartPrompt, label, o.name), "", prompt.WithConfirmFinalMessage())
+		if err != nil {
+			return fmt.Errorf("confirm to restart services in environment %s: %w", o.name, err)
+		}
+		if !confirmed {
+			return errEnvMaintenanceRestartCancelled
+		}
+	}
+	for _, svc := range svcs {
+		if err := restarter.ForceUpdateService(o.appName, o.name, svc); err != nil {
+			return fmt.Errorf("restart service %s in environment %s: %w", svc, o.name, err)
+		}
+		log.Successf("Started a rolling restart of service %s.\n", color.HighlightUserInput(svc))
+	}
+	return nil
+}
+
+// parseMaintenanceWindow parses a window of the form "HH:MM-HH:MM" in UTC, and returns its start and end as minutes since midnight.
+func parseMaintenanceWindow(window string) (start, end int, err error) {
+	parts := strings.Split(window, "-")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf(`maintenance window %q must be of the form "HH:MM-HH:MM"`, window)
+	}
+	var minutes [2]int
+	for i, part := range parts {
+		t, err := time.Parse(maintenanceWindowLayout, strings.TrimSpace(part))
+		if err != nil {
+			return 0, 0, fmt.Errorf(`maintenance window %q must be of the form "HH:MM-HH:MM"`, window)
+		}
+		minutes[i] = t.Hour()*60 + t.Minute()
+	}
+	if minutes[0] == minutes[1] {
+		return 0, 0, fmt.Errorf("maintenance window %q cannot start and end at the same time", window)
+	}
+	return minutes[0], minutes[1], nil
+}
+
+// inMaintenanceWindow returns true if now falls within the window. The window can span midnight.
+func inMaintenanceWindow(now time.Time, start, end int) bool {
+	minute := now.Hour()*60 + now.Minute()
+	if start < end {
+		return minute >= start && minute < end
+	}
+	return minute >= start || minute < end
+}
+
+func (o *maintenanceEnvOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application name %q: %v", o.appName, err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(envMaintenanceAppNamePrompt, envMaintenanceAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *maintenanceEnvOpts) validateOrAskEnv() error {
+	if o.name != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.name); err != nil {
+			return fmt.Errorf("validate environment name %q in application %q: %v", o.name, o.appName, err)
+		}
+		return nil
+	}
+	env, err := o.sel.Environment(fmt.Sprintf(envMaintenanceNamePrompt, color.HighlightUserInput(o.appName)), "", o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment for application %s: %w", o.appName, err)
+	}
+	o.name = env
+	return nil
+}
+
+// buildEnvMaintenanceCmd builds the command for listing the maintenance that AWS scheduled for the services in an environment.
+func buildEnvMaintenanceCmd() *cobra.Command {
+	vars := maintenanceEnvVars{}
+	cmd := &cobra.Command{
+		Use:   "maintenance",
+		Short: "Lists the maintenance that AWS scheduled for the services in an environment.",
+		Long: `Lists the maintenance that AWS scheduled for the services in an environment,
+such as Fargate task retirements and platform version migrations.
+Optionally, restarts the affected services to replace their tasks ahead of the scheduled changes.`,
+		Example: `
+  List the scheduled maintenance affecting the services in the "prod" environment.
+  /code $ copilot env maintenance --name prod
+
+  Restart the affected services if the current time is between 02:00 and 04:00 UTC.
+  /code $ copilot env maintenance --name prod --restart --window 02:00-04:00 --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newMaintenanceEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.restart, restartFlag, false, envMaintenanceRestartFlagDescription)
+	cmd.Flags().StringVar(&vars.window, windowFlag, "", envMaintenanceWindowFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
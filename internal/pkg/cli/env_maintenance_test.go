@@ -0,0 +1,363 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/health"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type maintenanceEnvMocks struct {
+	store       *mocks.Mockstore
+	deployStore *mocks.MockdeployedWorkloadsLister
+	sel         *mocks.MockconfigSelector
+	prompt      *mocks.Mockprompter
+	lister      *mocks.MockscheduledChangesLister
+	restarter   *mocks.MockecsServiceRestarter
+}
+
+func TestMaintenanceEnvOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inRestart bool
+		inWindow  string
+
+		wantedError error
+	}{
+		"no error without a window": {},
+		"error if the window is set without --restart": {
+			inWindow:    "02:00-04:00",
+			wantedError: errors.New("--restart must be specified with --window"),
+		},
+		"error if the window is malformed": {
+			inRestart:   true,
+			inWindow:    "02:00",
+			wantedError: errors.New(`maintenance window "02:00" must be of the form "HH:MM-HH:MM"`),
+		},
+		"error if a time in the window is invalid": {
+			inRestart:   true,
+			inWindow:    "02:00-25:00",
+			wantedError: errors.New(`maintenance window "02:00-25:00" must be of the form "HH:MM-HH:MM"`),
+		},
+		"error if the window starts and ends at the same time": {
+			inRestart:   true,
+			inWindow:    "02:00-02:00",
+			wantedError: errors.New(`maintenance window "02:00-02:00" cannot start and end at the same time`),
+		},
+		"valid window that spans midnight": {
+			inRestart: true,
+			inWindow:  "22:00-02:00",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &maintenanceEnvOpts{
+				maintenanceEnvVars: maintenanceEnvVars{
+					restart: tc.inRestart,
+					window:  tc.inWindow,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMaintenanceEnvOpts_Ask(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testEnv = "test"
+	)
+	testCases := map[string]struct {
+		inAppName string
+		inEnvName string
+
+		setupMocks func(m *maintenanceEnvMocks)
+
+		wantedAppName string
+		wantedEnvName string
+		wantedError   error
+	}{
+		"error if the application does not exist": {
+			inAppName: testApp,
+			setupMocks: func(m *maintenanceEnvMocks) {
+				m.store.EXPECT().GetApplication(testApp).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New(`validate application name "phonetool": some error`),
+		},
+		"error if the environment does not exist": {
+			inAppName: testApp,
+			inEnvName: testEnv,
+			setupMocks: func(m *maintenanceEnvMocks) {
+				m.store.EXPECT().GetApplication(testApp).Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment(testApp, testEnv).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New(`validate environment name "test" in application "phonetool": some error`),
+		},
+		"error if fails to select an application": {
+			setupMocks: func(m *maintenanceEnvMocks) {
+				m.sel.EXPECT().Application(envMaintenanceAppNamePrompt, envMaintenanceAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select application: some error"),
+		},
+		"prompts for the application and environment": {
+			setupMocks: func(m *maintenanceEnvMocks) {
+				m.sel.EXPECT().Application(envMaintenanceAppNamePrompt, envMaintenanceAppNameHelpPrompt).Return(testApp, nil)
+				m.sel.EXPECT().Environment(gomock.Any(), "", testApp).Return(testEnv, nil)
+			},
+			wantedAppName: testApp,
+			wantedEnvName: testEnv,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &maintenanceEnvMocks{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockconfigSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &maintenanceEnvOpts{
+				maintenanceEnvVars: maintenanceEnvVars{
+					appName: tc.inAppName,
+					name:    tc.inEnvName,
+				},
+				store: m.store,
+				sel:   m.sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAppName, opts.appName)
+			require.Equal(t, tc.wantedEnvName, opts.name)
+		})
+	}
+}
+
+func TestMaintenanceEnvOpts_Execute(t *testing.T) {
+	const (
+		testApp    = "phonetool"
+		testEnv    = "test"
+		testRegion = "us-west-2"
+		apiTaskARN = "arn:aws:ecs:us-west-2:123456789012:task/phonetool-test-Cluster/abc"
+	)
+	testEnvConfig := &config.Environment{
+		App:    testApp,
+		Name:   testEnv,
+		Region: testRegion,
+	}
+	retirement := health.Event{
+		ARN:              "arn:aws:health:us-west-2::event/ECS/AWS_ECS_TASK_PATCHING_RETIREMENT/1",
+		TypeCode:         "AWS_ECS_TASK_PATCHING_RETIREMENT",
+		StatusCode:       "upcoming",
+		StartTime:        time.Date(2026, 10, 20, 10, 0, 0, 0, time.UTC),
+		AffectedEntities: []string{apiTaskARN},
+	}
+	migration := health.Event{
+		ARN:              "arn:aws:health:us-west-2::event/ECS/AWS_ECS_PLATFORM_VERSION_MIGRATION/2",
+		TypeCode:         "AWS_ECS_PLATFORM_VERSION_MIGRATION",
+		StatusCode:       "open",
+		StartTime:        time.Date(2026, 10, 21, 10, 0, 0, 0, time.UTC),
+		EndTime:          time.Date(2026, 10, 28, 10, 0, 0, 0, time.UTC),
+		AffectedEntities: []string{"arn:aws:ecs:us-west-2:123456789012:service/phonetool-test-Cluster/phonetool-test-frontend-Service-xyz"},
+	}
+	unrelated := health.Event{
+		ARN:              "arn:aws:health:us-west-2::event/ECS/AWS_ECS_TASK_PATCHING_RETIREMENT/3",
+		TypeCode:         "AWS_ECS_TASK_PATCHING_RETIREMENT",
+		StatusCode:       "upcoming",
+		StartTime:        time.Date(2026, 10, 20, 10, 0, 0, 0, time.UTC),
+		AffectedEntities: []string{"arn:aws:ecs:us-west-2:123456789012:task/other-cluster/def"},
+	}
+	mockServices := func(m *maintenanceEnvMocks) {
+		m.deployStore.EXPECT().ListDeployedServices(testApp, testEnv).Return([]string{"api", "frontend", "runner"}, nil)
+		m.store.EXPECT().GetWorkload(testApp, "api").Return(&config.Workload{Type: manifest.BackendServiceType}, nil)
+		m.store.EXPECT().GetWorkload(testApp, "frontend").Return(&config.Workload{Type: manifest.LoadBalancedWebServiceType}, nil)
+		m.store.EXPECT().GetWorkload(testApp, "runner").Return(&config.Workload{Type: manifest.RequestDrivenWebServiceType}, nil)
+		m.restarter.EXPECT().DescribeService(testApp, testEnv, "api").Return(&ecs.ServiceDesc{
+			Name:  "phonetool-test-api-Service-abc",
+			Tasks: []*awsecs.Task{{TaskArn: aws.String(apiTaskARN)}},
+		}, nil)
+		m.restarter.EXPECT().DescribeService(testApp, testEnv, "frontend").Return(&ecs.ServiceDesc{
+			Name: "phonetool-test-frontend-Service-xyz",
+		}, nil)
+	}
+	testCases := map[string]struct {
+		inRestart          bool
+		inWindow           string
+		inSkipConfirmation bool
+		now                time.Time
+
+		setupMocks func(m *maintenanceEnvMocks)
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"error if fails to get the environment": {
+			setupMocks: func(m *maintenanceEnvMocks) {
+				m.store.EXPECT().GetEnvironment(testApp, testEnv).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get environment test configuration: some error"),
+		},
+		"error if fails to list scheduled changes": {
+			setupMocks: func(m *maintenanceEnvMocks) {
+				m.store.EXPECT().GetEnvironment(testApp, testEnv).Return(testEnvConfig, nil)
+				m.lister.EXPECT().ScheduledECSChanges(testRegion).Return(nil, health.ErrSubscriptionRequired)
+			},
+			wantedError: fmt.Errorf("list scheduled changes for environment test: %w", health.ErrSubscriptionRequired),
+		},
+		"error if fails to describe a service": {
+			setupMocks: func(m *maintenanceEnvMocks) {
+				m.store.EXPECT().GetEnvironment(testApp, testEnv).Return(testEnvConfig, nil)
+				m.lister.EXPECT().ScheduledECSChanges(testRegion).Return([]health.Event{retirement}, nil)
+				m.deployStore.EXPECT().ListDeployedServices(testApp, testEnv).Return([]string{"api"}, nil)
+				m.store.EXPECT().GetWorkload(testApp, "api").Return(&config.Workload{Type: manifest.BackendServiceType}, nil)
+				m.restarter.EXPECT().DescribeService(testApp, testEnv, "api").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe ECS service for api in environment test: some error"),
+		},
+		"does not describe services if nothing is scheduled": {
+			setupMocks: func(m *maintenanceEnvMocks) {
+				m.store.EXPECT().GetEnvironment(testApp, testEnv).Return(testEnvConfig, nil)
+				m.lister.EXPECT().ScheduledECSChanges(testRegion).Return(nil, nil)
+			},
+		},
+		"does not print events that don't affect the services": {
+			setupMocks: func(m *maintenanceEnvMocks) {
+				m.store.EXPECT().GetEnvironment(testApp, testEnv).Return(testEnvConfig, nil)
+				m.lister.EXPECT().ScheduledECSChanges(testRegion).Return([]health.Event{unrelated}, nil)
+				mockServices(m)
+			},
+		},
+		"prints the events that affect the services": {
+			setupMocks: func(m *maintenanceEnvMocks) {
+				m.store.EXPECT().GetEnvironment(testApp, testEnv).Return(testEnvConfig, nil)
+				m.lister.EXPECT().ScheduledECSChanges(testRegion).Return([]health.Event{retirement, unrelated, migration}, nil)
+				mockServices(m)
+			},
+			wantedOutput: `Scheduled maintenance for environment test
+
+  Event                               Status    Start                 End                   Services
+  -----                               ------    -----                 ---                   --------
+  AWS_ECS_TASK_PATCHING_RETIREMENT    upcoming  2026-10-20T10:00:00Z  -                     api
+  AWS_ECS_PLATFORM_VERSION_MIGRATION  open      2026-10-21T10:00:00Z  2026-10-28T10:00:00Z  frontend
+`,
+		},
+		"error if the current time is outside of the window": {
+			inRestart: true,
+			inWindow:  "02:00-04:00",
+			now:       time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC),
+			setupMocks: func(m *maintenanceEnvMocks) {
+				m.store.EXPECT().GetEnvironment(testApp, testEnv).Return(testEnvConfig, nil)
+				m.lister.EXPECT().ScheduledECSChanges(testRegion).Return([]health.Event{retirement}, nil)
+				mockServices(m)
+			},
+			wantedError: errors.New("current time 12:00 is outside of the maintenance window 02:00-04:00 (UTC)"),
+		},
+		"error if the restart is cancelled": {
+			inRestart: true,
+			setupMocks: func(m *maintenanceEnvMocks) {
+				m.store.EXPECT().GetEnvironment(testApp, testEnv).Return(testEnvConfig, nil)
+				m.lister.EXPECT().ScheduledECSChanges(testRegion).Return([]health.Event{retirement, migration}, nil)
+				mockServices(m)
+				m.prompt.EXPECT().Confirm(fmt.Sprintf(fmtEnvMaintenanceRestartPrompt, "services api and frontend", testEnv), "", gomock.Any()).Return(false, nil)
+			},
+			wantedError: errEnvMaintenanceRestartCancelled,
+		},
+		"error if fails to restart a service": {
+			inRestart:          true,
+			inSkipConfirmation: true,
+			setupMocks: func(m *maintenanceEnvMocks) {
+				m.store.EXPECT().GetEnvironment(testApp, testEnv).Return(testEnvConfig, nil)
+				m.lister.EXPECT().ScheduledECSChanges(testRegion).Return([]health.Event{retirement}, nil)
+				mockServices(m)
+				m.restarter.EXPECT().ForceUpdateService(testApp, testEnv, "api").Return(errors.New("some error"))
+			},
+			wantedError: errors.New("restart service api in environment test: some error"),
+		},
+		"restarts each affected service once within a window that spans midnight": {
+			inRestart: true,
+			inWindow:  "22:00-02:00",
+			now:       time.Date(2026, 10, 17, 1, 30, 0, 0, time.UTC),
+			setupMocks: func(m *maintenanceEnvMocks) {
+				m.store.EXPECT().GetEnvironment(testApp, testEnv).Return(testEnvConfig, nil)
+				m.lister.EXPECT().ScheduledECSChanges(testRegion).Return([]health.Event{retirement, migration, retirement}, nil)
+				mockServices(m)
+				m.prompt.EXPECT().Confirm(gomock.Any(), "", gomock.Any()).Return(true, nil)
+				gomock.InOrder(
+					m.restarter.EXPECT().ForceUpdateService(testApp, testEnv, "api").Return(nil),
+					m.restarter.EXPECT().ForceUpdateService(testApp, testEnv, "frontend").Return(nil),
+				)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &maintenanceEnvMocks{
+				store:       mocks.NewMockstore(ctrl),
+				deployStore: mocks.NewMockdeployedWorkloadsLister(ctrl),
+				prompt:      mocks.NewMockprompter(ctrl),
+				lister:      mocks.NewMockscheduledChangesLister(ctrl),
+				restarter:   mocks.NewMockecsServiceRestarter(ctrl),
+			}
+			tc.setupMocks(m)
+			buf := new(bytes.Buffer)
+			opts := &maintenanceEnvOpts{
+				maintenanceEnvVars: maintenanceEnvVars{
+					appName:          testApp,
+					name:             testEnv,
+					restart:          tc.inRestart,
+					window:           tc.inWindow,
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+				store:       m.store,
+				deployStore: m.deployStore,
+				prompt:      m.prompt,
+				newMaintenanceClients: func(env *config.Environment) (scheduledChangesLister, ecsServiceRestarter, error) {
+					return m.lister, m.restarter, nil
+				},
+				w: buf,
+				now: func() time.Time {
+					return tc.now
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantedOutput != "" {
+				require.Equal(t, tc.wantedOutput, buf.String())
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@
 package cli
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -51,6 +53,27 @@ func (df discardFile) Close() error {
 	return nil //noop
 }
 
+// bufferFile is an in-memory file that holds everything written to it.
+type bufferFile struct {
+	bytes.Buffer
+}
+
+// Close is a no-op.
+func (bf *bufferFile) Close() error {
+	return nil
+}
+
+// parseSerializedParams returns the parameters of a serialized template configuration.
+func parseSerializedParams(serialized string) (map[string]string, error) {
+	var cfg struct {
+		Parameters map[string]string `json:"Parameters"`
+	}
+	if err := json.Unmarshal([]byte(serialized), &cfg); err != nil {
+		return nil, fmt.Errorf("unmarshal template configuration: %w", err)
+	}
+	return cfg.Parameters, nil
+}
+
 type packageEnvOpts struct {
 	packageEnvVars
 
@@ -108,10 +131,15 @@ func newPackageEnvOpts(vars packageEnvVars) (*packageEnvOpts, error) {
 		if err != nil {
 			return nil, err
 		}
+		guardRulesDir, err := ws.GuardRulesDir()
+		if err != nil {
+			return nil, fmt.Errorf("get cfn-guard rules directory: %w", err)
+		}
 		return deploy.NewEnvDeployer(&deploy.NewEnvDeployerInput{
 			App:             appCfg,
 			Env:             envCfg,
 			SessionProvider: sessProvider,
+			GuardRulesDir:   guardRulesDir,
 		})
 	}
 	return opts, nil
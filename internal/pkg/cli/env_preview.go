@@ -0,0 +1,618 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envPreviewAppNamePrompt     = "Which application is the preview environment in?"
+	envPreviewAppNameHelpPrompt = "An application is a collection of related services."
+	envPreviewBasePrompt        = "Which environment would you like to clone for the preview?"
+	envPreviewBaseHelpPrompt    = "The preview environment has the same network and load balancer configuration as this environment."
+	fmtEnvPreviewCleanupPrompt  = "Are you sure you want to delete the preview %s %s, including the services and jobs deployed there?"
+
+	// fmtPreviewEnvName is the name of the preview environment of a pull request.
+	fmtPreviewEnvName = "pr-%d"
+	// defaultPreviewTTL is how long a preview environment lives after it was last deployed.
+	defaultPreviewTTL = 72 * time.Hour
+)
+
+var (
+	errEnvPreviewCleanupCancelled = errors.New("env preview cleanup cancelled - no changes made")
+)
+
+type createPreviewEnvVars struct {
+	appName     string
+	pullRequest int
+	baseEnv     string
+	svcs        []string
+	profile     string
+	ttl         time.Duration
+}
+
+type createPreviewEnvOpts struct {
+	createPreviewEnvVars
+
+	store           store
+	ws              wsPreviewEnvCloner
+	sel             appEnvSelector
+	newEnvInitCmd   func(o *createPreviewEnvOpts, region string) (cmd, error)
+	newEnvDeployCmd func(o *createPreviewEnvOpts) (cmd, error)
+	newSvcDeployCmd func(o *createPreviewEnvOpts, svc string) (cmd, error)
+	newURIDescriber func(o *createPreviewEnvOpts, svc string) (serviceURIDescriber, error)
+	w               io.Writer
+	now             func() time.Time
+}
+
+// previewEnvSummary is the machine-readable summary of a preview environment, for example to comment on the pull request.
+type previewEnvSummary struct {
+	App         string              `json:"application"`
+	Environment string              `json:"environment"`
+	PullRequest int                 `json:"pullRequest"`
+	ExpiresAt   time.Time           `json:"expiresAt"`
+	Services    []previewSvcSummary `json:"services"`
+}
+
+type previewSvcSummary struct {
+	Name string `json:"name"`
+	URI  string `json:"uri,omitempty"`
+}
+
+func newCreatePreviewEnvOpts(vars createPreviewEnvVars) (*createPreviewEnvOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env preview create"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	return &createPreviewEnvOpts{
+		createPreviewEnvVars: vars,
+
+		store: store,
+		ws:    ws,
+		sel:   selector.NewAppEnvSelector(prompt.New(), store),
+		newEnvInitCmd: func(o *createPreviewEnvOpts, region string) (cmd, error) {
+			opts, err := newInitEnvOpts(initEnvVars{
+				appName:       o.appName,
+				name:          o.envName(),
+				profile:       o.profile,
+				defaultConfig: true,
+				region:        region,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if o.profile == "" {
+				// Create the preview environment with the default credentials instead of prompting for them.
+				if opts.sess, err = sessProvider.DefaultWithRegion(region); err != nil {
+					return nil, fmt.Errorf("create default session in region %s: %w", region, err)
+				}
+			}
+			return opts, nil
+		},
+		newEnvDeployCmd: func(o *createPreviewEnvOpts) (cmd, error) {
+			return newEnvDeployOpts(deployEnvVars{
+				appName: o.appName,
+				name:    o.envName(),
+			})
+		},
+		newSvcDeployCmd: func(o *createPreviewEnvOpts, svc string) (cmd, error) {
+			return newSvcDeployOpts(deployWkldVars{
+				appName: o.appName,
+				name:    svc,
+				envName: o.envName(),
+			})
+		},
+		newURIDescriber: func(o *createPreviewEnvOpts, svc string) (serviceURIDescriber, error) {
+			return describe.NewReachableService(o.appName, svc, o.store)
+		},
+		w:   log.OutputWriter,
+		now: time.Now,
+	}, nil
+}
+
+// Validate returns an error if the pull request number or the time to live are invalid,
+// or if a service to deploy is not in the workspace.
+func (o *createPreviewEnvOpts) Validate() error {
+	if o.pullRequest <= 0 {
+		return fmt.Errorf("--%s must be a positive pull request number", pullRequestFlag)
+	}
+	if o.ttl <= 0 {
+		return fmt.Errorf("--%s must be a positive duration", ttlFlag)
+	}
+	if len(o.svcs) == 0 {
+		return nil
+	}
+	svcs, err := o.ws.ListServices()
+	if err != nil {
+		return fmt.Errorf("list services in the workspace: %w", err)
+	}
+	for _, svc := range o.svcs {
+		if !contains(svc, svcs) {
+			return fmt.Errorf("service %s is not in the workspace", svc)
+		}
+	}
+	return nil
+}
+
+// Ask validates the application and the base environment if they're provided, otherwise it prompts for them.
+func (o *createPreviewEnvOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateOrAskBaseEnv()
+}
+
+// Execute creates the preview environment if it doesn't exist yet, deploys the services to it,
+// and writes the summary of the preview environment as JSON.
+func (o *createPreviewEnvOpts) Execute() error {
+	created, err := o.createEnvIfNotExists()
+	if err != nil {
+		return err
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName())
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.envName(), err)
+	}
+	env.Preview = &config.Preview{
+		PullRequest: o.pullRequest,
+		BaseEnv:     o.baseEnv,
+		ExpiresAt:   o.now().Add(o.ttl).UTC(),
+	}
+	if err := o.store.UpdateEnvironment(env); err != nil {
+		return fmt.Errorf("set the expiry of environment %s: %w", o.envName(), err)
+	}
+	if created {
+		envCmd, err := o.newEnvDeployCmd(o)
+		if err != nil {
+			return err
+		}
+		if err := runPreviewStep(envCmd, "env deploy"); err != nil {
+			return err
+		}
+	}
+	if len(o.svcs) == 0 {
+		if o.svcs, err = o.ws.ListServices(); err != nil {
+			return fmt.Errorf("list services in the workspace: %w", err)
+		}
+	}
+	summary := previewEnvSummary{
+		App:         o.appName,
+		Environment: o.envName(),
+		PullRequest: o.pullRequest,
+		ExpiresAt:   env.Preview.ExpiresAt,
+		Services:    []previewSvcSummary{},
+	}
+	for _, svc := range o.svcs {
+		svcCmd, err := o.newSvcDeployCmd(o, svc)
+		if err != nil {
+			return err
+		}
+		if err := runPreviewStep(svcCmd, "svc deploy"); err != nil {
+			return err
+		}
+		uri, err := o.serviceURI(svc)
+		if err != nil {
+			return err
+		}
+		summary.Services = append(summary.Services, previewSvcSummary{
+			Name: svc,
+			URI:  uri,
+		})
+	}
+	log.Successf("Deployed the preview of pull request #%d to environment %s, which expires at %s.\n",
+		o.pullRequest, color.HighlightUserInput(o.envName()), env.Preview.ExpiresAt.Format(time.RFC3339))
+	out, err := json.Marshal(summary)
+	if err != nil {
+		return fmt.Errorf("marshal preview summary: %w", err)
+	}
+	fmt.Fprintln(o.w, string(out))
+	return nil
+}
+
+// envName returns the name of the preview environment.
+func (o *createPreviewEnvOpts) envName() string {
+	return fmt.Sprintf(fmtPreviewEnvName, o.pullRequest)
+}
+
+// createEnvIfNotExists clones the manifest of the base environment and initializes the preview environment,
+// unless it was created by a previous deployment of the pull request. It returns true if the environment was created.
+func (o *createPreviewEnvOpts) createEnvIfNotExists() (bool, error) {
+	env, err := o.store.GetEnvironment(o.appName, o.envName())
+	var errNoSuchEnv *config.ErrNoSuchEnvironment
+	switch {
+	case err == nil && env.Preview == nil:
+		return false, fmt.Errorf("environment %s already exists and is not a preview environment", o.envName())
+	case err == nil:
+		return false, nil
+	case !errors.As(err, &errNoSuchEnv):
+		return false, fmt.Errorf("get environment %s configuration: %w", o.envName(), err)
+	}
+	base, err := o.store.GetEnvironment(o.appName, o.baseEnv)
+	if err != nil {
+		return false, fmt.Errorf("get environment %s configuration: %w", o.baseEnv, err)
+	}
+	if err := o.writeManifest(); err != nil {
+		return false, err
+	}
+	initCmd, err := o.newEnvInitCmd(o, base.Region)
+	if err != nil {
+		return false, err
+	}
+	if err := runPreviewStep(initCmd, "env init"); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// writeManifest writes the manifest of the preview environment cloned from the base environment,
+// unless a manifest for the preview environment is already in the workspace.
+func (o *createPreviewEnvOpts) writeManifest() error {
+	raw, err := o.ws.ReadEnvironmentManifest(o.baseEnv)
+	if err != nil {
+		return fmt.Errorf("read manifest for environment %s: %w", o.baseEnv, err)
+	}
+	cloned, err := manifest.ClonePreviewEnvironment(raw, o.envName())
+	if err != nil {
+		return fmt.Errorf("clone manifest of environment %s: %w", o.baseEnv, err)
+	}
+	path, err := o.ws.WriteEnvironmentManifest(workspace.EnvironmentManifest(cloned), o.envName())
+	if err != nil {
+		var errFileExists *workspace.ErrFileExists
+		if !errors.As(err, &errFileExists) {
+			return fmt.Errorf("write manifest for environment %s: %w", o.envName(), err)
+		}
+		log.Infof("Manifest file for environment %s already exists at %s, skipping cloning it.\n", o.envName(), errFileExists.FileName)
+		return nil
+	}
+	log.Successf("Cloned the manifest of environment %s to %s.\n", color.HighlightUserInput(o.baseEnv), color.HighlightResource(path))
+	return nil
+}
+
+// serviceURI returns the URI of the service in the preview environment, or an empty string if it can't be reached over the network.
+func (o *createPreviewEnvOpts) serviceURI(svc string) (string, error) {
+	wkld, err := o.store.GetService(o.appName, svc)
+	if err != nil {
+		return "", fmt.Errorf("get service %s configuration: %w", svc, err)
+	}
+	if !contains(wkld.Type, []string{manifest.LoadBalancedWebServiceType, manifest.RequestDrivenWebServiceType, manifest.BackendServiceType}) {
+		return "", nil
+	}
+	describer, err := o.newURIDescriber(o, svc)
+	if err != nil {
+		return "", err
+	}
+	uri, err := describer.URI(o.envName())
+	if err != nil {
+		return "", fmt.Errorf("get uri of service %s in environment %s: %w", svc, o.envName(), err)
+	}
+	return uri.URI, nil
+}
+
+func (o *createPreviewEnvOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application name %q: %v", o.appName, err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(envPreviewAppNamePrompt, envPreviewAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *createPreviewEnvOpts) validateOrAskBaseEnv() error {
+	if o.baseEnv == "" {
+		env, err := o.sel.Environment(envPreviewBasePrompt, envPreviewBaseHelpPrompt, o.appName)
+		if err != nil {
+			return fmt.Errorf("select environment for application %s: %w", o.appName, err)
+		}
+		o.baseEnv = env
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.baseEnv)
+	if err != nil {
+		return fmt.Errorf("validate environment name %q in application %q: %v", o.baseEnv, o.appName, err)
+	}
+	if env.Preview != nil {
+		return fmt.Errorf("cannot clone preview environment %s", o.baseEnv)
+	}
+	return nil
+}
+
+type cleanupPreviewEnvVars struct {
+	appName          string
+	pullRequest      int
+	skipConfirmation bool
+}
+
+type cleanupPreviewEnvOpts struct {
+	cleanupPreviewEnvVars
+
+	store           store
+	deployStore     deployedWorkloadsLister
+	sel             appSelector
+	prompt          prompter
+	newSvcDeleteCmd func(o *cleanupPreviewEnvOpts, env, svc string) (cmd, error)
+	newJobDeleteCmd func(o *cleanupPreviewEnvOpts, env, job string) (cmd, error)
+	newEnvDeleteCmd func(o *cleanupPreviewEnvOpts, env string) (cmd, error)
+	now             func() time.Time
+}
+
+func newCleanupPreviewEnvOpts(vars cleanupPreviewEnvVars) (*cleanupPreviewEnvOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env preview cleanup"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	return &cleanupPreviewEnvOpts{
+		cleanupPreviewEnvVars: vars,
+
+		store:       store,
+		deployStore: deployStore,
+		sel:         selector.NewAppEnvSelector(prompter, store),
+		prompt:      prompter,
+		newSvcDeleteCmd: func(o *cleanupPreviewEnvOpts, env, svc string) (cmd, error) {
+			return newDeleteSvcOpts(deleteSvcVars{
+				appName:          o.appName,
+				name:             svc,
+				envName:          env,
+				skipConfirmation: true,
+			})
+		},
+		newJobDeleteCmd: func(o *cleanupPreviewEnvOpts, env, job string) (cmd, error) {
+			return newDeleteJobOpts(deleteJobVars{
+				appName:          o.appName,
+				name:             job,
+				envName:          env,
+				skipConfirmation: true,
+			})
+		},
+		newEnvDeleteCmd: func(o *cleanupPreviewEnvOpts, env string) (cmd, error) {
+			return newDeleteEnvOpts(deleteEnvVars{
+				appName:          o.appName,
+				name:             env,
+				skipConfirmation: true,
+			})
+		},
+		now: time.Now,
+	}, nil
+}
+
+// Validate returns an error if the pull request number is invalid.
+func (o *cleanupPreviewEnvOpts) Validate() error {
+	if o.pullRequest < 0 {
+		return fmt.Errorf("--%s must be a positive pull request number", pullRequestFlag)
+	}
+	return nil
+}
+
+// Ask validates the application name if it's provided, otherwise it prompts for it.
+func (o *cleanupPreviewEnvOpts) Ask() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application name %q: %v", o.appName, err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(envPreviewAppNamePrompt, envPreviewAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+// Execute deletes the preview environments that expired, or the preview environment of the pull request,
+// along with the services and jobs deployed to them.
+func (o *cleanupPreviewEnvOpts) Execute() error {
+	envs, err := o.previewEnvs()
+	if err != nil {
+		return err
+	}
+	if len(envs) == 0 {
+		log.Infof("No preview environments to clean up in application %s.\n", color.HighlightUserInput(o.appName))
+		return nil
+	}
+	if !o.skipConfirmation {
+		label := english.PluralWord(len(envs), "environment", "")
+		confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtEnvPreviewCleanupPrompt, label, english.WordSeries(envs, "and")), "", prompt.WithConfirmFinalMessage())
+		if err != nil {
+			return fmt.Errorf("confirm to delete preview environments: %w", err)
+		}
+		if !confirmed {
+			return errEnvPreviewCleanupCancelled
+		}
+	}
+	for _, env := range envs {
+		if err := o.deletePreviewEnv(env); err != nil {
+			return err
+		}
+		log.Successf("Deleted preview environment %s.\n", color.HighlightUserInput(env))
+	}
+	return nil
+}
+
+// previewEnvs returns the names of the preview environments to delete.
+func (o *cleanupPreviewEnvOpts) previewEnvs() ([]string, error) {
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	now := o.now()
+	var names []string
+	for _, env := range envs {
+		if env.Preview == nil {
+			continue
+		}
+		if o.pullRequest != 0 {
+			if env.Preview.PullRequest == o.pullRequest {
+				names = append(names, env.Name)
+			}
+			continue
+		}
+		if env.Preview.IsExpired(now) {
+			names = append(names, env.Name)
+		}
+	}
+	return names, nil
+}
+
+func (o *cleanupPreviewEnvOpts) deletePreviewEnv(env string) error {
+	svcs, err := o.deployStore.ListDeployedServices(o.appName, env)
+	if err != nil {
+		return fmt.Errorf("list services deployed to environment %s: %w", env, err)
+	}
+	for _, svc := range svcs {
+		deleteCmd, err := o.newSvcDeleteCmd(o, env, svc)
+		if err != nil {
+			return err
+		}
+		if err := runPreviewStep(deleteCmd, "svc delete"); err != nil {
+			return err
+		}
+	}
+	jobs, err := o.deployStore.ListDeployedJobs(o.appName, env)
+	if err != nil {
+		return fmt.Errorf("list jobs deployed to environment %s: %w", env, err)
+	}
+	for _, job := range jobs {
+		deleteCmd, err := o.newJobDeleteCmd(o, env, job)
+		if err != nil {
+			return err
+		}
+		if err := runPreviewStep(deleteCmd, "job delete"); err != nil {
+			return err
+		}
+	}
+	deleteCmd, err := o.newEnvDeleteCmd(o, env)
+	if err != nil {
+		return err
+	}
+	return runPreviewStep(deleteCmd, "env delete")
+}
+
+// runPreviewStep validates, asks and executes a command that is part of creating or cleaning up a preview environment.
+func runPreviewStep(c cmd, name string) error {
+	if err := c.Validate(); err != nil {
+		return fmt.Errorf("validate %s: %w", name, err)
+	}
+	if err := c.Ask(); err != nil {
+		return fmt.Errorf("ask %s: %w", name, err)
+	}
+	if err := c.Execute(); err != nil {
+		return fmt.Errorf("execute %s: %w", name, err)
+	}
+	return nil
+}
+
+// buildEnvPreviewCmd builds the command for managing the preview environments of pull requests.
+func buildEnvPreviewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preview",
+		Short: "Commands for ephemeral preview environments of pull requests.",
+		Long: `Commands for ephemeral preview environments of pull requests.
+Preview environments are cloned from a base environment and expire after a time to live.`,
+	}
+	cmd.AddCommand(buildEnvPreviewCreateCmd())
+	cmd.AddCommand(buildEnvPreviewCleanupCmd())
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
+
+// buildEnvPreviewCreateCmd builds the command for deploying the preview environment of a pull request.
+func buildEnvPreviewCreateCmd() *cobra.Command {
+	vars := createPreviewEnvVars{}
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Deploys services to the preview environment of a pull request.",
+		Long: `Deploys services to the preview environment of a pull request.
+The first time, the preview environment is created by cloning a base environment with reduced sizing.
+Writes a JSON summary of the environment and the URIs of its services to stdout.`,
+		Example: `
+  Preview pull request #123 in an environment cloned from "test".
+  /code $ copilot env preview create --pr 123 --base test
+
+  Only deploy the "frontend" and "api" services, and keep the environment for a week.
+  /code $ copilot env preview create --pr 123 --base test --services frontend,api --ttl 168h`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newCreatePreviewEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().IntVar(&vars.pullRequest, pullRequestFlag, 0, envPreviewPRFlagDescription)
+	cmd.Flags().StringVar(&vars.baseEnv, baseEnvFlag, "", envPreviewBaseFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.svcs, servicesFlag, nil, envPreviewSvcsFlagDescription)
+	cmd.Flags().StringVar(&vars.profile, profileFlag, "", envPreviewProfileFlagDescription)
+	cmd.Flags().DurationVar(&vars.ttl, ttlFlag, defaultPreviewTTL, envPreviewTTLFlagDescription)
+	_ = cmd.MarkFlagRequired(pullRequestFlag)
+	return cmd
+}
+
+// buildEnvPreviewCleanupCmd builds the command for deleting the preview environments that expired.
+func buildEnvPreviewCleanupCmd() *cobra.Command {
+	vars := cleanupPreviewEnvVars{}
+	cmd := &cobra.Command{
+		Use:   "cleanup",
+		Short: "Deletes the preview environments that expired.",
+		Long: `Deletes the preview environments that expired, along with the services and jobs deployed to them.
+Run it on a schedule, for example from a cron job in your CI system.`,
+		Example: `
+  Delete the expired preview environments without confirmation.
+  /code $ copilot env preview cleanup --yes
+
+  Delete the preview environment of pull request #123 once it is merged.
+  /code $ copilot env preview cleanup --pr 123 --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newCleanupPreviewEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().IntVar(&vars.pullRequest, pullRequestFlag, 0, envPreviewCleanupPRFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,446 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatePreviewEnvOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inPR   int
+		inTTL  time.Duration
+		inSvcs []string
+		mockWs func(m *mocks.MockwsPreviewEnvCloner)
+
+		wantedError error
+	}{
+		"error if the pull request number is not positive": {
+			inPR:        0,
+			inTTL:       time.Hour,
+			wantedError: errors.New("--pr must be a positive pull request number"),
+		},
+		"error if the time to live is not positive": {
+			inPR:        123,
+			inTTL:       -time.Hour,
+			wantedError: errors.New("--ttl must be a positive duration"),
+		},
+		"error if a service is not in the workspace": {
+			inPR:   123,
+			inTTL:  time.Hour,
+			inSvcs: []string{"frontend", "api"},
+			mockWs: func(m *mocks.MockwsPreviewEnvCloner) {
+				m.EXPECT().ListServices().Return([]string{"frontend"}, nil)
+			},
+			wantedError: errors.New("service api is not in the workspace"),
+		},
+		"valid without services": {
+			inPR:  123,
+			inTTL: time.Hour,
+		},
+		"valid with services in the workspace": {
+			inPR:   123,
+			inTTL:  time.Hour,
+			inSvcs: []string{"frontend"},
+			mockWs: func(m *mocks.MockwsPreviewEnvCloner) {
+				m.EXPECT().ListServices().Return([]string{"frontend", "api"}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsPreviewEnvCloner(ctrl)
+			if tc.mockWs != nil {
+				tc.mockWs(ws)
+			}
+			opts := createPreviewEnvOpts{
+				createPreviewEnvVars: createPreviewEnvVars{
+					pullRequest: tc.inPR,
+					ttl:         tc.inTTL,
+					svcs:        tc.inSvcs,
+				},
+				ws: ws,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCreatePreviewEnvOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inBaseEnv  string
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockappEnvSelector)
+
+		wantedBaseEnv string
+		wantedError   error
+	}{
+		"prompt for the base environment": {
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockappEnvSelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				sel.EXPECT().Environment(envPreviewBasePrompt, envPreviewBaseHelpPrompt, "phonetool").Return("test", nil)
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+			},
+			wantedBaseEnv: "test",
+		},
+		"error if the base environment is a preview environment": {
+			inBaseEnv: "pr-100",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockappEnvSelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "pr-100").Return(&config.Environment{
+					Name:    "pr-100",
+					Preview: &config.Preview{PullRequest: 100},
+				}, nil)
+			},
+			wantedError: errors.New("cannot clone preview environment pr-100"),
+		},
+		"error if the base environment does not exist": {
+			inBaseEnv: "test",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockappEnvSelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New(`validate environment name "test" in application "phonetool": some error`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			sel := mocks.NewMockappEnvSelector(ctrl)
+			tc.setupMocks(store, sel)
+			opts := createPreviewEnvOpts{
+				createPreviewEnvVars: createPreviewEnvVars{
+					appName: "phonetool",
+					baseEnv: tc.inBaseEnv,
+				},
+				store: store,
+				sel:   sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedBaseEnv, opts.baseEnv)
+		})
+	}
+}
+
+type createPreviewEnvMocks struct {
+	store     *mocks.Mockstore
+	ws        *mocks.MockwsPreviewEnvCloner
+	initCmd   *mocks.Mockcmd
+	deployCmd *mocks.Mockcmd
+	svcCmd    *mocks.Mockcmd
+	describer *mocks.MockserviceURIDescriber
+}
+
+func TestCreatePreviewEnvOpts_Execute(t *testing.T) {
+	now := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	expiresAt := now.Add(72 * time.Hour)
+	baseMft := `name: test
+type: Environment
+cdn: true
+`
+	clonedMft := `name: pr-123
+type: Environment
+observability:
+  container_insights: false
+`
+	expectRun := func(m *mocks.Mockcmd) {
+		m.EXPECT().Validate().Return(nil)
+		m.EXPECT().Ask().Return(nil)
+		m.EXPECT().Execute().Return(nil)
+	}
+	testCases := map[string]struct {
+		inSvcs     []string
+		setupMocks func(m createPreviewEnvMocks)
+
+		wantedSummary string
+		wantedError   error
+	}{
+		"create the preview environment and deploy every service": {
+			setupMocks: func(m createPreviewEnvMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().GetEnvironment("phonetool", "pr-123").Return(nil, &config.ErrNoSuchEnvironment{ApplicationName: "phonetool", EnvironmentName: "pr-123"}),
+					m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test", Region: "us-west-2"}, nil),
+					m.ws.EXPECT().ReadEnvironmentManifest("test").Return(workspace.EnvironmentManifest(baseMft), nil),
+					m.ws.EXPECT().WriteEnvironmentManifest(workspace.EnvironmentManifest(clonedMft), "pr-123").Return("copilot/environments/pr-123/manifest.yml", nil),
+				)
+				expectRun(m.initCmd)
+				m.store.EXPECT().GetEnvironment("phonetool", "pr-123").Return(&config.Environment{App: "phonetool", Name: "pr-123"}, nil)
+				m.store.EXPECT().UpdateEnvironment(&config.Environment{
+					App:  "phonetool",
+					Name: "pr-123",
+					Preview: &config.Preview{
+						PullRequest: 123,
+						BaseEnv:     "test",
+						ExpiresAt:   expiresAt,
+					},
+				}).Return(nil)
+				expectRun(m.deployCmd)
+				m.ws.EXPECT().ListServices().Return([]string{"frontend", "worker"}, nil)
+				m.svcCmd.EXPECT().Validate().Return(nil).Times(2)
+				m.svcCmd.EXPECT().Ask().Return(nil).Times(2)
+				m.svcCmd.EXPECT().Execute().Return(nil).Times(2)
+				m.store.EXPECT().GetService("phonetool", "frontend").Return(&config.Workload{Type: manifest.LoadBalancedWebServiceType}, nil)
+				m.describer.EXPECT().URI("pr-123").Return(describe.URI{URI: "http://my-lb.us-west-2.elb.amazonaws.com"}, nil)
+				m.store.EXPECT().GetService("phonetool", "worker").Return(&config.Workload{Type: manifest.WorkerServiceType}, nil)
+			},
+			wantedSummary: `{"application":"phonetool","environment":"pr-123","pullRequest":123,"expiresAt":"2022-06-04T12:00:00Z","services":[{"name":"frontend","uri":"http://my-lb.us-west-2.elb.amazonaws.com"},{"name":"worker"}]}` + "\n",
+		},
+		"redeploy the services to an existing preview environment and extend its expiry": {
+			inSvcs: []string{"frontend"},
+			setupMocks: func(m createPreviewEnvMocks) {
+				env := &config.Environment{
+					App:     "phonetool",
+					Name:    "pr-123",
+					Preview: &config.Preview{PullRequest: 123, BaseEnv: "test", ExpiresAt: now},
+				}
+				m.store.EXPECT().GetEnvironment("phonetool", "pr-123").Return(env, nil).Times(2)
+				m.store.EXPECT().UpdateEnvironment(gomock.Any()).DoAndReturn(func(env *config.Environment) error {
+					require.Equal(t, expiresAt, env.Preview.ExpiresAt)
+					return nil
+				})
+				m.initCmd.EXPECT().Execute().Times(0)
+				m.deployCmd.EXPECT().Execute().Times(0)
+				expectRun(m.svcCmd)
+				m.store.EXPECT().GetService("phonetool", "frontend").Return(&config.Workload{Type: manifest.BackendServiceType}, nil)
+				m.describer.EXPECT().URI("pr-123").Return(describe.URI{URI: "frontend.pr-123.phonetool.local:8080"}, nil)
+			},
+			wantedSummary: `{"application":"phonetool","environment":"pr-123","pullRequest":123,"expiresAt":"2022-06-04T12:00:00Z","services":[{"name":"frontend","uri":"frontend.pr-123.phonetool.local:8080"}]}` + "\n",
+		},
+		"error if an environment that is not a preview has the same name": {
+			setupMocks: func(m createPreviewEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "pr-123").Return(&config.Environment{Name: "pr-123"}, nil)
+			},
+			wantedError: errors.New("environment pr-123 already exists and is not a preview environment"),
+		},
+		"error if fails to create the preview environment": {
+			setupMocks: func(m createPreviewEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "pr-123").Return(nil, &config.ErrNoSuchEnvironment{ApplicationName: "phonetool", EnvironmentName: "pr-123"})
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test", Region: "us-west-2"}, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return(workspace.EnvironmentManifest(baseMft), nil)
+				m.ws.EXPECT().WriteEnvironmentManifest(gomock.Any(), "pr-123").Return("", &workspace.ErrFileExists{FileName: "copilot/environments/pr-123/manifest.yml"})
+				m.initCmd.EXPECT().Validate().Return(nil)
+				m.initCmd.EXPECT().Ask().Return(errors.New("some error"))
+			},
+			wantedError: errors.New("ask env init: some error"),
+		},
+		"error if fails to deploy a service": {
+			inSvcs: []string{"frontend"},
+			setupMocks: func(m createPreviewEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "pr-123").Return(&config.Environment{
+					Name:    "pr-123",
+					Preview: &config.Preview{PullRequest: 123},
+				}, nil).Times(2)
+				m.store.EXPECT().UpdateEnvironment(gomock.Any()).Return(nil)
+				m.svcCmd.EXPECT().Validate().Return(nil)
+				m.svcCmd.EXPECT().Ask().Return(nil)
+				m.svcCmd.EXPECT().Execute().Return(errors.New("some error"))
+			},
+			wantedError: errors.New("execute svc deploy: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := createPreviewEnvMocks{
+				store:     mocks.NewMockstore(ctrl),
+				ws:        mocks.NewMockwsPreviewEnvCloner(ctrl),
+				initCmd:   mocks.NewMockcmd(ctrl),
+				deployCmd: mocks.NewMockcmd(ctrl),
+				svcCmd:    mocks.NewMockcmd(ctrl),
+				describer: mocks.NewMockserviceURIDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+			out := new(strings.Builder)
+			opts := createPreviewEnvOpts{
+				createPreviewEnvVars: createPreviewEnvVars{
+					appName:     "phonetool",
+					pullRequest: 123,
+					baseEnv:     "test",
+					svcs:        tc.inSvcs,
+					ttl:         72 * time.Hour,
+				},
+				store: m.store,
+				ws:    m.ws,
+				newEnvInitCmd: func(o *createPreviewEnvOpts, region string) (cmd, error) {
+					require.Equal(t, "us-west-2", region)
+					return m.initCmd, nil
+				},
+				newEnvDeployCmd: func(o *createPreviewEnvOpts) (cmd, error) {
+					return m.deployCmd, nil
+				},
+				newSvcDeployCmd: func(o *createPreviewEnvOpts, svc string) (cmd, error) {
+					return m.svcCmd, nil
+				},
+				newURIDescriber: func(o *createPreviewEnvOpts, svc string) (serviceURIDescriber, error) {
+					return m.describer, nil
+				},
+				w: out,
+				now: func() time.Time {
+					return now
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSummary, out.String())
+		})
+	}
+}
+
+type cleanupPreviewEnvMocks struct {
+	store       *mocks.Mockstore
+	deployStore *mocks.MockdeployedWorkloadsLister
+	prompt      *mocks.Mockprompter
+	svcCmd      *mocks.Mockcmd
+	jobCmd      *mocks.Mockcmd
+	envCmd      *mocks.Mockcmd
+}
+
+func TestCleanupPreviewEnvOpts_Execute(t *testing.T) {
+	now := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	envs := []*config.Environment{
+		{Name: "test"},
+		{Name: "pr-100", Preview: &config.Preview{PullRequest: 100, ExpiresAt: now.Add(-time.Hour)}},
+		{Name: "pr-123", Preview: &config.Preview{PullRequest: 123, ExpiresAt: now.Add(time.Hour)}},
+	}
+	expectRun := func(m *mocks.Mockcmd) {
+		m.EXPECT().Validate().Return(nil)
+		m.EXPECT().Ask().Return(nil)
+		m.EXPECT().Execute().Return(nil)
+	}
+	testCases := map[string]struct {
+		inPR               int
+		inSkipConfirmation bool
+		setupMocks         func(m cleanupPreviewEnvMocks)
+
+		wantedError error
+	}{
+		"delete the expired preview environments and their workloads": {
+			inSkipConfirmation: true,
+			setupMocks: func(m cleanupPreviewEnvMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(envs, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "pr-100").Return([]string{"frontend"}, nil)
+				expectRun(m.svcCmd)
+				m.deployStore.EXPECT().ListDeployedJobs("phonetool", "pr-100").Return([]string{"mailer"}, nil)
+				expectRun(m.jobCmd)
+				expectRun(m.envCmd)
+			},
+		},
+		"delete the preview environment of the pull request even if it has not expired": {
+			inPR:               123,
+			inSkipConfirmation: true,
+			setupMocks: func(m cleanupPreviewEnvMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(envs, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "pr-123").Return(nil, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("phonetool", "pr-123").Return(nil, nil)
+				expectRun(m.envCmd)
+			},
+		},
+		"do nothing if no preview environment expired": {
+			inPR: 200,
+			setupMocks: func(m cleanupPreviewEnvMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(envs, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"error if the cleanup is cancelled": {
+			setupMocks: func(m cleanupPreviewEnvMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(envs, nil)
+				m.prompt.EXPECT().Confirm("Are you sure you want to delete the preview environment pr-100, including the services and jobs deployed there?", "", gomock.Any()).Return(false, nil)
+			},
+			wantedError: errEnvPreviewCleanupCancelled,
+		},
+		"error if fails to delete a service": {
+			inSkipConfirmation: true,
+			setupMocks: func(m cleanupPreviewEnvMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(envs, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "pr-100").Return([]string{"frontend"}, nil)
+				m.svcCmd.EXPECT().Validate().Return(nil)
+				m.svcCmd.EXPECT().Ask().Return(nil)
+				m.svcCmd.EXPECT().Execute().Return(errors.New("some error"))
+				m.envCmd.EXPECT().Execute().Times(0)
+			},
+			wantedError: errors.New("execute svc delete: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := cleanupPreviewEnvMocks{
+				store:       mocks.NewMockstore(ctrl),
+				deployStore: mocks.NewMockdeployedWorkloadsLister(ctrl),
+				prompt:      mocks.NewMockprompter(ctrl),
+				svcCmd:      mocks.NewMockcmd(ctrl),
+				jobCmd:      mocks.NewMockcmd(ctrl),
+				envCmd:      mocks.NewMockcmd(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := cleanupPreviewEnvOpts{
+				cleanupPreviewEnvVars: cleanupPreviewEnvVars{
+					appName:          "phonetool",
+					pullRequest:      tc.inPR,
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+				store:       m.store,
+				deployStore: m.deployStore,
+				prompt:      m.prompt,
+				newSvcDeleteCmd: func(o *cleanupPreviewEnvOpts, env, svc string) (cmd, error) {
+					return m.svcCmd, nil
+				},
+				newJobDeleteCmd: func(o *cleanupPreviewEnvOpts, env, job string) (cmd, error) {
+					return m.jobCmd, nil
+				},
+				newEnvDeleteCmd: func(o *cleanupPreviewEnvOpts, env string) (cmd, error) {
+					return m.envCmd, nil
+				},
+				now: func() time.Time {
+					return now
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
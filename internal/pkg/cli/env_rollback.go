@@ -0,0 +1,218 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envRollbackAppNamePrompt     = "Which application is the environment in?"
+	envRollbackAppNameHelpPrompt = "An application is a collection of related services."
+	envRollbackNamePrompt        = "Which environment of %s would you like to roll back?"
+	fmtEnvRollbackPrompt         = "Are you sure you want to roll back environment %s to its configuration before the latest deployment?"
+)
+
+var (
+	errEnvRollbackCancelled = errors.New("env rollback cancelled - no changes made")
+)
+
+type rollbackEnvVars struct {
+	appName          string
+	name             string
+	roleARN          string
+	skipConfirmation bool
+}
+
+type rollbackEnvOpts struct {
+	rollbackEnvVars
+
+	store            store
+	sel              configSelector
+	prompt           prompter
+	ws               wsEnvironmentReader
+	newInterpolator  func(app, env string) interpolator
+	newEnvRollbacker func(env *config.Environment) (envRollbacker, error)
+}
+
+func newRollbackEnvOpts(vars rollbackEnvVars) (*rollbackEnvOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env rollback"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	prompter := prompt.New()
+	opts := &rollbackEnvOpts{
+		rollbackEnvVars: vars,
+
+		store:           store,
+		sel:             selector.NewConfigSelector(prompter, store),
+		prompt:          prompter,
+		ws:              ws,
+		newInterpolator: newManifestInterpolator,
+	}
+	opts.newEnvRollbacker = func(env *config.Environment) (envRollbacker, error) {
+		app, err := store.GetApplication(opts.appName)
+		if err != nil {
+			return nil, fmt.Errorf("get application %s configuration: %w", opts.appName, err)
+		}
+		return deploy.NewEnvDeployer(&deploy.NewEnvDeployerInput{
+			App:             app,
+			Env:             env,
+			SessionProvider: sessProvider,
+		})
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the value of the --role-arn flag is not the ARN of an IAM role.
+func (o *rollbackEnvOpts) Validate() error {
+	if o.roleARN == "" {
+		return nil
+	}
+	return validateRoleARNFlag(o.roleARN)
+}
+
+// Ask validates the application and environment names if they're provided, otherwise it prompts for them.
+// Then, it asks for confirmation before rolling back the environment unless --yes is set.
+func (o *rollbackEnvOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	if err := o.validateOrAskEnv(); err != nil {
+		return err
+	}
+	if o.skipConfirmation {
+		return nil
+	}
+	confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtEnvRollbackPrompt, o.name), "", prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("confirm to roll back environment %s: %w", o.name, err)
+	}
+	if !confirmed {
+		return errEnvRollbackCancelled
+	}
+	return nil
+}
+
+// Execute redeploys the environment stack with the template and parameters it had before the latest deployment.
+func (o *rollbackEnvOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.name, err)
+	}
+	mft, err := o.readManifest()
+	if err != nil {
+		return err
+	}
+	rollbacker, err := o.newEnvRollbacker(env)
+	if err != nil {
+		return err
+	}
+	if err := rollbacker.Rollback(&deploy.RollbackEnvironmentInput{
+		ExecutionRoleARN: o.roleARN,
+		Manifest:         mft,
+	}); err != nil {
+		return fmt.Errorf("roll back environment %s: %w", o.name, err)
+	}
+	log.Successf("Rolled back environment %s to its configuration before the latest deployment.\n", color.HighlightUserInput(o.name))
+	return nil
+}
+
+// readManifest returns the manifest of the environment in the workspace, so that the environment is rolled back
+// with the execution role it configures. It returns nil if the manifest is not in the workspace.
+func (o *rollbackEnvOpts) readManifest() (*manifest.Environment, error) {
+	rawMft, err := o.ws.ReadEnvironmentManifest(o.name)
+	if err != nil {
+		var (
+			errNotFound          *workspace.ErrFileNotExists
+			errWorkspaceNotFound *workspace.ErrWorkspaceNotFound
+		)
+		if errors.As(err, &errNotFound) || errors.As(err, &errWorkspaceNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read manifest for environment %q: %w", o.name, err)
+	}
+	return environmentManifest(o.name, rawMft, o.newInterpolator(o.appName, o.name))
+}
+
+func (o *rollbackEnvOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application name %q: %v", o.appName, err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(envRollbackAppNamePrompt, envRollbackAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *rollbackEnvOpts) validateOrAskEnv() error {
+	if o.name != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.name); err != nil {
+			return fmt.Errorf("validate environment name %q in application %q: %v", o.name, o.appName, err)
+		}
+		return nil
+	}
+	env, err := o.sel.Environment(fmt.Sprintf(envRollbackNamePrompt, color.HighlightUserInput(o.appName)), "", o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment for application %s: %w", o.appName, err)
+	}
+	o.name = env
+	return nil
+}
+
+// buildEnvRollbackCmd builds the command for rolling back an environment to its previous deployment.
+func buildEnvRollbackCmd() *cobra.Command {
+	vars := rollbackEnvVars{}
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Rolls back an environment to its configuration before the latest deployment.",
+		Long: `Rolls back an environment to its configuration before the latest deployment.
+Copilot keeps the template and parameters of the environment stack before its latest successful deployment
+that changed the stack, and redeploys them with this command.`,
+		Example: `
+  Roll back the "test" environment after a faulty manifest change.
+  /code $ copilot env rollback --name test
+
+  Roll back the "test" environment without prompting.
+  /code $ copilot env rollback --name test --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRollbackEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVar(&vars.roleARN, roleARNFlag, "", envRollbackRoleARNFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,290 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type rollbackEnvMocks struct {
+	store        *mocks.Mockstore
+	sel          *mocks.MockconfigSelector
+	prompt       *mocks.Mockprompter
+	ws           *mocks.MockwsEnvironmentReader
+	interpolator *mocks.Mockinterpolator
+	rollbacker   *mocks.MockenvRollbacker
+}
+
+func TestRollbackEnvOpts_Ask(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testEnv = "test"
+	)
+	testCases := map[string]struct {
+		inAppName          string
+		inEnvName          string
+		inSkipConfirmation bool
+
+		setupMocks func(m *rollbackEnvMocks)
+
+		wantedAppName string
+		wantedEnvName string
+		wantedError   error
+	}{
+		"error if the application does not exist": {
+			inAppName: testApp,
+			setupMocks: func(m *rollbackEnvMocks) {
+				m.store.EXPECT().GetApplication(testApp).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New(`validate application name "phonetool": some error`),
+		},
+		"error if the environment does not exist": {
+			inAppName: testApp,
+			inEnvName: testEnv,
+			setupMocks: func(m *rollbackEnvMocks) {
+				m.store.EXPECT().GetApplication(testApp).Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment(testApp, testEnv).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New(`validate environment name "test" in application "phonetool": some error`),
+		},
+		"error if fails to select an application": {
+			setupMocks: func(m *rollbackEnvMocks) {
+				m.sel.EXPECT().Application(envRollbackAppNamePrompt, envRollbackAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select application: some error"),
+		},
+		"error if fails to select an environment": {
+			inAppName: testApp,
+			setupMocks: func(m *rollbackEnvMocks) {
+				m.store.EXPECT().GetApplication(testApp).Return(&config.Application{}, nil)
+				m.sel.EXPECT().Environment(gomock.Any(), "", testApp).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select environment for application phonetool: some error"),
+		},
+		"error if the rollback is cancelled": {
+			inAppName: testApp,
+			inEnvName: testEnv,
+			setupMocks: func(m *rollbackEnvMocks) {
+				m.store.EXPECT().GetApplication(testApp).Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment(testApp, testEnv).Return(&config.Environment{}, nil)
+				m.prompt.EXPECT().Confirm(fmt.Sprintf(fmtEnvRollbackPrompt, testEnv), "", gomock.Any()).Return(false, nil)
+			},
+			wantedError: errEnvRollbackCancelled,
+		},
+		"error if fails to confirm": {
+			inAppName: testApp,
+			inEnvName: testEnv,
+			setupMocks: func(m *rollbackEnvMocks) {
+				m.store.EXPECT().GetApplication(testApp).Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment(testApp, testEnv).Return(&config.Environment{}, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), "", gomock.Any()).Return(false, errors.New("some error"))
+			},
+			wantedError: errors.New("confirm to roll back environment test: some error"),
+		},
+		"prompt for the application and environment then confirm": {
+			setupMocks: func(m *rollbackEnvMocks) {
+				m.sel.EXPECT().Application(envRollbackAppNamePrompt, envRollbackAppNameHelpPrompt).Return(testApp, nil)
+				m.sel.EXPECT().Environment(gomock.Any(), "", testApp).Return(testEnv, nil)
+				m.prompt.EXPECT().Confirm(fmt.Sprintf(fmtEnvRollbackPrompt, testEnv), "", gomock.Any()).Return(true, nil)
+			},
+			wantedAppName: testApp,
+			wantedEnvName: testEnv,
+		},
+		"skip confirmation with --yes": {
+			inAppName:          testApp,
+			inEnvName:          testEnv,
+			inSkipConfirmation: true,
+			setupMocks: func(m *rollbackEnvMocks) {
+				m.store.EXPECT().GetApplication(testApp).Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment(testApp, testEnv).Return(&config.Environment{}, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedAppName: testApp,
+			wantedEnvName: testEnv,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &rollbackEnvMocks{
+				store:  mocks.NewMockstore(ctrl),
+				sel:    mocks.NewMockconfigSelector(ctrl),
+				prompt: mocks.NewMockprompter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &rollbackEnvOpts{
+				rollbackEnvVars: rollbackEnvVars{
+					appName:          tc.inAppName,
+					name:             tc.inEnvName,
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+				store:  m.store,
+				sel:    m.sel,
+				prompt: m.prompt,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAppName, opts.appName)
+			require.Equal(t, tc.wantedEnvName, opts.name)
+		})
+	}
+}
+
+func TestRollbackEnvOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inRoleARN   string
+		wantedError error
+	}{
+		"no role": {},
+		"valid role": {
+			inRoleARN: "arn:aws:iam::123456789012:role/rollback-role",
+		},
+		"error if the role ARN is not an IAM role": {
+			inRoleARN:   "arn:aws:s3:::bucket",
+			wantedError: errors.New(`--role-arn "arn:aws:s3:::bucket" is not a valid IAM role ARN`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &rollbackEnvOpts{
+				rollbackEnvVars: rollbackEnvVars{
+					roleARN: tc.inRoleARN,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRollbackEnvOpts_Execute(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testEnv = "test"
+	)
+	const mftWithRole = `name: test
+type: Environment
+deployment:
+  execution_role: arn:aws:iam::123456789012:role/manifest-role
+`
+	testCases := map[string]struct {
+		inRoleARN  string
+		setupMocks func(m *rollbackEnvMocks)
+
+		wantedError error
+	}{
+		"error if fails to get the environment": {
+			setupMocks: func(m *rollbackEnvMocks) {
+				m.store.EXPECT().GetEnvironment(testApp, testEnv).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get environment test configuration: some error"),
+		},
+		"error if fails to read the manifest": {
+			setupMocks: func(m *rollbackEnvMocks) {
+				m.store.EXPECT().GetEnvironment(testApp, testEnv).Return(&config.Environment{Name: testEnv}, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest(testEnv).Return(nil, errors.New("some error"))
+				m.rollbacker.EXPECT().Rollback(gomock.Any()).Times(0)
+			},
+			wantedError: errors.New(`read manifest for environment "test": some error`),
+		},
+		"error if fails to roll back": {
+			setupMocks: func(m *rollbackEnvMocks) {
+				m.store.EXPECT().GetEnvironment(testApp, testEnv).Return(&config.Environment{Name: testEnv}, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest(testEnv).Return(nil, &workspace.ErrWorkspaceNotFound{})
+				m.rollbacker.EXPECT().Rollback(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("roll back environment test: some error"),
+		},
+		"roll back without a manifest if it's not in the workspace": {
+			inRoleARN: "arn:aws:iam::123456789012:role/flag-role",
+			setupMocks: func(m *rollbackEnvMocks) {
+				m.store.EXPECT().GetEnvironment(testApp, testEnv).Return(&config.Environment{Name: testEnv}, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest(testEnv).Return(nil, &workspace.ErrFileNotExists{})
+				m.rollbacker.EXPECT().Rollback(&deploy.RollbackEnvironmentInput{
+					ExecutionRoleARN: "arn:aws:iam::123456789012:role/flag-role",
+				}).Return(nil)
+			},
+		},
+		"roll back with the manifest in the workspace": {
+			setupMocks: func(m *rollbackEnvMocks) {
+				m.store.EXPECT().GetEnvironment(testApp, testEnv).Return(&config.Environment{Name: testEnv}, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest(testEnv).Return(workspace.EnvironmentManifest(mftWithRole), nil)
+				m.interpolator.EXPECT().Interpolate(mftWithRole).Return(mftWithRole, nil)
+				m.rollbacker.EXPECT().Rollback(gomock.Any()).DoAndReturn(func(in *deploy.RollbackEnvironmentInput) error {
+					require.Empty(t, in.ExecutionRoleARN)
+					require.Equal(t, "arn:aws:iam::123456789012:role/manifest-role", aws.StringValue(in.Manifest.Deployment.ExecutionRole))
+					return nil
+				})
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &rollbackEnvMocks{
+				store:        mocks.NewMockstore(ctrl),
+				ws:           mocks.NewMockwsEnvironmentReader(ctrl),
+				interpolator: mocks.NewMockinterpolator(ctrl),
+				rollbacker:   mocks.NewMockenvRollbacker(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &rollbackEnvOpts{
+				rollbackEnvVars: rollbackEnvVars{
+					appName: testApp,
+					name:    testEnv,
+					roleARN: tc.inRoleARN,
+				},
+				store: m.store,
+				ws:    m.ws,
+				newInterpolator: func(_, _ string) interpolator {
+					return m.interpolator
+				},
+				newEnvRollbacker: func(env *config.Environment) (envRollbacker, error) {
+					require.Equal(t, testEnv, env.Name)
+					return m.rollbacker, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
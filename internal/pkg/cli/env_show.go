@@ -30,11 +30,14 @@ const (
 )
 
 type showEnvVars struct {
-	appName               string
-	name                  string
-	shouldOutputJSON      bool
-	shouldOutputResources bool
-	shouldOutputManifest  bool
+	appName                string
+	name                   string
+	shouldOutputJSON       bool
+	shouldOutputResources  bool
+	shouldOutputManifest   bool
+	shouldOutputTelemetry  bool
+	shouldOutputNetworking bool
+	shouldOutputParams     bool
 }
 
 type showEnvOpts struct {
@@ -45,6 +48,7 @@ type showEnvOpts struct {
 	describer        envDescriber
 	sel              configSelector
 	initEnvDescriber func() error
+	localParams      func() (map[string]string, error) // Generates the environment stack parameters from the workspace.
 }
 
 func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
@@ -68,11 +72,13 @@ func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
 	}
 	opts.initEnvDescriber = func() error {
 		d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
-			App:             opts.appName,
-			Env:             opts.name,
-			ConfigStore:     store,
-			DeployStore:     deployStore,
-			EnableResources: opts.shouldOutputResources,
+			App:                 opts.appName,
+			Env:                 opts.name,
+			ConfigStore:         store,
+			DeployStore:         deployStore,
+			EnableResources:     opts.shouldOutputResources,
+			EnableObservability: opts.shouldOutputTelemetry,
+			EnableNetworking:    opts.shouldOutputNetworking,
 		})
 		if err != nil {
 			return fmt.Errorf("creating describer for environment %s in application %s: %w", opts.name, opts.appName, err)
@@ -80,6 +86,22 @@ func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
 		opts.describer = d
 		return nil
 	}
+	opts.localParams = func() (map[string]string, error) {
+		pkgOpts, err := newPackageEnvOpts(packageEnvVars{
+			envName: opts.name,
+			appName: opts.appName,
+		})
+		if err != nil {
+			return nil, err
+		}
+		params := &bufferFile{}
+		pkgOpts.tplWriter = discardFile{}
+		pkgOpts.paramsWriter = params
+		if err := pkgOpts.Execute(); err != nil {
+			return nil, err
+		}
+		return parseSerializedParams(params.String())
+	}
 	return opts, nil
 }
 
@@ -104,6 +126,9 @@ func (o *showEnvOpts) Execute() error {
 	if o.shouldOutputManifest {
 		return o.writeManifest()
 	}
+	if o.shouldOutputParams {
+		return o.writeParams()
+	}
 
 	env, err := o.describer.Describe()
 	if err != nil {
@@ -168,6 +193,32 @@ func (o *showEnvOpts) writeManifest() error {
 	return nil
 }
 
+func (o *showEnvOpts) writeParams() error {
+	deployed, err := o.describer.Params()
+	if err != nil {
+		return fmt.Errorf("get parameters of environment %s: %w", o.name, err)
+	}
+	params := &describe.StackParams{
+		Deployed: deployed,
+	}
+	local, err := o.localParams()
+	if err != nil {
+		log.Warningf("Could not generate the parameters of environment %s from the workspace: %v\n", o.name, err)
+	} else {
+		params.Local = local
+	}
+	content := params.HumanString()
+	if o.shouldOutputJSON {
+		data, err := params.JSONString()
+		if err != nil {
+			return err
+		}
+		content = data
+	}
+	fmt.Fprint(o.w, content)
+	return nil
+}
+
 // buildEnvShowCmd builds the command for showing environments in an application.
 func buildEnvShowCmd() *cobra.Command {
 	vars := showEnvVars{}
@@ -179,8 +230,14 @@ func buildEnvShowCmd() *cobra.Command {
 		Example: `
   Print configuration for the "test" environment.
   /code $ copilot env show -n test
+  Summarize the tracing, logging, alarms and health checks of workloads in the "test" environment.
+  /code $ copilot env show -n test --telemetry
+  List the subnets, gateways, VPC endpoints and load balancers of the "test" environment.
+  /code $ copilot env show -n test --networking
   Print manifest file for deploying the "prod" environment.
-  /code $ copilot env show -n prod --manifest`,
+  /code $ copilot env show -n prod --manifest
+  Print the parameters of the "prod" environment stack and the ones that a deployment would change.
+  /code $ copilot env show -n prod --params`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newShowEnvOpts(vars)
 			if err != nil {
@@ -194,8 +251,17 @@ func buildEnvShowCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputResources, resourcesFlag, false, envResourcesFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputManifest, manifestFlag, false, manifestFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputTelemetry, telemetryFlag, false, telemetryFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputNetworking, networkingFlag, false, networkingFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputParams, paramsFlag, false, envParamsFlagDescription)
 
 	cmd.MarkFlagsMutuallyExclusive(jsonFlag, manifestFlag)
 	cmd.MarkFlagsMutuallyExclusive(resourcesFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(telemetryFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(paramsFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(paramsFlag, resourcesFlag)
+	cmd.MarkFlagsMutuallyExclusive(paramsFlag, telemetryFlag)
+	cmd.MarkFlagsMutuallyExclusive(networkingFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(paramsFlag, networkingFlag)
 	return cmd
 }
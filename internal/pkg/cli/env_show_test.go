@@ -195,6 +195,8 @@ func TestEnvShow_Execute(t *testing.T) {
 		inputEnv             string
 		shouldOutputJSON     bool
 		shouldOutputManifest bool
+		shouldOutputParams   bool
+		localParams          func() (map[string]string, error)
 
 		setupMocks func(mocks showEnvMocks)
 
@@ -274,6 +276,53 @@ Resources
 
 			wantedContent: "hello\n",
 		},
+		"return error if fail to get the deployed parameters": {
+			inputEnv:           "testEnv",
+			shouldOutputParams: true,
+			setupMocks: func(m showEnvMocks) {
+				m.describer.EXPECT().Params().Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("get parameters of environment testEnv: some error"),
+		},
+		"should print only the deployed parameters if they cannot be generated locally": {
+			inputEnv:           "testEnv",
+			shouldOutputParams: true,
+			localParams: func() (map[string]string, error) {
+				return nil, mockError
+			},
+			setupMocks: func(m showEnvMocks) {
+				m.describer.EXPECT().Params().Return(map[string]string{
+					"AppName": "testApp",
+				}, nil)
+			},
+
+			wantedContent: `Parameters
+
+  Name     Value
+  ----     -----
+  AppName  testApp
+`,
+		},
+		"should print the parameters that would change locally in JSON format": {
+			inputEnv:           "testEnv",
+			shouldOutputParams: true,
+			shouldOutputJSON:   true,
+			localParams: func() (map[string]string, error) {
+				return map[string]string{
+					"AppName":      "testApp",
+					"EFSWorkloads": "api",
+				}, nil
+			},
+			setupMocks: func(m showEnvMocks) {
+				m.describer.EXPECT().Params().Return(map[string]string{
+					"AppName":      "testApp",
+					"EFSWorkloads": "",
+				}, nil)
+			},
+
+			wantedContent: "{\"parameters\":[{\"name\":\"AppName\",\"value\":\"testApp\",\"localValue\":\"testApp\",\"changed\":false},{\"name\":\"EFSWorkloads\",\"value\":\"\",\"localValue\":\"api\",\"changed\":true}]}\n",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -296,10 +345,12 @@ Resources
 					name:                 tc.inputEnv,
 					shouldOutputJSON:     tc.shouldOutputJSON,
 					shouldOutputManifest: tc.shouldOutputManifest,
+					shouldOutputParams:   tc.shouldOutputParams,
 				},
 				store:            mockStoreReader,
 				describer:        mockEnvDescriber,
 				initEnvDescriber: func() error { return nil },
+				localParams:      tc.localParams,
 				w:                b,
 			}
 
@@ -3,8 +3,15 @@
 
 package cli
 
+import (
+	"fmt"
+	"time"
+)
+
 const (
 	defaultCommand = "/bin/sh"
+
+	defaultExecReconnect = 3
 )
 
 type execVars struct {
@@ -15,4 +22,17 @@ type execVars struct {
 	taskID           string
 	containerName    string
 	skipConfirmation *bool // If nil, we will prompt to upgrade the ssm plugin.
+	reconnect        int
+	keepAlive        time.Duration
+}
+
+// validateSession returns an error if the flags that keep the session connected are invalid.
+func (v execVars) validateSession() error {
+	if v.reconnect < 0 {
+		return fmt.Errorf("--%s must be greater than or equal to 0", reconnectFlag)
+	}
+	if v.keepAlive != 0 && v.keepAlive < time.Second {
+		return fmt.Errorf("--%s must be at least 1s", keepAliveFlag)
+	}
+	return nil
 }
@@ -33,10 +33,20 @@ const (
 	dockerFileContextFlag = "build-context"
 	imageTagFlag          = "tag"
 	resourceTagsFlag      = "resource-tags"
+	resourcePrefixFlag    = "resource-prefix"
+	sharedRepoFlag        = "shared-repository"
+	templateCatalogFlag   = "template-catalog"
+	peeredCIDRsFlag       = "peered-cidrs"
 	stackOutputDirFlag    = "output-dir"
 	uploadAssetsFlag      = "upload-assets"
 	limitFlag             = "limit"
 	followFlag            = "follow"
+	watchFlag             = "watch"
+	instanceFlag          = "instance"
+	buildFlag             = "build"
+	soakTimeFlag          = "soak-time"
+	reconnectFlag         = "reconnect"
+	keepAliveFlag         = "keep-alive"
 	sinceFlag             = "since"
 	startTimeFlag         = "start-time"
 	endTimeFlag           = "end-time"
@@ -45,6 +55,37 @@ const (
 	prodEnvFlag           = "prod"
 	deployFlag            = "deploy"
 	resourcesFlag         = "resources"
+	telemetryFlag         = "telemetry"
+	networkingFlag        = "networking"
+	diffFlag              = "diff"
+	yesSecurityFlag       = "yes-security"
+	detectDriftFlag       = "detect-drift"
+	failOnDriftFlag       = "fail-on-drift"
+	noWaitFlag            = "no-wait"
+	createChangeSetFlag   = "create-change-set"
+	statusFlag            = "status"
+	paramsFlag            = "params"
+	eventsFlag            = "events"
+	progressFlag          = "progress"
+	restartFlag           = "restart"
+	windowFlag            = "window"
+	stackSetFlag          = "stackset"
+	roleARNFlag           = "role-arn"
+	allowDowngradeFlag    = "allow-downgrade"
+	startFlag             = "start"
+	stopFlag              = "stop"
+	templateFlag          = "template"
+	fixFlag               = "fix"
+	pullRequestFlag       = "pr"
+	baseEnvFlag           = "base"
+	servicesFlag          = "services"
+	ttlFlag               = "ttl"
+	keepImagesFlag        = "keep-images"
+	keepDaysFlag          = "keep-days"
+	dryRunFlag            = "dry-run"
+	checkURIFlag          = "check-uri"
+
+	skipCustomResourcesUploadFlag = "skip-custom-resources-upload"
 
 	githubURLFlag         = "github-url"
 	repoURLFlag           = "url"
@@ -57,6 +98,7 @@ const (
 	localFlag        = "local"
 	deleteSecretFlag = "delete-secret"
 	svcPortFlag      = "port"
+	openAPIFlag      = "openapi"
 
 	noSubscriptionFlag  = "no-subscribe"
 	subscribeTopicsFlag = "subscribe-topics"
@@ -95,12 +137,15 @@ const (
 	publicSubnetsFlag              = "import-public-subnets"
 	privateSubnetsFlag             = "import-private-subnets"
 	certsFlag                      = "import-cert-arns"
+	importClusterFlag              = "import-cluster"
 	internalALBSubnetsFlag         = "internal-alb-subnets"
 	allowVPCIngressFlag            = "internal-alb-allow-vpc-ingress"
 	overrideVPCCIDRFlag            = "override-vpc-cidr"
 	overrideAZsFlag                = "override-az-names"
 	overridePublicSubnetCIDRsFlag  = "override-public-cidrs"
 	overridePrivateSubnetCIDRsFlag = "override-private-cidrs"
+	suggestCIDRFlag                = "suggest-cidr"
+	cidrSizeFlag                   = "cidr-size"
 
 	enableContainerInsightsFlag = "container-insights"
 
@@ -206,16 +251,32 @@ const (
 	yesFlagDescription        = "Skips confirmation prompt."
 	execYesFlagDescription    = "Optional. Whether to update the Session Manager Plugin."
 	jsonFlagDescription       = "Optional. Outputs in JSON format."
-	forceFlagDescription      = "Optional. Force a new service deployment using the existing image."
+	forceFlagDescription      = "Optional. Force a new service deployment using the existing image.\nRecreates the service stack if its first deployment was rolled back."
+	jobForceFlagDescription   = "Optional. Recreate the job stack if its first deployment was rolled back."
 	noRollbackFlagDescription = `Optional. Disable automatic stack 
 rollback in case of deployment failure.
 We do not recommend using this flag for a
 production environment.`
 	manifestFlagDescription = "Optional. Output the manifest file used for the deployment."
 
-	imageTagFlagDescription     = `Optional. The container image tag.`
+	imageTagFlagDescription = `Optional. The container image tag.
+Can be repeated to push the image with multiple tags.`
 	resourceTagsFlagDescription = `Optional. Labels with a key and value separated by commas.
 Allows you to categorize resources.`
+	resourcePrefixFlagDescription = `Optional. Prefix for the names of the ECS clusters,
+IAM roles and log groups created within the application.`
+	sharedRepoFlagDescription = `Optional. Store the images of all services and jobs
+in a single ECR repository, with tags prefixed by the workload name.`
+	templateCatalogFlagDescription = `Optional. Location of the workload templates of your organization.
+Either an S3 location "s3://bucket/prefix" or a git repository "git::url".`
+	peeredCIDRsFlagDescription = `Optional. CIDRs of the networks peered with the application's environments,
+such as VPC peering or transit gateway attachments. Environment VPCs can't overlap with them.`
+	svcInitTemplateFlagDescription = `Optional. Name of the template from the application's catalog
+to initialize the service with, optionally followed by a version, e.g. "go-api@v1.2.0".`
+	svcInitOpenAPIFlagDescription = `Optional. Path to an OpenAPI 3 or Swagger 2.0 document of the service.
+Prefills the port, path, and health check of a Load Balanced or Request-Driven Web Service.`
+	appUpgradeSharedRepoFlagDescription = `Optional. Migrate the images of all services and jobs
+to a single ECR repository, with tags prefixed by the workload name.`
 	stackOutputDirFlagDescription = "Optional. Writes the stack template and template configuration to a directory."
 	uploadAssetsFlagDescription   = `Optional. Whether to upload assets (container images, Lambda functions, etc.).
 Uploaded asset locations are filled in the template configuration.`
@@ -242,13 +303,76 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	pipelineTypeFlagDescription      = `The type of pipeline. Must be either "Workloads" or "Environments".`
 	domainNameFlagDescription        = "Optional. Your existing custom domain name."
 	envResourcesFlagDescription      = "Optional. Show the resources in your environment."
-	svcResourcesFlagDescription      = "Optional. Show the resources in your service."
-	pipelineResourcesFlagDescription = "Optional. Show the resources in your pipeline."
-	localSvcFlagDescription          = "Only show services in the workspace."
-	localJobFlagDescription          = "Only show jobs in the workspace."
-	localPipelineFlagDescription     = "Only show pipelines in the workspace."
-	deleteSecretFlagDescription      = "Deletes AWS Secrets Manager secret associated with a pipeline source repository."
-	svcPortFlagDescription           = "The port on which your service listens."
+	diffFlagDescription              = "Optional. Show the differences between the deployed stack and the one to be deployed,\nthen confirm before deploying."
+	yesSecurityFlagDescription       = "Optional. Skip confirming changes to IAM and security group resources\nof a deployed stack."
+	detectDriftFlagDescription       = "Optional. Warn if the deployed stack has drifted from its template\nbecause of changes made outside of Copilot."
+	failOnDriftFlagDescription       = "Optional. Stop the deployment if the deployed stack has drifted from its template.\nImplies --detect-drift."
+	deployAllEnvsFlagDescription     = "Optional. Deploy every environment in the workspace in parallel."
+	envNoWaitFlagDescription         = "Optional. Start the deployment and exit without waiting for it to complete.\nPost-deploy hooks are skipped."
+	createChangeSetFlagDescription   = "Optional. Create a change set for the environment stack and print its changes without executing it."
+	envStatusFlagDescription         = "Optional. Follow the deployment in progress until it completes, instead of deploying."
+	svcNoWaitFlagDescription         = "Optional. Start the deployment and exit without waiting for it to complete.\nNot supported for blue/green deployments."
+	svcWatchFlagDescription          = "Optional. Follow the deployment in progress until it completes, instead of deploying."
+	svcInstanceFlagDescription       = `Optional. Deploy the service as a separate instance with this name,
+so that multiple copies of the service can run in the same environment.`
+	svcDeployImageFlagDescription = `Optional. A fully-qualified ECR image URI, or the digest of an image
+in the service's ECR repository, to deploy instead of building the image.`
+	svcDeployBuildFlagDescription = `Optional. Where to build the container image. Must be one of "local" or "remote".
+Defaults to "local". With "remote", the build context is uploaded and built by a CodeBuild project
+in the environment's region, so a local Docker engine isn't needed.`
+	svcDeployEnvFlagDescription      = "Name of the environment, or a comma-separated list of environments to deploy to in order."
+	svcDeploySoakTimeFlagDescription = `Optional. When deploying to multiple environments, how long to monitor the
+alarms of the service in an environment before deploying to the next one, instead of asking for confirmation.
+For example: 10m, 1h.`
+	envForceFlagDescription    = "Optional. Update the environment stack even if nothing changed,\nso that custom resources such as DNS delegation run again."
+	envProgressFlagDescription = `Optional. How to report the progress of the deployment.
+Must be one of "human" or "json". Defaults to "human".
+With "json", stack events are written to stdout as newline-delimited JSON.`
+	envStackSetFlagDescription = `Optional. Deploy the environment as a stack instance of the application's
+CloudFormation stack set for environments, and report the status of each stack instance.
+The environment stack is imported into the stack set the first time.`
+	envRoleARNFlagDescription = `Optional. ARN of the IAM role that CloudFormation assumes to deploy the environment stack,
+instead of the role in the manifest or the one created by Copilot.`
+	envRollbackRoleARNFlagDescription = `Optional. ARN of the IAM role that CloudFormation assumes to roll back the environment stack,
+instead of the role in the manifest or the one created by Copilot.`
+	envAllowDowngradeFlagDescription = `Optional. Deploy the environment even if its stack was deployed
+with a newer template version by a more recent version of Copilot.`
+	envTimeoutFlagDescription = `Optional. How long to wait for the environment stack update to complete,
+overrides "deployment.timeout" in the manifest. Defaults to 1h30m.
+Accepts valid Go duration strings. For example: "3h", "20m".`
+	packagedTemplateFlagDescription = `Optional. Path to a stack template generated by the package command
+with --output-dir, to deploy verbatim instead of generating it. Requires --params.`
+	packagedParamsFlagDescription        = "Optional. Path to the template configuration generated along with the --template file."
+	envMaintenanceRestartFlagDescription = "Optional. Restart the services affected by the scheduled maintenance\nso that their tasks are replaced ahead of it."
+	envMaintenanceWindowFlagDescription  = `Optional. Only restart if the current time is within the window.
+Must be of the form "HH:MM-HH:MM" in UTC, for example "22:00-02:00". Requires --restart.`
+	deployAllFlagDescription          = "Optional. Deploy the environment and every service and job in the workspace,\nordered by their dependencies."
+	deploySinceFlagDescription        = "Optional. Deploy the environment and the workloads that changed since a git revision,\nalong with the workloads that are not deployed to the environment yet."
+	telemetryFlagDescription          = "Optional. Show a summary of tracing, logging, alarms and health checks\nfor the workloads in your environment."
+	networkingFlagDescription         = "Optional. Show the subnets, gateways, VPC endpoints and load balancers\nof your environment."
+	svcResourcesFlagDescription       = "Optional. Show the resources in your service."
+	envParamsFlagDescription          = "Optional. Show the parameters of the deployed environment stack,\nand highlight the ones that deploying the workspace would change."
+	svcParamsFlagDescription          = "Optional. Show the parameters of the service stack deployed in an environment,\nand highlight the ones that deploying the workspace would change."
+	svcEventsFlagDescription          = "Optional. Show the recent events of the ECS service, its stopped tasks\nand the images that couldn't be pulled in each environment."
+	svcCheckImagesNameFlagDescription = "Optional. Name of the service. Defaults to all the services in the workspace."
+	svcCheckImagesEnvFlagDescription  = "Optional. Name of an environment to also report the vulnerabilities\nthat ECR found in the images deployed there."
+	svcCheckImagesFixFlagDescription  = "Optional. Update the stale images in the Dockerfiles and manifests\nto their latest version, so that you can review and commit the patch."
+	svcFlagsStartFlagDescription      = "Optional. Name of a launch to start rolling out its feature variations."
+	svcFlagsStopFlagDescription       = "Optional. Name of a running launch to stop.\nUsers are served the default variation of its features again."
+	pipelineResourcesFlagDescription  = "Optional. Show the resources in your pipeline."
+	localSvcFlagDescription           = "Only show services in the workspace."
+	localJobFlagDescription           = "Only show jobs in the workspace."
+	localPipelineFlagDescription      = "Only show pipelines in the workspace."
+	deleteSecretFlagDescription       = "Deletes AWS Secrets Manager secret associated with a pipeline source repository."
+	svcPortFlagDescription            = "The port on which your service listens."
+
+	envPreviewPRFlagDescription        = "Number of the pull request to preview."
+	envPreviewBaseFlagDescription      = "Name of the environment to clone for the preview."
+	envPreviewSvcsFlagDescription      = "Optional. Names of the services to deploy to the preview environment.\nDefaults to all the services in the workspace."
+	envPreviewProfileFlagDescription   = "Optional. Name of the profile to create the preview environment with.\nDefaults to the default credentials."
+	envPreviewCleanupPRFlagDescription = "Optional. Number of a pull request whose preview environment to delete,\neven if it hasn't expired yet."
+	envPreviewTTLFlagDescription       = `Optional. How long the preview environment lives before it can be cleaned up.
+Deploying the preview again extends it. Defaults to 72h.`
 
 	noSubscriptionFlagDescription  = "Optional. Turn off selection for adding subscriptions for worker services."
 	subscribeTopicsFlagDescription = `Optional. SNS Topics to subscribe to from other services in your application.
@@ -291,6 +415,7 @@ Cannot be specified with any other flags.`
 	publicSubnetsFlagDescription      = "Optional. Use existing public subnet IDs."
 	privateSubnetsFlagDescription     = "Optional. Use existing private subnet IDs."
 	certsFlagDescription              = "Optional. Apply existing ACM certificates to the internet-facing load balancer."
+	importClusterFlagDescription      = "Optional. Use an existing ECS cluster name or ARN."
 	internalALBSubnetsFlagDescription = `Optional. Specify subnet IDs for an internal load balancer.
 By default, the load balancer will be placed in your private subnets.
 Cannot be specified with --default-config or any of the --override flags.`
@@ -303,6 +428,9 @@ Cannot be specified with --default-config or any of the --override flags.`
 (default 10.0.0.0/24,10.0.1.0/24)`
 	overridePrivateSubnetCIDRsFlagDescription = `Optional. CIDR to use for private subnets.
 (default 10.0.2.0/24,10.0.3.0/24)`
+	suggestCIDRFlagDescription = `Optional. Use a VPC CIDR that doesn't overlap with the VPCs of
+the other environments in the application or its peered networks.`
+	cidrSizeFlagDescription = "Optional. Prefix size of the suggested VPC CIDR, between 16 and 24."
 
 	enableContainerInsightsFlagDescription = "Optional. Enable CloudWatch Container Insights."
 
@@ -313,6 +441,8 @@ Cannot be specified with --default-config or any of the --override flags.`
 	sessionTokenFlagDescription    = "Optional. An AWS session token for temporary credentials."
 	envRegionTokenFlagDescription  = "Optional. An AWS region where the environment will be created."
 
+	completionMatrixRegionFlagDescription = "Optional. The AWS region to list the available features of.\nDefaults to the region of your default profile."
+
 	retriesFlagDescription = "Optional. The number of times to try restarting the job on a failure."
 	timeoutFlagDescription = `Optional. The total execution time for the task, including retries.
 Accepts valid Go duration strings. For example: "2h", "1h30m", "900s".`
@@ -322,11 +452,38 @@ For example: "0 * * * *", "@daily", "@weekly", "@every 1h30m".
 AWS Schedule Expressions of the form "rate(10 minutes)" or "cron(0 12 L * ? 2021)"
 are also accepted.`
 
+	jobDeployScheduleFlagDescription = `Optional. Override the schedule of the job in the manifest for this deployment.
+Accepts the same expressions as the "on.schedule" field of the manifest.
+For example: "@daily", "0 9 * * 1-5", "rate(30 minutes)".`
+
 	upgradeAllEnvsDescription = "Optional. Upgrade all environments."
 
 	taskIDFlagDescription      = "Optional. ID of the task you want to exec in."
 	execCommandFlagDescription = `Optional. The command that is passed to a running container.`
 	containerFlagDescription   = "Optional. The specific container you want to exec in. By default the first essential container will be used."
 
+	execReconnectFlagDescription = `Optional. Maximum number of times to resume the session if its connection drops.
+Set to 0 to exit as soon as the connection drops.`
+	execKeepAliveFlagDescription = `Optional. Interval at which the container writes to the session while it's idle,
+so that VPNs and proxies don't close the connection. For example: 1m.
+Requires "/bin/sh" in the container. Disabled by default.`
+
 	secretOverwriteFlagDescription = "Optional. Whether to overwrite an existing secret."
+
+	keepImagesFlagDescription = `Optional. Number of most recent images to keep for each service and job.
+Images that are deployed in any environment are always kept.`
+	keepDaysFlagDescription = `Optional. Number of days to keep images and artifacts for,
+regardless of how many more recent ones exist.`
+	gcDryRunFlagDescription = "Optional. Report the images and artifacts that would be deleted without deleting them."
+
+	appDNSVerifyFixFlagDescription = `Optional. Update the missing or stale NS records
+to the name servers of the hosted zones of the subdomains.`
+
+	svcStatusCheckURIFlagDescription = `Optional. Send a request to each endpoint of the service
+and report its status code and latency.`
+	svcDeployCheckURIFlagDescription = `Optional. Once deployed, send a request to each endpoint
+of the service and report its status code and latency.`
+
+	skipCustomResourcesUploadFlagDescription = `Optional. Don't upload the custom resources of the environment from this machine.
+Reference the copies seeded in "deployment.custom_resources_bucket" of the manifest instead.`
 )
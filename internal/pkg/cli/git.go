@@ -5,6 +5,7 @@ package cli
 
 import (
 	"bytes"
+	"path/filepath"
 	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/exec"
@@ -29,6 +30,22 @@ func hasUncommitedGitChanges(r execRunner) (bool, error) {
 	return strings.TrimSpace(stdout.String()) != "", nil
 }
 
+// deployedGitCommit returns the git commit that the workspace is checked out at, suffixed with "-dirty"
+// if there are uncommitted changes, so that deployments can be traced back to their source.
+// Returns the empty string if the user isn't in a git repository.
+func deployedGitCommit(r execRunner) string {
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if err := r.Run("git", []string{"rev-parse", "--short", "HEAD"}, exec.Stdout(&stdout), exec.Stderr(&stderr)); err != nil {
+		return ""
+	}
+	commit := strings.TrimSpace(stdout.String())
+	if isRepoDirty, _ := hasUncommitedGitChanges(r); isRepoDirty {
+		commit += "-dirty"
+	}
+	return commit
+}
+
 // imageTagFromGit returns the image tag to apply in case the user is in a git repository.
 // If the user provided their own tag, then just use that.
 // If there is a clean git commit with no local changes, then return the git commit id.
@@ -47,3 +64,30 @@ func imageTagFromGit(r execRunner, userTag string) string {
 	}
 	return commit
 }
+
+// changedGitFiles returns the absolute paths of the files that differ from the git revision,
+// including uncommitted and untracked files.
+func changedGitFiles(r execRunner, revision string) ([]string, error) {
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if err := r.Run("git", []string{"rev-parse", "--show-toplevel"}, exec.Stdout(&stdout), exec.Stderr(&stderr)); err != nil {
+		return nil, err
+	}
+	root := strings.TrimSpace(stdout.String())
+
+	stdout.Reset()
+	if err := r.Run("git", []string{"diff", "--name-only", revision, "--"}, exec.Stdout(&stdout), exec.Stderr(&stderr)); err != nil {
+		return nil, err
+	}
+	if err := r.Run("git", []string{"ls-files", "--others", "--exclude-standard", "--full-name"}, exec.Stdout(&stdout), exec.Stderr(&stderr)); err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, name := range strings.Split(stdout.String(), "\n") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		files = append(files, filepath.Join(root, filepath.FromSlash(name)))
+	}
+	return files, nil
+}
@@ -0,0 +1,52 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployedGitCommit(t *testing.T) {
+	testCases := map[string]struct {
+		setupMock func(m *mocks.MockexecRunner)
+
+		wanted string
+	}{
+		"empty if not in a git repository": {
+			setupMock: func(m *mocks.MockexecRunner) {
+				m.EXPECT().Run("git", []string{"rev-parse", "--short", "HEAD"}, gomock.Any()).Return(errors.New("some error"))
+			},
+		},
+		"the current commit": {
+			setupMock: func(m *mocks.MockexecRunner) {
+				m.EXPECT().Run("git", []string{"rev-parse", "--short", "HEAD"}, gomock.Any()).DoAndReturn(mockGitOutput("abc123\n"))
+				m.EXPECT().Run("git", []string{"status", "--porcelain"}, gomock.Any()).DoAndReturn(mockGitOutput(""))
+			},
+			wanted: "abc123",
+		},
+		"the current commit marked as dirty if there are uncommitted changes": {
+			setupMock: func(m *mocks.MockexecRunner) {
+				m.EXPECT().Run("git", []string{"rev-parse", "--short", "HEAD"}, gomock.Any()).DoAndReturn(mockGitOutput("abc123\n"))
+				m.EXPECT().Run("git", []string{"status", "--porcelain"}, gomock.Any()).DoAndReturn(mockGitOutput(" M Dockerfile\n"))
+			},
+			wanted: "abc123-dirty",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockexecRunner(ctrl)
+			tc.setupMock(m)
+
+			require.Equal(t, tc.wanted, deployedGitCommit(m))
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/afero"
+)
+
+const imageTagTimestampFormat = "20060102150405"
+
+// semverTagRegexp matches versions like "1.2.3", "v1.2.3" or "1.2.3-beta.1".
+// Build metadata, such as "+build.1", is not allowed since "+" is an invalid character in image tags.
+var semverTagRegexp = regexp.MustCompile(`^v?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(-[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*)?$`)
+
+// imageTagsFlag is a repeatable flag for the tags of a container image.
+// The first value is the main tag of the image, and any following values are additional tags for the same image.
+type imageTagsFlag struct {
+	tag   *string
+	extra *[]string
+}
+
+func newImageTagsFlag(tag *string, extra *[]string) *imageTagsFlag {
+	return &imageTagsFlag{
+		tag:   tag,
+		extra: extra,
+	}
+}
+
+// Set implements the pflag.Value interface.
+func (f *imageTagsFlag) Set(val string) error {
+	if *f.tag == "" {
+		*f.tag = val
+		return nil
+	}
+	*f.extra = append(*f.extra, val)
+	return nil
+}
+
+// String implements the pflag.Value interface.
+func (f *imageTagsFlag) String() string {
+	if *f.tag == "" {
+		return ""
+	}
+	return strings.Join(append([]string{*f.tag}, *f.extra...), ",")
+}
+
+// Type implements the pflag.Value interface.
+func (f *imageTagsFlag) Type() string {
+	return "string"
+}
+
+type imageTagInput struct {
+	userTag string
+	mft     interface{}
+	runner  execRunner
+	fs      afero.Fs
+	wsPath  string
+	now     func() time.Time
+}
+
+// imageTag returns the tag to apply to the image built for a workload.
+// If the user provided their own tag, then just use that.
+// If the manifest configures an "image.tag_strategy", then generate the tag with the strategy.
+// Otherwise, best effort assign the git commit id.
+func imageTag(in *imageTagInput) (string, error) {
+	if in.userTag != "" {
+		return in.userTag, nil
+	}
+	type tagStrategyGetter interface {
+		ImageTagStrategy() manifest.ImageTagStrategy
+	}
+	mft, ok := in.mft.(tagStrategyGetter)
+	if !ok {
+		return imageTagFromGit(in.runner, ""), nil
+	}
+	strategy := mft.ImageTagStrategy()
+	if strategy.IsEmpty() {
+		return imageTagFromGit(in.runner, ""), nil
+	}
+	var tag string
+	var err error
+	switch typ := aws.StringValue(strategy.Type); typ {
+	case manifest.ImageTagStrategyGitSHA:
+		tag, err = imageTagFromGitSHA(in.runner)
+	case manifest.ImageTagStrategySemver:
+		tag, err = imageTagFromFile(in.fs, filepath.Join(in.wsPath, aws.StringValue(strategy.File)))
+	case manifest.ImageTagStrategyTimestamp:
+		tag = in.now().UTC().Format(imageTagTimestampFormat)
+	default:
+		return "", fmt.Errorf("unsupported image tag strategy %q", typ)
+	}
+	if err != nil {
+		return "", fmt.Errorf("generate image tag with strategy %q: %w", aws.StringValue(strategy.Type), err)
+	}
+	log.Infof("Tagging the image with %s using the %q strategy.\n", tag, aws.StringValue(strategy.Type))
+	return tag, nil
+}
+
+func imageTagFromGitSHA(r execRunner) (string, error) {
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if err := r.Run("git", []string{"rev-parse", "--short", "HEAD"}, exec.Stdout(&stdout), exec.Stderr(&stderr)); err != nil {
+		return "", fmt.Errorf("get the current git commit: %w", err)
+	}
+	isRepoDirty, err := hasUncommitedGitChanges(r)
+	if err != nil {
+		return "", fmt.Errorf("check for uncommitted git changes: %w", err)
+	}
+	if isRepoDirty {
+		return "", errors.New("the git repository has uncommitted changes")
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+func imageTagFromFile(fs afero.Fs, path string) (string, error) {
+	content, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return "", fmt.Errorf("read version file: %w", err)
+	}
+	version := strings.TrimSpace(string(content))
+	if !semverTagRegexp.MatchString(version) {
+		return "", fmt.Errorf("version %q in %s is not a valid semantic version", version, path)
+	}
+	return version, nil
+}
@@ -0,0 +1,219 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	osexec "os/exec"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func mockGitOutput(out string) func(string, []string, ...exec.CmdOption) error {
+	return func(_ string, _ []string, opts ...exec.CmdOption) error {
+		cmd := &osexec.Cmd{}
+		for _, opt := range opts {
+			opt(cmd)
+		}
+		_, err := cmd.Stdout.Write([]byte(out))
+		return err
+	}
+}
+
+func TestImageTag(t *testing.T) {
+	mockNow := func() time.Time {
+		return time.Date(2022, time.August, 1, 12, 30, 45, 0, time.FixedZone("PDT", -7*60*60))
+	}
+	svcWithStrategy := func(strategy manifest.ImageTagStrategy) *manifest.LoadBalancedWebService {
+		svc := &manifest.LoadBalancedWebService{}
+		svc.ImageConfig.Image.Build.BuildString = aws.String("Dockerfile")
+		svc.ImageConfig.Image.TagStrategy = strategy
+		return svc
+	}
+	testCases := map[string]struct {
+		inUserTag string
+		inMft     interface{}
+		setupFS   func(fs afero.Fs)
+		setupMock func(m *mocks.MockexecRunner)
+
+		wantedTag   string
+		wantedError error
+	}{
+		"use the tag provided by the user": {
+			inUserTag: "v1.0.0",
+			inMft: svcWithStrategy(manifest.ImageTagStrategy{
+				Type: aws.String("timestamp"),
+			}),
+			setupMock: func(m *mocks.MockexecRunner) {},
+
+			wantedTag: "v1.0.0",
+		},
+		"best effort use the git commit if there is no tag strategy": {
+			inMft: &manifest.LoadBalancedWebService{},
+			setupMock: func(m *mocks.MockexecRunner) {
+				m.EXPECT().Run("git", []string{"describe", "--always"}, gomock.Any()).DoAndReturn(mockGitOutput("abc123\n"))
+				m.EXPECT().Run("git", []string{"status", "--porcelain"}, gomock.Any()).DoAndReturn(mockGitOutput(""))
+			},
+
+			wantedTag: "abc123",
+		},
+		"use the git commit sha": {
+			inMft: svcWithStrategy(manifest.ImageTagStrategy{
+				Type: aws.String("git-sha"),
+			}),
+			setupMock: func(m *mocks.MockexecRunner) {
+				m.EXPECT().Run("git", []string{"rev-parse", "--short", "HEAD"}, gomock.Any()).DoAndReturn(mockGitOutput("abc123\n"))
+				m.EXPECT().Run("git", []string{"status", "--porcelain"}, gomock.Any()).DoAndReturn(mockGitOutput(""))
+			},
+
+			wantedTag: "abc123",
+		},
+		"error if the git repository has uncommitted changes": {
+			inMft: svcWithStrategy(manifest.ImageTagStrategy{
+				Type: aws.String("git-sha"),
+			}),
+			setupMock: func(m *mocks.MockexecRunner) {
+				m.EXPECT().Run("git", []string{"rev-parse", "--short", "HEAD"}, gomock.Any()).DoAndReturn(mockGitOutput("abc123\n"))
+				m.EXPECT().Run("git", []string{"status", "--porcelain"}, gomock.Any()).DoAndReturn(mockGitOutput(" M Dockerfile\n"))
+			},
+
+			wantedError: errors.New(`generate image tag with strategy "git-sha": the git repository has uncommitted changes`),
+		},
+		"error if not in a git repository": {
+			inMft: svcWithStrategy(manifest.ImageTagStrategy{
+				Type: aws.String("git-sha"),
+			}),
+			setupMock: func(m *mocks.MockexecRunner) {
+				m.EXPECT().Run("git", []string{"rev-parse", "--short", "HEAD"}, gomock.Any()).Return(errors.New("some error"))
+			},
+
+			wantedError: errors.New(`generate image tag with strategy "git-sha": get the current git commit: some error`),
+		},
+		"use the version from the file": {
+			inMft: svcWithStrategy(manifest.ImageTagStrategy{
+				Type: aws.String("semver"),
+				File: aws.String("VERSION"),
+			}),
+			setupFS: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "/ws/VERSION", []byte("1.2.3-beta.1\n"), 0644)
+			},
+			setupMock: func(m *mocks.MockexecRunner) {},
+
+			wantedTag: "1.2.3-beta.1",
+		},
+		"error if the version file does not exist": {
+			inMft: svcWithStrategy(manifest.ImageTagStrategy{
+				Type: aws.String("semver"),
+				File: aws.String("VERSION"),
+			}),
+			setupMock: func(m *mocks.MockexecRunner) {},
+
+			wantedError: errors.New(`generate image tag with strategy "semver": read version file: open /ws/VERSION: file does not exist`),
+		},
+		"error if the version is not a semantic version": {
+			inMft: svcWithStrategy(manifest.ImageTagStrategy{
+				Type: aws.String("semver"),
+				File: aws.String("VERSION"),
+			}),
+			setupFS: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "/ws/VERSION", []byte("1.2.3+build.1"), 0644)
+			},
+			setupMock: func(m *mocks.MockexecRunner) {},
+
+			wantedError: errors.New(`generate image tag with strategy "semver": version "1.2.3+build.1" in /ws/VERSION is not a valid semantic version`),
+		},
+		"use the current UTC timestamp": {
+			inMft: svcWithStrategy(manifest.ImageTagStrategy{
+				Type: aws.String("timestamp"),
+			}),
+			setupMock: func(m *mocks.MockexecRunner) {},
+
+			wantedTag: "20220801193045",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockexecRunner(ctrl)
+			tc.setupMock(m)
+			fs := afero.NewMemMapFs()
+			if tc.setupFS != nil {
+				tc.setupFS(fs)
+			}
+
+			// WHEN
+			tag, err := imageTag(&imageTagInput{
+				userTag: tc.inUserTag,
+				mft:     tc.inMft,
+				runner:  m,
+				fs:      fs,
+				wsPath:  "/ws",
+				now:     mockNow,
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTag, tag)
+			}
+		})
+	}
+}
+
+func TestImageTagsFlag_Set(t *testing.T) {
+	testCases := map[string]struct {
+		inValues []string
+
+		wantedTag      string
+		wantedExtra    []string
+		wantedAsString string
+	}{
+		"no tags": {},
+		"single tag": {
+			inValues: []string{"v1.0.0"},
+
+			wantedTag:      "v1.0.0",
+			wantedAsString: "v1.0.0",
+		},
+		"multiple tags": {
+			inValues: []string{"ab1f5575", "main", "latest"},
+
+			wantedTag:      "ab1f5575",
+			wantedExtra:    []string{"main", "latest"},
+			wantedAsString: "ab1f5575,main,latest",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var tag string
+			var extra []string
+			flag := newImageTagsFlag(&tag, &extra)
+
+			// WHEN
+			for _, val := range tc.inValues {
+				require.NoError(t, flag.Set(val))
+			}
+
+			// THEN
+			require.Equal(t, tc.wantedTag, tag)
+			require.Equal(t, tc.wantedExtra, extra)
+			require.Equal(t, tc.wantedAsString, flag.String())
+		})
+	}
+}
@@ -155,8 +155,8 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 		identity:        id,
 		newInterpolator: newManifestInterpolator,
 	}
-	deployEnvCmd.newEnvDeployer = func() (envDeployer, error) {
-		return newEnvDeployer(deployEnvCmd)
+	deployEnvCmd.newEnvDeployer = func(env *config.Environment, progressOut termprogress.FileWriter) (envDeployer, error) {
+		return newEnvDeployer(deployEnvCmd, env, progressOut, ws)
 	}
 
 	deploySvcCmd := &deploySvcOpts{
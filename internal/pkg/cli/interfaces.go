@@ -4,22 +4,31 @@
 package cli
 
 import (
+	"context"
 	"encoding"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 
 	"github.com/aws/aws-sdk-go/aws/session"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/evidently"
+	"github.com/aws/copilot-cli/internal/pkg/aws/health"
+	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/catalog"
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	describestack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
@@ -27,6 +36,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/initialize"
 	"github.com/aws/copilot-cli/internal/pkg/logging"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/probe"
 	"github.com/aws/copilot-cli/internal/pkg/repository"
 	"github.com/aws/copilot-cli/internal/pkg/task"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -108,6 +118,7 @@ type applicationDeleter interface {
 
 type environmentStore interface {
 	environmentCreator
+	environmentUpdater
 	environmentGetter
 	environmentLister
 	environmentDeleter
@@ -117,6 +128,10 @@ type environmentCreator interface {
 	CreateEnvironment(env *config.Environment) error
 }
 
+type environmentUpdater interface {
+	UpdateEnvironment(env *config.Environment) error
+}
+
 type environmentGetter interface {
 	GetEnvironment(appName string, environmentName string) (*config.Environment, error)
 }
@@ -141,6 +156,31 @@ type store interface {
 	wlStore
 }
 
+type deployedWorkloadsLister interface {
+	ListDeployedServices(appName, envName string) ([]string, error)
+	ListDeployedJobs(appName string, envName string) ([]string, error)
+}
+
+type scheduledChangesLister interface {
+	ScheduledECSChanges(region string) ([]health.Event, error)
+}
+
+type ecsServiceRestarter interface {
+	DescribeService(app, env, svc string) (*ecs.ServiceDesc, error)
+	ForceUpdateService(app, env, svc string) error
+}
+
+type stackResourcesDescriber interface {
+	Resources() ([]*describestack.Resource, error)
+}
+
+type featureFlagManager interface {
+	Features(project string) ([]evidently.Feature, error)
+	Launches(project string) ([]evidently.Launch, error)
+	StartLaunch(project, launch string) error
+	StopLaunch(project, launch string) error
+}
+
 type deployedEnvironmentLister interface {
 	ListEnvironmentsDeployedTo(appName, svcName string) ([]string, error)
 	ListDeployedServices(appName, envName string) ([]string, error)
@@ -173,6 +213,19 @@ type repositoryURIGetter interface {
 	URI() (string, error)
 }
 
+type serviceURIDescriber interface {
+	URI(env string) (describe.URI, error)
+}
+
+type reachableSvcDescriber interface {
+	serviceURIDescriber
+	Manifest(env string) ([]byte, error)
+}
+
+type endpointProber interface {
+	Probe(ctx context.Context, endpoints []string, healthCheckPath string) []probe.Result
+}
+
 type repositoryService interface {
 	repositoryURIGetter
 	imageBuilderPusher
@@ -229,6 +282,7 @@ type describer interface {
 type workloadDescriber interface {
 	describer
 	Manifest(string) ([]byte, error)
+	Params(string) (map[string]string, error)
 }
 
 type wsFileDeleter interface {
@@ -243,6 +297,12 @@ type environmentManifestWriter interface {
 	WriteEnvironmentManifest(encoding.BinaryMarshaler, string) (string, error)
 }
 
+type wsPreviewEnvCloner interface {
+	serviceLister
+	wsEnvironmentReader
+	environmentManifestWriter
+}
+
 type workspacePathGetter interface {
 	Path() (string, error)
 }
@@ -267,6 +327,20 @@ type wsSvcReader interface {
 	manifestReader
 }
 
+type wsImageReader interface {
+	wsSvcReader
+	workspacePathGetter
+}
+
+type imageRegistry interface {
+	Tags(image string) ([]string, error)
+	Digest(image, tag string) (string, error)
+}
+
+type imageScanner interface {
+	ImageScanFindings(repoName, tagOrDigest string) (*ecr.ScanFindings, error)
+}
+
 type jobLister interface {
 	ListJobs() ([]string, error)
 }
@@ -280,6 +354,11 @@ type wlLister interface {
 	ListWorkloads() ([]string, error)
 }
 
+type wsManifestRenderer interface {
+	wlLister
+	manifestReader
+}
+
 type wsJobDirReader interface {
 	wsJobReader
 	workspacePathGetter
@@ -299,6 +378,15 @@ type wsEnvironmentReader interface {
 	ReadEnvironmentManifest(mftDirName string) (workspace.EnvironmentManifest, error)
 }
 
+type guardRulesDirGetter interface {
+	GuardRulesDir() (string, error)
+}
+
+type wsEnvironmentReadLister interface {
+	wsEnvironmentReader
+	wsEnvironmentsLister
+}
+
 type wsPipelineReader interface {
 	wsPipelineGetter
 	Rel(path string) (string, error)
@@ -347,7 +435,8 @@ type jobRemoverFromApp interface {
 }
 
 type imageRemover interface {
-	ClearRepository(repoName string) error // implemented by ECR Service
+	ClearRepository(repoName string) error                  // implemented by ECR Service
+	ClearImagesWithTagPrefix(repoName, prefix string) error // implemented by ECR Service
 }
 
 type pipelineDeployer interface {
@@ -403,6 +492,17 @@ type domainHostedZoneGetter interface {
 	DomainHostedZoneID(domainName string) (string, error)
 }
 
+type hostedZoneDescriber interface {
+	domainHostedZoneGetter
+	NameServers(hostedZoneID string) ([]string, error)
+}
+
+type dnsDelegationManager interface {
+	hostedZoneDescriber
+	ListNSRecords(hostedZoneID string) ([]route53.NSRecord, error)
+	UpsertNSRecord(hostedZoneID string, record route53.NSRecord, ttl int64) error
+}
+
 type domainInfoGetter interface {
 	IsRegisteredDomain(domainName string) error
 }
@@ -420,6 +520,7 @@ type envDescriber interface {
 	Describe() (*describe.EnvDescription, error)
 	PublicCIDRBlocks() ([]string, error)
 	Manifest() ([]byte, error)
+	Params() (map[string]string, error)
 }
 
 type versionCompatibilityChecker interface {
@@ -431,10 +532,44 @@ type versionGetter interface {
 	Version() (string, error)
 }
 
+type appDescriber interface {
+	versionGetter
+	RegionalResources(app *config.Application) ([]*describe.AppRegionalResources, error)
+}
+
 type appUpgrader interface {
 	UpgradeApplication(in *deploy.CreateAppInput) error
 }
 
+type sharedRepositoryMigrator interface {
+	AddSharedRepositoryToApp(app *config.Application) error
+	RemoveWorkloadRepositoriesFromApp(app *config.Application) error
+	GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error)
+}
+
+type imagePruner interface {
+	ListImages(repoName string) ([]ecr.Image, error)
+	DeleteImages(images []ecr.Image, repoName string) error
+}
+
+type artifactPruner interface {
+	ListObjects(bucket, prefix string) ([]*s3.Object, error)
+	Download(bucket, key string) ([]byte, error)
+	DeleteObjects(bucket string, objects []*s3.Object) error
+}
+
+type repositoryImageLister interface {
+	Auth() (string, string, error)
+	ListImages(repoName string) ([]ecr.Image, error)
+}
+
+type imageCopier interface {
+	CheckDockerEngineRunning() error
+	IsEcrCredentialHelperEnabled(uri string) bool
+	Login(uri, username, password string) error
+	CopyImage(source, target string) error
+}
+
 type pipelineGetter interface {
 	GetPipeline(pipelineName string) (*codepipeline.Pipeline, error)
 }
@@ -528,6 +663,10 @@ type ec2Client interface {
 	ListAZs() ([]ec2.AZ, error)
 }
 
+type clusterDescriber interface {
+	Cluster(name string) (*awsecs.Cluster, error)
+}
+
 type serviceResumer interface {
 	ResumeService(string) error
 }
@@ -540,10 +679,22 @@ type svcInitializer interface {
 	Service(props *initialize.ServiceProps) (string, error)
 }
 
+type templateGetter interface {
+	Get(ref string) (*catalog.Contents, error)
+}
+
+type templateLister interface {
+	List() ([]catalog.Template, error)
+}
+
 type roleDeleter interface {
 	DeleteRole(string) error
 }
 
+type envStackSetDetacher interface {
+	DetachEnvironment(env *config.Environment) error
+}
+
 type serviceDescriber interface {
 	DescribeService(app, env, svc string) (*ecs.ServiceDesc, error)
 }
@@ -622,6 +773,16 @@ type workloadDeployer interface {
 	UploadArtifacts() (*clideploy.UploadArtifactsOutput, error)
 	DeployWorkload(in *clideploy.DeployWorkloadInput) (clideploy.ActionRecommender, error)
 	IsServiceAvailableInRegion(region string) (bool, error)
+	GenerateCloudFormationTemplate(in *clideploy.GenerateCloudFormationTemplateInput) (
+		*clideploy.GenerateCloudFormationTemplateOutput, error)
+}
+
+type workloadStackRenderer interface {
+	RenderWorkloadUpdate(out termprogress.FileWriter, stackName string) error
+}
+
+type taggedAlarmsGetter interface {
+	AlarmsWithTags(tags map[string]string) ([]cloudwatch.AlarmStatus, error)
 }
 
 type workloadTemplateGenerator interface {
@@ -636,7 +797,17 @@ type runner interface {
 
 type envDeployer interface {
 	DeployEnvironment(in *clideploy.DeployEnvironmentInput) error
+	DeployEnvironmentNoWait(in *clideploy.DeployEnvironmentInput) (*clideploy.EnvironmentDeployment, error)
+	AttachToDeployment() error
+	CreateChangeSet(in *clideploy.DeployEnvironmentInput) (*clideploy.EnvironmentChangeSet, error)
 	UploadArtifacts() (map[string]string, error)
+	PreSeededArtifacts(bucket string) (map[string]string, error)
+	GenerateCloudFormationTemplate(in *clideploy.DeployEnvironmentInput) (*clideploy.GenerateCloudFormationTemplateOutput, error)
+	DetectDrift() ([]*awscloudformation.StackResourceDrift, error)
+}
+
+type envRollbacker interface {
+	Rollback(in *clideploy.RollbackEnvironmentInput) error
 }
 
 type envPackager interface {
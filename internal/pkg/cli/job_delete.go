@@ -22,6 +22,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -310,14 +311,24 @@ func (o *deleteJobOpts) emptyECRRepos(envs []*config.Environment) error {
 		}
 	}
 
-	// TODO: centralized ECR repo name
-	repoName := fmt.Sprintf("%s/%s", o.appName, o.name)
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	repoName := stack.NameForWorkloadRepository(o.appName, o.name, app.SharedRepository)
 	for _, region := range uniqueRegions {
 		sess, err := o.sess.DefaultWithRegion(region)
 		if err != nil {
 			return err
 		}
 		client := o.newImageRemover(sess)
+		if app.SharedRepository {
+			// Only delete the images of the workload from the repository shared by the application.
+			if err := client.ClearImagesWithTagPrefix(repoName, stack.SharedRepositoryImageTag(o.name, "")); err != nil {
+				return err
+			}
+			continue
+		}
 		if err := client.ClearRepository(repoName); err != nil {
 			return err
 		}
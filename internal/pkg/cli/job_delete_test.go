@@ -345,8 +345,9 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 					mocks.ecs.EXPECT().StopWorkloadTasks(mockAppName, mockEnvName, mockJobName).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtJobTasksStopComplete, mockJobName, mockEnvName)),
 
-					mocks.sessProvider.EXPECT().DefaultWithRegion(gomock.Any()).Return(&session.Session{}, nil),
 					// emptyECRRepos
+					mocks.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil),
+					mocks.sessProvider.EXPECT().DefaultWithRegion(gomock.Any()).Return(&session.Session{}, nil),
 					mocks.ecr.EXPECT().ClearRepository(mockRepo).Return(nil),
 					// removeJobFromApp
 					mocks.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil),
@@ -360,6 +361,44 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 			},
 			wantedError: nil,
 		},
+		"only delete the images of the job from a shared repository": {
+			inAppName: mockAppName,
+			inJobName: mockJobName,
+			setupMocks: func(mocks deleteJobMocks) {
+				sharedApp := &config.Application{
+					Name:             mockAppName,
+					SharedRepository: true,
+				}
+				gomock.InOrder(
+					// appEnvironments
+					mocks.store.EXPECT().ListEnvironments(gomock.Eq(mockAppName)).Times(1).Return(mockEnvs, nil),
+
+					mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil),
+					// deleteStacks
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtJobStackDeleteStart, mockJobName, mockEnvName)),
+					mocks.jobCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtJobStackDeleteComplete, mockJobName, mockEnvName)),
+					// delete orphan tasks
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtJobTasksStopStart, mockJobName, mockEnvName)),
+					mocks.ecs.EXPECT().StopWorkloadTasks(mockAppName, mockEnvName, mockJobName).Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtJobTasksStopComplete, mockJobName, mockEnvName)),
+
+					// emptyECRRepos
+					mocks.store.EXPECT().GetApplication(mockAppName).Return(sharedApp, nil),
+					mocks.sessProvider.EXPECT().DefaultWithRegion(gomock.Any()).Return(&session.Session{}, nil),
+					mocks.ecr.EXPECT().ClearImagesWithTagPrefix(mockAppName, "resizer-").Return(nil),
+					// removeJobFromApp
+					mocks.store.EXPECT().GetApplication(mockAppName).Return(sharedApp, nil),
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtJobDeleteResourcesStart, mockJobName, mockAppName)),
+					mocks.appCFN.EXPECT().RemoveJobFromApp(sharedApp, mockJobName).Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtJobDeleteResourcesComplete, mockJobName, mockAppName)),
+
+					// deleteSSMParam
+					mocks.store.EXPECT().DeleteJob(mockAppName, mockJobName).Return(nil),
+				)
+				mocks.ecr.EXPECT().ClearRepository(gomock.Any()).Times(0)
+			},
+		},
 		// A job can be deployed to multiple
 		// environments - and deleting it in one
 		// should not delete it form the entire app.
@@ -4,16 +4,20 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
@@ -23,11 +27,19 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 )
 
+const (
+	// nextScheduledRunsCount is the number of upcoming runs shown before a job is deployed.
+	nextScheduledRunsCount = 3
+
+	jobDeployRecreateHelpPrompt = "A stack in ROLLBACK_COMPLETE state cannot be updated, it must be deleted before the job can be deployed again."
+)
+
 type deployJobOpts struct {
 	deployWkldVars
 
@@ -36,16 +48,24 @@ type deployJobOpts struct {
 	unmarshal            func(in []byte) (manifest.WorkloadManifest, error)
 	newInterpolator      func(app, env string) interpolator
 	cmd                  execRunner
+	fs                   afero.Fs
 	sessProvider         *sessions.Provider
 	newJobDeployer       func() (workloadDeployer, error)
 	envFeaturesDescriber versionCompatibilityChecker
 	sel                  wsSelector
+	prompt               prompter
+	now                  func() time.Time
+
+	progressOut    termprogress.FileWriter // Optional. Where to render the progress of the deployment, defaults to os.Stderr.
+	nonInteractive bool                    // Fail instead of prompting, since the job is deployed alongside others.
 
 	// cached variables
 	targetApp       *config.Application
 	targetEnv       *config.Environment
 	appliedManifest interface{}
 	rootUserARN     string
+	deployerARN     string
+	commit          string // Git commit of the workspace, recorded with each deployment.
 }
 
 func newJobDeployOpts(vars deployWkldVars) (*deployJobOpts, error) {
@@ -68,9 +88,12 @@ func newJobDeployOpts(vars deployWkldVars) (*deployJobOpts, error) {
 		ws:              ws,
 		unmarshal:       manifest.UnmarshalWorkload,
 		sel:             selector.NewLocalWorkloadSelector(prompter, store, ws),
+		prompt:          prompter,
 		sessProvider:    sessProvider,
 		newInterpolator: newManifestInterpolator,
 		cmd:             exec.NewCmd(),
+		fs:              afero.NewOsFs(),
+		now:             time.Now,
 	}
 	opts.newJobDeployer = func() (workloadDeployer, error) {
 		// NOTE: Defined as a struct member to facilitate unit testing.
@@ -84,14 +107,32 @@ func newJobDeployer(o *deployJobOpts) (workloadDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("read manifest file for %s: %w", o.name, err)
 	}
+	wsPath, err := o.ws.Path()
+	if err != nil {
+		return nil, fmt.Errorf("get workspace path: %w", err)
+	}
+	tag, err := imageTag(&imageTagInput{
+		userTag: o.imageTag,
+		mft:     o.appliedManifest,
+		runner:  o.cmd,
+		fs:      o.fs,
+		wsPath:  wsPath,
+		now:     time.Now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	o.imageTag = tag
 	in := deploy.WorkloadDeployerInput{
 		SessionProvider: o.sessProvider,
 		Name:            o.name,
 		App:             o.targetApp,
 		Env:             o.targetEnv,
 		ImageTag:        o.imageTag,
+		ExtraImageTags:  o.extraImageTags,
 		Mft:             o.appliedManifest,
 		RawMft:          raw,
+		ProgressOut:     o.progressOut,
 	}
 	var deployer workloadDeployer
 	switch t := o.appliedManifest.(type) {
@@ -121,7 +162,12 @@ func (o *deployJobOpts) Validate() error {
 			return err
 		}
 	}
-	return nil
+	if o.schedule != "" {
+		if _, err := stack.ScheduleExpression(o.schedule); err != nil {
+			return fmt.Errorf("validate --%s: %w", scheduleFlag, err)
+		}
+	}
+	return o.validatePackagedTemplate()
 }
 
 // Ask prompts the user for any required fields that are not provided.
@@ -153,6 +199,12 @@ func (o *deployJobOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	if o.templatePath == "" {
+		// A packaged template is deployed verbatim, with the schedule it was generated with.
+		if err := o.validateSchedule(mft); err != nil {
+			return err
+		}
+	}
 	o.appliedManifest = mft
 	if err := validateWorkloadManifestCompatibilityWithEnv(o.ws, o.envFeaturesDescriber, mft, o.envName); err != nil {
 		return err
@@ -170,23 +222,49 @@ func (o *deployJobOpts) Execute() error {
 		log.Warningf(`Scheduled Job might not be available in region %s; proceed with caution.
 `, o.targetEnv.Region)
 	}
-	uploadOut, err := deployer.UploadArtifacts()
-	if err != nil {
+	uploadOut := &deploy.UploadArtifactsOutput{}
+	deployOpts := deploy.Options{
+		DisableRollback:         o.disableRollback,
+		RecreateRolledBackStack: o.forceNewUpdate,
+	}
+	if o.templatePath != "" {
+		// The artifacts referenced by a packaged template were uploaded when it was generated.
+		if deployOpts.Packaged, err = readPackagedTemplate(o.fs, o.templatePath, o.paramsPath); err != nil {
+			return err
+		}
+	} else if uploadOut, err = deployer.UploadArtifacts(); err != nil {
 		return fmt.Errorf("upload deploy resources for job %s: %w", o.name, err)
 	}
-	if _, err = deployer.DeployWorkload(&deploy.DeployWorkloadInput{
+	deployIn := &deploy.DeployWorkloadInput{
 		StackRuntimeConfiguration: deploy.StackRuntimeConfiguration{
 			ImageDigest:        uploadOut.ImageDigest,
 			EnvFileARN:         uploadOut.EnvFileARN,
 			AddonsURL:          uploadOut.AddonsURL,
 			RootUserARN:        o.rootUserARN,
+			Deployer:           o.deployerARN,
+			Commit:             o.commit,
 			Tags:               tags.Merge(o.targetApp.Tags, o.resourceTags),
 			CustomResourceURLs: uploadOut.CustomResourceURLs,
 		},
-		Options: deploy.Options{
-			DisableRollback: o.disableRollback,
-		},
-	}); err != nil {
+		Options: deployOpts,
+	}
+	_, err = deployer.DeployWorkload(deployIn)
+	var errRollbackComplete *deploycfn.ErrStackRollbackComplete
+	if errors.As(err, &errRollbackComplete) && o.nonInteractive {
+		err = fmt.Errorf("%w: run with --%s to delete and recreate it", err, forceFlag)
+	} else if errors.As(err, &errRollbackComplete) {
+		recreate, promptErr := o.prompt.Confirm(fmt.Sprintf(fmtSvcDeployRecreatePrompt, errRollbackComplete.StackName), jobDeployRecreateHelpPrompt)
+		if promptErr != nil {
+			return fmt.Errorf("confirm recreating stack %s: %w", errRollbackComplete.StackName, promptErr)
+		}
+		if recreate {
+			deployIn.Options.RecreateRolledBackStack = true
+			_, err = deployer.DeployWorkload(deployIn)
+		} else {
+			log.Infof("Run %s to delete and recreate the stack.\n", color.HighlightCode("copilot job deploy --force"))
+		}
+	}
+	if err != nil {
 		if o.disableRollback {
 			stackName := stack.NameForService(o.targetApp.Name, o.targetEnv.Name, o.name)
 			rollbackCmd := fmt.Sprintf("aws cloudformation rollback-stack --stack-name %s --role-arn %s", stackName, o.targetEnv.ExecutionRoleARN)
@@ -202,8 +280,39 @@ After fixing the deployment, you can:
 	return nil
 }
 
+// validateSchedule overrides the schedule of the job with the --schedule flag if it's set,
+// validates the schedule and shows the next times at which the job runs.
+func (o *deployJobOpts) validateSchedule(mft manifest.WorkloadManifest) error {
+	job, ok := mft.(*manifest.ScheduledJob)
+	if !ok {
+		return nil
+	}
+	if o.schedule != "" {
+		job.On.Schedule = aws.String(o.schedule)
+	}
+	expr, err := stack.ScheduleExpression(aws.StringValue(job.On.Schedule))
+	if err != nil {
+		return fmt.Errorf("validate schedule of job %s: %w", o.name, err)
+	}
+	runs, err := stack.NextScheduledRuns(expr, o.now(), nextScheduledRunsCount)
+	if err != nil {
+		return fmt.Errorf("compute the next runs of job %s: %w", o.name, err)
+	}
+	switch {
+	case expr == stack.DisabledScheduleExpression:
+		log.Infof("Job %s is disabled and won't run on a schedule.\n", color.HighlightUserInput(o.name))
+	case len(runs) == 0:
+		log.Infof("Job %s runs on the schedule %s.\n", color.HighlightUserInput(o.name), color.HighlightCode(expr))
+	default:
+		log.Infof("Job %s runs on the schedule %s. The next %d runs are at:\n", color.HighlightUserInput(o.name), color.HighlightCode(expr), len(runs))
+		for _, run := range runs {
+			log.Infof("  - %s\n", run.Format(time.RFC1123))
+		}
+	}
+	return nil
+}
+
 func (o *deployJobOpts) configureClients() error {
-	o.imageTag = imageTagFromGit(o.cmd, o.imageTag) // Best effort assign git tag.
 	env, err := o.store.GetEnvironment(o.appName, o.envName)
 	if err != nil {
 		return err
@@ -227,6 +336,8 @@ func (o *deployJobOpts) configureClients() error {
 		return fmt.Errorf("get identity: %w", err)
 	}
 	o.rootUserARN = caller.RootUserARN
+	o.deployerARN = caller.ARN
+	o.commit = deployedGitCommit(o.cmd)
 
 	envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 		App:         o.appName,
@@ -302,7 +413,11 @@ func buildJobDeployCmd() *cobra.Command {
   Deploys a job named "report-gen" to a "test" environment.
   /code $ copilot job deploy --name report-gen --env test
   Deploys a job with additional resource tags.
-  /code $ copilot job deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot job deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Deploys a job with a schedule that overrides the one in the manifest.
+  /code $ copilot job deploy --name report-gen --env test --schedule "rate(10 minutes)"
+  Deploys the template and configuration generated by "copilot job package --output-dir infrastructure --upload-assets".
+  /code $ copilot job deploy --name report-gen --env prod --template infrastructure/report-gen-prod.stack.yml --params infrastructure/report-gen-prod.params.json`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newJobDeployOpts(vars)
 			if err != nil {
@@ -314,9 +429,13 @@ func buildJobDeployCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
-	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
+	cmd.Flags().Var(newImageTagsFlag(&vars.imageTag, &vars.extraImageTags), imageTagFlag, imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, jobForceFlagDescription)
 	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
+	cmd.Flags().StringVar(&vars.templatePath, templateFlag, "", packagedTemplateFlagDescription)
+	cmd.Flags().StringVar(&vars.paramsPath, paramsFlag, "", packagedParamsFlagDescription)
+	cmd.Flags().StringVar(&vars.schedule, scheduleFlag, "", jobDeployScheduleFlagDescription)
 
 	return cmd
 }
@@ -7,12 +7,16 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
 
@@ -22,6 +26,10 @@ func TestJobDeployOpts_Validate(t *testing.T) {
 		inEnvName string
 		inJobName string
 
+		inTemplatePath string
+		inParamsPath   string
+		inSchedule     string
+
 		mockWs    func(m *mocks.MockwsWlDirReader)
 		mockStore func(m *mocks.Mockstore)
 
@@ -64,6 +72,32 @@ func TestJobDeployOpts_Validate(t *testing.T) {
 
 			wantedError: errors.New("get environment test configuration: unknown env"),
 		},
+		"error if --template is used without --params": {
+			inAppName:      "phonetool",
+			inTemplatePath: "infrastructure/resizer-test.stack.yml",
+			mockWs:         func(m *mocks.MockwsWlDirReader) {},
+			mockStore:      func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("--template and --params must be specified together"),
+		},
+		"error if the schedule override is invalid": {
+			inAppName:  "phonetool",
+			inSchedule: "rate(1 hours)",
+			mockWs:     func(m *mocks.MockwsWlDirReader) {},
+			mockStore:  func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New(`validate --schedule: schedule is not valid cron, rate, or preset: rate expression "rate(1 hours)" must use the singular unit "hour" for a value of 1`),
+		},
+		"error if the schedule is overridden for a packaged template": {
+			inAppName:      "phonetool",
+			inSchedule:     "@daily",
+			inTemplatePath: "infrastructure/resizer-test.stack.yml",
+			inParamsPath:   "infrastructure/resizer-test.params.json",
+			mockWs:         func(m *mocks.MockwsWlDirReader) {},
+			mockStore:      func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("cannot specify both --template and --schedule"),
+		},
 		"successful validation": {
 			inAppName: "phonetool",
 			inJobName: "resizer",
@@ -90,9 +124,12 @@ func TestJobDeployOpts_Validate(t *testing.T) {
 			tc.mockStore(mockStore)
 			opts := deployJobOpts{
 				deployWkldVars: deployWkldVars{
-					appName: tc.inAppName,
-					name:    tc.inJobName,
-					envName: tc.inEnvName,
+					appName:      tc.inAppName,
+					name:         tc.inJobName,
+					envName:      tc.inEnvName,
+					templatePath: tc.inTemplatePath,
+					paramsPath:   tc.inParamsPath,
+					schedule:     tc.inSchedule,
 				},
 				ws:    mockWs,
 				store: mockStore,
@@ -195,7 +232,11 @@ func TestJobDeployOpts_Execute(t *testing.T) {
 	)
 	mockError := errors.New("some error")
 	testCases := map[string]struct {
-		mock func(m *deployMocks)
+		inTemplatePath   string
+		inParamsPath     string
+		inForce          bool
+		inNonInteractive bool
+		mock             func(m *deployMocks)
 
 		wantedError error
 	}{
@@ -272,6 +313,112 @@ func TestJobDeployOpts_Execute(t *testing.T) {
 
 			wantedError: fmt.Errorf("deploy job upload to environment prod-iad: some error"),
 		},
+		"recreate the stack with --force if its first deployment was rolled back": {
+			inForce: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockJobName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).DoAndReturn(func(in *deploy.DeployWorkloadInput) (deploy.ActionRecommender, error) {
+					require.True(t, in.Options.RecreateRolledBackStack)
+					return nil, nil
+				})
+				m.mockPrompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"recreate a rolled back stack if the user confirms": {
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockJobName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				gomock.InOrder(
+					m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Return(nil, &deploycfn.ErrStackRollbackComplete{
+						StackName: "phonetool-prod-iad-upload",
+					}),
+					m.mockPrompt.EXPECT().Confirm("The stack phonetool-prod-iad-upload failed to be created and was rolled back. Would you like to delete and recreate it?", gomock.Any()).Return(true, nil),
+					m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).DoAndReturn(func(in *deploy.DeployWorkloadInput) (deploy.ActionRecommender, error) {
+						require.True(t, in.Options.RecreateRolledBackStack)
+						return nil, nil
+					}),
+				)
+			},
+		},
+		"error if the user declines to recreate a rolled back stack": {
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockJobName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Return(nil, &deploycfn.ErrStackRollbackComplete{
+					StackName: "phonetool-prod-iad-upload",
+				})
+				m.mockPrompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+
+			wantedError: fmt.Errorf("deploy job upload to environment prod-iad: stack phonetool-prod-iad-upload failed to be created and is in ROLLBACK_COMPLETE state"),
+		},
+		"error instead of prompting to recreate a rolled back stack when deployed alongside other workloads": {
+			inNonInteractive: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockJobName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Return(nil, &deploycfn.ErrStackRollbackComplete{
+					StackName: "phonetool-prod-iad-upload",
+				})
+				m.mockPrompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			wantedError: fmt.Errorf("deploy job upload to environment prod-iad: stack phonetool-prod-iad-upload failed to be created and is in ROLLBACK_COMPLETE state: run with --force to delete and recreate it"),
+		},
+		"deploy the packaged template without uploading artifacts": {
+			inTemplatePath: "infrastructure/upload-prod-iad.stack.yml",
+			inParamsPath:   "infrastructure/upload-prod-iad.params.json",
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockJobName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Times(0)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).DoAndReturn(func(in *deploy.DeployWorkloadInput) (deploy.ActionRecommender, error) {
+					require.Equal(t, "Resources: {}\n", in.Options.Packaged.Template)
+					require.Equal(t, `{"Parameters": {}}`, in.Options.Packaged.Configuration)
+					return nil, nil
+				})
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -285,18 +432,27 @@ func TestJobDeployOpts_Execute(t *testing.T) {
 				mockInterpolator:         mocks.NewMockinterpolator(ctrl),
 				mockWsReader:             mocks.NewMockwsWlDirReader(ctrl),
 				mockEnvFeaturesDescriber: mocks.NewMockversionCompatibilityChecker(ctrl),
+				mockPrompt:               mocks.NewMockprompter(ctrl),
 			}
 			tc.mock(m)
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "infrastructure/upload-prod-iad.stack.yml", []byte("Resources: {}\n"), 0644))
+			require.NoError(t, afero.WriteFile(fs, "infrastructure/upload-prod-iad.params.json", []byte(`{"Parameters": {}}`), 0644))
 
 			opts := deployJobOpts{
 				deployWkldVars: deployWkldVars{
-					appName: mockAppName,
-					name:    mockJobName,
-					envName: mockEnvName,
+					appName:        mockAppName,
+					name:           mockJobName,
+					envName:        mockEnvName,
+					templatePath:   tc.inTemplatePath,
+					paramsPath:     tc.inParamsPath,
+					forceNewUpdate: tc.inForce,
 
 					clientConfigured: true,
 				},
-				ws: m.mockWsReader,
+				fs:     fs,
+				ws:     m.mockWsReader,
+				prompt: m.mockPrompt,
 				newJobDeployer: func() (workloadDeployer, error) {
 					return m.mockDeployer, nil
 				},
@@ -308,8 +464,9 @@ func TestJobDeployOpts_Execute(t *testing.T) {
 				},
 				envFeaturesDescriber: m.mockEnvFeaturesDescriber,
 
-				targetApp: &config.Application{},
-				targetEnv: &config.Environment{},
+				targetApp:      &config.Application{},
+				targetEnv:      &config.Environment{},
+				nonInteractive: tc.inNonInteractive,
 			}
 
 			// WHEN
@@ -324,3 +481,60 @@ func TestJobDeployOpts_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestJobDeployOpts_validateSchedule(t *testing.T) {
+	now := time.Date(2022, 11, 23, 18, 30, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inSchedule    string
+		inMftSchedule string
+
+		wantedSchedule string
+		wantedError    error
+	}{
+		"keep the schedule of the manifest": {
+			inMftSchedule:  "@daily",
+			wantedSchedule: "@daily",
+		},
+		"override the schedule of the manifest": {
+			inSchedule:     "rate(30 minutes)",
+			inMftSchedule:  "@daily",
+			wantedSchedule: "rate(30 minutes)",
+		},
+		"error if the schedule of the manifest is invalid": {
+			inMftSchedule: "cron(0 9 * * 2-6 *)",
+			wantedError:   errors.New(`validate schedule of job report-gen: schedule is not valid cron, rate, or preset: cron expression "cron(0 9 * * 2-6 *)" must use "?" for either day-of-month or day-of-week`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mft := &manifest.ScheduledJob{
+				ScheduledJobConfig: manifest.ScheduledJobConfig{
+					On: manifest.JobTriggerConfig{
+						Schedule: aws.String(tc.inMftSchedule),
+					},
+				},
+			}
+			opts := deployJobOpts{
+				deployWkldVars: deployWkldVars{
+					name:     "report-gen",
+					schedule: tc.inSchedule,
+				},
+				now: func() time.Time {
+					return now
+				},
+			}
+
+			// WHEN
+			err := opts.validateSchedule(mft)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSchedule, aws.StringValue(mft.On.Schedule))
+		})
+	}
+}
@@ -67,11 +67,16 @@ func newJobLogOpts(vars jobLogsVars) (*jobLogsOpts, error) {
 		if err != nil {
 			return err
 		}
+		logGroup, err := opts.wkldLogGroup(opts.appName, opts.envName, opts.name)
+		if err != nil {
+			return err
+		}
 		opts.logsSvc, err = logging.NewServiceClient(&logging.NewServiceLogsConfig{
-			Sess: sess,
-			App:  opts.appName,
-			Env:  opts.envName,
-			Svc:  opts.name,
+			Sess:     sess,
+			App:      opts.appName,
+			Env:      opts.envName,
+			Svc:      opts.name,
+			LogGroup: logGroup,
 		})
 		if err != nil {
 			return err
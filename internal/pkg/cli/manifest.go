@@ -0,0 +1,27 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/spf13/cobra"
+)
+
+// BuildManifestCmd is the top level command for the manifests of the workloads in the workspace.
+func BuildManifestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "manifest",
+		Short: `Commands for workload manifests.
+Manifests can be written in YAML, or in CUE or Jsonnet files that render to YAML.`,
+	}
+
+	cmd.AddCommand(buildManifestRenderCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Extend,
+	}
+	return cmd
+}
@@ -0,0 +1,123 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	manifestRenderNamePrompt     = "Which workload's manifest would you like to render?"
+	manifestRenderNameHelpPrompt = "The manifest of the workload is evaluated to YAML if it is written in CUE or Jsonnet."
+)
+
+type renderManifestVars struct {
+	name string
+}
+
+type renderManifestOpts struct {
+	renderManifestVars
+
+	ws     wsManifestRenderer
+	prompt prompter
+	w      io.Writer
+}
+
+func newRenderManifestOpts(vars renderManifestVars) (*renderManifestOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	return &renderManifestOpts{
+		renderManifestVars: vars,
+		ws:                 ws,
+		prompt:             prompt.New(),
+		w:                  os.Stdout,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *renderManifestOpts) Validate() error {
+	if o.name == "" {
+		return nil
+	}
+	names, err := o.ws.ListWorkloads()
+	if err != nil {
+		return fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	for _, name := range names {
+		if name == o.name {
+			return nil
+		}
+	}
+	return fmt.Errorf("workload %s does not have a manifest in the workspace", o.name)
+}
+
+// Ask prompts for the workload if it's not provided.
+func (o *renderManifestOpts) Ask() error {
+	if o.name != "" {
+		return nil
+	}
+	names, err := o.ws.ListWorkloads()
+	if err != nil {
+		return fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	switch len(names) {
+	case 0:
+		return errors.New("no workloads found in the workspace")
+	case 1:
+		o.name = names[0]
+		return nil
+	}
+	name, err := o.prompt.SelectOne(manifestRenderNamePrompt, manifestRenderNameHelpPrompt, names, prompt.WithFinalMessage("Workload name:"))
+	if err != nil {
+		return fmt.Errorf("select workload: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute writes the manifest of the workload as the YAML document that Copilot deploys.
+func (o *renderManifestOpts) Execute() error {
+	raw, err := o.ws.ReadWorkloadManifest(o.name)
+	if err != nil {
+		return fmt.Errorf("read manifest file for %s: %w", o.name, err)
+	}
+	if _, err := o.w.Write(raw); err != nil {
+		return fmt.Errorf("write manifest of %s: %w", o.name, err)
+	}
+	return nil
+}
+
+// buildManifestRenderCmd builds the command for rendering the manifest of a workload.
+func buildManifestRenderCmd() *cobra.Command {
+	vars := renderManifestVars{}
+	cmd := &cobra.Command{
+		Use:   "render",
+		Short: "Prints the manifest of a service or job as YAML.",
+		Long: `Prints the manifest of a service or job as YAML.
+Manifests written in CUE (manifest.cue) or Jsonnet (manifest.jsonnet) are evaluated with the cue or jsonnet command.`,
+		Example: `
+  Prints the rendered manifest of the "api" service.
+  /code $ copilot manifest render -n api
+  Saves the rendered manifest to a file.
+  /code $ copilot manifest render -n api > api.yml`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRenderManifestOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", workloadFlagDescription)
+	return cmd
+}
@@ -0,0 +1,209 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type renderManifestMocks struct {
+	ws     *mocks.MockwsManifestRenderer
+	prompt *mocks.Mockprompter
+}
+
+func TestRenderManifestOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(m renderManifestMocks)
+
+		wantedError error
+	}{
+		"skip validation if the name is not provided": {
+			setupMocks: func(m renderManifestMocks) {},
+		},
+		"error if the workloads can't be listed": {
+			inName: "api",
+			setupMocks: func(m renderManifestMocks) {
+				m.ws.EXPECT().ListWorkloads().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list workloads in the workspace: some error"),
+		},
+		"error if the workload is not in the workspace": {
+			inName: "api",
+			setupMocks: func(m renderManifestMocks) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"frontend"}, nil)
+			},
+			wantedError: errors.New("workload api does not have a manifest in the workspace"),
+		},
+		"success": {
+			inName: "api",
+			setupMocks: func(m renderManifestMocks) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"frontend", "api"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := renderManifestMocks{
+				ws: mocks.NewMockwsManifestRenderer(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &renderManifestOpts{
+				renderManifestVars: renderManifestVars{
+					name: tc.inName,
+				},
+				ws: m.ws,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRenderManifestOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(m renderManifestMocks)
+
+		wantedName  string
+		wantedError error
+	}{
+		"skip prompting if the name is provided": {
+			inName:     "api",
+			setupMocks: func(m renderManifestMocks) {},
+			wantedName: "api",
+		},
+		"error if there are no workloads": {
+			setupMocks: func(m renderManifestMocks) {
+				m.ws.EXPECT().ListWorkloads().Return(nil, nil)
+			},
+			wantedError: errors.New("no workloads found in the workspace"),
+		},
+		"default to the only workload": {
+			setupMocks: func(m renderManifestMocks) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedName: "api",
+		},
+		"error if the selection fails": {
+			setupMocks: func(m renderManifestMocks) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api", "frontend"}, nil)
+				m.prompt.EXPECT().SelectOne(manifestRenderNamePrompt, manifestRenderNameHelpPrompt, []string{"api", "frontend"}, gomock.Any()).
+					Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select workload: some error"),
+		},
+		"prompt for the workload": {
+			setupMocks: func(m renderManifestMocks) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api", "frontend"}, nil)
+				m.prompt.EXPECT().SelectOne(manifestRenderNamePrompt, manifestRenderNameHelpPrompt, []string{"api", "frontend"}, gomock.Any()).
+					Return("frontend", nil)
+			},
+			wantedName: "frontend",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := renderManifestMocks{
+				ws:     mocks.NewMockwsManifestRenderer(ctrl),
+				prompt: mocks.NewMockprompter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &renderManifestOpts{
+				renderManifestVars: renderManifestVars{
+					name: tc.inName,
+				},
+				ws:     m.ws,
+				prompt: m.prompt,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.name)
+		})
+	}
+}
+
+func TestRenderManifestOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m renderManifestMocks)
+
+		wanted      string
+		wantedError error
+	}{
+		"error if the manifest can't be rendered": {
+			setupMocks: func(m renderManifestMocks) {
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return(nil, errors.New("run cue: exit status 1"))
+			},
+			wantedError: errors.New("read manifest file for api: run cue: exit status 1"),
+		},
+		"writes the rendered manifest": {
+			setupMocks: func(m renderManifestMocks) {
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte("name: api\ntype: Backend Service\n"), nil)
+			},
+			wanted: "name: api\ntype: Backend Service\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := renderManifestMocks{
+				ws: mocks.NewMockwsManifestRenderer(ctrl),
+			}
+			tc.setupMocks(m)
+			buf := new(bytes.Buffer)
+			opts := &renderManifestOpts{
+				renderManifestVars: renderManifestVars{
+					name: "api",
+				},
+				ws: m.ws,
+				w:  buf,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, buf.String())
+		})
+	}
+}
@@ -1,26 +1,35 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: ./internal/pkg/cli/interfaces.go
+// Source: ./cli/interfaces.go
 
 // Package mocks is a generated GoMock package.
 package mocks
 
 import (
+	context "context"
 	encoding "encoding"
 	reflect "reflect"
 
 	session "github.com/aws/aws-sdk-go/aws/session"
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	cloudwatch "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	ecr "github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	evidently "github.com/aws/copilot-cli/internal/pkg/aws/evidently"
+	health "github.com/aws/copilot-cli/internal/pkg/aws/health"
+	route53 "github.com/aws/copilot-cli/internal/pkg/aws/route53"
+	s3 "github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	secretsmanager "github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	ssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	catalog "github.com/aws/copilot-cli/internal/pkg/catalog"
 	deploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
 	deploy0 "github.com/aws/copilot-cli/internal/pkg/deploy"
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	stack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	describe "github.com/aws/copilot-cli/internal/pkg/describe"
+	stack0 "github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	dockerengine "github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	dockerfile "github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
 	ecs0 "github.com/aws/copilot-cli/internal/pkg/ecs"
@@ -28,6 +37,7 @@ import (
 	initialize "github.com/aws/copilot-cli/internal/pkg/initialize"
 	logging "github.com/aws/copilot-cli/internal/pkg/logging"
 	manifest "github.com/aws/copilot-cli/internal/pkg/manifest"
+	probe "github.com/aws/copilot-cli/internal/pkg/probe"
 	repository "github.com/aws/copilot-cli/internal/pkg/repository"
 	task "github.com/aws/copilot-cli/internal/pkg/task"
 	progress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -796,6 +806,20 @@ func (mr *MockenvironmentStoreMockRecorder) ListEnvironments(appName interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockenvironmentStore)(nil).ListEnvironments), appName)
 }
 
+// UpdateEnvironment mocks base method.
+func (m *MockenvironmentStore) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment.
+func (mr *MockenvironmentStoreMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockenvironmentStore)(nil).UpdateEnvironment), env)
+}
+
 // MockenvironmentCreator is a mock of environmentCreator interface.
 type MockenvironmentCreator struct {
 	ctrl     *gomock.Controller
@@ -833,6 +857,43 @@ func (mr *MockenvironmentCreatorMockRecorder) CreateEnvironment(env interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEnvironment", reflect.TypeOf((*MockenvironmentCreator)(nil).CreateEnvironment), env)
 }
 
+// MockenvironmentUpdater is a mock of environmentUpdater interface.
+type MockenvironmentUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvironmentUpdaterMockRecorder
+}
+
+// MockenvironmentUpdaterMockRecorder is the mock recorder for MockenvironmentUpdater.
+type MockenvironmentUpdaterMockRecorder struct {
+	mock *MockenvironmentUpdater
+}
+
+// NewMockenvironmentUpdater creates a new mock instance.
+func NewMockenvironmentUpdater(ctrl *gomock.Controller) *MockenvironmentUpdater {
+	mock := &MockenvironmentUpdater{ctrl: ctrl}
+	mock.recorder = &MockenvironmentUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvironmentUpdater) EXPECT() *MockenvironmentUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateEnvironment mocks base method.
+func (m *MockenvironmentUpdater) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment.
+func (mr *MockenvironmentUpdaterMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockenvironmentUpdater)(nil).UpdateEnvironment), env)
+}
+
 // MockenvironmentGetter is a mock of environmentGetter interface.
 type MockenvironmentGetter struct {
 	ctrl     *gomock.Controller
@@ -1283,6 +1344,282 @@ func (mr *MockstoreMockRecorder) UpdateApplication(app interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplication", reflect.TypeOf((*Mockstore)(nil).UpdateApplication), app)
 }
 
+// UpdateEnvironment mocks base method.
+func (m *Mockstore) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment.
+func (mr *MockstoreMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*Mockstore)(nil).UpdateEnvironment), env)
+}
+
+// MockdeployedWorkloadsLister is a mock of deployedWorkloadsLister interface.
+type MockdeployedWorkloadsLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployedWorkloadsListerMockRecorder
+}
+
+// MockdeployedWorkloadsListerMockRecorder is the mock recorder for MockdeployedWorkloadsLister.
+type MockdeployedWorkloadsListerMockRecorder struct {
+	mock *MockdeployedWorkloadsLister
+}
+
+// NewMockdeployedWorkloadsLister creates a new mock instance.
+func NewMockdeployedWorkloadsLister(ctrl *gomock.Controller) *MockdeployedWorkloadsLister {
+	mock := &MockdeployedWorkloadsLister{ctrl: ctrl}
+	mock.recorder = &MockdeployedWorkloadsListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeployedWorkloadsLister) EXPECT() *MockdeployedWorkloadsListerMockRecorder {
+	return m.recorder
+}
+
+// ListDeployedJobs mocks base method.
+func (m *MockdeployedWorkloadsLister) ListDeployedJobs(appName, envName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeployedJobs", appName, envName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeployedJobs indicates an expected call of ListDeployedJobs.
+func (mr *MockdeployedWorkloadsListerMockRecorder) ListDeployedJobs(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployedJobs", reflect.TypeOf((*MockdeployedWorkloadsLister)(nil).ListDeployedJobs), appName, envName)
+}
+
+// ListDeployedServices mocks base method.
+func (m *MockdeployedWorkloadsLister) ListDeployedServices(appName, envName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeployedServices", appName, envName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeployedServices indicates an expected call of ListDeployedServices.
+func (mr *MockdeployedWorkloadsListerMockRecorder) ListDeployedServices(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployedServices", reflect.TypeOf((*MockdeployedWorkloadsLister)(nil).ListDeployedServices), appName, envName)
+}
+
+// MockscheduledChangesLister is a mock of scheduledChangesLister interface.
+type MockscheduledChangesLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockscheduledChangesListerMockRecorder
+}
+
+// MockscheduledChangesListerMockRecorder is the mock recorder for MockscheduledChangesLister.
+type MockscheduledChangesListerMockRecorder struct {
+	mock *MockscheduledChangesLister
+}
+
+// NewMockscheduledChangesLister creates a new mock instance.
+func NewMockscheduledChangesLister(ctrl *gomock.Controller) *MockscheduledChangesLister {
+	mock := &MockscheduledChangesLister{ctrl: ctrl}
+	mock.recorder = &MockscheduledChangesListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockscheduledChangesLister) EXPECT() *MockscheduledChangesListerMockRecorder {
+	return m.recorder
+}
+
+// ScheduledECSChanges mocks base method.
+func (m *MockscheduledChangesLister) ScheduledECSChanges(region string) ([]health.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduledECSChanges", region)
+	ret0, _ := ret[0].([]health.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduledECSChanges indicates an expected call of ScheduledECSChanges.
+func (mr *MockscheduledChangesListerMockRecorder) ScheduledECSChanges(region interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduledECSChanges", reflect.TypeOf((*MockscheduledChangesLister)(nil).ScheduledECSChanges), region)
+}
+
+// MockecsServiceRestarter is a mock of ecsServiceRestarter interface.
+type MockecsServiceRestarter struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsServiceRestarterMockRecorder
+}
+
+// MockecsServiceRestarterMockRecorder is the mock recorder for MockecsServiceRestarter.
+type MockecsServiceRestarterMockRecorder struct {
+	mock *MockecsServiceRestarter
+}
+
+// NewMockecsServiceRestarter creates a new mock instance.
+func NewMockecsServiceRestarter(ctrl *gomock.Controller) *MockecsServiceRestarter {
+	mock := &MockecsServiceRestarter{ctrl: ctrl}
+	mock.recorder = &MockecsServiceRestarterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockecsServiceRestarter) EXPECT() *MockecsServiceRestarterMockRecorder {
+	return m.recorder
+}
+
+// DescribeService mocks base method.
+func (m *MockecsServiceRestarter) DescribeService(app, env, svc string) (*ecs0.ServiceDesc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeService", app, env, svc)
+	ret0, _ := ret[0].(*ecs0.ServiceDesc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeService indicates an expected call of DescribeService.
+func (mr *MockecsServiceRestarterMockRecorder) DescribeService(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeService", reflect.TypeOf((*MockecsServiceRestarter)(nil).DescribeService), app, env, svc)
+}
+
+// ForceUpdateService mocks base method.
+func (m *MockecsServiceRestarter) ForceUpdateService(app, env, svc string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceUpdateService", app, env, svc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForceUpdateService indicates an expected call of ForceUpdateService.
+func (mr *MockecsServiceRestarterMockRecorder) ForceUpdateService(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceUpdateService", reflect.TypeOf((*MockecsServiceRestarter)(nil).ForceUpdateService), app, env, svc)
+}
+
+// MockstackResourcesDescriber is a mock of stackResourcesDescriber interface.
+type MockstackResourcesDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackResourcesDescriberMockRecorder
+}
+
+// MockstackResourcesDescriberMockRecorder is the mock recorder for MockstackResourcesDescriber.
+type MockstackResourcesDescriberMockRecorder struct {
+	mock *MockstackResourcesDescriber
+}
+
+// NewMockstackResourcesDescriber creates a new mock instance.
+func NewMockstackResourcesDescriber(ctrl *gomock.Controller) *MockstackResourcesDescriber {
+	mock := &MockstackResourcesDescriber{ctrl: ctrl}
+	mock.recorder = &MockstackResourcesDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackResourcesDescriber) EXPECT() *MockstackResourcesDescriberMockRecorder {
+	return m.recorder
+}
+
+// Resources mocks base method.
+func (m *MockstackResourcesDescriber) Resources() ([]*stack0.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resources")
+	ret0, _ := ret[0].([]*stack0.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resources indicates an expected call of Resources.
+func (mr *MockstackResourcesDescriberMockRecorder) Resources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockstackResourcesDescriber)(nil).Resources))
+}
+
+// MockfeatureFlagManager is a mock of featureFlagManager interface.
+type MockfeatureFlagManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockfeatureFlagManagerMockRecorder
+}
+
+// MockfeatureFlagManagerMockRecorder is the mock recorder for MockfeatureFlagManager.
+type MockfeatureFlagManagerMockRecorder struct {
+	mock *MockfeatureFlagManager
+}
+
+// NewMockfeatureFlagManager creates a new mock instance.
+func NewMockfeatureFlagManager(ctrl *gomock.Controller) *MockfeatureFlagManager {
+	mock := &MockfeatureFlagManager{ctrl: ctrl}
+	mock.recorder = &MockfeatureFlagManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfeatureFlagManager) EXPECT() *MockfeatureFlagManagerMockRecorder {
+	return m.recorder
+}
+
+// Features mocks base method.
+func (m *MockfeatureFlagManager) Features(project string) ([]evidently.Feature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Features", project)
+	ret0, _ := ret[0].([]evidently.Feature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Features indicates an expected call of Features.
+func (mr *MockfeatureFlagManagerMockRecorder) Features(project interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Features", reflect.TypeOf((*MockfeatureFlagManager)(nil).Features), project)
+}
+
+// Launches mocks base method.
+func (m *MockfeatureFlagManager) Launches(project string) ([]evidently.Launch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Launches", project)
+	ret0, _ := ret[0].([]evidently.Launch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Launches indicates an expected call of Launches.
+func (mr *MockfeatureFlagManagerMockRecorder) Launches(project interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Launches", reflect.TypeOf((*MockfeatureFlagManager)(nil).Launches), project)
+}
+
+// StartLaunch mocks base method.
+func (m *MockfeatureFlagManager) StartLaunch(project, launch string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartLaunch", project, launch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartLaunch indicates an expected call of StartLaunch.
+func (mr *MockfeatureFlagManagerMockRecorder) StartLaunch(project, launch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartLaunch", reflect.TypeOf((*MockfeatureFlagManager)(nil).StartLaunch), project, launch)
+}
+
+// StopLaunch mocks base method.
+func (m *MockfeatureFlagManager) StopLaunch(project, launch string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopLaunch", project, launch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopLaunch indicates an expected call of StopLaunch.
+func (mr *MockfeatureFlagManagerMockRecorder) StopLaunch(project, launch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopLaunch", reflect.TypeOf((*MockfeatureFlagManager)(nil).StopLaunch), project, launch)
+}
+
 // MockdeployedEnvironmentLister is a mock of deployedEnvironmentLister interface.
 type MockdeployedEnvironmentLister struct {
 	ctrl     *gomock.Controller
@@ -1614,98 +1951,226 @@ func (mr *MockrepositoryURIGetterMockRecorder) URI() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URI", reflect.TypeOf((*MockrepositoryURIGetter)(nil).URI))
 }
 
-// MockrepositoryService is a mock of repositoryService interface.
-type MockrepositoryService struct {
+// MockserviceURIDescriber is a mock of serviceURIDescriber interface.
+type MockserviceURIDescriber struct {
 	ctrl     *gomock.Controller
-	recorder *MockrepositoryServiceMockRecorder
+	recorder *MockserviceURIDescriberMockRecorder
 }
 
-// MockrepositoryServiceMockRecorder is the mock recorder for MockrepositoryService.
-type MockrepositoryServiceMockRecorder struct {
-	mock *MockrepositoryService
+// MockserviceURIDescriberMockRecorder is the mock recorder for MockserviceURIDescriber.
+type MockserviceURIDescriberMockRecorder struct {
+	mock *MockserviceURIDescriber
 }
 
-// NewMockrepositoryService creates a new mock instance.
-func NewMockrepositoryService(ctrl *gomock.Controller) *MockrepositoryService {
-	mock := &MockrepositoryService{ctrl: ctrl}
-	mock.recorder = &MockrepositoryServiceMockRecorder{mock}
+// NewMockserviceURIDescriber creates a new mock instance.
+func NewMockserviceURIDescriber(ctrl *gomock.Controller) *MockserviceURIDescriber {
+	mock := &MockserviceURIDescriber{ctrl: ctrl}
+	mock.recorder = &MockserviceURIDescriberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockrepositoryService) EXPECT() *MockrepositoryServiceMockRecorder {
+func (m *MockserviceURIDescriber) EXPECT() *MockserviceURIDescriberMockRecorder {
 	return m.recorder
 }
 
-// BuildAndPush mocks base method.
-func (m *MockrepositoryService) BuildAndPush(docker repository.ContainerLoginBuildPusher, args *dockerengine.BuildArguments) (string, error) {
+// URI mocks base method.
+func (m *MockserviceURIDescriber) URI(env string) (describe.URI, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BuildAndPush", docker, args)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "URI", env)
+	ret0, _ := ret[0].(describe.URI)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// BuildAndPush indicates an expected call of BuildAndPush.
-func (mr *MockrepositoryServiceMockRecorder) BuildAndPush(docker, args interface{}) *gomock.Call {
+// URI indicates an expected call of URI.
+func (mr *MockserviceURIDescriberMockRecorder) URI(env interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndPush", reflect.TypeOf((*MockrepositoryService)(nil).BuildAndPush), docker, args)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URI", reflect.TypeOf((*MockserviceURIDescriber)(nil).URI), env)
+}
+
+// MockreachableSvcDescriber is a mock of reachableSvcDescriber interface.
+type MockreachableSvcDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockreachableSvcDescriberMockRecorder
+}
+
+// MockreachableSvcDescriberMockRecorder is the mock recorder for MockreachableSvcDescriber.
+type MockreachableSvcDescriberMockRecorder struct {
+	mock *MockreachableSvcDescriber
+}
+
+// NewMockreachableSvcDescriber creates a new mock instance.
+func NewMockreachableSvcDescriber(ctrl *gomock.Controller) *MockreachableSvcDescriber {
+	mock := &MockreachableSvcDescriber{ctrl: ctrl}
+	mock.recorder = &MockreachableSvcDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreachableSvcDescriber) EXPECT() *MockreachableSvcDescriberMockRecorder {
+	return m.recorder
+}
+
+// Manifest mocks base method.
+func (m *MockreachableSvcDescriber) Manifest(env string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Manifest", env)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Manifest indicates an expected call of Manifest.
+func (mr *MockreachableSvcDescriberMockRecorder) Manifest(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Manifest", reflect.TypeOf((*MockreachableSvcDescriber)(nil).Manifest), env)
 }
 
 // URI mocks base method.
-func (m *MockrepositoryService) URI() (string, error) {
+func (m *MockreachableSvcDescriber) URI(env string) (describe.URI, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "URI")
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "URI", env)
+	ret0, _ := ret[0].(describe.URI)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // URI indicates an expected call of URI.
-func (mr *MockrepositoryServiceMockRecorder) URI() *gomock.Call {
+func (mr *MockreachableSvcDescriberMockRecorder) URI(env interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URI", reflect.TypeOf((*MockrepositoryService)(nil).URI))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URI", reflect.TypeOf((*MockreachableSvcDescriber)(nil).URI), env)
 }
 
-// MocklogEventsWriter is a mock of logEventsWriter interface.
-type MocklogEventsWriter struct {
+// MockendpointProber is a mock of endpointProber interface.
+type MockendpointProber struct {
 	ctrl     *gomock.Controller
-	recorder *MocklogEventsWriterMockRecorder
+	recorder *MockendpointProberMockRecorder
 }
 
-// MocklogEventsWriterMockRecorder is the mock recorder for MocklogEventsWriter.
-type MocklogEventsWriterMockRecorder struct {
-	mock *MocklogEventsWriter
+// MockendpointProberMockRecorder is the mock recorder for MockendpointProber.
+type MockendpointProberMockRecorder struct {
+	mock *MockendpointProber
 }
 
-// NewMocklogEventsWriter creates a new mock instance.
-func NewMocklogEventsWriter(ctrl *gomock.Controller) *MocklogEventsWriter {
-	mock := &MocklogEventsWriter{ctrl: ctrl}
-	mock.recorder = &MocklogEventsWriterMockRecorder{mock}
+// NewMockendpointProber creates a new mock instance.
+func NewMockendpointProber(ctrl *gomock.Controller) *MockendpointProber {
+	mock := &MockendpointProber{ctrl: ctrl}
+	mock.recorder = &MockendpointProberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocklogEventsWriter) EXPECT() *MocklogEventsWriterMockRecorder {
+func (m *MockendpointProber) EXPECT() *MockendpointProberMockRecorder {
 	return m.recorder
 }
 
-// WriteLogEvents mocks base method.
-func (m *MocklogEventsWriter) WriteLogEvents(opts logging.WriteLogEventsOpts) error {
+// Probe mocks base method.
+func (m *MockendpointProber) Probe(ctx context.Context, endpoints []string, healthCheckPath string) []probe.Result {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WriteLogEvents", opts)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "Probe", ctx, endpoints, healthCheckPath)
+	ret0, _ := ret[0].([]probe.Result)
 	return ret0
 }
 
-// WriteLogEvents indicates an expected call of WriteLogEvents.
-func (mr *MocklogEventsWriterMockRecorder) WriteLogEvents(opts interface{}) *gomock.Call {
+// Probe indicates an expected call of Probe.
+func (mr *MockendpointProberMockRecorder) Probe(ctx, endpoints, healthCheckPath interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteLogEvents", reflect.TypeOf((*MocklogEventsWriter)(nil).WriteLogEvents), opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Probe", reflect.TypeOf((*MockendpointProber)(nil).Probe), ctx, endpoints, healthCheckPath)
 }
 
-// Mocktemplater is a mock of templater interface.
-type Mocktemplater struct {
+// MockrepositoryService is a mock of repositoryService interface.
+type MockrepositoryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockrepositoryServiceMockRecorder
+}
+
+// MockrepositoryServiceMockRecorder is the mock recorder for MockrepositoryService.
+type MockrepositoryServiceMockRecorder struct {
+	mock *MockrepositoryService
+}
+
+// NewMockrepositoryService creates a new mock instance.
+func NewMockrepositoryService(ctrl *gomock.Controller) *MockrepositoryService {
+	mock := &MockrepositoryService{ctrl: ctrl}
+	mock.recorder = &MockrepositoryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrepositoryService) EXPECT() *MockrepositoryServiceMockRecorder {
+	return m.recorder
+}
+
+// BuildAndPush mocks base method.
+func (m *MockrepositoryService) BuildAndPush(docker repository.ContainerLoginBuildPusher, args *dockerengine.BuildArguments) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildAndPush", docker, args)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildAndPush indicates an expected call of BuildAndPush.
+func (mr *MockrepositoryServiceMockRecorder) BuildAndPush(docker, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndPush", reflect.TypeOf((*MockrepositoryService)(nil).BuildAndPush), docker, args)
+}
+
+// URI mocks base method.
+func (m *MockrepositoryService) URI() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "URI")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// URI indicates an expected call of URI.
+func (mr *MockrepositoryServiceMockRecorder) URI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URI", reflect.TypeOf((*MockrepositoryService)(nil).URI))
+}
+
+// MocklogEventsWriter is a mock of logEventsWriter interface.
+type MocklogEventsWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MocklogEventsWriterMockRecorder
+}
+
+// MocklogEventsWriterMockRecorder is the mock recorder for MocklogEventsWriter.
+type MocklogEventsWriterMockRecorder struct {
+	mock *MocklogEventsWriter
+}
+
+// NewMocklogEventsWriter creates a new mock instance.
+func NewMocklogEventsWriter(ctrl *gomock.Controller) *MocklogEventsWriter {
+	mock := &MocklogEventsWriter{ctrl: ctrl}
+	mock.recorder = &MocklogEventsWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklogEventsWriter) EXPECT() *MocklogEventsWriterMockRecorder {
+	return m.recorder
+}
+
+// WriteLogEvents mocks base method.
+func (m *MocklogEventsWriter) WriteLogEvents(opts logging.WriteLogEventsOpts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteLogEvents", opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteLogEvents indicates an expected call of WriteLogEvents.
+func (mr *MocklogEventsWriterMockRecorder) WriteLogEvents(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteLogEvents", reflect.TypeOf((*MocklogEventsWriter)(nil).WriteLogEvents), opts)
+}
+
+// Mocktemplater is a mock of templater interface.
+type Mocktemplater struct {
 	ctrl     *gomock.Controller
 	recorder *MocktemplaterMockRecorder
 }
@@ -2200,6 +2665,21 @@ func (mr *MockworkloadDescriberMockRecorder) Manifest(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Manifest", reflect.TypeOf((*MockworkloadDescriber)(nil).Manifest), arg0)
 }
 
+// Params mocks base method.
+func (m *MockworkloadDescriber) Params(arg0 string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Params", arg0)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Params indicates an expected call of Params.
+func (mr *MockworkloadDescriberMockRecorder) Params(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MockworkloadDescriber)(nil).Params), arg0)
+}
+
 // MockwsFileDeleter is a mock of wsFileDeleter interface.
 type MockwsFileDeleter struct {
 	ctrl     *gomock.Controller
@@ -2313,6 +2793,74 @@ func (mr *MockenvironmentManifestWriterMockRecorder) WriteEnvironmentManifest(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteEnvironmentManifest", reflect.TypeOf((*MockenvironmentManifestWriter)(nil).WriteEnvironmentManifest), arg0, arg1)
 }
 
+// MockwsPreviewEnvCloner is a mock of wsPreviewEnvCloner interface.
+type MockwsPreviewEnvCloner struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsPreviewEnvClonerMockRecorder
+}
+
+// MockwsPreviewEnvClonerMockRecorder is the mock recorder for MockwsPreviewEnvCloner.
+type MockwsPreviewEnvClonerMockRecorder struct {
+	mock *MockwsPreviewEnvCloner
+}
+
+// NewMockwsPreviewEnvCloner creates a new mock instance.
+func NewMockwsPreviewEnvCloner(ctrl *gomock.Controller) *MockwsPreviewEnvCloner {
+	mock := &MockwsPreviewEnvCloner{ctrl: ctrl}
+	mock.recorder = &MockwsPreviewEnvClonerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsPreviewEnvCloner) EXPECT() *MockwsPreviewEnvClonerMockRecorder {
+	return m.recorder
+}
+
+// ListServices mocks base method.
+func (m *MockwsPreviewEnvCloner) ListServices() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServices")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServices indicates an expected call of ListServices.
+func (mr *MockwsPreviewEnvClonerMockRecorder) ListServices() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockwsPreviewEnvCloner)(nil).ListServices))
+}
+
+// ReadEnvironmentManifest mocks base method.
+func (m *MockwsPreviewEnvCloner) ReadEnvironmentManifest(mftDirName string) (workspace.EnvironmentManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEnvironmentManifest", mftDirName)
+	ret0, _ := ret[0].(workspace.EnvironmentManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadEnvironmentManifest indicates an expected call of ReadEnvironmentManifest.
+func (mr *MockwsPreviewEnvClonerMockRecorder) ReadEnvironmentManifest(mftDirName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentManifest", reflect.TypeOf((*MockwsPreviewEnvCloner)(nil).ReadEnvironmentManifest), mftDirName)
+}
+
+// WriteEnvironmentManifest mocks base method.
+func (m *MockwsPreviewEnvCloner) WriteEnvironmentManifest(arg0 encoding.BinaryMarshaler, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteEnvironmentManifest", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteEnvironmentManifest indicates an expected call of WriteEnvironmentManifest.
+func (mr *MockwsPreviewEnvClonerMockRecorder) WriteEnvironmentManifest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteEnvironmentManifest", reflect.TypeOf((*MockwsPreviewEnvCloner)(nil).WriteEnvironmentManifest), arg0, arg1)
+}
+
 // MockworkspacePathGetter is a mock of workspacePathGetter interface.
 type MockworkspacePathGetter struct {
 	ctrl     *gomock.Controller
@@ -2563,6 +3111,165 @@ func (mr *MockwsSvcReaderMockRecorder) ReadWorkloadManifest(name interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsSvcReader)(nil).ReadWorkloadManifest), name)
 }
 
+// MockwsImageReader is a mock of wsImageReader interface.
+type MockwsImageReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsImageReaderMockRecorder
+}
+
+// MockwsImageReaderMockRecorder is the mock recorder for MockwsImageReader.
+type MockwsImageReaderMockRecorder struct {
+	mock *MockwsImageReader
+}
+
+// NewMockwsImageReader creates a new mock instance.
+func NewMockwsImageReader(ctrl *gomock.Controller) *MockwsImageReader {
+	mock := &MockwsImageReader{ctrl: ctrl}
+	mock.recorder = &MockwsImageReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsImageReader) EXPECT() *MockwsImageReaderMockRecorder {
+	return m.recorder
+}
+
+// ListServices mocks base method.
+func (m *MockwsImageReader) ListServices() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServices")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServices indicates an expected call of ListServices.
+func (mr *MockwsImageReaderMockRecorder) ListServices() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockwsImageReader)(nil).ListServices))
+}
+
+// Path mocks base method.
+func (m *MockwsImageReader) Path() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Path")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Path indicates an expected call of Path.
+func (mr *MockwsImageReaderMockRecorder) Path() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Path", reflect.TypeOf((*MockwsImageReader)(nil).Path))
+}
+
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsImageReader) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsImageReaderMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsImageReader)(nil).ReadWorkloadManifest), name)
+}
+
+// MockimageRegistry is a mock of imageRegistry interface.
+type MockimageRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageRegistryMockRecorder
+}
+
+// MockimageRegistryMockRecorder is the mock recorder for MockimageRegistry.
+type MockimageRegistryMockRecorder struct {
+	mock *MockimageRegistry
+}
+
+// NewMockimageRegistry creates a new mock instance.
+func NewMockimageRegistry(ctrl *gomock.Controller) *MockimageRegistry {
+	mock := &MockimageRegistry{ctrl: ctrl}
+	mock.recorder = &MockimageRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageRegistry) EXPECT() *MockimageRegistryMockRecorder {
+	return m.recorder
+}
+
+// Digest mocks base method.
+func (m *MockimageRegistry) Digest(image, tag string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Digest", image, tag)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Digest indicates an expected call of Digest.
+func (mr *MockimageRegistryMockRecorder) Digest(image, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Digest", reflect.TypeOf((*MockimageRegistry)(nil).Digest), image, tag)
+}
+
+// Tags mocks base method.
+func (m *MockimageRegistry) Tags(image string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tags", image)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Tags indicates an expected call of Tags.
+func (mr *MockimageRegistryMockRecorder) Tags(image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tags", reflect.TypeOf((*MockimageRegistry)(nil).Tags), image)
+}
+
+// MockimageScanner is a mock of imageScanner interface.
+type MockimageScanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageScannerMockRecorder
+}
+
+// MockimageScannerMockRecorder is the mock recorder for MockimageScanner.
+type MockimageScannerMockRecorder struct {
+	mock *MockimageScanner
+}
+
+// NewMockimageScanner creates a new mock instance.
+func NewMockimageScanner(ctrl *gomock.Controller) *MockimageScanner {
+	mock := &MockimageScanner{ctrl: ctrl}
+	mock.recorder = &MockimageScannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageScanner) EXPECT() *MockimageScannerMockRecorder {
+	return m.recorder
+}
+
+// ImageScanFindings mocks base method.
+func (m *MockimageScanner) ImageScanFindings(repoName, tagOrDigest string) (*ecr.ScanFindings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageScanFindings", repoName, tagOrDigest)
+	ret0, _ := ret[0].(*ecr.ScanFindings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageScanFindings indicates an expected call of ImageScanFindings.
+func (mr *MockimageScannerMockRecorder) ImageScanFindings(repoName, tagOrDigest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageScanFindings", reflect.TypeOf((*MockimageScanner)(nil).ImageScanFindings), repoName, tagOrDigest)
+}
+
 // MockjobLister is a mock of jobLister interface.
 type MockjobLister struct {
 	ctrl     *gomock.Controller
@@ -2692,20 +3399,73 @@ func (mr *MockwlListerMockRecorder) ListWorkloads() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwlLister)(nil).ListWorkloads))
 }
 
-// MockwsJobDirReader is a mock of wsJobDirReader interface.
-type MockwsJobDirReader struct {
+// MockwsManifestRenderer is a mock of wsManifestRenderer interface.
+type MockwsManifestRenderer struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsJobDirReaderMockRecorder
+	recorder *MockwsManifestRendererMockRecorder
 }
 
-// MockwsJobDirReaderMockRecorder is the mock recorder for MockwsJobDirReader.
-type MockwsJobDirReaderMockRecorder struct {
-	mock *MockwsJobDirReader
+// MockwsManifestRendererMockRecorder is the mock recorder for MockwsManifestRenderer.
+type MockwsManifestRendererMockRecorder struct {
+	mock *MockwsManifestRenderer
 }
 
-// NewMockwsJobDirReader creates a new mock instance.
-func NewMockwsJobDirReader(ctrl *gomock.Controller) *MockwsJobDirReader {
-	mock := &MockwsJobDirReader{ctrl: ctrl}
+// NewMockwsManifestRenderer creates a new mock instance.
+func NewMockwsManifestRenderer(ctrl *gomock.Controller) *MockwsManifestRenderer {
+	mock := &MockwsManifestRenderer{ctrl: ctrl}
+	mock.recorder = &MockwsManifestRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsManifestRenderer) EXPECT() *MockwsManifestRendererMockRecorder {
+	return m.recorder
+}
+
+// ListWorkloads mocks base method.
+func (m *MockwsManifestRenderer) ListWorkloads() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockwsManifestRendererMockRecorder) ListWorkloads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsManifestRenderer)(nil).ListWorkloads))
+}
+
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsManifestRenderer) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsManifestRendererMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsManifestRenderer)(nil).ReadWorkloadManifest), name)
+}
+
+// MockwsJobDirReader is a mock of wsJobDirReader interface.
+type MockwsJobDirReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsJobDirReaderMockRecorder
+}
+
+// MockwsJobDirReaderMockRecorder is the mock recorder for MockwsJobDirReader.
+type MockwsJobDirReaderMockRecorder struct {
+	mock *MockwsJobDirReader
+}
+
+// NewMockwsJobDirReader creates a new mock instance.
+func NewMockwsJobDirReader(ctrl *gomock.Controller) *MockwsJobDirReader {
+	mock := &MockwsJobDirReader{ctrl: ctrl}
 	mock.recorder = &MockwsJobDirReaderMockRecorder{mock}
 	return mock
 }
@@ -2941,6 +3701,97 @@ func (mr *MockwsEnvironmentReaderMockRecorder) ReadEnvironmentManifest(mftDirNam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentManifest", reflect.TypeOf((*MockwsEnvironmentReader)(nil).ReadEnvironmentManifest), mftDirName)
 }
 
+// MockguardRulesDirGetter is a mock of guardRulesDirGetter interface.
+type MockguardRulesDirGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockguardRulesDirGetterMockRecorder
+}
+
+// MockguardRulesDirGetterMockRecorder is the mock recorder for MockguardRulesDirGetter.
+type MockguardRulesDirGetterMockRecorder struct {
+	mock *MockguardRulesDirGetter
+}
+
+// NewMockguardRulesDirGetter creates a new mock instance.
+func NewMockguardRulesDirGetter(ctrl *gomock.Controller) *MockguardRulesDirGetter {
+	mock := &MockguardRulesDirGetter{ctrl: ctrl}
+	mock.recorder = &MockguardRulesDirGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockguardRulesDirGetter) EXPECT() *MockguardRulesDirGetterMockRecorder {
+	return m.recorder
+}
+
+// GuardRulesDir mocks base method.
+func (m *MockguardRulesDirGetter) GuardRulesDir() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuardRulesDir")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuardRulesDir indicates an expected call of GuardRulesDir.
+func (mr *MockguardRulesDirGetterMockRecorder) GuardRulesDir() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuardRulesDir", reflect.TypeOf((*MockguardRulesDirGetter)(nil).GuardRulesDir))
+}
+
+// MockwsEnvironmentReadLister is a mock of wsEnvironmentReadLister interface.
+type MockwsEnvironmentReadLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsEnvironmentReadListerMockRecorder
+}
+
+// MockwsEnvironmentReadListerMockRecorder is the mock recorder for MockwsEnvironmentReadLister.
+type MockwsEnvironmentReadListerMockRecorder struct {
+	mock *MockwsEnvironmentReadLister
+}
+
+// NewMockwsEnvironmentReadLister creates a new mock instance.
+func NewMockwsEnvironmentReadLister(ctrl *gomock.Controller) *MockwsEnvironmentReadLister {
+	mock := &MockwsEnvironmentReadLister{ctrl: ctrl}
+	mock.recorder = &MockwsEnvironmentReadListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsEnvironmentReadLister) EXPECT() *MockwsEnvironmentReadListerMockRecorder {
+	return m.recorder
+}
+
+// ListEnvironments mocks base method.
+func (m *MockwsEnvironmentReadLister) ListEnvironments() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnvironments")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnvironments indicates an expected call of ListEnvironments.
+func (mr *MockwsEnvironmentReadListerMockRecorder) ListEnvironments() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockwsEnvironmentReadLister)(nil).ListEnvironments))
+}
+
+// ReadEnvironmentManifest mocks base method.
+func (m *MockwsEnvironmentReadLister) ReadEnvironmentManifest(mftDirName string) (workspace.EnvironmentManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEnvironmentManifest", mftDirName)
+	ret0, _ := ret[0].(workspace.EnvironmentManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadEnvironmentManifest indicates an expected call of ReadEnvironmentManifest.
+func (mr *MockwsEnvironmentReadListerMockRecorder) ReadEnvironmentManifest(mftDirName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentManifest", reflect.TypeOf((*MockwsEnvironmentReadLister)(nil).ReadEnvironmentManifest), mftDirName)
+}
+
 // MockwsPipelineReader is a mock of wsPipelineReader interface.
 type MockwsPipelineReader struct {
 	ctrl     *gomock.Controller
@@ -3478,6 +4329,20 @@ func (m *MockimageRemover) EXPECT() *MockimageRemoverMockRecorder {
 	return m.recorder
 }
 
+// ClearImagesWithTagPrefix mocks base method.
+func (m *MockimageRemover) ClearImagesWithTagPrefix(repoName, prefix string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearImagesWithTagPrefix", repoName, prefix)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearImagesWithTagPrefix indicates an expected call of ClearImagesWithTagPrefix.
+func (mr *MockimageRemoverMockRecorder) ClearImagesWithTagPrefix(repoName, prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearImagesWithTagPrefix", reflect.TypeOf((*MockimageRemover)(nil).ClearImagesWithTagPrefix), repoName, prefix)
+}
+
 // ClearRepository mocks base method.
 func (m *MockimageRemover) ClearRepository(repoName string) error {
 	m.ctrl.T.Helper()
@@ -4293,6 +5158,141 @@ func (mr *MockdomainHostedZoneGetterMockRecorder) DomainHostedZoneID(domainName
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainHostedZoneID", reflect.TypeOf((*MockdomainHostedZoneGetter)(nil).DomainHostedZoneID), domainName)
 }
 
+// MockhostedZoneDescriber is a mock of hostedZoneDescriber interface.
+type MockhostedZoneDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockhostedZoneDescriberMockRecorder
+}
+
+// MockhostedZoneDescriberMockRecorder is the mock recorder for MockhostedZoneDescriber.
+type MockhostedZoneDescriberMockRecorder struct {
+	mock *MockhostedZoneDescriber
+}
+
+// NewMockhostedZoneDescriber creates a new mock instance.
+func NewMockhostedZoneDescriber(ctrl *gomock.Controller) *MockhostedZoneDescriber {
+	mock := &MockhostedZoneDescriber{ctrl: ctrl}
+	mock.recorder = &MockhostedZoneDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockhostedZoneDescriber) EXPECT() *MockhostedZoneDescriberMockRecorder {
+	return m.recorder
+}
+
+// DomainHostedZoneID mocks base method.
+func (m *MockhostedZoneDescriber) DomainHostedZoneID(domainName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainHostedZoneID", domainName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DomainHostedZoneID indicates an expected call of DomainHostedZoneID.
+func (mr *MockhostedZoneDescriberMockRecorder) DomainHostedZoneID(domainName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainHostedZoneID", reflect.TypeOf((*MockhostedZoneDescriber)(nil).DomainHostedZoneID), domainName)
+}
+
+// NameServers mocks base method.
+func (m *MockhostedZoneDescriber) NameServers(hostedZoneID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NameServers", hostedZoneID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NameServers indicates an expected call of NameServers.
+func (mr *MockhostedZoneDescriberMockRecorder) NameServers(hostedZoneID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NameServers", reflect.TypeOf((*MockhostedZoneDescriber)(nil).NameServers), hostedZoneID)
+}
+
+// MockdnsDelegationManager is a mock of dnsDelegationManager interface.
+type MockdnsDelegationManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockdnsDelegationManagerMockRecorder
+}
+
+// MockdnsDelegationManagerMockRecorder is the mock recorder for MockdnsDelegationManager.
+type MockdnsDelegationManagerMockRecorder struct {
+	mock *MockdnsDelegationManager
+}
+
+// NewMockdnsDelegationManager creates a new mock instance.
+func NewMockdnsDelegationManager(ctrl *gomock.Controller) *MockdnsDelegationManager {
+	mock := &MockdnsDelegationManager{ctrl: ctrl}
+	mock.recorder = &MockdnsDelegationManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdnsDelegationManager) EXPECT() *MockdnsDelegationManagerMockRecorder {
+	return m.recorder
+}
+
+// DomainHostedZoneID mocks base method.
+func (m *MockdnsDelegationManager) DomainHostedZoneID(domainName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainHostedZoneID", domainName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DomainHostedZoneID indicates an expected call of DomainHostedZoneID.
+func (mr *MockdnsDelegationManagerMockRecorder) DomainHostedZoneID(domainName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainHostedZoneID", reflect.TypeOf((*MockdnsDelegationManager)(nil).DomainHostedZoneID), domainName)
+}
+
+// ListNSRecords mocks base method.
+func (m *MockdnsDelegationManager) ListNSRecords(hostedZoneID string) ([]route53.NSRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNSRecords", hostedZoneID)
+	ret0, _ := ret[0].([]route53.NSRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNSRecords indicates an expected call of ListNSRecords.
+func (mr *MockdnsDelegationManagerMockRecorder) ListNSRecords(hostedZoneID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNSRecords", reflect.TypeOf((*MockdnsDelegationManager)(nil).ListNSRecords), hostedZoneID)
+}
+
+// NameServers mocks base method.
+func (m *MockdnsDelegationManager) NameServers(hostedZoneID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NameServers", hostedZoneID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NameServers indicates an expected call of NameServers.
+func (mr *MockdnsDelegationManagerMockRecorder) NameServers(hostedZoneID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NameServers", reflect.TypeOf((*MockdnsDelegationManager)(nil).NameServers), hostedZoneID)
+}
+
+// UpsertNSRecord mocks base method.
+func (m *MockdnsDelegationManager) UpsertNSRecord(hostedZoneID string, record route53.NSRecord, ttl int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertNSRecord", hostedZoneID, record, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertNSRecord indicates an expected call of UpsertNSRecord.
+func (mr *MockdnsDelegationManagerMockRecorder) UpsertNSRecord(hostedZoneID, record, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertNSRecord", reflect.TypeOf((*MockdnsDelegationManager)(nil).UpsertNSRecord), hostedZoneID, record, ttl)
+}
+
 // MockdomainInfoGetter is a mock of domainInfoGetter interface.
 type MockdomainInfoGetter struct {
 	ctrl     *gomock.Controller
@@ -4474,6 +5474,21 @@ func (mr *MockenvDescriberMockRecorder) Manifest() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Manifest", reflect.TypeOf((*MockenvDescriber)(nil).Manifest))
 }
 
+// Params mocks base method.
+func (m *MockenvDescriber) Params() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Params")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Params indicates an expected call of Params.
+func (mr *MockenvDescriberMockRecorder) Params() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MockenvDescriber)(nil).Params))
+}
+
 // PublicCIDRBlocks mocks base method.
 func (m *MockenvDescriber) PublicCIDRBlocks() ([]string, error) {
 	m.ctrl.T.Helper()
@@ -4548,73 +5563,444 @@ type MockversionGetter struct {
 	recorder *MockversionGetterMockRecorder
 }
 
-// MockversionGetterMockRecorder is the mock recorder for MockversionGetter.
-type MockversionGetterMockRecorder struct {
-	mock *MockversionGetter
+// MockversionGetterMockRecorder is the mock recorder for MockversionGetter.
+type MockversionGetterMockRecorder struct {
+	mock *MockversionGetter
+}
+
+// NewMockversionGetter creates a new mock instance.
+func NewMockversionGetter(ctrl *gomock.Controller) *MockversionGetter {
+	mock := &MockversionGetter{ctrl: ctrl}
+	mock.recorder = &MockversionGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockversionGetter) EXPECT() *MockversionGetterMockRecorder {
+	return m.recorder
+}
+
+// Version mocks base method.
+func (m *MockversionGetter) Version() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Version")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Version indicates an expected call of Version.
+func (mr *MockversionGetterMockRecorder) Version() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockversionGetter)(nil).Version))
+}
+
+// MockappDescriber is a mock of appDescriber interface.
+type MockappDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockappDescriberMockRecorder
+}
+
+// MockappDescriberMockRecorder is the mock recorder for MockappDescriber.
+type MockappDescriberMockRecorder struct {
+	mock *MockappDescriber
+}
+
+// NewMockappDescriber creates a new mock instance.
+func NewMockappDescriber(ctrl *gomock.Controller) *MockappDescriber {
+	mock := &MockappDescriber{ctrl: ctrl}
+	mock.recorder = &MockappDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockappDescriber) EXPECT() *MockappDescriberMockRecorder {
+	return m.recorder
+}
+
+// RegionalResources mocks base method.
+func (m *MockappDescriber) RegionalResources(app *config.Application) ([]*describe.AppRegionalResources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegionalResources", app)
+	ret0, _ := ret[0].([]*describe.AppRegionalResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegionalResources indicates an expected call of RegionalResources.
+func (mr *MockappDescriberMockRecorder) RegionalResources(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegionalResources", reflect.TypeOf((*MockappDescriber)(nil).RegionalResources), app)
+}
+
+// Version mocks base method.
+func (m *MockappDescriber) Version() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Version")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Version indicates an expected call of Version.
+func (mr *MockappDescriberMockRecorder) Version() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockappDescriber)(nil).Version))
+}
+
+// MockappUpgrader is a mock of appUpgrader interface.
+type MockappUpgrader struct {
+	ctrl     *gomock.Controller
+	recorder *MockappUpgraderMockRecorder
+}
+
+// MockappUpgraderMockRecorder is the mock recorder for MockappUpgrader.
+type MockappUpgraderMockRecorder struct {
+	mock *MockappUpgrader
+}
+
+// NewMockappUpgrader creates a new mock instance.
+func NewMockappUpgrader(ctrl *gomock.Controller) *MockappUpgrader {
+	mock := &MockappUpgrader{ctrl: ctrl}
+	mock.recorder = &MockappUpgraderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockappUpgrader) EXPECT() *MockappUpgraderMockRecorder {
+	return m.recorder
+}
+
+// UpgradeApplication mocks base method.
+func (m *MockappUpgrader) UpgradeApplication(in *deploy0.CreateAppInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeApplication", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpgradeApplication indicates an expected call of UpgradeApplication.
+func (mr *MockappUpgraderMockRecorder) UpgradeApplication(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeApplication", reflect.TypeOf((*MockappUpgrader)(nil).UpgradeApplication), in)
+}
+
+// MocksharedRepositoryMigrator is a mock of sharedRepositoryMigrator interface.
+type MocksharedRepositoryMigrator struct {
+	ctrl     *gomock.Controller
+	recorder *MocksharedRepositoryMigratorMockRecorder
+}
+
+// MocksharedRepositoryMigratorMockRecorder is the mock recorder for MocksharedRepositoryMigrator.
+type MocksharedRepositoryMigratorMockRecorder struct {
+	mock *MocksharedRepositoryMigrator
+}
+
+// NewMocksharedRepositoryMigrator creates a new mock instance.
+func NewMocksharedRepositoryMigrator(ctrl *gomock.Controller) *MocksharedRepositoryMigrator {
+	mock := &MocksharedRepositoryMigrator{ctrl: ctrl}
+	mock.recorder = &MocksharedRepositoryMigratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksharedRepositoryMigrator) EXPECT() *MocksharedRepositoryMigratorMockRecorder {
+	return m.recorder
+}
+
+// AddSharedRepositoryToApp mocks base method.
+func (m *MocksharedRepositoryMigrator) AddSharedRepositoryToApp(app *config.Application) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSharedRepositoryToApp", app)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSharedRepositoryToApp indicates an expected call of AddSharedRepositoryToApp.
+func (mr *MocksharedRepositoryMigratorMockRecorder) AddSharedRepositoryToApp(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSharedRepositoryToApp", reflect.TypeOf((*MocksharedRepositoryMigrator)(nil).AddSharedRepositoryToApp), app)
+}
+
+// GetRegionalAppResources mocks base method.
+func (m *MocksharedRepositoryMigrator) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
+	ret0, _ := ret[0].([]*stack.AppRegionalResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegionalAppResources indicates an expected call of GetRegionalAppResources.
+func (mr *MocksharedRepositoryMigratorMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*MocksharedRepositoryMigrator)(nil).GetRegionalAppResources), app)
+}
+
+// RemoveWorkloadRepositoriesFromApp mocks base method.
+func (m *MocksharedRepositoryMigrator) RemoveWorkloadRepositoriesFromApp(app *config.Application) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveWorkloadRepositoriesFromApp", app)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveWorkloadRepositoriesFromApp indicates an expected call of RemoveWorkloadRepositoriesFromApp.
+func (mr *MocksharedRepositoryMigratorMockRecorder) RemoveWorkloadRepositoriesFromApp(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveWorkloadRepositoriesFromApp", reflect.TypeOf((*MocksharedRepositoryMigrator)(nil).RemoveWorkloadRepositoriesFromApp), app)
+}
+
+// MockimagePruner is a mock of imagePruner interface.
+type MockimagePruner struct {
+	ctrl     *gomock.Controller
+	recorder *MockimagePrunerMockRecorder
+}
+
+// MockimagePrunerMockRecorder is the mock recorder for MockimagePruner.
+type MockimagePrunerMockRecorder struct {
+	mock *MockimagePruner
+}
+
+// NewMockimagePruner creates a new mock instance.
+func NewMockimagePruner(ctrl *gomock.Controller) *MockimagePruner {
+	mock := &MockimagePruner{ctrl: ctrl}
+	mock.recorder = &MockimagePrunerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimagePruner) EXPECT() *MockimagePrunerMockRecorder {
+	return m.recorder
+}
+
+// DeleteImages mocks base method.
+func (m *MockimagePruner) DeleteImages(images []ecr.Image, repoName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImages", images, repoName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteImages indicates an expected call of DeleteImages.
+func (mr *MockimagePrunerMockRecorder) DeleteImages(images, repoName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImages", reflect.TypeOf((*MockimagePruner)(nil).DeleteImages), images, repoName)
+}
+
+// ListImages mocks base method.
+func (m *MockimagePruner) ListImages(repoName string) ([]ecr.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImages", repoName)
+	ret0, _ := ret[0].([]ecr.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImages indicates an expected call of ListImages.
+func (mr *MockimagePrunerMockRecorder) ListImages(repoName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockimagePruner)(nil).ListImages), repoName)
+}
+
+// MockartifactPruner is a mock of artifactPruner interface.
+type MockartifactPruner struct {
+	ctrl     *gomock.Controller
+	recorder *MockartifactPrunerMockRecorder
+}
+
+// MockartifactPrunerMockRecorder is the mock recorder for MockartifactPruner.
+type MockartifactPrunerMockRecorder struct {
+	mock *MockartifactPruner
+}
+
+// NewMockartifactPruner creates a new mock instance.
+func NewMockartifactPruner(ctrl *gomock.Controller) *MockartifactPruner {
+	mock := &MockartifactPruner{ctrl: ctrl}
+	mock.recorder = &MockartifactPrunerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockartifactPruner) EXPECT() *MockartifactPrunerMockRecorder {
+	return m.recorder
+}
+
+// DeleteObjects mocks base method.
+func (m *MockartifactPruner) DeleteObjects(bucket string, objects []*s3.Object) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObjects", bucket, objects)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObjects indicates an expected call of DeleteObjects.
+func (mr *MockartifactPrunerMockRecorder) DeleteObjects(bucket, objects interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjects", reflect.TypeOf((*MockartifactPruner)(nil).DeleteObjects), bucket, objects)
+}
+
+// Download mocks base method.
+func (m *MockartifactPruner) Download(bucket, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", bucket, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockartifactPrunerMockRecorder) Download(bucket, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockartifactPruner)(nil).Download), bucket, key)
+}
+
+// ListObjects mocks base method.
+func (m *MockartifactPruner) ListObjects(bucket, prefix string) ([]*s3.Object, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjects", bucket, prefix)
+	ret0, _ := ret[0].([]*s3.Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjects indicates an expected call of ListObjects.
+func (mr *MockartifactPrunerMockRecorder) ListObjects(bucket, prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockartifactPruner)(nil).ListObjects), bucket, prefix)
+}
+
+// MockrepositoryImageLister is a mock of repositoryImageLister interface.
+type MockrepositoryImageLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockrepositoryImageListerMockRecorder
+}
+
+// MockrepositoryImageListerMockRecorder is the mock recorder for MockrepositoryImageLister.
+type MockrepositoryImageListerMockRecorder struct {
+	mock *MockrepositoryImageLister
+}
+
+// NewMockrepositoryImageLister creates a new mock instance.
+func NewMockrepositoryImageLister(ctrl *gomock.Controller) *MockrepositoryImageLister {
+	mock := &MockrepositoryImageLister{ctrl: ctrl}
+	mock.recorder = &MockrepositoryImageListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrepositoryImageLister) EXPECT() *MockrepositoryImageListerMockRecorder {
+	return m.recorder
+}
+
+// Auth mocks base method.
+func (m *MockrepositoryImageLister) Auth() (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Auth")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Auth indicates an expected call of Auth.
+func (mr *MockrepositoryImageListerMockRecorder) Auth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth", reflect.TypeOf((*MockrepositoryImageLister)(nil).Auth))
+}
+
+// ListImages mocks base method.
+func (m *MockrepositoryImageLister) ListImages(repoName string) ([]ecr.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImages", repoName)
+	ret0, _ := ret[0].([]ecr.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImages indicates an expected call of ListImages.
+func (mr *MockrepositoryImageListerMockRecorder) ListImages(repoName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockrepositoryImageLister)(nil).ListImages), repoName)
+}
+
+// MockimageCopier is a mock of imageCopier interface.
+type MockimageCopier struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageCopierMockRecorder
+}
+
+// MockimageCopierMockRecorder is the mock recorder for MockimageCopier.
+type MockimageCopierMockRecorder struct {
+	mock *MockimageCopier
 }
 
-// NewMockversionGetter creates a new mock instance.
-func NewMockversionGetter(ctrl *gomock.Controller) *MockversionGetter {
-	mock := &MockversionGetter{ctrl: ctrl}
-	mock.recorder = &MockversionGetterMockRecorder{mock}
+// NewMockimageCopier creates a new mock instance.
+func NewMockimageCopier(ctrl *gomock.Controller) *MockimageCopier {
+	mock := &MockimageCopier{ctrl: ctrl}
+	mock.recorder = &MockimageCopierMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockversionGetter) EXPECT() *MockversionGetterMockRecorder {
+func (m *MockimageCopier) EXPECT() *MockimageCopierMockRecorder {
 	return m.recorder
 }
 
-// Version mocks base method.
-func (m *MockversionGetter) Version() (string, error) {
+// CheckDockerEngineRunning mocks base method.
+func (m *MockimageCopier) CheckDockerEngineRunning() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Version")
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CheckDockerEngineRunning")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Version indicates an expected call of Version.
-func (mr *MockversionGetterMockRecorder) Version() *gomock.Call {
+// CheckDockerEngineRunning indicates an expected call of CheckDockerEngineRunning.
+func (mr *MockimageCopierMockRecorder) CheckDockerEngineRunning() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockversionGetter)(nil).Version))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDockerEngineRunning", reflect.TypeOf((*MockimageCopier)(nil).CheckDockerEngineRunning))
 }
 
-// MockappUpgrader is a mock of appUpgrader interface.
-type MockappUpgrader struct {
-	ctrl     *gomock.Controller
-	recorder *MockappUpgraderMockRecorder
+// CopyImage mocks base method.
+func (m *MockimageCopier) CopyImage(source, target string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyImage", source, target)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MockappUpgraderMockRecorder is the mock recorder for MockappUpgrader.
-type MockappUpgraderMockRecorder struct {
-	mock *MockappUpgrader
+// CopyImage indicates an expected call of CopyImage.
+func (mr *MockimageCopierMockRecorder) CopyImage(source, target interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyImage", reflect.TypeOf((*MockimageCopier)(nil).CopyImage), source, target)
 }
 
-// NewMockappUpgrader creates a new mock instance.
-func NewMockappUpgrader(ctrl *gomock.Controller) *MockappUpgrader {
-	mock := &MockappUpgrader{ctrl: ctrl}
-	mock.recorder = &MockappUpgraderMockRecorder{mock}
-	return mock
+// IsEcrCredentialHelperEnabled mocks base method.
+func (m *MockimageCopier) IsEcrCredentialHelperEnabled(uri string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsEcrCredentialHelperEnabled", uri)
+	ret0, _ := ret[0].(bool)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockappUpgrader) EXPECT() *MockappUpgraderMockRecorder {
-	return m.recorder
+// IsEcrCredentialHelperEnabled indicates an expected call of IsEcrCredentialHelperEnabled.
+func (mr *MockimageCopierMockRecorder) IsEcrCredentialHelperEnabled(uri interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsEcrCredentialHelperEnabled", reflect.TypeOf((*MockimageCopier)(nil).IsEcrCredentialHelperEnabled), uri)
 }
 
-// UpgradeApplication mocks base method.
-func (m *MockappUpgrader) UpgradeApplication(in *deploy0.CreateAppInput) error {
+// Login mocks base method.
+func (m *MockimageCopier) Login(uri, username, password string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpgradeApplication", in)
+	ret := m.ctrl.Call(m, "Login", uri, username, password)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpgradeApplication indicates an expected call of UpgradeApplication.
-func (mr *MockappUpgraderMockRecorder) UpgradeApplication(in interface{}) *gomock.Call {
+// Login indicates an expected call of Login.
+func (mr *MockimageCopierMockRecorder) Login(uri, username, password interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeApplication", reflect.TypeOf((*MockappUpgrader)(nil).UpgradeApplication), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockimageCopier)(nil).Login), uri, username, password)
 }
 
 // MockpipelineGetter is a mock of pipelineGetter interface.
@@ -5659,6 +7045,44 @@ func (mr *Mockec2ClientMockRecorder) ListAZs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAZs", reflect.TypeOf((*Mockec2Client)(nil).ListAZs))
 }
 
+// MockclusterDescriber is a mock of clusterDescriber interface.
+type MockclusterDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockclusterDescriberMockRecorder
+}
+
+// MockclusterDescriberMockRecorder is the mock recorder for MockclusterDescriber.
+type MockclusterDescriberMockRecorder struct {
+	mock *MockclusterDescriber
+}
+
+// NewMockclusterDescriber creates a new mock instance.
+func NewMockclusterDescriber(ctrl *gomock.Controller) *MockclusterDescriber {
+	mock := &MockclusterDescriber{ctrl: ctrl}
+	mock.recorder = &MockclusterDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockclusterDescriber) EXPECT() *MockclusterDescriberMockRecorder {
+	return m.recorder
+}
+
+// Cluster mocks base method.
+func (m *MockclusterDescriber) Cluster(name string) (*ecs.Cluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cluster", name)
+	ret0, _ := ret[0].(*ecs.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cluster indicates an expected call of Cluster.
+func (mr *MockclusterDescriberMockRecorder) Cluster(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cluster", reflect.TypeOf((*MockclusterDescriber)(nil).Cluster), name)
+}
+
 // MockserviceResumer is a mock of serviceResumer interface.
 type MockserviceResumer struct {
 	ctrl     *gomock.Controller
@@ -5772,6 +7196,82 @@ func (mr *MocksvcInitializerMockRecorder) Service(props interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MocksvcInitializer)(nil).Service), props)
 }
 
+// MocktemplateGetter is a mock of templateGetter interface.
+type MocktemplateGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocktemplateGetterMockRecorder
+}
+
+// MocktemplateGetterMockRecorder is the mock recorder for MocktemplateGetter.
+type MocktemplateGetterMockRecorder struct {
+	mock *MocktemplateGetter
+}
+
+// NewMocktemplateGetter creates a new mock instance.
+func NewMocktemplateGetter(ctrl *gomock.Controller) *MocktemplateGetter {
+	mock := &MocktemplateGetter{ctrl: ctrl}
+	mock.recorder = &MocktemplateGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktemplateGetter) EXPECT() *MocktemplateGetterMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MocktemplateGetter) Get(ref string) (*catalog.Contents, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ref)
+	ret0, _ := ret[0].(*catalog.Contents)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MocktemplateGetterMockRecorder) Get(ref interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MocktemplateGetter)(nil).Get), ref)
+}
+
+// MocktemplateLister is a mock of templateLister interface.
+type MocktemplateLister struct {
+	ctrl     *gomock.Controller
+	recorder *MocktemplateListerMockRecorder
+}
+
+// MocktemplateListerMockRecorder is the mock recorder for MocktemplateLister.
+type MocktemplateListerMockRecorder struct {
+	mock *MocktemplateLister
+}
+
+// NewMocktemplateLister creates a new mock instance.
+func NewMocktemplateLister(ctrl *gomock.Controller) *MocktemplateLister {
+	mock := &MocktemplateLister{ctrl: ctrl}
+	mock.recorder = &MocktemplateListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktemplateLister) EXPECT() *MocktemplateListerMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MocktemplateLister) List() ([]catalog.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].([]catalog.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MocktemplateListerMockRecorder) List() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MocktemplateLister)(nil).List))
+}
+
 // MockroleDeleter is a mock of roleDeleter interface.
 type MockroleDeleter struct {
 	ctrl     *gomock.Controller
@@ -5809,6 +7309,43 @@ func (mr *MockroleDeleterMockRecorder) DeleteRole(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockroleDeleter)(nil).DeleteRole), arg0)
 }
 
+// MockenvStackSetDetacher is a mock of envStackSetDetacher interface.
+type MockenvStackSetDetacher struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvStackSetDetacherMockRecorder
+}
+
+// MockenvStackSetDetacherMockRecorder is the mock recorder for MockenvStackSetDetacher.
+type MockenvStackSetDetacherMockRecorder struct {
+	mock *MockenvStackSetDetacher
+}
+
+// NewMockenvStackSetDetacher creates a new mock instance.
+func NewMockenvStackSetDetacher(ctrl *gomock.Controller) *MockenvStackSetDetacher {
+	mock := &MockenvStackSetDetacher{ctrl: ctrl}
+	mock.recorder = &MockenvStackSetDetacherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvStackSetDetacher) EXPECT() *MockenvStackSetDetacherMockRecorder {
+	return m.recorder
+}
+
+// DetachEnvironment mocks base method.
+func (m *MockenvStackSetDetacher) DetachEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachEnvironment indicates an expected call of DetachEnvironment.
+func (mr *MockenvStackSetDetacherMockRecorder) DetachEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachEnvironment", reflect.TypeOf((*MockenvStackSetDetacher)(nil).DetachEnvironment), env)
+}
+
 // MockserviceDescriber is a mock of serviceDescriber interface.
 type MockserviceDescriber struct {
 	ctrl     *gomock.Controller
@@ -6578,6 +8115,21 @@ func (mr *MockworkloadDeployerMockRecorder) DeployWorkload(in interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployWorkload", reflect.TypeOf((*MockworkloadDeployer)(nil).DeployWorkload), in)
 }
 
+// GenerateCloudFormationTemplate mocks base method.
+func (m *MockworkloadDeployer) GenerateCloudFormationTemplate(in *deploy.GenerateCloudFormationTemplateInput) (*deploy.GenerateCloudFormationTemplateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateCloudFormationTemplate", in)
+	ret0, _ := ret[0].(*deploy.GenerateCloudFormationTemplateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateCloudFormationTemplate indicates an expected call of GenerateCloudFormationTemplate.
+func (mr *MockworkloadDeployerMockRecorder) GenerateCloudFormationTemplate(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCloudFormationTemplate", reflect.TypeOf((*MockworkloadDeployer)(nil).GenerateCloudFormationTemplate), in)
+}
+
 // IsServiceAvailableInRegion mocks base method.
 func (m *MockworkloadDeployer) IsServiceAvailableInRegion(region string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -6608,6 +8160,81 @@ func (mr *MockworkloadDeployerMockRecorder) UploadArtifacts() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadArtifacts", reflect.TypeOf((*MockworkloadDeployer)(nil).UploadArtifacts))
 }
 
+// MockworkloadStackRenderer is a mock of workloadStackRenderer interface.
+type MockworkloadStackRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkloadStackRendererMockRecorder
+}
+
+// MockworkloadStackRendererMockRecorder is the mock recorder for MockworkloadStackRenderer.
+type MockworkloadStackRendererMockRecorder struct {
+	mock *MockworkloadStackRenderer
+}
+
+// NewMockworkloadStackRenderer creates a new mock instance.
+func NewMockworkloadStackRenderer(ctrl *gomock.Controller) *MockworkloadStackRenderer {
+	mock := &MockworkloadStackRenderer{ctrl: ctrl}
+	mock.recorder = &MockworkloadStackRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockworkloadStackRenderer) EXPECT() *MockworkloadStackRendererMockRecorder {
+	return m.recorder
+}
+
+// RenderWorkloadUpdate mocks base method.
+func (m *MockworkloadStackRenderer) RenderWorkloadUpdate(out progress.FileWriter, stackName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderWorkloadUpdate", out, stackName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenderWorkloadUpdate indicates an expected call of RenderWorkloadUpdate.
+func (mr *MockworkloadStackRendererMockRecorder) RenderWorkloadUpdate(out, stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderWorkloadUpdate", reflect.TypeOf((*MockworkloadStackRenderer)(nil).RenderWorkloadUpdate), out, stackName)
+}
+
+// MocktaggedAlarmsGetter is a mock of taggedAlarmsGetter interface.
+type MocktaggedAlarmsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaggedAlarmsGetterMockRecorder
+}
+
+// MocktaggedAlarmsGetterMockRecorder is the mock recorder for MocktaggedAlarmsGetter.
+type MocktaggedAlarmsGetterMockRecorder struct {
+	mock *MocktaggedAlarmsGetter
+}
+
+// NewMocktaggedAlarmsGetter creates a new mock instance.
+func NewMocktaggedAlarmsGetter(ctrl *gomock.Controller) *MocktaggedAlarmsGetter {
+	mock := &MocktaggedAlarmsGetter{ctrl: ctrl}
+	mock.recorder = &MocktaggedAlarmsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktaggedAlarmsGetter) EXPECT() *MocktaggedAlarmsGetterMockRecorder {
+	return m.recorder
+}
+
+// AlarmsWithTags mocks base method.
+func (m *MocktaggedAlarmsGetter) AlarmsWithTags(tags map[string]string) ([]cloudwatch.AlarmStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlarmsWithTags", tags)
+	ret0, _ := ret[0].([]cloudwatch.AlarmStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AlarmsWithTags indicates an expected call of AlarmsWithTags.
+func (mr *MocktaggedAlarmsGetterMockRecorder) AlarmsWithTags(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlarmsWithTags", reflect.TypeOf((*MocktaggedAlarmsGetter)(nil).AlarmsWithTags), tags)
+}
+
 // MockworkloadTemplateGenerator is a mock of workloadTemplateGenerator interface.
 type MockworkloadTemplateGenerator struct {
 	ctrl     *gomock.Controller
@@ -6721,6 +8348,35 @@ func (m *MockenvDeployer) EXPECT() *MockenvDeployerMockRecorder {
 	return m.recorder
 }
 
+// AttachToDeployment mocks base method.
+func (m *MockenvDeployer) AttachToDeployment() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachToDeployment")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachToDeployment indicates an expected call of AttachToDeployment.
+func (mr *MockenvDeployerMockRecorder) AttachToDeployment() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachToDeployment", reflect.TypeOf((*MockenvDeployer)(nil).AttachToDeployment))
+}
+
+// CreateChangeSet mocks base method.
+func (m *MockenvDeployer) CreateChangeSet(in *deploy.DeployEnvironmentInput) (*deploy.EnvironmentChangeSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChangeSet", in)
+	ret0, _ := ret[0].(*deploy.EnvironmentChangeSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChangeSet indicates an expected call of CreateChangeSet.
+func (mr *MockenvDeployerMockRecorder) CreateChangeSet(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChangeSet", reflect.TypeOf((*MockenvDeployer)(nil).CreateChangeSet), in)
+}
+
 // DeployEnvironment mocks base method.
 func (m *MockenvDeployer) DeployEnvironment(in *deploy.DeployEnvironmentInput) error {
 	m.ctrl.T.Helper()
@@ -6735,6 +8391,66 @@ func (mr *MockenvDeployerMockRecorder) DeployEnvironment(in interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvironment", reflect.TypeOf((*MockenvDeployer)(nil).DeployEnvironment), in)
 }
 
+// DeployEnvironmentNoWait mocks base method.
+func (m *MockenvDeployer) DeployEnvironmentNoWait(in *deploy.DeployEnvironmentInput) (*deploy.EnvironmentDeployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployEnvironmentNoWait", in)
+	ret0, _ := ret[0].(*deploy.EnvironmentDeployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployEnvironmentNoWait indicates an expected call of DeployEnvironmentNoWait.
+func (mr *MockenvDeployerMockRecorder) DeployEnvironmentNoWait(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvironmentNoWait", reflect.TypeOf((*MockenvDeployer)(nil).DeployEnvironmentNoWait), in)
+}
+
+// DetectDrift mocks base method.
+func (m *MockenvDeployer) DetectDrift() ([]*cloudformation.StackResourceDrift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectDrift")
+	ret0, _ := ret[0].([]*cloudformation.StackResourceDrift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectDrift indicates an expected call of DetectDrift.
+func (mr *MockenvDeployerMockRecorder) DetectDrift() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectDrift", reflect.TypeOf((*MockenvDeployer)(nil).DetectDrift))
+}
+
+// GenerateCloudFormationTemplate mocks base method.
+func (m *MockenvDeployer) GenerateCloudFormationTemplate(in *deploy.DeployEnvironmentInput) (*deploy.GenerateCloudFormationTemplateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateCloudFormationTemplate", in)
+	ret0, _ := ret[0].(*deploy.GenerateCloudFormationTemplateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateCloudFormationTemplate indicates an expected call of GenerateCloudFormationTemplate.
+func (mr *MockenvDeployerMockRecorder) GenerateCloudFormationTemplate(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCloudFormationTemplate", reflect.TypeOf((*MockenvDeployer)(nil).GenerateCloudFormationTemplate), in)
+}
+
+// PreSeededArtifacts mocks base method.
+func (m *MockenvDeployer) PreSeededArtifacts(bucket string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreSeededArtifacts", bucket)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreSeededArtifacts indicates an expected call of PreSeededArtifacts.
+func (mr *MockenvDeployerMockRecorder) PreSeededArtifacts(bucket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreSeededArtifacts", reflect.TypeOf((*MockenvDeployer)(nil).PreSeededArtifacts), bucket)
+}
+
 // UploadArtifacts mocks base method.
 func (m *MockenvDeployer) UploadArtifacts() (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -6750,6 +8466,43 @@ func (mr *MockenvDeployerMockRecorder) UploadArtifacts() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadArtifacts", reflect.TypeOf((*MockenvDeployer)(nil).UploadArtifacts))
 }
 
+// MockenvRollbacker is a mock of envRollbacker interface.
+type MockenvRollbacker struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvRollbackerMockRecorder
+}
+
+// MockenvRollbackerMockRecorder is the mock recorder for MockenvRollbacker.
+type MockenvRollbackerMockRecorder struct {
+	mock *MockenvRollbacker
+}
+
+// NewMockenvRollbacker creates a new mock instance.
+func NewMockenvRollbacker(ctrl *gomock.Controller) *MockenvRollbacker {
+	mock := &MockenvRollbacker{ctrl: ctrl}
+	mock.recorder = &MockenvRollbackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvRollbacker) EXPECT() *MockenvRollbackerMockRecorder {
+	return m.recorder
+}
+
+// Rollback mocks base method.
+func (m *MockenvRollbacker) Rollback(in *deploy.RollbackEnvironmentInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollback", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollback indicates an expected call of Rollback.
+func (mr *MockenvRollbackerMockRecorder) Rollback(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockenvRollbacker)(nil).Rollback), in)
+}
+
 // MockenvPackager is a mock of envPackager interface.
 type MockenvPackager struct {
 	ctrl     *gomock.Controller
@@ -247,6 +247,7 @@ func (o *deployPipelineOpts) Execute() error {
 	}
 	deployPipelineInput := &deploy.CreatePipelineInput{
 		AppName:         o.appName,
+		ResourcePrefix:  o.app.ResourcePrefix,
 		Name:            pipeline.Name,
 		IsLegacy:        isLegacy,
 		Source:          source,
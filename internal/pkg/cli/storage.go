@@ -19,6 +19,7 @@ Augment your services with S3 buckets, NoSQL and SQL databases.`,
 	}
 
 	cmd.AddCommand(buildStorageInitCmd())
+	cmd.AddCommand(buildStorageShowCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
@@ -0,0 +1,186 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	storageShowWorkloadPrompt     = "Which workload of %s would you like to show the storage of?"
+	storageShowWorkloadHelpPrompt = "The endpoints of the Aurora clusters with readers of the workload will be shown per environment."
+)
+
+type showStorageVars struct {
+	appName          string
+	workloadName     string
+	shouldOutputJSON bool
+}
+
+type showStorageOpts struct {
+	showStorageVars
+
+	w             io.Writer
+	store         store
+	sel           appSelector
+	prompt        prompter
+	describer     describer
+	initDescriber func() error // Overridden in tests.
+}
+
+func newShowStorageOpts(vars showStorageVars) (*showStorageOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("storage show"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	ssmStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, ssmStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+
+	prompter := prompt.New()
+	opts := &showStorageOpts{
+		showStorageVars: vars,
+		store:           ssmStore,
+		w:               log.OutputWriter,
+		sel:             selector.NewAppEnvSelector(prompter, ssmStore),
+		prompt:          prompter,
+	}
+	opts.initDescriber = func() error {
+		d, err := describe.NewStorageDescriber(describe.NewServiceConfig{
+			App:         opts.appName,
+			Svc:         opts.workloadName,
+			ConfigStore: ssmStore,
+			DeployStore: deployStore,
+		})
+		if err != nil {
+			return fmt.Errorf("creating storage describer for %s in application %s: %w", opts.workloadName, opts.appName, err)
+		}
+		opts.describer = d
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *showStorageOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *showStorageOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateOrAskWorkload()
+}
+
+// Execute shows the endpoints of the storage addons of the workload in each deployed environment.
+func (o *showStorageOpts) Execute() error {
+	if err := o.initDescriber(); err != nil {
+		return err
+	}
+	storage, err := o.describer.Describe()
+	if err != nil {
+		return fmt.Errorf("describe storage of %s: %w", o.workloadName, err)
+	}
+	content := storage.HumanString()
+	if o.shouldOutputJSON {
+		data, err := storage.JSONString()
+		if err != nil {
+			return err
+		}
+		content = data
+	}
+	fmt.Fprint(o.w, content)
+	return nil
+}
+
+func (o *showStorageOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	appName, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application name: %w", err)
+	}
+	o.appName = appName
+	return nil
+}
+
+func (o *showStorageOpts) validateOrAskWorkload() error {
+	if o.workloadName != "" {
+		_, err := o.store.GetWorkload(o.appName, o.workloadName)
+		return err
+	}
+	workloads, err := o.store.ListWorkloads(o.appName)
+	if err != nil {
+		return fmt.Errorf("list workloads of application %s: %w", o.appName, err)
+	}
+	if len(workloads) == 0 {
+		return fmt.Errorf("no workloads found in application %s", o.appName)
+	}
+	names := make([]string, len(workloads))
+	for i, wl := range workloads {
+		names[i] = wl.Name
+	}
+	if len(names) == 1 {
+		o.workloadName = names[0]
+		return nil
+	}
+	name, err := o.prompt.SelectOne(fmt.Sprintf(storageShowWorkloadPrompt, color.HighlightUserInput(o.appName)),
+		storageShowWorkloadHelpPrompt, names, prompt.WithFinalMessage("Workload name:"))
+	if err != nil {
+		return fmt.Errorf("select workload for application %s: %w", o.appName, err)
+	}
+	o.workloadName = name
+	return nil
+}
+
+// buildStorageShowCmd builds the command for showing the storage of a workload.
+func buildStorageShowCmd() *cobra.Command {
+	vars := showStorageVars{}
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Shows the endpoints of the storage of a workload per environment.",
+		Long: `Shows the endpoints of the storage of a workload per environment.
+The writer endpoint, reader endpoint and port of Aurora clusters with readers are listed,
+along with the EFS file system of each environment and the workloads that mount it.`,
+
+		Example: `
+  Print the endpoints of the Aurora clusters of the "api" service in each environment.
+  /code $ copilot storage show -w api
+  Print the endpoints in JSON format.
+  /code $ copilot storage show -w api --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newShowStorageOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.workloadName, workloadFlag, workloadFlagShort, "", workloadFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,217 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+)
+
+type showStorageMocks struct {
+	store     *mocks.Mockstore
+	sel       *mocks.MockappSelector
+	prompt    *mocks.Mockprompter
+	describer *mocks.Mockdescriber
+}
+
+func TestStorageShow_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp      string
+		inWorkload string
+
+		setupMocks func(m showStorageMocks)
+
+		wantedApp      string
+		wantedWorkload string
+		wantedError    error
+	}{
+		"validate instead of prompting the application and workload names": {
+			inApp:      "phonetool",
+			inWorkload: "api",
+			setupMocks: func(m showStorageMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetWorkload("phonetool", "api").Return(&config.Workload{}, nil)
+			},
+			wantedApp:      "phonetool",
+			wantedWorkload: "api",
+		},
+		"return error if the workload doesn't exist": {
+			inApp:      "phonetool",
+			inWorkload: "api",
+			setupMocks: func(m showStorageMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetWorkload("phonetool", "api").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"prompt for the application name": {
+			inWorkload: "api",
+			setupMocks: func(m showStorageMocks) {
+				m.sel.EXPECT().Application(svcAppNamePrompt, svcAppNameHelpPrompt).Return("phonetool", nil)
+				m.store.EXPECT().GetWorkload("phonetool", "api").Return(&config.Workload{}, nil)
+			},
+			wantedApp:      "phonetool",
+			wantedWorkload: "api",
+		},
+		"return error if fail to select the application": {
+			setupMocks: func(m showStorageMocks) {
+				m.sel.EXPECT().Application(svcAppNamePrompt, svcAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("select application name: some error"),
+		},
+		"return error if there are no workloads in the application": {
+			inApp: "phonetool",
+			setupMocks: func(m showStorageMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().ListWorkloads("phonetool").Return(nil, nil)
+			},
+			wantedError: fmt.Errorf("no workloads found in application phonetool"),
+		},
+		"use the only workload of the application without prompting": {
+			inApp: "phonetool",
+			setupMocks: func(m showStorageMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().ListWorkloads("phonetool").Return([]*config.Workload{{Name: "api"}}, nil)
+			},
+			wantedApp:      "phonetool",
+			wantedWorkload: "api",
+		},
+		"prompt for the workload name": {
+			inApp: "phonetool",
+			setupMocks: func(m showStorageMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().ListWorkloads("phonetool").Return([]*config.Workload{{Name: "api"}, {Name: "resizer"}}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), storageShowWorkloadHelpPrompt, []string{"api", "resizer"}, gomock.Any()).
+					Return("resizer", nil)
+			},
+			wantedApp:      "phonetool",
+			wantedWorkload: "resizer",
+		},
+		"return error if fail to select the workload": {
+			inApp: "phonetool",
+			setupMocks: func(m showStorageMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().ListWorkloads("phonetool").Return([]*config.Workload{{Name: "api"}, {Name: "resizer"}}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("select workload for application phonetool: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := showStorageMocks{
+				store:  mocks.NewMockstore(ctrl),
+				sel:    mocks.NewMockappSelector(ctrl),
+				prompt: mocks.NewMockprompter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &showStorageOpts{
+				showStorageVars: showStorageVars{
+					appName:      tc.inApp,
+					workloadName: tc.inWorkload,
+				},
+				store:  m.store,
+				sel:    m.sel,
+				prompt: m.prompt,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedWorkload, opts.workloadName)
+		})
+	}
+}
+
+func TestStorageShow_Execute(t *testing.T) {
+	mockStorage := &mockDescribeData{
+		data: "mockData",
+	}
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+
+		setupMocks func(m showStorageMocks)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"return error if fail to describe the storage": {
+			setupMocks: func(m showStorageMocks) {
+				m.describer.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe storage of api: some error"),
+		},
+		"print the storage in human format": {
+			setupMocks: func(m showStorageMocks) {
+				m.describer.EXPECT().Describe().Return(mockStorage, nil)
+			},
+			wantedContent: "mockData",
+		},
+		"print the storage in JSON format": {
+			shouldOutputJSON: true,
+			setupMocks: func(m showStorageMocks) {
+				m.describer.EXPECT().Describe().Return(mockStorage, nil)
+			},
+			wantedContent: "mockData",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			m := showStorageMocks{
+				describer: mocks.NewMockdescriber(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &showStorageOpts{
+				showStorageVars: showStorageVars{
+					appName:          "phonetool",
+					workloadName:     "api",
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				w:             b,
+				initDescriber: func() error { return nil },
+			}
+			opts.describer = m.describer
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -34,6 +34,8 @@ Services are long-running ECS or App Runner services.`,
 	cmd.AddCommand(buildSvcExecCmd())
 	cmd.AddCommand(buildSvcPauseCmd())
 	cmd.AddCommand(buildSvcResumeCmd())
+	cmd.AddCommand(buildSvcFlagsCmd())
+	cmd.AddCommand(buildSvcCheckImagesCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
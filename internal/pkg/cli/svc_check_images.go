@@ -0,0 +1,492 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	describestack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
+	"github.com/aws/copilot-cli/internal/pkg/docker/registry"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// Statuses of the images reported by svc check-images.
+const (
+	imageStatusUpToDate   = "up-to-date"
+	imageStatusStale      = "stale"
+	imageStatusUnpinned   = "unpinned"
+	imageStatusUnknown    = "unknown"
+	imageStatusClean      = "clean"
+	imageStatusVulnerable = "vulnerable"
+	imageStatusNotScanned = "not-scanned"
+)
+
+const (
+	defaultImageTag       = "latest"
+	ecrRegistryHostInfix  = ".dkr.ecr."
+	ecrScanStatusComplete = "COMPLETE"
+	wkldManifestFileName  = "manifest.yml"
+)
+
+// vulnerableSeverities are the severities of scan findings that make an image vulnerable.
+var vulnerableSeverities = []string{"CRITICAL", "HIGH"}
+
+type checkImagesSvcVars struct {
+	appName          string
+	name             string
+	envName          string
+	fix              bool
+	shouldOutputJSON bool
+}
+
+type checkImagesSvcOpts struct {
+	checkImagesSvcVars
+
+	store    store
+	ws       wsImageReader
+	fs       afero.Fs
+	registry imageRegistry
+	w        io.Writer
+
+	// deployedImage returns the container image that the service is deployed with in the environment.
+	deployedImage func(env *config.Environment, svc string) (string, error)
+	newScanner    func(region string) (imageScanner, error)
+
+	// Cached variables.
+	targetEnv *config.Environment
+}
+
+func newCheckImagesSvcOpts(vars checkImagesSvcVars) (*checkImagesSvcOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc check-images"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	return &checkImagesSvcOpts{
+		checkImagesSvcVars: vars,
+		store:              config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region)),
+		ws:                 ws,
+		fs:                 afero.NewOsFs(),
+		registry:           registry.New(),
+		w:                  os.Stdout,
+		deployedImage: func(env *config.Environment, svc string) (string, error) {
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return "", err
+			}
+			descr, err := describestack.NewStackDescriber(stack.NameForService(vars.appName, env.Name, svc), sess).Describe()
+			if err != nil {
+				return "", err
+			}
+			return descr.Parameters[stack.WorkloadContainerImageParamKey], nil
+		},
+		newScanner: func(region string) (imageScanner, error) {
+			sess, err := sessProvider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, err
+			}
+			return ecr.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *checkImagesSvcOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	if o.name != "" {
+		svcs, err := o.ws.ListServices()
+		if err != nil {
+			return fmt.Errorf("list services in the workspace: %w", err)
+		}
+		if !contains(o.name, svcs) {
+			return fmt.Errorf("service %s not found in the workspace", color.HighlightUserInput(o.name))
+		}
+	}
+	if o.envName != "" {
+		env, err := o.store.GetEnvironment(o.appName, o.envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+		}
+		o.targetEnv = env
+	}
+	return nil
+}
+
+// baseImageCheck is the result of comparing an image that a service is built from with its upstream registry.
+type baseImageCheck struct {
+	Service string `json:"service"`
+	Source  string `json:"source"`
+	Image   string `json:"image"`
+	Latest  string `json:"latest,omitempty"`
+	Status  string `json:"status"`
+
+	path string // Absolute path of the file that refers to the image.
+	line int    // Line of the file that refers to the image.
+}
+
+// deployedImageCheck is the result of the vulnerability scan of the image that a service is deployed with.
+type deployedImageCheck struct {
+	Service  string           `json:"service"`
+	Image    string           `json:"image"`
+	Findings map[string]int64 `json:"findings,omitempty"`
+	Status   string           `json:"status"`
+}
+
+// Execute reports the base images of the services that have a newer version upstream,
+// and if an environment is specified, the deployed images that have vulnerabilities.
+func (o *checkImagesSvcOpts) Execute() error {
+	svcs := []string{o.name}
+	if o.name == "" {
+		names, err := o.ws.ListServices()
+		if err != nil {
+			return fmt.Errorf("list services in the workspace: %w", err)
+		}
+		svcs = names
+	}
+	wsPath, err := o.ws.Path()
+	if err != nil {
+		return fmt.Errorf("get workspace path: %w", err)
+	}
+	var baseImages []*baseImageCheck
+	for _, svc := range svcs {
+		checks, err := o.baseImages(svc, wsPath)
+		if err != nil {
+			return err
+		}
+		for _, check := range checks {
+			o.checkBaseImage(check)
+		}
+		baseImages = append(baseImages, checks...)
+	}
+	var deployedImages []*deployedImageCheck
+	if o.targetEnv != nil {
+		if deployedImages, err = o.checkDeployedImages(svcs); err != nil {
+			return err
+		}
+	}
+	if o.fix {
+		if err := o.fixBaseImages(baseImages); err != nil {
+			return err
+		}
+	}
+	if o.shouldOutputJSON {
+		return o.jsonOutput(baseImages, deployedImages)
+	}
+	o.humanOutput(baseImages, deployedImages)
+	return nil
+}
+
+// baseImages returns the images that the service is built from, or the image location in its manifest.
+func (o *checkImagesSvcOpts) baseImages(svc, wsPath string) ([]*baseImageCheck, error) {
+	raw, err := o.ws.ReadWorkloadManifest(svc)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest file for service %s: %w", svc, err)
+	}
+	mft, err := manifest.UnmarshalWorkload(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal manifest for service %s: %w", svc, err)
+	}
+	type buildable interface {
+		BuildRequired() (bool, error)
+		BuildArgs(rootDirectory string) *manifest.DockerBuildArgs
+	}
+	if b, ok := mft.(buildable); ok {
+		if required, err := b.BuildRequired(); err == nil && required {
+			path := aws.StringValue(b.BuildArgs(wsPath).Dockerfile)
+			imgs, err := dockerfile.New(o.fs, path).GetBaseImages()
+			if err != nil {
+				return nil, fmt.Errorf("get base images of service %s: %w", svc, err)
+			}
+			var checks []*baseImageCheck
+			for _, img := range imgs {
+				checks = append(checks, newBaseImageCheck(svc, img.String(), wsPath, path, img.Line))
+			}
+			return checks, nil
+		}
+	}
+	var location struct {
+		Image struct {
+			Location yaml.Node `yaml:"location"`
+		} `yaml:"image"`
+	}
+	if err := yaml.Unmarshal(raw, &location); err != nil {
+		return nil, fmt.Errorf("unmarshal image location of service %s: %w", svc, err)
+	}
+	if location.Image.Location.Value == "" {
+		return nil, nil
+	}
+	path := filepath.Join(wsPath, workspace.CopilotDirName, svc, wkldManifestFileName)
+	return []*baseImageCheck{newBaseImageCheck(svc, location.Image.Location.Value, wsPath, path, location.Image.Location.Line)}, nil
+}
+
+func newBaseImageCheck(svc, image, wsPath, path string, line int) *baseImageCheck {
+	source := path
+	if rel, err := filepath.Rel(wsPath, path); err == nil {
+		source = rel
+	}
+	return &baseImageCheck{
+		Service: svc,
+		Source:  fmt.Sprintf("%s:%d", filepath.ToSlash(source), line),
+		Image:   image,
+		path:    path,
+		line:    line,
+	}
+}
+
+// checkBaseImage compares the image with the newest version of its tag and the current digest of the tag upstream.
+func (o *checkImagesSvcOpts) checkBaseImage(check *baseImageCheck) {
+	img := dockerfile.ParseBaseImage(check.Image)
+	latest, err := o.latestBaseImage(img)
+	switch {
+	case err != nil:
+		log.Warningf("Could not check image %s of service %s: %v\n", check.Image, check.Service, err)
+		check.Status = imageStatusUnknown
+	case latest != img:
+		check.Latest = latest.String()
+		check.Status = imageStatusStale
+	case img.Digest == "" && !registry.IsVersionTag(img.Tag):
+		check.Status = imageStatusUnpinned
+	default:
+		check.Status = imageStatusUpToDate
+	}
+}
+
+// latestBaseImage returns the image with the newest tag of the same format, pinned to the current digest of the tag if the image is pinned.
+func (o *checkImagesSvcOpts) latestBaseImage(img dockerfile.BaseImage) (dockerfile.BaseImage, error) {
+	latest := img
+	if registry.IsVersionTag(img.Tag) {
+		tags, err := o.registry.Tags(img.Name)
+		if err != nil {
+			return img, err
+		}
+		if tag, ok := registry.LatestVersionTag(img.Tag, tags); ok {
+			latest.Tag = tag
+		}
+	}
+	if img.Digest == "" {
+		return latest, nil
+	}
+	tag := latest.Tag
+	if tag == "" {
+		tag = defaultImageTag
+	}
+	digest, err := o.registry.Digest(img.Name, tag)
+	if err != nil {
+		return img, err
+	}
+	latest.Digest = digest
+	return latest, nil
+}
+
+// checkDeployedImages returns the findings of the vulnerability scans of the images that the services are deployed with.
+func (o *checkImagesSvcOpts) checkDeployedImages(svcs []string) ([]*deployedImageCheck, error) {
+	scanner, err := o.newScanner(o.targetEnv.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create ECR client in region %s: %w", o.targetEnv.Region, err)
+	}
+	var checks []*deployedImageCheck
+	for _, svc := range svcs {
+		image, err := o.deployedImage(o.targetEnv, svc)
+		if err != nil {
+			log.Warningf("Could not get the image of service %s deployed in environment %s: %v\n", svc, o.envName, err)
+			continue
+		}
+		check := &deployedImageCheck{
+			Service: svc,
+			Image:   image,
+			Status:  imageStatusNotScanned,
+		}
+		checks = append(checks, check)
+		img := dockerfile.ParseBaseImage(image)
+		if !strings.Contains(img.Name, ecrRegistryHostInfix) {
+			continue
+		}
+		repo := img.Name[strings.Index(img.Name, "/")+1:]
+		tagOrDigest := img.Digest
+		if tagOrDigest == "" {
+			tagOrDigest = img.Tag
+		}
+		findings, err := scanner.ImageScanFindings(repo, tagOrDigest)
+		if err != nil {
+			return nil, fmt.Errorf("get scan findings of image %s: %w", image, err)
+		}
+		if findings == nil || findings.Status != ecrScanStatusComplete {
+			continue
+		}
+		check.Findings = findings.Counts
+		check.Status = imageStatusClean
+		for _, severity := range vulnerableSeverities {
+			if findings.Counts[severity] > 0 {
+				check.Status = imageStatusVulnerable
+			}
+		}
+	}
+	return checks, nil
+}
+
+// fixBaseImages rewrites the stale images in the Dockerfiles and manifests to their latest version.
+func (o *checkImagesSvcOpts) fixBaseImages(checks []*baseImageCheck) error {
+	for _, check := range checks {
+		if check.Status != imageStatusStale {
+			continue
+		}
+		info, err := o.fs.Stat(check.path)
+		if err != nil {
+			return fmt.Errorf("stat %s: %w", check.path, err)
+		}
+		content, err := afero.ReadFile(o.fs, check.path)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", check.path, err)
+		}
+		lines := strings.Split(string(content), "\n")
+		if check.line < 1 || check.line > len(lines) || !strings.Contains(lines[check.line-1], check.Image) {
+			log.Warningf("Could not find image %s at %s, skipping the update to %s\n", check.Image, check.Source, check.Latest)
+			continue
+		}
+		lines[check.line-1] = strings.Replace(lines[check.line-1], check.Image, check.Latest, 1)
+		if err := afero.WriteFile(o.fs, check.path, []byte(strings.Join(lines, "\n")), info.Mode()); err != nil {
+			return fmt.Errorf("write %s: %w", check.path, err)
+		}
+		log.Successf("Updated %s from %s to %s.\n", check.Source, check.Image, color.HighlightUserInput(check.Latest))
+	}
+	return nil
+}
+
+func (o *checkImagesSvcOpts) jsonOutput(baseImages []*baseImageCheck, deployedImages []*deployedImageCheck) error {
+	type serializedImages struct {
+		BaseImages     []*baseImageCheck     `json:"baseImages"`
+		DeployedImages []*deployedImageCheck `json:"deployedImages,omitempty"`
+	}
+	b, err := json.Marshal(serializedImages{
+		BaseImages:     baseImages,
+		DeployedImages: deployedImages,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal images: %w", err)
+	}
+	fmt.Fprintf(o.w, "%s\n", b)
+	return nil
+}
+
+func (o *checkImagesSvcOpts) humanOutput(baseImages []*baseImageCheck, deployedImages []*deployedImageCheck) {
+	writer := tabwriter.NewWriter(o.w, 0, 4, 2, ' ', 0)
+	fmt.Fprint(writer, color.Bold.Sprint("Base Images\n\n"))
+	writer.Flush()
+	if len(baseImages) == 0 {
+		fmt.Fprintln(writer, "  No base images found.")
+		writer.Flush()
+	} else {
+		writeTable(writer, []string{"Service", "Source", "Image", "Latest", "Status"}, func() {
+			for _, check := range baseImages {
+				latest := check.Latest
+				if latest == "" {
+					latest = "-"
+				}
+				fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", check.Service, check.Source, check.Image, latest, colorImageStatus(check.Status))
+			}
+		})
+	}
+	if o.targetEnv == nil {
+		return
+	}
+	fmt.Fprint(writer, color.Bold.Sprintf("\nDeployed Images in %s\n\n", o.envName))
+	writer.Flush()
+	if len(deployedImages) == 0 {
+		fmt.Fprintln(writer, "  No deployed services found.")
+		writer.Flush()
+		return
+	}
+	headers := []string{"Service", "Image"}
+	headers = append(headers, vulnerableSeverities...)
+	headers = append(headers, "Status")
+	writeTable(writer, headers, func() {
+		for _, check := range deployedImages {
+			row := []string{check.Service, check.Image}
+			for _, severity := range vulnerableSeverities {
+				count := "-"
+				if check.Findings != nil {
+					count = fmt.Sprintf("%d", check.Findings[severity])
+				}
+				row = append(row, count)
+			}
+			row = append(row, colorImageStatus(check.Status))
+			fmt.Fprintf(writer, "  %s\n", strings.Join(row, "\t"))
+		}
+	})
+}
+
+func colorImageStatus(status string) string {
+	switch status {
+	case imageStatusStale, imageStatusVulnerable:
+		return color.Red.Sprint(status)
+	case imageStatusUnpinned, imageStatusUnknown, imageStatusNotScanned:
+		return color.Yellow.Sprint(status)
+	default:
+		return color.Green.Sprint(status)
+	}
+}
+
+// buildSvcCheckImagesCmd builds the command for checking whether the images of services are up to date.
+func buildSvcCheckImagesCmd() *cobra.Command {
+	vars := checkImagesSvcVars{}
+	cmd := &cobra.Command{
+		Use:   "check-images",
+		Short: "Checks whether the base images of your services are stale or vulnerable.",
+		Long: `Checks whether the base images of your services are stale or vulnerable.
+Base images are read from the FROM instructions of the Dockerfiles, or from the image location in the manifests.
+An image is stale if its registry has a newer version of the tag, or if the tag that it's pinned to moved to a new digest.`,
+		Example: `
+  Check the base images of all the services in the workspace.
+  /code $ copilot svc check-images
+  Also report the vulnerabilities found by ECR in the images deployed to the "prod" environment.
+  /code $ copilot svc check-images -e prod
+  Update the stale base images of the "api" service in its Dockerfile.
+  /code $ copilot svc check-images -n api --fix`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newCheckImagesSvcOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcCheckImagesNameFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", svcCheckImagesEnvFlagDescription)
+	cmd.Flags().BoolVar(&vars.fix, fixFlag, false, svcCheckImagesFixFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
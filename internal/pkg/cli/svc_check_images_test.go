@@ -0,0 +1,270 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+type checkImagesSvcMocks struct {
+	store    *mocks.Mockstore
+	ws       *mocks.MockwsImageReader
+	registry *mocks.MockimageRegistry
+	scanner  *mocks.MockimageScanner
+}
+
+func TestCheckImagesSvcOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inApp string
+		inSvc string
+		inEnv string
+
+		setupMocks func(m checkImagesSvcMocks)
+
+		wantedEnv   *config.Environment
+		wantedError error
+	}{
+		"error if not in a workspace with an application": {
+			setupMocks:  func(m checkImagesSvcMocks) {},
+			wantedError: errNoAppInWorkspace,
+		},
+		"error if the application does not exist": {
+			inApp: "phonetool",
+			setupMocks: func(m checkImagesSvcMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get application phonetool: some error"),
+		},
+		"error if the service is not in the workspace": {
+			inApp: "phonetool",
+			inSvc: "api",
+			setupMocks: func(m checkImagesSvcMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.ws.EXPECT().ListServices().Return([]string{"web"}, nil)
+			},
+			wantedError: errors.New("service api not found in the workspace"),
+		},
+		"error if the environment does not exist": {
+			inApp: "phonetool",
+			inEnv: "prod",
+			setupMocks: func(m checkImagesSvcMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "prod").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get environment prod configuration: some error"),
+		},
+		"valid service and environment": {
+			inApp: "phonetool",
+			inSvc: "api",
+			inEnv: "prod",
+			setupMocks: func(m checkImagesSvcMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.ws.EXPECT().ListServices().Return([]string{"web", "api"}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{Name: "prod"}, nil)
+			},
+			wantedEnv: &config.Environment{Name: "prod"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := checkImagesSvcMocks{
+				store: mocks.NewMockstore(ctrl),
+				ws:    mocks.NewMockwsImageReader(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &checkImagesSvcOpts{
+				checkImagesSvcVars: checkImagesSvcVars{
+					appName: tc.inApp,
+					name:    tc.inSvc,
+					envName: tc.inEnv,
+				},
+				store: m.store,
+				ws:    m.ws,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnv, opts.targetEnv)
+		})
+	}
+}
+
+func TestCheckImagesSvcOpts_Execute(t *testing.T) {
+	const (
+		apiManifest = `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+`
+		apiDockerfile = `FROM node:16-alpine AS build
+RUN npm ci
+
+FROM nginx
+COPY --from=build /app /usr/share/nginx/html
+`
+		webManifest = `name: web
+type: Backend Service
+image:
+  # Pinned by the platform team.
+  location: public.ecr.aws/nginx/nginx:1.23@sha256:aaa
+`
+	)
+	testCases := map[string]struct {
+		inSvc  string
+		inEnv  *config.Environment
+		inFix  bool
+		inJSON bool
+
+		setupMocks func(m checkImagesSvcMocks)
+
+		wantedOutput     string
+		wantedDockerfile string
+		wantedManifest   string
+		wantedError      error
+	}{
+		"error if fails to read the manifest": {
+			inSvc: "api",
+			setupMocks: func(m checkImagesSvcMocks) {
+				m.ws.EXPECT().Path().Return("/ws", nil)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("read manifest file for service api: some error"),
+		},
+		"report the stale and unpinned images of all the services": {
+			setupMocks: func(m checkImagesSvcMocks) {
+				m.ws.EXPECT().ListServices().Return([]string{"api", "web"}, nil)
+				m.ws.EXPECT().Path().Return("/ws", nil)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(apiManifest), nil)
+				m.ws.EXPECT().ReadWorkloadManifest("web").Return([]byte(webManifest), nil)
+				m.registry.EXPECT().Tags("node").Return([]string{"16-alpine", "18-alpine", "18"}, nil)
+				m.registry.EXPECT().Tags("public.ecr.aws/nginx/nginx").Return(nil, errors.New("some error"))
+			},
+			wantedOutput: `Base Images
+
+  Service  Source                      Image                                       Latest          Status
+  -------  ------                      -----                                       ------          ------
+  api      api/Dockerfile:1            node:16-alpine                              node:18-alpine  stale
+  api      api/Dockerfile:4            nginx                                       -               unpinned
+  web      copilot/web/manifest.yml:5  public.ecr.aws/nginx/nginx:1.23@sha256:aaa  -               unknown
+`,
+		},
+		"update the stale images in place": {
+			inFix:  true,
+			inJSON: true,
+			setupMocks: func(m checkImagesSvcMocks) {
+				m.ws.EXPECT().ListServices().Return([]string{"api", "web"}, nil)
+				m.ws.EXPECT().Path().Return("/ws", nil)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(apiManifest), nil)
+				m.ws.EXPECT().ReadWorkloadManifest("web").Return([]byte(webManifest), nil)
+				m.registry.EXPECT().Tags("node").Return([]string{"16-alpine", "18-alpine"}, nil)
+				m.registry.EXPECT().Tags("public.ecr.aws/nginx/nginx").Return([]string{"1.23", "1.24"}, nil)
+				m.registry.EXPECT().Digest("public.ecr.aws/nginx/nginx", "1.24").Return("sha256:bbb", nil)
+			},
+			wantedOutput: `{"baseImages":[{"service":"api","source":"api/Dockerfile:1","image":"node:16-alpine","latest":"node:18-alpine","status":"stale"},` +
+				`{"service":"api","source":"api/Dockerfile:4","image":"nginx","status":"unpinned"},` +
+				`{"service":"web","source":"copilot/web/manifest.yml:5","image":"public.ecr.aws/nginx/nginx:1.23@sha256:aaa","latest":"public.ecr.aws/nginx/nginx:1.24@sha256:bbb","status":"stale"}]}` + "\n",
+			wantedDockerfile: `FROM node:18-alpine AS build
+RUN npm ci
+
+FROM nginx
+COPY --from=build /app /usr/share/nginx/html
+`,
+			wantedManifest: `name: web
+type: Backend Service
+image:
+  # Pinned by the platform team.
+  location: public.ecr.aws/nginx/nginx:1.24@sha256:bbb
+`,
+		},
+		"report the vulnerabilities of the deployed images": {
+			inSvc:  "web",
+			inEnv:  &config.Environment{Name: "prod", Region: "us-west-2"},
+			inJSON: true,
+			setupMocks: func(m checkImagesSvcMocks) {
+				m.ws.EXPECT().Path().Return("/ws", nil)
+				m.ws.EXPECT().ReadWorkloadManifest("web").Return([]byte(webManifest), nil)
+				m.registry.EXPECT().Tags("public.ecr.aws/nginx/nginx").Return([]string{"1.23"}, nil)
+				m.registry.EXPECT().Digest("public.ecr.aws/nginx/nginx", "1.23").Return("sha256:aaa", nil)
+				m.scanner.EXPECT().ImageScanFindings("phonetool/web", "sha256:ccc").Return(&ecr.ScanFindings{
+					Digest: "sha256:ccc",
+					Status: "COMPLETE",
+					Counts: map[string]int64{"HIGH": 2},
+				}, nil)
+			},
+			wantedOutput: `{"baseImages":[{"service":"web","source":"copilot/web/manifest.yml:5","image":"public.ecr.aws/nginx/nginx:1.23@sha256:aaa","status":"up-to-date"}],` +
+				`"deployedImages":[{"service":"web","image":"111111111111.dkr.ecr.us-west-2.amazonaws.com/phonetool/web@sha256:ccc","findings":{"HIGH":2},"status":"vulnerable"}]}` + "\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := checkImagesSvcMocks{
+				ws:       mocks.NewMockwsImageReader(ctrl),
+				registry: mocks.NewMockimageRegistry(ctrl),
+				scanner:  mocks.NewMockimageScanner(ctrl),
+			}
+			tc.setupMocks(m)
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "/ws/api/Dockerfile", []byte(apiDockerfile), 0644))
+			require.NoError(t, afero.WriteFile(fs, "/ws/copilot/web/manifest.yml", []byte(webManifest), 0644))
+			var out bytes.Buffer
+			opts := &checkImagesSvcOpts{
+				checkImagesSvcVars: checkImagesSvcVars{
+					appName:          "phonetool",
+					name:             tc.inSvc,
+					fix:              tc.inFix,
+					shouldOutputJSON: tc.inJSON,
+				},
+				ws:        m.ws,
+				fs:        fs,
+				registry:  m.registry,
+				w:         &out,
+				targetEnv: tc.inEnv,
+				deployedImage: func(env *config.Environment, svc string) (string, error) {
+					return "111111111111.dkr.ecr.us-west-2.amazonaws.com/phonetool/" + svc + "@sha256:ccc", nil
+				},
+				newScanner: func(region string) (imageScanner, error) {
+					require.Equal(t, "us-west-2", region)
+					return m.scanner, nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, out.String())
+			if tc.wantedDockerfile != "" {
+				content, err := afero.ReadFile(fs, "/ws/api/Dockerfile")
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDockerfile, string(content))
+			}
+			if tc.wantedManifest != "" {
+				content, err := afero.ReadFile(fs, "/ws/copilot/web/manifest.yml")
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedManifest, string(content))
+			}
+		})
+	}
+}
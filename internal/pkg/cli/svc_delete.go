@@ -21,6 +21,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -178,11 +179,18 @@ func (o *deleteSvcOpts) Execute() error {
 		return nil
 	}
 
-	if err := o.emptyECRRepos(envs); err != nil {
-		return err
+	svc, err := o.store.GetService(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get service %s configuration: %w", o.name, err)
 	}
-	if err := o.removeSvcFromApp(); err != nil {
-		return err
+	// The image repository and the application resources of an instance belong to the service it's an instance of.
+	if svc.InstanceOf == "" {
+		if err := o.emptyECRRepos(envs); err != nil {
+			return err
+		}
+		if err := o.removeSvcFromApp(); err != nil {
+			return err
+		}
 	}
 	if err := o.deleteSSMParam(); err != nil {
 		return err
@@ -284,14 +292,24 @@ func (o *deleteSvcOpts) emptyECRRepos(envs []*config.Environment) error {
 		}
 	}
 
-	// TODO: centralized ECR repo name
-	repoName := fmt.Sprintf("%s/%s", o.appName, o.name)
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	repoName := stack.NameForWorkloadRepository(o.appName, o.name, app.SharedRepository)
 	for _, region := range uniqueRegions {
 		sess, err := o.sess.DefaultWithRegion(region)
 		if err != nil {
 			return err
 		}
 		client := o.getECR(sess)
+		if app.SharedRepository {
+			// Only delete the images of the workload from the repository shared by the application.
+			if err := client.ClearImagesWithTagPrefix(repoName, stack.SharedRepositoryImageTag(o.name, "")); err != nil {
+				return err
+			}
+			continue
+		}
 		if err := client.ClearRepository(repoName); err != nil {
 			return err
 		}
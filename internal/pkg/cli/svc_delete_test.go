@@ -354,9 +354,11 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, mockSvcName, mockEnvName)),
 
-					mocks.sessProvider.EXPECT().DefaultWithRegion(gomock.Any()).Return(&session.Session{}, nil),
+					mocks.store.EXPECT().GetService(mockAppName, mockSvcName).Return(&config.Workload{Name: mockSvcName}, nil),
 
 					// emptyECRRepos
+					mocks.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil),
+					mocks.sessProvider.EXPECT().DefaultWithRegion(gomock.Any()).Return(&session.Session{}, nil),
 					mocks.ecr.EXPECT().ClearRepository(mockRepo).Return(nil),
 
 					// removeSvcFromApp
@@ -371,6 +373,69 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 			},
 			wantedError: nil,
 		},
+		"only delete the images of the service from a shared repository": {
+			inAppName: mockAppName,
+			inSvcName: mockSvcName,
+			setupMocks: func(mocks deleteSvcMocks) {
+				sharedApp := &config.Application{
+					Name:             mockAppName,
+					SharedRepository: true,
+				}
+				gomock.InOrder(
+					// appEnvironments
+					mocks.store.EXPECT().ListEnvironments(gomock.Eq(mockAppName)).Times(1).Return(mockEnvs, nil),
+
+					mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil),
+					// deleteStacks
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
+					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, mockSvcName, mockEnvName)),
+
+					mocks.store.EXPECT().GetService(mockAppName, mockSvcName).Return(&config.Workload{Name: mockSvcName}, nil),
+
+					// emptyECRRepos
+					mocks.store.EXPECT().GetApplication(mockAppName).Return(sharedApp, nil),
+					mocks.sessProvider.EXPECT().DefaultWithRegion(gomock.Any()).Return(&session.Session{}, nil),
+					mocks.ecr.EXPECT().ClearImagesWithTagPrefix(mockAppName, "backend-").Return(nil),
+
+					// removeSvcFromApp
+					mocks.store.EXPECT().GetApplication(mockAppName).Return(sharedApp, nil),
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteResourcesStart, mockSvcName, mockAppName)),
+					mocks.appCFN.EXPECT().RemoveServiceFromApp(sharedApp, mockSvcName).Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteResourcesComplete, mockSvcName, mockAppName)),
+
+					// deleteSSMParam
+					mocks.store.EXPECT().DeleteService(mockAppName, mockSvcName).Return(nil),
+				)
+				mocks.ecr.EXPECT().ClearRepository(gomock.Any()).Times(0)
+			},
+		},
+		"keep the repository and the application resources when deleting an instance": {
+			inAppName: mockAppName,
+			inSvcName: "backend-tenant-a",
+			setupMocks: func(mocks deleteSvcMocks) {
+				gomock.InOrder(
+					// appEnvironments
+					mocks.store.EXPECT().ListEnvironments(gomock.Eq(mockAppName)).Times(1).Return(mockEnvs, nil),
+
+					mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil),
+					// deleteStacks
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, "backend-tenant-a", mockEnvName)),
+					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, "backend-tenant-a", mockEnvName)),
+
+					mocks.store.EXPECT().GetService(mockAppName, "backend-tenant-a").Return(&config.Workload{
+						Name:       "backend-tenant-a",
+						InstanceOf: mockSvcName,
+					}, nil),
+
+					// deleteSSMParam
+					mocks.store.EXPECT().DeleteService(mockAppName, "backend-tenant-a").Return(nil),
+				)
+				mocks.ecr.EXPECT().ClearRepository(gomock.Any()).Times(0)
+				mocks.appCFN.EXPECT().RemoveServiceFromApp(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
 		// A service can be deployed to multiple
 		// environments - and deleting it in one
 		// should not delete it form the entire app.
@@ -4,25 +4,35 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/probe"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -31,14 +41,51 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 )
 
+const (
+	fmtSvcDeployRecreatePrompt   = "The stack %s failed to be created and was rolled back. Would you like to delete and recreate it?"
+	svcDeployRecreateHelpPrompt  = "A stack in ROLLBACK_COMPLETE state cannot be updated, it must be deleted before the service can be deployed again."
+	continueSvcDeploymentPrompt  = "Continue with the deployment?"
+	fmtSvcSecurityChangesPrompt  = "Deploying service %s changes its IAM or security group resources. Continue with the deployment?"
+	svcSecurityChangesHelpPrompt = "Review the permission changes before deploying them. Run with --yes-security to skip this confirmation."
+	fmtSvcPromotePrompt          = "Service %s is deployed to environment %s. Continue with the deployment to environment %s?"
+	svcPromoteHelpPrompt         = "Verify the service in the environment before promoting it. Run with --soak-time to monitor its alarms instead of confirming."
+)
+
+const (
+	// envNamesSeparator separates the environments to deploy to in order with --env.
+	envNamesSeparator = ","
+	// soakAlarmsPollInterval is how often the alarms of a service are checked while it soaks in an environment.
+	soakAlarmsPollInterval = 30 * time.Second
+	alarmStateAlarm        = "ALARM"
+)
+
+// Where the container image of a service is built.
+const (
+	buildLocal  = "local"
+	buildRemote = "remote"
+)
+
 type deployWkldVars struct {
 	appName         string
 	name            string
 	envName         string
 	imageTag        string
+	extraImageTags  []string
 	resourceTags    map[string]string
-	forceNewUpdate  bool // NOTE: this variable is not applicable for a job workload currently.
+	forceNewUpdate  bool // NOTE: for a job workload, this only recreates a stack whose first deployment was rolled back.
 	disableRollback bool
+	templatePath    string
+	paramsPath      string
+	showDiff        bool          // NOTE: this variable is not applicable for a job workload currently.
+	yesSecurity     bool          // NOTE: this variable is not applicable for a job workload currently.
+	noWait          bool          // NOTE: this variable is not applicable for a job workload currently.
+	watch           bool          // NOTE: this variable is not applicable for a job workload currently.
+	instance        string        // NOTE: this variable is not applicable for a job workload currently.
+	image           string        // NOTE: this variable is not applicable for a job workload currently.
+	build           string        // NOTE: this variable is not applicable for a job workload currently.
+	schedule        string        // NOTE: this variable is only applicable for a job workload.
+	soakTime        time.Duration // NOTE: this variable is not applicable for a job workload currently.
+	checkURI        bool          // NOTE: this variable is not applicable for a job workload currently.
 
 	// To facilitate unit tests.
 	clientConfigured bool
@@ -52,13 +99,25 @@ type deploySvcOpts struct {
 	unmarshal            func([]byte) (manifest.WorkloadManifest, error)
 	newInterpolator      func(app, env string) interpolator
 	cmd                  execRunner
+	fs                   afero.Fs
 	sessProvider         *sessions.Provider
 	newSvcDeployer       func() (workloadDeployer, error)
+	newStackRenderer     func(env *config.Environment) (workloadStackRenderer, error)
+	newAlarmsGetter      func(env *config.Environment) (taggedAlarmsGetter, error)
+	newURIDescriber      func() (serviceURIDescriber, error)
+	prober               endpointProber
 	envFeaturesDescriber versionCompatibilityChecker
 
-	spinner progress
-	sel     wsSelector
-	prompt  prompter
+	spinner    progress
+	sel        wsSelector
+	prompt     prompter
+	diffWriter io.Writer
+	uriWriter  io.Writer
+	now        func() time.Time
+	sleep      func(time.Duration)
+
+	progressOut    termprogress.FileWriter // Optional. Where to render the progress of the deployment, defaults to os.Stderr.
+	nonInteractive bool                    // Fail instead of prompting, since the service is deployed alongside others.
 
 	// cached variables
 	targetApp       *config.Application
@@ -66,7 +125,11 @@ type deploySvcOpts struct {
 	svcType         string
 	appliedManifest interface{}
 	rootUserARN     string
+	deployerARN     string
+	commit          string // Git commit of the workspace, recorded with each deployment.
 	deployRecs      clideploy.ActionRecommender
+	deployCanceled  bool
+	builtImages     map[string]string // Digests of the images built for the previous environments by region.
 }
 
 func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
@@ -93,14 +156,42 @@ func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
 		spinner:         termprogress.NewSpinner(log.DiagnosticWriter),
 		sel:             selector.NewLocalWorkloadSelector(prompter, store, ws),
 		prompt:          prompter,
+		diffWriter:      log.OutputWriter,
+		uriWriter:       log.DiagnosticWriter,
+		prober:          probe.New(probe.DefaultTimeout),
 		newInterpolator: newManifestInterpolator,
 		cmd:             exec.NewCmd(),
+		fs:              afero.NewOsFs(),
 		sessProvider:    sessProvider,
+		now:             time.Now,
+		sleep:           time.Sleep,
+	}
+	if vars.instance != "" {
+		opts.newInterpolator = func(app, env string) interpolator {
+			return manifest.NewInstanceInterpolator(app, env, vars.instance)
+		}
 	}
 	opts.newSvcDeployer = func() (workloadDeployer, error) {
 		// NOTE: Defined as a struct member to facilitate unit testing.
 		return newSvcDeployer(opts)
 	}
+	opts.newStackRenderer = func(env *config.Environment) (workloadStackRenderer, error) {
+		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		return deploycfn.New(sess), nil
+	}
+	opts.newAlarmsGetter = func(env *config.Environment) (taggedAlarmsGetter, error) {
+		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		return cloudwatch.New(sess), nil
+	}
+	opts.newURIDescriber = func() (serviceURIDescriber, error) {
+		return describe.NewReachableService(opts.appName, opts.workloadName(), opts.store)
+	}
 	return opts, err
 }
 
@@ -113,16 +204,44 @@ func newSvcDeployer(o *deploySvcOpts) (workloadDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("read manifest file for %s: %w", o.name, err)
 	}
-	var deployer workloadDeployer
+	wsPath, err := o.ws.Path()
+	if err != nil {
+		return nil, fmt.Errorf("get workspace path: %w", err)
+	}
 	in := clideploy.WorkloadDeployerInput{
 		SessionProvider: o.sessProvider,
 		Name:            o.name,
+		Instance:        o.instance,
 		App:             targetApp,
 		Env:             o.targetEnv,
-		ImageTag:        o.imageTag,
+		RemoteBuild:     o.build == buildRemote,
 		Mft:             o.appliedManifest,
 		RawMft:          raw,
+		ProgressOut:     o.progressOut,
+	}
+	if imageDigestRegexp.MatchString(o.image) {
+		in.ImageDigest = o.image
+	} else if digest, ok := o.builtImages[o.targetEnv.Region]; ok && o.image == "" {
+		// Reuse the image built for a previous environment in the same region instead of building it again.
+		in.ImageDigest = digest
 	}
+	if o.image == "" {
+		tag, err := imageTag(&imageTagInput{
+			userTag: o.imageTag,
+			mft:     o.appliedManifest,
+			runner:  o.cmd,
+			fs:      o.fs,
+			wsPath:  wsPath,
+			now:     time.Now,
+		})
+		if err != nil {
+			return nil, err
+		}
+		o.imageTag = tag
+		in.ImageTag = o.imageTag
+		in.ExtraImageTags = o.extraImageTags
+	}
+	var deployer workloadDeployer
 	switch t := o.appliedManifest.(type) {
 	case *manifest.LoadBalancedWebService:
 		deployer, err = clideploy.NewLBWSDeployer(&in)
@@ -147,6 +266,146 @@ func newManifestInterpolator(app, env string) interpolator {
 
 // Validate returns an error for any invalid optional flags.
 func (o *deploySvcOpts) Validate() error {
+	if err := o.validatePackagedTemplate(); err != nil {
+		return err
+	}
+	if o.instance != "" {
+		if err := basicNameValidation(o.instance); err != nil {
+			return fmt.Errorf("instance name %s is invalid: %w", o.instance, err)
+		}
+	}
+	if err := o.validateImage(); err != nil {
+		return err
+	}
+	if err := o.validateBuild(); err != nil {
+		return err
+	}
+	if err := o.validateEnvNames(); err != nil {
+		return err
+	}
+	return o.validateNoWait()
+}
+
+// validateEnvNames returns an error if the environments to deploy to in order are invalid or conflict with other flags.
+func (o *deploySvcOpts) validateEnvNames() error {
+	if o.soakTime < 0 {
+		return fmt.Errorf("--%s must be a positive duration", soakTimeFlag)
+	}
+	envs := o.targetEnvNames()
+	if len(envs) <= 1 {
+		if o.soakTime > 0 {
+			return fmt.Errorf("--%s requires multiple environments in --%s", soakTimeFlag, envFlag)
+		}
+		return nil
+	}
+	seen := make(map[string]bool)
+	for _, env := range envs {
+		if env == "" {
+			return fmt.Errorf("--%s %s must not contain empty environment names", envFlag, o.envName)
+		}
+		if seen[env] {
+			return fmt.Errorf("--%s %s must not contain environment %s more than once", envFlag, o.envName, env)
+		}
+		seen[env] = true
+	}
+	for _, flag := range []struct {
+		name  string
+		isSet bool
+	}{
+		{templateFlag, o.templatePath != ""},
+		{noWaitFlag, o.noWait},
+		{watchFlag, o.watch},
+	} {
+		if flag.isSet {
+			return fmt.Errorf("cannot specify both multiple environments in --%s and --%s", envFlag, flag.name)
+		}
+	}
+	return nil
+}
+
+// targetEnvNames returns the environments to deploy the service to, in order.
+func (o *deploySvcOpts) targetEnvNames() []string {
+	if o.envName == "" {
+		return nil
+	}
+	envs := strings.Split(o.envName, envNamesSeparator)
+	for i := range envs {
+		envs[i] = strings.TrimSpace(envs[i])
+	}
+	return envs
+}
+
+// validateBuild returns an error if the location to build the image in is invalid or conflicts with other flags.
+func (o *deploySvcOpts) validateBuild() error {
+	switch o.build {
+	case "", buildLocal:
+		return nil
+	case buildRemote:
+	default:
+		return fmt.Errorf(`--%s must be one of "%s" or "%s"`, buildFlag, buildLocal, buildRemote)
+	}
+	for _, flag := range []struct {
+		name  string
+		isSet bool
+	}{
+		{imageFlag, o.image != ""},
+		{templateFlag, o.templatePath != ""},
+	} {
+		if flag.isSet {
+			return fmt.Errorf("cannot specify both --%s %s and --%s", buildFlag, buildRemote, flag.name)
+		}
+	}
+	return nil
+}
+
+// validateImage returns an error if the image to deploy instead of building one is invalid or conflicts with other flags.
+func (o *deploySvcOpts) validateImage() error {
+	if o.image == "" {
+		return nil
+	}
+	if err := validateDeployImage(o.image); err != nil {
+		return err
+	}
+	for _, flag := range []struct {
+		name  string
+		isSet bool
+	}{
+		{imageTagFlag, o.imageTag != ""},
+		{templateFlag, o.templatePath != ""},
+		{watchFlag, o.watch},
+	} {
+		if flag.isSet {
+			return fmt.Errorf("cannot specify both --%s and --%s", imageFlag, flag.name)
+		}
+	}
+	return nil
+}
+
+// validateNoWait returns an error if the flags to deploy without waiting or to follow a deployment conflict with other flags.
+func (o *deploySvcOpts) validateNoWait() error {
+	if o.watch {
+		for _, flag := range []struct {
+			name  string
+			isSet bool
+		}{
+			{noWaitFlag, o.noWait},
+			{forceFlag, o.forceNewUpdate},
+			{diffFlag, o.showDiff},
+			{imageTagFlag, o.imageTag != ""},
+			{templateFlag, o.templatePath != ""},
+			{checkURIFlag, o.checkURI},
+		} {
+			if flag.isSet {
+				return fmt.Errorf("cannot specify both --%s and --%s", watchFlag, flag.name)
+			}
+		}
+	}
+	if o.noWait && o.forceNewUpdate {
+		return fmt.Errorf("cannot specify both --%s and --%s", noWaitFlag, forceFlag)
+	}
+	if o.noWait && o.checkURI {
+		return fmt.Errorf("cannot specify both --%s and --%s", noWaitFlag, checkURIFlag)
+	}
 	return nil
 }
 
@@ -172,7 +431,91 @@ func (o *deploySvcOpts) Ask() error {
 }
 
 // Execute builds and pushes the container image for the service,
+// and deploys it to each target environment in order.
 func (o *deploySvcOpts) Execute() error {
+	if o.watch {
+		return o.attachToDeployment()
+	}
+	envs := o.targetEnvNames()
+	for i, env := range envs {
+		if i > 0 {
+			proceed, err := o.approvePromotion(envs[i-1], env)
+			if err != nil {
+				return err
+			}
+			if !proceed {
+				o.deployCanceled = true
+				return nil
+			}
+		}
+		o.envName = env
+		if err := o.deployToEnv(); err != nil {
+			return err
+		}
+		if o.deployCanceled {
+			return nil
+		}
+	}
+	return nil
+}
+
+// approvePromotion returns true if the service deployed to an environment can be deployed to the next one.
+// Without a soak time, the user confirms the promotion. Otherwise, the alarms of the service are monitored during the soak time.
+func (o *deploySvcOpts) approvePromotion(from, to string) (bool, error) {
+	if o.soakTime == 0 {
+		proceed, err := o.prompt.Confirm(fmt.Sprintf(fmtSvcPromotePrompt, o.workloadName(), from, to), svcPromoteHelpPrompt)
+		if err != nil {
+			return false, fmt.Errorf("confirm deployment of service %s to environment %s: %w", o.workloadName(), to, err)
+		}
+		return proceed, nil
+	}
+	if err := o.soak(from); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// soak monitors the alarms of the service in the environment during the soak time,
+// and returns an error as soon as one of them goes into the ALARM state.
+func (o *deploySvcOpts) soak(env string) error {
+	alarms, err := o.newAlarmsGetter(o.targetEnv)
+	if err != nil {
+		return err
+	}
+	log.Infof("Monitoring the alarms of service %s in environment %s for %s.\n", color.HighlightUserInput(o.workloadName()), env, o.soakTime)
+	deadline := o.now().Add(o.soakTime)
+	for {
+		statuses, err := alarms.AlarmsWithTags(map[string]string{
+			deploy.AppTagKey:     o.appName,
+			deploy.EnvTagKey:     env,
+			deploy.ServiceTagKey: o.workloadName(),
+		})
+		if err != nil {
+			return fmt.Errorf("get CloudWatch alarms of service %s in environment %s: %w", o.workloadName(), env, err)
+		}
+		var firing []string
+		for _, status := range statuses {
+			if status.Status == alarmStateAlarm {
+				firing = append(firing, status.Name)
+			}
+		}
+		if len(firing) > 0 {
+			return fmt.Errorf("stop deploying service %s: alarms %s are in the ALARM state in environment %s",
+				o.workloadName(), strings.Join(firing, ", "), env)
+		}
+		remaining := deadline.Sub(o.now())
+		if remaining <= 0 {
+			return nil
+		}
+		if remaining > soakAlarmsPollInterval {
+			remaining = soakAlarmsPollInterval
+		}
+		o.sleep(remaining)
+	}
+}
+
+// deployToEnv builds and pushes the container image for the service if needed, and deploys it to the environment o.envName.
+func (o *deploySvcOpts) deployToEnv() error {
 	if !o.clientConfigured {
 		if err := o.configureClients(); err != nil {
 			return err
@@ -189,6 +532,15 @@ func (o *deploySvcOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	if err := manifest.ApplyInstance(mft, o.instance); err != nil {
+		return err
+	}
+	if err := o.applyImage(mft); err != nil {
+		return err
+	}
+	if o.targetEnv.Preview != nil {
+		manifest.ApplyPreviewSizing(mft)
+	}
 	o.appliedManifest = mft
 	if err := validateWorkloadManifestCompatibilityWithEnv(o.ws, o.envFeaturesDescriber, mft, o.envName); err != nil {
 		return err
@@ -206,31 +558,81 @@ func (o *deploySvcOpts) Execute() error {
 		log.Warningf(`%s might not be available in region %s; proceed with caution.
 `, o.svcType, o.targetEnv.Region)
 	}
-	uploadOut, err := deployer.UploadArtifacts()
-	if err != nil {
+	uploadOut := &clideploy.UploadArtifactsOutput{}
+	var packaged *deploy.PackagedTemplate
+	if o.templatePath != "" {
+		// The artifacts referenced by a packaged template were uploaded when it was generated.
+		if packaged, err = readPackagedTemplate(o.fs, o.templatePath, o.paramsPath); err != nil {
+			return err
+		}
+	} else if uploadOut, err = deployer.UploadArtifacts(); err != nil {
 		return fmt.Errorf("upload deploy resources for service %s: %w", o.name, err)
 	}
+	o.recordBuiltImage(uploadOut.ImageDigest)
 	targetApp, err := o.getTargetApp()
 	if err != nil {
 		return err
 	}
-	deployRecs, err := deployer.DeployWorkload(&clideploy.DeployWorkloadInput{
+	deployIn := &clideploy.DeployWorkloadInput{
 		StackRuntimeConfiguration: clideploy.StackRuntimeConfiguration{
 			ImageDigest:        uploadOut.ImageDigest,
 			EnvFileARN:         uploadOut.EnvFileARN,
 			AddonsURL:          uploadOut.AddonsURL,
 			RootUserARN:        o.rootUserARN,
+			Deployer:           o.deployerARN,
+			Commit:             o.commit,
 			Tags:               tags.Merge(targetApp.Tags, o.resourceTags),
 			CustomResourceURLs: uploadOut.CustomResourceURLs,
 		},
 		Options: clideploy.Options{
-			ForceNewUpdate:  o.forceNewUpdate,
-			DisableRollback: o.disableRollback,
+			ForceNewUpdate:          o.forceNewUpdate,
+			DisableRollback:         o.disableRollback,
+			NoWait:                  o.noWait,
+			RecreateRolledBackStack: o.forceNewUpdate,
+			Packaged:                packaged,
 		},
-	})
+	}
+	if o.showDiff {
+		contd, err := o.showDiffAndConfirm(deployer, deployIn.StackRuntimeConfiguration)
+		if err != nil {
+			return err
+		}
+		if !contd {
+			o.deployCanceled = true
+			return nil
+		}
+	} else if !o.yesSecurity && packaged == nil {
+		contd, err := o.confirmSecurityChanges(deployer, deployIn.StackRuntimeConfiguration)
+		if err != nil {
+			return err
+		}
+		if !contd {
+			o.deployCanceled = true
+			return nil
+		}
+	}
+	if err := o.registerInstance(); err != nil {
+		return err
+	}
+	deployRecs, err := deployer.DeployWorkload(deployIn)
+	var errRollbackComplete *deploycfn.ErrStackRollbackComplete
+	if errors.As(err, &errRollbackComplete) && o.nonInteractive {
+		err = fmt.Errorf("%w: run with --%s to delete and recreate it", err, forceFlag)
+	} else if errors.As(err, &errRollbackComplete) {
+		recreate, promptErr := o.prompt.Confirm(fmt.Sprintf(fmtSvcDeployRecreatePrompt, errRollbackComplete.StackName), svcDeployRecreateHelpPrompt)
+		if promptErr != nil {
+			return fmt.Errorf("confirm recreating stack %s: %w", errRollbackComplete.StackName, promptErr)
+		}
+		if recreate {
+			deployIn.Options.RecreateRolledBackStack = true
+			deployRecs, err = deployer.DeployWorkload(deployIn)
+		} else {
+			log.Infof("Run %s to delete and recreate the stack.\n", color.HighlightCode("copilot svc deploy --force"))
+		}
+	}
 	if err != nil {
 		if o.disableRollback {
-			stackName := stack.NameForService(o.targetApp.Name, o.targetEnv.Name, o.name)
+			stackName := stack.NameForService(o.targetApp.Name, o.targetEnv.Name, o.workloadName())
 			rollbackCmd := fmt.Sprintf("aws cloudformation rollback-stack --stack-name %s --role-arn %s", stackName, o.targetEnv.ExecutionRoleARN)
 			log.Infof(`It seems like you have disabled automatic stack rollback for this deployment. To debug, you can:
 * Run %s to inspect the service log.
@@ -240,15 +642,113 @@ After fixing the deployment, you can:
 2. Run %s to make a new deployment.
 `, color.HighlightCode("copilot svc logs"), color.HighlightCode(rollbackCmd), color.HighlightCode("copilot svc deploy"))
 		}
-		return fmt.Errorf("deploy service %s to environment %s: %w", o.name, o.envName, err)
+		return fmt.Errorf("deploy service %s to environment %s: %w", o.workloadName(), o.envName, err)
 	}
 	o.deployRecs = deployRecs
-	log.Successf("Deployed service %s.\n", color.HighlightUserInput(o.name))
+	if o.noWait {
+		log.Infof("Run %s to follow the deployment until it completes.\n",
+			color.HighlightCode(o.watchCommand()))
+		return nil
+	}
+	log.Successf("Deployed service %s.\n", color.HighlightUserInput(o.workloadName()))
+	if o.checkURI {
+		return o.checkEndpoints()
+	}
+	return nil
+}
+
+// checkEndpoints probes the endpoints of the just deployed service and returns an error if any of them didn't respond successfully.
+func (o *deploySvcOpts) checkEndpoints() error {
+	describer, err := o.newURIDescriber()
+	if err != nil {
+		return err
+	}
+	uri, err := describer.URI(o.envName)
+	if err != nil {
+		return fmt.Errorf("get uri for environment %s: %w", o.envName, err)
+	}
+	if failed := checkURI(o.uriWriter, o.prober, uri, healthCheckPath(o.appliedManifest)); failed > 0 {
+		return fmt.Errorf("%s of service %s did not respond successfully in environment %s",
+			english.Plural(failed, "endpoint", ""), o.workloadName(), o.envName)
+	}
+	return nil
+}
+
+// recordBuiltImage remembers the image built for the environment so that the next environments in the same region reuse it.
+func (o *deploySvcOpts) recordBuiltImage(digest *string) {
+	if digest == nil || o.image != "" {
+		return
+	}
+	if o.builtImages == nil {
+		o.builtImages = make(map[string]string)
+	}
+	o.builtImages[o.targetEnv.Region] = aws.StringValue(digest)
+}
+
+// workloadName returns the name of the deployed service, which is the name of the instance if the service is deployed as one.
+func (o *deploySvcOpts) workloadName() string {
+	return manifest.InstanceName(o.name, o.instance)
+}
+
+// registerInstance records the instance of the service in the config store, so that it can be described and deleted like a service.
+func (o *deploySvcOpts) registerInstance() error {
+	if o.instance == "" {
+		return nil
+	}
+	name := o.workloadName()
+	wkld, err := o.store.GetService(o.appName, name)
+	var errNoSuchSvc *config.ErrNoSuchService
+	switch {
+	case errors.As(err, &errNoSuchSvc):
+		if err := o.store.CreateService(&config.Workload{
+			App:        o.appName,
+			Name:       name,
+			Type:       o.svcType,
+			InstanceOf: o.name,
+		}); err != nil {
+			return fmt.Errorf("register instance %s of service %s: %w", o.instance, o.name, err)
+		}
+		return nil
+	case err != nil:
+		return fmt.Errorf("get service %s configuration: %w", name, err)
+	case wkld.InstanceOf != o.name:
+		return fmt.Errorf("service %s already exists and is not an instance of service %s", name, o.name)
+	}
+	return nil
+}
+
+// attachToDeployment renders the progress of the service deployment in progress until it completes.
+func (o *deploySvcOpts) attachToDeployment() error {
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+	}
+	o.targetEnv = env
+	renderer, err := o.newStackRenderer(env)
+	if err != nil {
+		return err
+	}
+	if err := renderer.RenderWorkloadUpdate(os.Stderr, stack.NameForService(o.appName, o.envName, o.workloadName())); err != nil {
+		return fmt.Errorf("follow the deployment of service %s to environment %s: %w", o.workloadName(), o.envName, err)
+	}
+	log.Successf("Deployed service %s.\n", color.HighlightUserInput(o.workloadName()))
 	return nil
 }
 
+// watchCommand returns the command to follow the deployment that was started without waiting.
+func (o *deploySvcOpts) watchCommand() string {
+	cmd := fmt.Sprintf("copilot svc deploy --name %s --env %s", o.name, o.envName)
+	if o.instance != "" {
+		cmd = fmt.Sprintf("%s --%s %s", cmd, instanceFlag, o.instance)
+	}
+	return fmt.Sprintf("%s --%s", cmd, watchFlag)
+}
+
 // RecommendActions returns follow-up actions the user can take after successfully executing the command.
 func (o *deploySvcOpts) RecommendActions() error {
+	if o.deployCanceled || o.noWait {
+		return nil
+	}
 	var recommendations []string
 	uriRecs, err := o.uriRecommendedActions()
 	if err != nil {
@@ -263,6 +763,109 @@ func (o *deploySvcOpts) RecommendActions() error {
 	return nil
 }
 
+// validatePackagedTemplate returns an error if only one of the packaged template and its configuration is provided.
+// showDiffAndConfirm prints the differences between the deployed service stack and the one to be deployed,
+// and returns true if the user wants to continue with the deployment.
+func (o *deploySvcOpts) showDiffAndConfirm(deployer workloadDeployer, in clideploy.StackRuntimeConfiguration) (bool, error) {
+	out, err := deployer.GenerateCloudFormationTemplate(&clideploy.GenerateCloudFormationTemplateInput{
+		StackRuntimeConfiguration: in,
+		WithDiff:                  true,
+	})
+	if err != nil {
+		return false, fmt.Errorf("generate the template for service %s: %w", o.name, err)
+	}
+	fmt.Fprint(o.diffWriter, out.Diff.HumanString())
+	contd, err := o.prompt.Confirm(continueSvcDeploymentPrompt, "")
+	if err != nil {
+		return false, fmt.Errorf("confirm deployment of service %s: %w", o.name, err)
+	}
+	return contd, nil
+}
+
+// confirmSecurityChanges prints the IAM and security group resources that the deployment changes in the service stack,
+// and returns true if there are none or if the user wants to continue with the deployment.
+func (o *deploySvcOpts) confirmSecurityChanges(deployer workloadDeployer, in clideploy.StackRuntimeConfiguration) (bool, error) {
+	out, err := deployer.GenerateCloudFormationTemplate(&clideploy.GenerateCloudFormationTemplateInput{
+		StackRuntimeConfiguration: in,
+		WithDiff:                  true,
+	})
+	if err != nil {
+		return false, fmt.Errorf("generate the template for service %s: %w", o.name, err)
+	}
+	summary := out.Diff.SecurityHumanString()
+	if summary == "" {
+		return true, nil
+	}
+	if o.nonInteractive {
+		// Like "env deploy --all", the changes of a service deployed alongside others must be approved up front.
+		return false, fmt.Errorf("deploying service %s changes IAM or security group resources %s: run with --%s to approve them",
+			o.name, english.WordSeries(out.Diff.SecurityChanges(), "and"), yesSecurityFlag)
+	}
+	fmt.Fprint(o.diffWriter, summary)
+	contd, err := o.prompt.Confirm(fmt.Sprintf(fmtSvcSecurityChangesPrompt, o.workloadName()), svcSecurityChangesHelpPrompt)
+	if err != nil {
+		return false, fmt.Errorf("confirm security-impacting changes of service %s: %w", o.name, err)
+	}
+	return contd, nil
+}
+
+// applyImage makes the manifest deploy the image provided with --image instead of building its Dockerfile.
+// An image digest refers to an image in the service's ECR repository, so the manifest must build its image.
+func (o *deploySvcOpts) applyImage(mft manifest.WorkloadManifest) error {
+	if o.image == "" {
+		return nil
+	}
+	if !imageDigestRegexp.MatchString(o.image) {
+		return manifest.ApplyImageLocation(mft, o.image)
+	}
+	required, err := manifest.DockerfileBuildRequired(mft)
+	if err != nil {
+		return err
+	}
+	if !required {
+		return fmt.Errorf(`cannot deploy image digest %s: service %s uses "image.location" instead of its ECR repository`, o.image, o.name)
+	}
+	return nil
+}
+
+func (o *deployWkldVars) validatePackagedTemplate() error {
+	if (o.templatePath == "") != (o.paramsPath == "") {
+		return fmt.Errorf("--%s and --%s must be specified together", templateFlag, paramsFlag)
+	}
+	if o.templatePath == "" {
+		return nil
+	}
+	for _, flag := range []struct {
+		name  string
+		isSet bool
+	}{
+		{imageTagFlag, o.imageTag != ""},
+		{diffFlag, o.showDiff},
+		{scheduleFlag, o.schedule != ""},
+	} {
+		if flag.isSet {
+			return fmt.Errorf("cannot specify both --%s and --%s", templateFlag, flag.name)
+		}
+	}
+	return nil
+}
+
+// readPackagedTemplate reads the template and template configuration files written by a package command.
+func readPackagedTemplate(fs afero.Fs, templatePath, paramsPath string) (*deploy.PackagedTemplate, error) {
+	tpl, err := afero.ReadFile(fs, templatePath)
+	if err != nil {
+		return nil, fmt.Errorf("read template file %s: %w", templatePath, err)
+	}
+	config, err := afero.ReadFile(fs, paramsPath)
+	if err != nil {
+		return nil, fmt.Errorf("read template configuration file %s: %w", paramsPath, err)
+	}
+	return &deploy.PackagedTemplate{
+		Template:      string(tpl),
+		Configuration: string(config),
+	}, nil
+}
+
 func (o *deploySvcOpts) validateSvcName() error {
 	names, err := o.ws.ListServices()
 	if err != nil {
@@ -277,8 +880,10 @@ func (o *deploySvcOpts) validateSvcName() error {
 }
 
 func (o *deploySvcOpts) validateEnvName() error {
-	if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
-		return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+	for _, env := range o.targetEnvNames() {
+		if _, err := o.store.GetEnvironment(o.appName, env); err != nil {
+			return fmt.Errorf("get environment %s configuration: %w", env, err)
+		}
 	}
 	return nil
 }
@@ -310,7 +915,6 @@ func (o *deploySvcOpts) validateOrAskEnvName() error {
 }
 
 func (o *deploySvcOpts) configureClients() error {
-	o.imageTag = imageTagFromGit(o.cmd, o.imageTag) // Best effort assign git tag.
 	env, err := o.store.GetEnvironment(o.appName, o.envName)
 	if err != nil {
 		return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
@@ -334,6 +938,8 @@ func (o *deploySvcOpts) configureClients() error {
 		return fmt.Errorf("get identity: %w", err)
 	}
 	o.rootUserARN = caller.RootUserARN
+	o.deployerARN = caller.ARN
+	o.commit = deployedGitCommit(o.cmd)
 
 	envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 		App:         o.appName,
@@ -425,7 +1031,7 @@ func (o *deploySvcOpts) uriRecommendedActions() ([]string, error) {
 		return nil, nil
 	}
 
-	describer, err := describe.NewReachableService(o.appName, o.name, o.store)
+	describer, err := describe.NewReachableService(o.appName, o.workloadName(), o.store)
 	if err != nil {
 		return nil, err
 	}
@@ -526,7 +1132,26 @@ func buildSvcDeployCmd() *cobra.Command {
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot svc deploy --name frontend --env test
   Deploys a service with additional resource tags.
-  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Deploys the template and configuration generated by "copilot svc package --output-dir infrastructure --upload-assets".
+  /code $ copilot svc deploy --name frontend --env prod --template infrastructure/frontend-prod.stack.yml --params infrastructure/frontend-prod.params.json
+  Shows the changes to the deployed "frontend" service stack and its addons before deploying.
+  /code $ copilot svc deploy --name frontend --env prod --diff
+  Starts deploying a service without waiting, then follows the deployment until it completes.
+  /code $ copilot svc deploy --name frontend --env prod --no-wait
+  /code $ copilot svc deploy --name frontend --env prod --watch
+  Deploys a copy of the "frontend" service for a tenant next to the other copies in the "prod" environment.
+  /code $ copilot svc deploy --name frontend --env prod --instance tenant-a
+  Deploys an image built by a separate pipeline instead of building the Dockerfile.
+  /code $ copilot svc deploy --name frontend --env prod --image 123456789012.dkr.ecr.us-west-2.amazonaws.com/demo/frontend:v1.2.0
+  Builds the image with CodeBuild instead of the local Docker engine.
+  /code $ copilot svc deploy --name frontend --env test --build remote
+  Deploys the image built once to "test", "staging" and "prod" in order, confirming before each promotion.
+  /code $ copilot svc deploy --name frontend --env test,staging,prod
+  Promotes the service to the next environment after monitoring its alarms for 15 minutes.
+  /code $ copilot svc deploy --name frontend --env test,staging,prod --soak-time 15m
+  Deploys a service, then checks that its endpoints respond.
+  /code $ copilot svc deploy --name frontend --env test --check-uri`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcDeployOpts(vars)
 			if err != nil {
@@ -537,11 +1162,22 @@ func buildSvcDeployCmd() *cobra.Command {
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
-	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
-	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", svcDeployEnvFlagDescription)
+	cmd.Flags().Var(newImageTagsFlag(&vars.imageTag, &vars.extraImageTags), imageTagFlag, imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceFlagDescription)
 	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
+	cmd.Flags().StringVar(&vars.templatePath, templateFlag, "", packagedTemplateFlagDescription)
+	cmd.Flags().StringVar(&vars.paramsPath, paramsFlag, "", packagedParamsFlagDescription)
+	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().BoolVar(&vars.yesSecurity, yesSecurityFlag, false, yesSecurityFlagDescription)
+	cmd.Flags().BoolVar(&vars.noWait, noWaitFlag, false, svcNoWaitFlagDescription)
+	cmd.Flags().BoolVar(&vars.watch, watchFlag, false, svcWatchFlagDescription)
+	cmd.Flags().StringVar(&vars.instance, instanceFlag, "", svcInstanceFlagDescription)
+	cmd.Flags().StringVar(&vars.image, imageFlag, "", svcDeployImageFlagDescription)
+	cmd.Flags().StringVar(&vars.build, buildFlag, buildLocal, svcDeployBuildFlagDescription)
+	cmd.Flags().DurationVar(&vars.soakTime, soakTimeFlag, 0, svcDeploySoakTimeFlagDescription)
+	cmd.Flags().BoolVar(&vars.checkURI, checkURIFlag, false, svcDeployCheckURIFlagDescription)
 
 	return cmd
 }
@@ -6,20 +6,198 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/probe"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 )
 
 func TestSvcDeployOpts_Validate(t *testing.T) {
-	// There is no validation for svc deploy.
+	testCases := map[string]struct {
+		inVars deployWkldVars
+
+		wantedError error
+	}{
+		"error if --template is used without --params": {
+			inVars: deployWkldVars{
+				templatePath: "infrastructure/frontend-test.stack.yml",
+			},
+			wantedError: errors.New("--template and --params must be specified together"),
+		},
+		"error if --params is used without --template": {
+			inVars: deployWkldVars{
+				paramsPath: "infrastructure/frontend-test.params.json",
+			},
+			wantedError: errors.New("--template and --params must be specified together"),
+		},
+		"error if --template is used with --tag": {
+			inVars: deployWkldVars{
+				imageTag:     "v1.0.0",
+				templatePath: "infrastructure/frontend-test.stack.yml",
+				paramsPath:   "infrastructure/frontend-test.params.json",
+			},
+			wantedError: errors.New("cannot specify both --template and --tag"),
+		},
+		"error if --template is used with --diff": {
+			inVars: deployWkldVars{
+				showDiff:     true,
+				templatePath: "infrastructure/frontend-test.stack.yml",
+				paramsPath:   "infrastructure/frontend-test.params.json",
+			},
+			wantedError: errors.New("cannot specify both --template and --diff"),
+		},
+		"error if --watch is used with --no-wait": {
+			inVars: deployWkldVars{
+				watch:  true,
+				noWait: true,
+			},
+			wantedError: errors.New("cannot specify both --watch and --no-wait"),
+		},
+		"error if --watch is used with --diff": {
+			inVars: deployWkldVars{
+				watch:    true,
+				showDiff: true,
+			},
+			wantedError: errors.New("cannot specify both --watch and --diff"),
+		},
+		"error if --no-wait is used with --force": {
+			inVars: deployWkldVars{
+				noWait:         true,
+				forceNewUpdate: true,
+			},
+			wantedError: errors.New("cannot specify both --no-wait and --force"),
+		},
+		"error if the instance name is invalid": {
+			inVars: deployWkldVars{
+				instance: "Tenant_A",
+			},
+			wantedError: errors.New("instance name Tenant_A is invalid: " + errValueBadFormat.Error()),
+		},
+		"error if the image is not an ECR image URI": {
+			inVars: deployWkldVars{
+				image: "nginx:latest",
+			},
+			wantedError: errors.New(`image nginx:latest must be a fully-qualified ECR image URI with a tag or a digest, or an image digest starting with "sha256:"`),
+		},
+		"error if --image is used with --tag": {
+			inVars: deployWkldVars{
+				image:    "123456789012.dkr.ecr.us-west-2.amazonaws.com/demo/frontend:v1.0.0",
+				imageTag: "v1.0.0",
+			},
+			wantedError: errors.New("cannot specify both --image and --tag"),
+		},
+		"error if the build location is invalid": {
+			inVars: deployWkldVars{
+				build: "cloud",
+			},
+			wantedError: errors.New(`--build must be one of "local" or "remote"`),
+		},
+		"error if --build remote is used with --template": {
+			inVars: deployWkldVars{
+				build:        "remote",
+				templatePath: "infrastructure/frontend-test.stack.yml",
+				paramsPath:   "infrastructure/frontend-test.params.json",
+			},
+			wantedError: errors.New("cannot specify both --build remote and --template"),
+		},
+		"success with --build remote": {
+			inVars: deployWkldVars{
+				build: "remote",
+			},
+		},
+		"success with an ECR image URI": {
+			inVars: deployWkldVars{
+				image: "123456789012.dkr.ecr.us-west-2.amazonaws.com/demo/frontend@sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+			},
+		},
+		"success with an image digest": {
+			inVars: deployWkldVars{
+				image: "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+			},
+		},
+		"error if --soak-time is used with a single environment": {
+			inVars: deployWkldVars{
+				envName:  "test",
+				soakTime: 10 * time.Minute,
+			},
+			wantedError: errors.New("--soak-time requires multiple environments in --env"),
+		},
+		"error if an environment is listed more than once": {
+			inVars: deployWkldVars{
+				envName: "test,prod,test",
+			},
+			wantedError: errors.New("--env test,prod,test must not contain environment test more than once"),
+		},
+		"error if an environment name is empty": {
+			inVars: deployWkldVars{
+				envName: "test,,prod",
+			},
+			wantedError: errors.New("--env test,,prod must not contain empty environment names"),
+		},
+		"error if multiple environments are used with --no-wait": {
+			inVars: deployWkldVars{
+				envName: "test,prod",
+				noWait:  true,
+			},
+			wantedError: errors.New("cannot specify both multiple environments in --env and --no-wait"),
+		},
+		"error if --check-uri is used with --no-wait": {
+			inVars: deployWkldVars{
+				noWait:   true,
+				checkURI: true,
+			},
+			wantedError: errors.New("cannot specify both --no-wait and --check-uri"),
+		},
+		"error if --check-uri is used with --watch": {
+			inVars: deployWkldVars{
+				watch:    true,
+				checkURI: true,
+			},
+			wantedError: errors.New("cannot specify both --watch and --check-uri"),
+		},
+		"success with multiple environments and a soak time": {
+			inVars: deployWkldVars{
+				envName:  "test, staging, prod",
+				soakTime: 10 * time.Minute,
+			},
+		},
+		"success with --template and --params": {
+			inVars: deployWkldVars{
+				templatePath: "infrastructure/frontend-test.stack.yml",
+				paramsPath:   "infrastructure/frontend-test.params.json",
+			},
+		},
+		"success without flags": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := deploySvcOpts{
+				deployWkldVars: tc.inVars,
+			}
+			gotErr := opts.Validate()
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
 }
 
 type svcDeployAskMocks struct {
@@ -127,6 +305,11 @@ type deployMocks struct {
 	mockInterpolator         *mocks.Mockinterpolator
 	mockWsReader             *mocks.MockwsWlDirReader
 	mockEnvFeaturesDescriber *mocks.MockversionCompatibilityChecker
+	mockPrompt               *mocks.Mockprompter
+	mockStore                *mocks.Mockstore
+	mockStackRenderer        *mocks.MockworkloadStackRenderer
+	mockURIDescriber         *mocks.MockserviceURIDescriber
+	mockProber               *mocks.MockendpointProber
 	mockMft                  *mockWorkloadMft
 }
 
@@ -137,9 +320,21 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 		mockEnvName = "prod-iad"
 	)
 	mockError := errors.New("some error")
+	noSecurityChanges := &deploy.GenerateCloudFormationTemplateOutput{
+		Diff: &deploy.TemplateDiff{},
+	}
 	testCases := map[string]struct {
-		mock func(m *deployMocks)
+		inTemplatePath   string
+		inParamsPath     string
+		inShowDiff       bool
+		inYesSecurity    bool
+		inNoWait         bool
+		inWatch          bool
+		inCheckURI       bool
+		inNonInteractive bool
+		mock             func(m *deployMocks)
 
+		wantedDiff  string
 		wantedError error
 	}{
 		"error out if fail to read workload manifest": {
@@ -210,12 +405,396 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{"mockFeature1", "mockFeature2"}, nil)
 				m.mockEnvFeaturesDescriber.EXPECT().Version().Times(0)
 				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
 				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Return(nil, mockError)
 				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
 			},
 
 			wantedError: fmt.Errorf("deploy service frontend to environment prod-iad: some error"),
 		},
+		"start the deployment without waiting with --no-wait": {
+			inNoWait: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).DoAndReturn(func(in *deploy.DeployWorkloadInput) (deploy.ActionRecommender, error) {
+					require.True(t, in.NoWait)
+					return nil, nil
+				})
+			},
+		},
+		"error if fail to get the environment to follow the deployment": {
+			inWatch: true,
+			mock: func(m *deployMocks) {
+				m.mockStore.EXPECT().GetEnvironment(mockAppName, mockEnvName).Return(nil, mockError)
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(gomock.Any()).Times(0)
+			},
+			wantedError: fmt.Errorf("get environment prod-iad configuration: some error"),
+		},
+		"error if the deployment that is followed fails": {
+			inWatch: true,
+			mock: func(m *deployMocks) {
+				m.mockStore.EXPECT().GetEnvironment(mockAppName, mockEnvName).Return(&config.Environment{Name: mockEnvName}, nil)
+				m.mockStackRenderer.EXPECT().RenderWorkloadUpdate(gomock.Any(), "phonetool-prod-iad-frontend").Return(mockError)
+			},
+			wantedError: fmt.Errorf("follow the deployment of service frontend to environment prod-iad: some error"),
+		},
+		"follow the deployment in progress with --watch": {
+			inWatch: true,
+			mock: func(m *deployMocks) {
+				m.mockStore.EXPECT().GetEnvironment(mockAppName, mockEnvName).Return(&config.Environment{Name: mockEnvName}, nil)
+				m.mockStackRenderer.EXPECT().RenderWorkloadUpdate(gomock.Any(), "phonetool-prod-iad-frontend").Return(nil)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Times(0)
+			},
+		},
+		"error if the packaged template configuration cannot be read": {
+			inTemplatePath: "infrastructure/frontend-prod-iad.stack.yml",
+			inParamsPath:   "infrastructure/missing.params.json",
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Times(0)
+			},
+
+			wantedError: errors.New("read template configuration file infrastructure/missing.params.json: open infrastructure/missing.params.json: file does not exist"),
+		},
+		"deploy the packaged template without uploading artifacts": {
+			inTemplatePath: "infrastructure/frontend-prod-iad.stack.yml",
+			inParamsPath:   "infrastructure/frontend-prod-iad.params.json",
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Times(0)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).DoAndReturn(func(in *deploy.DeployWorkloadInput) (deploy.ActionRecommender, error) {
+					require.Equal(t, "Resources: {}\n", in.Options.Packaged.Template)
+					require.Equal(t, `{"Parameters": {}}`, in.Options.Packaged.Configuration)
+					return nil, nil
+				})
+			},
+		},
+		"error if fail to generate the template to diff": {
+			inShowDiff: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(nil, mockError)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Times(0)
+			},
+
+			wantedError: fmt.Errorf("generate the template for service frontend: some error"),
+		},
+		"do not deploy if the user declines after reviewing the diff": {
+			inShowDiff: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{
+					ImageDigest: aws.String("sha256:1234"),
+				}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).DoAndReturn(func(in *deploy.GenerateCloudFormationTemplateInput) (*deploy.GenerateCloudFormationTemplateOutput, error) {
+					require.True(t, in.WithDiff)
+					require.Equal(t, "sha256:1234", aws.StringValue(in.ImageDigest))
+					return &deploy.GenerateCloudFormationTemplateOutput{
+						Diff: &deploy.TemplateDiff{
+							ChangedResources:  []string{"TaskRole"},
+							SecurityResources: []string{"TaskRole"},
+						},
+					}, nil
+				})
+				m.mockPrompt.EXPECT().Confirm(continueSvcDeploymentPrompt, "").Return(false, nil)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Times(0)
+			},
+
+			wantedDiff: `Resources
+  ~ TaskRole
+IAM and security group changes
+  ! TaskRole
+`,
+		},
+		"deploy after the user confirms the diff": {
+			inShowDiff: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				gomock.InOrder(
+					m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+						Diff: &deploy.TemplateDiff{},
+					}, nil),
+					m.mockPrompt.EXPECT().Confirm(continueSvcDeploymentPrompt, "").Return(true, nil),
+					m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Return(nil, nil),
+				)
+			},
+
+			wantedDiff: "No changes to the stack.\n",
+		},
+		"do not deploy if the user declines the security-impacting changes": {
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Diff: &deploy.TemplateDiff{
+						ChangedResources:  []string{"TaskRole"},
+						SecurityResources: []string{"TaskRole"},
+					},
+				}, nil)
+				m.mockPrompt.EXPECT().Confirm(fmt.Sprintf(fmtSvcSecurityChangesPrompt, mockSvcName), svcSecurityChangesHelpPrompt).Return(false, nil)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Times(0)
+			},
+
+			wantedDiff: `Security-impacting changes
+  ! TaskRole
+`,
+		},
+		"error if fail to confirm the security-impacting changes": {
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Diff: &deploy.TemplateDiff{
+						ChangedResources:  []string{"TaskRole"},
+						SecurityResources: []string{"TaskRole"},
+					},
+				}, nil)
+				m.mockPrompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(false, mockError)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Times(0)
+			},
+
+			wantedError: fmt.Errorf("confirm security-impacting changes of service frontend: some error"),
+		},
+		"error instead of prompting for security-impacting changes when deployed alongside other workloads": {
+			inNonInteractive: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Diff: &deploy.TemplateDiff{
+						ChangedResources:  []string{"TaskRole", "EnvControllerAction"},
+						SecurityResources: []string{"TaskRole", "EnvControllerAction"},
+					},
+				}, nil)
+				m.mockPrompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Times(0)
+			},
+
+			wantedError: fmt.Errorf("deploying service frontend changes IAM or security group resources TaskRole and EnvControllerAction: run with --yes-security to approve them"),
+		},
+		"deploy without checking for security-impacting changes with --yes-security": {
+			inYesSecurity: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Times(0)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Return(nil, nil)
+			},
+		},
+		"error if the stack was rolled back and the user declines to recreate it": {
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Return(nil, &deploycfn.ErrStackRollbackComplete{
+					StackName: "phonetool-prod-iad-frontend",
+				})
+				m.mockPrompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+
+			wantedError: fmt.Errorf("deploy service frontend to environment prod-iad: stack phonetool-prod-iad-frontend failed to be created and is in ROLLBACK_COMPLETE state"),
+		},
+		"error instead of prompting to recreate a rolled back stack when deployed alongside other workloads": {
+			inNonInteractive: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Return(nil, &deploycfn.ErrStackRollbackComplete{
+					StackName: "phonetool-prod-iad-frontend",
+				})
+				m.mockPrompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			wantedError: fmt.Errorf("deploy service frontend to environment prod-iad: stack phonetool-prod-iad-frontend failed to be created and is in ROLLBACK_COMPLETE state: run with --force to delete and recreate it"),
+		},
+		"error if the endpoints of the service don't respond with --check-uri": {
+			inCheckURI: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
+				gomock.InOrder(
+					m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Return(nil, nil),
+					m.mockURIDescriber.EXPECT().URI(mockEnvName).Return(describe.URI{
+						URI:        "https://frontend.example.com",
+						AccessType: describe.URIAccessTypeInternet,
+					}, nil),
+					m.mockProber.EXPECT().Probe(gomock.Any(), []string{"https://frontend.example.com"}, "").Return([]probe.Result{
+						{
+							Endpoint:   "https://frontend.example.com",
+							DNSName:    "frontend.example.com",
+							StatusCode: 503,
+						},
+					}),
+				)
+			},
+
+			wantedError: errors.New("1 endpoint of service frontend did not respond successfully in environment prod-iad"),
+		},
+		"check the endpoints of the service after the deployment with --check-uri": {
+			inCheckURI: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil)
+				gomock.InOrder(
+					m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Return(nil, nil),
+					m.mockURIDescriber.EXPECT().URI(mockEnvName).Return(describe.URI{
+						URI:        "https://frontend.example.com",
+						AccessType: describe.URIAccessTypeInternet,
+					}, nil),
+					m.mockProber.EXPECT().Probe(gomock.Any(), []string{"https://frontend.example.com"}, "").Return([]probe.Result{
+						{
+							Endpoint:   "https://frontend.example.com",
+							DNSName:    "frontend.example.com",
+							StatusCode: 200,
+						},
+					}),
+				)
+			},
+		},
+		"recreate the stack if it was rolled back and the user confirms": {
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				gomock.InOrder(
+					m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(noSecurityChanges, nil),
+					m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Return(nil, &deploycfn.ErrStackRollbackComplete{
+						StackName: "phonetool-prod-iad-frontend",
+					}),
+					m.mockPrompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil),
+					m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).DoAndReturn(func(in *deploy.DeployWorkloadInput) (deploy.ActionRecommender, error) {
+						require.True(t, in.Options.RecreateRolledBackStack)
+						return nil, nil
+					}),
+				)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -229,20 +808,44 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				mockInterpolator:         mocks.NewMockinterpolator(ctrl),
 				mockWsReader:             mocks.NewMockwsWlDirReader(ctrl),
 				mockEnvFeaturesDescriber: mocks.NewMockversionCompatibilityChecker(ctrl),
+				mockPrompt:               mocks.NewMockprompter(ctrl),
+				mockStore:                mocks.NewMockstore(ctrl),
+				mockStackRenderer:        mocks.NewMockworkloadStackRenderer(ctrl),
+				mockURIDescriber:         mocks.NewMockserviceURIDescriber(ctrl),
+				mockProber:               mocks.NewMockendpointProber(ctrl),
 			}
 			tc.mock(m)
+			diff := new(strings.Builder)
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "infrastructure/frontend-prod-iad.stack.yml", []byte("Resources: {}\n"), 0644))
+			require.NoError(t, afero.WriteFile(fs, "infrastructure/frontend-prod-iad.params.json", []byte(`{"Parameters": {}}`), 0644))
 
 			opts := deploySvcOpts{
 				deployWkldVars: deployWkldVars{
-					appName: mockAppName,
-					name:    mockSvcName,
-					envName: mockEnvName,
+					appName:      mockAppName,
+					name:         mockSvcName,
+					envName:      mockEnvName,
+					templatePath: tc.inTemplatePath,
+					paramsPath:   tc.inParamsPath,
+					showDiff:     tc.inShowDiff,
+					yesSecurity:  tc.inYesSecurity,
+					noWait:       tc.inNoWait,
+					watch:        tc.inWatch,
+					checkURI:     tc.inCheckURI,
 
 					clientConfigured: true,
 				},
+				store: m.mockStore,
+				fs:    fs,
 				newSvcDeployer: func() (workloadDeployer, error) {
 					return m.mockDeployer, nil
 				},
+				newStackRenderer: func(_ *config.Environment) (workloadStackRenderer, error) {
+					return m.mockStackRenderer, nil
+				},
+				newURIDescriber: func() (serviceURIDescriber, error) {
+					return m.mockURIDescriber, nil
+				},
 				newInterpolator: func(app, env string) interpolator {
 					return m.mockInterpolator
 				},
@@ -251,8 +854,13 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 					return m.mockMft, nil
 				},
 				envFeaturesDescriber: m.mockEnvFeaturesDescriber,
+				prompt:               m.mockPrompt,
+				diffWriter:           diff,
+				uriWriter:            io.Discard,
+				prober:               m.mockProber,
 				targetApp:            &config.Application{},
 				targetEnv:            &config.Environment{},
+				nonInteractive:       tc.inNonInteractive,
 			}
 
 			// WHEN
@@ -261,6 +869,7 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 			// THEN
 			if tc.wantedError == nil {
 				require.NoError(t, err)
+				require.Equal(t, tc.wantedDiff, diff.String())
 			} else {
 				require.EqualError(t, err, tc.wantedError.Error())
 			}
@@ -268,6 +877,294 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 	}
 }
 
+func TestSvcDeployOpts_Execute_multipleEnvs(t *testing.T) {
+	const (
+		mockAppName = "phonetool"
+		mockSvcName = "frontend"
+		mockDigest  = "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807"
+	)
+	mockError := errors.New("some error")
+	expectDeploy := func(m *deployMocks) {
+		m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+		m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+		m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+		m.mockDeployer.EXPECT().IsServiceAvailableInRegion("us-west-2").Return(true, nil)
+		m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{
+			ImageDigest: aws.String(mockDigest),
+		}, nil)
+		m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+			Diff: &deploy.TemplateDiff{},
+		}, nil)
+		m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Return(nil, nil)
+	}
+	testCases := map[string]struct {
+		inSoakTime time.Duration
+		mock       func(m *deployMocks, alarms *mocks.MocktaggedAlarmsGetter)
+
+		wantedDeployedEnvs []string
+		wantedError        error
+	}{
+		"stop after the first environment if the user doesn't promote the service": {
+			mock: func(m *deployMocks, alarms *mocks.MocktaggedAlarmsGetter) {
+				expectDeploy(m)
+				m.mockPrompt.EXPECT().Confirm("Service frontend is deployed to environment test. Continue with the deployment to environment prod?", gomock.Any()).Return(false, nil)
+			},
+			wantedDeployedEnvs: []string{"test"},
+		},
+		"deploy to each environment in order once the user confirms": {
+			mock: func(m *deployMocks, alarms *mocks.MocktaggedAlarmsGetter) {
+				expectDeploy(m)
+				m.mockPrompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil)
+				expectDeploy(m)
+			},
+			wantedDeployedEnvs: []string{"test", "prod"},
+		},
+		"error if an alarm of the service goes off during the soak time": {
+			inSoakTime: time.Minute,
+			mock: func(m *deployMocks, alarms *mocks.MocktaggedAlarmsGetter) {
+				expectDeploy(m)
+				wantedTags := map[string]string{
+					"copilot-application": mockAppName,
+					"copilot-environment": "test",
+					"copilot-service":     mockSvcName,
+				}
+				gomock.InOrder(
+					alarms.EXPECT().AlarmsWithTags(wantedTags).Return([]cloudwatch.AlarmStatus{{Name: "frontend-5xx", Status: "OK"}}, nil),
+					alarms.EXPECT().AlarmsWithTags(wantedTags).Return([]cloudwatch.AlarmStatus{{Name: "frontend-5xx", Status: "ALARM"}}, nil),
+				)
+			},
+			wantedDeployedEnvs: []string{"test"},
+			wantedError:        errors.New("stop deploying service frontend: alarms frontend-5xx are in the ALARM state in environment test"),
+		},
+		"error if fail to get the alarms of the service": {
+			inSoakTime: time.Minute,
+			mock: func(m *deployMocks, alarms *mocks.MocktaggedAlarmsGetter) {
+				expectDeploy(m)
+				alarms.EXPECT().AlarmsWithTags(gomock.Any()).Return(nil, mockError)
+			},
+			wantedDeployedEnvs: []string{"test"},
+			wantedError:        errors.New("get CloudWatch alarms of service frontend in environment test: some error"),
+		},
+		"promote the service once the soak time elapses without alarms": {
+			inSoakTime: time.Minute,
+			mock: func(m *deployMocks, alarms *mocks.MocktaggedAlarmsGetter) {
+				expectDeploy(m)
+				alarms.EXPECT().AlarmsWithTags(gomock.Any()).Return(nil, nil).Times(3)
+				m.mockPrompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+				expectDeploy(m)
+			},
+			wantedDeployedEnvs: []string{"test", "prod"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &deployMocks{
+				mockDeployer:             mocks.NewMockworkloadDeployer(ctrl),
+				mockInterpolator:         mocks.NewMockinterpolator(ctrl),
+				mockWsReader:             mocks.NewMockwsWlDirReader(ctrl),
+				mockEnvFeaturesDescriber: mocks.NewMockversionCompatibilityChecker(ctrl),
+				mockPrompt:               mocks.NewMockprompter(ctrl),
+				mockMft: &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				},
+			}
+			alarms := mocks.NewMocktaggedAlarmsGetter(ctrl)
+			tc.mock(m, alarms)
+			clock := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+
+			var deployedEnvs []string
+			opts := &deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					appName:  mockAppName,
+					name:     mockSvcName,
+					envName:  "test,prod",
+					soakTime: tc.inSoakTime,
+
+					clientConfigured: true,
+				},
+				newInterpolator: func(app, env string) interpolator {
+					return m.mockInterpolator
+				},
+				newAlarmsGetter: func(_ *config.Environment) (taggedAlarmsGetter, error) {
+					return alarms, nil
+				},
+				ws: m.mockWsReader,
+				unmarshal: func(b []byte) (manifest.WorkloadManifest, error) {
+					return m.mockMft, nil
+				},
+				envFeaturesDescriber: m.mockEnvFeaturesDescriber,
+				prompt:               m.mockPrompt,
+				diffWriter:           new(strings.Builder),
+				now: func() time.Time {
+					return clock
+				},
+				sleep: func(d time.Duration) {
+					require.LessOrEqual(t, d, soakAlarmsPollInterval)
+					clock = clock.Add(d)
+				},
+				targetApp: &config.Application{},
+				targetEnv: &config.Environment{Region: "us-west-2"},
+			}
+			opts.newSvcDeployer = func() (workloadDeployer, error) {
+				if len(deployedEnvs) > 0 {
+					require.Equal(t, mockDigest, opts.builtImages["us-west-2"], "the image built for the first environment is reused")
+				}
+				deployedEnvs = append(deployedEnvs, opts.envName)
+				return m.mockDeployer, nil
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			require.Equal(t, tc.wantedDeployedEnvs, deployedEnvs)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcDeployOpts_registerInstance(t *testing.T) {
+	testCases := map[string]struct {
+		inInstance string
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"skip if the service is not deployed as an instance": {
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetService(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"register the instance if it does not exist": {
+			inInstance: "tenant-a",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetService("phonetool", "frontend-tenant-a").Return(nil, &config.ErrNoSuchService{App: "phonetool", Name: "frontend-tenant-a"})
+				m.EXPECT().CreateService(&config.Workload{
+					App:        "phonetool",
+					Name:       "frontend-tenant-a",
+					Type:       manifest.LoadBalancedWebServiceType,
+					InstanceOf: "frontend",
+				}).Return(nil)
+			},
+		},
+		"error if fails to register the instance": {
+			inInstance: "tenant-a",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetService("phonetool", "frontend-tenant-a").Return(nil, &config.ErrNoSuchService{App: "phonetool", Name: "frontend-tenant-a"})
+				m.EXPECT().CreateService(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("register instance tenant-a of service frontend: some error"),
+		},
+		"skip if the instance is already registered": {
+			inInstance: "tenant-a",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetService("phonetool", "frontend-tenant-a").Return(&config.Workload{
+					Name:       "frontend-tenant-a",
+					InstanceOf: "frontend",
+				}, nil)
+				m.EXPECT().CreateService(gomock.Any()).Times(0)
+			},
+		},
+		"error if a different service has the name of the instance": {
+			inInstance: "tenant-a",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetService("phonetool", "frontend-tenant-a").Return(&config.Workload{
+					Name: "frontend-tenant-a",
+				}, nil)
+			},
+			wantedError: errors.New("service frontend-tenant-a already exists and is not an instance of service frontend"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockstore(ctrl)
+			tc.setupMocks(m)
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					appName:  "phonetool",
+					name:     "frontend",
+					instance: tc.inInstance,
+				},
+				store:   m,
+				svcType: manifest.LoadBalancedWebServiceType,
+			}
+
+			err := opts.registerInstance()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcDeployOpts_applyImage(t *testing.T) {
+	const (
+		mockURI    = "123456789012.dkr.ecr.us-west-2.amazonaws.com/demo/frontend:v1.2.0"
+		mockDigest = "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807"
+	)
+	testCases := map[string]struct {
+		inImage    string
+		inLocation *string
+
+		wantedLocation *string
+		wantedError    error
+	}{
+		"keep building the image without --image": {},
+		"deploy the image URI instead of building the image": {
+			inImage:        mockURI,
+			wantedLocation: aws.String(mockURI),
+		},
+		"keep building the image for a digest in the ECR repository": {
+			inImage: mockDigest,
+		},
+		"error if the digest is used for a service that does not build its image": {
+			inImage:     mockDigest,
+			inLocation:  aws.String("nginx"),
+			wantedError: errors.New(`cannot deploy image digest ` + mockDigest + `: service frontend uses "image.location" instead of its ECR repository`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mft := &manifest.BackendService{}
+			mft.ImageConfig.Image.Location = tc.inLocation
+			if tc.inLocation == nil {
+				mft.ImageConfig.Image.Build.BuildString = aws.String("./Dockerfile")
+			}
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					name:  "frontend",
+					image: tc.inImage,
+				},
+			}
+
+			err := opts.applyImage(mft)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedLocation, mft.ImageConfig.Image.Location)
+		})
+	}
+}
+
 type checkEnvironmentCompatibilityMocks struct {
 	ws                              *mocks.MockwsEnvironmentsLister
 	versionFeatureGetter            *mocks.MockversionCompatibilityChecker
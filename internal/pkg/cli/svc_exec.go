@@ -93,6 +93,9 @@ func newSvcExecOpts(vars execVars) (*svcExecOpts, error) {
 
 // Validate returns an error for any invalid optional flags.
 func (o *svcExecOpts) Validate() error {
+	if err := o.validateSession(); err != nil {
+		return err
+	}
 	return validateSSMBinary(o.prompter, o.ssmPluginManager, o.skipConfirmation)
 }
 
@@ -136,6 +139,8 @@ func (o *svcExecOpts) Execute() error {
 		Command:   o.command,
 		Container: container,
 		Task:      taskID,
+		Reconnect: o.reconnect,
+		KeepAlive: o.keepAlive,
 	}); err != nil {
 		var errExecCmd *awsecs.ErrExecuteCommand
 		if errors.As(err, &errExecCmd) {
@@ -284,7 +289,9 @@ func buildSvcExecCmd() *cobra.Command {
   Start an interactive bash session with a task part of the "frontend" service.
   /code $ copilot svc exec -a my-app -e test -n frontend
   Runs the 'ls' command in the task prefixed with ID "8c38184" within the "backend" service.
-  /code $ copilot svc exec -a my-app -e test --name backend --task-id 8c38184 --command "ls"`,
+  /code $ copilot svc exec -a my-app -e test --name backend --task-id 8c38184 --command "ls"
+  Start an interactive session that stays open over a VPN that closes idle connections.
+  /code $ copilot svc exec -a my-app -e test -n frontend --keep-alive 1m`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcExecOpts(vars)
 			if err != nil {
@@ -305,6 +312,8 @@ func buildSvcExecCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.command, commandFlag, commandFlagShort, defaultCommand, execCommandFlagDescription)
 	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", taskIDFlagDescription)
 	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", containerFlagDescription)
+	cmd.Flags().IntVar(&vars.reconnect, reconnectFlag, defaultExecReconnect, execReconnectFlagDescription)
+	cmd.Flags().DurationVar(&vars.keepAlive, keepAliveFlag, 0, execKeepAliveFlagDescription)
 	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
 
 	cmd.SetUsageTemplate(template.Usage)
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -41,11 +42,31 @@ func TestSvcExec_Validate(t *testing.T) {
 		inputApp         string
 		inputEnv         string
 		inputSvc         string
+		inputReconnect   int
+		inputKeepAlive   time.Duration
 		skipConfirmation *bool
 		setupMocks       func(mocks execSvcMocks)
 
 		wantedError error
 	}{
+		"error if the number of reconnect attempts is negative": {
+			inputApp:       mockApp,
+			inputEnv:       mockEnv,
+			inputSvc:       mockSvc,
+			inputReconnect: -1,
+			setupMocks:     func(m execSvcMocks) {},
+
+			wantedError: fmt.Errorf("--reconnect must be greater than or equal to 0"),
+		},
+		"error if the keep-alive interval is shorter than a second": {
+			inputApp:       mockApp,
+			inputEnv:       mockEnv,
+			inputSvc:       mockSvc,
+			inputKeepAlive: 500 * time.Millisecond,
+			setupMocks:     func(m execSvcMocks) {},
+
+			wantedError: fmt.Errorf("--keep-alive must be at least 1s"),
+		},
 		"skip without installing/updating if yes flag is set to be false": {
 			inputApp:         mockApp,
 			inputEnv:         mockEnv,
@@ -231,6 +252,8 @@ func TestSvcExec_Validate(t *testing.T) {
 					appName:          tc.inputApp,
 					envName:          tc.inputEnv,
 					skipConfirmation: tc.skipConfirmation,
+					reconnect:        tc.inputReconnect,
+					keepAlive:        tc.inputKeepAlive,
 				},
 				store:            mockStoreReader,
 				ssmPluginManager: mockSSMPluginManager,
@@ -0,0 +1,261 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/evidently"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	describestack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcFlagsAppNamePrompt     = "Which application is the service in?"
+	svcFlagsNamePrompt        = "Which service of %s would you like to manage feature flags for?"
+	svcFlagsSvcNameHelpPrompt = "The feature flags and launches of the selected service will be shown."
+
+	// evidentlyProjectLogicalID is the logical ID of the Evidently project in a service stack.
+	evidentlyProjectLogicalID = "EvidentlyProject"
+)
+
+type svcFlagsVars struct {
+	appName string
+	envName string
+	svcName string
+	start   string
+	stop    string
+}
+
+type svcFlagsOpts struct {
+	svcFlagsVars
+
+	store           store
+	sel             deploySelector
+	newFlagsClients func(env *config.Environment, stackName string) (stackResourcesDescriber, featureFlagManager, error)
+	w               io.Writer
+}
+
+func newSvcFlagsOpts(vars svcFlagsVars) (*svcFlagsOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc flags"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcFlagsOpts{
+		svcFlagsVars: vars,
+		store:        configStore,
+		sel:          selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		newFlagsClients: func(env *config.Environment, stackName string) (stackResourcesDescriber, featureFlagManager, error) {
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return describestack.NewStackDescriber(stackName, sess), evidently.New(sess), nil
+		},
+		w: log.OutputWriter,
+	}, nil
+}
+
+// Validate returns an error if both --start and --stop are specified.
+func (o *svcFlagsOpts) Validate() error {
+	if o.start != "" && o.stop != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s", startFlag, stopFlag)
+	}
+	return nil
+}
+
+// Ask prompts for and validates the application, environment, and service names.
+func (o *svcFlagsOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskSvcEnvName()
+}
+
+func (o *svcFlagsOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcFlagsAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcFlagsOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	var ecsServiceTypes []string
+	for _, svcType := range manifest.ServiceTypes() {
+		// App Runner services don't support Evidently feature flags.
+		if svcType != manifest.RequestDrivenWebServiceType {
+			ecsServiceTypes = append(ecsServiceTypes, svcType)
+		}
+	}
+	deployedService, err := o.sel.DeployedService(
+		fmt.Sprintf(svcFlagsNamePrompt, color.HighlightUserInput(o.appName)),
+		svcFlagsSvcNameHelpPrompt,
+		o.appName,
+		selector.WithEnv(o.envName),
+		selector.WithName(o.svcName),
+		selector.WithServiceTypesFilter(ecsServiceTypes),
+	)
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute starts or stops a launch if requested, then lists the feature flags and launches of the service.
+func (o *svcFlagsOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+	}
+	describer, flags, err := o.newFlagsClients(env, stack.NameForService(o.appName, o.envName, o.svcName))
+	if err != nil {
+		return err
+	}
+	project, err := o.evidentlyProject(describer)
+	if err != nil {
+		return err
+	}
+	switch {
+	case o.start != "":
+		if err := flags.StartLaunch(project, o.start); err != nil {
+			return err
+		}
+		log.Successf("Started launch %s.\n", color.HighlightUserInput(o.start))
+	case o.stop != "":
+		if err := flags.StopLaunch(project, o.stop); err != nil {
+			return err
+		}
+		log.Successf("Stopped launch %s.\n", color.HighlightUserInput(o.stop))
+	}
+	features, err := flags.Features(project)
+	if err != nil {
+		return err
+	}
+	launches, err := flags.Launches(project)
+	if err != nil {
+		return err
+	}
+	o.printFlags(features, launches)
+	return nil
+}
+
+// evidentlyProject returns the ARN of the Evidently project in the service stack.
+func (o *svcFlagsOpts) evidentlyProject(describer stackResourcesDescriber) (string, error) {
+	resources, err := describer.Resources()
+	if err != nil {
+		return "", fmt.Errorf("describe resources of service %s in environment %s: %w", o.svcName, o.envName, err)
+	}
+	for _, resource := range resources {
+		if resource.LogicalID == evidentlyProjectLogicalID {
+			return resource.PhysicalID, nil
+		}
+	}
+	return "", fmt.Errorf(`service %s in environment %s does not have feature flags: add "evidently" to its manifest and redeploy it`, o.svcName, o.envName)
+}
+
+func (o *svcFlagsOpts) printFlags(features []evidently.Feature, launches []evidently.Launch) {
+	writer := tabwriter.NewWriter(o.w, 0, 4, 2, ' ', 0)
+	fmt.Fprint(writer, color.Bold.Sprint("Feature Flags\n\n"))
+	writer.Flush()
+	writeTable(writer, []string{"Name", "Default Variation", "Status"}, func() {
+		for _, feature := range features {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", feature.Name, feature.DefaultVariation, feature.Status)
+		}
+	})
+	fmt.Fprint(writer, color.Bold.Sprint("\nLaunches\n\n"))
+	writer.Flush()
+	if len(launches) == 0 {
+		fmt.Fprintln(writer, "  No launches found.")
+		writer.Flush()
+		return
+	}
+	writeTable(writer, []string{"Name", "Status", "Features"}, func() {
+		for _, launch := range launches {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", launch.Name, launch.Status, strings.Join(launch.Features, ", "))
+		}
+	})
+}
+
+// writeTable writes the headers of a table and their underlines, then calls writeRows and flushes the writer.
+func writeTable(writer *tabwriter.Writer, headers []string, writeRows func()) {
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	var underlines []string
+	for _, header := range headers {
+		underlines = append(underlines, strings.Repeat("-", len(header)))
+	}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underlines, "\t"))
+	writeRows()
+	writer.Flush()
+}
+
+// buildSvcFlagsCmd builds the command for managing the feature flags of a service.
+func buildSvcFlagsCmd() *cobra.Command {
+	vars := svcFlagsVars{}
+	cmd := &cobra.Command{
+		Use:   "flags",
+		Short: "Lists and toggles the CloudWatch Evidently launches of a service.",
+		Long: `Lists the CloudWatch Evidently feature flags and launches of a deployed service.
+Launches can be started or stopped to roll out feature variations to a share of users.`,
+
+		Example: `
+  Lists the feature flags and launches of service "frontend" in environment "test".
+  /code $ copilot svc flags -n frontend -e test
+  Starts the launch "checkout-rollout".
+  /code $ copilot svc flags -n frontend -e test --start checkout-rollout
+  Stops the launch "checkout-rollout".
+  /code $ copilot svc flags -n frontend -e test --stop checkout-rollout`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcFlagsOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVar(&vars.start, startFlag, "", svcFlagsStartFlagDescription)
+	cmd.Flags().StringVar(&vars.stop, stopFlag, "", svcFlagsStopFlagDescription)
+	return cmd
+}
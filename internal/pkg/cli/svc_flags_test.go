@@ -0,0 +1,343 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/evidently"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcFlagsOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inStart string
+		inStop  string
+
+		wantedError error
+	}{
+		"valid without flags": {},
+		"valid with --start": {
+			inStart: "checkout-rollout",
+		},
+		"error if both --start and --stop are set": {
+			inStart:     "checkout-rollout",
+			inStop:      "dark-mode-beta",
+			wantedError: errors.New("cannot specify both --start and --stop"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcFlagsOpts{
+				svcFlagsVars: svcFlagsVars{
+					start: tc.inStart,
+					stop:  tc.inStop,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+type svcFlagsAskMocks struct {
+	store *mocks.Mockstore
+	sel   *mocks.MockdeploySelector
+}
+
+func TestSvcFlagsOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp string
+		inEnv string
+		inSvc string
+
+		setupMocks func(m svcFlagsAskMocks)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedSvc   string
+		wantedError error
+	}{
+		"error if fails to select application": {
+			setupMocks: func(m svcFlagsAskMocks) {
+				m.sel.EXPECT().Application(svcFlagsAppNamePrompt, svcAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select application: some error"),
+		},
+		"error if the environment doesn't exist": {
+			inApp: "phonetool",
+			inEnv: "test",
+			setupMocks: func(m svcFlagsAskMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"error if fails to select a deployed service": {
+			inApp: "phonetool",
+			setupMocks: func(m svcFlagsAskMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.sel.EXPECT().DeployedService(fmt.Sprintf(svcFlagsNamePrompt, "phonetool"), svcFlagsSvcNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("select deployed services for application phonetool: some error"),
+		},
+		"prompt for the application and the deployed service": {
+			setupMocks: func(m svcFlagsAskMocks) {
+				m.sel.EXPECT().Application(svcFlagsAppNamePrompt, svcAppNameHelpPrompt).Return("phonetool", nil)
+				m.sel.EXPECT().DeployedService(fmt.Sprintf(svcFlagsNamePrompt, "phonetool"), svcFlagsSvcNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "test",
+						Name: "frontend",
+					}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+			wantedSvc: "frontend",
+		},
+		"validate the flags": {
+			inApp: "phonetool",
+			inEnv: "test",
+			inSvc: "frontend",
+			setupMocks: func(m svcFlagsAskMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil),
+					m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil),
+					m.store.EXPECT().GetService("phonetool", "frontend").Return(&config.Workload{}, nil),
+				)
+				m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), "phonetool", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "test",
+						Name: "frontend",
+					}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+			wantedSvc: "frontend",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcFlagsAskMocks{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockdeploySelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcFlagsOpts{
+				svcFlagsVars: svcFlagsVars{
+					appName: tc.inApp,
+					envName: tc.inEnv,
+					svcName: tc.inSvc,
+				},
+				store: m.store,
+				sel:   m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+			require.Equal(t, tc.wantedSvc, opts.svcName)
+		})
+	}
+}
+
+type svcFlagsExecuteMocks struct {
+	store     *mocks.Mockstore
+	describer *mocks.MockstackResourcesDescriber
+	flags     *mocks.MockfeatureFlagManager
+}
+
+func TestSvcFlagsOpts_Execute(t *testing.T) {
+	const mockProject = "arn:aws:evidently:us-west-2:123456789012:project/phonetool-test-frontend"
+	mockResources := []*stack.Resource{
+		{
+			LogicalID:  "TaskRole",
+			PhysicalID: "phonetool-test-frontend-TaskRole",
+		},
+		{
+			LogicalID:  "EvidentlyProject",
+			PhysicalID: mockProject,
+		},
+	}
+	mockFeatures := []evidently.Feature{
+		{
+			Name:             "dark-mode",
+			Status:           "AVAILABLE",
+			DefaultVariation: "on",
+		},
+		{
+			Name:             "new-checkout",
+			Status:           "AVAILABLE",
+			DefaultVariation: "off",
+		},
+	}
+	mockLaunches := []evidently.Launch{
+		{
+			Name:     "checkout-rollout",
+			Status:   "RUNNING",
+			Features: []string{"new-checkout"},
+		},
+	}
+	testCases := map[string]struct {
+		inStart string
+		inStop  string
+
+		setupMocks func(m svcFlagsExecuteMocks)
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"error if fails to get the environment": {
+			setupMocks: func(m svcFlagsExecuteMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get environment test configuration: some error"),
+		},
+		"error if fails to describe the service stack resources": {
+			setupMocks: func(m svcFlagsExecuteMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+				m.describer.EXPECT().Resources().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe resources of service frontend in environment test: some error"),
+		},
+		"error if the service doesn't have an Evidently project": {
+			setupMocks: func(m svcFlagsExecuteMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+				m.describer.EXPECT().Resources().Return(mockResources[:1], nil)
+				m.flags.EXPECT().Features(gomock.Any()).Times(0)
+			},
+			wantedError: errors.New(`service frontend in environment test does not have feature flags: add "evidently" to its manifest and redeploy it`),
+		},
+		"error if fails to start the launch": {
+			inStart: "checkout-rollout",
+			setupMocks: func(m svcFlagsExecuteMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+				m.describer.EXPECT().Resources().Return(mockResources, nil)
+				m.flags.EXPECT().StartLaunch(mockProject, "checkout-rollout").Return(errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"error if fails to list the features": {
+			setupMocks: func(m svcFlagsExecuteMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+				m.describer.EXPECT().Resources().Return(mockResources, nil)
+				m.flags.EXPECT().Features(mockProject).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"stop the launch before listing the flags": {
+			inStop: "checkout-rollout",
+			setupMocks: func(m svcFlagsExecuteMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+				m.describer.EXPECT().Resources().Return(mockResources, nil)
+				gomock.InOrder(
+					m.flags.EXPECT().StopLaunch(mockProject, "checkout-rollout").Return(nil),
+					m.flags.EXPECT().Features(mockProject).Return(mockFeatures, nil),
+					m.flags.EXPECT().Launches(mockProject).Return(nil, nil),
+				)
+			},
+			wantedOutput: `Feature Flags
+
+  Name          Default Variation  Status
+  ----          -----------------  ------
+  dark-mode     on                 AVAILABLE
+  new-checkout  off                AVAILABLE
+
+Launches
+
+  No launches found.
+`,
+		},
+		"list the features and launches of the service": {
+			setupMocks: func(m svcFlagsExecuteMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+				m.describer.EXPECT().Resources().Return(mockResources, nil)
+				m.flags.EXPECT().StartLaunch(gomock.Any(), gomock.Any()).Times(0)
+				m.flags.EXPECT().StopLaunch(gomock.Any(), gomock.Any()).Times(0)
+				m.flags.EXPECT().Features(mockProject).Return(mockFeatures, nil)
+				m.flags.EXPECT().Launches(mockProject).Return(mockLaunches, nil)
+			},
+			wantedOutput: `Feature Flags
+
+  Name          Default Variation  Status
+  ----          -----------------  ------
+  dark-mode     on                 AVAILABLE
+  new-checkout  off                AVAILABLE
+
+Launches
+
+  Name              Status   Features
+  ----              ------   --------
+  checkout-rollout  RUNNING  new-checkout
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcFlagsExecuteMocks{
+				store:     mocks.NewMockstore(ctrl),
+				describer: mocks.NewMockstackResourcesDescriber(ctrl),
+				flags:     mocks.NewMockfeatureFlagManager(ctrl),
+			}
+			tc.setupMocks(m)
+			var stackName string
+			b := &bytes.Buffer{}
+			opts := &svcFlagsOpts{
+				svcFlagsVars: svcFlagsVars{
+					appName: "phonetool",
+					envName: "test",
+					svcName: "frontend",
+					start:   tc.inStart,
+					stop:    tc.inStop,
+				},
+				store: m.store,
+				newFlagsClients: func(env *config.Environment, name string) (stackResourcesDescriber, featureFlagManager, error) {
+					stackName = name
+					return m.describer, m.flags, nil
+				},
+				w: b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "phonetool-test-frontend", stackName)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
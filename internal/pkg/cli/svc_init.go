@@ -6,10 +6,13 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
@@ -17,12 +20,14 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/catalog"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/initialize"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/openapi"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -104,7 +109,9 @@ type initWkldVars struct {
 type initSvcVars struct {
 	initWkldVars
 
-	port uint16
+	port        uint16
+	template    string
+	openAPIPath string
 }
 
 type initSvcOpts struct {
@@ -119,6 +126,7 @@ type initSvcOpts struct {
 	sel          dockerfileSelector
 	topicSel     topicSelector
 	mftReader    manifestReader
+	newCatalog   func(location string) (templateGetter, error)
 
 	// Outputs stored on successful actions.
 	manifestPath string
@@ -130,8 +138,11 @@ type initSvcOpts struct {
 	wsPendingCreation bool
 
 	// Cache variables
-	df             dockerfileParser
-	manifestExists bool
+	df                 dockerfileParser
+	manifestExists     bool
+	tpl                *catalog.Contents
+	api                *openapi.Document
+	scaffoldDockerfile bool // True if the Dockerfile of the template needs to be written to the workspace.
 
 	// Init a Dockerfile parser using fs and input path
 	dockerfile func(string) dockerfileParser
@@ -173,6 +184,9 @@ func newInitSvcOpts(vars initSvcVars) (*initSvcOpts, error) {
 		mftReader:    ws,
 		dockerEngine: dockerengine.New(exec.NewCmd()),
 		wsAppName:    tryReadingAppName(),
+		newCatalog: func(location string) (templateGetter, error) {
+			return catalog.New(location, sess)
+		},
 	}
 	opts.dockerfile = func(path string) dockerfileParser {
 		if opts.df != nil {
@@ -206,6 +220,14 @@ func (o *initSvcOpts) Validate() error {
 			return err
 		}
 	}
+	if o.openAPIPath != "" {
+		if o.template != "" {
+			return fmt.Errorf("--%s and --%s cannot be specified together", openAPIFlag, templateFlag)
+		}
+		if _, err := o.fs.Stat(o.openAPIPath); err != nil {
+			return err
+		}
+	}
 	if o.image != "" && o.wkldType == manifest.RequestDrivenWebServiceType {
 		if err := validateAppRunnerImage(o.image); err != nil {
 			return err
@@ -219,6 +241,9 @@ func (o *initSvcOpts) Validate() error {
 
 // Ask prompts for and validates any required flags.
 func (o *initSvcOpts) Ask() error {
+	if err := o.loadTemplate(); err != nil {
+		return err
+	}
 	// NOTE: we optimize the case where `name` is given as a flag while `wkldType` is not.
 	// In this case, we can try reading the manifest, and set `wkldType` to the value found in the manifest
 	// without having to validate it. We can then short circuit the rest of the prompts for an optimal UX.
@@ -259,6 +284,10 @@ func (o *initSvcOpts) Ask() error {
 	if shouldSkipAsking {
 		return nil
 	}
+	if err := o.loadOpenAPI(); err != nil {
+		return err
+	}
+	o.useTemplateDockerfile()
 	err = o.askDockerfile()
 	if err != nil {
 		return err
@@ -279,7 +308,13 @@ func (o *initSvcOpts) Ask() error {
 
 // Execute writes the service's manifest file and stores the service in SSM.
 func (o *initSvcOpts) Execute() error {
+	if err := o.validateEnvRegions(); err != nil {
+		return err
+	}
 	// Check for a valid healthcheck and add it to the opts.
+	if err := o.writeTemplateDockerfile(); err != nil {
+		return err
+	}
 	var hc manifest.ContainerHealthCheck
 	var err error
 	if o.dockerfilePath != "" {
@@ -310,13 +345,17 @@ func (o *initSvcOpts) Execute() error {
 			},
 			Topics: o.topics,
 		},
-		Port:        o.port,
-		HealthCheck: hc,
+		Port:            o.port,
+		HealthCheck:     hc,
+		Path:            o.openAPIRoutingPath(),
+		HTTPHealthCheck: o.openAPIHealthCheckPath(),
+		Template:        o.tpl,
 	})
 	if err != nil {
 		return err
 	}
 	o.manifestPath = manifestPath
+	o.logOpenAPIRouteGroups()
 	return nil
 }
 
@@ -331,6 +370,163 @@ func (o *initSvcOpts) RecommendActions() error {
 	return nil
 }
 
+// loadTemplate retrieves the template of the service from the application's catalog, and sets the service type from it.
+func (o *initSvcOpts) loadTemplate() error {
+	if o.template == "" {
+		return nil
+	}
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	if app.TemplateCatalog == "" {
+		return fmt.Errorf("application %s does not have a template catalog: run %s to add one", o.appName,
+			color.HighlightCode(fmt.Sprintf("copilot app init %s --%s <location>", o.appName, templateCatalogFlag)))
+	}
+	templates, err := o.newCatalog(app.TemplateCatalog)
+	if err != nil {
+		return err
+	}
+	tpl, err := templates.Get(o.template)
+	if err != nil {
+		return fmt.Errorf("get template %s: %w", o.template, err)
+	}
+	if err := validateSvcType(tpl.Type); err != nil {
+		return fmt.Errorf("template %s is not for a service: %w", tpl.Name, err)
+	}
+	if o.wkldType != "" && o.wkldType != tpl.Type {
+		return fmt.Errorf("template %s is for a %s, not a %s", tpl.Name, tpl.Type, o.wkldType)
+	}
+	o.wkldType = tpl.Type
+	o.tpl = tpl
+	log.Infof("Using template %s@%s from the catalog of application %s.\n",
+		color.HighlightUserInput(tpl.Name), tpl.Version, color.HighlightUserInput(o.appName))
+	return nil
+}
+
+// loadOpenAPI parses the OpenAPI document of the service, and sets the port of the service from its servers unless a port was provided.
+func (o *initSvcOpts) loadOpenAPI() error {
+	if o.openAPIPath == "" {
+		return nil
+	}
+	if o.wkldType != manifest.LoadBalancedWebServiceType && o.wkldType != manifest.RequestDrivenWebServiceType {
+		return fmt.Errorf("--%s can only be used with a %s or a %s", openAPIFlag, manifest.LoadBalancedWebServiceType, manifest.RequestDrivenWebServiceType)
+	}
+	dat, err := afero.ReadFile(o.fs, o.openAPIPath)
+	if err != nil {
+		return fmt.Errorf("read OpenAPI document %s: %w", o.openAPIPath, err)
+	}
+	api, err := openapi.Parse(dat)
+	if err != nil {
+		return fmt.Errorf("parse OpenAPI document %s: %w", o.openAPIPath, err)
+	}
+	o.api = api
+	if port, ok := api.Port(); ok && o.port == 0 {
+		log.Infof("Detected port %s from the servers of OpenAPI document %s.\n",
+			color.HighlightUserInput(strconv.Itoa(int(port))), color.HighlightResource(o.openAPIPath))
+		o.port = port
+	}
+	return nil
+}
+
+// openAPIRoutingPath returns the path that routes requests to a Load Balanced Web Service from the base path of its API.
+// Returns an empty string if the API is served at the root, so that the default path is used.
+func (o *initSvcOpts) openAPIRoutingPath() string {
+	if o.api == nil || o.wkldType != manifest.LoadBalancedWebServiceType {
+		return ""
+	}
+	return strings.TrimPrefix(o.api.BasePath(), "/")
+}
+
+// openAPIHealthCheckPath returns the path of an operation of the API that looks like a health check, if any.
+func (o *initSvcOpts) openAPIHealthCheckPath() string {
+	if o.api == nil {
+		return ""
+	}
+	path, ok := o.api.HealthCheckPath()
+	if !ok {
+		return ""
+	}
+	log.Infof("Detected health check path %s from OpenAPI document %s.\n", color.HighlightUserInput(path), color.HighlightResource(o.openAPIPath))
+	return path
+}
+
+// logOpenAPIRouteGroups suggests a layout of routing rules from the paths of the API.
+func (o *initSvcOpts) logOpenAPIRouteGroups() {
+	if o.api == nil {
+		return
+	}
+	groups := o.api.RouteGroups()
+	if len(groups) < 2 {
+		return
+	}
+	log.Infoln("The API can be split into the following routing rules, as load balancer listener rules or API Gateway routes:")
+	for _, group := range groups {
+		log.Infof("  - %s (%d operations)\n", color.HighlightUserInput(group.Path), group.Operations)
+	}
+	log.Infof("Each rule can be served by its own service with the %s field of its manifest.\n", color.HighlightCode("http.path"))
+}
+
+// useTemplateDockerfile builds the service from the Dockerfile of its template, unless a Dockerfile or an image was provided.
+func (o *initSvcOpts) useTemplateDockerfile() {
+	if o.tpl == nil || o.tpl.Dockerfile == nil || o.dockerfilePath != "" || o.image != "" {
+		return
+	}
+	o.dockerfilePath = filepath.Join(o.name, "Dockerfile")
+	o.scaffoldDockerfile = true
+}
+
+func (o *initSvcOpts) writeTemplateDockerfile() error {
+	if !o.scaffoldDockerfile {
+		return nil
+	}
+	exists, err := afero.Exists(o.fs, o.dockerfilePath)
+	if err != nil {
+		return fmt.Errorf("check if %s exists: %w", o.dockerfilePath, err)
+	}
+	if exists {
+		log.Infof("Dockerfile %s already exists, skipping writing the one from template %s.\n", color.HighlightResource(o.dockerfilePath), o.tpl.Name)
+		return nil
+	}
+	if err := o.fs.MkdirAll(filepath.Dir(o.dockerfilePath), 0755); err != nil {
+		return fmt.Errorf("create directory for %s: %w", o.dockerfilePath, err)
+	}
+	if err := afero.WriteFile(o.fs, o.dockerfilePath, o.tpl.Dockerfile, 0644); err != nil {
+		return fmt.Errorf("write Dockerfile of template %s: %w", o.tpl.Name, err)
+	}
+	log.Successf("Wrote the Dockerfile from template %s at %s\n", color.HighlightUserInput(o.tpl.Name), color.HighlightResource(o.dockerfilePath))
+	return nil
+}
+
+// validateEnvRegions returns an error if the service type can't be deployed to any environment of the application,
+// and warns about the environments whose region doesn't support it.
+func (o *initSvcOpts) validateEnvRegions() error {
+	if o.wkldType != manifest.RequestDrivenWebServiceType || o.wsPendingCreation {
+		return nil
+	}
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	var unavailable int
+	for _, env := range envs {
+		err := partitions.Region(env.Region).CheckFeature(partitions.FeatureRequestDrivenWebService)
+		var errUnavailable *partitions.ErrFeatureUnavailable
+		if errors.As(err, &errUnavailable) {
+			log.Warningf("Service %s cannot be deployed to environment %s: %s.\n", o.name, env.Name, errUnavailable.Reason)
+			unavailable++
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("check features of region %s: %w", env.Region, err)
+		}
+	}
+	if len(envs) != 0 && unavailable == len(envs) {
+		return fmt.Errorf("%s is not available in the region of any environment in application %s", o.wkldType, o.appName)
+	}
+	return nil
+}
+
 func (o *initSvcOpts) askSvcType() error {
 	if o.wkldType != "" {
 		return nil
@@ -681,7 +877,13 @@ This command is also run as part of "copilot init".`,
   /code $ copilot svc init --name frontend --svc-type "Load Balanced Web Service" --dockerfile ./frontend/Dockerfile
 
   Create a "subscribers" backend service.
-  /code $ copilot svc init --name subscribers --svc-type "Backend Service"`,
+  /code $ copilot svc init --name subscribers --svc-type "Backend Service"
+
+  Create an "api" service from version v1.2.0 of the "go-api" template of your application's catalog.
+  /code $ copilot svc init --name api --template go-api@v1.2.0
+
+  Create an "orders" load balanced web service with the port, path, and health check of its OpenAPI document.
+  /code $ copilot svc init --name orders --svc-type "Load Balanced Web Service" --dockerfile ./orders/Dockerfile --openapi ./orders/openapi.yml`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitSvcOpts(vars)
 			if err != nil {
@@ -711,6 +913,8 @@ This command is also run as part of "copilot init".`,
 	cmd.Flags().Uint16Var(&vars.port, svcPortFlag, 0, svcPortFlagDescription)
 	cmd.Flags().StringArrayVar(&vars.subscriptions, subscribeTopicsFlag, []string{}, subscribeTopicsFlagDescription)
 	cmd.Flags().BoolVar(&vars.noSubscribe, noSubscriptionFlag, false, noSubscriptionFlagDescription)
+	cmd.Flags().StringVar(&vars.template, templateFlag, "", svcInitTemplateFlagDescription)
+	cmd.Flags().StringVar(&vars.openAPIPath, openAPIFlag, "", svcInitOpenAPIFlagDescription)
 
 	return cmd
 }
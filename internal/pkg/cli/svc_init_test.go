@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/catalog"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
@@ -19,6 +20,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/initialize"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/openapi"
 	"github.com/golang/mock/gomock"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
@@ -44,6 +46,8 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 		inSvcPort        uint16
 		inSubscribeTags  []string
 		inNoSubscribe    bool
+		inTemplate       string
+		inOpenAPIPath    string
 
 		setupMocks     func(mocks initSvcMocks)
 		mockFileSystem func(mockFS afero.Fs)
@@ -102,6 +106,21 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 			},
 			wantedErr: errors.New("validate subscribe configuration: cannot specify both --no-subscribe and --subscribe-topics"),
 		},
+		"fail if both openapi and template are set": {
+			inOpenAPIPath: "api/openapi.yml",
+			inTemplate:    "go-api",
+			setupMocks: func(m initSvcMocks) {
+				m.mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+			},
+			wantedErr: errors.New("--openapi and --template cannot be specified together"),
+		},
+		"fail if the OpenAPI document does not exist": {
+			inOpenAPIPath: "api/openapi.yml",
+			setupMocks: func(m initSvcMocks) {
+				m.mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+			},
+			wantedErr: errors.New("open api/openapi.yml: file does not exist"),
+		},
 		"valid flags": {
 			inSvcName:        "frontend",
 			inSvcType:        "Load Balanced Web Service",
@@ -141,7 +160,9 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 						subscriptions:  tc.inSubscribeTags,
 						noSubscribe:    tc.inNoSubscribe,
 					},
-					port: tc.inSvcPort,
+					port:        tc.inSvcPort,
+					template:    tc.inTemplate,
+					openAPIPath: tc.inOpenAPIPath,
 				},
 				store:     mockstore,
 				fs:        &afero.Afero{Fs: afero.NewMemMapFs()},
@@ -688,6 +709,7 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 		mockDockerfile   func(m *mocks.MockdockerfileParser)
 		mockDockerEngine func(m *mocks.MockdockerEngine)
 		mockTopicSel     func(m *mocks.MocktopicSelector)
+		mockStore        func(m *mocks.Mockstore)
 		inSvcPort        uint16
 		inSvcType        string
 		inSvcName        string
@@ -976,6 +998,9 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 
 			inSvcPort: 80,
 
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().ListEnvironments("sample").Return(nil, nil)
+			},
 			mockDockerfile: func(m *mocks.MockdockerfileParser) {
 				m.EXPECT().GetHealthCheck().Return(nil, nil)
 			},
@@ -986,6 +1011,50 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 
 			wantedErr: errors.New("redirect docker engine platform: Windows is not supported for App Runner services"),
 		},
+		"return error if failed to list the environments for a RDWS": {
+			inAppName: "sample",
+			inSvcName: "appRunner",
+			inSvcType: manifest.RequestDrivenWebServiceType,
+
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().ListEnvironments("sample").Return(nil, errors.New("some error"))
+			},
+
+			wantedErr: errors.New("list environments in application sample: some error"),
+		},
+		"return error if no environment region supports RDWS": {
+			inAppName: "sample",
+			inSvcName: "appRunner",
+			inSvcType: manifest.RequestDrivenWebServiceType,
+
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().ListEnvironments("sample").Return([]*config.Environment{
+					{Name: "test", Region: "cn-north-1"},
+					{Name: "prod", Region: "us-gov-west-1"},
+				}, nil)
+			},
+
+			wantedErr: errors.New("Request-Driven Web Service is not available in the region of any environment in application sample"),
+		},
+		"success if some environment region supports RDWS": {
+			inAppName: "sample",
+			inSvcName: "appRunner",
+			inSvcType: manifest.RequestDrivenWebServiceType,
+			inImage:   "public.ecr.aws/nginx/nginx",
+			inSvcPort: 80,
+
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().ListEnvironments("sample").Return([]*config.Environment{
+					{Name: "test", Region: "cn-north-1"},
+					{Name: "prod", Region: "us-west-2"},
+				}, nil)
+			},
+			mockSvcInit: func(m *mocks.MocksvcInitializer) {
+				m.EXPECT().Service(gomock.Any()).Return("manifest/path", nil)
+			},
+
+			wantedManifestPath: "manifest/path",
+		},
 		"failure": {
 			mockDockerEngine: func(m *mocks.MockdockerEngine) {
 				m.EXPECT().CheckDockerEngineRunning().Return(nil)
@@ -1008,6 +1077,7 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 			mockDockerfile := mocks.NewMockdockerfileParser(ctrl)
 			mockDockerEngine := mocks.NewMockdockerEngine(ctrl)
 			mockTopicSel := mocks.NewMocktopicSelector(ctrl)
+			mockStore := mocks.NewMockstore(ctrl)
 
 			if tc.mockSvcInit != nil {
 				tc.mockSvcInit(mockSvcInitializer)
@@ -1018,6 +1088,9 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 			if tc.mockDockerEngine != nil {
 				tc.mockDockerEngine(mockDockerEngine)
 			}
+			if tc.mockStore != nil {
+				tc.mockStore(mockStore)
+			}
 			opts := initSvcOpts{
 				initSvcVars: initSvcVars{
 					initWkldVars: initWkldVars{
@@ -1036,6 +1109,7 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 				df:             mockDockerfile,
 				dockerEngine:   mockDockerEngine,
 				topicSel:       mockTopicSel,
+				store:          mockStore,
 				manifestExists: tc.inManifestExists,
 			}
 
@@ -1052,3 +1126,383 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestSvcInitOpts_AskWithTemplate(t *testing.T) {
+	const (
+		mockAppName  = "phonetool"
+		mockCatalog  = "s3://my-templates/copilot"
+		mockTemplate = "go-api@v1"
+	)
+	mockContents := &catalog.Contents{
+		Template: catalog.Template{
+			Name:    "go-api",
+			Version: "v1",
+			Type:    manifest.LoadBalancedWebServiceType,
+		},
+		Manifest:   []byte("name: {{.Name}}"),
+		Dockerfile: []byte("FROM golang"),
+	}
+	testCases := map[string]struct {
+		inSvcType        string
+		inDockerfilePath string
+
+		setupMocks func(store *mocks.Mockstore, templates *mocks.MocktemplateGetter, mftReader *mocks.MockmanifestReader)
+
+		wantedErr                error
+		wantedSvcType            string
+		wantedDockerfilePath     string
+		wantedScaffoldDockerfile bool
+	}{
+		"returns an error if the application does not have a template catalog": {
+			setupMocks: func(store *mocks.Mockstore, _ *mocks.MocktemplateGetter, _ *mocks.MockmanifestReader) {
+				store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+			},
+			wantedErr: errors.New("application phonetool does not have a template catalog: run `copilot app init phonetool --template-catalog <location>` to add one"),
+		},
+		"returns a wrapped error if the template cannot be retrieved": {
+			setupMocks: func(store *mocks.Mockstore, templates *mocks.MocktemplateGetter, _ *mocks.MockmanifestReader) {
+				store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName, TemplateCatalog: mockCatalog}, nil)
+				templates.EXPECT().Get(mockTemplate).Return(nil, &catalog.ErrTemplateNotFound{Name: "go-api", Version: "v1"})
+			},
+			wantedErr: errors.New("get template go-api@v1: version v1 of template go-api not found in the catalog"),
+		},
+		"returns an error if the template is not for a service": {
+			setupMocks: func(store *mocks.Mockstore, templates *mocks.MocktemplateGetter, _ *mocks.MockmanifestReader) {
+				store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName, TemplateCatalog: mockCatalog}, nil)
+				templates.EXPECT().Get(mockTemplate).Return(&catalog.Contents{
+					Template: catalog.Template{Name: "go-api", Type: manifest.ScheduledJobType},
+				}, nil)
+			},
+			wantedErr: errors.New(`template go-api is not for a service: invalid service type Scheduled Job: must be one of "Request-Driven Web Service", "Load Balanced Web Service", "Backend Service", "Worker Service"`),
+		},
+		"returns an error if the template conflicts with the service type": {
+			inSvcType: manifest.BackendServiceType,
+			setupMocks: func(store *mocks.Mockstore, templates *mocks.MocktemplateGetter, _ *mocks.MockmanifestReader) {
+				store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName, TemplateCatalog: mockCatalog}, nil)
+				templates.EXPECT().Get(mockTemplate).Return(mockContents, nil)
+			},
+			wantedErr: errors.New("template go-api is for a Load Balanced Web Service, not a Backend Service"),
+		},
+		"uses the service type and the Dockerfile of the template": {
+			setupMocks: func(store *mocks.Mockstore, templates *mocks.MocktemplateGetter, mftReader *mocks.MockmanifestReader) {
+				store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName, TemplateCatalog: mockCatalog}, nil)
+				templates.EXPECT().Get(mockTemplate).Return(mockContents, nil)
+				store.EXPECT().GetService(mockAppName, "api").Return(nil, &config.ErrNoSuchService{})
+				mftReader.EXPECT().ReadWorkloadManifest("api").Return(nil, &workspace.ErrFileNotExists{FileName: "api"})
+			},
+			wantedSvcType:            manifest.LoadBalancedWebServiceType,
+			wantedDockerfilePath:     "api/Dockerfile",
+			wantedScaffoldDockerfile: true,
+		},
+		"keeps the Dockerfile provided with the flag": {
+			inDockerfilePath: "api/custom.Dockerfile",
+			setupMocks: func(store *mocks.Mockstore, templates *mocks.MocktemplateGetter, mftReader *mocks.MockmanifestReader) {
+				store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName, TemplateCatalog: mockCatalog}, nil)
+				templates.EXPECT().Get(mockTemplate).Return(mockContents, nil)
+				store.EXPECT().GetService(mockAppName, "api").Return(nil, &config.ErrNoSuchService{})
+				mftReader.EXPECT().ReadWorkloadManifest("api").Return(nil, &workspace.ErrFileNotExists{FileName: "api"})
+			},
+			wantedSvcType:        manifest.LoadBalancedWebServiceType,
+			wantedDockerfilePath: "api/custom.Dockerfile",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockTemplates := mocks.NewMocktemplateGetter(ctrl)
+			mockManifestReader := mocks.NewMockmanifestReader(ctrl)
+			tc.setupMocks(mockStore, mockTemplates, mockManifestReader)
+
+			opts := &initSvcOpts{
+				initSvcVars: initSvcVars{
+					initWkldVars: initWkldVars{
+						appName:        mockAppName,
+						name:           "api",
+						wkldType:       tc.inSvcType,
+						dockerfilePath: tc.inDockerfilePath,
+					},
+					port:     8080,
+					template: mockTemplate,
+				},
+				store:     mockStore,
+				mftReader: mockManifestReader,
+				newCatalog: func(location string) (templateGetter, error) {
+					require.Equal(t, mockCatalog, location)
+					return mockTemplates, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSvcType, opts.wkldType)
+			require.Equal(t, tc.wantedDockerfilePath, opts.dockerfilePath)
+			require.Equal(t, tc.wantedScaffoldDockerfile, opts.scaffoldDockerfile)
+		})
+	}
+}
+
+func TestSvcInitOpts_ExecuteWithTemplate(t *testing.T) {
+	mockContents := &catalog.Contents{
+		Template: catalog.Template{
+			Name: "go-api",
+			Type: manifest.BackendServiceType,
+		},
+		Dockerfile: []byte("FROM golang"),
+	}
+	testCases := map[string]struct {
+		setupFs func(fs afero.Fs)
+
+		wantedDockerfile string
+	}{
+		"writes the Dockerfile of the template": {
+			setupFs:          func(fs afero.Fs) {},
+			wantedDockerfile: "FROM golang",
+		},
+		"does not overwrite an existing Dockerfile": {
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "api/Dockerfile", []byte("FROM alpine"), 0644)
+			},
+			wantedDockerfile: "FROM alpine",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			fs := afero.NewMemMapFs()
+			tc.setupFs(fs)
+			mockSvcInitializer := mocks.NewMocksvcInitializer(ctrl)
+			mockDockerfile := mocks.NewMockdockerfileParser(ctrl)
+			mockDockerEngine := mocks.NewMockdockerEngine(ctrl)
+			mockDockerfile.EXPECT().GetHealthCheck().Return(nil, nil)
+			mockDockerEngine.EXPECT().CheckDockerEngineRunning().Return(nil)
+			mockDockerEngine.EXPECT().GetPlatform().Return("linux", "amd64", nil)
+			mockSvcInitializer.EXPECT().Service(&initialize.ServiceProps{
+				WorkloadProps: initialize.WorkloadProps{
+					App:            "sample",
+					Name:           "api",
+					Type:           manifest.BackendServiceType,
+					DockerfilePath: "api/Dockerfile",
+				},
+				Template: mockContents,
+			}).Return("api/manifest.yml", nil)
+
+			opts := initSvcOpts{
+				initSvcVars: initSvcVars{
+					initWkldVars: initWkldVars{
+						appName:        "sample",
+						name:           "api",
+						wkldType:       manifest.BackendServiceType,
+						dockerfilePath: "api/Dockerfile",
+					},
+				},
+				fs:   fs,
+				init: mockSvcInitializer,
+				dockerfile: func(s string) dockerfileParser {
+					return mockDockerfile
+				},
+				dockerEngine:       mockDockerEngine,
+				tpl:                mockContents,
+				scaffoldDockerfile: true,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			require.NoError(t, err)
+			content, err := afero.ReadFile(fs, "api/Dockerfile")
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDockerfile, string(content))
+		})
+	}
+}
+
+func TestSvcInitOpts_AskWithOpenAPI(t *testing.T) {
+	const mockOpenAPI = `
+openapi: 3.0.3
+servers:
+  - url: http://localhost:8080/v1
+paths:
+  /orders:
+    get: {}
+  /health:
+    get: {}
+`
+	testCases := map[string]struct {
+		inSvcType string
+		inSvcPort uint16
+		inOpenAPI string
+
+		wantedErr  error
+		wantedPort uint16
+	}{
+		"returns an error if the service type does not route HTTP requests": {
+			inSvcType: manifest.BackendServiceType,
+			inOpenAPI: mockOpenAPI,
+			wantedErr: errors.New("--openapi can only be used with a Load Balanced Web Service or a Request-Driven Web Service"),
+		},
+		"returns a wrapped error if the file is not an OpenAPI document": {
+			inSvcType: manifest.LoadBalancedWebServiceType,
+			inOpenAPI: "name: orders",
+			wantedErr: errors.New(`parse OpenAPI document orders/openapi.yml: document must declare an "openapi" or a "swagger" version`),
+		},
+		"sets the port from the servers of the document": {
+			inSvcType:  manifest.RequestDrivenWebServiceType,
+			inOpenAPI:  mockOpenAPI,
+			wantedPort: 8080,
+		},
+		"keeps the port provided with the flag": {
+			inSvcType:  manifest.LoadBalancedWebServiceType,
+			inSvcPort:  3000,
+			inOpenAPI:  mockOpenAPI,
+			wantedPort: 3000,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockManifestReader := mocks.NewMockmanifestReader(ctrl)
+			mockStore.EXPECT().GetService("phonetool", "orders").Return(nil, &config.ErrNoSuchService{})
+			mockManifestReader.EXPECT().ReadWorkloadManifest("orders").Return(nil, &workspace.ErrFileNotExists{FileName: "orders"})
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "orders/openapi.yml", []byte(tc.inOpenAPI), 0644))
+
+			opts := &initSvcOpts{
+				initSvcVars: initSvcVars{
+					initWkldVars: initWkldVars{
+						appName:        "phonetool",
+						name:           "orders",
+						wkldType:       tc.inSvcType,
+						dockerfilePath: "orders/Dockerfile",
+					},
+					port:        tc.inSvcPort,
+					openAPIPath: "orders/openapi.yml",
+				},
+				fs:        fs,
+				store:     mockStore,
+				mftReader: mockManifestReader,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPort, opts.port)
+		})
+	}
+}
+
+func TestSvcInitOpts_ExecuteWithOpenAPI(t *testing.T) {
+	testCases := map[string]struct {
+		inSvcType string
+		inOpenAPI string
+
+		wantedPath        string
+		wantedHealthCheck string
+	}{
+		"prefills the path and health check of a Load Balanced Web Service": {
+			inSvcType: manifest.LoadBalancedWebServiceType,
+			inOpenAPI: `
+openapi: 3.0.3
+servers:
+  - url: https://api.example.com/v1
+paths:
+  /orders:
+    get: {}
+  /health:
+    get: {}
+`,
+			wantedPath:        "v1",
+			wantedHealthCheck: "/v1/health",
+		},
+		"uses the default path if the API is served at the root": {
+			inSvcType: manifest.LoadBalancedWebServiceType,
+			inOpenAPI: `
+swagger: "2.0"
+paths:
+  /orders:
+    get: {}
+`,
+		},
+		"prefills only the health check of a Request-Driven Web Service": {
+			inSvcType: manifest.RequestDrivenWebServiceType,
+			inOpenAPI: `
+openapi: 3.0.3
+servers:
+  - url: https://api.example.com/v1
+paths:
+  /ping:
+    get: {}
+`,
+			wantedHealthCheck: "/v1/ping",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			api, err := openapi.Parse([]byte(tc.inOpenAPI))
+			require.NoError(t, err)
+			mockSvcInitializer := mocks.NewMocksvcInitializer(ctrl)
+			mockSvcInitializer.EXPECT().Service(&initialize.ServiceProps{
+				WorkloadProps: initialize.WorkloadProps{
+					App:   "phonetool",
+					Name:  "orders",
+					Type:  tc.inSvcType,
+					Image: "public.ecr.aws/phonetool/orders",
+				},
+				Port:            8080,
+				Path:            tc.wantedPath,
+				HTTPHealthCheck: tc.wantedHealthCheck,
+			}).Return("orders/manifest.yml", nil)
+
+			opts := initSvcOpts{
+				initSvcVars: initSvcVars{
+					initWkldVars: initWkldVars{
+						appName:  "phonetool",
+						name:     "orders",
+						wkldType: tc.inSvcType,
+						image:    "public.ecr.aws/phonetool/orders",
+					},
+					port:        8080,
+					openAPIPath: "orders/openapi.yml",
+				},
+				init:              mockSvcInitializer,
+				api:               api,
+				wsPendingCreation: true,
+			}
+
+			// WHEN
+			err = opts.Execute()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, "orders/manifest.yml", opts.manifestPath)
+		})
+	}
+}
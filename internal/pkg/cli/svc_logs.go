@@ -65,6 +65,20 @@ type wkldLogOpts struct {
 	initLogsSvc func() error // Overridden in tests.
 }
 
+// wkldLogGroup returns the name of the workload's log group under the application's naming convention.
+// If the application uses the default names, returns an empty string.
+func (o *wkldLogOpts) wkldLogGroup(app, env, wkld string) (string, error) {
+	a, err := o.configStore.GetApplication(app)
+	if err != nil {
+		return "", fmt.Errorf("get application %s: %w", app, err)
+	}
+	if a.ResourcePrefix == "" {
+		return "", nil
+	}
+	names := deploy.ResourceNames{Prefix: a.ResourcePrefix}
+	return names.WorkloadLogGroup(app, env, wkld), nil
+}
+
 func newSvcLogOpts(vars wkldLogsVars) (*svcLogsOpts, error) {
 	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc logs"))
 	defaultSess, err := sessProvider.Default()
@@ -99,12 +113,18 @@ func newSvcLogOpts(vars wkldLogsVars) (*svcLogsOpts, error) {
 		if err != nil {
 			return err
 		}
+		logGroup := opts.logGroup
+		if logGroup == "" {
+			if logGroup, err = opts.wkldLogGroup(opts.appName, opts.envName, opts.name); err != nil {
+				return err
+			}
+		}
 		opts.logsSvc, err = logging.NewServiceClient(&logging.NewServiceLogsConfig{
 			App:         opts.appName,
 			Env:         opts.envName,
 			Svc:         opts.name,
 			Sess:        sess,
-			LogGroup:    opts.logGroup,
+			LogGroup:    logGroup,
 			WkldType:    workload.Type,
 			TaskIDs:     opts.taskIDs,
 			ConfigStore: configStore,
@@ -37,16 +37,21 @@ type showSvcVars struct {
 	shouldOutputJSON      bool
 	shouldOutputResources bool
 	outputManifestForEnv  string
+	outputParamsForEnv    string
+	shouldOutputEvents    bool
 }
 
 type showSvcOpts struct {
 	showSvcVars
 
-	w             io.Writer
-	store         store
-	describer     workloadDescriber
-	sel           configSelector
-	initDescriber func() error // Overridden in tests.
+	w               io.Writer
+	store           store
+	describer       workloadDescriber
+	eventsDescriber describer
+	sel             configSelector
+	initDescriber   func() error // Overridden in tests.
+	// localParams generates the service stack parameters for an environment from the workspace.
+	localParams func(env string) (map[string]string, error)
 
 	// Cached variables.
 	targetSvc *config.Workload
@@ -118,8 +123,36 @@ func newShowSvcOpts(vars showSvcVars) (*showSvcOpts, error) {
 			return fmt.Errorf("creating describer for service %s in application %s: %w", opts.svcName, opts.appName, err)
 		}
 		opts.describer = d
+		if opts.shouldOutputEvents {
+			if svc.Type == manifest.RequestDrivenWebServiceType {
+				return fmt.Errorf("events are only available for services running on Amazon ECS, and %s is a %s", opts.svcName, svc.Type)
+			}
+			opts.eventsDescriber = describe.NewECSServiceEventsDescriber(describe.NewServiceConfig{
+				App:         opts.appName,
+				Svc:         opts.svcName,
+				ConfigStore: ssmStore,
+				DeployStore: deployStore,
+			})
+		}
 		return nil
 	}
+	opts.localParams = func(env string) (map[string]string, error) {
+		pkgOpts, err := newPackageSvcOpts(packageSvcVars{
+			name:    opts.svcName,
+			envName: env,
+			appName: opts.appName,
+		})
+		if err != nil {
+			return nil, err
+		}
+		params := &bufferFile{}
+		pkgOpts.stackWriter = discardFile{}
+		pkgOpts.paramsWriter = params
+		if err := pkgOpts.Execute(); err != nil {
+			return nil, err
+		}
+		return parseSerializedParams(params.String())
+	}
 	return opts, nil
 }
 
@@ -148,6 +181,12 @@ func (o *showSvcOpts) Execute() error {
 	if o.outputManifestForEnv != "" {
 		return o.writeManifest()
 	}
+	if o.outputParamsForEnv != "" {
+		return o.writeParams()
+	}
+	if o.shouldOutputEvents {
+		return o.writeEvents()
+	}
 	svc, err := o.describer.Describe()
 	if err != nil {
 		return fmt.Errorf("describe service %s: %w", o.svcName, err)
@@ -220,6 +259,49 @@ func (o *showSvcOpts) writeManifest() error {
 	return nil
 }
 
+func (o *showSvcOpts) writeParams() error {
+	deployed, err := o.describer.Params(o.outputParamsForEnv)
+	if err != nil {
+		return fmt.Errorf("get parameters for service %q in environment %q: %w", o.svcName, o.outputParamsForEnv, err)
+	}
+	params := &describe.StackParams{
+		Deployed: deployed,
+	}
+	local, err := o.localParams(o.outputParamsForEnv)
+	if err != nil {
+		log.Warningf("Could not generate the parameters of service %s in environment %s from the workspace: %v\n", o.svcName, o.outputParamsForEnv, err)
+	} else {
+		params.Local = local
+	}
+	content := params.HumanString()
+	if o.shouldOutputJSON {
+		data, err := params.JSONString()
+		if err != nil {
+			return err
+		}
+		content = data
+	}
+	fmt.Fprint(o.w, content)
+	return nil
+}
+
+func (o *showSvcOpts) writeEvents() error {
+	events, err := o.eventsDescriber.Describe()
+	if err != nil {
+		return fmt.Errorf("describe events of service %s: %w", o.svcName, err)
+	}
+	if !o.shouldOutputJSON {
+		fmt.Fprint(o.w, events.HumanString())
+		return nil
+	}
+	data, err := events.JSONString()
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(o.w, data)
+	return nil
+}
+
 // buildSvcShowCmd builds the command for showing services in an application.
 func buildSvcShowCmd() *cobra.Command {
 	vars := showSvcVars{}
@@ -232,7 +314,11 @@ func buildSvcShowCmd() *cobra.Command {
   Print service configuration in deployed environments.
   /code $ copilot svc show -n api
   Print manifest file used for deploying service "api" in the "prod" environment.
-  /code $ copilot svc show -n api --manifest prod`,
+  /code $ copilot svc show -n api --manifest prod
+  Print the parameters of service "api" in the "prod" environment and the ones that a deployment would change.
+  /code $ copilot svc show -n api --params prod
+  Print the recent events, stopped tasks and image pull failures of service "api" to find out why a deployment is stuck.
+  /code $ copilot svc show -n api --events`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newShowSvcOpts(vars)
 			if err != nil {
@@ -246,8 +332,15 @@ func buildSvcShowCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputResources, resourcesFlag, false, svcResourcesFlagDescription)
 	cmd.Flags().StringVar(&vars.outputManifestForEnv, manifestFlag, "", manifestFlagDescription)
+	cmd.Flags().StringVar(&vars.outputParamsForEnv, paramsFlag, "", svcParamsFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputEvents, eventsFlag, false, svcEventsFlagDescription)
 
 	cmd.MarkFlagsMutuallyExclusive(jsonFlag, manifestFlag)
 	cmd.MarkFlagsMutuallyExclusive(resourcesFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(paramsFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(paramsFlag, resourcesFlag)
+	cmd.MarkFlagsMutuallyExclusive(eventsFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(eventsFlag, paramsFlag)
+	cmd.MarkFlagsMutuallyExclusive(eventsFlag, resourcesFlag)
 	return cmd
 }
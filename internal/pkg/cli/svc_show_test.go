@@ -17,10 +17,11 @@ import (
 )
 
 type showSvcMocks struct {
-	storeSvc  *mocks.Mockstore
-	describer *mocks.MockworkloadDescriber
-	ws        *mocks.MockwsSvcReader
-	sel       *mocks.MockconfigSelector
+	storeSvc        *mocks.Mockstore
+	describer       *mocks.MockworkloadDescriber
+	eventsDescriber *mocks.Mockdescriber
+	ws              *mocks.MockwsSvcReader
+	sel             *mocks.MockconfigSelector
 }
 
 type mockDescribeData struct {
@@ -151,6 +152,9 @@ func TestSvcShow_Execute(t *testing.T) {
 		inputSvc             string
 		shouldOutputJSON     bool
 		outputManifestForEnv string
+		outputParamsForEnv   string
+		shouldOutputEvents   bool
+		localParams          func(env string) (map[string]string, error)
 
 		setupMocks func(mocks showSvcMocks)
 
@@ -214,6 +218,73 @@ func TestSvcShow_Execute(t *testing.T) {
 
 			wantedError: errors.New(`fetch manifest for service "my-svc" in environment "test": some error`),
 		},
+		"return wrapped error if --params is provided and stack cannot be retrieved": {
+			inputSvc:           "my-svc",
+			outputParamsForEnv: "test",
+			setupMocks: func(m showSvcMocks) {
+				m.describer.EXPECT().Params("test").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New(`get parameters for service "my-svc" in environment "test": some error`),
+		},
+		"print the deployed parameters and highlight the ones that would change if --params is provided": {
+			inputSvc:           "my-svc",
+			outputParamsForEnv: "test",
+			localParams: func(env string) (map[string]string, error) {
+				require.Equal(t, "test", env)
+				return map[string]string{
+					"TaskCount": "3",
+					"TaskCPU":   "256",
+				}, nil
+			},
+			setupMocks: func(m showSvcMocks) {
+				m.describer.EXPECT().Params("test").Return(map[string]string{
+					"TaskCount": "1",
+					"TaskCPU":   "256",
+				}, nil)
+			},
+
+			wantedContent: `Parameters
+
+  Name       Value     Local Value
+  ----       -----     -----------
+  TaskCPU    256       -
+  TaskCount  1         3
+`,
+		},
+		"return wrapped error if --events is provided and the events cannot be described": {
+			inputSvc:           "my-svc",
+			shouldOutputEvents: true,
+			setupMocks: func(m showSvcMocks) {
+				m.eventsDescriber.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe events of service my-svc: some error"),
+		},
+		"print the events of the service if --events is provided": {
+			inputSvc:           "my-svc",
+			shouldOutputEvents: true,
+			setupMocks: func(m showSvcMocks) {
+				m.describer.EXPECT().Describe().Times(0)
+				m.eventsDescriber.EXPECT().Describe().Return(&mockDescribeData{
+					data: "mockEvents",
+				}, nil)
+			},
+
+			wantedContent: "mockEvents",
+		},
+		"print the events of the service in JSON if --events and --json are provided": {
+			inputSvc:           "my-svc",
+			shouldOutputEvents: true,
+			shouldOutputJSON:   true,
+			setupMocks: func(m showSvcMocks) {
+				m.eventsDescriber.EXPECT().Describe().Return(&mockDescribeData{
+					data: "mockJSONEvents",
+				}, nil)
+			},
+
+			wantedContent: "mockJSONEvents",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -223,9 +294,11 @@ func TestSvcShow_Execute(t *testing.T) {
 
 			b := &bytes.Buffer{}
 			mockSvcDescriber := mocks.NewMockworkloadDescriber(ctrl)
+			mockEventsDescriber := mocks.NewMockdescriber(ctrl)
 
 			mocks := showSvcMocks{
-				describer: mockSvcDescriber,
+				describer:       mockSvcDescriber,
+				eventsDescriber: mockEventsDescriber,
 			}
 
 			tc.setupMocks(mocks)
@@ -236,10 +309,14 @@ func TestSvcShow_Execute(t *testing.T) {
 					svcName:              tc.inputSvc,
 					shouldOutputJSON:     tc.shouldOutputJSON,
 					outputManifestForEnv: tc.outputManifestForEnv,
+					outputParamsForEnv:   tc.outputParamsForEnv,
+					shouldOutputEvents:   tc.shouldOutputEvents,
 				},
-				describer:     mockSvcDescriber,
-				initDescriber: func() error { return nil },
-				w:             b,
+				describer:       mockSvcDescriber,
+				eventsDescriber: mockEventsDescriber,
+				initDescriber:   func() error { return nil },
+				localParams:     tc.localParams,
+				w:               b,
 			}
 
 			// WHEN
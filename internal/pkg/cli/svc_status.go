@@ -4,8 +4,11 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"text/tabwriter"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -16,9 +19,12 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/probe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/cobra"
 )
 
@@ -29,6 +35,7 @@ const (
 
 type svcStatusVars struct {
 	shouldOutputJSON bool
+	checkURI         bool
 	svcName          string
 	envName          string
 	appName          string
@@ -40,8 +47,11 @@ type svcStatusOpts struct {
 	w                   io.Writer
 	store               store
 	statusDescriber     statusDescriber
+	uriDescriber        reachableSvcDescriber
+	prober              endpointProber
 	sel                 deploySelector
 	initStatusDescriber func(*svcStatusOpts) error
+	initURIDescriber    func(*svcStatusOpts) error
 }
 
 func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
@@ -60,6 +70,7 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 		svcStatusVars: vars,
 		store:         configStore,
 		w:             log.OutputWriter,
+		prober:        probe.New(probe.DefaultTimeout),
 		sel:           selector.NewDeploySelect(prompt.New(), configStore, deployStore),
 		initStatusDescriber: func(o *svcStatusOpts) error {
 			wkld, err := configStore.GetWorkload(o.appName, o.svcName)
@@ -91,11 +102,26 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 			}
 			return nil
 		},
+		initURIDescriber: func(o *svcStatusOpts) error {
+			d, err := describe.NewReachableService(o.appName, o.svcName, configStore)
+			if err != nil {
+				return err
+			}
+			describer, ok := d.(reachableSvcDescriber)
+			if !ok {
+				return fmt.Errorf("service %s does not have a deployed manifest", o.svcName)
+			}
+			o.uriDescriber = describer
+			return nil
+		},
 	}, nil
 }
 
 // Validate returns an error for any invalid optional flags.
 func (o *svcStatusOpts) Validate() error {
+	if o.checkURI && o.shouldOutputJSON {
+		return fmt.Errorf("cannot specify both --%s and --%s", checkURIFlag, jsonFlag)
+	}
 	return nil
 }
 
@@ -126,7 +152,31 @@ func (o *svcStatusOpts) Execute() error {
 	} else {
 		fmt.Fprint(o.w, svcStatus.HumanString())
 	}
+	if o.checkURI {
+		return o.checkEndpoints()
+	}
+	return nil
+}
 
+// checkEndpoints probes the endpoints of the service and returns an error if any of them didn't respond successfully.
+func (o *svcStatusOpts) checkEndpoints() error {
+	if err := o.initURIDescriber(o); err != nil {
+		return err
+	}
+	uri, err := o.uriDescriber.URI(o.envName)
+	if err != nil {
+		return fmt.Errorf("get uri for environment %s: %w", o.envName, err)
+	}
+	var path string
+	// Best effort to respect the health check path, the probes default to the URI if the manifest can't be read.
+	if raw, err := o.uriDescriber.Manifest(o.envName); err == nil {
+		if mft, err := manifest.UnmarshalWorkload(raw); err == nil {
+			path = healthCheckPath(mft)
+		}
+	}
+	if failed := checkURI(o.w, o.prober, uri, path); failed > 0 {
+		return fmt.Errorf("%s of service %s did not respond successfully", english.Plural(failed, "endpoint", ""), o.svcName)
+	}
 	return nil
 }
 
@@ -166,6 +216,54 @@ func (o *svcStatusOpts) validateAndAskSvcEnvName() error {
 	return nil
 }
 
+// checkURI probes the endpoints of a service that are reachable over the internet, writes how each of them responded,
+// and returns the number of endpoints that didn't respond successfully.
+func checkURI(w io.Writer, prober endpointProber, uri describe.URI, healthCheckPath string) int {
+	writer := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprint(writer, color.Bold.Sprint("\nURI Check\n\n"))
+	writer.Flush()
+	endpoints := uri.Endpoints()
+	if uri.AccessType != describe.URIAccessTypeInternet || len(endpoints) == 0 {
+		fmt.Fprintln(writer, "  The service has no endpoints reachable over the internet.")
+		writer.Flush()
+		return 0
+	}
+	var failed int
+	results := prober.Probe(context.Background(), endpoints, healthCheckPath)
+	writeTable(writer, []string{"Endpoint", "DNS Name", "Status", "Latency"}, func() {
+		for _, res := range results {
+			latency := "-"
+			if res.Err == nil {
+				latency = res.Latency.Round(time.Millisecond).String()
+			}
+			if !res.Healthy() {
+				failed++
+			}
+			fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", res.Endpoint, res.DNSName, res.Status(), latency)
+		}
+	})
+	return failed
+}
+
+// healthCheckPath returns the path of the HTTP health check of a service manifest, or empty if it's the default one.
+func healthCheckPath(mft interface{}) string {
+	var hc manifest.HealthCheckArgsOrString
+	switch mft := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		hc = mft.RoutingRule.HealthCheck
+	case *manifest.RequestDrivenWebService:
+		hc = mft.HealthCheckConfiguration
+	default:
+		return ""
+	}
+	path := aws.StringValue(hc.Path())
+	if path == manifest.DefaultHealthCheckPath {
+		// Probe the URI as is, as the root path may be routed to a different service.
+		return ""
+	}
+	return path
+}
+
 // buildSvcStatusCmd builds the command for showing the status of a deployed service.
 func buildSvcStatusCmd() *cobra.Command {
 	vars := svcStatusVars{}
@@ -176,7 +274,9 @@ func buildSvcStatusCmd() *cobra.Command {
 
 		Example: `
   Shows status of the deployed service "my-svc"
-  /code $ copilot svc status -n my-svc`,
+  /code $ copilot svc status -n my-svc
+  Shows status of the deployed service "my-svc" and checks that its endpoints respond
+  /code $ copilot svc status -n my-svc --check-uri`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcStatusOpts(vars)
 			if err != nil {
@@ -189,5 +289,6 @@ func buildSvcStatusCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.checkURI, checkURIFlag, false, svcStatusCheckURIFlagDescription)
 	return cmd
 }
@@ -8,17 +8,54 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/probe"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 )
 
 func TestSvcStatus_Validate(t *testing.T) {
-	// NOTE: No optional flag to `copilot svc pause` needs to be validated.
+	testCases := map[string]struct {
+		inVars svcStatusVars
+
+		wantedError error
+	}{
+		"error if --check-uri is used with --json": {
+			inVars: svcStatusVars{
+				checkURI:         true,
+				shouldOutputJSON: true,
+			},
+			wantedError: errors.New("cannot specify both --check-uri and --json"),
+		},
+		"success with --check-uri": {
+			inVars: svcStatusVars{
+				checkURI: true,
+			},
+		},
+		"success without flags": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := svcStatusOpts{
+				svcStatusVars: tc.inVars,
+			}
+			err := opts.Validate()
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
 }
 
 type svcStatusAskMock struct {
@@ -158,15 +195,76 @@ func TestSvcStatus_Execute(t *testing.T) {
 	mockError := errors.New("some error")
 	testCases := map[string]struct {
 		shouldOutputJSON    bool
+		checkURI            bool
 		mockStatusDescriber func(m *mocks.MockstatusDescriber)
+		mockURI             func(d *mocks.MockreachableSvcDescriber, p *mocks.MockendpointProber)
 		wantedError         error
 	}{
 		"errors if failed to describe the status of the service": {
 			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
 				m.EXPECT().Describe().Return(nil, mockError)
 			},
+			mockURI:     func(d *mocks.MockreachableSvcDescriber, p *mocks.MockendpointProber) {},
 			wantedError: fmt.Errorf("describe status of service mockSvc: some error"),
 		},
+		"errors if failed to get the uri of the service": {
+			checkURI: true,
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(&mockDescribeData{data: "Task Summary\n"}, nil)
+			},
+			mockURI: func(d *mocks.MockreachableSvcDescriber, p *mocks.MockendpointProber) {
+				d.EXPECT().URI("mockEnv").Return(describe.URI{}, mockError)
+			},
+			wantedError: fmt.Errorf("get uri for environment mockEnv: some error"),
+		},
+		"errors if an endpoint does not respond successfully": {
+			checkURI: true,
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(&mockDescribeData{data: "Task Summary\n"}, nil)
+			},
+			mockURI: func(d *mocks.MockreachableSvcDescriber, p *mocks.MockendpointProber) {
+				d.EXPECT().URI("mockEnv").Return(describe.URI{
+					URI:        "http://my-lb.us-west-2.elb.amazonaws.com or my-nlb.us-west-2.elb.amazonaws.com:443",
+					AccessType: describe.URIAccessTypeInternet,
+				}, nil)
+				d.EXPECT().Manifest("mockEnv").Return(nil, mockError)
+				p.EXPECT().Probe(gomock.Any(), []string{"http://my-lb.us-west-2.elb.amazonaws.com", "my-nlb.us-west-2.elb.amazonaws.com:443"}, "").Return([]probe.Result{
+					{
+						Endpoint:   "http://my-lb.us-west-2.elb.amazonaws.com",
+						StatusCode: 200,
+					},
+					{
+						Endpoint: "my-nlb.us-west-2.elb.amazonaws.com:443",
+						Err:      errors.New("i/o timeout"),
+					},
+				})
+			},
+			wantedError: fmt.Errorf("1 endpoint of service mockSvc did not respond successfully"),
+		},
+		"probes the health check path of the deployed manifest": {
+			checkURI: true,
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(&mockDescribeData{data: "Task Summary\n"}, nil)
+			},
+			mockURI: func(d *mocks.MockreachableSvcDescriber, p *mocks.MockendpointProber) {
+				d.EXPECT().URI("mockEnv").Return(describe.URI{
+					URI:        "https://mockSvc.example.com",
+					AccessType: describe.URIAccessTypeInternet,
+				}, nil)
+				d.EXPECT().Manifest("mockEnv").Return([]byte(`name: mockSvc
+type: Load Balanced Web Service
+http:
+  path: '/'
+  healthcheck: '/_healthz'
+`), nil)
+				p.EXPECT().Probe(gomock.Any(), []string{"https://mockSvc.example.com"}, "/_healthz").Return([]probe.Result{
+					{
+						Endpoint:   "https://mockSvc.example.com/_healthz",
+						StatusCode: 200,
+					},
+				})
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -176,16 +274,23 @@ func TestSvcStatus_Execute(t *testing.T) {
 			b := &bytes.Buffer{}
 			mockStatusDescriber := mocks.NewMockstatusDescriber(ctrl)
 			tc.mockStatusDescriber(mockStatusDescriber)
+			mockURIDescriber := mocks.NewMockreachableSvcDescriber(ctrl)
+			mockProber := mocks.NewMockendpointProber(ctrl)
+			tc.mockURI(mockURIDescriber, mockProber)
 
 			svcStatus := &svcStatusOpts{
 				svcStatusVars: svcStatusVars{
 					svcName:          "mockSvc",
 					envName:          "mockEnv",
 					shouldOutputJSON: tc.shouldOutputJSON,
+					checkURI:         tc.checkURI,
 					appName:          "mockApp",
 				},
 				statusDescriber:     mockStatusDescriber,
+				uriDescriber:        mockURIDescriber,
+				prober:              mockProber,
 				initStatusDescriber: func(*svcStatusOpts) error { return nil },
+				initURIDescriber:    func(*svcStatusOpts) error { return nil },
 				w:                   b,
 			}
 
@@ -202,3 +307,135 @@ func TestSvcStatus_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckURI(t *testing.T) {
+	testCases := map[string]struct {
+		uri       describe.URI
+		mockProbe func(m *mocks.MockendpointProber)
+
+		wantedFailed int
+		wantedOutput string
+	}{
+		"does not probe endpoints that are not reachable over the internet": {
+			uri: describe.URI{
+				URI:        "api.test.phonetool.local:8080",
+				AccessType: describe.URIAccessTypeServiceDiscovery,
+			},
+			mockProbe: func(m *mocks.MockendpointProber) {
+				m.EXPECT().Probe(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedOutput: `
+URI Check
+
+  The service has no endpoints reachable over the internet.
+`,
+		},
+		"reports how each endpoint responded": {
+			uri: describe.URI{
+				URI:        "https://api.example.com or api-nlb.example.com:443",
+				AccessType: describe.URIAccessTypeInternet,
+			},
+			mockProbe: func(m *mocks.MockendpointProber) {
+				m.EXPECT().Probe(gomock.Any(), []string{"https://api.example.com", "api-nlb.example.com:443"}, "/healthz").Return([]probe.Result{
+					{
+						Endpoint:   "https://api.example.com/healthz",
+						DNSName:    "api.example.com",
+						Latency:    42 * time.Millisecond,
+						StatusCode: 200,
+					},
+					{
+						Endpoint: "api-nlb.example.com:443",
+						DNSName:  "api-nlb.example.com",
+						Err:      errors.New("i/o timeout"),
+					},
+				})
+			},
+			wantedFailed: 1,
+			wantedOutput: `
+URI Check
+
+  Endpoint                         DNS Name             Status                    Latency
+  --------                         --------             ------                    -------
+  https://api.example.com/healthz  api.example.com      200 OK                    42ms
+  api-nlb.example.com:443          api-nlb.example.com  unreachable: i/o timeout  -
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockendpointProber(ctrl)
+			tc.mockProbe(m)
+			b := &bytes.Buffer{}
+
+			// WHEN
+			failed := checkURI(b, m, tc.uri, "/healthz")
+
+			// THEN
+			require.Equal(t, tc.wantedFailed, failed)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
+
+func TestHealthCheckPath(t *testing.T) {
+	testCases := map[string]struct {
+		mft interface{}
+
+		wanted string
+	}{
+		"load balanced web service with a health check path": {
+			mft: &manifest.LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+					RoutingRule: manifest.RoutingRuleConfigOrBool{
+						RoutingRuleConfiguration: manifest.RoutingRuleConfiguration{
+							HealthCheck: manifest.HealthCheckArgsOrString{
+								HealthCheckArgs: manifest.HTTPHealthCheckArgs{
+									Path: aws.String("/healthz"),
+								},
+							},
+						},
+					},
+				},
+			},
+			wanted: "/healthz",
+		},
+		"load balanced web service with the default health check path": {
+			mft: &manifest.LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+					RoutingRule: manifest.RoutingRuleConfigOrBool{
+						RoutingRuleConfiguration: manifest.RoutingRuleConfiguration{
+							HealthCheck: manifest.HealthCheckArgsOrString{
+								HealthCheckPath: aws.String("/"),
+							},
+						},
+					},
+				},
+			},
+		},
+		"request-driven web service with a health check path": {
+			mft: &manifest.RequestDrivenWebService{
+				RequestDrivenWebServiceConfig: manifest.RequestDrivenWebServiceConfig{
+					RequestDrivenWebServiceHttpConfig: manifest.RequestDrivenWebServiceHttpConfig{
+						HealthCheckConfiguration: manifest.HealthCheckArgsOrString{
+							HealthCheckPath: aws.String("/ping"),
+						},
+					},
+				},
+			},
+			wanted: "/ping",
+		},
+		"services without a load balancer": {
+			mft: &manifest.BackendService{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, healthCheckPath(tc.mft))
+		})
+	}
+}
@@ -84,6 +84,9 @@ func newTaskExecOpts(vars taskExecVars) (*taskExecOpts, error) {
 
 // Validate returns an error if the values provided by the user are invalid.
 func (o *taskExecOpts) Validate() error {
+	if err := o.validateSession(); err != nil {
+		return err
+	}
 	if o.useDefault && (o.appName != tryReadingAppName() || o.envName != "") {
 		return fmt.Errorf("cannot specify both default flag and app or env flags")
 	}
@@ -148,6 +151,8 @@ func (o *taskExecOpts) Execute() error {
 		Command:   o.command,
 		Container: container,
 		Task:      taskID,
+		Reconnect: o.reconnect,
+		KeepAlive: o.keepAlive,
 	}); err != nil {
 		return fmt.Errorf("execute command %s in container %s: %w", o.command, container, err)
 	}
@@ -231,6 +236,8 @@ func buildTaskExecCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.command, commandFlag, commandFlagShort, defaultCommand, execCommandFlagDescription)
 	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", taskIDFlagDescription)
 	cmd.Flags().BoolVar(&vars.useDefault, taskDefaultFlag, false, taskExecDefaultFlagDescription)
+	cmd.Flags().IntVar(&vars.reconnect, reconnectFlag, defaultExecReconnect, execReconnectFlagDescription)
+	cmd.Flags().DurationVar(&vars.keepAlive, keepAliveFlag, 0, execKeepAliveFlagDescription)
 	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
 
 	cmd.SetUsageTemplate(template.Usage)
@@ -0,0 +1,27 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/spf13/cobra"
+)
+
+// BuildTemplatesCmd is the top level command for the workload templates of an application.
+func BuildTemplatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "templates",
+		Short: `Commands for workload templates.
+Templates are organization-provided manifests, addons and Dockerfiles to initialize services with.`,
+	}
+
+	cmd.AddCommand(buildTemplatesListCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Extend,
+	}
+	return cmd
+}
@@ -0,0 +1,153 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/catalog"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	templatesListAppNamePrompt = "Which application's templates would you like to list?"
+	templatesListAppNameHelper = "An application is a collection of related services."
+)
+
+type listTemplatesVars struct {
+	appName          string
+	shouldOutputJSON bool
+}
+
+type listTemplatesOpts struct {
+	listTemplatesVars
+
+	store      store
+	sel        configSelector
+	w          io.Writer
+	newCatalog func(location string) (templateLister, error)
+}
+
+func newListTemplatesOpts(vars listTemplatesVars) (*listTemplatesOpts, error) {
+	sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("templates ls")).Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	store := config.NewSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region))
+	return &listTemplatesOpts{
+		listTemplatesVars: vars,
+		store:             store,
+		sel:               selector.NewConfigSelector(prompt.New(), store),
+		w:                 os.Stdout,
+		newCatalog: func(location string) (templateLister, error) {
+			return catalog.New(location, sess)
+		},
+	}, nil
+}
+
+// Ask asks for and validates fields that are required but not passed in.
+func (o *listTemplatesOpts) Ask() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application: %w", err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(templatesListAppNamePrompt, templatesListAppNameHelper)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+// Execute writes the templates available in the catalog of the application.
+func (o *listTemplatesOpts) Execute() error {
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	if app.TemplateCatalog == "" {
+		return fmt.Errorf("application %s does not have a template catalog: run %s to add one", o.appName,
+			color.HighlightCode(fmt.Sprintf("copilot app init %s --%s <location>", o.appName, templateCatalogFlag)))
+	}
+	templates, err := o.newCatalog(app.TemplateCatalog)
+	if err != nil {
+		return err
+	}
+	tpls, err := templates.List()
+	if err != nil {
+		return fmt.Errorf("list templates in catalog %s: %w", app.TemplateCatalog, err)
+	}
+	if o.shouldOutputJSON {
+		return o.jsonOutput(tpls)
+	}
+	o.humanOutput(tpls)
+	return nil
+}
+
+func (o *listTemplatesOpts) jsonOutput(tpls []catalog.Template) error {
+	type serializedTemplates struct {
+		Templates []catalog.Template `json:"templates"`
+	}
+	b, err := json.Marshal(serializedTemplates{Templates: tpls})
+	if err != nil {
+		return fmt.Errorf("marshal templates: %w", err)
+	}
+	fmt.Fprintf(o.w, "%s\n", b)
+	return nil
+}
+
+func (o *listTemplatesOpts) humanOutput(tpls []catalog.Template) {
+	writer := tabwriter.NewWriter(o.w, 0, 4, 2, ' ', 0)
+	headers := []string{"Name", "Version", "Type", "Description"}
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+	var underlines []string
+	for _, header := range headers {
+		underlines = append(underlines, strings.Repeat("-", len(header)))
+	}
+	fmt.Fprintf(writer, "%s\n", strings.Join(underlines, "\t"))
+	for _, tpl := range tpls {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", tpl.Name, tpl.Version, tpl.Type, tpl.Description)
+	}
+	writer.Flush()
+}
+
+// buildTemplatesListCmd builds the command for listing the templates in the catalog of an application.
+func buildTemplatesListCmd() *cobra.Command {
+	vars := listTemplatesVars{}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists all the workload templates in the catalog of an application.",
+		Example: `
+  Lists all the templates available to the "my-app" application.
+  /code $ copilot templates ls -a my-app`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newListTemplatesOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
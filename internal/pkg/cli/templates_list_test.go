@@ -0,0 +1,165 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/catalog"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTemplatesOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockconfigSelector)
+
+		wantedAppName string
+		wantedErr     error
+	}{
+		"returns a wrapped error if the application does not exist": {
+			inAppName: "my-app",
+			setupMocks: func(store *mocks.Mockstore, _ *mocks.MockconfigSelector) {
+				store.EXPECT().GetApplication("my-app").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("validate application: some error"),
+		},
+		"uses the application from the flag": {
+			inAppName: "my-app",
+			setupMocks: func(store *mocks.Mockstore, _ *mocks.MockconfigSelector) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+			wantedAppName: "my-app",
+		},
+		"prompts for the application": {
+			setupMocks: func(_ *mocks.Mockstore, sel *mocks.MockconfigSelector) {
+				sel.EXPECT().Application(templatesListAppNamePrompt, templatesListAppNameHelper).Return("my-app", nil)
+			},
+			wantedAppName: "my-app",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockSel := mocks.NewMockconfigSelector(ctrl)
+			tc.setupMocks(mockStore, mockSel)
+			opts := &listTemplatesOpts{
+				listTemplatesVars: listTemplatesVars{appName: tc.inAppName},
+				store:             mockStore,
+				sel:               mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAppName, opts.appName)
+		})
+	}
+}
+
+func TestListTemplatesOpts_Execute(t *testing.T) {
+	mockTemplates := []catalog.Template{
+		{
+			Name:        "go-api",
+			Version:     "v1.2.0",
+			Type:        "Load Balanced Web Service",
+			Description: "A Go HTTP API.",
+		},
+		{
+			Name:    "worker",
+			Version: "v1",
+			Type:    "Worker Service",
+		},
+	}
+	testCases := map[string]struct {
+		inJSON     bool
+		setupMocks func(store *mocks.Mockstore, lister *mocks.MocktemplateLister)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"returns an error if the application does not have a template catalog": {
+			setupMocks: func(store *mocks.Mockstore, _ *mocks.MocktemplateLister) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+			wantedErr: errors.New("application my-app does not have a template catalog: run `copilot app init my-app --template-catalog <location>` to add one"),
+		},
+		"returns a wrapped error if the templates cannot be listed": {
+			setupMocks: func(store *mocks.Mockstore, lister *mocks.MocktemplateLister) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app", TemplateCatalog: "s3://my-templates"}, nil)
+				lister.EXPECT().List().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list templates in catalog s3://my-templates: some error"),
+		},
+		"writes the templates in a table": {
+			setupMocks: func(store *mocks.Mockstore, lister *mocks.MocktemplateLister) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app", TemplateCatalog: "s3://my-templates"}, nil)
+				lister.EXPECT().List().Return(mockTemplates, nil)
+			},
+			wantedContent: `Name    Version  Type                       Description
+----    -------  ----                       -----------
+go-api  v1.2.0   Load Balanced Web Service  A Go HTTP API.
+worker  v1       Worker Service             
+`,
+		},
+		"writes the templates in JSON": {
+			inJSON: true,
+			setupMocks: func(store *mocks.Mockstore, lister *mocks.MocktemplateLister) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app", TemplateCatalog: "s3://my-templates"}, nil)
+				lister.EXPECT().List().Return(mockTemplates, nil)
+			},
+			wantedContent: `{"templates":[{"name":"go-api","version":"v1.2.0","type":"Load Balanced Web Service","description":"A Go HTTP API."},{"name":"worker","version":"v1","type":"Worker Service"}]}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockLister := mocks.NewMocktemplateLister(ctrl)
+			tc.setupMocks(mockStore, mockLister)
+			b := &bytes.Buffer{}
+			opts := &listTemplatesOpts{
+				listTemplatesVars: listTemplatesVars{
+					appName:          "my-app",
+					shouldOutputJSON: tc.inJSON,
+				},
+				store: mockStore,
+				w:     b,
+				newCatalog: func(location string) (templateLister, error) {
+					return mockLister, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -25,20 +25,21 @@ import (
 )
 
 var (
-	errValueEmpty           = errors.New("value must not be empty")
-	errValueTooLong         = errors.New("value must not exceed 255 characters")
-	errValueBadFormat       = errors.New("value must start with a letter, contain only lower-case letters, numbers, and hyphens, and have no consecutive or trailing hyphen")
-	errValueNotAString      = errors.New("value must be a string")
-	errValueReserved        = errors.New("value is reserved")
-	errValueNotAStringSlice = errors.New("value must be a string slice")
-	errValueNotAValidPath   = errors.New("value must be a valid path")
-	errValueNotAnIPNet      = errors.New("value must be a valid IP address range (example: 10.0.0.0/16)")
-	errValueNotIPNetSlice   = errors.New("value must be a valid slice of IP address range (example: 10.0.0.0/16,10.0.1.0/16)")
-	errPortInvalid          = errors.New("value must be in range 1-65535")
-	errDomainInvalid        = errors.New("value must contain at least one '.' character")
-	errDurationInvalid      = errors.New("value must be a valid Go duration string (example: 1h30m)")
-	errDurationBadUnits     = errors.New("duration cannot be in units smaller than a second")
-	errScheduleInvalid      = errors.New("value must be a valid cron expression (examples: @weekly; @every 30m; 0 0 * * 0)")
+	errValueEmpty              = errors.New("value must not be empty")
+	errValueTooLong            = errors.New("value must not exceed 255 characters")
+	errValueBadFormat          = errors.New("value must start with a letter, contain only lower-case letters, numbers, and hyphens, and have no consecutive or trailing hyphen")
+	errValueNotAString         = errors.New("value must be a string")
+	errValueReserved           = errors.New("value is reserved")
+	errValueNotAStringSlice    = errors.New("value must be a string slice")
+	errValueNotAValidPath      = errors.New("value must be a valid path")
+	errResourcePrefixBadFormat = errors.New("value must start with a letter, contain only letters, numbers, hyphens, and underscores, and not exceed 20 characters")
+	errValueNotAnIPNet         = errors.New("value must be a valid IP address range (example: 10.0.0.0/16)")
+	errValueNotIPNetSlice      = errors.New("value must be a valid slice of IP address range (example: 10.0.0.0/16,10.0.1.0/16)")
+	errPortInvalid             = errors.New("value must be in range 1-65535")
+	errDomainInvalid           = errors.New("value must contain at least one '.' character")
+	errDurationInvalid         = errors.New("value must be a valid Go duration string (example: 1h30m)")
+	errDurationBadUnits        = errors.New("duration cannot be in units smaller than a second")
+	errScheduleInvalid         = errors.New("value must be a valid cron expression (examples: @weekly; @every 30m; 0 0 * * 0)")
 )
 
 // Addons validation errors.
@@ -150,6 +151,9 @@ var (
 	)
 )
 
+// resourcePrefixRegExp matches the characters that are valid in cluster, IAM role and log group names.
+var resourcePrefixRegExp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9\-\_]{0,19}$`)
+
 // SSM secret parameter name validation expression.
 // https://docs.aws.amazon.com/systems-manager/latest/APIReference/API_PutParameter.html#systemsmanager-PutParameter-request-Name
 var secretParameterNameRegExp = regexp.MustCompile("^[a-zA-Z0-9_.-]+$")
@@ -159,6 +163,12 @@ var (
 	regexpMatchSubscription = regexp.MustCompile(`^(\S+):(\S+)`)     // Validates that an expression contains the format serviceName:topicName
 )
 
+// ecrImageURIRegexp matches ECR image URIs with a tag or a digest.
+var ecrImageURIRegexp = regexp.MustCompile(`^\d{12}\.dkr\.ecr\.[a-z0-9-]+\.amazonaws\.com(\.cn)?/[a-z0-9][a-z0-9._/-]*(:\w[\w.-]{0,127}|@sha256:[a-f0-9]{64})$`)
+
+// imageDigestRegexp matches image digests.
+var imageDigestRegexp = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
 const regexpFindAllMatches = -1
 
 // reservedWorkloadNames is a constant map of reserved workload names that users are not allowed to name their workloads
@@ -285,6 +295,14 @@ func validateAppRunnerImage(img interface{}) error {
 	return nil
 }
 
+// validateDeployImage returns an error if img is neither a fully-qualified ECR image URI nor an image digest.
+func validateDeployImage(img string) error {
+	if ecrImageURIRegexp.MatchString(img) || imageDigestRegexp.MatchString(img) {
+		return nil
+	}
+	return fmt.Errorf(`image %s must be a fully-qualified ECR image URI with a tag or a digest, or an image digest starting with "sha256:"`, img)
+}
+
 func validateTimeout(timeout interface{}) error {
 	t, ok := timeout.(string)
 	if !ok {
@@ -304,6 +322,17 @@ func validateRate(rate interface{}) error {
 	return validateDuration(r, 60*time.Second)
 }
 
+func validateResourcePrefix(val interface{}) error {
+	prefix, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !resourcePrefixRegExp.MatchString(prefix) {
+		return errResourcePrefixBadFormat
+	}
+	return nil
+}
+
 func validateDomainName(val interface{}) error {
 	domainName, ok := val.(string)
 	if !ok {
@@ -14,12 +14,16 @@ import (
 
 // Application is a named collection of environments and services.
 type Application struct {
-	Name               string            `json:"name"`               // Name of an Application. Must be unique amongst other apps in the same account.
-	AccountID          string            `json:"account"`            // AccountID this app is mastered in.
-	Domain             string            `json:"domain"`             // Existing domain name in Route53. An empty domain name means the user does not have one.
-	DomainHostedZoneID string            `json:"domainHostedZoneID"` // Existing domain hosted zone in Route53. An empty domain name means the user does not have one.
-	Version            string            `json:"version"`            // The version of the app layout in the underlying datastore (e.g. SSM).
-	Tags               map[string]string `json:"tags,omitempty"`     // Labels to apply to resources created within the app.
+	Name               string            `json:"name"`                      // Name of an Application. Must be unique amongst other apps in the same account.
+	AccountID          string            `json:"account"`                   // AccountID this app is mastered in.
+	Domain             string            `json:"domain"`                    // Existing domain name in Route53. An empty domain name means the user does not have one.
+	DomainHostedZoneID string            `json:"domainHostedZoneID"`        // Existing domain hosted zone in Route53. An empty domain name means the user does not have one.
+	Version            string            `json:"version"`                   // The version of the app layout in the underlying datastore (e.g. SSM).
+	Tags               map[string]string `json:"tags,omitempty"`            // Labels to apply to resources created within the app.
+	ResourcePrefix     string            `json:"resourcePrefix,omitempty"`  // Prefix of the physical names of clusters, IAM roles and log groups created within the app.
+	SharedRepository   bool              `json:"sharedRepo,omitempty"`      // If true, all workloads of the app store their images in a single ECR repository.
+	TemplateCatalog    string            `json:"templateCatalog,omitempty"` // Location of the workload templates available to the app, e.g. "s3://bucket/prefix" or "git::url".
+	PeeredCIDRs        []string          `json:"peeredCIDRs,omitempty"`     // CIDR ranges of networks peered with the app's environments that VPCs must not overlap.
 }
 
 // CreateApplication instantiates a new application, validates its uniqueness and stores it in SSM.
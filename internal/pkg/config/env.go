@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -24,11 +25,28 @@ type Environment struct {
 	ExecutionRoleARN string `json:"executionRoleARN"` // ARN used by CloudFormation to make modification to the environment stack.
 	ManagerRoleARN   string `json:"managerRoleARN"`   // ARN for the manager role assumed to manipulate the environment and its services.
 
+	Preview *Preview `json:"preview,omitempty"` // Set if the environment is an ephemeral preview of a pull request.
+
 	// Fields that store user configuration is no longer updated, but kept for retrofitting purpose.
 	CustomConfig *CustomizeEnv `json:"customConfig,omitempty"` // Deprecated. Custom environment configuration by users. This configuration is now available in the env manifest.
 	Telemetry    *Telemetry    `json:"telemetry,omitempty"`    // Deprecated. Optional environment telemetry features. This configuration is now available in the env manifest.
 }
 
+// Preview holds the configuration of an ephemeral environment created for a pull request.
+type Preview struct {
+	PullRequest int       `json:"pullRequest"` // Number of the pull request the environment previews.
+	BaseEnv     string    `json:"baseEnv"`     // Name of the environment the preview environment was cloned from.
+	ExpiresAt   time.Time `json:"expiresAt"`   // Time after which the environment can be deleted.
+}
+
+// IsExpired returns true if the preview environment expired at the given time.
+func (p *Preview) IsExpired(now time.Time) bool {
+	if p == nil {
+		return false
+	}
+	return !now.Before(p.ExpiresAt)
+}
+
 // CustomizeEnv represents the custom environment config.
 type CustomizeEnv struct {
 	ImportVPC                   *ImportVPC `json:"importVPC,omitempty"`
@@ -36,6 +54,7 @@ type CustomizeEnv struct {
 	ImportCertARNs              []string   `json:"importCertARNs,omitempty"`
 	InternalALBSubnets          []string   `json:"internalALBSubnets,omitempty"`
 	EnableInternalALBVPCIngress bool       `json:"enableInternalALBVPCIngress,omitempty"`
+	ImportCluster               string     `json:"importCluster,omitempty"` // Name of an existing ECS cluster to use instead of creating one.
 }
 
 // IsEmpty returns if CustomizeEnv is an empty struct.
@@ -43,7 +62,7 @@ func (c *CustomizeEnv) IsEmpty() bool {
 	if c == nil {
 		return true
 	}
-	return c.ImportVPC == nil && c.VPCConfig == nil && len(c.ImportCertARNs) == 0 && len(c.InternalALBSubnets) == 0
+	return c.ImportVPC == nil && c.VPCConfig == nil && len(c.ImportCertARNs) == 0 && len(c.InternalALBSubnets) == 0 && c.ImportCluster == ""
 }
 
 // ImportVPC holds the fields to import VPC resources.
@@ -97,6 +116,26 @@ func (s *Store) CreateEnvironment(environment *Environment) error {
 	return nil
 }
 
+// UpdateEnvironment overwrites the configuration of an existing environment.
+func (s *Store) UpdateEnvironment(environment *Environment) error {
+	environmentPath := fmt.Sprintf(fmtEnvParamPath, environment.App, environment.Name)
+	data, err := marshal(environment)
+	if err != nil {
+		return fmt.Errorf("serializing environment %s: %w", environment.Name, err)
+	}
+
+	if _, err = s.ssm.PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(environmentPath),
+		Description: aws.String(fmt.Sprintf("The %s deployment stage", environment.Name)),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(data),
+		Overwrite:   aws.Bool(true),
+	}); err != nil {
+		return fmt.Errorf("update environment %s in application %s: %w", environment.Name, environment.App, err)
+	}
+	return nil
+}
+
 // GetEnvironment gets an environment belonging to a particular application by name. If no environment is found
 // it returns ErrNoSuchEnvironment.
 func (s *Store) GetEnvironment(appName string, environmentName string) (*Environment, error) {
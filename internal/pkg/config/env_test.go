@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -330,6 +331,91 @@ func TestStore_CreateEnvironment(t *testing.T) {
 	}
 }
 
+func TestStore_UpdateEnvironment(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvironment *Environment
+
+		mockPutParameter func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+		wantedErr        error
+	}{
+		"success": {
+			inEnvironment: &Environment{
+				App:  "phonetool",
+				Name: "pr-123",
+				Preview: &Preview{
+					PullRequest: 123,
+					BaseEnv:     "test",
+					ExpiresAt:   time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, fmt.Sprintf(fmtEnvParamPath, "phonetool", "pr-123"), *param.Name)
+				require.True(t, aws.BoolValue(param.Overwrite))
+				require.Equal(t, `{"app":"phonetool","name":"pr-123","region":"","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":"","preview":{"pullRequest":123,"baseEnv":"test","expiresAt":"2022-06-01T00:00:00Z"}}`, *param.Value)
+				return &ssm.PutParameterOutput{
+					Version: aws.Int64(2),
+				}, nil
+			},
+		},
+		"with SSM error": {
+			inEnvironment: &Environment{App: "phonetool", Name: "pr-123"},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, errors.New("broken")
+			},
+			wantedErr: errors.New("update environment pr-123 in application phonetool: broken"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				ssm: &mockSSM{
+					t:                t,
+					mockPutParameter: tc.mockPutParameter,
+				},
+			}
+
+			// WHEN
+			err := store.UpdateEnvironment(tc.inEnvironment)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPreview_IsExpired(t *testing.T) {
+	expiresAt := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		in     *Preview
+		inNow  time.Time
+		wanted bool
+	}{
+		"not a preview environment": {
+			inNow: expiresAt,
+		},
+		"before the expiry": {
+			in:    &Preview{ExpiresAt: expiresAt},
+			inNow: expiresAt.Add(-time.Minute),
+		},
+		"at the expiry": {
+			in:     &Preview{ExpiresAt: expiresAt},
+			inNow:  expiresAt,
+			wanted: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.IsExpired(tc.inNow))
+		})
+	}
+}
+
 func TestStore_DeleteEnvironment(t *testing.T) {
 	testCases := map[string]struct {
 		inApplicationName string
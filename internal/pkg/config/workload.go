@@ -24,6 +24,8 @@ type Workload struct {
 	App  string `json:"app"`  // Name of the app this workload belongs to.
 	Name string `json:"name"` // Name of the workload, which must be unique within an app.
 	Type string `json:"type"` // Type of the workload (ex: Load Balanced Web Service, etc)
+
+	InstanceOf string `json:"instanceOf,omitempty"` // Name of the workload whose manifest this workload is an instance of, if any.
 }
 
 // CreateService instantiates a new service within an existing application. Skip if
@@ -13,6 +13,13 @@ import (
 
 const appDNSDelegationRoleName = "DNSDelegationRole"
 
+// Maximum lengths of the physical names generated by ResourceNames.
+const (
+	maxIAMRoleNameLength  = 64
+	maxClusterNameLength  = 255
+	maxLogGroupNameLength = 512
+)
+
 // CreateAppInput holds the fields required to create an application stack set.
 type CreateAppInput struct {
 	Name                  string            // Name of the application that needs to be created.
@@ -22,6 +29,7 @@ type CreateAppInput struct {
 	DomainHostedZoneID    string            // Hosted Zone ID for the domain.
 	AdditionalTags        map[string]string // AdditionalTags are labels applied to resources under the application.
 	Version               string            // The version of the application template to create the stack/stackset. If empty, creates the legacy stack/stackset.
+	SharedRepository      bool              // If true, all workloads of the application store their images in a single ECR repository.
 }
 
 const (
@@ -38,6 +46,14 @@ type AppInformation struct {
 	AccountPrincipalARN string
 	Domain              string
 	Name                string
+	ResourcePrefix      string // Optional. Prefix of the physical names of resources created within the application.
+}
+
+// ResourceNames returns the naming convention of the physical resources in the application.
+func (a *AppInformation) ResourceNames() ResourceNames {
+	return ResourceNames{
+		Prefix: a.ResourcePrefix,
+	}
 }
 
 // DNSDelegationRole returns the ARN of the app's DNS delegation role.
@@ -57,3 +73,55 @@ func (a *AppInformation) DNSDelegationRole() string {
 func DNSDelegationRoleName(appName string) string {
 	return fmt.Sprintf("%s-%s", appName, appDNSDelegationRoleName)
 }
+
+// ResourceNames generates the physical names of clusters, IAM roles and log groups
+// so that they satisfy the naming convention configured for an application.
+type ResourceNames struct {
+	Prefix string // Prepended to every generated name. If empty, Copilot's default names are used.
+}
+
+// EnvCFNExecutionRole returns the name of the IAM role assumed by CloudFormation to manage an environment.
+func (n ResourceNames) EnvCFNExecutionRole(app, env string) string {
+	return fmt.Sprintf("%s%s-%s-CFNExecutionRole", n.Prefix, app, env)
+}
+
+// EnvManagerRole returns the name of the IAM role used to manage an environment.
+func (n ResourceNames) EnvManagerRole(app, env string) string {
+	return fmt.Sprintf("%s%s-%s-EnvManagerRole", n.Prefix, app, env)
+}
+
+// Cluster returns the name of the ECS cluster of an environment.
+func (n ResourceNames) Cluster(app, env string) string {
+	return fmt.Sprintf("%s%s-%s", n.Prefix, app, env)
+}
+
+// WorkloadLogGroup returns the name of the CloudWatch log group of a service or job.
+func (n ResourceNames) WorkloadLogGroup(app, env, wkld string) string {
+	return fmt.Sprintf("/copilot/%s%s-%s-%s", n.Prefix, app, env, wkld)
+}
+
+// ValidateEnv returns an error if the names generated for the environment resources exceed their AWS limits.
+func (n ResourceNames) ValidateEnv(app, env string) error {
+	for _, role := range []string{n.EnvCFNExecutionRole(app, env), n.EnvManagerRole(app, env)} {
+		if len(role) > maxIAMRoleNameLength {
+			return fmt.Errorf("IAM role name %s exceeds the maximum length of %d characters", role, maxIAMRoleNameLength)
+		}
+	}
+	if cluster := n.Cluster(app, env); len(cluster) > maxClusterNameLength {
+		return fmt.Errorf("cluster name %s exceeds the maximum length of %d characters", cluster, maxClusterNameLength)
+	}
+	return nil
+}
+
+// ValidateWorkload returns an error if the names generated for the workload resources exceed their AWS limits.
+func (n ResourceNames) ValidateWorkload(app, env, wkld string) error {
+	if logGroup := n.WorkloadLogGroup(app, env, wkld); len(logGroup) > maxLogGroupNameLength {
+		return fmt.Errorf("log group name %s exceeds the maximum length of %d characters", logGroup, maxLogGroupNameLength)
+	}
+	return nil
+}
+
+// Collides returns true if the resources of two applications would end up with the same physical names.
+func (n ResourceNames) Collides(app string, other ResourceNames, otherApp string) bool {
+	return n.Prefix+app == other.Prefix+otherApp
+}
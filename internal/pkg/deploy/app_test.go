@@ -51,3 +51,49 @@ func TestAppInformation_DNSDelegationRole(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceNames(t *testing.T) {
+	names := ResourceNames{Prefix: "corp-"}
+
+	require.Equal(t, "corp-phonetool-test-CFNExecutionRole", names.EnvCFNExecutionRole("phonetool", "test"))
+	require.Equal(t, "corp-phonetool-test-EnvManagerRole", names.EnvManagerRole("phonetool", "test"))
+	require.Equal(t, "corp-phonetool-test", names.Cluster("phonetool", "test"))
+	require.Equal(t, "/copilot/corp-phonetool-test-frontend", names.WorkloadLogGroup("phonetool", "test", "frontend"))
+	require.Equal(t, "phonetool-test-EnvManagerRole", ResourceNames{}.EnvManagerRole("phonetool", "test"))
+}
+
+func TestResourceNames_ValidateEnv(t *testing.T) {
+	testCases := map[string]struct {
+		prefix  string
+		app     string
+		env     string
+		wantErr string
+	}{
+		"valid names": {
+			prefix: "corp-",
+			app:    "phonetool",
+			env:    "test",
+		},
+		"role name is too long": {
+			prefix:  "corp-",
+			app:     "a-very-long-application-name-that-goes-on",
+			env:     "test",
+			wantErr: "IAM role name corp-a-very-long-application-name-that-goes-on-test-CFNExecutionRole exceeds the maximum length of 64 characters",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ResourceNames{Prefix: tc.prefix}.ValidateEnv(tc.app, tc.env)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestResourceNames_Collides(t *testing.T) {
+	require.True(t, ResourceNames{Prefix: "corp-"}.Collides("phonetool", ResourceNames{}, "corp-phonetool"))
+	require.False(t, ResourceNames{Prefix: "corp-"}.Collides("phonetool", ResourceNames{}, "phonetool"))
+}
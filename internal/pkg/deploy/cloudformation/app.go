@@ -38,7 +38,8 @@ func (cf CloudFormation) DeployApp(in *deploy.CreateAppInput) error {
 	}
 
 	blankAppTemplate, err := appConfig.ResourceTemplate(&stack.AppResourcesConfig{
-		App: appConfig.Name,
+		App:              appConfig.Name,
+		SharedRepository: in.SharedRepository,
 	})
 	if err != nil {
 		return err
@@ -214,6 +215,7 @@ func (cf CloudFormation) getResourcesForStackInstances(app *config.Application,
 			return nil, err
 		}
 		regionalResource.Region = summary.Region
+		regionalResource.StackID = summary.StackID
 		regionalResources = append(regionalResources, regionalResource)
 	}
 
@@ -271,10 +273,11 @@ func (cf CloudFormation) addWorkloadToApp(app *config.Application, wlName string
 	wlList = append(wlList, wlName)
 
 	newDeploymentConfig := stack.AppResourcesConfig{
-		Version:  previouslyDeployedConfig.Version + 1,
-		Services: wlList,
-		Accounts: previouslyDeployedConfig.Accounts,
-		App:      appConfig.Name,
+		Version:          previouslyDeployedConfig.Version + 1,
+		Services:         wlList,
+		Accounts:         previouslyDeployedConfig.Accounts,
+		App:              appConfig.Name,
+		SharedRepository: previouslyDeployedConfig.SharedRepository,
 	}
 	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig); err != nil {
 		return err
@@ -329,10 +332,11 @@ func (cf CloudFormation) removeWorkloadFromApp(app *config.Application, wlName s
 	}
 
 	newDeploymentConfig := stack.AppResourcesConfig{
-		Version:  previouslyDeployedConfig.Version + 1,
-		Services: wlList,
-		Accounts: previouslyDeployedConfig.Accounts,
-		App:      appConfig.Name,
+		Version:          previouslyDeployedConfig.Version + 1,
+		Services:         wlList,
+		Accounts:         previouslyDeployedConfig.Accounts,
+		App:              appConfig.Name,
+		SharedRepository: previouslyDeployedConfig.SharedRepository,
 	}
 	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig); err != nil {
 		return err
@@ -341,6 +345,46 @@ func (cf CloudFormation) removeWorkloadFromApp(app *config.Application, wlName s
 	return nil
 }
 
+// AddSharedRepositoryToApp adds an ECR repository shared by all the workloads of the application to the application resource stack.
+// The per-workload ECR repositories are kept with a "Retain" deletion policy so that their images can be copied to the shared repository.
+func (cf CloudFormation) AddSharedRepositoryToApp(app *config.Application) error {
+	if err := cf.deploySharedRepository(app, true); err != nil {
+		return fmt.Errorf("adding shared repository to application %s: %w", app.Name, err)
+	}
+	return nil
+}
+
+// RemoveWorkloadRepositoriesFromApp removes the per-workload ECR repositories from the application resource stack
+// once the application uses a shared repository. The repositories are retained in the account along with their images.
+func (cf CloudFormation) RemoveWorkloadRepositoriesFromApp(app *config.Application) error {
+	if err := cf.deploySharedRepository(app, false); err != nil {
+		return fmt.Errorf("removing workload repositories from application %s: %w", app.Name, err)
+	}
+	return nil
+}
+
+func (cf CloudFormation) deploySharedRepository(app *config.Application, retainWlRepos bool) error {
+	appConfig := stack.NewAppStackConfig(&deploy.CreateAppInput{
+		Name:           app.Name,
+		AccountID:      app.AccountID,
+		AdditionalTags: app.Tags,
+		Version:        deploy.LatestAppTemplateVersion,
+	})
+	previouslyDeployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
+	if err != nil {
+		return fmt.Errorf("get previous application %s config: %w", app.Name, err)
+	}
+	newDeploymentConfig := stack.AppResourcesConfig{
+		Version:                   previouslyDeployedConfig.Version + 1,
+		Services:                  previouslyDeployedConfig.Services,
+		Accounts:                  previouslyDeployedConfig.Accounts,
+		App:                       appConfig.Name,
+		SharedRepository:          true,
+		RetainServiceRepositories: retainWlRepos,
+	}
+	return cf.deployAppConfig(appConfig, &newDeploymentConfig)
+}
+
 // AddEnvToAppOpts contains the parameters to call AddEnvToApp.
 type AddEnvToAppOpts struct {
 	App          *config.Application
@@ -381,10 +425,11 @@ func (cf CloudFormation) AddEnvToApp(opts *AddEnvToAppOpts) error {
 	}
 
 	newDeploymentConfig := stack.AppResourcesConfig{
-		Version:  previouslyDeployedConfig.Version + 1,
-		Services: previouslyDeployedConfig.Services,
-		Accounts: accountList,
-		App:      appConfig.Name,
+		Version:          previouslyDeployedConfig.Version + 1,
+		Services:         previouslyDeployedConfig.Services,
+		Accounts:         accountList,
+		App:              appConfig.Name,
+		SharedRepository: previouslyDeployedConfig.SharedRepository,
 	}
 
 	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig); err != nil {
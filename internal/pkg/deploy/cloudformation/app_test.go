@@ -558,6 +558,32 @@ func TestCloudFormation_RemoveServiceFromApp(t *testing.T) {
 				return m
 			},
 		},
+		"should keep the shared repository": {
+			service: "test",
+
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Services:         []string{"test", "firsttest"},
+					Version:          1,
+					SharedRepository: true,
+				}})
+				require.NoError(t, err)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: string(body),
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Do(func(_, template string, opts ...stackset.CreateOrUpdateOption) {
+						configToDeploy, err := stack.AppConfigFrom(&template)
+						require.NoError(t, err)
+						require.ElementsMatch(t, []string{"firsttest"}, configToDeploy.Services)
+						require.True(t, configToDeploy.SharedRepository)
+						require.NotContains(t, template, "ECRRepofirsttest:")
+					})
+				return m
+			},
+		},
 	}
 
 	for name, tc := range tests {
@@ -576,6 +602,78 @@ func TestCloudFormation_RemoveServiceFromApp(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_SharedRepository(t *testing.T) {
+	mockApp := &config.Application{
+		Name:      "testapp",
+		AccountID: "1234",
+	}
+	mockStackSet := func(t *testing.T, ctrl *gomock.Controller, wantRetain bool) stackSetClient {
+		m := mocks.NewMockstackSetClient(ctrl)
+		body, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+			Services: []string{"frontend"},
+			Accounts: []string{"1234"},
+			Version:  1,
+		}})
+		require.NoError(t, err)
+		m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+			Template: string(body),
+		}, nil)
+		m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil).
+			Do(func(_, template string, opts ...stackset.CreateOrUpdateOption) {
+				configToDeploy, err := stack.AppConfigFrom(&template)
+				require.NoError(t, err)
+				require.Equal(t, []string{"frontend"}, configToDeploy.Services)
+				require.Equal(t, []string{"1234"}, configToDeploy.Accounts)
+				require.Equal(t, 2, configToDeploy.Version)
+				require.True(t, configToDeploy.SharedRepository)
+				require.Contains(t, template, "SharedECRRepo:")
+				if wantRetain {
+					require.Contains(t, template, "ECRRepofrontend:")
+					require.Contains(t, template, "DeletionPolicy: Retain")
+				} else {
+					require.NotContains(t, template, "ECRRepofrontend:")
+				}
+			})
+		return m
+	}
+
+	t.Run("AddSharedRepositoryToApp retains the workload repositories", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cf := CloudFormation{
+			appStackSet: mockStackSet(t, ctrl, true),
+			region:      "us-west-2",
+		}
+
+		require.NoError(t, cf.AddSharedRepositoryToApp(mockApp))
+	})
+	t.Run("RemoveWorkloadRepositoriesFromApp removes the workload repositories", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cf := CloudFormation{
+			appStackSet: mockStackSet(t, ctrl, false),
+			region:      "us-west-2",
+		}
+
+		require.NoError(t, cf.RemoveWorkloadRepositoriesFromApp(mockApp))
+	})
+	t.Run("wraps errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockstackSetClient(ctrl)
+		m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{}, errors.New("some error"))
+		cf := CloudFormation{
+			appStackSet: m,
+			region:      "us-west-2",
+		}
+
+		err := cf.AddSharedRepositoryToApp(mockApp)
+
+		require.EqualError(t, err, "adding shared repository to application testapp: get previous application testapp config: some error")
+	})
+}
+
 func TestCloudFormation_GetRegionalAppResources(t *testing.T) {
 	mockApp := config.Application{Name: "app", AccountID: "12345"}
 
@@ -590,6 +688,7 @@ func TestCloudFormation_GetRegionalAppResources(t *testing.T) {
 				KMSKeyARN:      "arn:aws:kms:us-west-2:01234567890:key/0000",
 				S3Bucket:       "tests3-bucket-us-west-2",
 				Region:         "us-east-9",
+				StackID:        "cross-region-stack",
 				RepositoryURLs: map[string]string{},
 			},
 			createRegionalMockClient: func(ctrl *gomock.Controller) cfnClient {
@@ -689,6 +788,7 @@ func TestCloudFormation_GetAppResourcesByRegion(t *testing.T) {
 				KMSKeyARN:      "arn:aws:kms:us-west-2:01234567890:key/0000",
 				S3Bucket:       "tests3-bucket-us-west-2",
 				Region:         "us-east-9",
+				StackID:        "cross-region-stack",
 				RepositoryURLs: map[string]string{},
 			},
 			region: "us-east-9",
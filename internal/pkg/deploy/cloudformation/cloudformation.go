@@ -6,6 +6,7 @@ package cloudformation
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -60,6 +61,7 @@ type cfnClient interface {
 	WaitForCreate(ctx context.Context, stackName string) error
 	Update(*cloudformation.Stack) (string, error)
 	UpdateAndWait(*cloudformation.Stack) error
+	CreateChangeSet(*cloudformation.Stack) (string, error)
 	WaitForUpdate(ctx context.Context, stackName string) error
 	Delete(stackName string) error
 	DeleteAndWait(stackName string) error
@@ -73,6 +75,7 @@ type cfnClient interface {
 	ErrorEvents(stackName string) ([]cloudformation.StackEvent, error)
 	Outputs(stack *cloudformation.Stack) (map[string]string, error)
 	StackResources(name string) ([]*cloudformation.StackResource, error)
+	DetectStackDrift(ctx context.Context, name string) ([]*cloudformation.StackResourceDrift, error)
 
 	// Methods vended by the aws sdk struct.
 	DescribeStackEvents(*sdkcloudformation.DescribeStackEventsInput) (*sdkcloudformation.DescribeStackEventsOutput, error)
@@ -100,6 +103,16 @@ type stackSetClient interface {
 	WaitForStackSetLastOperationComplete(name string) error
 }
 
+type envStackSetClient interface {
+	Create(name, template string, opts ...stackset.CreateOrUpdateOption) error
+	Describe(name string) (stackset.Description, error)
+	ImportStacksAndWait(name string, stackIDs []string) error
+	UpdateAndWait(name, template string, opts ...stackset.CreateOrUpdateOption) error
+	InstanceSummaries(name string, opts ...stackset.InstanceSummariesOption) ([]stackset.InstanceSummary, error)
+	DeleteInstancesAndWait(name string, accounts, regions []string, retainStacks bool) error
+	Delete(name string) error
+}
+
 // CloudFormation wraps the CloudFormationAPI interface
 type CloudFormation struct {
 	cfnClient      cfnClient
@@ -150,6 +163,7 @@ type renderStackChangesInput struct {
 	stackName        string
 	stackDescription string
 	createChangeSet  func() (string, error)
+	timeout          time.Duration // How long to wait for the stack to complete, defaults to waitForStackTimeout.
 }
 
 func (cf CloudFormation) newRenderWorkloadInput(w progress.FileWriter, stack *cloudformation.Stack) *renderStackChangesInput {
@@ -194,12 +208,33 @@ func (cf CloudFormation) newRenderWorkloadInput(w progress.FileWriter, stack *cl
 	return in
 }
 
+// renderStackUpdate renders the change set being executed on the stack until the update completes.
+// If the stack is not being updated, it returns an error only if the last update failed.
+func (cf CloudFormation) renderStackUpdate(out progress.FileWriter, stackName, description string) error {
+	descr, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		return fmt.Errorf("describe stack %s: %w", stackName, err)
+	}
+	if !cloudformation.StackStatus(aws.StringValue(descr.StackStatus)).InProgress() || descr.ChangeSetId == nil {
+		return cf.errOnFailedStack(stackName)
+	}
+	return cf.renderStackChanges(&renderStackChangesInput{
+		w:                out,
+		stackName:        stackName,
+		stackDescription: description,
+		createChangeSet: func() (string, error) {
+			return aws.StringValue(descr.ChangeSetId), nil
+		},
+	})
+}
+
 func (cf CloudFormation) renderStackChanges(in *renderStackChangesInput) error {
 	changeSetID, err := in.createChangeSet()
 	if err != nil {
 		return err
 	}
-	waitCtx, cancelWait := context.WithTimeout(context.Background(), waitForStackTimeout)
+	timeout := stackTimeout(in.timeout)
+	waitCtx, cancelWait := context.WithTimeout(context.Background(), timeout)
 	defer cancelWait()
 	g, ctx := errgroup.WithContext(waitCtx)
 
@@ -211,7 +246,7 @@ func (cf CloudFormation) renderStackChanges(in *renderStackChangesInput) error {
 		return progress.Render(ctx, progress.NewTabbedFileWriter(in.w), renderer)
 	})
 	if err := g.Wait(); err != nil {
-		return err
+		return errOnStackTimeout(in.stackName, timeout, err)
 	}
 	if err := cf.errOnFailedStack(in.stackName); err != nil {
 		return err
@@ -254,6 +289,80 @@ func (cf CloudFormation) createChangeSetRenderer(group *errgroup.Group, ctx cont
 	return renderer, nil
 }
 
+// StackProgressEvent is a machine-readable CloudFormation stack event.
+type StackProgressEvent struct {
+	LogicalResourceID string    `json:"logicalId"`
+	ResourceType      string    `json:"resourceType"`
+	Status            string    `json:"status"`
+	Timestamp         time.Time `json:"timestamp"`
+	Reason            string    `json:"reason,omitempty"`
+}
+
+// streamStackChanges creates a change set and writes the stack events to w as newline-delimited JSON until the stack update completes.
+// If timeout is zero, it waits for at most waitForStackTimeout.
+func (cf CloudFormation) streamStackChanges(w io.Writer, stackName string, timeout time.Duration, createChangeSet func() (string, error)) error {
+	changeSetID, err := createChangeSet()
+	if err != nil {
+		return err
+	}
+	changeSet, err := cf.cfnClient.DescribeChangeSet(changeSetID, stackName)
+	if err != nil {
+		return err
+	}
+	timeout = stackTimeout(timeout)
+	waitCtx, cancelWait := context.WithTimeout(context.Background(), timeout)
+	defer cancelWait()
+	g, ctx := errgroup.WithContext(waitCtx)
+
+	streamer := stream.NewStackStreamer(cf.cfnClient, stackName, changeSet.CreationTime)
+	events := streamer.Subscribe()
+	g.Go(func() error {
+		return stream.Stream(ctx, streamer)
+	})
+	g.Go(func() error {
+		enc := json.NewEncoder(w)
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case ev, ok := <-events:
+				if !ok {
+					return nil
+				}
+				if err := enc.Encode(StackProgressEvent{
+					LogicalResourceID: ev.LogicalResourceID,
+					ResourceType:      ev.ResourceType,
+					Status:            ev.ResourceStatus,
+					Timestamp:         ev.Timestamp,
+					Reason:            ev.ResourceStatusReason,
+				}); err != nil {
+					return fmt.Errorf("write stack event for resource %s: %w", ev.LogicalResourceID, err)
+				}
+			}
+		}
+	})
+	if err := g.Wait(); err != nil {
+		return errOnStackTimeout(stackName, timeout, err)
+	}
+	return cf.errOnFailedStack(stackName)
+}
+
+// stackTimeout returns how long to wait for a stack to complete, defaulting to waitForStackTimeout if timeout is zero.
+func stackTimeout(timeout time.Duration) time.Duration {
+	if timeout == 0 {
+		return waitForStackTimeout
+	}
+	return timeout
+}
+
+// errOnStackTimeout returns a descriptive error if err is due to the stack not completing within the timeout.
+func errOnStackTimeout(stackName string, timeout time.Duration, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("stack %s did not complete within %s: %w", stackName, timeout, err)
+	}
+	return err
+}
+
 type changeRenderersInput struct {
 	g                  *errgroup.Group             // Group that all goroutines belong.
 	ctx                context.Context             // Context associated with the group.
@@ -297,6 +406,13 @@ func (cf CloudFormation) changeRenderers(in changeRenderersInput) ([]progress.Re
 				Ctx:        in.ctx,
 				RenderOpts: in.opts,
 			})
+		case logicalID == workloadAddonsLogicalID:
+			// Stream the events of every addon resource, since addons templates don't describe their resources.
+			renderer = progress.ListeningNestedStackRenderer(in.stackStreamer, cf.cfnClient, logicalID, description, progress.NestedStackRendererOpts{
+				Group:      in.g,
+				Ctx:        in.ctx,
+				RenderOpts: in.opts,
+			})
 		case change.ResourceChange.ChangeSetId != nil:
 			// The resource change is a nested stack.
 			changeSetID := aws.StringValue(change.ResourceChange.ChangeSetId)
@@ -379,6 +495,53 @@ func (cf CloudFormation) errOnFailedStack(stackName string) error {
 	return nil
 }
 
+// ErrStackRollbackComplete occurs when a stack failed to be created the first time and was rolled back.
+// The stack must be deleted before it can be deployed again.
+type ErrStackRollbackComplete struct {
+	StackName string
+}
+
+func (e *ErrStackRollbackComplete) Error() string {
+	return fmt.Sprintf("stack %s failed to be created and is in %s state", e.StackName, sdkcloudformation.StackStatusRollbackComplete)
+}
+
+// DeleteRolledBackStack deletes the stack if it failed to be created and is in ROLLBACK_COMPLETE state.
+// It is a no-op if the stack doesn't exist or is in any other state.
+func (cf CloudFormation) DeleteRolledBackStack(stackName string) error {
+	if err := cf.errOnRollbackComplete(stackName); err != nil {
+		var errRollbackComplete *ErrStackRollbackComplete
+		if !errors.As(err, &errRollbackComplete) {
+			return err
+		}
+		if err := cf.cfnClient.DeleteAndWait(stackName); err != nil {
+			return fmt.Errorf("delete stack %s: %w", stackName, err)
+		}
+	}
+	return nil
+}
+
+// errOnRollbackComplete returns an ErrStackRollbackComplete if the stack exists and is in ROLLBACK_COMPLETE state.
+func (cf CloudFormation) errOnRollbackComplete(stackName string) error {
+	descr, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		var errNotFound *cloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return nil
+		}
+		return fmt.Errorf("describe stack %s: %w", stackName, err)
+	}
+	return errIfRollbackComplete(stackName, descr)
+}
+
+func errIfRollbackComplete(stackName string, descr *cloudformation.StackDescription) error {
+	if aws.StringValue(descr.StackStatus) != sdkcloudformation.StackStatusRollbackComplete {
+		return nil
+	}
+	return &ErrStackRollbackComplete{
+		StackName: stackName,
+	}
+}
+
 func toStack(config StackConfiguration) (*cloudformation.Stack, error) {
 	template, err := config.Template()
 	if err != nil {
@@ -35,7 +35,9 @@ func testDeployWorkload_OnPushToS3Failure(t *testing.T, when func(w progress.Fil
 	wantedErr := errors.New("some error")
 	mS3Client := mocks.NewMocks3Client(ctrl)
 	mS3Client.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("", wantedErr)
-	client := CloudFormation{s3Client: mS3Client}
+	m := mocks.NewMockcfnClient(ctrl)
+	m.EXPECT().Describe(gomock.Any()).Return(nil, &cloudformation.ErrStackNotFound{})
+	client := CloudFormation{cfnClient: m, s3Client: mS3Client}
 	buf := new(strings.Builder)
 
 	// WHEN
@@ -53,6 +55,7 @@ func testDeployWorkload_OnCreateChangeSetFailure(t *testing.T, when func(w progr
 	mS3Client := mocks.NewMocks3Client(ctrl)
 	mS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil)
 	m := mocks.NewMockcfnClient(ctrl)
+	m.EXPECT().Describe(gomock.Any()).Return(nil, &cloudformation.ErrStackNotFound{})
 	m.EXPECT().Create(gomock.Any()).Return("", wantedErr)
 	m.EXPECT().ErrorEvents(gomock.Any()).Return(nil, nil)
 	client := CloudFormation{cfnClient: m, s3Client: mS3Client}
@@ -73,6 +76,7 @@ func testDeployWorkload_OnUpdateChangeSetFailure(t *testing.T, when func(w progr
 	mS3Client := mocks.NewMocks3Client(ctrl)
 	mS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil)
 	m := mocks.NewMockcfnClient(ctrl)
+	m.EXPECT().Describe(gomock.Any()).Return(nil, &cloudformation.ErrStackNotFound{})
 	m.EXPECT().Create(gomock.Any()).Return("", &cloudformation.ErrStackAlreadyExists{})
 	m.EXPECT().Update(gomock.Any()).Return("", wantedErr)
 	m.EXPECT().ErrorEvents(gomock.Any()).Return(nil, nil)
@@ -93,6 +97,7 @@ func testDeployWorkload_OnDescribeChangeSetFailure(t *testing.T, when func(w pro
 	mS3Client := mocks.NewMocks3Client(ctrl)
 	mS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil)
 	m := mocks.NewMockcfnClient(ctrl)
+	m.EXPECT().Describe(gomock.Any()).Return(nil, &cloudformation.ErrStackNotFound{})
 	m.EXPECT().Create(gomock.Any()).Return("1234", nil)
 	m.EXPECT().DescribeChangeSet(gomock.Any(), gomock.Any()).Return(nil, errors.New("DescribeChangeSet error"))
 	client := CloudFormation{cfnClient: m, s3Client: mS3Client}
@@ -112,6 +117,7 @@ func testDeployWorkload_OnTemplateBodyFailure(t *testing.T, when func(w progress
 	mS3Client := mocks.NewMocks3Client(ctrl)
 	mS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil)
 	m := mocks.NewMockcfnClient(ctrl)
+	m.EXPECT().Describe(gomock.Any()).Return(nil, &cloudformation.ErrStackNotFound{})
 	m.EXPECT().Create(gomock.Any()).Return("1234", nil)
 	m.EXPECT().DescribeChangeSet(gomock.Any(), gomock.Any()).Return(&cloudformation.ChangeSetDescription{}, nil)
 	m.EXPECT().TemplateBodyFromChangeSet(gomock.Any(), gomock.Any()).Return("", errors.New("TemplateBody error"))
@@ -133,6 +139,7 @@ func testDeployWorkload_StackStreamerFailureShouldCancelRenderer(t *testing.T, w
 	mS3Client := mocks.NewMocks3Client(ctrl)
 	mS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil)
 	m := mocks.NewMockcfnClient(ctrl)
+	m.EXPECT().Describe(gomock.Any()).Return(nil, &cloudformation.ErrStackNotFound{})
 	m.EXPECT().Create(gomock.Any()).Return("1234", nil)
 	m.EXPECT().DescribeChangeSet(gomock.Any(), gomock.Any()).Return(&cloudformation.ChangeSetDescription{}, nil)
 	m.EXPECT().TemplateBodyFromChangeSet(gomock.Any(), gomock.Any()).Return("", nil)
@@ -154,6 +161,7 @@ func testDeployWorkload_StreamUntilStackCreationFails(t *testing.T, stackName st
 	mS3Client := mocks.NewMocks3Client(ctrl)
 	mS3Client.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil)
 	m := mocks.NewMockcfnClient(ctrl)
+	m.EXPECT().Describe(gomock.Any()).Return(nil, &cloudformation.ErrStackNotFound{})
 	m.EXPECT().Create(gomock.Any()).Return("1234", nil)
 	m.EXPECT().DescribeChangeSet(gomock.Any(), gomock.Any()).Return(&cloudformation.ChangeSetDescription{}, nil)
 	m.EXPECT().TemplateBodyFromChangeSet(gomock.Any(), gomock.Any()).Return("", nil)
@@ -188,6 +196,7 @@ func testDeployWorkload_RenderNewlyCreatedStackWithECSService(t *testing.T, stac
 	mS3Client := mocks.NewMocks3Client(ctrl)
 	mS3Client.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("mockURL", nil)
 	mockCFN := mocks.NewMockcfnClient(ctrl)
+	mockCFN.EXPECT().Describe(gomock.Any()).Return(nil, &cloudformation.ErrStackNotFound{})
 	mockECS := mocks.NewMockecsClient(ctrl)
 	deploymentTime := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
 
@@ -271,6 +280,7 @@ func testDeployWorkload_WithEnvControllerRenderer_NoStackUpdates(t *testing.T, s
 	mS3Client := mocks.NewMocks3Client(ctrl)
 	mS3Client.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("mockURL", nil)
 	mockCFN := mocks.NewMockcfnClient(ctrl)
+	mockCFN.EXPECT().Describe(gomock.Any()).Return(nil, &cloudformation.ErrStackNotFound{})
 	deploymentTime := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
 
 	mockCFN.EXPECT().Create(gomock.Any()).Return("1234", nil)
@@ -346,6 +356,7 @@ func testDeployWorkload_RenderNewlyCreatedStackWithAddons(t *testing.T, stackNam
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	m := mocks.NewMockcfnClient(ctrl)
+	m.EXPECT().Describe(gomock.Any()).Return(nil, &cloudformation.ErrStackNotFound{})
 	mS3Client := mocks.NewMocks3Client(ctrl)
 	mS3Client.EXPECT().Upload("mockBucket", "manual/templates/myapp-myenv-mysvc/5cde0f1298f41f7d1c8b907a36992a7a513225a2615bd6e307bf1a9149b06b40.yml", gomock.Any()).Return("mockURL", nil)
 
@@ -395,7 +406,14 @@ Resources:
 			{
 				EventId:            aws.String("2"),
 				LogicalResourceId:  aws.String("AddonsStack"),
-				PhysicalResourceId: aws.String("AWS::CloudFormation::Stack"),
+				PhysicalResourceId: aws.String("arn:aws:cloudformation:us-west-2:12345:stack/my-nested-stack/d0a825a0-e4cd-xmpl-b9fb-061c69e99205"),
+				ResourceStatus:     aws.String("CREATE_IN_PROGRESS"),
+				Timestamp:          aws.Time(time.Now()),
+			},
+			{
+				EventId:            aws.String("4"),
+				LogicalResourceId:  aws.String("AddonsStack"),
+				PhysicalResourceId: aws.String("arn:aws:cloudformation:us-west-2:12345:stack/my-nested-stack/d0a825a0-e4cd-xmpl-b9fb-061c69e99205"),
 				ResourceStatus:     aws.String("CREATE_COMPLETE"),
 				Timestamp:          aws.Time(time.Now()),
 			},
@@ -414,24 +432,6 @@ Resources:
 	}, nil)
 
 	// Mocks for the addons stack.
-	m.EXPECT().DescribeChangeSet("5678", "my-nested-stack").Return(&cloudformation.ChangeSetDescription{
-		Changes: []*sdkcloudformation.Change{
-			{
-				ResourceChange: &sdkcloudformation.ResourceChange{
-					LogicalResourceId:  aws.String("MyTable"),
-					PhysicalResourceId: aws.String("AWS::DynamoDB::Table"),
-				},
-			},
-		},
-	}, nil)
-
-	m.EXPECT().TemplateBodyFromChangeSet("5678", "my-nested-stack").Return(`
-Resources:
-  MyTable:
-    Metadata:
-      'aws:copilot:description': 'A DynamoDB table to store data'
-    Type: AWS::DynamoDB::Table`, nil)
-
 	m.EXPECT().DescribeStackEvents(&sdkcloudformation.DescribeStackEventsInput{
 		StackName: aws.String("my-nested-stack"),
 	}).Return(&sdkcloudformation.DescribeStackEventsOutput{
@@ -462,7 +462,7 @@ Resources:
 	require.NoError(t, err)
 	require.Contains(t, buf.String(), "An ECS cluster")
 	require.Contains(t, buf.String(), "An Addons CloudFormation Stack for your additional AWS resources")
-	require.Contains(t, buf.String(), "A DynamoDB table to store data")
+	require.Contains(t, buf.String(), "MyTable", "addon resources are rendered by their logical ID")
 }
 
 func testDeployTask_OnCreateChangeSetFailure(t *testing.T, when func(w progress.FileWriter, cf CloudFormation) error) {
@@ -659,7 +659,14 @@ Resources:
 			{
 				EventId:            aws.String("2"),
 				LogicalResourceId:  aws.String("AddonsStack"),
-				PhysicalResourceId: aws.String("AWS::CloudFormation::Stack"),
+				PhysicalResourceId: aws.String("arn:aws:cloudformation:us-west-2:12345:stack/my-nested-stack/d0a825a0-e4cd-xmpl-b9fb-061c69e99205"),
+				ResourceStatus:     aws.String("CREATE_IN_PROGRESS"),
+				Timestamp:          aws.Time(time.Now()),
+			},
+			{
+				EventId:            aws.String("4"),
+				LogicalResourceId:  aws.String("AddonsStack"),
+				PhysicalResourceId: aws.String("arn:aws:cloudformation:us-west-2:12345:stack/my-nested-stack/d0a825a0-e4cd-xmpl-b9fb-061c69e99205"),
 				ResourceStatus:     aws.String("CREATE_COMPLETE"),
 				Timestamp:          aws.Time(time.Now()),
 			},
@@ -678,24 +685,6 @@ Resources:
 	}, nil)
 
 	// Mocks for the addons stack.
-	m.EXPECT().DescribeChangeSet("5678", "my-nested-stack").Return(&cloudformation.ChangeSetDescription{
-		Changes: []*sdkcloudformation.Change{
-			{
-				ResourceChange: &sdkcloudformation.ResourceChange{
-					LogicalResourceId:  aws.String("MyTable"),
-					PhysicalResourceId: aws.String("AWS::DynamoDB::Table"),
-				},
-			},
-		},
-	}, nil)
-
-	m.EXPECT().TemplateBodyFromChangeSet("5678", "my-nested-stack").Return(`
-Resources:
-  MyTable:
-    Metadata:
-      'aws:copilot:description': 'A DynamoDB table to store data'
-    Type: AWS::DynamoDB::Table`, nil)
-
 	m.EXPECT().DescribeStackEvents(&sdkcloudformation.DescribeStackEventsInput{
 		StackName: aws.String("my-nested-stack"),
 	}).Return(&sdkcloudformation.DescribeStackEventsOutput{
@@ -726,5 +715,221 @@ Resources:
 	require.NoError(t, err)
 	require.Contains(t, buf.String(), "An ECS cluster")
 	require.Contains(t, buf.String(), "An Addons CloudFormation Stack for your additional AWS resources")
-	require.Contains(t, buf.String(), "A DynamoDB table to store data")
+	require.Contains(t, buf.String(), "MyTable", "addon resources are rendered by their logical ID")
+}
+
+func TestCloudFormation_DeleteRolledBackStack(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+		wantedErr  error
+	}{
+		"returns a wrapped error if the stack cannot be described": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test-api").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("describe stack phonetool-test-api: some error"),
+		},
+		"does nothing if the stack does not exist": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test-api").Return(nil, &cloudformation.ErrStackNotFound{})
+				m.EXPECT().DeleteAndWait(gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"does nothing if the stack is not in ROLLBACK_COMPLETE state": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test-api").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String(sdkcloudformation.StackStatusUpdateRollbackComplete),
+				}, nil)
+				m.EXPECT().DeleteAndWait(gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"returns a wrapped error if the rolled back stack cannot be deleted": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test-api").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String(sdkcloudformation.StackStatusRollbackComplete),
+				}, nil)
+				m.EXPECT().DeleteAndWait("phonetool-test-api").Return(errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("delete stack phonetool-test-api: some error"),
+		},
+		"deletes the stack if it is in ROLLBACK_COMPLETE state": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test-api").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String(sdkcloudformation.StackStatusRollbackComplete),
+				}, nil)
+				m.EXPECT().DeleteAndWait("phonetool-test-api").Return(nil)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.DeleteRolledBackStack("phonetool-test-api")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_streamStackChanges(t *testing.T) {
+	const stackName = "phonetool-test"
+	changeSetTime := time.Date(2022, time.March, 1, 18, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inCreateChangeSet func() (string, error)
+		inClient          func(ctrl *gomock.Controller) *mocks.MockcfnClient
+		inTimeout         time.Duration
+
+		wantedOut string
+		wantedErr error
+	}{
+		"should return the error from creating the change set": {
+			inCreateChangeSet: func() (string, error) {
+				return "", errors.New("some error")
+			},
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				return mocks.NewMockcfnClient(ctrl)
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"should return the error from describing the change set": {
+			inCreateChangeSet: func() (string, error) {
+				return "1234", nil
+			},
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().DescribeChangeSet("1234", stackName).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"should write each stack event as a JSON line until the stack update completes": {
+			inCreateChangeSet: func() (string, error) {
+				return "1234", nil
+			},
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().DescribeChangeSet("1234", stackName).Return(&cloudformation.ChangeSetDescription{
+					CreationTime: changeSetTime,
+				}, nil)
+				m.EXPECT().DescribeStackEvents(gomock.Any()).Return(&sdkcloudformation.DescribeStackEventsOutput{
+					StackEvents: []*sdkcloudformation.StackEvent{
+						{
+							EventId:           aws.String("2"),
+							LogicalResourceId: aws.String(stackName),
+							ResourceType:      aws.String("AWS::CloudFormation::Stack"),
+							ResourceStatus:    aws.String("UPDATE_COMPLETE"),
+							Timestamp:         aws.Time(changeSetTime.Add(2 * time.Minute)),
+						},
+						{
+							EventId:              aws.String("1"),
+							LogicalResourceId:    aws.String("PublicLoadBalancer"),
+							ResourceType:         aws.String("AWS::ElasticLoadBalancingV2::LoadBalancer"),
+							ResourceStatus:       aws.String("UPDATE_IN_PROGRESS"),
+							ResourceStatusReason: aws.String("Requested update"),
+							Timestamp:            aws.Time(changeSetTime.Add(time.Minute)),
+						},
+					},
+				}, nil)
+				m.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
+					StackStatus: aws.String("UPDATE_COMPLETE"),
+				}, nil)
+				return m
+			},
+			wantedOut: `{"logicalId":"PublicLoadBalancer","resourceType":"AWS::ElasticLoadBalancingV2::LoadBalancer","status":"UPDATE_IN_PROGRESS","timestamp":"2022-03-01T18:01:00Z","reason":"Requested update"}
+{"logicalId":"phonetool-test","resourceType":"AWS::CloudFormation::Stack","status":"UPDATE_COMPLETE","timestamp":"2022-03-01T18:02:00Z"}
+`,
+		},
+		"should return an error if the stack update failed": {
+			inCreateChangeSet: func() (string, error) {
+				return "1234", nil
+			},
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().DescribeChangeSet("1234", stackName).Return(&cloudformation.ChangeSetDescription{
+					CreationTime: changeSetTime,
+				}, nil)
+				m.EXPECT().DescribeStackEvents(gomock.Any()).Return(&sdkcloudformation.DescribeStackEventsOutput{
+					StackEvents: []*sdkcloudformation.StackEvent{
+						{
+							EventId:           aws.String("1"),
+							LogicalResourceId: aws.String(stackName),
+							ResourceType:      aws.String("AWS::CloudFormation::Stack"),
+							ResourceStatus:    aws.String("UPDATE_ROLLBACK_COMPLETE"),
+							Timestamp:         aws.Time(changeSetTime.Add(time.Minute)),
+						},
+					},
+				}, nil)
+				m.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
+					StackStatus: aws.String("UPDATE_ROLLBACK_COMPLETE"),
+				}, nil)
+				return m
+			},
+			wantedOut: `{"logicalId":"phonetool-test","resourceType":"AWS::CloudFormation::Stack","status":"UPDATE_ROLLBACK_COMPLETE","timestamp":"2022-03-01T18:01:00Z"}
+`,
+			wantedErr: errors.New("stack phonetool-test did not complete successfully and exited with status UPDATE_ROLLBACK_COMPLETE"),
+		},
+		"should return an error if the stack update does not complete within the timeout": {
+			inCreateChangeSet: func() (string, error) {
+				return "1234", nil
+			},
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().DescribeChangeSet("1234", stackName).Return(&cloudformation.ChangeSetDescription{
+					CreationTime: changeSetTime,
+				}, nil)
+				m.EXPECT().DescribeStackEvents(gomock.Any()).Return(&sdkcloudformation.DescribeStackEventsOutput{}, nil).AnyTimes()
+				m.EXPECT().Describe(gomock.Any()).Times(0)
+				return m
+			},
+			inTimeout: 10 * time.Millisecond,
+			wantedErr: errors.New("stack phonetool-test did not complete within 10ms: context deadline exceeded"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := CloudFormation{
+				cfnClient: tc.inClient(ctrl),
+			}
+			buf := new(strings.Builder)
+
+			// WHEN
+			err := cf.streamStackChanges(buf, stackName, tc.inTimeout, tc.inCreateChangeSet)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedOut, buf.String())
+		})
+	}
 }
@@ -0,0 +1,126 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"gopkg.in/yaml.v3"
+)
+
+const customResourceType = "AWS::CloudFormation::CustomResource"
+
+// FailedCustomResource is a custom resource that failed to be created, updated or deleted,
+// along with the Lambda function that handles its requests.
+type FailedCustomResource struct {
+	LogicalID    string
+	Reason       string
+	FailedAt     time.Time
+	FunctionName string // Empty if the function can't be found in the stack.
+}
+
+// EnvironmentFailedCustomResources returns the custom resources of the environment stack that failed since the given time.
+func (cf CloudFormation) EnvironmentFailedCustomResources(appName, envName string, since time.Time) ([]FailedCustomResource, error) {
+	return cf.failedCustomResources(stack.NameForEnv(appName, envName), since)
+}
+
+// failedCustomResources returns the first failure of each custom resource of the stack since the given time.
+func (cf CloudFormation) failedCustomResources(stackName string, since time.Time) ([]FailedCustomResource, error) {
+	events, err := cf.cfnClient.ErrorEvents(stackName)
+	if err != nil {
+		return nil, fmt.Errorf("describe failed events of stack %s: %w", stackName, err)
+	}
+	var failed []FailedCustomResource
+	seen := make(map[string]bool)
+	for _, event := range events {
+		resourceType, logicalID := aws.StringValue(event.ResourceType), aws.StringValue(event.LogicalResourceId)
+		if !isCustomResource(resourceType) || aws.TimeValue(event.Timestamp).Before(since) || seen[logicalID] {
+			continue
+		}
+		seen[logicalID] = true
+		failed = append(failed, FailedCustomResource{
+			LogicalID: logicalID,
+			Reason:    aws.StringValue(event.ResourceStatusReason),
+			FailedAt:  aws.TimeValue(event.Timestamp),
+		})
+	}
+	if len(failed) == 0 {
+		return nil, nil
+	}
+
+	tpl, err := cf.cfnClient.TemplateBody(stackName)
+	if err != nil {
+		return nil, fmt.Errorf("get template of stack %s: %w", stackName, err)
+	}
+	handlers, err := customResourceHandlers(tpl)
+	if err != nil {
+		return nil, fmt.Errorf("parse template of stack %s: %w", stackName, err)
+	}
+	resources, err := cf.cfnClient.StackResources(stackName)
+	if err != nil {
+		return nil, fmt.Errorf("describe resources of stack %s: %w", stackName, err)
+	}
+	physicalIDs := make(map[string]string)
+	for _, r := range resources {
+		physicalIDs[aws.StringValue(r.LogicalResourceId)] = aws.StringValue(r.PhysicalResourceId)
+	}
+	for i := range failed {
+		if handler, ok := handlers[failed[i].LogicalID]; ok {
+			failed[i].FunctionName = physicalIDs[handler]
+		}
+	}
+	return failed, nil
+}
+
+func isCustomResource(resourceType string) bool {
+	return resourceType == customResourceType || strings.HasPrefix(resourceType, "Custom::")
+}
+
+// customResourceHandlers maps the logical ID of each custom resource in the template to the logical ID of
+// the function referenced by its "ServiceToken" property with "Fn::GetAtt".
+func customResourceHandlers(tpl string) (map[string]string, error) {
+	var parsed struct {
+		Resources map[string]struct {
+			Type       string `yaml:"Type"`
+			Properties struct {
+				ServiceToken yaml.Node `yaml:"ServiceToken"`
+			} `yaml:"Properties"`
+		} `yaml:"Resources"`
+	}
+	if err := yaml.Unmarshal([]byte(tpl), &parsed); err != nil {
+		return nil, err
+	}
+	handlers := make(map[string]string)
+	for logicalID, r := range parsed.Resources {
+		if !isCustomResource(r.Type) {
+			continue
+		}
+		if handler := getAttResource(&r.Properties.ServiceToken); handler != "" {
+			handlers[logicalID] = handler
+		}
+	}
+	return handlers, nil
+}
+
+// getAttResource returns the logical ID of the resource referenced by a "!GetAtt" or "Fn::GetAtt" node,
+// or an empty string if the node is not one.
+func getAttResource(node *yaml.Node) string {
+	switch {
+	case node.Kind == yaml.ScalarNode && node.Tag == "!GetAtt":
+		return strings.Split(node.Value, ".")[0]
+	case node.Kind == yaml.SequenceNode && node.Tag == "!GetAtt" && len(node.Content) > 0:
+		return node.Content[0].Value
+	case node.Kind == yaml.MappingNode && len(node.Content) == 2 && node.Content[0].Value == "Fn::GetAtt":
+		value := node.Content[1]
+		if value.Kind == yaml.SequenceNode && len(value.Content) > 0 {
+			return value.Content[0].Value
+		}
+		return strings.Split(value.Value, ".")[0]
+	}
+	return ""
+}
@@ -0,0 +1,156 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudFormation_EnvironmentFailedCustomResources(t *testing.T) {
+	const mockTemplate = `
+Resources:
+  CustomDomainFunction:
+    Type: AWS::Lambda::Function
+  CustomDomainAction:
+    Type: Custom::CustomDomain
+    Properties:
+      ServiceToken: !GetAtt CustomDomainFunction.Arn
+  CertificateValidationFunction:
+    Type: AWS::Lambda::Function
+  HTTPSCert:
+    Type: AWS::CloudFormation::CustomResource
+    Properties:
+      ServiceToken:
+        Fn::GetAtt: [CertificateValidationFunction, Arn]
+`
+	mockSince := time.Date(2022, 10, 18, 10, 0, 0, 0, time.UTC)
+	mockFailedAt := mockSince.Add(3 * time.Minute)
+	testCases := map[string]struct {
+		setUpMocks func(m *mocks.MockcfnClient)
+
+		wanted    []FailedCustomResource
+		wantedErr error
+	}{
+		"error if fail to describe the failed stack events": {
+			setUpMocks: func(m *mocks.MockcfnClient) {
+				m.EXPECT().ErrorEvents("phonetool-test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe failed events of stack phonetool-test: some error"),
+		},
+		"no custom resources failed since the given time": {
+			setUpMocks: func(m *mocks.MockcfnClient) {
+				m.EXPECT().ErrorEvents("phonetool-test").Return([]cloudformation.StackEvent{
+					{
+						LogicalResourceId:    aws.String("CustomDomainAction"),
+						ResourceType:         aws.String("Custom::CustomDomain"),
+						ResourceStatusReason: aws.String("an older failure"),
+						Timestamp:            aws.Time(mockSince.Add(-time.Hour)),
+					},
+					{
+						LogicalResourceId:    aws.String("VPC"),
+						ResourceType:         aws.String("AWS::EC2::VPC"),
+						ResourceStatusReason: aws.String("not a custom resource"),
+						Timestamp:            aws.Time(mockFailedAt),
+					},
+				}, nil)
+				m.EXPECT().TemplateBody(gomock.Any()).Times(0)
+			},
+		},
+		"error if fail to describe the stack resources": {
+			setUpMocks: func(m *mocks.MockcfnClient) {
+				m.EXPECT().ErrorEvents("phonetool-test").Return([]cloudformation.StackEvent{
+					{
+						LogicalResourceId: aws.String("CustomDomainAction"),
+						ResourceType:      aws.String("Custom::CustomDomain"),
+						Timestamp:         aws.Time(mockFailedAt),
+					},
+				}, nil)
+				m.EXPECT().TemplateBody("phonetool-test").Return(mockTemplate, nil)
+				m.EXPECT().StackResources("phonetool-test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe resources of stack phonetool-test: some error"),
+		},
+		"return the first failure of each custom resource with its function": {
+			setUpMocks: func(m *mocks.MockcfnClient) {
+				m.EXPECT().ErrorEvents("phonetool-test").Return([]cloudformation.StackEvent{
+					{
+						LogicalResourceId:    aws.String("CustomDomainAction"),
+						ResourceType:         aws.String("Custom::CustomDomain"),
+						ResourceStatusReason: aws.String("Custom Resource failed to stabilize in expected time"),
+						Timestamp:            aws.Time(mockFailedAt),
+					},
+					{
+						LogicalResourceId:    aws.String("HTTPSCert"),
+						ResourceType:         aws.String("AWS::CloudFormation::CustomResource"),
+						ResourceStatusReason: aws.String("Resource update cancelled"),
+						Timestamp:            aws.Time(mockFailedAt),
+					},
+					{
+						LogicalResourceId:    aws.String("CustomDomainAction"),
+						ResourceType:         aws.String("Custom::CustomDomain"),
+						ResourceStatusReason: aws.String("failed to roll back"),
+						Timestamp:            aws.Time(mockFailedAt.Add(time.Minute)),
+					},
+				}, nil)
+				m.EXPECT().TemplateBody("phonetool-test").Return(mockTemplate, nil)
+				m.EXPECT().StackResources("phonetool-test").Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("CustomDomainFunction"),
+						PhysicalResourceId: aws.String("phonetool-test-CustomDomainFunction-abc"),
+					},
+					{
+						LogicalResourceId:  aws.String("CertificateValidationFunction"),
+						PhysicalResourceId: aws.String("phonetool-test-CertificateValidationFunction-def"),
+					},
+				}, nil)
+			},
+			wanted: []FailedCustomResource{
+				{
+					LogicalID:    "CustomDomainAction",
+					Reason:       "Custom Resource failed to stabilize in expected time",
+					FailedAt:     mockFailedAt,
+					FunctionName: "phonetool-test-CustomDomainFunction-abc",
+				},
+				{
+					LogicalID:    "HTTPSCert",
+					Reason:       "Resource update cancelled",
+					FailedAt:     mockFailedAt,
+					FunctionName: "phonetool-test-CertificateValidationFunction-def",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcfnClient(ctrl)
+			tc.setUpMocks(m)
+			cf := CloudFormation{
+				cfnClient: m,
+			}
+
+			// WHEN
+			got, err := cf.EnvironmentFailedCustomResources("phonetool", "test", mockSince)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -7,6 +7,7 @@ package cloudformation
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -43,24 +44,10 @@ func (cf CloudFormation) CreateAndRenderEnvironment(out progress.FileWriter, env
 
 // UpdateAndRenderEnvironment updates the CloudFormation stack for an environment, and render the stack creation to out.
 func (cf CloudFormation) UpdateAndRenderEnvironment(out progress.FileWriter, env *deploy.CreateEnvironmentInput, opts ...cloudformation.StackOption) error {
-	cfnStack, err := cf.toUploadedStack(env.ArtifactBucketARN, stack.NewEnvStackConfig(env))
+	cfnStack, err := cf.environmentStackToUpdate(env, opts...)
 	if err != nil {
 		return err
 	}
-	for _, opt := range opts {
-		opt(cfnStack)
-	}
-
-	descr, err := cf.waitAndDescribeStack(cfnStack.Name)
-	if err != nil {
-		return err
-	}
-	params, err := cf.transformParameters(cfnStack.Parameters, descr.Parameters, transformEnvControllerParameters)
-	if err != nil {
-		return err
-	}
-	cfnStack.Parameters = params
-
 	in := newRenderEnvironmentInput(out, cfnStack)
 	in.createChangeSet = func() (changeSetID string, err error) {
 		spinner := progress.NewSpinner(out)
@@ -73,9 +60,96 @@ func (cf CloudFormation) UpdateAndRenderEnvironment(out progress.FileWriter, env
 		}
 		return changeSetID, nil
 	}
+	in.timeout = env.Timeout
 	return cf.renderStackChanges(in)
 }
 
+// UpdateAndStreamEnvironment updates the CloudFormation stack for an environment, and writes the stack events
+// to out as newline-delimited JSON until the update completes.
+func (cf CloudFormation) UpdateAndStreamEnvironment(out io.Writer, env *deploy.CreateEnvironmentInput, opts ...cloudformation.StackOption) error {
+	cfnStack, err := cf.environmentStackToUpdate(env, opts...)
+	if err != nil {
+		return err
+	}
+	return cf.streamStackChanges(out, cfnStack.Name, env.Timeout, func() (string, error) {
+		return cf.cfnClient.Update(cfnStack)
+	})
+}
+
+// UpdateEnvironment starts updating the CloudFormation stack for an environment without waiting for the update to complete.
+// It returns the ID of the change set executed on the stack.
+func (cf CloudFormation) UpdateEnvironment(env *deploy.CreateEnvironmentInput, opts ...cloudformation.StackOption) (string, error) {
+	cfnStack, err := cf.environmentStackToUpdate(env, opts...)
+	if err != nil {
+		return "", err
+	}
+	return cf.cfnClient.Update(cfnStack)
+}
+
+// CreateEnvironmentChangeSet creates a change set to update the CloudFormation stack for an environment without executing it.
+// It returns the ID of the change set.
+func (cf CloudFormation) CreateEnvironmentChangeSet(env *deploy.CreateEnvironmentInput, opts ...cloudformation.StackOption) (string, error) {
+	cfnStack, err := cf.environmentStackToUpdate(env, opts...)
+	if err != nil {
+		return "", err
+	}
+	changeSetID, err := cf.cfnClient.CreateChangeSet(cfnStack)
+	if err != nil {
+		return "", fmt.Errorf("create change set for stack %s: %w", cfnStack.Name, err)
+	}
+	return changeSetID, nil
+}
+
+// EnvironmentChangeSet returns the description of a change set for the CloudFormation stack of an environment.
+func (cf CloudFormation) EnvironmentChangeSet(appName, envName, changeSetID string) (*cloudformation.ChangeSetDescription, error) {
+	stackName := stack.NameForEnv(appName, envName)
+	descr, err := cf.cfnClient.DescribeChangeSet(changeSetID, stackName)
+	if err != nil {
+		return nil, fmt.Errorf("describe change set %s for stack %s: %w", changeSetID, stackName, err)
+	}
+	return descr, nil
+}
+
+// RenderEnvironmentUpdate renders the update in progress on the environment stack to out until it completes.
+// If the stack is not being updated, it returns an error only if the last update failed.
+func (cf CloudFormation) RenderEnvironmentUpdate(out progress.FileWriter, appName, envName string) error {
+	stackName := stack.NameForEnv(appName, envName)
+	return cf.renderStackUpdate(out, stackName, fmt.Sprintf("Updating the infrastructure for the %s environment.", stackName))
+}
+
+// environmentStackToUpdate returns the environment stack to deploy once the stack is ready to be updated.
+func (cf CloudFormation) environmentStackToUpdate(env *deploy.CreateEnvironmentInput, opts ...cloudformation.StackOption) (*cloudformation.Stack, error) {
+	var conf StackConfiguration = stack.NewEnvStackConfig(env)
+	if env.Packaged != nil {
+		packaged, err := stack.NewPackagedStack(stack.NameForEnv(env.App.Name, env.Name), env.Packaged)
+		if err != nil {
+			return nil, err
+		}
+		conf = packaged
+	}
+	cfnStack, err := cf.toUploadedStack(env.ArtifactBucketARN, conf)
+	if err != nil {
+		return nil, err
+	}
+	for _, opt := range opts {
+		opt(cfnStack)
+	}
+
+	descr, err := cf.waitAndDescribeStack(cfnStack.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := errIfRollbackComplete(cfnStack.Name, descr); err != nil {
+		return nil, err
+	}
+	params, err := cf.transformParameters(cfnStack.Parameters, descr.Parameters, transformEnvControllerParameters)
+	if err != nil {
+		return nil, err
+	}
+	cfnStack.Parameters = params
+	return cfnStack, nil
+}
+
 func newRenderEnvironmentInput(out progress.FileWriter, cfnStack *cloudformation.Stack) *renderStackChangesInput {
 	return &renderStackChangesInput{
 		w:                out,
@@ -125,6 +199,11 @@ func (cf CloudFormation) EnvironmentParameters(appName, envName string) ([]*awsc
 	return out.Parameters, nil
 }
 
+// EnvironmentDrift detects drift on the environment stack and returns the resources that were changed outside of CloudFormation.
+func (cf CloudFormation) EnvironmentDrift(appName, envName string) ([]*cloudformation.StackResourceDrift, error) {
+	return cf.cfnClient.DetectStackDrift(context.Background(), stack.NameForEnv(appName, envName))
+}
+
 // UpdateEnvironmentTemplate updates the cloudformation stack's template body while maintaining the parameters and tags.
 func (cf CloudFormation) UpdateEnvironmentTemplate(appName, envName, templateBody, cfnExecRoleARN string) error {
 	stackName := stack.NameForEnv(appName, envName)
@@ -139,6 +218,40 @@ func (cf CloudFormation) UpdateEnvironmentTemplate(appName, envName, templateBod
 	return cf.cfnClient.UpdateAndWait(s)
 }
 
+// RollbackAndRenderEnvironment updates the environment stack to the template stored at templateURL with the given parameters,
+// and renders the stack update to out.
+// The parameters managed by the environment controller keep their currently deployed values, since services may have updated them since.
+func (cf CloudFormation) RollbackAndRenderEnvironment(out progress.FileWriter, appName, envName, templateURL string, params []*awscfn.Parameter, cfnExecRoleARN string) error {
+	stackName := stack.NameForEnv(appName, envName)
+	descr, err := cf.waitAndDescribeStack(stackName)
+	if err != nil {
+		return err
+	}
+	if err := errIfRollbackComplete(stackName, descr); err != nil {
+		return err
+	}
+	params, err = cf.transformParameters(params, descr.Parameters, transformEnvControllerParameters)
+	if err != nil {
+		return err
+	}
+	s := cloudformation.NewStackWithURL(stackName, templateURL)
+	s.Parameters = params
+	s.Tags = descr.Tags
+	s.RoleARN = aws.String(cfnExecRoleARN)
+	return cf.renderStackChanges(&renderStackChangesInput{
+		w:                out,
+		stackName:        stackName,
+		stackDescription: fmt.Sprintf("Rolling back the infrastructure for the %s environment.", stackName),
+		createChangeSet: func() (changeSetID string, err error) {
+			spinner := progress.NewSpinner(out)
+			label := fmt.Sprintf("Proposing infrastructure changes to roll back the %s environment.", stackName)
+			spinner.Start(label)
+			defer stopSpinner(spinner, err, label)
+			return cf.cfnClient.Update(s)
+		},
+	})
+}
+
 func (cf CloudFormation) toUploadedStack(artifactBucketARN string, stackConfig StackConfiguration) (*cloudformation.Stack, error) {
 	bucketARN, err := arn.Parse(artifactBucketARN)
 	if err != nil {
@@ -0,0 +1,297 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/progress"
+)
+
+const (
+	// Self-managed permissions for the environment stack set. The administration role lives in the application account,
+	// and the execution role must exist in every account that hosts an environment and trust the administration role.
+	envStackSetAdminRoleName     = "AWSCloudFormationStackSetAdministrationRole"
+	envStackSetExecutionRoleName = "AWSCloudFormationStackSetExecutionRole"
+	fmtEnvStackSetAdminRoleARN   = "arn:%s:iam::%s:role/%s"
+)
+
+// EnvStackSet deploys environments through a CloudFormation stack set instead of updating their stacks directly.
+// The environments of an application share one stack set, where each environment stack is a stack instance
+// in the account and region of the environment. The first time an environment is deployed this way, its stack is
+// imported into the stack set, so the environment keeps its stack and its resources.
+type EnvStackSet struct {
+	CloudFormation // Reads and uploads the environment stack in the environment region.
+
+	app      *config.Application
+	stackSet envStackSetClient
+}
+
+// NewEnvStackSet returns a client that deploys the environments of an application through its environment stack set.
+// The stack set is managed with appSess, and the environment stacks are read with envSess.
+func NewEnvStackSet(app *config.Application, appSess, envSess *session.Session) *EnvStackSet {
+	return &EnvStackSet{
+		CloudFormation: New(envSess),
+		app:            app,
+		stackSet:       stackset.New(appSess),
+	}
+}
+
+// StackInstanceStatus is the status of an environment stack instance after the stack set is deployed.
+// Since the stack set is updated one environment at a time, the instances of the other environments are
+// usually "OUTDATED": their stacks are still deployed with the last configuration of their own environment.
+type StackInstanceStatus struct {
+	StackName string `json:"stackName"`
+	Account   string `json:"account"`
+	Region    string `json:"region"`
+	Status    string `json:"status"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// UpdateAndRenderEnvironment updates the stack instance of an environment, and renders the status of each stack instance of the stack set to out.
+func (ss *EnvStackSet) UpdateAndRenderEnvironment(out progress.FileWriter, env *deploy.CreateEnvironmentInput, opts ...cloudformation.StackOption) error {
+	cfnStack, err := ss.environmentStackToUpdate(env, opts...)
+	if err != nil {
+		return err
+	}
+	spinner := progress.NewSpinner(out)
+	label := fmt.Sprintf("Deploying the %s environment through stack set %s.", env.Name, stack.NameForEnvStackSet(ss.app.Name))
+	spinner.Start(label)
+	statuses, err := ss.deployStackSet(cfnStack)
+	stopSpinner(spinner, err, label)
+	for _, status := range statuses {
+		fmt.Fprintf(out, "  - %s in %s (%s): %s\n", status.StackName, status.Region, status.Account, status.Status)
+		if status.Reason != "" {
+			fmt.Fprintf(out, "    %s\n", status.Reason)
+		}
+	}
+	return err
+}
+
+// UpdateAndStreamEnvironment updates the stack instance of an environment, and writes the status of each stack instance of the stack set
+// to out as newline-delimited JSON once the update completes.
+func (ss *EnvStackSet) UpdateAndStreamEnvironment(out io.Writer, env *deploy.CreateEnvironmentInput, opts ...cloudformation.StackOption) error {
+	cfnStack, err := ss.environmentStackToUpdate(env, opts...)
+	if err != nil {
+		return err
+	}
+	statuses, err := ss.deployStackSet(cfnStack)
+	enc := json.NewEncoder(out)
+	for _, status := range statuses {
+		if encErr := enc.Encode(status); encErr != nil {
+			return fmt.Errorf("write status of stack instance %s: %w", status.StackName, encErr)
+		}
+	}
+	return err
+}
+
+// UpdateEnvironment is not supported since stack set operations don't use change sets.
+func (ss *EnvStackSet) UpdateEnvironment(env *deploy.CreateEnvironmentInput, opts ...cloudformation.StackOption) (string, error) {
+	return "", errUnsupportedByStackSet("deploying without waiting")
+}
+
+// CreateEnvironmentChangeSet is not supported since stack set operations don't use change sets.
+func (ss *EnvStackSet) CreateEnvironmentChangeSet(env *deploy.CreateEnvironmentInput, opts ...cloudformation.StackOption) (string, error) {
+	return "", errUnsupportedByStackSet("creating a change set")
+}
+
+// RenderEnvironmentUpdate is not supported since stack set operations don't use change sets.
+func (ss *EnvStackSet) RenderEnvironmentUpdate(out progress.FileWriter, appName, envName string) error {
+	return errUnsupportedByStackSet("following a deployment in progress")
+}
+
+// RollbackAndRenderEnvironment is not supported since it would update the environment stack outside of its stack set.
+func (ss *EnvStackSet) RollbackAndRenderEnvironment(out progress.FileWriter, app, env, templateURL string, params []*awscfn.Parameter, cfnExecRoleARN string) error {
+	return errUnsupportedByStackSet("rolling back")
+}
+
+// IsEnvironmentInstance returns true if the stack of the environment is an instance of the environment stack set.
+func (ss *EnvStackSet) IsEnvironmentInstance(env *config.Environment) (bool, error) {
+	if _, err := ss.stackSet.Describe(stack.NameForEnvStackSet(ss.app.Name)); err != nil {
+		var errNotFound *stackset.ErrStackSetNotFound
+		if errors.As(err, &errNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	instance, err := ss.instanceOf(env.AccountID, env.Region)
+	if err != nil {
+		return false, err
+	}
+	return instance != nil && parseStackNameFromARN(instance.StackID) == stack.NameForEnv(ss.app.Name, env.Name), nil
+}
+
+// DetachEnvironment removes the stack instance of an environment from the environment stack set, and keeps the stack.
+// The stack set is deleted once it has no stack instances left.
+func (ss *EnvStackSet) DetachEnvironment(env *config.Environment) error {
+	isInstance, err := ss.IsEnvironmentInstance(env)
+	if err != nil {
+		return err
+	}
+	if !isInstance {
+		return nil
+	}
+	name := stack.NameForEnvStackSet(ss.app.Name)
+	if err := ss.stackSet.DeleteInstancesAndWait(name, []string{env.AccountID}, []string{env.Region}, true); err != nil {
+		return err
+	}
+	summaries, err := ss.stackSet.InstanceSummaries(name)
+	if err != nil {
+		return err
+	}
+	if len(summaries) > 0 {
+		return nil
+	}
+	return ss.stackSet.Delete(name)
+}
+
+// deployStackSet updates the stack instance of the environment stack, and returns the status of every stack instance
+// of the stack set. The statuses are returned even if the update fails, so that the failing instance can be reported.
+func (ss *EnvStackSet) deployStackSet(cfnStack *cloudformation.Stack) ([]StackInstanceStatus, error) {
+	descr, err := ss.cfnClient.Describe(cfnStack.Name)
+	if err != nil {
+		return nil, fmt.Errorf("describe stack %s: %w", cfnStack.Name, err)
+	}
+	stackID := aws.StringValue(descr.StackId)
+	parsed, err := arn.Parse(stackID)
+	if err != nil {
+		return nil, fmt.Errorf("parse ID of stack %s: %w", cfnStack.Name, err)
+	}
+	adminRoleARN, err := ss.adminRoleARN()
+	if err != nil {
+		return nil, err
+	}
+	name := stack.NameForEnvStackSet(ss.app.Name)
+	opts := []stackset.CreateOrUpdateOption{
+		stackset.WithTemplateURL(cfnStack.TemplateURL),
+		// The stack set is administered from the application account, so it can't resolve previous values of
+		// parameters that the environment controller updates on the stack directly.
+		stackset.WithParameters(withCurrentValues(cfnStack.Parameters, descr.Parameters)),
+		stackset.WithCapabilities(awscfn.CapabilityCapabilityIam, awscfn.CapabilityCapabilityNamedIam),
+		stackset.WithDescription(fmt.Sprintf("Copilot environment stacks of application %s", ss.app.Name)),
+		stackset.WithAdministrationRoleARN(adminRoleARN),
+		stackset.WithExecutionRoleName(envStackSetExecutionRoleName),
+		stackset.WithTags(toMap(cfnStack.Tags)),
+	}
+	if err := ss.importStack(name, stackID, parsed.AccountID, parsed.Region, opts); err != nil {
+		return nil, err
+	}
+	// Only update the instance of this environment, the other instances keep the stacks of their own environments.
+	updateErr := ss.stackSet.UpdateAndWait(name, "", append(opts, stackset.WithInstances([]string{parsed.AccountID}, []string{parsed.Region}))...)
+	statuses, err := ss.instanceStatuses(name)
+	if err != nil {
+		return nil, err
+	}
+	return statuses, updateErr
+}
+
+// importStack imports the environment stack into the environment stack set, if it isn't an instance of the stack set yet.
+// The stack set is created if it doesn't exist.
+func (ss *EnvStackSet) importStack(name, stackID, account, region string, opts []stackset.CreateOrUpdateOption) error {
+	_, err := ss.stackSet.Describe(name)
+	if err != nil {
+		var errNotFound *stackset.ErrStackSetNotFound
+		if !errors.As(err, &errNotFound) {
+			return err
+		}
+		if err := ss.stackSet.Create(name, "", opts...); err != nil {
+			return err
+		}
+	}
+	instance, err := ss.instanceOf(account, region)
+	if err != nil {
+		return err
+	}
+	if instance == nil {
+		return ss.stackSet.ImportStacksAndWait(name, []string{stackID})
+	}
+	if instance.StackID != stackID {
+		return fmt.Errorf("stack set %s already deploys stack %s in account %s and region %s: a stack set can only have one stack instance per account and region",
+			name, parseStackNameFromARN(instance.StackID), account, region)
+	}
+	return nil
+}
+
+// instanceOf returns the stack instance of the environment stack set in an account and region, or nil if there is none.
+func (ss *EnvStackSet) instanceOf(account, region string) (*stackset.InstanceSummary, error) {
+	name := stack.NameForEnvStackSet(ss.app.Name)
+	summaries, err := ss.stackSet.InstanceSummaries(name, stackset.FilterSummariesByAccountID(account), stackset.FilterSummariesByRegion(region))
+	if err != nil {
+		return nil, err
+	}
+	if len(summaries) == 0 {
+		return nil, nil
+	}
+	return &summaries[0], nil
+}
+
+func (ss *EnvStackSet) adminRoleARN() (string, error) {
+	partition, err := partitions.Region(ss.region).Partition()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(fmtEnvStackSetAdminRoleARN, partition.ID(), ss.app.AccountID, envStackSetAdminRoleName), nil
+}
+
+func (ss *EnvStackSet) instanceStatuses(name string) ([]StackInstanceStatus, error) {
+	summaries, err := ss.stackSet.InstanceSummaries(name)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]StackInstanceStatus, len(summaries))
+	for i, summary := range summaries {
+		statuses[i] = StackInstanceStatus{
+			StackName: parseStackNameFromARN(summary.StackID),
+			Account:   summary.Account,
+			Region:    summary.Region,
+			Status:    summary.Status,
+			Reason:    summary.StatusReason,
+		}
+	}
+	sort.SliceStable(statuses, func(i, j int) bool {
+		if statuses[i].Region != statuses[j].Region {
+			return statuses[i].Region < statuses[j].Region
+		}
+		return statuses[i].Account < statuses[j].Account
+	})
+	return statuses, nil
+}
+
+// withCurrentValues replaces the parameters that use their previous value with their current value on the stack.
+func withCurrentValues(params, current []*awscfn.Parameter) []*awscfn.Parameter {
+	values := make(map[string]*string)
+	for _, param := range current {
+		values[aws.StringValue(param.ParameterKey)] = param.ParameterValue
+	}
+	resolved := make([]*awscfn.Parameter, len(params))
+	for i, param := range params {
+		if !aws.BoolValue(param.UsePreviousValue) {
+			resolved[i] = param
+			continue
+		}
+		resolved[i] = &awscfn.Parameter{
+			ParameterKey:   param.ParameterKey,
+			ParameterValue: values[aws.StringValue(param.ParameterKey)],
+		}
+	}
+	return resolved
+}
+
+func errUnsupportedByStackSet(action string) error {
+	return fmt.Errorf("%s is not supported for environments deployed through stack sets", action)
+}
@@ -0,0 +1,302 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type envStackSetMocks struct {
+	cfnClient *mocks.MockcfnClient
+	stackSet  *mocks.MockenvStackSetClient
+}
+
+func TestEnvStackSet_deployStackSet(t *testing.T) {
+	const (
+		testStackName    = "phonetool-test"
+		testStackSetName = "phonetool-environments"
+		testStackID      = "arn:aws:cloudformation:us-west-2:1234:stack/phonetool-test/1"
+	)
+	testStack := cloudformation.NewStackWithURL(testStackName, "https://bucket.s3.us-west-2.amazonaws.com/manual/templates/phonetool-test/template.yml")
+	testStack.Parameters = []*awscfn.Parameter{
+		{
+			ParameterKey:   aws.String("EnvironmentName"),
+			ParameterValue: aws.String("test"),
+		},
+		{
+			ParameterKey:     aws.String("ALBWorkloads"),
+			UsePreviousValue: aws.Bool(true),
+		},
+	}
+	testDescr := &cloudformation.StackDescription{
+		StackId: aws.String(testStackID),
+		Parameters: []*awscfn.Parameter{
+			{
+				ParameterKey:   aws.String("EnvironmentName"),
+				ParameterValue: aws.String("test"),
+			},
+			{
+				ParameterKey:   aws.String("ALBWorkloads"),
+				ParameterValue: aws.String("frontend"),
+			},
+		},
+	}
+	testInstance := stackset.InstanceSummary{
+		StackID: testStackID,
+		Account: "1234",
+		Region:  "us-west-2",
+		Status:  awscfn.StackInstanceStatusCurrent,
+	}
+	testSummaries := []stackset.InstanceSummary{
+		testInstance,
+		{
+			StackID:      "arn:aws:cloudformation:eu-west-1:5678:stack/phonetool-prod/2",
+			Account:      "5678",
+			Region:       "eu-west-1",
+			Status:       awscfn.StackInstanceStatusInoperable,
+			StatusReason: "some reason",
+		},
+	}
+	wantedStatuses := []StackInstanceStatus{
+		{
+			StackName: "phonetool-prod",
+			Account:   "5678",
+			Region:    "eu-west-1",
+			Status:    awscfn.StackInstanceStatusInoperable,
+			Reason:    "some reason",
+		},
+		{
+			StackName: "phonetool-test",
+			Account:   "1234",
+			Region:    "us-west-2",
+			Status:    awscfn.StackInstanceStatusCurrent,
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *envStackSetMocks)
+
+		wantedStatuses []StackInstanceStatus
+		wantedError    error
+	}{
+		"error if fails to describe the environment stack": {
+			setupMocks: func(m *envStackSetMocks) {
+				m.cfnClient.EXPECT().Describe(testStackName).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe stack %s: some error", testStackName),
+		},
+		"error if fails to describe the stack set": {
+			setupMocks: func(m *envStackSetMocks) {
+				m.cfnClient.EXPECT().Describe(testStackName).Return(testDescr, nil)
+				m.stackSet.EXPECT().Describe(testStackSetName).Return(stackset.Description{}, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"error if fails to import the environment stack": {
+			setupMocks: func(m *envStackSetMocks) {
+				m.cfnClient.EXPECT().Describe(testStackName).Return(testDescr, nil)
+				m.stackSet.EXPECT().Describe(testStackSetName).Return(stackset.Description{}, nil)
+				m.stackSet.EXPECT().InstanceSummaries(testStackSetName, gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.stackSet.EXPECT().ImportStacksAndWait(testStackSetName, []string{testStackID}).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"error if another stack is the instance in the account and region of the environment": {
+			setupMocks: func(m *envStackSetMocks) {
+				m.cfnClient.EXPECT().Describe(testStackName).Return(testDescr, nil)
+				m.stackSet.EXPECT().Describe(testStackSetName).Return(stackset.Description{}, nil)
+				m.stackSet.EXPECT().InstanceSummaries(testStackSetName, gomock.Any(), gomock.Any()).Return([]stackset.InstanceSummary{
+					{
+						StackID: "arn:aws:cloudformation:us-west-2:1234:stack/phonetool-other/3",
+						Account: "1234",
+						Region:  "us-west-2",
+					},
+				}, nil)
+			},
+			wantedError: errors.New("stack set phonetool-environments already deploys stack phonetool-other in account 1234 and region us-west-2: a stack set can only have one stack instance per account and region"),
+		},
+		"creates the stack set and imports the environment stack before updating its instance the first time": {
+			setupMocks: func(m *envStackSetMocks) {
+				m.cfnClient.EXPECT().Describe(testStackName).Return(testDescr, nil)
+				m.stackSet.EXPECT().Describe(testStackSetName).Return(stackset.Description{}, &stackset.ErrStackSetNotFound{})
+				gomock.InOrder(
+					m.stackSet.EXPECT().Create(testStackSetName, "", gomock.Any()).Return(nil),
+					m.stackSet.EXPECT().InstanceSummaries(testStackSetName, gomock.Any(), gomock.Any()).Return(nil, nil),
+					m.stackSet.EXPECT().ImportStacksAndWait(testStackSetName, []string{testStackID}).Return(nil),
+					m.stackSet.EXPECT().UpdateAndWait(testStackSetName, "", gomock.Any()).
+						Do(func(_, _ string, opts ...stackset.CreateOrUpdateOption) {
+							in := &awscfn.UpdateStackSetInput{}
+							for _, opt := range opts {
+								opt(in)
+							}
+							require.Equal(t, aws.StringSlice([]string{"1234"}), in.Accounts)
+							require.Equal(t, aws.StringSlice([]string{"us-west-2"}), in.Regions)
+							require.Equal(t, "arn:aws:iam::1234:role/AWSCloudFormationStackSetAdministrationRole", aws.StringValue(in.AdministrationRoleARN))
+							require.Equal(t, "AWSCloudFormationStackSetExecutionRole", aws.StringValue(in.ExecutionRoleName))
+							require.Equal(t, testDescr.Parameters, in.Parameters)
+						}).Return(nil),
+					m.stackSet.EXPECT().InstanceSummaries(testStackSetName).Return(testSummaries, nil),
+				)
+			},
+			wantedStatuses: wantedStatuses,
+		},
+		"returns the status of each instance if the update fails": {
+			setupMocks: func(m *envStackSetMocks) {
+				m.cfnClient.EXPECT().Describe(testStackName).Return(testDescr, nil)
+				m.stackSet.EXPECT().Describe(testStackSetName).Return(stackset.Description{}, nil)
+				m.stackSet.EXPECT().InstanceSummaries(testStackSetName, gomock.Any(), gomock.Any()).Return([]stackset.InstanceSummary{testInstance}, nil)
+				m.stackSet.EXPECT().UpdateAndWait(testStackSetName, "", gomock.Any()).Return(errors.New("some error"))
+				m.stackSet.EXPECT().InstanceSummaries(testStackSetName).Return(testSummaries, nil)
+			},
+			wantedStatuses: wantedStatuses,
+			wantedError:    errors.New("some error"),
+		},
+		"error if fails to list the stack instances": {
+			setupMocks: func(m *envStackSetMocks) {
+				m.cfnClient.EXPECT().Describe(testStackName).Return(testDescr, nil)
+				m.stackSet.EXPECT().Describe(testStackSetName).Return(stackset.Description{}, nil)
+				m.stackSet.EXPECT().InstanceSummaries(testStackSetName, gomock.Any(), gomock.Any()).Return([]stackset.InstanceSummary{testInstance}, nil)
+				m.stackSet.EXPECT().UpdateAndWait(testStackSetName, "", gomock.Any()).Return(nil)
+				m.stackSet.EXPECT().InstanceSummaries(testStackSetName).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &envStackSetMocks{
+				cfnClient: mocks.NewMockcfnClient(ctrl),
+				stackSet:  mocks.NewMockenvStackSetClient(ctrl),
+			}
+			tc.setupMocks(m)
+			ss := &EnvStackSet{
+				CloudFormation: CloudFormation{
+					cfnClient: m.cfnClient,
+					region:    "us-west-2",
+				},
+				app: &config.Application{
+					Name:      "phonetool",
+					AccountID: "1234",
+				},
+				stackSet: m.stackSet,
+			}
+
+			// WHEN
+			statuses, err := ss.deployStackSet(testStack)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedStatuses, statuses)
+		})
+	}
+}
+
+func TestEnvStackSet_DetachEnvironment(t *testing.T) {
+	const testStackSetName = "phonetool-environments"
+	testEnv := &config.Environment{
+		Name:      "test",
+		AccountID: "1234",
+		Region:    "us-west-2",
+	}
+	testInstance := stackset.InstanceSummary{
+		StackID: "arn:aws:cloudformation:us-west-2:1234:stack/phonetool-test/1",
+		Account: "1234",
+		Region:  "us-west-2",
+	}
+	testCases := map[string]struct {
+		setupMocks  func(m *mocks.MockenvStackSetClient)
+		wantedError error
+	}{
+		"no-op if the stack set doesn't exist": {
+			setupMocks: func(m *mocks.MockenvStackSetClient) {
+				m.EXPECT().Describe(testStackSetName).Return(stackset.Description{}, &stackset.ErrStackSetNotFound{})
+			},
+		},
+		"no-op if the environment stack is not an instance of the stack set": {
+			setupMocks: func(m *mocks.MockenvStackSetClient) {
+				m.EXPECT().Describe(testStackSetName).Return(stackset.Description{}, nil)
+				m.EXPECT().InstanceSummaries(testStackSetName, gomock.Any(), gomock.Any()).Return([]stackset.InstanceSummary{
+					{
+						StackID: "arn:aws:cloudformation:us-west-2:1234:stack/phonetool-other/2",
+					},
+				}, nil)
+			},
+		},
+		"error if fails to delete the stack instance": {
+			setupMocks: func(m *mocks.MockenvStackSetClient) {
+				m.EXPECT().Describe(testStackSetName).Return(stackset.Description{}, nil)
+				m.EXPECT().InstanceSummaries(testStackSetName, gomock.Any(), gomock.Any()).Return([]stackset.InstanceSummary{testInstance}, nil)
+				m.EXPECT().DeleteInstancesAndWait(testStackSetName, []string{"1234"}, []string{"us-west-2"}, true).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"keeps the stack set if other environments are instances of it": {
+			setupMocks: func(m *mocks.MockenvStackSetClient) {
+				m.EXPECT().Describe(testStackSetName).Return(stackset.Description{}, nil)
+				m.EXPECT().InstanceSummaries(testStackSetName, gomock.Any(), gomock.Any()).Return([]stackset.InstanceSummary{testInstance}, nil)
+				m.EXPECT().DeleteInstancesAndWait(testStackSetName, []string{"1234"}, []string{"us-west-2"}, true).Return(nil)
+				m.EXPECT().InstanceSummaries(testStackSetName).Return([]stackset.InstanceSummary{
+					{
+						StackID: "arn:aws:cloudformation:eu-west-1:5678:stack/phonetool-prod/3",
+					},
+				}, nil)
+			},
+		},
+		"deletes the stack set once it has no instances left": {
+			setupMocks: func(m *mocks.MockenvStackSetClient) {
+				m.EXPECT().Describe(testStackSetName).Return(stackset.Description{}, nil)
+				gomock.InOrder(
+					m.EXPECT().InstanceSummaries(testStackSetName, gomock.Any(), gomock.Any()).Return([]stackset.InstanceSummary{testInstance}, nil),
+					m.EXPECT().DeleteInstancesAndWait(testStackSetName, []string{"1234"}, []string{"us-west-2"}, true).Return(nil),
+					m.EXPECT().InstanceSummaries(testStackSetName).Return(nil, nil),
+					m.EXPECT().Delete(testStackSetName).Return(nil),
+				)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockenvStackSetClient(ctrl)
+			tc.setupMocks(m)
+			ss := &EnvStackSet{
+				app: &config.Application{
+					Name: "phonetool",
+				},
+				stackSet: m,
+			}
+
+			// WHEN
+			err := ss.DetachEnvironment(testEnv)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package cloudformation
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -47,6 +48,68 @@ func TestCloudFormation_EnvironmentTemplate(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_EnvironmentDrift(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName string
+		inEnvName string
+		inClient  func(ctrl *gomock.Controller) *mocks.MockcfnClient
+
+		wantedDrifts []*cloudformation.StackResourceDrift
+		wantedErr    error
+	}{
+		"should return the error from detecting drift": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().DetectStackDrift(gomock.Any(), "phonetool-test").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"should return the drifted resources of the environment stack": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().DetectStackDrift(gomock.Any(), "phonetool-test").Return([]*cloudformation.StackResourceDrift{
+					{
+						LogicalResourceId: aws.String("PublicLoadBalancer"),
+					},
+				}, nil)
+				return m
+			},
+			wantedDrifts: []*cloudformation.StackResourceDrift{
+				{
+					LogicalResourceId: aws.String("PublicLoadBalancer"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := &CloudFormation{
+				cfnClient: tc.inClient(ctrl),
+			}
+
+			// WHEN
+			drifts, err := cf.EnvironmentDrift(tc.inAppName, tc.inEnvName)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDrifts, drifts)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_EnvironmentParameters(t *testing.T) {
 	testCases := map[string]struct {
 		inAppName string
@@ -188,3 +251,218 @@ func TestCloudFormation_UpdateEnvironmentTemplate(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudFormation_RollbackAndRenderEnvironment(t *testing.T) {
+	prevParams := []*awscfn.Parameter{
+		{
+			ParameterKey:   aws.String("AppName"),
+			ParameterValue: aws.String("phonetool"),
+		},
+		{
+			ParameterKey:   aws.String("ALBWorkloads"),
+			ParameterValue: aws.String(""),
+		},
+	}
+	testCases := map[string]struct {
+		inClient func(t *testing.T, ctrl *gomock.Controller) *mocks.MockcfnClient
+
+		wantedError error
+	}{
+		"wraps error if describe fails": {
+			inClient: func(t *testing.T, ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New("describe stack phonetool-test: some error"),
+		},
+		"returns an error if the stack was never created successfully": {
+			inClient: func(t *testing.T, ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String(awscfn.StackStatusRollbackComplete),
+				}, nil)
+				m.EXPECT().Update(gomock.Any()).Times(0)
+				return m
+			},
+			wantedError: &ErrStackRollbackComplete{
+				StackName: "phonetool-test",
+			},
+		},
+		"updates the stack to the previous template while keeping the env controller managed parameters": {
+			inClient: func(t *testing.T, ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				tags := []*awscfn.Tag{
+					{
+						Key:   aws.String("copilot-application"),
+						Value: aws.String("phonetool"),
+					},
+				}
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String(awscfn.StackStatusUpdateComplete),
+					Parameters: []*awscfn.Parameter{
+						{
+							ParameterKey:   aws.String("AppName"),
+							ParameterValue: aws.String("phonetool"),
+						},
+						{
+							ParameterKey:   aws.String("ALBWorkloads"),
+							ParameterValue: aws.String("frontend"),
+						},
+					},
+					Tags: tags,
+				}, nil)
+				m.EXPECT().Update(gomock.Any()).DoAndReturn(func(s *cloudformation.Stack) (string, error) {
+					require.Equal(t, "phonetool-test", s.Name)
+					require.Equal(t, "https://mockBucket.s3.us-west-2.amazonaws.com/template.yml", s.TemplateURL)
+					require.ElementsMatch(t, []*awscfn.Parameter{
+						{
+							ParameterKey:   aws.String("AppName"),
+							ParameterValue: aws.String("phonetool"),
+						},
+						{
+							ParameterKey:     aws.String("ALBWorkloads"),
+							UsePreviousValue: aws.Bool(true),
+						},
+					}, s.Parameters)
+					require.Equal(t, tags, s.Tags)
+					require.Equal(t, aws.String("arn"), s.RoleARN)
+					return "", errors.New("some error")
+				})
+				return m
+			},
+			wantedError: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := &CloudFormation{
+				cfnClient: tc.inClient(t, ctrl),
+			}
+
+			// WHEN
+			err := cf.RollbackAndRenderEnvironment(mockFileWriter{Writer: new(strings.Builder)}, "phonetool", "test",
+				"https://mockBucket.s3.us-west-2.amazonaws.com/template.yml", prevParams, "arn")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_RenderEnvironmentUpdate(t *testing.T) {
+	testCases := map[string]struct {
+		inClient func(ctrl *gomock.Controller) *mocks.MockcfnClient
+
+		wantedErr error
+	}{
+		"should return the error from describing the stack": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("describe stack phonetool-test: some error"),
+		},
+		"should return nil if the last update completed successfully": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String(awscfn.StackStatusUpdateComplete),
+				}, nil).Times(2)
+				return m
+			},
+		},
+		"should return an error if the last update failed": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String(awscfn.StackStatusUpdateRollbackComplete),
+				}, nil).Times(2)
+				return m
+			},
+			wantedErr: errors.New("stack phonetool-test did not complete successfully and exited with status UPDATE_ROLLBACK_COMPLETE"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := &CloudFormation{
+				cfnClient: tc.inClient(ctrl),
+			}
+
+			// WHEN
+			err := cf.RenderEnvironmentUpdate(nil, "phonetool", "test")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_EnvironmentChangeSet(t *testing.T) {
+	testCases := map[string]struct {
+		inClient func(ctrl *gomock.Controller) *mocks.MockcfnClient
+
+		wanted    *cloudformation.ChangeSetDescription
+		wantedErr error
+	}{
+		"should return a wrapped error if the change set cannot be described": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().DescribeChangeSet("mockChangeSetID", "phonetool-test").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("describe change set mockChangeSetID for stack phonetool-test: some error"),
+		},
+		"should return the description of the change set": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().DescribeChangeSet("mockChangeSetID", "phonetool-test").Return(&cloudformation.ChangeSetDescription{
+					ExecutionStatus: awscfn.ExecutionStatusAvailable,
+				}, nil)
+				return m
+			},
+			wanted: &cloudformation.ChangeSetDescription{
+				ExecutionStatus: awscfn.ExecutionStatusAvailable,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := &CloudFormation{
+				cfnClient: tc.inClient(ctrl),
+			}
+
+			// WHEN
+			got, err := cf.EnvironmentChangeSet("phonetool", "test", "mockChangeSetID")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: ./internal/pkg/deploy/cloudformation/cloudformation.go
+// Source: ./deploy/cloudformation/cloudformation.go
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -202,6 +202,21 @@ func (mr *MockcfnClientMockRecorder) CreateAndWait(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAndWait", reflect.TypeOf((*MockcfnClient)(nil).CreateAndWait), arg0)
 }
 
+// CreateChangeSet mocks base method.
+func (m *MockcfnClient) CreateChangeSet(arg0 *cloudformation0.Stack) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChangeSet", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChangeSet indicates an expected call of CreateChangeSet.
+func (mr *MockcfnClientMockRecorder) CreateChangeSet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChangeSet", reflect.TypeOf((*MockcfnClient)(nil).CreateChangeSet), arg0)
+}
+
 // Delete mocks base method.
 func (m *MockcfnClient) Delete(stackName string) error {
 	m.ctrl.T.Helper()
@@ -289,6 +304,21 @@ func (mr *MockcfnClientMockRecorder) DescribeStackEvents(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStackEvents", reflect.TypeOf((*MockcfnClient)(nil).DescribeStackEvents), arg0)
 }
 
+// DetectStackDrift mocks base method.
+func (m *MockcfnClient) DetectStackDrift(ctx context.Context, name string) ([]*cloudformation0.StackResourceDrift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectStackDrift", ctx, name)
+	ret0, _ := ret[0].([]*cloudformation0.StackResourceDrift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectStackDrift indicates an expected call of DetectStackDrift.
+func (mr *MockcfnClientMockRecorder) DetectStackDrift(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectStackDrift", reflect.TypeOf((*MockcfnClient)(nil).DetectStackDrift), ctx, name)
+}
+
 // ErrorEvents mocks base method.
 func (m *MockcfnClient) ErrorEvents(stackName string) ([]cloudformation0.StackEvent, error) {
 	m.ctrl.T.Helper()
@@ -700,3 +730,141 @@ func (mr *MockstackSetClientMockRecorder) WaitForStackSetLastOperationComplete(n
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForStackSetLastOperationComplete", reflect.TypeOf((*MockstackSetClient)(nil).WaitForStackSetLastOperationComplete), name)
 }
+
+// MockenvStackSetClient is a mock of envStackSetClient interface.
+type MockenvStackSetClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvStackSetClientMockRecorder
+}
+
+// MockenvStackSetClientMockRecorder is the mock recorder for MockenvStackSetClient.
+type MockenvStackSetClientMockRecorder struct {
+	mock *MockenvStackSetClient
+}
+
+// NewMockenvStackSetClient creates a new mock instance.
+func NewMockenvStackSetClient(ctrl *gomock.Controller) *MockenvStackSetClient {
+	mock := &MockenvStackSetClient{ctrl: ctrl}
+	mock.recorder = &MockenvStackSetClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvStackSetClient) EXPECT() *MockenvStackSetClientMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockenvStackSetClient) Create(name, template string, opts ...stackset.CreateOrUpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name, template}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Create", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockenvStackSetClientMockRecorder) Create(name, template interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name, template}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockenvStackSetClient)(nil).Create), varargs...)
+}
+
+// Delete mocks base method.
+func (m *MockenvStackSetClient) Delete(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockenvStackSetClientMockRecorder) Delete(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockenvStackSetClient)(nil).Delete), name)
+}
+
+// DeleteInstancesAndWait mocks base method.
+func (m *MockenvStackSetClient) DeleteInstancesAndWait(name string, accounts, regions []string, retainStacks bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInstancesAndWait", name, accounts, regions, retainStacks)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteInstancesAndWait indicates an expected call of DeleteInstancesAndWait.
+func (mr *MockenvStackSetClientMockRecorder) DeleteInstancesAndWait(name, accounts, regions, retainStacks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstancesAndWait", reflect.TypeOf((*MockenvStackSetClient)(nil).DeleteInstancesAndWait), name, accounts, regions, retainStacks)
+}
+
+// Describe mocks base method.
+func (m *MockenvStackSetClient) Describe(name string) (stackset.Description, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe", name)
+	ret0, _ := ret[0].(stackset.Description)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockenvStackSetClientMockRecorder) Describe(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockenvStackSetClient)(nil).Describe), name)
+}
+
+// ImportStacksAndWait mocks base method.
+func (m *MockenvStackSetClient) ImportStacksAndWait(name string, stackIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportStacksAndWait", name, stackIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportStacksAndWait indicates an expected call of ImportStacksAndWait.
+func (mr *MockenvStackSetClientMockRecorder) ImportStacksAndWait(name, stackIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportStacksAndWait", reflect.TypeOf((*MockenvStackSetClient)(nil).ImportStacksAndWait), name, stackIDs)
+}
+
+// InstanceSummaries mocks base method.
+func (m *MockenvStackSetClient) InstanceSummaries(name string, opts ...stackset.InstanceSummariesOption) ([]stackset.InstanceSummary, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "InstanceSummaries", varargs...)
+	ret0, _ := ret[0].([]stackset.InstanceSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstanceSummaries indicates an expected call of InstanceSummaries.
+func (mr *MockenvStackSetClientMockRecorder) InstanceSummaries(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceSummaries", reflect.TypeOf((*MockenvStackSetClient)(nil).InstanceSummaries), varargs...)
+}
+
+// UpdateAndWait mocks base method.
+func (m *MockenvStackSetClient) UpdateAndWait(name, template string, opts ...stackset.CreateOrUpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name, template}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAndWait", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAndWait indicates an expected call of UpdateAndWait.
+func (mr *MockenvStackSetClientMockRecorder) UpdateAndWait(name, template interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name, template}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAndWait", reflect.TypeOf((*MockenvStackSetClient)(nil).UpdateAndWait), varargs...)
+}
@@ -0,0 +1,31 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"errors"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/progress"
+)
+
+// DeployRemoteBuildProject deploys the stack of the CodeBuild project that builds the images of an application remotely,
+// and renders the deployment to out until it is done.
+// If the stack doesn't exist, then it creates the stack.
+// If the stack already exists, it updates the stack.
+// If the stack doesn't have any changes, it returns nil.
+func (cf CloudFormation) DeployRemoteBuildProject(out progress.FileWriter, app, bucket string) error {
+	s, err := toStack(stack.NewRemoteBuildStackConfig(app, bucket))
+	if err != nil {
+		return err
+	}
+	if err := cf.renderStackChanges(cf.newRenderWorkloadInput(out, s)); err != nil {
+		var errChangeSetEmpty *cloudformation.ErrChangeSetEmpty
+		if !errors.As(err, &errChangeSetEmpty) {
+			return err
+		}
+	}
+	return nil
+}
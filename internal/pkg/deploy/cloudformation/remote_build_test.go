@@ -0,0 +1,29 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/progress"
+)
+
+func TestCloudFormation_DeployRemoteBuildProject(t *testing.T) {
+	when := func(w progress.FileWriter, cf CloudFormation) error {
+		return cf.DeployRemoteBuildProject(w, "phonetool", "mockBucket")
+	}
+
+	t.Run("returns a wrapped error if creating a change set fails", func(t *testing.T) {
+		testDeployTask_OnCreateChangeSetFailure(t, when)
+	})
+	t.Run("calls Update if stack is already created and returns wrapped error if Update fails", func(t *testing.T) {
+		testDeployTask_OnUpdateChangeSetFailure(t, when)
+	})
+	t.Run("returns nil if the change set is empty when calling Update", func(t *testing.T) {
+		testDeployTask_ReturnNilOnEmptyChangeSetWhileUpdatingStack(t, when)
+	})
+	t.Run("returns an error if stack creation fails", func(t *testing.T) {
+		testDeployTask_StreamUntilStackCreationFails(t, "phonetool-remote-build", when)
+	})
+}
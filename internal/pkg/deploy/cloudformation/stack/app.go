@@ -27,10 +27,15 @@ type DeployedAppMetadata struct {
 // AppResourcesConfig is a configuration for a deployed Application
 // StackSet.
 type AppResourcesConfig struct {
-	Accounts []string `yaml:"Accounts,flow"`
-	Services []string `yaml:"Services,flow"`
-	App      string   `yaml:"App"`
-	Version  int      `yaml:"Version"`
+	Accounts         []string `yaml:"Accounts,flow"`
+	Services         []string `yaml:"Services,flow"`
+	App              string   `yaml:"App"`
+	Version          int      `yaml:"Version"`
+	SharedRepository bool     `yaml:"SharedRepository,omitempty"` // If true, all workloads store their images in a single ECR repository.
+
+	// RetainServiceRepositories keeps the per-workload ECR repositories with a "Retain" deletion policy
+	// alongside the shared repository while the images of an application are migrated. It's never persisted.
+	RetainServiceRepositories bool `yaml:"-"`
 }
 
 // AppStackConfig is for providing all the values to set up an
@@ -42,10 +47,42 @@ type AppStackConfig struct {
 
 // AppRegionalResources represent application resources that are regional.
 type AppRegionalResources struct {
-	Region         string            // The region these resources are in.
-	KMSKeyARN      string            // A KMS Key ARN for encrypting Pipeline artifacts.
-	S3Bucket       string            // A bucket used for any Copilot artifacts that must be stored in S3 (pipelines, env files, etc).
-	RepositoryURLs map[string]string // The image repository URLs by service name.
+	Region              string            // The region these resources are in.
+	StackID             string            // The ID of the stack set instance that holds these resources.
+	KMSKeyARN           string            // A KMS Key ARN for encrypting Pipeline artifacts.
+	S3Bucket            string            // A bucket used for any Copilot artifacts that must be stored in S3 (pipelines, env files, etc).
+	RepositoryURLs      map[string]string // The image repository URLs by service name.
+	SharedRepositoryURL string            // The URL of the image repository shared by all services. Empty if the application doesn't share a repository.
+}
+
+// WorkloadRepositoryURL returns the URL of the image repository of a workload.
+func (r *AppRegionalResources) WorkloadRepositoryURL(name string) string {
+	if r.SharedRepositoryURL != "" {
+		return r.SharedRepositoryURL
+	}
+	return r.RepositoryURLs[name]
+}
+
+// WorkloadImageTag returns the tag of a workload's image in its repository.
+// Tags are prefixed by the workload name in a shared repository so that images of different workloads don't collide.
+func (r *AppRegionalResources) WorkloadImageTag(name, tag string) string {
+	if r.SharedRepositoryURL == "" || tag == "" {
+		return tag
+	}
+	return SharedRepositoryImageTag(name, tag)
+}
+
+// NameForWorkloadRepository returns the name of the ECR repository that stores the images of a workload.
+func NameForWorkloadRepository(app, name string, shared bool) string {
+	if shared {
+		return app
+	}
+	return fmt.Sprintf("%s/%s", app, name)
+}
+
+// SharedRepositoryImageTag returns the tag of a workload's image in the repository shared by an application.
+func SharedRepositoryImageTag(name, tag string) string {
+	return fmt.Sprintf("%s-%s", name, tag)
 }
 
 const (
@@ -57,6 +94,7 @@ const (
 	appOutputKMSKey               = "KMSKeyARN"      // Name of the CloudFormation Output that holds the KMS Key ARN to encrypt artifact buckets.
 	appOutputS3Bucket             = "PipelineBucket" // Name of the CloudFormation Output that holds the Artifact Bucket name.
 	appOutputECRRepoPrefix        = "ECRRepo"        // Prefix of the CloudFormation Output name that holds the ECR image repository ARN for each service.
+	appOutputSharedECRRepo        = "SharedECRRepo"  // Name of the CloudFormation Output that holds the ARN of the ECR image repository shared by all services.
 	appDNSDelegatedAccountsKey    = "AppDNSDelegatedAccounts"
 	appDomainNameKey              = "AppDomainName"
 	appDomainHostedZoneIDKey      = "AppDomainHostedZoneID"
@@ -233,6 +271,12 @@ func ToAppRegionalResources(stack *cloudformation.Stack) (*AppRegionalResources,
 			regionalResources.KMSKeyARN = value
 		case key == appOutputS3Bucket:
 			regionalResources.S3Bucket = value
+		case key == appOutputSharedECRRepo:
+			uri, err := ecr.URIFromARN(value)
+			if err != nil {
+				return nil, err
+			}
+			regionalResources.SharedRepositoryURL = uri
 		case strings.HasPrefix(key, appOutputECRRepoPrefix):
 			// If the output starts with the ECR Repo Prefix,
 			// we'll pull the ARN out and construct a URL from it.
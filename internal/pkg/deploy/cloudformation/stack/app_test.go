@@ -276,6 +276,22 @@ func TestToRegionalResources(t *testing.T) {
 				},
 			},
 		},
+		"should generate the shared repository URL": {
+			givenStackOutputs: map[string]string{
+				appOutputKMSKey:       "arn:aws:kms:us-west-2:01234567890:key/0000",
+				appOutputS3Bucket:     "tests3-bucket-us-west-2",
+				"SharedECRRepo":       "arn:aws:ecr:us-west-2:0123456789:repository/app",
+				"ECRRepofrontDASHend": "arn:aws:ecr:us-west-2:0123456789:repository/app/front-end",
+			},
+			wantedResource: AppRegionalResources{
+				KMSKeyARN: "arn:aws:kms:us-west-2:01234567890:key/0000",
+				S3Bucket:  "tests3-bucket-us-west-2",
+				RepositoryURLs: map[string]string{
+					"front-end": "0123456789.dkr.ecr.us-west-2.amazonaws.com/app/front-end",
+				},
+				SharedRepositoryURL: "0123456789.dkr.ecr.us-west-2.amazonaws.com/app",
+			},
+		},
 		"should return error when no bucket exists": {
 			givenStackOutputs: map[string]string{
 				appOutputKMSKey:       "arn:aws:kms:us-west-2:01234567890:key/0000",
@@ -308,6 +324,47 @@ func TestToRegionalResources(t *testing.T) {
 	}
 }
 
+func TestAppRegionalResources_WorkloadRepository(t *testing.T) {
+	testCases := map[string]struct {
+		given AppRegionalResources
+
+		wantedURL string
+		wantedTag string
+	}{
+		"per-workload repository": {
+			given: AppRegionalResources{
+				RepositoryURLs: map[string]string{
+					"front-end": "0123456789.dkr.ecr.us-west-2.amazonaws.com/app/front-end",
+				},
+			},
+			wantedURL: "0123456789.dkr.ecr.us-west-2.amazonaws.com/app/front-end",
+			wantedTag: "v1",
+		},
+		"shared repository": {
+			given: AppRegionalResources{
+				RepositoryURLs: map[string]string{
+					"front-end": "0123456789.dkr.ecr.us-west-2.amazonaws.com/app/front-end",
+				},
+				SharedRepositoryURL: "0123456789.dkr.ecr.us-west-2.amazonaws.com/app",
+			},
+			wantedURL: "0123456789.dkr.ecr.us-west-2.amazonaws.com/app",
+			wantedTag: "front-end-v1",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedURL, tc.given.WorkloadRepositoryURL("front-end"))
+			require.Equal(t, tc.wantedTag, tc.given.WorkloadImageTag("front-end", "v1"))
+			require.Equal(t, "", tc.given.WorkloadImageTag("front-end", ""))
+		})
+	}
+}
+
+func TestNameForWorkloadRepository(t *testing.T) {
+	require.Equal(t, "app/front-end", NameForWorkloadRepository("app", "front-end", false))
+	require.Equal(t, "app", NameForWorkloadRepository("app", "front-end", true))
+}
+
 func TestDNSDelegatedAccountsForStack(t *testing.T) {
 	testCases := map[string]struct {
 		given map[string]string
@@ -389,12 +446,14 @@ Metadata:
   - testsvc2
   Accounts:
   - 0000000000
+  SharedRepository: true
 `
 	config, err := AppConfigFrom(&given)
 	require.NoError(t, err)
 	require.Equal(t, AppResourcesConfig{
-		Accounts: []string{"0000000000"},
-		Version:  7,
-		Services: []string{"testsvc1", "testsvc2"},
+		Accounts:         []string{"0000000000"},
+		Version:          7,
+		Services:         []string{"testsvc1", "testsvc2"},
+		SharedRepository: true,
 	}, *config)
 }
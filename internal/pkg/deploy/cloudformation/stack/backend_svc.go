@@ -148,11 +148,13 @@ func (s *BackendService) Template() (string, error) {
 		allowedSourceIPs = append(allowedSourceIPs, string(ipNet))
 	}
 
-	content, err := s.parser.ParseBackendService(template.WorkloadOpts{
+	opts := template.WorkloadOpts{
 		AppName:            s.app,
 		EnvName:            s.env,
 		WorkloadName:       s.name,
+		ResourcePrefix:     s.rc.ResourcePrefix,
 		SerializedManifest: string(s.rawManifest),
+		DeploymentMetadata: s.rc.deploymentMetadataOpts(),
 
 		Variables:                s.manifest.BackendServiceConfig.Variables,
 		Secrets:                  convertSecrets(s.manifest.BackendServiceConfig.Secrets),
@@ -168,29 +170,39 @@ func (s *BackendService) Template() (string, error) {
 		ExecuteCommand:           convertExecuteCommand(&s.manifest.ExecuteCommand),
 		WorkloadType:             manifest.BackendServiceType,
 		HealthCheck:              convertContainerHealthCheck(s.manifest.BackendServiceConfig.ImageConfig.HealthCheck),
-		HTTPHealthCheck:          convertHTTPHealthCheck(&s.manifest.RoutingRule.HealthCheck),
+		HTTPHealthCheck:          convertHTTPHealthCheck(&s.manifest.RoutingRule.HealthCheck, s.manifest.BackendServiceConfig.ImageConfig.HealthCheck),
 		DeregistrationDelay:      deregistrationDelay,
 		AllowedSourceIps:         allowedSourceIPs,
+		HTTPTargetProtocol:       strings.ToUpper(aws.StringValue(s.manifest.RoutingRule.TargetProtocol)),
+		PrivateCAARN:             aws.StringValue(s.manifest.RoutingRule.PrivateCA),
 		CustomResources:          crs,
 		LogConfig:                convertLogging(s.manifest.Logging),
+		LogGroup:                 convertLogGroup(s.manifest.Logging, s.logGroupName()),
 		DockerLabels:             s.manifest.ImageConfig.Image.DockerLabels,
 		Storage:                  convertStorageOpts(s.manifest.Name, s.manifest.Storage),
-		Network:                  convertNetworkConfig(s.manifest.Network),
-		DeploymentConfiguration:  convertDeploymentConfig(s.manifest.DeployConfig),
+		Network:                  convertNetworkConfig(s.manifest.Network, s.rc.PlacementSubnetIDs),
+		DeploymentConfiguration:  convertDeploymentConfig(s.manifest.DeployConfig, s.manifest.Alarms),
+		Alarms:                   convertAlarms(s.manifest.Alarms),
+		ServiceDiscovery:         convertServiceDiscovery(s.manifest.Network.ServiceDiscovery),
 		EntryPoint:               entrypoint,
 		Command:                  command,
 		DependsOn:                convertDependsOn(s.manifest.ImageConfig.Image.DependsOn),
 		CredentialsParameter:     aws.StringValue(s.manifest.ImageConfig.Image.Credentials),
 		ServiceDiscoveryEndpoint: s.rc.ServiceDiscoveryEndpoint,
 		Publish:                  publishers,
-		Platform:                 convertPlatform(s.manifest.Platform),
+		Evidently:                convertEvidently(s.manifest.Evidently, s.app, s.env, s.name),
+		Platform:                 convertPlatform(s.manifest.Platform, s.manifest.PlatformVersion),
 		HTTPVersion:              convertHTTPVersion(s.manifest.RoutingRule.ProtocolVersion),
 		ALBEnabled:               s.albEnabled,
 		Observability: template.ObservabilityOpts{
 			Tracing: strings.ToUpper(aws.StringValue(s.manifest.Observability.Tracing)),
 		},
 		HostedZoneAliases: hostedZoneAliases,
-	})
+	}
+	if err := validateEnvVars(opts); err != nil {
+		return "", err
+	}
+	content, err := s.parser.ParseBackendService(opts)
 	if err != nil {
 		return "", fmt.Errorf("parse backend service template: %w", err)
 	}
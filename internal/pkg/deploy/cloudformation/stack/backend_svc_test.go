@@ -273,6 +273,8 @@ Outputs:
 					Stickiness:          aws.Bool(true),
 					DeregistrationDelay: (*time.Duration)(aws.Int64(int64(59 * time.Second))),
 					AllowedSourceIps:    []manifest.IPNet{"10.0.1.0/24"},
+					TargetProtocol:      aws.String("https"),
+					PrivateCA:           aws.String("arn:aws:acm-pca:us-west-2:123456789012:certificate-authority/mock-ca"),
 				}
 				svc.albEnabled = true
 			},
@@ -304,6 +306,8 @@ Outputs:
 						HostedZoneAliases:   make(template.AliasesForHostedZone),
 						DeregistrationDelay: aws.Int64(59),
 						AllowedSourceIps:    []string{"10.0.1.0/24"},
+						HTTPTargetProtocol:  "HTTPS",
+						PrivateCAARN:        "arn:aws:acm-pca:us-west-2:123456789012:certificate-authority/mock-ca",
 						CustomResources: map[string]template.S3ObjectLocation{
 							"EnvControllerFunction": {
 								Bucket: "my-bucket",
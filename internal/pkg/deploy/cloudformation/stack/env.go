@@ -43,6 +43,7 @@ const (
 	envParamCreateHTTPSListenerKey         = "CreateHTTPSListener"
 	envParamCreateInternalHTTPSListenerKey = "CreateInternalHTTPSListener"
 	EnvParamServiceDiscoveryEndpoint       = "ServiceDiscoveryEndpoint"
+	envParamForceUpdateIDKey               = "ForceUpdateID"
 
 	// Output keys.
 	EnvOutputVPCID               = "VpcId"
@@ -88,16 +89,22 @@ func (e *EnvStackConfig) Template() (string, error) {
 	content, err := e.parser.ParseEnv(&template.EnvOpts{
 		AppName:                  e.in.App.Name,
 		EnvName:                  e.in.Name,
+		ResourcePrefix:           e.in.App.ResourcePrefix,
 		CustomResources:          crs,
 		ArtifactBucketARN:        e.in.ArtifactBucketARN,
 		ArtifactBucketKeyARN:     e.in.ArtifactBucketKeyARN,
 		PublicImportedCertARNs:   e.importPublicCertARNs(),
 		PrivateImportedCertARNs:  e.importPrivateCertARNs(),
+		PublicListeners:          e.publicListeners(),
+		PublicMutualTLS:          e.publicMutualTLS(),
 		VPCConfig:                e.vpcConfig(),
 		CustomInternalALBSubnets: e.internalALBSubnets(),
 		AllowVPCIngress:          e.in.AllowVPCIngress, // TODO(jwh): fetch AllowVPCIngress from Manifest or SSM.
 		Telemetry:                e.telemetryConfig(),
+		ImportedCluster:          e.importedCluster(),
+		SharedEFS:                e.sharedEFS(),
 		CDNConfig:                e.cdnConfig(),
+		ServiceConnect:           e.serviceConnect(),
 
 		Version:            e.in.Version,
 		LatestVersion:      deploy.LatestEnvTemplateVersion,
@@ -175,13 +182,22 @@ func (e *EnvStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
 			ParameterKey:   aws.String(envParamNATWorkloadsKey),
 			ParameterValue: aws.String(""),
 		},
+		{
+			ParameterKey:   aws.String(envParamForceUpdateIDKey),
+			ParameterValue: aws.String(e.in.ForceUpdateID),
+		},
 	}
 	if e.prevParams == nil {
 		return currParams, nil
 	}
 	// If we're creating a stack configuration for an existing environment stack, ensure the previous env controller
-	// managed parameters are using the previous value.
-	return e.transformParameters(currParams, e.prevParams, transformEnvControllerParameters)
+	// managed parameters and the force update ID are using the previous value.
+	return e.transformParameters(currParams, e.prevParams, func(new cloudformation.Parameter, old *cloudformation.Parameter) *cloudformation.Parameter {
+		if aws.StringValue(new.ParameterKey) == envParamForceUpdateIDKey {
+			return transformForceUpdateIDParameter(new, old)
+		}
+		return transformEnvControllerParameters(new, old)
+	})
 }
 
 // SerializedParameters returns the CloudFormation stack's parameters serialized to a JSON document.
@@ -260,6 +276,18 @@ func transformEnvControllerParameters(new cloudformation.Parameter, old *cloudfo
 	}
 }
 
+// transformForceUpdateIDParameter keeps the previous force update ID unless a new one is set,
+// so that the stack and its custom resources are only updated when an update is forced.
+func transformForceUpdateIDParameter(new cloudformation.Parameter, old *cloudformation.Parameter) *cloudformation.Parameter {
+	if old == nil || aws.StringValue(new.ParameterValue) != "" {
+		return &new
+	}
+	return &cloudformation.Parameter{
+		ParameterKey:   new.ParameterKey,
+		ParameterValue: old.ParameterValue,
+	}
+}
+
 // NewBootstrapEnvStackConfig sets up a BootstrapEnvStackConfig struct.
 func NewBootstrapEnvStackConfig(input *deploy.CreateEnvironmentInput) *BootstrapEnvStackConfig {
 	return &BootstrapEnvStackConfig{
@@ -274,6 +302,7 @@ type BootstrapEnvStackConfig EnvStackConfig
 // Template returns the CloudFormation template to bootstrap environment resources.
 func (e *BootstrapEnvStackConfig) Template() (string, error) {
 	content, err := e.parser.ParseEnvBootstrap(&template.EnvOpts{
+		ResourcePrefix:       e.in.App.ResourcePrefix,
 		ArtifactBucketARN:    e.in.ArtifactBucketARN,
 		ArtifactBucketKeyARN: e.in.ArtifactBucketKeyARN,
 	})
@@ -345,13 +374,28 @@ func (e *EnvStackConfig) cdnConfig() *template.CDNConfig {
 	return nil // no-op - return &template.CDNConfig{} when feature is ready
 }
 
+func (e *EnvStackConfig) serviceConnect() bool {
+	if e.in.Mft == nil {
+		return false
+	}
+	return e.in.Mft.Features.ServiceConnectEnabled()
+}
+
 func (e *EnvStackConfig) vpcConfig() template.VPCConfig {
 	return template.VPCConfig{
-		Imported: e.importVPC(),
-		Managed:  e.managedVPC(),
+		Imported:    e.importVPC(),
+		Managed:     e.managedVPC(),
+		Attachments: e.vpcAttachments(),
 	}
 }
 
+func (e *EnvStackConfig) vpcAttachments() []template.VPCAttachment {
+	if e.in.Mft == nil {
+		return nil
+	}
+	return e.in.Mft.Network.VPC.VPCAttachments()
+}
+
 func (e *EnvStackConfig) importVPC() *template.ImportVPC {
 	// If a manifest is present, it is the only place we look at.
 	if e.in.Mft != nil {
@@ -395,6 +439,20 @@ func (e *EnvStackConfig) managedVPC() template.ManagedVPC {
 	}
 }
 
+func (e *EnvStackConfig) importedCluster() string {
+	if e.in.Mft == nil {
+		return ""
+	}
+	return e.in.Mft.Cluster.ImportedClusterName()
+}
+
+func (e *EnvStackConfig) sharedEFS() bool {
+	if e.in.Mft == nil {
+		return false
+	}
+	return e.in.Mft.Storage.SharedEFS()
+}
+
 func (e *EnvStackConfig) telemetryConfig() *template.Telemetry {
 	// If a manifest is present, it is the only place we look at.
 	if e.in.Mft != nil {
@@ -427,6 +485,42 @@ func (e *EnvStackConfig) importPublicCertARNs() []string {
 	return e.in.ImportCertARNs
 }
 
+func (e *EnvStackConfig) publicListeners() []template.PublicListener {
+	// Additional listeners can only be configured with a manifest.
+	if e.in.Mft == nil {
+		return nil
+	}
+	var listeners []template.PublicListener
+	for _, l := range e.in.Mft.HTTPConfig.Public.AdditionalListeners {
+		listeners = append(listeners, template.PublicListener{
+			Name:            aws.StringValue(l.Name),
+			Port:            aws.Uint16Value(l.Port),
+			Protocol:        l.Protocol(),
+			CertificateARNs: l.Certificates,
+			DefaultAction: template.ListenerDefaultAction{
+				FixedResponseStatusCode: aws.IntValue(l.DefaultAction.FixedResponse),
+				RedirectPort:            aws.Uint16Value(l.DefaultAction.Redirect.Port),
+				RedirectProtocol:        aws.StringValue(l.DefaultAction.Redirect.Protocol),
+			},
+		})
+	}
+	return listeners
+}
+
+func (e *EnvStackConfig) publicMutualTLS() *template.MutualTLS {
+	// Mutual TLS can only be configured with a manifest.
+	if e.in.Mft == nil || e.in.Mft.HTTPConfig.Public.MutualTLS.IsEmpty() {
+		return nil
+	}
+	mtls := e.in.Mft.HTTPConfig.Public.MutualTLS
+	return &template.MutualTLS{
+		Mode:                          mtls.ModeOrDefault(),
+		TrustStoreBucket:              aws.StringValue(mtls.TrustStore.Bucket),
+		TrustStoreKey:                 aws.StringValue(mtls.TrustStore.Key),
+		IgnoreClientCertificateExpiry: aws.BoolValue(mtls.IgnoreClientCertificateExpiry),
+	}
+}
+
 func (e *EnvStackConfig) importPrivateCertARNs() []string {
 	// If a manifest is present, it is the only place we look at.
 	if e.in.Mft != nil {
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -62,6 +63,92 @@ func TestEnv_Template(t *testing.T) {
 			},
 			expectedOutput: mockTemplate,
 		},
+		"should turn on service connect if the feature is enabled in the manifest": {
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				mft, err := manifest.UnmarshalEnvironment([]byte(`name: env
+type: Environment
+features:
+  service_connect: true
+`))
+				require.NoError(t, err)
+				e.in.Mft = mft
+
+				m := mocks.NewMockenvReadParser(ctrl)
+				m.EXPECT().ParseEnv(gomock.Any(), gomock.Any()).DoAndReturn(func(data *template.EnvOpts, options ...template.ParseOption) (*template.Content, error) {
+					require.True(t, data.ServiceConnect)
+					return &template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil
+				})
+				e.parser = m
+			},
+			expectedOutput: mockTemplate,
+		},
+		"should pass the mutual TLS configuration of the public load balancer": {
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				mft, err := manifest.UnmarshalEnvironment([]byte(`name: env
+type: Environment
+http:
+  public:
+    mutual_tls:
+      trust_store:
+        bucket: my-ca-bucket
+        key: ca-bundle.pem
+`))
+				require.NoError(t, err)
+				e.in.Mft = mft
+
+				m := mocks.NewMockenvReadParser(ctrl)
+				m.EXPECT().ParseEnv(gomock.Any(), gomock.Any()).DoAndReturn(func(data *template.EnvOpts, options ...template.ParseOption) (*template.Content, error) {
+					require.Equal(t, &template.MutualTLS{
+						Mode:             "verify",
+						TrustStoreBucket: "my-ca-bucket",
+						TrustStoreKey:    "ca-bundle.pem",
+					}, data.PublicMutualTLS)
+					return &template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil
+				})
+				e.parser = m
+			},
+			expectedOutput: mockTemplate,
+		},
+		"should pass the existing security group and route tables of an imported VPC": {
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				mft, err := manifest.UnmarshalEnvironment([]byte(`name: env
+type: Environment
+network:
+  vpc:
+    id: vpc-1234
+    subnets:
+      public:
+        - id: subnet-1
+        - id: subnet-2
+      private:
+        - id: subnet-3
+        - id: subnet-4
+    security_group: sg-1234
+    route_tables:
+      public: rtb-1
+      private:
+        - rtb-2
+        - rtb-3
+`))
+				require.NoError(t, err)
+				e.in.Mft = mft
+
+				m := mocks.NewMockenvReadParser(ctrl)
+				m.EXPECT().ParseEnv(gomock.Any(), gomock.Any()).DoAndReturn(func(data *template.EnvOpts, options ...template.ParseOption) (*template.Content, error) {
+					require.Equal(t, &template.ImportVPC{
+						ID:                   "vpc-1234",
+						PublicSubnetIDs:      []string{"subnet-1", "subnet-2"},
+						PrivateSubnetIDs:     []string{"subnet-3", "subnet-4"},
+						SecurityGroupID:      "sg-1234",
+						PublicRouteTableID:   "rtb-1",
+						PrivateRouteTableIDs: []string{"rtb-2", "rtb-3"},
+					}, data.VPCConfig.Imported)
+					return &template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil
+				})
+				e.parser = m
+			},
+			expectedOutput: mockTemplate,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -93,6 +180,8 @@ func TestEnv_Parameters(t *testing.T) {
 	deploymentInputWithDNS := mockDeployEnvironmentInput()
 	deploymentInputWithDNS.App.Domain = "ecs.aws"
 	deploymentInputWithPrivateDNS := mockDeployEnvironmentInput()
+	deploymentInputWithForceUpdate := mockDeployEnvironmentInput()
+	deploymentInputWithForceUpdate.ForceUpdateID = "mockNewForceUpdateID"
 	deploymentInputWithPrivateDNS.ImportCertARNs = []string{"arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012"}
 	testCases := map[string]struct {
 		input     *deploy.CreateEnvironmentInput
@@ -154,6 +243,10 @@ func TestEnv_Parameters(t *testing.T) {
 					ParameterKey:   aws.String(envParamCreateInternalHTTPSListenerKey),
 					ParameterValue: aws.String("false"),
 				},
+				{
+					ParameterKey:   aws.String(envParamForceUpdateIDKey),
+					ParameterValue: aws.String(""),
+				},
 			},
 		},
 		"with DNS": {
@@ -211,6 +304,10 @@ func TestEnv_Parameters(t *testing.T) {
 					ParameterKey:   aws.String(envParamCreateInternalHTTPSListenerKey),
 					ParameterValue: aws.String("false"),
 				},
+				{
+					ParameterKey:   aws.String(envParamForceUpdateIDKey),
+					ParameterValue: aws.String(""),
+				},
 			},
 		},
 		"with private DNS only": {
@@ -268,6 +365,10 @@ func TestEnv_Parameters(t *testing.T) {
 					ParameterKey:   aws.String(envParamCreateInternalHTTPSListenerKey),
 					ParameterValue: aws.String("true"),
 				},
+				{
+					ParameterKey:   aws.String(envParamForceUpdateIDKey),
+					ParameterValue: aws.String(""),
+				},
 			},
 		},
 		"should retain the values from EnvControllerParameters": {
@@ -281,6 +382,10 @@ func TestEnv_Parameters(t *testing.T) {
 					ParameterKey:   aws.String(envParamNATWorkloadsKey),
 					ParameterValue: aws.String("backend"),
 				},
+				{
+					ParameterKey:   aws.String(envParamForceUpdateIDKey),
+					ParameterValue: aws.String("mockForceUpdateID"),
+				},
 			},
 
 			want: []*cloudformation.Parameter{
@@ -336,6 +441,10 @@ func TestEnv_Parameters(t *testing.T) {
 					ParameterKey:   aws.String(envParamCreateInternalHTTPSListenerKey),
 					ParameterValue: aws.String("false"),
 				},
+				{
+					ParameterKey:   aws.String(envParamForceUpdateIDKey),
+					ParameterValue: aws.String("mockForceUpdateID"),
+				},
 			},
 		},
 		"should not include old parameters that are deleted": {
@@ -399,6 +508,78 @@ func TestEnv_Parameters(t *testing.T) {
 					ParameterKey:   aws.String(envParamCreateInternalHTTPSListenerKey),
 					ParameterValue: aws.String("false"),
 				},
+				{
+					ParameterKey:   aws.String(envParamForceUpdateIDKey),
+					ParameterValue: aws.String(""),
+				},
+			},
+		},
+		"should use the new force update ID instead of the previous one": {
+			input: deploymentInputWithForceUpdate,
+			oldParams: []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(envParamForceUpdateIDKey),
+					ParameterValue: aws.String("mockForceUpdateID"),
+				},
+			},
+
+			want: []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(envParamAppNameKey),
+					ParameterValue: aws.String(deploymentInput.App.Name),
+				},
+				{
+					ParameterKey:   aws.String(envParamEnvNameKey),
+					ParameterValue: aws.String(deploymentInput.Name),
+				},
+				{
+					ParameterKey:   aws.String(envParamToolsAccountPrincipalKey),
+					ParameterValue: aws.String(deploymentInput.App.AccountPrincipalARN),
+				},
+				{
+					ParameterKey:   aws.String(envParamAppDNSKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamAppDNSDelegationRoleKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(EnvParamAliasesKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(EnvParamALBWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamInternalALBWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamEFSWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamNATWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(EnvParamServiceDiscoveryEndpoint),
+					ParameterValue: aws.String("env.project.local"),
+				},
+				{
+					ParameterKey:   aws.String(envParamCreateHTTPSListenerKey),
+					ParameterValue: aws.String("false"),
+				},
+				{
+					ParameterKey:   aws.String(envParamCreateInternalHTTPSListenerKey),
+					ParameterValue: aws.String("false"),
+				},
+				{
+					ParameterKey:   aws.String(envParamForceUpdateIDKey),
+					ParameterValue: aws.String("mockNewForceUpdateID"),
+				},
 			},
 		},
 	}
@@ -0,0 +1,248 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/dustin/go-humanize/english"
+)
+
+// Sources of the environment variables of a container.
+const (
+	envVarSourceManifestVariables = `manifest "variables"`
+	envVarSourceManifestSecrets   = `manifest "secrets"`
+	envVarSourceAddons            = "addons outputs"
+	envVarSourceCopilot           = "Copilot"
+)
+
+const (
+	// maxTaskDefEnvVarsSize is the maximum size in bytes of an ECS task definition, which holds the environment
+	// variables and secrets of all its containers.
+	maxTaskDefEnvVarsSize = 64 * 1024
+	// maxReportedEnvVars is the maximum number of the largest environment variables reported when they don't fit in a task definition.
+	maxReportedEnvVars = 5
+
+	firelensContainerName = "firelens_log_router"
+)
+
+type envVar struct {
+	name   string
+	value  string // Empty if the value is only known once the stack is deployed.
+	source string
+}
+
+func (v envVar) size() int {
+	return len(v.name) + len(v.value)
+}
+
+type containerEnvVars struct {
+	container string
+	vars      []envVar
+}
+
+// validateEnvVars returns an error if the environment variables and secrets of the containers in the task
+// have invalid names, are defined more than once in a container, or don't fit in an ECS task definition.
+func validateEnvVars(opts template.WorkloadOpts) error {
+	containers := taskEnvVars(opts)
+	if err := validateEnvVarNames(containers); err != nil {
+		return err
+	}
+	if err := validateEnvVarsUnique(containers); err != nil {
+		return err
+	}
+	return validateEnvVarsSize(containers)
+}
+
+func validateEnvVarNames(containers []containerEnvVars) error {
+	var invalid []string
+	for _, c := range containers {
+		for _, v := range c.vars {
+			if isValidEnvVarName(v.name) && !strings.ContainsRune(v.value, 0) {
+				continue
+			}
+			invalid = append(invalid, fmt.Sprintf("%q in container %q", v.name, c.container))
+		}
+	}
+	if len(invalid) == 0 {
+		return nil
+	}
+	return fmt.Errorf(`environment variable names must not be empty or contain "=", whitespace or null characters, and values must not contain null characters: %s`,
+		strings.Join(invalid, ", "))
+}
+
+// isValidEnvVarName returns true if the container runtime can set an environment variable with the name.
+func isValidEnvVarName(name string) bool {
+	if name == "" || strings.ContainsAny(name, "=\x00") {
+		return false
+	}
+	return strings.IndexFunc(name, unicode.IsSpace) == -1
+}
+
+func validateEnvVarsUnique(containers []containerEnvVars) error {
+	var duplicates []string
+	for _, c := range containers {
+		sources := make(map[string][]string)
+		var names []string
+		for _, v := range c.vars {
+			if _, ok := sources[v.name]; !ok {
+				names = append(names, v.name)
+			}
+			sources[v.name] = append(sources[v.name], v.source)
+		}
+		for _, name := range names {
+			if len(sources[name]) < 2 {
+				continue
+			}
+			duplicates = append(duplicates, fmt.Sprintf("%q in container %q is defined by %s",
+				name, c.container, english.WordSeries(sources[name], "and")))
+		}
+	}
+	if len(duplicates) == 0 {
+		return nil
+	}
+	return fmt.Errorf("environment variables are defined more than once: %s", strings.Join(duplicates, "; "))
+}
+
+func validateEnvVarsSize(containers []containerEnvVars) error {
+	type sizedEnvVar struct {
+		container string
+		envVar
+	}
+	var total int
+	var all []sizedEnvVar
+	for _, c := range containers {
+		for _, v := range c.vars {
+			total += v.size()
+			all = append(all, sizedEnvVar{container: c.container, envVar: v})
+		}
+	}
+	if total <= maxTaskDefEnvVarsSize {
+		return nil
+	}
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].size() > all[j].size()
+	})
+	if len(all) > maxReportedEnvVars {
+		all = all[:maxReportedEnvVars]
+	}
+	largest := make([]string, len(all))
+	for i, v := range all {
+		largest[i] = fmt.Sprintf("%q in container %q (%d bytes)", v.name, v.container, v.size())
+	}
+	return fmt.Errorf("environment variables and secrets take %d bytes, which exceeds the %d bytes limit of an ECS task definition: the largest are %s",
+		total, maxTaskDefEnvVarsSize, strings.Join(largest, ", "))
+}
+
+// taskEnvVars returns the environment variables and secrets of each container in the task.
+// It mirrors the variables set by the "envvars-common", "envvars-container" and "secrets" template partials.
+func taskEnvVars(opts template.WorkloadOpts) []containerEnvVars {
+	common := commonEnvVars(opts)
+
+	main := containerEnvVars{
+		container: opts.WorkloadName,
+	}
+	main.vars = append(main.vars, common...)
+	main.vars = append(main.vars, variables(opts.Variables)...)
+	if opts.Storage != nil && len(opts.Storage.MountPoints) > 0 {
+		main.vars = append(main.vars, envVar{name: "COPILOT_MOUNT_POINTS", source: envVarSourceCopilot})
+	}
+	main.vars = append(main.vars, secrets(opts.Secrets)...)
+	if opts.NestedStack != nil {
+		for _, out := range opts.NestedStack.SecretOutputs {
+			main.vars = append(main.vars, envVar{name: template.ToSnakeCaseFunc(out), source: envVarSourceAddons})
+		}
+	}
+	containers := []containerEnvVars{main}
+
+	for _, sidecar := range opts.Sidecars {
+		c := containerEnvVars{
+			container: aws.StringValue(sidecar.Name),
+		}
+		c.vars = append(c.vars, common...)
+		c.vars = append(c.vars, variables(sidecar.Variables)...)
+		if len(sidecar.Storage.MountPoints) > 0 {
+			c.vars = append(c.vars, envVar{name: "COPILOT_MOUNT_POINTS", source: envVarSourceCopilot})
+		}
+		c.vars = append(c.vars, secrets(sidecar.Secrets)...)
+		containers = append(containers, c)
+	}
+
+	if opts.LogConfig != nil {
+		c := containerEnvVars{
+			container: firelensContainerName,
+		}
+		c.vars = append(c.vars, common...)
+		c.vars = append(c.vars, variables(opts.LogConfig.Variables)...)
+		c.vars = append(c.vars, secrets(opts.LogConfig.Secrets)...)
+		containers = append(containers, c)
+	}
+	return containers
+}
+
+// commonEnvVars returns the variables that Copilot and the addons stack set in every container of the task.
+func commonEnvVars(opts template.WorkloadOpts) []envVar {
+	vars := []envVar{
+		{name: "COPILOT_APPLICATION_NAME", value: opts.AppName, source: envVarSourceCopilot},
+		{name: "COPILOT_SERVICE_DISCOVERY_ENDPOINT", value: opts.ServiceDiscoveryEndpoint, source: envVarSourceCopilot},
+		{name: "COPILOT_ENVIRONMENT_NAME", value: opts.EnvName, source: envVarSourceCopilot},
+		{name: "COPILOT_SERVICE_NAME", value: opts.WorkloadName, source: envVarSourceCopilot},
+	}
+	if opts.NestedStack != nil {
+		for _, out := range opts.NestedStack.VariableOutputs {
+			vars = append(vars, envVar{name: template.ToSnakeCaseFunc(out), source: envVarSourceAddons})
+		}
+	}
+	if opts.Publish != nil && len(opts.Publish.Topics) > 0 {
+		vars = append(vars, envVar{name: "COPILOT_SNS_TOPIC_ARNS", source: envVarSourceCopilot})
+	}
+	if opts.WorkloadType == manifest.WorkerServiceType {
+		vars = append(vars, envVar{name: "COPILOT_QUEUE_URI", source: envVarSourceCopilot})
+	}
+	if opts.Subscribe != nil && opts.Subscribe.HasTopicQueues() {
+		vars = append(vars, envVar{name: "COPILOT_TOPIC_QUEUE_URIS", source: envVarSourceCopilot})
+	}
+	if opts.PrivateCAARN != "" {
+		vars = append(vars, envVar{name: "COPILOT_PRIVATE_CA_ARN", value: opts.PrivateCAARN, source: envVarSourceCopilot})
+	}
+	if opts.Evidently != nil {
+		vars = append(vars, envVar{name: "COPILOT_EVIDENTLY_PROJECT", source: envVarSourceCopilot})
+	}
+	if opts.WorkloadType == manifest.LoadBalancedWebServiceType && opts.ALBEnabled {
+		vars = append(vars, envVar{name: "COPILOT_LB_DNS", source: envVarSourceCopilot})
+	}
+	return vars
+}
+
+func variables(in map[string]string) []envVar {
+	vars := make([]envVar, 0, len(in))
+	for name, value := range in {
+		vars = append(vars, envVar{name: name, value: value, source: envVarSourceManifestVariables})
+	}
+	sort.Slice(vars, func(i, j int) bool {
+		return vars[i].name < vars[j].name
+	})
+	return vars
+}
+
+func secrets(in map[string]template.Secret) []envVar {
+	vars := make([]envVar, 0, len(in))
+	for name, secret := range in {
+		var valueFrom string
+		if secret != nil {
+			valueFrom = secret.ValueFrom()
+		}
+		vars = append(vars, envVar{name: name, value: valueFrom, source: envVarSourceManifestSecrets})
+	}
+	sort.Slice(vars, func(i, j int) bool {
+		return vars[i].name < vars[j].name
+	})
+	return vars
+}
@@ -0,0 +1,125 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateEnvVars(t *testing.T) {
+	testCases := map[string]struct {
+		in template.WorkloadOpts
+
+		wantedError error
+	}{
+		"valid environment variables": {
+			in: template.WorkloadOpts{
+				AppName:      "phonetool",
+				EnvName:      "test",
+				WorkloadName: "api",
+				WorkloadType: manifest.LoadBalancedWebServiceType,
+				Variables: map[string]string{
+					"LOG_LEVEL":   "info",
+					"lower.dot-1": "ok",
+				},
+				Secrets: map[string]template.Secret{
+					"DB_PASSWORD": template.SecretFromSSMOrARN("DB_PASSWORD"),
+				},
+				NestedStack: &template.WorkloadNestedStackOpts{
+					VariableOutputs: []string{"MyTableName"},
+					SecretOutputs:   []string{"MyDBSecret"},
+				},
+				Sidecars: []*template.SidecarOpts{
+					{
+						Name: aws.String("nginx"),
+						Variables: map[string]string{
+							"LOG_LEVEL": "debug",
+						},
+					},
+				},
+			},
+		},
+		"error if names have characters that are invalid for the container runtime": {
+			in: template.WorkloadOpts{
+				WorkloadName: "api",
+				Variables: map[string]string{
+					"A=B":       "value",
+					"LOG LEVEL": "info",
+					"NULL":      "a\x00b",
+				},
+				LogConfig: &template.LogConfigOpts{
+					Secrets: map[string]template.Secret{
+						"": template.SecretFromSSMOrARN("param"),
+					},
+				},
+			},
+			wantedError: errors.New(`environment variable names must not be empty or contain "=", whitespace or null characters, and values must not contain null characters: "A=B" in container "api", "LOG LEVEL" in container "api", "NULL" in container "api", "" in container "firelens_log_router"`),
+		},
+		"error if a manifest variable overrides a variable injected by Copilot or the addons": {
+			in: template.WorkloadOpts{
+				WorkloadName: "api",
+				WorkloadType: manifest.WorkerServiceType,
+				Variables: map[string]string{
+					"COPILOT_QUEUE_URI": "https://sqs",
+					"MY_TABLE_NAME":     "table",
+				},
+				Secrets: map[string]template.Secret{
+					"MY_TABLE_NAME": template.SecretFromSSMOrARN("table"),
+				},
+				NestedStack: &template.WorkloadNestedStackOpts{
+					VariableOutputs: []string{"MyTableName"},
+				},
+			},
+			wantedError: errors.New(`environment variables are defined more than once: "MY_TABLE_NAME" in container "api" is defined by addons outputs, manifest "variables" and manifest "secrets"; "COPILOT_QUEUE_URI" in container "api" is defined by Copilot and manifest "variables"`),
+		},
+		"error if a sidecar variable overrides a variable injected by Copilot": {
+			in: template.WorkloadOpts{
+				WorkloadName: "api",
+				Sidecars: []*template.SidecarOpts{
+					{
+						Name: aws.String("nginx"),
+						Variables: map[string]string{
+							"COPILOT_MOUNT_POINTS": "{}",
+						},
+						Storage: template.SidecarStorageOpts{
+							MountPoints: []*template.MountPoint{{}},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`environment variables are defined more than once: "COPILOT_MOUNT_POINTS" in container "nginx" is defined by manifest "variables" and Copilot`),
+		},
+		"error if the environment variables don't fit in a task definition": {
+			in: template.WorkloadOpts{
+				WorkloadName: "api",
+				Variables: map[string]string{
+					"LARGE":   strings.Repeat("a", 40*1024),
+					"LARGER":  strings.Repeat("a", 50*1024),
+					"SMALL":   "a",
+					"SMALLER": "",
+				},
+			},
+			wantedError: errors.New(`environment variables and secrets take 92289 bytes, which exceeds the 65536 bytes limit of an ECS task definition: the largest are "LARGER" in container "api" (51206 bytes), "LARGE" in container "api" (40965 bytes), "COPILOT_SERVICE_DISCOVERY_ENDPOINT" in container "api" (34 bytes), "COPILOT_APPLICATION_NAME" in container "api" (24 bytes), "COPILOT_ENVIRONMENT_NAME" in container "api" (24 bytes)`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateEnvVars(tc.in)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
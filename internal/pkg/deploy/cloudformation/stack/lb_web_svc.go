@@ -23,6 +23,16 @@ const (
 	LBWebServiceDNSDelegatedParamKey = "DNSDelegated"
 	LBWebServiceNLBAliasesParamKey   = "NLBAliases"
 	LBWebServiceNLBPortParamKey      = "NLBPort"
+
+	LBWebServiceDeployedTaskDefinitionParamKey = "DeployedTaskDefinition"
+)
+
+// Output keys of a load balanced web service deployed with blue/green deployments.
+const (
+	LBWebServiceOutputTaskDefinition            = "TaskDefinition"
+	LBWebServiceOutputServiceTaskDefinition     = "ServiceTaskDefinition"
+	LBWebServiceOutputCodeDeployApplication     = "CodeDeployApplication"
+	LBWebServiceOutputCodeDeployDeploymentGroup = "CodeDeployDeploymentGroup"
 )
 
 type loadBalancedWebSvcReadParser interface {
@@ -193,15 +203,22 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		allowedSourceIPs = append(allowedSourceIPs, string(ipNet))
 	}
 
+	rateLimit, err := convertHTTPRateLimit(s.manifest.RoutingRule.RateLimit)
+	if err != nil {
+		return "", fmt.Errorf(`convert "http.rate_limit" field for service %s: %w`, s.name, err)
+	}
+
 	nlbConfig, err := s.convertNetworkLoadBalancer()
 	if err != nil {
 		return "", err
 	}
-	content, err := s.parser.ParseLoadBalancedWebService(template.WorkloadOpts{
+	opts := template.WorkloadOpts{
 		AppName:            s.app,
 		EnvName:            s.env,
 		WorkloadName:       s.name,
+		ResourcePrefix:     s.rc.ResourcePrefix,
 		SerializedManifest: string(s.rawManifest),
+		DeploymentMetadata: s.rc.deploymentMetadataOpts(),
 
 		Variables:                s.manifest.TaskConfig.Variables,
 		Secrets:                  convertSecrets(s.manifest.TaskConfig.Secrets),
@@ -212,6 +229,7 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		AddonsExtraParams:        addonsParams,
 		Sidecars:                 sidecars,
 		LogConfig:                convertLogging(s.manifest.Logging),
+		LogGroup:                 convertLogGroup(s.manifest.Logging, s.logGroupName()),
 		DockerLabels:             s.manifest.ImageConfig.Image.DockerLabels,
 		Autoscaling:              autoscaling,
 		CapacityProviders:        capacityProviders,
@@ -219,22 +237,29 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		ExecuteCommand:           convertExecuteCommand(&s.manifest.ExecuteCommand),
 		WorkloadType:             manifest.LoadBalancedWebServiceType,
 		HealthCheck:              convertContainerHealthCheck(s.manifest.ImageConfig.HealthCheck),
-		HTTPHealthCheck:          convertHTTPHealthCheck(&s.manifest.RoutingRule.HealthCheck),
+		HTTPHealthCheck:          convertHTTPHealthCheck(&s.manifest.RoutingRule.HealthCheck, s.manifest.ImageConfig.HealthCheck),
 		DeregistrationDelay:      deregistrationDelay,
 		AllowedSourceIps:         allowedSourceIPs,
+		HTTPTargetProtocol:       strings.ToUpper(aws.StringValue(s.manifest.RoutingRule.TargetProtocol)),
+		PrivateCAARN:             aws.StringValue(s.manifest.RoutingRule.PrivateCA),
+		RateLimit:                rateLimit,
+		AdditionalListener:       aws.StringValue(s.manifest.RoutingRule.Listener),
 		CustomResources:          crs,
 		Storage:                  convertStorageOpts(s.manifest.Name, s.manifest.Storage),
-		Network:                  convertNetworkConfig(s.manifest.Network),
+		Network:                  convertNetworkConfig(s.manifest.Network, s.rc.PlacementSubnetIDs),
 		EntryPoint:               entrypoint,
 		Command:                  command,
 		DependsOn:                convertDependsOn(s.manifest.ImageConfig.Image.DependsOn),
 		CredentialsParameter:     aws.StringValue(s.manifest.ImageConfig.Image.Credentials),
 		ServiceDiscoveryEndpoint: s.rc.ServiceDiscoveryEndpoint,
 		Publish:                  publishers,
-		Platform:                 convertPlatform(s.manifest.Platform),
+		Evidently:                convertEvidently(s.manifest.Evidently, s.app, s.env, s.name),
+		Platform:                 convertPlatform(s.manifest.Platform, s.manifest.PlatformVersion),
 		HTTPVersion:              convertHTTPVersion(s.manifest.RoutingRule.ProtocolVersion),
 		NLB:                      nlbConfig.settings,
-		DeploymentConfiguration:  convertDeploymentConfig(s.manifest.DeployConfig),
+		DeploymentConfiguration:  convertDeploymentConfig(s.manifest.DeployConfig, s.manifest.Alarms),
+		Alarms:                   convertAlarms(s.manifest.Alarms),
+		ServiceDiscovery:         convertServiceDiscovery(s.manifest.Network.ServiceDiscovery),
 		AppDNSName:               nlbConfig.appDNSName,
 		AppDNSDelegationRole:     nlbConfig.appDNSDelegationRole,
 		ALBEnabled:               !s.manifest.RoutingRule.Disabled(),
@@ -242,7 +267,11 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 			Tracing: strings.ToUpper(aws.StringValue(s.manifest.Observability.Tracing)),
 		},
 		HostedZoneAliases: aliasesFor,
-	})
+	}
+	if err := validateEnvVars(opts); err != nil {
+		return "", err
+	}
+	content, err := s.parser.ParseLoadBalancedWebService(opts)
 	if err != nil {
 		return "", err
 	}
@@ -333,6 +362,12 @@ func (s *LoadBalancedWebService) Parameters() ([]*cloudformation.Parameter, erro
 			},
 		}...)
 	}
+	if s.manifest.DeployConfig.IsBlueGreen() {
+		wkldParams = append(wkldParams, &cloudformation.Parameter{
+			ParameterKey:   aws.String(LBWebServiceDeployedTaskDefinitionParamKey),
+			ParameterValue: aws.String(s.rc.DeployedTaskDefinitionARN),
+		})
+	}
 	return wkldParams, nil
 }
 
@@ -401,6 +401,7 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 	testCases := map[string]struct {
 		httpsEnabled         bool
 		dnsDelegationEnabled bool
+		deployedTaskDefARN   string
 		setupManifest        func(*manifest.LoadBalancedWebService)
 
 		expectedParams []*cloudformation.Parameter
@@ -777,6 +778,46 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 				},
 			}...),
 		},
+		"with blue/green deployments": {
+			deployedTaskDefARN: "arn:aws:ecs:us-west-2:111111111111:task-definition/phonetool-test-frontend:1",
+			setupManifest: func(service *manifest.LoadBalancedWebService) {
+				service.DeployConfig.Strategy = aws.String(manifest.ECSBlueGreenDeploymentStrategy)
+			},
+			expectedParams: append(expectedParams, []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(WorkloadRulePathParamKey),
+					ParameterValue: aws.String("frontend"),
+				},
+				{
+					ParameterKey:   aws.String(WorkloadHTTPSParamKey),
+					ParameterValue: aws.String("false"),
+				},
+				{
+					ParameterKey:   aws.String(WorkloadTargetContainerParamKey),
+					ParameterValue: aws.String("frontend"),
+				},
+				{
+					ParameterKey:   aws.String(WorkloadTargetPortParamKey),
+					ParameterValue: aws.String("80"),
+				},
+				{
+					ParameterKey:   aws.String(WorkloadTaskCountParamKey),
+					ParameterValue: aws.String("1"),
+				},
+				{
+					ParameterKey:   aws.String(WorkloadStickinessParamKey),
+					ParameterValue: aws.String("false"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceDNSDelegatedParamKey),
+					ParameterValue: aws.String("false"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceDeployedTaskDefinitionParamKey),
+					ParameterValue: aws.String("arn:aws:ecs:us-west-2:111111111111:task-definition/phonetool-test-frontend:1"),
+				},
+			}...),
+		},
 		"with bad count": {
 			httpsEnabled: true,
 			setupManifest: func(service *manifest.LoadBalancedWebService) {
@@ -809,6 +850,7 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 								RepoURL:  testImageRepoURL,
 								ImageTag: testImageTag,
 							},
+							DeployedTaskDefinitionARN: tc.deployedTaskDefARN,
 						},
 					},
 					tc: testManifest.TaskConfig,
@@ -56,6 +56,11 @@ func NameForAppStackSet(app string) string {
 	return fmt.Sprintf("%s-infrastructure", app)
 }
 
+// NameForEnvStackSet returns the stackset name that deploys the environments of an app.
+func NameForEnvStackSet(app string) string {
+	return fmt.Sprintf("%s-environments", app)
+}
+
 // NameForPipeline returns the stack name for a pipeline, depending on whether it has been deployed using the legacy scheme.
 // Note that it doesn't cut name to length of 128 like service stack name. It expects CloudFormation to error out
 // when the name is to long.
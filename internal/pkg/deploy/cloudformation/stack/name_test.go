@@ -39,3 +39,9 @@ func TestNameForAppStackSet(t *testing.T) {
 
 	require.Equal(t, name, "foo-infrastructure")
 }
+
+func TestNameForEnvStackSet(t *testing.T) {
+	name := NameForEnvStackSet("foo")
+
+	require.Equal(t, name, "foo-environments")
+}
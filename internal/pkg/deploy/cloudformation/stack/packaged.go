@@ -0,0 +1,77 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+)
+
+// PackagedStack represents the configuration of a CloudFormation stack whose template and parameters
+// were generated ahead of the deployment, for example with `copilot svc package --output-dir`.
+type PackagedStack struct {
+	name   string
+	tpl    string
+	config string
+	params []*cloudformation.Parameter
+	tags   []*cloudformation.Tag
+}
+
+// NewPackagedStack returns the configuration of the stack named name that deploys the packaged template verbatim.
+func NewPackagedStack(name string, packaged *deploy.PackagedTemplate) (*PackagedStack, error) {
+	var config struct {
+		Parameters map[string]string `json:"Parameters"`
+		Tags       map[string]string `json:"Tags"`
+	}
+	if err := json.Unmarshal([]byte(packaged.Configuration), &config); err != nil {
+		return nil, fmt.Errorf("unmarshal template configuration of stack %s: %w", name, err)
+	}
+	var params []*cloudformation.Parameter
+	for k, v := range config.Parameters {
+		params = append(params, &cloudformation.Parameter{
+			ParameterKey:   aws.String(k),
+			ParameterValue: aws.String(v),
+		})
+	}
+	sort.SliceStable(params, func(i, j int) bool {
+		return aws.StringValue(params[i].ParameterKey) < aws.StringValue(params[j].ParameterKey)
+	})
+	return &PackagedStack{
+		name:   name,
+		tpl:    packaged.Template,
+		config: packaged.Configuration,
+		params: params,
+		tags:   mergeAndFlattenTags(config.Tags, nil),
+	}, nil
+}
+
+// StackName returns the name of the CloudFormation stack.
+func (s *PackagedStack) StackName() string {
+	return s.name
+}
+
+// Template returns the packaged CloudFormation template.
+func (s *PackagedStack) Template() (string, error) {
+	return s.tpl, nil
+}
+
+// Parameters returns the parameter values from the packaged template configuration.
+func (s *PackagedStack) Parameters() ([]*cloudformation.Parameter, error) {
+	return s.params, nil
+}
+
+// Tags returns the tags from the packaged template configuration.
+func (s *PackagedStack) Tags() []*cloudformation.Tag {
+	return s.tags
+}
+
+// SerializedParameters returns the packaged template configuration.
+func (s *PackagedStack) SerializedParameters() (string, error) {
+	return s.config, nil
+}
@@ -0,0 +1,74 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPackagedStack(t *testing.T) {
+	testCases := map[string]struct {
+		inConfig string
+
+		wantedParams []*cloudformation.Parameter
+		wantedTags   []*cloudformation.Tag
+		wantedErr    string
+	}{
+		"returns an error if the configuration is not valid JSON": {
+			inConfig:  `{"Parameters": `,
+			wantedErr: "unmarshal template configuration of stack phonetool-test-api: unexpected end of JSON input",
+		},
+		"sorts the parameters and tags from the configuration": {
+			inConfig: `{
+  "Parameters" : {
+    "EnvName": "test",
+    "AppName": "phonetool"
+  },
+  "Tags": {
+    "copilot-service": "api",
+    "copilot-application": "phonetool"
+  }
+}`,
+			wantedParams: []*cloudformation.Parameter{
+				{ParameterKey: aws.String("AppName"), ParameterValue: aws.String("phonetool")},
+				{ParameterKey: aws.String("EnvName"), ParameterValue: aws.String("test")},
+			},
+			wantedTags: []*cloudformation.Tag{
+				{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+				{Key: aws.String("copilot-service"), Value: aws.String("api")},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			s, err := NewPackagedStack("phonetool-test-api", &deploy.PackagedTemplate{
+				Template:      "Resources: {}",
+				Configuration: tc.inConfig,
+			})
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "phonetool-test-api", s.StackName())
+			tpl, err := s.Template()
+			require.NoError(t, err)
+			require.Equal(t, "Resources: {}", tpl)
+			params, err := s.Parameters()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedParams, params)
+			require.Equal(t, tc.wantedTags, s.Tags())
+			config, err := s.SerializedParameters()
+			require.NoError(t, err)
+			require.Equal(t, tc.inConfig, config)
+		})
+	}
+}
@@ -120,7 +120,9 @@ func (s *RequestDrivenWebService) Template() (string, error) {
 		AppName:            s.wkld.app,
 		EnvName:            s.env,
 		WorkloadName:       s.name,
+		ResourcePrefix:     s.rc.ResourcePrefix,
 		SerializedManifest: string(s.rawManifest),
+		DeploymentMetadata: s.rc.deploymentMetadataOpts(),
 
 		Variables:            s.manifest.Variables,
 		StartCommand:         s.manifest.StartCommand,
@@ -0,0 +1,90 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+)
+
+const (
+	remoteBuildTemplatePath = "remote-build/cf.yml"
+
+	remoteBuildAppNameParamKey        = "AppName"
+	remoteBuildArtifactBucketParamKey = "ArtifactBucket"
+	remoteBuildLogRetentionParamKey   = "LogRetention"
+
+	remoteBuildLogRetentionInDays = "30"
+)
+
+type remoteBuildStackConfig struct {
+	app    string
+	bucket string
+	parser template.Reader
+}
+
+// NewRemoteBuildStackConfig sets up a struct that provides stack configurations for CloudFormation
+// to deploy the CodeBuild project that builds the images of an application remotely.
+func NewRemoteBuildStackConfig(app, bucket string) *remoteBuildStackConfig {
+	return &remoteBuildStackConfig{
+		app:    app,
+		bucket: bucket,
+		parser: template.New(),
+	}
+}
+
+// NameForRemoteBuild returns the name of the remote build stack and of its CodeBuild project for an application.
+func NameForRemoteBuild(app string) string {
+	return fmt.Sprintf("%s-remote-build", app)
+}
+
+// StackName returns the name of the CloudFormation stack for the remote build project.
+func (c *remoteBuildStackConfig) StackName() string {
+	return NameForRemoteBuild(c.app)
+}
+
+// Template returns the remote build CloudFormation template.
+func (c *remoteBuildStackConfig) Template() (string, error) {
+	content, err := c.parser.Read(remoteBuildTemplatePath)
+	if err != nil {
+		return "", fmt.Errorf("read template for remote build stack: %w", err)
+	}
+	return content.String(), nil
+}
+
+// Parameters returns the parameter values to be passed to the remote build CloudFormation template.
+func (c *remoteBuildStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
+	return []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String(remoteBuildAppNameParamKey),
+			ParameterValue: aws.String(c.app),
+		},
+		{
+			ParameterKey:   aws.String(remoteBuildArtifactBucketParamKey),
+			ParameterValue: aws.String(c.bucket),
+		},
+		{
+			ParameterKey:   aws.String(remoteBuildLogRetentionParamKey),
+			ParameterValue: aws.String(remoteBuildLogRetentionInDays),
+		},
+	}, nil
+}
+
+// SerializedParameters returns the CloudFormation stack's parameters serialized
+// to a YAML document annotated with comments for readability to users.
+func (c *remoteBuildStackConfig) SerializedParameters() (string, error) {
+	// No-op for now.
+	return "", nil
+}
+
+// Tags returns the tags that should be applied to the remote build CloudFormation stack.
+func (c *remoteBuildStackConfig) Tags() []*cloudformation.Tag {
+	return mergeAndFlattenTags(nil, map[string]string{
+		deploy.AppTagKey: c.app,
+	})
+}
@@ -0,0 +1,93 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteBuildStackConfig_Template(t *testing.T) {
+	testCases := map[string]struct {
+		mockReader func(m *mocks.MockReader)
+
+		wantedTemplate string
+		wantedError    error
+	}{
+		"should return error if unable to read": {
+			mockReader: func(m *mocks.MockReader) {
+				m.EXPECT().Read(remoteBuildTemplatePath).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("read template for remote build stack: some error"),
+		},
+		"should return template body when present": {
+			mockReader: func(m *mocks.MockReader) {
+				m.EXPECT().Read(remoteBuildTemplatePath).Return(&template.Content{
+					Buffer: bytes.NewBufferString("This is the remote build template"),
+				}, nil)
+			},
+			wantedTemplate: "This is the remote build template",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockReader(ctrl)
+			tc.mockReader(m)
+			conf := &remoteBuildStackConfig{
+				app:    "phonetool",
+				bucket: "mockBucket",
+				parser: m,
+			}
+
+			got, err := conf.Template()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTemplate, got)
+		})
+	}
+}
+
+func TestRemoteBuildStackConfig_Parameters(t *testing.T) {
+	conf := NewRemoteBuildStackConfig("phonetool", "mockBucket")
+
+	params, err := conf.Parameters()
+
+	require.NoError(t, err)
+	require.Equal(t, []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String("AppName"),
+			ParameterValue: aws.String("phonetool"),
+		},
+		{
+			ParameterKey:   aws.String("ArtifactBucket"),
+			ParameterValue: aws.String("mockBucket"),
+		},
+		{
+			ParameterKey:   aws.String("LogRetention"),
+			ParameterValue: aws.String("30"),
+		},
+	}, params)
+	require.Equal(t, "phonetool-remote-build", conf.StackName())
+	require.Equal(t, []*cloudformation.Tag{
+		{
+			Key:   aws.String("copilot-application"),
+			Value: aws.String("phonetool"),
+		},
+	}, conf.Tags())
+}
@@ -0,0 +1,196 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/robfig/cron/v3"
+)
+
+// DisabledScheduleExpression is the schedule expression of a job that doesn't run on a schedule.
+const DisabledScheduleExpression = "none"
+
+const (
+	awsRatePrefix = "rate("
+	awsCronPrefix = "cron("
+)
+
+// Fields of an AWS cron expression.
+const (
+	awsCronMinutes = iota
+	awsCronHours
+	awsCronDayOfMonth
+	awsCronMonth
+	awsCronDayOfWeek
+	awsCronYear
+	awsCronFieldsCount
+)
+
+var (
+	awsRateRegexp     = regexp.MustCompile(`^rate\((\d+) (minutes?|hours?|days?)\)$`) // Captures the value and unit of a rate expression.
+	awsCronYearRegexp = regexp.MustCompile(`^[0-9*,/-]+$`)
+)
+
+// validateAWSScheduleExpression returns an error if a "rate( )" or "cron( )" expression would be rejected by CloudWatch Events.
+// Cron expressions that use the "L", "W" or "#" wildcards are only validated for their structure.
+func validateAWSScheduleExpression(expr string) error {
+	switch {
+	case expr == DisabledScheduleExpression:
+		return nil
+	case strings.HasPrefix(expr, awsRatePrefix):
+		_, err := awsRateInterval(expr)
+		return err
+	case strings.HasPrefix(expr, awsCronPrefix):
+		_, err := awsCronSchedule(expr)
+		return err
+	default:
+		return fmt.Errorf(`expression %q must be of the form "rate(value unit)" or "cron(fields)"`, expr)
+	}
+}
+
+// NextScheduledRuns returns the next n times after the given time, in UTC, at which a job with the CloudWatch Events
+// schedule expression runs.
+// Returns nil if the job is disabled, or if the expression uses wildcards or years that can only be evaluated by CloudWatch Events.
+func NextScheduledRuns(expr string, after time.Time, n int) ([]time.Time, error) {
+	after = after.UTC()
+	var runs []time.Time
+	switch {
+	case expr == DisabledScheduleExpression:
+		return nil, nil
+	case strings.HasPrefix(expr, awsRatePrefix):
+		interval, err := awsRateInterval(expr)
+		if err != nil {
+			return nil, err
+		}
+		// Rates are measured from the time the rule is created, which is approximated by the given time.
+		for i := 1; i <= n; i++ {
+			runs = append(runs, after.Add(time.Duration(i)*interval).Truncate(time.Minute))
+		}
+	case strings.HasPrefix(expr, awsCronPrefix):
+		sched, err := awsCronSchedule(expr)
+		if err != nil {
+			return nil, err
+		}
+		if sched == nil {
+			return nil, nil
+		}
+		next := after
+		for i := 0; i < n; i++ {
+			next = sched.Next(next)
+			runs = append(runs, next)
+		}
+	default:
+		return nil, fmt.Errorf(`expression %q must be of the form "rate(value unit)" or "cron(fields)"`, expr)
+	}
+	return runs, nil
+}
+
+// awsRateInterval validates a rate expression and returns the interval between two runs.
+func awsRateInterval(expr string) (time.Duration, error) {
+	match := awsRateRegexp.FindStringSubmatch(expr)
+	if match == nil {
+		return 0, fmt.Errorf(`rate expression %q must be of the form "rate(value unit)" where unit is "minutes", "hours" or "days"`, expr)
+	}
+	value, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, fmt.Errorf("parse value of rate expression %q: %w", expr, err)
+	}
+	unit := match[2]
+	plural := strings.HasSuffix(unit, "s")
+	switch {
+	case value <= 0:
+		return 0, fmt.Errorf("value of rate expression %q must be greater than 0", expr)
+	case value == 1 && plural:
+		return 0, fmt.Errorf(`rate expression %q must use the singular unit "%s" for a value of 1`, expr, strings.TrimSuffix(unit, "s"))
+	case value > 1 && !plural:
+		return 0, fmt.Errorf(`rate expression %q must use the plural unit "%ss" for a value greater than 1`, expr, unit)
+	}
+	var d time.Duration
+	switch strings.TrimSuffix(unit, "s") {
+	case "minute":
+		d = time.Minute
+	case "hour":
+		d = time.Hour
+	case "day":
+		d = 24 * time.Hour
+	}
+	return time.Duration(value) * d, nil
+}
+
+// awsCronSchedule validates a cron expression and returns its equivalent standard cron schedule.
+// The schedule is nil if the expression can't be represented as a standard cron, for example if it uses the "L" wildcard.
+func awsCronSchedule(expr string) (cron.Schedule, error) {
+	if !strings.HasSuffix(expr, ")") {
+		return nil, fmt.Errorf(`cron expression %q must be of the form "cron(fields)"`, expr)
+	}
+	fields := strings.Fields(strings.TrimSuffix(strings.TrimPrefix(expr, awsCronPrefix), ")"))
+	if len(fields) != awsCronFieldsCount {
+		return nil, fmt.Errorf("cron expression %q must have %d fields: minutes, hours, day-of-month, month, day-of-week and year", expr, awsCronFieldsCount)
+	}
+	dom, dow := fields[awsCronDayOfMonth], fields[awsCronDayOfWeek]
+	switch {
+	case dom == "?" && dow == "?":
+		return nil, fmt.Errorf(`cron expression %q cannot use "?" for both day-of-month and day-of-week`, expr)
+	case dom != "?" && dow != "?":
+		return nil, fmt.Errorf(`cron expression %q must use "?" for either day-of-month or day-of-week`, expr)
+	}
+	if !awsCronYearRegexp.MatchString(fields[awsCronYear]) {
+		return nil, fmt.Errorf("cron expression %q has an invalid year %q", expr, fields[awsCronYear])
+	}
+	if containsAWSOnlyWildcard(dom, dow) || fields[awsCronYear] != "*" {
+		// The standard cron syntax doesn't support these wildcards or years, leave them to CloudWatch Events.
+		return nil, nil
+	}
+	standard := []string{
+		fields[awsCronMinutes],
+		fields[awsCronHours],
+		strings.ReplaceAll(dom, "?", "*"),
+		fields[awsCronMonth],
+		toStandardDayOfWeek(strings.ReplaceAll(dow, "?", "*")),
+	}
+	sched, err := cron.ParseStandard(strings.Join(standard, " "))
+	if err != nil {
+		return nil, fmt.Errorf("parse cron expression %q: %w", expr, err)
+	}
+	return sched, nil
+}
+
+// containsAWSOnlyWildcard returns true if the day-of-month or day-of-week fields use the "L", "W", or "#" wildcards.
+func containsAWSOnlyWildcard(dom, dow string) bool {
+	if strings.ContainsAny(dom, "LW") || strings.Contains(dow, "#") {
+		return true
+	}
+	// Day names such as "SAT" are valid, only a standalone "L" or an "L" following a number is a wildcard.
+	return dow == "L" || len(dow) > 1 && strings.HasSuffix(dow, "L") && unicode.IsDigit(rune(dow[len(dow)-2]))
+}
+
+// toStandardDayOfWeek converts the one-indexed days of week of AWS cron expressions to the zero-indexed days of standard crons.
+// Example input: 2-6/2
+//
+//	output: 1-5/2
+func toStandardDayOfWeek(dow string) string {
+	parts := strings.Split(dow, ",")
+	for i, part := range parts {
+		rng, step, hasStep := strings.Cut(part, "/")
+		var b strings.Builder
+		for _, c := range rng {
+			if unicode.IsDigit(c) {
+				b.WriteRune(c - 1)
+				continue
+			}
+			b.WriteRune(c)
+		}
+		parts[i] = b.String()
+		if hasStep {
+			parts[i] += "/" + step
+		}
+	}
+	return strings.Join(parts, ",")
+}
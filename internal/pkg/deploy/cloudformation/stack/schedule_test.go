@@ -0,0 +1,95 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextScheduledRuns(t *testing.T) {
+	now := time.Date(2022, 11, 23, 18, 30, 20, 0, time.UTC) // Wednesday.
+	testCases := map[string]struct {
+		inExpr string
+
+		wantedRuns  []time.Time
+		wantedError error
+	}{
+		"no runs if the job is disabled": {
+			inExpr: "none",
+		},
+		"runs at a fixed rate": {
+			inExpr: "rate(2 hours)",
+			wantedRuns: []time.Time{
+				time.Date(2022, 11, 23, 20, 30, 0, 0, time.UTC),
+				time.Date(2022, 11, 23, 22, 30, 0, 0, time.UTC),
+				time.Date(2022, 11, 24, 0, 30, 0, 0, time.UTC),
+			},
+		},
+		"error if the rate has an unknown unit": {
+			inExpr:      "rate(5 weeks)",
+			wantedError: errors.New(`rate expression "rate(5 weeks)" must be of the form "rate(value unit)" where unit is "minutes", "hours" or "days"`),
+		},
+		"error if the rate is zero": {
+			inExpr:      "rate(0 minutes)",
+			wantedError: errors.New(`value of rate expression "rate(0 minutes)" must be greater than 0`),
+		},
+		"error if a rate greater than 1 uses a singular unit": {
+			inExpr:      "rate(5 day)",
+			wantedError: errors.New(`rate expression "rate(5 day)" must use the plural unit "days" for a value greater than 1`),
+		},
+		"runs on one-indexed days of the week": {
+			inExpr: "cron(0 9 ? * 2-6 *)",
+			wantedRuns: []time.Time{
+				time.Date(2022, 11, 24, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 11, 25, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 11, 28, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		"runs on days of the month": {
+			inExpr: "cron(15 0 1,15 * ? *)",
+			wantedRuns: []time.Time{
+				time.Date(2022, 12, 1, 0, 15, 0, 0, time.UTC),
+				time.Date(2022, 12, 15, 0, 15, 0, 0, time.UTC),
+				time.Date(2023, 1, 1, 0, 15, 0, 0, time.UTC),
+			},
+		},
+		"no runs if the cron uses wildcards only supported by CloudWatch Events": {
+			inExpr: "cron(0 10 ? * 6L *)",
+		},
+		"no runs if the cron is restricted to some years": {
+			inExpr: "cron(0 10 * * ? 2023)",
+		},
+		"error if the cron has too few fields": {
+			inExpr:      "cron(0 10 * * ?)",
+			wantedError: errors.New(`cron expression "cron(0 10 * * ?)" must have 6 fields: minutes, hours, day-of-month, month, day-of-week and year`),
+		},
+		"error if the cron uses ? for both days": {
+			inExpr:      "cron(0 10 ? * ? *)",
+			wantedError: errors.New(`cron expression "cron(0 10 ? * ? *)" cannot use "?" for both day-of-month and day-of-week`),
+		},
+		"error if the cron has an invalid field": {
+			inExpr:      "cron(0 25 * * ? *)",
+			wantedError: errors.New(`parse cron expression "cron(0 25 * * ? *)": end of range (25) above maximum (23): 25`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			runs, err := NextScheduledRuns(tc.inExpr, now, 3)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedRuns, runs)
+		})
+	}
+}
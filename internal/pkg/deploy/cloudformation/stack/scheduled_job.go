@@ -166,8 +166,10 @@ func (j *ScheduledJob) Template() (string, error) {
 		return "", err
 	}
 
-	content, err := j.parser.ParseScheduledJob(template.WorkloadOpts{
+	opts := template.WorkloadOpts{
+		ResourcePrefix:           j.rc.ResourcePrefix,
 		SerializedManifest:       string(j.rawManifest),
+		DeploymentMetadata:       j.rc.deploymentMetadataOpts(),
 		Variables:                j.manifest.Variables,
 		Secrets:                  convertSecrets(j.manifest.Secrets),
 		WorkloadType:             manifest.ScheduledJobType,
@@ -175,22 +177,29 @@ func (j *ScheduledJob) Template() (string, error) {
 		AddonsExtraParams:        addonsParams,
 		Sidecars:                 sidecars,
 		ScheduleExpression:       schedule,
+		ScheduleTimezone:         aws.StringValue(j.manifest.On.Timezone),
 		StateMachine:             stateMachine,
 		HealthCheck:              convertContainerHealthCheck(j.manifest.ImageConfig.HealthCheck),
 		LogConfig:                convertLogging(j.manifest.Logging),
+		LogGroup:                 convertLogGroup(j.manifest.Logging, j.logGroupName()),
 		DockerLabels:             j.manifest.ImageConfig.Image.DockerLabels,
 		Storage:                  convertStorageOpts(j.manifest.Name, j.manifest.Storage),
-		Network:                  convertNetworkConfig(j.manifest.Network),
+		Network:                  convertNetworkConfig(j.manifest.Network, j.rc.PlacementSubnetIDs),
 		EntryPoint:               entrypoint,
 		Command:                  command,
 		DependsOn:                convertDependsOn(j.manifest.ImageConfig.Image.DependsOn),
 		CredentialsParameter:     aws.StringValue(j.manifest.ImageConfig.Image.Credentials),
 		ServiceDiscoveryEndpoint: j.rc.ServiceDiscoveryEndpoint,
 		Publish:                  publishers,
-		Platform:                 convertPlatform(j.manifest.Platform),
+		Evidently:                convertEvidently(j.manifest.Evidently, j.app, j.env, j.name),
+		Platform:                 convertPlatform(j.manifest.Platform, j.manifest.PlatformVersion),
 
 		CustomResources: crs,
-	})
+	}
+	if err := validateEnvVars(opts); err != nil {
+		return "", err
+	}
+	content, err := j.parser.ParseScheduledJob(opts)
 	if err != nil {
 		return "", fmt.Errorf("parse scheduled job template: %w", err)
 	}
@@ -228,23 +237,31 @@ func (j *ScheduledJob) SerializedParameters() (string, error) {
 	return serializeTemplateConfig(j.wkld.parser, j)
 }
 
-// awsSchedule converts the Schedule string to the format required by Cloudwatch Events
+// awsSchedule converts the Schedule string from the manifest to the format required by Cloudwatch Events.
+func (j *ScheduledJob) awsSchedule() (string, error) {
+	schedule := aws.StringValue(j.manifest.On.Schedule)
+	if schedule == "" {
+		return "", fmt.Errorf(`missing required field "schedule" in manifest for job %s`, j.name)
+	}
+	return ScheduleExpression(schedule)
+}
+
+// ScheduleExpression converts a job schedule to the format required by Cloudwatch Events
 // https://docs.aws.amazon.com/lambda/latest/dg/services-cloudwatchevents-expressions.html
 // Cron expressions must have an sixth "year" field, and must contain at least one ? (either-or)
 // in either day-of-month or day-of-week.
 // Day-of-week expressions are zero-indexed in Golang but one-indexed in AWS.
 // @every cron definition strings are converted to rates.
 // All others become cron expressions.
-// Exception is made for strings of the form "rate( )" or "cron( )". These are accepted as-is and
-// validated server-side by CloudFormation.
-func (j *ScheduledJob) awsSchedule() (string, error) {
-	schedule := aws.StringValue(j.manifest.On.Schedule)
-	if schedule == "" {
-		return "", fmt.Errorf(`missing required field "schedule" in manifest for job %s`, j.name)
-	}
-	// If the schedule uses default CloudWatch Events syntax, pass it through for server-side validation.
+// Exception is made for strings of the form "rate( )" or "cron( )". These are accepted as-is
+// once they are validated locally.
+func ScheduleExpression(schedule string) (string, error) {
+	// If the schedule uses default CloudWatch Events syntax, validate it and pass it through.
 	if match := awsScheduleRegexp.FindStringSubmatch(schedule); match != nil {
-		return aws.StringValue(j.manifest.On.Schedule), nil
+		if err := validateAWSScheduleExpression(schedule); err != nil {
+			return "", errScheduleInvalid{reason: err}
+		}
+		return schedule, nil
 	}
 	// Try parsing the string as a cron expression to validate it.
 	if _, err := cron.ParseStandard(schedule); err != nil {
@@ -276,7 +293,8 @@ func (j *ScheduledJob) awsSchedule() (string, error) {
 
 // toRate converts a cron "@every" directive to a rate expression defined in minutes.
 // example input: @every 1h30m
-//        output: rate(90 minutes)
+//
+//	output: rate(90 minutes)
 func toRate(duration string) (string, error) {
 	d, err := time.ParseDuration(duration)
 	if err != nil {
@@ -301,9 +319,10 @@ func toRate(duration string) (string, error) {
 // toFixedSchedule converts cron predefined schedules into AWS-flavored cron expressions.
 // (https://godoc.org/github.com/robfig/cron#hdr-Predefined_schedules)
 // Example input: @daily
-//        output: cron(0 0 * * ? *)
-//         input: @annually
-//        output: cron(0 0 1 1 ? *)
+//
+//	output: cron(0 0 * * ? *)
+//	 input: @annually
+//	output: cron(0 0 1 1 ? *)
 func toFixedSchedule(schedule string) (string, error) {
 	switch {
 	case strings.HasPrefix(schedule, hourly):
@@ -336,7 +355,8 @@ func awsCronFieldSpecified(input string) bool {
 // BOTH DOM and DOW cannot be specified
 // DOW numbers run 1-7, not 0-6
 // Example input: 0 9 * * 1-5 (at 9 am, Monday-Friday)
-//              : cron(0 9 ? * 2-6 *) (adds required ? operator, increments DOW to 1-index, adds year)
+//
+//	: cron(0 9 ? * 2-6 *) (adds required ? operator, increments DOW to 1-index, adds year)
 func toAWSCron(schedule string) (string, error) {
 	const (
 		MIN = iota
@@ -391,7 +411,7 @@ func toAWSCron(schedule string) (string, error) {
 	return fmt.Sprintf(fmtCronScheduleExpression, strings.Join(sched, " ")), nil
 }
 
-// StateMachine converts the Timeout and Retries fields to an instance of template.StateMachineOpts
+// StateMachine converts the Timeout, Retries and Concurrency fields to an instance of template.StateMachineOpts
 // It also performs basic validations to provide a fast feedback loop to the customer.
 func (j *ScheduledJob) stateMachineOpts() (*template.StateMachineOpts, error) {
 	var timeoutSeconds *int
@@ -417,7 +437,8 @@ func (j *ScheduledJob) stateMachineOpts() (*template.StateMachineOpts, error) {
 		retries = aws.Int(inRetries)
 	}
 	return &template.StateMachineOpts{
-		Timeout: timeoutSeconds,
-		Retries: retries,
+		Timeout:     timeoutSeconds,
+		Retries:     retries,
+		Concurrency: aws.StringValue(j.manifest.On.Concurrency),
 	}, nil
 }
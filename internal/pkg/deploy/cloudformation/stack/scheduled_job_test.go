@@ -311,6 +311,18 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 			inputSchedule:  "rate(5 minutes)",
 			wantedSchedule: "rate(5 minutes)",
 		},
+		"passthrough AWS flavored cron with wildcards only supported by CloudWatch Events": {
+			inputSchedule:  "cron(0 12 L * ? 2021)",
+			wantedSchedule: "cron(0 12 L * ? 2021)",
+		},
+		"error on AWS flavored rate with mismatched unit": {
+			inputSchedule: "rate(1 minutes)",
+			wantedError:   errors.New(`schedule is not valid cron, rate, or preset: rate expression "rate(1 minutes)" must use the singular unit "minute" for a value of 1`),
+		},
+		"error on AWS flavored cron without ? in day-of-month or day-of-week": {
+			inputSchedule: "cron(0 * * * * *)",
+			wantedError:   errors.New(`schedule is not valid cron, rate, or preset: cron expression "cron(0 * * * * *)" must use "?" for either day-of-month or day-of-week`),
+		},
 		"passthrough 'none' case": {
 			inputSchedule:  "none",
 			wantedSchedule: "none",
@@ -353,11 +365,12 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 
 func TestScheduledJob_stateMachine(t *testing.T) {
 	testCases := map[string]struct {
-		inputTimeout    string
-		inputRetries    int
-		wantedConfig    template.StateMachineOpts
-		wantedError     error
-		wantedErrorType interface{}
+		inputTimeout     string
+		inputRetries     int
+		inputConcurrency *string
+		wantedConfig     template.StateMachineOpts
+		wantedError      error
+		wantedErrorType  interface{}
 	}{
 		"timeout and retries": {
 			inputTimeout: "3h",
@@ -381,6 +394,14 @@ func TestScheduledJob_stateMachine(t *testing.T) {
 				Retries: aws.Int(2),
 			},
 		},
+		"concurrency policy": {
+			inputRetries:     1,
+			inputConcurrency: aws.String("replace"),
+			wantedConfig: template.StateMachineOpts{
+				Retries:     aws.Int(1),
+				Concurrency: "replace",
+			},
+		},
 		"negative retries": {
 			inputRetries: -4,
 			wantedError:  errors.New("number of retries cannot be negative"),
@@ -413,6 +434,9 @@ func TestScheduledJob_stateMachine(t *testing.T) {
 							Retries: aws.Int(tc.inputRetries),
 							Timeout: aws.String(tc.inputTimeout),
 						},
+						On: manifest.JobTriggerConfig{
+							Concurrency: tc.inputConcurrency,
+						},
 					},
 				},
 			}
@@ -428,6 +452,7 @@ func TestScheduledJob_stateMachine(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, aws.IntValue(tc.wantedConfig.Retries), aws.IntValue(parsedStateMachine.Retries))
 				require.Equal(t, aws.IntValue(tc.wantedConfig.Timeout), aws.IntValue(parsedStateMachine.Timeout))
+				require.Equal(t, tc.wantedConfig.Concurrency, parsedStateMachine.Concurrency)
 			}
 		})
 	}
@@ -38,6 +38,12 @@ const (
 	defaultNLBProtocol     = manifest.TCP
 )
 
+// Default values for Copilot-managed FSx for Windows File Server file systems.
+const (
+	defaultFSxStorageCapacityGiB = 32
+	defaultFSxThroughputCapacity = 8
+)
+
 // Supported capacityproviders for Fargate services
 const (
 	capacityProviderFargateSpot = "FARGATE_SPOT"
@@ -52,6 +58,17 @@ const (
 	maxPercentDefault         = 200
 )
 
+// Defaults for blue/green deployments with CodeDeploy.
+const (
+	defaultBlueGreenTestListenerPort = 8080
+)
+
+var blueGreenDeploymentConfigNames = map[string]string{
+	manifest.BlueGreenAllAtOnceTrafficShifting: "CodeDeployDefault.ECSAllAtOnce",
+	manifest.BlueGreenCanaryTrafficShifting:    "CodeDeployDefault.ECSCanary10Percent5Minutes",
+	manifest.BlueGreenLinearTrafficShifting:    "CodeDeployDefault.ECSLinear10PercentEvery1Minutes",
+}
+
 var (
 	taskDefOverrideRulePrefixes = []string{"Resources", "TaskDefinition", "Properties"}
 	subnetPlacementForTemplate  = map[manifest.PlacementString]string{
@@ -106,6 +123,7 @@ func convertSidecar(s map[string]*manifest.SidecarConfig) ([]*template.SidecarOp
 			EntryPoint:   entrypoint,
 			HealthCheck:  convertContainerHealthCheck(config.HealthCheck),
 			Command:      command,
+			LogGroup:     convertSidecarLogging(config.Logging),
 		})
 	}
 	return sidecars, nil
@@ -316,12 +334,18 @@ func convertAutoscaling(a manifest.AdvancedCount) (*template.AutoscalingOpts, er
 }
 
 // convertHTTPHealthCheck converts the ALB health check configuration into a format parsable by the templates pkg.
-func convertHTTPHealthCheck(hc *manifest.HealthCheckArgsOrString) template.HTTPHealthCheckOpts {
+// If the grace period is not set, it is extended to the container health check's start period so that
+// the load balancer doesn't replace tasks that are still starting up.
+func convertHTTPHealthCheck(hc *manifest.HealthCheckArgsOrString, containerHC manifest.ContainerHealthCheck) template.HTTPHealthCheckOpts {
+	gracePeriod := int64(manifest.DefaultHealthCheckGracePeriod)
+	if containerHC.StartPeriod != nil && int64(containerHC.StartPeriod.Seconds()) > gracePeriod {
+		gracePeriod = int64(containerHC.StartPeriod.Seconds())
+	}
 	opts := template.HTTPHealthCheckOpts{
 		HealthCheckPath:    manifest.DefaultHealthCheckPath,
 		HealthyThreshold:   hc.HealthCheckArgs.HealthyThreshold,
 		UnhealthyThreshold: hc.HealthCheckArgs.UnhealthyThreshold,
-		GracePeriod:        aws.Int64(manifest.DefaultHealthCheckGracePeriod),
+		GracePeriod:        aws.Int64(gracePeriod),
 	}
 	if hc.HealthCheckArgs.Path != nil {
 		opts.HealthCheckPath = *hc.HealthCheckArgs.Path
@@ -456,6 +480,44 @@ func convertLogging(lc manifest.Logging) *template.LogConfigOpts {
 	}
 }
 
+// convertLogGroup converts the manifest log group configuration. The log group name in the manifest
+// takes precedence over the defaultName generated from the application's naming convention.
+func convertLogGroup(lc manifest.Logging, defaultName *string) template.LogGroupOpts {
+	name := lc.GroupName
+	if name == nil {
+		name = defaultName
+	}
+	return template.LogGroupOpts{
+		Name:      name,
+		KMSKeyARN: lc.KMSKeyARN,
+	}
+}
+
+// convertHTTPRateLimit converts the manifest rate limit into the number of requests allowed per IP address
+// within the window over which WAF rate-based rules count requests.
+func convertHTTPRateLimit(in *manifest.HTTPRateLimit) (*template.RateLimitOpts, error) {
+	if in == nil {
+		return nil, nil
+	}
+	requests, per, err := in.Parse()
+	if err != nil {
+		return nil, err
+	}
+	return &template.RateLimitOpts{
+		Limit: requests * int64(manifest.RateLimitEvaluationWindow/per),
+	}, nil
+}
+
+func convertSidecarLogging(l manifest.SidecarLogging) *template.SidecarLogGroupOpts {
+	if l.IsEmpty() {
+		return nil
+	}
+	return &template.SidecarLogGroupOpts{
+		Name:      l.GroupName,
+		Retention: l.Retention,
+	}
+}
+
 func convertTaskDefOverrideRules(inRules []manifest.OverrideRule) []override.Rule {
 	var res []override.Rule
 	suffixStr := strings.Join(taskDefOverrideRulePrefixes, override.PathSegmentSeparator)
@@ -480,6 +542,8 @@ func convertStorageOpts(wlName *string, in manifest.Storage) *template.StorageOp
 		MountPoints:       convertMountPoints(in.Volumes),
 		EFSPerms:          convertEFSPermissions(in.Volumes),
 		ManagedVolumeInfo: convertManagedFSInfo(wlName, in.Volumes),
+		FSxCredentials:    convertFSxCredentials(in.Volumes),
+		ManagedFSxInfo:    convertManagedFSxInfo(in.Volumes),
 	}
 }
 
@@ -531,12 +595,8 @@ func convertMountPoints(input map[string]*manifest.Volume) []*template.MountPoin
 func convertEFSPermissions(input map[string]*manifest.Volume) []*template.EFSPermission {
 	var output []*template.EFSPermission
 	for _, volume := range input {
-		// If there's no EFS configuration, we don't need to generate any permissions.
-		if volume.EmptyVolume() {
-			continue
-		}
-		// If EFS is explicitly disabled, we don't need to generate permisisons.
-		if volume.EFS.Disabled() {
+		// If there's no EFS configuration or EFS is explicitly disabled, we don't need to generate any permissions.
+		if !volume.HasEFS() {
 			continue
 		}
 		// Managed FS permissions are rendered separately in the template.
@@ -562,7 +622,7 @@ func convertEFSPermissions(input map[string]*manifest.Volume) []*template.EFSPer
 func convertManagedFSInfo(wlName *string, input map[string]*manifest.Volume) *template.ManagedVolumeCreationInfo {
 	var output *template.ManagedVolumeCreationInfo
 	for name, volume := range input {
-		if volume.EmptyVolume() || !volume.EFS.UseManagedFS() {
+		if !volume.HasEFS() || !volume.EFS.UseManagedFS() {
 			continue
 		}
 		uid := volume.EFS.Advanced.UID
@@ -597,6 +657,18 @@ func convertVolumes(input map[string]*manifest.Volume) []*template.Volume {
 		//   b) no EFS configuration, in which case the volume is created using task scratch storage in order to share
 		//      data between containers.
 
+		// If FSx is configured, convert the FSx configuration and continue.
+		if !volume.FSx.IsEmpty() {
+			output = append(
+				output,
+				&template.Volume{
+					Name: aws.String(name),
+					FSx:  convertFSxConfiguration(volume.FSx),
+				},
+			)
+			continue
+		}
+
 		// If EFS is not configured, just add the name to create an empty volume and continue.
 		if volume.EmptyVolume() {
 			output = append(
@@ -653,7 +725,59 @@ func convertEFSConfiguration(in manifest.EFSVolumeConfiguration) *template.EFSVo
 	}
 }
 
-func convertNetworkConfig(network manifest.NetworkConfig) template.NetworkOpts {
+func convertFSxConfiguration(in manifest.FSxWindowsVolumeConfiguration) *template.FSxWindowsVolumeConfiguration {
+	return &template.FSxWindowsVolumeConfiguration{
+		Filesystem:           in.FileSystemID,
+		RootDirectory:        in.RootDirectory,
+		CredentialsParameter: in.CredentialsParameter,
+		Domain:               in.Domain,
+	}
+}
+
+func convertFSxCredentials(input map[string]*manifest.Volume) []string {
+	var output []string
+	seen := make(map[string]bool)
+	for _, volume := range input {
+		if volume.FSx.IsEmpty() {
+			continue
+		}
+		credentials := aws.StringValue(volume.FSx.CredentialsParameter)
+		if seen[credentials] {
+			continue
+		}
+		seen[credentials] = true
+		output = append(output, credentials)
+	}
+	// Sort the credentials so that the rendered template is deterministic.
+	sort.Strings(output)
+	return output
+}
+
+func convertManagedFSxInfo(input map[string]*manifest.Volume) *template.ManagedFSxCreationInfo {
+	for _, volume := range input {
+		if volume.FSx.IsEmpty() || !volume.FSx.UseManagedFS() {
+			continue
+		}
+		storageCapacity := volume.FSx.StorageCapacity
+		if storageCapacity == nil {
+			storageCapacity = aws.Int(defaultFSxStorageCapacityGiB)
+		}
+		throughputCapacity := volume.FSx.ThroughputCapacity
+		if throughputCapacity == nil {
+			throughputCapacity = aws.Int(defaultFSxThroughputCapacity)
+		}
+		return &template.ManagedFSxCreationInfo{
+			ActiveDirectoryID:  volume.FSx.ActiveDirectoryID,
+			StorageCapacity:    storageCapacity,
+			ThroughputCapacity: throughputCapacity,
+		}
+	}
+	return nil
+}
+
+// convertNetworkConfig converts the network configuration of a manifest to template options.
+// azSubnetIDs are the subnets resolved from the availability zones in the placement of the manifest, if any.
+func convertNetworkConfig(network manifest.NetworkConfig, azSubnetIDs []string) template.NetworkOpts {
 	if network.IsEmpty() {
 		return template.NetworkOpts{
 			AssignPublicIP: template.EnablePublicIP,
@@ -671,6 +795,15 @@ func convertNetworkConfig(network manifest.NetworkConfig) template.NetworkOpts {
 	if placement.IsEmpty() {
 		return opts
 	}
+	if placement.PlacementString == nil && len(placement.AZs) != 0 {
+		if placement.SubnetType() == manifest.PrivateSubnetPlacement {
+			opts.AssignPublicIP = template.DisablePublicIP
+		}
+		// Fall back to every subnet of the type if the subnets in the availability zones are not resolved.
+		opts.SubnetsType = subnetPlacementForTemplate[placement.SubnetType()]
+		opts.SubnetIDs = azSubnetIDs
+		return opts
+	}
 	if placement.PlacementString == nil {
 		opts.AssignPublicIP = template.DisablePublicIP
 		opts.SubnetIDs = placement.PlacementArgs.Subnets
@@ -716,7 +849,7 @@ func convertEntryPoint(entrypoint manifest.EntryPointOverride) ([]string, error)
 	return out, nil
 }
 
-func convertDeploymentConfig(deploymentConfig manifest.DeploymentConfiguration) template.DeploymentConfigurationOpts {
+func convertDeploymentConfig(deploymentConfig manifest.DeploymentConfiguration, alarms []manifest.WorkloadAlarm) template.DeploymentConfigurationOpts {
 	var deployConfigs template.DeploymentConfigurationOpts
 	if strings.EqualFold(aws.StringValue(deploymentConfig.Rolling), manifest.ECSRecreateRollingUpdateStrategy) {
 		deployConfigs.MinHealthyPercent = minHealthyPercentRecreate
@@ -725,9 +858,101 @@ func convertDeploymentConfig(deploymentConfig manifest.DeploymentConfiguration)
 		deployConfigs.MinHealthyPercent = minHealthyPercentDefault
 		deployConfigs.MaxPercent = maxPercentDefault
 	}
+	if deploymentConfig.IsBlueGreen() {
+		deployConfigs.BlueGreen = convertBlueGreenDeploymentConfig(deploymentConfig.BlueGreen)
+		deployConfigs.BlueGreen.TrafficRouting = convertCanaryDeploymentConfig(deploymentConfig.Canary)
+	}
+	for _, alarm := range alarms {
+		if alarm.Rollback() {
+			deployConfigs.RollbackAlarms = append(deployConfigs.RollbackAlarms, aws.StringValue(alarm.Name))
+		}
+	}
 	return deployConfigs
 }
 
+// alarmMetrics maps the metrics that workload alarms can watch to their CloudWatch namespace, name, and statistic.
+var alarmMetrics = map[string]template.AlarmOpts{
+	manifest.AlarmMetricCPU: {
+		Namespace:  "AWS/ECS",
+		MetricName: "CPUUtilization",
+		Statistic:  "Average",
+	},
+	manifest.AlarmMetricMemory: {
+		Namespace:  "AWS/ECS",
+		MetricName: "MemoryUtilization",
+		Statistic:  "Average",
+	},
+	manifest.AlarmMetricHTTP5xx: {
+		Namespace:   "AWS/ApplicationELB",
+		MetricName:  "HTTPCode_Target_5XX_Count",
+		Statistic:   "Sum",
+		TargetGroup: true,
+	},
+	manifest.AlarmMetricResponseTime: {
+		Namespace:   "AWS/ApplicationELB",
+		MetricName:  "TargetResponseTime",
+		Statistic:   "Average",
+		TargetGroup: true,
+	},
+}
+
+func convertAlarms(alarms []manifest.WorkloadAlarm) []template.AlarmOpts {
+	var out []template.AlarmOpts
+	for _, alarm := range alarms {
+		opts := alarmMetrics[strings.ToLower(aws.StringValue(alarm.Metric))]
+		opts.Name = aws.StringValue(alarm.Name)
+		opts.Threshold = aws.Float64Value(alarm.Threshold)
+		opts.Period = int64(alarm.AlarmPeriod().Seconds())
+		opts.Notify = !alarm.Rollback()
+		out = append(out, opts)
+	}
+	return out
+}
+
+func convertServiceDiscovery(in manifest.ServiceDiscoveryConfig) template.ServiceDiscoveryOpts {
+	out := template.ServiceDiscoveryOpts{
+		RecordType:    aws.StringValue(in.RecordType),
+		RoutingPolicy: aws.StringValue(in.RoutingPolicy),
+	}
+	if in.TTL != nil {
+		out.TTL = aws.Int64(int64(in.TTL.Seconds()))
+	}
+	return out
+}
+
+func convertBlueGreenDeploymentConfig(in manifest.BlueGreenDeploymentConfig) *template.BlueGreenDeploymentOpts {
+	out := &template.BlueGreenDeploymentOpts{
+		TestListenerPort:     defaultBlueGreenTestListenerPort,
+		DeploymentConfigName: blueGreenDeploymentConfigNames[manifest.BlueGreenAllAtOnceTrafficShifting],
+		RollbackAlarms:       in.RollbackAlarms,
+	}
+	if in.TestListenerPort != nil {
+		out.TestListenerPort = aws.Uint16Value(in.TestListenerPort)
+	}
+	if name, ok := blueGreenDeploymentConfigNames[strings.ToLower(aws.StringValue(in.TrafficShifting))]; ok {
+		out.DeploymentConfigName = name
+	}
+	return out
+}
+
+// convertCanaryDeploymentConfig returns the traffic routing of a custom CodeDeploy deployment configuration from canary steps.
+// A single step is a canary, while several steps are linear as the manifest validation ensures that they are evenly spaced.
+func convertCanaryDeploymentConfig(in manifest.CanaryDeploymentConfig) *template.TrafficRoutingOpts {
+	if in.IsEmpty() {
+		return nil
+	}
+	first := in.Steps[0]
+	out := &template.TrafficRoutingOpts{
+		Type:            template.TrafficRoutingTimeBasedCanary,
+		Percentage:      aws.IntValue(first.Weight),
+		IntervalMinutes: int(first.BakeTime().Minutes()),
+	}
+	if len(in.Steps) > 1 {
+		out.Type = template.TrafficRoutingTimeBasedLinear
+	}
+	return out
+}
+
 func convertCommand(command manifest.CommandOverride) ([]string, error) {
 	out, err := command.ToStringSlice()
 	if err != nil {
@@ -748,19 +973,45 @@ func convertPublish(topics []manifest.Topic, accountID, region, app, env, svc st
 	// convert the topics to template Topics
 	for _, topic := range topics {
 		publishers.Topics = append(publishers.Topics, &template.Topic{
-			Name:      topic.Name,
-			AccountID: accountID,
-			Partition: partition.ID(),
-			Region:    region,
-			App:       app,
-			Env:       env,
-			Svc:       svc,
+			Name:              topic.Name,
+			KMSKey:            topic.KMSKey,
+			AllowedPublishers: convertAllowedPrincipals(topic.AllowedPublishers),
+			AccountID:         accountID,
+			Partition:         partition.ID(),
+			Region:            region,
+			App:               app,
+			Env:               env,
+			Svc:               svc,
 		})
 	}
 
 	return &publishers, nil
 }
 
+// convertEvidently returns the Evidently project and feature flags of a service.
+// If the project name isn't specified in the manifest, it defaults to "<app>-<env>-<svc>".
+func convertEvidently(e manifest.Evidently, app, env, svc string) *template.EvidentlyOpts {
+	if e.IsEmpty() {
+		return nil
+	}
+	project := fmt.Sprintf("%s-%s-%s", app, env, svc)
+	if e.Project != nil {
+		project = aws.StringValue(e.Project)
+	}
+	features := make([]template.EvidentlyFeature, len(e.Features))
+	for i, feature := range e.Features {
+		features[i] = template.EvidentlyFeature{
+			Name:        aws.StringValue(feature.Name),
+			Description: aws.StringValue(feature.Description),
+			Enabled:     aws.BoolValue(feature.Enabled),
+		}
+	}
+	return &template.EvidentlyOpts{
+		Project:  project,
+		Features: features,
+	}
+}
+
 func convertSubscribe(s manifest.SubscribeConfig) (*template.SubscribeOpts, error) {
 	if s.Topics == nil {
 		return nil, nil
@@ -785,17 +1036,19 @@ func convertTopicSubscription(t manifest.TopicSubscription) (
 	}
 	if aws.BoolValue(t.Queue.Enabled) {
 		return &template.TopicSubscription{
-			Name:         t.Name,
-			Service:      t.Service,
-			Queue:        &template.SQSQueue{},
-			FilterPolicy: filterPolicy,
+			Name:               t.Name,
+			Service:            t.Service,
+			Queue:              &template.SQSQueue{},
+			FilterPolicy:       filterPolicy,
+			RawMessageDelivery: aws.BoolValue(t.RawMessageDelivery),
 		}, nil
 	}
 	return &template.TopicSubscription{
-		Name:         t.Name,
-		Service:      t.Service,
-		Queue:        convertQueue(t.Queue.Advanced),
-		FilterPolicy: filterPolicy,
+		Name:               t.Name,
+		Service:            t.Service,
+		Queue:              convertQueue(t.Queue.Advanced),
+		FilterPolicy:       filterPolicy,
+		RawMessageDelivery: aws.BoolValue(t.RawMessageDelivery),
 	}, nil
 }
 
@@ -815,10 +1068,22 @@ func convertQueue(q manifest.SQSQueue) *template.SQSQueue {
 		return nil
 	}
 	return &template.SQSQueue{
-		Retention:  convertRetention(q.Retention),
-		Delay:      convertDelay(q.Delay),
-		Timeout:    convertTimeout(q.Timeout),
-		DeadLetter: convertDeadLetter(q.DeadLetter),
+		Retention:         convertRetention(q.Retention),
+		Delay:             convertDelay(q.Delay),
+		Timeout:           convertTimeout(q.Timeout),
+		DeadLetter:        convertDeadLetter(q.DeadLetter),
+		KMSKey:            q.KMSKey,
+		AllowedPublishers: convertAllowedPrincipals(q.AllowedPublishers),
+	}
+}
+
+func convertAllowedPrincipals(p manifest.AllowedPrincipals) *template.AllowedPrincipals {
+	if p.IsEmpty() {
+		return nil
+	}
+	return &template.AllowedPrincipals{
+		ARNs:   p.ARNs,
+		OrgIDs: p.OrgIDs,
 	}
 }
 
@@ -861,9 +1126,11 @@ func convertAppInformation(app deploy.AppInformation) (delegationRole *string, d
 	return
 }
 
-func convertPlatform(platform manifest.PlatformArgsOrString) template.RuntimePlatformOpts {
+func convertPlatform(platform manifest.PlatformArgsOrString, pinnedVersion *string) template.RuntimePlatformOpts {
 	if platform.IsEmpty() {
-		return template.RuntimePlatformOpts{}
+		return template.RuntimePlatformOpts{
+			PinnedVersion: aws.StringValue(pinnedVersion),
+		}
 	}
 
 	os := template.OSLinux
@@ -879,8 +1146,9 @@ func convertPlatform(platform manifest.PlatformArgsOrString) template.RuntimePla
 		arch = template.ArchARM64
 	}
 	return template.RuntimePlatformOpts{
-		OS:   os,
-		Arch: arch,
+		OS:            os,
+		Arch:          arch,
+		PinnedVersion: aws.StringValue(pinnedVersion),
 	}
 }
 
@@ -29,6 +29,7 @@ func Test_convertSidecar(t *testing.T) {
 		inDependsOn       map[string]string
 		inImageOverride   manifest.ImageOverride
 		inHealthCheck     manifest.ContainerHealthCheck
+		inLogging         manifest.SidecarLogging
 		circDepContainers []string
 
 		wanted    *template.SidecarOpts
@@ -205,6 +206,25 @@ func Test_convertSidecar(t *testing.T) {
 				},
 			},
 		},
+		"with dedicated log group": {
+			inLogging: manifest.SidecarLogging{
+				GroupName: aws.String("/corp/nginx"),
+				Retention: aws.Int(7),
+			},
+
+			wanted: &template.SidecarOpts{
+				Name:       aws.String("foo"),
+				CredsParam: mockCredsParam,
+				Image:      mockImage,
+				Secrets:    mockSecrets,
+				Variables:  mockMap,
+				Essential:  aws.Bool(false),
+				LogGroup: &template.SidecarLogGroupOpts{
+					Name:      aws.String("/corp/nginx"),
+					Retention: aws.Int(7),
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -220,6 +240,7 @@ func Test_convertSidecar(t *testing.T) {
 					DependsOn:     tc.inDependsOn,
 					ImageOverride: tc.inImageOverride,
 					HealthCheck:   tc.inHealthCheck,
+					Logging:       tc.inLogging,
 				},
 			}
 			got, err := convertSidecar(sidecar)
@@ -637,6 +658,7 @@ func Test_convertHTTPHealthCheck(t *testing.T) {
 	// These are used by reference to represent the output of the manifest.durationp function.
 	duration15Seconds := 15 * time.Second
 	duration60Seconds := 60 * time.Second
+	duration90Seconds := 90 * time.Second
 	testCases := map[string]struct {
 		inputPath               *string
 		inputPort               *int
@@ -646,6 +668,7 @@ func Test_convertHTTPHealthCheck(t *testing.T) {
 		inputInterval           *time.Duration
 		inputTimeout            *time.Duration
 		inputGracePeriod        *time.Duration
+		inputStartPeriod        *time.Duration
 
 		wantedOpts template.HTTPHealthCheckOpts
 	}{
@@ -748,6 +771,31 @@ func Test_convertHTTPHealthCheck(t *testing.T) {
 				GracePeriod:     aws.Int64(60),
 			},
 		},
+		"grace period extended to a longer container health check start period": {
+			inputStartPeriod: &duration90Seconds,
+
+			wantedOpts: template.HTTPHealthCheckOpts{
+				HealthCheckPath: "/",
+				GracePeriod:     aws.Int64(90),
+			},
+		},
+		"default grace period kept with a shorter container health check start period": {
+			inputStartPeriod: &duration15Seconds,
+
+			wantedOpts: template.HTTPHealthCheckOpts{
+				HealthCheckPath: "/",
+				GracePeriod:     aws.Int64(60),
+			},
+		},
+		"explicit grace period is not overridden by the container health check start period": {
+			inputGracePeriod: &duration15Seconds,
+			inputStartPeriod: &duration90Seconds,
+
+			wantedOpts: template.HTTPHealthCheckOpts{
+				HealthCheckPath: "/",
+				GracePeriod:     aws.Int64(15),
+			},
+		},
 		"all values changed in manifest": {
 			inputPath:               aws.String("/road/to/nowhere"),
 			inputPort:               aws.Int(8080),
@@ -787,7 +835,9 @@ func Test_convertHTTPHealthCheck(t *testing.T) {
 				},
 			}
 			// WHEN
-			actualOpts := convertHTTPHealthCheck(&hc)
+			actualOpts := convertHTTPHealthCheck(&hc, manifest.ContainerHealthCheck{
+				StartPeriod: tc.inputStartPeriod,
+			})
 
 			// THEN
 			require.Equal(t, tc.wantedOpts, actualOpts)
@@ -1216,6 +1266,81 @@ func Test_convertStorageOpts(t *testing.T) {
 				},
 			},
 		},
+		"fsx with an existing file system": {
+			inVolumes: map[string]*manifest.Volume{
+				"shared": {
+					FSx: manifest.FSxWindowsVolumeConfiguration{
+						FileSystemID:         aws.String("fs-1234567890abcdef0"),
+						RootDirectory:        aws.String("share"),
+						Domain:               aws.String("corp.example.com"),
+						CredentialsParameter: aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:fsx-creds"),
+					},
+					MountPointOpts: manifest.MountPointOpts{
+						ContainerPath: aws.String(`C:\data`),
+						ReadOnly:      aws.Bool(false),
+					},
+				},
+			},
+			wantOpts: template.StorageOpts{
+				Volumes: []*template.Volume{
+					{
+						Name: aws.String("shared"),
+						FSx: &template.FSxWindowsVolumeConfiguration{
+							Filesystem:           aws.String("fs-1234567890abcdef0"),
+							RootDirectory:        aws.String("share"),
+							Domain:               aws.String("corp.example.com"),
+							CredentialsParameter: aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:fsx-creds"),
+						},
+					},
+				},
+				MountPoints: []*template.MountPoint{
+					{
+						ContainerPath: aws.String(`C:\data`),
+						ReadOnly:      aws.Bool(false),
+						SourceVolume:  aws.String("shared"),
+					},
+				},
+				FSxCredentials: []string{"arn:aws:secretsmanager:us-west-2:123456789012:secret:fsx-creds"},
+			},
+		},
+		"managed fsx with default capacities": {
+			inVolumes: map[string]*manifest.Volume{
+				"shared": {
+					FSx: manifest.FSxWindowsVolumeConfiguration{
+						ActiveDirectoryID:    aws.String("d-1234567890"),
+						Domain:               aws.String("corp.example.com"),
+						CredentialsParameter: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/fsx-creds"),
+					},
+					MountPointOpts: manifest.MountPointOpts{
+						ContainerPath: aws.String(`C:\data`),
+					},
+				},
+			},
+			wantOpts: template.StorageOpts{
+				Volumes: []*template.Volume{
+					{
+						Name: aws.String("shared"),
+						FSx: &template.FSxWindowsVolumeConfiguration{
+							Domain:               aws.String("corp.example.com"),
+							CredentialsParameter: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/fsx-creds"),
+						},
+					},
+				},
+				MountPoints: []*template.MountPoint{
+					{
+						ContainerPath: aws.String(`C:\data`),
+						ReadOnly:      aws.Bool(true),
+						SourceVolume:  aws.String("shared"),
+					},
+				},
+				FSxCredentials: []string{"arn:aws:ssm:us-west-2:123456789012:parameter/fsx-creds"},
+				ManagedFSxInfo: &template.ManagedFSxCreationInfo{
+					ActiveDirectoryID:  aws.String("d-1234567890"),
+					StorageCapacity:    aws.Int(32),
+					ThroughputCapacity: aws.Int(8),
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1233,6 +1358,8 @@ func Test_convertStorageOpts(t *testing.T) {
 			require.ElementsMatch(t, tc.wantOpts.MountPoints, got.MountPoints)
 			require.ElementsMatch(t, tc.wantOpts.Volumes, got.Volumes)
 			require.Equal(t, tc.wantOpts.ManagedVolumeInfo, got.ManagedVolumeInfo)
+			require.Equal(t, tc.wantOpts.FSxCredentials, got.FSxCredentials)
+			require.Equal(t, tc.wantOpts.ManagedFSxInfo, got.ManagedFSxInfo)
 		})
 	}
 }
@@ -1403,6 +1530,36 @@ func Test_convertPublish(t *testing.T) {
 				},
 			},
 		},
+		"publish with a custom key and allowed publishers": {
+			inTopics: []manifest.Topic{
+				{
+					Name:   aws.String("topic1"),
+					KMSKey: aws.String("alias/orders"),
+					AllowedPublishers: manifest.AllowedPrincipals{
+						ARNs:   []string{"arn:aws:iam::123456789012:role/publisher"},
+						OrgIDs: []string{"o-a1b2c3d4e5"},
+					},
+				},
+			},
+			wanted: &template.PublishOpts{
+				Topics: []*template.Topic{
+					{
+						Name:   aws.String("topic1"),
+						KMSKey: aws.String("alias/orders"),
+						AllowedPublishers: &template.AllowedPrincipals{
+							ARNs:   []string{"arn:aws:iam::123456789012:role/publisher"},
+							OrgIDs: []string{"o-a1b2c3d4e5"},
+						},
+						AccountID: accountId,
+						Partition: partition,
+						Region:    region,
+						App:       app,
+						Env:       env,
+						Svc:       svc,
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1416,6 +1573,67 @@ func Test_convertPublish(t *testing.T) {
 	}
 }
 
+func Test_convertEvidently(t *testing.T) {
+	testCases := map[string]struct {
+		in     manifest.Evidently
+		wanted *template.EvidentlyOpts
+	}{
+		"returns nil if evidently is not configured": {},
+		"defaults the project name to the service stack": {
+			in: manifest.Evidently{
+				Features: []manifest.EvidentlyFeature{
+					{
+						Name:        aws.String("dark-mode"),
+						Description: aws.String("Render the site in dark mode."),
+						Enabled:     aws.Bool(true),
+					},
+					{
+						Name: aws.String("new-checkout"),
+					},
+				},
+			},
+			wanted: &template.EvidentlyOpts{
+				Project: "phonetool-test-frontend",
+				Features: []template.EvidentlyFeature{
+					{
+						Name:        "dark-mode",
+						Description: "Render the site in dark mode.",
+						Enabled:     true,
+					},
+					{
+						Name: "new-checkout",
+					},
+				},
+			},
+		},
+		"uses the project name from the manifest": {
+			in: manifest.Evidently{
+				Project: aws.String("storefront"),
+				Features: []manifest.EvidentlyFeature{
+					{
+						Name: aws.String("dark-mode"),
+					},
+				},
+			},
+			wanted: &template.EvidentlyOpts{
+				Project: "storefront",
+				Features: []template.EvidentlyFeature{
+					{
+						Name: "dark-mode",
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := convertEvidently(tc.in, "phonetool", "test", "frontend")
+
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func Test_convertSubscribe(t *testing.T) {
 	duration111Seconds := 111 * time.Second
 	mockStruct := map[string]interface{}{
@@ -1490,6 +1708,46 @@ func Test_convertSubscribe(t *testing.T) {
 				Queue: nil,
 			},
 		},
+		"valid subscribe with raw message delivery, custom keys and allowed publishers": {
+			inSubscribe: manifest.SubscribeConfig{
+				Topics: []manifest.TopicSubscription{
+					{
+						Name:               aws.String("name"),
+						Service:            aws.String("svc"),
+						RawMessageDelivery: aws.Bool(true),
+						Queue: manifest.SQSQueueOrBool{
+							Advanced: manifest.SQSQueue{
+								KMSKey: aws.String("alias/topic-queue"),
+							},
+						},
+					},
+				},
+				Queue: manifest.SQSQueue{
+					KMSKey: aws.String("alias/queue"),
+					AllowedPublishers: manifest.AllowedPrincipals{
+						OrgIDs: []string{"o-a1b2c3d4e5"},
+					},
+				},
+			},
+			wanted: &template.SubscribeOpts{
+				Topics: []*template.TopicSubscription{
+					{
+						Name:               aws.String("name"),
+						Service:            aws.String("svc"),
+						RawMessageDelivery: true,
+						Queue: &template.SQSQueue{
+							KMSKey: aws.String("alias/topic-queue"),
+						},
+					},
+				},
+				Queue: &template.SQSQueue{
+					KMSKey: aws.String("alias/queue"),
+					AllowedPublishers: &template.AllowedPrincipals{
+						OrgIDs: []string{"o-a1b2c3d4e5"},
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1502,10 +1760,17 @@ func Test_convertSubscribe(t *testing.T) {
 
 func Test_convertPlatform(t *testing.T) {
 	testCases := map[string]struct {
-		in  manifest.PlatformArgsOrString
-		out template.RuntimePlatformOpts
+		in        manifest.PlatformArgsOrString
+		inVersion *string
+		out       template.RuntimePlatformOpts
 	}{
 		"should return empty struct if user did not set a platform field in the manifest": {},
+		"should return the pinned platform version even if user did not set a platform field": {
+			inVersion: aws.String("1.4.0"),
+			out: template.RuntimePlatformOpts{
+				PinnedVersion: "1.4.0",
+			},
+		},
 		"should return windows server 2019 full and x86_64 when advanced config specifies full": {
 			in: manifest.PlatformArgsOrString{
 				PlatformArgs: manifest.PlatformArgs{
@@ -1549,7 +1814,7 @@ func Test_convertPlatform(t *testing.T) {
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			require.Equal(t, tc.out, convertPlatform(tc.in))
+			require.Equal(t, tc.out, convertPlatform(tc.in, tc.inVersion))
 		})
 	}
 }
@@ -1572,6 +1837,215 @@ func Test_convertHTTPVersion(t *testing.T) {
 	}
 }
 
+func Test_convertDeploymentConfig(t *testing.T) {
+	fiveMinutes, fifteenMinutes := 5*time.Minute, 15*time.Minute
+	testCases := map[string]struct {
+		in     manifest.DeploymentConfiguration
+		alarms []manifest.WorkloadAlarm
+		wanted template.DeploymentConfigurationOpts
+	}{
+		"rolling update by default": {
+			wanted: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: 100,
+				MaxPercent:        200,
+			},
+		},
+		"recreate rolling update": {
+			in: manifest.DeploymentConfiguration{
+				Rolling: aws.String("recreate"),
+			},
+			wanted: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: 0,
+				MaxPercent:        100,
+			},
+		},
+		"blue/green deployment with defaults": {
+			in: manifest.DeploymentConfiguration{
+				Strategy: aws.String("blue/green"),
+			},
+			wanted: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: 100,
+				MaxPercent:        200,
+				BlueGreen: &template.BlueGreenDeploymentOpts{
+					TestListenerPort:     8080,
+					DeploymentConfigName: "CodeDeployDefault.ECSAllAtOnce",
+				},
+			},
+		},
+		"blue/green deployment with canary traffic shifting and rollback alarms": {
+			in: manifest.DeploymentConfiguration{
+				Strategy: aws.String("blue/green"),
+				BlueGreen: manifest.BlueGreenDeploymentConfig{
+					TestListenerPort: aws.Uint16(9000),
+					TrafficShifting:  aws.String("canary"),
+					RollbackAlarms:   []string{"HighLatency", "HighErrorRate"},
+				},
+			},
+			wanted: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: 100,
+				MaxPercent:        200,
+				BlueGreen: &template.BlueGreenDeploymentOpts{
+					TestListenerPort:     9000,
+					DeploymentConfigName: "CodeDeployDefault.ECSCanary10Percent5Minutes",
+					RollbackAlarms:       []string{"HighLatency", "HighErrorRate"},
+				},
+			},
+		},
+		"blue/green deployment with a single canary step": {
+			in: manifest.DeploymentConfiguration{
+				Strategy: aws.String("blue/green"),
+				Canary: manifest.CanaryDeploymentConfig{
+					Steps: []manifest.CanaryStep{
+						{Weight: aws.Int(20), Bake: &fifteenMinutes},
+					},
+				},
+			},
+			wanted: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: 100,
+				MaxPercent:        200,
+				BlueGreen: &template.BlueGreenDeploymentOpts{
+					TestListenerPort:     8080,
+					DeploymentConfigName: "CodeDeployDefault.ECSAllAtOnce",
+					TrafficRouting: &template.TrafficRoutingOpts{
+						Type:            "TimeBasedCanary",
+						Percentage:      20,
+						IntervalMinutes: 15,
+					},
+				},
+			},
+		},
+		"blue/green deployment with linear canary steps": {
+			in: manifest.DeploymentConfiguration{
+				Strategy: aws.String("blue/green"),
+				Canary: manifest.CanaryDeploymentConfig{
+					Steps: []manifest.CanaryStep{
+						{Weight: aws.Int(25), Bake: &fiveMinutes},
+						{Weight: aws.Int(50), Bake: &fiveMinutes},
+						{Weight: aws.Int(75), Bake: &fiveMinutes},
+					},
+				},
+			},
+			wanted: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: 100,
+				MaxPercent:        200,
+				BlueGreen: &template.BlueGreenDeploymentOpts{
+					TestListenerPort:     8080,
+					DeploymentConfigName: "CodeDeployDefault.ECSAllAtOnce",
+					TrafficRouting: &template.TrafficRoutingOpts{
+						Type:            "TimeBasedLinear",
+						Percentage:      25,
+						IntervalMinutes: 5,
+					},
+				},
+			},
+		},
+		"rolling update rolled back by alarms": {
+			alarms: []manifest.WorkloadAlarm{
+				{Name: aws.String("high-cpu"), Metric: aws.String("cpu"), Threshold: aws.Float64(80)},
+				{Name: aws.String("slow"), Metric: aws.String("response_time"), Threshold: aws.Float64(1), Action: aws.String("notify")},
+				{Name: aws.String("errors"), Metric: aws.String("http_5xx"), Threshold: aws.Float64(10), Action: aws.String("rollback")},
+			},
+			wanted: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: 100,
+				MaxPercent:        200,
+				RollbackAlarms:    []string{"high-cpu", "errors"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertDeploymentConfig(tc.in, tc.alarms))
+		})
+	}
+}
+
+func Test_convertAlarms(t *testing.T) {
+	fiveMinutes := 5 * time.Minute
+	testCases := map[string]struct {
+		in     []manifest.WorkloadAlarm
+		wanted []template.AlarmOpts
+	}{
+		"no alarms": {},
+		"alarms on service and load balancer metrics": {
+			in: []manifest.WorkloadAlarm{
+				{Name: aws.String("high-cpu"), Metric: aws.String("cpu"), Threshold: aws.Float64(80)},
+				{Name: aws.String("high-memory"), Metric: aws.String("Memory"), Threshold: aws.Float64(90), Period: &fiveMinutes},
+				{Name: aws.String("errors"), Metric: aws.String("http_5xx"), Threshold: aws.Float64(10), Action: aws.String("notify")},
+				{Name: aws.String("slow"), Metric: aws.String("response_time"), Threshold: aws.Float64(0.5)},
+			},
+			wanted: []template.AlarmOpts{
+				{
+					Name:       "high-cpu",
+					Namespace:  "AWS/ECS",
+					MetricName: "CPUUtilization",
+					Statistic:  "Average",
+					Threshold:  80,
+					Period:     60,
+				},
+				{
+					Name:       "high-memory",
+					Namespace:  "AWS/ECS",
+					MetricName: "MemoryUtilization",
+					Statistic:  "Average",
+					Threshold:  90,
+					Period:     300,
+				},
+				{
+					Name:        "errors",
+					Namespace:   "AWS/ApplicationELB",
+					MetricName:  "HTTPCode_Target_5XX_Count",
+					Statistic:   "Sum",
+					Threshold:   10,
+					Period:      60,
+					TargetGroup: true,
+					Notify:      true,
+				},
+				{
+					Name:        "slow",
+					Namespace:   "AWS/ApplicationELB",
+					MetricName:  "TargetResponseTime",
+					Statistic:   "Average",
+					Threshold:   0.5,
+					Period:      60,
+					TargetGroup: true,
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertAlarms(tc.in))
+		})
+	}
+}
+
+func Test_convertServiceDiscovery(t *testing.T) {
+	oneMinute := time.Minute
+	testCases := map[string]struct {
+		in     manifest.ServiceDiscoveryConfig
+		wanted template.ServiceDiscoveryOpts
+	}{
+		"defaults if not configured": {},
+		"SRV records with a weighted routing policy": {
+			in: manifest.ServiceDiscoveryConfig{
+				TTL:           &oneMinute,
+				RecordType:    aws.String("SRV"),
+				RoutingPolicy: aws.String("WEIGHTED"),
+			},
+			wanted: template.ServiceDiscoveryOpts{
+				TTL:           aws.Int64(60),
+				RecordType:    "SRV",
+				RoutingPolicy: "WEIGHTED",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertServiceDiscovery(tc.in))
+		})
+	}
+}
+
 func Test_convertCustomResources(t *testing.T) {
 	testCases := map[string]struct {
 		in        map[string]string
@@ -1610,3 +2084,150 @@ func Test_convertCustomResources(t *testing.T) {
 		})
 	}
 }
+
+func Test_convertLogGroup(t *testing.T) {
+	testCases := map[string]struct {
+		inLogging     manifest.Logging
+		inDefaultName *string
+
+		wanted template.LogGroupOpts
+	}{
+		"empty logging configuration": {
+			wanted: template.LogGroupOpts{},
+		},
+		"uses the name generated from the naming convention": {
+			inDefaultName: aws.String("/copilot/corp-phonetool-test-frontend"),
+			inLogging: manifest.Logging{
+				KMSKeyARN: aws.String("mockKeyARN"),
+			},
+			wanted: template.LogGroupOpts{
+				Name:      aws.String("/copilot/corp-phonetool-test-frontend"),
+				KMSKeyARN: aws.String("mockKeyARN"),
+			},
+		},
+		"manifest log group name takes precedence": {
+			inDefaultName: aws.String("/copilot/corp-phonetool-test-frontend"),
+			inLogging: manifest.Logging{
+				GroupName: aws.String("/corp/frontend"),
+			},
+			wanted: template.LogGroupOpts{
+				Name: aws.String("/corp/frontend"),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertLogGroup(tc.inLogging, tc.inDefaultName))
+		})
+	}
+}
+
+func Test_convertHTTPRateLimit(t *testing.T) {
+	testCases := map[string]struct {
+		in *manifest.HTTPRateLimit
+
+		wanted      *template.RateLimitOpts
+		wantedError error
+	}{
+		"no rate limit": {},
+		"requests per second": {
+			in: (*manifest.HTTPRateLimit)(aws.String("1000rps-per-ip")),
+
+			wanted: &template.RateLimitOpts{Limit: 300000},
+		},
+		"requests per minute": {
+			in: (*manifest.HTTPRateLimit)(aws.String("60rpm-per-ip")),
+
+			wanted: &template.RateLimitOpts{Limit: 300},
+		},
+		"invalid rate limit": {
+			in: (*manifest.HTTPRateLimit)(aws.String("fast")),
+
+			wantedError: errors.New(`invalid rate limit "fast". Should be in format of ${requests}rps-per-ip or ${requests}rpm-per-ip`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertHTTPRateLimit(tc.in)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertNetworkConfig(t *testing.T) {
+	privatePlacement := manifest.PrivateSubnetPlacement
+	testCases := map[string]struct {
+		inPlacement   manifest.PlacementArgOrString
+		inAZSubnetIDs []string
+
+		wanted template.NetworkOpts
+	}{
+		"should place tasks in public subnets by default": {
+			wanted: template.NetworkOpts{
+				AssignPublicIP: template.EnablePublicIP,
+				SubnetsType:    template.PublicSubnetsPlacement,
+			},
+		},
+		"should place tasks in private subnets": {
+			inPlacement: manifest.PlacementArgOrString{
+				PlacementString: &privatePlacement,
+			},
+			wanted: template.NetworkOpts{
+				AssignPublicIP: template.DisablePublicIP,
+				SubnetsType:    template.PrivateSubnetsPlacement,
+			},
+		},
+		"should place tasks in specific subnets": {
+			inPlacement: manifest.PlacementArgOrString{
+				PlacementArgs: manifest.PlacementArgs{
+					Subnets: []string{"subnet-1", "subnet-2"},
+				},
+			},
+			wanted: template.NetworkOpts{
+				AssignPublicIP: template.DisablePublicIP,
+				SubnetsType:    template.PublicSubnetsPlacement,
+				SubnetIDs:      []string{"subnet-1", "subnet-2"},
+			},
+		},
+		"should place tasks in the public subnets of availability zones": {
+			inPlacement: manifest.PlacementArgOrString{
+				PlacementArgs: manifest.PlacementArgs{
+					AZs: []string{"us-west-2a"},
+				},
+			},
+			inAZSubnetIDs: []string{"subnet-1"},
+			wanted: template.NetworkOpts{
+				AssignPublicIP: template.EnablePublicIP,
+				SubnetsType:    template.PublicSubnetsPlacement,
+				SubnetIDs:      []string{"subnet-1"},
+			},
+		},
+		"should place tasks in the private subnets of availability zones": {
+			inPlacement: manifest.PlacementArgOrString{
+				PlacementArgs: manifest.PlacementArgs{
+					AZs:  []string{"us-west-2a", "us-west-2b"},
+					Type: &privatePlacement,
+				},
+			},
+			inAZSubnetIDs: []string{"subnet-3", "subnet-4"},
+			wanted: template.NetworkOpts{
+				AssignPublicIP: template.DisablePublicIP,
+				SubnetsType:    template.PrivateSubnetsPlacement,
+				SubnetIDs:      []string{"subnet-3", "subnet-4"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var network manifest.NetworkConfig
+			network.VPC.Placement = tc.inPlacement
+
+			require.Equal(t, tc.wanted, convertNetworkConfig(network, tc.inAZSubnetIDs))
+		})
+	}
+}
@@ -115,11 +115,13 @@ func (s *WorkerService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf(`convert "publish" field for service %s: %w`, s.name, err)
 	}
-	content, err := s.parser.ParseWorkerService(template.WorkloadOpts{
+	opts := template.WorkloadOpts{
 		AppName:            s.app,
 		EnvName:            s.env,
 		WorkloadName:       s.name,
+		ResourcePrefix:     s.rc.ResourcePrefix,
 		SerializedManifest: string(s.rawManifest),
+		DeploymentMetadata: s.rc.deploymentMetadataOpts(),
 
 		Variables:                s.manifest.WorkerServiceConfig.Variables,
 		Secrets:                  convertSecrets(s.manifest.WorkerServiceConfig.Secrets),
@@ -133,11 +135,13 @@ func (s *WorkerService) Template() (string, error) {
 		WorkloadType:             manifest.WorkerServiceType,
 		HealthCheck:              convertContainerHealthCheck(s.manifest.WorkerServiceConfig.ImageConfig.HealthCheck),
 		LogConfig:                convertLogging(s.manifest.Logging),
+		LogGroup:                 convertLogGroup(s.manifest.Logging, s.logGroupName()),
 		DockerLabels:             s.manifest.ImageConfig.Image.DockerLabels,
 		CustomResources:          crs,
 		Storage:                  convertStorageOpts(s.manifest.Name, s.manifest.Storage),
-		Network:                  convertNetworkConfig(s.manifest.Network),
-		DeploymentConfiguration:  convertDeploymentConfig(s.manifest.DeployConfig),
+		Network:                  convertNetworkConfig(s.manifest.Network, s.rc.PlacementSubnetIDs),
+		DeploymentConfiguration:  convertDeploymentConfig(s.manifest.DeployConfig, s.manifest.Alarms),
+		Alarms:                   convertAlarms(s.manifest.Alarms),
 		EntryPoint:               entrypoint,
 		Command:                  command,
 		DependsOn:                convertDependsOn(s.manifest.ImageConfig.Image.DependsOn),
@@ -145,11 +149,16 @@ func (s *WorkerService) Template() (string, error) {
 		ServiceDiscoveryEndpoint: s.rc.ServiceDiscoveryEndpoint,
 		Subscribe:                subscribe,
 		Publish:                  publishers,
-		Platform:                 convertPlatform(s.manifest.Platform),
+		Evidently:                convertEvidently(s.manifest.Evidently, s.app, s.env, s.name),
+		Platform:                 convertPlatform(s.manifest.Platform, s.manifest.PlatformVersion),
 		Observability: template.ObservabilityOpts{
 			Tracing: strings.ToUpper(aws.StringValue(s.manifest.Observability.Tracing)),
 		},
-	})
+	}
+	if err := validateEnvVars(opts); err != nil {
+		return "", err
+	}
+	content, err := s.parser.ParseWorkerService(opts)
 	if err != nil {
 		return "", fmt.Errorf("parse worker service template: %w", err)
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/template/override"
+	"github.com/aws/copilot-cli/internal/pkg/version"
 )
 
 // Template rendering configuration common across workloads.
@@ -76,6 +77,11 @@ type RuntimeConfig struct {
 	EnvFileARN         string            // Optional. S3 object ARN for the env file.
 	AdditionalTags     map[string]string // AdditionalTags are labels applied to resources in the workload stack.
 	CustomResourcesURL map[string]string // Mapping of Custom Resource Function Name to the S3 URL where the function zip file is stored.
+	ResourcePrefix     string            // Optional. Prefix of the physical names of resources under the application's naming convention.
+	PlacementSubnetIDs []string          // Optional. Subnets in the availability zones of the "network.vpc.placement.azs" field.
+	// Optional. Task definition that the service was last deployed with by CloudFormation, set for blue/green deployments.
+	DeployedTaskDefinitionARN string
+	Deployment                *DeploymentMetadata // Optional. Who deployed the workload and from which source.
 
 	// The target environment metadata.
 	ServiceDiscoveryEndpoint string // Endpoint for the service discovery namespace in the environment.
@@ -83,6 +89,26 @@ type RuntimeConfig struct {
 	Region                   string
 }
 
+// DeploymentMetadata represents who deployed a workload and from which source.
+// It's recorded in the metadata of the workload template so that it can be described later on.
+type DeploymentMetadata struct {
+	Deployer string // ARN of the identity that deployed the workload.
+	Commit   string // Optional. Git commit of the workspace that the workload was deployed from.
+}
+
+// deploymentMetadataOpts returns the deployment details to record in the workload template,
+// or nil if the template isn't rendered for a deployment.
+func (rc RuntimeConfig) deploymentMetadataOpts() *template.DeploymentMetadataOpts {
+	if rc.Deployment == nil {
+		return nil
+	}
+	return &template.DeploymentMetadataOpts{
+		Deployer: rc.Deployment.Deployer,
+		Commit:   rc.Deployment.Commit,
+		Version:  version.Version,
+	}
+}
+
 // ECRImage represents configuration about the pushed ECR image that is needed to
 // create a CloudFormation stack.
 type ECRImage struct {
@@ -92,16 +118,17 @@ type ECRImage struct {
 }
 
 // GetLocation returns the ECR image URI.
-// If a tag is provided by the user or discovered from git then prioritize referring to the image via the tag.
-// Otherwise, each image after a push to ECR will get a digest and we refer to the image via the digest.
+// Each image after a push to ECR gets a digest, and we prioritize referring to the image via the digest so that
+// the task definition is pinned to the exact image that was pushed even if its tags are moved later on.
+// Otherwise, if a tag is provided by the user or discovered from git, we refer to the image via the tag.
 // Finally, if no digest or tag is present, this occurs with the "package" commands, we default to the "latest" tag.
 func (i ECRImage) GetLocation() string {
-	if i.ImageTag != "" {
-		return fmt.Sprintf("%s:%s", i.RepoURL, i.ImageTag)
-	}
 	if i.Digest != "" {
 		return fmt.Sprintf("%s@%s", i.RepoURL, i.Digest)
 	}
+	if i.ImageTag != "" {
+		return fmt.Sprintf("%s:%s", i.RepoURL, i.ImageTag)
+	}
 	return fmt.Sprintf("%s:%s", i.RepoURL, "latest")
 }
 
@@ -284,6 +311,16 @@ type ecsWkld struct {
 	taskDefOverrideFunc func(overrideRules []override.Rule, origTemp []byte) ([]byte, error)
 }
 
+// logGroupName returns the name of the workload's log group under the application's naming convention.
+// If the application doesn't have a naming convention, returns nil so that the default name is used.
+func (w *ecsWkld) logGroupName() *string {
+	if w.rc.ResourcePrefix == "" {
+		return nil
+	}
+	names := deploy.ResourceNames{Prefix: w.rc.ResourcePrefix}
+	return aws.String(names.WorkloadLogGroup(w.app, w.env, w.name))
+}
+
 // Parameters returns the list of CloudFormation parameters used by the template.
 func (w *ecsWkld) Parameters() ([]*cloudformation.Parameter, error) {
 	wkldParameters, err := w.wkld.Parameters()
@@ -6,6 +6,8 @@ package stack
 import (
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/stretchr/testify/require"
 )
 
@@ -15,20 +17,20 @@ func TestECRImage_GetLocation(t *testing.T) {
 
 		wanted string
 	}{
-		"should use the image tag over anything else": {
+		"should use the image digest over anything else": {
 			in: ECRImage{
 				RepoURL:  "aws_account_id.dkr.ecr.us-west-2.amazonaws.com/amazonlinux",
 				ImageTag: "ab1f5575",
 				Digest:   "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
 			},
-			wanted: "aws_account_id.dkr.ecr.us-west-2.amazonaws.com/amazonlinux:ab1f5575",
+			wanted: "aws_account_id.dkr.ecr.us-west-2.amazonaws.com/amazonlinux@sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
 		},
-		"should use the digest if no tag is provided": {
+		"should use the tag if no digest is provided": {
 			in: ECRImage{
-				RepoURL: "aws_account_id.dkr.ecr.us-west-2.amazonaws.com/amazonlinux",
-				Digest:  "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+				RepoURL:  "aws_account_id.dkr.ecr.us-west-2.amazonaws.com/amazonlinux",
+				ImageTag: "ab1f5575",
 			},
-			wanted: "aws_account_id.dkr.ecr.us-west-2.amazonaws.com/amazonlinux@sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+			wanted: "aws_account_id.dkr.ecr.us-west-2.amazonaws.com/amazonlinux:ab1f5575",
 		},
 		"should use the latest image if nothing is provided": {
 			in: ECRImage{
@@ -44,3 +46,34 @@ func TestECRImage_GetLocation(t *testing.T) {
 		})
 	}
 }
+
+func TestRuntimeConfig_deploymentMetadataOpts(t *testing.T) {
+	testCases := map[string]struct {
+		in RuntimeConfig
+
+		wanted *template.DeploymentMetadataOpts
+	}{
+		"should not record anything if the template isn't rendered for a deployment": {
+			in: RuntimeConfig{},
+		},
+		"should record the deployer and the commit": {
+			in: RuntimeConfig{
+				Deployment: &DeploymentMetadata{
+					Deployer: "arn:aws:sts::123456789012:assumed-role/Admin/jane",
+					Commit:   "4e1b5c2",
+				},
+			},
+			wanted: &template.DeploymentMetadataOpts{
+				Deployer: "arn:aws:sts::123456789012:assumed-role/Admin/jane",
+				Commit:   "4e1b5c2",
+				Version:  version.Version,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.deploymentMetadataOpts())
+		})
+	}
+}
@@ -7,28 +7,62 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress"
 )
 
+// workloadAddonsLogicalID is the logical ID of the nested addons stack in a workload template.
+const workloadAddonsLogicalID = "AddonsStack"
+
 // DeployService deploys a service stack and renders progress updates to out until the deployment is done.
 // If the service stack doesn't exist, then it creates the stack.
 // If the service stack already exists, it updates the stack.
+// If the service stack failed to be created and was rolled back, it returns an ErrStackRollbackComplete.
 func (cf CloudFormation) DeployService(out progress.FileWriter, conf StackConfiguration, bucketName string, opts ...cloudformation.StackOption) error {
-	templateURL, err := cf.uploadStackTemplateToS3(bucketName, conf)
+	stack, err := cf.workloadStackToDeploy(conf, bucketName, opts...)
 	if err != nil {
 		return err
 	}
+	return cf.renderStackChanges(cf.newRenderWorkloadInput(out, stack))
+}
+
+// DeployServiceNoWait starts creating or updating the CloudFormation stack of a workload without waiting for it to complete.
+// It returns the ID of the change set that is executed.
+func (cf CloudFormation) DeployServiceNoWait(out progress.FileWriter, conf StackConfiguration, bucketName string, opts ...cloudformation.StackOption) (string, error) {
+	stack, err := cf.workloadStackToDeploy(conf, bucketName, opts...)
+	if err != nil {
+		return "", err
+	}
+	return cf.newRenderWorkloadInput(out, stack).createChangeSet()
+}
+
+// RenderWorkloadUpdate renders the update in progress on the workload stack to out until it completes.
+// If the stack is not being updated, it returns an error only if the last update failed.
+func (cf CloudFormation) RenderWorkloadUpdate(out progress.FileWriter, stackName string) error {
+	return cf.renderStackUpdate(out, stackName, fmt.Sprintf("Updating the infrastructure for stack %s", stackName))
+}
+
+// workloadStackToDeploy uploads the template of the workload stack and returns the stack to deploy once it's ready to be updated.
+func (cf CloudFormation) workloadStackToDeploy(conf StackConfiguration, bucketName string, opts ...cloudformation.StackOption) (*cloudformation.Stack, error) {
+	if err := cf.errOnRollbackComplete(conf.StackName()); err != nil {
+		return nil, err
+	}
+	templateURL, err := cf.uploadStackTemplateToS3(bucketName, conf)
+	if err != nil {
+		return nil, err
+	}
 	stack, err := toStackFromS3(conf, templateURL)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	for _, opt := range opts {
 		opt(stack)
 	}
-	return cf.renderStackChanges(cf.newRenderWorkloadInput(out, stack))
+	return stack, nil
 }
 
 type uploadableStack interface {
@@ -66,3 +100,46 @@ func (cf CloudFormation) handleStackError(stackName string, err error) error {
 func (cf CloudFormation) DeleteWorkload(in deploy.DeleteWorkloadInput) error {
 	return cf.cfnClient.DeleteAndWait(fmt.Sprintf("%s-%s-%s", in.AppName, in.EnvName, in.Name))
 }
+
+// WorkloadTemplate returns the template body of a deployed workload stack.
+func (cf CloudFormation) WorkloadTemplate(stackName string) (string, error) {
+	return cf.cfnClient.TemplateBody(stackName)
+}
+
+// WorkloadParameters returns the parameters of a deployed workload stack.
+func (cf CloudFormation) WorkloadParameters(stackName string) ([]*sdkcloudformation.Parameter, error) {
+	out, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		return nil, err
+	}
+	return out.Parameters, nil
+}
+
+// WorkloadOutputs returns the outputs of a deployed workload stack.
+func (cf CloudFormation) WorkloadOutputs(stackName string) (map[string]string, error) {
+	out, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		return nil, err
+	}
+	outputs := make(map[string]string)
+	for _, output := range out.Outputs {
+		outputs[aws.StringValue(output.OutputKey)] = aws.StringValue(output.OutputValue)
+	}
+	return outputs, nil
+}
+
+// WorkloadAddonsTemplate returns the template body of the addons nested stack of a deployed workload.
+// If the workload has no addons stack, it returns an empty string.
+func (cf CloudFormation) WorkloadAddonsTemplate(stackName string) (string, error) {
+	resources, err := cf.cfnClient.StackResources(stackName)
+	if err != nil {
+		return "", fmt.Errorf("describe resources of stack %s: %w", stackName, err)
+	}
+	for _, r := range resources {
+		if aws.StringValue(r.LogicalResourceId) != workloadAddonsLogicalID || aws.StringValue(r.PhysicalResourceId) == "" {
+			continue
+		}
+		return cf.cfnClient.TemplateBody(aws.StringValue(r.PhysicalResourceId))
+	}
+	return "", nil
+}
@@ -4,10 +4,13 @@
 package cloudformation
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -71,6 +74,24 @@ func TestCloudFormation_DeployService(t *testing.T) {
 		return cf.DeployService(w, serviceConfig, "mockBucket")
 	}
 
+	t.Run("returns an ErrStackRollbackComplete if the stack failed to be created", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockcfnClient(ctrl)
+		m.EXPECT().Describe("myapp-myenv-mysvc").Return(&cloudformation.StackDescription{
+			StackStatus: aws.String(sdkcloudformation.StackStatusRollbackComplete),
+		}, nil)
+		client := CloudFormation{cfnClient: m}
+
+		// WHEN
+		err := when(mockFileWriter{Writer: new(strings.Builder)}, client)
+
+		// THEN
+		var errRollbackComplete *ErrStackRollbackComplete
+		require.True(t, errors.As(err, &errRollbackComplete))
+		require.EqualError(t, err, "stack myapp-myenv-mysvc failed to be created and is in ROLLBACK_COMPLETE state")
+	})
 	t.Run("returns a wrapped error if pushing to s3 bucket fails", func(t *testing.T) {
 		testDeployWorkload_OnPushToS3Failure(t, when)
 	})
@@ -104,6 +125,103 @@ func TestCloudFormation_DeployService(t *testing.T) {
 	})
 }
 
+func TestCloudFormation_DeployServiceNoWait(t *testing.T) {
+	serviceConfig := &mockStackConfig{
+		name:     "myapp-myenv-mysvc",
+		template: "template",
+	}
+	when := func(w progress.FileWriter, cf CloudFormation) error {
+		_, err := cf.DeployServiceNoWait(w, serviceConfig, "mockBucket")
+		return err
+	}
+
+	t.Run("returns a wrapped error if pushing to s3 bucket fails", func(t *testing.T) {
+		testDeployWorkload_OnPushToS3Failure(t, when)
+	})
+	t.Run("returns a wrapped error if creating a change set fails", func(t *testing.T) {
+		testDeployWorkload_OnCreateChangeSetFailure(t, when)
+	})
+	t.Run("calls Update if stack is already created and returns wrapped error if Update fails", func(t *testing.T) {
+		testDeployWorkload_OnUpdateChangeSetFailure(t, when)
+	})
+	t.Run("returns the ID of the change set without waiting for the stack", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mS3Client := mocks.NewMocks3Client(ctrl)
+		mS3Client.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("", nil)
+		m := mocks.NewMockcfnClient(ctrl)
+		m.EXPECT().Describe("myapp-myenv-mysvc").Return(nil, &cloudformation.ErrStackNotFound{})
+		m.EXPECT().Create(gomock.Any()).Return("", &cloudformation.ErrStackAlreadyExists{})
+		m.EXPECT().Update(gomock.Any()).Return("1234", nil)
+		client := CloudFormation{cfnClient: m, s3Client: mS3Client}
+
+		// WHEN
+		changeSetID, err := client.DeployServiceNoWait(mockFileWriter{Writer: new(strings.Builder)}, serviceConfig, "mockBucket")
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, "1234", changeSetID)
+	})
+}
+
+func TestCloudFormation_RenderWorkloadUpdate(t *testing.T) {
+	testCases := map[string]struct {
+		inClient func(ctrl *gomock.Controller) *mocks.MockcfnClient
+
+		wantedErr error
+	}{
+		"should return the error from describing the stack": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test-api").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("describe stack phonetool-test-api: some error"),
+		},
+		"should return nil if the last update completed successfully": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test-api").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String(sdkcloudformation.StackStatusUpdateComplete),
+				}, nil).Times(2)
+				return m
+			},
+		},
+		"should return an error if the last update failed": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test-api").Return(&cloudformation.StackDescription{
+					StackStatus: aws.String(sdkcloudformation.StackStatusUpdateRollbackComplete),
+				}, nil).Times(2)
+				return m
+			},
+			wantedErr: errors.New("stack phonetool-test-api did not complete successfully and exited with status UPDATE_ROLLBACK_COMPLETE"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := &CloudFormation{
+				cfnClient: tc.inClient(ctrl),
+			}
+
+			// WHEN
+			err := cf.RenderWorkloadUpdate(nil, "phonetool-test-api")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_DeleteWorkload(t *testing.T) {
 	testCases := map[string]struct {
 		in         deploy.DeleteWorkloadInput
@@ -141,3 +259,128 @@ func TestCloudFormation_DeleteWorkload(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudFormation_WorkloadAddonsTemplate(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedTemplate string
+		wantedErr      error
+	}{
+		"returns the wrapped error if stack resources cannot be described": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().StackResources("kudos-test-webhook").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("describe resources of stack kudos-test-webhook: some error"),
+		},
+		"returns an empty template if the workload has no addons stack": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().StackResources("kudos-test-webhook").Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("Service"),
+						PhysicalResourceId: aws.String("webhook"),
+					},
+				}, nil)
+				return m
+			},
+		},
+		"returns the template of the nested addons stack": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().StackResources("kudos-test-webhook").Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("AddonsStack"),
+						PhysicalResourceId: aws.String("arn:aws:cloudformation:us-west-2:1111:stack/kudos-test-webhook-AddonsStack/1"),
+					},
+				}, nil)
+				m.EXPECT().TemplateBody("arn:aws:cloudformation:us-west-2:1111:stack/kudos-test-webhook-AddonsStack/1").Return("Resources: {}", nil)
+				return m
+			},
+			wantedTemplate: "Resources: {}",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			tpl, err := c.WorkloadAddonsTemplate("kudos-test-webhook")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTemplate, tpl)
+		})
+	}
+}
+
+func TestCloudFormation_WorkloadOutputs(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedOutputs map[string]string
+		wantedErr     error
+	}{
+		"returns the error if the stack cannot be described": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test-webhook").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"returns the outputs of the stack": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test-webhook").Return(&cloudformation.StackDescription{
+					Outputs: []*sdkcloudformation.Output{
+						{
+							OutputKey:   aws.String("CodeDeployApplication"),
+							OutputValue: aws.String("kudos-test-webhook-app"),
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantedOutputs: map[string]string{
+				"CodeDeployApplication": "kudos-test-webhook-app",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			outputs, err := c.WorkloadOutputs("kudos-test-webhook")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutputs, outputs)
+		})
+	}
+}
@@ -6,6 +6,8 @@
 package deploy
 
 import (
+	"time"
+
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 )
@@ -43,6 +45,11 @@ type CreateEnvironmentInput struct {
 	RawMft             []byte                // Content of the environment manifest without any modifications.
 
 	CFNServiceRoleARN string // Optional. A service role ARN that CloudFormation should use to make calls to resources in the stack.
+	ForceUpdateID     string // Optional. A unique ID that forces the stack to update, and its custom resources to run again, even if nothing else changed.
+
+	Timeout time.Duration // Optional. How long to wait for the stack update to complete, instead of the default wait limit.
+
+	Packaged *PackagedTemplate // Optional. A template generated by `env package` to deploy instead of generating one from the inputs.
 }
 
 // CreateEnvironmentResponse holds the created environment on successful deployment.
@@ -53,6 +53,9 @@ type CreatePipelineInput struct {
 	// Name of the application this pipeline belongs to
 	AppName string
 
+	// Optional. Prefix of the physical names of the environment IAM roles under the application's naming convention.
+	ResourcePrefix string
+
 	// Name of the pipeline
 	Name string
 
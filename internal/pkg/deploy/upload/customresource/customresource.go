@@ -7,6 +7,7 @@ package customresource
 import (
 	"archive/zip"
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"path"
@@ -34,6 +35,7 @@ const (
 	customDomainFnName        = "CustomDomainFunction"
 	certValidationFnName      = "CertificateValidationFunction"
 	dnsDelegationFnName       = "DNSDelegationFunction"
+	wafRateLimitFnName        = "WAFRateLimitFunction"
 )
 
 // Function source file locations.
@@ -48,6 +50,7 @@ var (
 	envControllerFilePath            = path.Join(customResourcesDir, "env-controller.js")
 	nlbCertValidatorFilePath         = path.Join(customResourcesDir, "nlb-cert-validator.js")
 	nlbCustomDomainFilePath          = path.Join(customResourcesDir, "nlb-custom-domain.js")
+	wafRateLimiterFilePath           = path.Join(customResourcesDir, "waf-rate-limiter.js")
 )
 
 // CustomResource represents a CloudFormation custom resource backed by a Lambda function.
@@ -115,6 +118,7 @@ func LBWS(fs template.Reader) ([]*CustomResource, error) {
 		rulePriorityFnName:        albRulePriorityGeneratorFilePath,
 		nlbCustomDomainFnName:     nlbCustomDomainFilePath,
 		nlbCertValidatorFnName:    nlbCertValidatorFilePath,
+		wafRateLimitFnName:        wafRateLimiterFilePath,
 	})
 }
 
@@ -169,6 +173,26 @@ func Upload(upload UploadFunc, crs []*CustomResource) (map[string]string, error)
 	return urls, nil
 }
 
+// UploadSet uploads the S3 key of each CustomResource as a JSON document under a key derived from the keys themselves,
+// so that the artifacts garbage collector can tell which custom resources are still referenced.
+// Since the document is content-addressed, concurrent uploads of different sets never overwrite each other.
+// Returns the version of the set.
+func UploadSet(upload UploadFunc, crs []*CustomResource) (string, error) {
+	keys := make(map[string]string, len(crs))
+	for _, cr := range crs {
+		keys[cr.FunctionName()] = cr.ArtifactPath()
+	}
+	dat, err := json.Marshal(keys)
+	if err != nil {
+		return "", fmt.Errorf("marshal custom resources set: %w", err)
+	}
+	version := artifactpath.CustomResourcesVersion(keys)
+	if _, err := upload(artifactpath.CustomResourceSet(version), bytes.NewReader(dat)); err != nil {
+		return "", fmt.Errorf("upload custom resources set %s: %w", version, err)
+	}
+	return version, nil
+}
+
 func buildCustomResources(fs template.Reader, pathForFn map[string]string) ([]*CustomResource, error) {
 	var idx int
 	crs := make([]*CustomResource, len(pathForFn))
@@ -6,6 +6,7 @@ package customresource
 import (
 	"archive/zip"
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -14,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
 )
 
 type fakeTemplateReader struct {
@@ -99,6 +101,9 @@ func TestLBWS(t *testing.T) {
 			"custom-resources/nlb-cert-validator.js": {
 				Buffer: bytes.NewBufferString("nlb cert"),
 			},
+			"custom-resources/waf-rate-limiter.js": {
+				Buffer: bytes.NewBufferString("waf rate limiter"),
+			},
 		},
 	}
 	wantedPaths := map[string]string{
@@ -107,6 +112,7 @@ func TestLBWS(t *testing.T) {
 		"RulePriorityFunction":        "manual/scripts/custom-resources/rulepriorityfunction/1385d258950a50faf4b5cd7deeecbc4bcc79a0d41d631e3977cffa0332e6f0c6.zip",
 		"NLBCustomDomainFunction":     "manual/scripts/custom-resources/nlbcustomdomainfunction/8f7e392db9b10ae69816b92c0b1d501e0ceb630e029852ac8ea33a3c205f8e4c.zip",
 		"NLBCertValidatorFunction":    "manual/scripts/custom-resources/nlbcertvalidatorfunction/3b9f56301b50779e09a3495a6d7eadc42b4401f265d4cfb359543c1ad3f21769.zip",
+		"WAFRateLimitFunction":        "manual/scripts/custom-resources/wafratelimitfunction/2c81c200394050441766b42b034d9fc53233bec248027bc93a42c84824ae9182.zip",
 	}
 
 	// WHEN
@@ -114,14 +120,14 @@ func TestLBWS(t *testing.T) {
 
 	// THEN
 	require.NoError(t, err)
-	require.Equal(t, fakeFS.matchCount, 5, "expected path calls do not match")
+	require.Equal(t, fakeFS.matchCount, 6, "expected path calls do not match")
 
 	actualFnNames := make([]string, len(crs))
 	for i, cr := range crs {
 		actualFnNames[i] = cr.FunctionName()
 	}
 	require.ElementsMatch(t,
-		[]string{"DynamicDesiredCountFunction", "EnvControllerFunction", "RulePriorityFunction", "NLBCustomDomainFunction", "NLBCertValidatorFunction"},
+		[]string{"DynamicDesiredCountFunction", "EnvControllerFunction", "RulePriorityFunction", "NLBCustomDomainFunction", "NLBCertValidatorFunction", "WAFRateLimitFunction"},
 		actualFnNames, "function names must match")
 
 	// ensure the zip files contain an index.js file.
@@ -425,3 +431,45 @@ func TestUpload(t *testing.T) {
 		})
 	}
 }
+
+func TestUploadSet(t *testing.T) {
+	crs := []*CustomResource{
+		{
+			name: "Func2",
+			zip:  new(bytes.Buffer),
+		},
+		{
+			name: "Func1",
+			zip:  new(bytes.Buffer),
+		},
+	}
+	wantedKeys := map[string]string{
+		"Func1": "manual/scripts/custom-resources/func1/e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855.zip",
+		"Func2": "manual/scripts/custom-resources/func2/e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855.zip",
+	}
+	t.Run("should return a wrapped error if the set cannot be uploaded", func(t *testing.T) {
+		s3 := &fakeS3{
+			err: errors.New("some err"),
+		}
+
+		_, err := UploadSet(s3.UploadFunc(), crs)
+
+		require.EqualError(t, err, fmt.Sprintf("upload custom resources set %s: some err", artifactpath.CustomResourcesVersion(wantedKeys)))
+	})
+	t.Run("should upload the keys of the custom resources under the version of the set", func(t *testing.T) {
+		var gotKey string
+		var gotKeys map[string]string
+		upload := func(key string, dat io.Reader) (string, error) {
+			gotKey = key
+			require.NoError(t, json.NewDecoder(dat).Decode(&gotKeys))
+			return "url", nil
+		}
+
+		version, err := UploadSet(upload, crs)
+
+		require.NoError(t, err)
+		require.Equal(t, artifactpath.CustomResourcesVersion(wantedKeys), version)
+		require.Equal(t, artifactpath.CustomResourceSet(version), gotKey)
+		require.Equal(t, wantedKeys, gotKeys)
+	})
+}
@@ -24,3 +24,10 @@ type DeleteWorkloadInput struct {
 	EnvName string // Name of the environment the service is deployed in.
 	AppName string // Name of the application the service belongs to.
 }
+
+// PackagedTemplate holds a CloudFormation template and its configuration generated ahead of a deployment
+// by one of the `package` commands, so that they can be deployed as-is instead of being generated again.
+type PackagedTemplate struct {
+	Template      string // Content of the stack template.
+	Configuration string // JSON document with the "Parameters" and "Tags" of the stack.
+}
@@ -7,17 +7,22 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"text/tabwriter"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"golang.org/x/mod/semver"
+	"golang.org/x/sync/errgroup"
 	"gopkg.in/yaml.v3"
 )
 
@@ -30,9 +35,49 @@ type App struct {
 	Services           []*config.Workload       `json:"services"`
 	Jobs               []*config.Workload       `json:"jobs"`
 	Pipelines          []*codepipeline.Pipeline `json:"pipelines"`
+	Regions            []*AppRegionalResources  `json:"regions,omitempty"`
 	WkldDeployedtoEnvs map[string][]string      `json:"-"`
 }
 
+// AppRegionalResources contains serialized parameters for the resources of an application in a region.
+type AppRegionalResources struct {
+	Region              string            `json:"region"`
+	StackInstanceID     string            `json:"stackInstanceId"`
+	S3Bucket            string            `json:"s3Bucket"`
+	KMSKeyARN           string            `json:"kmsKeyArn"`
+	SharedRepositoryURL string            `json:"sharedRepositoryUrl,omitempty"`
+	RepositoryURLs      map[string]string `json:"repositoryUrls,omitempty"`
+	Repositories        []*AppRepository  `json:"repositories,omitempty"`
+	// Pipelines are serialized once in App.Pipelines.
+	Pipelines []*codepipeline.Pipeline `json:"-"`
+}
+
+// AppRepository contains serialized parameters for an ECR repository of an application.
+type AppRepository struct {
+	Name       string `json:"name"`
+	URL        string `json:"url"`
+	ImageCount int    `json:"imageCount"`
+}
+
+type appResourcesGetter interface {
+	GetRegionalAppResources(app *config.Application) ([]*cfnstack.AppRegionalResources, error)
+}
+
+type imageLister interface {
+	ListImages(repoName string) ([]ecr.Image, error)
+}
+
+type deployedPipelineLister interface {
+	ListDeployedPipelines(appName string) ([]deploy.Pipeline, error)
+}
+
+// appRegionalClients holds the clients to look up the resources of an application in a region.
+type appRegionalClients struct {
+	images         imageLister
+	pipelineLister deployedPipelineLister
+	pipelines      pipelineGetter
+}
+
 // JSONString returns the stringified App struct with json format.
 func (a *App) JSONString() (string, error) {
 	b, err := json.Marshal(a)
@@ -83,23 +128,56 @@ func (a *App) HumanString() string {
 		fmt.Fprintf(writer, "  %s\t%s\t%s\n", job.Name, job.Type, envs)
 	}
 	writer.Flush()
+	if len(a.Regions) > 0 {
+		a.writeRegionalResources(writer)
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nPipelines\n\n"))
 	writer.Flush()
-	headers = []string{"Name"}
+	headers = []string{"Name", "Region"}
 	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
 	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
 	for _, pipeline := range a.Pipelines {
-		fmt.Fprintf(writer, "  %s\n", pipeline.Name)
+		fmt.Fprintf(writer, "  %s\t%s\n", pipeline.Name, pipeline.Region)
 	}
 	writer.Flush()
 	return b.String()
 }
 
+func (a *App) writeRegionalResources(writer *tabwriter.Writer) {
+	fmt.Fprint(writer, color.Bold.Sprint("\nRegional Resources\n\n"))
+	writer.Flush()
+	headers := []string{"Region", "Artifact Bucket", "KMS Key"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, region := range a.Regions {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", region.Region, region.S3Bucket, region.KMSKeyARN)
+	}
+	writer.Flush()
+	fmt.Fprint(writer, color.Bold.Sprint("\nRepositories\n\n"))
+	writer.Flush()
+	headers = []string{"Region", "Name", "Images"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, region := range a.Regions {
+		for i, repo := range region.Repositories {
+			regionName := region.Region
+			if i > 0 {
+				regionName = dittoSymbol
+			}
+			fmt.Fprintf(writer, "  %s\t%s\t%d\n", regionName, repo.Name, repo.ImageCount)
+		}
+	}
+	writer.Flush()
+}
+
 // AppDescriber retrieves information about an application.
 type AppDescriber struct {
 	app               string
 	stackDescriber    stackDescriber
 	stackSetDescriber stackDescriber
+	appResources      appResourcesGetter
+
+	newRegionalClients func(region string) (*appRegionalClients, error)
 }
 
 // NewAppDescriber instantiates an application describer.
@@ -112,6 +190,18 @@ func NewAppDescriber(appName string) (*AppDescriber, error) {
 		app:               appName,
 		stackDescriber:    stack.NewStackDescriber(cfnstack.NameForAppStack(appName), sess),
 		stackSetDescriber: stack.NewStackDescriber(cfnstack.NameForAppStackSet(appName), sess),
+		appResources:      cloudformation.New(sess),
+		newRegionalClients: func(region string) (*appRegionalClients, error) {
+			sess, err := sessions.ImmutableProvider().DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create session with region %s: %w", region, err)
+			}
+			return &appRegionalClients{
+				images:         ecr.New(sess),
+				pipelineLister: deploy.NewPipelineStore(rg.New(sess)),
+				pipelines:      codepipeline.New(sess),
+			}, nil
+		},
 	}, nil
 }
 
@@ -157,3 +247,122 @@ func (d *AppDescriber) Version() (string, error) {
 	}
 	return minVersion, nil
 }
+
+// RegionalResources returns the resources of the application in each region that it has environments in:
+// the artifact bucket, the KMS key, the ECR repositories with their image counts, and the pipelines.
+// The regions are looked up concurrently and returned in alphabetical order.
+func (d *AppDescriber) RegionalResources(app *config.Application) ([]*AppRegionalResources, error) {
+	resources, err := d.appResources.GetRegionalAppResources(app)
+	if err != nil {
+		return nil, fmt.Errorf("get regional resources for application %s: %w", d.app, err)
+	}
+	regions := make([]*AppRegionalResources, len(resources))
+	g := new(errgroup.Group)
+	for i := range resources {
+		i := i
+		g.Go(func() error {
+			region, err := d.regionalResources(resources[i])
+			if err != nil {
+				return err
+			}
+			regions[i] = region
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	sort.Slice(regions, func(i, j int) bool {
+		return regions[i].Region < regions[j].Region
+	})
+	return regions, nil
+}
+
+func (d *AppDescriber) regionalResources(resources *cfnstack.AppRegionalResources) (*AppRegionalResources, error) {
+	clients, err := d.newRegionalClients(resources.Region)
+	if err != nil {
+		return nil, err
+	}
+	region := &AppRegionalResources{
+		Region:              resources.Region,
+		StackInstanceID:     resources.StackID,
+		S3Bucket:            resources.S3Bucket,
+		KMSKeyARN:           resources.KMSKeyARN,
+		SharedRepositoryURL: resources.SharedRepositoryURL,
+		RepositoryURLs:      resources.RepositoryURLs,
+	}
+	urls := make(map[string]bool)
+	if resources.SharedRepositoryURL != "" {
+		urls[resources.SharedRepositoryURL] = true
+	}
+	for _, url := range resources.RepositoryURLs {
+		urls[url] = true
+	}
+	for url := range urls {
+		region.Repositories = append(region.Repositories, &AppRepository{
+			Name: repositoryName(url),
+			URL:  url,
+		})
+	}
+	sort.Slice(region.Repositories, func(i, j int) bool {
+		return region.Repositories[i].Name < region.Repositories[j].Name
+	})
+
+	g := new(errgroup.Group)
+	for i := range region.Repositories {
+		repo := region.Repositories[i]
+		g.Go(func() error {
+			images, err := clients.images.ListImages(repo.Name)
+			if err != nil {
+				return fmt.Errorf("list images in repository %s in region %s: %w", repo.Name, resources.Region, err)
+			}
+			repo.ImageCount = len(images)
+			return nil
+		})
+	}
+	g.Go(func() error {
+		pipelines, err := d.regionalPipelines(clients, resources.Region)
+		if err != nil {
+			return err
+		}
+		region.Pipelines = pipelines
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return region, nil
+}
+
+func (d *AppDescriber) regionalPipelines(clients *appRegionalClients, region string) ([]*codepipeline.Pipeline, error) {
+	deployed, err := clients.pipelineLister.ListDeployedPipelines(d.app)
+	if err != nil {
+		return nil, fmt.Errorf("list pipelines in region %s: %w", region, err)
+	}
+	pipelines := make([]*codepipeline.Pipeline, len(deployed))
+	g := new(errgroup.Group)
+	for i := range deployed {
+		i := i
+		g.Go(func() error {
+			info, err := clients.pipelines.GetPipeline(deployed[i].ResourceName)
+			if err != nil {
+				return fmt.Errorf("get info for pipeline %s in region %s: %w", deployed[i].Name, region, err)
+			}
+			pipelines[i] = info
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return pipelines, nil
+}
+
+// repositoryName returns the name of an ECR repository from its URL, e.g.
+// "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/my-svc" becomes "my-app/my-svc".
+func repositoryName(url string) string {
+	if i := strings.Index(url, "/"); i != -1 {
+		return url[i+1:]
+	}
+	return url
+}
@@ -8,6 +8,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -82,3 +87,177 @@ func TestAppDescriber_Version(t *testing.T) {
 		})
 	}
 }
+
+type appRegionalResourcesMocks struct {
+	appResources   *mocks.MockappResourcesGetter
+	images         map[string]*mocks.MockimageLister
+	pipelineLister map[string]*mocks.MockdeployedPipelineLister
+	pipelines      map[string]*mocks.MockpipelineGetter
+}
+
+func TestAppDescriber_RegionalResources(t *testing.T) {
+	mockApp := &config.Application{
+		Name: "phonetool",
+	}
+	mockErr := errors.New("some error")
+	mockResources := []*cfnstack.AppRegionalResources{
+		{
+			Region:    "us-west-2",
+			StackID:   "arn:aws:cloudformation:us-west-2:123456789012:stack/StackSet-phonetool-infrastructure/2",
+			KMSKeyARN: "arn:aws:kms:us-west-2:123456789012:key/2",
+			S3Bucket:  "phonetool-bucket-2",
+			RepositoryURLs: map[string]string{
+				"frontend": "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
+				"backend":  "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/backend",
+			},
+		},
+		{
+			Region:              "us-east-1",
+			StackID:             "arn:aws:cloudformation:us-east-1:123456789012:stack/StackSet-phonetool-infrastructure/1",
+			KMSKeyARN:           "arn:aws:kms:us-east-1:123456789012:key/1",
+			S3Bucket:            "phonetool-bucket-1",
+			SharedRepositoryURL: "123456789012.dkr.ecr.us-east-1.amazonaws.com/phonetool",
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m appRegionalResourcesMocks)
+
+		wanted    []*AppRegionalResources
+		wantedErr error
+	}{
+		"should return error if fail to get the regional resources of the app": {
+			setupMocks: func(m appRegionalResourcesMocks) {
+				m.appResources.EXPECT().GetRegionalAppResources(mockApp).Return(nil, mockErr)
+			},
+			wantedErr: fmt.Errorf("get regional resources for application phonetool: some error"),
+		},
+		"should return error if fail to list the images of a repository": {
+			setupMocks: func(m appRegionalResourcesMocks) {
+				m.appResources.EXPECT().GetRegionalAppResources(mockApp).Return(mockResources[1:], nil)
+				m.images["us-east-1"].EXPECT().ListImages("phonetool").Return(nil, mockErr)
+				m.pipelineLister["us-east-1"].EXPECT().ListDeployedPipelines("phonetool").Return(nil, nil).AnyTimes()
+			},
+			wantedErr: fmt.Errorf("list images in repository phonetool in region us-east-1: some error"),
+		},
+		"should return error if fail to get a pipeline": {
+			setupMocks: func(m appRegionalResourcesMocks) {
+				m.appResources.EXPECT().GetRegionalAppResources(mockApp).Return(mockResources[1:], nil)
+				m.images["us-east-1"].EXPECT().ListImages("phonetool").Return(nil, nil).AnyTimes()
+				m.pipelineLister["us-east-1"].EXPECT().ListDeployedPipelines("phonetool").Return([]deploy.Pipeline{
+					{
+						Name:         "release",
+						ResourceName: "pipeline-phonetool-release",
+					},
+				}, nil)
+				m.pipelines["us-east-1"].EXPECT().GetPipeline("pipeline-phonetool-release").Return(nil, mockErr)
+			},
+			wantedErr: fmt.Errorf("get info for pipeline release in region us-east-1: some error"),
+		},
+		"success": {
+			setupMocks: func(m appRegionalResourcesMocks) {
+				m.appResources.EXPECT().GetRegionalAppResources(mockApp).Return(mockResources, nil)
+				m.images["us-east-1"].EXPECT().ListImages("phonetool").Return([]ecr.Image{{Digest: "sha256:1"}}, nil)
+				m.images["us-west-2"].EXPECT().ListImages("phonetool/frontend").Return([]ecr.Image{{Digest: "sha256:1"}, {Digest: "sha256:2"}}, nil)
+				m.images["us-west-2"].EXPECT().ListImages("phonetool/backend").Return(nil, nil)
+				m.pipelineLister["us-east-1"].EXPECT().ListDeployedPipelines("phonetool").Return([]deploy.Pipeline{
+					{
+						Name:         "release",
+						ResourceName: "pipeline-phonetool-release",
+					},
+				}, nil)
+				m.pipelines["us-east-1"].EXPECT().GetPipeline("pipeline-phonetool-release").Return(&codepipeline.Pipeline{
+					Name:   "pipeline-phonetool-release",
+					Region: "us-east-1",
+				}, nil)
+				m.pipelineLister["us-west-2"].EXPECT().ListDeployedPipelines("phonetool").Return(nil, nil)
+			},
+			wanted: []*AppRegionalResources{
+				{
+					Region:              "us-east-1",
+					StackInstanceID:     "arn:aws:cloudformation:us-east-1:123456789012:stack/StackSet-phonetool-infrastructure/1",
+					S3Bucket:            "phonetool-bucket-1",
+					KMSKeyARN:           "arn:aws:kms:us-east-1:123456789012:key/1",
+					SharedRepositoryURL: "123456789012.dkr.ecr.us-east-1.amazonaws.com/phonetool",
+					Repositories: []*AppRepository{
+						{
+							Name:       "phonetool",
+							URL:        "123456789012.dkr.ecr.us-east-1.amazonaws.com/phonetool",
+							ImageCount: 1,
+						},
+					},
+					Pipelines: []*codepipeline.Pipeline{
+						{
+							Name:   "pipeline-phonetool-release",
+							Region: "us-east-1",
+						},
+					},
+				},
+				{
+					Region:          "us-west-2",
+					StackInstanceID: "arn:aws:cloudformation:us-west-2:123456789012:stack/StackSet-phonetool-infrastructure/2",
+					S3Bucket:        "phonetool-bucket-2",
+					KMSKeyARN:       "arn:aws:kms:us-west-2:123456789012:key/2",
+					RepositoryURLs: map[string]string{
+						"frontend": "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
+						"backend":  "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/backend",
+					},
+					Repositories: []*AppRepository{
+						{
+							Name:       "phonetool/backend",
+							URL:        "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/backend",
+							ImageCount: 0,
+						},
+						{
+							Name:       "phonetool/frontend",
+							URL:        "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
+							ImageCount: 2,
+						},
+					},
+					Pipelines: []*codepipeline.Pipeline{},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := appRegionalResourcesMocks{
+				appResources:   mocks.NewMockappResourcesGetter(ctrl),
+				images:         make(map[string]*mocks.MockimageLister),
+				pipelineLister: make(map[string]*mocks.MockdeployedPipelineLister),
+				pipelines:      make(map[string]*mocks.MockpipelineGetter),
+			}
+			for _, region := range []string{"us-east-1", "us-west-2"} {
+				m.images[region] = mocks.NewMockimageLister(ctrl)
+				m.pipelineLister[region] = mocks.NewMockdeployedPipelineLister(ctrl)
+				m.pipelines[region] = mocks.NewMockpipelineGetter(ctrl)
+			}
+			tc.setupMocks(m)
+			d := &AppDescriber{
+				app:          "phonetool",
+				appResources: m.appResources,
+				newRegionalClients: func(region string) (*appRegionalClients, error) {
+					return &appRegionalClients{
+						images:         m.images[region],
+						pipelineLister: m.pipelineLister[region],
+						pipelines:      m.pipelines[region],
+					}, nil
+				},
+			}
+
+			// WHEN
+			actual, err := d.RegionalResources(mockApp)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, actual)
+			}
+		})
+	}
+}
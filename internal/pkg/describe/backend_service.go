@@ -7,9 +7,12 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
+	"sync"
 	"text/tabwriter"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
@@ -39,10 +42,12 @@ type BackendServiceDescriber struct {
 	initLBDescriber          func(string) (lbDescriber, error)
 	ecsServiceDescribers     map[string]ecsDescriber
 	envStackDescriber        map[string]envDescriber
+	mu                       sync.Mutex // Guards the cached describers, since environments are described concurrently.
 }
 
 // NewBackendServiceDescriber instantiates a backend service describer.
 func NewBackendServiceDescriber(opt NewServiceConfig) (*BackendServiceDescriber, error) {
+	stackCache := stack.NewCache() // Shared by the describers of every environment.
 	describer := &BackendServiceDescriber{
 		app:                  opt.App,
 		svc:                  opt.Svc,
@@ -63,6 +68,8 @@ func NewBackendServiceDescriber(opt NewServiceConfig) (*BackendServiceDescriber,
 		return elbv2.New(sess), nil
 	}
 	describer.initECSServiceDescribers = func(env string) (ecsDescriber, error) {
+		describer.mu.Lock()
+		defer describer.mu.Unlock()
 		if describer, ok := describer.ecsServiceDescribers[env]; ok {
 			return describer, nil
 		}
@@ -70,6 +77,7 @@ func NewBackendServiceDescriber(opt NewServiceConfig) (*BackendServiceDescriber,
 			App:         opt.App,
 			Svc:         opt.Svc,
 			ConfigStore: opt.ConfigStore,
+			stackCache:  stackCache,
 		}, env)
 		if err != nil {
 			return nil, err
@@ -78,6 +86,8 @@ func NewBackendServiceDescriber(opt NewServiceConfig) (*BackendServiceDescriber,
 		return svcDescr, nil
 	}
 	describer.initEnvDescribers = func(env string) (envDescriber, error) {
+		describer.mu.Lock()
+		defer describer.mu.Unlock()
 		if describer, ok := describer.envStackDescriber[env]; ok {
 			return describer, nil
 		}
@@ -85,6 +95,7 @@ func NewBackendServiceDescriber(opt NewServiceConfig) (*BackendServiceDescriber,
 			App:         opt.App,
 			Env:         env,
 			ConfigStore: opt.ConfigStore,
+			stackCache:  stackCache,
 		})
 		if err != nil {
 			return nil, err
@@ -102,85 +113,40 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 		return nil, fmt.Errorf("list deployed environments for application %s: %w", d.app, err)
 	}
 
+	envDescs := make([]*ecsSvcEnvDesc, len(environments))
+	err = describeEnvs(environments, func(i int, env string) error {
+		desc, err := d.describeEnv(env)
+		if err != nil {
+			return err
+		}
+		envDescs[i] = desc
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var routes []*WebServiceRoute
 	var configs []*ECSServiceConfig
 	var services []*ServiceDiscovery
 	var envVars []*containerEnvVar
 	var secrets []*secret
-	for _, env := range environments {
-		svcDescr, err := d.initECSServiceDescribers(env)
-		if err != nil {
-			return nil, err
-		}
-		uri, err := d.URI(env)
-		if err != nil {
-			return nil, fmt.Errorf("retrieve service URI: %w", err)
-		}
-		if uri.AccessType == URIAccessTypeInternal {
-			routes = append(routes, &WebServiceRoute{
-				Environment: env,
-				URL:         uri.URI,
-			})
-		}
-		svcParams, err := svcDescr.Params()
-		if err != nil {
-			return nil, fmt.Errorf("get stack parameters for environment %s: %w", env, err)
-		}
-		envDescr, err := d.initEnvDescribers(env)
-		if err != nil {
-			return nil, err
-		}
-		port := blankContainerPort
-		if svcParams[cfnstack.WorkloadContainerPortParamKey] != cfnstack.NoExposedContainerPort {
-			endpoint, err := envDescr.ServiceDiscoveryEndpoint()
-			if err != nil {
-				return nil, err
-			}
-			port = svcParams[cfnstack.WorkloadContainerPortParamKey]
-			services = appendServiceDiscovery(services, serviceDiscovery{
-				Service:  d.svc,
-				Port:     port,
-				Endpoint: endpoint,
-			}, env)
-		}
-		containerPlatform, err := svcDescr.Platform()
-		if err != nil {
-			return nil, fmt.Errorf("retrieve platform: %w", err)
+	var deployments []*stack.Deployment
+	resources := make(map[string][]*stack.Resource)
+	for i, desc := range envDescs {
+		env := environments[i]
+		routes = append(routes, desc.routes...)
+		configs = append(configs, desc.config)
+		if desc.serviceDiscovery != nil {
+			services = appendServiceDiscovery(services, *desc.serviceDiscovery, env)
 		}
-		backendSvcEnvVars, err := svcDescr.EnvVars()
-		if err != nil {
-			return nil, fmt.Errorf("retrieve environment variables: %w", err)
+		envVars = append(envVars, desc.envVars...)
+		secrets = append(secrets, desc.secrets...)
+		if desc.deployment != nil {
+			deployments = append(deployments, desc.deployment)
 		}
-		configs = append(configs, &ECSServiceConfig{
-			ServiceConfig: &ServiceConfig{
-				Environment: env,
-				Port:        port,
-				CPU:         svcParams[cfnstack.WorkloadTaskCPUParamKey],
-				Memory:      svcParams[cfnstack.WorkloadTaskMemoryParamKey],
-				Platform:    dockerengine.PlatformString(containerPlatform.OperatingSystem, containerPlatform.Architecture),
-			},
-			Tasks: svcParams[cfnstack.WorkloadTaskCountParamKey],
-		})
-		envVars = append(envVars, flattenContainerEnvVars(env, backendSvcEnvVars)...)
-		webSvcSecrets, err := svcDescr.Secrets()
-		if err != nil {
-			return nil, fmt.Errorf("retrieve secrets: %w", err)
-		}
-		secrets = append(secrets, flattenSecrets(env, webSvcSecrets)...)
-	}
-
-	resources := make(map[string][]*stack.Resource)
-	if d.enableResources {
-		for _, env := range environments {
-			svcDescr, err := d.initECSServiceDescribers(env)
-			if err != nil {
-				return nil, err
-			}
-			stackResources, err := svcDescr.ServiceStackResources()
-			if err != nil {
-				return nil, fmt.Errorf("retrieve service resources: %w", err)
-			}
-			resources[env] = stackResources
+		if d.enableResources {
+			resources[env] = desc.resources
 		}
 	}
 
@@ -194,11 +160,85 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 		Variables:        envVars,
 		Secrets:          secrets,
 		Resources:        resources,
+		Deployments:      deployments,
 
 		environments: environments,
 	}, nil
 }
 
+// describeEnv returns the description of the service in an environment.
+func (d *BackendServiceDescriber) describeEnv(env string) (*ecsSvcEnvDesc, error) {
+	svcDescr, err := d.initECSServiceDescribers(env)
+	if err != nil {
+		return nil, err
+	}
+	uri, err := d.URI(env)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve service URI: %w", err)
+	}
+	desc := &ecsSvcEnvDesc{}
+	if uri.AccessType == URIAccessTypeInternal {
+		desc.routes = webServiceRoutes(env, uri)
+	}
+	svcParams, err := svcDescr.Params()
+	if err != nil {
+		return nil, fmt.Errorf("get stack parameters for environment %s: %w", env, err)
+	}
+	envDescr, err := d.initEnvDescribers(env)
+	if err != nil {
+		return nil, err
+	}
+	port := blankContainerPort
+	if svcParams[cfnstack.WorkloadContainerPortParamKey] != cfnstack.NoExposedContainerPort {
+		endpoint, err := envDescr.ServiceDiscoveryEndpoint()
+		if err != nil {
+			return nil, err
+		}
+		recordTypes, err := serviceDiscoveryRecordTypes(svcDescr)
+		if err != nil {
+			return nil, err
+		}
+		port = svcParams[cfnstack.WorkloadContainerPortParamKey]
+		desc.serviceDiscovery = &serviceDiscovery{
+			Service:     d.svc,
+			Port:        port,
+			Endpoint:    endpoint,
+			RecordTypes: recordTypes,
+		}
+	}
+	containerPlatform, err := svcDescr.Platform()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve platform: %w", err)
+	}
+	backendSvcEnvVars, err := svcDescr.EnvVars()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve environment variables: %w", err)
+	}
+	desc.config = &ECSServiceConfig{
+		ServiceConfig: &ServiceConfig{
+			Environment: env,
+			Port:        port,
+			CPU:         svcParams[cfnstack.WorkloadTaskCPUParamKey],
+			Memory:      svcParams[cfnstack.WorkloadTaskMemoryParamKey],
+			Platform:    dockerengine.PlatformString(containerPlatform.OperatingSystem, containerPlatform.Architecture),
+		},
+		Tasks: svcParams[cfnstack.WorkloadTaskCountParamKey],
+	}
+	desc.envVars = flattenContainerEnvVars(env, backendSvcEnvVars)
+	webSvcSecrets, err := svcDescr.Secrets()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve secrets: %w", err)
+	}
+	desc.secrets = flattenSecrets(env, webSvcSecrets)
+	desc.deployment = bestEffortLastDeployment(svcDescr)
+	if d.enableResources {
+		if desc.resources, err = workloadStackResources(svcDescr); err != nil {
+			return nil, err
+		}
+	}
+	return desc, nil
+}
+
 // Manifest returns the contents of the manifest used to deploy a backend service stack.
 // If the Manifest metadata doesn't exist in the stack template, then returns ErrManifestNotFoundInTemplate.
 func (d *BackendServiceDescriber) Manifest(env string) ([]byte, error) {
@@ -209,6 +249,15 @@ func (d *BackendServiceDescriber) Manifest(env string) ([]byte, error) {
 	return cfn.Manifest()
 }
 
+// Params returns the parameters of the backend service stack deployed in the environment.
+func (d *BackendServiceDescriber) Params(env string) (map[string]string, error) {
+	cfn, err := d.initECSServiceDescribers(env)
+	if err != nil {
+		return nil, err
+	}
+	return cfn.Params()
+}
+
 // backendSvcDesc contains serialized parameters for a backend service.
 type backendSvcDesc struct {
 	Service          string               `json:"service"`
@@ -220,6 +269,7 @@ type backendSvcDesc struct {
 	Variables        containerEnvVars     `json:"variables"`
 	Secrets          secrets              `json:"secrets,omitempty"`
 	Resources        deployedSvcResources `json:"resources,omitempty"`
+	Deployments      lastDeployments      `json:"deployments,omitempty"`
 
 	environments []string `json:"-"`
 }
@@ -233,6 +283,52 @@ func (w *backendSvcDesc) JSONString() (string, error) {
 	return fmt.Sprintf("%s\n", b), nil
 }
 
+func (w *backendSvcDesc) writeRoutes(writer io.Writer) {
+	if !w.hasTargetGroups() {
+		headers := []string{"Environment", "URL"}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, route := range w.Routes {
+			fmt.Fprintf(writer, "  %s\t%s\n", route.Environment, route.URL)
+		}
+		return
+	}
+	headers := []string{"Environment", "URL", "Target Group"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, route := range w.Routes {
+		targetGroup := "-"
+		if route.TargetGroup != "" {
+			targetGroup = targetGroupName(route.TargetGroup)
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", route.Environment, route.URL, targetGroup)
+	}
+}
+
+// hasTargetGroups returns true if the service is behind an internal load balancer in any environment.
+func (w *backendSvcDesc) hasTargetGroups() bool {
+	for _, route := range w.Routes {
+		if route.TargetGroup != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// targetGroupName returns the name of the target group from its ARN, or the ARN itself if it's malformed.
+// For example, "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-tg/73e2d6bc24d8a067" is named "my-tg".
+func targetGroupName(tgARN string) string {
+	parsed, err := arn.Parse(tgARN)
+	if err != nil {
+		return tgARN
+	}
+	parts := strings.Split(parsed.Resource, "/")
+	if len(parts) != 3 {
+		return tgARN
+	}
+	return parts[1]
+}
+
 // HumanString returns the stringified backendService struct with human readable format.
 func (w *backendSvcDesc) HumanString() string {
 	var b bytes.Buffer
@@ -245,15 +341,15 @@ func (w *backendSvcDesc) HumanString() string {
 	fmt.Fprint(writer, color.Bold.Sprint("\nConfigurations\n\n"))
 	writer.Flush()
 	w.Configurations.humanString(writer)
+	if len(w.Deployments) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nDeployment\n\n"))
+		writer.Flush()
+		w.Deployments.humanString(writer)
+	}
 	if len(w.Routes) > 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nRoutes\n\n"))
 		writer.Flush()
-		headers := []string{"Environment", "URL"}
-		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
-		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
-		for _, route := range w.Routes {
-			fmt.Fprintf(writer, "  %s\t%s\n", route.Environment, route.URL)
-		}
+		w.writeRoutes(writer)
 	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nService Discovery\n\n"))
 	writer.Flush()
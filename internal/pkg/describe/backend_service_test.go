@@ -4,14 +4,16 @@
 package describe
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"testing"
+	"text/tabwriter"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 
 	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
-	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	describeStack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	"github.com/golang/mock/gomock"
@@ -82,8 +84,14 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 					m.ecsDescriber.EXPECT().ServiceStackResources().Return(nil, nil),
 					m.ecsDescriber.EXPECT().Params().Return(params, nil),
 					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
+					m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{
+						svcOutputDiscoveryServiceRecordTypes: "A,SRV",
+					}, nil),
 					m.ecsDescriber.EXPECT().Params().Return(params, nil),
 					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
+					m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{
+						svcOutputDiscoveryServiceRecordTypes: "A,SRV",
+					}, nil),
 					m.ecsDescriber.EXPECT().Platform().Return(nil, errors.New("some error")),
 				)
 			},
@@ -102,8 +110,14 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 					m.ecsDescriber.EXPECT().ServiceStackResources().Return(nil, nil),
 					m.ecsDescriber.EXPECT().Params().Return(params, nil),
 					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
+					m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{
+						svcOutputDiscoveryServiceRecordTypes: "A,SRV",
+					}, nil),
 					m.ecsDescriber.EXPECT().Params().Return(params, nil),
 					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
+					m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{
+						svcOutputDiscoveryServiceRecordTypes: "A,SRV",
+					}, nil),
 					m.ecsDescriber.EXPECT().Platform().Return(&ecs.ContainerPlatform{
 						OperatingSystem: "LINUX",
 						Architecture:    "X86_64",
@@ -126,8 +140,14 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 					m.ecsDescriber.EXPECT().ServiceStackResources().Return(nil, nil),
 					m.ecsDescriber.EXPECT().Params().Return(params, nil),
 					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
+					m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{
+						svcOutputDiscoveryServiceRecordTypes: "A,SRV",
+					}, nil),
 					m.ecsDescriber.EXPECT().Params().Return(params, nil),
 					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
+					m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{
+						svcOutputDiscoveryServiceRecordTypes: "A,SRV",
+					}, nil),
 					m.ecsDescriber.EXPECT().Platform().Return(&ecs.ContainerPlatform{
 						OperatingSystem: "LINUX",
 						Architecture:    "X86_64",
@@ -165,88 +185,351 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 					cfnstack.WorkloadTaskCPUParamKey:       "512",
 					cfnstack.WorkloadTaskMemoryParamKey:    "1024",
 				}
+				m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv, prodEnv, mockEnv}, nil)
+				m.ecsDescribers[testEnv].EXPECT().LastDeployment().Return(&stack.Deployment{Environment: testEnv}, nil)
 				gomock.InOrder(
-					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv, prodEnv, mockEnv}, nil),
-					m.ecsDescriber.EXPECT().ServiceStackResources().Return(nil, nil),
-					m.ecsDescriber.EXPECT().Params().Return(testParams, nil),
-					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
-					m.ecsDescriber.EXPECT().Params().Return(testParams, nil),
-					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
-					m.ecsDescriber.EXPECT().Platform().Return(&ecs.ContainerPlatform{
+					m.ecsDescribers[testEnv].EXPECT().ServiceStackResources().Return(nil, nil),
+					m.ecsDescribers[testEnv].EXPECT().Params().Return(testParams, nil),
+					m.envDescribers[testEnv].EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
+					m.ecsDescribers[testEnv].EXPECT().Outputs().Return(map[string]string{
+						svcOutputDiscoveryServiceRecordTypes: "A,SRV",
+					}, nil),
+					m.ecsDescribers[testEnv].EXPECT().Params().Return(testParams, nil),
+					m.envDescribers[testEnv].EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
+					m.ecsDescribers[testEnv].EXPECT().Outputs().Return(map[string]string{
+						svcOutputDiscoveryServiceRecordTypes: "A,SRV",
+					}, nil),
+					m.ecsDescribers[testEnv].EXPECT().Platform().Return(&ecs.ContainerPlatform{
 						OperatingSystem: "LINUX",
 						Architecture:    "X86_64",
 					}, nil),
-					m.ecsDescriber.EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
+					m.ecsDescribers[testEnv].EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
 						{
 							Name:      "COPILOT_ENVIRONMENT_NAME",
 							Container: "container",
 							Value:     testEnv,
 						},
 					}, nil),
-					m.ecsDescriber.EXPECT().Secrets().Return([]*ecs.ContainerSecret{
+					m.ecsDescribers[testEnv].EXPECT().Secrets().Return([]*ecs.ContainerSecret{
 						{
 							Name:      "GITHUB_WEBHOOK_SECRET",
 							Container: "container",
 							ValueFrom: "GH_WEBHOOK_SECRET",
 						},
 					}, nil),
-					m.ecsDescriber.EXPECT().ServiceStackResources().Return(nil, nil),
-					m.ecsDescriber.EXPECT().Params().Return(prodParams, nil),
-					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("prod.phonetool.local", nil),
-					m.ecsDescriber.EXPECT().Params().Return(prodParams, nil),
-					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("prod.phonetool.local", nil),
-					m.ecsDescriber.EXPECT().Platform().Return(&ecs.ContainerPlatform{
+					m.ecsDescribers[testEnv].EXPECT().ServiceStackResources().Return([]*stack.Resource{
+						{
+							Type:       "AWS::EC2::SecurityGroupIngress",
+							PhysicalID: "ContainerSecurityGroupIngressFromPublicALB",
+						},
+					}, nil),
+					m.ecsDescribers[testEnv].EXPECT().AddonsStackResources().Return(nil, nil),
+				)
+				m.ecsDescribers[prodEnv].EXPECT().LastDeployment().Return(&stack.Deployment{Environment: prodEnv}, nil)
+				gomock.InOrder(
+					m.ecsDescribers[prodEnv].EXPECT().ServiceStackResources().Return(nil, nil),
+					m.ecsDescribers[prodEnv].EXPECT().Params().Return(prodParams, nil),
+					m.envDescribers[prodEnv].EXPECT().ServiceDiscoveryEndpoint().Return("prod.phonetool.local", nil),
+					m.ecsDescribers[prodEnv].EXPECT().Outputs().Return(map[string]string{
+						svcOutputDiscoveryServiceRecordTypes: "A,SRV",
+					}, nil),
+					m.ecsDescribers[prodEnv].EXPECT().Params().Return(prodParams, nil),
+					m.envDescribers[prodEnv].EXPECT().ServiceDiscoveryEndpoint().Return("prod.phonetool.local", nil),
+					m.ecsDescribers[prodEnv].EXPECT().Outputs().Return(map[string]string{
+						svcOutputDiscoveryServiceRecordTypes: "A,SRV",
+					}, nil),
+					m.ecsDescribers[prodEnv].EXPECT().Platform().Return(&ecs.ContainerPlatform{
 						OperatingSystem: "LINUX",
 						Architecture:    "ARM64",
 					}, nil),
-					m.ecsDescriber.EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
+					m.ecsDescribers[prodEnv].EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
 						{
 							Name:      "COPILOT_ENVIRONMENT_NAME",
 							Container: "container",
 							Value:     prodEnv,
 						},
 					}, nil),
-					m.ecsDescriber.EXPECT().Secrets().Return([]*ecs.ContainerSecret{
+					m.ecsDescribers[prodEnv].EXPECT().Secrets().Return([]*ecs.ContainerSecret{
 						{
 							Name:      "SOME_OTHER_SECRET",
 							Container: "container",
 							ValueFrom: "SHHHHHHHH",
 						},
 					}, nil),
-					m.ecsDescriber.EXPECT().ServiceStackResources().Return(nil, nil),
-					m.ecsDescriber.EXPECT().Params().Return(mockParams, nil),
-					m.ecsDescriber.EXPECT().Params().Return(mockParams, nil),
-					m.ecsDescriber.EXPECT().Platform().Return(&ecs.ContainerPlatform{
+					m.ecsDescribers[prodEnv].EXPECT().ServiceStackResources().Return([]*stack.Resource{
+						{
+							Type:       "AWS::EC2::SecurityGroup",
+							PhysicalID: "sg-0758ed6b233743530",
+						},
+					}, nil),
+					m.ecsDescribers[prodEnv].EXPECT().AddonsStackResources().Return(nil, nil),
+				)
+				m.ecsDescribers[mockEnv].EXPECT().LastDeployment().Return(&stack.Deployment{Environment: mockEnv}, nil)
+				gomock.InOrder(
+					m.ecsDescribers[mockEnv].EXPECT().ServiceStackResources().Return(nil, nil),
+					m.ecsDescribers[mockEnv].EXPECT().Params().Return(mockParams, nil),
+					m.ecsDescribers[mockEnv].EXPECT().Params().Return(mockParams, nil),
+					m.ecsDescribers[mockEnv].EXPECT().Platform().Return(&ecs.ContainerPlatform{
 						OperatingSystem: "LINUX",
 						Architecture:    "X86_64",
 					}, nil),
-					m.ecsDescriber.EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
+					m.ecsDescribers[mockEnv].EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
 						{
 							Name:      "COPILOT_ENVIRONMENT_NAME",
 							Container: "container",
 							Value:     mockEnv,
 						},
 					}, nil),
-					m.ecsDescriber.EXPECT().Secrets().Return(
+					m.ecsDescribers[mockEnv].EXPECT().Secrets().Return(
 						nil, nil),
-					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
+					m.ecsDescribers[mockEnv].EXPECT().ServiceStackResources().Return([]*stack.Resource{
+						{
+							Type:       "AWS::EC2::SecurityGroup",
+							PhysicalID: "sg-2337435300758ed6b",
+						},
+					}, nil),
+					m.ecsDescribers[mockEnv].EXPECT().AddonsStackResources().Return(nil, nil),
+				)
+			},
+			wantedBackendSvc: &backendSvcDesc{
+				Service: testSvc,
+				Type:    "Backend Service",
+				App:     testApp,
+				Configurations: []*ECSServiceConfig{
+					{
+						ServiceConfig: &ServiceConfig{
+							CPU:         "256",
+							Environment: "test",
+							Memory:      "512",
+							Platform:    "LINUX/X86_64",
+							Port:        "5000",
+						},
+						Tasks: "1",
+					},
+					{
+						ServiceConfig: &ServiceConfig{
+							CPU:         "512",
+							Environment: "prod",
+							Memory:      "1024",
+							Platform:    "LINUX/ARM64",
+							Port:        "5000",
+						},
+						Tasks: "2",
+					},
+					{
+						ServiceConfig: &ServiceConfig{
+							CPU:         "512",
+							Environment: "mockEnv",
+							Memory:      "1024",
+							Platform:    "LINUX/X86_64",
+							Port:        "-",
+						},
+						Tasks: "2",
+					},
+				},
+				ServiceDiscovery: []*ServiceDiscovery{
+					{
+						Environment: []string{"test"},
+						Namespace:   "jobs.test.phonetool.local:5000",
+					},
+					{
+						Environment: []string{"prod"},
+						Namespace:   "jobs.prod.phonetool.local:5000",
+					},
+				},
+				Variables: []*containerEnvVar{
+					{
+						envVar: &envVar{
+							Environment: "test",
+							Name:        "COPILOT_ENVIRONMENT_NAME",
+							Value:       "test",
+						},
+						Container: "container",
+					},
+					{
+						envVar: &envVar{
+							Environment: "prod",
+							Name:        "COPILOT_ENVIRONMENT_NAME",
+							Value:       "prod",
+						},
+						Container: "container",
+					},
+					{
+						envVar: &envVar{
+							Environment: "mockEnv",
+							Name:        "COPILOT_ENVIRONMENT_NAME",
+							Value:       "mockEnv",
+						},
+						Container: "container",
+					},
+				},
+				Secrets: []*secret{
+					{
+						Name:        "GITHUB_WEBHOOK_SECRET",
+						Container:   "container",
+						Environment: "test",
+						ValueFrom:   "GH_WEBHOOK_SECRET",
+					},
+					{
+						Name:        "SOME_OTHER_SECRET",
+						Container:   "container",
+						Environment: "prod",
+						ValueFrom:   "SHHHHHHHH",
+					},
+				},
+				Resources: map[string][]*stack.Resource{
+					"test": {
+						{
+							Type:       "AWS::EC2::SecurityGroupIngress",
+							PhysicalID: "ContainerSecurityGroupIngressFromPublicALB",
+						},
+					},
+					"prod": {
+						{
+							Type:       "AWS::EC2::SecurityGroup",
+							PhysicalID: "sg-0758ed6b233743530",
+						},
+					},
+					"mockEnv": {
+						{
+							Type:       "AWS::EC2::SecurityGroup",
+							PhysicalID: "sg-2337435300758ed6b",
+						},
+					},
+				},
+				Deployments: []*stack.Deployment{
+					{Environment: "test"},
+					{Environment: "prod"},
+					{Environment: "mockEnv"},
+				},
+				environments: []string{"test", "prod", "mockEnv"},
+			},
+		},
+		"omit the last deployment of an environment if it can't be retrieved": {
+			shouldOutputResources: true,
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				testParams := map[string]string{
+					cfnstack.WorkloadContainerPortParamKey: "5000",
+					cfnstack.WorkloadTaskCountParamKey:     "1",
+					cfnstack.WorkloadTaskCPUParamKey:       "256",
+					cfnstack.WorkloadTaskMemoryParamKey:    "512",
+				}
+				prodParams := map[string]string{
+					cfnstack.WorkloadContainerPortParamKey: "5000",
+					cfnstack.WorkloadTaskCountParamKey:     "2",
+					cfnstack.WorkloadTaskCPUParamKey:       "512",
+					cfnstack.WorkloadTaskMemoryParamKey:    "1024",
+				}
+				mockParams := map[string]string{
+					cfnstack.WorkloadContainerPortParamKey: "-1",
+					cfnstack.WorkloadTaskCountParamKey:     "2",
+					cfnstack.WorkloadTaskCPUParamKey:       "512",
+					cfnstack.WorkloadTaskMemoryParamKey:    "1024",
+				}
+				m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv, prodEnv, mockEnv}, nil)
+				m.ecsDescribers[testEnv].EXPECT().LastDeployment().Return(&stack.Deployment{Environment: testEnv}, nil)
+				gomock.InOrder(
+					m.ecsDescribers[testEnv].EXPECT().ServiceStackResources().Return(nil, nil),
+					m.ecsDescribers[testEnv].EXPECT().Params().Return(testParams, nil),
+					m.envDescribers[testEnv].EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
+					m.ecsDescribers[testEnv].EXPECT().Outputs().Return(map[string]string{
+						svcOutputDiscoveryServiceRecordTypes: "A,SRV",
+					}, nil),
+					m.ecsDescribers[testEnv].EXPECT().Params().Return(testParams, nil),
+					m.envDescribers[testEnv].EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
+					m.ecsDescribers[testEnv].EXPECT().Outputs().Return(map[string]string{
+						svcOutputDiscoveryServiceRecordTypes: "A,SRV",
+					}, nil),
+					m.ecsDescribers[testEnv].EXPECT().Platform().Return(&ecs.ContainerPlatform{
+						OperatingSystem: "LINUX",
+						Architecture:    "X86_64",
+					}, nil),
+					m.ecsDescribers[testEnv].EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
+						{
+							Name:      "COPILOT_ENVIRONMENT_NAME",
+							Container: "container",
+							Value:     testEnv,
+						},
+					}, nil),
+					m.ecsDescribers[testEnv].EXPECT().Secrets().Return([]*ecs.ContainerSecret{
+						{
+							Name:      "GITHUB_WEBHOOK_SECRET",
+							Container: "container",
+							ValueFrom: "GH_WEBHOOK_SECRET",
+						},
+					}, nil),
+					m.ecsDescribers[testEnv].EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
 							Type:       "AWS::EC2::SecurityGroupIngress",
 							PhysicalID: "ContainerSecurityGroupIngressFromPublicALB",
 						},
 					}, nil),
-					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
+					m.ecsDescribers[testEnv].EXPECT().AddonsStackResources().Return(nil, nil),
+				)
+				m.ecsDescribers[prodEnv].EXPECT().LastDeployment().Return(&stack.Deployment{Environment: prodEnv}, nil)
+				gomock.InOrder(
+					m.ecsDescribers[prodEnv].EXPECT().ServiceStackResources().Return(nil, nil),
+					m.ecsDescribers[prodEnv].EXPECT().Params().Return(prodParams, nil),
+					m.envDescribers[prodEnv].EXPECT().ServiceDiscoveryEndpoint().Return("prod.phonetool.local", nil),
+					m.ecsDescribers[prodEnv].EXPECT().Outputs().Return(map[string]string{
+						svcOutputDiscoveryServiceRecordTypes: "A,SRV",
+					}, nil),
+					m.ecsDescribers[prodEnv].EXPECT().Params().Return(prodParams, nil),
+					m.envDescribers[prodEnv].EXPECT().ServiceDiscoveryEndpoint().Return("prod.phonetool.local", nil),
+					m.ecsDescribers[prodEnv].EXPECT().Outputs().Return(map[string]string{
+						svcOutputDiscoveryServiceRecordTypes: "A,SRV",
+					}, nil),
+					m.ecsDescribers[prodEnv].EXPECT().Platform().Return(&ecs.ContainerPlatform{
+						OperatingSystem: "LINUX",
+						Architecture:    "ARM64",
+					}, nil),
+					m.ecsDescribers[prodEnv].EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
+						{
+							Name:      "COPILOT_ENVIRONMENT_NAME",
+							Container: "container",
+							Value:     prodEnv,
+						},
+					}, nil),
+					m.ecsDescribers[prodEnv].EXPECT().Secrets().Return([]*ecs.ContainerSecret{
+						{
+							Name:      "SOME_OTHER_SECRET",
+							Container: "container",
+							ValueFrom: "SHHHHHHHH",
+						},
+					}, nil),
+					m.ecsDescribers[prodEnv].EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
 							Type:       "AWS::EC2::SecurityGroup",
 							PhysicalID: "sg-0758ed6b233743530",
 						},
 					}, nil),
-					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
+					m.ecsDescribers[prodEnv].EXPECT().AddonsStackResources().Return(nil, nil),
+				)
+				m.ecsDescribers[mockEnv].EXPECT().LastDeployment().Return(nil, mockErr)
+				gomock.InOrder(
+					m.ecsDescribers[mockEnv].EXPECT().ServiceStackResources().Return(nil, nil),
+					m.ecsDescribers[mockEnv].EXPECT().Params().Return(mockParams, nil),
+					m.ecsDescribers[mockEnv].EXPECT().Params().Return(mockParams, nil),
+					m.ecsDescribers[mockEnv].EXPECT().Platform().Return(&ecs.ContainerPlatform{
+						OperatingSystem: "LINUX",
+						Architecture:    "X86_64",
+					}, nil),
+					m.ecsDescribers[mockEnv].EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
+						{
+							Name:      "COPILOT_ENVIRONMENT_NAME",
+							Container: "container",
+							Value:     mockEnv,
+						},
+					}, nil),
+					m.ecsDescribers[mockEnv].EXPECT().Secrets().Return(
+						nil, nil),
+					m.ecsDescribers[mockEnv].EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
 							Type:       "AWS::EC2::SecurityGroup",
 							PhysicalID: "sg-2337435300758ed6b",
 						},
 					}, nil),
+					m.ecsDescribers[mockEnv].EXPECT().AddonsStackResources().Return(nil, nil),
 				)
 			},
 			wantedBackendSvc: &backendSvcDesc{
@@ -355,6 +638,10 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						},
 					},
 				},
+				Deployments: []*stack.Deployment{
+					{Environment: "test"},
+					{Environment: "prod"},
+				},
 				environments: []string{"test", "prod", "mockEnv"},
 			},
 		},
@@ -380,14 +667,23 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						PhysicalID: "listenerRuleARN",
 					},
 				}
+				m.ecsDescriber.EXPECT().LastDeployment().Return(&stack.Deployment{Environment: testEnv}, nil)
 				gomock.InOrder(
 					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
 					m.ecsDescriber.EXPECT().ServiceStackResources().Return(resources, nil),
-					m.ecsDescriber.EXPECT().Params().Return(params, nil),
-					m.ecsDescriber.EXPECT().ServiceStackResources().Return(resources, nil),
-					m.lbDescriber.EXPECT().ListenerRuleHostHeaders("listenerRuleARN").Return([]string{"jobs.test.phonetool.internal"}, nil),
+					m.lbDescriber.EXPECT().ListenerRules("listenerRuleARN").Return([]*elbv2.ListenerRule{
+						{
+							ARN:             "listenerRuleARN",
+							HostHeaders:     []string{"jobs.test.phonetool.internal"},
+							PathPatterns:    []string{"/mySvc", "/mySvc/*"},
+							TargetGroupARNs: []string{"targetGroupARN"},
+						},
+					}, nil),
 					m.ecsDescriber.EXPECT().Params().Return(params, nil),
 					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
+					m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{
+						svcOutputDiscoveryServiceRecordTypes: "A,SRV",
+					}, nil),
 					m.ecsDescriber.EXPECT().Platform().Return(&ecs.ContainerPlatform{
 						OperatingSystem: "LINUX",
 						Architecture:    "X86_64",
@@ -407,6 +703,7 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						},
 					}, nil),
 					m.ecsDescriber.EXPECT().ServiceStackResources().Return(resources, nil),
+					m.ecsDescriber.EXPECT().AddonsStackResources().Return(nil, nil),
 				)
 			},
 			wantedBackendSvc: &backendSvcDesc{
@@ -429,6 +726,12 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 					{
 						Environment: "test",
 						URL:         "http://jobs.test.phonetool.internal/mySvc",
+						AccessType:  URIAccessTypeInternal,
+						Protocol:    "http",
+						DNSNames:    []string{"jobs.test.phonetool.internal"},
+						Path:        "/mySvc",
+						Port:        "80",
+						TargetGroup: "targetGroupARN",
 					},
 				},
 				ServiceDiscovery: []*ServiceDiscovery{
@@ -469,6 +772,9 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						},
 					},
 				},
+				Deployments: []*stack.Deployment{
+					{Environment: "test"},
+				},
 				environments: []string{"test"},
 			},
 		},
@@ -479,12 +785,7 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			mocks := lbWebSvcDescriberMocks{
-				storeSvc:     mocks.NewMockDeployedEnvServicesLister(ctrl),
-				ecsDescriber: mocks.NewMockecsDescriber(ctrl),
-				envDescriber: mocks.NewMockenvDescriber(ctrl),
-				lbDescriber:  mocks.NewMocklbDescriber(ctrl),
-			}
+			mocks := newLBWebSvcDescriberMocks(ctrl, testEnv, prodEnv, mockEnv)
 
 			tc.setupMocks(mocks)
 
@@ -493,8 +794,8 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 				svc:                      testSvc,
 				enableResources:          tc.shouldOutputResources,
 				store:                    mocks.storeSvc,
-				initECSServiceDescribers: func(s string) (ecsDescriber, error) { return mocks.ecsDescriber, nil },
-				initEnvDescribers:        func(s string) (envDescriber, error) { return mocks.envDescriber, nil },
+				initECSServiceDescribers: func(s string) (ecsDescriber, error) { return mocks.ecsDescribers[s], nil },
+				initEnvDescribers:        func(s string) (envDescriber, error) { return mocks.envDescribers[s], nil },
 				initLBDescriber:          func(s string) (lbDescriber, error) { return mocks.lbDescriber, nil },
 			}
 
@@ -663,3 +964,66 @@ Resources
 		})
 	}
 }
+
+func TestBackendSvcDesc_writeRoutes(t *testing.T) {
+	testCases := map[string]struct {
+		inRoutes []*WebServiceRoute
+
+		wanted string
+	}{
+		"without an internal load balancer": {
+			inRoutes: []*WebServiceRoute{
+				{
+					Environment: "test",
+					URL:         "http://my-svc.test.my-app.local:5000",
+				},
+			},
+			wanted: `  Environment  URL
+  -----------  ---
+  test         http://my-svc.test.my-app.local:5000
+`,
+		},
+		"with a route per listener rule path": {
+			inRoutes: []*WebServiceRoute{
+				{
+					Environment: "test",
+					URL:         "http://my-svc.test.my-app.local:5000",
+				},
+				{
+					Environment: "prod",
+					URL:         "http://my-svc.prod.my-app.internal/api",
+					TargetGroup: "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-ap-Targe-1AB2C3D4/73e2d6bc24d8a067",
+				},
+				{
+					Environment: "prod",
+					URL:         "http://my-svc.prod.my-app.internal/admin",
+					TargetGroup: "adminTargetGroupARN",
+				},
+			},
+			wanted: `  Environment  URL                                       Target Group
+  -----------  ---                                       ------------
+  test         http://my-svc.test.my-app.local:5000      -
+  prod         http://my-svc.prod.my-app.internal/api    my-ap-Targe-1AB2C3D4
+  prod         http://my-svc.prod.my-app.internal/admin  adminTargetGroupARN
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var b bytes.Buffer
+			writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+			desc := &backendSvcDesc{
+				Routes: tc.inRoutes,
+			}
+
+			// WHEN
+			desc.writeRoutes(writer)
+			writer.Flush()
+
+			// THEN
+			require.Equal(t, tc.wanted, b.String())
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
 
 	"github.com/dustin/go-humanize"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
@@ -45,12 +46,48 @@ type stackDescriber interface {
 
 type deployedSvcResources map[string][]*stack.Resource
 
+// ecsSvcEnvDesc is the description of an ECS service in a single environment.
+type ecsSvcEnvDesc struct {
+	routes           []*WebServiceRoute // Empty if the service is not reachable in the environment.
+	config           *ECSServiceConfig
+	serviceDiscovery *serviceDiscovery // Nil if the service can't be discovered in the environment.
+	queues           []*WorkerServiceQueue
+	envVars          []*containerEnvVar
+	secrets          []*secret
+	resources        []*stack.Resource
+	deployment       *stack.Deployment
+}
+
+// describeEnvs calls describeEnv for each environment concurrently, so that describing a workload deployed to
+// many environments takes about as long as describing it in one of them.
+// Returns the first error returned by describeEnv.
+func describeEnvs(envs []string, describeEnv func(i int, env string) error) error {
+	var g errgroup.Group
+	for i := range envs {
+		i, env := i, envs[i]
+		g.Go(func() error {
+			return describeEnv(i, env)
+		})
+	}
+	return g.Wait()
+}
+
 func (c deployedSvcResources) humanStringByEnv(w io.Writer, envs []string) {
 	for _, env := range envs {
 		resources := c[env]
 		fmt.Fprintf(w, "\n  %s\n", env)
+		var nestedStack string
 		for _, resource := range resources {
-			fmt.Fprintf(w, "    %s\t%s\n", resource.Type, resource.PhysicalID)
+			if resource.Stack == "" {
+				fmt.Fprintf(w, "    %s\t%s\n", resource.Type, resource.PhysicalID)
+				continue
+			}
+			// Resources of nested stacks are grouped under the name of their stack.
+			if resource.Stack != nestedStack {
+				nestedStack = resource.Stack
+				fmt.Fprintf(w, "\n    %s\n", nestedStack)
+			}
+			fmt.Fprintf(w, "      %s\t%s\n", resource.Type, resource.PhysicalID)
 		}
 	}
 }
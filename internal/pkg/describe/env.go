@@ -11,6 +11,7 @@ import (
 	"strings"
 	"text/tabwriter"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"gopkg.in/yaml.v3"
 
@@ -28,10 +29,23 @@ var (
 	fmtLegacySvcDiscoveryEndpoint = "%s.local"
 )
 
+// Resource types that indicate monitoring coverage of a workload.
+const (
+	cloudWatchAlarmResourceType  = "AWS::CloudWatch::Alarm"
+	targetGroupResourceType      = "AWS::ElasticLoadBalancingV2::TargetGroup"
+	appRunnerServiceResourceType = "AWS::AppRunner::Service"
+)
+
 type vpcSubnetLister interface {
 	ListVPCSubnets(vpcID string) (*ec2.VPCSubnets, error)
 }
 
+type vpcNetworkDescriber interface {
+	ListNATGateways(vpcID string) ([]ec2.NATGateway, error)
+	ListInternetGateways(vpcID string) ([]ec2.Resource, error)
+	ListVPCEndpoints(vpcID string) ([]ec2.VPCEndpoint, error)
+}
+
 // EnvDescription contains the information about an environment.
 type EnvDescription struct {
 	Environment    *config.Environment `json:"environment"`
@@ -40,6 +54,61 @@ type EnvDescription struct {
 	Tags           map[string]string   `json:"tags,omitempty"`
 	Resources      []*stack.Resource   `json:"resources,omitempty"`
 	EnvironmentVPC EnvironmentVPC      `json:"environmentVPC"`
+	Networking     *EnvNetworking      `json:"networking,omitempty"`
+	Observability  *EnvObservability   `json:"observability,omitempty"`
+}
+
+// EnvNetworking holds the subnets, gateways, VPC endpoints and load balancers of an environment.
+type EnvNetworking struct {
+	Subnets                     []*EnvSubnet      `json:"subnets"`
+	InternetGateway             string            `json:"internetGateway,omitempty"`
+	NATGateways                 []*EnvNATGateway  `json:"natGateways,omitempty"`
+	VPCEndpoints                []*EnvVPCEndpoint `json:"vpcEndpoints,omitempty"`
+	PublicLoadBalancerDNSName   string            `json:"publicLoadBalancerDNSName,omitempty"`
+	InternalLoadBalancerDNSName string            `json:"internalLoadBalancerDNSName,omitempty"`
+}
+
+// EnvSubnet holds the placement of a subnet used by an environment.
+type EnvSubnet struct {
+	ID               string `json:"id"`
+	Type             string `json:"type"` // Either "public" or "private".
+	AvailabilityZone string `json:"availabilityZone"`
+	CIDRBlock        string `json:"cidrBlock"`
+}
+
+// EnvNATGateway holds the ID, subnet and public IP address of a NAT gateway in the environment's VPC.
+type EnvNATGateway struct {
+	ID       string `json:"id"`
+	SubnetID string `json:"subnetID"`
+	PublicIP string `json:"publicIP,omitempty"`
+}
+
+// EnvVPCEndpoint holds the ID, service name and type of a VPC endpoint in the environment's VPC.
+type EnvVPCEndpoint struct {
+	ID          string `json:"id"`
+	ServiceName string `json:"serviceName"`
+	Type        string `json:"type"`
+}
+
+// EnvObservability summarizes the monitoring coverage of an environment and its deployed workloads.
+type EnvObservability struct {
+	ContainerInsights bool                     `json:"containerInsights"`
+	Workloads         []*WorkloadObservability `json:"workloads"`
+}
+
+// WorkloadObservability holds the observability settings of a deployed service or job.
+type WorkloadObservability struct {
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	Tracing      string `json:"tracing,omitempty"`
+	LogRetention string `json:"logRetention,omitempty"` // In days.
+	Alarms       int    `json:"alarms"`
+	HealthCheck  bool   `json:"healthCheck"`
+}
+
+// hasGaps returns true if the workload is missing alarms or health checks.
+func (w *WorkloadObservability) hasGaps() bool {
+	return w.Alarms == 0 || !w.HealthCheck
 }
 
 // EnvironmentVPC holds the ID of the environment's VPC configuration.
@@ -51,14 +120,18 @@ type EnvironmentVPC struct {
 
 // EnvDescriber retrieves information about an environment.
 type EnvDescriber struct {
-	app             string
-	env             *config.Environment
-	enableResources bool
-
-	configStore  ConfigStoreSvc
-	deployStore  DeployedEnvServicesLister
-	cfn          stackDescriber
-	subnetLister vpcSubnetLister
+	app                 string
+	env                 *config.Environment
+	enableResources     bool
+	enableObservability bool
+	enableNetworking    bool
+
+	configStore      ConfigStoreSvc
+	deployStore      DeployedEnvServicesLister
+	cfn              stackDescriber
+	subnetLister     vpcSubnetLister
+	networkDescriber vpcNetworkDescriber
+	newWkldDescriber func(stackName string) stackDescriber
 
 	// Cached values for reuse.
 	description *EnvDescription
@@ -66,11 +139,15 @@ type EnvDescriber struct {
 
 // NewEnvDescriberConfig contains fields that initiates EnvDescriber struct.
 type NewEnvDescriberConfig struct {
-	App             string
-	Env             string
-	EnableResources bool
-	ConfigStore     ConfigStoreSvc
-	DeployStore     DeployedEnvServicesLister
+	App                 string
+	Env                 string
+	EnableResources     bool
+	EnableObservability bool
+	EnableNetworking    bool
+	ConfigStore         ConfigStoreSvc
+	DeployStore         DeployedEnvServicesLister
+
+	stackCache *stack.Cache // Memoizes the CloudFormation calls of the describers sharing it. A new one is used if nil.
 }
 
 // NewEnvDescriber instantiates an environment describer.
@@ -83,15 +160,26 @@ func NewEnvDescriber(opt NewEnvDescriberConfig) (*EnvDescriber, error) {
 	if err != nil {
 		return nil, fmt.Errorf("assume role for environment %s: %w", env.ManagerRoleARN, err)
 	}
+	cache := opt.stackCache
+	if cache == nil {
+		cache = stack.NewCache()
+	}
+	ec2Client := ec2.New(sess)
 	return &EnvDescriber{
-		app:             opt.App,
-		env:             env,
-		enableResources: opt.EnableResources,
-
-		configStore:  opt.ConfigStore,
-		deployStore:  opt.DeployStore,
-		cfn:          stack.NewStackDescriber(cfnstack.NameForEnv(opt.App, opt.Env), sess),
-		subnetLister: ec2.New(sess),
+		app:                 opt.App,
+		env:                 env,
+		enableResources:     opt.EnableResources,
+		enableObservability: opt.EnableObservability,
+		enableNetworking:    opt.EnableNetworking,
+
+		configStore:      opt.ConfigStore,
+		deployStore:      opt.DeployStore,
+		cfn:              stack.NewCachedStackDescriber(cfnstack.NameForEnv(opt.App, opt.Env), sess, cache),
+		subnetLister:     ec2Client,
+		networkDescriber: ec2Client,
+		newWkldDescriber: func(stackName string) stackDescriber {
+			return stack.NewCachedStackDescriber(stackName, sess, cache)
+		},
 	}, nil
 }
 
@@ -122,6 +210,20 @@ func (d *EnvDescriber) Describe() (*EnvDescription, error) {
 			return nil, fmt.Errorf("retrieve environment resources: %w", err)
 		}
 	}
+	var networking *EnvNetworking
+	if d.enableNetworking {
+		networking, err = d.networking(environmentVPC)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var observability *EnvObservability
+	if d.enableObservability {
+		observability, err = d.observability(append(svcs, jobs...))
+		if err != nil {
+			return nil, err
+		}
+	}
 	d.description = &EnvDescription{
 		Environment:    d.env,
 		Services:       svcs,
@@ -129,6 +231,8 @@ func (d *EnvDescriber) Describe() (*EnvDescription, error) {
 		Tags:           tags,
 		Resources:      stackResources,
 		EnvironmentVPC: environmentVPC,
+		Networking:     networking,
+		Observability:  observability,
 	}
 	return d.description, nil
 }
@@ -254,6 +358,144 @@ func (d *EnvDescriber) PublicCIDRBlocks() ([]string, error) {
 	return cidrBlocks, nil
 }
 
+func (d *EnvDescriber) networking(vpc EnvironmentVPC) (*EnvNetworking, error) {
+	outputs, err := d.Outputs()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve environment stack: %w", err)
+	}
+	vpcSubnets, err := d.subnetLister.ListVPCSubnets(vpc.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list subnets of vpc %s in environment %s: %w", vpc.ID, d.env.Name, err)
+	}
+	subnets := make(map[string]ec2.Subnet)
+	for _, subnet := range append(vpcSubnets.Public, vpcSubnets.Private...) {
+		subnets[subnet.ID] = subnet
+	}
+	igws, err := d.networkDescriber.ListInternetGateways(vpc.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list internet gateways in environment %s: %w", d.env.Name, err)
+	}
+	natGWs, err := d.networkDescriber.ListNATGateways(vpc.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list NAT gateways in environment %s: %w", d.env.Name, err)
+	}
+	endpoints, err := d.networkDescriber.ListVPCEndpoints(vpc.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list VPC endpoints in environment %s: %w", d.env.Name, err)
+	}
+
+	networking := &EnvNetworking{
+		PublicLoadBalancerDNSName:   outputs[envOutputPublicLoadBalancerDNSName],
+		InternalLoadBalancerDNSName: outputs[envOutputInternalLoadBalancerDNSName],
+	}
+	for _, subnetType := range []struct {
+		name string
+		ids  []string
+	}{
+		{"public", vpc.PublicSubnetIDs},
+		{"private", vpc.PrivateSubnetIDs},
+	} {
+		for _, id := range subnetType.ids {
+			networking.Subnets = append(networking.Subnets, &EnvSubnet{
+				ID:               id,
+				Type:             subnetType.name,
+				AvailabilityZone: subnets[id].AvailabilityZone,
+				CIDRBlock:        subnets[id].CIDRBlock,
+			})
+		}
+	}
+	if len(igws) != 0 {
+		// A VPC has at most one internet gateway attached.
+		networking.InternetGateway = igws[0].ID
+	}
+	for _, gw := range natGWs {
+		networking.NATGateways = append(networking.NATGateways, &EnvNATGateway{
+			ID:       gw.ID,
+			SubnetID: gw.SubnetID,
+			PublicIP: gw.PublicIP,
+		})
+	}
+	for _, endpoint := range endpoints {
+		networking.VPCEndpoints = append(networking.VPCEndpoints, &EnvVPCEndpoint{
+			ID:          endpoint.ID,
+			ServiceName: endpoint.ServiceName,
+			Type:        endpoint.Type,
+		})
+	}
+	return networking, nil
+}
+
+func (d *EnvDescriber) observability(wklds []*config.Workload) (*EnvObservability, error) {
+	raw, err := d.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf("get manifest of environment %s: %w", d.env.Name, err)
+	}
+	envMft, err := manifest.UnmarshalEnvironment(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal manifest of environment %s: %w", d.env.Name, err)
+	}
+	obs := &EnvObservability{
+		ContainerInsights: aws.BoolValue(envMft.Observability.ContainerInsights),
+	}
+	for _, wkld := range wklds {
+		wkldObs, err := d.wkldObservability(wkld)
+		if err != nil {
+			return nil, err
+		}
+		obs.Workloads = append(obs.Workloads, wkldObs)
+	}
+	return obs, nil
+}
+
+func (d *EnvDescriber) wkldObservability(wkld *config.Workload) (*WorkloadObservability, error) {
+	describer := d.newWkldDescriber(cfnstack.NameForService(d.app, d.env.Name, wkld.Name))
+	descr, err := describer.Describe()
+	if err != nil {
+		return nil, fmt.Errorf("describe stack of %s: %w", wkld.Name, err)
+	}
+	resources, err := describer.Resources()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve resources of %s: %w", wkld.Name, err)
+	}
+	rawMetadata, err := describer.StackMetadata()
+	if err != nil {
+		return nil, fmt.Errorf("get stack metadata of %s: %w", wkld.Name, err)
+	}
+	metadata := struct {
+		Manifest string `yaml:"Manifest"`
+	}{}
+	if err := yaml.Unmarshal([]byte(rawMetadata), &metadata); err != nil {
+		return nil, fmt.Errorf("unmarshal Metadata.Manifest in stack of %s: %v", wkld.Name, err)
+	}
+	mft := struct {
+		Observability struct {
+			Tracing string `yaml:"tracing"`
+		} `yaml:"observability"`
+		Image struct {
+			HealthCheck yaml.Node `yaml:"healthcheck"`
+		} `yaml:"image"`
+	}{}
+	if err := yaml.Unmarshal([]byte(metadata.Manifest), &mft); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest of %s: %v", wkld.Name, err)
+	}
+	obs := &WorkloadObservability{
+		Name:         wkld.Name,
+		Type:         wkld.Type,
+		Tracing:      mft.Observability.Tracing,
+		LogRetention: descr.Parameters[cfnstack.WorkloadLogRetentionParamKey],
+		HealthCheck:  !mft.Image.HealthCheck.IsZero(),
+	}
+	for _, r := range resources {
+		switch r.Type {
+		case cloudWatchAlarmResourceType:
+			obs.Alarms++
+		case targetGroupResourceType, appRunnerServiceResourceType:
+			obs.HealthCheck = true
+		}
+	}
+	return obs, nil
+}
+
 func (d *EnvDescriber) loadStackInfo() (map[string]string, EnvironmentVPC, error) {
 	var environmentVPC EnvironmentVPC
 
@@ -365,6 +607,12 @@ func (e *EnvDescription) HumanString() string {
 		}
 	}
 	writer.Flush()
+	if e.Networking != nil {
+		e.Networking.humanString(writer, e.EnvironmentVPC.ID)
+	}
+	if e.Observability != nil {
+		e.Observability.humanString(writer)
+	}
 	if len(e.Resources) != 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n\n"))
 		writer.Flush()
@@ -375,3 +623,96 @@ func (e *EnvDescription) HumanString() string {
 	writer.Flush()
 	return b.String()
 }
+
+func (n *EnvNetworking) humanString(w *tabwriter.Writer, vpcID string) {
+	fmt.Fprint(w, color.Bold.Sprint("\nNetworking\n\n"))
+	w.Flush()
+	fmt.Fprintf(w, "  %s\t%s\n", "VPC", valueOrDash(vpcID))
+	fmt.Fprintf(w, "  %s\t%s\n", "Internet Gateway", valueOrDash(n.InternetGateway))
+	fmt.Fprintf(w, "  %s\t%s\n", "Public Load Balancer", valueOrDash(n.PublicLoadBalancerDNSName))
+	fmt.Fprintf(w, "  %s\t%s\n", "Internal Load Balancer", valueOrDash(n.InternalLoadBalancerDNSName))
+	w.Flush()
+	if len(n.Subnets) != 0 {
+		fmt.Fprintln(w)
+		headers := []string{"Subnet", "Type", "Availability Zone", "CIDR Block"}
+		fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, subnet := range n.Subnets {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", subnet.ID, subnet.Type, valueOrDash(subnet.AvailabilityZone), valueOrDash(subnet.CIDRBlock))
+		}
+		w.Flush()
+	}
+	if len(n.NATGateways) != 0 {
+		fmt.Fprintln(w)
+		headers := []string{"NAT Gateway", "Subnet", "Public IP"}
+		fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, gw := range n.NATGateways {
+			fmt.Fprintf(w, "  %s\t%s\t%s\n", gw.ID, gw.SubnetID, valueOrDash(gw.PublicIP))
+		}
+		w.Flush()
+	}
+	if len(n.VPCEndpoints) != 0 {
+		fmt.Fprintln(w)
+		headers := []string{"VPC Endpoint", "Service", "Type"}
+		fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, endpoint := range n.VPCEndpoints {
+			fmt.Fprintf(w, "  %s\t%s\t%s\n", endpoint.ID, endpoint.ServiceName, endpoint.Type)
+		}
+		w.Flush()
+	}
+}
+
+func (o *EnvObservability) humanString(w *tabwriter.Writer) {
+	fmt.Fprint(w, color.Bold.Sprint("\nObservability\n\n"))
+	w.Flush()
+	insights := "disabled"
+	if o.ContainerInsights {
+		insights = "enabled"
+	}
+	fmt.Fprintf(w, "  %s\t%s\n", "Container Insights", insights)
+	w.Flush()
+	if len(o.Workloads) == 0 {
+		return
+	}
+	fmt.Fprintln(w)
+	headers := []string{"Name", "Tracing", "Log Retention", "Alarms", "Health Check"}
+	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+	var hasGaps bool
+	for _, wkld := range o.Workloads {
+		tracing, retention, healthCheck := "-", "-", "no"
+		if wkld.Tracing != "" {
+			tracing = wkld.Tracing
+		}
+		if wkld.LogRetention != "" {
+			retention = fmt.Sprintf("%s days", wkld.LogRetention)
+		}
+		if wkld.HealthCheck {
+			healthCheck = "yes"
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%d\t%s\n", wkld.Name, tracing, retention, wkld.Alarms, healthCheck)
+		hasGaps = hasGaps || wkld.hasGaps()
+	}
+	w.Flush()
+	if !hasGaps {
+		return
+	}
+	fmt.Fprint(w, color.Bold.Sprint("\nGaps\n\n"))
+	w.Flush()
+	for _, wkld := range o.Workloads {
+		if !wkld.hasGaps() {
+			continue
+		}
+		var missing []string
+		if wkld.Alarms == 0 {
+			missing = append(missing, "alarms")
+		}
+		if !wkld.HealthCheck {
+			missing = append(missing, "health checks")
+		}
+		fmt.Fprintf(w, "  %s\tno %s\n", wkld.Name, strings.Join(missing, " or "))
+	}
+	w.Flush()
+}
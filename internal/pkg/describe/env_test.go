@@ -20,10 +20,12 @@ import (
 )
 
 type envDescriberMocks struct {
-	configStoreSvc *mocks.MockConfigStoreSvc
-	deployStoreSvc *mocks.MockDeployedEnvServicesLister
-	stackDescriber *mocks.MockstackDescriber
-	subnetLister   *mocks.MockvpcSubnetLister
+	configStoreSvc   *mocks.MockConfigStoreSvc
+	deployStoreSvc   *mocks.MockDeployedEnvServicesLister
+	stackDescriber   *mocks.MockstackDescriber
+	wkldDescriber    *mocks.MockstackDescriber
+	subnetLister     *mocks.MockvpcSubnetLister
+	networkDescriber *mocks.MockvpcNetworkDescriber
 }
 
 var wantedResources = []*stack.Resource{
@@ -95,7 +97,8 @@ func TestEnvDescriber_Describe(t *testing.T) {
 	envJobs := []*config.Workload{testJob1, testJob2}
 	mockError := errors.New("some error")
 	testCases := map[string]struct {
-		shouldOutputResources bool
+		shouldOutputResources     bool
+		shouldOutputObservability bool
 
 		setupMocks func(mocks envDescriberMocks)
 
@@ -164,6 +167,105 @@ func TestEnvDescriber_Describe(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("retrieve environment resources: some error"),
 		},
+		"error if fail to describe a workload stack for observability": {
+			shouldOutputObservability: true,
+			setupMocks: func(m envDescriberMocks) {
+				gomock.InOrder(
+					m.configStoreSvc.EXPECT().ListServices(testApp).Return([]*config.Workload{testSvc1}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
+						Return([]string{"testSvc1"}, nil),
+					m.configStoreSvc.EXPECT().ListJobs(testApp).Return(nil, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedJobs(testApp, testEnv.Name).Return(nil, nil),
+					m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{
+						Tags:    stackTags,
+						Outputs: stackOutputs,
+					}, nil),
+					m.stackDescriber.EXPECT().StackMetadata().Return("Manifest: |\n  name: testEnv\n  type: Environment\n", nil),
+					m.wkldDescriber.EXPECT().Describe().Return(stack.StackDescription{}, mockError),
+				)
+			},
+			wantedError: fmt.Errorf("describe stack of testSvc1: some error"),
+		},
+		"success with observability": {
+			shouldOutputObservability: true,
+			setupMocks: func(m envDescriberMocks) {
+				gomock.InOrder(
+					m.configStoreSvc.EXPECT().ListServices(testApp).Return([]*config.Workload{testSvc1}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
+						Return([]string{"testSvc1"}, nil),
+					m.configStoreSvc.EXPECT().ListJobs(testApp).Return([]*config.Workload{testJob1}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedJobs(testApp, testEnv.Name).
+						Return([]string{"testJob1"}, nil),
+					m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{
+						Tags:    stackTags,
+						Outputs: stackOutputs,
+					}, nil),
+					m.stackDescriber.EXPECT().StackMetadata().Return(`Manifest: |
+  name: testEnv
+  type: Environment
+  observability:
+    container_insights: true
+`, nil),
+					m.wkldDescriber.EXPECT().Describe().Return(stack.StackDescription{
+						Parameters: map[string]string{
+							cfstack.WorkloadLogRetentionParamKey: "30",
+						},
+					}, nil),
+					m.wkldDescriber.EXPECT().Resources().Return([]*stack.Resource{
+						{Type: "AWS::ElasticLoadBalancingV2::TargetGroup", PhysicalID: "tg"},
+						{Type: "AWS::CloudWatch::Alarm", PhysicalID: "alarm1"},
+						{Type: "AWS::CloudWatch::Alarm", PhysicalID: "alarm2"},
+					}, nil),
+					m.wkldDescriber.EXPECT().StackMetadata().Return(`Manifest: |
+  name: testSvc1
+  observability:
+    tracing: awsxray
+`, nil),
+					m.wkldDescriber.EXPECT().Describe().Return(stack.StackDescription{
+						Parameters: map[string]string{
+							cfstack.WorkloadLogRetentionParamKey: "7",
+						},
+					}, nil),
+					m.wkldDescriber.EXPECT().Resources().Return(nil, nil),
+					m.wkldDescriber.EXPECT().StackMetadata().Return(`Manifest: |
+  name: testJob1
+  image:
+    healthcheck:
+      command: ["CMD-SHELL", "exit 0"]
+`, nil),
+				)
+			},
+			wantedEnv: &EnvDescription{
+				Environment: testEnv,
+				Services:    []*config.Workload{testSvc1},
+				Jobs:        []*config.Workload{testJob1},
+				Tags:        map[string]string{"copilot-application": "testApp", "copilot-environment": "testEnv"},
+				EnvironmentVPC: EnvironmentVPC{
+					ID:               "vpc-012abcd345",
+					PublicSubnetIDs:  []string{"subnet-0789ab", "subnet-0123cd"},
+					PrivateSubnetIDs: []string{"subnet-023ff", "subnet-04af"},
+				},
+				Observability: &EnvObservability{
+					ContainerInsights: true,
+					Workloads: []*WorkloadObservability{
+						{
+							Name:         "testSvc1",
+							Type:         "load-balanced",
+							Tracing:      "awsxray",
+							LogRetention: "30",
+							Alarms:       2,
+							HealthCheck:  true,
+						},
+						{
+							Name:         "testJob1",
+							Type:         "Scheduled Job",
+							LogRetention: "7",
+							HealthCheck:  true,
+						},
+					},
+				},
+			},
+		},
 		"success without resources": {
 			shouldOutputResources: false,
 			setupMocks: func(m envDescriberMocks) {
@@ -243,22 +345,28 @@ func TestEnvDescriber_Describe(t *testing.T) {
 			mockConfigStoreSvc := mocks.NewMockConfigStoreSvc(ctrl)
 			mockDeployedEnvServicesLister := mocks.NewMockDeployedEnvServicesLister(ctrl)
 			mockCFN := mocks.NewMockstackDescriber(ctrl)
+			mockWkldCFN := mocks.NewMockstackDescriber(ctrl)
 			mocks := envDescriberMocks{
 				configStoreSvc: mockConfigStoreSvc,
 				deployStoreSvc: mockDeployedEnvServicesLister,
 				stackDescriber: mockCFN,
+				wkldDescriber:  mockWkldCFN,
 			}
 
 			tc.setupMocks(mocks)
 
 			d := &EnvDescriber{
-				env:             testEnv,
-				app:             testApp,
-				enableResources: tc.shouldOutputResources,
+				env:                 testEnv,
+				app:                 testApp,
+				enableResources:     tc.shouldOutputResources,
+				enableObservability: tc.shouldOutputObservability,
 
 				configStore: mockConfigStoreSvc,
 				deployStore: mockDeployedEnvServicesLister,
 				cfn:         mockCFN,
+				newWkldDescriber: func(string) stackDescriber {
+					return mockWkldCFN
+				},
 			}
 
 			// WHEN
@@ -783,3 +891,331 @@ Resources
 	// THEN
 	require.Equal(t, wantedContent, actual)
 }
+
+func TestEnvDescription_HumanString_Observability(t *testing.T) {
+	wantedContent := `About
+
+  Name        testEnv
+  Production  false
+  Region      us-west-2
+  Account ID  123456789012
+
+Workloads
+
+  Name      Type
+  ----      ----
+  testSvc1  load-balanced
+  testJob1  Scheduled Job
+
+Observability
+
+  Container Insights  enabled
+
+  Name      Tracing   Log Retention  Alarms    Health Check
+  ----      -------   -------------  ------    ------------
+  testSvc1  awsxray   30 days        2         yes
+  testJob1  -         -              0         no
+
+Gaps
+
+  testJob1  no alarms or health checks
+`
+	d := &EnvDescription{
+		Environment: &config.Environment{
+			App:       "testApp",
+			Name:      "testEnv",
+			Region:    "us-west-2",
+			AccountID: "123456789012",
+		},
+		Services: []*config.Workload{
+			{
+				App:  "testApp",
+				Name: "testSvc1",
+				Type: "load-balanced",
+			},
+		},
+		Jobs: []*config.Workload{
+			{
+				App:  "testApp",
+				Name: "testJob1",
+				Type: "Scheduled Job",
+			},
+		},
+		Observability: &EnvObservability{
+			ContainerInsights: true,
+			Workloads: []*WorkloadObservability{
+				{
+					Name:         "testSvc1",
+					Type:         "load-balanced",
+					Tracing:      "awsxray",
+					LogRetention: "30",
+					Alarms:       2,
+					HealthCheck:  true,
+				},
+				{
+					Name: "testJob1",
+					Type: "Scheduled Job",
+				},
+			},
+		},
+	}
+
+	// WHEN
+	actual := d.HumanString()
+
+	// THEN
+	require.Equal(t, wantedContent, actual)
+}
+
+func TestEnvDescriber_networking(t *testing.T) {
+	vpc := EnvironmentVPC{
+		ID:               "vpc-012abcd345",
+		PublicSubnetIDs:  []string{"subnet-0789ab", "subnet-0123cd"},
+		PrivateSubnetIDs: []string{"subnet-023ff"},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m envDescriberMocks)
+
+		wantedNetworking *EnvNetworking
+		wantedError      error
+	}{
+		"error if fail to describe the environment stack": {
+			setupMocks: func(m envDescriberMocks) {
+				m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{}, errors.New("some error"))
+			},
+			wantedError: errors.New("retrieve environment stack: some error"),
+		},
+		"error if fail to list subnets": {
+			setupMocks: func(m envDescriberMocks) {
+				m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{}, nil)
+				m.subnetLister.EXPECT().ListVPCSubnets("vpc-012abcd345").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list subnets of vpc vpc-012abcd345 in environment testEnv: some error"),
+		},
+		"error if fail to list NAT gateways": {
+			setupMocks: func(m envDescriberMocks) {
+				m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{}, nil)
+				m.subnetLister.EXPECT().ListVPCSubnets("vpc-012abcd345").Return(&ec2.VPCSubnets{}, nil)
+				m.networkDescriber.EXPECT().ListInternetGateways("vpc-012abcd345").Return(nil, nil)
+				m.networkDescriber.EXPECT().ListNATGateways("vpc-012abcd345").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list NAT gateways in environment testEnv: some error"),
+		},
+		"success": {
+			setupMocks: func(m envDescriberMocks) {
+				m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{
+					Outputs: map[string]string{
+						"PublicLoadBalancerDNSName":   "testApp-Publi-1234.us-west-2.elb.amazonaws.com",
+						"InternalLoadBalancerDNSName": "internal-testApp-Inter-5678.us-west-2.elb.amazonaws.com",
+					},
+				}, nil)
+				m.subnetLister.EXPECT().ListVPCSubnets("vpc-012abcd345").Return(&ec2.VPCSubnets{
+					Public: []ec2.Subnet{
+						{
+							Resource:         ec2.Resource{ID: "subnet-0789ab"},
+							CIDRBlock:        "10.0.0.0/24",
+							AvailabilityZone: "us-west-2a",
+						},
+						{
+							Resource:         ec2.Resource{ID: "subnet-0123cd"},
+							CIDRBlock:        "10.0.1.0/24",
+							AvailabilityZone: "us-west-2b",
+						},
+					},
+					Private: []ec2.Subnet{
+						{
+							Resource:         ec2.Resource{ID: "subnet-023ff"},
+							CIDRBlock:        "10.0.2.0/24",
+							AvailabilityZone: "us-west-2a",
+						},
+						{
+							Resource:         ec2.Resource{ID: "subnet-unused"},
+							CIDRBlock:        "10.0.3.0/24",
+							AvailabilityZone: "us-west-2b",
+						},
+					},
+				}, nil)
+				m.networkDescriber.EXPECT().ListInternetGateways("vpc-012abcd345").Return([]ec2.Resource{{ID: "igw-1"}}, nil)
+				m.networkDescriber.EXPECT().ListNATGateways("vpc-012abcd345").Return([]ec2.NATGateway{
+					{
+						Resource: ec2.Resource{ID: "nat-1"},
+						SubnetID: "subnet-0789ab",
+						PublicIP: "3.3.3.3",
+					},
+				}, nil)
+				m.networkDescriber.EXPECT().ListVPCEndpoints("vpc-012abcd345").Return([]ec2.VPCEndpoint{
+					{
+						ID:          "vpce-1",
+						ServiceName: "com.amazonaws.us-west-2.s3",
+						Type:        "Gateway",
+					},
+				}, nil)
+			},
+			wantedNetworking: &EnvNetworking{
+				Subnets: []*EnvSubnet{
+					{
+						ID:               "subnet-0789ab",
+						Type:             "public",
+						AvailabilityZone: "us-west-2a",
+						CIDRBlock:        "10.0.0.0/24",
+					},
+					{
+						ID:               "subnet-0123cd",
+						Type:             "public",
+						AvailabilityZone: "us-west-2b",
+						CIDRBlock:        "10.0.1.0/24",
+					},
+					{
+						ID:               "subnet-023ff",
+						Type:             "private",
+						AvailabilityZone: "us-west-2a",
+						CIDRBlock:        "10.0.2.0/24",
+					},
+				},
+				InternetGateway: "igw-1",
+				NATGateways: []*EnvNATGateway{
+					{
+						ID:       "nat-1",
+						SubnetID: "subnet-0789ab",
+						PublicIP: "3.3.3.3",
+					},
+				},
+				VPCEndpoints: []*EnvVPCEndpoint{
+					{
+						ID:          "vpce-1",
+						ServiceName: "com.amazonaws.us-west-2.s3",
+						Type:        "Gateway",
+					},
+				},
+				PublicLoadBalancerDNSName:   "testApp-Publi-1234.us-west-2.elb.amazonaws.com",
+				InternalLoadBalancerDNSName: "internal-testApp-Inter-5678.us-west-2.elb.amazonaws.com",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := envDescriberMocks{
+				stackDescriber:   mocks.NewMockstackDescriber(ctrl),
+				subnetLister:     mocks.NewMockvpcSubnetLister(ctrl),
+				networkDescriber: mocks.NewMockvpcNetworkDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+
+			d := &EnvDescriber{
+				env: &config.Environment{
+					App:  "testApp",
+					Name: "testEnv",
+				},
+				app:              "testApp",
+				cfn:              m.stackDescriber,
+				subnetLister:     m.subnetLister,
+				networkDescriber: m.networkDescriber,
+			}
+
+			// WHEN
+			actual, err := d.networking(vpc)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNetworking, actual)
+		})
+	}
+}
+
+func TestEnvDescription_HumanString_Networking(t *testing.T) {
+	wantedContent := `About
+
+  Name        testEnv
+  Production  false
+  Region      us-west-2
+  Account ID  123456789012
+
+Workloads
+
+  Name      Type
+  ----      ----
+  testSvc1  load-balanced
+
+Networking
+
+  VPC                     vpc-012abcd345
+  Internet Gateway        igw-1
+  Public Load Balancer    testApp-Publi-1234.us-west-2.elb.amazonaws.com
+  Internal Load Balancer  -
+
+  Subnet         Type      Availability Zone  CIDR Block
+  ------         ----      -----------------  ----------
+  subnet-0789ab  public    us-west-2a         10.0.0.0/24
+  subnet-023ff   private   -                  -
+
+  NAT Gateway  Subnet         Public IP
+  -----------  ------         ---------
+  nat-1        subnet-0789ab  3.3.3.3
+
+  VPC Endpoint  Service                     Type
+  ------------  -------                     ----
+  vpce-1        com.amazonaws.us-west-2.s3  Gateway
+`
+	d := &EnvDescription{
+		Environment: &config.Environment{
+			App:       "testApp",
+			Name:      "testEnv",
+			Region:    "us-west-2",
+			AccountID: "123456789012",
+		},
+		Services: []*config.Workload{
+			{
+				App:  "testApp",
+				Name: "testSvc1",
+				Type: "load-balanced",
+			},
+		},
+		EnvironmentVPC: EnvironmentVPC{
+			ID: "vpc-012abcd345",
+		},
+		Networking: &EnvNetworking{
+			Subnets: []*EnvSubnet{
+				{
+					ID:               "subnet-0789ab",
+					Type:             "public",
+					AvailabilityZone: "us-west-2a",
+					CIDRBlock:        "10.0.0.0/24",
+				},
+				{
+					ID:   "subnet-023ff",
+					Type: "private",
+				},
+			},
+			InternetGateway: "igw-1",
+			NATGateways: []*EnvNATGateway{
+				{
+					ID:       "nat-1",
+					SubnetID: "subnet-0789ab",
+					PublicIP: "3.3.3.3",
+				},
+			},
+			VPCEndpoints: []*EnvVPCEndpoint{
+				{
+					ID:          "vpce-1",
+					ServiceName: "com.amazonaws.us-west-2.s3",
+					Type:        "Gateway",
+				},
+			},
+			PublicLoadBalancerDNSName: "testApp-Publi-1234.us-west-2.elb.amazonaws.com",
+		},
+	}
+
+	// WHEN
+	actual := d.HumanString()
+
+	// THEN
+	require.Equal(t, wantedContent, actual)
+}
@@ -12,6 +12,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"text/tabwriter"
 
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
@@ -31,13 +32,17 @@ const (
 	envOutputPublicLoadBalancerDNSName   = "PublicLoadBalancerDNSName"
 	envOutputInternalLoadBalancerDNSName = "InternalLoadBalancerDNSName"
 	envOutputSubdomain                   = "EnvironmentSubdomain"
+	envOutputCloudFrontDomainName        = "CloudFrontDistributionDomainName"
 
 	svcStackResourceALBTargetGroupLogicalID    = "TargetGroup"
 	svcStackResourceNLBTargetGroupLogicalID    = "NLBTargetGroup"
 	svcStackResourceHTTPSListenerRuleLogicalID = "HTTPSListenerRule"
 	svcStackResourceHTTPListenerRuleLogicalID  = "HTTPListenerRule"
 	svcStackResourceListenerRuleResourceType   = "AWS::ElasticLoadBalancingV2::ListenerRule"
+	svcStackResourceListenerResourceType       = "AWS::ElasticLoadBalancingV2::Listener"
+	svcStackResourceNLBListenerLogicalIDPrefix = "NLBListener"
 	svcOutputPublicNLBDNSName                  = "PublicNetworkLoadBalancerDNSName"
+	svcOutputDiscoveryServiceRecordTypes       = "DiscoveryServiceRecordTypes"
 )
 
 type envDescriber interface {
@@ -48,6 +53,8 @@ type envDescriber interface {
 
 type lbDescriber interface {
 	ListenerRuleHostHeaders(ruleARN string) ([]string, error)
+	Listeners(listenerARNs ...string) ([]*elbv2.Listener, error)
+	ListenerRules(ruleARNs ...string) ([]*elbv2.ListenerRule, error)
 }
 
 // LBWebServiceDescriber retrieves information about a load balanced web service.
@@ -62,10 +69,12 @@ type LBWebServiceDescriber struct {
 	initLBDescriber          func(string) (lbDescriber, error)
 	ecsServiceDescribers     map[string]ecsDescriber
 	envDescriber             map[string]envDescriber
+	mu                       sync.Mutex // Guards the cached describers, since environments are described concurrently.
 }
 
 // NewLBWebServiceDescriber instantiates a load balanced service describer.
 func NewLBWebServiceDescriber(opt NewServiceConfig) (*LBWebServiceDescriber, error) {
+	stackCache := stack.NewCache() // Shared by the describers of every environment.
 	describer := &LBWebServiceDescriber{
 		app:                  opt.App,
 		svc:                  opt.Svc,
@@ -86,6 +95,8 @@ func NewLBWebServiceDescriber(opt NewServiceConfig) (*LBWebServiceDescriber, err
 		return elbv2.New(sess), nil
 	}
 	describer.initECSServiceDescribers = func(env string) (ecsDescriber, error) {
+		describer.mu.Lock()
+		defer describer.mu.Unlock()
 		if describer, ok := describer.ecsServiceDescribers[env]; ok {
 			return describer, nil
 		}
@@ -93,6 +104,7 @@ func NewLBWebServiceDescriber(opt NewServiceConfig) (*LBWebServiceDescriber, err
 			App:         opt.App,
 			Svc:         opt.Svc,
 			ConfigStore: opt.ConfigStore,
+			stackCache:  stackCache,
 		}, env)
 		if err != nil {
 			return nil, err
@@ -101,6 +113,8 @@ func NewLBWebServiceDescriber(opt NewServiceConfig) (*LBWebServiceDescriber, err
 		return svcDescr, nil
 	}
 	describer.initEnvDescribers = func(env string) (envDescriber, error) {
+		describer.mu.Lock()
+		defer describer.mu.Unlock()
 		if describer, ok := describer.envDescriber[env]; ok {
 			return describer, nil
 		}
@@ -108,6 +122,7 @@ func NewLBWebServiceDescriber(opt NewServiceConfig) (*LBWebServiceDescriber, err
 			App:         opt.App,
 			Env:         env,
 			ConfigStore: opt.ConfigStore,
+			stackCache:  stackCache,
 		})
 		if err != nil {
 			return nil, err
@@ -125,78 +140,38 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 		return nil, fmt.Errorf("list deployed environments for application %s: %w", d.app, err)
 	}
 
+	envDescs := make([]*ecsSvcEnvDesc, len(environments))
+	err = describeEnvs(environments, func(i int, env string) error {
+		desc, err := d.describeEnv(env)
+		if err != nil {
+			return err
+		}
+		envDescs[i] = desc
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var routes []*WebServiceRoute
 	var configs []*ECSServiceConfig
 	var serviceDiscoveries []*ServiceDiscovery
 	var envVars []*containerEnvVar
 	var secrets []*secret
-	for _, env := range environments {
-		svcDescr, err := d.initECSServiceDescribers(env)
-		if err != nil {
-			return nil, err
-		}
-		uri, err := d.URI(env)
-		if err != nil {
-			return nil, fmt.Errorf("retrieve service URI: %w", err)
-		}
-		routes = append(routes, &WebServiceRoute{
-			Environment: env,
-			URL:         uri.URI,
-		})
-		containerPlatform, err := svcDescr.Platform()
-		if err != nil {
-			return nil, fmt.Errorf("retrieve platform: %w", err)
-		}
-		webSvcEnvVars, err := svcDescr.EnvVars()
-		if err != nil {
-			return nil, fmt.Errorf("retrieve environment variables: %w", err)
-		}
-		svcParams, err := svcDescr.Params()
-		if err != nil {
-			return nil, fmt.Errorf("get stack parameters for service %s: %w", d.svc, err)
-		}
-		configs = append(configs, &ECSServiceConfig{
-			ServiceConfig: &ServiceConfig{
-				Environment: env,
-				Port:        svcParams[cfnstack.WorkloadContainerPortParamKey],
-				CPU:         svcParams[cfnstack.WorkloadTaskCPUParamKey],
-				Memory:      svcParams[cfnstack.WorkloadTaskMemoryParamKey],
-				Platform:    dockerengine.PlatformString(containerPlatform.OperatingSystem, containerPlatform.Architecture),
-			},
-			Tasks: svcParams[cfnstack.WorkloadTaskCountParamKey],
-		})
-		envDescr, err := d.initEnvDescribers(env)
-		if err != nil {
-			return nil, err
-		}
-		endpoint, err := envDescr.ServiceDiscoveryEndpoint()
-		if err != nil {
-			return nil, err
-		}
-		serviceDiscoveries = appendServiceDiscovery(serviceDiscoveries, serviceDiscovery{
-			Service:  d.svc,
-			Port:     svcParams[cfnstack.WorkloadContainerPortParamKey],
-			Endpoint: endpoint,
-		}, env)
-		envVars = append(envVars, flattenContainerEnvVars(env, webSvcEnvVars)...)
-		webSvcSecrets, err := svcDescr.Secrets()
-		if err != nil {
-			return nil, fmt.Errorf("retrieve secrets: %w", err)
-		}
-		secrets = append(secrets, flattenSecrets(env, webSvcSecrets)...)
-	}
+	var deployments []*stack.Deployment
 	resources := make(map[string][]*stack.Resource)
-	if d.enableResources {
-		for _, env := range environments {
-			svcDescr, err := d.initECSServiceDescribers(env)
-			if err != nil {
-				return nil, err
-			}
-			stackResources, err := svcDescr.ServiceStackResources()
-			if err != nil {
-				return nil, fmt.Errorf("retrieve service resources: %w", err)
-			}
-			resources[env] = stackResources
+	for i, desc := range envDescs {
+		env := environments[i]
+		routes = append(routes, desc.routes...)
+		configs = append(configs, desc.config)
+		serviceDiscoveries = appendServiceDiscovery(serviceDiscoveries, *desc.serviceDiscovery, env)
+		envVars = append(envVars, desc.envVars...)
+		secrets = append(secrets, desc.secrets...)
+		if desc.deployment != nil {
+			deployments = append(deployments, desc.deployment)
+		}
+		if d.enableResources {
+			resources[env] = desc.resources
 		}
 	}
 
@@ -210,11 +185,80 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 		Variables:        envVars,
 		Secrets:          secrets,
 		Resources:        resources,
+		Deployments:      deployments,
 
 		environments: environments,
 	}, nil
 }
 
+// describeEnv returns the description of the service in an environment.
+func (d *LBWebServiceDescriber) describeEnv(env string) (*ecsSvcEnvDesc, error) {
+	svcDescr, err := d.initECSServiceDescribers(env)
+	if err != nil {
+		return nil, err
+	}
+	uri, err := d.URI(env)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve service URI: %w", err)
+	}
+	containerPlatform, err := svcDescr.Platform()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve platform: %w", err)
+	}
+	webSvcEnvVars, err := svcDescr.EnvVars()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve environment variables: %w", err)
+	}
+	svcParams, err := svcDescr.Params()
+	if err != nil {
+		return nil, fmt.Errorf("get stack parameters for service %s: %w", d.svc, err)
+	}
+	envDescr, err := d.initEnvDescribers(env)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := envDescr.ServiceDiscoveryEndpoint()
+	if err != nil {
+		return nil, err
+	}
+	recordTypes, err := serviceDiscoveryRecordTypes(svcDescr)
+	if err != nil {
+		return nil, err
+	}
+	webSvcSecrets, err := svcDescr.Secrets()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve secrets: %w", err)
+	}
+	desc := &ecsSvcEnvDesc{
+		routes: webServiceRoutes(env, uri),
+		config: &ECSServiceConfig{
+			ServiceConfig: &ServiceConfig{
+				Environment: env,
+				Port:        svcParams[cfnstack.WorkloadContainerPortParamKey],
+				CPU:         svcParams[cfnstack.WorkloadTaskCPUParamKey],
+				Memory:      svcParams[cfnstack.WorkloadTaskMemoryParamKey],
+				Platform:    dockerengine.PlatformString(containerPlatform.OperatingSystem, containerPlatform.Architecture),
+			},
+			Tasks: svcParams[cfnstack.WorkloadTaskCountParamKey],
+		},
+		serviceDiscovery: &serviceDiscovery{
+			Service:     d.svc,
+			Port:        svcParams[cfnstack.WorkloadContainerPortParamKey],
+			Endpoint:    endpoint,
+			RecordTypes: recordTypes,
+		},
+		envVars: flattenContainerEnvVars(env, webSvcEnvVars),
+		secrets: flattenSecrets(env, webSvcSecrets),
+	}
+	desc.deployment = bestEffortLastDeployment(svcDescr)
+	if d.enableResources {
+		if desc.resources, err = workloadStackResources(svcDescr); err != nil {
+			return nil, err
+		}
+	}
+	return desc, nil
+}
+
 // Manifest returns the contents of the manifest used to deploy a load balanced web service stack.
 // If the Manifest metadata doesn't exist in the stack template, then returns ErrManifestNotFoundInTemplate.
 func (d *LBWebServiceDescriber) Manifest(env string) ([]byte, error) {
@@ -225,6 +269,15 @@ func (d *LBWebServiceDescriber) Manifest(env string) ([]byte, error) {
 	return cfn.Manifest()
 }
 
+// Params returns the parameters of the load balanced web service stack deployed in the environment.
+func (d *LBWebServiceDescriber) Params(env string) (map[string]string, error) {
+	cfn, err := d.initECSServiceDescribers(env)
+	if err != nil {
+		return nil, err
+	}
+	return cfn.Params()
+}
+
 type secret struct {
 	Name        string `json:"name"`
 	Container   string `json:"container"`
@@ -283,8 +336,83 @@ func underline(headings []string) []string {
 
 // WebServiceRoute contains serialized route parameters for a web service.
 type WebServiceRoute struct {
-	Environment string `json:"environment"`
-	URL         string `json:"url"`
+	Environment string        `json:"environment"`
+	URL         string        `json:"url"`
+	AccessType  URIAccessType `json:"accessType,omitempty"`
+	Protocol    string        `json:"protocol,omitempty"`
+	DNSNames    []string      `json:"dnsNames,omitempty"`
+	Path        string        `json:"path,omitempty"`
+	Port        string        `json:"port,omitempty"`
+	Primary     bool          `json:"primary,omitempty"`
+	Status      string        `json:"status,omitempty"`
+	Origin      bool          `json:"origin,omitempty"`
+	TargetGroup string        `json:"targetGroup,omitempty"`
+}
+
+// writeRoutes writes the routes of the service, marking which ones to use if the service is behind a CloudFront distribution.
+func (w *webSvcDesc) writeRoutes(writer io.Writer) {
+	if !w.hasCDN() {
+		headers := []string{"Environment", "URL"}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, route := range w.Routes {
+			fmt.Fprintf(writer, "  %s\t%s\n", route.Environment, route.URL)
+		}
+		return
+	}
+	headers := []string{"Environment", "URL", "Primary"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, route := range w.Routes {
+		primary := "-"
+		switch {
+		case route.Primary:
+			primary = "yes"
+		case route.Origin:
+			primary = "no (CloudFront origin)"
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", route.Environment, route.URL, primary)
+	}
+}
+
+// hasCDN returns true if the service is behind a CloudFront distribution in any environment.
+func (w *webSvcDesc) hasCDN() bool {
+	for _, route := range w.Routes {
+		if route.Origin {
+			return true
+		}
+	}
+	return false
+}
+
+// webServiceRoutes returns a route for each group of endpoints in the URI of the service in the environment.
+func webServiceRoutes(env string, uri URI) []*WebServiceRoute {
+	if len(uri.Routes) == 0 {
+		return []*WebServiceRoute{
+			{
+				Environment: env,
+				URL:         uri.URI,
+				AccessType:  uri.AccessType,
+			},
+		}
+	}
+	routes := make([]*WebServiceRoute, len(uri.Routes))
+	for i, route := range uri.Routes {
+		routes[i] = &WebServiceRoute{
+			Environment: env,
+			URL:         route.URI,
+			AccessType:  route.AccessType,
+			Protocol:    route.Protocol,
+			DNSNames:    route.DNSNames,
+			Path:        route.Path,
+			Port:        route.Port,
+			Primary:     route.Primary,
+			Status:      route.Status,
+			Origin:      route.Origin,
+			TargetGroup: route.TargetGroup,
+		}
+	}
+	return routes
 }
 
 // ServiceDiscovery contains serialized service discovery info for an service.
@@ -315,6 +443,7 @@ type webSvcDesc struct {
 	Variables        containerEnvVars     `json:"variables"`
 	Secrets          secrets              `json:"secrets,omitempty"`
 	Resources        deployedSvcResources `json:"resources,omitempty"`
+	Deployments      lastDeployments      `json:"deployments,omitempty"`
 
 	environments []string
 }
@@ -340,14 +469,14 @@ func (w *webSvcDesc) HumanString() string {
 	fmt.Fprint(writer, color.Bold.Sprint("\nConfigurations\n\n"))
 	writer.Flush()
 	w.Configurations.humanString(writer)
+	if len(w.Deployments) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nDeployment\n\n"))
+		writer.Flush()
+		w.Deployments.humanString(writer)
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nRoutes\n\n"))
 	writer.Flush()
-	headers := []string{"Environment", "URL"}
-	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
-	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
-	for _, route := range w.Routes {
-		fmt.Fprintf(writer, "  %s\t%s\n", route.Environment, route.URL)
-	}
+	w.writeRoutes(writer)
 	fmt.Fprint(writer, color.Bold.Sprint("\nService Discovery\n\n"))
 	writer.Flush()
 	w.ServiceDiscovery.humanString(writer)
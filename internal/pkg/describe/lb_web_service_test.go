@@ -4,9 +4,11 @@
 package describe
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"testing"
+	"text/tabwriter"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
@@ -21,6 +23,27 @@ type lbWebSvcDescriberMocks struct {
 	ecsDescriber *mocks.MockecsDescriber
 	envDescriber *mocks.MockenvDescriber
 	lbDescriber  *mocks.MocklbDescriber
+
+	// Environments are described concurrently, so each environment gets its own describers.
+	ecsDescribers map[string]*mocks.MockecsDescriber
+	envDescribers map[string]*mocks.MockenvDescriber
+}
+
+// newLBWebSvcDescriberMocks creates mocks with a pair of describers for each environment.
+// The default ecsDescriber and envDescriber are the describers of the first environment.
+func newLBWebSvcDescriberMocks(ctrl *gomock.Controller, envs ...string) lbWebSvcDescriberMocks {
+	m := lbWebSvcDescriberMocks{
+		storeSvc:      mocks.NewMockDeployedEnvServicesLister(ctrl),
+		lbDescriber:   mocks.NewMocklbDescriber(ctrl),
+		ecsDescribers: make(map[string]*mocks.MockecsDescriber),
+		envDescribers: make(map[string]*mocks.MockenvDescriber),
+	}
+	for _, env := range envs {
+		m.ecsDescribers[env] = mocks.NewMockecsDescriber(ctrl)
+		m.envDescribers[env] = mocks.NewMockenvDescriber(ctrl)
+	}
+	m.ecsDescriber, m.envDescriber = m.ecsDescribers[envs[0]], m.envDescribers[envs[0]]
+	return m
 }
 
 func TestLBWebServiceDescriber_Describe(t *testing.T) {
@@ -92,6 +115,9 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
 						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
 					}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
 					m.ecsDescriber.EXPECT().Platform().Return(&ecs.ContainerPlatform{
 						OperatingSystem: "LINUX",
 						Architecture:    "X86_64",
@@ -121,6 +147,9 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
 						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
 					}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
 					m.ecsDescriber.EXPECT().Platform().Return(&ecs.ContainerPlatform{
 						OperatingSystem: "LINUX",
 						Architecture:    "X86_64",
@@ -151,6 +180,9 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
 						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
 					}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
 					m.ecsDescriber.EXPECT().Platform().Return(nil, errors.New("some error")),
 				)
 			},
@@ -169,6 +201,9 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
 						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
 					}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
 					m.ecsDescriber.EXPECT().Platform().Return(&ecs.ContainerPlatform{
 						OperatingSystem: "LINUX",
 						Architecture:    "X86_64",
@@ -191,6 +226,9 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
 						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
 					}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
 					m.ecsDescriber.EXPECT().Platform().Return(&ecs.ContainerPlatform{
 						OperatingSystem: "LINUX",
 						Architecture:    "X86_64",
@@ -204,6 +242,9 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 					}, nil),
 					m.ecsDescriber.EXPECT().Params().Return(mockParams, nil),
 					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
+					m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{
+						svcOutputDiscoveryServiceRecordTypes: "A,SRV",
+					}, nil),
 					m.ecsDescriber.EXPECT().Secrets().Return(nil, mockErr),
 				)
 			},
@@ -212,6 +253,7 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 		"return error if fail to retrieve service resources for ALB service": {
 			shouldOutputResources: true,
 			setupMocks: func(m lbWebSvcDescriberMocks) {
+				m.ecsDescriber.EXPECT().LastDeployment().Return(&stack.Deployment{Environment: testEnv}, nil)
 				gomock.InOrder(
 					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
 					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
@@ -223,6 +265,9 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
 						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
 					}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
 					m.ecsDescriber.EXPECT().Platform().Return(&ecs.ContainerPlatform{
 						OperatingSystem: "LINUX",
 						Architecture:    "X86_64",
@@ -236,6 +281,9 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 					}, nil),
 					m.ecsDescriber.EXPECT().Params().Return(mockParams, nil),
 					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
+					m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{
+						svcOutputDiscoveryServiceRecordTypes: "A,SRV",
+					}, nil),
 					m.ecsDescriber.EXPECT().Secrets().Return([]*ecs.ContainerSecret{
 						{
 							Name:      "GITHUB_WEBHOOK_SECRET",
@@ -256,76 +304,101 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 		"success for ALB service": {
 			shouldOutputResources: true,
 			setupMocks: func(m lbWebSvcDescriberMocks) {
+				m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv, prodEnv}, nil)
+				m.ecsDescribers[testEnv].EXPECT().LastDeployment().Return(&stack.Deployment{Environment: testEnv}, nil)
 				gomock.InOrder(
-					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv, prodEnv}, nil),
-					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
+					m.ecsDescribers[testEnv].EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
 							LogicalID: svcStackResourceALBTargetGroupLogicalID,
 						},
 					}, nil),
-					m.ecsDescriber.EXPECT().Params().Return(mockParams, nil),
-					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+					m.ecsDescribers[testEnv].EXPECT().Params().Return(mockParams, nil),
+					m.envDescribers[testEnv].EXPECT().Outputs().Return(map[string]string{
 						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
 					}, nil),
-					m.ecsDescriber.EXPECT().Platform().Return(&ecs.ContainerPlatform{
+					m.envDescribers[testEnv].EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
+					m.ecsDescribers[testEnv].EXPECT().Platform().Return(&ecs.ContainerPlatform{
 						OperatingSystem: "LINUX",
 						Architecture:    "X86_64",
 					}, nil),
-					m.ecsDescriber.EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
+					m.ecsDescribers[testEnv].EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
 						{
 							Name:      "COPILOT_ENVIRONMENT_NAME",
 							Container: "container1",
 							Value:     testEnv,
 						},
 					}, nil),
-					m.ecsDescriber.EXPECT().Params().Return(mockParams, nil),
-					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
-					m.ecsDescriber.EXPECT().Secrets().Return([]*ecs.ContainerSecret{
+					m.ecsDescribers[testEnv].EXPECT().Params().Return(mockParams, nil),
+					m.envDescribers[testEnv].EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
+					m.ecsDescribers[testEnv].EXPECT().Outputs().Return(map[string]string{
+						svcOutputDiscoveryServiceRecordTypes: "A,SRV",
+					}, nil),
+					m.ecsDescribers[testEnv].EXPECT().Secrets().Return([]*ecs.ContainerSecret{
 						{
 							Name:      "GITHUB_WEBHOOK_SECRET",
 							Container: "container",
 							ValueFrom: "GH_WEBHOOK_SECRET",
 						},
 					}, nil),
-					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
+					m.ecsDescribers[testEnv].EXPECT().ServiceStackResources().Return([]*stack.Resource{
+						{
+							Type:       "AWS::EC2::SecurityGroupIngress",
+							PhysicalID: "ContainerSecurityGroupIngressFromPublicALB",
+						},
+					}, nil),
+					m.ecsDescribers[testEnv].EXPECT().AddonsStackResources().Return(nil, nil),
+				)
+				m.ecsDescribers[prodEnv].EXPECT().LastDeployment().Return(&stack.Deployment{Environment: prodEnv}, nil)
+				gomock.InOrder(
+					m.ecsDescribers[prodEnv].EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
 							LogicalID: svcStackResourceALBTargetGroupLogicalID,
 						},
 					}, nil),
-					m.ecsDescriber.EXPECT().Params().Return(mockProdParams, nil),
-					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+					m.ecsDescribers[prodEnv].EXPECT().Params().Return(mockProdParams, nil),
+					m.envDescribers[prodEnv].EXPECT().Outputs().Return(map[string]string{
 						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
 					}, nil),
-					m.ecsDescriber.EXPECT().Platform().Return(&ecs.ContainerPlatform{
+					m.envDescribers[prodEnv].EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+						envOutputCloudFrontDomainName:      "d1a2b3c4.cloudfront.net",
+					}, nil),
+					m.ecsDescribers[prodEnv].EXPECT().Platform().Return(&ecs.ContainerPlatform{
 						OperatingSystem: "LINUX",
 						Architecture:    "ARM64",
 					}, nil),
-					m.ecsDescriber.EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
+					m.ecsDescribers[prodEnv].EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
 						{
 							Name:      "COPILOT_ENVIRONMENT_NAME",
 							Container: "container2",
 							Value:     prodEnv,
 						},
 					}, nil),
-					m.ecsDescriber.EXPECT().Params().Return(mockProdParams, nil),
-					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("prod.phonetool.local", nil),
-					m.ecsDescriber.EXPECT().Secrets().Return([]*ecs.ContainerSecret{
+					m.ecsDescribers[prodEnv].EXPECT().Params().Return(mockProdParams, nil),
+					m.envDescribers[prodEnv].EXPECT().ServiceDiscoveryEndpoint().Return("prod.phonetool.local", nil),
+					m.ecsDescribers[prodEnv].EXPECT().Outputs().Return(map[string]string{
+						svcOutputDiscoveryServiceRecordTypes: "A,SRV",
+					}, nil),
+					m.ecsDescribers[prodEnv].EXPECT().Secrets().Return([]*ecs.ContainerSecret{
 						{
 							Name:      "SOME_OTHER_SECRET",
 							Container: "container",
 							ValueFrom: "SHHHHHHHH",
 						},
 					}, nil),
-					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
+					m.ecsDescribers[prodEnv].EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
-							Type:       "AWS::EC2::SecurityGroupIngress",
-							PhysicalID: "ContainerSecurityGroupIngressFromPublicALB",
+							Type:       "AWS::EC2::SecurityGroup",
+							PhysicalID: "sg-0758ed6b233743530",
 						},
 					}, nil),
-					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
+					m.ecsDescribers[prodEnv].EXPECT().AddonsStackResources().Return([]*stack.Resource{
 						{
-							Type:       "AWS::EC2::SecurityGroup",
-							PhysicalID: "sg-0758ed6b233743530",
+							Type:       "AWS::DynamoDB::Table",
+							PhysicalID: "phonetool-prod-api-orders",
+							Stack:      "phonetool-prod-api-AddonsStack-1A2B3C",
 						},
 					}, nil),
 				)
@@ -360,10 +433,31 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 					{
 						Environment: "test",
 						URL:         "http://abc.us-west-1.elb.amazonaws.com/*",
+						AccessType:  URIAccessTypeInternet,
+						Protocol:    "http",
+						DNSNames:    []string{"abc.us-west-1.elb.amazonaws.com"},
+						Path:        "/*",
+						Port:        "80",
+					},
+					{
+						Environment: "prod",
+						URL:         "https://d1a2b3c4.cloudfront.net/*",
+						AccessType:  URIAccessTypeInternet,
+						Protocol:    "https",
+						DNSNames:    []string{"d1a2b3c4.cloudfront.net"},
+						Path:        "/*",
+						Port:        "443",
+						Primary:     true,
 					},
 					{
 						Environment: "prod",
 						URL:         "http://abc.us-west-1.elb.amazonaws.com/*",
+						AccessType:  URIAccessTypeInternet,
+						Protocol:    "http",
+						DNSNames:    []string{"abc.us-west-1.elb.amazonaws.com"},
+						Path:        "/*",
+						Port:        "80",
+						Origin:      true,
 					},
 				},
 				ServiceDiscovery: []*ServiceDiscovery{
@@ -420,8 +514,17 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 							Type:       "AWS::EC2::SecurityGroup",
 							PhysicalID: "sg-0758ed6b233743530",
 						},
+						{
+							Type:       "AWS::DynamoDB::Table",
+							PhysicalID: "phonetool-prod-api-orders",
+							Stack:      "phonetool-prod-api-AddonsStack-1A2B3C",
+						},
 					},
 				},
+				Deployments: []*stack.Deployment{
+					{Environment: "test"},
+					{Environment: "prod"},
+				},
 				environments: []string{"test", "prod"},
 			},
 		},
@@ -432,14 +535,7 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			mockStore := mocks.NewMockDeployedEnvServicesLister(ctrl)
-			mockSvcStackDescriber := mocks.NewMockecsDescriber(ctrl)
-			mockEnvDescriber := mocks.NewMockenvDescriber(ctrl)
-			mocks := lbWebSvcDescriberMocks{
-				storeSvc:     mockStore,
-				ecsDescriber: mockSvcStackDescriber,
-				envDescriber: mockEnvDescriber,
-			}
+			mocks := newLBWebSvcDescriberMocks(ctrl, testEnv, prodEnv)
 
 			tc.setupMocks(mocks)
 
@@ -447,9 +543,9 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 				app:                      testApp,
 				svc:                      testSvc,
 				enableResources:          tc.shouldOutputResources,
-				store:                    mockStore,
-				initECSServiceDescribers: func(s string) (ecsDescriber, error) { return mockSvcStackDescriber, nil },
-				initEnvDescribers:        func(s string) (envDescriber, error) { return mockEnvDescriber, nil },
+				store:                    mocks.storeSvc,
+				initECSServiceDescribers: func(s string) (ecsDescriber, error) { return mocks.ecsDescribers[s], nil },
+				initEnvDescribers:        func(s string) (envDescriber, error) { return mocks.envDescribers[s], nil },
 			}
 
 			// WHEN
@@ -521,8 +617,11 @@ Resources
 
   prod
     AWS::EC2::SecurityGroupIngress  ContainerSecurityGroupIngressFromPublicALB
+
+    my-app-prod-my-svc-AddonsStack-1A2B3C
+      AWS::DynamoDB::Table  my-app-prod-my-svc-orders
 `,
-			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Load Balanced Web Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"cpu\":\"256\",\"memory\":\"512\",\"platform\":\"LINUX/X86_64\",\"tasks\":\"1\"},{\"environment\":\"prod\",\"port\":\"5000\",\"cpu\":\"512\",\"memory\":\"1024\",\"platform\":\"LINUX/ARM64\",\"tasks\":\"3\"}],\"routes\":[{\"environment\":\"test\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/frontend\"},{\"environment\":\"prod\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/backend\"}],\"serviceDiscovery\":[{\"environment\":[\"test\"],\"namespace\":\"http://my-svc.test.my-app.local:5000\"},{\"environment\":[\"prod\"],\"namespace\":\"http://my-svc.prod.my-app.local:5000\"}],\"variables\":[{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\",\"container\":\"containerA\"},{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\",\"container\":\"containerB\"},{\"environment\":\"prod\",\"name\":\"DIFFERENT_ENV_VAR\",\"value\":\"prod\",\"container\":\"containerB\"}],\"secrets\":[{\"name\":\"GITHUB_WEBHOOK_SECRET\",\"container\":\"containerA\",\"environment\":\"test\",\"valueFrom\":\"GH_WEBHOOK_SECRET\"},{\"name\":\"SOME_OTHER_SECRET\",\"container\":\"containerB\",\"environment\":\"prod\",\"valueFrom\":\"SHHHHH\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
+			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Load Balanced Web Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"cpu\":\"256\",\"memory\":\"512\",\"platform\":\"LINUX/X86_64\",\"tasks\":\"1\"},{\"environment\":\"prod\",\"port\":\"5000\",\"cpu\":\"512\",\"memory\":\"1024\",\"platform\":\"LINUX/ARM64\",\"tasks\":\"3\"}],\"routes\":[{\"environment\":\"test\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/frontend\",\"accessType\":\"internet\",\"protocol\":\"http\",\"dnsNames\":[\"my-pr-Publi.us-west-2.elb.amazonaws.com\"],\"path\":\"/frontend\",\"port\":\"80\"},{\"environment\":\"prod\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/backend\",\"accessType\":\"internet\",\"protocol\":\"http\",\"dnsNames\":[\"my-pr-Publi.us-west-2.elb.amazonaws.com\"],\"path\":\"/backend\",\"port\":\"80\"}],\"serviceDiscovery\":[{\"environment\":[\"test\"],\"namespace\":\"http://my-svc.test.my-app.local:5000\"},{\"environment\":[\"prod\"],\"namespace\":\"http://my-svc.prod.my-app.local:5000\"}],\"variables\":[{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\",\"container\":\"containerA\"},{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\",\"container\":\"containerB\"},{\"environment\":\"prod\",\"name\":\"DIFFERENT_ENV_VAR\",\"value\":\"prod\",\"container\":\"containerB\"}],\"secrets\":[{\"name\":\"GITHUB_WEBHOOK_SECRET\",\"container\":\"containerA\",\"environment\":\"test\",\"valueFrom\":\"GH_WEBHOOK_SECRET\"},{\"name\":\"SOME_OTHER_SECRET\",\"container\":\"containerB\",\"environment\":\"prod\",\"valueFrom\":\"SHHHHH\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"},{\"type\":\"AWS::DynamoDB::Table\",\"physicalID\":\"my-app-prod-my-svc-orders\",\"stack\":\"my-app-prod-my-svc-AddonsStack-1A2B3C\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
 		},
 	}
 
@@ -594,10 +693,20 @@ Resources
 				{
 					Environment: "test",
 					URL:         "http://my-pr-Publi.us-west-2.elb.amazonaws.com/frontend",
+					AccessType:  URIAccessTypeInternet,
+					Protocol:    "http",
+					DNSNames:    []string{"my-pr-Publi.us-west-2.elb.amazonaws.com"},
+					Path:        "/frontend",
+					Port:        "80",
 				},
 				{
 					Environment: "prod",
 					URL:         "http://my-pr-Publi.us-west-2.elb.amazonaws.com/backend",
+					AccessType:  URIAccessTypeInternet,
+					Protocol:    "http",
+					DNSNames:    []string{"my-pr-Publi.us-west-2.elb.amazonaws.com"},
+					Path:        "/backend",
+					Port:        "80",
 				},
 			}
 			sds := []*ServiceDiscovery{
@@ -622,6 +731,11 @@ Resources
 						Type:       "AWS::EC2::SecurityGroupIngress",
 						PhysicalID: "ContainerSecurityGroupIngressFromPublicALB",
 					},
+					{
+						Type:       "AWS::DynamoDB::Table",
+						PhysicalID: "my-app-prod-my-svc-orders",
+						Stack:      "my-app-prod-my-svc-AddonsStack-1A2B3C",
+					},
 				},
 			}
 			webSvc := &webSvcDesc{
@@ -644,3 +758,66 @@ Resources
 		})
 	}
 }
+
+func TestWebSvcDesc_writeRoutes(t *testing.T) {
+	testCases := map[string]struct {
+		inRoutes []*WebServiceRoute
+
+		wanted string
+	}{
+		"without a CloudFront distribution": {
+			inRoutes: []*WebServiceRoute{
+				{
+					Environment: "test",
+					URL:         "http://my-pr-Publi.us-west-2.elb.amazonaws.com/frontend",
+				},
+			},
+			wanted: `  Environment  URL
+  -----------  ---
+  test         http://my-pr-Publi.us-west-2.elb.amazonaws.com/frontend
+`,
+		},
+		"with a CloudFront distribution in one environment": {
+			inRoutes: []*WebServiceRoute{
+				{
+					Environment: "test",
+					URL:         "http://my-pr-Publi.us-west-2.elb.amazonaws.com/frontend",
+				},
+				{
+					Environment: "prod",
+					URL:         "https://d1a2b3c4.cloudfront.net/frontend",
+					Primary:     true,
+				},
+				{
+					Environment: "prod",
+					URL:         "http://my-pr-Publi.us-east-1.elb.amazonaws.com/frontend",
+					Origin:      true,
+				},
+			},
+			wanted: `  Environment  URL                                                      Primary
+  -----------  ---                                                      -------
+  test         http://my-pr-Publi.us-west-2.elb.amazonaws.com/frontend  -
+  prod         https://d1a2b3c4.cloudfront.net/frontend                 yes
+  prod         http://my-pr-Publi.us-east-1.elb.amazonaws.com/frontend  no (CloudFront origin)
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var b bytes.Buffer
+			writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+			desc := &webSvcDesc{
+				Routes: tc.inRoutes,
+			}
+
+			// WHEN
+			desc.writeRoutes(writer)
+			writer.Flush()
+
+			// THEN
+			require.Equal(t, tc.wanted, b.String())
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./describe/app.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	ecr "github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	config "github.com/aws/copilot-cli/internal/pkg/config"
+	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
+	stack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockappResourcesGetter is a mock of appResourcesGetter interface.
+type MockappResourcesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockappResourcesGetterMockRecorder
+}
+
+// MockappResourcesGetterMockRecorder is the mock recorder for MockappResourcesGetter.
+type MockappResourcesGetterMockRecorder struct {
+	mock *MockappResourcesGetter
+}
+
+// NewMockappResourcesGetter creates a new mock instance.
+func NewMockappResourcesGetter(ctrl *gomock.Controller) *MockappResourcesGetter {
+	mock := &MockappResourcesGetter{ctrl: ctrl}
+	mock.recorder = &MockappResourcesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockappResourcesGetter) EXPECT() *MockappResourcesGetterMockRecorder {
+	return m.recorder
+}
+
+// GetRegionalAppResources mocks base method.
+func (m *MockappResourcesGetter) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
+	ret0, _ := ret[0].([]*stack.AppRegionalResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegionalAppResources indicates an expected call of GetRegionalAppResources.
+func (mr *MockappResourcesGetterMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*MockappResourcesGetter)(nil).GetRegionalAppResources), app)
+}
+
+// MockimageLister is a mock of imageLister interface.
+type MockimageLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageListerMockRecorder
+}
+
+// MockimageListerMockRecorder is the mock recorder for MockimageLister.
+type MockimageListerMockRecorder struct {
+	mock *MockimageLister
+}
+
+// NewMockimageLister creates a new mock instance.
+func NewMockimageLister(ctrl *gomock.Controller) *MockimageLister {
+	mock := &MockimageLister{ctrl: ctrl}
+	mock.recorder = &MockimageListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageLister) EXPECT() *MockimageListerMockRecorder {
+	return m.recorder
+}
+
+// ListImages mocks base method.
+func (m *MockimageLister) ListImages(repoName string) ([]ecr.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImages", repoName)
+	ret0, _ := ret[0].([]ecr.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImages indicates an expected call of ListImages.
+func (mr *MockimageListerMockRecorder) ListImages(repoName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockimageLister)(nil).ListImages), repoName)
+}
+
+// MockdeployedPipelineLister is a mock of deployedPipelineLister interface.
+type MockdeployedPipelineLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployedPipelineListerMockRecorder
+}
+
+// MockdeployedPipelineListerMockRecorder is the mock recorder for MockdeployedPipelineLister.
+type MockdeployedPipelineListerMockRecorder struct {
+	mock *MockdeployedPipelineLister
+}
+
+// NewMockdeployedPipelineLister creates a new mock instance.
+func NewMockdeployedPipelineLister(ctrl *gomock.Controller) *MockdeployedPipelineLister {
+	mock := &MockdeployedPipelineLister{ctrl: ctrl}
+	mock.recorder = &MockdeployedPipelineListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeployedPipelineLister) EXPECT() *MockdeployedPipelineListerMockRecorder {
+	return m.recorder
+}
+
+// ListDeployedPipelines mocks base method.
+func (m *MockdeployedPipelineLister) ListDeployedPipelines(appName string) ([]deploy.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeployedPipelines", appName)
+	ret0, _ := ret[0].([]deploy.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeployedPipelines indicates an expected call of ListDeployedPipelines.
+func (mr *MockdeployedPipelineListerMockRecorder) ListDeployedPipelines(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployedPipelines", reflect.TypeOf((*MockdeployedPipelineLister)(nil).ListDeployedPipelines), appName)
+}
@@ -48,3 +48,71 @@ func (mr *MockvpcSubnetListerMockRecorder) ListVPCSubnets(vpcID interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCSubnets", reflect.TypeOf((*MockvpcSubnetLister)(nil).ListVPCSubnets), vpcID)
 }
+
+// MockvpcNetworkDescriber is a mock of vpcNetworkDescriber interface.
+type MockvpcNetworkDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockvpcNetworkDescriberMockRecorder
+}
+
+// MockvpcNetworkDescriberMockRecorder is the mock recorder for MockvpcNetworkDescriber.
+type MockvpcNetworkDescriberMockRecorder struct {
+	mock *MockvpcNetworkDescriber
+}
+
+// NewMockvpcNetworkDescriber creates a new mock instance.
+func NewMockvpcNetworkDescriber(ctrl *gomock.Controller) *MockvpcNetworkDescriber {
+	mock := &MockvpcNetworkDescriber{ctrl: ctrl}
+	mock.recorder = &MockvpcNetworkDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockvpcNetworkDescriber) EXPECT() *MockvpcNetworkDescriberMockRecorder {
+	return m.recorder
+}
+
+// ListInternetGateways mocks base method.
+func (m *MockvpcNetworkDescriber) ListInternetGateways(vpcID string) ([]ec2.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInternetGateways", vpcID)
+	ret0, _ := ret[0].([]ec2.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInternetGateways indicates an expected call of ListInternetGateways.
+func (mr *MockvpcNetworkDescriberMockRecorder) ListInternetGateways(vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInternetGateways", reflect.TypeOf((*MockvpcNetworkDescriber)(nil).ListInternetGateways), vpcID)
+}
+
+// ListNATGateways mocks base method.
+func (m *MockvpcNetworkDescriber) ListNATGateways(vpcID string) ([]ec2.NATGateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNATGateways", vpcID)
+	ret0, _ := ret[0].([]ec2.NATGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNATGateways indicates an expected call of ListNATGateways.
+func (mr *MockvpcNetworkDescriberMockRecorder) ListNATGateways(vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNATGateways", reflect.TypeOf((*MockvpcNetworkDescriber)(nil).ListNATGateways), vpcID)
+}
+
+// ListVPCEndpoints mocks base method.
+func (m *MockvpcNetworkDescriber) ListVPCEndpoints(vpcID string) ([]ec2.VPCEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVPCEndpoints", vpcID)
+	ret0, _ := ret[0].([]ec2.VPCEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVPCEndpoints indicates an expected call of ListVPCEndpoints.
+func (mr *MockvpcNetworkDescriberMockRecorder) ListVPCEndpoints(vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCEndpoints", reflect.TypeOf((*MockvpcNetworkDescriber)(nil).ListVPCEndpoints), vpcID)
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: ./internal/pkg/describe/lb_web_service.go
+// Source: ./describe/lb_web_service.go
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -7,6 +7,7 @@ package mocks
 import (
 	reflect "reflect"
 
+	elbv2 "github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -115,3 +116,41 @@ func (mr *MocklbDescriberMockRecorder) ListenerRuleHostHeaders(ruleARN interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenerRuleHostHeaders", reflect.TypeOf((*MocklbDescriber)(nil).ListenerRuleHostHeaders), ruleARN)
 }
+
+// ListenerRules mocks base method.
+func (m *MocklbDescriber) ListenerRules(ruleARNs ...string) ([]*elbv2.ListenerRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range ruleARNs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListenerRules", varargs...)
+	ret0, _ := ret[0].([]*elbv2.ListenerRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListenerRules indicates an expected call of ListenerRules.
+func (mr *MocklbDescriberMockRecorder) ListenerRules(ruleARNs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenerRules", reflect.TypeOf((*MocklbDescriber)(nil).ListenerRules), ruleARNs...)
+}
+
+// Listeners mocks base method.
+func (m *MocklbDescriber) Listeners(listenerARNs ...string) ([]*elbv2.Listener, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range listenerARNs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Listeners", varargs...)
+	ret0, _ := ret[0].([]*elbv2.Listener)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Listeners indicates an expected call of Listeners.
+func (mr *MocklbDescriberMockRecorder) Listeners(listenerARNs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Listeners", reflect.TypeOf((*MocklbDescriber)(nil).Listeners), listenerARNs...)
+}
@@ -48,3 +48,18 @@ func (mr *MockpipelineGetterMockRecorder) GetPipeline(pipelineName interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipeline), pipelineName)
 }
+
+// ListExecutions mocks base method.
+func (m *MockpipelineGetter) ListExecutions(pipelineName string, maxResults int) ([]codepipeline.PipelineExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExecutions", pipelineName, maxResults)
+	ret0, _ := ret[0].([]codepipeline.PipelineExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExecutions indicates an expected call of ListExecutions.
+func (mr *MockpipelineGetterMockRecorder) ListExecutions(pipelineName, maxResults interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExecutions", reflect.TypeOf((*MockpipelineGetter)(nil).ListExecutions), pipelineName, maxResults)
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: ./internal/pkg/describe/service.go
+// Source: ./describe/service.go
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -241,6 +241,21 @@ func (m *MockapprunnerClient) EXPECT() *MockapprunnerClientMockRecorder {
 	return m.recorder
 }
 
+// DescribeCustomDomains mocks base method.
+func (m *MockapprunnerClient) DescribeCustomDomains(svcArn string) ([]apprunner.CustomDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCustomDomains", svcArn)
+	ret0, _ := ret[0].([]apprunner.CustomDomain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCustomDomains indicates an expected call of DescribeCustomDomains.
+func (mr *MockapprunnerClientMockRecorder) DescribeCustomDomains(svcArn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCustomDomains", reflect.TypeOf((*MockapprunnerClient)(nil).DescribeCustomDomains), svcArn)
+}
+
 // DescribeService mocks base method.
 func (m *MockapprunnerClient) DescribeService(svcArn string) (*apprunner.Service, error) {
 	m.ctrl.T.Helper()
@@ -279,6 +294,36 @@ func (m *MockworkloadStackDescriber) EXPECT() *MockworkloadStackDescriberMockRec
 	return m.recorder
 }
 
+// AddonsStackResources mocks base method.
+func (m *MockworkloadStackDescriber) AddonsStackResources() ([]*stack.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddonsStackResources")
+	ret0, _ := ret[0].([]*stack.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddonsStackResources indicates an expected call of AddonsStackResources.
+func (mr *MockworkloadStackDescriberMockRecorder) AddonsStackResources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonsStackResources", reflect.TypeOf((*MockworkloadStackDescriber)(nil).AddonsStackResources))
+}
+
+// LastDeployment mocks base method.
+func (m *MockworkloadStackDescriber) LastDeployment() (*stack.Deployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastDeployment")
+	ret0, _ := ret[0].(*stack.Deployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastDeployment indicates an expected call of LastDeployment.
+func (mr *MockworkloadStackDescriberMockRecorder) LastDeployment() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastDeployment", reflect.TypeOf((*MockworkloadStackDescriber)(nil).LastDeployment))
+}
+
 // Manifest mocks base method.
 func (m *MockworkloadStackDescriber) Manifest() ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -362,6 +407,21 @@ func (m *MockecsDescriber) EXPECT() *MockecsDescriberMockRecorder {
 	return m.recorder
 }
 
+// AddonsStackResources mocks base method.
+func (m *MockecsDescriber) AddonsStackResources() ([]*stack.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddonsStackResources")
+	ret0, _ := ret[0].([]*stack.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddonsStackResources indicates an expected call of AddonsStackResources.
+func (mr *MockecsDescriberMockRecorder) AddonsStackResources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonsStackResources", reflect.TypeOf((*MockecsDescriber)(nil).AddonsStackResources))
+}
+
 // EnvVars mocks base method.
 func (m *MockecsDescriber) EnvVars() ([]*ecs.ContainerEnvVar, error) {
 	m.ctrl.T.Helper()
@@ -377,6 +437,21 @@ func (mr *MockecsDescriberMockRecorder) EnvVars() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvVars", reflect.TypeOf((*MockecsDescriber)(nil).EnvVars))
 }
 
+// LastDeployment mocks base method.
+func (m *MockecsDescriber) LastDeployment() (*stack.Deployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastDeployment")
+	ret0, _ := ret[0].(*stack.Deployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastDeployment indicates an expected call of LastDeployment.
+func (mr *MockecsDescriberMockRecorder) LastDeployment() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastDeployment", reflect.TypeOf((*MockecsDescriber)(nil).LastDeployment))
+}
+
 // Manifest mocks base method.
 func (m *MockecsDescriber) Manifest() ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -490,6 +565,51 @@ func (m *MockapprunnerDescriber) EXPECT() *MockapprunnerDescriberMockRecorder {
 	return m.recorder
 }
 
+// AddonsStackResources mocks base method.
+func (m *MockapprunnerDescriber) AddonsStackResources() ([]*stack.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddonsStackResources")
+	ret0, _ := ret[0].([]*stack.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddonsStackResources indicates an expected call of AddonsStackResources.
+func (mr *MockapprunnerDescriberMockRecorder) AddonsStackResources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonsStackResources", reflect.TypeOf((*MockapprunnerDescriber)(nil).AddonsStackResources))
+}
+
+// CustomDomains mocks base method.
+func (m *MockapprunnerDescriber) CustomDomains() ([]apprunner.CustomDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomDomains")
+	ret0, _ := ret[0].([]apprunner.CustomDomain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CustomDomains indicates an expected call of CustomDomains.
+func (mr *MockapprunnerDescriberMockRecorder) CustomDomains() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomDomains", reflect.TypeOf((*MockapprunnerDescriber)(nil).CustomDomains))
+}
+
+// LastDeployment mocks base method.
+func (m *MockapprunnerDescriber) LastDeployment() (*stack.Deployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastDeployment")
+	ret0, _ := ret[0].(*stack.Deployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastDeployment indicates an expected call of LastDeployment.
+func (mr *MockapprunnerDescriberMockRecorder) LastDeployment() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastDeployment", reflect.TypeOf((*MockapprunnerDescriber)(nil).LastDeployment))
+}
+
 // Manifest mocks base method.
 func (m *MockapprunnerDescriber) Manifest() ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -7,6 +7,7 @@ package mocks
 import (
 	reflect "reflect"
 
+	aas "github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	cloudwatch "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	cloudwatchlogs "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
@@ -272,3 +273,56 @@ func (mr *MockautoscalingAlarmNamesGetterMockRecorder) ECSServiceAlarmNames(clus
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceAlarmNames", reflect.TypeOf((*MockautoscalingAlarmNamesGetter)(nil).ECSServiceAlarmNames), cluster, service)
 }
+
+// MockautoscalingActivityGetter is a mock of autoscalingActivityGetter interface.
+type MockautoscalingActivityGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockautoscalingActivityGetterMockRecorder
+}
+
+// MockautoscalingActivityGetterMockRecorder is the mock recorder for MockautoscalingActivityGetter.
+type MockautoscalingActivityGetterMockRecorder struct {
+	mock *MockautoscalingActivityGetter
+}
+
+// NewMockautoscalingActivityGetter creates a new mock instance.
+func NewMockautoscalingActivityGetter(ctrl *gomock.Controller) *MockautoscalingActivityGetter {
+	mock := &MockautoscalingActivityGetter{ctrl: ctrl}
+	mock.recorder = &MockautoscalingActivityGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockautoscalingActivityGetter) EXPECT() *MockautoscalingActivityGetterMockRecorder {
+	return m.recorder
+}
+
+// ECSServiceScalableTarget mocks base method.
+func (m *MockautoscalingActivityGetter) ECSServiceScalableTarget(cluster, service string) (*aas.ScalableTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECSServiceScalableTarget", cluster, service)
+	ret0, _ := ret[0].(*aas.ScalableTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECSServiceScalableTarget indicates an expected call of ECSServiceScalableTarget.
+func (mr *MockautoscalingActivityGetterMockRecorder) ECSServiceScalableTarget(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceScalableTarget", reflect.TypeOf((*MockautoscalingActivityGetter)(nil).ECSServiceScalableTarget), cluster, service)
+}
+
+// ECSServiceScalingActivities mocks base method.
+func (m *MockautoscalingActivityGetter) ECSServiceScalingActivities(cluster, service string, limit int) ([]aas.ScalingActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECSServiceScalingActivities", cluster, service, limit)
+	ret0, _ := ret[0].([]aas.ScalingActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECSServiceScalingActivities indicates an expected call of ECSServiceScalingActivities.
+func (mr *MockautoscalingActivityGetterMockRecorder) ECSServiceScalingActivities(cluster, service, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceScalingActivities", reflect.TypeOf((*MockautoscalingActivityGetter)(nil).ECSServiceScalingActivities), cluster, service, limit)
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/storage.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockaddonsOutputsDescriber is a mock of addonsOutputsDescriber interface.
+type MockaddonsOutputsDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockaddonsOutputsDescriberMockRecorder
+}
+
+// MockaddonsOutputsDescriberMockRecorder is the mock recorder for MockaddonsOutputsDescriber.
+type MockaddonsOutputsDescriberMockRecorder struct {
+	mock *MockaddonsOutputsDescriber
+}
+
+// NewMockaddonsOutputsDescriber creates a new mock instance.
+func NewMockaddonsOutputsDescriber(ctrl *gomock.Controller) *MockaddonsOutputsDescriber {
+	mock := &MockaddonsOutputsDescriber{ctrl: ctrl}
+	mock.recorder = &MockaddonsOutputsDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockaddonsOutputsDescriber) EXPECT() *MockaddonsOutputsDescriberMockRecorder {
+	return m.recorder
+}
+
+// AddonsOutputs mocks base method.
+func (m *MockaddonsOutputsDescriber) AddonsOutputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddonsOutputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddonsOutputs indicates an expected call of AddonsOutputs.
+func (mr *MockaddonsOutputsDescriberMockRecorder) AddonsOutputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonsOutputs", reflect.TypeOf((*MockaddonsOutputsDescriber)(nil).AddonsOutputs))
+}
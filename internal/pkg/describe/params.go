@@ -0,0 +1,99 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// StackParams contains the parameters of a deployed CloudFormation stack.
+type StackParams struct {
+	Deployed map[string]string
+	// Local holds the parameters generated from the local workspace.
+	// It is nil if the stack could not be regenerated locally.
+	Local map[string]string
+}
+
+type stackParam struct {
+	Name       string  `json:"name"`
+	Value      string  `json:"value"`
+	LocalValue *string `json:"localValue,omitempty"`
+	Changed    bool    `json:"changed"`
+}
+
+// params returns the parameters sorted by name, including the parameters that only exist locally.
+func (p *StackParams) params() []stackParam {
+	names := make(map[string]struct{})
+	for name := range p.Deployed {
+		names[name] = struct{}{}
+	}
+	for name := range p.Local {
+		names[name] = struct{}{}
+	}
+	var params []stackParam
+	for name := range names {
+		deployed, isDeployed := p.Deployed[name]
+		param := stackParam{
+			Name:  name,
+			Value: deployed,
+		}
+		if p.Local != nil {
+			local, isLocal := p.Local[name]
+			param.LocalValue = aws.String(local)
+			param.Changed = isDeployed != isLocal || deployed != local
+		}
+		params = append(params, param)
+	}
+	sort.Slice(params, func(i, j int) bool { return params[i].Name < params[j].Name })
+	return params
+}
+
+// JSONString returns the stringified StackParams struct with json format.
+func (p *StackParams) JSONString() (string, error) {
+	b, err := json.Marshal(struct {
+		Parameters []stackParam `json:"parameters"`
+	}{
+		Parameters: p.params(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal stack parameters: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified StackParams struct with human readable format.
+// Parameters whose value would change when the stack is regenerated locally are highlighted.
+func (p *StackParams) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Parameters\n\n"))
+	writer.Flush()
+	headers := []string{"Name", "Value"}
+	if p.Local != nil {
+		headers = append(headers, "Local Value")
+	}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, param := range p.params() {
+		if param.LocalValue == nil {
+			fmt.Fprintf(writer, "  %s\t%s\n", param.Name, param.Value)
+			continue
+		}
+		if !param.Changed {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", param.Name, param.Value, "-")
+			continue
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", param.Name, param.Value, color.Yellow.Sprint(aws.StringValue(param.LocalValue)))
+	}
+	writer.Flush()
+	return b.String()
+}
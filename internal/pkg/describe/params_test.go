@@ -0,0 +1,97 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStackParams_JSONString(t *testing.T) {
+	testCases := map[string]struct {
+		in         StackParams
+		wantedJSON string
+	}{
+		"only deployed parameters": {
+			in: StackParams{
+				Deployed: map[string]string{
+					"TaskCount": "1",
+					"AppName":   "phonetool",
+				},
+			},
+			wantedJSON: "{\"parameters\":[{\"name\":\"AppName\",\"value\":\"phonetool\",\"changed\":false},{\"name\":\"TaskCount\",\"value\":\"1\",\"changed\":false}]}\n",
+		},
+		"marks parameters that would change locally": {
+			in: StackParams{
+				Deployed: map[string]string{
+					"AppName":   "phonetool",
+					"TaskCount": "1",
+					"Removed":   "old",
+				},
+				Local: map[string]string{
+					"AppName":   "phonetool",
+					"TaskCount": "3",
+					"Added":     "",
+				},
+			},
+			wantedJSON: "{\"parameters\":[{\"name\":\"Added\",\"value\":\"\",\"localValue\":\"\",\"changed\":true},{\"name\":\"AppName\",\"value\":\"phonetool\",\"localValue\":\"phonetool\",\"changed\":false},{\"name\":\"Removed\",\"value\":\"old\",\"localValue\":\"\",\"changed\":true},{\"name\":\"TaskCount\",\"value\":\"1\",\"localValue\":\"3\",\"changed\":true}]}\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := tc.in.JSONString()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedJSON, actual)
+		})
+	}
+}
+
+func TestStackParams_HumanString(t *testing.T) {
+	testCases := map[string]struct {
+		in           StackParams
+		wantedString string
+	}{
+		"only deployed parameters": {
+			in: StackParams{
+				Deployed: map[string]string{
+					"TaskCount": "1",
+					"AppName":   "phonetool",
+				},
+			},
+			wantedString: `Parameters
+
+  Name       Value
+  ----       -----
+  AppName    phonetool
+  TaskCount  1
+`,
+		},
+		"with local parameters": {
+			in: StackParams{
+				Deployed: map[string]string{
+					"AppName":   "phonetool",
+					"TaskCount": "1",
+				},
+				Local: map[string]string{
+					"AppName":   "phonetool",
+					"TaskCount": "3",
+				},
+			},
+			wantedString: `Parameters
+
+  Name       Value      Local Value
+  ----       -----      -----------
+  AppName    phonetool  -
+  TaskCount  1          3
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedString, tc.in.HumanString())
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -20,8 +21,12 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 )
 
+// maxPipelineExecutions is the number of recent executions shown for a pipeline.
+const maxPipelineExecutions = 5
+
 type pipelineGetter interface {
 	GetPipeline(pipelineName string) (*codepipeline.Pipeline, error)
+	ListExecutions(pipelineName string, maxResults int) ([]codepipeline.PipelineExecution, error)
 }
 
 // Pipeline contains serialized parameters for a pipeline.
@@ -30,7 +35,8 @@ type Pipeline struct {
 	Name string `json:"name"`
 	codepipeline.Pipeline
 
-	Resources []*describestack.Resource `json:"resources,omitempty"`
+	Executions []codepipeline.PipelineExecution `json:"executions,omitempty"` // Most recent executions first.
+	Resources  []*describestack.Resource        `json:"resources,omitempty"`
 }
 
 // PipelineDescriber retrieves information about a deployed pipeline.
@@ -66,6 +72,10 @@ func (d *PipelineDescriber) Describe() (HumanJSONStringer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get pipeline: %w", err)
 	}
+	executions, err := d.pipelineSvc.ListExecutions(d.pipeline.ResourceName, maxPipelineExecutions)
+	if err != nil {
+		return nil, fmt.Errorf("list pipeline executions: %w", err)
+	}
 	var resources []*describestack.Resource
 	if d.showResources {
 		stackResources, err := d.cfn.Resources()
@@ -75,9 +85,10 @@ func (d *PipelineDescriber) Describe() (HumanJSONStringer, error) {
 		resources = stackResources
 	}
 	pipeline := &Pipeline{
-		Name:      d.pipeline.Name,
-		Pipeline:  *cp,
-		Resources: resources,
+		Name:       d.pipeline.Name,
+		Pipeline:   *cp,
+		Executions: executions,
+		Resources:  resources,
 	}
 	return pipeline, nil
 }
@@ -106,13 +117,25 @@ func (p *Pipeline) HumanString() string {
 	writer.Flush()
 	fmt.Fprint(writer, color.Bold.Sprint("\nStages\n\n"))
 	writer.Flush()
-	headers := []string{"Name", "Category", "Provider", "Details"}
+	headers := []string{"Name", "Category", "Provider", "Environment", "Artifacts", "Details"}
 	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
 	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
 	for _, stage := range p.Pipeline.Stages {
 		fmt.Fprintf(writer, "  %s", stage.HumanString())
 	}
 	writer.Flush()
+	if len(p.Executions) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nRecent Executions\n\n"))
+		writer.Flush()
+		headers := []string{"Started", "Status", "Trigger", "Duration", "Commit"}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, execution := range p.Executions {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", humanizeTime(execution.StartedAt), execution.Status,
+				valueOrDash(execution.Trigger), execution.Duration().Round(time.Second), commitString(execution))
+		}
+		writer.Flush()
+	}
 	if len(p.Resources) != 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n"))
 		writer.Flush()
@@ -124,3 +147,27 @@ func (p *Pipeline) HumanString() string {
 	writer.Flush()
 	return b.String()
 }
+
+// commitString returns the short revision ID and the first line of the revision summary of an execution.
+func commitString(execution codepipeline.PipelineExecution) string {
+	const shortSHALength = 7
+	commit := execution.Commit
+	if commit == "" {
+		return "-"
+	}
+	if len(commit) > shortSHALength {
+		commit = commit[:shortSHALength]
+	}
+	msg, _, _ := strings.Cut(execution.CommitMsg, "\n")
+	if msg == "" {
+		return commit
+	}
+	return fmt.Sprintf("%s %s", commit, msg)
+}
+
+func valueOrDash(v string) string {
+	if v == "" {
+		return "-"
+	}
+	return v
+}
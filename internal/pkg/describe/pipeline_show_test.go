@@ -35,27 +35,49 @@ var mockPipeline = &codepipeline.Pipeline{
 	AccountID: "1234567890",
 	Stages: []*codepipeline.Stage{
 		{
-			Name:     "Source",
-			Category: "Source",
-			Provider: "GitHub",
-			Details:  "Repository: badgoose/repo",
+			Name:            "Source",
+			Category:        "Source",
+			Provider:        "GitHub",
+			Details:         "Repository: badgoose/repo",
+			OutputArtifacts: []string{"SCCheckoutArtifact"},
 		},
 		{
-			Name:     "Build",
-			Category: "Build",
-			Provider: "CodeBuild",
-			Details:  "BuildProject: pipeline-dinder-badgoose-repo-BuildProject",
+			Name:            "Build",
+			Category:        "Build",
+			Provider:        "CodeBuild",
+			Details:         "BuildProject: pipeline-dinder-badgoose-repo-BuildProject",
+			InputArtifacts:  []string{"SCCheckoutArtifact"},
+			OutputArtifacts: []string{"BuildOutput"},
 		},
 		{
-			Name:     "DeployTo-test",
-			Category: "Deploy",
-			Provider: "CloudFormation",
-			Details:  "StackName: dinder-test-test",
+			Name:           "DeployTo-test",
+			Category:       "Deploy",
+			Provider:       "CloudFormation",
+			Details:        "StackName: dinder-test-test",
+			Environment:    "test",
+			InputArtifacts: []string{"BuildOutput"},
 		},
 	},
 	CreatedAt: mockTime(),
 	UpdatedAt: mockTime(),
 }
+var mockExecutions = []codepipeline.PipelineExecution{
+	{
+		ID:        "2",
+		Status:    "Failed",
+		Trigger:   "Webhook",
+		StartedAt: mockTime(),
+		UpdatedAt: mockTime().Add(3*time.Minute + 20*time.Second),
+		Commit:    "a1b2c3d4e5f6",
+		CommitMsg: "Fix the login page\n\nThe button was hidden.",
+	},
+	{
+		ID:        "1",
+		Status:    "Succeeded",
+		StartedAt: mockTime(),
+		UpdatedAt: mockTime().Add(15 * time.Minute),
+	},
+}
 var expectedResources = []*stack.Resource{
 	{
 		PhysicalID: "pipeline-dinder-badgoose-repo-BuildProject",
@@ -122,19 +144,22 @@ func TestPipelineDescriber_Describe(t *testing.T) {
 		"happy path with resources": {
 			callMocks: func(m pipelineDescriberMocks) {
 				m.pipelineGetter.EXPECT().GetPipeline(pipelineResourceName).Return(mockPipeline, nil)
+				m.pipelineGetter.EXPECT().ListExecutions(pipelineResourceName, 5).Return(mockExecutions, nil)
 				m.cfn.EXPECT().Resources().Return(mockResources, nil)
 			},
 			inShowResource: true,
 			expectedError:  nil,
 			expectedOutput: &Pipeline{
-				Name:      pipelineName,
-				Pipeline:  *mockPipeline,
-				Resources: expectedResources,
+				Name:       pipelineName,
+				Pipeline:   *mockPipeline,
+				Executions: mockExecutions,
+				Resources:  expectedResources,
 			},
 		},
 		"happy path without resources": {
 			callMocks: func(m pipelineDescriberMocks) {
 				m.pipelineGetter.EXPECT().GetPipeline(pipelineResourceName).Return(mockPipeline, nil)
+				m.pipelineGetter.EXPECT().ListExecutions(pipelineResourceName, 5).Return(nil, nil)
 			},
 			inShowResource: false,
 			expectedError:  nil,
@@ -152,9 +177,17 @@ func TestPipelineDescriber_Describe(t *testing.T) {
 			expectedError:  fmt.Errorf("get pipeline: %w", mockError),
 			expectedOutput: nil,
 		},
+		"wraps list executions error": {
+			callMocks: func(m pipelineDescriberMocks) {
+				m.pipelineGetter.EXPECT().GetPipeline(pipelineResourceName).Return(mockPipeline, nil)
+				m.pipelineGetter.EXPECT().ListExecutions(pipelineResourceName, 5).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("list pipeline executions: %w", mockError),
+		},
 		"wraps stack resources error": {
 			callMocks: func(m pipelineDescriberMocks) {
 				m.pipelineGetter.EXPECT().GetPipeline(pipelineResourceName).Return(mockPipeline, nil)
+				m.pipelineGetter.EXPECT().ListExecutions(pipelineResourceName, 5).Return(nil, nil)
 				m.cfn.EXPECT().Resources().Return(nil, mockError)
 			},
 			inShowResource: true,
@@ -218,11 +251,12 @@ func TestPipelineDescriber_String(t *testing.T) {
 		expectedHumanString string
 		expectedJSONString  string
 	}{
-		"correct output with resources": {
+		"correct output with executions and resources": {
 			inPipeline: &Pipeline{
-				Name:      pipelineName,
-				Pipeline:  *mockPipeline,
-				Resources: expectedResources,
+				Name:       pipelineName,
+				Pipeline:   *mockPipeline,
+				Executions: mockExecutions,
+				Resources:  expectedResources,
 			},
 			expectedHumanString: `About
 
@@ -234,11 +268,18 @@ func TestPipelineDescriber_String(t *testing.T) {
 
 Stages
 
-  Name           Category  Provider        Details
-  ----           --------  --------        -------
-  Source         Source    GitHub          Repository: badgoose/repo
-  Build          Build     CodeBuild       BuildProject: pipeline-dinder-badgoose-repo-BuildProject
-  DeployTo-test  Deploy    CloudFormation  StackName: dinder-test-test
+  Name           Category  Provider        Environment  Artifacts                          Details
+  ----           --------  --------        -----------  ---------                          -------
+  Source         Source    GitHub          -            -> SCCheckoutArtifact              Repository: badgoose/repo
+  Build          Build     CodeBuild       -            SCCheckoutArtifact -> BuildOutput  BuildProject: pipeline-dinder-badgoose-repo-BuildProject
+  DeployTo-test  Deploy    CloudFormation  test         BuildOutput                        StackName: dinder-test-test
+
+Recent Executions
+
+  Started       Status     Trigger   Duration  Commit
+  -------       ------     -------   --------  ------
+  4 months ago  Failed     Webhook   3m20s     a1b2c3d Fix the login page
+  4 months ago  Succeeded  -         15m0s     -
 
 Resources
     AWS::CodeBuild::Project      pipeline-dinder-badgoose-repo-BuildProject
@@ -248,7 +289,7 @@ Resources
     AWS::IAM::Role               pipeline-dinder-badgoose-repo-PipelineRole-100SEEQN6CU0F
     AWS::IAM::Policy             pipel-Pipe-EO4QGE10RJ8F
 `,
-			expectedJSONString: "{\"name\":\"pipeline-dinder-badgoose-repo\",\"pipelineName\":\"pipeline-dinder-badgoose-repo-RANDOMSTRING\",\"region\":\"us-west-2\",\"accountId\":\"1234567890\",\"stages\":[{\"name\":\"Source\",\"category\":\"Source\",\"provider\":\"GitHub\",\"details\":\"Repository: badgoose/repo\"},{\"name\":\"Build\",\"category\":\"Build\",\"provider\":\"CodeBuild\",\"details\":\"BuildProject: pipeline-dinder-badgoose-repo-BuildProject\"},{\"name\":\"DeployTo-test\",\"category\":\"Deploy\",\"provider\":\"CloudFormation\",\"details\":\"StackName: dinder-test-test\"}],\"createdAt\":\"2020-02-02T15:04:05Z\",\"updatedAt\":\"2020-02-02T15:04:05Z\",\"resources\":[{\"type\":\"AWS::CodeBuild::Project\",\"physicalID\":\"pipeline-dinder-badgoose-repo-BuildProject\"},{\"type\":\"AWS::IAM::Policy\",\"physicalID\":\"pipel-Buil-1PEASDDL44ID2\"},{\"type\":\"AWS::IAM::Role\",\"physicalID\":\"pipeline-dinder-badgoose-repo-BuildProjectRole-A4V6VSG1XIIJ\"},{\"type\":\"AWS::CodePipeline::Pipeline\",\"physicalID\":\"pipeline-dinder-badgoose-repo\"},{\"type\":\"AWS::IAM::Role\",\"physicalID\":\"pipeline-dinder-badgoose-repo-PipelineRole-100SEEQN6CU0F\"},{\"type\":\"AWS::IAM::Policy\",\"physicalID\":\"pipel-Pipe-EO4QGE10RJ8F\"}]}\n",
+			expectedJSONString: "{\"name\":\"pipeline-dinder-badgoose-repo\",\"pipelineName\":\"pipeline-dinder-badgoose-repo-RANDOMSTRING\",\"region\":\"us-west-2\",\"accountId\":\"1234567890\",\"stages\":[{\"name\":\"Source\",\"category\":\"Source\",\"provider\":\"GitHub\",\"details\":\"Repository: badgoose/repo\",\"outputArtifacts\":[\"SCCheckoutArtifact\"]},{\"name\":\"Build\",\"category\":\"Build\",\"provider\":\"CodeBuild\",\"details\":\"BuildProject: pipeline-dinder-badgoose-repo-BuildProject\",\"inputArtifacts\":[\"SCCheckoutArtifact\"],\"outputArtifacts\":[\"BuildOutput\"]},{\"name\":\"DeployTo-test\",\"category\":\"Deploy\",\"provider\":\"CloudFormation\",\"details\":\"StackName: dinder-test-test\",\"environment\":\"test\",\"inputArtifacts\":[\"BuildOutput\"]}],\"createdAt\":\"2020-02-02T15:04:05Z\",\"updatedAt\":\"2020-02-02T15:04:05Z\",\"executions\":[{\"id\":\"2\",\"status\":\"Failed\",\"trigger\":\"Webhook\",\"startedAt\":\"2020-02-02T15:04:05Z\",\"updatedAt\":\"2020-02-02T15:07:25Z\",\"commit\":\"a1b2c3d4e5f6\",\"commitMessage\":\"Fix the login page\\n\\nThe button was hidden.\"},{\"id\":\"1\",\"status\":\"Succeeded\",\"startedAt\":\"2020-02-02T15:04:05Z\",\"updatedAt\":\"2020-02-02T15:19:05Z\"}],\"resources\":[{\"type\":\"AWS::CodeBuild::Project\",\"physicalID\":\"pipeline-dinder-badgoose-repo-BuildProject\"},{\"type\":\"AWS::IAM::Policy\",\"physicalID\":\"pipel-Buil-1PEASDDL44ID2\"},{\"type\":\"AWS::IAM::Role\",\"physicalID\":\"pipeline-dinder-badgoose-repo-BuildProjectRole-A4V6VSG1XIIJ\"},{\"type\":\"AWS::CodePipeline::Pipeline\",\"physicalID\":\"pipeline-dinder-badgoose-repo\"},{\"type\":\"AWS::IAM::Role\",\"physicalID\":\"pipeline-dinder-badgoose-repo-PipelineRole-100SEEQN6CU0F\"},{\"type\":\"AWS::IAM::Policy\",\"physicalID\":\"pipel-Pipe-EO4QGE10RJ8F\"}]}\n",
 		},
 		"correct output without resources": {
 			inPipeline: &Pipeline{
@@ -266,13 +307,13 @@ Resources
 
 Stages
 
-  Name           Category  Provider        Details
-  ----           --------  --------        -------
-  Source         Source    GitHub          Repository: badgoose/repo
-  Build          Build     CodeBuild       BuildProject: pipeline-dinder-badgoose-repo-BuildProject
-  DeployTo-test  Deploy    CloudFormation  StackName: dinder-test-test
+  Name           Category  Provider        Environment  Artifacts                          Details
+  ----           --------  --------        -----------  ---------                          -------
+  Source         Source    GitHub          -            -> SCCheckoutArtifact              Repository: badgoose/repo
+  Build          Build     CodeBuild       -            SCCheckoutArtifact -> BuildOutput  BuildProject: pipeline-dinder-badgoose-repo-BuildProject
+  DeployTo-test  Deploy    CloudFormation  test         BuildOutput                        StackName: dinder-test-test
 `,
-			expectedJSONString: "{\"name\":\"pipeline-dinder-badgoose-repo\",\"pipelineName\":\"pipeline-dinder-badgoose-repo-RANDOMSTRING\",\"region\":\"us-west-2\",\"accountId\":\"1234567890\",\"stages\":[{\"name\":\"Source\",\"category\":\"Source\",\"provider\":\"GitHub\",\"details\":\"Repository: badgoose/repo\"},{\"name\":\"Build\",\"category\":\"Build\",\"provider\":\"CodeBuild\",\"details\":\"BuildProject: pipeline-dinder-badgoose-repo-BuildProject\"},{\"name\":\"DeployTo-test\",\"category\":\"Deploy\",\"provider\":\"CloudFormation\",\"details\":\"StackName: dinder-test-test\"}],\"createdAt\":\"2020-02-02T15:04:05Z\",\"updatedAt\":\"2020-02-02T15:04:05Z\"}\n",
+			expectedJSONString: "{\"name\":\"pipeline-dinder-badgoose-repo\",\"pipelineName\":\"pipeline-dinder-badgoose-repo-RANDOMSTRING\",\"region\":\"us-west-2\",\"accountId\":\"1234567890\",\"stages\":[{\"name\":\"Source\",\"category\":\"Source\",\"provider\":\"GitHub\",\"details\":\"Repository: badgoose/repo\",\"outputArtifacts\":[\"SCCheckoutArtifact\"]},{\"name\":\"Build\",\"category\":\"Build\",\"provider\":\"CodeBuild\",\"details\":\"BuildProject: pipeline-dinder-badgoose-repo-BuildProject\",\"inputArtifacts\":[\"SCCheckoutArtifact\"],\"outputArtifacts\":[\"BuildOutput\"]},{\"name\":\"DeployTo-test\",\"category\":\"Deploy\",\"provider\":\"CloudFormation\",\"details\":\"StackName: dinder-test-test\",\"environment\":\"test\",\"inputArtifacts\":[\"BuildOutput\"]}],\"createdAt\":\"2020-02-02T15:04:05Z\",\"updatedAt\":\"2020-02-02T15:04:05Z\"}\n",
 		},
 	}
 	for _, tc := range testCases {
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"sync"
 	"text/tabwriter"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -28,10 +29,12 @@ type RDWebServiceDescriber struct {
 	store                  DeployedEnvServicesLister
 	initAppRunnerDescriber func(string) (apprunnerDescriber, error)
 	envSvcDescribers       map[string]apprunnerDescriber
+	mu                     sync.Mutex // Guards the cached describers, since environments are described concurrently.
 }
 
 // NewRDWebServiceDescriber instantiates a request-driven service describer.
 func NewRDWebServiceDescriber(opt NewServiceConfig) (*RDWebServiceDescriber, error) {
+	stackCache := stack.NewCache() // Shared by the describers of every environment.
 	describer := &RDWebServiceDescriber{
 		app:             opt.App,
 		svc:             opt.Svc,
@@ -41,6 +44,8 @@ func NewRDWebServiceDescriber(opt NewServiceConfig) (*RDWebServiceDescriber, err
 		envSvcDescribers: make(map[string]apprunnerDescriber),
 	}
 	describer.initAppRunnerDescriber = func(env string) (apprunnerDescriber, error) {
+		describer.mu.Lock()
+		defer describer.mu.Unlock()
 		if describer, ok := describer.envSvcDescribers[env]; ok {
 			return describer, nil
 		}
@@ -48,6 +53,7 @@ func NewRDWebServiceDescriber(opt NewServiceConfig) (*RDWebServiceDescriber, err
 			App:         opt.App,
 			Svc:         opt.Svc,
 			ConfigStore: opt.ConfigStore,
+			stackCache:  stackCache,
 		}, env)
 		if err != nil {
 			return nil, err
@@ -74,25 +80,40 @@ func (d *RDWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 		return nil, fmt.Errorf("list deployed environments for application %s: %w", d.app, err)
 	}
 
+	services := make([]*apprunner.Service, len(environments))
+	customDomains := make([][]apprunner.CustomDomain, len(environments))
+	stackResources := make([][]*stack.Resource, len(environments))
+	envDeployments := make([]*stack.Deployment, len(environments))
+	err = describeEnvs(environments, func(i int, env string) error {
+		describer, err := d.initAppRunnerDescriber(env)
+		if err != nil {
+			return err
+		}
+		if services[i], err = describer.Service(); err != nil {
+			return fmt.Errorf("retrieve service configuration: %w", err)
+		}
+		customDomains[i] = bestEffortCustomDomains(describer)
+		envDeployments[i] = bestEffortLastDeployment(describer)
+		if d.enableResources {
+			if stackResources[i], err = workloadStackResources(describer); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var observabilities []observabilityInEnv
 	var routes []*WebServiceRoute
 	var configs []*ServiceConfig
 	var envVars envVars
+	var deployments []*stack.Deployment
 	resources := make(map[string][]*stack.Resource)
-	for _, env := range environments {
-		describer, err := d.initAppRunnerDescriber(env)
-		if err != nil {
-			return nil, err
-		}
-		service, err := describer.Service()
-		if err != nil {
-			return nil, fmt.Errorf("retrieve service configuration: %w", err)
-		}
-		webServiceURI := formatAppRunnerUrl(service.ServiceURL)
-		routes = append(routes, &WebServiceRoute{
-			Environment: env,
-			URL:         webServiceURI,
-		})
+	for i, service := range services {
+		env := environments[i]
+		routes = append(routes, webServiceRoutes(env, appRunnerURI(formatAppRunnerUrl(service.ServiceURL), customDomains[i]))...)
 		configs = append(configs, &ServiceConfig{
 			Environment: env,
 			Port:        service.Port,
@@ -110,12 +131,11 @@ func (d *RDWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 			Environment: env,
 			Tracing:     formatTracingConfiguration(service.Observability.TraceConfiguration),
 		})
+		if envDeployments[i] != nil {
+			deployments = append(deployments, envDeployments[i])
+		}
 		if d.enableResources {
-			stackResources, err := describer.ServiceStackResources()
-			if err != nil {
-				return nil, fmt.Errorf("retrieve service resources: %w", err)
-			}
-			resources[env] = stackResources
+			resources[env] = stackResources[i]
 		}
 	}
 
@@ -131,6 +151,7 @@ func (d *RDWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 		Variables:               envVars,
 		Resources:               resources,
 		Observability:           observabilities,
+		Deployments:             deployments,
 
 		environments: environments,
 	}, nil
@@ -146,6 +167,15 @@ func (d *RDWebServiceDescriber) Manifest(env string) ([]byte, error) {
 	return cfn.Manifest()
 }
 
+// Params returns the parameters of the request-driven web service stack deployed in the environment.
+func (d *RDWebServiceDescriber) Params(env string) (map[string]string, error) {
+	cfn, err := d.initAppRunnerDescriber(env)
+	if err != nil {
+		return nil, err
+	}
+	return cfn.Params()
+}
+
 func formatTracingConfiguration(configuration *apprunner.TraceConfiguration) *tracing {
 	if configuration == nil {
 		return nil
@@ -206,6 +236,7 @@ type rdWebSvcDesc struct {
 	Variables               envVars                 `json:"variables"`
 	Resources               deployedSvcResources    `json:"resources,omitempty"`
 	Observability           observabilityPerEnv     `json:"observability,omitempty"`
+	Deployments             lastDeployments         `json:"deployments,omitempty"`
 
 	environments []string `json:"-"`
 }
@@ -231,6 +262,11 @@ func (w *rdWebSvcDesc) HumanString() string {
 	fmt.Fprint(writer, color.Bold.Sprint("\nConfigurations\n\n"))
 	writer.Flush()
 	w.AppRunnerConfigurations.humanString(writer)
+	if len(w.Deployments) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nDeployment\n\n"))
+		writer.Flush()
+		w.Deployments.humanString(writer)
+	}
 	if w.Observability.hasObservabilityConfiguration() {
 		fmt.Fprint(writer, color.Bold.Sprint("\nObservability\n\n"))
 		writer.Flush()
@@ -238,12 +274,7 @@ func (w *rdWebSvcDesc) HumanString() string {
 	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nRoutes\n\n"))
 	writer.Flush()
-	headers := []string{"Environment", "URL"}
-	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
-	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
-	for _, route := range w.Routes {
-		fmt.Fprintf(writer, "  %s\t%s\n", route.Environment, route.URL)
-	}
+	w.writeRoutes(writer)
 
 	fmt.Fprint(writer, color.Bold.Sprint("\nVariables\n\n"))
 	writer.Flush()
@@ -258,3 +289,35 @@ func (w *rdWebSvcDesc) HumanString() string {
 	writer.Flush()
 	return b.String()
 }
+
+func (w *rdWebSvcDesc) writeRoutes(writer io.Writer) {
+	if !w.hasCustomDomains() {
+		headers := []string{"Environment", "URL"}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, route := range w.Routes {
+			fmt.Fprintf(writer, "  %s\t%s\n", route.Environment, route.URL)
+		}
+		return
+	}
+	headers := []string{"Environment", "URL", "Primary", "Status"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, route := range w.Routes {
+		primary := "-"
+		if route.Primary {
+			primary = "yes"
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", route.Environment, route.URL, primary, valueOrDash(route.Status))
+	}
+}
+
+// hasCustomDomains returns true if the service is associated with a custom domain in any environment.
+func (w *rdWebSvcDesc) hasCustomDomains() bool {
+	for _, route := range w.Routes {
+		if route.Status != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -55,6 +55,9 @@ Resources
 type apprunnerSvcDescriberMocks struct {
 	storeSvc        *mocks.MockDeployedEnvServicesLister
 	ecsSvcDescriber *mocks.MockapprunnerDescriber
+
+	// Environments are described concurrently, so each environment gets its own describer.
+	ecsSvcDescribers map[string]*mocks.MockapprunnerDescriber
 }
 
 func TestRDWebServiceDescriber_Describe(t *testing.T) {
@@ -96,6 +99,8 @@ func TestRDWebServiceDescriber_Describe(t *testing.T) {
 				gomock.InOrder(
 					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
 					m.ecsSvcDescriber.EXPECT().Service().Return(&apprunner.Service{}, nil),
+					m.ecsSvcDescriber.EXPECT().CustomDomains().Return(nil, nil),
+					m.ecsSvcDescriber.EXPECT().LastDeployment().Return(&stack.Deployment{Environment: testEnv}, nil),
 					m.ecsSvcDescriber.EXPECT().ServiceStackResources().Return(nil, mockErr),
 				)
 			},
@@ -104,9 +109,9 @@ func TestRDWebServiceDescriber_Describe(t *testing.T) {
 		"success": {
 			shouldOutputResources: true,
 			setupMocks: func(m apprunnerSvcDescriberMocks) {
+				m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv, prodEnv}, nil)
 				gomock.InOrder(
-					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv, prodEnv}, nil),
-					m.ecsSvcDescriber.EXPECT().Service().Return(&apprunner.Service{
+					m.ecsSvcDescribers[testEnv].EXPECT().Service().Return(&apprunner.Service{
 						ServiceARN: "arn:aws:apprunner:us-east-1:111111111111:service/testapp-test-testsvc",
 						ServiceURL: "6znxd4ra33.public.us-east-1.apprunner.amazonaws.com",
 						CPU:        "1024",
@@ -119,13 +124,18 @@ func TestRDWebServiceDescriber_Describe(t *testing.T) {
 							},
 						},
 					}, nil),
-					m.ecsSvcDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
+					m.ecsSvcDescribers[testEnv].EXPECT().CustomDomains().Return(nil, nil),
+					m.ecsSvcDescribers[testEnv].EXPECT().LastDeployment().Return(&stack.Deployment{Environment: testEnv}, nil),
+					m.ecsSvcDescribers[testEnv].EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
 							Type:       "AWS::AppRunner::Service",
 							PhysicalID: "arn:aws:apprunner:us-east-1:111111111111:service/testapp-test-testsvc",
 						},
 					}, nil),
-					m.ecsSvcDescriber.EXPECT().Service().Return(&apprunner.Service{
+					m.ecsSvcDescribers[testEnv].EXPECT().AddonsStackResources().Return(nil, nil),
+				)
+				gomock.InOrder(
+					m.ecsSvcDescribers[prodEnv].EXPECT().Service().Return(&apprunner.Service{
 						ServiceARN: "arn:aws:apprunner:us-east-1:111111111111:service/testapp-prod-testsvc",
 						ServiceURL: "tumkjmvjjf.public.us-east-1.apprunner.amazonaws.com",
 						CPU:        "2048",
@@ -138,12 +148,20 @@ func TestRDWebServiceDescriber_Describe(t *testing.T) {
 							},
 						},
 					}, nil),
-					m.ecsSvcDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
+					m.ecsSvcDescribers[prodEnv].EXPECT().CustomDomains().Return([]apprunner.CustomDomain{
+						{
+							DomainName: "example.com",
+							Status:     "active",
+						},
+					}, nil),
+					m.ecsSvcDescribers[prodEnv].EXPECT().LastDeployment().Return(&stack.Deployment{Environment: prodEnv}, nil),
+					m.ecsSvcDescribers[prodEnv].EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
 							Type:       "AWS::AppRunner::Service",
 							PhysicalID: "arn:aws:apprunner:us-east-1:111111111111:service/testapp-prod-testsvc",
 						},
 					}, nil),
+					m.ecsSvcDescribers[prodEnv].EXPECT().AddonsStackResources().Return(nil, nil),
 				)
 			},
 			wantedSvcDesc: &rdWebSvcDesc{
@@ -168,10 +186,32 @@ func TestRDWebServiceDescriber_Describe(t *testing.T) {
 					{
 						Environment: "test",
 						URL:         "https://6znxd4ra33.public.us-east-1.apprunner.amazonaws.com",
+						AccessType:  URIAccessTypeInternet,
+						Protocol:    "https",
+						DNSNames:    []string{"6znxd4ra33.public.us-east-1.apprunner.amazonaws.com"},
+						Path:        "/",
+						Port:        "443",
+						Primary:     true,
+					},
+					{
+						Environment: "prod",
+						URL:         "https://example.com",
+						AccessType:  URIAccessTypeInternet,
+						Protocol:    "https",
+						DNSNames:    []string{"example.com"},
+						Path:        "/",
+						Port:        "443",
+						Primary:     true,
+						Status:      "active",
 					},
 					{
 						Environment: "prod",
 						URL:         "https://tumkjmvjjf.public.us-east-1.apprunner.amazonaws.com",
+						AccessType:  URIAccessTypeInternet,
+						Protocol:    "https",
+						DNSNames:    []string{"tumkjmvjjf.public.us-east-1.apprunner.amazonaws.com"},
+						Path:        "/",
+						Port:        "443",
 					},
 				},
 				Variables: []*envVar{
@@ -200,15 +240,159 @@ func TestRDWebServiceDescriber_Describe(t *testing.T) {
 						},
 					},
 				},
+				Deployments: []*stack.Deployment{
+					{Environment: "test"},
+					{Environment: "prod"},
+				},
+				environments: []string{"test", "prod"},
+			},
+		},
+		"omit the last deployment of an environment if it can't be retrieved": {
+			shouldOutputResources: true,
+			setupMocks: func(m apprunnerSvcDescriberMocks) {
+				m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv, prodEnv}, nil)
+				gomock.InOrder(
+					m.ecsSvcDescribers[testEnv].EXPECT().Service().Return(&apprunner.Service{
+						ServiceARN: "arn:aws:apprunner:us-east-1:111111111111:service/testapp-test-testsvc",
+						ServiceURL: "6znxd4ra33.public.us-east-1.apprunner.amazonaws.com",
+						CPU:        "1024",
+						Memory:     "2048",
+						Port:       "80",
+						EnvironmentVariables: []*apprunner.EnvironmentVariable{
+							{
+								Name:  "COPILOT_ENVIRONMENT_NAME",
+								Value: "test",
+							},
+						},
+					}, nil),
+					m.ecsSvcDescribers[testEnv].EXPECT().CustomDomains().Return(nil, nil),
+					m.ecsSvcDescribers[testEnv].EXPECT().LastDeployment().Return(&stack.Deployment{Environment: testEnv}, nil),
+					m.ecsSvcDescribers[testEnv].EXPECT().ServiceStackResources().Return([]*stack.Resource{
+						{
+							Type:       "AWS::AppRunner::Service",
+							PhysicalID: "arn:aws:apprunner:us-east-1:111111111111:service/testapp-test-testsvc",
+						},
+					}, nil),
+					m.ecsSvcDescribers[testEnv].EXPECT().AddonsStackResources().Return(nil, nil),
+				)
+				gomock.InOrder(
+					m.ecsSvcDescribers[prodEnv].EXPECT().Service().Return(&apprunner.Service{
+						ServiceARN: "arn:aws:apprunner:us-east-1:111111111111:service/testapp-prod-testsvc",
+						ServiceURL: "tumkjmvjjf.public.us-east-1.apprunner.amazonaws.com",
+						CPU:        "2048",
+						Memory:     "3072",
+						Port:       "80",
+						EnvironmentVariables: []*apprunner.EnvironmentVariable{
+							{
+								Name:  "COPILOT_ENVIRONMENT_NAME",
+								Value: "prod",
+							},
+						},
+					}, nil),
+					m.ecsSvcDescribers[prodEnv].EXPECT().CustomDomains().Return([]apprunner.CustomDomain{
+						{
+							DomainName: "example.com",
+							Status:     "active",
+						},
+					}, nil),
+					m.ecsSvcDescribers[prodEnv].EXPECT().LastDeployment().Return(nil, mockErr),
+					m.ecsSvcDescribers[prodEnv].EXPECT().ServiceStackResources().Return([]*stack.Resource{
+						{
+							Type:       "AWS::AppRunner::Service",
+							PhysicalID: "arn:aws:apprunner:us-east-1:111111111111:service/testapp-prod-testsvc",
+						},
+					}, nil),
+					m.ecsSvcDescribers[prodEnv].EXPECT().AddonsStackResources().Return(nil, nil),
+				)
+			},
+			wantedSvcDesc: &rdWebSvcDesc{
+				Service: testSvc,
+				Type:    "Request-Driven Web Service",
+				App:     testApp,
+				AppRunnerConfigurations: []*ServiceConfig{
+					{
+						CPU:         "1024",
+						Environment: "test",
+						Memory:      "2048",
+						Port:        "80",
+					},
+					{
+						CPU:         "2048",
+						Environment: "prod",
+						Memory:      "3072",
+						Port:        "80",
+					},
+				},
+				Routes: []*WebServiceRoute{
+					{
+						Environment: "test",
+						URL:         "https://6znxd4ra33.public.us-east-1.apprunner.amazonaws.com",
+						AccessType:  URIAccessTypeInternet,
+						Protocol:    "https",
+						DNSNames:    []string{"6znxd4ra33.public.us-east-1.apprunner.amazonaws.com"},
+						Path:        "/",
+						Port:        "443",
+						Primary:     true,
+					},
+					{
+						Environment: "prod",
+						URL:         "https://example.com",
+						AccessType:  URIAccessTypeInternet,
+						Protocol:    "https",
+						DNSNames:    []string{"example.com"},
+						Path:        "/",
+						Port:        "443",
+						Primary:     true,
+						Status:      "active",
+					},
+					{
+						Environment: "prod",
+						URL:         "https://tumkjmvjjf.public.us-east-1.apprunner.amazonaws.com",
+						AccessType:  URIAccessTypeInternet,
+						Protocol:    "https",
+						DNSNames:    []string{"tumkjmvjjf.public.us-east-1.apprunner.amazonaws.com"},
+						Path:        "/",
+						Port:        "443",
+					},
+				},
+				Variables: []*envVar{
+					{
+						Environment: "test",
+						Name:        "COPILOT_ENVIRONMENT_NAME",
+						Value:       "test",
+					},
+					{
+						Environment: "prod",
+						Name:        "COPILOT_ENVIRONMENT_NAME",
+						Value:       "prod",
+					},
+				},
+				Resources: map[string][]*stack.Resource{
+					"test": {
+						{
+							Type:       "AWS::AppRunner::Service",
+							PhysicalID: "arn:aws:apprunner:us-east-1:111111111111:service/testapp-test-testsvc",
+						},
+					},
+					"prod": {
+						{
+							Type:       "AWS::AppRunner::Service",
+							PhysicalID: "arn:aws:apprunner:us-east-1:111111111111:service/testapp-prod-testsvc",
+						},
+					},
+				},
+				Deployments: []*stack.Deployment{
+					{Environment: "test"},
+				},
 				environments: []string{"test", "prod"},
 			},
 		},
 		"success with observability": {
 			shouldOutputResources: true,
 			setupMocks: func(m apprunnerSvcDescriberMocks) {
+				m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv, prodEnv}, nil)
 				gomock.InOrder(
-					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv, prodEnv}, nil),
-					m.ecsSvcDescriber.EXPECT().Service().Return(&apprunner.Service{
+					m.ecsSvcDescribers[testEnv].EXPECT().Service().Return(&apprunner.Service{
 						ServiceARN: "arn:aws:apprunner:us-east-1:111111111111:service/testapp-test-testsvc",
 						ServiceURL: "6znxd4ra33.public.us-east-1.apprunner.amazonaws.com",
 						CPU:        "1024",
@@ -226,13 +410,18 @@ func TestRDWebServiceDescriber_Describe(t *testing.T) {
 							},
 						},
 					}, nil),
-					m.ecsSvcDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
+					m.ecsSvcDescribers[testEnv].EXPECT().CustomDomains().Return(nil, nil),
+					m.ecsSvcDescribers[testEnv].EXPECT().LastDeployment().Return(&stack.Deployment{Environment: testEnv}, nil),
+					m.ecsSvcDescribers[testEnv].EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
 							Type:       "AWS::AppRunner::Service",
 							PhysicalID: "arn:aws:apprunner:us-east-1:111111111111:service/testapp-test-testsvc",
 						},
 					}, nil),
-					m.ecsSvcDescriber.EXPECT().Service().Return(&apprunner.Service{
+					m.ecsSvcDescribers[testEnv].EXPECT().AddonsStackResources().Return(nil, nil),
+				)
+				gomock.InOrder(
+					m.ecsSvcDescribers[prodEnv].EXPECT().Service().Return(&apprunner.Service{
 						ServiceARN: "arn:aws:apprunner:us-east-1:111111111111:service/testapp-prod-testsvc",
 						ServiceURL: "tumkjmvjjf.public.us-east-1.apprunner.amazonaws.com",
 						CPU:        "2048",
@@ -245,12 +434,15 @@ func TestRDWebServiceDescriber_Describe(t *testing.T) {
 							},
 						},
 					}, nil),
-					m.ecsSvcDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
+					m.ecsSvcDescribers[prodEnv].EXPECT().CustomDomains().Return(nil, nil),
+					m.ecsSvcDescribers[prodEnv].EXPECT().LastDeployment().Return(&stack.Deployment{Environment: prodEnv}, nil),
+					m.ecsSvcDescribers[prodEnv].EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
 							Type:       "AWS::AppRunner::Service",
 							PhysicalID: "arn:aws:apprunner:us-east-1:111111111111:service/testapp-prod-testsvc",
 						},
 					}, nil),
+					m.ecsSvcDescribers[prodEnv].EXPECT().AddonsStackResources().Return(nil, nil),
 				)
 			},
 			wantedSvcDesc: &rdWebSvcDesc{
@@ -275,10 +467,22 @@ func TestRDWebServiceDescriber_Describe(t *testing.T) {
 					{
 						Environment: "test",
 						URL:         "https://6znxd4ra33.public.us-east-1.apprunner.amazonaws.com",
+						AccessType:  URIAccessTypeInternet,
+						Protocol:    "https",
+						DNSNames:    []string{"6znxd4ra33.public.us-east-1.apprunner.amazonaws.com"},
+						Path:        "/",
+						Port:        "443",
+						Primary:     true,
 					},
 					{
 						Environment: "prod",
 						URL:         "https://tumkjmvjjf.public.us-east-1.apprunner.amazonaws.com",
+						AccessType:  URIAccessTypeInternet,
+						Protocol:    "https",
+						DNSNames:    []string{"tumkjmvjjf.public.us-east-1.apprunner.amazonaws.com"},
+						Path:        "/",
+						Port:        "443",
+						Primary:     true,
 					},
 				},
 				Variables: []*envVar{
@@ -318,6 +522,10 @@ func TestRDWebServiceDescriber_Describe(t *testing.T) {
 						},
 					},
 				},
+				Deployments: []*stack.Deployment{
+					{Environment: "test"},
+					{Environment: "prod"},
+				},
 				environments: []string{"test", "prod"},
 			},
 		},
@@ -329,10 +537,14 @@ func TestRDWebServiceDescriber_Describe(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockStore := mocks.NewMockDeployedEnvServicesLister(ctrl)
-			mockSvcDescriber := mocks.NewMockapprunnerDescriber(ctrl)
+			mockSvcDescribers := map[string]*mocks.MockapprunnerDescriber{
+				testEnv: mocks.NewMockapprunnerDescriber(ctrl),
+				prodEnv: mocks.NewMockapprunnerDescriber(ctrl),
+			}
 			mocks := apprunnerSvcDescriberMocks{
-				storeSvc:        mockStore,
-				ecsSvcDescriber: mockSvcDescriber,
+				storeSvc:         mockStore,
+				ecsSvcDescriber:  mockSvcDescribers[testEnv],
+				ecsSvcDescribers: mockSvcDescribers,
 			}
 
 			tc.setupMocks(mocks)
@@ -342,7 +554,7 @@ func TestRDWebServiceDescriber_Describe(t *testing.T) {
 				svc:                    testSvc,
 				enableResources:        tc.shouldOutputResources,
 				store:                  mockStore,
-				initAppRunnerDescriber: func(string) (apprunnerDescriber, error) { return mockSvcDescriber, nil },
+				initAppRunnerDescriber: func(env string) (apprunnerDescriber, error) { return mockSvcDescribers[env], nil },
 			}
 
 			// WHEN
@@ -540,6 +752,78 @@ Resources
 		human := svcDesc.HumanString()
 		json, _ := svcDesc.JSONString()
 
+		require.Equal(t, wantedHumanString, human)
+		require.Equal(t, wantedJSONString, json)
+	})
+	t.Run("correct output including custom domains", func(t *testing.T) {
+		wantedHumanString := `About
+
+  Application  testapp
+  Name         testsvc
+  Type         Request-Driven Web Service
+
+Configurations
+
+  Environment  CPU (vCPU)  Memory (MiB)  Port
+  -----------  ----------  ------------  ----
+  prod         1           2048          80
+
+Routes
+
+  Environment  URL                                                          Primary   Status
+  -----------  ---                                                          -------   ------
+  prod         https://example.com                                          yes       active
+  prod         https://api.example.com                                      -         pending_certificate_dns_validation
+  prod         https://tumkjmvjjf.public.us-east-1.apprunner.amazonaws.com  -         -
+
+Variables
+
+  Name                      Environment  Value
+  ----                      -----------  -----
+  COPILOT_ENVIRONMENT_NAME  prod         prod
+`
+		wantedJSONString := "{\"service\":\"testsvc\",\"type\":\"Request-Driven Web Service\",\"application\":\"testapp\",\"configurations\":[{\"environment\":\"prod\",\"port\":\"80\",\"cpu\":\"1024\",\"memory\":\"2048\"}],\"routes\":[{\"environment\":\"prod\",\"url\":\"https://example.com\",\"primary\":true,\"status\":\"active\"},{\"environment\":\"prod\",\"url\":\"https://api.example.com\",\"status\":\"pending_certificate_dns_validation\"},{\"environment\":\"prod\",\"url\":\"https://tumkjmvjjf.public.us-east-1.apprunner.amazonaws.com\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\"}]}\n"
+		svcDesc := &rdWebSvcDesc{
+			Service: "testsvc",
+			Type:    "Request-Driven Web Service",
+			App:     "testapp",
+			AppRunnerConfigurations: []*ServiceConfig{
+				{
+					CPU:         "1024",
+					Environment: "prod",
+					Memory:      "2048",
+					Port:        "80",
+				},
+			},
+			Routes: []*WebServiceRoute{
+				{
+					Environment: "prod",
+					URL:         "https://example.com",
+					Primary:     true,
+					Status:      "active",
+				},
+				{
+					Environment: "prod",
+					URL:         "https://api.example.com",
+					Status:      "pending_certificate_dns_validation",
+				},
+				{
+					Environment: "prod",
+					URL:         "https://tumkjmvjjf.public.us-east-1.apprunner.amazonaws.com",
+				},
+			},
+			Variables: []*envVar{
+				{
+					Environment: "prod",
+					Name:        "COPILOT_ENVIRONMENT_NAME",
+					Value:       "prod",
+				},
+			},
+			environments: []string{"prod"},
+		}
+		human := svcDesc.HumanString()
+		json, _ := svcDesc.JSONString()
+
 		require.Equal(t, wantedHumanString, human)
 		require.Equal(t, wantedJSONString, json)
 	})
@@ -30,6 +30,11 @@ const (
 
 const apprunnerServiceType = "AWS::AppRunner::Service"
 
+const (
+	nestedStackType      = "AWS::CloudFormation::Stack"
+	addonsStackLogicalID = "AddonsStack"
+)
+
 // ConfigStoreSvc wraps methods of config store.
 type ConfigStoreSvc interface {
 	GetEnvironment(appName string, environmentName string) (*config.Environment, error)
@@ -52,13 +57,16 @@ type ecsClient interface {
 
 type apprunnerClient interface {
 	DescribeService(svcArn string) (*apprunner.Service, error)
+	DescribeCustomDomains(svcArn string) ([]apprunner.CustomDomain, error)
 }
 
 type workloadStackDescriber interface {
 	Params() (map[string]string, error)
 	Outputs() (map[string]string, error)
 	ServiceStackResources() ([]*stack.Resource, error)
+	AddonsStackResources() ([]*stack.Resource, error)
 	Manifest() ([]byte, error)
+	LastDeployment() (*stack.Deployment, error)
 }
 
 type ecsDescriber interface {
@@ -75,6 +83,7 @@ type apprunnerDescriber interface {
 	Service() (*apprunner.Service, error)
 	ServiceARN() (string, error)
 	ServiceURL() (string, error)
+	CustomDomains() ([]apprunner.CustomDomain, error)
 }
 
 // serviceStackDescriber provides base functionality for retrieving info about a service.
@@ -83,13 +92,15 @@ type serviceStackDescriber struct {
 	service string
 	env     string
 
-	cfn  stackDescriber
-	sess *session.Session
+	cfn               stackDescriber
+	sess              *session.Session
+	newStackDescriber func(stackName string) stackDescriber
 
 	// Cache variables.
-	params         map[string]string
-	outputs        map[string]string
-	stackResources []*stack.Resource
+	params          map[string]string
+	outputs         map[string]string
+	stackResources  []*stack.Resource
+	addonsResources []*stack.Resource
 }
 
 // newServiceStackDescriber instantiates the core elements of a new service.
@@ -102,13 +113,20 @@ func newServiceStackDescriber(opt NewServiceConfig, env string) (*serviceStackDe
 	if err != nil {
 		return nil, err
 	}
+	cache := opt.stackCache
+	if cache == nil {
+		cache = stack.NewCache()
+	}
 	return &serviceStackDescriber{
 		app:     opt.App,
 		service: opt.Svc,
 		env:     env,
 
-		cfn:  stack.NewStackDescriber(cfnstack.NameForService(opt.App, env, opt.Svc), sess),
+		cfn:  stack.NewCachedStackDescriber(cfnstack.NameForService(opt.App, env, opt.Svc), sess, cache),
 		sess: sess,
+		newStackDescriber: func(stackName string) stackDescriber {
+			return stack.NewCachedStackDescriber(stackName, sess, cache)
+		},
 	}, nil
 }
 
@@ -162,6 +180,102 @@ func (d *serviceStackDescriber) ServiceStackResources() ([]*stack.Resource, erro
 	return resources, nil
 }
 
+// AddonsStackResources returns the resources created by the addons stack of the service and by the stacks nested in it.
+// Each resource is labeled with the name of the stack holding it. Returns nil if the service doesn't have addons.
+func (d *serviceStackDescriber) AddonsStackResources() ([]*stack.Resource, error) {
+	if d.addonsResources != nil {
+		return d.addonsResources, nil
+	}
+	svcResources, err := d.ServiceStackResources()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range svcResources {
+		if r.Type != nestedStackType || r.LogicalID != addonsStackLogicalID {
+			continue
+		}
+		resources, err := d.nestedStackResources(r.PhysicalID)
+		if err != nil {
+			return nil, err
+		}
+		d.addonsResources = resources
+		return resources, nil
+	}
+	return nil, nil
+}
+
+// AddonsOutputs returns the outputs of the addons stack of the service. Returns nil if the service doesn't have addons.
+func (d *serviceStackDescriber) AddonsOutputs() (map[string]string, error) {
+	svcResources, err := d.ServiceStackResources()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range svcResources {
+		if r.Type != nestedStackType || r.LogicalID != addonsStackLogicalID {
+			continue
+		}
+		descr, err := d.newStackDescriber(r.PhysicalID).Describe()
+		if err != nil {
+			return nil, err
+		}
+		return descr.Outputs, nil
+	}
+	return nil, nil
+}
+
+// nestedStackResources returns the resources of the nested stack identified by its ARN, and of any stack nested in it.
+func (d *serviceStackDescriber) nestedStackResources(stackID string) ([]*stack.Resource, error) {
+	resources, err := d.newStackDescriber(stackID).Resources()
+	if err != nil {
+		return nil, err
+	}
+	name := nestedStackName(stackID)
+	var out []*stack.Resource
+	var nested []string
+	for _, r := range resources {
+		labeled := *r
+		labeled.Stack = name
+		out = append(out, &labeled)
+		if r.Type == nestedStackType && r.PhysicalID != "" {
+			nested = append(nested, r.PhysicalID)
+		}
+	}
+	for _, id := range nested {
+		nestedResources, err := d.nestedStackResources(id)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, nestedResources...)
+	}
+	return out, nil
+}
+
+// nestedStackName returns the name of a stack from its ARN.
+// Example input: arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-api-AddonsStack-1DFQ4XPVV3BS1/f6e6c2f0-6f6a-11ec-a5f8-0a8a9b3c2c71
+// Output: phonetool-test-api-AddonsStack-1DFQ4XPVV3BS1
+func nestedStackName(stackID string) string {
+	parts := strings.Split(stackID, "/")
+	if len(parts) < 3 {
+		return stackID
+	}
+	return parts[len(parts)-2]
+}
+
+// workloadStackResources returns the resources of the service stack followed by the resources of its addons stacks.
+func workloadStackResources(d workloadStackDescriber) ([]*stack.Resource, error) {
+	svcResources, err := d.ServiceStackResources()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve service resources: %w", err)
+	}
+	addonsResources, err := d.AddonsStackResources()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve addons resources: %w", err)
+	}
+	resources := make([]*stack.Resource, 0, len(svcResources)+len(addonsResources))
+	resources = append(resources, svcResources...)
+	return append(resources, addonsResources...), nil
+}
+
 // Manifest returns the contents of the manifest used to deploy a workload stack.
 // If the Manifest metadata doesn't exist in the stack template, then returns ErrManifestNotFoundInTemplate.
 func (d *serviceStackDescriber) Manifest() ([]byte, error) {
@@ -186,6 +300,87 @@ func (d *serviceStackDescriber) Manifest() ([]byte, error) {
 	return []byte(metadata.Manifest), nil
 }
 
+// LastDeployment returns the details of the last deployment of the workload stack.
+// The deployer, commit and template version are recorded by "copilot svc deploy", "copilot job deploy" and "copilot deploy",
+// so they are empty if the stack was deployed otherwise, for example from a packaged template by a pipeline.
+func (d *serviceStackDescriber) LastDeployment() (*stack.Deployment, error) {
+	descr, err := d.cfn.Describe()
+	if err != nil {
+		return nil, err
+	}
+	tpl, err := d.cfn.StackMetadata()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve stack metadata for %s-%s-%s: %w", d.app, d.env, d.service, err)
+	}
+	metadata := struct {
+		Deployment struct {
+			Deployer string `yaml:"Deployer"`
+			Commit   string `yaml:"Commit"`
+			Version  string `yaml:"Version"`
+		} `yaml:"Deployment"`
+	}{}
+	if err := yaml.Unmarshal([]byte(tpl), &metadata); err != nil {
+		return nil, fmt.Errorf("unmarshal Metadata.Deployment in stack %s-%s-%s: %v", d.app, d.env, d.service, err)
+	}
+	return &stack.Deployment{
+		Environment:     d.env,
+		DeployedAt:      descr.LastUpdatedTime,
+		DeployedBy:      metadata.Deployment.Deployer,
+		Commit:          metadata.Deployment.Commit,
+		ImageDigest:     imageDigest(descr.Parameters[cfnstack.WorkloadContainerImageParamKey]),
+		TemplateVersion: metadata.Deployment.Version,
+	}, nil
+}
+
+// imageDigest returns the digest of an image location such as "1234.dkr.ecr.us-west-2.amazonaws.com/api@sha256:abc",
+// or the empty string if the image is referred to by tag.
+func imageDigest(location string) string {
+	_, digest, found := strings.Cut(location, "@")
+	if !found {
+		return ""
+	}
+	return digest
+}
+
+// bestEffortLastDeployment returns the last deployment of a workload stack, or nil if it can't be retrieved,
+// so that the rest of the workload can still be described.
+func bestEffortLastDeployment(describer workloadStackDescriber) *stack.Deployment {
+	deployment, err := describer.LastDeployment()
+	if err != nil {
+		return nil
+	}
+	return deployment
+}
+
+type lastDeployments []*stack.Deployment
+
+func (d lastDeployments) humanString(w io.Writer) {
+	headers := []string{"Environment", "Deployed", "By", "Commit", "Image Digest", "Template Version"}
+	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, deployment := range d {
+		fmt.Fprintf(w, "  %s\n", strings.Join([]string{
+			deployment.Environment,
+			humanizeTime(deployment.DeployedAt),
+			valueOrDash(deployment.DeployedBy),
+			valueOrDash(deployment.Commit),
+			valueOrDash(shortImageDigest(deployment.ImageDigest)),
+			valueOrDash(deployment.TemplateVersion),
+		}, "\t"))
+	}
+}
+
+// shortImageDigest truncates the hash of an image digest to 12 characters like the Docker CLI does.
+// For example, "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807" is shortened to "sha256:f1d4ae3f7261".
+func shortImageDigest(digest string) string {
+	const shortHashLen = 12
+	algorithm, hash, found := strings.Cut(digest, ":")
+	if !found || len(hash) <= shortHashLen {
+		return digest
+	}
+	return fmt.Sprintf("%s:%s", algorithm, hash[:shortHashLen])
+}
+
 type ecsServiceDescriber struct {
 	*serviceStackDescriber
 	ecsClient ecsClient
@@ -204,6 +399,8 @@ type NewServiceConfig struct {
 
 	EnableResources bool
 	DeployStore     DeployedEnvServicesLister
+
+	stackCache *stack.Cache // Memoizes the CloudFormation calls of the describers sharing it. A new one is used if nil.
 }
 
 func newECSServiceDescriber(opt NewServiceConfig, env string) (*ecsServiceDescriber, error) {
@@ -304,6 +501,15 @@ func (d *appRunnerServiceDescriber) ServiceURL() (string, error) {
 	return formatAppRunnerUrl(service.ServiceURL), nil
 }
 
+// CustomDomains retrieves the custom domains associated with the app runner service.
+func (d *appRunnerServiceDescriber) CustomDomains() ([]apprunner.CustomDomain, error) {
+	serviceARN, err := d.ServiceARN()
+	if err != nil {
+		return nil, err
+	}
+	return d.apprunnerClient.DescribeCustomDomains(serviceARN)
+}
+
 func formatAppRunnerUrl(serviceURL string) string {
 	svcUrl := &url.URL{
 		Host: serviceURL,
@@ -0,0 +1,279 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	maxServiceEvents     = 10
+	maxStoppedTaskEvents = 10
+	maxEventMessageWidth = 80
+)
+
+// ECSServiceEventsDescriber retrieves the recent events of an ECS service in the environments that it's deployed to.
+type ECSServiceEventsDescriber struct {
+	app string
+	svc string
+
+	store          DeployedEnvServicesLister
+	initDescribers func(env string) (serviceDescriber, ecsServiceGetter, error)
+}
+
+// NewECSServiceEventsDescriber instantiates a describer for the recent events of an ECS service.
+func NewECSServiceEventsDescriber(opt NewServiceConfig) *ECSServiceEventsDescriber {
+	return &ECSServiceEventsDescriber{
+		app:   opt.App,
+		svc:   opt.Svc,
+		store: opt.DeployStore,
+		initDescribers: func(envName string) (serviceDescriber, ecsServiceGetter, error) {
+			env, err := opt.ConfigStore.GetEnvironment(opt.App, envName)
+			if err != nil {
+				return nil, nil, fmt.Errorf("get environment %s: %w", envName, err)
+			}
+			sess, err := sessions.ImmutableProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return ecs.New(sess), awsecs.New(sess), nil
+		},
+	}
+}
+
+// Describe returns the recent service events, stopped tasks and image pull failures of the service in each environment.
+func (d *ECSServiceEventsDescriber) Describe() (HumanJSONStringer, error) {
+	environments, err := d.store.ListEnvironmentsDeployedTo(d.app, d.svc)
+	if err != nil {
+		return nil, fmt.Errorf("list deployed environments for service %s: %w", d.svc, err)
+	}
+	envEvents := make([]*ServiceEnvEvents, len(environments))
+	err = describeEnvs(environments, func(i int, env string) error {
+		events, err := d.describeEnv(env)
+		if err != nil {
+			return err
+		}
+		envEvents[i] = events
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ServiceEvents{
+		Service:      d.svc,
+		Environments: envEvents,
+	}, nil
+}
+
+func (d *ECSServiceEventsDescriber) describeEnv(env string) (*ServiceEnvEvents, error) {
+	svcDescriber, ecsSvcGetter, err := d.initDescribers(env)
+	if err != nil {
+		return nil, err
+	}
+	svcDesc, err := svcDescriber.DescribeService(d.app, env, d.svc)
+	if err != nil {
+		return nil, fmt.Errorf("get ECS service description for %s in environment %s: %w", d.svc, env, err)
+	}
+	service, err := ecsSvcGetter.Service(svcDesc.ClusterName, svcDesc.Name)
+	if err != nil {
+		return nil, fmt.Errorf("get service %s: %w", svcDesc.Name, err)
+	}
+	var stoppedTasks []awsecs.TaskStatus
+	for _, task := range svcDesc.StoppedTasks {
+		status, err := task.TaskStatus()
+		if err != nil {
+			return nil, fmt.Errorf("get status for stopped task %s: %w", aws.StringValue(task.TaskArn), err)
+		}
+		stoppedTasks = append(stoppedTasks, *status)
+	}
+	sort.SliceStable(stoppedTasks, func(i, j int) bool {
+		return stoppedTasks[i].StoppedAt.After(stoppedTasks[j].StoppedAt)
+	})
+	pullFailures := imagePullFailures(stoppedTasks)
+	if len(stoppedTasks) > maxStoppedTaskEvents {
+		stoppedTasks = stoppedTasks[:maxStoppedTaskEvents]
+	}
+	return &ServiceEnvEvents{
+		Environment:       env,
+		Events:            service.RecentEvents(maxServiceEvents),
+		StoppedTasks:      stoppedTasks,
+		ImagePullFailures: pullFailures,
+	}, nil
+}
+
+// imagePullFailures returns the containers of the stopped tasks that couldn't start because their image couldn't be pulled.
+func imagePullFailures(tasks []awsecs.TaskStatus) []ImagePullFailure {
+	var failures []ImagePullFailure
+	for _, task := range tasks {
+		for _, container := range task.StoppedContainers {
+			reason := container.Reason
+			if container.ExitCode == nil && reason == "" {
+				// The container never ran, so the task's reason explains why.
+				reason = task.StoppedReason
+			}
+			if !isImagePullError(reason) {
+				continue
+			}
+			failures = append(failures, ImagePullFailure{
+				TaskID:    task.ID,
+				StoppedAt: task.StoppedAt,
+				Container: container.Name,
+				Image:     container.Image,
+				Reason:    reason,
+			})
+		}
+		if len(task.StoppedContainers) == 0 && isImagePullError(task.StoppedReason) {
+			failures = append(failures, ImagePullFailure{
+				TaskID:    task.ID,
+				StoppedAt: task.StoppedAt,
+				Reason:    task.StoppedReason,
+			})
+		}
+	}
+	return failures
+}
+
+func isImagePullError(reason string) bool {
+	if strings.HasPrefix(reason, "CannotPullContainerError") {
+		return true
+	}
+	// For example, "ResourceInitializationError: unable to pull secrets or registry auth".
+	return strings.HasPrefix(reason, "ResourceInitializationError") && strings.Contains(reason, "pull")
+}
+
+// ServiceEvents contains serialized recent events of a service in each environment that it's deployed to.
+type ServiceEvents struct {
+	Service      string              `json:"service"`
+	Environments []*ServiceEnvEvents `json:"environments"`
+}
+
+// ServiceEnvEvents contains serialized recent events of a service in an environment.
+type ServiceEnvEvents struct {
+	Environment       string                `json:"environment"`
+	Events            []awsecs.ServiceEvent `json:"events"`
+	StoppedTasks      []awsecs.TaskStatus   `json:"stoppedTasks"`
+	ImagePullFailures []ImagePullFailure    `json:"imagePullFailures"`
+}
+
+// ImagePullFailure contains serialized parameters of a container that couldn't start because its image couldn't be pulled.
+type ImagePullFailure struct {
+	TaskID    string    `json:"taskId"`
+	StoppedAt time.Time `json:"stoppedAt"`
+	Container string    `json:"container,omitempty"`
+	Image     string    `json:"image,omitempty"`
+	Reason    string    `json:"reason"`
+}
+
+// JSONString returns the stringified ServiceEvents struct with json format.
+func (e *ServiceEvents) JSONString() (string, error) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return "", fmt.Errorf("marshal service events: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified ServiceEvents struct with human readable format.
+func (e *ServiceEvents) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Service Events\n\n"))
+	writer.Flush()
+	e.writeServiceEvents(writer)
+	writer.Flush()
+	if e.hasStoppedTasks() {
+		fmt.Fprint(writer, color.Bold.Sprint("\nStopped Tasks\n\n"))
+		writer.Flush()
+		e.writeStoppedTasks(writer)
+		writer.Flush()
+	}
+	if e.hasImagePullFailures() {
+		fmt.Fprint(writer, color.Bold.Sprint("\nImage Pull Failures\n\n"))
+		writer.Flush()
+		e.writeImagePullFailures(writer)
+		writer.Flush()
+	}
+	return b.String()
+}
+
+func (e *ServiceEvents) writeServiceEvents(writer io.Writer) {
+	headers := []string{"Environment", "Created At", "Message"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, env := range e.Environments {
+		envName := env.Environment
+		if len(env.Events) == 0 {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", envName, "-", "-")
+			continue
+		}
+		for _, event := range env.Events {
+			printWithMaxWidth(writer, "  %s\t%s\t%s\n", maxEventMessageWidth, envName, humanizeTime(event.CreatedAt), event.Message)
+			envName = dittoSymbol
+		}
+	}
+}
+
+func (e *ServiceEvents) writeStoppedTasks(writer io.Writer) {
+	headers := []string{"Environment", "ID", "Stopped At", "Stop Code", "Container", "Exit Code", "Reason"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, env := range e.Environments {
+		envName := env.Environment
+		for _, task := range env.StoppedTasks {
+			for _, row := range stoppedTaskDiagnosticRows(task) {
+				printWithMaxWidth(writer, "  %s\t%s\t%s\t%s\t%s\t%s\t%s\n", maxAlarmStatusColumnWidth, append([]string{envName}, row...)...)
+				envName = dittoSymbol
+			}
+		}
+	}
+}
+
+func (e *ServiceEvents) writeImagePullFailures(writer io.Writer) {
+	headers := []string{"Environment", "Task", "Stopped At", "Container", "Image", "Reason"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, env := range e.Environments {
+		envName := env.Environment
+		for _, failure := range env.ImagePullFailures {
+			stoppedSince := "-"
+			if !failure.StoppedAt.IsZero() {
+				stoppedSince = humanizeTime(failure.StoppedAt)
+			}
+			printWithMaxWidth(writer, "  %s\t%s\t%s\t%s\t%s\t%s\n", maxAlarmStatusColumnWidth, envName, shortTaskID(failure.TaskID),
+				stoppedSince, valueOrDash(failure.Container), valueOrDash(failure.Image), failure.Reason)
+			envName = dittoSymbol
+		}
+	}
+}
+
+func (e *ServiceEvents) hasStoppedTasks() bool {
+	for _, env := range e.Environments {
+		if len(env.StoppedTasks) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *ServiceEvents) hasImagePullFailures() bool {
+	for _, env := range e.Environments {
+		if len(env.ImagePullFailures) > 0 {
+			return true
+		}
+	}
+	return false
+}
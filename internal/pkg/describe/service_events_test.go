@@ -0,0 +1,335 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/dustin/go-humanize"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type serviceEventsMocks struct {
+	store        *mocks.MockDeployedEnvServicesLister
+	svcDescriber *mocks.MockserviceDescriber
+	ecsSvcGetter *mocks.MockecsServiceGetter
+}
+
+func TestECSServiceEventsDescriber_Describe(t *testing.T) {
+	const (
+		mockApp = "phonetool"
+		mockSvc = "api"
+	)
+	mockErr := errors.New("some error")
+	eventTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	oldTaskStoppedAt := time.Date(2019, 12, 31, 23, 0, 0, 0, time.UTC)
+	newTaskStoppedAt := time.Date(2019, 12, 31, 23, 30, 0, 0, time.UTC)
+	mockServiceDesc := &ecs.ServiceDesc{
+		Name:        "phonetool-test-api-Service",
+		ClusterName: "phonetool-test-Cluster",
+		StoppedTasks: []*awsecs.Task{
+			{
+				TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789012:task/phonetool-test-Cluster/1111111111111111"),
+				LastStatus:    aws.String("STOPPED"),
+				StoppedAt:     aws.Time(oldTaskStoppedAt),
+				StopCode:      aws.String("EssentialContainerExited"),
+				StoppedReason: aws.String("Essential container in task exited"),
+				Containers: []*sdkecs.Container{
+					{
+						Name:       aws.String("api"),
+						Image:      aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v1"),
+						LastStatus: aws.String("STOPPED"),
+						ExitCode:   aws.Int64(137),
+						Reason:     aws.String("OutOfMemoryError: Container killed due to memory usage"),
+					},
+				},
+			},
+			{
+				TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789012:task/phonetool-test-Cluster/2222222222222222"),
+				LastStatus:    aws.String("STOPPED"),
+				StoppedAt:     aws.Time(newTaskStoppedAt),
+				StopCode:      aws.String("TaskFailedToStart"),
+				StoppedReason: aws.String("CannotPullContainerError: pull image manifest has been retried 5 time(s)"),
+				Containers: []*sdkecs.Container{
+					{
+						Name:       aws.String("api"),
+						Image:      aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v2"),
+						LastStatus: aws.String("STOPPED"),
+					},
+				},
+			},
+		},
+	}
+	mockService := &awsecs.Service{
+		Events: []*sdkecs.ServiceEvent{
+			{
+				Id:        aws.String("1"),
+				CreatedAt: aws.Time(eventTime),
+				Message:   aws.String("(service phonetool-test-api-Service) was unable to place a task."),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m serviceEventsMocks)
+
+		wanted    *ServiceEvents
+		wantedErr error
+	}{
+		"returns error if fail to list the environments the service is deployed to": {
+			setupMocks: func(m serviceEventsMocks) {
+				m.store.EXPECT().ListEnvironmentsDeployedTo(mockApp, mockSvc).Return(nil, mockErr)
+			},
+			wantedErr: fmt.Errorf("list deployed environments for service api: some error"),
+		},
+		"returns error if fail to describe the ECS service": {
+			setupMocks: func(m serviceEventsMocks) {
+				m.store.EXPECT().ListEnvironmentsDeployedTo(mockApp, mockSvc).Return([]string{"test"}, nil)
+				m.svcDescriber.EXPECT().DescribeService(mockApp, "test", mockSvc).Return(nil, mockErr)
+			},
+			wantedErr: fmt.Errorf("get ECS service description for api in environment test: some error"),
+		},
+		"returns error if fail to get the ECS service": {
+			setupMocks: func(m serviceEventsMocks) {
+				m.store.EXPECT().ListEnvironmentsDeployedTo(mockApp, mockSvc).Return([]string{"test"}, nil)
+				m.svcDescriber.EXPECT().DescribeService(mockApp, "test", mockSvc).Return(mockServiceDesc, nil)
+				m.ecsSvcGetter.EXPECT().Service("phonetool-test-Cluster", "phonetool-test-api-Service").Return(nil, mockErr)
+			},
+			wantedErr: fmt.Errorf("get service phonetool-test-api-Service: some error"),
+		},
+		"success": {
+			setupMocks: func(m serviceEventsMocks) {
+				m.store.EXPECT().ListEnvironmentsDeployedTo(mockApp, mockSvc).Return([]string{"test"}, nil)
+				m.svcDescriber.EXPECT().DescribeService(mockApp, "test", mockSvc).Return(mockServiceDesc, nil)
+				m.ecsSvcGetter.EXPECT().Service("phonetool-test-Cluster", "phonetool-test-api-Service").Return(mockService, nil)
+			},
+			wanted: &ServiceEvents{
+				Service: mockSvc,
+				Environments: []*ServiceEnvEvents{
+					{
+						Environment: "test",
+						Events: []awsecs.ServiceEvent{
+							{
+								ID:        "1",
+								CreatedAt: eventTime,
+								Message:   "(service phonetool-test-api-Service) was unable to place a task.",
+							},
+						},
+						StoppedTasks: []awsecs.TaskStatus{
+							{
+								ID:            "2222222222222222",
+								Images:        []awsecs.Image{{ID: "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v2"}},
+								LastStatus:    "STOPPED",
+								StoppedAt:     newTaskStoppedAt,
+								StoppedReason: "CannotPullContainerError: pull image manifest has been retried 5 time(s)",
+								StopCode:      "TaskFailedToStart",
+								StoppedContainers: []awsecs.StoppedContainer{
+									{
+										Name:  "api",
+										Image: "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v2",
+									},
+								},
+							},
+							{
+								ID:            "1111111111111111",
+								Images:        []awsecs.Image{{ID: "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v1"}},
+								LastStatus:    "STOPPED",
+								StoppedAt:     oldTaskStoppedAt,
+								StoppedReason: "Essential container in task exited",
+								StopCode:      "EssentialContainerExited",
+								StoppedContainers: []awsecs.StoppedContainer{
+									{
+										Name:     "api",
+										Image:    "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v1",
+										ExitCode: aws.Int64(137),
+										Reason:   "OutOfMemoryError: Container killed due to memory usage",
+									},
+								},
+							},
+						},
+						ImagePullFailures: []ImagePullFailure{
+							{
+								TaskID:    "2222222222222222",
+								StoppedAt: newTaskStoppedAt,
+								Container: "api",
+								Image:     "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v2",
+								Reason:    "CannotPullContainerError: pull image manifest has been retried 5 time(s)",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := serviceEventsMocks{
+				store:        mocks.NewMockDeployedEnvServicesLister(ctrl),
+				svcDescriber: mocks.NewMockserviceDescriber(ctrl),
+				ecsSvcGetter: mocks.NewMockecsServiceGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &ECSServiceEventsDescriber{
+				app:   mockApp,
+				svc:   mockSvc,
+				store: m.store,
+				initDescribers: func(env string) (serviceDescriber, ecsServiceGetter, error) {
+					return m.svcDescriber, m.ecsSvcGetter, nil
+				},
+			}
+
+			// WHEN
+			actual, err := d.Describe()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, actual)
+			}
+		})
+	}
+}
+
+func TestServiceEvents_HumanString(t *testing.T) {
+	// humanizeTime is overridden so that the output doesn't change as time passes.
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2020-01-01T00:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	stoppedAt := time.Date(2019, 12, 31, 23, 30, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		events *ServiceEvents
+
+		wanted string
+	}{
+		"only shows the service events if no task stopped": {
+			events: &ServiceEvents{
+				Service: "api",
+				Environments: []*ServiceEnvEvents{
+					{
+						Environment: "test",
+						Events: []awsecs.ServiceEvent{
+							{
+								CreatedAt: time.Date(2019, 12, 31, 22, 0, 0, 0, time.UTC),
+								Message:   "(service api) has reached a steady state.",
+							},
+							{
+								CreatedAt: time.Date(2019, 12, 31, 21, 0, 0, 0, time.UTC),
+								Message:   "(service api) has started 1 tasks: (task 1234).",
+							},
+						},
+					},
+					{
+						Environment: "prod",
+					},
+				},
+			},
+			wanted: `Service Events
+
+  Environment  Created At   Message
+  -----------  ----------   -------
+  test         2 hours ago  (service api) has reached a steady state.
+    "          3 hours ago  (service api) has started 1 tasks: (task 1234).
+  prod         -            -
+`,
+		},
+		"shows stopped tasks and image pull failures": {
+			events: &ServiceEvents{
+				Service: "api",
+				Environments: []*ServiceEnvEvents{
+					{
+						Environment: "test",
+						Events: []awsecs.ServiceEvent{
+							{
+								CreatedAt: time.Date(2019, 12, 31, 23, 0, 0, 0, time.UTC),
+								Message:   "(service api) was unable to place a task.",
+							},
+						},
+						StoppedTasks: []awsecs.TaskStatus{
+							{
+								ID:            "2222222222222222",
+								StoppedAt:     stoppedAt,
+								StoppedReason: "CannotPullContainerError: access denied",
+								StopCode:      "TaskFailedToStart",
+								StoppedContainers: []awsecs.StoppedContainer{
+									{
+										Name:  "api",
+										Image: "api:v2",
+									},
+								},
+							},
+							{
+								ID:            "1111111111111111",
+								StoppedAt:     stoppedAt,
+								StoppedReason: "Essential container in task exited",
+								StopCode:      "EssentialContainerExited",
+								StoppedContainers: []awsecs.StoppedContainer{
+									{
+										Name:     "api",
+										ExitCode: aws.Int64(1),
+									},
+								},
+							},
+						},
+						ImagePullFailures: []ImagePullFailure{
+							{
+								TaskID:    "2222222222222222",
+								StoppedAt: stoppedAt,
+								Container: "api",
+								Image:     "api:v2",
+								Reason:    "CannotPullContainerError: access denied",
+							},
+						},
+					},
+				},
+			},
+			wanted: `Service Events
+
+  Environment  Created At  Message
+  -----------  ----------  -------
+  test         1 hour ago  (service api) was unable to place a task.
+
+Stopped Tasks
+
+  Environment  ID        Stopped At      Stop Code                 Container  Exit Code  Reason
+  -----------  --        ----------      ---------                 ---------  ---------  ------
+  test         22222222  30 minutes ago  TaskFailedToStart         api        -          CannotPullContainerError: acce
+                                                                                         ss denied
+    "          11111111  30 minutes ago  EssentialContainerExited  api        1          -
+
+Image Pull Failures
+
+  Environment  Task      Stopped At      Container  Image     Reason
+  -----------  ----      ----------      ---------  -----     ------
+  test         22222222  30 minutes ago  api        api:v2    CannotPullContainerError: acce
+                                                              ss denied
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.events.HumanString())
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
 
@@ -81,6 +82,100 @@ Manifest: |
 	}
 }
 
+func TestServiceStackDescriber_LastDeployment(t *testing.T) {
+	testApp, testEnv, testWorkload := "phonetool", "test", "api"
+	deployedAt := time.Date(2023, time.March, 1, 10, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		mockCFN func(m *mocks.MockstackDescriber)
+
+		wanted    *stack.Deployment
+		wantedErr error
+	}{
+		"should return the error if the stack cannot be described": {
+			mockCFN: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().Describe().Return(stack.StackDescription{}, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"should return wrapped error if Metadata cannot be retrieved from stack": {
+			mockCFN: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().Describe().Return(stack.StackDescription{}, nil)
+				m.EXPECT().StackMetadata().Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("retrieve stack metadata for phonetool-test-api: some error"),
+		},
+		"should return only the time of the deployment if it wasn't recorded": {
+			mockCFN: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().Describe().Return(stack.StackDescription{
+					Parameters: map[string]string{
+						"ContainerImage": "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:latest",
+					},
+					LastUpdatedTime: deployedAt,
+				}, nil)
+				m.EXPECT().StackMetadata().Return(`
+Manifest: |
+  name: api`, nil)
+			},
+			wanted: &stack.Deployment{
+				Environment: testEnv,
+				DeployedAt:  deployedAt,
+			},
+		},
+		"should return the recorded deployment": {
+			mockCFN: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().Describe().Return(stack.StackDescription{
+					Parameters: map[string]string{
+						"ContainerImage": "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api@sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+					},
+					LastUpdatedTime: deployedAt,
+				}, nil)
+				m.EXPECT().StackMetadata().Return(`
+Manifest: |
+  name: api
+Deployment:
+  Deployer: 'arn:aws:sts::123456789012:assumed-role/Admin/jane'
+  Commit: '4e1b5c2'
+  Version: 'v1.25.0'`, nil)
+			},
+			wanted: &stack.Deployment{
+				Environment:     testEnv,
+				DeployedAt:      deployedAt,
+				DeployedBy:      "arn:aws:sts::123456789012:assumed-role/Admin/jane",
+				Commit:          "4e1b5c2",
+				ImageDigest:     "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+				TemplateVersion: "v1.25.0",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockstackDescriber(ctrl)
+			tc.mockCFN(m)
+			describer := serviceStackDescriber{
+				app:     testApp,
+				env:     testEnv,
+				service: testWorkload,
+				cfn:     m,
+			}
+
+			// WHEN
+			actual, err := describer.LastDeployment()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, actual)
+		})
+	}
+}
+
 func Test_WorkloadManifest(t *testing.T) {
 	testApp, testService := "phonetool", "api"
 
@@ -189,6 +284,102 @@ func Test_WorkloadManifest(t *testing.T) {
 	}
 }
 
+func Test_WorkloadParams(t *testing.T) {
+	testApp, testService := "phonetool", "api"
+	type paramsDescriber interface {
+		Params(string) (map[string]string, error)
+	}
+
+	testCases := map[string]struct {
+		inEnv         string
+		mockDescriber func(ctrl *gomock.Controller) paramsDescriber
+
+		wantedParams map[string]string
+		wantedErr    error
+	}{
+		"should return the error as is from the mock ecs client for LBWSDescriber": {
+			inEnv: "test",
+			mockDescriber: func(ctrl *gomock.Controller) paramsDescriber {
+				m := mocks.NewMockecsDescriber(ctrl)
+				m.EXPECT().Params().Return(nil, errors.New("some error"))
+				return &LBWebServiceDescriber{
+					app: testApp,
+					svc: testService,
+					initECSServiceDescribers: func(s string) (ecsDescriber, error) {
+						return m, nil
+					},
+				}
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"should return the parameters on success for BackendServiceDescriber": {
+			inEnv: "test",
+			mockDescriber: func(ctrl *gomock.Controller) paramsDescriber {
+				m := mocks.NewMockecsDescriber(ctrl)
+				m.EXPECT().Params().Return(map[string]string{"TaskCount": "1"}, nil)
+				return &BackendServiceDescriber{
+					app: testApp,
+					svc: testService,
+					initECSServiceDescribers: func(s string) (ecsDescriber, error) {
+						return m, nil
+					},
+				}
+			},
+			wantedParams: map[string]string{"TaskCount": "1"},
+		},
+		"should return the parameters on success for RDWebServiceDescriber": {
+			inEnv: "test",
+			mockDescriber: func(ctrl *gomock.Controller) paramsDescriber {
+				m := mocks.NewMockapprunnerDescriber(ctrl)
+				m.EXPECT().Params().Return(map[string]string{"InstanceCPU": "1024"}, nil)
+				return &RDWebServiceDescriber{
+					app: testApp,
+					svc: testService,
+					initAppRunnerDescriber: func(s string) (apprunnerDescriber, error) {
+						return m, nil
+					},
+				}
+			},
+			wantedParams: map[string]string{"InstanceCPU": "1024"},
+		},
+		"should return the parameters on success for WorkerServiceDescriber": {
+			inEnv: "test",
+			mockDescriber: func(ctrl *gomock.Controller) paramsDescriber {
+				m := mocks.NewMockecsDescriber(ctrl)
+				m.EXPECT().Params().Return(map[string]string{"TaskCount": "2"}, nil)
+				return &WorkerServiceDescriber{
+					app: testApp,
+					svc: testService,
+					initECSDescriber: func(s string) (ecsDescriber, error) {
+						return m, nil
+					},
+				}
+			},
+			wantedParams: map[string]string{"TaskCount": "2"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			describer := tc.mockDescriber(ctrl)
+
+			// WHEN
+			actualParams, actualErr := describer.Params(tc.inEnv)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, actualErr, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, actualErr)
+				require.Equal(t, tc.wantedParams, actualParams)
+			}
+		})
+	}
+}
+
 type ecsSvcDescriberMocks struct {
 	mockCFN       *mocks.MockstackDescriber
 	mockECSClient *mocks.MockecsClient
@@ -471,6 +662,237 @@ func TestServiceDescriber_ServiceStackResources(t *testing.T) {
 	}
 }
 
+func TestServiceDescriber_AddonsStackResources(t *testing.T) {
+	const (
+		addonsStackARN = "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-jobs-AddonsStack-1A2B3C/f6e6c2f0-6f6a-11ec-a5f8-0a8a9b3c2c71"
+		nestedStackARN = "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-jobs-AddonsStack-1A2B3C-CacheStack-4D5E6F/0a8a9b3c-6f6a-11ec-a5f8-f6e6c2f02c71"
+	)
+	testCases := map[string]struct {
+		setupMocks func(svcStack, addonsStack, nestedStack *mocks.MockstackDescriber)
+
+		wantedResources []*stack.Resource
+		wantedError     error
+	}{
+		"returns error when fail to describe service stack resources": {
+			setupMocks: func(svcStack, _, _ *mocks.MockstackDescriber) {
+				svcStack.EXPECT().Resources().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns nil if the service doesn't have addons": {
+			setupMocks: func(svcStack, _, _ *mocks.MockstackDescriber) {
+				svcStack.EXPECT().Resources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::EC2::SecurityGroup",
+						LogicalID:  "EnvControllerSecurityGroup",
+						PhysicalID: "sg-0758ed6b233743530",
+					},
+				}, nil)
+			},
+		},
+		"returns error when fail to describe addons stack resources": {
+			setupMocks: func(svcStack, addonsStack, _ *mocks.MockstackDescriber) {
+				svcStack.EXPECT().Resources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::CloudFormation::Stack",
+						LogicalID:  "AddonsStack",
+						PhysicalID: addonsStackARN,
+					},
+				}, nil)
+				addonsStack.EXPECT().Resources().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns the resources of the addons stack and of its nested stacks labeled by stack": {
+			setupMocks: func(svcStack, addonsStack, nestedStack *mocks.MockstackDescriber) {
+				svcStack.EXPECT().Resources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::ECS::Service",
+						LogicalID:  "Service",
+						PhysicalID: "arn:aws:ecs:us-west-2:123456789012:service/phonetool-test-Cluster/phonetool-test-jobs-Service",
+					},
+					{
+						Type:       "AWS::CloudFormation::Stack",
+						LogicalID:  "AddonsStack",
+						PhysicalID: addonsStackARN,
+					},
+				}, nil)
+				addonsStack.EXPECT().Resources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::DynamoDB::Table",
+						LogicalID:  "ordersTable",
+						PhysicalID: "phonetool-test-jobs-orders",
+					},
+					{
+						Type:       "AWS::CloudFormation::Stack",
+						LogicalID:  "CacheStack",
+						PhysicalID: nestedStackARN,
+					},
+				}, nil)
+				nestedStack.EXPECT().Resources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::ElastiCache::CacheCluster",
+						LogicalID:  "Cache",
+						PhysicalID: "pho-ca-1a2b3c4d5e6f",
+					},
+				}, nil)
+			},
+			wantedResources: []*stack.Resource{
+				{
+					Type:       "AWS::DynamoDB::Table",
+					LogicalID:  "ordersTable",
+					PhysicalID: "phonetool-test-jobs-orders",
+					Stack:      "phonetool-test-jobs-AddonsStack-1A2B3C",
+				},
+				{
+					Type:       "AWS::CloudFormation::Stack",
+					LogicalID:  "CacheStack",
+					PhysicalID: nestedStackARN,
+					Stack:      "phonetool-test-jobs-AddonsStack-1A2B3C",
+				},
+				{
+					Type:       "AWS::ElastiCache::CacheCluster",
+					LogicalID:  "Cache",
+					PhysicalID: "pho-ca-1a2b3c4d5e6f",
+					Stack:      "phonetool-test-jobs-AddonsStack-1A2B3C-CacheStack-4D5E6F",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svcStack := mocks.NewMockstackDescriber(ctrl)
+			addonsStack := mocks.NewMockstackDescriber(ctrl)
+			nestedStack := mocks.NewMockstackDescriber(ctrl)
+			tc.setupMocks(svcStack, addonsStack, nestedStack)
+
+			d := &serviceStackDescriber{
+				app:     "phonetool",
+				service: "jobs",
+				env:     "test",
+				cfn:     svcStack,
+				newStackDescriber: func(stackName string) stackDescriber {
+					if stackName == nestedStackARN {
+						return nestedStack
+					}
+					require.Equal(t, addonsStackARN, stackName)
+					return addonsStack
+				},
+			}
+
+			// WHEN
+			actual, err := d.AddonsStackResources()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedResources, actual)
+		})
+	}
+}
+
+func TestServiceDescriber_AddonsOutputs(t *testing.T) {
+	const addonsStackARN = "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-jobs-AddonsStack-1A2B3C/f6e6c2f0-6f6a-11ec-a5f8-0a8a9b3c2c71"
+	testCases := map[string]struct {
+		setupMocks func(svcStack, addonsStack *mocks.MockstackDescriber)
+
+		wantedOutputs map[string]string
+		wantedError   error
+	}{
+		"returns error when fail to describe service stack resources": {
+			setupMocks: func(svcStack, _ *mocks.MockstackDescriber) {
+				svcStack.EXPECT().Resources().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns nil if the service doesn't have addons": {
+			setupMocks: func(svcStack, _ *mocks.MockstackDescriber) {
+				svcStack.EXPECT().Resources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::EC2::SecurityGroup",
+						LogicalID:  "EnvControllerSecurityGroup",
+						PhysicalID: "sg-0758ed6b233743530",
+					},
+				}, nil)
+			},
+		},
+		"returns error when fail to describe the addons stack": {
+			setupMocks: func(svcStack, addonsStack *mocks.MockstackDescriber) {
+				svcStack.EXPECT().Resources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::CloudFormation::Stack",
+						LogicalID:  "AddonsStack",
+						PhysicalID: addonsStackARN,
+					},
+				}, nil)
+				addonsStack.EXPECT().Describe().Return(stack.StackDescription{}, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns the outputs of the addons stack": {
+			setupMocks: func(svcStack, addonsStack *mocks.MockstackDescriber) {
+				svcStack.EXPECT().Resources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::CloudFormation::Stack",
+						LogicalID:  "AddonsStack",
+						PhysicalID: addonsStackARN,
+					},
+				}, nil)
+				addonsStack.EXPECT().Describe().Return(stack.StackDescription{
+					Outputs: map[string]string{
+						"ordersDBClusterWriterEndpoint": "orders.cluster-abc.us-west-2.rds.amazonaws.com",
+					},
+				}, nil)
+			},
+			wantedOutputs: map[string]string{
+				"ordersDBClusterWriterEndpoint": "orders.cluster-abc.us-west-2.rds.amazonaws.com",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svcStack := mocks.NewMockstackDescriber(ctrl)
+			addonsStack := mocks.NewMockstackDescriber(ctrl)
+			tc.setupMocks(svcStack, addonsStack)
+
+			d := &serviceStackDescriber{
+				app:     "phonetool",
+				service: "jobs",
+				env:     "test",
+				cfn:     svcStack,
+				newStackDescriber: func(stackName string) stackDescriber {
+					require.Equal(t, addonsStackARN, stackName)
+					return addonsStack
+				},
+			}
+
+			// WHEN
+			actual, err := d.AddonsOutputs()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutputs, actual)
+		})
+	}
+}
+
 func TestServiceDescriber_Platform(t *testing.T) {
 	const (
 		testApp = "phonetool"
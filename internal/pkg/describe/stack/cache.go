@@ -0,0 +1,64 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Cache memoizes the CloudFormation calls of the StackDescribers that share it, so that describing
+// the same stack many times within a command calls the CloudFormation API once.
+// A failed call isn't memoized and is retried by the next describer that makes it.
+// Cache is safe for concurrent use.
+type Cache struct {
+	mu    sync.Mutex
+	calls map[string]*cachedCall
+}
+
+type cachedCall struct {
+	once sync.Once
+	val  interface{}
+	err  error
+}
+
+// NewCache returns an empty Cache.
+func NewCache() *Cache {
+	return &Cache{
+		calls: make(map[string]*cachedCall),
+	}
+}
+
+// do returns the result of the first successful call of fn for the key.
+// Concurrent calls for the same key wait for the call in flight instead of calling fn again.
+// If c is nil, fn is always called.
+func (c *Cache) do(key string, fn func() (interface{}, error)) (interface{}, error) {
+	if c == nil {
+		return fn()
+	}
+	c.mu.Lock()
+	call, ok := c.calls[key]
+	if !ok {
+		call = &cachedCall{}
+		c.calls[key] = call
+	}
+	c.mu.Unlock()
+
+	call.once.Do(func() {
+		call.val, call.err = fn()
+	})
+	if call.err != nil {
+		c.mu.Lock()
+		if c.calls[key] == call {
+			delete(c.calls, key)
+		}
+		c.mu.Unlock()
+	}
+	return call.val, call.err
+}
+
+// cacheKey returns the key of a call for a stack. Stack names are only unique within a region.
+func cacheKey(region, stackName, call string) string {
+	return fmt.Sprintf("%s/%s/%s", region, stackName, call)
+}
@@ -0,0 +1,134 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_do(t *testing.T) {
+	t.Run("calls fn every time if the cache is nil", func(t *testing.T) {
+		var c *Cache
+		var calls int
+		for i := 0; i < 2; i++ {
+			out, err := c.do("key", func() (interface{}, error) {
+				calls++
+				return calls, nil
+			})
+			require.NoError(t, err)
+			require.Equal(t, i+1, out)
+		}
+		require.Equal(t, 2, calls)
+	})
+	t.Run("memoizes the result of a successful call by key", func(t *testing.T) {
+		c := NewCache()
+		var calls int
+		fn := func() (interface{}, error) {
+			calls++
+			return "value", nil
+		}
+		for i := 0; i < 2; i++ {
+			out, err := c.do("key", fn)
+			require.NoError(t, err)
+			require.Equal(t, "value", out)
+		}
+		_, err := c.do("other", fn)
+		require.NoError(t, err)
+		require.Equal(t, 2, calls)
+	})
+	t.Run("retries a failed call", func(t *testing.T) {
+		c := NewCache()
+		_, err := c.do("key", func() (interface{}, error) {
+			return nil, errors.New("throttled")
+		})
+		require.EqualError(t, err, "throttled")
+
+		out, err := c.do("key", func() (interface{}, error) {
+			return "value", nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "value", out)
+	})
+	t.Run("concurrent calls for the same key call fn once", func(t *testing.T) {
+		c := NewCache()
+		var mu sync.Mutex
+		var calls int
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				out, err := c.do("key", func() (interface{}, error) {
+					mu.Lock()
+					defer mu.Unlock()
+					calls++
+					return "value", nil
+				})
+				require.NoError(t, err)
+				require.Equal(t, "value", out)
+			}()
+		}
+		wg.Wait()
+		require.Equal(t, 1, calls)
+	})
+}
+
+func TestStackDescriber_SharedCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockcfn := mocks.NewMockcfn(ctrl)
+	mockcfn.EXPECT().Describe("phonetool-test-api").Return(&cloudformation.StackDescription{
+		Outputs: []*sdkcfn.Output{
+			{
+				OutputKey:   aws.String("mockOutputKey"),
+				OutputValue: aws.String("mockOutputVal"),
+			},
+		},
+	}, nil).Times(1)
+	mockcfn.EXPECT().Describe("phonetool-test-api").Return(&cloudformation.StackDescription{}, nil).Times(1) // Different region.
+	mockcfn.EXPECT().StackResources("phonetool-test-api").Return([]*cloudformation.StackResource{
+		{
+			ResourceType:       aws.String("AWS::ECS::Service"),
+			PhysicalResourceId: aws.String("phonetool-test-api-Service"),
+			LogicalResourceId:  aws.String("Service"),
+		},
+	}, nil).Times(1)
+
+	cache := NewCache()
+	newDescriber := func(region string) *StackDescriber {
+		return &StackDescriber{
+			name:   "phonetool-test-api",
+			region: region,
+			cfn:    mockcfn,
+			cache:  cache,
+		}
+	}
+	for i := 0; i < 2; i++ {
+		descr, err := newDescriber("us-west-2").Describe()
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"mockOutputKey": "mockOutputVal"}, descr.Outputs)
+
+		resources, err := newDescriber("us-west-2").Resources()
+		require.NoError(t, err)
+		require.Equal(t, []*Resource{
+			{
+				Type:       "AWS::ECS::Service",
+				PhysicalID: "phonetool-test-api-Service",
+				LogicalID:  "Service",
+			},
+		}, resources)
+	}
+	_, err := newDescriber("us-east-1").Describe()
+	require.NoError(t, err)
+}
@@ -5,6 +5,7 @@ package stack
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -22,6 +23,8 @@ type StackDescription struct {
 	Parameters map[string]string
 	Tags       map[string]string
 	Outputs    map[string]string
+	// Time of the last update of the stack, or of its creation if it was never updated.
+	LastUpdatedTime time.Time
 }
 
 // Resource contains cloudformation stack resource info.
@@ -29,6 +32,7 @@ type Resource struct {
 	Type       string `json:"type"`
 	PhysicalID string `json:"physicalID"`
 	LogicalID  string `json:"logicalID,omitempty"`
+	Stack      string `json:"stack,omitempty"` // Name of the nested stack holding the resource, empty for the workload stack.
 }
 
 // HumanString returns the stringified Resource struct with human readable format.
@@ -36,26 +40,49 @@ func (c Resource) HumanString() string {
 	return fmt.Sprintf("%s\t%s\n", c.Type, c.PhysicalID)
 }
 
+// Deployment contains the details of the last deployment of a workload stack in an environment.
+type Deployment struct {
+	Environment     string    `json:"environment"`
+	DeployedAt      time.Time `json:"deployedAt"`
+	DeployedBy      string    `json:"deployedBy,omitempty"`
+	Commit          string    `json:"commit,omitempty"`
+	ImageDigest     string    `json:"imageDigest,omitempty"`
+	TemplateVersion string    `json:"templateVersion,omitempty"`
+}
+
 // StackDescriber retrieves information about a stack.
 type StackDescriber struct {
-	name string
-	cfn  cfn
+	name   string
+	region string
+	cfn    cfn
+	cache  *Cache
 }
 
 // NewStackDescriber instantiates a new StackDescriber.
 func NewStackDescriber(stackName string, sess *session.Session) *StackDescriber {
 	return &StackDescriber{
-		name: stackName,
-		cfn:  cloudformation.New(sess),
+		name:   stackName,
+		region: aws.StringValue(sess.Config.Region),
+		cfn:    cloudformation.New(sess),
 	}
 }
 
+// NewCachedStackDescriber instantiates a new StackDescriber whose CloudFormation calls are memoized in the cache.
+func NewCachedStackDescriber(stackName string, sess *session.Session, cache *Cache) *StackDescriber {
+	d := NewStackDescriber(stackName, sess)
+	d.cache = cache
+	return d
+}
+
 // Describe retrieves information about a cloudformation stack.
 func (d *StackDescriber) Describe() (StackDescription, error) {
-	descr, err := d.cfn.Describe(d.name)
+	out, err := d.cache.do(cacheKey(d.region, d.name, "Describe"), func() (interface{}, error) {
+		return d.cfn.Describe(d.name)
+	})
 	if err != nil {
 		return StackDescription{}, fmt.Errorf("describe stack %s: %w", d.name, err)
 	}
+	descr := out.(*cloudformation.StackDescription)
 	params := make(map[string]string)
 	for _, param := range descr.Parameters {
 		params[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
@@ -68,38 +95,49 @@ func (d *StackDescriber) Describe() (StackDescription, error) {
 	for _, tag := range descr.Tags {
 		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
 	}
+	lastUpdated := aws.TimeValue(descr.CreationTime)
+	if descr.LastUpdatedTime != nil {
+		lastUpdated = aws.TimeValue(descr.LastUpdatedTime)
+	}
 	return StackDescription{
-		Parameters: params,
-		Tags:       tags,
-		Outputs:    outputs,
+		Parameters:      params,
+		Tags:            tags,
+		Outputs:         outputs,
+		LastUpdatedTime: lastUpdated,
 	}, nil
 }
 
 // Resources retrieves the information about a stack's resources.
 func (d *StackDescriber) Resources() ([]*Resource, error) {
-	resources, err := d.cfn.StackResources(d.name)
+	out, err := d.cache.do(cacheKey(d.region, d.name, "StackResources"), func() (interface{}, error) {
+		return d.cfn.StackResources(d.name)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("retrieve resources for stack %s: %w", d.name, err)
 	}
-	return flattenResources(resources), nil
+	return flattenResources(out.([]*cloudformation.StackResource)), nil
 }
 
 // StackMetadata returns the metadata of the stack.
 func (d *StackDescriber) StackMetadata() (string, error) {
-	metadata, err := d.cfn.Metadata(cloudformation.MetadataWithStackName(d.name))
+	out, err := d.cache.do(cacheKey(d.region, d.name, "StackMetadata"), func() (interface{}, error) {
+		return d.cfn.Metadata(cloudformation.MetadataWithStackName(d.name))
+	})
 	if err != nil {
 		return "", fmt.Errorf("get metadata for stack %s: %w", d.name, err)
 	}
-	return metadata, nil
+	return out.(string), nil
 }
 
 // StackSetMetadata returns the metadata of the stackset.
 func (d *StackDescriber) StackSetMetadata() (string, error) {
-	metadata, err := d.cfn.Metadata(cloudformation.MetadataWithStackSetName(d.name))
+	out, err := d.cache.do(cacheKey(d.region, d.name, "StackSetMetadata"), func() (interface{}, error) {
+		return d.cfn.Metadata(cloudformation.MetadataWithStackSetName(d.name))
+	})
 	if err != nil {
 		return "", fmt.Errorf("get metadata for stack set %s: %w", d.name, err)
 	}
-	return metadata, nil
+	return out.(string), nil
 }
 
 func flattenResources(stackResources []*cloudformation.StackResource) []*Resource {
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	sdkcfn "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -59,13 +60,29 @@ func TestStackDescriber_Describe(t *testing.T) {
 								Value: aws.String("mockTagVal"),
 							},
 						},
+						CreationTime: aws.Time(time.Date(2023, time.March, 1, 10, 0, 0, 0, time.UTC)),
 					}, nil),
 				)
 			},
 			wantedDescription: StackDescription{
-				Parameters: map[string]string{"mockParamKey": "mockParamVal"},
-				Tags:       map[string]string{"mockTagKey": "mockTagVal"},
-				Outputs:    map[string]string{"mockOutputKey": "mockOutputVal"},
+				Parameters:      map[string]string{"mockParamKey": "mockParamVal"},
+				Tags:            map[string]string{"mockTagKey": "mockTagVal"},
+				Outputs:         map[string]string{"mockOutputKey": "mockOutputVal"},
+				LastUpdatedTime: time.Date(2023, time.March, 1, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		"use the time of the last update of the stack": {
+			setupMocks: func(m stackDescriberMocks) {
+				m.cfn.EXPECT().Describe(mockStackName).Return(&cloudformation.StackDescription{
+					CreationTime:    aws.Time(time.Date(2023, time.March, 1, 10, 0, 0, 0, time.UTC)),
+					LastUpdatedTime: aws.Time(time.Date(2023, time.March, 2, 10, 0, 0, 0, time.UTC)),
+				}, nil)
+			},
+			wantedDescription: StackDescription{
+				Parameters:      map[string]string{},
+				Tags:            map[string]string{},
+				Outputs:         map[string]string{},
+				LastUpdatedTime: time.Date(2023, time.March, 2, 10, 0, 0, 0, time.UTC),
 			},
 		},
 	}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"text/tabwriter"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/term/progress/summarybar"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
@@ -28,6 +30,7 @@ import (
 
 const (
 	maxAlarmStatusColumnWidth = 30
+	maxStoppedTaskDiagnostics = 5
 	defaultServiceLogsLimit   = 10
 	shortTaskIDLength         = 8
 	summaryBarWidth           = 10
@@ -46,6 +49,13 @@ type ecsServiceStatus struct {
 	Alarms                   []cloudwatch.AlarmStatus `json:"alarms"`
 	StoppedTasks             []awsecs.TaskStatus      `json:"stoppedTasks"`
 	TargetHealthDescriptions []taskTargetHealth       `json:"targetHealthDescriptions"`
+	Autoscaling              *ecsServiceAutoscaling   `json:"autoscaling,omitempty"`
+}
+
+// ecsServiceAutoscaling contains the capacity bounds of an auto scaling ECS service and its most recent scaling activities.
+type ecsServiceAutoscaling struct {
+	aas.ScalableTarget
+	Activities []aas.ScalingActivity `json:"activities"`
 }
 
 // appRunnerServiceStatus contains the status for an AppRunner service.
@@ -114,6 +124,13 @@ func (s *ecsServiceStatus) HumanString() string {
 		writer.Flush()
 	}
 
+	if diagnosed := s.diagnosedStoppedTasks(); len(diagnosed) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nStopped Task Diagnostics\n\n"))
+		writer.Flush()
+		writeStoppedTaskDiagnostics(writer, diagnosed)
+		writer.Flush()
+	}
+
 	if len(s.DesiredRunningTasks) > 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nTasks\n\n"))
 		writer.Flush()
@@ -121,6 +138,13 @@ func (s *ecsServiceStatus) HumanString() string {
 		writer.Flush()
 	}
 
+	if s.Autoscaling != nil {
+		fmt.Fprint(writer, color.Bold.Sprint("\nAuto Scaling\n\n"))
+		writer.Flush()
+		s.writeAutoscaling(writer)
+		writer.Flush()
+	}
+
 	if len(s.Alarms) > 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nAlarms\n\n"))
 		writer.Flush()
@@ -358,10 +382,16 @@ func (s *ecsServiceStatus) writeStoppedTasks(writer io.Writer) {
 	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
 
 	reasonToTasks := make(map[string][]string)
+	var reasons []string
 	for _, task := range s.StoppedTasks {
+		if _, ok := reasonToTasks[task.StoppedReason]; !ok {
+			reasons = append(reasons, task.StoppedReason)
+		}
 		reasonToTasks[task.StoppedReason] = append(reasonToTasks[task.StoppedReason], shortTaskID(task.ID))
 	}
-	for reason, ids := range reasonToTasks {
+	sort.Strings(reasons)
+	for _, reason := range reasons {
+		ids := reasonToTasks[reason]
 		sampleIDs := ids
 		if len(sampleIDs) > 5 {
 			sampleIDs = sampleIDs[:5]
@@ -370,6 +400,79 @@ func (s *ecsServiceStatus) writeStoppedTasks(writer io.Writer) {
 	}
 }
 
+// diagnosedStoppedTasks returns the most recently stopped tasks that have a stop code or stopped containers.
+func (s *ecsServiceStatus) diagnosedStoppedTasks() []awsecs.TaskStatus {
+	var tasks []awsecs.TaskStatus
+	for _, task := range s.StoppedTasks {
+		if task.StopCode == "" && len(task.StoppedContainers) == 0 {
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return tasks[i].StoppedAt.After(tasks[j].StoppedAt)
+	})
+	if len(tasks) > maxStoppedTaskDiagnostics {
+		tasks = tasks[:maxStoppedTaskDiagnostics]
+	}
+	return tasks
+}
+
+func writeStoppedTaskDiagnostics(writer io.Writer, tasks []awsecs.TaskStatus) {
+	headers := []string{"ID", "Stopped At", "Stop Code", "Container", "Exit Code", "Reason"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, task := range tasks {
+		for _, row := range stoppedTaskDiagnosticRows(task) {
+			printWithMaxWidth(writer, "  %s\t%s\t%s\t%s\t%s\t%s\n", maxAlarmStatusColumnWidth, row...)
+		}
+	}
+}
+
+// stoppedTaskDiagnosticRows returns the ID, stopped time, stop code, container, exit code and reason
+// of each stopped container of a task. The task's details are only shown in its first row.
+func stoppedTaskDiagnosticRows(task awsecs.TaskStatus) [][]string {
+	stoppedSince := "-"
+	if !task.StoppedAt.IsZero() {
+		stoppedSince = humanizeTime(task.StoppedAt)
+	}
+	id, stopCode := shortTaskID(task.ID), valueOrDash(task.StopCode)
+	if len(task.StoppedContainers) == 0 {
+		return [][]string{{id, stoppedSince, stopCode, "-", "-", valueOrDash(task.StoppedReason)}}
+	}
+	var rows [][]string
+	for _, container := range task.StoppedContainers {
+		exitCode, reason := "-", container.Reason
+		if container.ExitCode != nil {
+			exitCode = strconv.FormatInt(*container.ExitCode, 10)
+		}
+		if container.ExitCode == nil && reason == "" {
+			// The container never ran, for example because its image couldn't be pulled, so the task's reason explains why.
+			reason = task.StoppedReason
+		}
+		rows = append(rows, []string{id, stoppedSince, stopCode, container.Name, exitCode, valueOrDash(reason)})
+		id, stoppedSince, stopCode = "", "", ""
+	}
+	return rows
+}
+
+func (s *ecsServiceStatus) writeAutoscaling(writer io.Writer) {
+	fmt.Fprintf(writer, "  %s\t%d\n", "Desired", s.Service.DesiredCount)
+	fmt.Fprintf(writer, "  %s\t%d\n", "Minimum", s.Autoscaling.MinCapacity)
+	fmt.Fprintf(writer, "  %s\t%d\n", "Maximum", s.Autoscaling.MaxCapacity)
+	if len(s.Autoscaling.Activities) == 0 {
+		return
+	}
+	fmt.Fprintln(writer)
+	headers := []string{"Started At", "Status", "Description", "Cause"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, activity := range s.Autoscaling.Activities {
+		printWithMaxWidth(writer, "  %s\t%s\t%s\t%s\n", maxAlarmStatusColumnWidth,
+			humanizeTime(activity.StartTime), activity.Status, activity.Description, valueOrDash(activity.Cause))
+	}
+}
+
 func (s *ecsServiceStatus) writeRunningTasks(writer io.Writer) {
 	shouldShowHTTPHealth := anyTasksInAnyTargetGroup(s.DesiredRunningTasks, s.TargetHealthDescriptions)
 	shouldShowCapacityProvider := isCapacityProvidersEnabled(s.DesiredRunningTasks)
@@ -18,7 +18,10 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 )
 
-const fmtAppRunnerSvcLogGroupName = "/aws/apprunner/%s/%s/service"
+const (
+	fmtAppRunnerSvcLogGroupName = "/aws/apprunner/%s/%s/service"
+	maxScalingActivities        = 5
+)
 
 type targetHealthGetter interface {
 	TargetsHealth(targetGroupARN string) ([]*elbv2.TargetHealth, error)
@@ -46,6 +49,11 @@ type autoscalingAlarmNamesGetter interface {
 	ECSServiceAlarmNames(cluster, service string) ([]string, error)
 }
 
+type autoscalingActivityGetter interface {
+	ECSServiceScalableTarget(cluster, service string) (*aas.ScalableTarget, error)
+	ECSServiceScalingActivities(cluster, service string, limit int) ([]aas.ScalingActivity, error)
+}
+
 type ecsStatusDescriber struct {
 	app string
 	env string
@@ -55,6 +63,7 @@ type ecsStatusDescriber struct {
 	ecsSvcGetter       ecsServiceGetter
 	cwSvcGetter        alarmStatusGetter
 	aasSvcGetter       autoscalingAlarmNamesGetter
+	scalingGetter      autoscalingActivityGetter
 	targetHealthGetter targetHealthGetter
 }
 
@@ -85,6 +94,7 @@ func NewECSStatusDescriber(opt *NewServiceStatusConfig) (*ecsStatusDescriber, er
 	if err != nil {
 		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
 	}
+	aasClient := aas.New(sess)
 	return &ecsStatusDescriber{
 		app:                opt.App,
 		env:                opt.Env,
@@ -92,7 +102,8 @@ func NewECSStatusDescriber(opt *NewServiceStatusConfig) (*ecsStatusDescriber, er
 		svcDescriber:       ecs.New(sess),
 		cwSvcGetter:        cloudwatch.New(sess),
 		ecsSvcGetter:       awsecs.New(sess),
-		aasSvcGetter:       aas.New(sess),
+		aasSvcGetter:       aasClient,
+		scalingGetter:      aasClient,
 		targetHealthGetter: elbv2.New(sess),
 	}, nil
 }
@@ -162,6 +173,10 @@ func (s *ecsStatusDescriber) Describe() (HumanJSONStringer, error) {
 		return nil, err
 	}
 	alarms = append(alarms, autoscalingAlarms...)
+	autoscaling, err := s.ecsServiceAutoscaling(svcDesc.ClusterName, svcDesc.Name)
+	if err != nil {
+		return nil, err
+	}
 
 	var tasksTargetHealth []taskTargetHealth
 	targetGroupsARN := service.TargetGroups()
@@ -185,6 +200,27 @@ func (s *ecsStatusDescriber) Describe() (HumanJSONStringer, error) {
 		Alarms:                   alarms,
 		StoppedTasks:             stoppedTaskStatus,
 		TargetHealthDescriptions: tasksTargetHealth,
+		Autoscaling:              autoscaling,
+	}, nil
+}
+
+// ecsServiceAutoscaling returns the capacity bounds and the most recent scaling activities of the ECS service.
+// If the service doesn't auto scale, it returns nil.
+func (s *ecsStatusDescriber) ecsServiceAutoscaling(cluster, service string) (*ecsServiceAutoscaling, error) {
+	target, err := s.scalingGetter.ECSServiceScalableTarget(cluster, service)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve auto scaling target for ECS service %s/%s: %w", cluster, service, err)
+	}
+	if target == nil {
+		return nil, nil
+	}
+	activities, err := s.scalingGetter.ECSServiceScalingActivities(cluster, service, maxScalingActivities)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve auto scaling activities for ECS service %s/%s: %w", cluster, service, err)
+	}
+	return &ecsServiceAutoscaling{
+		ScalableTarget: *target,
+		Activities:     activities,
 	}, nil
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
 	elbv2api "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
@@ -29,6 +30,7 @@ type serviceStatusDescriberMocks struct {
 	alarmStatusGetter     *mocks.MockalarmStatusGetter
 	serviceDescriber      *mocks.MockserviceDescriber
 	aas                   *mocks.MockautoscalingAlarmNamesGetter
+	scaling               *mocks.MockautoscalingActivityGetter
 	logGetter             *mocks.MocklogGetter
 	targetHealthGetter    *mocks.MocktargetHealthGetter
 }
@@ -154,8 +156,38 @@ func TestServiceStatus_Describe(t *testing.T) {
 
 			wantedError: fmt.Errorf("get auto scaling CloudWatch alarms: some error"),
 		},
+		"errors if failed to get the auto scaling target": {
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				gomock.InOrder(
+					m.serviceDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockServiceDesc, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&awsecs.Service{}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatus(gomock.Any()).Return(nil, nil),
+					m.scaling.EXPECT().ECSServiceScalableTarget(mockCluster, mockService).Return(nil, mockError),
+				)
+			},
+
+			wantedError: fmt.Errorf("retrieve auto scaling target for ECS service mockCluster/mockService: some error"),
+		},
+		"errors if failed to get the auto scaling activities": {
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				gomock.InOrder(
+					m.serviceDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockServiceDesc, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&awsecs.Service{}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatus(gomock.Any()).Return(nil, nil),
+					m.scaling.EXPECT().ECSServiceScalableTarget(mockCluster, mockService).Return(&aas.ScalableTarget{}, nil),
+					m.scaling.EXPECT().ECSServiceScalingActivities(mockCluster, mockService, 5).Return(nil, mockError),
+				)
+			},
+
+			wantedError: fmt.Errorf("retrieve auto scaling activities for ECS service mockCluster/mockService: some error"),
+		},
 		"do not error out if failed to get a service's target group health": {
 			setupMocks: func(m serviceStatusDescriberMocks) {
+				m.scaling.EXPECT().ECSServiceScalableTarget(mockCluster, mockService).Return(nil, nil)
 				gomock.InOrder(
 					m.serviceDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockServiceDesc, nil),
 					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&awsecs.Service{
@@ -199,6 +231,7 @@ func TestServiceStatus_Describe(t *testing.T) {
 		},
 		"retrieve all target health information in service": {
 			setupMocks: func(m serviceStatusDescriberMocks) {
+				m.scaling.EXPECT().ECSServiceScalableTarget(mockCluster, mockService).Return(nil, nil)
 				gomock.InOrder(
 					m.serviceDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
 						ClusterName: mockCluster,
@@ -424,6 +457,18 @@ func TestServiceStatus_Describe(t *testing.T) {
 							UpdatedTimes: updateTime,
 						},
 					}, nil),
+					m.scaling.EXPECT().ECSServiceScalableTarget(mockCluster, mockService).Return(&aas.ScalableTarget{
+						MinCapacity: 1,
+						MaxCapacity: 10,
+					}, nil),
+					m.scaling.EXPECT().ECSServiceScalingActivities(mockCluster, mockService, 5).Return([]aas.ScalingActivity{
+						{
+							Description: "Setting desired count to 1.",
+							Cause:       "monitor alarm mockAlarm2 in state OK triggered policy mockPolicy",
+							Status:      "Successful",
+							StartTime:   updateTime,
+						},
+					}, nil),
 				)
 			},
 
@@ -459,6 +504,20 @@ func TestServiceStatus_Describe(t *testing.T) {
 						UpdatedTimes: updateTime,
 					},
 				},
+				Autoscaling: &ecsServiceAutoscaling{
+					ScalableTarget: aas.ScalableTarget{
+						MinCapacity: 1,
+						MaxCapacity: 10,
+					},
+					Activities: []aas.ScalingActivity{
+						{
+							Description: "Setting desired count to 1.",
+							Cause:       "monitor alarm mockAlarm2 in state OK triggered policy mockPolicy",
+							Status:      "Successful",
+							StartTime:   updateTime,
+						},
+					},
+				},
 				DesiredRunningTasks: []awsecs.TaskStatus{
 					{
 						Health:     "HEALTHY",
@@ -493,12 +552,14 @@ func TestServiceStatus_Describe(t *testing.T) {
 			mockcwSvc := mocks.NewMockalarmStatusGetter(ctrl)
 			mockSvcDescriber := mocks.NewMockserviceDescriber(ctrl)
 			mockaasClient := mocks.NewMockautoscalingAlarmNamesGetter(ctrl)
+			mockScalingGetter := mocks.NewMockautoscalingActivityGetter(ctrl)
 			mockTargetHealthGetter := mocks.NewMocktargetHealthGetter(ctrl)
 			mocks := serviceStatusDescriberMocks{
 				ecsServiceGetter:   mockecsSvc,
 				alarmStatusGetter:  mockcwSvc,
 				serviceDescriber:   mockSvcDescriber,
 				aas:                mockaasClient,
+				scaling:            mockScalingGetter,
 				targetHealthGetter: mockTargetHealthGetter,
 			}
 
@@ -512,6 +573,7 @@ func TestServiceStatus_Describe(t *testing.T) {
 				ecsSvcGetter:       mockecsSvc,
 				svcDescriber:       mockSvcDescriber,
 				aasSvcGetter:       mockaasClient,
+				scalingGetter:      mockScalingGetter,
 				targetHealthGetter: mockTargetHealthGetter,
 			}
 
@@ -7,6 +7,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
@@ -535,6 +538,128 @@ Tasks
   Running   ░░░░░░░░░░  0/0 desired tasks are running
 `,
 			json: `{"Service":{"desiredCount":0,"runningCount":0,"status":"ACTIVE","deployments":[{"id":"id-4","desiredCount":0,"runningCount":0,"updatedAt":"0001-01-01T00:00:00Z","launchType":"","taskDefinition":"arn:aws:ecs:us-east-1:000000000000:task-definition/some-task-def:6","status":"PRIMARY"}],"lastDeploymentAt":"0001-01-01T00:00:00Z","taskDefinition":""},"tasks":[],"alarms":null,"stoppedTasks":null,"targetHealthDescriptions":null}
+`,
+		},
+		"with auto scaling activities": {
+			desc: &ecsServiceStatus{
+				Service: awsecs.ServiceStatus{
+					DesiredCount: 3,
+					RunningCount: 3,
+					Status:       "ACTIVE",
+				},
+				Autoscaling: &ecsServiceAutoscaling{
+					ScalableTarget: aas.ScalableTarget{
+						MinCapacity: 1,
+						MaxCapacity: 10,
+					},
+					Activities: []aas.ScalingActivity{
+						{
+							Description: "Setting desired count to 3.",
+							Cause:       "monitor alarm CPUHigh in state ALARM",
+							Status:      "Successful",
+							StartTime:   stoppedTime,
+						},
+						{
+							Description: "Setting desired count to 1.",
+							Status:      "Successful",
+							StartTime:   updateTime,
+						},
+					},
+				},
+			},
+			human: `Task Summary
+
+  Running   ██████████  3/3 desired tasks are running
+
+Auto Scaling
+
+  Desired   3
+  Minimum   1
+  Maximum   10
+
+  Started At         Status      Description                  Cause
+  ----------         ------      -----------                  -----
+  2 months from now  Successful  Setting desired count to 3.  monitor alarm CPUHigh in state
+                                                               ALARM
+  2 months from now  Successful  Setting desired count to 1.  -
+`,
+			json: `{"Service":{"desiredCount":3,"runningCount":3,"status":"ACTIVE","deployments":null,"lastDeploymentAt":"0001-01-01T00:00:00Z","taskDefinition":""},"tasks":null,"alarms":null,"stoppedTasks":null,"targetHealthDescriptions":null,"autoscaling":{"minCapacity":1,"maxCapacity":10,"activities":[{"description":"Setting desired count to 3.","cause":"monitor alarm CPUHigh in state ALARM","status":"Successful","startTime":"2020-03-13T20:00:30Z","endTime":"0001-01-01T00:00:00Z"},{"description":"Setting desired count to 1.","cause":"","status":"Successful","startTime":"2020-03-13T19:50:30Z","endTime":"0001-01-01T00:00:00Z"}]}}
+`,
+		},
+		"with diagnostics of stopped tasks": {
+			desc: &ecsServiceStatus{
+				Service: awsecs.ServiceStatus{
+					DesiredCount: 1,
+					RunningCount: 0,
+					Status:       "ACTIVE",
+				},
+				StoppedTasks: []awsecs.TaskStatus{
+					{
+						LastStatus:    "STOPPED",
+						ID:            "S111111111111",
+						StoppedAt:     stoppedTime.Add(-time.Hour),
+						StoppedReason: "CannotPullContainerError: pull image manifest has been retried 5 time(s)",
+						StopCode:      "TaskFailedToStart",
+						StoppedContainers: []awsecs.StoppedContainer{
+							{
+								Name: "web",
+							},
+						},
+					},
+					{
+						LastStatus:    "STOPPED",
+						ID:            "S2222222222222",
+						StoppedAt:     stoppedTime,
+						StoppedReason: "Essential container in task exited",
+						StopCode:      "EssentialContainerExited",
+						StoppedContainers: []awsecs.StoppedContainer{
+							{
+								Name:     "web",
+								ExitCode: aws.Int64(137),
+								Reason:   "OutOfMemoryError: Container killed due to memory usage",
+							},
+							{
+								Name:     "nginx",
+								ExitCode: aws.Int64(0),
+							},
+						},
+					},
+					{
+						LastStatus:    "STOPPED",
+						ID:            "S3333333333333",
+						StoppedAt:     stoppedTime,
+						StoppedReason: "Scaling activity initiated by deployment",
+					},
+				},
+			},
+			human: `Task Summary
+
+  Running   ░░░░░░░░░░  0/1 desired tasks are running
+
+Stopped Tasks
+
+  Reason                          Task Count  Sample Task IDs
+  ------                          ----------  ---------------
+  CannotPullContainerError: pull  1           S1111111
+   image manifest has been retri              
+  ed 5 time(s)                                
+  Essential container in task ex  1           S2222222
+  ited                                        
+  Scaling activity initiated by   1           S3333333
+  deployment                                  
+
+Stopped Task Diagnostics
+
+  ID        Stopped At         Stop Code                 Container   Exit Code   Reason
+  --        ----------         ---------                 ---------   ---------   ------
+  S2222222  2 months from now  EssentialContainerExited  web         137         OutOfMemoryError: Container ki
+                                                                                 lled due to memory usage
+                                                         nginx       0           -
+  S1111111  2 months from now  TaskFailedToStart         web         -           CannotPullContainerError: pull
+                                                                                  image manifest has been retri
+                                                                                 ed 5 time(s)
+`,
+			json: `{"Service":{"desiredCount":1,"runningCount":0,"status":"ACTIVE","deployments":null,"lastDeploymentAt":"0001-01-01T00:00:00Z","taskDefinition":""},"tasks":null,"alarms":null,"stoppedTasks":[{"health":"","id":"S111111111111","images":null,"lastStatus":"STOPPED","startedAt":"0001-01-01T00:00:00Z","stoppedAt":"2020-03-13T19:00:30Z","stoppedReason":"CannotPullContainerError: pull image manifest has been retried 5 time(s)","capacityProvider":"","taskDefinitionARN":"","stopCode":"TaskFailedToStart","stoppedContainers":[{"name":"web"}]},{"health":"","id":"S2222222222222","images":null,"lastStatus":"STOPPED","startedAt":"0001-01-01T00:00:00Z","stoppedAt":"2020-03-13T20:00:30Z","stoppedReason":"Essential container in task exited","capacityProvider":"","taskDefinitionARN":"","stopCode":"EssentialContainerExited","stoppedContainers":[{"name":"web","exitCode":137,"reason":"OutOfMemoryError: Container killed due to memory usage"},{"name":"nginx","exitCode":0}]},{"health":"","id":"S3333333333333","images":null,"lastStatus":"STOPPED","startedAt":"0001-01-01T00:00:00Z","stoppedAt":"2020-03-13T20:00:30Z","stoppedReason":"Scaling activity initiated by deployment","capacityProvider":"","taskDefinitionARN":""}],"targetHealthDescriptions":null}
 `,
 		},
 	}
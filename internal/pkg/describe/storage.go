@@ -0,0 +1,243 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const envOutputManagedFileSystemID = "ManagedFileSystemID"
+
+type addonsOutputsDescriber interface {
+	AddonsOutputs() (map[string]string, error)
+}
+
+// StorageDescriber retrieves information about the storage addons of a workload.
+type StorageDescriber struct {
+	app      string
+	workload string
+
+	store                     DeployedEnvServicesLister
+	initAddonsOutputDescriber func(env string) (addonsOutputsDescriber, error)
+	initEnvDescriber          func(env string) (envDescriber, error)
+}
+
+// NewStorageDescriber instantiates a describer for the storage addons of a workload.
+func NewStorageDescriber(opt NewServiceConfig) (*StorageDescriber, error) {
+	stackCache := stack.NewCache() // Shared by the describers of every environment.
+	return &StorageDescriber{
+		app:      opt.App,
+		workload: opt.Svc,
+		store:    opt.DeployStore,
+		initAddonsOutputDescriber: func(env string) (addonsOutputsDescriber, error) {
+			describer, err := newServiceStackDescriber(NewServiceConfig{
+				App:         opt.App,
+				Svc:         opt.Svc,
+				ConfigStore: opt.ConfigStore,
+				stackCache:  stackCache,
+			}, env)
+			if err != nil {
+				return nil, err
+			}
+			return describer, nil
+		},
+		initEnvDescriber: func(env string) (envDescriber, error) {
+			describer, err := NewEnvDescriber(NewEnvDescriberConfig{
+				App:         opt.App,
+				Env:         env,
+				ConfigStore: opt.ConfigStore,
+				stackCache:  stackCache,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return describer, nil
+		},
+	}, nil
+}
+
+// Describe returns the endpoints of the Aurora clusters with readers of the workload in each deployed environment,
+// and the file system shared by the workloads of each deployed environment.
+func (d *StorageDescriber) Describe() (HumanJSONStringer, error) {
+	environments, err := d.store.ListEnvironmentsDeployedTo(d.app, d.workload)
+	if err != nil {
+		return nil, fmt.Errorf("list deployed environments for application %s: %w", d.app, err)
+	}
+	endpointsByEnv := make([][]*AuroraEndpoints, len(environments))
+	fileSystemByEnv := make([]*SharedFileSystem, len(environments))
+	err = describeEnvs(environments, func(i int, env string) error {
+		describer, err := d.initAddonsOutputDescriber(env)
+		if err != nil {
+			return err
+		}
+		outputs, err := describer.AddonsOutputs()
+		if err != nil {
+			return fmt.Errorf("retrieve addons outputs of %s in environment %s: %w", d.workload, env, err)
+		}
+		endpointsByEnv[i] = auroraEndpoints(env, outputs)
+		fileSystem, err := d.sharedFileSystem(env)
+		if err != nil {
+			return err
+		}
+		fileSystemByEnv[i] = fileSystem
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var endpoints []*AuroraEndpoints
+	for _, envEndpoints := range endpointsByEnv {
+		endpoints = append(endpoints, envEndpoints...)
+	}
+	var fileSystems []*SharedFileSystem
+	for _, fileSystem := range fileSystemByEnv {
+		if fileSystem != nil {
+			fileSystems = append(fileSystems, fileSystem)
+		}
+	}
+	return &storageDesc{
+		Workload:          d.workload,
+		Aurora:            endpoints,
+		SharedFileSystems: fileSystems,
+	}, nil
+}
+
+// sharedFileSystem returns the file system managed by the environment along with the workloads that mount it,
+// or nil if the environment does not manage a file system.
+func (d *StorageDescriber) sharedFileSystem(env string) (*SharedFileSystem, error) {
+	describer, err := d.initEnvDescriber(env)
+	if err != nil {
+		return nil, err
+	}
+	outputs, err := describer.Outputs()
+	if err != nil {
+		return nil, fmt.Errorf("get outputs of environment %s: %w", env, err)
+	}
+	id, ok := outputs[envOutputManagedFileSystemID]
+	if !ok {
+		return nil, nil
+	}
+	params, err := describer.Params()
+	if err != nil {
+		return nil, fmt.Errorf("get parameters of environment %s: %w", env, err)
+	}
+	var workloads []string
+	for _, wkld := range strings.Split(params[template.EFSFeatureName], ",") {
+		if wkld = strings.TrimSpace(wkld); wkld != "" {
+			workloads = append(workloads, wkld)
+		}
+	}
+	sort.Strings(workloads)
+	return &SharedFileSystem{
+		Environment:  env,
+		FileSystemID: id,
+		MountedBy:    workloads,
+	}, nil
+}
+
+// auroraEndpoints returns the endpoints of each Aurora cluster that outputs a writer endpoint in the addons stack,
+// sorted by cluster.
+func auroraEndpoints(env string, outputs map[string]string) []*AuroraEndpoints {
+	var endpoints []*AuroraEndpoints
+	for name, writer := range outputs {
+		cluster := strings.TrimSuffix(name, addon.AuroraWriterEndpointOutputSuffix)
+		if cluster == name || cluster == "" {
+			continue
+		}
+		endpoints = append(endpoints, &AuroraEndpoints{
+			Environment: env,
+			Cluster:     cluster,
+			Writer:      writer,
+			Reader:      outputs[cluster+addon.AuroraReaderEndpointOutputSuffix],
+			Port:        outputs[cluster+addon.AuroraPortOutputSuffix],
+		})
+	}
+	sort.Slice(endpoints, func(i, j int) bool {
+		return endpoints[i].Cluster < endpoints[j].Cluster
+	})
+	return endpoints
+}
+
+// AuroraEndpoints contains the endpoints of an Aurora cluster in an environment.
+type AuroraEndpoints struct {
+	Environment string `json:"environment"`
+	Cluster     string `json:"cluster"`
+	Writer      string `json:"writerEndpoint"`
+	Reader      string `json:"readerEndpoint,omitempty"`
+	Port        string `json:"port,omitempty"`
+}
+
+// SharedFileSystem contains the EFS file system managed by an environment and the workloads that mount it.
+type SharedFileSystem struct {
+	Environment  string   `json:"environment"`
+	FileSystemID string   `json:"fileSystemID"`
+	MountedBy    []string `json:"mountedBy"`
+}
+
+// storageDesc contains the storage addons of a workload.
+type storageDesc struct {
+	Workload          string              `json:"workload"`
+	Aurora            []*AuroraEndpoints  `json:"aurora"`
+	SharedFileSystems []*SharedFileSystem `json:"sharedFileSystems,omitempty"`
+}
+
+// JSONString returns the stringified storageDesc struct with json format.
+func (s *storageDesc) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal storage description: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified storageDesc struct with human readable format.
+func (s *storageDesc) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("About\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", "Workload", s.Workload)
+	fmt.Fprint(writer, color.Bold.Sprint("\nAurora Endpoints\n\n"))
+	writer.Flush()
+	if len(s.Aurora) == 0 {
+		fmt.Fprintf(writer, "  %s\n", "No Aurora cluster with readers is deployed.")
+	} else {
+		headers := []string{"Environment", "Cluster", "Writer", "Reader", "Port"}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		var prevEnv string
+		for _, endpoints := range s.Aurora {
+			env := endpoints.Environment
+			if env == prevEnv {
+				env = dittoSymbol
+			}
+			prevEnv = endpoints.Environment
+			fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", env, endpoints.Cluster, endpoints.Writer, valueOrDash(endpoints.Reader), valueOrDash(endpoints.Port))
+		}
+	}
+	writer.Flush()
+	if len(s.SharedFileSystems) == 0 {
+		return b.String()
+	}
+	fmt.Fprint(writer, color.Bold.Sprint("\nShared File Systems\n\n"))
+	writer.Flush()
+	headers := []string{"Environment", "File System", "Mounted By"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, fs := range s.SharedFileSystems {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", fs.Environment, fs.FileSystemID, valueOrDash(strings.Join(fs.MountedBy, ", ")))
+	}
+	writer.Flush()
+	return b.String()
+}
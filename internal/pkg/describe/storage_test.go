@@ -0,0 +1,252 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type storageDescriberMocks struct {
+	storeSvc  *mocks.MockDeployedEnvServicesLister
+	describer map[string]*mocks.MockaddonsOutputsDescriber
+	envDescr  map[string]*mocks.MockenvDescriber
+}
+
+func TestStorageDescriber_Describe(t *testing.T) {
+	const (
+		testApp      = "phonetool"
+		testWorkload = "orders"
+	)
+	testCases := map[string]struct {
+		setupMocks func(mocks storageDescriberMocks)
+
+		wantedStorage *storageDesc
+		wantedError   error
+	}{
+		"return error if fail to list deployed environments": {
+			setupMocks: func(m storageDescriberMocks) {
+				m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testWorkload).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("list deployed environments for application phonetool: some error"),
+		},
+		"return error if fail to retrieve the addons outputs": {
+			setupMocks: func(m storageDescriberMocks) {
+				m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testWorkload).Return([]string{"test"}, nil)
+				m.describer["test"].EXPECT().AddonsOutputs().Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("retrieve addons outputs of orders in environment test: some error"),
+		},
+		"return error if fail to get the environment outputs": {
+			setupMocks: func(m storageDescriberMocks) {
+				m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testWorkload).Return([]string{"test"}, nil)
+				m.describer["test"].EXPECT().AddonsOutputs().Return(nil, nil)
+				m.envDescr["test"].EXPECT().Outputs().Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("get outputs of environment test: some error"),
+		},
+		"return error if fail to get the environment parameters": {
+			setupMocks: func(m storageDescriberMocks) {
+				m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testWorkload).Return([]string{"test"}, nil)
+				m.describer["test"].EXPECT().AddonsOutputs().Return(nil, nil)
+				m.envDescr["test"].EXPECT().Outputs().Return(map[string]string{
+					"ManagedFileSystemID": "fs-1234",
+				}, nil)
+				m.envDescr["test"].EXPECT().Params().Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("get parameters of environment test: some error"),
+		},
+		"success": {
+			setupMocks: func(m storageDescriberMocks) {
+				m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testWorkload).Return([]string{"test", "prod"}, nil)
+				m.describer["test"].EXPECT().AddonsOutputs().Return(nil, nil)
+				m.envDescr["test"].EXPECT().Outputs().Return(map[string]string{
+					"ManagedFileSystemID": "fs-1234",
+				}, nil)
+				m.envDescr["test"].EXPECT().Params().Return(map[string]string{
+					"EFSWorkloads": "orders,api,",
+				}, nil)
+				m.envDescr["prod"].EXPECT().Outputs().Return(map[string]string{}, nil)
+				m.describer["prod"].EXPECT().AddonsOutputs().Return(map[string]string{
+					"usersDBClusterWriterEndpoint":  "users.cluster-abc.us-west-2.rds.amazonaws.com",
+					"usersDBClusterReaderEndpoint":  "users.cluster-ro-abc.us-west-2.rds.amazonaws.com",
+					"usersDBClusterPort":            "3306",
+					"ordersDBClusterWriterEndpoint": "orders.cluster-def.us-west-2.rds.amazonaws.com",
+					"ordersTableName":               "phonetool-prod-orders",
+				}, nil)
+			},
+			wantedStorage: &storageDesc{
+				Workload: testWorkload,
+				Aurora: []*AuroraEndpoints{
+					{
+						Environment: "prod",
+						Cluster:     "ordersDBCluster",
+						Writer:      "orders.cluster-def.us-west-2.rds.amazonaws.com",
+					},
+					{
+						Environment: "prod",
+						Cluster:     "usersDBCluster",
+						Writer:      "users.cluster-abc.us-west-2.rds.amazonaws.com",
+						Reader:      "users.cluster-ro-abc.us-west-2.rds.amazonaws.com",
+						Port:        "3306",
+					},
+				},
+				SharedFileSystems: []*SharedFileSystem{
+					{
+						Environment:  "test",
+						FileSystemID: "fs-1234",
+						MountedBy:    []string{"api", "orders"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := storageDescriberMocks{
+				storeSvc: mocks.NewMockDeployedEnvServicesLister(ctrl),
+				describer: map[string]*mocks.MockaddonsOutputsDescriber{
+					"test": mocks.NewMockaddonsOutputsDescriber(ctrl),
+					"prod": mocks.NewMockaddonsOutputsDescriber(ctrl),
+				},
+				envDescr: map[string]*mocks.MockenvDescriber{
+					"test": mocks.NewMockenvDescriber(ctrl),
+					"prod": mocks.NewMockenvDescriber(ctrl),
+				},
+			}
+			tc.setupMocks(m)
+
+			d := &StorageDescriber{
+				app:      testApp,
+				workload: testWorkload,
+				store:    m.storeSvc,
+				initAddonsOutputDescriber: func(env string) (addonsOutputsDescriber, error) {
+					return m.describer[env], nil
+				},
+				initEnvDescriber: func(env string) (envDescriber, error) {
+					return m.envDescr[env], nil
+				},
+			}
+
+			// WHEN
+			actual, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStorage, actual)
+		})
+	}
+}
+
+func TestStorageDesc_String(t *testing.T) {
+	testCases := map[string]struct {
+		desc *storageDesc
+
+		wantedHumanString string
+		wantedJSONString  string
+	}{
+		"without Aurora clusters with readers": {
+			desc: &storageDesc{
+				Workload: "orders",
+			},
+			wantedHumanString: `About
+
+  Workload  orders
+
+Aurora Endpoints
+
+  No Aurora cluster with readers is deployed.
+`,
+			wantedJSONString: "{\"workload\":\"orders\",\"aurora\":null}\n",
+		},
+		"with Aurora clusters with readers": {
+			desc: &storageDesc{
+				Workload: "orders",
+				Aurora: []*AuroraEndpoints{
+					{
+						Environment: "test",
+						Cluster:     "ordersDBCluster",
+						Writer:      "orders.cluster-abc.rds.amazonaws.com",
+						Reader:      "orders.cluster-ro-abc.rds.amazonaws.com",
+						Port:        "5432",
+					},
+					{
+						Environment: "test",
+						Cluster:     "usersDBCluster",
+						Writer:      "users.cluster-abc.rds.amazonaws.com",
+					},
+				},
+			},
+			wantedHumanString: `About
+
+  Workload  orders
+
+Aurora Endpoints
+
+  Environment  Cluster          Writer                                Reader                                   Port
+  -----------  -------          ------                                ------                                   ----
+  test         ordersDBCluster  orders.cluster-abc.rds.amazonaws.com  orders.cluster-ro-abc.rds.amazonaws.com  5432
+    "          usersDBCluster   users.cluster-abc.rds.amazonaws.com   -                                        -
+`,
+			wantedJSONString: "{\"workload\":\"orders\",\"aurora\":[{\"environment\":\"test\",\"cluster\":\"ordersDBCluster\",\"writerEndpoint\":\"orders.cluster-abc.rds.amazonaws.com\",\"readerEndpoint\":\"orders.cluster-ro-abc.rds.amazonaws.com\",\"port\":\"5432\"},{\"environment\":\"test\",\"cluster\":\"usersDBCluster\",\"writerEndpoint\":\"users.cluster-abc.rds.amazonaws.com\"}]}\n",
+		},
+		"with shared file systems": {
+			desc: &storageDesc{
+				Workload: "orders",
+				SharedFileSystems: []*SharedFileSystem{
+					{
+						Environment:  "test",
+						FileSystemID: "fs-1234",
+						MountedBy:    []string{"api", "orders"},
+					},
+					{
+						Environment:  "prod",
+						FileSystemID: "fs-5678",
+					},
+				},
+			},
+			wantedHumanString: `About
+
+  Workload  orders
+
+Aurora Endpoints
+
+  No Aurora cluster with readers is deployed.
+
+Shared File Systems
+
+  Environment  File System  Mounted By
+  -----------  -----------  ----------
+  test         fs-1234      api, orders
+  prod         fs-5678      -
+`,
+			wantedJSONString: "{\"workload\":\"orders\",\"aurora\":null,\"sharedFileSystems\":[{\"environment\":\"test\",\"fileSystemID\":\"fs-1234\",\"mountedBy\":[\"api\",\"orders\"]},{\"environment\":\"prod\",\"fileSystemID\":\"fs-5678\",\"mountedBy\":null}]}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			human := tc.desc.HumanString()
+			json, err := tc.desc.JSONString()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHumanString, human)
+			require.Equal(t, tc.wantedJSONString, json)
+		})
+	}
+}
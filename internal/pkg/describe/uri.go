@@ -4,12 +4,17 @@
 package describe
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/dustin/go-humanize/english"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	describestack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 )
 
@@ -20,15 +25,96 @@ const (
 	URIAccessTypeInternet
 	URIAccessTypeInternal
 	URIAccessTypeServiceDiscovery
+	URIAccessTypeQueue
+)
+
+// String returns the name of the access type.
+func (t URIAccessType) String() string {
+	switch t {
+	case URIAccessTypeInternet:
+		return "internet"
+	case URIAccessTypeInternal:
+		return "internal"
+	case URIAccessTypeServiceDiscovery:
+		return "serviceDiscovery"
+	case URIAccessTypeQueue:
+		return "queue"
+	default:
+		return "none"
+	}
+}
+
+// MarshalJSON serializes the access type as its name.
+func (t URIAccessType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// Protocols of the routes to a service.
+const (
+	routeProtocolHTTP  = "http"
+	routeProtocolHTTPS = "https"
+	routeProtocolTCP   = "tcp"
+
+	routePortHTTP  = "80"
+	routePortHTTPS = "443"
 )
 
 var (
 	fmtSvcDiscoveryEndpointWithPort = "%s.%s:%s" // Format string of the form {svc}.{endpoint}:{port}
+	// Format string of the form {svc}.{endpoint}:{port} (SRV), for services that are only registered with SRV records.
+	fmtSvcDiscoverySRVEndpointWithPort = "%s.%s:%s (SRV)"
+	fmtNLBAddressWithProtocol          = "%s:%s (%s)" // Format string of the form {dns}:{port} ({protocol}).
+
+	serviceDiscoveryRecordTypeSRV      = "SRV"
+	defaultServiceDiscoveryRecordTypes = "A,SRV"
 )
 
 type URI struct {
 	URI        string
 	AccessType URIAccessType
+	Routes     []Route // Empty if the service is not reachable through a load balancer.
+}
+
+// Route is a group of endpoints of a service that are reached with the same protocol, path and port.
+type Route struct {
+	AccessType URIAccessType
+	Protocol   string // One of "http", "https", "tcp", "udp" or "tcp_udp".
+	DNSNames   []string
+	Path       string // Empty unless the protocol is HTTP or HTTPS.
+	Port       string
+	URI        string // Human readable series of the endpoints.
+
+	// Primary and Status are only set for App Runner services, which can be reached through custom domains,
+	// and for Load Balanced Web Services, which can be reached through a CloudFront distribution.
+	Primary bool   // True if the route is the one to advertise, such as an active custom domain.
+	Status  string // The association status of a custom domain, empty for the default domain.
+	Origin  bool   // True if the route reaches the origin of a CloudFront distribution directly.
+
+	// TargetGroup is the ARN of the target group that the listener rule of the route forwards traffic to.
+	// It's only set for Backend Services behind an internal application load balancer.
+	TargetGroup string
+}
+
+// Endpoints returns the URLs and "host:port" addresses that the URI is made of.
+// Addresses that can only be resolved with SRV records are omitted since they don't have a fixed port,
+// and so are addresses that only accept UDP datagrams.
+func (u URI) Endpoints() []string {
+	switch u.AccessType {
+	case URIAccessTypeInternet, URIAccessTypeInternal, URIAccessTypeServiceDiscovery:
+	default:
+		return nil
+	}
+	// The URI is an English series of endpoints, such as "a", "a or b", or "a, b, or c".
+	series := strings.ReplaceAll(u.URI, ", or ", ", ")
+	series = strings.ReplaceAll(series, " or ", ", ")
+	var endpoints []string
+	for _, endpoint := range strings.Split(series, ", ") {
+		if endpoint == "" || strings.HasSuffix(endpoint, " (SRV)") || strings.HasSuffix(endpoint, fmt.Sprintf(" (%s)", elbv2.ProtocolEnumUdp)) {
+			continue
+		}
+		endpoints = append(endpoints, strings.TrimSuffix(endpoint, fmt.Sprintf(" (%s)", elbv2.ProtocolEnumTcpUdp)))
+	}
+	return endpoints
 }
 
 // ReachableService represents a service describer that has an endpoint.
@@ -54,6 +140,8 @@ func NewReachableService(app, svc string, store ConfigStoreSvc) (ReachableServic
 		return NewRDWebServiceDescriber(in)
 	case manifest.BackendServiceType:
 		return NewBackendServiceDescriber(in)
+	case manifest.WorkerServiceType:
+		return NewWorkerServiceDescriber(in)
 	default:
 		return nil, fmt.Errorf("service %s is of type %s which cannot be reached over the network", svc, cfg.Type)
 	}
@@ -84,6 +172,7 @@ func (d *LBWebServiceDescriber) URI(envName string) (URI, error) {
 	}
 
 	var uri LBWebServiceURI
+	var routes []Route
 	if albEnabled {
 		albDescr := &albDescriber{
 			svc:             d.svc,
@@ -98,33 +187,73 @@ func (d *LBWebServiceDescriber) URI(envName string) (URI, error) {
 			return URI{}, err
 		}
 		uri.albURI = albURI
+		albRoute := albURI.route(URIAccessTypeInternet)
+		cdnURI, err := d.cdnURI(envName, envDescr, albURI)
+		if err != nil {
+			return URI{}, err
+		}
+		if cdnURI != nil {
+			uri.cdnURI = cdnURI
+			cdnRoute := cdnURI.route(URIAccessTypeInternet)
+			cdnRoute.Primary = true
+			albRoute.Origin = true
+			routes = append(routes, cdnRoute)
+		}
+		routes = append(routes, albRoute)
 	}
 
 	if nlbEnabled {
-		nlbURI, err := d.nlbURI(envName, svcDescr, envDescr)
+		nlbURI, err := d.nlbURI(envName, svcDescr, envDescr, resources)
 		if err != nil {
 			return URI{}, err
 		}
 		uri.nlbURI = nlbURI
+		routes = append(routes, nlbURI.routes(URIAccessTypeInternet)...)
 	}
 
 	return URI{
 		URI:        uri.String(),
 		AccessType: URIAccessTypeInternet,
+		Routes:     routes,
 	}, nil
 }
 
-func (d *LBWebServiceDescriber) nlbURI(envName string, svcDescr ecsDescriber, envDescr envDescriber) (nlbURI, error) {
+// cdnURI returns the URI of the service through the CloudFront distribution in front of the public load balancer
+// of the environment, or nil if the environment doesn't have one.
+func (d *LBWebServiceDescriber) cdnURI(envName string, envDescr envDescriber, origin albURI) (*albURI, error) {
+	envOutputs, err := envDescr.Outputs()
+	if err != nil {
+		return nil, fmt.Errorf("get stack outputs for environment %s: %w", envName, err)
+	}
+	domain, ok := envOutputs[envOutputCloudFrontDomainName]
+	if !ok || domain == "" {
+		return nil, nil
+	}
+	return &albURI{
+		HTTPS:    true, // CloudFront serves its default domain over HTTPS regardless of the origin's protocol.
+		DNSNames: []string{domain},
+		Path:     origin.Path,
+	}, nil
+}
+
+func (d *LBWebServiceDescriber) nlbURI(envName string, svcDescr ecsDescriber, envDescr envDescriber, resources []*describestack.Resource) (nlbURI, error) {
 	svcParams, err := svcDescr.Params()
 	if err != nil {
 		return nlbURI{}, fmt.Errorf("get stack parameters for service %s: %w", d.svc, err)
 	}
-	port, ok := svcParams[stack.LBWebServiceNLBPortParamKey]
-	if !ok {
-		return nlbURI{}, nil
+	listeners, err := d.nlbListeners(envName, resources)
+	if err != nil {
+		return nlbURI{}, err
+	}
+	if len(listeners) == 0 {
+		port, ok := svcParams[stack.LBWebServiceNLBPortParamKey]
+		if !ok {
+			return nlbURI{}, nil
+		}
+		listeners = []nlbListener{{Port: port}}
 	}
 	uri := nlbURI{
-		Port: port,
+		Listeners: listeners,
 	}
 	dnsDelegated, ok := svcParams[stack.LBWebServiceDNSDelegatedParamKey]
 	if !ok || dnsDelegated != "true" {
@@ -149,6 +278,35 @@ func (d *LBWebServiceDescriber) nlbURI(envName string, svcDescr ecsDescriber, en
 	return uri, nil
 }
 
+// nlbListeners returns the port and protocol of each listener of the network load balancer in the service stack.
+func (d *LBWebServiceDescriber) nlbListeners(envName string, resources []*describestack.Resource) ([]nlbListener, error) {
+	var arns []string
+	for _, resource := range resources {
+		if resource.Type == svcStackResourceListenerResourceType && strings.HasPrefix(resource.LogicalID, svcStackResourceNLBListenerLogicalIDPrefix) {
+			arns = append(arns, resource.PhysicalID)
+		}
+	}
+	if len(arns) == 0 {
+		return nil, nil
+	}
+	lbDescr, err := d.initLBDescriber(envName)
+	if err != nil {
+		return nil, err
+	}
+	out, err := lbDescr.Listeners(arns...)
+	if err != nil {
+		return nil, fmt.Errorf("get network load balancer listeners for service %s: %w", d.svc, err)
+	}
+	listeners := make([]nlbListener, len(out))
+	for i, listener := range out {
+		listeners[i] = nlbListener{
+			Port:     strconv.FormatInt(listener.Port, 10),
+			Protocol: listener.Protocol,
+		}
+	}
+	return listeners, nil
+}
+
 // URI returns the service discovery namespace and is used to make
 // BackendServiceDescriber have the same signature as WebServiceDescriber.
 func (d *BackendServiceDescriber) URI(envName string) (URI, error) {
@@ -174,6 +332,18 @@ func (d *BackendServiceDescriber) URI(envName string) (URI, error) {
 				initLBDescriber: d.initLBDescriber,
 				envDNSNameKey:   envOutputInternalLoadBalancerDNSName,
 			}
+			routes, uris, err := albDescr.ruleRoutes(resources, URIAccessTypeInternal)
+			if err != nil {
+				return URI{}, err
+			}
+			if len(routes) != 0 {
+				return URI{
+					URI:        english.OxfordWordSeries(uris, "or"),
+					AccessType: URIAccessTypeInternal,
+					Routes:     routes,
+				}, nil
+			}
+			// Fall back to the path of the service for stacks without listener rules that forward traffic.
 			albURI, err := albDescr.uri()
 			if err != nil {
 				return URI{}, err
@@ -184,6 +354,7 @@ func (d *BackendServiceDescriber) URI(envName string) (URI, error) {
 			return URI{
 				URI:        english.OxfordWordSeries(albURI.strings(), "or"),
 				AccessType: URIAccessTypeInternal,
+				Routes:     []Route{albURI.route(URIAccessTypeInternal)},
 			}, nil
 		}
 	}
@@ -203,10 +374,15 @@ func (d *BackendServiceDescriber) URI(envName string) (URI, error) {
 	if err != nil {
 		return URI{}, fmt.Errorf("retrieve service discovery endpoint for environment %s: %w", envName, err)
 	}
+	recordTypes, err := serviceDiscoveryRecordTypes(svcDescr)
+	if err != nil {
+		return URI{}, err
+	}
 	s := serviceDiscovery{
-		Service:  d.svc,
-		Port:     port,
-		Endpoint: endpoint,
+		Service:     d.svc,
+		Port:        port,
+		Endpoint:    endpoint,
+		RecordTypes: recordTypes,
 	}
 	return URI{
 		URI:        s.String(),
@@ -281,6 +457,88 @@ func (d *albDescriber) uri() (albURI, error) {
 	}, nil
 }
 
+// ruleRoutes returns a route for each path of each listener rule in the service stack that forwards traffic
+// to a target group, along with the distinct URIs of the routes.
+func (d *albDescriber) ruleRoutes(svcResources []*describestack.Resource, accessType URIAccessType) (routes []Route, uris []string, err error) {
+	var ruleARNs []string
+	httpsRules := make(map[string]bool)
+	for _, resource := range svcResources {
+		if resource.Type != svcStackResourceListenerRuleResourceType || resource.PhysicalID == "" {
+			continue
+		}
+		ruleARNs = append(ruleARNs, resource.PhysicalID)
+		httpsRules[resource.PhysicalID] = resource.LogicalID == svcStackResourceHTTPSListenerRuleLogicalID
+	}
+	if len(ruleARNs) == 0 {
+		return nil, nil, nil
+	}
+	lbDescr, err := d.initLBDescriber(d.env)
+	if err != nil {
+		return nil, nil, err
+	}
+	rules, err := lbDescr.ListenerRules(ruleARNs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get listener rules of service %s: %w", d.svc, err)
+	}
+	seen := make(map[string]bool)
+	for _, rule := range rules {
+		if len(rule.TargetGroupARNs) == 0 {
+			// The rule redirects or responds to requests, such as the HTTP to HTTPS redirect.
+			continue
+		}
+		uri := albURI{
+			HTTPS:    httpsRules[rule.ARN],
+			DNSNames: rule.HostHeaders,
+		}
+		if len(uri.DNSNames) == 0 {
+			envURI, err := d.envDNSName("")
+			if err != nil {
+				return nil, nil, err
+			}
+			uri.DNSNames = envURI.DNSNames
+		}
+		if !uri.HTTPS && len(uri.DNSNames) > 1 {
+			uri = d.bestEffortRemoveEnvDNSName(uri)
+		}
+		for _, path := range rulePaths(rule.PathPatterns) {
+			uri.Path = path
+			route := uri.route(accessType)
+			route.TargetGroup = rule.TargetGroupARNs[0]
+			routes = append(routes, route)
+			for _, s := range uri.strings() {
+				if !seen[s] {
+					seen[s] = true
+					uris = append(uris, s)
+				}
+			}
+		}
+	}
+	return routes, uris, nil
+}
+
+// rulePaths returns the distinct paths matched by the path patterns of a listener rule, in the format of the
+// rule path parameter of a service: "/" for the root, and the path without leading slash otherwise.
+// For example, the patterns "/api" and "/api/*" both match the path "api".
+func rulePaths(patterns []string) []string {
+	if len(patterns) == 0 {
+		return []string{"/"}
+	}
+	var paths []string
+	seen := make(map[string]bool)
+	for _, pattern := range patterns {
+		path := strings.Trim(strings.TrimSuffix(pattern, "*"), "/")
+		if path == "" {
+			path = "/"
+		}
+		if seen[path] {
+			continue
+		}
+		seen[path] = true
+		paths = append(paths, path)
+	}
+	return paths
+}
+
 func (d *albDescriber) bestEffortRemoveEnvDNSName(albURI albURI) albURI {
 	envOutputs, err := d.envDescriber.Outputs()
 	if err != nil {
@@ -296,7 +554,46 @@ func (d *albDescriber) bestEffortRemoveEnvDNSName(albURI albURI) albURI {
 	return albURI
 }
 
+// URI returns the URLs of the SQS queues that the worker service polls in the environment.
+func (d *WorkerServiceDescriber) URI(envName string) (URI, error) {
+	svcDescr, err := d.initECSDescriber(envName)
+	if err != nil {
+		return URI{}, err
+	}
+	urls, err := workerQueueURLs(svcDescr)
+	if err != nil {
+		return URI{}, fmt.Errorf("get queues of service %s in environment %s: %w", d.svc, envName, err)
+	}
+	if len(urls) == 0 {
+		return URI{
+			URI:        blankQueueURI,
+			AccessType: URIAccessTypeNone,
+		}, nil
+	}
+	return URI{
+		URI:        strings.Join(urls, ", "),
+		AccessType: URIAccessTypeQueue,
+	}, nil
+}
+
+// workerQueueURLs returns the URLs of the events queues in the worker service stack, excluding the dead-letter queues.
+func workerQueueURLs(svcDescr ecsDescriber) ([]string, error) {
+	resources, err := svcDescr.ServiceStackResources()
+	if err != nil {
+		return nil, err
+	}
+	var urls []string
+	for _, resource := range resources {
+		// The physical ID of an SQS queue is its URL.
+		if resource.Type == svcStackResourceQueueResourceType && strings.HasSuffix(resource.LogicalID, svcStackResourceEventsQueueLogicalIDSuffix) {
+			urls = append(urls, resource.PhysicalID)
+		}
+	}
+	return urls, nil
+}
+
 // URI returns the WebServiceURI to identify this service uniquely given an environment name.
+// The URI lists the active custom domains of the service before its default App Runner domain.
 func (d *RDWebServiceDescriber) URI(envName string) (URI, error) {
 	describer, err := d.initAppRunnerDescriber(envName)
 	if err != nil {
@@ -307,17 +604,71 @@ func (d *RDWebServiceDescriber) URI(envName string) (URI, error) {
 	if err != nil {
 		return URI{}, fmt.Errorf("get outputs for service %s: %w", d.svc, err)
 	}
+	return appRunnerURI(serviceURL, bestEffortCustomDomains(describer)), nil
+}
+
+// bestEffortCustomDomains returns the custom domains of an App Runner service,
+// or none if they can't be retrieved, for example because the environment manager role
+// of an environment that isn't upgraded yet doesn't have the "apprunner:DescribeCustomDomains" permission.
+func bestEffortCustomDomains(describer apprunnerDescriber) []apprunner.CustomDomain {
+	domains, err := describer.CustomDomains()
+	if err != nil {
+		return nil
+	}
+	return domains
+}
 
+// appRunnerURI returns the URI of an App Runner service from its "https://" URL and its custom domains.
+// The first active custom domain is the primary route of the service. If there is none, the default URL is.
+func appRunnerURI(serviceURL string, domains []apprunner.CustomDomain) URI {
+	var routes []Route
+	var urls []string
+	for _, domain := range domains {
+		route := Route{
+			AccessType: URIAccessTypeInternet,
+			Protocol:   routeProtocolHTTPS,
+			DNSNames:   []string{domain.DomainName},
+			Path:       "/",
+			Port:       routePortHTTPS,
+			URI:        formatAppRunnerUrl(domain.DomainName),
+			Status:     domain.Status,
+		}
+		if domain.EnableWWWSubdomain {
+			route.DNSNames = append(route.DNSNames, "www."+domain.DomainName)
+		}
+		if domain.IsActive() {
+			route.Primary = len(urls) == 0
+			urls = append(urls, route.URI)
+		}
+		routes = append(routes, route)
+	}
+	defaultRoute := appRunnerRoute(serviceURL)
+	defaultRoute.Primary = len(urls) == 0
+	urls = append(urls, serviceURL)
 	return URI{
-		URI:        serviceURL,
+		URI:        english.OxfordWordSeries(urls, "or"),
 		AccessType: URIAccessTypeInternet,
-	}, nil
+		Routes:     append(routes, defaultRoute),
+	}
+}
+
+// appRunnerRoute returns the route to an App Runner service from its "https://" URL.
+func appRunnerRoute(serviceURL string) Route {
+	return Route{
+		AccessType: URIAccessTypeInternet,
+		Protocol:   routeProtocolHTTPS,
+		DNSNames:   []string{strings.TrimPrefix(serviceURL, "https://")},
+		Path:       "/",
+		Port:       routePortHTTPS,
+		URI:        serviceURL,
+	}
 }
 
 // LBWebServiceURI represents the unique identifier to access a load balanced web service.
 type LBWebServiceURI struct {
 	albURI albURI
 	nlbURI nlbURI
+	cdnURI *albURI // Nil unless the public load balancer is the origin of a CloudFront distribution.
 }
 
 type albURI struct {
@@ -327,18 +678,80 @@ type albURI struct {
 }
 
 type nlbURI struct {
-	DNSNames []string
+	DNSNames  []string
+	Listeners []nlbListener
+}
+
+type nlbListener struct {
 	Port     string
+	Protocol string // Empty if the protocol is unknown, in which case TCP is assumed.
 }
 
+func (l nlbListener) address(dnsName string) string {
+	switch l.Protocol {
+	case elbv2.ProtocolEnumUdp, elbv2.ProtocolEnumTcpUdp:
+		// Annotate the addresses that accept UDP datagrams, since they can't all be reached with a TCP connection.
+		return fmt.Sprintf(fmtNLBAddressWithProtocol, dnsName, l.Port, l.Protocol)
+	default:
+		return fmt.Sprintf("%s:%s", dnsName, l.Port)
+	}
+}
+
+// routes returns a route for each listener of the network load balancer.
+func (u *nlbURI) routes(accessType URIAccessType) []Route {
+	var routes []Route
+	for _, listener := range u.Listeners {
+		protocol := routeProtocolTCP
+		if listener.Protocol != "" {
+			protocol = strings.ToLower(listener.Protocol)
+		}
+		addresses := make([]string, len(u.DNSNames))
+		for i, dnsName := range u.DNSNames {
+			addresses[i] = listener.address(dnsName)
+		}
+		routes = append(routes, Route{
+			AccessType: accessType,
+			Protocol:   protocol,
+			DNSNames:   u.DNSNames,
+			Port:       listener.Port,
+			URI:        english.OxfordWordSeries(addresses, "or"),
+		})
+	}
+	return routes
+}
+
+// String returns the endpoints to advertise for the service.
+// If the service is behind a CloudFront distribution, the distribution is advertised instead of the load balancer.
 func (u *LBWebServiceURI) String() string {
 	uris := u.albURI.strings()
+	if u.cdnURI != nil {
+		uris = u.cdnURI.strings()
+	}
 	for _, dnsName := range u.nlbURI.DNSNames {
-		uris = append(uris, fmt.Sprintf("%s:%s", dnsName, u.nlbURI.Port))
+		for _, listener := range u.nlbURI.Listeners {
+			uris = append(uris, listener.address(dnsName))
+		}
 	}
 	return english.OxfordWordSeries(uris, "or")
 }
 
+// route returns the structured description of the URI.
+func (u *albURI) route(accessType URIAccessType) Route {
+	r := Route{
+		AccessType: accessType,
+		Protocol:   routeProtocolHTTP,
+		DNSNames:   u.DNSNames,
+		Path:       "/" + strings.TrimPrefix(u.Path, "/"),
+		Port:       routePortHTTP,
+		URI:        english.OxfordWordSeries(u.strings(), "or"),
+	}
+	if u.HTTPS {
+		r.Protocol = routeProtocolHTTPS
+		r.Port = routePortHTTPS
+	}
+	return r
+}
+
 func (u *albURI) strings() []string {
 	var uris []string
 	for _, dnsName := range u.DNSNames {
@@ -356,11 +769,30 @@ func (u *albURI) strings() []string {
 }
 
 type serviceDiscovery struct {
-	Service  string
-	Endpoint string
-	Port     string
+	Service     string
+	Endpoint    string
+	Port        string
+	RecordTypes string // Comma-separated types of the DNS records that the tasks are registered with.
 }
 
 func (s *serviceDiscovery) String() string {
+	if s.RecordTypes == serviceDiscoveryRecordTypeSRV {
+		// The endpoint doesn't resolve to an IP address without an A record, clients need to look up the port from the SRV record.
+		return fmt.Sprintf(fmtSvcDiscoverySRVEndpointWithPort, s.Service, s.Endpoint, s.Port)
+	}
 	return fmt.Sprintf(fmtSvcDiscoveryEndpointWithPort, s.Service, s.Endpoint, s.Port)
 }
+
+// serviceDiscoveryRecordTypes returns the comma-separated types of the DNS records that the tasks of a service are registered with.
+func serviceDiscoveryRecordTypes(svcDescr workloadStackDescriber) (string, error) {
+	outputs, err := svcDescr.Outputs()
+	if err != nil {
+		return "", fmt.Errorf("get stack outputs: %w", err)
+	}
+	recordTypes, ok := outputs[svcOutputDiscoveryServiceRecordTypes]
+	if !ok {
+		// Stacks deployed before the record types were configurable register tasks with both A and SRV records.
+		return defaultServiceDiscoveryRecordTypes, nil
+	}
+	return recordTypes, nil
+}
@@ -4,10 +4,13 @@
 package describe
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
 
@@ -31,8 +34,9 @@ func TestLBWebServiceDescriber_URI(t *testing.T) {
 	testCases := map[string]struct {
 		setupMocks func(mocks lbWebSvcDescriberMocks)
 
-		wantedURI   string
-		wantedError error
+		wantedURI    string
+		wantedRoutes []Route
+		wantedError  error
 	}{
 		"fail to get stack resources of service stack": {
 			setupMocks: func(m lbWebSvcDescriberMocks) {
@@ -118,6 +122,9 @@ func TestLBWebServiceDescriber_URI(t *testing.T) {
 					}, nil),
 					m.lbDescriber.EXPECT().ListenerRuleHostHeaders("mockRuleARN").
 						Return([]string{"jobs.test.phonetool.com", "phonetool.com"}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
 				)
 			},
 			wantedURI: "https://jobs.test.phonetool.com or https://phonetool.com",
@@ -136,11 +143,76 @@ func TestLBWebServiceDescriber_URI(t *testing.T) {
 					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
 						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
 					}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
 				)
 			},
 
 			wantedURI: "http://abc.us-west-1.elb.amazonaws.com/mySvc",
 		},
+		"fail to get outputs of environment stack when looking up the CloudFront distribution": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*describeStack.Resource{
+						{
+							LogicalID: svcStackResourceALBTargetGroupLogicalID,
+						},
+					}, nil),
+					m.ecsDescriber.EXPECT().Params().Return(map[string]string{
+						stack.WorkloadRulePathParamKey: "mySvc",
+					}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(nil, mockErr),
+				)
+			},
+
+			wantedError: fmt.Errorf("get stack outputs for environment test: some error"),
+		},
+		"http web service behind a CloudFront distribution": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				envOutputs := map[string]string{
+					envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					envOutputCloudFrontDomainName:      "d1a2b3c4.cloudfront.net",
+				}
+				gomock.InOrder(
+					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*describeStack.Resource{
+						{
+							LogicalID: svcStackResourceALBTargetGroupLogicalID,
+						},
+					}, nil),
+					m.ecsDescriber.EXPECT().Params().Return(map[string]string{
+						stack.WorkloadRulePathParamKey: "mySvc",
+					}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(envOutputs, nil),
+					m.envDescriber.EXPECT().Outputs().Return(envOutputs, nil),
+				)
+			},
+
+			wantedURI: "https://d1a2b3c4.cloudfront.net/mySvc",
+			wantedRoutes: []Route{
+				{
+					AccessType: URIAccessTypeInternet,
+					Protocol:   "https",
+					DNSNames:   []string{"d1a2b3c4.cloudfront.net"},
+					Path:       "/mySvc",
+					Port:       "443",
+					URI:        "https://d1a2b3c4.cloudfront.net/mySvc",
+					Primary:    true,
+				},
+				{
+					AccessType: URIAccessTypeInternet,
+					Protocol:   "http",
+					DNSNames:   []string{testEnvLBDNSName},
+					Path:       "/mySvc",
+					Port:       "80",
+					URI:        "http://abc.us-west-1.elb.amazonaws.com/mySvc",
+					Origin:     true,
+				},
+			},
+		},
 		"fail to get parameters of service stack when fetching NLB uris": {
 			setupMocks: func(m lbWebSvcDescriberMocks) {
 				m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*describeStack.Resource{
@@ -237,6 +309,88 @@ func TestLBWebServiceDescriber_URI(t *testing.T) {
 			},
 			wantedURI: "alias1.phonetool.com:443 or alias2.phonetool.com:443",
 		},
+		"fail to describe the listeners of the nlb": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*describeStack.Resource{
+						{
+							LogicalID: svcStackResourceNLBTargetGroupLogicalID,
+						},
+						{
+							LogicalID:  "NLBListener",
+							Type:       svcStackResourceListenerResourceType,
+							PhysicalID: "mockListenerARN",
+						},
+					}, nil),
+					m.ecsDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceNLBPortParamKey:      "443",
+						stack.LBWebServiceDNSDelegatedParamKey: "true",
+					}, nil),
+					m.lbDescriber.EXPECT().Listeners("mockListenerARN").Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("get network load balancer listeners for service jobs: some error"),
+		},
+		"nlb web service with multiple listeners": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*describeStack.Resource{
+						{
+							LogicalID: svcStackResourceNLBTargetGroupLogicalID,
+						},
+						{
+							LogicalID:  "NLBListener",
+							Type:       svcStackResourceListenerResourceType,
+							PhysicalID: "mockTCPListenerARN",
+						},
+						{
+							LogicalID:  "NLBListenerDNS",
+							Type:       svcStackResourceListenerResourceType,
+							PhysicalID: "mockUDPListenerARN",
+						},
+						{
+							LogicalID:  "HTTPListenerRule",
+							Type:       svcStackResourceListenerRuleResourceType,
+							PhysicalID: "mockRuleARN",
+						},
+					}, nil),
+					m.ecsDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceNLBPortParamKey:      "443",
+						stack.LBWebServiceDNSDelegatedParamKey: "true",
+						stack.LBWebServiceNLBAliasesParamKey:   "alias1.phonetool.com,alias2.phonetool.com",
+					}, nil),
+					m.lbDescriber.EXPECT().Listeners("mockTCPListenerARN", "mockUDPListenerARN").Return([]*elbv2.Listener{
+						{
+							ARN:      "mockUDPListenerARN",
+							Port:     53,
+							Protocol: "UDP",
+						},
+						{
+							ARN:      "mockTCPListenerARN",
+							Port:     443,
+							Protocol: "TCP",
+						},
+					}, nil),
+				)
+			},
+			wantedURI: "alias1.phonetool.com:53 (UDP), alias1.phonetool.com:443, alias2.phonetool.com:53 (UDP), or alias2.phonetool.com:443",
+			wantedRoutes: []Route{
+				{
+					AccessType: URIAccessTypeInternet,
+					Protocol:   "udp",
+					DNSNames:   []string{"alias1.phonetool.com", "alias2.phonetool.com"},
+					Port:       "53",
+					URI:        "alias1.phonetool.com:53 (UDP) or alias2.phonetool.com:53 (UDP)",
+				},
+				{
+					AccessType: URIAccessTypeInternet,
+					Protocol:   "tcp",
+					DNSNames:   []string{"alias1.phonetool.com", "alias2.phonetool.com"},
+					Port:       "443",
+					URI:        "alias1.phonetool.com:443 or alias2.phonetool.com:443",
+				},
+			},
+		},
 		"both http and nlb with alias": {
 			setupMocks: func(m lbWebSvcDescriberMocks) {
 				gomock.InOrder(
@@ -261,6 +415,9 @@ func TestLBWebServiceDescriber_URI(t *testing.T) {
 					}, nil),
 					m.lbDescriber.EXPECT().ListenerRuleHostHeaders("mockRuleARN").
 						Return([]string{"example.com", "v1.example.com"}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
 					m.ecsDescriber.EXPECT().Params().Return(map[string]string{
 						stack.LBWebServiceNLBPortParamKey:      "443",
 						stack.LBWebServiceDNSDelegatedParamKey: "true",
@@ -269,6 +426,23 @@ func TestLBWebServiceDescriber_URI(t *testing.T) {
 				)
 			},
 			wantedURI: "https://example.com, https://v1.example.com, alias1.phonetool.com:443, or alias2.phonetool.com:443",
+			wantedRoutes: []Route{
+				{
+					AccessType: URIAccessTypeInternet,
+					Protocol:   "https",
+					DNSNames:   []string{"example.com", "v1.example.com"},
+					Path:       "/",
+					Port:       "443",
+					URI:        "https://example.com or https://v1.example.com",
+				},
+				{
+					AccessType: URIAccessTypeInternet,
+					Protocol:   "tcp",
+					DNSNames:   []string{"alias1.phonetool.com", "alias2.phonetool.com"},
+					Port:       "443",
+					URI:        "alias1.phonetool.com:443 or alias2.phonetool.com:443",
+				},
+			},
 		},
 	}
 
@@ -306,6 +480,9 @@ func TestLBWebServiceDescriber_URI(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				require.Equal(t, tc.wantedURI, actual.URI)
+				if tc.wantedRoutes != nil {
+					require.Equal(t, tc.wantedRoutes, actual.Routes)
+				}
 			}
 		})
 	}
@@ -321,8 +498,9 @@ func TestBackendServiceDescriber_URI(t *testing.T) {
 	testCases := map[string]struct {
 		setupMocks func(mocks lbWebSvcDescriberMocks)
 
-		wantedURI   string
-		wantedError error
+		wantedURI    string
+		wantedRoutes []Route
+		wantedError  error
 	}{
 		"should return a blank service discovery URI if there is no port exposed": {
 			setupMocks: func(m lbWebSvcDescriberMocks) {
@@ -340,37 +518,92 @@ func TestBackendServiceDescriber_URI(t *testing.T) {
 					stack.WorkloadContainerPortParamKey: "8080",
 				}, nil)
 				m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.app.local", nil)
+				m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{
+					svcOutputDiscoveryServiceRecordTypes: "A,SRV",
+				}, nil)
 			},
 			wantedURI: "my-svc.test.app.local:8080",
 		},
-		"internal url http": {
+		"should return the SRV endpoint if tasks are only registered with SRV records": {
 			setupMocks: func(m lbWebSvcDescriberMocks) {
-				resources := []*describeStack.Resource{
+				m.ecsDescriber.EXPECT().ServiceStackResources().Return(nil, nil)
+				m.ecsDescriber.EXPECT().Params().Return(map[string]string{
+					stack.WorkloadContainerPortParamKey: "8080",
+				}, nil)
+				m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.app.local", nil)
+				m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{
+					svcOutputDiscoveryServiceRecordTypes: "SRV",
+				}, nil)
+			},
+			wantedURI: "my-svc.test.app.local:8080 (SRV)",
+		},
+		"should default to A and SRV records for stacks without the record types output": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				m.ecsDescriber.EXPECT().ServiceStackResources().Return(nil, nil)
+				m.ecsDescriber.EXPECT().Params().Return(map[string]string{
+					stack.WorkloadContainerPortParamKey: "8080",
+				}, nil)
+				m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.app.local", nil)
+				m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{}, nil)
+			},
+			wantedURI: "my-svc.test.app.local:8080",
+		},
+		"fail to get listener rules": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*describeStack.Resource{
 					{
-						Type:       "AWS::ElasticLoadBalancingV2::TargetGroup",
-						LogicalID:  svcStackResourceALBTargetGroupLogicalID,
-						PhysicalID: "targetGroupARN",
+						LogicalID: svcStackResourceALBTargetGroupLogicalID,
 					},
 					{
 						Type:       svcStackResourceListenerRuleResourceType,
 						LogicalID:  svcStackResourceHTTPListenerRuleLogicalID,
 						PhysicalID: "mockRuleARN",
 					},
-				}
+				}, nil)
+				m.lbDescriber.EXPECT().ListenerRules("mockRuleARN").Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("get listener rules of service my-svc: some error"),
+		},
+		"internal url http": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
 				gomock.InOrder(
-					m.ecsDescriber.EXPECT().ServiceStackResources().Return(resources, nil),
-					m.ecsDescriber.EXPECT().Params().Return(map[string]string{
-						stack.WorkloadRulePathParamKey: "mySvc",
+					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*describeStack.Resource{
+						{
+							Type:       "AWS::ElasticLoadBalancingV2::TargetGroup",
+							LogicalID:  svcStackResourceALBTargetGroupLogicalID,
+							PhysicalID: "targetGroupARN",
+						},
+						{
+							Type:       svcStackResourceListenerRuleResourceType,
+							LogicalID:  svcStackResourceHTTPListenerRuleLogicalID,
+							PhysicalID: "mockRuleARN",
+						},
+					}, nil),
+					m.lbDescriber.EXPECT().ListenerRules("mockRuleARN").Return([]*elbv2.ListenerRule{
+						{
+							ARN:             "mockRuleARN",
+							HostHeaders:     []string{"1234.us-west-2.internal.aws.com", "jobs.test.phonetool.internal"},
+							PathPatterns:    []string{"/mySvc", "/mySvc/*"},
+							TargetGroupARNs: []string{"targetGroupARN"},
+						},
 					}, nil),
-					m.ecsDescriber.EXPECT().ServiceStackResources().Return(resources, nil),
-					m.lbDescriber.EXPECT().ListenerRuleHostHeaders("mockRuleARN").
-						Return([]string{"jobs.test.phonetool.internal", "1234.us-west-2.internal.aws.com"}, nil),
 					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
 						envOutputInternalLoadBalancerDNSName: "1234.us-west-2.internal.aws.com",
 					}, nil),
 				)
 			},
 			wantedURI: "http://jobs.test.phonetool.internal/mySvc",
+			wantedRoutes: []Route{
+				{
+					AccessType:  URIAccessTypeInternal,
+					Protocol:    routeProtocolHTTP,
+					DNSNames:    []string{"jobs.test.phonetool.internal"},
+					Path:        "/mySvc",
+					Port:        routePortHTTP,
+					URI:         "http://jobs.test.phonetool.internal/mySvc",
+					TargetGroup: "targetGroupARN",
+				},
+			},
 		},
 		"internal url https": {
 			setupMocks: func(m lbWebSvcDescriberMocks) {
@@ -379,23 +612,135 @@ func TestBackendServiceDescriber_URI(t *testing.T) {
 						{
 							LogicalID: svcStackResourceALBTargetGroupLogicalID,
 						},
-					}, nil),
-					m.ecsDescriber.EXPECT().Params().Return(map[string]string{
-						stack.WorkloadRulePathParamKey: "/",
-						stack.WorkloadHTTPSParamKey:    "true",
-					}, nil),
-					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*describeStack.Resource{
 						{
 							LogicalID:  svcStackResourceHTTPSListenerRuleLogicalID,
 							Type:       svcStackResourceListenerRuleResourceType,
 							PhysicalID: "mockRuleARN",
 						},
+						{
+							LogicalID:  "HTTPListenerRuleWithDomain",
+							Type:       svcStackResourceListenerRuleResourceType,
+							PhysicalID: "mockRedirectRuleARN",
+						},
+					}, nil),
+					m.lbDescriber.EXPECT().ListenerRules("mockRuleARN", "mockRedirectRuleARN").Return([]*elbv2.ListenerRule{
+						{
+							ARN:             "mockRuleARN",
+							HostHeaders:     []string{"jobs.test.phonetool.com", "phonetool.com"},
+							PathPatterns:    []string{"/*"},
+							TargetGroupARNs: []string{"targetGroupARN"},
+						},
+						{
+							ARN:          "mockRedirectRuleARN",
+							HostHeaders:  []string{"jobs.test.phonetool.com", "phonetool.com"},
+							PathPatterns: []string{"/*"},
+						},
 					}, nil),
-					m.lbDescriber.EXPECT().ListenerRuleHostHeaders("mockRuleARN").
-						Return([]string{"jobs.test.phonetool.com", "phonetool.com"}, nil),
 				)
 			},
 			wantedURI: "https://jobs.test.phonetool.com or https://phonetool.com",
+			wantedRoutes: []Route{
+				{
+					AccessType:  URIAccessTypeInternal,
+					Protocol:    routeProtocolHTTPS,
+					DNSNames:    []string{"jobs.test.phonetool.com", "phonetool.com"},
+					Path:        "/",
+					Port:        routePortHTTPS,
+					URI:         "https://jobs.test.phonetool.com or https://phonetool.com",
+					TargetGroup: "targetGroupARN",
+				},
+			},
+		},
+		"a route per path of each listener rule": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*describeStack.Resource{
+						{
+							LogicalID: svcStackResourceALBTargetGroupLogicalID,
+						},
+						{
+							LogicalID:  svcStackResourceHTTPListenerRuleLogicalID,
+							Type:       svcStackResourceListenerRuleResourceType,
+							PhysicalID: "apiRuleARN",
+						},
+						{
+							LogicalID:  "HTTPListenerRuleAdmin",
+							Type:       svcStackResourceListenerRuleResourceType,
+							PhysicalID: "adminRuleARN",
+						},
+					}, nil),
+					m.lbDescriber.EXPECT().ListenerRules("apiRuleARN", "adminRuleARN").Return([]*elbv2.ListenerRule{
+						{
+							ARN:             "apiRuleARN",
+							HostHeaders:     []string{"jobs.test.phonetool.internal"},
+							PathPatterns:    []string{"/api", "/api/*", "/v2", "/v2/*"},
+							TargetGroupARNs: []string{"apiTargetGroupARN"},
+						},
+						{
+							ARN:             "adminRuleARN",
+							PathPatterns:    []string{"/admin"},
+							TargetGroupARNs: []string{"adminTargetGroupARN"},
+						},
+					}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputInternalLoadBalancerDNSName: "1234.us-west-2.internal.aws.com",
+					}, nil),
+				)
+			},
+			wantedURI: "http://jobs.test.phonetool.internal/api, http://jobs.test.phonetool.internal/v2, or http://1234.us-west-2.internal.aws.com/admin",
+			wantedRoutes: []Route{
+				{
+					AccessType:  URIAccessTypeInternal,
+					Protocol:    routeProtocolHTTP,
+					DNSNames:    []string{"jobs.test.phonetool.internal"},
+					Path:        "/api",
+					Port:        routePortHTTP,
+					URI:         "http://jobs.test.phonetool.internal/api",
+					TargetGroup: "apiTargetGroupARN",
+				},
+				{
+					AccessType:  URIAccessTypeInternal,
+					Protocol:    routeProtocolHTTP,
+					DNSNames:    []string{"jobs.test.phonetool.internal"},
+					Path:        "/v2",
+					Port:        routePortHTTP,
+					URI:         "http://jobs.test.phonetool.internal/v2",
+					TargetGroup: "apiTargetGroupARN",
+				},
+				{
+					AccessType:  URIAccessTypeInternal,
+					Protocol:    routeProtocolHTTP,
+					DNSNames:    []string{"1234.us-west-2.internal.aws.com"},
+					Path:        "/admin",
+					Port:        routePortHTTP,
+					URI:         "http://1234.us-west-2.internal.aws.com/admin",
+					TargetGroup: "adminTargetGroupARN",
+				},
+			},
+		},
+		"fall back to the service path if the stack has no listener rules": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*describeStack.Resource{
+						{
+							LogicalID: svcStackResourceALBTargetGroupLogicalID,
+						},
+					}, nil),
+					m.ecsDescriber.EXPECT().Params().Return(map[string]string{
+						stack.WorkloadRulePathParamKey: "mySvc",
+					}, nil),
+					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*describeStack.Resource{
+						{
+							LogicalID: svcStackResourceALBTargetGroupLogicalID,
+						},
+					}, nil),
+					m.lbDescriber.EXPECT().ListenerRuleHostHeaders("").Return(nil, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputInternalLoadBalancerDNSName: "1234.us-west-2.internal.aws.com",
+					}, nil),
+				)
+			},
+			wantedURI: "http://1234.us-west-2.internal.aws.com/mySvc",
 		},
 	}
 	for name, tc := range testCases {
@@ -432,6 +777,9 @@ func TestBackendServiceDescriber_URI(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				require.Equal(t, tc.wantedURI, actual.URI)
+				if tc.wantedRoutes != nil {
+					require.Equal(t, tc.wantedRoutes, actual.Routes)
+				}
 			}
 		})
 	}
@@ -463,11 +811,41 @@ func TestRDWebServiceDescriber_URI(t *testing.T) {
 			setupMocks: func(m apprunnerSvcDescriberMocks) {
 				gomock.InOrder(
 					m.ecsSvcDescriber.EXPECT().ServiceURL().Return(testSvcURL, nil),
+					m.ecsSvcDescriber.EXPECT().CustomDomains().Return(nil, nil),
+				)
+			},
+
+			wantedURI: "https://6znxd4ra33.public.us-east-1.apprunner.amazonaws.com",
+		},
+		"fall back to the default URL if custom domains can't be retrieved": {
+			setupMocks: func(m apprunnerSvcDescriberMocks) {
+				gomock.InOrder(
+					m.ecsSvcDescriber.EXPECT().ServiceURL().Return(testSvcURL, nil),
+					m.ecsSvcDescriber.EXPECT().CustomDomains().Return(nil, mockErr),
 				)
 			},
 
 			wantedURI: "https://6znxd4ra33.public.us-east-1.apprunner.amazonaws.com",
 		},
+		"list active custom domains before the default URL": {
+			setupMocks: func(m apprunnerSvcDescriberMocks) {
+				gomock.InOrder(
+					m.ecsSvcDescriber.EXPECT().ServiceURL().Return(testSvcURL, nil),
+					m.ecsSvcDescriber.EXPECT().CustomDomains().Return([]apprunner.CustomDomain{
+						{
+							DomainName: "pending.example.com",
+							Status:     "pending_certificate_dns_validation",
+						},
+						{
+							DomainName: "example.com",
+							Status:     "active",
+						},
+					}, nil),
+				)
+			},
+
+			wantedURI: "https://example.com or https://6znxd4ra33.public.us-east-1.apprunner.amazonaws.com",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -503,11 +881,195 @@ func TestRDWebServiceDescriber_URI(t *testing.T) {
 	}
 }
 
+func TestAppRunnerURI(t *testing.T) {
+	const testSvcURL = "https://6znxd4ra33.public.us-east-1.apprunner.amazonaws.com"
+	defaultRoute := Route{
+		AccessType: URIAccessTypeInternet,
+		Protocol:   "https",
+		DNSNames:   []string{"6znxd4ra33.public.us-east-1.apprunner.amazonaws.com"},
+		Path:       "/",
+		Port:       "443",
+		URI:        testSvcURL,
+	}
+	primaryDefaultRoute := defaultRoute
+	primaryDefaultRoute.Primary = true
+
+	testCases := map[string]struct {
+		inDomains []apprunner.CustomDomain
+
+		wanted URI
+	}{
+		"default URL is primary without custom domains": {
+			wanted: URI{
+				URI:        testSvcURL,
+				AccessType: URIAccessTypeInternet,
+				Routes:     []Route{primaryDefaultRoute},
+			},
+		},
+		"default URL is primary if no custom domain is active": {
+			inDomains: []apprunner.CustomDomain{
+				{
+					DomainName: "example.com",
+					Status:     "pending_certificate_dns_validation",
+				},
+			},
+			wanted: URI{
+				URI:        testSvcURL,
+				AccessType: URIAccessTypeInternet,
+				Routes: []Route{
+					{
+						AccessType: URIAccessTypeInternet,
+						Protocol:   "https",
+						DNSNames:   []string{"example.com"},
+						Path:       "/",
+						Port:       "443",
+						URI:        "https://example.com",
+						Status:     "pending_certificate_dns_validation",
+					},
+					primaryDefaultRoute,
+				},
+			},
+		},
+		"first active custom domain is primary": {
+			inDomains: []apprunner.CustomDomain{
+				{
+					DomainName:         "example.com",
+					Status:             "active",
+					EnableWWWSubdomain: true,
+				},
+				{
+					DomainName: "api.example.com",
+					Status:     "active",
+				},
+			},
+			wanted: URI{
+				URI:        "https://example.com, https://api.example.com, or " + testSvcURL,
+				AccessType: URIAccessTypeInternet,
+				Routes: []Route{
+					{
+						AccessType: URIAccessTypeInternet,
+						Protocol:   "https",
+						DNSNames:   []string{"example.com", "www.example.com"},
+						Path:       "/",
+						Port:       "443",
+						URI:        "https://example.com",
+						Primary:    true,
+						Status:     "active",
+					},
+					{
+						AccessType: URIAccessTypeInternet,
+						Protocol:   "https",
+						DNSNames:   []string{"api.example.com"},
+						Path:       "/",
+						Port:       "443",
+						URI:        "https://api.example.com",
+						Status:     "active",
+					},
+					defaultRoute,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, appRunnerURI(testSvcURL, tc.inDomains))
+		})
+	}
+}
+
+func TestWorkerServiceDescriber_URI(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testEnv = "test"
+		testSvc = "jobs"
+	)
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockecsDescriber)
+
+		wantedURI   URI
+		wantedError error
+	}{
+		"fail to get the resources of the service stack": {
+			setupMocks: func(m *mocks.MockecsDescriber) {
+				m.EXPECT().ServiceStackResources().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get queues of service jobs in environment test: some error"),
+		},
+		"no queue": {
+			setupMocks: func(m *mocks.MockecsDescriber) {
+				m.EXPECT().ServiceStackResources().Return(nil, nil)
+			},
+			wantedURI: URI{
+				URI:        "-",
+				AccessType: URIAccessTypeNone,
+			},
+		},
+		"the events queues of the service without the dead-letter queues": {
+			setupMocks: func(m *mocks.MockecsDescriber) {
+				m.EXPECT().ServiceStackResources().Return([]*describeStack.Resource{
+					{
+						Type:       "AWS::SQS::Queue",
+						LogicalID:  "EventsQueue",
+						PhysicalID: "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-jobs-EventsQueue-1A2B3C",
+					},
+					{
+						Type:       "AWS::SQS::Queue",
+						LogicalID:  "DeadLetterQueue",
+						PhysicalID: "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-jobs-DeadLetterQueue-4D5E6F",
+					},
+					{
+						Type:       "AWS::SQS::QueuePolicy",
+						LogicalID:  "EventsQueuePolicy",
+						PhysicalID: "phonetool-test-jobs-EventsQueuePolicy-1A2B3C",
+					},
+					{
+						Type:       "AWS::SQS::Queue",
+						LogicalID:  "apiordersEventsQueue",
+						PhysicalID: "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-jobs-apiordersEventsQueue-7G8H9I",
+					},
+				}, nil)
+			},
+			wantedURI: URI{
+				URI:        "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-jobs-EventsQueue-1A2B3C, https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-jobs-apiordersEventsQueue-7G8H9I",
+				AccessType: URIAccessTypeQueue,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockecsDescriber(ctrl)
+			tc.setupMocks(m)
+			d := &WorkerServiceDescriber{
+				app:              testApp,
+				svc:              testSvc,
+				initECSDescriber: func(string) (ecsDescriber, error) { return m, nil },
+			}
+
+			// WHEN
+			actual, err := d.URI(testEnv)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedURI, actual)
+		})
+	}
+}
+
 func TestLBWebServiceURI_String(t *testing.T) {
 	testCases := map[string]struct {
 		albDNSNames []string
 		albPath     string
 		albHTTPS    bool
+		cdnDNSNames []string
 
 		wanted string
 	}{
@@ -537,6 +1099,13 @@ func TestLBWebServiceURI_String(t *testing.T) {
 
 			wanted: "https://jobs.test.phonetool.com",
 		},
+		"behind a CloudFront distribution": {
+			albDNSNames: []string{"abc.us-west-1.elb.amazonaws.com"},
+			albPath:     "svc",
+			cdnDNSNames: []string{"d1a2b3c4.cloudfront.net"},
+
+			wanted: "https://d1a2b3c4.cloudfront.net/svc",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -548,8 +1117,101 @@ func TestLBWebServiceURI_String(t *testing.T) {
 					HTTPS:    tc.albHTTPS,
 				},
 			}
+			if tc.cdnDNSNames != nil {
+				uri.cdnURI = &albURI{
+					DNSNames: tc.cdnDNSNames,
+					Path:     tc.albPath,
+					HTTPS:    true,
+				}
+			}
 
 			require.Equal(t, tc.wanted, uri.String())
 		})
 	}
 }
+
+func TestURI_Endpoints(t *testing.T) {
+	testCases := map[string]struct {
+		in URI
+
+		wanted []string
+	}{
+		"single URL": {
+			in: URI{
+				URI:        "http://abc.us-west-1.elb.amazonaws.com/mySvc",
+				AccessType: URIAccessTypeInternet,
+			},
+			wanted: []string{"http://abc.us-west-1.elb.amazonaws.com/mySvc"},
+		},
+		"two endpoints": {
+			in: URI{
+				URI:        "alias1.phonetool.com:443 or alias2.phonetool.com:443",
+				AccessType: URIAccessTypeInternet,
+			},
+			wanted: []string{"alias1.phonetool.com:443", "alias2.phonetool.com:443"},
+		},
+		"more than two endpoints": {
+			in: URI{
+				URI:        "https://example.com, https://v1.example.com, alias1.phonetool.com:443, or alias2.phonetool.com:443",
+				AccessType: URIAccessTypeInternet,
+			},
+			wanted: []string{"https://example.com", "https://v1.example.com", "alias1.phonetool.com:443", "alias2.phonetool.com:443"},
+		},
+		"service discovery address": {
+			in: URI{
+				URI:        "frontend.test.phonetool.local:8080",
+				AccessType: URIAccessTypeServiceDiscovery,
+			},
+			wanted: []string{"frontend.test.phonetool.local:8080"},
+		},
+		"omits addresses that only accept UDP datagrams": {
+			in: URI{
+				URI:        "alias1.phonetool.com:53 (UDP), alias1.phonetool.com:443, or alias1.phonetool.com:8080 (TCP_UDP)",
+				AccessType: URIAccessTypeInternet,
+			},
+			wanted: []string{"alias1.phonetool.com:443", "alias1.phonetool.com:8080"},
+		},
+		"omits SRV only addresses": {
+			in: URI{
+				URI:        "frontend.test.phonetool.local:8080 (SRV)",
+				AccessType: URIAccessTypeServiceDiscovery,
+			},
+		},
+		"no endpoints for queues": {
+			in: URI{
+				URI:        "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-jobs-EventsQueue-1A2B3C",
+				AccessType: URIAccessTypeQueue,
+			},
+		},
+		"no endpoints without an exposed port": {
+			in: URI{
+				URI:        BlankServiceDiscoveryURI,
+				AccessType: URIAccessTypeNone,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.Endpoints())
+		})
+	}
+}
+
+func TestURIAccessType_MarshalJSON(t *testing.T) {
+	testCases := map[URIAccessType]string{
+		URIAccessTypeNone:             `"none"`,
+		URIAccessTypeInternet:         `"internet"`,
+		URIAccessTypeInternal:         `"internal"`,
+		URIAccessTypeServiceDiscovery: `"serviceDiscovery"`,
+		URIAccessTypeQueue:            `"queue"`,
+	}
+	for in, wanted := range testCases {
+		t.Run(wanted, func(t *testing.T) {
+			b, err := json.Marshal(in)
+
+			require.NoError(t, err)
+			require.Equal(t, wanted, string(b))
+		})
+	}
+}
@@ -7,6 +7,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
+	"strings"
+	"sync"
 	"text/tabwriter"
 
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
@@ -17,6 +20,13 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 )
 
+const (
+	svcStackResourceQueueResourceType          = "AWS::SQS::Queue"
+	svcStackResourceEventsQueueLogicalIDSuffix = "EventsQueue"
+
+	blankQueueURI = "-"
+)
+
 // WorkerServiceDescriber retrieves information about a worker service.
 type WorkerServiceDescriber struct {
 	app             string
@@ -26,10 +36,12 @@ type WorkerServiceDescriber struct {
 	store             DeployedEnvServicesLister
 	initECSDescriber  func(string) (ecsDescriber, error)
 	svcStackDescriber map[string]ecsDescriber
+	mu                sync.Mutex // Guards the cached describers, since environments are described concurrently.
 }
 
 // NewWorkerServiceDescriber instantiates a worker service describer.
 func NewWorkerServiceDescriber(opt NewServiceConfig) (*WorkerServiceDescriber, error) {
+	stackCache := stack.NewCache() // Shared by the describers of every environment.
 	describer := &WorkerServiceDescriber{
 		app:             opt.App,
 		svc:             opt.Svc,
@@ -39,6 +51,8 @@ func NewWorkerServiceDescriber(opt NewServiceConfig) (*WorkerServiceDescriber, e
 		svcStackDescriber: make(map[string]ecsDescriber),
 	}
 	describer.initECSDescriber = func(env string) (ecsDescriber, error) {
+		describer.mu.Lock()
+		defer describer.mu.Unlock()
 		if describer, ok := describer.svcStackDescriber[env]; ok {
 			return describer, nil
 		}
@@ -46,6 +60,7 @@ func NewWorkerServiceDescriber(opt NewServiceConfig) (*WorkerServiceDescriber, e
 			App:         opt.App,
 			Svc:         opt.Svc,
 			ConfigStore: opt.ConfigStore,
+			stackCache:  stackCache,
 		}, env)
 		if err != nil {
 			return nil, err
@@ -63,56 +78,35 @@ func (d *WorkerServiceDescriber) Describe() (HumanJSONStringer, error) {
 		return nil, fmt.Errorf("list deployed environments for application %s: %w", d.app, err)
 	}
 
-	var configs []*ECSServiceConfig
-	var envVars []*containerEnvVar
-	var secrets []*secret
-	for _, env := range environments {
-		svcDescr, err := d.initECSDescriber(env)
-		if err != nil {
-			return nil, err
-		}
-		svcParams, err := svcDescr.Params()
-		if err != nil {
-			return nil, fmt.Errorf("get stack parameters for environment %s: %w", env, err)
-		}
-		containerPlatform, err := svcDescr.Platform()
+	envDescs := make([]*ecsSvcEnvDesc, len(environments))
+	err = describeEnvs(environments, func(i int, env string) error {
+		desc, err := d.describeEnv(env)
 		if err != nil {
-			return nil, fmt.Errorf("retrieve platform: %w", err)
+			return err
 		}
-		configs = append(configs, &ECSServiceConfig{
-			ServiceConfig: &ServiceConfig{
-				Environment: env,
-				Port:        blankContainerPort,
-				CPU:         svcParams[cfnstack.WorkloadTaskCPUParamKey],
-				Memory:      svcParams[cfnstack.WorkloadTaskMemoryParamKey],
-				Platform:    dockerengine.PlatformString(containerPlatform.OperatingSystem, containerPlatform.Architecture),
-			},
-			Tasks: svcParams[cfnstack.WorkloadTaskCountParamKey],
-		})
-		workerSvcEnvVars, err := svcDescr.EnvVars()
-		if err != nil {
-			return nil, fmt.Errorf("retrieve environment variables: %w", err)
-		}
-		envVars = append(envVars, flattenContainerEnvVars(env, workerSvcEnvVars)...)
-		webSvcSecrets, err := svcDescr.Secrets()
-		if err != nil {
-			return nil, fmt.Errorf("retrieve secrets: %w", err)
-		}
-		secrets = append(secrets, flattenSecrets(env, webSvcSecrets)...)
+		envDescs[i] = desc
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	var configs []*ECSServiceConfig
+	var queues []*WorkerServiceQueue
+	var envVars []*containerEnvVar
+	var secrets []*secret
+	var deployments []*stack.Deployment
 	resources := make(map[string][]*stack.Resource)
-	if d.enableResources {
-		for _, env := range environments {
-			svcDescr, err := d.initECSDescriber(env)
-			if err != nil {
-				return nil, err
-			}
-			stackResources, err := svcDescr.ServiceStackResources()
-			if err != nil {
-				return nil, fmt.Errorf("retrieve service resources: %w", err)
-			}
-			resources[env] = stackResources
+	for i, desc := range envDescs {
+		configs = append(configs, desc.config)
+		envVars = append(envVars, desc.envVars...)
+		secrets = append(secrets, desc.secrets...)
+		if desc.deployment != nil {
+			deployments = append(deployments, desc.deployment)
+		}
+		queues = append(queues, desc.queues...)
+		if d.enableResources {
+			resources[environments[i]] = desc.resources
 		}
 	}
 
@@ -121,14 +115,71 @@ func (d *WorkerServiceDescriber) Describe() (HumanJSONStringer, error) {
 		Type:           manifest.WorkerServiceType,
 		App:            d.app,
 		Configurations: configs,
+		Queues:         queues,
 		Variables:      envVars,
 		Secrets:        secrets,
 		Resources:      resources,
+		Deployments:    deployments,
 
 		environments: environments,
 	}, nil
 }
 
+// describeEnv returns the description of the service in an environment.
+func (d *WorkerServiceDescriber) describeEnv(env string) (*ecsSvcEnvDesc, error) {
+	svcDescr, err := d.initECSDescriber(env)
+	if err != nil {
+		return nil, err
+	}
+	svcParams, err := svcDescr.Params()
+	if err != nil {
+		return nil, fmt.Errorf("get stack parameters for environment %s: %w", env, err)
+	}
+	containerPlatform, err := svcDescr.Platform()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve platform: %w", err)
+	}
+	workerSvcEnvVars, err := svcDescr.EnvVars()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve environment variables: %w", err)
+	}
+	webSvcSecrets, err := svcDescr.Secrets()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve secrets: %w", err)
+	}
+	urls, err := workerQueueURLs(svcDescr)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve queues: %w", err)
+	}
+	desc := &ecsSvcEnvDesc{
+		config: &ECSServiceConfig{
+			ServiceConfig: &ServiceConfig{
+				Environment: env,
+				Port:        blankContainerPort,
+				CPU:         svcParams[cfnstack.WorkloadTaskCPUParamKey],
+				Memory:      svcParams[cfnstack.WorkloadTaskMemoryParamKey],
+				Platform:    dockerengine.PlatformString(containerPlatform.OperatingSystem, containerPlatform.Architecture),
+			},
+			Tasks: svcParams[cfnstack.WorkloadTaskCountParamKey],
+		},
+		envVars: flattenContainerEnvVars(env, workerSvcEnvVars),
+		secrets: flattenSecrets(env, webSvcSecrets),
+	}
+	for _, url := range urls {
+		desc.queues = append(desc.queues, &WorkerServiceQueue{
+			Environment: env,
+			URL:         url,
+		})
+	}
+	desc.deployment = bestEffortLastDeployment(svcDescr)
+	if d.enableResources {
+		if desc.resources, err = workloadStackResources(svcDescr); err != nil {
+			return nil, err
+		}
+	}
+	return desc, nil
+}
+
 // Manifest returns the contents of the manifest used to deploy a worker service stack.
 // If the Manifest metadata doesn't exist in the stack template, then returns ErrManifestNotFoundInTemplate.
 func (d *WorkerServiceDescriber) Manifest(env string) ([]byte, error) {
@@ -139,15 +190,26 @@ func (d *WorkerServiceDescriber) Manifest(env string) ([]byte, error) {
 	return cfn.Manifest()
 }
 
+// Params returns the parameters of the worker service stack deployed in the environment.
+func (d *WorkerServiceDescriber) Params(env string) (map[string]string, error) {
+	cfn, err := d.initECSDescriber(env)
+	if err != nil {
+		return nil, err
+	}
+	return cfn.Params()
+}
+
 // workerSvcDesc contains serialized parameters for a worker service.
 type workerSvcDesc struct {
 	Service        string               `json:"service"`
 	Type           string               `json:"type"`
 	App            string               `json:"application"`
 	Configurations ecsConfigurations    `json:"configurations"`
+	Queues         workerServiceQueues  `json:"queues,omitempty"`
 	Variables      containerEnvVars     `json:"variables"`
 	Secrets        secrets              `json:"secrets,omitempty"`
 	Resources      deployedSvcResources `json:"resources,omitempty"`
+	Deployments    lastDeployments      `json:"deployments,omitempty"`
 
 	environments []string `json:"-"`
 }
@@ -173,6 +235,16 @@ func (w *workerSvcDesc) HumanString() string {
 	fmt.Fprint(writer, color.Bold.Sprint("\nConfigurations\n\n"))
 	writer.Flush()
 	w.Configurations.humanString(writer)
+	if len(w.Deployments) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nDeployment\n\n"))
+		writer.Flush()
+		w.Deployments.humanString(writer)
+	}
+	if len(w.Queues) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nQueues\n\n"))
+		writer.Flush()
+		w.Queues.humanString(writer)
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nVariables\n\n"))
 	writer.Flush()
 	w.Variables.humanString(writer)
@@ -190,3 +262,20 @@ func (w *workerSvcDesc) HumanString() string {
 	writer.Flush()
 	return b.String()
 }
+
+// WorkerServiceQueue contains serialized info about an SQS queue that a worker service polls.
+type WorkerServiceQueue struct {
+	Environment string `json:"environment"`
+	URL         string `json:"url"`
+}
+
+type workerServiceQueues []*WorkerServiceQueue
+
+func (q workerServiceQueues) humanString(w io.Writer) {
+	headers := []string{"Environment", "URL"}
+	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, queue := range q {
+		fmt.Fprintf(w, "  %s\t%s\n", queue.Environment, queue.URL)
+	}
+}
@@ -7,12 +7,13 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 
 	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
-	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/dustin/go-humanize"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -112,12 +113,10 @@ func TestWorkerServiceDescriber_Describe(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("retrieve secrets: some error"),
 		},
-		"success": {
-			shouldOutputResources: true,
+		"return error if fail to retrieve queues": {
 			setupMocks: func(m lbWebSvcDescriberMocks) {
 				gomock.InOrder(
-					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv, prodEnv, mockEnv}, nil),
-
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
 					m.ecsDescriber.EXPECT().Params().Return(map[string]string{
 						cfnstack.WorkloadContainerPortParamKey: "-",
 						cfnstack.WorkloadTaskCountParamKey:     "1",
@@ -128,81 +127,132 @@ func TestWorkerServiceDescriber_Describe(t *testing.T) {
 						OperatingSystem: "LINUX",
 						Architecture:    "X86_64",
 					}, nil),
-					m.ecsDescriber.EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
+					m.ecsDescriber.EXPECT().EnvVars().Return(nil, nil),
+					m.ecsDescriber.EXPECT().Secrets().Return(nil, nil),
+					m.ecsDescriber.EXPECT().ServiceStackResources().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve queues: some error"),
+		},
+		"success": {
+			shouldOutputResources: true,
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv, prodEnv, mockEnv}, nil)
+				m.ecsDescribers[testEnv].EXPECT().LastDeployment().Return(&stack.Deployment{Environment: testEnv}, nil)
+				gomock.InOrder(
+					m.ecsDescribers[testEnv].EXPECT().Params().Return(map[string]string{
+						cfnstack.WorkloadContainerPortParamKey: "-",
+						cfnstack.WorkloadTaskCountParamKey:     "1",
+						cfnstack.WorkloadTaskCPUParamKey:       "256",
+						cfnstack.WorkloadTaskMemoryParamKey:    "512",
+					}, nil),
+					m.ecsDescribers[testEnv].EXPECT().Platform().Return(&ecs.ContainerPlatform{
+						OperatingSystem: "LINUX",
+						Architecture:    "X86_64",
+					}, nil),
+					m.ecsDescribers[testEnv].EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
 						{
 							Name:      "COPILOT_ENVIRONMENT_NAME",
 							Container: "container",
 							Value:     testEnv,
 						},
 					}, nil),
-					m.ecsDescriber.EXPECT().Secrets().Return([]*ecs.ContainerSecret{
+					m.ecsDescribers[testEnv].EXPECT().Secrets().Return([]*ecs.ContainerSecret{
 						{
 							Name:      "GITHUB_WEBHOOK_SECRET",
 							Container: "container",
 							ValueFrom: "GH_WEBHOOK_SECRET",
 						},
 					}, nil),
-					m.ecsDescriber.EXPECT().Params().Return(map[string]string{
+					m.ecsDescribers[testEnv].EXPECT().ServiceStackResources().Return([]*stack.Resource{
+						{
+							Type:       "AWS::SQS::Queue",
+							LogicalID:  "EventsQueue",
+							PhysicalID: "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-jobs-EventsQueue-1A2B3C",
+						},
+						{
+							Type:       "AWS::SQS::Queue",
+							LogicalID:  "DeadLetterQueue",
+							PhysicalID: "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-jobs-DeadLetterQueue-4D5E6F",
+						},
+						{
+							Type:       "AWS::SQS::Queue",
+							LogicalID:  "apiordersEventsQueue",
+							PhysicalID: "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-jobs-apiordersEventsQueue-7G8H9I",
+						},
+					}, nil),
+					m.ecsDescribers[testEnv].EXPECT().ServiceStackResources().Return([]*stack.Resource{
+						{
+							Type:       "AWS::EC2::SecurityGroupIngress",
+							PhysicalID: "ContainerSecurityGroupIngressFromPublicALB",
+						},
+					}, nil),
+					m.ecsDescribers[testEnv].EXPECT().AddonsStackResources().Return(nil, nil),
+				)
+				m.ecsDescribers[prodEnv].EXPECT().LastDeployment().Return(&stack.Deployment{Environment: prodEnv}, nil)
+				gomock.InOrder(
+					m.ecsDescribers[prodEnv].EXPECT().Params().Return(map[string]string{
 						cfnstack.WorkloadContainerPortParamKey: "-",
 						cfnstack.WorkloadTaskCountParamKey:     "2",
 						cfnstack.WorkloadTaskCPUParamKey:       "512",
 						cfnstack.WorkloadTaskMemoryParamKey:    "1024",
 					}, nil),
-					m.ecsDescriber.EXPECT().Platform().Return(&ecs.ContainerPlatform{
+					m.ecsDescribers[prodEnv].EXPECT().Platform().Return(&ecs.ContainerPlatform{
 						OperatingSystem: "LINUX",
 						Architecture:    "ARM64",
 					}, nil),
-					m.ecsDescriber.EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
+					m.ecsDescribers[prodEnv].EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
 						{
 							Name:      "COPILOT_ENVIRONMENT_NAME",
 							Container: "container",
 							Value:     prodEnv,
 						},
 					}, nil),
-					m.ecsDescriber.EXPECT().Secrets().Return([]*ecs.ContainerSecret{
+					m.ecsDescribers[prodEnv].EXPECT().Secrets().Return([]*ecs.ContainerSecret{
 						{
 							Name:      "A_SECRET",
 							Container: "container",
 							ValueFrom: "SECRET",
 						},
 					}, nil),
-					m.ecsDescriber.EXPECT().Params().Return(map[string]string{
+					m.ecsDescribers[prodEnv].EXPECT().ServiceStackResources().Return(nil, nil),
+					m.ecsDescribers[prodEnv].EXPECT().ServiceStackResources().Return([]*stack.Resource{
+						{
+							Type:       "AWS::EC2::SecurityGroup",
+							PhysicalID: "sg-0758ed6b233743530",
+						},
+					}, nil),
+					m.ecsDescribers[prodEnv].EXPECT().AddonsStackResources().Return(nil, nil),
+				)
+				m.ecsDescribers[mockEnv].EXPECT().LastDeployment().Return(&stack.Deployment{Environment: mockEnv}, nil)
+				gomock.InOrder(
+					m.ecsDescribers[mockEnv].EXPECT().Params().Return(map[string]string{
 						cfnstack.WorkloadContainerPortParamKey: "-",
 						cfnstack.WorkloadTaskCountParamKey:     "2",
 						cfnstack.WorkloadTaskCPUParamKey:       "512",
 						cfnstack.WorkloadTaskMemoryParamKey:    "1024",
 					}, nil),
-					m.ecsDescriber.EXPECT().Platform().Return(&ecs.ContainerPlatform{
+					m.ecsDescribers[mockEnv].EXPECT().Platform().Return(&ecs.ContainerPlatform{
 						OperatingSystem: "LINUX",
 						Architecture:    "X86_64",
 					}, nil),
-					m.ecsDescriber.EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
+					m.ecsDescribers[mockEnv].EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
 						{
 							Name:      "COPILOT_ENVIRONMENT_NAME",
 							Container: "container",
 							Value:     mockEnv,
 						},
 					}, nil),
-					m.ecsDescriber.EXPECT().Secrets().Return(
+					m.ecsDescribers[mockEnv].EXPECT().Secrets().Return(
 						nil, nil),
-					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
-						{
-							Type:       "AWS::EC2::SecurityGroupIngress",
-							PhysicalID: "ContainerSecurityGroupIngressFromPublicALB",
-						},
-					}, nil),
-					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
-						{
-							Type:       "AWS::EC2::SecurityGroup",
-							PhysicalID: "sg-0758ed6b233743530",
-						},
-					}, nil),
-					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
+					m.ecsDescribers[mockEnv].EXPECT().ServiceStackResources().Return(nil, nil),
+					m.ecsDescribers[mockEnv].EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
 							Type:       "AWS::EC2::SecurityGroup",
 							PhysicalID: "sg-2337435300758ed6b",
 						},
 					}, nil),
+					m.ecsDescribers[mockEnv].EXPECT().AddonsStackResources().Return(nil, nil),
 				)
 			},
 			wantedWorkerSvc: &workerSvcDesc{
@@ -241,6 +291,16 @@ func TestWorkerServiceDescriber_Describe(t *testing.T) {
 						Tasks: "2",
 					},
 				},
+				Queues: []*WorkerServiceQueue{
+					{
+						Environment: "test",
+						URL:         "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-jobs-EventsQueue-1A2B3C",
+					},
+					{
+						Environment: "test",
+						URL:         "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-jobs-apiordersEventsQueue-7G8H9I",
+					},
+				},
 				Variables: []*containerEnvVar{
 					{
 						envVar: &envVar{
@@ -301,6 +361,11 @@ func TestWorkerServiceDescriber_Describe(t *testing.T) {
 						},
 					},
 				},
+				Deployments: []*stack.Deployment{
+					{Environment: "test"},
+					{Environment: "prod"},
+					{Environment: "mockEnv"},
+				},
 				environments: []string{"test", "prod", "mockEnv"},
 			},
 		},
@@ -311,12 +376,7 @@ func TestWorkerServiceDescriber_Describe(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			mockStore := mocks.NewMockDeployedEnvServicesLister(ctrl)
-			mockSvcDescriber := mocks.NewMockecsDescriber(ctrl)
-			mocks := lbWebSvcDescriberMocks{
-				storeSvc:     mockStore,
-				ecsDescriber: mockSvcDescriber,
-			}
+			mocks := newLBWebSvcDescriberMocks(ctrl, testEnv, prodEnv, mockEnv)
 
 			tc.setupMocks(mocks)
 
@@ -324,8 +384,8 @@ func TestWorkerServiceDescriber_Describe(t *testing.T) {
 				app:              testApp,
 				svc:              testSvc,
 				enableResources:  tc.shouldOutputResources,
-				store:            mockStore,
-				initECSDescriber: func(s string) (ecsDescriber, error) { return mockSvcDescriber, nil },
+				store:            mocks.storeSvc,
+				initECSDescriber: func(s string) (ecsDescriber, error) { return mocks.ecsDescribers[s], nil },
 			}
 
 			// WHEN
@@ -343,6 +403,14 @@ func TestWorkerServiceDescriber_Describe(t *testing.T) {
 }
 
 func TestWorkerSvcDesc_String(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2023-03-01T12:00:00Z")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
 	testCases := map[string]struct {
 		wantedHumanString string
 		wantedJSONString  string
@@ -361,6 +429,19 @@ Configurations
   test         1         0.25        512           LINUX/X86_64  -
   prod         3         0.5         1024          LINUX/ARM64     "
 
+Deployment
+
+  Environment  Deployed     By                                                 Commit    Image Digest         Template Version
+  -----------  --------     --                                                 ------    ------------         ----------------
+  test         2 hours ago  arn:aws:sts::123456789012:assumed-role/Admin/jane  4e1b5c2   sha256:f1d4ae3f7261  v1.25.0
+  prod         2 days ago   -                                                  -         -                    -
+
+Queues
+
+  Environment  URL
+  -----------  ---
+  test         https://sqs.us-west-2.amazonaws.com/123456789012/my-app-test-my-svc-EventsQueue-1A2B3C
+
 Variables
 
   Name                      Container  Environment  Value
@@ -383,7 +464,7 @@ Resources
   prod
     AWS::EC2::SecurityGroupIngress  ContainerSecurityGroupIngressFromPublicALB
 `,
-			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Worker Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"-\",\"cpu\":\"256\",\"memory\":\"512\",\"platform\":\"LINUX/X86_64\",\"tasks\":\"1\"},{\"environment\":\"prod\",\"port\":\"-\",\"cpu\":\"512\",\"memory\":\"1024\",\"platform\":\"LINUX/ARM64\",\"tasks\":\"3\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\",\"container\":\"container\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\",\"container\":\"container\"}],\"secrets\":[{\"name\":\"A_SECRET\",\"container\":\"container\",\"environment\":\"prod\",\"valueFrom\":\"SECRET\"},{\"name\":\"GITHUB_WEBHOOK_SECRET\",\"container\":\"container\",\"environment\":\"test\",\"valueFrom\":\"GH_WEBHOOK_SECRET\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
+			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Worker Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"-\",\"cpu\":\"256\",\"memory\":\"512\",\"platform\":\"LINUX/X86_64\",\"tasks\":\"1\"},{\"environment\":\"prod\",\"port\":\"-\",\"cpu\":\"512\",\"memory\":\"1024\",\"platform\":\"LINUX/ARM64\",\"tasks\":\"3\"}],\"queues\":[{\"environment\":\"test\",\"url\":\"https://sqs.us-west-2.amazonaws.com/123456789012/my-app-test-my-svc-EventsQueue-1A2B3C\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\",\"container\":\"container\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\",\"container\":\"container\"}],\"secrets\":[{\"name\":\"A_SECRET\",\"container\":\"container\",\"environment\":\"prod\",\"valueFrom\":\"SECRET\"},{\"name\":\"GITHUB_WEBHOOK_SECRET\",\"container\":\"container\",\"environment\":\"test\",\"valueFrom\":\"GH_WEBHOOK_SECRET\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]},\"deployments\":[{\"environment\":\"test\",\"deployedAt\":\"2023-03-01T10:00:00Z\",\"deployedBy\":\"arn:aws:sts::123456789012:assumed-role/Admin/jane\",\"commit\":\"4e1b5c2\",\"imageDigest\":\"sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807\",\"templateVersion\":\"v1.25.0\"},{\"environment\":\"prod\",\"deployedAt\":\"2023-02-27T12:00:00Z\"}]}\n",
 		},
 	}
 
@@ -466,6 +547,26 @@ Resources
 				Secrets:        secrets,
 				Resources:      resources,
 				environments:   []string{"test", "prod"},
+				Deployments: []*stack.Deployment{
+					{
+						Environment:     "test",
+						DeployedAt:      time.Date(2023, time.March, 1, 10, 0, 0, 0, time.UTC),
+						DeployedBy:      "arn:aws:sts::123456789012:assumed-role/Admin/jane",
+						Commit:          "4e1b5c2",
+						ImageDigest:     "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+						TemplateVersion: "v1.25.0",
+					},
+					{
+						Environment: "prod",
+						DeployedAt:  time.Date(2023, time.February, 27, 12, 0, 0, 0, time.UTC),
+					},
+				},
+				Queues: []*WorkerServiceQueue{
+					{
+						Environment: "test",
+						URL:         "https://sqs.us-west-2.amazonaws.com/123456789012/my-app-test-my-svc-EventsQueue-1A2B3C",
+					},
+				},
 			}
 			human := workerSvc.HumanString()
 			json, _ := workerSvc.JSONString()
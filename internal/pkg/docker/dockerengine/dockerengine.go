@@ -66,6 +66,7 @@ type BuildArguments struct {
 	Target     string            // Optional. The target build stage to pass to `docker build`.
 	CacheFrom  []string          // Optional. Images to consider as cache sources to pass to `docker build`
 	Platform   string            // Optional. OS/Arch to pass to `docker build`.
+	Platforms  []string          // Optional. OS/Arch pairs to build a multi-platform image for with `docker buildx build`.
 	Args       map[string]string // Optional. Build args to pass via `--build-arg` flags. Equivalent to ARG directives in dockerfile.
 }
 
@@ -76,12 +77,48 @@ type dockerConfig struct {
 
 // Build will run a `docker build` command for the given ecr repo URI and build arguments.
 func (c CmdClient) Build(in *BuildArguments) error {
+	args := append([]string{"build"}, c.buildFlags(in, in.Platform)...)
+	// If host platform is not linux/amd64, show the user how the container image is being built; if the build fails (if their docker server doesn't have multi-platform-- and therefore `--platform` capability, for instance) they may see why.
+	if in.Platform != "" {
+		log.Infof("Building your container image: docker %s\n", strings.Join(args, " "))
+	}
+	if err := c.runner.Run("docker", args); err != nil {
+		return fmt.Errorf("building image: %w", err)
+	}
+
+	return nil
+}
+
+// BuildMultiPlatform runs a `docker buildx build` command that builds the image for each of the platforms in the build arguments,
+// and pushes the resulting manifest list to the ecr repo URI with its tags. It returns the digest of the manifest list.
+// The caller must log in to the repository before calling this method, since buildx pushes the images as it builds them.
+func (c CmdClient) BuildMultiPlatform(in *BuildArguments) (digest string, err error) {
+	args := append([]string{"buildx", "build", "--push"}, c.buildFlags(in, strings.Join(in.Platforms, ","))...)
+	log.Infof("Building your multi-platform container image: docker %s\n", strings.Join(args, " "))
+	if err := c.runner.Run("docker", args); err != nil {
+		return "", fmt.Errorf("building multi-platform image: %w", err)
+	}
+	buf := new(strings.Builder)
+	if err := c.runner.Run("docker", []string{"buildx", "imagetools", "inspect", in.URI, "--format", "{{json .Manifest}}"}, exec.Stdout(buf)); err != nil {
+		return "", fmt.Errorf("inspect manifest list digest for %s: %w", in.URI, err)
+	}
+	var manifestList struct {
+		Digest string `json:"digest"`
+	}
+	if err := json.Unmarshal([]byte(strings.TrimSpace(buf.String())), &manifestList); err != nil || manifestList.Digest == "" {
+		return "", fmt.Errorf("parse the digest from the manifest list '%s'", strings.TrimSpace(buf.String()))
+	}
+	return manifestList.Digest, nil
+}
+
+// buildFlags returns the flags and positional arguments shared by `docker build` and `docker buildx build`.
+func (c CmdClient) buildFlags(in *BuildArguments, platform string) []string {
 	dfDir := in.Context
 	if dfDir == "" { // Context wasn't specified use the Dockerfile's directory as context.
 		dfDir = filepath.Dir(in.Dockerfile)
 	}
 
-	args := []string{"build"}
+	var args []string
 
 	// Add additional image tags to the docker build call.
 	args = append(args, "-t", in.URI)
@@ -100,8 +137,8 @@ func (c CmdClient) Build(in *BuildArguments) error {
 	}
 
 	// Add platform option.
-	if in.Platform != "" {
-		args = append(args, "--platform", in.Platform)
+	if platform != "" {
+		args = append(args, "--platform", platform)
 	}
 
 	// Plain display if we're in a CI environment.
@@ -120,16 +157,7 @@ func (c CmdClient) Build(in *BuildArguments) error {
 		args = append(args, "--build-arg", fmt.Sprintf("%s=%s", k, in.Args[k]))
 	}
 
-	args = append(args, dfDir, "-f", in.Dockerfile)
-	// If host platform is not linux/amd64, show the user how the container image is being built; if the build fails (if their docker server doesn't have multi-platform-- and therefore `--platform` capability, for instance) they may see why.
-	if in.Platform != "" {
-		log.Infof("Building your container image: docker %s\n", strings.Join(args, " "))
-	}
-	if err := c.runner.Run("docker", args); err != nil {
-		return fmt.Errorf("building image: %w", err)
-	}
-
-	return nil
+	return append(args, dfDir, "-f", in.Dockerfile)
 }
 
 // Login will run a `docker login` command against the Service repository URI with the input uri and auth data.
@@ -173,6 +201,20 @@ func (c CmdClient) Push(uri string, tags ...string) (digest string, err error) {
 	return parts[1], nil
 }
 
+// CopyImage pulls the source image, tags it as the target image, and pushes the target image.
+func (c CmdClient) CopyImage(source, target string) error {
+	if err := c.runner.Run("docker", []string{"pull", source}); err != nil {
+		return fmt.Errorf("docker pull %s: %w", source, err)
+	}
+	if err := c.runner.Run("docker", []string{"tag", source, target}); err != nil {
+		return fmt.Errorf("docker tag %s %s: %w", source, target, err)
+	}
+	if err := c.runner.Run("docker", []string{"push", target}); err != nil {
+		return fmt.Errorf("docker push %s: %w", target, err)
+	}
+	return nil
+}
+
 // CheckDockerEngineRunning will run `docker info` command to check if the docker engine is running.
 func (c CmdClient) CheckDockerEngineRunning() error {
 	if _, err := osexec.LookPath("docker"); err != nil {
@@ -237,6 +237,146 @@ func TestDockerCommand_Login(t *testing.T) {
 	}
 }
 
+func TestDockerCommand_CopyImage(t *testing.T) {
+	const (
+		mockSource = "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:v1"
+		mockTarget = "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool:frontend-v1"
+	)
+	mockError := errors.New("some error")
+
+	tests := map[string]struct {
+		setupMocks func(m *MockCmd)
+
+		wantedErr error
+	}{
+		"wrap error returned from pull": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("docker", []string{"pull", mockSource}).Return(mockError)
+			},
+			wantedErr: fmt.Errorf("docker pull %s: %w", mockSource, mockError),
+		},
+		"wrap error returned from tag": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("docker", []string{"pull", mockSource}).Return(nil)
+				m.EXPECT().Run("docker", []string{"tag", mockSource, mockTarget}).Return(mockError)
+			},
+			wantedErr: fmt.Errorf("docker tag %s %s: %w", mockSource, mockTarget, mockError),
+		},
+		"wrap error returned from push": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("docker", []string{"pull", mockSource}).Return(nil)
+				m.EXPECT().Run("docker", []string{"tag", mockSource, mockTarget}).Return(nil)
+				m.EXPECT().Run("docker", []string{"push", mockTarget}).Return(mockError)
+			},
+			wantedErr: fmt.Errorf("docker push %s: %w", mockTarget, mockError),
+		},
+		"pull, tag, and push the image": {
+			setupMocks: func(m *MockCmd) {
+				gomock.InOrder(
+					m.EXPECT().Run("docker", []string{"pull", mockSource}).Return(nil),
+					m.EXPECT().Run("docker", []string{"tag", mockSource, mockTarget}).Return(nil),
+					m.EXPECT().Run("docker", []string{"push", mockTarget}).Return(nil),
+				)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCmd := NewMockCmd(ctrl)
+			tc.setupMocks(mockCmd)
+			s := CmdClient{
+				runner: mockCmd,
+			}
+
+			err := s.CopyImage(mockSource, mockTarget)
+
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
+func TestDockerCommand_BuildMultiPlatform(t *testing.T) {
+	emptyLookupEnv := func(key string) (string, bool) {
+		return "", false
+	}
+	mockURI := "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app"
+	testCases := map[string]struct {
+		setupMocks func(m *MockCmd)
+
+		wantedDigest string
+		wantedError  error
+	}{
+		"error if fail to build": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("docker", gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("building multi-platform image: some error"),
+		},
+		"error if fail to parse the digest of the manifest list": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("docker", gomock.Any()).Return(nil)
+				m.EXPECT().Run("docker", gomock.Any(), gomock.Any()).
+					Do(func(_ string, _ []string, opt exec.CmdOption) {
+						cmd := &osexec.Cmd{}
+						opt(cmd)
+						_, _ = cmd.Stdout.Write([]byte("{}\n"))
+					}).Return(nil)
+			},
+			wantedError: errors.New("parse the digest from the manifest list '{}'"),
+		},
+		"builds and pushes a manifest list for every platform": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("docker", []string{"buildx", "build", "--push",
+					"-t", mockURI, "-t", mockURI + ":g123bfc",
+					"--platform", "linux/amd64,linux/arm64",
+					"--build-arg", "GOPROXY=direct",
+					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}).Return(nil)
+				m.EXPECT().Run("docker", []string{"buildx", "imagetools", "inspect", mockURI, "--format", "{{json .Manifest}}"}, gomock.Any()).
+					Do(func(_ string, _ []string, opt exec.CmdOption) {
+						cmd := &osexec.Cmd{}
+						opt(cmd)
+						_, _ = cmd.Stdout.Write([]byte(`{"mediaType":"application/vnd.docker.distribution.manifest.list.v2+json","digest":"sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807","size":743}` + "\n"))
+					}).Return(nil)
+			},
+			wantedDigest: "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := NewMockCmd(ctrl)
+			tc.setupMocks(m)
+			cmd := CmdClient{
+				runner:    m,
+				lookupEnv: emptyLookupEnv,
+			}
+
+			// WHEN
+			digest, err := cmd.BuildMultiPlatform(&BuildArguments{
+				URI:        mockURI,
+				Tags:       []string{"g123bfc"},
+				Dockerfile: "mockPath/to/mockDockerfile",
+				Platforms:  []string{"linux/amd64", "linux/arm64"},
+				Args:       map[string]string{"GOPROXY": "direct"},
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDigest, digest)
+		})
+	}
+}
+
 func TestDockerCommand_Push(t *testing.T) {
 	emptyLookupEnv := func(key string) (string, bool) {
 		return "", false
@@ -65,10 +65,31 @@ type HealthCheck struct {
 	Cmd         []string
 }
 
+// BaseImage represents an image that a stage of a Dockerfile is built from.
+type BaseImage struct {
+	Name   string // Name of the image repository, for example "public.ecr.aws/docker/library/nginx".
+	Tag    string // Tag of the image, empty if the FROM instruction doesn't specify one.
+	Digest string // Digest that the image is pinned to, empty if the FROM instruction doesn't specify one.
+	Line   int    // Line number of the FROM instruction.
+}
+
+// String returns the image reference as written in the FROM instruction.
+func (img BaseImage) String() string {
+	ref := img.Name
+	if img.Tag != "" {
+		ref = fmt.Sprintf("%s:%s", ref, img.Tag)
+	}
+	if img.Digest != "" {
+		ref = fmt.Sprintf("%s@%s", ref, img.Digest)
+	}
+	return ref
+}
+
 // Dockerfile represents a parsed Dockerfile.
 type Dockerfile struct {
 	exposedPorts []Port
 	healthCheck  *HealthCheck
+	baseImages   []BaseImage
 	parsed       bool
 	path         string
 
@@ -121,6 +142,17 @@ func (df *Dockerfile) GetHealthCheck() (*HealthCheck, error) {
 	return df.healthCheck, nil
 }
 
+// GetBaseImages returns the images pulled from a registry by the FROM instructions of the Dockerfile.
+// Stages built from "scratch", from a previous stage, or from an image name that depends on build arguments are skipped.
+func (df *Dockerfile) GetBaseImages() ([]BaseImage, error) {
+	if !df.parsed {
+		if err := df.parse(); err != nil {
+			return nil, err
+		}
+	}
+	return df.baseImages, nil
+}
+
 // parse takes a Dockerfile and fills in struct members based on methods like parseExpose and parseHealthcheck.
 func (df *Dockerfile) parse() error {
 	if df.parsed {
@@ -146,6 +178,7 @@ func (df *Dockerfile) parse() error {
 
 	df.exposedPorts = parsedDockerfile.exposedPorts
 	df.healthCheck = parsedDockerfile.healthCheck
+	df.baseImages = parsedDockerfile.baseImages
 	df.parsed = true
 	return nil
 }
@@ -154,6 +187,7 @@ func (df *Dockerfile) parse() error {
 func parse(name, content string) (*Dockerfile, error) {
 	var df Dockerfile
 	df.exposedPorts = []Port{}
+	stages := make(map[string]bool)
 
 	lexer := lex(strings.NewReader(content))
 	for {
@@ -172,10 +206,59 @@ func parse(name, content string) (*Dockerfile, error) {
 				return nil, err
 			}
 			df.healthCheck = hc
+		case instrFrom:
+			img, stage := parseFrom(instr.args)
+			if img != nil && !stages[strings.ToLower(img.Name)] {
+				img.Line = instr.line
+				df.baseImages = append(df.baseImages, *img)
+			}
+			if stage != "" {
+				stages[strings.ToLower(stage)] = true
+			}
 		}
 	}
 }
 
+// parseFrom parses the arguments of a FROM instruction of the form:
+// FROM [--platform=<platform>] <image>[:<tag>][@<digest>] [AS <name>]
+// It returns the image, or nil if the stage isn't built from an image in a registry, and the name of the stage.
+func parseFrom(args string) (img *BaseImage, stage string) {
+	var fields []string
+	for _, field := range strings.Fields(args) {
+		if strings.HasPrefix(field, "--") {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	if len(fields) == 0 {
+		return nil, ""
+	}
+	if len(fields) == 3 && strings.EqualFold(fields[1], "as") {
+		stage = fields[2]
+	}
+	ref := fields[0]
+	if strings.EqualFold(ref, "scratch") || strings.Contains(ref, "$") {
+		return nil, stage
+	}
+	parsed := ParseBaseImage(ref)
+	return &parsed, stage
+}
+
+// ParseBaseImage parses an image reference of the form <image>[:<tag>][@<digest>].
+func ParseBaseImage(ref string) BaseImage {
+	img := BaseImage{
+		Name: ref,
+	}
+	if i := strings.Index(img.Name, "@"); i != -1 {
+		img.Name, img.Digest = img.Name[:i], img.Name[i+1:]
+	}
+	// A colon after the last slash separates the tag, otherwise it's the port of the registry host.
+	if i := strings.LastIndex(img.Name, ":"); i > strings.LastIndex(img.Name, "/") {
+		img.Name, img.Tag = img.Name[:i], img.Name[i+1:]
+	}
+	return img
+}
+
 func parseExpose(line string) []Port {
 	// group 0: whole match
 	// group 1: port
@@ -277,6 +277,67 @@ HEALTHCHECK   CMD     ["a",    "b"]
 	}
 }
 
+func TestDockerfile_GetBaseImages(t *testing.T) {
+	testCases := map[string]struct {
+		dockerfile   []byte
+		wantedImages []BaseImage
+	}{
+		"no FROM instruction": {
+			dockerfile: []byte(`EXPOSE 80`),
+		},
+		"image without tag": {
+			dockerfile: []byte(`FROM nginx
+EXPOSE 80`),
+			wantedImages: []BaseImage{
+				{Name: "nginx", Line: 1},
+			},
+		},
+		"images with tags, digests and registry ports": {
+			dockerfile: []byte(`
+from --platform=linux/amd64 public.ecr.aws/docker/library/golang:1.19-alpine AS builder
+RUN go build ./...
+
+FROM localhost:5000/base@sha256:4d5e6f
+FROM gcr.io/distroless/static:nonroot@sha256:1a2b3c
+`),
+			wantedImages: []BaseImage{
+				{Name: "public.ecr.aws/docker/library/golang", Tag: "1.19-alpine", Line: 2},
+				{Name: "localhost:5000/base", Digest: "sha256:4d5e6f", Line: 5},
+				{Name: "gcr.io/distroless/static", Tag: "nonroot", Digest: "sha256:1a2b3c", Line: 6},
+			},
+		},
+		"skip scratch, previous stages and build arguments": {
+			dockerfile: []byte(`ARG BASE=node:18
+FROM ${BASE} AS deps
+FROM node:18-alpine as Builder
+FROM builder AS test
+FROM scratch
+COPY --from=builder /app /app`),
+			wantedImages: []BaseImage{
+				{Name: "node", Tag: "18-alpine", Line: 3},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			err := afero.WriteFile(fs, "./Dockerfile", tc.dockerfile, 0644)
+			require.NoError(t, err)
+
+			images, err := New(fs, "./Dockerfile").GetBaseImages()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedImages, images)
+		})
+	}
+}
+
+func TestBaseImage_String(t *testing.T) {
+	require.Equal(t, "nginx", BaseImage{Name: "nginx"}.String())
+	require.Equal(t, "localhost:5000/nginx:1.23@sha256:1a2b3c", BaseImage{Name: "localhost:5000/nginx", Tag: "1.23", Digest: "sha256:1a2b3c"}.String())
+}
+
 func stringifyPorts(ports []Port) []string {
 	var arr []string
 	for _, p := range ports {
@@ -18,12 +18,14 @@ const (
 	instrErr         instructionName = iota // an error occurred while scanning.
 	instrHealthCheck                        // a HEALTHCHECK instruction.
 	instrExpose                             // an EXPOSE instruction.
+	instrFrom                               // a FROM instruction.
 	instrEOF                                // done scanning.
 )
 
 const (
 	markerExposeInstr      = "expose "      // start of an EXPOSE instruction.
 	markerHealthCheckInstr = "healthcheck " // start of a HEALTHCHECK instruction.
+	markerFromInstr        = "from "        // start of a FROM instruction.
 )
 
 var (
@@ -32,6 +34,7 @@ var (
 	instrMarkers = map[instructionName]string{ // lookup table for how an instruction starts.
 		instrExpose:      markerExposeInstr,
 		instrHealthCheck: markerHealthCheckInstr,
+		instrFrom:        markerFromInstr,
 	}
 )
 
@@ -174,6 +177,8 @@ func lexContent(l *lexer) stateFn {
 		return lexExpose
 	case strings.HasPrefix(line, markerHealthCheckInstr):
 		return lexHealthCheck
+	case strings.HasPrefix(line, markerFromInstr):
+		return lexFrom
 	default:
 		return lexContent // Ignore all the other instructions, consume the line without emitting any instructions.
 	}
@@ -189,6 +194,11 @@ func lexHealthCheck(l *lexer) stateFn {
 	return lexInstruction(l, instrHealthCheck)
 }
 
+// lexFrom collects the arguments for a FROM instruction and then emits it.
+func lexFrom(l *lexer) stateFn {
+	return lexInstruction(l, instrFrom)
+}
+
 // lexInstruction collects all the arguments for the named instruction and then emits it.
 func lexInstruction(l *lexer, name instructionName) stateFn {
 	args := trimContinuationLineMarker(trimInstruction(l.curLine, instrMarkers[name]))
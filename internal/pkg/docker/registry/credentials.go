@@ -0,0 +1,142 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+)
+
+const (
+	dockerConfigDirEnvVar  = "DOCKER_CONFIG"
+	dockerConfigFileName   = "config.json"
+	dockerHubServerAddress = "https://index.docker.io/v1/"
+	credentialHelperPrefix = "docker-credential-"
+	identityTokenUsername  = "<token>"
+)
+
+var errNoCredentials = errors.New("no credentials are configured in the Docker config file")
+
+// ErrCredentialsRequired occurs when a registry requires credentials that can't be found in the Docker config file.
+type ErrCredentialsRequired struct {
+	Host string
+	err  error
+}
+
+func (e *ErrCredentialsRequired) Error() string {
+	return fmt.Sprintf("registry %s requires credentials: %v", e.Host, e.err)
+}
+
+// Unwrap returns the reason why the credentials couldn't be retrieved.
+func (e *ErrCredentialsRequired) Unwrap() error {
+	return e.err
+}
+
+type runner interface {
+	Run(name string, args []string, opts ...exec.CmdOption) error
+}
+
+// dockerCredentials reads the credentials of registries the same way as the Docker CLI,
+// from the credential helpers or the "auths" configured in the Docker config file.
+type dockerCredentials struct {
+	configDir string
+	runner    runner
+}
+
+func newDockerCredentials() *dockerCredentials {
+	dir := os.Getenv(dockerConfigDirEnvVar)
+	if dir == "" {
+		if home, err := os.UserHomeDir(); err == nil {
+			dir = filepath.Join(home, ".docker")
+		}
+	}
+	return &dockerCredentials{
+		configDir: dir,
+		runner:    exec.NewCmd(),
+	}
+}
+
+// Credentials returns the username and secret to access the registry host.
+func (c *dockerCredentials) Credentials(host string) (username, secret string, err error) {
+	content, err := os.ReadFile(filepath.Join(c.configDir, dockerConfigFileName))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", "", errNoCredentials
+		}
+		return "", "", fmt.Errorf("read Docker config file: %w", err)
+	}
+	var config struct {
+		CredsStore  string            `json:"credsStore"`
+		CredHelpers map[string]string `json:"credHelpers"`
+		Auths       map[string]struct {
+			Auth string `json:"auth"`
+		} `json:"auths"`
+	}
+	if err := json.Unmarshal(content, &config); err != nil {
+		return "", "", fmt.Errorf("unmarshal Docker config file: %w", err)
+	}
+	server := serverAddress(host)
+	helper := config.CredsStore
+	if h, ok := config.CredHelpers[server]; ok {
+		helper = h
+	}
+	if helper != "" {
+		return c.fromHelper(helper, server)
+	}
+	auth, ok := config.Auths[server]
+	if !ok || auth.Auth == "" {
+		return "", "", errNoCredentials
+	}
+	decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+	if err != nil {
+		return "", "", fmt.Errorf("decode credentials of %s in Docker config file: %w", server, err)
+	}
+	username, secret, found := strings.Cut(string(decoded), ":")
+	if !found {
+		return "", "", fmt.Errorf("credentials of %s in Docker config file are not in the username:password format", server)
+	}
+	return username, secret, nil
+}
+
+// fromHelper runs the "get" command of a credential helper.
+// See https://github.com/docker/docker-credential-helpers#development
+func (c *dockerCredentials) fromHelper(helper, server string) (username, secret string, err error) {
+	var stdout, stderr bytes.Buffer
+	if err := c.runner.Run(credentialHelperPrefix+helper, []string{"get"},
+		exec.Stdin(strings.NewReader(server)), exec.Stdout(&stdout), exec.Stderr(&stderr)); err != nil {
+		if strings.Contains(stdout.String()+stderr.String(), "credentials not found") {
+			return "", "", errNoCredentials
+		}
+		return "", "", fmt.Errorf("get credentials of %s from %s%s: %w", server, credentialHelperPrefix, helper, err)
+	}
+	var out struct {
+		Username string `json:"Username"`
+		Secret   string `json:"Secret"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &out); err != nil {
+		return "", "", fmt.Errorf("unmarshal credentials of %s from %s%s: %w", server, credentialHelperPrefix, helper, err)
+	}
+	if out.Username == identityTokenUsername {
+		// Identity tokens must be exchanged with an OAuth2 flow that registries don't implement consistently.
+		return "", "", fmt.Errorf("credentials of %s from %s%s are an identity token, which is not supported", server, credentialHelperPrefix, helper)
+	}
+	return out.Username, out.Secret, nil
+}
+
+// serverAddress returns the key of the registry host in the Docker config file.
+// Docker Hub credentials are stored under its legacy index address.
+func serverAddress(host string) string {
+	if host == dockerHubRegistryHost {
+		return dockerHubServerAddress
+	}
+	return host
+}
@@ -0,0 +1,150 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"errors"
+	"io"
+	"os"
+	osexec "os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDockerCredentials_Credentials(t *testing.T) {
+	const ecrHost = "123456789012.dkr.ecr.us-west-2.amazonaws.com"
+	testCases := map[string]struct {
+		inConfig   string
+		inHost     string
+		setupMocks func(m *Mockrunner)
+
+		wantedUsername string
+		wantedSecret   string
+		wantedError    error
+	}{
+		"no credentials without a config file": {
+			inHost:      ecrHost,
+			setupMocks:  func(m *Mockrunner) {},
+			wantedError: errNoCredentials,
+		},
+		"no credentials if the host isn't configured": {
+			inConfig:    `{"auths": {"ghcr.io": {"auth": "dXNlcjpzZWNyZXQ="}}}`,
+			inHost:      ecrHost,
+			setupMocks:  func(m *Mockrunner) {},
+			wantedError: errNoCredentials,
+		},
+		"read the credentials of the host from auths": {
+			inConfig:       `{"auths": {"ghcr.io": {"auth": "dXNlcjpzZWNyZXQ="}}}`,
+			inHost:         "ghcr.io",
+			setupMocks:     func(m *Mockrunner) {},
+			wantedUsername: "user",
+			wantedSecret:   "secret",
+		},
+		"get the credentials from the credential helper of the host": {
+			inConfig: `{"credsStore": "desktop", "credHelpers": {"` + ecrHost + `": "ecr-login"}}`,
+			inHost:   ecrHost,
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("docker-credential-ecr-login", []string{"get"}, gomock.Any(), gomock.Any(), gomock.Any()).
+					Do(func(_ string, _ []string, opts ...exec.CmdOption) {
+						cmd := &osexec.Cmd{}
+						for _, opt := range opts {
+							opt(cmd)
+						}
+						server, err := io.ReadAll(cmd.Stdin)
+						require.NoError(t, err)
+						require.Equal(t, ecrHost, string(server))
+						_, _ = cmd.Stdout.Write([]byte(`{"ServerURL": "` + ecrHost + `", "Username": "AWS", "Secret": "token"}`))
+					}).Return(nil)
+			},
+			wantedUsername: "AWS",
+			wantedSecret:   "token",
+		},
+		"get the credentials of Docker Hub from the credentials store": {
+			inConfig: `{"credsStore": "desktop"}`,
+			inHost:   "registry-1.docker.io",
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("docker-credential-desktop", []string{"get"}, gomock.Any(), gomock.Any(), gomock.Any()).
+					Do(func(_ string, _ []string, opts ...exec.CmdOption) {
+						cmd := &osexec.Cmd{}
+						for _, opt := range opts {
+							opt(cmd)
+						}
+						server, err := io.ReadAll(cmd.Stdin)
+						require.NoError(t, err)
+						require.Equal(t, "https://index.docker.io/v1/", string(server))
+						_, _ = cmd.Stdout.Write([]byte(`{"Username": "user", "Secret": "secret"}`))
+					}).Return(nil)
+			},
+			wantedUsername: "user",
+			wantedSecret:   "secret",
+		},
+		"no credentials if the credential helper doesn't have any": {
+			inConfig: `{"credsStore": "desktop"}`,
+			inHost:   "ghcr.io",
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("docker-credential-desktop", []string{"get"}, gomock.Any(), gomock.Any(), gomock.Any()).
+					Do(func(_ string, _ []string, opts ...exec.CmdOption) {
+						cmd := &osexec.Cmd{}
+						for _, opt := range opts {
+							opt(cmd)
+						}
+						_, _ = cmd.Stdout.Write([]byte("credentials not found in native keychain\n"))
+					}).Return(errors.New("exit status 1"))
+			},
+			wantedError: errNoCredentials,
+		},
+		"error if the credential helper fails": {
+			inConfig: `{"credsStore": "desktop"}`,
+			inHost:   "ghcr.io",
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("docker-credential-desktop", []string{"get"}, gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("get credentials of ghcr.io from docker-credential-desktop: some error"),
+		},
+		"error if the credential helper returns an identity token": {
+			inConfig: `{"credsStore": "desktop"}`,
+			inHost:   "ghcr.io",
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("docker-credential-desktop", []string{"get"}, gomock.Any(), gomock.Any(), gomock.Any()).
+					Do(func(_ string, _ []string, opts ...exec.CmdOption) {
+						cmd := &osexec.Cmd{}
+						for _, opt := range opts {
+							opt(cmd)
+						}
+						_, _ = cmd.Stdout.Write([]byte(`{"Username": "<token>", "Secret": "refresh-token"}`))
+					}).Return(nil)
+			},
+			wantedError: errors.New("credentials of ghcr.io from docker-credential-desktop are an identity token, which is not supported"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := NewMockrunner(ctrl)
+			tc.setupMocks(m)
+			dir := t.TempDir()
+			if tc.inConfig != "" {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "config.json"), []byte(tc.inConfig), 0600))
+			}
+			creds := &dockerCredentials{
+				configDir: dir,
+				runner:    m,
+			}
+
+			username, secret, err := creds.Credentials(tc.inHost)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedUsername, username)
+			require.Equal(t, tc.wantedSecret, secret)
+		})
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package registry provides a client to look up images in container registries that implement the Docker Registry HTTP API V2.
+package registry
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	dockerHubHost         = "docker.io"
+	dockerHubRegistryHost = "registry-1.docker.io"
+	officialImagesPrefix  = "library/"
+
+	headerAuthenticate    = "WWW-Authenticate"
+	headerAuthorization   = "Authorization"
+	headerAccept          = "Accept"
+	headerContentDigest   = "Docker-Content-Digest"
+	headerLink            = "Link"
+	bearerChallengeScheme = "Bearer "
+	basicChallengeScheme  = "Basic "
+
+	// requestTimeout bounds each request to a registry, so that an unresponsive registry doesn't hang the command.
+	requestTimeout = 30 * time.Second
+)
+
+// manifestMediaTypes are the media types of image manifests and indexes, so that multi-platform images return the digest of the index.
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+var (
+	challengeParamRegexp = regexp.MustCompile(`(\w+)="([^"]*)"`)
+	nextLinkRegexp       = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+)
+
+type httpClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+type credentialsGetter interface {
+	Credentials(host string) (username, secret string, err error)
+}
+
+// Registry looks up images in container registries.
+type Registry struct {
+	http   httpClient
+	creds  credentialsGetter
+	scheme string
+}
+
+// New returns a Registry that makes requests over HTTPS, authenticated with the credentials
+// of the Docker config file if the registry requires them.
+func New() *Registry {
+	return &Registry{
+		http: &http.Client{
+			Timeout: requestTimeout,
+		},
+		creds:  newDockerCredentials(),
+		scheme: "https",
+	}
+}
+
+// Tags returns all the tags of an image repository, for example "nginx" or "public.ecr.aws/docker/library/nginx".
+func (r *Registry) Tags(image string) ([]string, error) {
+	host, repo := parseRepository(image)
+	next := fmt.Sprintf("%s://%s/v2/%s/tags/list", r.scheme, host, repo)
+	var tags []string
+	for next != "" {
+		req, err := http.NewRequest(http.MethodGet, next, nil)
+		if err != nil {
+			return nil, fmt.Errorf("create request to list tags of %s: %w", image, err)
+		}
+		resp, err := r.do(req)
+		if err != nil {
+			return nil, fmt.Errorf("list tags of %s: %w", image, err)
+		}
+		var page struct {
+			Tags []string `json:"tags"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("decode tags of %s: %w", image, err)
+		}
+		tags = append(tags, page.Tags...)
+		next, err = nextPage(req.URL, resp.Header.Get(headerLink))
+		if err != nil {
+			return nil, fmt.Errorf("parse next page of tags of %s: %w", image, err)
+		}
+	}
+	return tags, nil
+}
+
+// Digest returns the digest of the manifest that the tag of an image repository points to.
+func (r *Registry) Digest(image, tag string) (string, error) {
+	host, repo := parseRepository(image)
+	req, err := http.NewRequest(http.MethodHead, fmt.Sprintf("%s://%s/v2/%s/manifests/%s", r.scheme, host, repo, tag), nil)
+	if err != nil {
+		return "", fmt.Errorf("create request to get manifest of %s:%s: %w", image, tag, err)
+	}
+	req.Header.Set(headerAccept, strings.Join(manifestMediaTypes, ", "))
+	resp, err := r.do(req)
+	if err != nil {
+		return "", fmt.Errorf("get manifest of %s:%s: %w", image, tag, err)
+	}
+	resp.Body.Close()
+	digest := resp.Header.Get(headerContentDigest)
+	if digest == "" {
+		return "", fmt.Errorf("registry %s did not return the digest of %s:%s", host, image, tag)
+	}
+	return digest, nil
+}
+
+// do sends the request, and if the registry requires authentication, sends it again with the credentials it asks for.
+func (r *Registry) do(req *http.Request) (*http.Response, error) {
+	resp, err := r.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		resp.Body.Close()
+		auth, err := r.authorization(req.URL.Host, resp.Header.Get(headerAuthenticate))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set(headerAuthorization, auth)
+		if resp, err = r.http.Do(req); err != nil {
+			return nil, err
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return resp, nil
+}
+
+// authorization returns the value of the Authorization header that answers the authentication challenge of the registry host.
+func (r *Registry) authorization(host, challenge string) (string, error) {
+	switch {
+	case strings.HasPrefix(challenge, bearerChallengeScheme):
+		token, err := r.token(host, challenge)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Bearer %s", token), nil
+	case strings.HasPrefix(challenge, basicChallengeScheme):
+		username, secret, err := r.credentials(host)
+		if err != nil {
+			return "", &ErrCredentialsRequired{
+				Host: host,
+				err:  err,
+			}
+		}
+		return fmt.Sprintf("Basic %s", base64.StdEncoding.EncodeToString([]byte(username+":"+secret))), nil
+	default:
+		return "", fmt.Errorf("unsupported authentication challenge %q", challenge)
+	}
+}
+
+// credentials returns the username and secret to access the registry host.
+func (r *Registry) credentials(host string) (username, secret string, err error) {
+	if r.creds == nil {
+		return "", "", errNoCredentials
+	}
+	return r.creds.Credentials(host)
+}
+
+// token requests a token from the authorization server of a bearer challenge,
+// with the credentials of the registry host if there are any or anonymously otherwise.
+// See https://docs.docker.com/registry/spec/auth/token/
+func (r *Registry) token(host, challenge string) (string, error) {
+	params := make(map[string]string)
+	for _, match := range challengeParamRegexp.FindAllStringSubmatch(challenge, -1) {
+		params[match[1]] = match[2]
+	}
+	realm, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return "", fmt.Errorf("invalid realm in authentication challenge %q", challenge)
+	}
+	query := realm.Query()
+	for _, key := range []string{"service", "scope"} {
+		if params[key] != "" {
+			query.Set(key, params[key])
+		}
+	}
+	realm.RawQuery = query.Encode()
+	req, err := http.NewRequest(http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("create request for token: %w", err)
+	}
+	if username, secret, err := r.credentials(host); err == nil {
+		req.SetBasicAuth(username, secret)
+	}
+	resp, err := r.http.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("request token from %s: %w", realm.Host, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("request token from %s: unexpected status %s", realm.Host, resp.Status)
+	}
+	var out struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", fmt.Errorf("decode token from %s: %w", realm.Host, err)
+	}
+	if out.Token != "" {
+		return out.Token, nil
+	}
+	return out.AccessToken, nil
+}
+
+// parseRepository returns the registry host and the repository path of an image name.
+// Names without a registry host refer to Docker Hub, where official images are under "library/".
+func parseRepository(image string) (host, repo string) {
+	parts := strings.SplitN(image, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		host, repo = parts[0], parts[1]
+	} else {
+		host, repo = dockerHubHost, image
+	}
+	if host != dockerHubHost {
+		return host, repo
+	}
+	if !strings.Contains(repo, "/") {
+		repo = officialImagesPrefix + repo
+	}
+	return dockerHubRegistryHost, repo
+}
+
+// nextPage returns the URL of the next page from a Link header, or an empty string if it's the last page.
+func nextPage(current *url.URL, link string) (string, error) {
+	match := nextLinkRegexp.FindStringSubmatch(link)
+	if match == nil {
+		return "", nil
+	}
+	next, err := current.Parse(match[1])
+	if err != nil {
+		return "", err
+	}
+	return next.String(), nil
+}
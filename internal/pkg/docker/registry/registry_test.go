@@ -0,0 +1,206 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRegistry returns a registry server that requires an anonymous token to pull "team/api".
+func newTestRegistry(t *testing.T) *httptest.Server {
+	var srv *httptest.Server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "registry.test", r.URL.Query().Get("service"))
+		require.Equal(t, "repository:team/api:pull", r.URL.Query().Get("scope"))
+		fmt.Fprint(w, `{"token": "anonymous"}`)
+	})
+	mux.HandleFunc("/v2/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer anonymous" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry.test",scope="repository:team/api:pull"`, srv.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/v2/team/api/tags/list":
+			if r.URL.Query().Get("last") == "" {
+				w.Header().Set("Link", `</v2/team/api/tags/list?last=1.0>; rel="next"`)
+				fmt.Fprint(w, `{"name": "team/api", "tags": ["latest", "1.0"]}`)
+				return
+			}
+			fmt.Fprint(w, `{"name": "team/api", "tags": ["1.1"]}`)
+		case "/v2/team/api/manifests/1.1":
+			require.Equal(t, http.MethodHead, r.Method)
+			require.True(t, strings.Contains(r.Header.Get("Accept"), "application/vnd.oci.image.index.v1+json"))
+			w.Header().Set("Docker-Content-Digest", "sha256:1a2b3c")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	srv = httptest.NewServer(mux)
+	return srv
+}
+
+func TestRegistry_Tags(t *testing.T) {
+	srv := newTestRegistry(t)
+	defer srv.Close()
+	r := &Registry{
+		http:   srv.Client(),
+		scheme: "http",
+	}
+	host := strings.TrimPrefix(srv.URL, "http://")
+
+	t.Run("should list the tags of all the pages", func(t *testing.T) {
+		tags, err := r.Tags(host + "/team/api")
+		require.NoError(t, err)
+		require.Equal(t, []string{"latest", "1.0", "1.1"}, tags)
+	})
+	t.Run("should return an error if the repository does not exist", func(t *testing.T) {
+		_, err := r.Tags(host + "/team/web")
+		require.EqualError(t, err, fmt.Sprintf("list tags of %s/team/web: unexpected status 404 Not Found", host))
+	})
+}
+
+func TestRegistry_Digest(t *testing.T) {
+	srv := newTestRegistry(t)
+	defer srv.Close()
+	r := &Registry{
+		http:   srv.Client(),
+		scheme: "http",
+	}
+	host := strings.TrimPrefix(srv.URL, "http://")
+
+	t.Run("should return the digest of the tag", func(t *testing.T) {
+		digest, err := r.Digest(host+"/team/api", "1.1")
+		require.NoError(t, err)
+		require.Equal(t, "sha256:1a2b3c", digest)
+	})
+	t.Run("should return an error if the tag does not exist", func(t *testing.T) {
+		_, err := r.Digest(host+"/team/api", "2.0")
+		require.EqualError(t, err, fmt.Sprintf("get manifest of %s/team/api:2.0: unexpected status 404 Not Found", host))
+	})
+}
+
+func Test_parseRepository(t *testing.T) {
+	testCases := map[string]struct {
+		in         string
+		wantedHost string
+		wantedRepo string
+	}{
+		"official Docker Hub image": {
+			in:         "nginx",
+			wantedHost: "registry-1.docker.io",
+			wantedRepo: "library/nginx",
+		},
+		"Docker Hub image of an organization": {
+			in:         "bitnami/redis",
+			wantedHost: "registry-1.docker.io",
+			wantedRepo: "bitnami/redis",
+		},
+		"explicit Docker Hub host": {
+			in:         "docker.io/nginx",
+			wantedHost: "registry-1.docker.io",
+			wantedRepo: "library/nginx",
+		},
+		"Amazon ECR Public image": {
+			in:         "public.ecr.aws/docker/library/nginx",
+			wantedHost: "public.ecr.aws",
+			wantedRepo: "docker/library/nginx",
+		},
+		"registry with a port": {
+			in:         "localhost:5000/nginx",
+			wantedHost: "localhost:5000",
+			wantedRepo: "nginx",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			host, repo := parseRepository(tc.in)
+			require.Equal(t, tc.wantedHost, host)
+			require.Equal(t, tc.wantedRepo, repo)
+		})
+	}
+}
+
+type staticCredentials struct {
+	username, secret string
+	err              error
+}
+
+func (c staticCredentials) Credentials(_ string) (string, string, error) {
+	return c.username, c.secret, c.err
+}
+
+func TestRegistry_BasicAuthentication(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, secret, ok := r.BasicAuth(); !ok || username != "AWS" || secret != "token" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="registry.test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"name": "team/api", "tags": ["1.0"]}`)
+	}))
+	defer srv.Close()
+	host := strings.TrimPrefix(srv.URL, "http://")
+
+	t.Run("should send the credentials of the registry", func(t *testing.T) {
+		r := &Registry{
+			http:   srv.Client(),
+			creds:  staticCredentials{username: "AWS", secret: "token"},
+			scheme: "http",
+		}
+		tags, err := r.Tags(host + "/team/api")
+		require.NoError(t, err)
+		require.Equal(t, []string{"1.0"}, tags)
+	})
+	t.Run("should return an error if there are no credentials for the registry", func(t *testing.T) {
+		r := &Registry{
+			http:   srv.Client(),
+			creds:  staticCredentials{err: errNoCredentials},
+			scheme: "http",
+		}
+		_, err := r.Tags(host + "/team/api")
+		var errCreds *ErrCredentialsRequired
+		require.ErrorAs(t, err, &errCreds)
+		require.EqualError(t, err, fmt.Sprintf("list tags of %s/team/api: registry %s requires credentials: no credentials are configured in the Docker config file", host, host))
+	})
+}
+
+func TestRegistry_AuthenticatedToken(t *testing.T) {
+	var srv *httptest.Server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if username, secret, ok := r.BasicAuth(); ok && username == "user" && secret == "secret" {
+			fmt.Fprint(w, `{"token": "private"}`)
+			return
+		}
+		fmt.Fprint(w, `{"token": "anonymous"}`)
+	})
+	mux.HandleFunc("/v2/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer private" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry.test",scope="repository:team/private:pull"`, srv.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"name": "team/private", "tags": ["1.0"]}`)
+	})
+	srv = httptest.NewServer(mux)
+	defer srv.Close()
+	r := &Registry{
+		http:   srv.Client(),
+		creds:  staticCredentials{username: "user", secret: "secret"},
+		scheme: "http",
+	}
+	host := strings.TrimPrefix(srv.URL, "http://")
+
+	tags, err := r.Tags(host + "/team/private")
+	require.NoError(t, err)
+	require.Equal(t, []string{"1.0"}, tags)
+}
@@ -0,0 +1,78 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// versionTagRegexp matches tags such as "18", "v1.2.3" or "3.11-slim-bullseye".
+var versionTagRegexp = regexp.MustCompile(`^(v?)(\d+(?:\.\d+)*)(.*)$`)
+
+type versionTag struct {
+	prefix  string
+	numbers []int
+	suffix  string
+}
+
+func parseVersionTag(tag string) (versionTag, bool) {
+	match := versionTagRegexp.FindStringSubmatch(tag)
+	if match == nil {
+		return versionTag{}, false
+	}
+	v := versionTag{
+		prefix: match[1],
+		suffix: match[3],
+	}
+	for _, s := range strings.Split(match[2], ".") {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return versionTag{}, false
+		}
+		v.numbers = append(v.numbers, n)
+	}
+	return v, true
+}
+
+// sameFormat returns true if both tags have the same prefix, suffix and number of version components.
+func (v versionTag) sameFormat(other versionTag) bool {
+	return v.prefix == other.prefix && v.suffix == other.suffix && len(v.numbers) == len(other.numbers)
+}
+
+// less returns true if v is an older version than other.
+func (v versionTag) less(other versionTag) bool {
+	for i := range v.numbers {
+		if v.numbers[i] != other.numbers[i] {
+			return v.numbers[i] < other.numbers[i]
+		}
+	}
+	return false
+}
+
+// IsVersionTag returns true if the tag starts with a version number, such as "18-alpine" or "v1.2.3".
+func IsVersionTag(tag string) bool {
+	_, ok := parseVersionTag(tag)
+	return ok
+}
+
+// LatestVersionTag returns the newest tag that has the same format as the current tag, for example
+// "18.2-alpine" for "18.1-alpine" among "18.2-alpine", "18.2" and "18.2.1-alpine".
+// If the current tag is not a version or no tag is newer, then returns false.
+func LatestVersionTag(current string, tags []string) (string, bool) {
+	latest, ok := parseVersionTag(current)
+	if !ok {
+		return "", false
+	}
+	var latestTag string
+	for _, tag := range tags {
+		v, ok := parseVersionTag(tag)
+		if !ok || !latest.sameFormat(v) || !latest.less(v) {
+			continue
+		}
+		latest, latestTag = v, tag
+	}
+	return latestTag, latestTag != ""
+}
@@ -0,0 +1,61 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatestVersionTag(t *testing.T) {
+	testCases := map[string]struct {
+		current string
+		tags    []string
+
+		wantedTag string
+		wantedOK  bool
+	}{
+		"not a version": {
+			current: "latest",
+			tags:    []string{"latest", "1.0"},
+		},
+		"already the latest version": {
+			current: "1.23",
+			tags:    []string{"1.22", "1.23", "latest"},
+		},
+		"newer major version": {
+			current:   "16-alpine",
+			tags:      []string{"16-alpine", "18-alpine", "20-alpine", "20", "20.1-alpine"},
+			wantedTag: "20-alpine",
+			wantedOK:  true,
+		},
+		"compare versions numerically": {
+			current:   "v1.9.0",
+			tags:      []string{"v1.9.0", "v1.10.1", "v1.10.0", "1.11.0", "v1.11.0-rc1"},
+			wantedTag: "v1.10.1",
+			wantedOK:  true,
+		},
+		"keep the same variant": {
+			current:   "3.10-slim-bullseye",
+			tags:      []string{"3.11-slim-bookworm", "3.11-slim-bullseye", "3.12-slim"},
+			wantedTag: "3.11-slim-bullseye",
+			wantedOK:  true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tag, ok := LatestVersionTag(tc.current, tc.tags)
+			require.Equal(t, tc.wantedTag, tag)
+			require.Equal(t, tc.wantedOK, ok)
+		})
+	}
+}
+
+func TestIsVersionTag(t *testing.T) {
+	require.True(t, IsVersionTag("18-alpine"))
+	require.True(t, IsVersionTag("v1.2.3"))
+	require.False(t, IsVersionTag("latest"))
+	require.False(t, IsVersionTag("bookworm"))
+}
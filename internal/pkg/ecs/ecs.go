@@ -15,6 +15,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -25,6 +26,8 @@ const (
 	fmtTaskTaskDefinitionFamily     = "copilot-%s"
 	clusterResourceType             = "ecs:cluster"
 	serviceResourceType             = "ecs:service"
+	fmtEnvStackName                 = "%s-%s"
+	envOutputClusterARN             = "ClusterArn"
 
 	taskStopReason = "Task stopped because the underlying CloudFormation stack was deleted."
 )
@@ -47,6 +50,10 @@ type ecsClient interface {
 	DescribeTasks(cluster string, taskARNs []string) ([]*ecs.Task, error)
 }
 
+type stackDescriber interface {
+	Describe(name string) (*cloudformation.StackDescription, error)
+}
+
 type stepFunctionsClient interface {
 	StateMachineDefinition(stateMachineARN string) (string, error)
 }
@@ -63,6 +70,7 @@ type ServiceDesc struct {
 type Client struct {
 	rgGetter       resourceGetter
 	ecsClient      ecsClient
+	stackDescriber stackDescriber
 	StepFuncClient stepFunctionsClient
 }
 
@@ -71,6 +79,7 @@ func New(sess *session.Session) *Client {
 	return &Client{
 		rgGetter:       resourcegroups.New(sess),
 		ecsClient:      ecs.New(sess),
+		stackDescriber: cloudformation.New(sess),
 		StepFuncClient: stepfunctions.New(sess),
 	}
 }
@@ -389,7 +398,8 @@ func (c Client) clusterARN(app, env string) (string, error) {
 	}
 
 	if len(clusters) == 0 {
-		return "", fmt.Errorf("no cluster found in environment %s", env)
+		// The cluster isn't tagged by Copilot if it was imported into the environment.
+		return c.importedClusterARN(app, env)
 	}
 
 	// NOTE: only one cluster is associated with an application and an environment.
@@ -399,6 +409,20 @@ func (c Client) clusterARN(app, env string) (string, error) {
 	return clusters[0].ARN, nil
 }
 
+func (c Client) importedClusterARN(app, env string) (string, error) {
+	stackName := fmt.Sprintf(fmtEnvStackName, app, env)
+	stack, err := c.stackDescriber.Describe(stackName)
+	if err != nil {
+		return "", fmt.Errorf("describe environment stack %s: %w", stackName, err)
+	}
+	for _, output := range stack.Outputs {
+		if aws.StringValue(output.OutputKey) == envOutputClusterARN {
+			return aws.StringValue(output.OutputValue), nil
+		}
+	}
+	return "", fmt.Errorf("no cluster found in environment %s", env)
+}
+
 func (c Client) fetchAndParseServiceARN(app, env, svc string) (cluster, service string, err error) {
 	svcARN, err := c.serviceARN(app, env, svc)
 	if err != nil {
@@ -10,7 +10,9 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -22,6 +24,7 @@ import (
 type clientMocks struct {
 	resourceGetter *mocks.MockresourceGetter
 	ecsClient      *mocks.MockecsClient
+	stackDescriber *mocks.MockstackDescriber
 	StepFuncClient *mocks.MockstepFunctionsClient
 }
 
@@ -51,15 +54,43 @@ func TestClient_ClusterARN(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("get cluster resources for environment mockEnv: some error"),
 		},
+		"errors if fail to describe the environment stack": {
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, getRgInput).
+						Return([]*resourcegroups.Resource{}, nil),
+					m.stackDescriber.EXPECT().Describe("mockApp-mockEnv").Return(nil, testError),
+				)
+			},
+			wantedError: fmt.Errorf("describe environment stack mockApp-mockEnv: some error"),
+		},
 		"errors if no cluster found": {
 			setupMocks: func(m clientMocks) {
 				gomock.InOrder(
 					m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, getRgInput).
 						Return([]*resourcegroups.Resource{}, nil),
+					m.stackDescriber.EXPECT().Describe("mockApp-mockEnv").Return(&cloudformation.StackDescription{}, nil),
 				)
 			},
 			wantedError: fmt.Errorf("no cluster found in environment mockEnv"),
 		},
+		"success with an imported cluster": {
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, getRgInput).
+						Return([]*resourcegroups.Resource{}, nil),
+					m.stackDescriber.EXPECT().Describe("mockApp-mockEnv").Return(&cloudformation.StackDescription{
+						Outputs: []*sdkcloudformation.Output{
+							{
+								OutputKey:   aws.String("ClusterArn"),
+								OutputValue: aws.String("arn:aws:ecs:us-west-2:1234567890:cluster/shared"),
+							},
+						},
+					}, nil),
+				)
+			},
+			wantedCluster: "arn:aws:ecs:us-west-2:1234567890:cluster/shared",
+		},
 		"errors if more than one cluster found": {
 			setupMocks: func(m clientMocks) {
 				gomock.InOrder(
@@ -91,14 +122,17 @@ func TestClient_ClusterARN(t *testing.T) {
 
 			// GIVEN
 			mockRgGetter := mocks.NewMockresourceGetter(ctrl)
+			mockStackDescriber := mocks.NewMockstackDescriber(ctrl)
 			mocks := clientMocks{
 				resourceGetter: mockRgGetter,
+				stackDescriber: mockStackDescriber,
 			}
 
 			test.setupMocks(mocks)
 
 			client := Client{
-				rgGetter: mockRgGetter,
+				rgGetter:       mockRgGetter,
+				stackDescriber: mockStackDescriber,
 			}
 
 			// WHEN
@@ -1133,6 +1167,7 @@ func Test_NetworkConfiguration(t *testing.T) {
 				gomock.InOrder(
 					m.resourceGetter.EXPECT().GetResourcesByTags(clusterResourceType, getRgInput).
 						Return([]*resourcegroups.Resource{}, nil),
+					m.stackDescriber.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{}, nil),
 				)
 			},
 			wantedError: fmt.Errorf("no cluster found in environment test"),
@@ -1188,13 +1223,15 @@ func Test_NetworkConfiguration(t *testing.T) {
 			m := clientMocks{
 				resourceGetter: mocks.NewMockresourceGetter(ctrl),
 				ecsClient:      mocks.NewMockecsClient(ctrl),
+				stackDescriber: mocks.NewMockstackDescriber(ctrl),
 			}
 
 			tc.setupMocks(m)
 
 			client := Client{
-				rgGetter:  m.resourceGetter,
-				ecsClient: m.ecsClient,
+				rgGetter:       m.resourceGetter,
+				ecsClient:      m.ecsClient,
+				stackDescriber: m.stackDescriber,
 			}
 
 			// WHEN
@@ -36,11 +36,17 @@ type CmdOption func(cmd *exec.Cmd)
 // NewCmd returns a Cmd that can run external commands.
 // By default the output of the commands is piped to stderr.
 func NewCmd() *Cmd {
+	return NewCmdWithOutput(os.Stderr)
+}
+
+// NewCmdWithOutput returns a Cmd that can run external commands.
+// By default the output of the commands is piped to w.
+func NewCmdWithOutput(w io.Writer) *Cmd {
 	return &Cmd{
 		command: func(name string, args []string, opts ...CmdOption) cmdRunner {
 			cmd := exec.Command(name, args...)
-			cmd.Stdout = os.Stderr
-			cmd.Stderr = os.Stderr
+			cmd.Stdout = w
+			cmd.Stderr = w
 			for _, opt := range opts {
 				opt(cmd)
 			}
@@ -70,6 +76,16 @@ func Stderr(writer io.Writer) CmdOption {
 	}
 }
 
+// Env appends the environment variables, in the form "key=value", to the ones inherited from the current process.
+func Env(vars ...string) CmdOption {
+	return func(c *exec.Cmd) {
+		if c.Env == nil {
+			c.Env = os.Environ()
+		}
+		c.Env = append(c.Env, vars...)
+	}
+}
+
 // Run starts the named command and waits until it finishes.
 func (c *Cmd) Run(name string, args []string, opts ...CmdOption) error {
 	cmd := c.command(name, args, opts...)
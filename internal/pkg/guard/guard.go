@@ -0,0 +1,134 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package guard validates generated CloudFormation templates against cfn-guard policy-as-code rules.
+package guard
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	osexec "os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+)
+
+const (
+	guardCommand = "cfn-guard"
+	// exitCodeNonCompliant is the exit code of "cfn-guard validate" when the data doesn't comply with the rules.
+	exitCodeNonCompliant = 19
+)
+
+//go:embed rules/*.guard
+var baselineRules embed.FS
+
+// ErrGuardCommandNotFound means the cfn-guard command is not found.
+var ErrGuardCommandNotFound = errors.New("cfn-guard: command not found, install it from https://github.com/aws-cloudformation/cloudformation-guard")
+
+// ErrRulesViolated means a template does not comply with the rules.
+type ErrRulesViolated struct {
+	Template string // Name of the template that was validated.
+	Report   string // Summary of the violated rules reported by cfn-guard.
+}
+
+func (e *ErrRulesViolated) Error() string {
+	return fmt.Sprintf("template %s violates cfn-guard rules:\n%s", e.Template, e.Report)
+}
+
+type runner interface {
+	Run(name string, args []string, options ...exec.CmdOption) error
+}
+
+type exitCoder interface {
+	ExitCode() int
+}
+
+// Validator validates templates against the rules embedded in Copilot and the rules of the organization.
+type Validator struct {
+	orgRulesDir string
+
+	runner   runner
+	lookPath func(file string) (string, error)
+	tempDir  func(dir, pattern string) (string, error)
+}
+
+// New returns a Validator that evaluates the baseline rules embedded in Copilot along with the
+// "*.guard" rule files under orgRulesDir.
+func New(orgRulesDir string) *Validator {
+	return &Validator{
+		orgRulesDir: orgRulesDir,
+		runner:      exec.NewCmd(),
+		lookPath:    osexec.LookPath,
+		tempDir:     os.MkdirTemp,
+	}
+}
+
+// Validate runs "cfn-guard validate" against the template and returns an ErrRulesViolated if any rule fails.
+func (v *Validator) Validate(name, template string) error {
+	if _, err := v.lookPath(guardCommand); err != nil {
+		return ErrGuardCommandNotFound
+	}
+	dir, err := v.tempDir("", "copilot-guard-")
+	if err != nil {
+		return fmt.Errorf("create temporary directory for cfn-guard: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	dataPath := filepath.Join(dir, fmt.Sprintf("%s.yml", name))
+	if err := os.WriteFile(dataPath, []byte(template), 0644); err != nil {
+		return fmt.Errorf("write template %s: %w", name, err)
+	}
+	rulesDir, err := writeBaselineRules(dir)
+	if err != nil {
+		return err
+	}
+	args := []string{"validate", "--data", dataPath, "--rules", rulesDir}
+	if v.orgRulesDir != "" {
+		args = append(args, "--rules", v.orgRulesDir)
+	}
+	args = append(args, "--show-summary", "fail", "--output-format", "single-line-summary")
+
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	err = v.runner.Run(guardCommand, args, exec.Stdout(stdout), exec.Stderr(stderr))
+	if err == nil {
+		return nil
+	}
+	var exitErr exitCoder
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == exitCodeNonCompliant {
+		return &ErrRulesViolated{
+			Template: name,
+			Report:   strings.TrimSpace(stdout.String()),
+		}
+	}
+	if msg := strings.TrimSpace(stderr.String()); msg != "" {
+		return fmt.Errorf("run cfn-guard validate: %w: %s", err, msg)
+	}
+	return fmt.Errorf("run cfn-guard validate: %w", err)
+}
+
+// writeBaselineRules copies the rules embedded in Copilot under dir and returns the directory holding them.
+func writeBaselineRules(dir string) (string, error) {
+	rulesDir := filepath.Join(dir, "rules")
+	if err := os.Mkdir(rulesDir, 0755); err != nil {
+		return "", fmt.Errorf("create directory for baseline rules: %w", err)
+	}
+	files, err := fs.Glob(baselineRules, "rules/*.guard")
+	if err != nil {
+		return "", fmt.Errorf("list baseline rules: %w", err)
+	}
+	for _, file := range files {
+		dat, err := baselineRules.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("read baseline rules %s: %w", file, err)
+		}
+		if err := os.WriteFile(filepath.Join(rulesDir, filepath.Base(file)), dat, 0644); err != nil {
+			return "", fmt.Errorf("write baseline rules %s: %w", file, err)
+		}
+	}
+	return rulesDir, nil
+}
@@ -0,0 +1,112 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package guard
+
+import (
+	"errors"
+	"os"
+	osexec "os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockExitError struct {
+	code int
+}
+
+func (e *mockExitError) Error() string {
+	return "exit status"
+}
+
+func (e *mockExitError) ExitCode() int {
+	return e.code
+}
+
+func TestValidator_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		orgRulesDir string
+		lookPathErr error
+		setupMocks  func(m *Mockrunner)
+
+		wantedError error
+	}{
+		"error if cfn-guard is not installed": {
+			lookPathErr: osexec.ErrNotFound,
+			setupMocks:  func(m *Mockrunner) {},
+			wantedError: ErrGuardCommandNotFound,
+		},
+		"success with only the baseline rules": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("cfn-guard", gomock.Any(), gomock.Any()).DoAndReturn(func(_ string, args []string, _ ...exec.CmdOption) error {
+					require.Len(t, args, 9)
+					require.Equal(t, "validate", args[0])
+					dat, err := os.ReadFile(args[2])
+					require.NoError(t, err)
+					require.Equal(t, "Resources: {}", string(dat))
+					rules, err := os.ReadDir(args[4])
+					require.NoError(t, err)
+					require.Equal(t, "copilot.guard", rules[0].Name())
+					require.Equal(t, []string{"--show-summary", "fail", "--output-format", "single-line-summary"}, args[5:])
+					return nil
+				})
+			},
+		},
+		"evaluate the rules of the organization": {
+			orgRulesDir: "/copilot/.guard",
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("cfn-guard", gomock.Any(), gomock.Any()).DoAndReturn(func(_ string, args []string, _ ...exec.CmdOption) error {
+					require.Equal(t, []string{"--rules", "/copilot/.guard"}, args[5:7])
+					return nil
+				})
+			},
+		},
+		"error if the template violates the rules": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("cfn-guard", gomock.Any(), gomock.Any()).Return(&mockExitError{code: 19})
+			},
+			wantedError: errors.New("template api violates cfn-guard rules:\n"),
+		},
+		"error if cfn-guard fails to run": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("cfn-guard", gomock.Any(), gomock.Any()).Return(&mockExitError{code: 5})
+			},
+			wantedError: errors.New("run cfn-guard validate: exit status"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := NewMockrunner(ctrl)
+			tc.setupMocks(m)
+			tmp := t.TempDir()
+			v := &Validator{
+				orgRulesDir: tc.orgRulesDir,
+				runner:      m,
+				lookPath: func(file string) (string, error) {
+					return filepath.Join("/usr/local/bin", file), tc.lookPathErr
+				},
+				tempDir: func(_, pattern string) (string, error) {
+					return os.MkdirTemp(tmp, pattern)
+				},
+			}
+
+			// WHEN
+			err := v.Validate("api", "Resources: {}")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/guard/guard.go
+
+// Package guard is a generated GoMock package.
+package guard
+
+import (
+	reflect "reflect"
+
+	exec "github.com/aws/copilot-cli/internal/pkg/exec"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// Mockrunner is a mock of runner interface.
+type Mockrunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockrunnerMockRecorder
+}
+
+// MockrunnerMockRecorder is the mock recorder for Mockrunner.
+type MockrunnerMockRecorder struct {
+	mock *Mockrunner
+}
+
+// NewMockrunner creates a new mock instance.
+func NewMockrunner(ctrl *gomock.Controller) *Mockrunner {
+	mock := &Mockrunner{ctrl: ctrl}
+	mock.recorder = &MockrunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockrunner) EXPECT() *MockrunnerMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *Mockrunner) Run(name string, args []string, options ...exec.CmdOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name, args}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Run", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockrunnerMockRecorder) Run(name, args interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name, args}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*Mockrunner)(nil).Run), varargs...)
+}
+
+// MockexitCoder is a mock of exitCoder interface.
+type MockexitCoder struct {
+	ctrl     *gomock.Controller
+	recorder *MockexitCoderMockRecorder
+}
+
+// MockexitCoderMockRecorder is the mock recorder for MockexitCoder.
+type MockexitCoderMockRecorder struct {
+	mock *MockexitCoder
+}
+
+// NewMockexitCoder creates a new mock instance.
+func NewMockexitCoder(ctrl *gomock.Controller) *MockexitCoder {
+	mock := &MockexitCoder{ctrl: ctrl}
+	mock.recorder = &MockexitCoderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockexitCoder) EXPECT() *MockexitCoderMockRecorder {
+	return m.recorder
+}
+
+// ExitCode mocks base method.
+func (m *MockexitCoder) ExitCode() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExitCode")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// ExitCode indicates an expected call of ExitCode.
+func (mr *MockexitCoderMockRecorder) ExitCode() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExitCode", reflect.TypeOf((*MockexitCoder)(nil).ExitCode))
+}
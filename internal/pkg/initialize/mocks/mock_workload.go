@@ -197,6 +197,21 @@ func (mr *MockWorkspaceMockRecorder) Path() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Path", reflect.TypeOf((*MockWorkspace)(nil).Path))
 }
 
+// WriteAddon mocks base method.
+func (m *MockWorkspace) WriteAddon(content encoding.BinaryMarshaler, svc, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteAddon", content, svc, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteAddon indicates an expected call of WriteAddon.
+func (mr *MockWorkspaceMockRecorder) WriteAddon(content, svc, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteAddon", reflect.TypeOf((*MockWorkspace)(nil).WriteAddon), content, svc, name)
+}
+
 // WriteJobManifest mocks base method.
 func (m *MockWorkspace) WriteJobManifest(marshaler encoding.BinaryMarshaler, jobName string) (string, error) {
 	m.ctrl.T.Helper()
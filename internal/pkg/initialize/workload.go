@@ -6,12 +6,16 @@ package initialize
 
 import (
 	"encoding"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/catalog"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
@@ -56,6 +60,7 @@ type Workspace interface {
 	Path() (string, error)
 	WriteJobManifest(marshaler encoding.BinaryMarshaler, jobName string) (string, error)
 	WriteServiceManifest(marshaler encoding.BinaryMarshaler, serviceName string) (string, error)
+	WriteAddon(content encoding.BinaryMarshaler, svc, name string) (string, error)
 }
 
 // Prog contains the methods needed to render multi-stage operations.
@@ -87,9 +92,12 @@ type JobProps struct {
 // ServiceProps contains the information needed to represent a Service (port, HealthCheck, and workload common props).
 type ServiceProps struct {
 	WorkloadProps
-	Port        uint16
-	HealthCheck manifest.ContainerHealthCheck
-	appDomain   *string
+	Port            uint16
+	HealthCheck     manifest.ContainerHealthCheck
+	Path            string            // If set, overrides the default path that routes requests to a Load Balanced Web Service.
+	HTTPHealthCheck string            // If set, the path of the HTTP health check of a Load Balanced or Request-Driven Web Service.
+	Template        *catalog.Contents // If set, the manifest and addons of the service are rendered from the catalog template.
+	appDomain       *string
 }
 
 // WorkloadInitializer holds the clients necessary to initialize either a
@@ -224,6 +232,11 @@ func (w *WorkloadInitializer) initService(props *ServiceProps) (string, error) {
 		manifestMsgFmt = "Manifest file for %s %s already exists at %s, skipping writing it.\n"
 	}
 	log.Successf(manifestMsgFmt, svcWlType, color.HighlightUserInput(props.Name), color.HighlightResource(manifestPath))
+	if props.Template != nil && !manifestExists {
+		if err := w.writeTemplateAddons(props); err != nil {
+			return "", err
+		}
+	}
 
 	helpText := "Your manifest contains configurations like your container size and port."
 	if props.Port != 0 {
@@ -239,6 +252,33 @@ func (w *WorkloadInitializer) initService(props *ServiceProps) (string, error) {
 	return manifestPath, nil
 }
 
+// writeTemplateAddons writes the addons of the catalog template under the service's "addons/" directory.
+func (w *WorkloadInitializer) writeTemplateAddons(props *ServiceProps) error {
+	names := make([]string, 0, len(props.Template.Addons))
+	for name := range props.Template.Addons {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		addonName := strings.TrimSuffix(name, filepath.Ext(name))
+		path, err := w.Ws.WriteAddon(templateFile(props.Template.Addons[name]), props.Name, addonName)
+		if err != nil {
+			var errFileExists *workspace.ErrFileExists
+			if errors.As(err, &errFileExists) {
+				continue
+			}
+			return fmt.Errorf("write addon %s of template %s: %w", name, props.Template.Name, err)
+		}
+		path, err = relPath(path)
+		if err != nil {
+			return err
+		}
+		log.Successf("Wrote the addon %s from template %s at %s\n",
+			color.HighlightUserInput(addonName), color.HighlightUserInput(props.Template.Name), color.HighlightResource(path))
+	}
+	return nil
+}
+
 func (w *WorkloadInitializer) addSvcToAppAndSSM(app *config.Application, props WorkloadProps) error {
 	return w.addWlToAppAndSSM(app, props, svcWlType)
 }
@@ -288,6 +328,9 @@ func newJobManifest(i *JobProps) (encoding.BinaryMarshaler, error) {
 }
 
 func (w *WorkloadInitializer) newServiceManifest(i *ServiceProps) (encoding.BinaryMarshaler, error) {
+	if i.Template != nil {
+		return newTemplateServiceManifest(i)
+	}
 	switch i.Type {
 	case manifest.LoadBalancedWebServiceType:
 		return w.newLoadBalancedWebServiceManifest(i)
@@ -302,6 +345,30 @@ func (w *WorkloadInitializer) newServiceManifest(i *ServiceProps) (encoding.Bina
 	}
 }
 
+func newTemplateServiceManifest(i *ServiceProps) (encoding.BinaryMarshaler, error) {
+	if i.Template.Type != i.Type {
+		return nil, fmt.Errorf("template %s is for a %s, not a %s", i.Template.Name, i.Template.Type, i.Type)
+	}
+	out, err := i.Template.RenderManifest(catalog.ManifestData{
+		Name:       i.Name,
+		Dockerfile: i.DockerfilePath,
+		Image:      i.Image,
+		Port:       i.Port,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return templateFile(out), nil
+}
+
+// templateFile is the already rendered content of a catalog template file.
+type templateFile []byte
+
+// MarshalBinary returns the content of the file as is.
+func (f templateFile) MarshalBinary() ([]byte, error) {
+	return f, nil
+}
+
 func (w *WorkloadInitializer) newLoadBalancedWebServiceManifest(i *ServiceProps) (*manifest.LoadBalancedWebService, error) {
 	var httpVersion string
 	if i.Port == commonGRPCPort {
@@ -315,11 +382,16 @@ func (w *WorkloadInitializer) newLoadBalancedWebServiceManifest(i *ServiceProps)
 			Dockerfile: i.DockerfilePath,
 			Image:      i.Image,
 		},
-		Path:        "/",
-		Port:        i.Port,
-		HTTPVersion: httpVersion,
-		HealthCheck: i.HealthCheck,
-		Platform:    i.Platform,
+		Path:            "/",
+		Port:            i.Port,
+		HTTPVersion:     httpVersion,
+		HealthCheck:     i.HealthCheck,
+		HTTPHealthCheck: i.HTTPHealthCheck,
+		Platform:        i.Platform,
+	}
+	if i.Path != "" {
+		props.Path = i.Path
+		return manifest.NewLoadBalancedWebService(props), nil
 	}
 	existingSvcs, err := w.Store.ListServices(i.App)
 	if err != nil {
@@ -343,8 +415,9 @@ func (w *WorkloadInitializer) newRequestDrivenWebServiceManifest(i *ServiceProps
 			Dockerfile: i.DockerfilePath,
 			Image:      i.Image,
 		},
-		Port:     i.Port,
-		Platform: i.Platform,
+		Port:            i.Port,
+		HTTPHealthCheck: i.HTTPHealthCheck,
+		Platform:        i.Platform,
 	}
 	return manifest.NewRequestDrivenWebService(props)
 }
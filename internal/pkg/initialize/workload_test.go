@@ -3,16 +3,19 @@
 package initialize
 
 import (
+	"encoding"
 	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/catalog"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/initialize/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -263,10 +266,13 @@ func TestAppInitOpts_createLoadBalancedAppManifest(t *testing.T) {
 		inSvcName        string
 		inDockerfilePath string
 		inAppName        string
+		inPath           string
+		inHealthCheck    string
 		mockstore        func(m *mocks.MockStore)
 
-		wantedErr  error
-		wantedPath string
+		wantedErr         error
+		wantedPath        string
+		wantedHealthCheck string
 	}{
 		"creates manifest with / as the path when there are no other apps": {
 			inAppName:        "app",
@@ -331,6 +337,17 @@ func TestAppInitOpts_createLoadBalancedAppManifest(t *testing.T) {
 
 			wantedPath: "frontend",
 		},
+		"creates manifest with the given path and health check": {
+			inAppName:        "app",
+			inSvcName:        "api",
+			inSvcPort:        8080,
+			inDockerfilePath: "/Dockerfile",
+			inPath:           "v1",
+			inHealthCheck:    "/v1/healthz",
+
+			wantedPath:        "v1",
+			wantedHealthCheck: "/v1/healthz",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -350,7 +367,9 @@ func TestAppInitOpts_createLoadBalancedAppManifest(t *testing.T) {
 					App:            tc.inAppName,
 					DockerfilePath: tc.inDockerfilePath,
 				},
-				Port: tc.inSvcPort,
+				Port:            tc.inSvcPort,
+				Path:            tc.inPath,
+				HTTPHealthCheck: tc.inHealthCheck,
 			}
 
 			initter := &WorkloadInitializer{
@@ -367,6 +386,7 @@ func TestAppInitOpts_createLoadBalancedAppManifest(t *testing.T) {
 				require.Equal(t, tc.inSvcPort, aws.Uint16Value(manifest.ImageConfig.Port))
 				require.Contains(t, tc.inDockerfilePath, aws.StringValue(manifest.ImageConfig.Image.Build.BuildArgs.Dockerfile))
 				require.Equal(t, tc.wantedPath, aws.StringValue(manifest.RoutingRule.Path))
+				require.Equal(t, tc.wantedHealthCheck, aws.StringValue(manifest.RoutingRule.HealthCheck.HealthCheckArgs.Path))
 			} else {
 				require.EqualError(t, err, tc.wantedErr.Error())
 			}
@@ -446,6 +466,7 @@ func TestWorkloadInitializer_Service(t *testing.T) {
 		inImage          string
 		inHealthCheck    manifest.ContainerHealthCheck
 		inTopics         []manifest.TopicSubscription
+		inTemplate       *catalog.Contents
 
 		mockWriter      func(m *mocks.MockWorkspace)
 		mockstore       func(m *mocks.MockStore)
@@ -764,6 +785,65 @@ func TestWorkloadInitializer_Service(t *testing.T) {
 				m.EXPECT().Stop(log.Ssuccessf(fmtAddWlToAppComplete, "service", "worker"))
 			},
 		},
+		"returns an error if the template is for a different service type": {
+			inSvcType:        manifest.BackendServiceType,
+			inAppName:        "app",
+			inSvcName:        "api",
+			inDockerfilePath: "api/Dockerfile",
+			inTemplate: &catalog.Contents{
+				Template: catalog.Template{Name: "go-api", Type: manifest.LoadBalancedWebServiceType},
+			},
+
+			mockWriter: func(m *mocks.MockWorkspace) {
+				m.EXPECT().Path().Return("/", nil)
+			},
+			mockstore: func(m *mocks.MockStore) {
+				m.EXPECT().GetApplication("app").Return(&config.Application{Name: "app"}, nil)
+			},
+
+			wantedErr: errors.New("template go-api is for a Load Balanced Web Service, not a Backend Service"),
+		},
+		"writes the manifest and addons rendered from the template": {
+			inSvcType:        manifest.LoadBalancedWebServiceType,
+			inAppName:        "app",
+			inSvcName:        "api",
+			inDockerfilePath: "/ws/api/Dockerfile",
+			inSvcPort:        8080,
+			inTemplate: &catalog.Contents{
+				Template: catalog.Template{Name: "go-api", Type: manifest.LoadBalancedWebServiceType},
+				Manifest: []byte("name: {{.Name}}\nbuild: {{.Dockerfile}}\nport: {{.Port}}"),
+				Addons: map[string][]byte{
+					"table.yml":  []byte("table"),
+					"bucket.yml": []byte("bucket"),
+				},
+			},
+
+			mockWriter: func(m *mocks.MockWorkspace) {
+				m.EXPECT().Path().Return("/ws", nil)
+				m.EXPECT().WriteServiceManifest(gomock.Any(), "api").
+					DoAndReturn(func(mf encoding.BinaryMarshaler, _ string) (string, error) {
+						out, err := mf.MarshalBinary()
+						require.NoError(t, err)
+						require.Equal(t, "name: api\nbuild: api/Dockerfile\nport: 8080", string(out))
+						return "/ws/api/manifest.yml", nil
+					})
+				gomock.InOrder(
+					m.EXPECT().WriteAddon(gomock.Any(), "api", "bucket").Return("/ws/api/addons/bucket.yml", nil),
+					m.EXPECT().WriteAddon(gomock.Any(), "api", "table").Return("", &workspace.ErrFileExists{FileName: "/ws/api/addons/table.yml"}),
+				)
+			},
+			mockstore: func(m *mocks.MockStore) {
+				m.EXPECT().CreateService(gomock.Any()).Return(nil)
+				m.EXPECT().GetApplication("app").Return(&config.Application{Name: "app"}, nil)
+			},
+			mockappDeployer: func(m *mocks.MockWorkloadAdder) {
+				m.EXPECT().AddServiceToApp(gomock.Any(), "api")
+			},
+			mockProg: func(m *mocks.MockProg) {
+				m.EXPECT().Start(gomock.Any())
+				m.EXPECT().Stop(gomock.Any())
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -809,6 +889,7 @@ func TestWorkloadInitializer_Service(t *testing.T) {
 				},
 				Port:        tc.inSvcPort,
 				HealthCheck: tc.inHealthCheck,
+				Template:    tc.inTemplate,
 			})
 
 			// THEN
@@ -36,6 +36,7 @@ type BackendServiceConfig struct {
 	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
 	DeployConfig     DeploymentConfiguration   `yaml:"deployment"`
 	Observability    Observability             `yaml:"observability"`
+	Alarms           []WorkloadAlarm           `yaml:"alarms"`
 }
 
 // BackendServiceProps represents the configuration needed to create a backend service.
@@ -114,6 +115,16 @@ func (s *BackendService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.ImageConfig.Image.BuildConfig(wsRoot)
 }
 
+// ImageTagStrategy returns how the image built for the service should be tagged.
+func (s *BackendService) ImageTagStrategy() ImageTagStrategy {
+	return s.ImageConfig.Image.TagStrategy
+}
+
+// ImagePlatforms returns the platforms to build a multi-architecture image of the service for.
+func (s *BackendService) ImagePlatforms() []string {
+	return s.ImageConfig.Image.Platforms
+}
+
 // EnvFile returns the location of the env file against the ws root directory.
 func (s *BackendService) EnvFile() string {
 	return aws.StringValue(s.TaskConfig.EnvFile)
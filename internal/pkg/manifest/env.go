@@ -7,11 +7,15 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	"golang.org/x/mod/semver"
 	"gopkg.in/yaml.v3"
 )
 
@@ -55,6 +59,9 @@ func FromEnvConfig(cfg *config.Environment, parser template.Parser) *Environment
 	var obs environmentObservability
 	obs.loadObsConfig(cfg.Telemetry)
 
+	var cluster environmentClusterConfig
+	cluster.loadClusterConfig(cfg.CustomConfig)
+
 	return &Environment{
 		Workload: Workload{
 			Name: stringP(cfg.Name),
@@ -64,6 +71,7 @@ func FromEnvConfig(cfg *config.Environment, parser template.Parser) *Environment
 			Network: environmentNetworkConfig{
 				VPC: vpc,
 			},
+			Cluster:       cluster,
 			HTTPConfig:    http,
 			Observability: obs,
 		},
@@ -85,9 +93,14 @@ func (e *Environment) MarshalBinary() ([]byte, error) {
 
 type environmentConfig struct {
 	Network       environmentNetworkConfig `yaml:"network,omitempty"`
+	Cluster       environmentClusterConfig `yaml:"cluster,omitempty"`
 	Observability environmentObservability `yaml:"observability,omitempty"`
 	HTTPConfig    environmentHTTPConfig    `yaml:"http,omitempty"`
 	CDNConfig     environmentCDNConfig     `yaml:"cdn,omitempty,flow"`
+	Features      environmentFeatures      `yaml:"features,omitempty"`
+	Hooks         environmentHooks         `yaml:"hooks,omitempty"`
+	Deployment    environmentDeployment    `yaml:"deployment,omitempty"`
+	Storage       environmentStorageConfig `yaml:"storage,omitempty"`
 }
 
 type environmentNetworkConfig struct {
@@ -98,6 +111,45 @@ type environmentVPCConfig struct {
 	ID      *string              `yaml:"id,omitempty"`
 	CIDR    *IPNet               `yaml:"cidr,omitempty"`
 	Subnets subnetsConfiguration `yaml:"subnets,omitempty"`
+
+	// Existing resources of an imported VPC to use instead of creating new ones.
+	SecurityGroup *string                  `yaml:"security_group,omitempty"`
+	RouteTables   routeTablesConfiguration `yaml:"route_tables,omitempty"`
+
+	Attachments []vpcAttachment `yaml:"attachments,omitempty"`
+}
+
+// vpcAttachment routes traffic to networks outside the VPC through a transit gateway or a VPC peering connection.
+type vpcAttachment struct {
+	TransitGateway    *string `yaml:"transit_gateway,omitempty"`
+	PeeringConnection *string `yaml:"peering_connection,omitempty"`
+	CIDRs             []IPNet `yaml:"cidrs,omitempty"` // Destination CIDRs of the networks reachable through the attachment.
+}
+
+type environmentClusterConfig struct {
+	ID *string `yaml:"id,omitempty"` // Name or ARN of an existing ECS cluster to use instead of creating one.
+}
+
+// IsEmpty returns true if the environment creates its own cluster.
+func (cfg environmentClusterConfig) IsEmpty() bool {
+	return cfg.ID == nil
+}
+
+// ImportedClusterName returns the name of the imported ECS cluster, or an empty string if the environment creates its own cluster.
+func (cfg environmentClusterConfig) ImportedClusterName() string {
+	id := aws.StringValue(cfg.ID)
+	parsed, err := arn.Parse(id)
+	if err != nil {
+		return id
+	}
+	return strings.TrimPrefix(parsed.Resource, "cluster/")
+}
+
+func (cfg *environmentClusterConfig) loadClusterConfig(env *config.CustomizeEnv) {
+	if env.IsEmpty() || env.ImportCluster == "" {
+		return
+	}
+	cfg.ID = stringP(env.ImportCluster)
 }
 
 type environmentCDNConfig struct {
@@ -149,9 +201,178 @@ func (cfg *environmentCDNConfig) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// Experimental environment features that can be turned on under the "features" field.
+const (
+	EnvFeatureServiceConnect = "service_connect"
+)
+
+// environmentFeatures gates experimental template functionality for the environment.
+type environmentFeatures struct {
+	ServiceConnect environmentFeature `yaml:"service_connect,omitempty"`
+}
+
+// environmentFeature represents an experimental feature that can be turned on with a bool or a composite-style map.
+type environmentFeature struct {
+	Enabled       *bool   `yaml:"enabled,omitempty"`
+	MinCLIVersion *string `yaml:"min_cli_version,omitempty"` // Minimum version of the CLI allowed to deploy the environment with the feature.
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the environmentFeature
+// struct, allowing it to perform more complex unmarshaling behavior.
+// This method implements the yaml.Unmarshaler (v3) interface.
+func (f *environmentFeature) UnmarshalYAML(value *yaml.Node) error {
+	var enabled bool
+	if err := value.Decode(&enabled); err == nil {
+		f.Enabled = aws.Bool(enabled)
+		return nil
+	}
+	type feature environmentFeature
+	if err := value.Decode((*feature)(f)); err != nil {
+		return errors.New(`unable to unmarshal into bool or composite-style map`)
+	}
+	return nil
+}
+
+func (f environmentFeatures) byName() map[string]environmentFeature {
+	return map[string]environmentFeature{
+		EnvFeatureServiceConnect: f.ServiceConnect,
+	}
+}
+
+// Enabled returns the names of the experimental features turned on for the environment in alphabetical order.
+func (f environmentFeatures) Enabled() []string {
+	var enabled []string
+	for name, feature := range f.byName() {
+		if aws.BoolValue(feature.Enabled) {
+			enabled = append(enabled, name)
+		}
+	}
+	sort.Strings(enabled)
+	return enabled
+}
+
+// ServiceConnectEnabled returns true if the cluster of the environment should default to its
+// service discovery namespace for Service Connect.
+func (f environmentFeatures) ServiceConnectEnabled() bool {
+	return aws.BoolValue(f.ServiceConnect.Enabled)
+}
+
+// ValidateCLIVersion returns an error if an enabled feature requires a newer version of the CLI than cliVersion.
+// Development builds without a valid semantic version are always allowed.
+func (f environmentFeatures) ValidateCLIVersion(cliVersion string) error {
+	current := canonicalSemver(cliVersion)
+	if !semver.IsValid(current) {
+		return nil
+	}
+	for _, name := range f.Enabled() {
+		feature := f.byName()[name]
+		if feature.MinCLIVersion == nil {
+			continue
+		}
+		if semver.Compare(current, canonicalSemver(aws.StringValue(feature.MinCLIVersion))) < 0 {
+			return fmt.Errorf("feature %q requires Copilot CLI %s or later, but the current version is %s",
+				name, aws.StringValue(feature.MinCLIVersion), cliVersion)
+		}
+	}
+	return nil
+}
+
+// canonicalSemver adds the "v" prefix expected by the semver package if it's missing.
+func canonicalSemver(version string) string {
+	if strings.HasPrefix(version, "v") {
+		return version
+	}
+	return "v" + version
+}
+
+// Failure modes of a deployment hook.
+const (
+	HookOnFailureAbort = "abort"
+	HookOnFailureWarn  = "warn"
+)
+
+// environmentHooks holds the hooks to run before and after the environment stack is deployed.
+type environmentHooks struct {
+	PreDeploy  []DeploymentHook `yaml:"pre_deploy,omitempty"`
+	PostDeploy []DeploymentHook `yaml:"post_deploy,omitempty"`
+}
+
+// environmentStorageConfig holds the storage shared by the workloads of the environment.
+type environmentStorageConfig struct {
+	EFS environmentEFSConfig `yaml:"efs,omitempty"`
+}
+
+// environmentEFSConfig configures the Copilot-managed EFS file system of the environment.
+type environmentEFSConfig struct {
+	// Shared creates the file system with the environment instead of with the first workload that mounts it,
+	// and only lets each workload mount it through the workload's own access point.
+	Shared *bool `yaml:"shared,omitempty"`
+}
+
+// IsEmpty returns true if no shared storage is configured.
+func (cfg environmentStorageConfig) IsEmpty() bool {
+	return cfg.EFS.Shared == nil
+}
+
+// SharedEFS returns true if the environment's EFS file system is shared by its workloads with an access point each.
+func (cfg environmentStorageConfig) SharedEFS() bool {
+	return aws.BoolValue(cfg.EFS.Shared)
+}
+
+// environmentDeployment holds the configuration used by CloudFormation to deploy the environment stack.
+type environmentDeployment struct {
+	ExecutionRole *string        `yaml:"execution_role,omitempty"` // ARN of the role assumed by CloudFormation instead of the one created by Copilot.
+	Timeout       *time.Duration `yaml:"timeout,omitempty"`        // How long to wait for the stack update to complete.
+	// Name of a bucket seeded ahead of time with the custom resources of the environment,
+	// used instead of the application's bucket when their upload is skipped.
+	CustomResourcesBucket *string `yaml:"custom_resources_bucket,omitempty"`
+}
+
+// DeploymentHook is either a shell command or an AWS Lambda function that runs around a deployment.
+type DeploymentHook struct {
+	Command   *string `yaml:"command,omitempty"`
+	Lambda    *string `yaml:"lambda,omitempty"`     // ARN of the function to invoke.
+	OnFailure *string `yaml:"on_failure,omitempty"` // Either "abort" or "warn", defaults to "abort".
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the DeploymentHook
+// struct, allowing it to perform more complex unmarshaling behavior.
+// This method implements the yaml.Unmarshaler (v3) interface.
+func (h *DeploymentHook) UnmarshalYAML(value *yaml.Node) error {
+	var command string
+	if err := value.Decode(&command); err == nil {
+		h.Command = aws.String(command)
+		return nil
+	}
+	type hook DeploymentHook
+	if err := value.Decode((*hook)(h)); err != nil {
+		return errors.New(`unable to unmarshal into string or composite-style map`)
+	}
+	return nil
+}
+
+// AbortOnFailure returns true if the deployment should stop when the hook fails.
+func (h DeploymentHook) AbortOnFailure() bool {
+	return h.OnFailure == nil || aws.StringValue(h.OnFailure) == HookOnFailureAbort
+}
+
+// String returns a human-readable description of the hook.
+func (h DeploymentHook) String() string {
+	if h.Lambda != nil {
+		return fmt.Sprintf("function %s", aws.StringValue(h.Lambda))
+	}
+	return fmt.Sprintf("command %q", aws.StringValue(h.Command))
+}
+
+// IsEmpty returns true if there are no hooks configured.
+func (h environmentHooks) IsEmpty() bool {
+	return len(h.PreDeploy) == 0 && len(h.PostDeploy) == 0
+}
+
 // IsEmpty returns true if vpc is not configured.
 func (cfg environmentVPCConfig) IsEmpty() bool {
-	return cfg.ID == nil && cfg.CIDR == nil && cfg.Subnets.IsEmpty()
+	return cfg.ID == nil && cfg.CIDR == nil && cfg.Subnets.IsEmpty() && cfg.SecurityGroup == nil && cfg.RouteTables.IsEmpty() &&
+		len(cfg.Attachments) == 0
 }
 
 func (cfg *environmentVPCConfig) loadVPCConfig(env *config.CustomizeEnv) {
@@ -227,9 +448,12 @@ func (cfg *environmentVPCConfig) ImportedVPC() *template.ImportVPC {
 		privateSubnetIDs = append(privateSubnetIDs, aws.StringValue(subnet.SubnetID))
 	}
 	return &template.ImportVPC{
-		ID:               aws.StringValue(cfg.ID),
-		PublicSubnetIDs:  publicSubnetIDs,
-		PrivateSubnetIDs: privateSubnetIDs,
+		ID:                   aws.StringValue(cfg.ID),
+		PublicSubnetIDs:      publicSubnetIDs,
+		PrivateSubnetIDs:     privateSubnetIDs,
+		SecurityGroupID:      aws.StringValue(cfg.SecurityGroup),
+		PublicRouteTableID:   aws.StringValue(cfg.RouteTables.Public),
+		PrivateRouteTableIDs: cfg.RouteTables.Private,
 	}
 }
 
@@ -272,6 +496,26 @@ func (cfg *environmentVPCConfig) ManagedVPC() *template.ManagedVPC {
 	}
 }
 
+// VPCAttachments returns the transit gateways and peering connections that the VPC routes traffic to.
+func (cfg *environmentVPCConfig) VPCAttachments() []template.VPCAttachment {
+	if len(cfg.Attachments) == 0 {
+		return nil
+	}
+	attachments := make([]template.VPCAttachment, len(cfg.Attachments))
+	for i, attachment := range cfg.Attachments {
+		cidrs := make([]string, len(attachment.CIDRs))
+		for j, cidr := range attachment.CIDRs {
+			cidrs[j] = string(cidr)
+		}
+		attachments[i] = template.VPCAttachment{
+			TransitGatewayID:    aws.StringValue(attachment.TransitGateway),
+			PeeringConnectionID: aws.StringValue(attachment.PeeringConnection),
+			CIDRs:               cidrs,
+		}
+	}
+	return attachments
+}
+
 type subnetsConfiguration struct {
 	Public  []subnetConfiguration `yaml:"public,omitempty"`
 	Private []subnetConfiguration `yaml:"private,omitempty"`
@@ -282,6 +526,17 @@ func (cs subnetsConfiguration) IsEmpty() bool {
 	return len(cs.Public) == 0 && len(cs.Private) == 0
 }
 
+// routeTablesConfiguration holds the IDs of existing route tables associated with the imported subnets.
+type routeTablesConfiguration struct {
+	Public  *string  `yaml:"public,omitempty"`
+	Private []string `yaml:"private,omitempty"`
+}
+
+// IsEmpty returns true if no route tables are configured.
+func (rt routeTablesConfiguration) IsEmpty() bool {
+	return rt.Public == nil && len(rt.Private) == 0
+}
+
 type subnetConfiguration struct {
 	SubnetID *string `yaml:"id,omitempty"`
 	CIDR     *IPNet  `yaml:"cidr,omitempty"`
@@ -327,12 +582,90 @@ func (cfg *environmentHTTPConfig) loadLBConfig(env *config.CustomizeEnv) {
 }
 
 type publicHTTPConfig struct {
-	Certificates []string `yaml:"certificates,omitempty"`
+	Certificates        []string             `yaml:"certificates,omitempty"`
+	AdditionalListeners []publicHTTPListener `yaml:"additional_listeners,omitempty"`
+	MutualTLS           mutualTLSConfig      `yaml:"mutual_tls,omitempty"`
 }
 
 // IsEmpty returns true if there is no customization to the public ALB.
 func (cfg publicHTTPConfig) IsEmpty() bool {
-	return len(cfg.Certificates) == 0
+	return len(cfg.Certificates) == 0 && len(cfg.AdditionalListeners) == 0 && cfg.MutualTLS.IsEmpty()
+}
+
+// Modes of mutual TLS authentication on a load balancer listener.
+const (
+	MutualTLSModeVerify      = "verify"
+	MutualTLSModePassthrough = "passthrough"
+)
+
+// mutualTLSConfig represents the client certificate authentication on the public ALB's HTTPS listener.
+type mutualTLSConfig struct {
+	Mode                          *string          `yaml:"mode,omitempty"` // Either "verify" or "passthrough", defaults to "verify".
+	TrustStore                    trustStoreConfig `yaml:"trust_store,omitempty"`
+	IgnoreClientCertificateExpiry *bool            `yaml:"ignore_client_certificate_expiry,omitempty"`
+}
+
+// IsEmpty returns true if mutual TLS is not configured.
+func (cfg mutualTLSConfig) IsEmpty() bool {
+	return cfg.Mode == nil && cfg.TrustStore.IsEmpty() && cfg.IgnoreClientCertificateExpiry == nil
+}
+
+// ModeOrDefault returns the mutual TLS mode, or "verify" if it isn't specified.
+func (cfg mutualTLSConfig) ModeOrDefault() string {
+	if cfg.Mode == nil {
+		return MutualTLSModeVerify
+	}
+	return aws.StringValue(cfg.Mode)
+}
+
+// trustStoreConfig represents the location of the CA certificates bundle used to verify client certificates.
+type trustStoreConfig struct {
+	Bucket *string `yaml:"bucket,omitempty"`
+	Key    *string `yaml:"key,omitempty"`
+}
+
+// IsEmpty returns true if the trust store is not configured.
+func (cfg trustStoreConfig) IsEmpty() bool {
+	return cfg.Bucket == nil && cfg.Key == nil
+}
+
+// publicHTTPListener represents a listener on the public ALB in addition to the default ones on port 80 and 443.
+type publicHTTPListener struct {
+	Name          *string               `yaml:"name,omitempty"`
+	Port          *uint16               `yaml:"port,omitempty"`
+	Certificates  []string              `yaml:"certificates,omitempty"`
+	DefaultAction listenerDefaultAction `yaml:"default_action,omitempty"`
+}
+
+// Protocol returns the protocol of the listener.
+// Listeners with certificates accept HTTPS traffic, otherwise they accept HTTP traffic.
+func (l publicHTTPListener) Protocol() string {
+	if len(l.Certificates) != 0 {
+		return "HTTPS"
+	}
+	return "HTTP"
+}
+
+// listenerDefaultAction represents the action taken by a listener when no service rule matches a request.
+// If empty, requests are forwarded to the default target group of the environment like the default listeners.
+type listenerDefaultAction struct {
+	FixedResponse *int             `yaml:"fixed_response,omitempty"` // HTTP status code to respond with.
+	Redirect      listenerRedirect `yaml:"redirect,omitempty"`
+}
+
+// IsEmpty returns true if the listener's default action is not customized.
+func (a listenerDefaultAction) IsEmpty() bool {
+	return a.FixedResponse == nil && a.Redirect.IsEmpty()
+}
+
+type listenerRedirect struct {
+	Port     *uint16 `yaml:"port,omitempty"`
+	Protocol *string `yaml:"protocol,omitempty"`
+}
+
+// IsEmpty returns true if the redirect is not configured.
+func (r listenerRedirect) IsEmpty() bool {
+	return r.Port == nil && r.Protocol == nil
 }
 
 type privateHTTPConfig struct {
@@ -4,7 +4,9 @@
 package manifest
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
 
@@ -304,6 +306,27 @@ func TestFromEnvConfig(t *testing.T) {
 				},
 			},
 		},
+		"converts imported cluster": {
+			in: &config.Environment{
+				App:  "phonetool",
+				Name: "test",
+				CustomConfig: &config.CustomizeEnv{
+					ImportCluster: "shared",
+				},
+			},
+
+			wanted: &Environment{
+				Workload: Workload{
+					Name: stringP("test"),
+					Type: stringP("Environment"),
+				},
+				environmentConfig: environmentConfig{
+					Cluster: environmentClusterConfig{
+						ID: stringP("shared"),
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -381,6 +404,63 @@ network:
 				},
 			},
 		},
+		"unmarshal with VPC attachments": {
+			inContent: `name: test
+type: Environment
+
+network:
+    vpc:
+        attachments:
+            - transit_gateway: tgw-1234
+              cidrs: ['192.168.0.0/16', '172.16.0.0/12']
+            - peering_connection: pcx-1234
+              cidrs: ['10.100.0.0/16']
+`,
+			wantedStruct: &Environment{
+				Workload: Workload{
+					Name: aws.String("test"),
+					Type: aws.String("Environment"),
+				},
+				environmentConfig: environmentConfig{
+					Network: environmentNetworkConfig{
+						VPC: environmentVPCConfig{
+							Attachments: []vpcAttachment{
+								{
+									TransitGateway: aws.String("tgw-1234"),
+									CIDRs:          []IPNet{"192.168.0.0/16", "172.16.0.0/12"},
+								},
+								{
+									PeeringConnection: aws.String("pcx-1234"),
+									CIDRs:             []IPNet{"10.100.0.0/16"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"unmarshal with a shared file system": {
+			inContent: `name: test
+type: Environment
+
+storage:
+    efs:
+        shared: true
+`,
+			wantedStruct: &Environment{
+				Workload: Workload{
+					Name: aws.String("test"),
+					Type: aws.String("Environment"),
+				},
+				environmentConfig: environmentConfig{
+					Storage: environmentStorageConfig{
+						EFS: environmentEFSConfig{
+							Shared: aws.Bool(true),
+						},
+					},
+				},
+			},
+		},
 		"unmarshal with observability": {
 			inContent: `name: prod
 type: Environment
@@ -442,6 +522,105 @@ http:
 				},
 			},
 		},
+		"unmarshal with experimental features": {
+			inContent: `name: prod
+type: Environment
+
+features:
+    service_connect:
+        enabled: true
+        min_cli_version: v1.22.0
+`,
+			wantedStruct: &Environment{
+				Workload: Workload{
+					Name: aws.String("prod"),
+					Type: aws.String("Environment"),
+				},
+				environmentConfig: environmentConfig{
+					Features: environmentFeatures{
+						ServiceConnect: environmentFeature{
+							Enabled:       aws.Bool(true),
+							MinCLIVersion: aws.String("v1.22.0"),
+						},
+					},
+				},
+			},
+		},
+		"fail to unmarshal an experimental feature": {
+			inContent: `name: prod
+type: Environment
+
+features:
+    service_connect: [true]
+`,
+			wantedErrPrefix: "unable to unmarshal into bool or composite-style map",
+		},
+		"unmarshal with deployment hooks": {
+			inContent: `name: prod
+type: Environment
+
+hooks:
+    pre_deploy:
+        - ./scripts/check-quotas.sh
+    post_deploy:
+        - command: ./scripts/validate-network.sh
+          on_failure: warn
+        - lambda: arn:aws:lambda:us-west-2:123456789012:function:validate
+`,
+			wantedStruct: &Environment{
+				Workload: Workload{
+					Name: aws.String("prod"),
+					Type: aws.String("Environment"),
+				},
+				environmentConfig: environmentConfig{
+					Hooks: environmentHooks{
+						PreDeploy: []DeploymentHook{
+							{Command: aws.String("./scripts/check-quotas.sh")},
+						},
+						PostDeploy: []DeploymentHook{
+							{
+								Command:   aws.String("./scripts/validate-network.sh"),
+								OnFailure: aws.String("warn"),
+							},
+							{Lambda: aws.String("arn:aws:lambda:us-west-2:123456789012:function:validate")},
+						},
+					},
+				},
+			},
+		},
+		"unmarshal with a custom execution role": {
+			inContent: `name: prod
+type: Environment
+
+deployment:
+    execution_role: arn:aws:iam::123456789012:role/restricted-deploy
+    timeout: 2h30m
+    custom_resources_bucket: internal-artifacts
+`,
+			wantedStruct: &Environment{
+				Workload: Workload{
+					Name: aws.String("prod"),
+					Type: aws.String("Environment"),
+				},
+				environmentConfig: environmentConfig{
+					Deployment: environmentDeployment{
+						ExecutionRole:         aws.String("arn:aws:iam::123456789012:role/restricted-deploy"),
+						Timeout:               durationp(2*time.Hour + 30*time.Minute),
+						CustomResourcesBucket: aws.String("internal-artifacts"),
+					},
+				},
+			},
+		},
+		"fail to unmarshal a deployment hook": {
+			inContent: `name: prod
+type: Environment
+
+hooks:
+    pre_deploy:
+        - [./scripts/check-quotas.sh]
+`,
+			wantedErrPrefix: "unable to unmarshal into string or composite-style map",
+		},
 		"fail to unmarshal": {
 			inContent:       `watermelon in easter hay`,
 			wantedErrPrefix: "unmarshal environment manifest: ",
@@ -532,6 +711,36 @@ func TestEnvironmentVPCConfig_ImportedVPC(t *testing.T) {
 				PrivateSubnetIDs: []string{"subnet-789", "subnet-012"},
 			},
 		},
+		"existing security group and route tables imported": {
+			inVPCConfig: environmentVPCConfig{
+				ID: aws.String("vpc-1234"),
+				Subnets: subnetsConfiguration{
+					Public: []subnetConfiguration{
+						{
+							SubnetID: aws.String("subnet-123"),
+						},
+					},
+					Private: []subnetConfiguration{
+						{
+							SubnetID: aws.String("subnet-789"),
+						},
+					},
+				},
+				SecurityGroup: aws.String("sg-1234"),
+				RouteTables: routeTablesConfiguration{
+					Public:  aws.String("rtb-1"),
+					Private: []string{"rtb-2"},
+				},
+			},
+			wanted: &template.ImportVPC{
+				ID:                   "vpc-1234",
+				PublicSubnetIDs:      []string{"subnet-123"},
+				PrivateSubnetIDs:     []string{"subnet-789"},
+				SecurityGroupID:      "sg-1234",
+				PublicRouteTableID:   "rtb-1",
+				PrivateRouteTableIDs: []string{"rtb-2"},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -645,6 +854,44 @@ func TestEnvironmentVPCConfig_ManagedVPC(t *testing.T) {
 	}
 }
 
+func TestEnvironmentVPCConfig_VPCAttachments(t *testing.T) {
+	testCases := map[string]struct {
+		in     environmentVPCConfig
+		wanted []template.VPCAttachment
+	}{
+		"no attachments": {},
+		"transit gateway and peering connection": {
+			in: environmentVPCConfig{
+				Attachments: []vpcAttachment{
+					{
+						TransitGateway: aws.String("tgw-1234"),
+						CIDRs:          []IPNet{"192.168.0.0/16", "172.16.0.0/12"},
+					},
+					{
+						PeeringConnection: aws.String("pcx-1234"),
+						CIDRs:             []IPNet{"10.100.0.0/16"},
+					},
+				},
+			},
+			wanted: []template.VPCAttachment{
+				{
+					TransitGatewayID: "tgw-1234",
+					CIDRs:            []string{"192.168.0.0/16", "172.16.0.0/12"},
+				},
+				{
+					PeeringConnectionID: "pcx-1234",
+					CIDRs:               []string{"10.100.0.0/16"},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.VPCAttachments())
+		})
+	}
+}
+
 func TestEnvironmentVPCConfig_IsEmpty(t *testing.T) {
 	testCases := map[string]struct {
 		in     environmentVPCConfig
@@ -658,6 +905,15 @@ func TestEnvironmentVPCConfig_IsEmpty(t *testing.T) {
 				ID: aws.String("mock-vpc-id"),
 			},
 		},
+		"not empty with attachments only": {
+			in: environmentVPCConfig{
+				Attachments: []vpcAttachment{
+					{
+						TransitGateway: aws.String("tgw-1234"),
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -730,6 +986,13 @@ func TestPublicHTTPConfig_IsEmpty(t *testing.T) {
 				Certificates: []string{"mock-cert-1"},
 			},
 		},
+		"not empty with mutual TLS": {
+			in: publicHTTPConfig{
+				MutualTLS: mutualTLSConfig{
+					Mode: aws.String("passthrough"),
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -841,3 +1104,98 @@ func TestEnvironmentCDNConfig_CDNEnabled(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironmentFeatures_Enabled(t *testing.T) {
+	testCases := map[string]struct {
+		in     environmentFeatures
+		wanted []string
+	}{
+		"none enabled": {
+			in: environmentFeatures{
+				ServiceConnect: environmentFeature{
+					Enabled: aws.Bool(false),
+				},
+			},
+		},
+		"return enabled features": {
+			in: environmentFeatures{
+				ServiceConnect: environmentFeature{
+					Enabled: aws.Bool(true),
+				},
+			},
+			wanted: []string{"service_connect"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.Enabled())
+			require.Equal(t, len(tc.wanted) != 0, tc.in.ServiceConnectEnabled())
+		})
+	}
+}
+
+func TestDeploymentHook_AbortOnFailure(t *testing.T) {
+	testCases := map[string]struct {
+		in     DeploymentHook
+		wanted bool
+	}{
+		"abort by default": {
+			in:     DeploymentHook{Command: aws.String("./validate.sh")},
+			wanted: true,
+		},
+		"abort": {
+			in: DeploymentHook{
+				Command:   aws.String("./validate.sh"),
+				OnFailure: aws.String("abort"),
+			},
+			wanted: true,
+		},
+		"warn": {
+			in: DeploymentHook{
+				Command:   aws.String("./validate.sh"),
+				OnFailure: aws.String("warn"),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.AbortOnFailure())
+		})
+	}
+}
+
+func TestEnvironmentFeatures_ValidateCLIVersion(t *testing.T) {
+	features := environmentFeatures{
+		ServiceConnect: environmentFeature{
+			Enabled:       aws.Bool(true),
+			MinCLIVersion: aws.String("1.22.0"),
+		},
+	}
+	testCases := map[string]struct {
+		inCLIVersion string
+		wantedErr    error
+	}{
+		"allow development builds": {
+			inCLIVersion: "",
+		},
+		"allow versions newer than the minimum version of enabled features": {
+			inCLIVersion: "v1.22.1",
+		},
+		"error if the CLI is older than the minimum version of an enabled feature": {
+			inCLIVersion: "v1.21.0",
+			wantedErr:    errors.New(`feature "service_connect" requires Copilot CLI 1.22.0 or later, but the current version is v1.21.0`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := features.ValidateCLIVersion(tc.inCLIVersion)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -5,6 +5,9 @@ package manifest
 
 import (
 	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,6 +15,11 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
+var httpRateLimitRegexp = regexp.MustCompile(`^(\d+)rp([sm])-per-ip$`)
+
+// RateLimitEvaluationWindow is the window over which WAF rate-based rules count the requests of an IP address.
+const RateLimitEvaluationWindow = 5 * time.Minute
+
 // RoutingRuleConfigOrBool holds advanced configuration for routing rule or a boolean switch.
 type RoutingRuleConfigOrBool struct {
 	RoutingRuleConfiguration
@@ -60,6 +68,14 @@ type RoutingRuleConfiguration struct {
 	TargetContainerCamelCase *string `yaml:"targetContainer"` // "targetContainerCamelCase" for backwards compatibility
 	AllowedSourceIps         []IPNet `yaml:"allowed_source_ips"`
 	HostedZone               *string `yaml:"hosted_zone"`
+	// Listener is the name of an additional listener on the environment's public load balancer to also route traffic from.
+	Listener *string `yaml:"listener"`
+	// TargetProtocol is the protocol used by the load balancer to route traffic to the tasks.
+	TargetProtocol *string `yaml:"target_protocol"`
+	// PrivateCA is the ARN of the ACM Private CA from which tasks issue their own certificates.
+	PrivateCA *string `yaml:"private_ca"`
+	// RateLimit is the maximum rate of requests that a client IP address can send to the service.
+	RateLimit *HTTPRateLimit `yaml:"rate_limit"`
 }
 
 // GetTargetContainer returns the correct target container value, if set.
@@ -75,7 +91,29 @@ func (r *RoutingRuleConfiguration) GetTargetContainer() *string {
 func (r *RoutingRuleConfiguration) IsEmpty() bool {
 	return r.Path == nil && r.ProtocolVersion == nil && r.HealthCheck.IsEmpty() && r.Stickiness == nil && r.Alias.IsEmpty() &&
 		r.DeregistrationDelay == nil && r.TargetContainer == nil && r.TargetContainerCamelCase == nil && r.AllowedSourceIps == nil &&
-		r.HostedZone == nil
+		r.HostedZone == nil && r.Listener == nil && r.TargetProtocol == nil && r.PrivateCA == nil && r.RateLimit == nil
+}
+
+// HTTPRateLimit is the maximum rate of requests per client IP address, such as "1000rps-per-ip" or "600rpm-per-ip".
+type HTTPRateLimit string
+
+// Parse returns the number of requests allowed per unit of time.
+// For example: "1000rps-per-ip" returns 1000 and time.Second.
+func (r HTTPRateLimit) Parse() (requests int64, per time.Duration, err error) {
+	matches := httpRateLimitRegexp.FindStringSubmatch(string(r))
+	// Valid matches example: ["1000rps-per-ip", "1000", "s"]
+	if len(matches) != 3 {
+		return 0, 0, fmt.Errorf("invalid rate limit %q. Should be in format of ${requests}rps-per-ip or ${requests}rpm-per-ip", string(r))
+	}
+	requests, err = strconv.ParseInt(matches[1], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("cannot convert number of requests %s to integer", matches[1])
+	}
+	per = time.Second
+	if matches[2] == "m" {
+		per = time.Minute
+	}
+	return requests, per, nil
 }
 
 // IPNet represents an IP network string. For example: 10.1.0.0/16
@@ -4,7 +4,9 @@
 package manifest
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
@@ -247,3 +249,49 @@ func TestAlias_ToStringSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPRateLimit_Parse(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wantedRequests int64
+		wantedPer      time.Duration
+		wantedErr      error
+	}{
+		"invalid format": {
+			in: "1000rps",
+
+			wantedErr: fmt.Errorf(`invalid rate limit "1000rps". Should be in format of ${requests}rps-per-ip or ${requests}rpm-per-ip`),
+		},
+		"invalid unit": {
+			in: "1000rph-per-ip",
+
+			wantedErr: fmt.Errorf(`invalid rate limit "1000rph-per-ip". Should be in format of ${requests}rps-per-ip or ${requests}rpm-per-ip`),
+		},
+		"requests per second": {
+			in: "1000rps-per-ip",
+
+			wantedRequests: 1000,
+			wantedPer:      time.Second,
+		},
+		"requests per minute": {
+			in: "60rpm-per-ip",
+
+			wantedRequests: 60,
+			wantedPer:      time.Minute,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			requests, per, err := HTTPRateLimit(tc.in).Parse()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedRequests, requests)
+			require.Equal(t, tc.wantedPer, per)
+		})
+	}
+}
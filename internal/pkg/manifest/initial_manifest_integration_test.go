@@ -34,6 +34,18 @@ func TestLoadBalancedWebService_InitialManifestIntegration(t *testing.T) {
 			},
 			wantedTestdata: "lb-svc.yml",
 		},
+		"with health check path": {
+			inProps: LoadBalancedWebServiceProps{
+				WorkloadProps: &WorkloadProps{
+					Name:       "api",
+					Dockerfile: "./api/Dockerfile",
+				},
+				Path:            "api",
+				Port:            8080,
+				HTTPHealthCheck: "/v1/healthz",
+			},
+			wantedTestdata: "lb-svc-healthcheck.yml",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -16,6 +16,8 @@ import (
 const (
 	reservedEnvVarKeyForAppName = "COPILOT_APPLICATION_NAME"
 	reservedEnvVarKeyForEnvName = "COPILOT_ENVIRONMENT_NAME"
+
+	reservedEnvVarKeyForInstanceName = "COPILOT_INSTANCE_NAME"
 )
 
 var (
@@ -40,6 +42,14 @@ func NewInterpolator(appName, envName string) *Interpolator {
 	}
 }
 
+// NewInstanceInterpolator initiates a new Interpolator for a manifest deployed as one of the instances of a workload.
+// The name of the instance is available to the manifest as ${COPILOT_INSTANCE_NAME}.
+func NewInstanceInterpolator(appName, envName, instanceName string) *Interpolator {
+	i := NewInterpolator(appName, envName)
+	i.predefinedEnvVars[reservedEnvVarKeyForInstanceName] = instanceName
+	return i
+}
+
 // Interpolate substitutes environment variables in a string.
 func (i *Interpolator) Interpolate(s string) (string, error) {
 	content, err := unmarshalYAML([]byte(s))
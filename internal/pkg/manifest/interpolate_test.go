@@ -118,3 +118,46 @@ variables:
 		})
 	}
 }
+
+func TestInstanceInterpolator_Interpolate(t *testing.T) {
+	testCases := map[string]struct {
+		inInstance string
+		inputStr   string
+
+		wanted    string
+		wantedErr error
+	}{
+		"should return error if the instance name is referenced without an instance": {
+			inputStr: "alias: ${COPILOT_INSTANCE_NAME}.example.com",
+
+			wantedErr: fmt.Errorf(`environment variable "COPILOT_INSTANCE_NAME" is not defined`),
+		},
+		"should substitute the name of the instance": {
+			inInstance: "tenant-a",
+			inputStr:   "alias: ${COPILOT_INSTANCE_NAME}.${COPILOT_ENVIRONMENT_NAME}.example.com",
+
+			wanted: "alias: tenant-a.test.example.com\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			itpl := NewInterpolator("myApp", "test")
+			if tc.inInstance != "" {
+				itpl = NewInstanceInterpolator("myApp", "test", tc.inInstance)
+			}
+
+			// WHEN
+			actual, actualErr := itpl.Interpolate(tc.inputStr)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, actualErr, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, actualErr)
+				require.Equal(t, tc.wanted, actual)
+			}
+		})
+	}
+}
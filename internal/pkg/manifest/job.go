@@ -14,6 +14,16 @@ const (
 	ScheduledJobType = "Scheduled Job"
 )
 
+// Valid values for a scheduled job's concurrency policy.
+const (
+	// JobConcurrencyAllow lets a new run start even if a previous run is still in progress.
+	JobConcurrencyAllow = "allow"
+	// JobConcurrencyForbid skips a new run if a previous run is still in progress.
+	JobConcurrencyForbid = "forbid"
+	// JobConcurrencyReplace stops any run still in progress before starting the new run.
+	JobConcurrencyReplace = "replace"
+)
+
 const (
 	scheduledJobManifestPath = "workloads/jobs/scheduled-job/manifest.yml"
 )
@@ -51,7 +61,9 @@ type ScheduledJobConfig struct {
 
 // JobTriggerConfig represents the configuration for the event that triggers the job.
 type JobTriggerConfig struct {
-	Schedule *string `yaml:"schedule"`
+	Schedule    *string `yaml:"schedule"`
+	Timezone    *string `yaml:"timezone"`
+	Concurrency *string `yaml:"concurrency"`
 }
 
 // JobFailureHandlerConfig represents the error handling configuration for the job.
@@ -140,6 +152,16 @@ func (j *ScheduledJob) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return j.ImageConfig.Image.BuildConfig(wsRoot)
 }
 
+// ImageTagStrategy returns how the image built for the job should be tagged.
+func (j *ScheduledJob) ImageTagStrategy() ImageTagStrategy {
+	return j.ImageConfig.Image.TagStrategy
+}
+
+// ImagePlatforms returns the platforms to build a multi-architecture image of the job for.
+func (j *ScheduledJob) ImagePlatforms() []string {
+	return j.ImageConfig.Image.Platforms
+}
+
 // BuildRequired returns if the service requires building from the local Dockerfile.
 func (j *ScheduledJob) BuildRequired() (bool, error) {
 	return requiresBuild(j.ImageConfig.Image)
@@ -62,6 +62,7 @@ type LoadBalancedWebServiceConfig struct {
 	NLBConfig        NetworkLoadBalancerConfiguration `yaml:"nlb"`
 	DeployConfig     DeploymentConfiguration          `yaml:"deployment"`
 	Observability    Observability                    `yaml:"observability"`
+	Alarms           []WorkloadAlarm                  `yaml:"alarms"`
 }
 
 // LoadBalancedWebServiceProps contains properties for creating a new load balanced fargate service manifest.
@@ -70,9 +71,10 @@ type LoadBalancedWebServiceProps struct {
 	Path string
 	Port uint16
 
-	HTTPVersion string               // Optional http protocol version such as gRPC, HTTP2.
-	HealthCheck ContainerHealthCheck // Optional healthcheck configuration.
-	Platform    PlatformArgsOrString // Optional platform configuration.
+	HTTPVersion     string               // Optional http protocol version such as gRPC, HTTP2.
+	HealthCheck     ContainerHealthCheck // Optional healthcheck configuration.
+	HTTPHealthCheck string               // Optional path of the load balancer health check, the default is "/".
+	Platform        PlatformArgsOrString // Optional platform configuration.
 }
 
 // NewLoadBalancedWebService creates a new public load balanced web service, receives all the requests from the load balancer,
@@ -93,6 +95,13 @@ func NewLoadBalancedWebService(props *LoadBalancedWebServiceProps) *LoadBalanced
 	if props.HTTPVersion != "" {
 		svc.RoutingRule.ProtocolVersion = &props.HTTPVersion
 	}
+	if props.HTTPHealthCheck != "" {
+		svc.RoutingRule.HealthCheck = HealthCheckArgsOrString{
+			HealthCheckArgs: HTTPHealthCheckArgs{
+				Path: aws.String(props.HTTPHealthCheck),
+			},
+		}
+	}
 	svc.RoutingRule.Path = aws.String(props.Path)
 	svc.parser = template.New()
 	return svc
@@ -185,6 +194,16 @@ func (s *LoadBalancedWebService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.ImageConfig.Image.BuildConfig(wsRoot)
 }
 
+// ImageTagStrategy returns how the image built for the service should be tagged.
+func (s *LoadBalancedWebService) ImageTagStrategy() ImageTagStrategy {
+	return s.ImageConfig.Image.TagStrategy
+}
+
+// ImagePlatforms returns the platforms to build a multi-architecture image of the service for.
+func (s *LoadBalancedWebService) ImagePlatforms() []string {
+	return s.ImageConfig.Image.Platforms
+}
+
 // EnvFile returns the location of the env file against the ws root directory.
 func (s *LoadBalancedWebService) EnvFile() string {
 	return aws.StringValue(s.TaskConfig.EnvFile)
@@ -79,6 +79,68 @@ func TestNewHTTPLoadBalancedWebService(t *testing.T) {
 				},
 			},
 		},
+		"sets the path of the load balancer health check": {
+			props: LoadBalancedWebServiceProps{
+				WorkloadProps: &WorkloadProps{
+					Name:       "api",
+					Dockerfile: "./api/Dockerfile",
+				},
+				Path:            "api",
+				Port:            8080,
+				HTTPHealthCheck: "/v1/healthz",
+			},
+
+			wanted: &LoadBalancedWebService{
+				Workload: Workload{
+					Name: stringP("api"),
+					Type: stringP(LoadBalancedWebServiceType),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: ImageWithPortAndHealthcheck{
+						ImageWithPort: ImageWithPort{
+							Image: Image{
+								Build: BuildArgsOrString{
+									BuildArgs: DockerBuildArgs{
+										Dockerfile: stringP("./api/Dockerfile"),
+									},
+								},
+							},
+							Port: aws.Uint16(8080),
+						},
+					},
+					RoutingRule: RoutingRuleConfigOrBool{
+						RoutingRuleConfiguration: RoutingRuleConfiguration{
+							Path: stringP("api"),
+							HealthCheck: HealthCheckArgsOrString{
+								HealthCheckArgs: HTTPHealthCheckArgs{
+									Path: stringP("/v1/healthz"),
+								},
+							},
+						},
+					},
+					TaskConfig: TaskConfig{
+						CPU:    aws.Int(256),
+						Memory: aws.Int(512),
+						Count: Count{
+							Value: aws.Int(1),
+							AdvancedCount: AdvancedCount{
+								workloadType: LoadBalancedWebServiceType,
+							},
+						},
+						ExecuteCommand: ExecuteCommand{
+							Enable: aws.Bool(false),
+						},
+					},
+					Network: NetworkConfig{
+						VPC: vpcConfig{
+							Placement: PlacementArgOrString{
+								PlacementString: placementStringP(PublicSubnetPlacement),
+							},
+						},
+					},
+				},
+			},
+		},
 		"overrides default settings when optional configuration is provided": {
 			props: LoadBalancedWebServiceProps{
 				WorkloadProps: &WorkloadProps{
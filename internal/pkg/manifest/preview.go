@@ -0,0 +1,80 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"gopkg.in/yaml.v3"
+)
+
+// Keys of the environment manifest that are changed for preview environments.
+const (
+	envNameKey              = "name"
+	envObservabilityKey     = "observability"
+	envContainerInsightsKey = "container_insights"
+	envCDNKey               = "cdn"
+)
+
+// ClonePreviewEnvironment returns the manifest of a preview environment named name, cloned from the manifest of a base environment.
+// The preview environment keeps the network and load balancer configuration of the base environment, but
+// doesn't enable Container Insights or a CDN so that it is cheaper and faster to create and delete.
+func ClonePreviewEnvironment(base []byte, name string) ([]byte, error) {
+	doc, err := unmarshalYAML(base)
+	if err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("environment manifest must be a map")
+	}
+	root := doc.Content[0]
+	setMappingValue(root, envNameKey, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: name})
+	deleteMappingKey(root, envCDNKey)
+	setMappingValue(root, envObservabilityKey, &yaml.Node{
+		Kind: yaml.MappingNode,
+		Content: []*yaml.Node{
+			{Kind: yaml.ScalarNode, Value: envContainerInsightsKey},
+			{Kind: yaml.ScalarNode, Tag: "!!bool", Value: "false"},
+		},
+	})
+	return marshalYAML(doc)
+}
+
+// ApplyPreviewSizing reduces the workload to a single task, without autoscaling, for a preview environment.
+// Manifests of workloads that don't run as ECS tasks are left unchanged.
+func ApplyPreviewSizing(mft WorkloadManifest) {
+	task, ok := mft.(interface{ taskConfig() *TaskConfig })
+	if !ok {
+		return
+	}
+	task.taskConfig().Count = Count{
+		Value: aws.Int(1),
+	}
+}
+
+func (t *TaskConfig) taskConfig() *TaskConfig {
+	return t
+}
+
+// setMappingValue sets the value of key in the mapping node, adding the key if it doesn't exist.
+func setMappingValue(mapping *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i < len(mapping.Content)-1; i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content[i+1] = value
+			return
+		}
+	}
+	mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, value)
+}
+
+// deleteMappingKey removes key and its value from the mapping node.
+func deleteMappingKey(mapping *yaml.Node, key string) {
+	for i := 0; i < len(mapping.Content)-1; i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClonePreviewEnvironment(t *testing.T) {
+	testCases := map[string]struct {
+		inBase string
+
+		wanted      string
+		wantedError error
+	}{
+		"clones the base environment without container insights and CDN": {
+			inBase: `# The manifest for the "test" environment.
+name: test
+type: Environment
+
+network:
+  vpc:
+    id: vpc-1234 # Shared with preview environments.
+cdn: true
+observability:
+  container_insights: true
+`,
+			wanted: `# The manifest for the "test" environment.
+name: pr-123
+type: Environment
+network:
+  vpc:
+    id: vpc-1234 # Shared with preview environments.
+observability:
+  container_insights: false
+`,
+		},
+		"adds observability if not set in the base environment": {
+			inBase: `name: test
+type: Environment
+`,
+			wanted: `name: pr-123
+type: Environment
+observability:
+  container_insights: false
+`,
+		},
+		"error if the manifest is not a map": {
+			inBase:      `- test`,
+			wantedError: errors.New("environment manifest must be a map"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ClonePreviewEnvironment([]byte(tc.inBase), "pr-123")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, string(got))
+		})
+	}
+}
+
+func TestApplyPreviewSizing(t *testing.T) {
+	t.Run("runs a single task without autoscaling", func(t *testing.T) {
+		mft := &LoadBalancedWebService{
+			LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+				TaskConfig: TaskConfig{
+					Count: Count{
+						AdvancedCount: AdvancedCount{
+							Range: Range{Value: (*IntRangeBand)(aws.String("1-10"))},
+						},
+					},
+				},
+			},
+		}
+
+		ApplyPreviewSizing(mft)
+
+		require.Equal(t, Count{Value: aws.Int(1)}, mft.Count)
+	})
+	t.Run("leaves App Runner services unchanged", func(t *testing.T) {
+		mft := &RequestDrivenWebService{
+			RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+				InstanceConfig: AppRunnerInstanceConfig{
+					CPU: aws.Int(1024),
+				},
+			},
+		}
+
+		ApplyPreviewSizing(mft)
+
+		require.Equal(t, aws.Int(1024), mft.InstanceConfig.CPU)
+	})
+}
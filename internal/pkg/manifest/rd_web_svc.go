@@ -91,8 +91,9 @@ type AppRunnerInstanceConfig struct {
 // RequestDrivenWebServiceProps contains properties for creating a new request-driven web service manifest.
 type RequestDrivenWebServiceProps struct {
 	*WorkloadProps
-	Port     uint16
-	Platform PlatformArgsOrString
+	Port            uint16
+	HTTPHealthCheck string // Optional path of the HTTP health check, App Runner uses TCP health checks by default.
+	Platform        PlatformArgsOrString
 }
 
 // NewRequestDrivenWebService creates a new Request-Driven Web Service manifest with default values.
@@ -103,6 +104,9 @@ func NewRequestDrivenWebService(props *RequestDrivenWebServiceProps) *RequestDri
 	svc.RequestDrivenWebServiceConfig.ImageConfig.Image.Build.BuildArgs.Dockerfile = stringP(props.Dockerfile)
 	svc.RequestDrivenWebServiceConfig.ImageConfig.Port = aws.Uint16(props.Port)
 	svc.RequestDrivenWebServiceConfig.InstanceConfig.Platform = props.Platform
+	if props.HTTPHealthCheck != "" {
+		svc.RequestDrivenWebServiceConfig.RequestDrivenWebServiceHttpConfig.HealthCheckConfiguration.HealthCheckArgs.Path = aws.String(props.HTTPHealthCheck)
+	}
 	svc.parser = template.New()
 	return svc
 }
@@ -146,6 +150,11 @@ func (s *RequestDrivenWebService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.ImageConfig.Image.BuildConfig(wsRoot)
 }
 
+// ImageTagStrategy returns how the image built for the service should be tagged.
+func (s *RequestDrivenWebService) ImageTagStrategy() ImageTagStrategy {
+	return s.ImageConfig.Image.TagStrategy
+}
+
 // ApplyEnv returns the service manifest with environment overrides.
 // If the environment passed in does not have any overrides then it returns itself.
 func (s RequestDrivenWebService) ApplyEnv(envName string) (WorkloadManifest, error) {
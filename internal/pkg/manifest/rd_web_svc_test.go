@@ -55,6 +55,42 @@ func TestNewRequestDrivenWebService(t *testing.T) {
 				},
 			},
 		},
+		"should set the path of the http health check": {
+			input: &RequestDrivenWebServiceProps{
+				WorkloadProps: &WorkloadProps{
+					Name:  "api",
+					Image: "public.ecr.aws/my/api:latest",
+				},
+				Port:            uint16(8080),
+				HTTPHealthCheck: "/v1/healthz",
+			},
+
+			wantedStruct: &RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("api"),
+					Type: aws.String(RequestDrivenWebServiceType),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
+						Image: Image{
+							Location: aws.String("public.ecr.aws/my/api:latest"),
+						},
+						Port: aws.Uint16(8080),
+					},
+					InstanceConfig: AppRunnerInstanceConfig{
+						CPU:    aws.Int(1024),
+						Memory: aws.Int(2048),
+					},
+					RequestDrivenWebServiceHttpConfig: RequestDrivenWebServiceHttpConfig{
+						HealthCheckConfiguration: HealthCheckArgsOrString{
+							HealthCheckArgs: HTTPHealthCheckArgs{
+								Path: aws.String("/v1/healthz"),
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -66,6 +102,7 @@ func TestNewRequestDrivenWebService(t *testing.T) {
 			require.Equal(t, tc.wantedStruct.Environments, svc.Environments)
 			require.Equal(t, tc.wantedStruct.InstanceConfig, svc.InstanceConfig)
 			require.Equal(t, tc.wantedStruct.ImageConfig, svc.ImageConfig)
+			require.Equal(t, tc.wantedStruct.RequestDrivenWebServiceHttpConfig, svc.RequestDrivenWebServiceHttpConfig)
 			require.Equal(t, tc.wantedStruct.Tags, svc.Tags)
 			require.Equal(t, tc.wantedStruct.Variables, svc.Variables)
 
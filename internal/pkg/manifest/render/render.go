@@ -0,0 +1,122 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package render evaluates manifests written in CUE or Jsonnet into YAML documents.
+package render
+
+import (
+	"bytes"
+	"fmt"
+	osexec "os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"gopkg.in/yaml.v3"
+)
+
+// File extensions of the manifests that can be rendered.
+const (
+	CUEFileExtension     = ".cue"
+	JsonnetFileExtension = ".jsonnet"
+)
+
+const (
+	cueCommand     = "cue"
+	jsonnetCommand = "jsonnet"
+
+	cueInstallURL     = "https://cuelang.org/docs/install"
+	jsonnetInstallURL = "https://jsonnet.org"
+
+	yamlIndent = 2
+)
+
+// ErrCommandNotFound means the command that evaluates a manifest is not installed.
+type ErrCommandNotFound struct {
+	Command    string // Name of the missing command.
+	InstallURL string // Location to download the command from.
+}
+
+func (e *ErrCommandNotFound) Error() string {
+	return fmt.Sprintf("%s: command not found, install it from %s", e.Command, e.InstallURL)
+}
+
+type runner interface {
+	Run(name string, args []string, options ...exec.CmdOption) error
+}
+
+// Renderer evaluates CUE manifests with the "cue" command and Jsonnet manifests with the "jsonnet" command.
+type Renderer struct {
+	runner   runner
+	lookPath func(file string) (string, error)
+}
+
+// New returns a Renderer that runs the commands installed on the machine.
+func New() *Renderer {
+	return &Renderer{
+		runner:   exec.NewCmd(),
+		lookPath: osexec.LookPath,
+	}
+}
+
+// Render evaluates the manifest at path and returns the resulting YAML document.
+// Imports in the manifest are resolved relative to the directory of the manifest.
+func (r *Renderer) Render(path string) ([]byte, error) {
+	switch ext := filepath.Ext(path); ext {
+	case CUEFileExtension:
+		return r.run(cueCommand, cueInstallURL, []string{"export", "--out", "yaml", path})
+	case JsonnetFileExtension:
+		out, err := r.run(jsonnetCommand, jsonnetInstallURL, []string{"--jpath", filepath.Dir(path), path})
+		if err != nil {
+			return nil, err
+		}
+		return jsonToYAML(out)
+	default:
+		return nil, fmt.Errorf("render manifest %s: unsupported file extension %q", path, ext)
+	}
+}
+
+func (r *Renderer) run(command, installURL string, args []string) ([]byte, error) {
+	if _, err := r.lookPath(command); err != nil {
+		return nil, &ErrCommandNotFound{
+			Command:    command,
+			InstallURL: installURL,
+		}
+	}
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	if err := r.runner.Run(command, args, exec.Stdout(stdout), exec.Stderr(stderr)); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("run %s: %w: %s", command, err, msg)
+		}
+		return nil, fmt.Errorf("run %s: %w", command, err)
+	}
+	return stdout.Bytes(), nil
+}
+
+// jsonToYAML converts the JSON document output by jsonnet to YAML while preserving the order of the keys.
+func jsonToYAML(in []byte) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(in, &doc); err != nil {
+		return nil, fmt.Errorf("unmarshal jsonnet output: %w", err)
+	}
+	clearStyle(&doc)
+	buf := new(bytes.Buffer)
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(yamlIndent)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, fmt.Errorf("marshal jsonnet output to YAML: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("marshal jsonnet output to YAML: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// clearStyle removes the flow and quoting styles that JSON documents are parsed with, so that nodes are written as block YAML.
+// The encoder still quotes strings that would otherwise be parsed as a different type, like "true" or "8080".
+func clearStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		clearStyle(child)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package render
+
+import (
+	"errors"
+	"fmt"
+	osexec "os/exec"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// writeOutput returns a Run function that writes to the stdout and stderr of the command.
+func writeOutput(stdout, stderr string, err error) func(string, []string, ...exec.CmdOption) error {
+	return func(_ string, _ []string, opts ...exec.CmdOption) error {
+		cmd := &osexec.Cmd{}
+		for _, opt := range opts {
+			opt(cmd)
+		}
+		fmt.Fprint(cmd.Stdout, stdout)
+		fmt.Fprint(cmd.Stderr, stderr)
+		return err
+	}
+}
+
+func TestRenderer_Render(t *testing.T) {
+	testCases := map[string]struct {
+		path        string
+		lookPathErr error
+		setupMocks  func(m *Mockrunner)
+
+		wanted      string
+		wantedError error
+	}{
+		"error if the file extension is not supported": {
+			path:        "/copilot/api/manifest.json",
+			setupMocks:  func(m *Mockrunner) {},
+			wantedError: errors.New(`render manifest /copilot/api/manifest.json: unsupported file extension ".json"`),
+		},
+		"error if cue is not installed": {
+			path:        "/copilot/api/manifest.cue",
+			lookPathErr: osexec.ErrNotFound,
+			setupMocks:  func(m *Mockrunner) {},
+			wantedError: errors.New("cue: command not found, install it from https://cuelang.org/docs/install"),
+		},
+		"error if jsonnet is not installed": {
+			path:        "/copilot/api/manifest.jsonnet",
+			lookPathErr: osexec.ErrNotFound,
+			setupMocks:  func(m *Mockrunner) {},
+			wantedError: errors.New("jsonnet: command not found, install it from https://jsonnet.org"),
+		},
+		"exports a CUE manifest to YAML": {
+			path: "/copilot/api/manifest.cue",
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("cue", []string{"export", "--out", "yaml", "/copilot/api/manifest.cue"}, gomock.Any()).
+					DoAndReturn(writeOutput("name: api\ntype: Backend Service\n", "", nil))
+			},
+			wanted: "name: api\ntype: Backend Service\n",
+		},
+		"error with the message of cue if the manifest is invalid": {
+			path: "/copilot/api/manifest.cue",
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("cue", gomock.Any(), gomock.Any()).
+					DoAndReturn(writeOutput("", "count: conflicting values 1 and 2\n", errors.New("exit status 1")))
+			},
+			wantedError: errors.New("run cue: exit status 1: count: conflicting values 1 and 2"),
+		},
+		"converts the JSON output of jsonnet to YAML": {
+			path: "/copilot/api/manifest.jsonnet",
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("jsonnet", []string{"--jpath", "/copilot/api", "/copilot/api/manifest.jsonnet"}, gomock.Any()).
+					DoAndReturn(writeOutput(`{
+   "name": "api",
+   "type": "Backend Service",
+   "image": {
+      "port": 8080
+   },
+   "variables": {
+      "DEBUG": "true",
+      "LOG_LEVEL": "info"
+   },
+   "count": [1, 2]
+}
+`, "", nil))
+			},
+			wanted: `name: api
+type: Backend Service
+image:
+  port: 8080
+variables:
+  DEBUG: "true"
+  LOG_LEVEL: info
+count:
+  - 1
+  - 2
+`,
+		},
+		"error if jsonnet fails without a message": {
+			path: "/copilot/api/manifest.jsonnet",
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("jsonnet", gomock.Any(), gomock.Any()).Return(errors.New("exit status 1"))
+			},
+			wantedError: errors.New("run jsonnet: exit status 1"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := NewMockrunner(ctrl)
+			tc.setupMocks(m)
+			r := &Renderer{
+				runner: m,
+				lookPath: func(file string) (string, error) {
+					return file, tc.lookPathErr
+				},
+			}
+
+			// WHEN
+			got, err := r.Render(tc.path)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, string(got))
+		})
+	}
+}
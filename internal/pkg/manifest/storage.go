@@ -45,21 +45,22 @@ func (s *Storage) hasManagedFS() bool {
 
 // Volume is an abstraction which merges the MountPoint and Volumes concepts from the ECS Task Definition
 type Volume struct {
-	EFS            EFSConfigOrBool `yaml:"efs"`
+	EFS            EFSConfigOrBool               `yaml:"efs"`
+	FSx            FSxWindowsVolumeConfiguration `yaml:"fsx"`
 	MountPointOpts `yaml:",inline"`
 }
 
-// EmptyVolume returns true if the EFS configuration is nil or explicitly/implicitly disabled.
+// EmptyVolume returns true if neither an FSx configuration nor an enabled EFS configuration is specified.
 func (v *Volume) EmptyVolume() bool {
-	if v.EFS.IsEmpty() {
-		return true
-	}
-	// Respect Bool value first: return true if EFS is explicitly disabled.
-	if v.EFS.Disabled() {
-		return true
+	if !v.FSx.IsEmpty() {
+		return false
 	}
+	return !v.HasEFS()
+}
 
-	return false
+// HasEFS returns true if the EFS configuration is specified and not explicitly disabled.
+func (v *Volume) HasEFS() bool {
+	return !v.EFS.IsEmpty() && !v.EFS.Disabled()
 }
 
 // MountPointOpts is shared between Volumes for the main container and MountPoints for sidecars.
@@ -183,6 +184,28 @@ func (e *EFSVolumeConfiguration) isValid() error {
 	return nil
 }
 
+// FSxWindowsVolumeConfiguration holds options which tell ECS how to reach out to an FSx for Windows File Server file system.
+type FSxWindowsVolumeConfiguration struct {
+	FileSystemID         *string `yaml:"id"`                    // ID of an existing file system. Mutually exclusive with active_directory_id.
+	RootDirectory        *string `yaml:"root_dir"`              // Directory of the file system to mount, such as a share name.
+	Domain               *string `yaml:"domain"`                // Required. Fully qualified domain name of the Active Directory.
+	CredentialsParameter *string `yaml:"credentials_parameter"` // Required. ARN of the Secrets Manager secret or SSM parameter with the domain credentials.
+	ActiveDirectoryID    *string `yaml:"active_directory_id"`   // ID of the AWS Managed Microsoft AD to join a Copilot-managed file system to.
+	StorageCapacity      *int    `yaml:"storage_capacity"`      // Storage capacity in GiB for managed FSx.
+	ThroughputCapacity   *int    `yaml:"throughput_capacity"`   // Throughput capacity in MB/s for managed FSx.
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (f *FSxWindowsVolumeConfiguration) IsEmpty() bool {
+	return f.FileSystemID == nil && f.RootDirectory == nil && f.Domain == nil && f.CredentialsParameter == nil &&
+		f.ActiveDirectoryID == nil && f.StorageCapacity == nil && f.ThroughputCapacity == nil
+}
+
+// UseManagedFS returns true if Copilot should create the FSx for Windows File Server file system.
+func (f *FSxWindowsVolumeConfiguration) UseManagedFS() bool {
+	return f.FileSystemID == nil && f.ActiveDirectoryID != nil
+}
+
 // AuthorizationConfig holds options relating to access points and IAM authorization.
 type AuthorizationConfig struct {
 	IAM           *bool   `yaml:"iam"`             // Default true
@@ -304,9 +304,14 @@ subscribe:
     delay: 15s
     dead_letter:
           tries: 5
+    kms_key: alias/dogs
+    allowed_publishers:
+      arns: ["arn:aws:iam::123456789012:role/publisher"]
+      org_ids: ["o-a1b2c3d4e5"]
   topics:
     - name: publisher1
       service: testpubsvc
+      raw_message_delivery: true
     - name: publisher2
       service: testpubjob
       queue:
@@ -351,8 +356,9 @@ subscribe:
 						Subscribe: SubscribeConfig{
 							Topics: []TopicSubscription{
 								{
-									Name:    aws.String("publisher1"),
-									Service: aws.String("testpubsvc"),
+									Name:               aws.String("publisher1"),
+									Service:            aws.String("testpubsvc"),
+									RawMessageDelivery: aws.Bool(true),
 								},
 								{
 									Name:    aws.String("publisher2"),
@@ -369,6 +375,11 @@ subscribe:
 								DeadLetter: DeadLetterQueue{
 									Tries: aws.Uint16(5),
 								},
+								KMSKey: aws.String("alias/dogs"),
+								AllowedPublishers: AllowedPrincipals{
+									ARNs:   []string{"arn:aws:iam::123456789012:role/publisher"},
+									OrgIDs: []string{"o-a1b2c3d4e5"},
+								},
 							},
 						},
 					},
@@ -33,6 +33,7 @@ var defaultTransformers = []mergo.Transformers{
 	rangeTransformer{},
 	efsConfigOrBoolTransformer{},
 	efsVolumeConfigurationTransformer{},
+	fsxWindowsVolumeConfigurationTransformer{},
 	sqsQueueOrBoolTransformer{},
 	routingRuleConfigOrBoolTransformer{},
 	secretTransformer{},
@@ -416,6 +417,32 @@ func (t efsVolumeConfigurationTransformer) Transformer(typ reflect.Type) func(ds
 	}
 }
 
+type fsxWindowsVolumeConfigurationTransformer struct{}
+
+// Transformer returns custom merge logic for FSxWindowsVolumeConfiguration's fields.
+func (t fsxWindowsVolumeConfigurationTransformer) Transformer(typ reflect.Type) func(dst, src reflect.Value) error {
+	if typ != reflect.TypeOf(FSxWindowsVolumeConfiguration{}) {
+		return nil
+	}
+	return func(dst, src reflect.Value) error {
+		dstStruct, srcStruct := dst.Interface().(FSxWindowsVolumeConfiguration), src.Interface().(FSxWindowsVolumeConfiguration)
+		if srcStruct.FileSystemID != nil {
+			dstStruct.ActiveDirectoryID = nil
+			dstStruct.StorageCapacity = nil
+			dstStruct.ThroughputCapacity = nil
+		}
+
+		if srcStruct.ActiveDirectoryID != nil {
+			dstStruct.FileSystemID = nil
+		}
+
+		if dst.CanSet() { // For extra safety to prevent panicking.
+			dst.Set(reflect.ValueOf(dstStruct))
+		}
+		return nil
+	}
+}
+
 type sqsQueueOrBoolTransformer struct{}
 
 // Transformer returns custom merge logic for SQSQueueOrBool's fields.
@@ -951,6 +951,60 @@ func TestEfsVolumeConfigurationTransformer_Transformer(t *testing.T) {
 	}
 }
 
+func TestFSxWindowsVolumeConfigurationTransformer_Transformer(t *testing.T) {
+	testCases := map[string]struct {
+		original func(f *FSxWindowsVolumeConfiguration)
+		override func(f *FSxWindowsVolumeConfiguration)
+		wanted   func(f *FSxWindowsVolumeConfiguration)
+	}{
+		"managed config set to empty if id is not empty": {
+			original: func(f *FSxWindowsVolumeConfiguration) {
+				f.ActiveDirectoryID = aws.String("d-1234567890")
+				f.StorageCapacity = aws.Int(64)
+				f.Domain = aws.String("corp.example.com")
+			},
+			override: func(f *FSxWindowsVolumeConfiguration) {
+				f.FileSystemID = aws.String("fs-1234567890abcdef0")
+			},
+			wanted: func(f *FSxWindowsVolumeConfiguration) {
+				f.FileSystemID = aws.String("fs-1234567890abcdef0")
+				f.Domain = aws.String("corp.example.com")
+			},
+		},
+		"id set to empty if active_directory_id is not empty": {
+			original: func(f *FSxWindowsVolumeConfiguration) {
+				f.FileSystemID = aws.String("fs-1234567890abcdef0")
+			},
+			override: func(f *FSxWindowsVolumeConfiguration) {
+				f.ActiveDirectoryID = aws.String("d-1234567890")
+			},
+			wanted: func(f *FSxWindowsVolumeConfiguration) {
+				f.ActiveDirectoryID = aws.String("d-1234567890")
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var dst, override, wanted FSxWindowsVolumeConfiguration
+
+			tc.original(&dst)
+			tc.override(&override)
+			tc.wanted(&wanted)
+
+			// Perform default merge.
+			err := mergo.Merge(&dst, override, mergo.WithOverride)
+			require.NoError(t, err)
+
+			// Use custom transformer.
+			err = mergo.Merge(&dst, override, mergo.WithOverride, mergo.WithTransformers(fsxWindowsVolumeConfigurationTransformer{}))
+			require.NoError(t, err)
+
+			require.Equal(t, wanted, dst)
+		})
+	}
+}
+
 func TestSQSQueueOrBoolTransformer_Transformer(t *testing.T) {
 	testCases := map[string]struct {
 		original func(e *SQSQueueOrBool)
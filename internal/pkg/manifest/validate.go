@@ -12,8 +12,11 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
+	_ "time/tzdata" // Embed the time zone database so that "timezone" fields validate on hosts without one.
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/graph"
 	"github.com/dustin/go-humanize/english"
 )
@@ -29,7 +32,24 @@ const (
 	ephemeralMinValueGiB = 20
 	ephemeralMaxValueGiB = 200
 
+	// Min and Max values for the storage capacity of an FSx for Windows File Server file system in GiB.
+	fsxWindowsMinStorageCapacityGiB = 32
+	fsxWindowsMaxStorageCapacityGiB = 65536
+
 	envFileExt = ".env"
+
+	// Container health check command types and limits.
+	// See https://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_HealthCheck.html
+	containerHealthCheckCmdExec        = "CMD"
+	containerHealthCheckCmdShell       = "CMD-SHELL"
+	containerHealthCheckCmdNone        = "NONE"
+	containerHealthCheckMinInterval    = 5 * time.Second
+	containerHealthCheckMaxInterval    = 300 * time.Second
+	containerHealthCheckMinTimeout     = 2 * time.Second
+	containerHealthCheckMaxTimeout     = 60 * time.Second
+	containerHealthCheckMaxStartPeriod = 300 * time.Second
+	containerHealthCheckMinRetries     = 1
+	containerHealthCheckMaxRetries     = 10
 )
 
 const (
@@ -44,18 +64,46 @@ const (
 var (
 	intRangeBandRegexp  = regexp.MustCompile(`^(\d+)-(\d+)$`)
 	volumesPathRegexp   = regexp.MustCompile(`^[a-zA-Z0-9\-\.\_/]+$`)
-	awsSNSTopicRegexp   = regexp.MustCompile(`^[a-zA-Z0-9_-]*$`)   // Validates that an expression contains only letters, numbers, underscores, and hyphens.
-	awsNameRegexp       = regexp.MustCompile(`^[a-z][a-z0-9\-]+$`) // Validates that an expression starts with a letter and only contains letters, numbers, and hyphens.
-	punctuationRegExp   = regexp.MustCompile(`[\.\-]{2,}`)         // Check for consecutive periods or dashes.
-	trailingPunctRegExp = regexp.MustCompile(`[\-\.]$`)            // Check for trailing dash or dot.
+	windowsPathRegexp   = regexp.MustCompile(`^[a-zA-Z]:[\\/][a-zA-Z0-9\-\.\_\\/]*$`)
+	awsSNSTopicRegexp   = regexp.MustCompile(`^[a-zA-Z0-9_-]*$`)    // Validates that an expression contains only letters, numbers, underscores, and hyphens.
+	awsNameRegexp       = regexp.MustCompile(`^[a-z][a-z0-9\-]+$`)  // Validates that an expression starts with a letter and only contains letters, numbers, and hyphens.
+	punctuationRegExp   = regexp.MustCompile(`[\.\-]{2,}`)          // Check for consecutive periods or dashes.
+	trailingPunctRegExp = regexp.MustCompile(`[\-\.]$`)             // Check for trailing dash or dot.
+	awsOrgIDRegexp      = regexp.MustCompile(`^o-[a-z0-9]{10,32}$`) // Validates that an expression is an AWS Organizations ID.
 
 	essentialContainerDependsOnValidStatuses = []string{dependsOnStart, dependsOnHealthy}
 	dependsOnValidStatuses                   = []string{dependsOnStart, dependsOnComplete, dependsOnSuccess, dependsOnHealthy}
 	nlbValidProtocols                        = []string{TCP, tls}
 	TracingValidVendors                      = []string{awsXRAY}
+	JobConcurrencyPolicies                   = []string{JobConcurrencyAllow, JobConcurrencyForbid, JobConcurrencyReplace}
 	ecsRollingUpdateStrategies               = []string{ECSDefaultRollingUpdateStrategy, ECSRecreateRollingUpdateStrategy}
+	ecsDeploymentStrategies                  = []string{ECSRollingDeploymentStrategy, ECSBlueGreenDeploymentStrategy}
+	fsxWindowsThroughputCapacities           = []int{8, 16, 32, 64, 128, 256, 512, 1024, 2048}
+	blueGreenTrafficShiftingOptions          = []string{BlueGreenAllAtOnceTrafficShifting, BlueGreenCanaryTrafficShifting, BlueGreenLinearTrafficShifting}
+	alarmMetrics                             = []string{AlarmMetricCPU, AlarmMetricMemory, AlarmMetricHTTP5xx, AlarmMetricResponseTime}
+	loadBalancerAlarmMetrics                 = []string{AlarmMetricHTTP5xx, AlarmMetricResponseTime}
+	alarmActions                             = []string{AlarmActionRollback, AlarmActionNotify}
+	serviceDiscoveryRecordTypes              = []string{ServiceDiscoveryRecordTypeA, ServiceDiscoveryRecordTypeSRV}
+	serviceDiscoveryRoutingPolicies          = []string{ServiceDiscoveryRoutingPolicyMultivalue, ServiceDiscoveryRoutingPolicyWeighted}
+
+	containerHealthCheckCmdTypes = []string{containerHealthCheckCmdExec, containerHealthCheckCmdShell, containerHealthCheckCmdNone}
 
 	httpProtocolVersions = []string{"GRPC", "HTTP1", "HTTP2"}
+	httpTargetProtocols  = []string{"HTTP", "HTTPS"}
+
+	// Bounds of the WAF rate-based rules that enforce "http.rate_limit".
+	minRateLimitPerEvaluationWindow = int64(100)
+	maxRateLimitPerEvaluationWindow = int64(2000000000)
+
+	// logRetentionValidDays are the values accepted by CloudWatch Logs for a log group's retention.
+	logRetentionValidDays = []int{1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653}
+	logGroupNameRegexp    = regexp.MustCompile(`^[\.\-_/#A-Za-z0-9]{1,512}$`)
+
+	// alarmNameRegexp validates the name of a workload alarm, which is appended to the CloudWatch alarm name.
+	alarmNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9]+(-[a-zA-Z0-9]+)*$`)
+
+	// evidentlyNameRegexp validates the name of a CloudWatch Evidently project or feature.
+	evidentlyNameRegexp = regexp.MustCompile(`^[-a-zA-Z0-9._]{1,127}$`)
 
 	invalidTaskDefOverridePathRegexp = []string{`Family`, `ContainerDefinitions\[\d+\].Name`}
 )
@@ -98,14 +146,156 @@ func (d DeploymentConfiguration) Validate() error {
 	if d.isEmpty() {
 		return nil
 	}
-	for _, validStrategy := range ecsRollingUpdateStrategies {
-		if strings.EqualFold(aws.StringValue(d.Rolling), validStrategy) {
-			return nil
+	if d.Rolling != nil && !containsFold(aws.StringValue(d.Rolling), ecsRollingUpdateStrategies) {
+		return fmt.Errorf("invalid rolling deployment strategy %s, must be one of %s",
+			aws.StringValue(d.Rolling),
+			english.WordSeries(ecsRollingUpdateStrategies, "or"))
+	}
+	if d.Strategy != nil && !containsFold(aws.StringValue(d.Strategy), ecsDeploymentStrategies) {
+		return fmt.Errorf("invalid deployment strategy %s, must be one of %s",
+			aws.StringValue(d.Strategy),
+			english.WordSeries(ecsDeploymentStrategies, "or"))
+	}
+	if !d.IsBlueGreen() {
+		if !d.BlueGreen.IsEmpty() {
+			return fmt.Errorf(`"blue_green" can only be specified when "strategy" is %s`, ECSBlueGreenDeploymentStrategy)
+		}
+		if !d.Canary.IsEmpty() {
+			return fmt.Errorf(`"canary" can only be specified when "strategy" is %s`, ECSBlueGreenDeploymentStrategy)
+		}
+		return nil
+	}
+	if d.Rolling != nil {
+		return fmt.Errorf(`"rolling" cannot be specified when "strategy" is %s`, ECSBlueGreenDeploymentStrategy)
+	}
+	if err := d.BlueGreen.Validate(); err != nil {
+		return fmt.Errorf(`validate "blue_green": %w`, err)
+	}
+	if d.Canary.IsEmpty() {
+		return nil
+	}
+	if d.BlueGreen.TrafficShifting != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "canary",
+			secondField: "blue_green.traffic_shifting",
+		}
+	}
+	if err := d.Canary.Validate(); err != nil {
+		return fmt.Errorf(`validate "canary": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if CanaryDeploymentConfig is configured correctly.
+// CodeDeploy shifts traffic either with a single canary step, or linearly with steps of the same weight and bake time,
+// so the steps must match one of these two shapes.
+func (c CanaryDeploymentConfig) Validate() error {
+	for idx, step := range c.Steps {
+		if err := step.Validate(); err != nil {
+			return fmt.Errorf(`validate "steps[%d]": %w`, idx, err)
+		}
+	}
+	if len(c.Steps) == 1 {
+		return nil
+	}
+	first := c.Steps[0]
+	for idx, step := range c.Steps {
+		if aws.IntValue(step.Weight) != (idx+1)*aws.IntValue(first.Weight) || step.BakeTime() != first.BakeTime() {
+			return fmt.Errorf(`"steps" must either be a single step, or increase the weight by the same amount after the same bake time`)
+		}
+	}
+	if (len(c.Steps)+1)*aws.IntValue(first.Weight) < 100 {
+		return fmt.Errorf(`"steps" must continue until all traffic is shifted: add steps up to weight %d`,
+			(99/aws.IntValue(first.Weight))*aws.IntValue(first.Weight))
+	}
+	return nil
+}
+
+// Validate returns nil if CanaryStep is configured correctly.
+func (s CanaryStep) Validate() error {
+	if s.Weight == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "weight",
+		}
+	}
+	if weight := aws.IntValue(s.Weight); weight < 1 || weight > 99 {
+		return fmt.Errorf(`"weight" %d must be between 1 and 99`, weight)
+	}
+	if s.Bake == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "bake",
+		}
+	}
+	if bake := s.BakeTime(); bake < time.Minute || bake%time.Minute != 0 {
+		return fmt.Errorf(`"bake" %s must be a whole number of minutes`, bake)
+	}
+	return nil
+}
+
+// Validate returns nil if BlueGreenDeploymentConfig is configured correctly.
+func (b BlueGreenDeploymentConfig) Validate() error {
+	if b.TrafficShifting != nil && !containsFold(aws.StringValue(b.TrafficShifting), blueGreenTrafficShiftingOptions) {
+		return fmt.Errorf(`invalid "traffic_shifting" %s, must be one of %s`,
+			aws.StringValue(b.TrafficShifting),
+			english.WordSeries(blueGreenTrafficShiftingOptions, "or"))
+	}
+	if b.TestListenerPort != nil && (aws.Uint16Value(b.TestListenerPort) == 80 || aws.Uint16Value(b.TestListenerPort) == 443) {
+		return fmt.Errorf(`"test_listener_port" %d is reserved for the environment load balancer listeners`, aws.Uint16Value(b.TestListenerPort))
+	}
+	return nil
+}
+
+// validateAlarms returns nil if the alarms of a service are configured correctly.
+// Metrics published by the load balancer can only be watched if the service has a target group.
+func validateAlarms(alarms []WorkloadAlarm, hasTargetGroup bool) error {
+	names := make(map[string]bool)
+	for idx, alarm := range alarms {
+		if err := alarm.Validate(); err != nil {
+			return fmt.Errorf(`validate "alarms[%d]": %w`, idx, err)
+		}
+		// Alarm resources are named after the alarm without its hyphens, so names must differ by more than hyphens.
+		name := strings.ReplaceAll(aws.StringValue(alarm.Name), "-", "")
+		if names[name] {
+			return fmt.Errorf(`validate "alarms[%d]": name %s conflicts with the name of another alarm`, idx, aws.StringValue(alarm.Name))
+		}
+		names[name] = true
+		if metric := aws.StringValue(alarm.Metric); !hasTargetGroup && containsFold(metric, loadBalancerAlarmMetrics) {
+			return fmt.Errorf(`validate "alarms[%d]": "http" must be enabled to watch metric %s`, idx, metric)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if WorkloadAlarm is configured correctly.
+func (a WorkloadAlarm) Validate() error {
+	if a.Name == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "name",
+		}
+	}
+	if name := aws.StringValue(a.Name); !alarmNameRegexp.MatchString(name) {
+		return fmt.Errorf(`"name" %s must contain only letters, numbers, and single hyphens between them`, name)
+	}
+	if a.Metric == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "metric",
 		}
 	}
-	return fmt.Errorf("invalid rolling deployment strategy %s, must be one of %s",
-		aws.StringValue(d.Rolling),
-		english.WordSeries(ecsRollingUpdateStrategies, "or"))
+	if !containsFold(aws.StringValue(a.Metric), alarmMetrics) {
+		return fmt.Errorf(`invalid "metric" %s, must be one of %s`, aws.StringValue(a.Metric), english.WordSeries(alarmMetrics, "or"))
+	}
+	if a.Threshold == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "threshold",
+		}
+	}
+	if period := a.AlarmPeriod(); period < time.Minute || period%time.Minute != 0 {
+		return fmt.Errorf(`"period" %s must be a whole number of minutes`, period)
+	}
+	if a.Action != nil && !containsFold(aws.StringValue(a.Action), alarmActions) {
+		return fmt.Errorf(`invalid "action" %s, must be one of %s`, aws.StringValue(a.Action), english.WordSeries(alarmActions, "or"))
+	}
+	return nil
 }
 
 // Validate returns nil if LoadBalancedWebServiceConfig is configured correctly.
@@ -128,6 +318,9 @@ func (l LoadBalancedWebServiceConfig) Validate() error {
 	if err = l.RoutingRule.Validate(); err != nil {
 		return fmt.Errorf(`validate "http": %w`, err)
 	}
+	if err = validateHealthCheckGracePeriod(l.RoutingRule.HealthCheck, l.ImageConfig.HealthCheck); err != nil {
+		return err
+	}
 	if err = l.TaskConfig.Validate(); err != nil {
 		return err
 	}
@@ -156,6 +349,8 @@ func (l LoadBalancedWebServiceConfig) Validate() error {
 		}); err != nil {
 			return fmt.Errorf("validate Windows: %w", err)
 		}
+	} else if err = validateNoFSxVolumes(l.Storage.Volumes); err != nil {
+		return fmt.Errorf(`validate "storage": %w`, err)
 	}
 	if l.TaskConfig.IsARM() {
 		if err = validateARM(validateARMOpts{
@@ -171,6 +366,17 @@ func (l LoadBalancedWebServiceConfig) Validate() error {
 	if err = l.DeployConfig.Validate(); err != nil {
 		return fmt.Errorf(`validate "deployment": %w`, err)
 	}
+	if err = validateAlarms(l.Alarms, !l.RoutingRule.Disabled()); err != nil {
+		return err
+	}
+	if l.DeployConfig.IsBlueGreen() {
+		if l.RoutingRule.Disabled() {
+			return fmt.Errorf(`"http" must be enabled when "deployment.strategy" is %s`, ECSBlueGreenDeploymentStrategy)
+		}
+		if !l.NLBConfig.IsEmpty() {
+			return fmt.Errorf(`"nlb" cannot be specified when "deployment.strategy" is %s`, ECSBlueGreenDeploymentStrategy)
+		}
+	}
 	return nil
 }
 
@@ -180,6 +386,9 @@ func (b BackendService) Validate() error {
 	if err = b.DeployConfig.Validate(); err != nil {
 		return fmt.Errorf(`validate "deployment": %w`, err)
 	}
+	if b.DeployConfig.IsBlueGreen() {
+		return fmt.Errorf(`validate "deployment": strategy %s is only supported by %s`, ECSBlueGreenDeploymentStrategy, LoadBalancedWebServiceType)
+	}
 	if err = b.BackendServiceConfig.Validate(); err != nil {
 		return err
 	}
@@ -209,6 +418,15 @@ func (b BackendServiceConfig) Validate() error {
 	if err = b.RoutingRule.Validate(); err != nil {
 		return fmt.Errorf(`validate "http": %w`, err)
 	}
+	if b.RoutingRule.Listener != nil {
+		return errors.New(`validate "http": "listener" is only supported for services behind the public load balancer`)
+	}
+	if b.RoutingRule.RateLimit != nil {
+		return errors.New(`validate "http": "rate_limit" is only supported for services behind the public load balancer`)
+	}
+	if err = validateHealthCheckGracePeriod(b.RoutingRule.HealthCheck, b.ImageConfig.HealthCheck); err != nil {
+		return err
+	}
 	if b.RoutingRule.IsEmpty() && (!b.Count.AdvancedCount.Requests.IsEmpty() || !b.Count.AdvancedCount.ResponseTime.IsEmpty()) {
 		return &errFieldMustBeSpecified{
 			missingField:      "http",
@@ -237,12 +455,17 @@ func (b BackendServiceConfig) Validate() error {
 			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
 		}
 	}
+	if err = validateAlarms(b.Alarms, !b.RoutingRule.IsEmpty()); err != nil {
+		return err
+	}
 	if b.TaskConfig.IsWindows() {
 		if err = validateWindows(validateWindowsOpts{
 			efsVolumes: b.Storage.Volumes,
 		}); err != nil {
 			return fmt.Errorf("validate Windows: %w", err)
 		}
+	} else if err = validateNoFSxVolumes(b.Storage.Volumes); err != nil {
+		return fmt.Errorf(`validate "storage": %w`, err)
 	}
 	if b.TaskConfig.IsARM() {
 		if err = validateARM(validateARMOpts{
@@ -269,6 +492,9 @@ func (r RequestDrivenWebServiceConfig) Validate() error {
 	if err = r.ImageConfig.Validate(); err != nil {
 		return fmt.Errorf(`validate "image": %w`, err)
 	}
+	if len(r.ImageConfig.Image.Platforms) != 0 {
+		return fmt.Errorf(`"image.platforms" is not supported for %s`, RequestDrivenWebServiceType)
+	}
 	if err = r.InstanceConfig.Validate(); err != nil {
 		return err
 	}
@@ -286,6 +512,9 @@ func (r RequestDrivenWebServiceConfig) Validate() error {
 		return fmt.Errorf(`placement %q is not supported for %s`,
 			*r.Network.VPC.Placement.PlacementString, RequestDrivenWebServiceType)
 	}
+	if len(r.Network.VPC.Placement.AZs) != 0 {
+		return fmt.Errorf(`"placement.azs" is not supported for %s`, RequestDrivenWebServiceType)
+	}
 	if err = r.Observability.Validate(); err != nil {
 		return fmt.Errorf(`validate "observability": %w`, err)
 	}
@@ -298,6 +527,9 @@ func (w WorkerService) Validate() error {
 	if err = w.DeployConfig.Validate(); err != nil {
 		return fmt.Errorf(`validate "deployment": %w`, err)
 	}
+	if w.DeployConfig.IsBlueGreen() {
+		return fmt.Errorf(`validate "deployment": strategy %s is only supported by %s`, ECSBlueGreenDeploymentStrategy, LoadBalancedWebServiceType)
+	}
 	if err = w.WorkerServiceConfig.Validate(); err != nil {
 		return err
 	}
@@ -338,6 +570,9 @@ func (w WorkerServiceConfig) Validate() error {
 	if err = w.Network.Validate(); err != nil {
 		return fmt.Errorf(`validate "network": %w`, err)
 	}
+	if !w.Network.ServiceDiscovery.IsEmpty() {
+		return fmt.Errorf(`"network.service_discovery" is not supported for %s`, WorkerServiceType)
+	}
 	if err = w.Subscribe.Validate(); err != nil {
 		return fmt.Errorf(`validate "subscribe": %w`, err)
 	}
@@ -349,12 +584,17 @@ func (w WorkerServiceConfig) Validate() error {
 			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
 		}
 	}
+	if err = validateAlarms(w.Alarms, false); err != nil {
+		return err
+	}
 	if w.TaskConfig.IsWindows() {
 		if err = validateWindows(validateWindowsOpts{
 			efsVolumes: w.Storage.Volumes,
 		}); err != nil {
 			return fmt.Errorf(`validate Windows: %w`, err)
 		}
+	} else if err = validateNoFSxVolumes(w.Storage.Volumes); err != nil {
+		return fmt.Errorf(`validate "storage": %w`, err)
 	}
 	if w.TaskConfig.IsARM() {
 		if err = validateARM(validateARMOpts{
@@ -410,6 +650,9 @@ func (s ScheduledJobConfig) Validate() error {
 	if err = s.Network.Validate(); err != nil {
 		return fmt.Errorf(`validate "network": %w`, err)
 	}
+	if !s.Network.ServiceDiscovery.IsEmpty() {
+		return fmt.Errorf(`"network.service_discovery" is not supported for %s`, ScheduledJobType)
+	}
 	if err = s.On.Validate(); err != nil {
 		return fmt.Errorf(`validate "on": %w`, err)
 	}
@@ -430,6 +673,8 @@ func (s ScheduledJobConfig) Validate() error {
 		}); err != nil {
 			return fmt.Errorf(`validate Windows: %w`, err)
 		}
+	} else if err = validateNoFSxVolumes(s.Storage.Volumes); err != nil {
+		return fmt.Errorf(`validate "storage": %w`, err)
 	}
 	if s.TaskConfig.IsARM() {
 		if err = validateARM(validateARMOpts{
@@ -514,6 +759,9 @@ func (i ImageWithHealthcheck) Validate() error {
 	if err := i.Image.Validate(); err != nil {
 		return err
 	}
+	if err := i.HealthCheck.Validate(); err != nil {
+		return fmt.Errorf(`validate "healthcheck": %w`, err)
+	}
 	return nil
 }
 
@@ -554,6 +802,69 @@ func (i Image) Validate() error {
 	if err = i.DependsOn.Validate(); err != nil {
 		return fmt.Errorf(`validate "depends_on": %w`, err)
 	}
+	if i.Location != nil && !i.TagStrategy.IsEmpty() {
+		return &errFieldMutualExclusive{
+			firstField:  "location",
+			secondField: "tag_strategy",
+		}
+	}
+	if err = i.TagStrategy.Validate(); err != nil {
+		return fmt.Errorf(`validate "tag_strategy": %w`, err)
+	}
+	if i.Location != nil && len(i.Platforms) != 0 {
+		return &errFieldMutualExclusive{
+			firstField:  "location",
+			secondField: "platforms",
+		}
+	}
+	if err = validateImagePlatforms(i.Platforms); err != nil {
+		return fmt.Errorf(`validate "platforms": %w`, err)
+	}
+	return nil
+}
+
+// validateImagePlatforms returns nil if every platform of a multi-architecture image is a distinct Linux platform.
+func validateImagePlatforms(platforms []string) error {
+	seen := make(map[string]bool)
+	for _, platform := range platforms {
+		if !contains(strings.ToLower(platform), validMultiArchPlatforms) {
+			return fmt.Errorf("platform %q is invalid; valid platforms are: %s", platform, english.WordSeries(validMultiArchPlatforms, "and"))
+		}
+		if seen[strings.ToLower(platform)] {
+			return fmt.Errorf("platform %q is specified more than once", platform)
+		}
+		seen[strings.ToLower(platform)] = true
+	}
+	return nil
+}
+
+// Validate returns nil if ImageTagStrategy is configured correctly.
+func (s ImageTagStrategy) Validate() error {
+	if s.IsEmpty() {
+		return nil
+	}
+	if s.Type == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "type",
+		}
+	}
+	typ := aws.StringValue(s.Type)
+	var isValid bool
+	for _, strategy := range ImageTagStrategies {
+		if typ == strategy {
+			isValid = true
+			break
+		}
+	}
+	if !isValid {
+		return fmt.Errorf(`"type" must be one of %s`, english.WordSeries(quoteStringSlice(ImageTagStrategies), "or"))
+	}
+	if typ == ImageTagStrategySemver && s.File == nil {
+		return fmt.Errorf(`"file" must be specified when "type" is %q`, ImageTagStrategySemver)
+	}
+	if typ != ImageTagStrategySemver && s.File != nil {
+		return fmt.Errorf(`"file" can only be specified when "type" is %q`, ImageTagStrategySemver)
+	}
 	return nil
 }
 
@@ -595,7 +906,69 @@ func (DockerBuildArgs) Validate() error {
 }
 
 // Validate returns nil if ContainerHealthCheck is configured correctly.
-func (ContainerHealthCheck) Validate() error {
+func (hc ContainerHealthCheck) Validate() error {
+	if hc.IsEmpty() {
+		return nil
+	}
+	if hc.Command != nil {
+		if err := validateContainerHealthCheckCommand(hc.Command); err != nil {
+			return fmt.Errorf(`validate "command": %w`, err)
+		}
+	}
+	if err := validateDurationRange("interval", hc.Interval, containerHealthCheckMinInterval, containerHealthCheckMaxInterval); err != nil {
+		return err
+	}
+	if err := validateDurationRange("timeout", hc.Timeout, containerHealthCheckMinTimeout, containerHealthCheckMaxTimeout); err != nil {
+		return err
+	}
+	if err := validateDurationRange("start_period", hc.StartPeriod, 0, containerHealthCheckMaxStartPeriod); err != nil {
+		return err
+	}
+	if hc.Retries != nil && (aws.IntValue(hc.Retries) < containerHealthCheckMinRetries || aws.IntValue(hc.Retries) > containerHealthCheckMaxRetries) {
+		return fmt.Errorf(`"retries" must be between %d and %d`, containerHealthCheckMinRetries, containerHealthCheckMaxRetries)
+	}
+	return nil
+}
+
+func validateContainerHealthCheckCommand(cmd []string) error {
+	if len(cmd) == 0 {
+		return errors.New("command cannot be empty")
+	}
+	switch cmd[0] {
+	case containerHealthCheckCmdNone:
+		if len(cmd) != 1 {
+			return fmt.Errorf(`"%s" cannot be followed by arguments`, containerHealthCheckCmdNone)
+		}
+	case containerHealthCheckCmdExec, containerHealthCheckCmdShell:
+		if len(cmd) == 1 {
+			return fmt.Errorf(`"%s" must be followed by the command to run`, cmd[0])
+		}
+	default:
+		return fmt.Errorf(`the first element must be one of %s`, english.WordSeries(containerHealthCheckCmdTypes, "or"))
+	}
+	return nil
+}
+
+func validateDurationRange(field string, d *time.Duration, min, max time.Duration) error {
+	if d == nil {
+		return nil
+	}
+	if *d < min || *d > max {
+		return fmt.Errorf(`%q must be between %s and %s`, field, min, max)
+	}
+	return nil
+}
+
+// validateHealthCheckGracePeriod returns an error if the load balancer stops ignoring failed health checks
+// before the container health check starts counting failures.
+func validateHealthCheckGracePeriod(http HealthCheckArgsOrString, container ContainerHealthCheck) error {
+	if http.HealthCheckArgs.GracePeriod == nil || container.StartPeriod == nil {
+		return nil
+	}
+	if *http.HealthCheckArgs.GracePeriod < *container.StartPeriod {
+		return fmt.Errorf(`"http.healthcheck.grace_period" (%s) must be at least "image.healthcheck.start_period" (%s)`,
+			*http.HealthCheckArgs.GracePeriod, *container.StartPeriod)
+	}
 	return nil
 }
 
@@ -672,6 +1045,45 @@ func (r RoutingRuleConfiguration) Validate() error {
 			conditionalFields: []string{"hosted_zone"},
 		}
 	}
+	if r.Listener != nil && !listenerNameRegexp.MatchString(aws.StringValue(r.Listener)) {
+		return fmt.Errorf(`"listener" %q can only contain letters and numbers`, aws.StringValue(r.Listener))
+	}
+	if r.TargetProtocol != nil && !contains(strings.ToUpper(aws.StringValue(r.TargetProtocol)), httpTargetProtocols) {
+		return fmt.Errorf(`"target_protocol" %q must be one of %s`, aws.StringValue(r.TargetProtocol), english.WordSeries(httpTargetProtocols, "or"))
+	}
+	if r.PrivateCA != nil {
+		if !strings.EqualFold(aws.StringValue(r.TargetProtocol), "HTTPS") {
+			return errors.New(`"target_protocol" must be "HTTPS" if "private_ca" is specified`)
+		}
+		parsed, err := arn.Parse(aws.StringValue(r.PrivateCA))
+		if err != nil || parsed.Service != "acm-pca" {
+			return fmt.Errorf(`"private_ca" %q is not a valid certificate authority ARN`, aws.StringValue(r.PrivateCA))
+		}
+	}
+	if r.RateLimit != nil {
+		if err := r.RateLimit.Validate(); err != nil {
+			return fmt.Errorf(`validate "rate_limit": %w`, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if HTTPRateLimit is configured correctly.
+func (r HTTPRateLimit) Validate() error {
+	requests, per, err := r.Parse()
+	if err != nil {
+		return err
+	}
+	windows := int64(RateLimitEvaluationWindow / per)
+	min := (minRateLimitPerEvaluationWindow + windows - 1) / windows
+	max := maxRateLimitPerEvaluationWindow / windows
+	if requests < min || requests > max {
+		unit := "second"
+		if per == time.Minute {
+			unit = "minute"
+		}
+		return fmt.Errorf("rate limit %q must allow between %d and %d requests per %s", string(r), min, max, unit)
+	}
 	return nil
 }
 
@@ -712,6 +1124,15 @@ func (a Alias) Validate() error {
 			return err
 		}
 	}
+	aliases, err := toStringSlice(&a.StringSliceOrString)
+	if err != nil {
+		return err
+	}
+	for _, alias := range aliases {
+		if err := validateWildcardAlias(alias); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -722,6 +1143,18 @@ func (a AdvancedAlias) Validate() error {
 			missingField: "name",
 		}
 	}
+	return validateWildcardAlias(aws.StringValue(a.Alias))
+}
+
+// validateWildcardAlias returns an error if a wildcard alias is not of the form "*.domain",
+// since certificates only match a wildcard as the leftmost label of a domain.
+func validateWildcardAlias(alias string) error {
+	if !strings.Contains(alias, "*") {
+		return nil
+	}
+	if !strings.HasPrefix(alias, "*.") || strings.Count(alias, "*") > 1 {
+		return fmt.Errorf(`wildcard alias %q must start with "*." and cannot contain any other "*"`, alias)
+	}
 	return nil
 }
 
@@ -795,6 +1228,12 @@ func (t TaskConfig) Validate() error {
 	if err = t.Platform.Validate(); err != nil {
 		return fmt.Errorf(`validate "platform": %w`, err)
 	}
+	if err = t.validatePlatformVersion(); err != nil {
+		return fmt.Errorf(`validate "platform_version": %w`, err)
+	}
+	if err = t.validateTaskSize(); err != nil {
+		return fmt.Errorf(`validate task size: %w`, err)
+	}
 	if err = t.Count.Validate(); err != nil {
 		return fmt.Errorf(`validate "count": %w`, err)
 	}
@@ -804,6 +1243,9 @@ func (t TaskConfig) Validate() error {
 	if err = t.Storage.Validate(); err != nil {
 		return fmt.Errorf(`validate "storage": %w`, err)
 	}
+	if err = t.Evidently.Validate(); err != nil {
+		return fmt.Errorf(`validate "evidently": %w`, err)
+	}
 	if t.EnvFile != nil {
 		envFile := aws.StringValue(t.EnvFile)
 		if filepath.Ext(envFile) != envFileExt {
@@ -813,6 +1255,103 @@ func (t TaskConfig) Validate() error {
 	return nil
 }
 
+func (t TaskConfig) validatePlatformVersion() error {
+	if t.PlatformVersion == nil {
+		return nil
+	}
+	version := aws.StringValue(t.PlatformVersion)
+	if t.IsWindows() {
+		if !contains(version, windowsFargatePlatformVersions) {
+			return fmt.Errorf("platform version %q is not supported by Windows tasks, must be one of %s", version, english.WordSeries(windowsFargatePlatformVersions, "or"))
+		}
+		return nil
+	}
+	if !contains(version, linuxFargatePlatformVersions) {
+		return fmt.Errorf("platform version %q must be one of %s", version, english.WordSeries(linuxFargatePlatformVersions, "or"))
+	}
+	if t.IsARM() && t.pinsLegacyPlatformVersion() {
+		return fmt.Errorf("platform version %q is not supported by ARM tasks, must be %s or 1.4.0", version, FargatePlatformVersionLatest)
+	}
+	return nil
+}
+
+// validateTaskSize returns nil if Fargate supports the cpu, memory and ephemeral storage of the task on its platform.
+func (t TaskConfig) validateTaskSize() error {
+	if t.Storage.Ephemeral != nil && t.pinsLegacyPlatformVersion() {
+		return fmt.Errorf(`ephemeral storage requires platform version 1.4.0 or later, but "platform_version" is %q`, aws.StringValue(t.PlatformVersion))
+	}
+	if t.CPU == nil || t.Memory == nil {
+		return nil
+	}
+	cpu, memory := aws.IntValue(t.CPU), aws.IntValue(t.Memory)
+	var supported []fargateTaskSize
+	for _, size := range fargateTaskSizes {
+		if size.linuxOnly && t.IsWindows() {
+			continue
+		}
+		if size.requiresPlatformVersion140 && t.pinsLegacyPlatformVersion() {
+			continue
+		}
+		supported = append(supported, size)
+	}
+	suggestedCPU, suggestedMemory := nearestTaskSize(supported, cpu, memory)
+	suggestion := fmt.Sprintf("try cpu %d and memory %d", suggestedCPU, suggestedMemory)
+	for _, size := range fargateTaskSizes {
+		if size.cpu != cpu {
+			continue
+		}
+		switch {
+		case size.linuxOnly && t.IsWindows():
+			return fmt.Errorf("cpu %d is not supported by Windows tasks, must be one of %s; %s", cpu, taskSizeCPUs(supported), suggestion)
+		case size.requiresPlatformVersion140 && t.pinsLegacyPlatformVersion():
+			return fmt.Errorf("cpu %d requires platform version 1.4.0 or later, but \"platform_version\" is %q; %s", cpu, aws.StringValue(t.PlatformVersion), suggestion)
+		case !containsInt(memory, size.memory):
+			return fmt.Errorf("memory %d is not supported with cpu %d, must be %s; %s", memory, cpu, size.memoryString(), suggestion)
+		}
+		return nil
+	}
+	return fmt.Errorf("cpu %d is not supported by Fargate, must be one of %s; %s", cpu, taskSizeCPUs(supported), suggestion)
+}
+
+// nearestTaskSize returns the smallest of the sizes that has at least the cpu and memory requested.
+// If none of the sizes has enough cpu, it returns the largest cpu with as much of the memory requested as possible.
+func nearestTaskSize(sizes []fargateTaskSize, cpu, memory int) (int, int) {
+	largest := sizes[len(sizes)-1]
+	if cpu > largest.cpu {
+		cpu = largest.cpu
+	}
+	for _, size := range sizes {
+		if size.cpu < cpu {
+			continue
+		}
+		for _, m := range size.memory {
+			if m >= memory {
+				return size.cpu, m
+			}
+		}
+	}
+	return largest.cpu, largest.memory[len(largest.memory)-1]
+}
+
+func taskSizeCPUs(sizes []fargateTaskSize) string {
+	var cpus []string
+	for _, size := range sizes {
+		cpus = append(cpus, strconv.Itoa(size.cpu))
+	}
+	return english.WordSeries(cpus, "or")
+}
+
+func (s fargateTaskSize) memoryString() string {
+	if len(s.memory) <= 3 {
+		var memory []string
+		for _, m := range s.memory {
+			memory = append(memory, strconv.Itoa(m))
+		}
+		return english.WordSeries(memory, "or")
+	}
+	return fmt.Sprintf("between %d and %d in increments of %d", s.memory[0], s.memory[len(s.memory)-1], s.memory[1]-s.memory[0])
+}
+
 // Validate returns nil if PlatformArgsOrString is configured correctly.
 func (p PlatformArgsOrString) Validate() error {
 	if p.IsEmpty() {
@@ -1071,6 +1610,47 @@ func (ExecuteCommandConfig) Validate() error {
 	return nil
 }
 
+// Validate returns nil if Evidently is configured correctly.
+func (e Evidently) Validate() error {
+	if e.IsEmpty() {
+		return nil
+	}
+	if e.Project != nil && !evidentlyNameRegexp.MatchString(aws.StringValue(e.Project)) {
+		return fmt.Errorf(`"project" %q must be 1 to 127 letters, numbers, hyphens, periods, or underscores`, aws.StringValue(e.Project))
+	}
+	if len(e.Features) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField:      "features",
+			conditionalFields: []string{"project"},
+		}
+	}
+	names := make(map[string]bool)
+	for idx, feature := range e.Features {
+		if err := feature.Validate(); err != nil {
+			return fmt.Errorf(`validate "features[%d]": %w`, idx, err)
+		}
+		name := aws.StringValue(feature.Name)
+		if names[name] {
+			return fmt.Errorf(`feature %q is declared more than once`, name)
+		}
+		names[name] = true
+	}
+	return nil
+}
+
+// Validate returns nil if EvidentlyFeature is configured correctly.
+func (f EvidentlyFeature) Validate() error {
+	if f.Name == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "name",
+		}
+	}
+	if !evidentlyNameRegexp.MatchString(aws.StringValue(f.Name)) {
+		return fmt.Errorf(`"name" %q must be 1 to 127 letters, numbers, hyphens, periods, or underscores`, aws.StringValue(f.Name))
+	}
+	return nil
+}
+
 // Validate returns nil if Storage is configured correctly.
 func (s Storage) Validate() error {
 	if s.IsEmpty() {
@@ -1082,17 +1662,23 @@ func (s Storage) Validate() error {
 			return fmt.Errorf(`validate "ephemeral": ephemeral storage must be between 20 GiB and 200 GiB`)
 		}
 	}
-	var hasManagedVolume bool
+	var hasManagedVolume, hasManagedFSx bool
 	for k, v := range s.Volumes {
 		if err := v.Validate(); err != nil {
 			return fmt.Errorf(`validate "volumes[%s]": %w`, k, err)
 		}
-		if !v.EmptyVolume() && v.EFS.UseManagedFS() {
+		if v.HasEFS() && v.EFS.UseManagedFS() {
 			if hasManagedVolume {
 				return fmt.Errorf("cannot specify more than one managed volume per service")
 			}
 			hasManagedVolume = true
 		}
+		if !v.FSx.IsEmpty() && v.FSx.UseManagedFS() {
+			if hasManagedFSx {
+				return fmt.Errorf("cannot specify more than one managed FSx file system per service")
+			}
+			hasManagedFSx = true
+		}
 	}
 	return nil
 }
@@ -1102,7 +1688,29 @@ func (v Volume) Validate() error {
 	if err := v.EFS.Validate(); err != nil {
 		return fmt.Errorf(`validate "efs": %w`, err)
 	}
-	return v.MountPointOpts.Validate()
+	if v.FSx.IsEmpty() {
+		return v.MountPointOpts.Validate()
+	}
+	if v.HasEFS() {
+		return &errFieldMutualExclusive{
+			firstField:  "efs",
+			secondField: "fsx",
+		}
+	}
+	if err := v.FSx.Validate(); err != nil {
+		return fmt.Errorf(`validate "fsx": %w`, err)
+	}
+	// FSx for Windows File Server volumes are mounted into Windows containers.
+	path := aws.StringValue(v.ContainerPath)
+	if path == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "path",
+		}
+	}
+	if !windowsPathRegexp.MatchString(path) {
+		return fmt.Errorf(`validate "path": path must be an absolute Windows path and can only contain the characters a-zA-Z0-9.-_\\/`)
+	}
+	return nil
 }
 
 // Validate returns nil if MountPointOpts is configured correctly.
@@ -1171,6 +1779,66 @@ func (e EFSVolumeConfiguration) Validate() error {
 	return nil
 }
 
+// Validate returns nil if FSxWindowsVolumeConfiguration is configured correctly.
+func (f FSxWindowsVolumeConfiguration) Validate() error {
+	if f.IsEmpty() {
+		return nil
+	}
+	if f.FileSystemID != nil && f.ActiveDirectoryID != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "id",
+			secondField: "active_directory_id",
+		}
+	}
+	if f.FileSystemID == nil && f.ActiveDirectoryID == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "id or active_directory_id",
+		}
+	}
+	if f.Domain == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "domain",
+		}
+	}
+	if f.CredentialsParameter == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "credentials_parameter",
+		}
+	}
+	if !arn.IsARN(aws.StringValue(f.CredentialsParameter)) {
+		return fmt.Errorf(`"credentials_parameter" %q must be the ARN of a Secrets Manager secret or an SSM parameter`, aws.StringValue(f.CredentialsParameter))
+	}
+	if f.RootDirectory != nil {
+		if err := validateVolumePath(aws.StringValue(f.RootDirectory)); err != nil {
+			return fmt.Errorf(`validate "root_dir": %w`, err)
+		}
+	}
+	if !f.UseManagedFS() {
+		if f.StorageCapacity != nil || f.ThroughputCapacity != nil {
+			return fmt.Errorf(`"storage_capacity" and "throughput_capacity" can only be specified with "active_directory_id"`)
+		}
+		return nil
+	}
+	if f.StorageCapacity != nil {
+		capacity := aws.IntValue(f.StorageCapacity)
+		if capacity < fsxWindowsMinStorageCapacityGiB || capacity > fsxWindowsMaxStorageCapacityGiB {
+			return fmt.Errorf(`"storage_capacity" must be between %d GiB and %d GiB`, fsxWindowsMinStorageCapacityGiB, fsxWindowsMaxStorageCapacityGiB)
+		}
+	}
+	if f.ThroughputCapacity != nil {
+		throughput := aws.IntValue(f.ThroughputCapacity)
+		validThroughputs := make([]string, len(fsxWindowsThroughputCapacities))
+		for i, t := range fsxWindowsThroughputCapacities {
+			if t == throughput {
+				return nil
+			}
+			validThroughputs[i] = strconv.Itoa(t)
+		}
+		return fmt.Errorf(`"throughput_capacity" %d must be one of %s`, throughput, english.WordSeries(validThroughputs, "or"))
+	}
+	return nil
+}
+
 // Validate returns nil if AuthorizationConfig is configured correctly.
 func (a AuthorizationConfig) Validate() error {
 	if a.IsEmpty() {
@@ -1181,9 +1849,29 @@ func (a AuthorizationConfig) Validate() error {
 
 // Validate returns nil if Logging is configured correctly.
 func (l Logging) Validate() error {
+	if err := validateLogRetention(l.Retention); err != nil {
+		return fmt.Errorf(`validate "retention": %w`, err)
+	}
+	if err := validateLogGroupName(l.GroupName); err != nil {
+		return fmt.Errorf(`validate "groupName": %w`, err)
+	}
+	if l.KMSKeyARN != nil && !arn.IsARN(aws.StringValue(l.KMSKeyARN)) {
+		return fmt.Errorf(`"kmsKeyARN" must be a valid KMS key ARN: %s`, aws.StringValue(l.KMSKeyARN))
+	}
+	return nil
+}
+
+// Validate returns nil if SidecarLogging is configured correctly.
+func (l SidecarLogging) Validate() error {
 	if l.IsEmpty() {
 		return nil
 	}
+	if err := validateLogRetention(l.Retention); err != nil {
+		return fmt.Errorf(`validate "retention": %w`, err)
+	}
+	if err := validateLogGroupName(l.GroupName); err != nil {
+		return fmt.Errorf(`validate "groupName": %w`, err)
+	}
 	return nil
 }
 
@@ -1200,6 +1888,9 @@ func (s SidecarConfig) Validate() error {
 	if err := s.DependsOn.Validate(); err != nil {
 		return fmt.Errorf(`validate "depends_on": %w`, err)
 	}
+	if err := s.Logging.Validate(); err != nil {
+		return fmt.Errorf(`validate "logging": %w`, err)
+	}
 	return s.ImageOverride.Validate()
 }
 
@@ -1221,6 +1912,31 @@ func (n NetworkConfig) Validate() error {
 	if err := n.VPC.Validate(); err != nil {
 		return fmt.Errorf(`validate "vpc": %w`, err)
 	}
+	if err := n.ServiceDiscovery.Validate(); err != nil {
+		return fmt.Errorf(`validate "service_discovery": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if ServiceDiscoveryConfig is configured correctly.
+func (c ServiceDiscoveryConfig) Validate() error {
+	if c.IsEmpty() {
+		return nil
+	}
+	if c.TTL != nil {
+		if *c.TTL < 0 {
+			return fmt.Errorf(`"ttl" cannot be negative`)
+		}
+		if *c.TTL%time.Second != 0 {
+			return fmt.Errorf(`"ttl" must be a whole number of seconds`)
+		}
+	}
+	if c.RecordType != nil && !contains(aws.StringValue(c.RecordType), serviceDiscoveryRecordTypes) {
+		return fmt.Errorf(`"record_type" must be one of %s`, strings.Join(serviceDiscoveryRecordTypes, ", "))
+	}
+	if c.RoutingPolicy != nil && !contains(aws.StringValue(c.RoutingPolicy), serviceDiscoveryRoutingPolicies) {
+		return fmt.Errorf(`"routing_policy" must be one of %s`, strings.Join(serviceDiscoveryRoutingPolicies, ", "))
+	}
 	return nil
 }
 
@@ -1271,8 +1987,35 @@ func (p PlacementArgOrString) Validate() error {
 	return p.PlacementArgs.Validate()
 }
 
-// Validate is a no-op for PlacementArgs.
+// Validate returns nil if PlacementArgs is configured correctly.
 func (p PlacementArgs) Validate() error {
+	if len(p.Subnets) != 0 && len(p.AZs) != 0 {
+		return &errFieldMutualExclusive{
+			firstField:  "subnets",
+			secondField: "azs",
+		}
+	}
+	if p.Type != nil && len(p.AZs) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField:      "azs",
+			conditionalFields: []string{"type"},
+		}
+	}
+	if len(p.Subnets) == 0 && len(p.AZs) == 0 {
+		return &errAtLeastOneFieldMustBeSpecified{
+			missingFields: []string{"subnets", "azs"},
+		}
+	}
+	for idx, az := range p.AZs {
+		if az == "" {
+			return fmt.Errorf(`"azs[%d]" cannot be empty`, idx)
+		}
+	}
+	if p.Type != nil {
+		if err := p.Type.Validate(); err != nil {
+			return fmt.Errorf(`validate "type": %w`, err)
+		}
+	}
 	return nil
 }
 
@@ -1348,6 +2091,24 @@ func (c JobTriggerConfig) Validate() error {
 			missingField: "schedule",
 		}
 	}
+	if c.Timezone != nil {
+		if err := validateTimezone(aws.StringValue(c.Timezone)); err != nil {
+			return fmt.Errorf(`validate "timezone": %w`, err)
+		}
+	}
+	if c.Concurrency != nil && !contains(aws.StringValue(c.Concurrency), JobConcurrencyPolicies) {
+		return fmt.Errorf(`validate "concurrency": %q must be one of %s`,
+			aws.StringValue(c.Concurrency), english.WordSeries(quoteStringSlice(JobConcurrencyPolicies), "or"))
+	}
+	return nil
+}
+
+// validateTimezone returns nil if tz is a name from the IANA time zone database, such as "America/New_York".
+func validateTimezone(tz string) error {
+	// time.LoadLocation maps "" to UTC and "Local" to the host's zone, neither of which is a database name.
+	if _, err := time.LoadLocation(tz); err != nil || tz == "" || tz == "Local" {
+		return fmt.Errorf("%q is not a valid time zone name", tz)
+	}
 	return nil
 }
 
@@ -1368,7 +2129,28 @@ func (p PublishConfig) Validate() error {
 
 // Validate returns nil if Topic is configured correctly.
 func (t Topic) Validate() error {
-	return validatePubSubName(aws.StringValue(t.Name))
+	if err := validatePubSubName(aws.StringValue(t.Name)); err != nil {
+		return err
+	}
+	if err := t.AllowedPublishers.Validate(); err != nil {
+		return fmt.Errorf(`validate "allowed_publishers": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if AllowedPrincipals is configured correctly.
+func (p AllowedPrincipals) Validate() error {
+	for _, principal := range p.ARNs {
+		if _, err := arn.Parse(principal); err != nil {
+			return fmt.Errorf(`"arns" %q is not a valid ARN`, principal)
+		}
+	}
+	for _, id := range p.OrgIDs {
+		if !awsOrgIDRegexp.MatchString(id) {
+			return fmt.Errorf(`"org_ids" %q is not a valid organization ID`, id)
+		}
+	}
+	return nil
 }
 
 // Validate returns nil if SubscribeConfig is configured correctly.
@@ -1423,6 +2205,9 @@ func (q SQSQueue) Validate() error {
 	if err := q.DeadLetter.Validate(); err != nil {
 		return fmt.Errorf(`validate "dead_letter": %w`, err)
 	}
+	if err := q.AllowedPublishers.Validate(); err != nil {
+		return fmt.Errorf(`validate "allowed_publishers": %w`, err)
+	}
 	return nil
 }
 
@@ -1598,6 +2383,32 @@ func validatePubSubName(name string) error {
 	return nil
 }
 
+func validateLogRetention(days *int) error {
+	if days == nil {
+		return nil
+	}
+	for _, valid := range logRetentionValidDays {
+		if aws.IntValue(days) == valid {
+			return nil
+		}
+	}
+	validDays := make([]string, len(logRetentionValidDays))
+	for i, d := range logRetentionValidDays {
+		validDays[i] = strconv.Itoa(d)
+	}
+	return fmt.Errorf("%d is not a valid number of days, must be one of %s", aws.IntValue(days), english.WordSeries(validDays, "or"))
+}
+
+func validateLogGroupName(name *string) error {
+	if name == nil {
+		return nil
+	}
+	if !logGroupNameRegexp.MatchString(aws.StringValue(name)) {
+		return fmt.Errorf("log group name %q must be between 1 and 512 characters and can only contain the characters a-zA-Z0-9.-_/#", aws.StringValue(name))
+	}
+	return nil
+}
+
 func isValidSubSvcName(name string) bool {
 	if !awsNameRegexp.MatchString(name) {
 		return false
@@ -1615,13 +2426,22 @@ func isValidSubSvcName(name string) bool {
 
 func validateWindows(opts validateWindowsOpts) error {
 	for _, volume := range opts.efsVolumes {
-		if !volume.EmptyVolume() {
+		if volume.HasEFS() {
 			return errors.New(`'EFS' is not supported when deploying a Windows container`)
 		}
 	}
 	return nil
 }
 
+func validateNoFSxVolumes(volumes map[string]*Volume) error {
+	for _, volume := range volumes {
+		if !volume.FSx.IsEmpty() {
+			return errors.New(`'FSx' is only supported when deploying a Windows container`)
+		}
+	}
+	return nil
+}
+
 func validateARM(opts validateARMOpts) error {
 	if opts.Spot != nil || opts.SpotFrom != nil {
 		return errors.New(`'Fargate Spot' is not supported when deploying on ARM architecture`)
@@ -1637,3 +2457,21 @@ func contains(name string, names []string) bool {
 	}
 	return false
 }
+
+func containsFold(value string, values []string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt(value int, values []int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
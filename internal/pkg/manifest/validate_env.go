@@ -6,15 +6,26 @@ package manifest
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/dustin/go-humanize/english"
+	"golang.org/x/mod/semver"
 )
 
 var (
 	errAZsNotEqual = errors.New("public subnets and private subnets do not span the same availability zones")
 
 	minAZs = 2
+
+	listenerNameRegexp    = regexp.MustCompile(`^[a-zA-Z0-9]+$`)                       // Listener names are used in CloudFormation logical IDs.
+	reservedListenerNames = []string{"HTTP", "HTTPS", "InternalHTTP", "InternalHTTPS"} // Names of the default listeners in the environment template.
+	listenerProtocols     = []string{"HTTP", "HTTPS"}
+
+	hookOnFailureValues = []string{HookOnFailureAbort, HookOnFailureWarn}
+	mutualTLSModes      = []string{MutualTLSModeVerify, MutualTLSModePassthrough}
 )
 
 // Validate returns nil if Environment is configured correctly.
@@ -33,9 +44,30 @@ func (e environmentConfig) Validate() error {
 	if err := e.Observability.Validate(); err != nil {
 		return fmt.Errorf(`validate "observability": %w`, err)
 	}
+	if err := e.Cluster.Validate(); err != nil {
+		return fmt.Errorf(`validate "cluster": %w`, err)
+	}
+	if !e.Cluster.IsEmpty() && aws.BoolValue(e.Observability.ContainerInsights) {
+		return errors.New(`"observability.container_insights" cannot be enabled by Copilot for an imported cluster: enable it on the cluster instead`)
+	}
 	if err := e.HTTPConfig.Validate(); err != nil {
 		return fmt.Errorf(`validate "http config": %w`, err)
 	}
+	if err := e.Features.Validate(); err != nil {
+		return fmt.Errorf(`validate "features": %w`, err)
+	}
+	if err := e.Hooks.Validate(); err != nil {
+		return fmt.Errorf(`validate "hooks": %w`, err)
+	}
+	if err := e.Deployment.Validate(); err != nil {
+		return fmt.Errorf(`validate "deployment": %w`, err)
+	}
+	if err := e.Storage.Validate(); err != nil {
+		return fmt.Errorf(`validate "storage": %w`, err)
+	}
+	if e.Storage.SharedEFS() && e.Network.VPC.imported() && len(e.Network.VPC.Subnets.Private) == 0 {
+		return errors.New(`"storage.efs.shared" requires private subnets to be imported for the mount targets of the file system`)
+	}
 
 	if e.HTTPConfig.Private.InternalALBSubnets != nil {
 		if !e.Network.VPC.imported() {
@@ -48,6 +80,14 @@ func (e environmentConfig) Validate() error {
 	return nil
 }
 
+// Validate returns nil if environmentClusterConfig is configured correctly.
+func (cfg environmentClusterConfig) Validate() error {
+	if cfg.ID != nil && cfg.ImportedClusterName() == "" {
+		return fmt.Errorf(`"id" %q must be the name or ARN of an ECS cluster`, aws.StringValue(cfg.ID))
+	}
+	return nil
+}
+
 // Validate returns nil if environmentNetworkConfig is configured correctly.
 func (n environmentNetworkConfig) Validate() error {
 	if err := n.VPC.Validate(); err != nil {
@@ -68,12 +108,76 @@ func (cfg environmentVPCConfig) Validate() error {
 		if err := cfg.validateImportedVPC(); err != nil {
 			return fmt.Errorf(`validate "subnets" for an imported VPC: %w`, err)
 		}
+		if err := cfg.validateImportedRouteTables(); err != nil {
+			return fmt.Errorf(`validate "route_tables": %w`, err)
+		}
+	}
+	if !cfg.imported() && (cfg.SecurityGroup != nil || !cfg.RouteTables.IsEmpty()) {
+		return errors.New(`"security_group" and "route_tables" can only be specified when importing a VPC with "id"`)
 	}
 	if cfg.managedVPCCustomized() {
 		if err := cfg.validateManagedVPC(); err != nil {
 			return fmt.Errorf(`validate "subnets" for an adjusted VPC: %w`, err)
 		}
 	}
+	if err := cfg.validateAttachments(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (cfg environmentVPCConfig) validateAttachments() error {
+	transitGateways := make(map[string]struct{})
+	destinations := make(map[string]struct{})
+	for idx, attachment := range cfg.Attachments {
+		if err := attachment.Validate(); err != nil {
+			return fmt.Errorf(`validate "attachments[%d]": %w`, idx, err)
+		}
+		if id := aws.StringValue(attachment.TransitGateway); id != "" {
+			if _, ok := transitGateways[id]; ok {
+				return fmt.Errorf(`validate "attachments[%d]": transit gateway %s is attached more than once`, idx, id)
+			}
+			transitGateways[id] = struct{}{}
+		}
+		for _, cidr := range attachment.CIDRs {
+			if _, ok := destinations[string(cidr)]; ok {
+				return fmt.Errorf(`validate "attachments[%d]": CIDR %s is routed to more than one attachment`, idx, cidr)
+			}
+			destinations[string(cidr)] = struct{}{}
+		}
+	}
+	if len(cfg.Attachments) != 0 && cfg.imported() && cfg.RouteTables.IsEmpty() {
+		return errors.New(`"attachments" require "route_tables" to be specified when importing a VPC`)
+	}
+	return nil
+}
+
+// Validate returns nil if vpcAttachment is configured correctly.
+func (a vpcAttachment) Validate() error {
+	if a.TransitGateway == nil && a.PeeringConnection == nil {
+		return &errFieldMutualExclusive{
+			firstField:  "transit_gateway",
+			secondField: "peering_connection",
+			mustExist:   true,
+		}
+	}
+	if a.TransitGateway != nil && a.PeeringConnection != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "transit_gateway",
+			secondField: "peering_connection",
+			mustExist:   false,
+		}
+	}
+	if len(a.CIDRs) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "cidrs",
+		}
+	}
+	for idx, cidr := range a.CIDRs {
+		if err := cidr.Validate(); err != nil {
+			return fmt.Errorf(`validate "cidrs[%d]": %w`, idx, err)
+		}
+	}
 	return nil
 }
 
@@ -103,6 +207,16 @@ func (cfg environmentVPCConfig) validateImportedVPC() error {
 	return nil
 }
 
+func (cfg environmentVPCConfig) validateImportedRouteTables() error {
+	if cfg.RouteTables.Public != nil && len(cfg.Subnets.Public) == 0 {
+		return errors.New(`"public" requires public subnets to be imported`)
+	}
+	if len(cfg.RouteTables.Private) != 0 && len(cfg.Subnets.Private) == 0 {
+		return errors.New(`"private" requires private subnets to be imported`)
+	}
+	return nil
+}
+
 func (cfg environmentVPCConfig) validateManagedVPC() error {
 	var (
 		publicAZs    = make(map[string]struct{})
@@ -213,6 +327,142 @@ func (cfg publicHTTPConfig) Validate() error {
 			return fmt.Errorf(`parse "certificates[%d]": %w`, idx, err)
 		}
 	}
+	names := make(map[string]bool)
+	ports := map[uint16]bool{
+		80:  true,
+		443: true,
+	}
+	for idx, listener := range cfg.AdditionalListeners {
+		if err := listener.Validate(); err != nil {
+			return fmt.Errorf(`validate "additional_listeners[%d]": %w`, idx, err)
+		}
+		name, port := aws.StringValue(listener.Name), aws.Uint16Value(listener.Port)
+		if names[name] {
+			return fmt.Errorf(`validate "additional_listeners[%d]": listener name %q is already used`, idx, name)
+		}
+		if ports[port] {
+			return fmt.Errorf(`validate "additional_listeners[%d]": port %d is already used by another listener`, idx, port)
+		}
+		names[name], ports[port] = true, true
+	}
+	if err := cfg.MutualTLS.Validate(); err != nil {
+		return fmt.Errorf(`validate "mutual_tls": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if publicHTTPListener is configured correctly.
+func (l publicHTTPListener) Validate() error {
+	if l.Name == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "name",
+		}
+	}
+	if !listenerNameRegexp.MatchString(aws.StringValue(l.Name)) {
+		return fmt.Errorf(`"name" %q can only contain letters and numbers`, aws.StringValue(l.Name))
+	}
+	for _, reserved := range reservedListenerNames {
+		if aws.StringValue(l.Name) == reserved {
+			return fmt.Errorf(`"name" %q is reserved for a default listener of the environment`, reserved)
+		}
+	}
+	if l.Port == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "port",
+		}
+	}
+	if aws.Uint16Value(l.Port) == 0 {
+		return errors.New(`"port" must be between 1 and 65535`)
+	}
+	for idx, certARN := range l.Certificates {
+		if _, err := arn.Parse(certARN); err != nil {
+			return fmt.Errorf(`parse "certificates[%d]": %w`, idx, err)
+		}
+	}
+	if err := l.DefaultAction.Validate(); err != nil {
+		return fmt.Errorf(`validate "default_action": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if listenerDefaultAction is configured correctly.
+func (a listenerDefaultAction) Validate() error {
+	if a.FixedResponse != nil && !a.Redirect.IsEmpty() {
+		return &errFieldMutualExclusive{
+			firstField:  "fixed_response",
+			secondField: "redirect",
+		}
+	}
+	if a.FixedResponse != nil {
+		code := aws.IntValue(a.FixedResponse)
+		if !(code >= 200 && code <= 299) && !(code >= 400 && code <= 599) {
+			return fmt.Errorf(`"fixed_response" %d must be a 2XX, 4XX, or 5XX status code`, code)
+		}
+	}
+	if err := a.Redirect.Validate(); err != nil {
+		return fmt.Errorf(`validate "redirect": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if listenerRedirect is configured correctly.
+func (r listenerRedirect) Validate() error {
+	if r.IsEmpty() {
+		return nil
+	}
+	if r.Port == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "port",
+		}
+	}
+	if aws.Uint16Value(r.Port) == 0 {
+		return errors.New(`"port" must be between 1 and 65535`)
+	}
+	if r.Protocol != nil && !contains(aws.StringValue(r.Protocol), listenerProtocols) {
+		return fmt.Errorf(`"protocol" %q must be one of %s`, aws.StringValue(r.Protocol), english.WordSeries(listenerProtocols, "or"))
+	}
+	return nil
+}
+
+// Validate returns nil if mutualTLSConfig is configured correctly.
+func (cfg mutualTLSConfig) Validate() error {
+	if cfg.IsEmpty() {
+		return nil
+	}
+	mode := cfg.ModeOrDefault()
+	if !contains(mode, mutualTLSModes) {
+		return fmt.Errorf(`"mode" %q must be one of %s`, mode, english.WordSeries(quoteStringSlice(mutualTLSModes), "or"))
+	}
+	if mode == MutualTLSModePassthrough {
+		if !cfg.TrustStore.IsEmpty() {
+			return fmt.Errorf(`"trust_store" cannot be specified when "mode" is %q`, MutualTLSModePassthrough)
+		}
+		if cfg.IgnoreClientCertificateExpiry != nil {
+			return fmt.Errorf(`"ignore_client_certificate_expiry" cannot be specified when "mode" is %q`, MutualTLSModePassthrough)
+		}
+		return nil
+	}
+	if cfg.TrustStore.IsEmpty() {
+		return fmt.Errorf(`"trust_store" must be specified when "mode" is %q`, MutualTLSModeVerify)
+	}
+	if err := cfg.TrustStore.Validate(); err != nil {
+		return fmt.Errorf(`validate "trust_store": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if trustStoreConfig is configured correctly.
+func (cfg trustStoreConfig) Validate() error {
+	if cfg.Bucket == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "bucket",
+		}
+	}
+	if cfg.Key == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "key",
+		}
+	}
 	return nil
 }
 
@@ -226,6 +476,109 @@ func (cfg privateHTTPConfig) Validate() error {
 	return nil
 }
 
+// Validate returns nil if environmentFeatures is configured correctly.
+func (f environmentFeatures) Validate() error {
+	for _, name := range []string{EnvFeatureServiceConnect} {
+		if err := f.byName()[name].Validate(); err != nil {
+			return fmt.Errorf(`validate %q: %w`, name, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if environmentFeature is configured correctly.
+func (f environmentFeature) Validate() error {
+	if f.MinCLIVersion == nil {
+		return nil
+	}
+	if f.Enabled == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "enabled",
+			conditionalFields: []string{"min_cli_version"},
+		}
+	}
+	if !semver.IsValid(canonicalSemver(aws.StringValue(f.MinCLIVersion))) {
+		return fmt.Errorf(`"min_cli_version" %q is not a valid semantic version`, aws.StringValue(f.MinCLIVersion))
+	}
+	return nil
+}
+
+// Validate returns nil if environmentHooks is configured correctly.
+func (h environmentHooks) Validate() error {
+	for i, hook := range h.PreDeploy {
+		if err := hook.Validate(); err != nil {
+			return fmt.Errorf(`validate "pre_deploy[%d]": %w`, i, err)
+		}
+	}
+	for i, hook := range h.PostDeploy {
+		if err := hook.Validate(); err != nil {
+			return fmt.Errorf(`validate "post_deploy[%d]": %w`, i, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if DeploymentHook is configured correctly.
+func (h DeploymentHook) Validate() error {
+	if h.Command != nil && h.Lambda != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "command",
+			secondField: "lambda",
+		}
+	}
+	if h.Command == nil && h.Lambda == nil {
+		return &errFieldMutualExclusive{
+			firstField:  "command",
+			secondField: "lambda",
+			mustExist:   true,
+		}
+	}
+	if h.Command != nil && strings.TrimSpace(aws.StringValue(h.Command)) == "" {
+		return errors.New(`"command" cannot be empty`)
+	}
+	if h.Lambda != nil {
+		parsed, err := arn.Parse(aws.StringValue(h.Lambda))
+		if err != nil || parsed.Service != "lambda" {
+			return fmt.Errorf(`"lambda" %q is not a valid function ARN`, aws.StringValue(h.Lambda))
+		}
+	}
+	if h.OnFailure != nil && !contains(aws.StringValue(h.OnFailure), hookOnFailureValues) {
+		return fmt.Errorf(`"on_failure" %q must be one of %s`, aws.StringValue(h.OnFailure), english.WordSeries(quoteStringSlice(hookOnFailureValues), "or"))
+	}
+	return nil
+}
+
+// Validate returns nil if environmentStorageConfig is configured correctly.
+func (cfg environmentStorageConfig) Validate() error {
+	if err := cfg.EFS.Validate(); err != nil {
+		return fmt.Errorf(`validate "efs": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if environmentEFSConfig is configured correctly.
+func (cfg environmentEFSConfig) Validate() error {
+	return nil
+}
+
+// Validate returns nil if environmentDeployment is configured correctly.
+func (d environmentDeployment) Validate() error {
+	if d.Timeout != nil && *d.Timeout <= 0 {
+		return fmt.Errorf(`"timeout" %s must be positive`, *d.Timeout)
+	}
+	if d.CustomResourcesBucket != nil && aws.StringValue(d.CustomResourcesBucket) == "" {
+		return errors.New(`"custom_resources_bucket" cannot be empty`)
+	}
+	if d.ExecutionRole == nil {
+		return nil
+	}
+	parsed, err := arn.Parse(aws.StringValue(d.ExecutionRole))
+	if err != nil || parsed.Service != "iam" || !strings.HasPrefix(parsed.Resource, "role/") {
+		return fmt.Errorf(`"execution_role" %q is not a valid IAM role ARN`, aws.StringValue(d.ExecutionRole))
+	}
+	return nil
+}
+
 // Validate returns nil if environmentCDNConfig is configured correctly.
 func (cfg environmentCDNConfig) Validate() error {
 	if cfg.CDNConfig.IsEmpty() {
@@ -6,6 +6,7 @@ package manifest
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
@@ -137,6 +138,65 @@ func TestEnvironmentConfig_Validate(t *testing.T) {
 				},
 			},
 		},
+		"error if the imported cluster ARN is not a cluster": {
+			in: environmentConfig{
+				Cluster: environmentClusterConfig{
+					ID: aws.String("arn:aws:ecs:us-west-2:123456789012:cluster/"),
+				},
+			},
+			wantedError: `validate "cluster": "id" "arn:aws:ecs:us-west-2:123456789012:cluster/" must be the name or ARN of an ECS cluster`,
+		},
+		"error if container insights are enabled for an imported cluster": {
+			in: environmentConfig{
+				Cluster: environmentClusterConfig{
+					ID: aws.String("shared"),
+				},
+				Observability: environmentObservability{
+					ContainerInsights: aws.Bool(true),
+				},
+			},
+			wantedError: `"observability.container_insights" cannot be enabled by Copilot for an imported cluster`,
+		},
+		"valid case with an imported cluster": {
+			in: environmentConfig{
+				Cluster: environmentClusterConfig{
+					ID: aws.String("arn:aws:ecs:us-west-2:123456789012:cluster/shared"),
+				},
+				Observability: environmentObservability{
+					ContainerInsights: aws.Bool(false),
+				},
+			},
+		},
+		"error if the shared file system has no private subnets for its mount targets": {
+			in: environmentConfig{
+				Network: environmentNetworkConfig{
+					VPC: environmentVPCConfig{
+						ID: aws.String("vpc-1234"),
+						Subnets: subnetsConfiguration{
+							Public: []subnetConfiguration{
+								{SubnetID: aws.String("mock-public-subnet-1")},
+								{SubnetID: aws.String("mock-public-subnet-2")},
+							},
+						},
+					},
+				},
+				Storage: environmentStorageConfig{
+					EFS: environmentEFSConfig{
+						Shared: aws.Bool(true),
+					},
+				},
+			},
+			wantedError: `"storage.efs.shared" requires private subnets to be imported for the mount targets of the file system`,
+		},
+		"valid case with a shared file system in a managed VPC": {
+			in: environmentConfig{
+				Storage: environmentStorageConfig{
+					EFS: environmentEFSConfig{
+						Shared: aws.Bool(true),
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -507,6 +567,159 @@ func TestEnvironmentVPCConfig_Validate(t *testing.T) {
 				},
 			},
 		},
+		"error if a security group is specified without importing a vpc": {
+			in: environmentVPCConfig{
+				SecurityGroup: aws.String("sg-1234"),
+			},
+			wantedErr: errors.New(`"security_group" and "route_tables" can only be specified when importing a VPC with "id"`),
+		},
+		"error if a public route table is imported without public subnets": {
+			in: environmentVPCConfig{
+				ID: aws.String("vpc-1234"),
+				Subnets: subnetsConfiguration{
+					Private: []subnetConfiguration{
+						{
+							SubnetID: aws.String("mock-private-subnet-1"),
+						},
+						{
+							SubnetID: aws.String("mock-private-subnet-2"),
+						},
+					},
+				},
+				RouteTables: routeTablesConfiguration{
+					Public: aws.String("rtb-1"),
+				},
+			},
+			wantedErr: errors.New(`validate "route_tables": "public" requires public subnets to be imported`),
+		},
+		"succeed on imported vpc with existing security group and route tables": {
+			in: environmentVPCConfig{
+				ID: aws.String("vpc-1234"),
+				Subnets: subnetsConfiguration{
+					Private: []subnetConfiguration{
+						{
+							SubnetID: aws.String("mock-private-subnet-1"),
+						},
+						{
+							SubnetID: aws.String("mock-private-subnet-2"),
+						},
+					},
+				},
+				SecurityGroup: aws.String("sg-1234"),
+				RouteTables: routeTablesConfiguration{
+					Private: []string{"rtb-1", "rtb-2"},
+				},
+			},
+		},
+		"error if an attachment has neither a transit gateway nor a peering connection": {
+			in: environmentVPCConfig{
+				Attachments: []vpcAttachment{
+					{
+						CIDRs: []IPNet{"192.168.0.0/16"},
+					},
+				},
+			},
+			wantedErr: errors.New(`validate "attachments[0]": must specify one of "transit_gateway" and "peering_connection"`),
+		},
+		"error if an attachment has both a transit gateway and a peering connection": {
+			in: environmentVPCConfig{
+				Attachments: []vpcAttachment{
+					{
+						TransitGateway:    aws.String("tgw-1234"),
+						PeeringConnection: aws.String("pcx-1234"),
+						CIDRs:             []IPNet{"192.168.0.0/16"},
+					},
+				},
+			},
+			wantedErr: errors.New(`validate "attachments[0]": must specify one, not both, of "transit_gateway" and "peering_connection"`),
+		},
+		"error if an attachment has no CIDRs": {
+			in: environmentVPCConfig{
+				Attachments: []vpcAttachment{
+					{
+						TransitGateway: aws.String("tgw-1234"),
+					},
+				},
+			},
+			wantedErr: errors.New(`validate "attachments[0]": "cidrs" must be specified`),
+		},
+		"error if an attachment has an invalid CIDR": {
+			in: environmentVPCConfig{
+				Attachments: []vpcAttachment{
+					{
+						PeeringConnection: aws.String("pcx-1234"),
+						CIDRs:             []IPNet{"192.168.0.0"},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "attachments[0]": validate "cidrs[0]": parse IPNet 192.168.0.0: `,
+		},
+		"error if a transit gateway is attached twice": {
+			in: environmentVPCConfig{
+				Attachments: []vpcAttachment{
+					{
+						TransitGateway: aws.String("tgw-1234"),
+						CIDRs:          []IPNet{"192.168.0.0/16"},
+					},
+					{
+						TransitGateway: aws.String("tgw-1234"),
+						CIDRs:          []IPNet{"172.16.0.0/12"},
+					},
+				},
+			},
+			wantedErr: errors.New(`validate "attachments[1]": transit gateway tgw-1234 is attached more than once`),
+		},
+		"error if a CIDR is routed to two attachments": {
+			in: environmentVPCConfig{
+				Attachments: []vpcAttachment{
+					{
+						TransitGateway: aws.String("tgw-1234"),
+						CIDRs:          []IPNet{"192.168.0.0/16"},
+					},
+					{
+						PeeringConnection: aws.String("pcx-1234"),
+						CIDRs:             []IPNet{"192.168.0.0/16"},
+					},
+				},
+			},
+			wantedErr: errors.New(`validate "attachments[1]": CIDR 192.168.0.0/16 is routed to more than one attachment`),
+		},
+		"error if attachments are specified for an imported vpc without route tables": {
+			in: environmentVPCConfig{
+				ID: aws.String("vpc-1234"),
+				Subnets: subnetsConfiguration{
+					Private: []subnetConfiguration{
+						{
+							SubnetID: aws.String("mock-private-subnet-1"),
+						},
+						{
+							SubnetID: aws.String("mock-private-subnet-2"),
+						},
+					},
+				},
+				Attachments: []vpcAttachment{
+					{
+						TransitGateway: aws.String("tgw-1234"),
+						CIDRs:          []IPNet{"192.168.0.0/16"},
+					},
+				},
+			},
+			wantedErr: errors.New(`"attachments" require "route_tables" to be specified when importing a VPC`),
+		},
+		"succeed on attachments to a managed vpc": {
+			in: environmentVPCConfig{
+				Attachments: []vpcAttachment{
+					{
+						TransitGateway: aws.String("tgw-1234"),
+						CIDRs:          []IPNet{"192.168.0.0/16", "172.16.0.0/12"},
+					},
+					{
+						PeeringConnection: aws.String("pcx-1234"),
+						CIDRs:             []IPNet{"10.100.0.0/16"},
+					},
+				},
+			},
+		},
 		"succeed on empty config": {},
 	}
 	for name, tc := range testCases {
@@ -617,6 +830,265 @@ func TestCDNConfiguration_Validate(t *testing.T) {
 	}
 }
 
+func TestEnvironmentFeatures_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in          environmentFeatures
+		wantedError error
+	}{
+		"valid if empty": {
+			in: environmentFeatures{},
+		},
+		"valid if min_cli_version is a semantic version": {
+			in: environmentFeatures{
+				ServiceConnect: environmentFeature{
+					Enabled:       aws.Bool(true),
+					MinCLIVersion: aws.String("1.22.0"),
+				},
+			},
+		},
+		"error if min_cli_version is specified without enabled": {
+			in: environmentFeatures{
+				ServiceConnect: environmentFeature{
+					MinCLIVersion: aws.String("v1.22.0"),
+				},
+			},
+			wantedError: errors.New(`validate "service_connect": "enabled" must be specified if "min_cli_version" is specified`),
+		},
+		"error if min_cli_version is not a semantic version": {
+			in: environmentFeatures{
+				ServiceConnect: environmentFeature{
+					Enabled:       aws.Bool(true),
+					MinCLIVersion: aws.String("latest"),
+				},
+			},
+			wantedError: errors.New(`validate "service_connect": "min_cli_version" "latest" is not a valid semantic version`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.Validate()
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestMutualTLSConfig_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in          mutualTLSConfig
+		wantedError error
+	}{
+		"valid if empty": {
+			in: mutualTLSConfig{},
+		},
+		"valid with a trust store": {
+			in: mutualTLSConfig{
+				TrustStore: trustStoreConfig{
+					Bucket: aws.String("my-ca-bucket"),
+					Key:    aws.String("ca-bundle.pem"),
+				},
+				IgnoreClientCertificateExpiry: aws.Bool(true),
+			},
+		},
+		"valid in passthrough mode": {
+			in: mutualTLSConfig{
+				Mode: aws.String("passthrough"),
+			},
+		},
+		"error if mode is invalid": {
+			in: mutualTLSConfig{
+				Mode: aws.String("off"),
+			},
+			wantedError: errors.New(`"mode" "off" must be one of "verify" or "passthrough"`),
+		},
+		"error if trust store is missing in verify mode": {
+			in: mutualTLSConfig{
+				Mode: aws.String("verify"),
+			},
+			wantedError: errors.New(`"trust_store" must be specified when "mode" is "verify"`),
+		},
+		"error if trust store key is missing": {
+			in: mutualTLSConfig{
+				TrustStore: trustStoreConfig{
+					Bucket: aws.String("my-ca-bucket"),
+				},
+			},
+			wantedError: errors.New(`validate "trust_store": "key" must be specified`),
+		},
+		"error if trust store is specified in passthrough mode": {
+			in: mutualTLSConfig{
+				Mode: aws.String("passthrough"),
+				TrustStore: trustStoreConfig{
+					Bucket: aws.String("my-ca-bucket"),
+					Key:    aws.String("ca-bundle.pem"),
+				},
+			},
+			wantedError: errors.New(`"trust_store" cannot be specified when "mode" is "passthrough"`),
+		},
+		"error if ignore_client_certificate_expiry is specified in passthrough mode": {
+			in: mutualTLSConfig{
+				Mode:                          aws.String("passthrough"),
+				IgnoreClientCertificateExpiry: aws.Bool(true),
+			},
+			wantedError: errors.New(`"ignore_client_certificate_expiry" cannot be specified when "mode" is "passthrough"`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.Validate()
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestEnvironmentHooks_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in          environmentHooks
+		wantedError error
+	}{
+		"valid if empty": {
+			in: environmentHooks{},
+		},
+		"valid with commands and functions": {
+			in: environmentHooks{
+				PreDeploy: []DeploymentHook{
+					{Command: aws.String("./validate.sh")},
+				},
+				PostDeploy: []DeploymentHook{
+					{
+						Lambda:    aws.String("arn:aws:lambda:us-west-2:123456789012:function:validate"),
+						OnFailure: aws.String("warn"),
+					},
+				},
+			},
+		},
+		"error if neither command nor lambda is specified": {
+			in: environmentHooks{
+				PreDeploy: []DeploymentHook{
+					{OnFailure: aws.String("warn")},
+				},
+			},
+			wantedError: errors.New(`validate "pre_deploy[0]": must specify one of "command" and "lambda"`),
+		},
+		"error if both command and lambda are specified": {
+			in: environmentHooks{
+				PostDeploy: []DeploymentHook{
+					{Command: aws.String("./validate.sh")},
+					{
+						Command: aws.String("./validate.sh"),
+						Lambda:  aws.String("arn:aws:lambda:us-west-2:123456789012:function:validate"),
+					},
+				},
+			},
+			wantedError: errors.New(`validate "post_deploy[1]": must specify one, not both, of "command" and "lambda"`),
+		},
+		"error if command is empty": {
+			in: environmentHooks{
+				PreDeploy: []DeploymentHook{
+					{Command: aws.String(" ")},
+				},
+			},
+			wantedError: errors.New(`validate "pre_deploy[0]": "command" cannot be empty`),
+		},
+		"error if lambda is not a function ARN": {
+			in: environmentHooks{
+				PostDeploy: []DeploymentHook{
+					{Lambda: aws.String("arn:aws:s3:::mybucket")},
+				},
+			},
+			wantedError: errors.New(`validate "post_deploy[0]": "lambda" "arn:aws:s3:::mybucket" is not a valid function ARN`),
+		},
+		"error if on_failure is invalid": {
+			in: environmentHooks{
+				PreDeploy: []DeploymentHook{
+					{
+						Command:   aws.String("./validate.sh"),
+						OnFailure: aws.String("ignore"),
+					},
+				},
+			},
+			wantedError: errors.New(`validate "pre_deploy[0]": "on_failure" "ignore" must be one of "abort" or "warn"`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.Validate()
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestEnvironmentDeployment_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in          environmentDeployment
+		wantedError error
+	}{
+		"valid if empty": {
+			in: environmentDeployment{},
+		},
+		"valid with a role ARN": {
+			in: environmentDeployment{
+				ExecutionRole: aws.String("arn:aws:iam::123456789012:role/restricted-deploy"),
+			},
+		},
+		"valid with a timeout": {
+			in: environmentDeployment{
+				Timeout: durationp(3 * time.Hour),
+			},
+		},
+		"error if timeout is not positive": {
+			in: environmentDeployment{
+				Timeout: durationp(0),
+			},
+			wantedError: errors.New(`"timeout" 0s must be positive`),
+		},
+		"valid with a custom resources bucket": {
+			in: environmentDeployment{
+				CustomResourcesBucket: aws.String("internal-artifacts"),
+			},
+		},
+		"error if custom_resources_bucket is empty": {
+			in: environmentDeployment{
+				CustomResourcesBucket: aws.String(""),
+			},
+			wantedError: errors.New(`"custom_resources_bucket" cannot be empty`),
+		},
+		"error if execution_role is not an ARN": {
+			in: environmentDeployment{
+				ExecutionRole: aws.String("restricted-deploy"),
+			},
+			wantedError: errors.New(`"execution_role" "restricted-deploy" is not a valid IAM role ARN`),
+		},
+		"error if execution_role is not a role ARN": {
+			in: environmentDeployment{
+				ExecutionRole: aws.String("arn:aws:iam::123456789012:user/deployer"),
+			},
+			wantedError: errors.New(`"execution_role" "arn:aws:iam::123456789012:user/deployer" is not a valid IAM role ARN`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.Validate()
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
 func TestSubnetConfiguration_Validate(t *testing.T) {
 	mockCIDR := IPNet("10.0.0.0/24")
 	testCases := map[string]struct {
@@ -715,6 +1187,168 @@ func TestEnvironmentHTTPConfig_Validate(t *testing.T) {
 				},
 			},
 		},
+		"error if an additional listener is missing a port": {
+			in: environmentHTTPConfig{
+				Public: publicHTTPConfig{
+					AdditionalListeners: []publicHTTPListener{
+						{
+							Name: aws.String("legacy"),
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "additional_listeners[0]": "port" must be specified`,
+		},
+		"error if an additional listener name is not alphanumeric": {
+			in: environmentHTTPConfig{
+				Public: publicHTTPConfig{
+					AdditionalListeners: []publicHTTPListener{
+						{
+							Name: aws.String("legacy-tls"),
+							Port: aws.Uint16(8443),
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "additional_listeners[0]": "name" "legacy-tls" can only contain letters and numbers`,
+		},
+		"error if an additional listener uses the name of a default listener": {
+			in: environmentHTTPConfig{
+				Public: publicHTTPConfig{
+					AdditionalListeners: []publicHTTPListener{
+						{
+							Name: aws.String("InternalHTTPS"),
+							Port: aws.Uint16(8443),
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "additional_listeners[0]": "name" "InternalHTTPS" is reserved for a default listener of the environment`,
+		},
+		"error if an additional listener uses the port of a default listener": {
+			in: environmentHTTPConfig{
+				Public: publicHTTPConfig{
+					AdditionalListeners: []publicHTTPListener{
+						{
+							Name: aws.String("legacy"),
+							Port: aws.Uint16(443),
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "additional_listeners[0]": port 443 is already used by another listener`,
+		},
+		"error if additional listeners have the same name": {
+			in: environmentHTTPConfig{
+				Public: publicHTTPConfig{
+					AdditionalListeners: []publicHTTPListener{
+						{
+							Name: aws.String("legacy"),
+							Port: aws.Uint16(8080),
+						},
+						{
+							Name: aws.String("legacy"),
+							Port: aws.Uint16(8443),
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "additional_listeners[1]": listener name "legacy" is already used`,
+		},
+		"error if an additional listener has a malformed certificate": {
+			in: environmentHTTPConfig{
+				Public: publicHTTPConfig{
+					AdditionalListeners: []publicHTTPListener{
+						{
+							Name:         aws.String("legacy"),
+							Port:         aws.Uint16(8443),
+							Certificates: []string{"arn:aws:weird-little-arn"},
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "additional_listeners[0]": parse "certificates[0]": `,
+		},
+		"error if both fixed_response and redirect are specified": {
+			in: environmentHTTPConfig{
+				Public: publicHTTPConfig{
+					AdditionalListeners: []publicHTTPListener{
+						{
+							Name: aws.String("legacy"),
+							Port: aws.Uint16(8080),
+							DefaultAction: listenerDefaultAction{
+								FixedResponse: aws.Int(404),
+								Redirect: listenerRedirect{
+									Port: aws.Uint16(443),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "default_action": must specify one, not both, of "fixed_response" and "redirect"`,
+		},
+		"error if fixed_response is not a valid status code": {
+			in: environmentHTTPConfig{
+				Public: publicHTTPConfig{
+					AdditionalListeners: []publicHTTPListener{
+						{
+							Name: aws.String("legacy"),
+							Port: aws.Uint16(8080),
+							DefaultAction: listenerDefaultAction{
+								FixedResponse: aws.Int(301),
+							},
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `"fixed_response" 301 must be a 2XX, 4XX, or 5XX status code`,
+		},
+		"error if redirect protocol is invalid": {
+			in: environmentHTTPConfig{
+				Public: publicHTTPConfig{
+					AdditionalListeners: []publicHTTPListener{
+						{
+							Name: aws.String("legacy"),
+							Port: aws.Uint16(8080),
+							DefaultAction: listenerDefaultAction{
+								Redirect: listenerRedirect{
+									Port:     aws.Uint16(443),
+									Protocol: aws.String("TCP"),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "redirect": "protocol" "TCP" must be one of HTTP or HTTPS`,
+		},
+		"success with additional listeners": {
+			in: environmentHTTPConfig{
+				Public: publicHTTPConfig{
+					AdditionalListeners: []publicHTTPListener{
+						{
+							Name: aws.String("legacy"),
+							Port: aws.Uint16(8080),
+							DefaultAction: listenerDefaultAction{
+								FixedResponse: aws.Int(404),
+							},
+						},
+						{
+							Name:         aws.String("legacyTLS"),
+							Port:         aws.Uint16(8443),
+							Certificates: []string{"arn:aws:acm:us-east-1:1111111:certificate/look-like-a-good-arn"},
+							DefaultAction: listenerDefaultAction{
+								Redirect: listenerRedirect{
+									Port:     aws.Uint16(443),
+									Protocol: aws.String("HTTPS"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -82,7 +82,7 @@ func TestLoadBalancedWebService_Validate(t *testing.T) {
 				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
 					ImageConfig: testImageConfig,
 					Network: NetworkConfig{
-						vpcConfig{
+						VPC: vpcConfig{
 							Placement: PlacementArgOrString{
 								PlacementString: (*PlacementString)(aws.String("")),
 							},
@@ -340,6 +340,74 @@ func TestLoadBalancedWebService_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "deployment"`,
 		},
+		"error if blue/green deployment is used without http": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					RoutingRule: RoutingRuleConfigOrBool{
+						Enabled: aws.Bool(false),
+					},
+					NLBConfig: NetworkLoadBalancerConfiguration{
+						Port: aws.String("443/tcp"),
+					},
+					DeployConfig: DeploymentConfiguration{
+						Strategy: aws.String("blue/green"),
+					},
+				},
+			},
+			wantedError: errors.New(`"http" must be enabled when "deployment.strategy" is blue/green`),
+		},
+		"error if blue/green deployment is used with nlb": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					RoutingRule: RoutingRuleConfigOrBool{
+						RoutingRuleConfiguration: RoutingRuleConfiguration{
+							Path: stringP("/"),
+						},
+					},
+					NLBConfig: NetworkLoadBalancerConfiguration{
+						Port: aws.String("443/tcp"),
+					},
+					DeployConfig: DeploymentConfiguration{
+						Strategy: aws.String("blue/green"),
+					},
+				},
+			},
+			wantedError: errors.New(`"nlb" cannot be specified when "deployment.strategy" is blue/green`),
+		},
+		"error if the grace period is shorter than the container health check start period": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: ImageWithPortAndHealthcheck{
+						ImageWithPort: testImageConfig.ImageWithPort,
+						HealthCheck: ContainerHealthCheck{
+							StartPeriod: durationp(90 * time.Second),
+						},
+					},
+					RoutingRule: RoutingRuleConfigOrBool{
+						RoutingRuleConfiguration: RoutingRuleConfiguration{
+							Path: stringP("/"),
+							HealthCheck: HealthCheckArgsOrString{
+								HealthCheckArgs: HTTPHealthCheckArgs{
+									GracePeriod: durationp(30 * time.Second),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`"http.healthcheck.grace_period" (30s) must be at least "image.healthcheck.start_period" (1m30s)`),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -389,6 +457,30 @@ func TestBackendService_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "image": `,
 		},
+		"error if a listener is specified": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					RoutingRule: RoutingRuleConfiguration{
+						Path:     aws.String("/"),
+						Listener: aws.String("legacy"),
+					},
+				},
+			},
+			wantedError: errors.New(`validate "http": "listener" is only supported for services behind the public load balancer`),
+		},
+		"error if a rate limit is specified": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					RoutingRule: RoutingRuleConfiguration{
+						Path:      aws.String("/"),
+						RateLimit: (*HTTPRateLimit)(aws.String("1000rps-per-ip")),
+					},
+				},
+			},
+			wantedError: errors.New(`validate "http": "rate_limit" is only supported for services behind the public load balancer`),
+		},
 		"error if fail to validate sidecars": {
 			config: BackendService{
 				BackendServiceConfig: BackendServiceConfig{
@@ -409,7 +501,7 @@ func TestBackendService_Validate(t *testing.T) {
 				BackendServiceConfig: BackendServiceConfig{
 					ImageConfig: testImageConfig,
 					Network: NetworkConfig{
-						vpcConfig{
+						VPC: vpcConfig{
 							Placement: PlacementArgOrString{
 								PlacementString: (*PlacementString)(aws.String("")),
 							},
@@ -536,6 +628,17 @@ func TestBackendService_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "deployment":`,
 		},
+		"error if blue/green deployment is used": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					DeployConfig: DeploymentConfiguration{
+						Strategy: aws.String("blue/green"),
+					},
+				},
+			},
+			wantedError: errors.New(`validate "deployment": strategy blue/green is only supported by Load Balanced Web Service`),
+		},
 		"error if fail to validate http": {
 			config: BackendService{
 				BackendServiceConfig: BackendServiceConfig{
@@ -607,6 +710,23 @@ func TestRequestDrivenWebService_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "image": `,
 		},
+		"error if image platforms are specified": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
+						Image: Image{
+							Build:     BuildArgsOrString{BuildString: aws.String("mockBuild")},
+							Platforms: []string{"linux/amd64", "linux/arm64"},
+						},
+						Port: uint16P(80),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`"image.platforms" is not supported for Request-Driven Web Service`),
+		},
 		"error if fail to validate instance": {
 			config: RequestDrivenWebService{
 				Workload: Workload{
@@ -771,7 +891,7 @@ func TestWorkerService_Validate(t *testing.T) {
 				WorkerServiceConfig: WorkerServiceConfig{
 					ImageConfig: testImageConfig,
 					Network: NetworkConfig{
-						vpcConfig{
+						VPC: vpcConfig{
 							Placement: PlacementArgOrString{
 								PlacementString: (*PlacementString)(aws.String("")),
 							},
@@ -781,6 +901,19 @@ func TestWorkerService_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "network": `,
 		},
+		"error if service discovery is configured": {
+			config: WorkerService{
+				WorkerServiceConfig: WorkerServiceConfig{
+					ImageConfig: testImageConfig,
+					Network: NetworkConfig{
+						ServiceDiscovery: ServiceDiscoveryConfig{
+							RecordType: aws.String("SRV"),
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `"network.service_discovery" is not supported for Worker Service`,
+		},
 		"error if fail to validate subscribe": {
 			config: WorkerService{
 				WorkerServiceConfig: WorkerServiceConfig{
@@ -977,7 +1110,7 @@ func TestScheduledJob_Validate(t *testing.T) {
 				ScheduledJobConfig: ScheduledJobConfig{
 					ImageConfig: testImageConfig,
 					Network: NetworkConfig{
-						vpcConfig{
+						VPC: vpcConfig{
 							Placement: PlacementArgOrString{
 								PlacementString: (*PlacementString)(aws.String("")),
 							},
@@ -1244,6 +1377,69 @@ func TestImage_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "depends_on":`,
 		},
+		"error if tag_strategy is specified with location": {
+			Image: Image{
+				Location: aws.String("mockLocation"),
+				TagStrategy: ImageTagStrategy{
+					Type: aws.String("git-sha"),
+				},
+			},
+			wantedError: fmt.Errorf(`must specify one, not both, of "location" and "tag_strategy"`),
+		},
+		"error if fail to validate tag_strategy": {
+			Image: Image{
+				Build: BuildArgsOrString{
+					BuildString: aws.String("mockBuild"),
+				},
+				TagStrategy: ImageTagStrategy{
+					Type: aws.String("latest"),
+				},
+			},
+			wantedErrorMsgPrefix: `validate "tag_strategy":`,
+		},
+		"valid with a tag_strategy": {
+			Image: Image{
+				Build: BuildArgsOrString{
+					BuildString: aws.String("mockBuild"),
+				},
+				TagStrategy: ImageTagStrategy{
+					Type: aws.String("timestamp"),
+				},
+			},
+		},
+		"error if platforms is specified with location": {
+			Image: Image{
+				Location:  aws.String("mockLocation"),
+				Platforms: []string{"linux/amd64", "linux/arm64"},
+			},
+			wantedError: fmt.Errorf(`must specify one, not both, of "location" and "platforms"`),
+		},
+		"error if a platform is invalid": {
+			Image: Image{
+				Build: BuildArgsOrString{
+					BuildString: aws.String("mockBuild"),
+				},
+				Platforms: []string{"linux/amd64", "windows/amd64"},
+			},
+			wantedError: fmt.Errorf(`validate "platforms": platform "windows/amd64" is invalid; valid platforms are: linux/amd64 and linux/arm64`),
+		},
+		"error if a platform is repeated": {
+			Image: Image{
+				Build: BuildArgsOrString{
+					BuildString: aws.String("mockBuild"),
+				},
+				Platforms: []string{"linux/arm64", "linux/ARM64"},
+			},
+			wantedError: fmt.Errorf(`validate "platforms": platform "linux/ARM64" is specified more than once`),
+		},
+		"valid with platforms": {
+			Image: Image{
+				Build: BuildArgsOrString{
+					BuildString: aws.String("mockBuild"),
+				},
+				Platforms: []string{"linux/amd64", "linux/arm64"},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1288,6 +1484,57 @@ func TestDependsOn_Validate(t *testing.T) {
 	}
 }
 
+func TestImageTagStrategy_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     ImageTagStrategy
+		wanted error
+	}{
+		"should return nil if empty": {},
+		"should return an error if type is missing": {
+			in: ImageTagStrategy{
+				File: aws.String("VERSION"),
+			},
+			wanted: errors.New(`"type" must be specified`),
+		},
+		"should return an error if type is invalid": {
+			in: ImageTagStrategy{
+				Type: aws.String("latest"),
+			},
+			wanted: errors.New(`"type" must be one of "git-sha", "semver" or "timestamp"`),
+		},
+		"should return an error if file is missing for semver": {
+			in: ImageTagStrategy{
+				Type: aws.String("semver"),
+			},
+			wanted: errors.New(`"file" must be specified when "type" is "semver"`),
+		},
+		"should return an error if file is specified for other types": {
+			in: ImageTagStrategy{
+				Type: aws.String("git-sha"),
+				File: aws.String("VERSION"),
+			},
+			wanted: errors.New(`"file" can only be specified when "type" is "semver"`),
+		},
+		"should return nil for semver with a file": {
+			in: ImageTagStrategy{
+				Type: aws.String("semver"),
+				File: aws.String("VERSION"),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestRoutingRule_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		RoutingRule RoutingRuleConfiguration
@@ -1350,6 +1597,86 @@ func TestRoutingRule_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "alias":`,
 		},
+		"error if a wildcard alias contains several wildcards": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path: stringP("/"),
+				Alias: Alias{
+					StringSliceOrString: stringSliceOrString{
+						StringSlice: []string{"example.com", "*.*.example.com"},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "alias": wildcard alias "*.*.example.com" must start with "*." and cannot contain any other "*"`),
+		},
+		"error if listener name is not alphanumeric": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path:     stringP("/"),
+				Listener: aws.String("legacy-tls"),
+			},
+			wantedError: errors.New(`"listener" "legacy-tls" can only contain letters and numbers`),
+		},
+		"error if target protocol is invalid": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path:           stringP("/"),
+				TargetProtocol: aws.String("TCP"),
+			},
+			wantedError: errors.New(`"target_protocol" "TCP" must be one of HTTP or HTTPS`),
+		},
+		"error if private CA is specified without HTTPS targets": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path:      stringP("/"),
+				PrivateCA: aws.String("arn:aws:acm-pca:us-west-2:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012"),
+			},
+			wantedError: errors.New(`"target_protocol" must be "HTTPS" if "private_ca" is specified`),
+		},
+		"error if private CA is not a certificate authority ARN": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path:           stringP("/"),
+				TargetProtocol: aws.String("HTTPS"),
+				PrivateCA:      aws.String("arn:aws:acm:us-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012"),
+			},
+			wantedError: errors.New(`"private_ca" "arn:aws:acm:us-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012" is not a valid certificate authority ARN`),
+		},
+		"valid with HTTPS targets and a private CA": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path:           stringP("/"),
+				TargetProtocol: aws.String("https"),
+				PrivateCA:      aws.String("arn:aws:acm-pca:us-west-2:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012"),
+			},
+		},
+		"should not error with a listener": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path:     stringP("/"),
+				Listener: aws.String("legacy"),
+			},
+		},
+		"error if rate limit is malformed": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path:      stringP("/"),
+				RateLimit: (*HTTPRateLimit)(aws.String("1000rps")),
+			},
+			wantedError: errors.New(`validate "rate_limit": invalid rate limit "1000rps". Should be in format of ${requests}rps-per-ip or ${requests}rpm-per-ip`),
+		},
+		"error if rate limit per minute is too low": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path:      stringP("/"),
+				RateLimit: (*HTTPRateLimit)(aws.String("10rpm-per-ip")),
+			},
+			wantedError: errors.New(`validate "rate_limit": rate limit "10rpm-per-ip" must allow between 20 and 400000000 requests per minute`),
+		},
+		"error if rate limit per second is too high": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path:      stringP("/"),
+				RateLimit: (*HTTPRateLimit)(aws.String("10000000rps-per-ip")),
+			},
+			wantedError: errors.New(`validate "rate_limit": rate limit "10000000rps-per-ip" must allow between 1 and 6666666 requests per second`),
+		},
+		"should not error with a rate limit": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path:      stringP("/"),
+				RateLimit: (*HTTPRateLimit)(aws.String("1000rps-per-ip")),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1472,6 +1799,17 @@ func TestAdvancedAlias_Validate(t *testing.T) {
 			},
 			wanted: errors.New(`"name" must be specified`),
 		},
+		"should return an error if the wildcard is not the leftmost label": {
+			in: AdvancedAlias{
+				Alias: aws.String("pr-*.test.app.example.com"),
+			},
+			wanted: errors.New(`wildcard alias "pr-*.test.app.example.com" must start with "*." and cannot contain any other "*"`),
+		},
+		"should return nil for a wildcard alias": {
+			in: AdvancedAlias{
+				Alias: aws.String("*.test.app.example.com"),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1528,6 +1866,91 @@ func TestTaskConfig_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "platform": `,
 		},
+		"error if the platform version is unknown": {
+			TaskConfig: TaskConfig{
+				PlatformVersion: aws.String("1.5.0"),
+			},
+			wantedError: errors.New(`validate "platform_version": platform version "1.5.0" must be one of LATEST, 1.4.0, 1.3.0, 1.2.0, 1.1.0 or 1.0.0`),
+		},
+		"error if the platform version is not supported by Windows tasks": {
+			TaskConfig: TaskConfig{
+				Platform: PlatformArgsOrString{
+					PlatformString: (*PlatformString)(aws.String("windows/amd64")),
+				},
+				PlatformVersion: aws.String("1.4.0"),
+			},
+			wantedError: errors.New(`validate "platform_version": platform version "1.4.0" is not supported by Windows tasks, must be one of LATEST or 1.0.0`),
+		},
+		"error if an ARM task pins a platform version before 1.4.0": {
+			TaskConfig: TaskConfig{
+				Platform: PlatformArgsOrString{
+					PlatformString: (*PlatformString)(aws.String("linux/arm64")),
+				},
+				PlatformVersion: aws.String("1.3.0"),
+			},
+			wantedError: errors.New(`validate "platform_version": platform version "1.3.0" is not supported by ARM tasks, must be LATEST or 1.4.0`),
+		},
+		"valid task size": {
+			TaskConfig: TaskConfig{
+				CPU:    aws.Int(8192),
+				Memory: aws.Int(20480),
+			},
+		},
+		"error if the cpu is not supported by Fargate": {
+			TaskConfig: TaskConfig{
+				CPU:    aws.Int(300),
+				Memory: aws.Int(600),
+			},
+			wantedError: errors.New(`validate task size: cpu 300 is not supported by Fargate, must be one of 256, 512, 1024, 2048, 4096, 8192 or 16384; try cpu 512 and memory 1024`),
+		},
+		"error if the memory is not supported with the cpu": {
+			TaskConfig: TaskConfig{
+				CPU:    aws.Int(256),
+				Memory: aws.Int(1536),
+			},
+			wantedError: errors.New(`validate task size: memory 1536 is not supported with cpu 256, must be 512, 1024 or 2048; try cpu 256 and memory 2048`),
+		},
+		"suggest a larger cpu if the memory is too large for the cpu": {
+			TaskConfig: TaskConfig{
+				CPU:    aws.Int(1024),
+				Memory: aws.Int(20000),
+			},
+			wantedError: errors.New(`validate task size: memory 20000 is not supported with cpu 1024, must be between 2048 and 8192 in increments of 1024; try cpu 4096 and memory 20480`),
+		},
+		"suggest the largest size if the task is too large": {
+			TaskConfig: TaskConfig{
+				CPU:    aws.Int(32768),
+				Memory: aws.Int(131072),
+			},
+			wantedError: errors.New(`validate task size: cpu 32768 is not supported by Fargate, must be one of 256, 512, 1024, 2048, 4096, 8192 or 16384; try cpu 16384 and memory 122880`),
+		},
+		"error if the cpu is not supported by Windows tasks": {
+			TaskConfig: TaskConfig{
+				Platform: PlatformArgsOrString{
+					PlatformString: (*PlatformString)(aws.String("windows/amd64")),
+				},
+				CPU:    aws.Int(512),
+				Memory: aws.Int(1024),
+			},
+			wantedError: errors.New(`validate task size: cpu 512 is not supported by Windows tasks, must be one of 1024, 2048 or 4096; try cpu 1024 and memory 2048`),
+		},
+		"error if the cpu requires platform version 1.4.0": {
+			TaskConfig: TaskConfig{
+				CPU:             aws.Int(8192),
+				Memory:          aws.Int(16384),
+				PlatformVersion: aws.String("1.3.0"),
+			},
+			wantedError: errors.New(`validate task size: cpu 8192 requires platform version 1.4.0 or later, but "platform_version" is "1.3.0"; try cpu 4096 and memory 16384`),
+		},
+		"error if ephemeral storage requires platform version 1.4.0": {
+			TaskConfig: TaskConfig{
+				PlatformVersion: aws.String("1.3.0"),
+				Storage: Storage{
+					Ephemeral: aws.Int(50),
+				},
+			},
+			wantedError: errors.New(`validate task size: ephemeral storage requires platform version 1.4.0 or later, but "platform_version" is "1.3.0"`),
+		},
 		"error if fail to validate count": {
 			TaskConfig: TaskConfig{
 				Count: Count{
@@ -1556,6 +1979,14 @@ func TestTaskConfig_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "storage": `,
 		},
+		"error if fail to validate evidently": {
+			TaskConfig: TaskConfig{
+				Evidently: Evidently{
+					Project: aws.String("mockProject"),
+				},
+			},
+			wantedErrorMsgPrefix: `validate "evidently": `,
+		},
 		"error if invalid env file": {
 			TaskConfig: TaskConfig{
 				EnvFile: aws.String("foo"),
@@ -2073,6 +2504,76 @@ func TestRangeConfig_Validate(t *testing.T) {
 	}
 }
 
+func TestEvidently_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in          Evidently
+		wantedError error
+	}{
+		"valid if empty": {},
+		"error if the project name is invalid": {
+			in: Evidently{
+				Project: aws.String("my project"),
+				Features: []EvidentlyFeature{
+					{Name: aws.String("dark-mode")},
+				},
+			},
+			wantedError: errors.New(`"project" "my project" must be 1 to 127 letters, numbers, hyphens, periods, or underscores`),
+		},
+		"error if project is set without features": {
+			in: Evidently{
+				Project: aws.String("mockProject"),
+			},
+			wantedError: errors.New(`"features" must be specified if "project" is specified`),
+		},
+		"error if a feature doesn't have a name": {
+			in: Evidently{
+				Features: []EvidentlyFeature{
+					{Name: aws.String("dark-mode")},
+					{Description: aws.String("Show the new checkout page.")},
+				},
+			},
+			wantedError: errors.New(`validate "features[1]": "name" must be specified`),
+		},
+		"error if a feature name is invalid": {
+			in: Evidently{
+				Features: []EvidentlyFeature{
+					{Name: aws.String("dark/mode")},
+				},
+			},
+			wantedError: errors.New(`validate "features[0]": "name" "dark/mode" must be 1 to 127 letters, numbers, hyphens, periods, or underscores`),
+		},
+		"error if a feature is declared twice": {
+			in: Evidently{
+				Features: []EvidentlyFeature{
+					{Name: aws.String("dark-mode")},
+					{Name: aws.String("dark-mode"), Enabled: aws.Bool(true)},
+				},
+			},
+			wantedError: errors.New(`feature "dark-mode" is declared more than once`),
+		},
+		"valid": {
+			in: Evidently{
+				Project: aws.String("phonetool.frontend"),
+				Features: []EvidentlyFeature{
+					{Name: aws.String("dark-mode"), Enabled: aws.Bool(true)},
+					{Name: aws.String("new_checkout"), Description: aws.String("Show the new checkout page.")},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestStorage_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		Storage Storage
@@ -2121,6 +2622,33 @@ func TestStorage_Validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("cannot specify more than one managed volume per service"),
 		},
+		"error if storage has more than one managed FSx file system": {
+			Storage: Storage{
+				Volumes: map[string]*Volume{
+					"foo": {
+						FSx: FSxWindowsVolumeConfiguration{
+							ActiveDirectoryID:    aws.String("d-1234567890"),
+							Domain:               aws.String("corp.example.com"),
+							CredentialsParameter: aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:fsx-creds"),
+						},
+						MountPointOpts: MountPointOpts{
+							ContainerPath: aws.String(`C:\foo`),
+						},
+					},
+					"bar": {
+						FSx: FSxWindowsVolumeConfiguration{
+							ActiveDirectoryID:    aws.String("d-1234567890"),
+							Domain:               aws.String("corp.example.com"),
+							CredentialsParameter: aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:fsx-creds"),
+						},
+						MountPointOpts: MountPointOpts{
+							ContainerPath: aws.String(`C:\bar`),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf("cannot specify more than one managed FSx file system per service"),
+		},
 		"valid": {
 			Storage: Storage{
 				Volumes: map[string]*Volume{
@@ -2189,6 +2717,50 @@ func TestVolume_Validate(t *testing.T) {
 			},
 			wantedErrorPrefix: `validate "efs": `,
 		},
+		"error if both efs and fsx are specified": {
+			Volume: Volume{
+				EFS: EFSConfigOrBool{
+					Enabled: aws.Bool(true),
+				},
+				FSx: FSxWindowsVolumeConfiguration{
+					FileSystemID: aws.String("fs-1234567890abcdef0"),
+				},
+			},
+			wantedErrorPrefix: `must specify one, not both, of "efs" and "fsx"`,
+		},
+		"error if fail to validate fsx": {
+			Volume: Volume{
+				FSx: FSxWindowsVolumeConfiguration{
+					FileSystemID: aws.String("fs-1234567890abcdef0"),
+				},
+			},
+			wantedErrorPrefix: `validate "fsx": `,
+		},
+		"error if fsx path is not a Windows path": {
+			Volume: Volume{
+				FSx: FSxWindowsVolumeConfiguration{
+					FileSystemID:         aws.String("fs-1234567890abcdef0"),
+					Domain:               aws.String("corp.example.com"),
+					CredentialsParameter: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/fsx-creds"),
+				},
+				MountPointOpts: MountPointOpts{
+					ContainerPath: aws.String("/var/data"),
+				},
+			},
+			wantedErrorPrefix: `validate "path": path must be an absolute Windows path`,
+		},
+		"valid fsx volume": {
+			Volume: Volume{
+				FSx: FSxWindowsVolumeConfiguration{
+					FileSystemID:         aws.String("fs-1234567890abcdef0"),
+					Domain:               aws.String("corp.example.com"),
+					CredentialsParameter: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/fsx-creds"),
+				},
+				MountPointOpts: MountPointOpts{
+					ContainerPath: aws.String(`C:\data`),
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2264,6 +2836,105 @@ func TestEFSVolumeConfiguration_Validate(t *testing.T) {
 	}
 }
 
+func TestFSxWindowsVolumeConfiguration_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in FSxWindowsVolumeConfiguration
+
+		wantedError error
+	}{
+		"error if id and active_directory_id are both specified": {
+			in: FSxWindowsVolumeConfiguration{
+				FileSystemID:      aws.String("fs-1234567890abcdef0"),
+				ActiveDirectoryID: aws.String("d-1234567890"),
+			},
+			wantedError: fmt.Errorf(`must specify one, not both, of "id" and "active_directory_id"`),
+		},
+		"error if neither id nor active_directory_id is specified": {
+			in: FSxWindowsVolumeConfiguration{
+				Domain: aws.String("corp.example.com"),
+			},
+			wantedError: fmt.Errorf(`"id or active_directory_id" must be specified`),
+		},
+		"error if domain is missing": {
+			in: FSxWindowsVolumeConfiguration{
+				FileSystemID: aws.String("fs-1234567890abcdef0"),
+			},
+			wantedError: fmt.Errorf(`"domain" must be specified`),
+		},
+		"error if credentials_parameter is missing": {
+			in: FSxWindowsVolumeConfiguration{
+				FileSystemID: aws.String("fs-1234567890abcdef0"),
+				Domain:       aws.String("corp.example.com"),
+			},
+			wantedError: fmt.Errorf(`"credentials_parameter" must be specified`),
+		},
+		"error if credentials_parameter is not an ARN": {
+			in: FSxWindowsVolumeConfiguration{
+				FileSystemID:         aws.String("fs-1234567890abcdef0"),
+				Domain:               aws.String("corp.example.com"),
+				CredentialsParameter: aws.String("fsx-creds"),
+			},
+			wantedError: fmt.Errorf(`"credentials_parameter" "fsx-creds" must be the ARN of a Secrets Manager secret or an SSM parameter`),
+		},
+		"error if root_dir is invalid": {
+			in: FSxWindowsVolumeConfiguration{
+				FileSystemID:         aws.String("fs-1234567890abcdef0"),
+				Domain:               aws.String("corp.example.com"),
+				CredentialsParameter: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/fsx-creds"),
+				RootDirectory:        aws.String("!!!!"),
+			},
+			wantedError: fmt.Errorf(`validate "root_dir": path can only contain the characters a-zA-Z0-9.-_/`),
+		},
+		"error if capacities are specified for an existing file system": {
+			in: FSxWindowsVolumeConfiguration{
+				FileSystemID:         aws.String("fs-1234567890abcdef0"),
+				Domain:               aws.String("corp.example.com"),
+				CredentialsParameter: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/fsx-creds"),
+				StorageCapacity:      aws.Int(64),
+			},
+			wantedError: fmt.Errorf(`"storage_capacity" and "throughput_capacity" can only be specified with "active_directory_id"`),
+		},
+		"error if storage_capacity is out of range": {
+			in: FSxWindowsVolumeConfiguration{
+				ActiveDirectoryID:    aws.String("d-1234567890"),
+				Domain:               aws.String("corp.example.com"),
+				CredentialsParameter: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/fsx-creds"),
+				StorageCapacity:      aws.Int(16),
+			},
+			wantedError: fmt.Errorf(`"storage_capacity" must be between 32 GiB and 65536 GiB`),
+		},
+		"error if throughput_capacity is invalid": {
+			in: FSxWindowsVolumeConfiguration{
+				ActiveDirectoryID:    aws.String("d-1234567890"),
+				Domain:               aws.String("corp.example.com"),
+				CredentialsParameter: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/fsx-creds"),
+				ThroughputCapacity:   aws.Int(10),
+			},
+			wantedError: fmt.Errorf(`"throughput_capacity" 10 must be one of 8, 16, 32, 64, 128, 256, 512, 1024 or 2048`),
+		},
+		"valid managed file system": {
+			in: FSxWindowsVolumeConfiguration{
+				ActiveDirectoryID:    aws.String("d-1234567890"),
+				Domain:               aws.String("corp.example.com"),
+				CredentialsParameter: aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:fsx-creds"),
+				StorageCapacity:      aws.Int(64),
+				ThroughputCapacity:   aws.Int(16),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
 func TestSidecarConfig_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		config SidecarConfig
@@ -2286,6 +2957,14 @@ func TestSidecarConfig_Validate(t *testing.T) {
 			},
 			wantedErrorPrefix: `validate "depends_on": `,
 		},
+		"error if fail to validate logging": {
+			config: SidecarConfig{
+				Logging: SidecarLogging{
+					Retention: aws.Int(2),
+				},
+			},
+			wantedErrorPrefix: `validate "logging": validate "retention": `,
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2300,6 +2979,61 @@ func TestSidecarConfig_Validate(t *testing.T) {
 	}
 }
 
+func TestLogging_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     Logging
+		wanted string
+	}{
+		"success with empty config": {},
+		"success with valid log group configuration": {
+			in: Logging{
+				Retention: aws.Int(14),
+				GroupName: aws.String("/corp/team/frontend"),
+				KMSKeyARN: aws.String("arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+			},
+		},
+		"success with a retention of 3 years": {
+			in: Logging{
+				Retention: aws.Int(1096),
+			},
+		},
+		"success with a retention of 9 years": {
+			in: Logging{
+				Retention: aws.Int(3288),
+			},
+		},
+		"error if retention is not an allowed value": {
+			in: Logging{
+				Retention: aws.Int(10),
+			},
+			wanted: `validate "retention": 10 is not a valid number of days, must be one of 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288 or 3653`,
+		},
+		"error if log group name contains invalid characters": {
+			in: Logging{
+				GroupName: aws.String("my log group"),
+			},
+			wanted: `validate "groupName": log group name "my log group" must be between 1 and 512 characters and can only contain the characters a-zA-Z0-9.-_/#`,
+		},
+		"error if kms key is not an ARN": {
+			in: Logging{
+				KMSKeyARN: aws.String("alias/mykey"),
+			},
+			wanted: `"kmsKeyARN" must be a valid KMS key ARN: alias/mykey`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.Validate()
+
+			if tc.wanted != "" {
+				require.EqualError(t, gotErr, tc.wanted)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
 func TestSidecarMountPoint_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		in     SidecarMountPoint
@@ -2368,6 +3102,14 @@ func TestNetworkConfig_Validate(t *testing.T) {
 			},
 			wantedErrorPrefix: `validate "vpc": `,
 		},
+		"error if fail to validate service_discovery": {
+			config: NetworkConfig{
+				ServiceDiscovery: ServiceDiscoveryConfig{
+					RecordType: aws.String("CNAME"),
+				},
+			},
+			wantedErrorPrefix: `validate "service_discovery": `,
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2382,6 +3124,60 @@ func TestNetworkConfig_Validate(t *testing.T) {
 	}
 }
 
+func TestServiceDiscoveryConfig_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		config ServiceDiscoveryConfig
+
+		wantedError error
+	}{
+		"valid if empty": {
+			config: ServiceDiscoveryConfig{},
+		},
+		"valid SRV records with a weighted routing policy": {
+			config: ServiceDiscoveryConfig{
+				TTL:           durationp(60 * time.Second),
+				RecordType:    aws.String("SRV"),
+				RoutingPolicy: aws.String("WEIGHTED"),
+			},
+		},
+		"error if ttl is negative": {
+			config: ServiceDiscoveryConfig{
+				TTL: durationp(-10 * time.Second),
+			},
+			wantedError: errors.New(`"ttl" cannot be negative`),
+		},
+		"error if ttl is not a whole number of seconds": {
+			config: ServiceDiscoveryConfig{
+				TTL: durationp(1500 * time.Millisecond),
+			},
+			wantedError: errors.New(`"ttl" must be a whole number of seconds`),
+		},
+		"error if record type is invalid": {
+			config: ServiceDiscoveryConfig{
+				RecordType: aws.String("CNAME"),
+			},
+			wantedError: errors.New(`"record_type" must be one of A, SRV`),
+		},
+		"error if routing policy is invalid": {
+			config: ServiceDiscoveryConfig{
+				RoutingPolicy: aws.String("FAILOVER"),
+			},
+			wantedError: errors.New(`"routing_policy" must be one of MULTIVALUE, WEIGHTED`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.config.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
 func TestRequestDrivenWebServiceNetworkConfig_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		config RequestDrivenWebServiceNetworkConfig
@@ -2497,6 +3293,62 @@ func TestPlacementString_Validate(t *testing.T) {
 	}
 }
 
+func TestPlacementArgs_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     PlacementArgs
+		wanted error
+	}{
+		"should return an error if both subnets and azs are specified": {
+			in: PlacementArgs{
+				Subnets: []string{"subnet-1"},
+				AZs:     []string{"us-west-2a"},
+			},
+			wanted: errors.New(`must specify one, not both, of "subnets" and "azs"`),
+		},
+		"should return an error if type is specified without azs": {
+			in: PlacementArgs{
+				Type: placementStringP(PrivateSubnetPlacement),
+			},
+			wanted: errors.New(`"azs" must be specified if "type" is specified`),
+		},
+		"should return an error if an availability zone is empty": {
+			in: PlacementArgs{
+				AZs: []string{"us-west-2a", ""},
+			},
+			wanted: errors.New(`"azs[1]" cannot be empty`),
+		},
+		"should return an error if type is invalid": {
+			in: PlacementArgs{
+				AZs:  []string{"us-west-2a"},
+				Type: (*PlacementString)(aws.String("external")),
+			},
+			wanted: errors.New(`validate "type": "placement" external must be one of public, private`),
+		},
+		"success with subnets": {
+			in: PlacementArgs{
+				Subnets: []string{"subnet-1"},
+			},
+		},
+		"success with availability zones in private subnets": {
+			in: PlacementArgs{
+				AZs:  []string{"us-west-2a", "us-west-2b"},
+				Type: placementStringP(PrivateSubnetPlacement),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestAppRunnerInstanceConfig_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		config            AppRunnerInstanceConfig
@@ -2610,6 +3462,39 @@ func TestJobTriggerConfig_Validate(t *testing.T) {
 			in:     &JobTriggerConfig{},
 			wanted: errors.New(`"schedule" must be specified`),
 		},
+		"should return an error if concurrency is invalid": {
+			in: &JobTriggerConfig{
+				Schedule:    aws.String("@hourly"),
+				Concurrency: aws.String("queue"),
+			},
+			wanted: errors.New(`validate "concurrency": "queue" must be one of "allow", "forbid" or "replace"`),
+		},
+		"should return an error if timezone is not in the time zone database": {
+			in: &JobTriggerConfig{
+				Schedule: aws.String("0 9 * * MON-FRI"),
+				Timezone: aws.String("America/Gotham"),
+			},
+			wanted: errors.New(`validate "timezone": "America/Gotham" is not a valid time zone name`),
+		},
+		"should return an error if timezone is Local": {
+			in: &JobTriggerConfig{
+				Schedule: aws.String("0 9 * * MON-FRI"),
+				Timezone: aws.String("Local"),
+			},
+			wanted: errors.New(`validate "timezone": "Local" is not a valid time zone name`),
+		},
+		"success with a valid timezone": {
+			in: &JobTriggerConfig{
+				Schedule: aws.String("0 9 * * MON-FRI"),
+				Timezone: aws.String("America/New_York"),
+			},
+		},
+		"success with a valid concurrency policy": {
+			in: &JobTriggerConfig{
+				Schedule:    aws.String("@hourly"),
+				Concurrency: aws.String("forbid"),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2667,6 +3552,34 @@ func TestTopic_Validate(t *testing.T) {
 			},
 			wanted: errors.New(`"name" can only contain letters, numbers, underscores, and hypthens`),
 		},
+		"should return an error if an allowed publisher is not an ARN": {
+			in: Topic{
+				Name: aws.String("orders"),
+				AllowedPublishers: AllowedPrincipals{
+					ARNs: []string{"123456789012"},
+				},
+			},
+			wanted: errors.New(`validate "allowed_publishers": "arns" "123456789012" is not a valid ARN`),
+		},
+		"should return an error if an allowed organization is not valid": {
+			in: Topic{
+				Name: aws.String("orders"),
+				AllowedPublishers: AllowedPrincipals{
+					OrgIDs: []string{"my-org"},
+				},
+			},
+			wanted: errors.New(`validate "allowed_publishers": "org_ids" "my-org" is not a valid organization ID`),
+		},
+		"success with allowed publishers": {
+			in: Topic{
+				Name:   aws.String("orders"),
+				KMSKey: aws.String("alias/orders"),
+				AllowedPublishers: AllowedPrincipals{
+					ARNs:   []string{"arn:aws:iam::123456789012:role/publisher"},
+					OrgIDs: []string{"o-a1b2c3d4e5"},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2733,6 +3646,20 @@ func TestTopicSubscription_Validate(t *testing.T) {
 			},
 			wanted: errors.New("service name must start with a letter, contain only lower-case letters, numbers, and hyphens, and have no consecutive or trailing hyphen"),
 		},
+		"should return an error if the queue has an invalid allowed publisher": {
+			in: TopicSubscription{
+				Name:    aws.String("mockTopic"),
+				Service: aws.String("mockservice"),
+				Queue: SQSQueueOrBool{
+					Advanced: SQSQueue{
+						AllowedPublishers: AllowedPrincipals{
+							ARNs: []string{"mockRole"},
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate "queue": validate "allowed_publishers": "arns" "mockRole" is not a valid ARN`),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2991,6 +3918,17 @@ func TestValidateWindows(t *testing.T) {
 			in:          validateWindowsOpts{},
 			wantedError: nil,
 		},
+		"should return nil if only fsx is specified": {
+			in: validateWindowsOpts{
+				efsVolumes: map[string]*Volume{
+					"someVolume": {
+						FSx: FSxWindowsVolumeConfiguration{
+							FileSystemID: aws.String("fs-1234567890abcdef0"),
+						},
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -3005,6 +3943,44 @@ func TestValidateWindows(t *testing.T) {
 	}
 }
 
+func TestValidateNoFSxVolumes(t *testing.T) {
+	testCases := map[string]struct {
+		in          map[string]*Volume
+		wantedError error
+	}{
+		"error if fsx specified": {
+			in: map[string]*Volume{
+				"someVolume": {
+					FSx: FSxWindowsVolumeConfiguration{
+						FileSystemID: aws.String("fs-1234567890abcdef0"),
+					},
+				},
+			},
+			wantedError: errors.New(`'FSx' is only supported when deploying a Windows container`),
+		},
+		"should return nil if fsx not specified": {
+			in: map[string]*Volume{
+				"someVolume": {
+					EFS: EFSConfigOrBool{
+						Enabled: aws.Bool(true),
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateNoFSxVolumes(tc.in)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateARM(t *testing.T) {
 	testCases := map[string]struct {
 		in          validateARMOpts
@@ -3042,6 +4018,84 @@ func TestValidateARM(t *testing.T) {
 	}
 }
 
+func TestContainerHealthCheck_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		hc     ContainerHealthCheck
+		wanted string
+	}{
+		"ok if empty": {
+			hc: ContainerHealthCheck{},
+		},
+		"ok with a shell command and values within range": {
+			hc: ContainerHealthCheck{
+				Command:     []string{"CMD-SHELL", "curl -f http://localhost/ || exit 1"},
+				Interval:    durationp(30 * time.Second),
+				Retries:     aws.Int(3),
+				Timeout:     durationp(5 * time.Second),
+				StartPeriod: durationp(60 * time.Second),
+			},
+		},
+		"ok with NONE to disable the image's health check": {
+			hc: ContainerHealthCheck{
+				Command: []string{"NONE"},
+			},
+		},
+		"error if the command type is unknown": {
+			hc: ContainerHealthCheck{
+				Command: []string{"curl", "-f", "http://localhost/"},
+			},
+			wanted: `validate "command": the first element must be one of CMD, CMD-SHELL or NONE`,
+		},
+		"error if the command is missing after CMD": {
+			hc: ContainerHealthCheck{
+				Command: []string{"CMD"},
+			},
+			wanted: `validate "command": "CMD" must be followed by the command to run`,
+		},
+		"error if NONE has arguments": {
+			hc: ContainerHealthCheck{
+				Command: []string{"NONE", "true"},
+			},
+			wanted: `validate "command": "NONE" cannot be followed by arguments`,
+		},
+		"error if the interval is out of range": {
+			hc: ContainerHealthCheck{
+				Interval: durationp(time.Second),
+			},
+			wanted: `"interval" must be between 5s and 5m0s`,
+		},
+		"error if the timeout is out of range": {
+			hc: ContainerHealthCheck{
+				Timeout: durationp(2 * time.Minute),
+			},
+			wanted: `"timeout" must be between 2s and 1m0s`,
+		},
+		"error if the start period is out of range": {
+			hc: ContainerHealthCheck{
+				StartPeriod: durationp(10 * time.Minute),
+			},
+			wanted: `"start_period" must be between 0s and 5m0s`,
+		},
+		"error if the retries are out of range": {
+			hc: ContainerHealthCheck{
+				Retries: aws.Int(11),
+			},
+			wanted: `"retries" must be between 1 and 10`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.hc.Validate()
+
+			if tc.wanted != "" {
+				require.EqualError(t, gotErr, tc.wanted)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
 func TestDeploymentConfiguration_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		deployConfig DeploymentConfiguration
@@ -3066,6 +4120,170 @@ func TestDeploymentConfiguration_Validate(t *testing.T) {
 		"ok if deployment is empty": {
 			deployConfig: DeploymentConfiguration{},
 		},
+		"error if deploy config has invalid strategy": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("canary"),
+			},
+			wanted: `invalid deployment strategy canary, must be one of rolling or blue/green`,
+		},
+		"error if blue/green options are specified without the blue/green strategy": {
+			deployConfig: DeploymentConfiguration{
+				BlueGreen: BlueGreenDeploymentConfig{
+					TestListenerPort: aws.Uint16(8080),
+				},
+			},
+			wanted: `"blue_green" can only be specified when "strategy" is blue/green`,
+		},
+		"error if rolling is specified with the blue/green strategy": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("blue/green"),
+				Rolling:  aws.String("recreate"),
+			},
+			wanted: `"rolling" cannot be specified when "strategy" is blue/green`,
+		},
+		"error if blue/green traffic shifting is invalid": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("blue/green"),
+				BlueGreen: BlueGreenDeploymentConfig{
+					TrafficShifting: aws.String("exponential"),
+				},
+			},
+			wanted: `validate "blue_green": invalid "traffic_shifting" exponential, must be one of all_at_once, canary or linear`,
+		},
+		"error if blue/green test listener port is reserved": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("blue/green"),
+				BlueGreen: BlueGreenDeploymentConfig{
+					TestListenerPort: aws.Uint16(443),
+				},
+			},
+			wanted: `validate "blue_green": "test_listener_port" 443 is reserved for the environment load balancer listeners`,
+		},
+		"ok if deployment strategy is blue/green": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("blue/green"),
+				BlueGreen: BlueGreenDeploymentConfig{
+					TestListenerPort: aws.Uint16(8080),
+					TrafficShifting:  aws.String("linear"),
+					RollbackAlarms:   []string{"HighLatency"},
+				},
+			},
+		},
+		"error if canary steps are specified without the blue/green strategy": {
+			deployConfig: DeploymentConfiguration{
+				Canary: CanaryDeploymentConfig{
+					Steps: []CanaryStep{{Weight: aws.Int(10), Bake: durationp(5 * time.Minute)}},
+				},
+			},
+			wanted: `"canary" can only be specified when "strategy" is blue/green`,
+		},
+		"error if canary steps are specified with blue/green traffic shifting": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("blue/green"),
+				BlueGreen: BlueGreenDeploymentConfig{
+					TrafficShifting: aws.String("linear"),
+				},
+				Canary: CanaryDeploymentConfig{
+					Steps: []CanaryStep{{Weight: aws.Int(10), Bake: durationp(5 * time.Minute)}},
+				},
+			},
+			wanted: `must specify one, not both, of "canary" and "blue_green.traffic_shifting"`,
+		},
+		"error if a canary step is missing its weight": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("blue/green"),
+				Canary: CanaryDeploymentConfig{
+					Steps: []CanaryStep{{Bake: durationp(5 * time.Minute)}},
+				},
+			},
+			wanted: `validate "canary": validate "steps[0]": "weight" must be specified`,
+		},
+		"error if a canary step shifts all the traffic": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("blue/green"),
+				Canary: CanaryDeploymentConfig{
+					Steps: []CanaryStep{{Weight: aws.Int(100), Bake: durationp(5 * time.Minute)}},
+				},
+			},
+			wanted: `validate "canary": validate "steps[0]": "weight" 100 must be between 1 and 99`,
+		},
+		"error if a canary step is missing its bake time": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("blue/green"),
+				Canary: CanaryDeploymentConfig{
+					Steps: []CanaryStep{{Weight: aws.Int(10)}},
+				},
+			},
+			wanted: `validate "canary": validate "steps[0]": "bake" must be specified`,
+		},
+		"error if a canary bake time is not a whole number of minutes": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("blue/green"),
+				Canary: CanaryDeploymentConfig{
+					Steps: []CanaryStep{{Weight: aws.Int(10), Bake: durationp(90 * time.Second)}},
+				},
+			},
+			wanted: `validate "canary": validate "steps[0]": "bake" 1m30s must be a whole number of minutes`,
+		},
+		"error if canary steps do not increase the weight evenly": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("blue/green"),
+				Canary: CanaryDeploymentConfig{
+					Steps: []CanaryStep{
+						{Weight: aws.Int(10), Bake: durationp(5 * time.Minute)},
+						{Weight: aws.Int(50), Bake: durationp(5 * time.Minute)},
+					},
+				},
+			},
+			wanted: `validate "canary": "steps" must either be a single step, or increase the weight by the same amount after the same bake time`,
+		},
+		"error if canary steps do not have the same bake time": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("blue/green"),
+				Canary: CanaryDeploymentConfig{
+					Steps: []CanaryStep{
+						{Weight: aws.Int(50), Bake: durationp(5 * time.Minute)},
+						{Weight: aws.Int(100), Bake: durationp(10 * time.Minute)},
+					},
+				},
+			},
+			wanted: `validate "canary": validate "steps[1]": "weight" 100 must be between 1 and 99`,
+		},
+		"error if canary steps stop before all traffic is shifted": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("blue/green"),
+				Canary: CanaryDeploymentConfig{
+					Steps: []CanaryStep{
+						{Weight: aws.Int(20), Bake: durationp(5 * time.Minute)},
+						{Weight: aws.Int(40), Bake: durationp(5 * time.Minute)},
+					},
+				},
+			},
+			wanted: `validate "canary": "steps" must continue until all traffic is shifted: add steps up to weight 80`,
+		},
+		"ok with a single canary step": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("blue/green"),
+				BlueGreen: BlueGreenDeploymentConfig{
+					RollbackAlarms: []string{"HighLatency"},
+				},
+				Canary: CanaryDeploymentConfig{
+					Steps: []CanaryStep{{Weight: aws.Int(10), Bake: durationp(5 * time.Minute)}},
+				},
+			},
+		},
+		"ok with linear canary steps": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("blue/green"),
+				Canary: CanaryDeploymentConfig{
+					Steps: []CanaryStep{
+						{Weight: aws.Int(30), Bake: durationp(10 * time.Minute)},
+						{Weight: aws.Int(60), Bake: durationp(10 * time.Minute)},
+						{Weight: aws.Int(90), Bake: durationp(10 * time.Minute)},
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -3080,3 +4298,70 @@ func TestDeploymentConfiguration_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAlarms(t *testing.T) {
+	testCases := map[string]struct {
+		alarms         []WorkloadAlarm
+		hasTargetGroup bool
+		wanted         string
+	}{
+		"ok if there are no alarms": {},
+		"error if the name is missing": {
+			alarms: []WorkloadAlarm{{Metric: aws.String("cpu"), Threshold: aws.Float64(80)}},
+			wanted: `validate "alarms[0]": "name" must be specified`,
+		},
+		"error if the name is invalid": {
+			alarms: []WorkloadAlarm{{Name: aws.String("high cpu"), Metric: aws.String("cpu"), Threshold: aws.Float64(80)}},
+			wanted: `validate "alarms[0]": "name" high cpu must contain only letters, numbers, and single hyphens between them`,
+		},
+		"error if the metric is missing": {
+			alarms: []WorkloadAlarm{{Name: aws.String("high-cpu"), Threshold: aws.Float64(80)}},
+			wanted: `validate "alarms[0]": "metric" must be specified`,
+		},
+		"error if the metric is invalid": {
+			alarms: []WorkloadAlarm{{Name: aws.String("high-cpu"), Metric: aws.String("disk"), Threshold: aws.Float64(80)}},
+			wanted: `validate "alarms[0]": invalid "metric" disk, must be one of cpu, memory, http_5xx or response_time`,
+		},
+		"error if the threshold is missing": {
+			alarms: []WorkloadAlarm{{Name: aws.String("high-cpu"), Metric: aws.String("cpu")}},
+			wanted: `validate "alarms[0]": "threshold" must be specified`,
+		},
+		"error if the period is not a whole number of minutes": {
+			alarms: []WorkloadAlarm{{Name: aws.String("high-cpu"), Metric: aws.String("cpu"), Threshold: aws.Float64(80), Period: durationp(90 * time.Second)}},
+			wanted: `validate "alarms[0]": "period" 1m30s must be a whole number of minutes`,
+		},
+		"error if the action is invalid": {
+			alarms: []WorkloadAlarm{{Name: aws.String("high-cpu"), Metric: aws.String("cpu"), Threshold: aws.Float64(80), Action: aws.String("page")}},
+			wanted: `validate "alarms[0]": invalid "action" page, must be one of rollback or notify`,
+		},
+		"error if two alarms have the same name": {
+			alarms: []WorkloadAlarm{
+				{Name: aws.String("high-cpu"), Metric: aws.String("cpu"), Threshold: aws.Float64(80)},
+				{Name: aws.String("highcpu"), Metric: aws.String("memory"), Threshold: aws.Float64(80)},
+			},
+			wanted: `validate "alarms[1]": name highcpu conflicts with the name of another alarm`,
+		},
+		"error if a load balancer metric is watched without a target group": {
+			alarms: []WorkloadAlarm{{Name: aws.String("errors"), Metric: aws.String("http_5xx"), Threshold: aws.Float64(10)}},
+			wanted: `validate "alarms[0]": "http" must be enabled to watch metric http_5xx`,
+		},
+		"ok with load balancer metrics and a target group": {
+			alarms: []WorkloadAlarm{
+				{Name: aws.String("errors"), Metric: aws.String("http_5xx"), Threshold: aws.Float64(10), Period: durationp(5 * time.Minute)},
+				{Name: aws.String("slow"), Metric: aws.String("response_time"), Threshold: aws.Float64(0.5), Action: aws.String("notify")},
+			},
+			hasTargetGroup: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validateAlarms(tc.alarms, tc.hasTargetGroup)
+
+			if tc.wanted != "" {
+				require.EqualError(t, gotErr, tc.wanted)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
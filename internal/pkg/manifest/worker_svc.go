@@ -49,6 +49,7 @@ type WorkerServiceConfig struct {
 	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
 	DeployConfig     DeploymentConfiguration   `yaml:"deployment"`
 	Observability    Observability             `yaml:"observability"`
+	Alarms           []WorkloadAlarm           `yaml:"alarms"`
 }
 
 // SubscribeConfig represents the configurable options for setting up subscriptions.
@@ -64,10 +65,11 @@ func (s *SubscribeConfig) IsEmpty() bool {
 
 // TopicSubscription represents the configurable options for setting up a SNS Topic Subscription.
 type TopicSubscription struct {
-	Name         *string                `yaml:"name"`
-	Service      *string                `yaml:"service"`
-	FilterPolicy map[string]interface{} `yaml:"filter_policy"`
-	Queue        SQSQueueOrBool         `yaml:"queue"`
+	Name               *string                `yaml:"name"`
+	Service            *string                `yaml:"service"`
+	FilterPolicy       map[string]interface{} `yaml:"filter_policy"`
+	RawMessageDelivery *bool                  `yaml:"raw_message_delivery"`
+	Queue              SQSQueueOrBool         `yaml:"queue"`
 }
 
 // SQSQueueOrBool is a custom type which supports unmarshaling yaml which
@@ -106,16 +108,18 @@ func (q *SQSQueueOrBool) UnmarshalYAML(value *yaml.Node) error {
 
 // SQSQueue represents the configurable options for setting up a SQS Queue.
 type SQSQueue struct {
-	Retention  *time.Duration  `yaml:"retention"`
-	Delay      *time.Duration  `yaml:"delay"`
-	Timeout    *time.Duration  `yaml:"timeout"`
-	DeadLetter DeadLetterQueue `yaml:"dead_letter"`
+	Retention         *time.Duration    `yaml:"retention"`
+	Delay             *time.Duration    `yaml:"delay"`
+	Timeout           *time.Duration    `yaml:"timeout"`
+	DeadLetter        DeadLetterQueue   `yaml:"dead_letter"`
+	KMSKey            *string           `yaml:"kms_key"`
+	AllowedPublishers AllowedPrincipals `yaml:"allowed_publishers"`
 }
 
 // IsEmpty returns empty if the struct has all zero members.
 func (q *SQSQueue) IsEmpty() bool {
 	return q.Retention == nil && q.Delay == nil && q.Timeout == nil &&
-		q.DeadLetter.IsEmpty()
+		q.DeadLetter.IsEmpty() && q.KMSKey == nil && q.AllowedPublishers.IsEmpty()
 }
 
 // DeadLetterQueue represents the configurable options for setting up a Dead-Letter Queue.
@@ -180,6 +184,16 @@ func (s *WorkerService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.ImageConfig.Image.BuildConfig(wsRoot)
 }
 
+// ImageTagStrategy returns how the image built for the service should be tagged.
+func (s *WorkerService) ImageTagStrategy() ImageTagStrategy {
+	return s.ImageConfig.Image.TagStrategy
+}
+
+// ImagePlatforms returns the platforms to build a multi-architecture image of the service for.
+func (s *WorkerService) ImagePlatforms() []string {
+	return s.ImageConfig.Image.Platforms
+}
+
 // EnvFile returns the location of the env file against the ws root directory.
 func (s *WorkerService) EnvFile() string {
 	return aws.StringValue(s.TaskConfig.EnvFile)
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -33,6 +34,16 @@ var (
 	subnetPlacements = []string{string(PublicSubnetPlacement), string(PrivateSubnetPlacement)}
 )
 
+const (
+	// Image tag strategies.
+	ImageTagStrategyGitSHA    = "git-sha"
+	ImageTagStrategySemver    = "semver"
+	ImageTagStrategyTimestamp = "timestamp"
+)
+
+// ImageTagStrategies are the supported strategies to generate an image tag.
+var ImageTagStrategies = []string{ImageTagStrategyGitSHA, ImageTagStrategySemver, ImageTagStrategyTimestamp}
+
 // Error definitions.
 var (
 	ErrAppRunnerInvalidPlatformWindows = errors.New("Windows is not supported for App Runner services")
@@ -55,6 +66,55 @@ func WorkloadTypes() []string {
 	return append(ServiceTypes(), JobTypes()...)
 }
 
+// InstanceName returns the name of an instance of a workload, or the name of the workload if there is no instance.
+func InstanceName(name, instance string) string {
+	if instance == "" {
+		return name
+	}
+	return fmt.Sprintf("%s-%s", name, instance)
+}
+
+// ApplyInstance renames the workload of a manifest after one of its instances,
+// so that the resources of each instance are named after the instance instead of the workload.
+func ApplyInstance(mft WorkloadManifest, instance string) error {
+	if instance == "" {
+		return nil
+	}
+	wkld, ok := mft.(interface{ workload() *Workload })
+	if !ok {
+		return fmt.Errorf("manifest of type %T cannot be deployed as an instance", mft)
+	}
+	w := wkld.workload()
+	w.Name = aws.String(InstanceName(aws.StringValue(w.Name), instance))
+	return nil
+}
+
+// ApplyImageLocation makes a manifest deploy an existing image instead of building one from its "image.build" field.
+func ApplyImageLocation(mft WorkloadManifest, location string) error {
+	var img *Image
+	switch t := mft.(type) {
+	case *LoadBalancedWebService:
+		img = &t.ImageConfig.Image
+	case *BackendService:
+		img = &t.ImageConfig.Image
+	case *WorkerService:
+		img = &t.ImageConfig.Image
+	case *RequestDrivenWebService:
+		img = &t.ImageConfig.Image
+	case *ScheduledJob:
+		img = &t.ImageConfig.Image
+	default:
+		return fmt.Errorf("manifest of type %T cannot be deployed with an existing image", mft)
+	}
+	img.Build = BuildArgsOrString{}
+	img.Location = aws.String(location)
+	return nil
+}
+
+func (w *Workload) workload() *Workload {
+	return w
+}
+
 // WorkloadManifest represents a workload manifest.
 type WorkloadManifest interface {
 	ApplyEnv(envName string) (WorkloadManifest, error)
@@ -115,6 +175,19 @@ type Image struct {
 	Credentials  *string           `yaml:"credentials"`     // ARN of the secret containing the private repository credentials.
 	DockerLabels map[string]string `yaml:"labels,flow"`     // Apply Docker labels to the container at runtime.
 	DependsOn    DependsOn         `yaml:"depends_on,flow"` // Add any sidecar dependencies.
+	TagStrategy  ImageTagStrategy  `yaml:"tag_strategy"`    // How to tag the image built from the Dockerfile.
+	Platforms    []string          `yaml:"platforms"`       // Build a multi-architecture image for these platforms with docker buildx.
+}
+
+// ImageTagStrategy represents how the tag of an image built from a Dockerfile is generated.
+type ImageTagStrategy struct {
+	Type *string `yaml:"type"` // One of "git-sha", "semver" or "timestamp".
+	File *string `yaml:"file"` // Path to the file holding the version, relative to the workspace root. Only used by "semver".
+}
+
+// IsEmpty returns true if no tag strategy is configured.
+func (s *ImageTagStrategy) IsEmpty() bool {
+	return s.Type == nil && s.File == nil
 }
 
 // DependsOn represents container dependency for a container.
@@ -376,23 +449,64 @@ type PublishConfig struct {
 
 // Topic represents the configurable options for setting up a SNS Topic.
 type Topic struct {
-	Name *string `yaml:"name"`
+	Name              *string           `yaml:"name"`
+	KMSKey            *string           `yaml:"kms_key"`
+	AllowedPublishers AllowedPrincipals `yaml:"allowed_publishers"`
+}
+
+// AllowedPrincipals represents the principals, outside of the service, that are granted access to a topic or queue.
+type AllowedPrincipals struct {
+	ARNs   []string `yaml:"arns"`
+	OrgIDs []string `yaml:"org_ids"`
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (p *AllowedPrincipals) IsEmpty() bool {
+	return len(p.ARNs) == 0 && len(p.OrgIDs) == 0
 }
 
 // NetworkConfig represents options for network connection to AWS resources within a VPC.
 type NetworkConfig struct {
-	VPC vpcConfig `yaml:"vpc"`
+	VPC              vpcConfig              `yaml:"vpc"`
+	ServiceDiscovery ServiceDiscoveryConfig `yaml:"service_discovery"`
 }
 
 // IsEmpty returns empty if the struct has all zero members.
 func (c *NetworkConfig) IsEmpty() bool {
-	return c.VPC.isEmpty()
+	return c.VPC.isEmpty() && c.ServiceDiscovery.IsEmpty()
+}
+
+// Record types of the DNS records that Cloud Map creates for a service.
+const (
+	ServiceDiscoveryRecordTypeA   = "A"
+	ServiceDiscoveryRecordTypeSRV = "SRV"
+)
+
+// Routing policies of the DNS records that Cloud Map creates for a service.
+const (
+	ServiceDiscoveryRoutingPolicyMultivalue = "MULTIVALUE"
+	ServiceDiscoveryRoutingPolicyWeighted   = "WEIGHTED"
+)
+
+// ServiceDiscoveryConfig represents the DNS records that other services in the environment discover the service with.
+type ServiceDiscoveryConfig struct {
+	TTL           *time.Duration `yaml:"ttl"`
+	RecordType    *string        `yaml:"record_type"` // Both A and SRV records are created if unset.
+	RoutingPolicy *string        `yaml:"routing_policy"`
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (c *ServiceDiscoveryConfig) IsEmpty() bool {
+	return c.TTL == nil && c.RecordType == nil && c.RoutingPolicy == nil
 }
 
 func (c *NetworkConfig) requiredEnvFeatures() []string {
 	if aws.StringValue((*string)(c.VPC.Placement.PlacementString)) == string(PrivateSubnetPlacement) {
 		return []string{template.NATFeatureName}
 	}
+	if len(c.VPC.Placement.AZs) != 0 && c.VPC.Placement.SubnetType() == PrivateSubnetPlacement {
+		return []string{template.NATFeatureName}
+	}
 	return nil
 }
 
@@ -431,12 +545,24 @@ func (p *PlacementArgOrString) UnmarshalYAML(value *yaml.Node) error {
 }
 
 // PlacementArgs represents what subnets to place tasks.
+// Tasks are placed either in specific subnets, or in the subnets of a type that are in specific availability zones.
 type PlacementArgs struct {
-	Subnets []string `yaml:"subnets"`
+	Subnets []string         `yaml:"subnets"`
+	AZs     []string         `yaml:"azs"`
+	Type    *PlacementString `yaml:"type"`
 }
 
 func (p *PlacementArgs) isEmpty() bool {
-	return p.Subnets == nil || len(p.Subnets) == 0
+	return len(p.Subnets) == 0 && len(p.AZs) == 0 && p.Type == nil
+}
+
+// SubnetType returns the type of subnets to place tasks in when they are placed by availability zones.
+// It defaults to public subnets.
+func (p *PlacementArgs) SubnetType() PlacementString {
+	if p.Type == nil {
+		return PublicSubnetPlacement
+	}
+	return *p.Type
 }
 
 // PlacementString represents what types of subnets (public or private subnets) to place tasks.
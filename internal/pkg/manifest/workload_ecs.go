@@ -6,6 +6,7 @@ package manifest
 import (
 	"errors"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -31,6 +32,9 @@ const (
 	ArchARM   = dockerengine.ArchARM
 	ArchARM64 = dockerengine.ArchARM64
 
+	// FargatePlatformVersionLatest always resolves to the most recent Fargate platform version.
+	FargatePlatformVersionLatest = "LATEST"
+
 	// Minimum CPU and mem values required for Windows-based tasks.
 	MinWindowsTaskCPU    = 1024
 	MinWindowsTaskMemory = 2048
@@ -38,6 +42,23 @@ const (
 	// deployment strategies
 	ECSDefaultRollingUpdateStrategy  = "default"
 	ECSRecreateRollingUpdateStrategy = "recreate"
+	ECSRollingDeploymentStrategy     = "rolling"
+	ECSBlueGreenDeploymentStrategy   = "blue/green"
+
+	// blue/green traffic shifting options
+	BlueGreenAllAtOnceTrafficShifting = "all_at_once"
+	BlueGreenCanaryTrafficShifting    = "canary"
+	BlueGreenLinearTrafficShifting    = "linear"
+
+	// metrics that workload alarms can watch
+	AlarmMetricCPU          = "cpu"
+	AlarmMetricMemory       = "memory"
+	AlarmMetricHTTP5xx      = "http_5xx"
+	AlarmMetricResponseTime = "response_time"
+
+	// actions taken when a workload alarm goes off
+	AlarmActionRollback = "rollback"
+	AlarmActionNotify   = "notify"
 )
 
 // Platform related settings.
@@ -52,6 +73,10 @@ var (
 		dockerengine.PlatformString(OSWindows, ArchAMD64),
 		dockerengine.PlatformString(OSWindows, ArchX86),
 	}
+	validMultiArchPlatforms = []string{ // All of the os/arch combinations that a multi-architecture image may be built for.
+		dockerengine.PlatformString(OSLinux, ArchAMD64),
+		dockerengine.PlatformString(OSLinux, ArchARM64),
+	}
 	validAdvancedPlatforms = []PlatformArgs{ // All of the OsFamily/Arch combinations that the PlatformArgs field may accept.
 		{OSFamily: aws.String(OSLinux), Arch: aws.String(ArchX86)},
 		{OSFamily: aws.String(OSLinux), Arch: aws.String(ArchAMD64)},
@@ -64,8 +89,44 @@ var (
 		{OSFamily: aws.String(OSWindowsServer2019Full), Arch: aws.String(ArchX86)},
 		{OSFamily: aws.String(OSWindowsServer2019Full), Arch: aws.String(ArchAMD64)},
 	}
+
+	// Fargate platform versions that a task may pin, see
+	// https://docs.aws.amazon.com/AmazonECS/latest/developerguide/platform_versions.html
+	linuxFargatePlatformVersions           = []string{FargatePlatformVersionLatest, "1.4.0", "1.3.0", "1.2.0", "1.1.0", "1.0.0"}
+	windowsFargatePlatformVersions         = []string{FargatePlatformVersionLatest, "1.0.0"}
+	deprecatedLinuxFargatePlatformVersions = []string{"1.0.0", "1.1.0", "1.2.0"}
+	legacyLinuxFargatePlatformVersions     = []string{"1.3.0", "1.2.0", "1.1.0", "1.0.0"} // Versions before 1.4.0.
+
+	// Combinations of CPU units and memory in MiB that Fargate supports for a task, see
+	// https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task_definition_parameters.html#task_size
+	fargateTaskSizes = []fargateTaskSize{
+		{cpu: 256, memory: []int{512, 1024, 2048}, linuxOnly: true},
+		{cpu: 512, memory: memoryRange(1024, 4096, 1024), linuxOnly: true},
+		{cpu: 1024, memory: memoryRange(2048, 8192, 1024)},
+		{cpu: 2048, memory: memoryRange(4096, 16384, 1024)},
+		{cpu: 4096, memory: memoryRange(8192, 30720, 1024)},
+		{cpu: 8192, memory: memoryRange(16384, 61440, 4096), linuxOnly: true, requiresPlatformVersion140: true},
+		{cpu: 16384, memory: memoryRange(32768, 122880, 8192), linuxOnly: true, requiresPlatformVersion140: true},
+	}
 )
 
+// fargateTaskSize holds the memory values that Fargate supports for a task with a number of CPU units.
+type fargateTaskSize struct {
+	cpu                        int
+	memory                     []int
+	linuxOnly                  bool // Windows tasks need at least 1 vCPU and at most 4 vCPU.
+	requiresPlatformVersion140 bool
+}
+
+// memoryRange returns the memory values from min to max, in increments of step.
+func memoryRange(min, max, step int) []int {
+	var memory []int
+	for m := min; m <= max; m += step {
+		memory = append(memory, m)
+	}
+	return memory
+}
+
 // ImageWithHealthcheck represents a container image with health check.
 type ImageWithHealthcheck struct {
 	Image       Image                `yaml:",inline"`
@@ -80,11 +141,87 @@ type ImageWithPortAndHealthcheck struct {
 
 // DeploymentConfiguration represents the deployment strategies for a service.
 type DeploymentConfiguration struct {
-	Rolling *string `yaml:"rolling"`
+	Rolling   *string                   `yaml:"rolling"`
+	Strategy  *string                   `yaml:"strategy"`
+	BlueGreen BlueGreenDeploymentConfig `yaml:"blue_green"`
+	Canary    CanaryDeploymentConfig    `yaml:"canary"`
 }
 
 func (d *DeploymentConfiguration) isEmpty() bool {
-	return d == nil || d.Rolling == nil
+	return d == nil || (d.Rolling == nil && d.Strategy == nil && d.BlueGreen.IsEmpty() && d.Canary.IsEmpty())
+}
+
+// IsBlueGreen returns true if the service should be deployed by CodeDeploy with a blue/green deployment.
+func (d *DeploymentConfiguration) IsBlueGreen() bool {
+	return d != nil && strings.EqualFold(aws.StringValue(d.Strategy), ECSBlueGreenDeploymentStrategy)
+}
+
+// BlueGreenDeploymentConfig represents how traffic is shifted to the new tasks during a blue/green deployment.
+type BlueGreenDeploymentConfig struct {
+	TestListenerPort *uint16  `yaml:"test_listener_port"`
+	TrafficShifting  *string  `yaml:"traffic_shifting"`
+	RollbackAlarms   []string `yaml:"rollback_alarms"`
+}
+
+// IsEmpty returns true if none of the blue/green deployment options are specified.
+func (b BlueGreenDeploymentConfig) IsEmpty() bool {
+	return b.TestListenerPort == nil && b.TrafficShifting == nil && len(b.RollbackAlarms) == 0
+}
+
+// CanaryDeploymentConfig represents the steps in which traffic is shifted to the new tasks during a blue/green deployment.
+type CanaryDeploymentConfig struct {
+	Steps []CanaryStep `yaml:"steps"`
+}
+
+// IsEmpty returns true if no canary step is specified.
+func (c CanaryDeploymentConfig) IsEmpty() bool {
+	return len(c.Steps) == 0
+}
+
+// BakeTime returns the total time that traffic is held at the weights of the steps.
+func (c CanaryDeploymentConfig) BakeTime() time.Duration {
+	var total time.Duration
+	for _, step := range c.Steps {
+		total += step.BakeTime()
+	}
+	return total
+}
+
+// CanaryStep represents a share of the traffic routed to the new tasks, and how long it is held before the next step.
+type CanaryStep struct {
+	Weight *int           `yaml:"weight"`
+	Bake   *time.Duration `yaml:"bake"`
+}
+
+// BakeTime returns how long the weight of the step is held, or 0 if it's not specified.
+func (s CanaryStep) BakeTime() time.Duration {
+	if s.Bake == nil {
+		return 0
+	}
+	return *s.Bake
+}
+
+// WorkloadAlarm represents a CloudWatch alarm on a metric of the service.
+type WorkloadAlarm struct {
+	Name      *string        `yaml:"name"`
+	Metric    *string        `yaml:"metric"`
+	Threshold *float64       `yaml:"threshold"`
+	Period    *time.Duration `yaml:"period"`
+	Action    *string        `yaml:"action"`
+}
+
+// AlarmPeriod returns the period over which the metric is evaluated, or one minute if it's not specified.
+func (a WorkloadAlarm) AlarmPeriod() time.Duration {
+	if a.Period == nil {
+		return time.Minute
+	}
+	return *a.Period
+}
+
+// Rollback returns true if a deployment of the service should be rolled back when the alarm goes off.
+// Alarms roll back deployments unless they only notify.
+func (a WorkloadAlarm) Rollback() bool {
+	return !strings.EqualFold(aws.StringValue(a.Action), AlarmActionNotify)
 }
 
 // ImageWithHealthcheckAndOptionalPort represents a container image with an optional exposed port and health check.
@@ -101,15 +238,17 @@ type ImageWithOptionalPort struct {
 
 // TaskConfig represents the resource boundaries and environment variables for the containers in the task.
 type TaskConfig struct {
-	CPU            *int                 `yaml:"cpu"`
-	Memory         *int                 `yaml:"memory"`
-	Platform       PlatformArgsOrString `yaml:"platform,omitempty"`
-	Count          Count                `yaml:"count"`
-	ExecuteCommand ExecuteCommand       `yaml:"exec"`
-	Variables      map[string]string    `yaml:"variables"`
-	EnvFile        *string              `yaml:"env_file"`
-	Secrets        map[string]Secret    `yaml:"secrets"`
-	Storage        Storage              `yaml:"storage"`
+	CPU             *int                 `yaml:"cpu"`
+	Memory          *int                 `yaml:"memory"`
+	Platform        PlatformArgsOrString `yaml:"platform,omitempty"`
+	PlatformVersion *string              `yaml:"platform_version"`
+	Count           Count                `yaml:"count"`
+	ExecuteCommand  ExecuteCommand       `yaml:"exec"`
+	Variables       map[string]string    `yaml:"variables"`
+	EnvFile         *string              `yaml:"env_file"`
+	Secrets         map[string]Secret    `yaml:"secrets"`
+	Storage         Storage              `yaml:"storage"`
+	Evidently       Evidently            `yaml:"evidently"`
 }
 
 // ContainerPlatform returns the platform for the service.
@@ -133,6 +272,27 @@ func (t TaskConfig) IsARM() bool {
 	return IsArmArch(t.Platform.Arch())
 }
 
+// PinnedPlatformVersion returns the Fargate platform version pinned by the task, or an empty string if it's not pinned.
+func (t TaskConfig) PinnedPlatformVersion() string {
+	return aws.StringValue(t.PlatformVersion)
+}
+
+// HasDeprecatedPlatformVersion returns true if the task pins a Fargate platform version that AWS deprecated.
+func (t TaskConfig) HasDeprecatedPlatformVersion() bool {
+	if t.PlatformVersion == nil || t.IsWindows() {
+		return false
+	}
+	return contains(aws.StringValue(t.PlatformVersion), deprecatedLinuxFargatePlatformVersions)
+}
+
+// pinsLegacyPlatformVersion returns true if the task pins a Linux Fargate platform version before 1.4.0.
+func (t TaskConfig) pinsLegacyPlatformVersion() bool {
+	if t.PlatformVersion == nil || t.IsWindows() {
+		return false
+	}
+	return contains(aws.StringValue(t.PlatformVersion), legacyLinuxFargatePlatformVersions)
+}
+
 // Secret represents an identifier for sensitive data stored in either SSM or SecretsManager.
 type Secret struct {
 	from               *string              // SSM Parameter name or ARN to a secret.
@@ -184,6 +344,8 @@ func (s secretsManagerSecret) IsEmpty() bool {
 // Logging holds configuration for Firelens to route your logs.
 type Logging struct {
 	Retention      *int              `yaml:"retention"`
+	GroupName      *string           `yaml:"groupName"`
+	KMSKeyARN      *string           `yaml:"kmsKeyARN"`
 	Image          *string           `yaml:"image"`
 	Destination    map[string]string `yaml:"destination,flow"`
 	EnableMetadata *bool             `yaml:"enableMetadata"`
@@ -194,6 +356,7 @@ type Logging struct {
 }
 
 // IsEmpty returns empty if the struct has all zero members.
+// The log group fields "retention", "groupName" and "kmsKeyARN" are not considered since they don't require Firelens.
 func (lc *Logging) IsEmpty() bool {
 	return lc.Image == nil && lc.Destination == nil && lc.EnableMetadata == nil &&
 		lc.SecretOptions == nil && lc.ConfigFile == nil && lc.Variables == nil && lc.Secrets == nil
@@ -228,9 +391,21 @@ type SidecarConfig struct {
 	DockerLabels  map[string]string    `yaml:"labels"`
 	DependsOn     DependsOn            `yaml:"depends_on"`
 	HealthCheck   ContainerHealthCheck `yaml:"healthcheck"`
+	Logging       SidecarLogging       `yaml:"logging"`
 	ImageOverride `yaml:",inline"`
 }
 
+// SidecarLogging holds configuration for a dedicated CloudWatch log group for a sidecar container.
+type SidecarLogging struct {
+	GroupName *string `yaml:"groupName"`
+	Retention *int    `yaml:"retention"`
+}
+
+// IsEmpty returns true if the sidecar should send its logs to the workload's log group.
+func (l SidecarLogging) IsEmpty() bool {
+	return l.GroupName == nil && l.Retention == nil
+}
+
 // OverrideRule holds the manifest overriding rule for CloudFormation template.
 type OverrideRule struct {
 	Path  string    `yaml:"path"`
@@ -277,6 +452,24 @@ func (e ExecuteCommandConfig) IsEmpty() bool {
 	return e.Enable == nil
 }
 
+// Evidently holds the CloudWatch Evidently project and feature flags of a service.
+type Evidently struct {
+	Project  *string            `yaml:"project"`
+	Features []EvidentlyFeature `yaml:"features"`
+}
+
+// IsEmpty returns whether Evidently is empty.
+func (e Evidently) IsEmpty() bool {
+	return e.Project == nil && len(e.Features) == 0
+}
+
+// EvidentlyFeature holds the configuration of a boolean feature flag in a CloudWatch Evidently project.
+type EvidentlyFeature struct {
+	Name        *string `yaml:"name"`
+	Description *string `yaml:"description"`
+	Enabled     *bool   `yaml:"enabled"` // Whether the feature is served "on" by default.
+}
+
 // ContainerHealthCheck holds the configuration to determine if the service container is healthy.
 // See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-ecs-taskdefinition-healthcheck.html
 type ContainerHealthCheck struct {
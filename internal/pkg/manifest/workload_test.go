@@ -373,6 +373,32 @@ func TestPlacementArgOrString_UnmarshalYAML(t *testing.T) {
   archie: leg64`),
 			wantedError: errUnmarshalPlacementOpts,
 		},
+		"success with subnets": {
+			inContent: []byte(`placement:
+  subnets: ["id1", "id2"]`),
+			wantedStruct: PlacementArgOrString{
+				PlacementArgs: PlacementArgs{
+					Subnets: []string{"id1", "id2"},
+				},
+			},
+		},
+		"success with availability zones": {
+			inContent: []byte(`placement:
+  azs: ["us-west-2a", "us-west-2b"]
+  type: private`),
+			wantedStruct: PlacementArgOrString{
+				PlacementArgs: PlacementArgs{
+					AZs:  []string{"us-west-2a", "us-west-2b"},
+					Type: placementStringP(PrivateSubnetPlacement),
+				},
+			},
+		},
+		"success with string": {
+			inContent: []byte(`placement: private`),
+			wantedStruct: PlacementArgOrString{
+				PlacementString: placementStringP(PrivateSubnetPlacement),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -383,7 +409,7 @@ func TestPlacementArgOrString_UnmarshalYAML(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				require.Equal(t, tc.wantedStruct.PlacementString, v.Placement.PlacementString)
-				require.Equal(t, tc.wantedStruct.PlacementArgs.Subnets, v.Placement.PlacementArgs.Subnets)
+				require.Equal(t, tc.wantedStruct.PlacementArgs, v.Placement.PlacementArgs)
 			}
 		})
 	}
@@ -950,3 +976,94 @@ topics: abc
 		})
 	}
 }
+
+func TestApplyInstance(t *testing.T) {
+	testCases := map[string]struct {
+		inInstance string
+
+		wantedName string
+	}{
+		"keeps the name of the workload without an instance": {
+			wantedName: "api",
+		},
+		"renames the workload after the instance": {
+			inInstance: "tenant-a",
+			wantedName: "api-tenant-a",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mft := &BackendService{
+				Workload: Workload{
+					Name: aws.String("api"),
+				},
+			}
+
+			err := ApplyInstance(mft, tc.inInstance)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, aws.StringValue(mft.Name))
+		})
+	}
+}
+
+func TestApplyImageLocation(t *testing.T) {
+	const location = "123456789012.dkr.ecr.us-west-2.amazonaws.com/api:v1.0.0"
+	testCases := map[string]struct {
+		inMft WorkloadManifest
+
+		wantedImage Image
+	}{
+		"replaces the build of a service with the image location": {
+			inMft: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: ImageWithPortAndHealthcheck{
+						ImageWithPort: ImageWithPort{
+							Image: Image{
+								Build: BuildArgsOrString{
+									BuildString: aws.String("./Dockerfile"),
+								},
+								TagStrategy: ImageTagStrategy{
+									Type: aws.String(ImageTagStrategyGitSHA),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantedImage: Image{
+				Location: aws.String(location),
+				TagStrategy: ImageTagStrategy{
+					Type: aws.String(ImageTagStrategyGitSHA),
+				},
+			},
+		},
+		"replaces the image location of a job": {
+			inMft: &ScheduledJob{
+				ScheduledJobConfig: ScheduledJobConfig{
+					ImageConfig: ImageWithHealthcheck{
+						Image: Image{
+							Location: aws.String("nginx"),
+						},
+					},
+				},
+			},
+			wantedImage: Image{
+				Location: aws.String(location),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ApplyImageLocation(tc.inMft, location)
+
+			require.NoError(t, err)
+			switch mft := tc.inMft.(type) {
+			case *LoadBalancedWebService:
+				require.Equal(t, tc.wantedImage, mft.ImageConfig.Image)
+			case *ScheduledJob:
+				require.Equal(t, tc.wantedImage, mft.ImageConfig.Image)
+			}
+		})
+	}
+}
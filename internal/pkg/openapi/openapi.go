@@ -0,0 +1,184 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package openapi reads OpenAPI documents to infer the network configuration of a service.
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const rootPath = "/"
+
+// Ports that are served by a load balancer or a proxy rather than by the container.
+var defaultSchemePorts = map[string]bool{
+	"80":  true,
+	"443": true,
+}
+
+// Last segments of the paths that are usually served for health checks, by order of preference.
+var healthCheckSegments = []string{"health", "healthz", "healthcheck", "health-check", "ping", "status", "ready", "readyz", "livez"}
+
+var serverVariableRegexp = regexp.MustCompile(`{([^}]+)}`) // Captures the name of a server URL variable.
+
+// Operations that can be listed under a path item.
+var httpMethods = map[string]bool{
+	"get":     true,
+	"put":     true,
+	"post":    true,
+	"delete":  true,
+	"options": true,
+	"head":    true,
+	"patch":   true,
+	"trace":   true,
+}
+
+// ErrNotOpenAPI means the document is neither an OpenAPI 3 nor a Swagger 2.0 document.
+var ErrNotOpenAPI = errors.New(`document must declare an "openapi" or a "swagger" version`)
+
+// Document is the part of an OpenAPI 3 or Swagger 2.0 document that describes where the API is served.
+type Document struct {
+	OpenAPI string                          `yaml:"openapi"`
+	Swagger string                          `yaml:"swagger"`
+	Servers []server                        `yaml:"servers"`  // OpenAPI 3.
+	Host    string                          `yaml:"host"`     // Swagger 2.0.
+	Base    string                          `yaml:"basePath"` // Swagger 2.0.
+	Paths   map[string]map[string]yaml.Node `yaml:"paths"`
+}
+
+type server struct {
+	URL       string `yaml:"url"`
+	Variables map[string]struct {
+		Default string `yaml:"default"`
+	} `yaml:"variables"`
+}
+
+// RouteGroup is a set of API paths sharing the same first segment that can be routed together.
+type RouteGroup struct {
+	Path       string // Path prefix of the group, including the base path of the API.
+	Operations int    // Number of operations served under the prefix.
+}
+
+// Parse unmarshals an OpenAPI document in YAML or JSON.
+func Parse(dat []byte) (*Document, error) {
+	var doc Document
+	if err := yaml.Unmarshal(dat, &doc); err != nil {
+		return nil, fmt.Errorf("unmarshal OpenAPI document: %w", err)
+	}
+	if doc.OpenAPI == "" && doc.Swagger == "" {
+		return nil, ErrNotOpenAPI
+	}
+	return &doc, nil
+}
+
+// Port returns the port of the first server URL that sets a port other than 80 or 443.
+// Returns false if no server sets such a port, since the port of the container can't be inferred.
+func (d *Document) Port() (uint16, bool) {
+	for _, u := range d.serverURLs() {
+		port := u.Port()
+		if port == "" || defaultSchemePorts[port] {
+			continue
+		}
+		p, err := strconv.ParseUint(port, 10, 16)
+		if err != nil || p == 0 {
+			continue
+		}
+		return uint16(p), true
+	}
+	return 0, false
+}
+
+// BasePath returns the path that prefixes all the API paths, or "/" if the API is served at the root.
+func (d *Document) BasePath() string {
+	urls := d.serverURLs()
+	if len(urls) == 0 {
+		return rootPath
+	}
+	return path.Clean("/" + urls[0].Path)
+}
+
+// HealthCheckPath returns the full path of a GET operation that looks like a health check, such as "/health" or "/ping".
+// Returns false if the API doesn't have any.
+func (d *Document) HealthCheckPath() (string, bool) {
+	for _, segment := range healthCheckSegments {
+		for _, p := range d.sortedPaths() {
+			if _, ok := d.Paths[p]["get"]; !ok {
+				continue
+			}
+			if strings.EqualFold(path.Base(p), segment) {
+				return path.Join(d.BasePath(), p), true
+			}
+		}
+	}
+	return "", false
+}
+
+// RouteGroups groups the operations of the API by the first segment of their path, sorted by path.
+// Paths starting with a template such as "/{id}" are grouped under the base path.
+func (d *Document) RouteGroups() []RouteGroup {
+	base := d.BasePath()
+	operations := make(map[string]int)
+	for _, p := range d.sortedPaths() {
+		prefix := base
+		segments := strings.Split(strings.Trim(p, "/"), "/")
+		if segments[0] != "" && !strings.HasPrefix(segments[0], "{") {
+			prefix = path.Join(base, segments[0])
+		}
+		for method := range d.Paths[p] {
+			if httpMethods[strings.ToLower(method)] {
+				operations[prefix]++
+			}
+		}
+	}
+	groups := make([]RouteGroup, 0, len(operations))
+	for prefix, count := range operations {
+		groups = append(groups, RouteGroup{
+			Path:       prefix,
+			Operations: count,
+		})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Path < groups[j].Path
+	})
+	return groups
+}
+
+// serverURLs returns the URLs that the API is served at, with their variables replaced by their default value.
+func (d *Document) serverURLs() []*url.URL {
+	var raw []string
+	if d.Swagger != "" {
+		raw = append(raw, "//"+d.Host+d.Base)
+	}
+	for _, s := range d.Servers {
+		raw = append(raw, serverVariableRegexp.ReplaceAllStringFunc(s.URL, func(match string) string {
+			return s.Variables[strings.Trim(match, "{}")].Default
+		}))
+	}
+	var urls []*url.URL
+	for _, r := range raw {
+		u, err := url.Parse(r)
+		if err != nil {
+			continue
+		}
+		urls = append(urls, u)
+	}
+	return urls
+}
+
+func (d *Document) sortedPaths() []string {
+	paths := make([]string, 0, len(d.Paths))
+	for p := range d.Paths {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
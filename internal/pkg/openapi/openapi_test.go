@@ -0,0 +1,192 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const petstore = `
+openapi: 3.0.3
+servers:
+  - url: https://api.example.com/v1
+  - url: http://localhost:{port}/v1
+    variables:
+      port:
+        default: "8080"
+paths:
+  /pets:
+    get: {}
+    post: {}
+  /pets/{petId}:
+    get: {}
+    delete: {}
+    parameters: []
+  /owners:
+    get: {}
+  /{tenant}:
+    get: {}
+  /ping:
+    get: {}
+  /healthz:
+    get: {}
+  /health:
+    post: {}
+`
+
+func TestParse(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wantedError string
+	}{
+		"error if the document is not an OpenAPI document": {
+			in:          `name: api`,
+			wantedError: `document must declare an "openapi" or a "swagger" version`,
+		},
+		"error if the document is malformed": {
+			in:          `openapi: [`,
+			wantedError: "unmarshal OpenAPI document: yaml: line 1: did not find expected node content",
+		},
+		"parse a JSON document": {
+			in: `{"swagger": "2.0", "host": "localhost:3000", "paths": {}}`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse([]byte(tc.in))
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDocument_Port(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wantedPort uint16
+		wantedOK   bool
+	}{
+		"port from the default value of a server variable": {
+			in:         petstore,
+			wantedPort: 8080,
+			wantedOK:   true,
+		},
+		"port from a swagger host": {
+			in:         `{"swagger": "2.0", "host": "localhost:3000"}`,
+			wantedPort: 3000,
+			wantedOK:   true,
+		},
+		"ignore the ports of the HTTP and HTTPS schemes": {
+			in: `
+openapi: 3.1.0
+servers:
+  - url: https://api.example.com:443
+  - url: http://api.example.com:80
+  - url: /v1
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			doc, err := Parse([]byte(tc.in))
+			require.NoError(t, err)
+
+			port, ok := doc.Port()
+
+			require.Equal(t, tc.wantedOK, ok)
+			require.Equal(t, tc.wantedPort, port)
+		})
+	}
+}
+
+func TestDocument_BasePath(t *testing.T) {
+	testCases := map[string]struct {
+		in         string
+		wantedPath string
+	}{
+		"path of the first server URL": {
+			in:         petstore,
+			wantedPath: "/v1",
+		},
+		"relative server URL": {
+			in: `
+openapi: 3.0.0
+servers:
+  - url: /api/
+`,
+			wantedPath: "/api",
+		},
+		"swagger base path": {
+			in:         `{"swagger": "2.0", "basePath": "/api/v2"}`,
+			wantedPath: "/api/v2",
+		},
+		"root if there is no server": {
+			in:         `openapi: 3.0.0`,
+			wantedPath: "/",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			doc, err := Parse([]byte(tc.in))
+			require.NoError(t, err)
+
+			require.Equal(t, tc.wantedPath, doc.BasePath())
+		})
+	}
+}
+
+func TestDocument_HealthCheckPath(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wantedPath string
+		wantedOK   bool
+	}{
+		"prefer the GET operations of the most common health check paths": {
+			in:         petstore,
+			wantedPath: "/v1/healthz",
+			wantedOK:   true,
+		},
+		"no health check path": {
+			in: `
+openapi: 3.0.0
+paths:
+  /pets:
+    get: {}
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			doc, err := Parse([]byte(tc.in))
+			require.NoError(t, err)
+
+			path, ok := doc.HealthCheckPath()
+
+			require.Equal(t, tc.wantedOK, ok)
+			require.Equal(t, tc.wantedPath, path)
+		})
+	}
+}
+
+func TestDocument_RouteGroups(t *testing.T) {
+	doc, err := Parse([]byte(petstore))
+	require.NoError(t, err)
+
+	require.Equal(t, []RouteGroup{
+		{Path: "/v1", Operations: 1},
+		{Path: "/v1/health", Operations: 1},
+		{Path: "/v1/healthz", Operations: 1},
+		{Path: "/v1/owners", Operations: 1},
+		{Path: "/v1/pets", Operations: 4},
+		{Path: "/v1/ping", Operations: 1},
+	}, doc.RouteGroups())
+}
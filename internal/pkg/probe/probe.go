@@ -0,0 +1,154 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package probe checks whether the endpoints of a deployed service respond.
+package probe
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTimeout is the maximum time to wait for an endpoint to respond.
+const DefaultTimeout = 5 * time.Second
+
+// Result is the outcome of probing an endpoint.
+type Result struct {
+	Endpoint   string        // URL or "host:port" address of the endpoint.
+	DNSName    string        // DNS name that the endpoint resolves.
+	Latency    time.Duration // Time taken by the endpoint to respond.
+	StatusCode int           // Status code of the HTTP response. Zero for TCP probes.
+	Err        error         // Non-nil if the endpoint didn't respond.
+}
+
+// Healthy returns true if the endpoint accepted the TCP connection, or responded with a non-error HTTP status code.
+func (r Result) Healthy() bool {
+	if r.Err != nil {
+		return false
+	}
+	if r.StatusCode == 0 {
+		return true
+	}
+	return r.StatusCode < http.StatusBadRequest
+}
+
+// Status returns a human readable description of the response of the endpoint.
+func (r Result) Status() string {
+	if r.Err != nil {
+		return fmt.Sprintf("unreachable: %v", r.Err)
+	}
+	if r.StatusCode == 0 {
+		return "open"
+	}
+	return fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode))
+}
+
+type httpDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+type dialer interface {
+	DialContext(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// Prober sends HTTP requests to URLs and opens TCP connections to "host:port" addresses.
+type Prober struct {
+	client httpDoer
+	dialer dialer
+	now    func() time.Time
+}
+
+// New returns a Prober that gives up on an endpoint after the timeout.
+func New(timeout time.Duration) *Prober {
+	return &Prober{
+		client: &http.Client{
+			Timeout: timeout,
+		},
+		dialer: &net.Dialer{
+			Timeout: timeout,
+		},
+		now: time.Now,
+	}
+}
+
+// Probe checks each endpoint concurrently and returns the results in the same order as the endpoints.
+// If healthCheckPath is not empty, it replaces the path of the URLs.
+func (p *Prober) Probe(ctx context.Context, endpoints []string, healthCheckPath string) []Result {
+	results := make([]Result, len(endpoints))
+	var wg sync.WaitGroup
+	for i, endpoint := range endpoints {
+		wg.Add(1)
+		go func(i int, endpoint string) {
+			defer wg.Done()
+			results[i] = p.probe(ctx, endpoint, healthCheckPath)
+		}(i, endpoint)
+	}
+	wg.Wait()
+	return results
+}
+
+func (p *Prober) probe(ctx context.Context, endpoint, healthCheckPath string) Result {
+	if !strings.Contains(endpoint, "://") {
+		return p.probeTCP(ctx, endpoint)
+	}
+	return p.probeHTTP(ctx, endpoint, healthCheckPath)
+}
+
+func (p *Prober) probeHTTP(ctx context.Context, endpoint, healthCheckPath string) Result {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return Result{
+			Endpoint: endpoint,
+			Err:      fmt.Errorf("parse url: %w", err),
+		}
+	}
+	if healthCheckPath != "" {
+		u.Path = "/" + strings.TrimPrefix(healthCheckPath, "/")
+	}
+	res := Result{
+		Endpoint: u.String(),
+		DNSName:  u.Hostname(),
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, res.Endpoint, nil)
+	if err != nil {
+		res.Err = fmt.Errorf("create request: %w", err)
+		return res
+	}
+	start := p.now()
+	resp, err := p.client.Do(req)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	defer resp.Body.Close()
+	res.Latency = p.now().Sub(start)
+	res.StatusCode = resp.StatusCode
+	return res
+}
+
+func (p *Prober) probeTCP(ctx context.Context, address string) Result {
+	res := Result{
+		Endpoint: address,
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		res.Err = fmt.Errorf("parse address: %w", err)
+		return res
+	}
+	res.DNSName = host
+	start := p.now()
+	conn, err := p.dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	defer conn.Close()
+	res.Latency = p.now().Sub(start)
+	return res
+}
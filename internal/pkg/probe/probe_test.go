@@ -0,0 +1,203 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package probe
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock returns times that are one second apart on each call.
+type fakeClock struct {
+	mu  sync.Mutex
+	cur time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cur = c.cur.Add(time.Second)
+	return c.cur
+}
+
+func TestProber_Probe(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/healthz", "/api":
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+	serverHost := strings.TrimPrefix(server.URL, "http://")
+
+	testCases := map[string]struct {
+		endpoints       []string
+		healthCheckPath string
+
+		wanted []Result
+	}{
+		"probes the URL as is without a health check path": {
+			endpoints: []string{server.URL + "/api"},
+			wanted: []Result{
+				{
+					Endpoint:   server.URL + "/api",
+					DNSName:    "127.0.0.1",
+					Latency:    time.Second,
+					StatusCode: http.StatusOK,
+				},
+			},
+		},
+		"replaces the path of the URL with the health check path": {
+			endpoints:       []string{server.URL + "/api"},
+			healthCheckPath: "healthz",
+			wanted: []Result{
+				{
+					Endpoint:   server.URL + "/healthz",
+					DNSName:    "127.0.0.1",
+					Latency:    time.Second,
+					StatusCode: http.StatusOK,
+				},
+			},
+		},
+		"reports the status code of an unhealthy URL": {
+			endpoints: []string{server.URL},
+			wanted: []Result{
+				{
+					Endpoint:   server.URL,
+					DNSName:    "127.0.0.1",
+					Latency:    time.Second,
+					StatusCode: http.StatusServiceUnavailable,
+				},
+			},
+		},
+		"opens a TCP connection to an address": {
+			endpoints:       []string{lis.Addr().String()},
+			healthCheckPath: "/healthz",
+			wanted: []Result{
+				{
+					Endpoint: lis.Addr().String(),
+					DNSName:  "127.0.0.1",
+					Latency:  time.Second,
+				},
+			},
+		},
+		"reports an error for an invalid address": {
+			endpoints: []string{"example.com"},
+			wanted: []Result{
+				{
+					Endpoint: "example.com",
+					Err:      errors.New("parse address: address example.com: missing port in address"),
+				},
+			},
+		},
+		"probes both URLs and addresses": {
+			endpoints:       []string{"http://" + serverHost, lis.Addr().String()},
+			healthCheckPath: "/healthz",
+			wanted: []Result{
+				{
+					Endpoint:   server.URL + "/healthz",
+					DNSName:    "127.0.0.1",
+					Latency:    time.Second,
+					StatusCode: http.StatusOK,
+				},
+				{
+					Endpoint: lis.Addr().String(),
+					DNSName:  "127.0.0.1",
+					Latency:  time.Second,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			p := New(DefaultTimeout)
+			clock := &fakeClock{}
+			p.now = clock.now
+			if len(tc.endpoints) > 1 {
+				// Probes run concurrently, so the latencies depend on the interleaving of the calls.
+				p.now = func() time.Time { return time.Time{} }
+				for i := range tc.wanted {
+					tc.wanted[i].Latency = 0
+				}
+			}
+
+			// WHEN
+			got := p.Probe(context.Background(), tc.endpoints, tc.healthCheckPath)
+
+			// THEN
+			require.Len(t, got, len(tc.wanted))
+			for i := range tc.wanted {
+				require.Equal(t, tc.wanted[i].Endpoint, got[i].Endpoint)
+				require.Equal(t, tc.wanted[i].DNSName, got[i].DNSName)
+				require.Equal(t, tc.wanted[i].Latency, got[i].Latency)
+				require.Equal(t, tc.wanted[i].StatusCode, got[i].StatusCode)
+				if tc.wanted[i].Err != nil {
+					require.EqualError(t, got[i].Err, tc.wanted[i].Err.Error())
+				} else {
+					require.NoError(t, got[i].Err)
+				}
+			}
+		})
+	}
+}
+
+func TestResult_Status(t *testing.T) {
+	testCases := map[string]struct {
+		in Result
+
+		wantedStatus  string
+		wantedHealthy bool
+	}{
+		"unreachable endpoint": {
+			in:           Result{Err: errors.New("connection refused")},
+			wantedStatus: "unreachable: connection refused",
+		},
+		"open TCP connection": {
+			in:            Result{},
+			wantedStatus:  "open",
+			wantedHealthy: true,
+		},
+		"successful HTTP response": {
+			in:            Result{StatusCode: http.StatusOK},
+			wantedStatus:  "200 OK",
+			wantedHealthy: true,
+		},
+		"failed HTTP response": {
+			in:           Result{StatusCode: http.StatusBadGateway},
+			wantedStatus: "502 Bad Gateway",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedStatus, tc.in.Status())
+			require.Equal(t, tc.wantedHealthy, tc.in.Healthy())
+		})
+	}
+}
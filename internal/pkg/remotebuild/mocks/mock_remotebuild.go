@@ -0,0 +1,143 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/remotebuild/remotebuild.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	codebuild "github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
+	progress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// Mockuploader is a mock of uploader interface.
+type Mockuploader struct {
+	ctrl     *gomock.Controller
+	recorder *MockuploaderMockRecorder
+}
+
+// MockuploaderMockRecorder is the mock recorder for Mockuploader.
+type MockuploaderMockRecorder struct {
+	mock *Mockuploader
+}
+
+// NewMockuploader creates a new mock instance.
+func NewMockuploader(ctrl *gomock.Controller) *Mockuploader {
+	mock := &Mockuploader{ctrl: ctrl}
+	mock.recorder = &MockuploaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockuploader) EXPECT() *MockuploaderMockRecorder {
+	return m.recorder
+}
+
+// Upload mocks base method.
+func (m *Mockuploader) Upload(bucket, key string, data io.Reader) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", bucket, key, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockuploaderMockRecorder) Upload(bucket, key, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*Mockuploader)(nil).Upload), bucket, key, data)
+}
+
+// MockprojectDeployer is a mock of projectDeployer interface.
+type MockprojectDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockprojectDeployerMockRecorder
+}
+
+// MockprojectDeployerMockRecorder is the mock recorder for MockprojectDeployer.
+type MockprojectDeployerMockRecorder struct {
+	mock *MockprojectDeployer
+}
+
+// NewMockprojectDeployer creates a new mock instance.
+func NewMockprojectDeployer(ctrl *gomock.Controller) *MockprojectDeployer {
+	mock := &MockprojectDeployer{ctrl: ctrl}
+	mock.recorder = &MockprojectDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockprojectDeployer) EXPECT() *MockprojectDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployRemoteBuildProject mocks base method.
+func (m *MockprojectDeployer) DeployRemoteBuildProject(out progress.FileWriter, app, bucket string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployRemoteBuildProject", out, app, bucket)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployRemoteBuildProject indicates an expected call of DeployRemoteBuildProject.
+func (mr *MockprojectDeployerMockRecorder) DeployRemoteBuildProject(out, app, bucket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployRemoteBuildProject", reflect.TypeOf((*MockprojectDeployer)(nil).DeployRemoteBuildProject), out, app, bucket)
+}
+
+// MockbuildRunner is a mock of buildRunner interface.
+type MockbuildRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockbuildRunnerMockRecorder
+}
+
+// MockbuildRunnerMockRecorder is the mock recorder for MockbuildRunner.
+type MockbuildRunnerMockRecorder struct {
+	mock *MockbuildRunner
+}
+
+// NewMockbuildRunner creates a new mock instance.
+func NewMockbuildRunner(ctrl *gomock.Controller) *MockbuildRunner {
+	mock := &MockbuildRunner{ctrl: ctrl}
+	mock.recorder = &MockbuildRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockbuildRunner) EXPECT() *MockbuildRunnerMockRecorder {
+	return m.recorder
+}
+
+// StartBuild mocks base method.
+func (m *MockbuildRunner) StartBuild(in *codebuild.StartBuildInput) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartBuild", in)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartBuild indicates an expected call of StartBuild.
+func (mr *MockbuildRunnerMockRecorder) StartBuild(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBuild", reflect.TypeOf((*MockbuildRunner)(nil).StartBuild), in)
+}
+
+// WaitForBuild mocks base method.
+func (m *MockbuildRunner) WaitForBuild(ctx context.Context, id string) (*codebuild.Build, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForBuild", ctx, id)
+	ret0, _ := ret[0].(*codebuild.Build)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForBuild indicates an expected call of WaitForBuild.
+func (mr *MockbuildRunnerMockRecorder) WaitForBuild(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForBuild", reflect.TypeOf((*MockbuildRunner)(nil).WaitForBuild), ctx, id)
+}
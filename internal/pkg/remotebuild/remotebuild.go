@@ -0,0 +1,253 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package remotebuild provides support for building and pushing images with AWS CodeBuild instead of a local Docker engine.
+package remotebuild
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/repository"
+	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/spf13/afero"
+)
+
+const (
+	// Files generated by Copilot in the build context.
+	copilotDirName       = ".copilot"
+	buildScriptFileName  = "build.sh"
+	dockerfileFileName   = "Dockerfile"
+	imageDigestEnvVarKey = "IMAGE_DIGEST"
+
+	// Build environment used for images that target ARM.
+	armPlatform         = "linux/arm64"
+	armEnvironmentType  = "ARM_CONTAINER"
+	armEnvironmentImage = "aws/codebuild/amazonlinux2-aarch64-standard:2.0"
+)
+
+// Directories that are never uploaded as part of the build context.
+var ignoredDirs = map[string]bool{
+	".git": true,
+}
+
+type uploader interface {
+	Upload(bucket, key string, data io.Reader) (string, error)
+}
+
+type projectDeployer interface {
+	DeployRemoteBuildProject(out progress.FileWriter, app, bucket string) error
+}
+
+type buildRunner interface {
+	StartBuild(in *codebuild.StartBuildInput) (string, error)
+	WaitForBuild(ctx context.Context, id string) (*codebuild.Build, error)
+}
+
+// Builder builds and pushes images with a CodeBuild project that is shared by the workloads of an application.
+type Builder struct {
+	app      string
+	name     string
+	bucket   string
+	fs       afero.Fs
+	uploader uploader
+	deployer projectDeployer
+	runner   buildRunner
+	out      progress.FileWriter
+}
+
+// BuilderConfig holds the fields to instantiate a Builder.
+type BuilderConfig struct {
+	App      string // Name of the application.
+	Name     string // Name of the workload whose image is built.
+	Bucket   string // Artifact bucket of the application in the region of the repository.
+	Uploader uploader
+	Deployer projectDeployer
+	Runner   buildRunner
+	Out      progress.FileWriter // Writer to render the deployment of the CodeBuild project to.
+}
+
+// New instantiates a new Builder.
+func New(cfg BuilderConfig) *Builder {
+	return &Builder{
+		app:      cfg.App,
+		name:     cfg.Name,
+		bucket:   cfg.Bucket,
+		fs:       afero.NewOsFs(),
+		uploader: cfg.Uploader,
+		deployer: cfg.Deployer,
+		runner:   cfg.Runner,
+		out:      cfg.Out,
+	}
+}
+
+// BuildAndPush zips the build context, uploads it to the artifact bucket, and builds and pushes the image with CodeBuild.
+// The docker client is ignored since the image is never built locally.
+func (b *Builder) BuildAndPush(_ repository.ContainerLoginBuildPusher, args *dockerengine.BuildArguments) (string, error) {
+	if len(args.Platforms) != 0 {
+		return "", errors.New(`multi-platform images cannot be built remotely: remove "image.platforms" from the manifest or build the image locally`)
+	}
+	source, err := b.zipContext(args)
+	if err != nil {
+		return "", err
+	}
+	key := artifactpath.RemoteBuildSource(b.name, source)
+	if _, err := b.uploader.Upload(b.bucket, key, bytes.NewReader(source)); err != nil {
+		return "", fmt.Errorf("upload build context to bucket %s: %w", b.bucket, err)
+	}
+	if err := b.deployer.DeployRemoteBuildProject(b.out, b.app, b.bucket); err != nil {
+		return "", fmt.Errorf("deploy remote build project for application %s: %w", b.app, err)
+	}
+	in := &codebuild.StartBuildInput{
+		Project:        stack.NameForRemoteBuild(b.app),
+		SourceLocation: fmt.Sprintf("%s/%s", b.bucket, key),
+	}
+	if args.Platform == armPlatform {
+		in.EnvironmentType = armEnvironmentType
+		in.Image = armEnvironmentImage
+	}
+	id, err := b.runner.StartBuild(in)
+	if err != nil {
+		return "", err
+	}
+	log.Infof("Building your container image remotely with build %s.\n", id)
+	build, err := b.runner.WaitForBuild(context.Background(), id)
+	if err != nil {
+		return "", err
+	}
+	if build.Status != "SUCCEEDED" {
+		return "", fmt.Errorf("remote build %s finished with status %s: see the logs at %s", id, build.Status, build.LogsURL)
+	}
+	digest, ok := build.ExportedEnv[imageDigestEnvVarKey]
+	if !ok || digest == "" {
+		return "", fmt.Errorf("remote build %s did not export the image digest", id)
+	}
+	return digest, nil
+}
+
+// zipContext returns the zipped build context with the Dockerfile and the script that builds and pushes the image.
+func (b *Builder) zipContext(args *dockerengine.BuildArguments) ([]byte, error) {
+	ctxDir := args.Context
+	if ctxDir == "" { // Context wasn't specified use the Dockerfile's directory as context.
+		ctxDir = filepath.Dir(args.Dockerfile)
+	}
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+	err := afero.Walk(b.fs, ctxDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if ignoredDirs[info.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel, err := filepath.Rel(ctxDir, path)
+		if err != nil {
+			return err
+		}
+		content, err := afero.ReadFile(b.fs, path)
+		if err != nil {
+			return fmt.Errorf("read file %s: %w", path, err)
+		}
+		return writeZipFile(w, filepath.ToSlash(rel), content)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("zip build context %s: %w", ctxDir, err)
+	}
+	dockerfile, err := filepath.Rel(ctxDir, args.Dockerfile)
+	if err != nil || strings.HasPrefix(dockerfile, "..") {
+		// The Dockerfile is outside of the build context, so it's uploaded next to the build script.
+		content, err := afero.ReadFile(b.fs, args.Dockerfile)
+		if err != nil {
+			return nil, fmt.Errorf("read Dockerfile %s: %w", args.Dockerfile, err)
+		}
+		dockerfile = filepath.Join(copilotDirName, dockerfileFileName)
+		if err := writeZipFile(w, filepath.ToSlash(dockerfile), content); err != nil {
+			return nil, err
+		}
+	}
+	script := buildScript(args, filepath.ToSlash(dockerfile))
+	if err := writeZipFile(w, copilotDirName+"/"+buildScriptFileName, []byte(script)); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("zip build context %s: %w", ctxDir, err)
+	}
+	return buf.Bytes(), nil
+}
+
+func writeZipFile(w *zip.Writer, name string, content []byte) error {
+	f, err := w.Create(name)
+	if err != nil {
+		return fmt.Errorf("create zip file %s: %w", name, err)
+	}
+	if _, err := f.Write(content); err != nil {
+		return fmt.Errorf("write zip file %s: %w", name, err)
+	}
+	return nil
+}
+
+// buildScript returns the shell script run by CodeBuild from the root of the build context.
+// The script mirrors the docker commands run by a local build, and writes the digest of the pushed image to .copilot/digest.
+func buildScript(args *dockerengine.BuildArguments, dockerfile string) string {
+	registry := strings.SplitN(args.URI, "/", 2)[0]
+	images := []string{args.URI}
+	for _, tag := range args.Tags {
+		images = append(images, fmt.Sprintf("%s:%s", args.URI, tag))
+	}
+
+	build := []string{"docker", "build"}
+	for _, img := range images {
+		build = append(build, "-t", quote(img))
+	}
+	for _, img := range args.CacheFrom {
+		build = append(build, "--cache-from", quote(img))
+	}
+	if args.Target != "" {
+		build = append(build, "--target", quote(args.Target))
+	}
+	if args.Platform != "" {
+		build = append(build, "--platform", quote(args.Platform))
+	}
+	// Collect the keys in a slice to sort for test stability.
+	var keys []string
+	for k := range args.Args {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		build = append(build, "--build-arg", quote(fmt.Sprintf("%s=%s", k, args.Args[k])))
+	}
+	build = append(build, "--progress", "plain", "-f", quote(dockerfile), ".")
+
+	lines := []string{
+		"set -e",
+		fmt.Sprintf("aws ecr get-login-password | docker login --username AWS --password-stdin %s", quote(registry)),
+		strings.Join(build, " "),
+	}
+	for _, img := range images {
+		lines = append(lines, fmt.Sprintf("docker push %s", quote(img)))
+	}
+	lines = append(lines, fmt.Sprintf(`docker inspect --format '{{index .RepoDigests 0}}' %s | cut -d '@' -f 2 > %s/digest`, quote(args.URI), copilotDirName))
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// quote returns s as a single-quoted shell word.
+func quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
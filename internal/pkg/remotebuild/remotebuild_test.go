@@ -0,0 +1,208 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package remotebuild
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/remotebuild/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+type builderMocks struct {
+	uploader *mocks.Mockuploader
+	deployer *mocks.MockprojectDeployer
+	runner   *mocks.MockbuildRunner
+}
+
+func TestBuilder_BuildAndPush(t *testing.T) {
+	const (
+		mockURI    = "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend"
+		mockBucket = "stackset-bucket"
+	)
+	testCases := map[string]struct {
+		inArgs     *dockerengine.BuildArguments
+		setupFS    func(fs afero.Fs)
+		setupMocks func(m builderMocks)
+
+		wantedDigest string
+		wantedError  error
+	}{
+		"error if the image is built for multiple platforms": {
+			inArgs: &dockerengine.BuildArguments{
+				URI:        mockURI,
+				Dockerfile: "/ws/frontend/Dockerfile",
+				Platforms:  []string{"linux/amd64", "linux/arm64"},
+			},
+			setupFS:     func(fs afero.Fs) {},
+			setupMocks:  func(m builderMocks) {},
+			wantedError: errors.New(`multi-platform images cannot be built remotely: remove "image.platforms" from the manifest or build the image locally`),
+		},
+		"error if the Dockerfile cannot be read": {
+			inArgs: &dockerengine.BuildArguments{
+				URI:        mockURI,
+				Dockerfile: "/ws/frontend/Dockerfile",
+				Context:    "/ws/backend",
+			},
+			setupFS: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "/ws/backend/main.go", []byte("package main"), 0644)
+			},
+			setupMocks:  func(m builderMocks) {},
+			wantedError: errors.New("read Dockerfile /ws/frontend/Dockerfile: open /ws/frontend/Dockerfile: file does not exist"),
+		},
+		"error if fail to upload the build context": {
+			inArgs: &dockerengine.BuildArguments{
+				URI:        mockURI,
+				Dockerfile: "/ws/frontend/Dockerfile",
+			},
+			setupFS: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "/ws/frontend/Dockerfile", []byte("FROM nginx"), 0644)
+			},
+			setupMocks: func(m builderMocks) {
+				m.uploader.EXPECT().Upload(mockBucket, gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("upload build context to bucket stackset-bucket: some error"),
+		},
+		"error if fail to deploy the build project": {
+			inArgs: &dockerengine.BuildArguments{
+				URI:        mockURI,
+				Dockerfile: "/ws/frontend/Dockerfile",
+			},
+			setupFS: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "/ws/frontend/Dockerfile", []byte("FROM nginx"), 0644)
+			},
+			setupMocks: func(m builderMocks) {
+				m.uploader.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil)
+				m.deployer.EXPECT().DeployRemoteBuildProject(gomock.Any(), "phonetool", mockBucket).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("deploy remote build project for application phonetool: some error"),
+		},
+		"error if the build fails": {
+			inArgs: &dockerengine.BuildArguments{
+				URI:        mockURI,
+				Dockerfile: "/ws/frontend/Dockerfile",
+			},
+			setupFS: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "/ws/frontend/Dockerfile", []byte("FROM nginx"), 0644)
+			},
+			setupMocks: func(m builderMocks) {
+				m.uploader.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil)
+				m.deployer.EXPECT().DeployRemoteBuildProject(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.runner.EXPECT().StartBuild(gomock.Any()).Return("phonetool-remote-build:1234", nil)
+				m.runner.EXPECT().WaitForBuild(gomock.Any(), "phonetool-remote-build:1234").Return(&codebuild.Build{
+					ID:      "phonetool-remote-build:1234",
+					Status:  "FAILED",
+					LogsURL: "https://logs",
+				}, nil)
+			},
+			wantedError: errors.New("remote build phonetool-remote-build:1234 finished with status FAILED: see the logs at https://logs"),
+		},
+		"build an arm64 image with the Dockerfile outside of the context": {
+			inArgs: &dockerengine.BuildArguments{
+				URI:        mockURI,
+				Dockerfile: "/ws/frontend/Dockerfile",
+				Context:    "/ws/backend",
+				Platform:   "linux/arm64",
+			},
+			setupFS: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "/ws/frontend/Dockerfile", []byte("FROM nginx"), 0644)
+				_ = afero.WriteFile(fs, "/ws/backend/main.go", []byte("package main"), 0644)
+				_ = afero.WriteFile(fs, "/ws/backend/.git/HEAD", []byte("ref: refs/heads/main"), 0644)
+			},
+			setupMocks: func(m builderMocks) {
+				m.uploader.EXPECT().Upload(mockBucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, data io.Reader) (string, error) {
+					require.Regexp(t, "^manual/remote-builds/frontend/[a-f0-9]{64}.zip$", key)
+					require.ElementsMatch(t, []string{"main.go", ".copilot/Dockerfile", ".copilot/build.sh"}, zippedFiles(t, data))
+					return "", nil
+				})
+				m.deployer.EXPECT().DeployRemoteBuildProject(gomock.Any(), "phonetool", mockBucket).Return(nil)
+				m.runner.EXPECT().StartBuild(gomock.Any()).DoAndReturn(func(in *codebuild.StartBuildInput) (string, error) {
+					require.Equal(t, "phonetool-remote-build", in.Project)
+					require.Equal(t, "ARM_CONTAINER", in.EnvironmentType)
+					require.Equal(t, "aws/codebuild/amazonlinux2-aarch64-standard:2.0", in.Image)
+					return "phonetool-remote-build:1234", nil
+				})
+				m.runner.EXPECT().WaitForBuild(gomock.Any(), "phonetool-remote-build:1234").Return(&codebuild.Build{
+					ID:          "phonetool-remote-build:1234",
+					Status:      "SUCCEEDED",
+					ExportedEnv: map[string]string{"IMAGE_DIGEST": "sha256:1234"},
+				}, nil)
+			},
+			wantedDigest: "sha256:1234",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := builderMocks{
+				uploader: mocks.NewMockuploader(ctrl),
+				deployer: mocks.NewMockprojectDeployer(ctrl),
+				runner:   mocks.NewMockbuildRunner(ctrl),
+			}
+			tc.setupMocks(m)
+			fs := afero.NewMemMapFs()
+			tc.setupFS(fs)
+			b := &Builder{
+				app:      "phonetool",
+				name:     "frontend",
+				bucket:   mockBucket,
+				fs:       fs,
+				uploader: m.uploader,
+				deployer: m.deployer,
+				runner:   m.runner,
+			}
+
+			// WHEN
+			digest, err := b.BuildAndPush(nil, tc.inArgs)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDigest, digest)
+		})
+	}
+}
+
+func zippedFiles(t *testing.T, data io.Reader) []string {
+	content, err := io.ReadAll(data)
+	require.NoError(t, err)
+	r, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+	var names []string
+	for _, f := range r.File {
+		names = append(names, f.Name)
+	}
+	return names
+}
+
+func TestBuildScript(t *testing.T) {
+	script := buildScript(&dockerengine.BuildArguments{
+		URI:    "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
+		Tags:   []string{"v1"},
+		Target: "prod",
+		Args:   map[string]string{"B": "it's", "A": "1"},
+	}, "Dockerfile")
+
+	require.Equal(t, `set -e
+aws ecr get-login-password | docker login --username AWS --password-stdin '123456789012.dkr.ecr.us-west-2.amazonaws.com'
+docker build -t '123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend' -t '123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:v1' --target 'prod' --build-arg 'A=1' --build-arg 'B=it'\''s' --progress plain -f 'Dockerfile' .
+docker push '123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend'
+docker push '123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:v1'
+docker inspect --format '{{index .RepoDigests 0}}' '123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend' | cut -d '@' -f 2 > .copilot/digest
+`, script)
+}
@@ -48,6 +48,21 @@ func (mr *MockContainerLoginBuildPusherMockRecorder) Build(args interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).Build), args)
 }
 
+// BuildMultiPlatform mocks base method.
+func (m *MockContainerLoginBuildPusher) BuildMultiPlatform(args *dockerengine.BuildArguments) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildMultiPlatform", args)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildMultiPlatform indicates an expected call of BuildMultiPlatform.
+func (mr *MockContainerLoginBuildPusherMockRecorder) BuildMultiPlatform(args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildMultiPlatform", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).BuildMultiPlatform), args)
+}
+
 // IsEcrCredentialHelperEnabled mocks base method.
 func (m *MockContainerLoginBuildPusher) IsEcrCredentialHelperEnabled(uri string) bool {
 	m.ctrl.T.Helper()
@@ -135,6 +150,36 @@ func (mr *MockRegistryMockRecorder) Auth() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth", reflect.TypeOf((*MockRegistry)(nil).Auth))
 }
 
+// ImageTagExists mocks base method.
+func (m *MockRegistry) ImageTagExists(name, tag string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageTagExists", name, tag)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageTagExists indicates an expected call of ImageTagExists.
+func (mr *MockRegistryMockRecorder) ImageTagExists(name, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageTagExists", reflect.TypeOf((*MockRegistry)(nil).ImageTagExists), name, tag)
+}
+
+// IsTagImmutable mocks base method.
+func (m *MockRegistry) IsTagImmutable(name string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTagImmutable", name)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTagImmutable indicates an expected call of IsTagImmutable.
+func (mr *MockRegistryMockRecorder) IsTagImmutable(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTagImmutable", reflect.TypeOf((*MockRegistry)(nil).IsTagImmutable), name)
+}
+
 // RepositoryURI mocks base method.
 func (m *MockRegistry) RepositoryURI(name string) (string, error) {
 	m.ctrl.T.Helper()
@@ -6,13 +6,16 @@ package repository
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 )
 
 // ContainerLoginBuildPusher provides support for logging in to repositories, building images and pushing images to repositories.
 type ContainerLoginBuildPusher interface {
 	Build(args *dockerengine.BuildArguments) error
+	BuildMultiPlatform(args *dockerengine.BuildArguments) (digest string, err error)
 	Login(uri, username, password string) error
 	Push(uri string, tags ...string) (digest string, err error)
 	IsEcrCredentialHelperEnabled(uri string) bool
@@ -22,6 +25,25 @@ type ContainerLoginBuildPusher interface {
 type Registry interface {
 	RepositoryURI(name string) (string, error)
 	Auth() (string, string, error)
+	IsTagImmutable(name string) (bool, error)
+	ImageTagExists(name, tag string) (bool, error)
+}
+
+// ErrImageTagExists occurs when pushing a tag that already exists to a repository with immutable tags.
+type ErrImageTagExists struct {
+	Repository string
+	Tag        string
+}
+
+func (e *ErrImageTagExists) Error() string {
+	return fmt.Sprintf("image tag %s already exists in repository %s which has tag immutability enabled", e.Tag, e.Repository)
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+// Implements main.actionRecommender interface.
+func (e *ErrImageTagExists) RecommendActions() string {
+	return fmt.Sprintf("Images with tag %s cannot be overwritten. Update your image tag strategy to generate a new tag, or specify a different tag with %s.",
+		e.Tag, color.HighlightCode("--tag"))
 }
 
 // Repository builds and pushes images to a repository.
@@ -57,22 +79,26 @@ func (r *Repository) BuildAndPush(docker ContainerLoginBuildPusher, args *docker
 		}
 		args.URI = uri
 	}
-	if err := docker.Build(args); err != nil {
-		return "", fmt.Errorf("build Dockerfile at %s: %w", args.Dockerfile, err)
+	if err := r.checkTagsAvailable(pushedTags(args)); err != nil {
+		return "", err
 	}
-
-	// Perform docker login only if credStore attribute value != ecr-login
-	if !docker.IsEcrCredentialHelperEnabled(args.URI) {
-		username, password, err := r.registry.Auth()
-		if err != nil {
-			return "", fmt.Errorf("get auth: %w", err)
+	if len(args.Platforms) != 0 {
+		// buildx pushes the image of each platform as it builds them, so log in first.
+		if err := r.login(docker, args.URI); err != nil {
+			return "", err
 		}
-
-		if err := docker.Login(args.URI, username, password); err != nil {
-			return "", fmt.Errorf("login to repo %s: %w", r.name, err)
+		digest, err := docker.BuildMultiPlatform(args)
+		if err != nil {
+			return "", fmt.Errorf("build and push multi-platform image from Dockerfile at %s to repo %s: %w", args.Dockerfile, r.name, err)
 		}
+		return digest, nil
+	}
+	if err := docker.Build(args); err != nil {
+		return "", fmt.Errorf("build Dockerfile at %s: %w", args.Dockerfile, err)
+	}
+	if err := r.login(docker, args.URI); err != nil {
+		return "", err
 	}
-
 	digest, err = docker.Push(args.URI, args.Tags...)
 	if err != nil {
 		return "", fmt.Errorf("push to repo %s: %w", r.name, err)
@@ -80,6 +106,22 @@ func (r *Repository) BuildAndPush(docker ContainerLoginBuildPusher, args *docker
 	return digest, nil
 }
 
+// login logs in to the repository, unless docker uses the ECR credential helper for it.
+func (r *Repository) login(docker ContainerLoginBuildPusher, uri string) error {
+	// Perform docker login only if credStore attribute value != ecr-login
+	if docker.IsEcrCredentialHelperEnabled(uri) {
+		return nil
+	}
+	username, password, err := r.registry.Auth()
+	if err != nil {
+		return fmt.Errorf("get auth: %w", err)
+	}
+	if err := docker.Login(uri, username, password); err != nil {
+		return fmt.Errorf("login to repo %s: %w", r.name, err)
+	}
+	return nil
+}
+
 // URI returns the uri of the repository.
 func (r *Repository) URI() (string, error) {
 	if r.uri != "" {
@@ -91,3 +133,42 @@ func (r *Repository) URI() (string, error) {
 	}
 	return uri, nil
 }
+
+// pushedTags returns every tag pushed to the repository: the additional tags, and the tag of the image URI,
+// which is "latest" if the URI doesn't have one.
+func pushedTags(args *dockerengine.BuildArguments) []string {
+	tags := append([]string{}, args.Tags...)
+	uriTag := "latest"
+	name := args.URI[strings.LastIndex(args.URI, "/")+1:] // Ignore the port of the registry host.
+	if i := strings.LastIndex(name, ":"); i != -1 {
+		uriTag = name[i+1:]
+	}
+	return append(tags, uriTag)
+}
+
+// checkTagsAvailable returns an error if any of the tags already exists in a repository with immutable tags.
+func (r *Repository) checkTagsAvailable(tags []string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	immutable, err := r.registry.IsTagImmutable(r.name)
+	if err != nil {
+		return fmt.Errorf("check tag mutability of repo %s: %w", r.name, err)
+	}
+	if !immutable {
+		return nil
+	}
+	for _, tag := range tags {
+		exists, err := r.registry.ImageTagExists(r.name, tag)
+		if err != nil {
+			return fmt.Errorf("check if tag %s exists in repo %s: %w", tag, r.name, err)
+		}
+		if exists {
+			return &ErrImageTagExists{
+				Repository: r.name,
+				Tag:        tag,
+			}
+		}
+	}
+	return nil
+}
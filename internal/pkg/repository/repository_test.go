@@ -32,6 +32,7 @@ func TestRepository_BuildAndPush(t *testing.T) {
 
 	testCases := map[string]struct {
 		inURI        string
+		inPlatforms  []string
 		inMockDocker func(m *mocks.MockContainerLoginBuildPusher)
 
 		mockRegistry func(m *mocks.MockRegistry)
@@ -46,9 +47,59 @@ func TestRepository_BuildAndPush(t *testing.T) {
 			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {},
 			wantedError:  errors.New("get repository URI: some error"),
 		},
+		"failed to check tag mutability": {
+			inURI: defaultDockerArguments.URI,
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().IsTagImmutable(inRepoName).Return(false, errors.New("some error"))
+			},
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().Build(gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("check tag mutability of repo my-repo: some error"),
+		},
+		"failed to check if a tag exists": {
+			inURI: defaultDockerArguments.URI,
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().IsTagImmutable(inRepoName).Return(true, nil)
+				m.EXPECT().ImageTagExists(inRepoName, mockTag1).Return(false, errors.New("some error"))
+			},
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().Build(gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("check if tag tag1 exists in repo my-repo: some error"),
+		},
+		"error if a tag already exists in a repository with immutable tags": {
+			inURI: defaultDockerArguments.URI,
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().IsTagImmutable(inRepoName).Return(true, nil)
+				m.EXPECT().ImageTagExists(inRepoName, mockTag1).Return(false, nil)
+				m.EXPECT().ImageTagExists(inRepoName, mockTag2).Return(true, nil)
+			},
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().Build(gomock.Any()).Times(0)
+				m.EXPECT().Push(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("image tag tag2 already exists in repository my-repo which has tag immutability enabled"),
+		},
+		"error if the latest tag of the image URI already exists in a repository with immutable tags": {
+			inURI: defaultDockerArguments.URI,
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().IsTagImmutable(inRepoName).Return(true, nil)
+				m.EXPECT().ImageTagExists(inRepoName, mockTag1).Return(false, nil)
+				m.EXPECT().ImageTagExists(inRepoName, mockTag2).Return(false, nil)
+				m.EXPECT().ImageTagExists(inRepoName, mockTag3).Return(false, nil)
+				m.EXPECT().ImageTagExists(inRepoName, "latest").Return(true, nil)
+			},
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().Build(gomock.Any()).Times(0)
+				m.EXPECT().Push(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("image tag latest already exists in repository my-repo which has tag immutability enabled"),
+		},
 		"failed to get auth": {
 			inURI: defaultDockerArguments.URI,
 			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().IsTagImmutable(inRepoName).Return(false, nil)
 				m.EXPECT().Auth().Return("", "", errors.New("error getting auth"))
 			},
 			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
@@ -62,6 +113,7 @@ func TestRepository_BuildAndPush(t *testing.T) {
 		"failed to build image": {
 			inURI: defaultDockerArguments.URI,
 			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().IsTagImmutable(inRepoName).Return(false, nil)
 				m.EXPECT().Auth().Return("", "", nil).AnyTimes()
 			},
 			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
@@ -74,6 +126,7 @@ func TestRepository_BuildAndPush(t *testing.T) {
 		"failed to login": {
 			inURI: defaultDockerArguments.URI,
 			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().IsTagImmutable(inRepoName).Return(false, nil)
 				m.EXPECT().Auth().Return("my-name", "my-pwd", nil)
 			},
 			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
@@ -87,6 +140,7 @@ func TestRepository_BuildAndPush(t *testing.T) {
 		"failed to push": {
 			inURI: defaultDockerArguments.URI,
 			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().IsTagImmutable(inRepoName).Return(false, nil)
 				m.EXPECT().Auth().Times(1)
 			},
 			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
@@ -99,6 +153,25 @@ func TestRepository_BuildAndPush(t *testing.T) {
 		},
 		"push with ecr-login": {
 			inURI: defaultDockerArguments.URI,
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().IsTagImmutable(inRepoName).Return(false, nil)
+			},
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().Build(&defaultDockerArguments).Return(nil).Times(1)
+				m.EXPECT().IsEcrCredentialHelperEnabled(defaultDockerArguments.URI).Return(true)
+				m.EXPECT().Push(mockRepoURI, mockTag1, mockTag2, mockTag3).Return("sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807", nil)
+			},
+			wantedDigest: "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+		},
+		"success with immutable tags": {
+			inURI: defaultDockerArguments.URI,
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().IsTagImmutable(inRepoName).Return(true, nil)
+				m.EXPECT().ImageTagExists(inRepoName, mockTag1).Return(false, nil)
+				m.EXPECT().ImageTagExists(inRepoName, mockTag2).Return(false, nil)
+				m.EXPECT().ImageTagExists(inRepoName, mockTag3).Return(false, nil)
+				m.EXPECT().ImageTagExists(inRepoName, "latest").Return(false, nil)
+			},
 			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
 				m.EXPECT().Build(&defaultDockerArguments).Return(nil).Times(1)
 				m.EXPECT().IsEcrCredentialHelperEnabled(defaultDockerArguments.URI).Return(true)
@@ -106,9 +179,46 @@ func TestRepository_BuildAndPush(t *testing.T) {
 			},
 			wantedDigest: "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
 		},
+		"failed to build multi-platform image": {
+			inURI:       defaultDockerArguments.URI,
+			inPlatforms: []string{"linux/amd64", "linux/arm64"},
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().IsTagImmutable(inRepoName).Return(false, nil)
+			},
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().IsEcrCredentialHelperEnabled(defaultDockerArguments.URI).Return(true)
+				m.EXPECT().BuildMultiPlatform(gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("build and push multi-platform image from Dockerfile at %s to repo my-repo: some error", inDockerfilePath),
+		},
+		"success with multi-platform image": {
+			inURI:       defaultDockerArguments.URI,
+			inPlatforms: []string{"linux/amd64", "linux/arm64"},
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().IsTagImmutable(inRepoName).Return(false, nil)
+				m.EXPECT().Auth().Return("my-name", "my-pwd", nil)
+			},
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				gomock.InOrder(
+					m.EXPECT().IsEcrCredentialHelperEnabled(defaultDockerArguments.URI).Return(false),
+					m.EXPECT().Login(mockRepoURI, "my-name", "my-pwd").Return(nil),
+					m.EXPECT().BuildMultiPlatform(&dockerengine.BuildArguments{
+						URI:        mockRepoURI,
+						Dockerfile: inDockerfilePath,
+						Context:    filepath.Dir(inDockerfilePath),
+						Tags:       []string{mockTag1, mockTag2, mockTag3},
+						Platforms:  []string{"linux/amd64", "linux/arm64"},
+					}).Return("sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807", nil),
+				)
+				m.EXPECT().Build(gomock.Any()).Times(0)
+				m.EXPECT().Push(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedDigest: "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+		},
 		"success": {
 			mockRegistry: func(m *mocks.MockRegistry) {
 				m.EXPECT().RepositoryURI(inRepoName).Return(defaultDockerArguments.URI, nil)
+				m.EXPECT().IsTagImmutable(inRepoName).Return(false, nil)
 				m.EXPECT().Auth().Return("my-name", "my-pwd", nil).Times(1)
 			},
 			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
@@ -145,6 +255,7 @@ func TestRepository_BuildAndPush(t *testing.T) {
 				Dockerfile: inDockerfilePath,
 				Context:    filepath.Dir(inDockerfilePath),
 				Tags:       []string{mockTag1, mockTag2, mockTag3},
+				Platforms:  tc.inPlatforms,
 			})
 			if tc.wantedError != nil {
 				require.EqualError(t, tc.wantedError, err.Error())
@@ -155,3 +266,36 @@ func TestRepository_BuildAndPush(t *testing.T) {
 		})
 	}
 }
+
+func TestPushedTags(t *testing.T) {
+	testCases := map[string]struct {
+		in     *dockerengine.BuildArguments
+		wanted []string
+	}{
+		"pushes latest if the image URI has no tag": {
+			in: &dockerengine.BuildArguments{
+				URI:  "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/frontend",
+				Tags: []string{"v1"},
+			},
+			wanted: []string{"v1", "latest"},
+		},
+		"pushes the tag of the image URI": {
+			in: &dockerengine.BuildArguments{
+				URI:  "localhost:5000/my-app:frontend-latest",
+				Tags: []string{"frontend-v1"},
+			},
+			wanted: []string{"frontend-v1", "frontend-latest"},
+		},
+		"ignores the port of the registry host": {
+			in: &dockerengine.BuildArguments{
+				URI: "localhost:5000/my-app",
+			},
+			wanted: []string{"latest"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, pushedTags(tc.in))
+		})
+	}
+}
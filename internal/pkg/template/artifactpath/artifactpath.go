@@ -8,6 +8,8 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"path"
+	"sort"
+	"strings"
 )
 
 const (
@@ -17,6 +19,10 @@ const (
 	s3ArtifactEnvFilesDirName = "env-files"
 	s3ScriptsDirName          = "scripts"
 	s3CustomResourcesDirName  = "custom-resources"
+	s3CustomResourceSetsDir   = "sets"
+	s3PreviousDeploymentDir   = "previous-deployment"
+	s3LastDeploymentDir       = "last-deployment"
+	s3RemoteBuildsDirName     = "remote-builds"
 )
 
 // MkdirSHA256 prefixes the key with the SHA256 hash of the contents of "manual/<hash>/key".
@@ -47,3 +53,75 @@ func EnvFiles(key string, content []byte) string {
 func CustomResource(key string, zipFile []byte) string {
 	return path.Join(s3ArtifactDirName, s3ScriptsDirName, s3CustomResourcesDirName, key, fmt.Sprintf("%x.zip", sha256.Sum256(zipFile)))
 }
+
+// CustomResourcesVersion returns the version of a set of custom resources, given the S3 key of each function.
+// The version is the sha256 of the functions and their keys, it is empty if there are no custom resources.
+func CustomResourcesVersion(keyForFn map[string]string) string {
+	if len(keyForFn) == 0 {
+		return ""
+	}
+	fns := make([]string, 0, len(keyForFn))
+	for fn := range keyForFn {
+		fns = append(fns, fn)
+	}
+	sort.Strings(fns)
+	var b strings.Builder
+	for _, fn := range fns {
+		fmt.Fprintf(&b, "%s=%s\n", fn, keyForFn[fn])
+	}
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(b.String())))
+}
+
+// CustomResourceSet returns the path to store the S3 keys of a set of custom resources with the version of the set.
+// Example: manual/scripts/custom-resources/sets/version.json
+func CustomResourceSet(version string) string {
+	return path.Join(s3ArtifactDirName, s3ScriptsDirName, s3CustomResourcesDirName, s3CustomResourceSetsDir, fmt.Sprintf("%s.json", version))
+}
+
+// IsCustomResourceSet returns true if the key is the path of a set of custom resources.
+func IsCustomResourceSet(key string) bool {
+	dir, file := path.Split(key)
+	return dir == path.Join(s3ArtifactDirName, s3ScriptsDirName, s3CustomResourcesDirName, s3CustomResourceSetsDir)+"/" && path.Ext(file) == ".json"
+}
+
+// DeploymentArtifactPrefixes returns the prefixes of the artifacts that are only read while a stack is being deployed.
+// Unlike env files or previous deployments, these artifacts can be deleted without affecting running tasks.
+// Example: manual/templates/
+func DeploymentArtifactPrefixes() []string {
+	return []string{
+		path.Join(s3ArtifactDirName, s3TemplateDirName) + "/",
+		path.Join(s3ArtifactDirName, s3ArtifactAddonsDirName) + "/",
+		path.Join(s3ArtifactDirName, s3ScriptsDirName, s3CustomResourcesDirName) + "/",
+		path.Join(s3ArtifactDirName, s3RemoteBuildsDirName) + "/",
+	}
+}
+
+// PreviousDeploymentTemplate returns the path to store the template of a stack before it gets updated.
+// Example: manual/previous-deployment/key/template.yml
+func PreviousDeploymentTemplate(key string) string {
+	return path.Join(s3ArtifactDirName, s3PreviousDeploymentDir, key, "template.yml")
+}
+
+// PreviousDeploymentParams returns the path to store the parameters of a stack before it gets updated.
+// Example: manual/previous-deployment/key/params.json
+func PreviousDeploymentParams(key string) string {
+	return path.Join(s3ArtifactDirName, s3PreviousDeploymentDir, key, "params.json")
+}
+
+// LastDeploymentTemplate returns the path to store the template of a stack after it was last seen updated.
+// Example: manual/last-deployment/key/template.yml
+func LastDeploymentTemplate(key string) string {
+	return path.Join(s3ArtifactDirName, s3LastDeploymentDir, key, "template.yml")
+}
+
+// LastDeploymentParams returns the path to store the parameters of a stack after it was last seen updated.
+// Example: manual/last-deployment/key/params.json
+func LastDeploymentParams(key string) string {
+	return path.Join(s3ArtifactDirName, s3LastDeploymentDir, key, "params.json")
+}
+
+// RemoteBuildSource returns the path to store the zipped build context of an image with sha256 of the content.
+// Example: manual/remote-builds/key/sha.zip
+func RemoteBuildSource(key string, zipFile []byte) string {
+	return path.Join(s3ArtifactDirName, s3RemoteBuildsDirName, key, fmt.Sprintf("%x.zip", sha256.Sum256(zipFile)))
+}
@@ -12,3 +12,47 @@ import (
 func TestCustomResource(t *testing.T) {
 	require.Equal(t, "manual/scripts/custom-resources/envcontrollerfunction/e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855.zip", CustomResource("envcontrollerfunction", []byte("")))
 }
+
+func TestCustomResourcesVersion(t *testing.T) {
+	keys := map[string]string{
+		"DNSDelegationFunction":         "manual/scripts/custom-resources/dnsdelegationfunction/1.zip",
+		"CertificateValidationFunction": "manual/scripts/custom-resources/certificatevalidationfunction/2.zip",
+	}
+	version := CustomResourcesVersion(keys)
+	require.Len(t, version, 64)
+	require.Equal(t, version, CustomResourcesVersion(map[string]string{
+		"CertificateValidationFunction": "manual/scripts/custom-resources/certificatevalidationfunction/2.zip",
+		"DNSDelegationFunction":         "manual/scripts/custom-resources/dnsdelegationfunction/1.zip",
+	}), "version must not depend on the order of the functions")
+	require.NotEqual(t, version, CustomResourcesVersion(map[string]string{
+		"DNSDelegationFunction":         "manual/scripts/custom-resources/dnsdelegationfunction/3.zip",
+		"CertificateValidationFunction": "manual/scripts/custom-resources/certificatevalidationfunction/2.zip",
+	}), "version must change with the key of any function")
+	require.Empty(t, CustomResourcesVersion(nil))
+	require.Equal(t, "manual/scripts/custom-resources/sets/"+version+".json", CustomResourceSet(version))
+	require.True(t, IsCustomResourceSet(CustomResourceSet(version)))
+	require.False(t, IsCustomResourceSet("manual/scripts/custom-resources/dnsdelegationfunction/1.zip"))
+}
+
+func TestDeploymentArtifactPrefixes(t *testing.T) {
+	require.Equal(t, []string{
+		"manual/templates/",
+		"manual/addons/",
+		"manual/scripts/custom-resources/",
+		"manual/remote-builds/",
+	}, DeploymentArtifactPrefixes())
+}
+
+func TestPreviousDeployment(t *testing.T) {
+	require.Equal(t, "manual/previous-deployment/phonetool-test/template.yml", PreviousDeploymentTemplate("phonetool-test"))
+	require.Equal(t, "manual/previous-deployment/phonetool-test/params.json", PreviousDeploymentParams("phonetool-test"))
+}
+
+func TestLastDeployment(t *testing.T) {
+	require.Equal(t, "manual/last-deployment/phonetool-test/template.yml", LastDeploymentTemplate("phonetool-test"))
+	require.Equal(t, "manual/last-deployment/phonetool-test/params.json", LastDeploymentParams("phonetool-test"))
+}
+
+func TestRemoteBuildSource(t *testing.T) {
+	require.Equal(t, "manual/remote-builds/frontend/e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855.zip", RemoteBuildSource("frontend", []byte("")))
+}
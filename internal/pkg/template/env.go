@@ -67,6 +67,7 @@ var (
 		"lambdas",
 		"vpc-resources",
 		"nat-gateways",
+		"vpc-attachments",
 		"bootstrap-resources",
 	}
 )
@@ -86,6 +87,8 @@ type EnvOpts struct {
 	EnvName string
 	Version string // The template version to use for the environment. If empty uses the "legacy" template.
 
+	ResourcePrefix string // Optional. Prefix of the physical names of the cluster and IAM roles.
+
 	// Custom Resourced backed by Lambda functions.
 	CustomResources           map[string]S3ObjectLocation
 	DNSDelegationLambda       string
@@ -99,13 +102,19 @@ type EnvOpts struct {
 
 	VPCConfig                VPCConfig
 	PublicImportedCertARNs   []string
+	PublicListeners          []PublicListener // Listeners on the public ALB in addition to the ones on port 80 and 443.
+	PublicMutualTLS          *MutualTLS       // If not-nil, authenticate clients with certificates on the public HTTPS listener.
 	PrivateImportedCertARNs  []string
 	CustomInternalALBSubnets []string
 	AllowVPCIngress          bool
 	Telemetry                *Telemetry
+	ImportedCluster          string // Optional. Name of an existing ECS cluster to use instead of creating one.
+	SharedEFS                bool   // If true, the managed file system is created with the environment and only mountable through access points.
 
 	CDNConfig *CDNConfig // If nil, no cdn is to be used
 
+	ServiceConnect bool // If true, services in the cluster default to the service discovery namespace for Service Connect.
+
 	LatestVersion      string
 	SerializedManifest string // Serialized manifest used to render the environment template.
 }
@@ -114,8 +123,17 @@ type EnvOpts struct {
 type CDNConfig struct{}
 
 type VPCConfig struct {
-	Imported *ImportVPC // If not-nil, use the imported VPC resources instead of the Managed VPC.
-	Managed  ManagedVPC
+	Imported    *ImportVPC // If not-nil, use the imported VPC resources instead of the Managed VPC.
+	Managed     ManagedVPC
+	Attachments []VPCAttachment // Transit gateways and peering connections to route traffic to.
+}
+
+// VPCAttachment holds the fields to route traffic from the subnets of the VPC to other networks.
+// Exactly one of TransitGatewayID and PeeringConnectionID is set.
+type VPCAttachment struct {
+	TransitGatewayID    string
+	PeeringConnectionID string
+	CIDRs               []string
 }
 
 // ImportVPC holds the fields to import VPC resources.
@@ -123,6 +141,11 @@ type ImportVPC struct {
 	ID               string
 	PublicSubnetIDs  []string
 	PrivateSubnetIDs []string
+
+	// Optional existing resources to use instead of creating new ones.
+	SecurityGroupID      string
+	PublicRouteTableID   string
+	PrivateRouteTableIDs []string
 }
 
 // ManagedVPC holds the fields to configure a managed VPC.
@@ -133,6 +156,31 @@ type ManagedVPC struct {
 	PrivateSubnetCIDRs []string
 }
 
+// PublicListener holds the fields to configure an additional listener on the public load balancer.
+type PublicListener struct {
+	Name            string
+	Port            uint16
+	Protocol        string
+	CertificateARNs []string
+	DefaultAction   ListenerDefaultAction
+}
+
+// ListenerDefaultAction holds the action taken by a listener when no rule matches a request.
+// If all fields are empty, then requests are forwarded to the default target group.
+type ListenerDefaultAction struct {
+	FixedResponseStatusCode int
+	RedirectPort            uint16
+	RedirectProtocol        string // If empty, keep the protocol of the original request.
+}
+
+// MutualTLS holds the fields to authenticate clients with certificates on a load balancer listener.
+type MutualTLS struct {
+	Mode                          string // Either "verify" or "passthrough".
+	TrustStoreBucket              string // S3 bucket of the CA certificates bundle, only used in "verify" mode.
+	TrustStoreKey                 string
+	IgnoreClientCertificateExpiry bool
+}
+
 // Telemetry represents optional observability and monitoring configuration.
 type Telemetry struct {
 	EnableContainerInsights bool
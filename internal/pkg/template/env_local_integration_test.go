@@ -5,6 +5,7 @@ package template
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -47,3 +48,20 @@ func TestEnv_AvailableEnvFeatures(t *testing.T) {
 		require.True(t, ok, fmt.Sprintf("should specify a least-required environment template version for the env-controller managed feature %s", paramName))
 	}
 }
+
+func TestEnv_ResourcePrefix(t *testing.T) {
+	c, err := New().ParseEnv(&EnvOpts{
+		ResourcePrefix: "acme-",
+	}, WithFuncs(map[string]interface{}{
+		"inc":      IncFunc,
+		"fmtSlice": FmtSliceFunc,
+	}))
+	require.NoError(t, err)
+
+	roleNames := regexp.MustCompile(`(\S*)\$\{AWS::StackName\}-(CFNExecutionRole|EnvManagerRole)\b`)
+	matches := roleNames.FindAllStringSubmatch(c.String(), -1)
+	require.NotEmpty(t, matches)
+	for _, match := range matches {
+		require.True(t, strings.HasSuffix(match[1], "acme-"), "reference to the role %s should use the resource prefix", match[0])
+	}
+}
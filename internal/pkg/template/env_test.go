@@ -23,6 +23,7 @@ func TestTemplate_ParseEnv(t *testing.T) {
 				"templates/environment/partials/lambdas.yml":                  []byte("lambdas"),
 				"templates/environment/partials/vpc-resources.yml":            []byte("vpc-resources"),
 				"templates/environment/partials/nat-gateways.yml":             []byte("nat-gateways"),
+				"templates/environment/partials/vpc-attachments.yml":          []byte("vpc-attachments"),
 				"templates/environment/partials/bootstrap-resources.yml":      []byte("bootstrap"),
 			},
 		},
@@ -134,6 +134,7 @@ func TestTemplate_ParseLoadBalancedWebService(t *testing.T) {
 		"RulePriorityFunction":        fakeS3Object,
 		"NLBCustomDomainFunction":     fakeS3Object,
 		"NLBCertValidatorFunction":    fakeS3Object,
+		"WAFRateLimitFunction":        fakeS3Object,
 	}
 
 	testCases := map[string]struct {
@@ -329,6 +330,113 @@ DiscoveryServiceArn:
 				CustomResources: customResources,
 			},
 		},
+		"renders a valid template with an additional listener": {
+			opts: template.WorkloadOpts{
+				HTTPHealthCheck:          defaultHttpHealthCheck,
+				ServiceDiscoveryEndpoint: "test.app.local",
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				AllowedSourceIps:   []string{"10.0.1.0/24"},
+				AdditionalListener: "legacy",
+				ALBEnabled:         true,
+				CustomResources:    customResources,
+			},
+		},
+		"renders a valid template with HTTPS targets and a private CA": {
+			opts: template.WorkloadOpts{
+				HTTPHealthCheck:          defaultHttpHealthCheck,
+				ServiceDiscoveryEndpoint: "test.app.local",
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				HTTPTargetProtocol: "HTTPS",
+				PrivateCAARN:       "arn:aws:acm-pca:us-west-2:123456789012:certificate-authority/mock-ca",
+				ALBEnabled:         true,
+				CustomResources:    customResources,
+			},
+		},
+		"renders a valid template with Evidently feature flags": {
+			opts: template.WorkloadOpts{
+				HTTPHealthCheck:          defaultHttpHealthCheck,
+				ServiceDiscoveryEndpoint: "test.app.local",
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				Evidently: &template.EvidentlyOpts{
+					Project: "phonetool-test-frontend",
+					Features: []template.EvidentlyFeature{
+						{
+							Name:        "dark-mode",
+							Description: "Render the site in dark mode.",
+							Enabled:     true,
+						},
+						{
+							Name: "new-checkout",
+						},
+					},
+				},
+				ALBEnabled:      true,
+				CustomResources: customResources,
+			},
+		},
+		"renders a valid template with deployment metadata": {
+			opts: template.WorkloadOpts{
+				SerializedManifest: "name: frontend\ntype: Load Balanced Web Service\n",
+				DeploymentMetadata: &template.DeploymentMetadataOpts{
+					Deployer: "arn:aws:sts::123456789012:assumed-role/Admin/jane",
+					Commit:   "4e1b5c2",
+					Version:  "v1.25.0",
+				},
+				HTTPHealthCheck: defaultHttpHealthCheck,
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				ServiceDiscoveryEndpoint: "test.app.local",
+				ALBEnabled:               true,
+				CustomResources:          customResources,
+			},
+		},
+		"renders a valid template with a rate limit": {
+			opts: template.WorkloadOpts{
+				HTTPHealthCheck: defaultHttpHealthCheck,
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				Aliases:                  []string{"api.example.com"},
+				HTTPSListener:            true,
+				RateLimit:                &template.RateLimitOpts{Limit: 300000},
+				ServiceDiscoveryEndpoint: "test.app.local",
+				ALBEnabled:               true,
+				CustomResources:          customResources,
+			},
+		},
+		"renders a valid template with blue/green deployments": {
+			opts: template.WorkloadOpts{
+				HTTPHealthCheck: defaultHttpHealthCheck,
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				DeploymentConfiguration: template.DeploymentConfigurationOpts{
+					MinHealthyPercent: 100,
+					MaxPercent:        200,
+					BlueGreen: &template.BlueGreenDeploymentOpts{
+						TestListenerPort:     8080,
+						DeploymentConfigName: "CodeDeployDefault.ECSAllAtOnce",
+						RollbackAlarms:       []string{"HighLatency"},
+					},
+				},
+				ServiceDiscoveryEndpoint: "test.app.local",
+				ALBEnabled:               true,
+				CustomResources:          customResources,
+			},
+		},
 		"renders a valid template with Windows platform": {
 			opts: template.WorkloadOpts{
 				HTTPHealthCheck: defaultHttpHealthCheck,
@@ -345,6 +453,60 @@ DiscoveryServiceArn:
 				CustomResources:          customResources,
 			},
 		},
+		"renders a valid template with FSx for Windows File Server volumes": {
+			opts: template.WorkloadOpts{
+				HTTPHealthCheck: defaultHttpHealthCheck,
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				Platform: template.RuntimePlatformOpts{
+					OS:   "windows",
+					Arch: "x86_64",
+				},
+				Storage: &template.StorageOpts{
+					Volumes: []*template.Volume{
+						{
+							Name: aws.String("existing"),
+							FSx: &template.FSxWindowsVolumeConfiguration{
+								Filesystem:           aws.String("fs-1234567890abcdef0"),
+								RootDirectory:        aws.String("share"),
+								Domain:               aws.String("corp.example.com"),
+								CredentialsParameter: aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:fsx-creds"),
+							},
+						},
+						{
+							Name: aws.String("managed"),
+							FSx: &template.FSxWindowsVolumeConfiguration{
+								Domain:               aws.String("corp.example.com"),
+								CredentialsParameter: aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:fsx-creds"),
+							},
+						},
+					},
+					MountPoints: []*template.MountPoint{
+						{
+							ContainerPath: aws.String(`C:\existing`),
+							ReadOnly:      aws.Bool(true),
+							SourceVolume:  aws.String("existing"),
+						},
+						{
+							ContainerPath: aws.String(`C:\managed`),
+							ReadOnly:      aws.Bool(false),
+							SourceVolume:  aws.String("managed"),
+						},
+					},
+					FSxCredentials: []string{"arn:aws:secretsmanager:us-west-2:123456789012:secret:fsx-creds"},
+					ManagedFSxInfo: &template.ManagedFSxCreationInfo{
+						ActiveDirectoryID:  aws.String("d-1234567890"),
+						StorageCapacity:    aws.Int(32),
+						ThroughputCapacity: aws.Int(8),
+					},
+				},
+				ServiceDiscoveryEndpoint: "test.app.local",
+				ALBEnabled:               true,
+				CustomResources:          customResources,
+			},
+		},
 	}
 
 	for name, tc := range testCases {
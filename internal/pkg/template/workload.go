@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"fmt"
 	"strconv"
+	"strings"
 	"text/template"
 
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
@@ -79,6 +80,7 @@ var (
 		"state-machine",
 		"state-machine-definition.json",
 		"efs-access-point",
+		"fsx-file-system",
 		"https-listener",
 		"http-listener",
 		"env-controller",
@@ -88,10 +90,16 @@ var (
 		"instancerole",
 		"accessrole",
 		"publish",
+		"evidently",
 		"subscribe",
 		"nlb",
 		"vpc-connector",
 		"alb",
+		"target-group-properties",
+		"blue-green",
+		"alarms",
+		"rate-limit",
+		"metadata",
 	}
 
 	// Operating systems to determine Fargate platform versions.
@@ -126,6 +134,13 @@ type SidecarOpts struct {
 	EntryPoint   []string
 	Command      []string
 	HealthCheck  *ContainerHealthCheck
+	LogGroup     *SidecarLogGroupOpts
+}
+
+// SidecarLogGroupOpts holds configuration for a sidecar container that writes to its own log group.
+type SidecarLogGroupOpts struct {
+	Name      *string
+	Retention *int
 }
 
 // SidecarStorageOpts holds data structures for rendering Mount Points inside of a sidecar.
@@ -140,6 +155,8 @@ type StorageOpts struct {
 	MountPoints       []*MountPoint
 	EFSPerms          []*EFSPermission
 	ManagedVolumeInfo *ManagedVolumeCreationInfo // Used for delegating CreationInfo for Copilot-managed EFS.
+	FSxCredentials    []string                   // ARNs of the domain credentials for FSx for Windows File Server volumes.
+	ManagedFSxInfo    *ManagedFSxCreationInfo    // Used for creating a Copilot-managed FSx for Windows File Server file system.
 }
 
 // requiresEFSCreation returns true if managed volume information is specified; false otherwise.
@@ -166,6 +183,7 @@ type Volume struct {
 	Name *string
 
 	EFS *EFSVolumeConfiguration
+	FSx *FSxWindowsVolumeConfiguration
 }
 
 // ManagedVolumeCreationInfo holds information about how to create Copilot-managed access points.
@@ -187,6 +205,23 @@ type EFSVolumeConfiguration struct {
 	IAM           *string // ENABLED or DISABLED
 }
 
+// FSxWindowsVolumeConfiguration contains information about how to mount an FSx for Windows File Server file system.
+type FSxWindowsVolumeConfiguration struct {
+	Filesystem    *string // Empty if the file system is managed by Copilot.
+	RootDirectory *string
+
+	// Authorization Config
+	CredentialsParameter *string
+	Domain               *string
+}
+
+// ManagedFSxCreationInfo holds information about how to create a Copilot-managed FSx for Windows File Server file system.
+type ManagedFSxCreationInfo struct {
+	ActiveDirectoryID  *string
+	StorageCapacity    *int
+	ThroughputCapacity *int
+}
+
 // LogConfigOpts holds configuration that's needed if the service is configured with Firelens to route
 // its logs.
 type LogConfigOpts struct {
@@ -199,6 +234,12 @@ type LogConfigOpts struct {
 	Secrets        map[string]Secret
 }
 
+// LogGroupOpts holds configuration for the CloudWatch log group of the workload.
+type LogGroupOpts struct {
+	Name      *string
+	KMSKeyARN *string
+}
+
 // HTTPHealthCheckOpts holds configuration that's needed for HTTP Health Check.
 type HTTPHealthCheckOpts struct {
 	HealthCheckPath     string
@@ -212,6 +253,55 @@ type HTTPHealthCheckOpts struct {
 	GracePeriod         *int64
 }
 
+// Default DNS configuration of the Cloud Map service of a workload.
+const (
+	defaultServiceDiscoveryTTL           = 10
+	defaultServiceDiscoveryRoutingPolicy = "MULTIVALUE"
+	serviceDiscoveryRecordTypeA          = "A"
+	serviceDiscoveryRecordTypeSRV        = "SRV"
+)
+
+// ServiceDiscoveryOpts holds configuration for the DNS records that Cloud Map creates for a service.
+type ServiceDiscoveryOpts struct {
+	TTL           *int64 // Defaults to 10 seconds.
+	RecordType    string // Both A and SRV records are created if empty.
+	RoutingPolicy string // Defaults to MULTIVALUE.
+}
+
+// TTLSeconds returns the TTL of the DNS records in seconds.
+func (o ServiceDiscoveryOpts) TTLSeconds() int64 {
+	if o.TTL == nil {
+		return defaultServiceDiscoveryTTL
+	}
+	return *o.TTL
+}
+
+// RecordTypes returns the types of the DNS records.
+func (o ServiceDiscoveryOpts) RecordTypes() []string {
+	if o.RecordType == "" {
+		return []string{serviceDiscoveryRecordTypeA, serviceDiscoveryRecordTypeSRV}
+	}
+	return []string{o.RecordType}
+}
+
+// JoinedRecordTypes returns the types of the DNS records separated by commas, for example "A,SRV".
+func (o ServiceDiscoveryOpts) JoinedRecordTypes() string {
+	return strings.Join(o.RecordTypes(), ",")
+}
+
+// HasSRVRecord returns true if other services can discover the port of the service from a SRV record.
+func (o ServiceDiscoveryOpts) HasSRVRecord() bool {
+	return o.RecordType == "" || o.RecordType == serviceDiscoveryRecordTypeSRV
+}
+
+// Routing returns the routing policy of the DNS records.
+func (o ServiceDiscoveryOpts) Routing() string {
+	if o.RoutingPolicy == "" {
+		return defaultServiceDiscoveryRoutingPolicy
+	}
+	return o.RoutingPolicy
+}
+
 // A Secret represents an SSM or SecretsManager secret that can be rendered in CloudFormation.
 type Secret interface {
 	RequiresSub() bool
@@ -366,15 +456,59 @@ type DeploymentConfigurationOpts struct {
 	MinHealthyPercent int
 	// The upper limit on the number of tasks that should be running during a service deployment or when a container instance is draining.
 	MaxPercent int
+	// Optional. If set, the service is deployed by CodeDeploy with a blue/green deployment.
+	BlueGreen *BlueGreenDeploymentOpts
+	// Names of the workload alarms that roll back a deployment when they go off.
+	RollbackAlarms []string
+}
+
+// AlarmOpts holds configuration for a CloudWatch alarm on a metric of the service.
+type AlarmOpts struct {
+	Name        string  // Suffix appended to the app, env and workload names to name the alarm.
+	Namespace   string  // Namespace of the metric, such as AWS/ECS.
+	MetricName  string  // Name of the metric, such as CPUUtilization.
+	Statistic   string  // Statistic applied to the metric, such as Average.
+	Threshold   float64 // The alarm goes off when the statistic is greater than the threshold.
+	Period      int64   // Number of seconds over which the statistic is applied.
+	TargetGroup bool    // If true, the metric is published by the load balancer for the target group of the service.
+	Notify      bool    // If true, the alarm publishes to the alarm topic of the service instead of rolling back deployments.
+}
+
+// RateLimitOpts holds configuration for the WAF rule that limits the rate of requests per client IP address.
+type RateLimitOpts struct {
+	Limit int64 // Maximum number of requests from a single IP address in any five-minute window.
+}
+
+// BlueGreenDeploymentOpts holds configuration for deploying a service with CodeDeploy blue/green deployments.
+type BlueGreenDeploymentOpts struct {
+	TestListenerPort     uint16   // Port of the listener that routes test traffic to the replacement tasks.
+	DeploymentConfigName string   // Name of the CodeDeploy deployment configuration that shifts traffic.
+	RollbackAlarms       []string // Names of the CloudWatch alarms that roll back the deployment when they go off.
+	// Optional. If set, traffic is shifted by a custom deployment configuration instead of DeploymentConfigName.
+	TrafficRouting *TrafficRoutingOpts
+}
+
+// Traffic routing types of CodeDeploy deployment configurations.
+const (
+	TrafficRoutingTimeBasedCanary = "TimeBasedCanary"
+	TrafficRoutingTimeBasedLinear = "TimeBasedLinear"
+)
+
+// TrafficRoutingOpts holds the steps in which CodeDeploy shifts traffic to the replacement tasks.
+type TrafficRoutingOpts struct {
+	Type            string // Either TimeBasedCanary or TimeBasedLinear.
+	Percentage      int    // Percentage of the traffic shifted in the first step, or in each step if linear.
+	IntervalMinutes int    // Number of minutes between the steps.
 }
 
 // ExecuteCommandOpts holds configuration that's needed for ECS Execute Command.
 type ExecuteCommandOpts struct{}
 
-// StateMachineOpts holds configuration needed for State Machine retries and timeout.
+// StateMachineOpts holds configuration needed for State Machine retries, timeout and concurrency.
 type StateMachineOpts struct {
-	Timeout *int
-	Retries *int
+	Timeout     *int
+	Retries     *int
+	Concurrency string // One of "allow", "forbid" or "replace". Empty means "allow".
 }
 
 // PublishOpts holds configuration needed if the service has publishers.
@@ -382,9 +516,34 @@ type PublishOpts struct {
 	Topics []*Topic
 }
 
+// EvidentlyOpts holds configuration needed if the service manages feature flags with CloudWatch Evidently.
+type EvidentlyOpts struct {
+	Project  string
+	Features []EvidentlyFeature
+}
+
+// EvidentlyFeature holds configuration of a boolean feature flag in a CloudWatch Evidently project.
+type EvidentlyFeature struct {
+	Name        string
+	Description string
+	Enabled     bool // Whether the "on" variation is served by default.
+}
+
+// HasCustomKMSKeys returns true if any topic is encrypted with a KMS key provided by the user.
+func (p *PublishOpts) HasCustomKMSKeys() bool {
+	for _, t := range p.Topics {
+		if t.KMSKey != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // Topic holds information needed to render a SNSTopic in a container definition.
 type Topic struct {
-	Name *string
+	Name              *string
+	KMSKey            *string
+	AllowedPublishers *AllowedPrincipals
 
 	Region    string
 	Partition string
@@ -410,20 +569,42 @@ func (s *SubscribeOpts) HasTopicQueues() bool {
 	return false
 }
 
+// HasCustomKMSKeys returns true if any queue is encrypted with a KMS key provided by the user.
+func (s *SubscribeOpts) HasCustomKMSKeys() bool {
+	if s.Queue != nil && s.Queue.KMSKey != nil {
+		return true
+	}
+	for _, t := range s.Topics {
+		if t.Queue != nil && t.Queue.KMSKey != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // TopicSubscription holds information needed to render a SNS Topic Subscription in a container definition.
 type TopicSubscription struct {
-	Name         *string
-	Service      *string
-	FilterPolicy *string
-	Queue        *SQSQueue
+	Name               *string
+	Service            *string
+	FilterPolicy       *string
+	RawMessageDelivery bool
+	Queue              *SQSQueue
 }
 
 // SQSQueue holds information needed to render a SQS Queue in a container definition.
 type SQSQueue struct {
-	Retention  *int64
-	Delay      *int64
-	Timeout    *int64
-	DeadLetter *DeadLetterQueue
+	Retention         *int64
+	Delay             *int64
+	Timeout           *int64
+	DeadLetter        *DeadLetterQueue
+	KMSKey            *string
+	AllowedPublishers *AllowedPrincipals
+}
+
+// AllowedPrincipals holds the principals outside of the service that can publish messages to a topic or queue.
+type AllowedPrincipals struct {
+	ARNs   []string
+	OrgIDs []string
 }
 
 // DeadLetterQueue holds information needed to render a dead-letter SQS Queue in a container definition.
@@ -443,8 +624,9 @@ type NetworkOpts struct {
 
 // RuntimePlatformOpts holds configuration needed for Platform configuration.
 type RuntimePlatformOpts struct {
-	OS   string
-	Arch string
+	OS            string
+	Arch          string
+	PinnedVersion string // Optional. Fargate platform version pinned in the manifest.
 }
 
 // IsDefault returns true if the platform matches the default docker image platform of "linux/amd64".
@@ -458,8 +640,11 @@ func (p RuntimePlatformOpts) IsDefault() bool {
 	return false
 }
 
-// Version returns the Fargate platform version based on the selected os family.
+// Version returns the Fargate platform version pinned in the manifest, or the one based on the selected os family.
 func (p RuntimePlatformOpts) Version() string {
+	if p.PinnedVersion != "" {
+		return p.PinnedVersion
+	}
 	for _, os := range osFamiliesForPV100 {
 		if p.OS == os {
 			return "1.0.0"
@@ -478,12 +663,21 @@ type S3ObjectLocation struct {
 	Key    string // Key of the object.
 }
 
+// DeploymentMetadataOpts holds the details of a deployment that are recorded in the metadata of the workload template.
+type DeploymentMetadataOpts struct {
+	Deployer string // ARN of the identity that deployed the workload.
+	Commit   string // Optional. Git commit of the workspace that the workload was deployed from.
+	Version  string // Version of Copilot that generated the template.
+}
+
 // WorkloadOpts holds optional data that can be provided to enable features in a workload stack template.
 type WorkloadOpts struct {
 	AppName            string
 	EnvName            string
 	WorkloadName       string
-	SerializedManifest string // Raw manifest file used to deploy the workload.
+	ResourcePrefix     string                  // Optional. Prefix of the physical names of the environment's IAM roles.
+	SerializedManifest string                  // Raw manifest file used to deploy the workload.
+	DeploymentMetadata *DeploymentMetadataOpts // Optional. Who deployed the workload and from which source.
 
 	// Additional options that are common between **all** workload templates.
 	Variables                map[string]string
@@ -496,6 +690,7 @@ type WorkloadOpts struct {
 	AddonsExtraParams        string                   // Additional user defined Parameters for the addons stack.
 	Sidecars                 []*SidecarOpts
 	LogConfig                *LogConfigOpts
+	LogGroup                 LogGroupOpts
 	Autoscaling              *AutoscalingOpts
 	CapacityProviders        []*CapacityProviderStrategy
 	DesiredCountOnSpot       *int
@@ -509,6 +704,7 @@ type WorkloadOpts struct {
 	DockerLabels             map[string]string
 	DependsOn                map[string]string
 	Publish                  *PublishOpts
+	Evidently                *EvidentlyOpts
 	ServiceDiscoveryEndpoint string
 	HTTPVersion              *string
 	ALBEnabled               bool
@@ -521,14 +717,21 @@ type WorkloadOpts struct {
 	HTTPHealthCheck         HTTPHealthCheckOpts
 	DeregistrationDelay     *int64
 	AllowedSourceIps        []string
+	AdditionalListener      string // Name of an additional listener on the public load balancer to route traffic from.
+	HTTPTargetProtocol      string // Protocol used by the load balancer to route traffic to the tasks, defaults to HTTP.
+	PrivateCAARN            string // ARN of the ACM Private CA from which tasks can issue certificates.
+	RateLimit               *RateLimitOpts
 	NLB                     *NetworkLoadBalancer
 	DeploymentConfiguration DeploymentConfigurationOpts
+	Alarms                  []AlarmOpts
+	ServiceDiscovery        ServiceDiscoveryOpts
 
 	// Custom Resources backed by Lambda functions.
 	CustomResources map[string]S3ObjectLocation
 
 	// Additional options for job templates.
 	ScheduleExpression string
+	ScheduleTimezone   string // IANA time zone name. When set, the job is triggered by EventBridge Scheduler.
 	StateMachine       *StateMachineOpts
 
 	// Additional options for request driven web service templates.
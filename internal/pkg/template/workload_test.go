@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
 )
 
@@ -46,6 +47,7 @@ func TestTemplate_ParseSvc(t *testing.T) {
 					"templates/workloads/partials/cf/eventrule.yml":                       []byte("eventrule"),
 					"templates/workloads/partials/cf/state-machine.yml":                   []byte("state-machine"),
 					"templates/workloads/partials/cf/efs-access-point.yml":                []byte("efs-access-point"),
+					"templates/workloads/partials/cf/fsx-file-system.yml":                 []byte("fsx-file-system"),
 					"templates/workloads/partials/cf/https-listener.yml":                  []byte("https-listener"),
 					"templates/workloads/partials/cf/http-listener.yml":                   []byte("http-listener"),
 					"templates/workloads/partials/cf/env-controller.yml":                  []byte("env-controller"),
@@ -55,10 +57,16 @@ func TestTemplate_ParseSvc(t *testing.T) {
 					"templates/workloads/partials/cf/instancerole.yml":                    []byte("instancerole"),
 					"templates/workloads/partials/cf/accessrole.yml":                      []byte("accessrole"),
 					"templates/workloads/partials/cf/publish.yml":                         []byte("publish"),
+					"templates/workloads/partials/cf/evidently.yml":                       []byte("evidently"),
 					"templates/workloads/partials/cf/subscribe.yml":                       []byte("subscribe"),
 					"templates/workloads/partials/cf/nlb.yml":                             []byte("nlb"),
 					"templates/workloads/partials/cf/vpc-connector.yml":                   []byte("vpc-connector"),
 					"templates/workloads/partials/cf/alb.yml":                             []byte("alb"),
+					"templates/workloads/partials/cf/target-group-properties.yml":         []byte("target-group-properties"),
+					"templates/workloads/partials/cf/blue-green.yml":                      []byte("blue-green"),
+					"templates/workloads/partials/cf/alarms.yml":                          []byte("alarms"),
+					"templates/workloads/partials/cf/rate-limit.yml":                      []byte("rate-limit"),
+					"templates/workloads/partials/cf/metadata.yml":                        []byte("metadata"),
 				}
 			},
 			wantedContent: `  loggroup
@@ -79,6 +87,7 @@ func TestTemplate_ParseSvc(t *testing.T) {
   state-machine
   state-machine-definition
   efs-access-point
+  fsx-file-system
   https-listener
   http-listener
   env-controller
@@ -88,10 +97,16 @@ func TestTemplate_ParseSvc(t *testing.T) {
   instancerole
   accessrole
   publish
+  evidently
   subscribe
   nlb
   vpc-connector
   alb
+  target-group-properties
+  blue-green
+  alarms
+  rate-limit
+  metadata
 `,
 		},
 	}
@@ -178,6 +193,14 @@ func TestRuntimePlatformOpts_Version(t *testing.T) {
 			},
 			wantedPV: "1.0.0",
 		},
+		"should return the pinned platform version": {
+			in: RuntimePlatformOpts{
+				OS:            "LINUX",
+				Arch:          "X86_64",
+				PinnedVersion: "1.3.0",
+			},
+			wantedPV: "1.3.0",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -217,6 +240,44 @@ func TestRuntimePlatformOpts_IsDefault(t *testing.T) {
 	}
 }
 
+func TestSubscribeOpts_HasCustomKMSKeys(t *testing.T) {
+	key := "alias/orders"
+	testCases := map[string]struct {
+		in     SubscribeOpts
+		wanted bool
+	}{
+		"should return false if no queue is configured": {
+			in: SubscribeOpts{
+				Topics: []*TopicSubscription{{}},
+			},
+		},
+		"should return false if queues are encrypted with the default key": {
+			in: SubscribeOpts{
+				Topics: []*TopicSubscription{{Queue: &SQSQueue{}}},
+				Queue:  &SQSQueue{},
+			},
+		},
+		"should return true if the default queue has a custom key": {
+			in: SubscribeOpts{
+				Queue: &SQSQueue{KMSKey: &key},
+			},
+			wanted: true,
+		},
+		"should return true if a topic queue has a custom key": {
+			in: SubscribeOpts{
+				Topics: []*TopicSubscription{{}, {Queue: &SQSQueue{KMSKey: &key}}},
+			},
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.HasCustomKMSKeys())
+		})
+	}
+}
+
 func TestSsmOrSecretARN_RequiresSub(t *testing.T) {
 	require.False(t, ssmOrSecretARN{}.RequiresSub(), "SSM Parameter Store or secret ARNs do not require !Sub")
 }
@@ -236,3 +297,27 @@ func TestSecretsManagerName_Service(t *testing.T) {
 func TestSecretsManagerName_ValueFrom(t *testing.T) {
 	require.Equal(t, "secret:aes128-1a2b3c", SecretFromSecretsManager("aes128-1a2b3c").ValueFrom())
 }
+
+func TestServiceDiscoveryOpts(t *testing.T) {
+	t.Run("defaults to A and SRV records with a multivalue routing policy", func(t *testing.T) {
+		opts := ServiceDiscoveryOpts{}
+
+		require.Equal(t, int64(10), opts.TTLSeconds())
+		require.Equal(t, []string{"A", "SRV"}, opts.RecordTypes())
+		require.Equal(t, "A,SRV", opts.JoinedRecordTypes())
+		require.True(t, opts.HasSRVRecord())
+		require.Equal(t, "MULTIVALUE", opts.Routing())
+	})
+	t.Run("only A records", func(t *testing.T) {
+		opts := ServiceDiscoveryOpts{
+			TTL:           aws.Int64(60),
+			RecordType:    "A",
+			RoutingPolicy: "WEIGHTED",
+		}
+
+		require.Equal(t, int64(60), opts.TTLSeconds())
+		require.Equal(t, []string{"A"}, opts.RecordTypes())
+		require.False(t, opts.HasSRVRecord())
+		require.Equal(t, "WEIGHTED", opts.Routing())
+	})
+}
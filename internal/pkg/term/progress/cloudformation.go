@@ -35,6 +35,13 @@ type ECSServiceRendererOpts struct {
 	RenderOpts RenderOptions
 }
 
+// NestedStackRendererOpts is optional configuration for a listening nested stack renderer.
+type NestedStackRendererOpts struct {
+	Group      *errgroup.Group
+	Ctx        context.Context
+	RenderOpts RenderOptions
+}
+
 // ListeningChangeSetRenderer returns a component that listens for CloudFormation
 // resource events from a stack mutated with a changeSet until the streamer stops.
 func ListeningChangeSetRenderer(streamer StackSubscriber, stackName, description string, changes []Renderer, opts RenderOptions) DynamicRenderer {
@@ -87,6 +94,37 @@ func ListeningECSServiceResourceRenderer(streamer StackSubscriber, ecsDescriber
 	return comp
 }
 
+// ListeningNestedStackRenderer is a ListeningResourceRenderer for a nested stack resource,
+// followed by every resource of the nested stack as its events stream in.
+// Unlike the resources of the parent stack, the resources of the nested stack don't need a description
+// to be rendered, they are listed by their logical ID.
+func ListeningNestedStackRenderer(streamer StackSubscriber, cfnDescriber stream.StackEventsDescriber, logicalID, description string, opts NestedStackRendererOpts) DynamicRenderer {
+	g := new(errgroup.Group)
+	ctx := context.Background()
+	if opts.Group != nil {
+		g = opts.Group
+	}
+	if opts.Ctx != nil {
+		ctx = opts.Ctx
+	}
+	comp := &nestedStackResourceComponent{
+		cfnStream:    streamer.Subscribe(),
+		cfnDescriber: cfnDescriber,
+		logicalID:    logicalID,
+
+		group:      g,
+		ctx:        ctx,
+		renderOpts: opts.RenderOpts,
+		resourceRenderer: ListeningResourceRenderer(streamer, logicalID, description, ResourceRendererOpts{
+			RenderOpts: opts.RenderOpts,
+		}),
+		done: make(chan struct{}),
+	}
+	comp.newNestedStackRender = comp.newListeningNestedStackRenderer
+	go comp.Listen()
+	return comp
+}
+
 // regularResourceComponent can display a simple CloudFormation stack resource event.
 type regularResourceComponent struct {
 	logicalID   string        // The LogicalID defined in the template for the resource.
@@ -155,7 +193,8 @@ type stackComponent struct {
 	resourceDescriptions map[string]string
 
 	// Optional inputs.
-	renderOpts RenderOptions
+	renderOpts       RenderOptions
+	showAllResources bool // Render the resources without a description by their logical ID.
 
 	// Sub-components.
 	resources     []Renderer
@@ -190,7 +229,7 @@ func listeningStackComponent(streamer StackSubscriber, stackName, description st
 }
 
 // Listen consumes stack events from the stream.
-// On new resource events, if the resource's LogicalID has a description
+// On new resource events, if the resource's LogicalID has a description or all resources are shown,
 // then the resource is added to the list of sub-components to render.
 func (c *stackComponent) Listen() {
 	for ev := range c.cfnStream {
@@ -202,7 +241,10 @@ func (c *stackComponent) Listen() {
 
 		description, ok := c.resourceDescriptions[logicalID]
 		if !ok {
-			continue
+			if !c.showAllResources {
+				continue
+			}
+			description = logicalID
 		}
 		c.addRenderer(ev, description)
 	}
@@ -334,6 +376,109 @@ func (c *ecsServiceResourceComponent) newListeningRollingUpdateRenderer(serviceA
 	return renderer
 }
 
+// nestedStackResourceComponent can display a nested stack created with CloudFormation along with its resources.
+type nestedStackResourceComponent struct {
+	// Required inputs.
+	cfnStream    <-chan stream.StackEvent    // Subscribed stream of the parent stack to initialize the nestedRenderer.
+	cfnDescriber stream.StackEventsDescriber // Client needed to stream the events of the nested stack.
+	logicalID    string                      // LogicalID for the nested stack in the parent stack.
+
+	// Optional inputs.
+	group      *errgroup.Group // Existing group to catch StackStreamer errors.
+	ctx        context.Context // Context for the StackStreamer.
+	renderOpts RenderOptions
+
+	// Sub-components.
+	resourceRenderer DynamicRenderer
+	nestedRenderer   Renderer
+
+	done                 chan struct{}
+	mu                   sync.Mutex
+	newNestedStackRender func(string, time.Time) DynamicRenderer // Overriden in tests.
+}
+
+// Listen creates a nestedRenderer the first time the nested stack is created or updated.
+// It closes the Done channel if the CFN resource is Done and the nestedRenderer is also Done.
+func (c *nestedStackResourceComponent) Listen() {
+	renderers := []DynamicRenderer{c.resourceRenderer}
+	for ev := range c.cfnStream {
+		if c.logicalID != ev.LogicalResourceID {
+			continue
+		}
+		if !cloudformation.StackStatus(ev.ResourceStatus).UpsertInProgress() || ev.PhysicalResourceID == "" {
+			// New nested stacks receive two "CREATE_IN_PROGRESS" events, only the second one has the stack ID.
+			continue
+		}
+		c.mu.Lock()
+		started := c.nestedRenderer != nil
+		c.mu.Unlock()
+		if started {
+			continue
+		}
+		renderer := c.newNestedStackRender(ev.PhysicalResourceID, ev.Timestamp)
+		c.mu.Lock()
+		c.nestedRenderer = renderer
+		c.mu.Unlock()
+		renderers = append(renderers, renderer)
+	}
+
+	// Close the done channel once all the renderers are done listening.
+	for _, r := range renderers {
+		<-r.Done()
+	}
+	close(c.done)
+}
+
+// Render writes the status of the CloudFormation nested stack resource, followed with the status of each of its resources.
+func (c *nestedStackResourceComponent) Render(out io.Writer) (numLines int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	renderers := []Renderer{c.resourceRenderer}
+	if c.nestedRenderer != nil {
+		renderers = append(renderers, c.nestedRenderer)
+	}
+	return renderComponents(out, renderers)
+}
+
+// Done returns a channel that's closed when there are no more events to Listen.
+func (c *nestedStackResourceComponent) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *nestedStackResourceComponent) newListeningNestedStackRenderer(stackID string, startTime time.Time) DynamicRenderer {
+	stackName := parseStackARN(stackID)
+	streamer := stream.NewStackStreamer(c.cfnDescriber, stackName, startTime)
+	comp := &stackComponent{
+		cfnStream:        streamer.Subscribe(),
+		stack:            streamer,
+		renderOpts:       c.renderOpts,
+		showAllResources: true,
+		seenResources: map[string]bool{
+			// The nested stack is already rendered by the resourceRenderer.
+			stackName: true,
+		},
+		done: make(chan struct{}),
+	}
+	comp.addRenderer = comp.addResourceRenderer
+	go comp.Listen()
+	c.group.Go(func() error {
+		return stream.Stream(c.ctx, streamer)
+	})
+	return comp
+}
+
+// parseStackARN returns the name of a stack given its ID.
+// For example: arn:aws:cloudformation:us-west-2:1111:stack/phonetool-test-api-AddonsStack-1FOO/abcd returns
+// "phonetool-test-api-AddonsStack-1FOO".
+func parseStackARN(stackID string) string {
+	parts := strings.Split(stackID, "/")
+	if len(parts) < 2 {
+		return stackID
+	}
+	return parts[1]
+}
+
 func updateComponentStatus(mu *sync.Mutex, statuses *[]stackStatus, event stream.StackEvent) {
 	mu.Lock()
 	defer mu.Unlock()
@@ -90,6 +90,50 @@ func TestStackComponent_Listen(t *testing.T) {
 	require.Equal(t, wantedRenderers, actualRenderers)
 }
 
+func TestStackComponent_ListenShowAllResources(t *testing.T) {
+	// GIVEN
+	ch := make(chan stream.StackEvent)
+	done := make(chan struct{})
+	var actualDescriptions []string
+	comp := &stackComponent{
+		cfnStream: ch,
+		resourceDescriptions: map[string]string{
+			"Table": "dynamodb table",
+		},
+		showAllResources: true,
+		seenResources: map[string]bool{
+			"phonetool-test-api-AddonsStack-1FOO": true,
+		},
+		done: done,
+		addRenderer: func(_ stream.StackEvent, description string) {
+			actualDescriptions = append(actualDescriptions, description)
+		},
+	}
+
+	// WHEN
+	go comp.Listen()
+	go func() {
+		// Should not create a renderer for a resource that was already seen.
+		ch <- stream.StackEvent{
+			LogicalResourceID: "phonetool-test-api-AddonsStack-1FOO",
+			ResourceStatus:    "CREATE_IN_PROGRESS",
+		}
+		ch <- stream.StackEvent{
+			LogicalResourceID: "Table",
+			ResourceStatus:    "CREATE_IN_PROGRESS",
+		}
+		ch <- stream.StackEvent{
+			LogicalResourceID: "Bucket",
+			ResourceStatus:    "CREATE_IN_PROGRESS",
+		}
+		close(ch)
+	}()
+
+	// THEN
+	<-done
+	require.Equal(t, []string{"dynamodb table", "Bucket"}, actualDescriptions, "expected resources without a description to use their logical ID")
+}
+
 func TestStackComponent_Render(t *testing.T) {
 	// GIVEN
 	comp := &stackComponent{
@@ -502,3 +546,166 @@ func TestEcsServiceResourceComponent_Render(t *testing.T) {
 			"deployment\t\t\n", buf.String())
 	})
 }
+
+func TestNestedStackResourceComponent_Listen(t *testing.T) {
+	t.Run("should create a nested stack renderer once the nested stack ID is known", func(t *testing.T) {
+		// GIVEN
+		ch := make(chan stream.StackEvent)
+		nestedDone := make(chan struct{})
+		resourceDone := make(chan struct{})
+		var numNestedRenderers int
+		var actualStackID string
+		c := &nestedStackResourceComponent{
+			cfnStream: ch,
+			logicalID: "AddonsStack",
+			group:     new(errgroup.Group),
+			ctx:       context.Background(),
+			done:      make(chan struct{}),
+			resourceRenderer: &mockDynamicRenderer{
+				done: resourceDone,
+			},
+			newNestedStackRender: func(stackID string, t time.Time) DynamicRenderer {
+				numNestedRenderers += 1
+				actualStackID = stackID
+				return &mockDynamicRenderer{
+					done: nestedDone,
+				}
+			},
+		}
+
+		// WHEN
+		go c.Listen()
+		go func() {
+			ch <- stream.StackEvent{
+				LogicalResourceID:  "AddonsStack",
+				PhysicalResourceID: "",
+				ResourceStatus:     "CREATE_IN_PROGRESS",
+			}
+			ch <- stream.StackEvent{
+				LogicalResourceID:  "AddonsStack",
+				PhysicalResourceID: "arn:aws:cloudformation:us-west-2:1111:stack/phonetool-test-api-AddonsStack-1FOO/abcd",
+				ResourceStatus:     "CREATE_IN_PROGRESS",
+			}
+			// Should not create another nested stack renderer.
+			ch <- stream.StackEvent{
+				LogicalResourceID:  "AddonsStack",
+				PhysicalResourceID: "arn:aws:cloudformation:us-west-2:1111:stack/phonetool-test-api-AddonsStack-1FOO/abcd",
+				ResourceStatus:     "UPDATE_IN_PROGRESS",
+			}
+			// Close channels to notify that the nested stack is done.
+			close(nestedDone)
+			close(resourceDone)
+			close(ch)
+		}()
+
+		// THEN
+		<-c.done // Wait for listen to exit.
+		require.NotNil(t, c.nestedRenderer, "expected the nested stack renderer to be initialized")
+		require.Equal(t, 1, numNestedRenderers)
+		require.Equal(t, "arn:aws:cloudformation:us-west-2:1111:stack/phonetool-test-api-AddonsStack-1FOO/abcd", actualStackID)
+	})
+	t.Run("should not create a nested stack renderer if the nested stack never goes in create or update in progress", func(t *testing.T) {
+		// GIVEN
+		ch := make(chan stream.StackEvent)
+		resourceDone := make(chan struct{})
+		c := &nestedStackResourceComponent{
+			cfnStream: ch,
+			logicalID: "AddonsStack",
+			group:     new(errgroup.Group),
+			ctx:       context.Background(),
+			done:      make(chan struct{}),
+			resourceRenderer: &mockDynamicRenderer{
+				done: resourceDone,
+			},
+			newNestedStackRender: func(s string, t time.Time) DynamicRenderer {
+				return &mockDynamicRenderer{
+					done: make(chan struct{}),
+				}
+			},
+		}
+
+		// WHEN
+		go c.Listen()
+		go func() {
+			ch <- stream.StackEvent{
+				LogicalResourceID:  "AddonsStack",
+				PhysicalResourceID: "arn:aws:cloudformation:us-west-2:1111:stack/phonetool-test-api-AddonsStack-1FOO/abcd",
+				ResourceStatus:     "DELETE_IN_PROGRESS",
+			}
+			ch <- stream.StackEvent{
+				LogicalResourceID:  "AddonsStack",
+				PhysicalResourceID: "arn:aws:cloudformation:us-west-2:1111:stack/phonetool-test-api-AddonsStack-1FOO/abcd",
+				ResourceStatus:     "DELETE_COMPLETE",
+			}
+			close(resourceDone)
+			close(ch)
+		}()
+
+		// THEN
+		<-c.done // Wait for listen to exit.
+		require.Nil(t, c.nestedRenderer, "expected the nested stack renderer to be nil")
+	})
+}
+
+func TestNestedStackResourceComponent_Render(t *testing.T) {
+	t.Run("renders only the resource renderer if the nested stack hasn't started", func(t *testing.T) {
+		// GIVEN
+		buf := new(strings.Builder)
+		c := &nestedStackResourceComponent{
+			resourceRenderer: &mockDynamicRenderer{
+				content: "addons\n",
+			},
+		}
+
+		// WHEN
+		nl, err := c.Render(buf)
+
+		// THEN
+		require.Nil(t, err)
+		require.Equal(t, 1, nl)
+		require.Equal(t, "addons\n", buf.String())
+	})
+	t.Run("renders both the resource and the nested stack resources", func(t *testing.T) {
+		// GIVEN
+		buf := new(strings.Builder)
+		c := &nestedStackResourceComponent{
+			resourceRenderer: &mockDynamicRenderer{
+				content: "addons\n",
+			},
+			nestedRenderer: &mockDynamicRenderer{
+				content: "  table\n",
+			},
+		}
+
+		// WHEN
+		nl, err := c.Render(buf)
+
+		// THEN
+		require.Nil(t, err)
+		require.Equal(t, 2, nl)
+		require.Equal(t, "addons\n"+
+			"  table\n", buf.String())
+	})
+}
+
+func TestParseStackARN(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		wanted string
+	}{
+		"returns the stack name of a stack ARN": {
+			in:     "arn:aws:cloudformation:us-west-2:1111:stack/phonetool-test-api-AddonsStack-1FOO/abcd",
+			wanted: "phonetool-test-api-AddonsStack-1FOO",
+		},
+		"returns the input if it's not an ARN": {
+			in:     "phonetool-test-api-AddonsStack-1FOO",
+			wanted: "phonetool-test-api-AddonsStack-1FOO",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, parseStackARN(tc.in))
+		})
+	}
+}
@@ -5,6 +5,9 @@ package progress
 
 import (
 	"io"
+	"strconv"
+	"strings"
+	"sync"
 	"text/tabwriter"
 )
 
@@ -69,3 +72,111 @@ func (w *suffixWriter) Write(p []byte) (n int, err error) {
 	}
 	return len(p), nil
 }
+
+// FrameWriter is a FileWriter that keeps the text that would remain on a terminal once the components
+// rendered to it are done, so that the progress of an operation running in the background can be printed at once.
+// Cursor movements and erased lines are applied to the buffered text instead of being written as escape sequences.
+type FrameWriter struct {
+	mu        sync.Mutex
+	lines     [][]byte
+	row       int
+	overwrite bool // True after a carriage return, the next text replaces the current line.
+}
+
+// NewFrameWriter returns an empty FrameWriter.
+func NewFrameWriter() *FrameWriter {
+	return &FrameWriter{}
+}
+
+// Write applies p to the buffered lines.
+func (w *FrameWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for i := 0; i < len(p); i++ {
+		switch b := p[i]; b {
+		case '\x1b':
+			i += w.applyEscapeSequence(p[i:])
+		case '\r':
+			w.overwrite = true
+		case '\n':
+			w.line()
+			w.row += 1
+			w.overwrite = false
+		default:
+			w.print([]byte{b})
+		}
+	}
+	return len(p), nil
+}
+
+// Fd returns an invalid file descriptor, so that the writer is never mistaken for a terminal.
+func (w *FrameWriter) Fd() uintptr {
+	return ^uintptr(0)
+}
+
+// String returns the buffered lines, without the trailing empty ones.
+func (w *FrameWriter) String() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var lines []string
+	for _, line := range w.lines {
+		lines = append(lines, string(line))
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// applyEscapeSequence applies the control sequence at the start of p and returns the number of bytes consumed after the escape character.
+// Colors are kept as is, cursor moves up and line erasures are applied, and any other sequence is dropped.
+func (w *FrameWriter) applyEscapeSequence(p []byte) int {
+	if len(p) < 2 || p[1] != '[' {
+		return 0
+	}
+	end := 2
+	for end < len(p) && (p[end] < 0x40 || p[end] > 0x7e) {
+		end++
+	}
+	if end == len(p) {
+		return len(p) - 1
+	}
+	params := string(p[2:end])
+	switch p[end] {
+	case 'm':
+		w.print(p[:end+1])
+	case 'A', 'F':
+		n, err := strconv.Atoi(params)
+		if err != nil || n < 1 {
+			n = 1
+		}
+		w.row -= n
+		if w.row < 0 {
+			w.row = 0
+		}
+	case 'K':
+		w.line()
+		w.lines[w.row] = w.lines[w.row][:0]
+	}
+	return end
+}
+
+// print appends text to the current line, or replaces the line after a carriage return.
+func (w *FrameWriter) print(text []byte) {
+	w.line()
+	if w.overwrite {
+		w.lines[w.row] = w.lines[w.row][:0]
+		w.overwrite = false
+	}
+	w.lines[w.row] = append(w.lines[w.row], text...)
+}
+
+// line makes sure that the current line exists.
+func (w *FrameWriter) line() {
+	for len(w.lines) <= w.row {
+		w.lines = append(w.lines, nil)
+	}
+}
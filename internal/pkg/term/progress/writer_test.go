@@ -48,3 +48,64 @@ on Amazon ECS and AWS Fargate.
 		})
 	}
 }
+
+func TestFrameWriter_Write(t *testing.T) {
+	testCases := map[string]struct {
+		inWrites []string
+
+		wantedText string
+	}{
+		"keeps plain lines": {
+			inWrites: []string{"Building image\n", "Pushing image\n"},
+
+			wantedText: "Building image\nPushing image\n",
+		},
+		"keeps only the last render of a component": {
+			inWrites: []string{
+				"- Creating stack\n  - Service [in progress]\n",
+				"\x1b[2K\x1b[1A\x1b[2K\x1b[1A\x1b[2K",
+				"- Creating stack\n  - Service [complete]\n",
+			},
+
+			wantedText: "- Creating stack\n  - Service [complete]\n",
+		},
+		"replaces the spinner with its final message": {
+			inWrites: []string{
+				"\x1b[?25l",
+				"\r\x1b[K⠋ Uploading",
+				"\r\x1b[K⠙ Uploading",
+				"\x1b[?25h",
+				"\r\x1b[K",
+				"✔ Uploaded\n",
+			},
+
+			wantedText: "✔ Uploaded\n",
+		},
+		"keeps colors": {
+			inWrites: []string{"\x1b[32m✔\x1b[0m Done\n"},
+
+			wantedText: "\x1b[32m✔\x1b[0m Done\n",
+		},
+		"drops trailing empty lines": {
+			inWrites: []string{"Done\n\n\n"},
+
+			wantedText: "Done\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			w := NewFrameWriter()
+
+			// WHEN
+			for _, text := range tc.inWrites {
+				_, err := w.Write([]byte(text))
+				require.NoError(t, err)
+			}
+
+			// THEN
+			require.Equal(t, tc.wantedText, w.String())
+		})
+	}
+}
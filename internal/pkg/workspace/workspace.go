@@ -8,7 +8,7 @@
 //  ├── copilot                        (application directory)
 //  │   ├── .workspace                 (workspace summary)
 //  │   ├── my-service
-//  │   │   └── manifest.yml           (service manifest)
+//  │   │   └── manifest.yml           (service manifest, or a manifest.cue or manifest.jsonnet file that renders to it)
 //  |   |   environments
 //  |   |   └── test
 //  │   │       └── manifest.yml       (environment manifest for the environment test)
@@ -33,6 +33,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/render"
 	"github.com/spf13/afero"
 	"gopkg.in/yaml.v3"
 )
@@ -46,9 +47,12 @@ const (
 	addonsDirName             = "addons"
 	pipelinesDirName          = "pipelines"
 	environmentsDirName       = "environments"
+	guardRulesDirName         = ".guard"
 	maximumParentDirsToSearch = 5
 	legacyPipelineFileName    = "pipeline.yml"
 	manifestFileName          = "manifest.yml"
+	cueManifestFileName       = "manifest" + render.CUEFileExtension
+	jsonnetManifestFileName   = "manifest" + render.JsonnetFileExtension
 	buildspecFileName         = "buildspec.yml"
 
 	ymlFileExtension = ".yml"
@@ -64,12 +68,20 @@ type Summary struct {
 	Path string // absolute path to the summary file.
 }
 
+// workloadManifestFileNames are the names of the files that a workload's manifest can be read from.
+var workloadManifestFileNames = []string{manifestFileName, cueManifestFileName, jsonnetManifestFileName}
+
+type manifestRenderer interface {
+	Render(path string) ([]byte, error)
+}
+
 // Workspace typically represents a Git repository where the user has its infrastructure-as-code files as well as source files.
 type Workspace struct {
 	workingDir string
 	copilotDir string
 	fs         *afero.Afero
 	logger     func(format string, args ...interface{})
+	renderer   manifestRenderer
 }
 
 // New returns a workspace, used for reading and writing to user's local workspace.
@@ -86,6 +98,7 @@ func New() (*Workspace, error) {
 		workingDir: workingDir,
 		fs:         fsUtils,
 		logger:     logger,
+		renderer:   render.New(),
 	}
 
 	return &ws, nil
@@ -257,9 +270,13 @@ func (ws *Workspace) listWorkloads(match func(string) bool) ([]string, error) {
 		if !f.IsDir() {
 			continue
 		}
-		if exists, _ := ws.fs.Exists(filepath.Join(copilotPath, f.Name(), manifestFileName)); !exists {
-			// Swallow the error because we don't want to include any services that we don't have permissions to read.
-			continue
+		if _, err := ws.workloadManifestFileName(copilotPath, f.Name()); err != nil {
+			var errNotExist *ErrFileNotExists
+			if errors.As(err, &errNotExist) {
+				// Swallow the error because we don't want to include any services that we don't have permissions to read.
+				continue
+			}
+			return nil, err
 		}
 		manifestBytes, err := ws.ReadWorkloadManifest(f.Name())
 		if err != nil {
@@ -302,8 +319,22 @@ func (ws *Workspace) ListEnvironments() ([]string, error) {
 }
 
 // ReadWorkloadManifest returns the contents of the workload's manifest under copilot/{name}/manifest.yml.
+// If the workload has a manifest.cue or manifest.jsonnet file instead, it returns the YAML document that the file renders to.
 func (ws *Workspace) ReadWorkloadManifest(mftDirName string) (WorkloadManifest, error) {
-	raw, err := ws.read(mftDirName, manifestFileName)
+	copilotPath, err := ws.copilotDirPath()
+	if err != nil {
+		return nil, err
+	}
+	fname, err := ws.workloadManifestFileName(copilotPath, mftDirName)
+	if err != nil {
+		return nil, err
+	}
+	var raw []byte
+	if fname == manifestFileName {
+		raw, err = ws.read(mftDirName, manifestFileName)
+	} else {
+		raw, err = ws.renderer.Render(filepath.Join(copilotPath, mftDirName, fname))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -314,6 +345,24 @@ func (ws *Workspace) ReadWorkloadManifest(mftDirName string) (WorkloadManifest,
 	return mft, nil
 }
 
+// workloadManifestFileName returns the name of the only manifest file under the copilot/{name}/ directory.
+func (ws *Workspace) workloadManifestFileName(copilotPath, mftDirName string) (string, error) {
+	var found []string
+	for _, fname := range workloadManifestFileNames {
+		if exists, _ := ws.fs.Exists(filepath.Join(copilotPath, mftDirName, fname)); exists {
+			found = append(found, fname)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return "", &ErrFileNotExists{FileName: filepath.Join(copilotPath, mftDirName, manifestFileName)}
+	case 1:
+		return found[0], nil
+	default:
+		return "", fmt.Errorf("workload %s has more than one manifest: remove all but one of %s", mftDirName, strings.Join(found, ", "))
+	}
+}
+
 // ReadEnvironmentManifest returns the contents of the environment's manifest under copilot/environments/{name}/manifest.yml.
 func (ws *Workspace) ReadEnvironmentManifest(mftDirName string) (EnvironmentManifest, error) {
 	raw, err := ws.read(environmentsDirName, mftDirName, manifestFileName)
@@ -426,6 +475,24 @@ func (ws *Workspace) ReadAddonsDir(svcName string) ([]string, error) {
 	return names, nil
 }
 
+// GuardRulesDir returns the path to the "copilot/.guard/" directory holding the cfn-guard rules that generated templates must comply with.
+// The workspace opts in to validating templates by creating the directory, so the path is empty if it doesn't exist.
+func (ws *Workspace) GuardRulesDir() (string, error) {
+	copilotPath, err := ws.copilotDirPath()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(copilotPath, guardRulesDirName)
+	exists, err := ws.fs.DirExists(dir)
+	if err != nil {
+		return "", fmt.Errorf("check if directory %s exists: %w", dir, err)
+	}
+	if !exists {
+		return "", nil
+	}
+	return dir, nil
+}
+
 // ReadAddon returns the contents of a file under the service's "addons/" directory.
 func (ws *Workspace) ReadAddon(svc, fname string) ([]byte, error) {
 	return ws.read(svc, addonsDirName, fname)
@@ -670,6 +737,12 @@ func (e EnvironmentManifest) name() (string, error) {
 	return retrieveNameFromManifest(e)
 }
 
+// MarshalBinary returns the raw manifest, so that it can be written as is to another environment.
+// Implements the encoding.BinaryMarshaler interface.
+func (e EnvironmentManifest) MarshalBinary() ([]byte, error) {
+	return e, nil
+}
+
 type namedManifest interface {
 	name() (string, error)
 }
@@ -484,12 +484,15 @@ type: Backend Service`))
 				reportManifest.Write([]byte(`name: report
 type: Scheduled Job`))
 
+				fs.Mkdir("/copilot/orders", 0755)
+				afero.WriteFile(fs, "/copilot/orders/manifest.cue", []byte(`name: "orders"`), 0644)
+
 				// Missing manifest.yml.
 				fs.Mkdir("/copilot/inventory", 0755)
 				return fs
 			},
 
-			wantedNames: []string{"frontend", "users", "report"},
+			wantedNames: []string{"frontend", "users", "report", "orders"},
 		},
 	}
 
@@ -500,6 +503,9 @@ type: Scheduled Job`))
 				fs: &afero.Afero{
 					Fs: tc.fs(),
 				},
+				renderer: fakeRenderer(func(path string) ([]byte, error) {
+					return []byte("name: orders\ntype: Worker Service\n"), nil
+				}),
 			}
 
 			names, err := ws.ListWorkloads()
@@ -885,6 +891,49 @@ func TestWorkspace_ReadAddonsDir(t *testing.T) {
 	}
 }
 
+func TestWorkspace_GuardRulesDir(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedDir string
+	}{
+		"returns an empty path if the workspace doesn't have guard rules": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/webhook", 0755)
+				return fs
+			},
+		},
+		"returns the path to the guard rules": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/.guard", 0755)
+				return fs
+			},
+			wantedDir: "/copilot/.guard",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ws := &Workspace{
+				copilotDir: "/copilot",
+				fs: &afero.Afero{
+					Fs: tc.fs(),
+				},
+			}
+
+			// WHEN
+			dir, err := ws.GuardRulesDir()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDir, dir)
+		})
+	}
+}
+
 func TestWorkspace_WriteAddon(t *testing.T) {
 	testCases := map[string]struct {
 		marshaler   mockBinaryMarshaler
@@ -944,14 +993,22 @@ func TestWorkspace_WriteAddon(t *testing.T) {
 	}
 }
 
+// fakeRenderer renders manifests by calling itself.
+type fakeRenderer func(path string) ([]byte, error)
+
+func (r fakeRenderer) Render(path string) ([]byte, error) {
+	return r(path)
+}
+
 func TestWorkspace_ReadWorkloadManifest(t *testing.T) {
 	const (
 		mockCopilotDir   = "/copilot"
 		mockWorkloadName = "webhook"
 	)
 	testCases := map[string]struct {
-		elems  []string
-		mockFS func() afero.Fs
+		elems    []string
+		mockFS   func() afero.Fs
+		renderer fakeRenderer
 
 		wantedData      WorkloadManifest
 		wantedErr       error
@@ -1002,6 +1059,44 @@ flavor: vanilla`))
 type: Load Balanced Web Service
 flavor: vanilla`),
 		},
+		"render a jsonnet manifest": {
+			mockFS: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/webhook/", 0755)
+				afero.WriteFile(fs, "/copilot/webhook/manifest.jsonnet", []byte(`{name: "webhook"}`), 0644)
+				return fs
+			},
+			renderer: func(path string) ([]byte, error) {
+				require.Equal(t, "/copilot/webhook/manifest.jsonnet", path)
+				return []byte("name: webhook\ntype: Backend Service\n"), nil
+			},
+
+			wantedData: []byte("name: webhook\ntype: Backend Service\n"),
+		},
+		"return error if a CUE manifest fails to render": {
+			mockFS: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/webhook/", 0755)
+				afero.WriteFile(fs, "/copilot/webhook/manifest.cue", []byte(`name: "webhook"`), 0644)
+				return fs
+			},
+			renderer: func(path string) ([]byte, error) {
+				return nil, errors.New("some error")
+			},
+
+			wantedErr: errors.New("some error"),
+		},
+		"return error if the workload has more than one manifest": {
+			mockFS: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/webhook/", 0755)
+				afero.WriteFile(fs, "/copilot/webhook/manifest.yml", []byte(`name: webhook`), 0644)
+				afero.WriteFile(fs, "/copilot/webhook/manifest.cue", []byte(`name: "webhook"`), 0644)
+				return fs
+			},
+
+			wantedErr: errors.New("workload webhook has more than one manifest: remove all but one of manifest.yml, manifest.cue"),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1010,6 +1105,7 @@ flavor: vanilla`),
 				fs: &afero.Afero{
 					Fs: tc.mockFS(),
 				},
+				renderer: tc.renderer,
 			}
 			data, err := ws.ReadWorkloadManifest(mockWorkloadName)
 			if tc.wantedErr == nil && tc.wantedErrPrefix == "" {